@@ -2,24 +2,36 @@ package main
 
 import (
 	"context"
+	"errors"
+	"expvar"
 	"fmt"
 	"net/http"
 	"os"
+	"time"
 
 	"journeyhub/graph"
 	"journeyhub/graph/server"
 	"journeyhub/internal/modules/auth"
 	"journeyhub/internal/modules/auth/jwtauth"
+	"journeyhub/internal/modules/avatars"
 	"journeyhub/internal/modules/calls"
 	"journeyhub/internal/modules/chat"
 	"journeyhub/internal/modules/contacts"
 	"journeyhub/internal/modules/media"
 	"journeyhub/internal/modules/notifications"
+	"journeyhub/internal/modules/presence"
+	"journeyhub/internal/modules/reports"
 	"journeyhub/internal/modules/roommembers"
 	"journeyhub/internal/modules/rooms"
+	"journeyhub/internal/modules/users"
+	"journeyhub/internal/platform/antivirus"
 	"journeyhub/internal/platform/config"
 	"journeyhub/internal/platform/db"
+	"journeyhub/internal/platform/livekit"
 	"journeyhub/internal/platform/nats"
+	"journeyhub/internal/platform/outbox"
+	"journeyhub/internal/platform/push"
+	"journeyhub/internal/platform/ratelimit"
 	"journeyhub/internal/platform/validation"
 
 	"github.com/go-kit/log"
@@ -90,9 +102,21 @@ func main() {
 	entLogger := log.With(logger, "component", "ent")
 	entClient.Use(db.LoggingHook(entLogger))
 
+	// Initialize antivirus scanner
+	var antivirusScanner antivirus.Scanner
+	if config.Antivirus.Enabled {
+		antivirusScanner = antivirus.NewClamdScanner(config.Antivirus)
+	} else {
+		antivirusScanner = antivirus.NewNopScanner()
+	}
+	antivirusScanner = antivirus.NewScannerLogging(
+		log.With(logger, "component", "antivirus"),
+		antivirusScanner,
+	)
+
 	// Initialize media service
 	var mediaService media.Service
-	mediaService, mErr := media.NewService(config.S3)
+	mediaService, mErr := media.NewService(config.S3, config.Media, entClient, antivirusScanner)
 	if mErr != nil {
 		level.Error(logger).Log("exit", mErr)
 		os.Exit(1)
@@ -110,27 +134,96 @@ func main() {
 		validationService,
 	)
 
+	// Initialize auth service
+	var authService auth.Service
+	authService = auth.NewService(config.Auth, entClient)
+	authService = auth.NewServiceLogging(
+		log.With(logger, "component", "auth"),
+		authService,
+	)
+
+	// Initialize push senders
+	gorushSender, gErr := push.NewGorushSender(config.Notifications)
+	if gErr != nil {
+		level.Error(logger).Log("exit", gErr)
+		os.Exit(1)
+	}
+	defer gorushSender.Close()
+
+	pushProviders := map[string]push.Sender{
+		"gorush":  gorushSender,
+		"webhook": push.NewWebhookSender(config.Notifications.Webhook),
+	}
+	if config.Notifications.APNs.KeyFile != "" {
+		apnsSender, aErr := push.NewAPNsSender(config.Notifications.APNs)
+		if aErr != nil {
+			level.Error(logger).Log("exit", aErr)
+			os.Exit(1)
+		}
+		pushProviders["apns"] = apnsSender
+	}
+
+	pushSenders := map[push.Platform]push.Sender{}
+	for platform, provider := range map[push.Platform]string{
+		push.PlatformAndroid: config.Notifications.Providers.Android,
+		push.PlatformIOS:     config.Notifications.Providers.IOS,
+		push.PlatformWeb:     config.Notifications.Providers.Web,
+	} {
+		if provider == "" {
+			continue
+		}
+		sender, ok := pushProviders[provider]
+		if !ok {
+			level.Error(logger).Log("exit", fmt.Sprintf("unknown push provider %q for %s", provider, platform))
+			os.Exit(1)
+		}
+		pushSenders[platform] = push.NewSenderLogging(
+			log.With(logger, "component", "push", "platform", platform),
+			sender,
+		)
+	}
+	pushMonitor := push.NewMonitor(config.Notifications.InvalidTokens)
+	pushSender := push.NewSenderMonitoring(pushMonitor, push.NewRouter(pushSenders))
+
+	// Initialize outbox service
+	var outboxService outbox.Service
+	outboxService = outbox.NewService(config.Outbox, entClient, natsService, log.With(logger, "component", "outbox"))
+	outboxService = outbox.NewServiceLogging(
+		log.With(logger, "component", "outbox"),
+		outboxService,
+	)
+
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+
+	go outboxService.Relay(workersCtx)
+
 	// Initialize notifications service
+	var notificationsSubscriptions notifications.Subscriptions
+	notificationsSubscriptions = notifications.NewSubscriptions(entClient, authService, natsService)
+	notificationsSubscriptions = notifications.NewSubscriptionsLogging(
+		log.With(logger, "component", "notifications-subscriptions"),
+		notificationsSubscriptions,
+	)
 	var notificationsService notifications.Service
-	notificationsService = notifications.NewService(config.Notifications)
+	notificationsService = notifications.NewService(
+		config.Notifications,
+		entClient,
+		notificationsSubscriptions,
+		authService,
+		natsService,
+		outboxService,
+		pushSender,
+	)
 	notificationsService = notifications.NewServiceLogging(
 		log.With(logger, "component", "notifications"),
 		notificationsService,
 	)
 
-	if nErr := notificationsService.Connect(); nErr != nil {
-		level.Error(logger).Log("exit", nErr)
+	if wErr := notificationsService.StartPushWorker(workersCtx); wErr != nil {
+		level.Error(logger).Log("exit", wErr)
 		os.Exit(1)
 	}
-	defer notificationsService.Close()
-
-	// Initialize auth service
-	var authService auth.Service
-	authService = auth.NewService(config.Auth, entClient)
-	authService = auth.NewServiceLogging(
-		log.With(logger, "component", "auth"),
-		authService,
-	)
 
 	// Initialize room members service
 	var roomMembersSubscriptions roommembers.Subscriptions
@@ -155,35 +248,130 @@ func main() {
 	)
 
 	// Initialize contacts service
+	var contactsSubscriptions contacts.Subscriptions
+	contactsSubscriptions = contacts.NewSubscriptions(entClient, authService, natsService)
+	contactsSubscriptions = contacts.NewSubscriptionsLogging(
+		log.With(logger, "component", "contacts-subscriptions"),
+		contactsSubscriptions,
+	)
+	var contactsLimiter ratelimit.Limiter
+	contactsLimiter, lErr := ratelimit.NewKVLimiter(context.TODO(), natsService, config.Contacts.RateLimit)
+	if lErr != nil {
+		level.Error(logger).Log("exit", lErr)
+		os.Exit(1)
+	}
+	contactsLimiter = ratelimit.NewLimiterLogging(
+		log.With(logger, "component", "contacts-ratelimit"),
+		contactsLimiter,
+	)
 	var contactsService contacts.Service
-	contactsService = contacts.NewService(entClient, authService)
+	contactsService = contacts.NewService(
+		config.Contacts.Pins,
+		entClient,
+		contactsLimiter,
+		contactsSubscriptions,
+		authService,
+		notificationsService,
+		roomsService,
+	)
 	contactsService = contacts.NewServiceLogging(
 		log.With(logger, "component", "contacts"),
 		contactsService,
 	)
 
-	// Initialize call service
-	var callsService calls.Service
-	callsService = calls.NewService(config.Livekit, entClient, authService, notificationsService)
-	callsService = calls.NewServiceLogging(
-		log.With(logger, "component", "calls"),
-		callsService,
-	)
-
 	// Initialize chat service
 	var chatSubscriptions chat.Subscriptions
-	chatSubscriptions = chat.NewSubscriptions(entClient, natsService)
+	chatSubscriptions = chat.NewSubscriptions(entClient, authService, natsService)
 	chatSubscriptions = chat.NewSubscriptionsLogging(
 		log.With(logger, "component", "chat-subscriptions"),
 		chatSubscriptions,
 	)
 	var chatService chat.Service
-	chatService = chat.NewService(entClient, chatSubscriptions, authService, roomsService, roomMembersService, mediaService)
+	chatService = chat.NewService(entClient, chatSubscriptions, authService, roomsService, roomMembersService, mediaService, log.With(logger, "component", "chat"))
 	chatService = chat.NewServiceLogging(
 		log.With(logger, "component", "chat"),
 		chatService,
 	)
 
+	// Initialize call service
+	var callsSubscriptions calls.Subscriptions
+	callsSubscriptions = calls.NewSubscriptions(authService, natsService)
+	callsSubscriptions = calls.NewSubscriptionsLogging(
+		log.With(logger, "component", "calls-subscriptions"),
+		callsSubscriptions,
+	)
+
+	var callsService calls.Service
+	callsService = calls.NewService(config.Livekit, config.Calls, entClient, callsSubscriptions, authService, roomMembersService, chatService, notificationsService, log.With(logger, "component", "calls"))
+	callsService = calls.NewServiceLogging(
+		log.With(logger, "component", "calls"),
+		callsService,
+	)
+
+	// A failed run is retried on the next tick.
+	go func() {
+		ticker := time.NewTicker(config.Calls.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-workersCtx.Done():
+				return
+			case <-ticker.C:
+				if err := callsService.ExpireCalls(workersCtx); err != nil && !errors.Is(err, context.Canceled) {
+					level.Error(logger).Log("component", "calls", "method", "ExpireCalls", "err", err)
+				}
+			}
+		}
+	}()
+
+	// Initialize users service
+	var searchLimiter ratelimit.Limiter
+	searchLimiter, lErr = ratelimit.NewKVLimiter(context.TODO(), natsService, config.Users.Search)
+	if lErr != nil {
+		level.Error(logger).Log("exit", lErr)
+		os.Exit(1)
+	}
+	searchLimiter = ratelimit.NewLimiterLogging(
+		log.With(logger, "component", "users-ratelimit"),
+		searchLimiter,
+	)
+	var usersService users.Service
+	usersService = users.NewService(entClient, authService, searchLimiter)
+	usersService = users.NewServiceLogging(
+		log.With(logger, "component", "users"),
+		usersService,
+	)
+
+	// Initialize avatars service
+	var avatarsService avatars.Service
+	avatarsService = avatars.NewService(entClient, authService, mediaService, roomMembersService, roomsService, chatService)
+	avatarsService = avatars.NewServiceLogging(
+		log.With(logger, "component", "avatars"),
+		avatarsService,
+	)
+
+	// Initialize presence service
+	var presenceService presence.Service
+	presenceService = presence.NewService(config.Presence, entClient, authService, natsService, log.With(logger, "component", "presence"))
+	presenceService = presence.NewServiceLogging(
+		log.With(logger, "component", "presence"),
+		presenceService,
+	)
+
+	if pErr := presenceService.Start(workersCtx); pErr != nil {
+		level.Error(logger).Log("exit", pErr)
+		os.Exit(1)
+	}
+
+	// Initialize reports service
+	var reportsService reports.Service
+	reportsService = reports.NewService(entClient, authService, roomsService, roomMembersService, chatService)
+	reportsService = reports.NewServiceLogging(
+		log.With(logger, "component", "reports"),
+		reportsService,
+	)
+
 	httpLogger := log.With(logger, "component", "http")
 
 	// Initialize chi router
@@ -200,6 +388,7 @@ func main() {
 
 	// Auth middleware stack
 	router.Use(jwtauth.Verifier(jwtAuth))
+	router.Use(auth.RejectSuspended(authService))
 
 	// Set a timeout value on the request context (ctx), that will signal
 	// through ctx.Done() that the request has timed out and further
@@ -217,16 +406,53 @@ func main() {
 			contactsService,
 			callsService,
 			chatService,
+			mediaService,
+			notificationsService,
+			usersService,
+			avatarsService,
+			presenceService,
+			reportsService,
 		),
 		graphqlLogger,
 		jwtAuth,
 		entClient,
+		authService,
+		presenceService,
 	)
 	router.Handle("/query", graphqlQueryHandler)
 
 	graphqlPlaygroundHandler := playground.AltairHandler("GraphQL", "/query")
 	router.Get("/", graphqlPlaygroundHandler)
 
+	if config.Notifications.Feedback.Secret != "" {
+		router.Handle("/push/feedback", push.NewFeedbackHandler(
+			config.Notifications.Feedback,
+			pushMonitor,
+			notificationsService.ReportPushFeedback,
+		))
+	} else {
+		level.Warn(httpLogger).Log("msg", "push feedback disabled, no secret configured")
+	}
+	router.Handle("/livekit/webhook", livekit.NewWebhookHandler(
+		config.Livekit,
+		callsService.HandleLivekitEvent,
+	))
+
+	// The metrics stay off the public router.
+	if config.Server.Debug != "" {
+		debugRouter := http.NewServeMux()
+		debugRouter.Handle("/debug/vars", expvar.Handler())
+		go func() {
+			level.Info(httpLogger).Log(
+				"msg", "start debug server",
+				"addr", config.Server.Debug,
+			)
+			if err := http.ListenAndServe(config.Server.Debug, debugRouter); err != nil {
+				level.Error(httpLogger).Log("msg", "debug server", "err", err)
+			}
+		}()
+	}
+
 	addr := fmt.Sprintf(":%d", config.Server.Port)
 
 	level.Info(httpLogger).Log(
@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"journeyhub/ent/avatar"
+	"journeyhub/ent/file"
+	"journeyhub/ent/room"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Avatar is the model entity for the Avatar schema.
+type Avatar struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID pulid.ID `json:"id,omitempty"`
+	// FileID holds the value of the "file_id" field.
+	FileID pulid.ID `json:"file_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *pulid.ID `json:"user_id,omitempty"`
+	// RoomID holds the value of the "room_id" field.
+	RoomID *pulid.ID `json:"room_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AvatarQuery when eager-loading is set.
+	Edges        AvatarEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AvatarEdges holds the relations/edges for other nodes in the graph.
+type AvatarEdges struct {
+	// File holds the value of the file edge.
+	File *File `json:"file,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Room holds the value of the room edge.
+	Room *Room `json:"room,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+	// totalCount holds the count of the edges above.
+	totalCount [3]map[string]int
+}
+
+// FileOrErr returns the File value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AvatarEdges) FileOrErr() (*File, error) {
+	if e.File != nil {
+		return e.File, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: file.Label}
+	}
+	return nil, &NotLoadedError{edge: "file"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AvatarEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// RoomOrErr returns the Room value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AvatarEdges) RoomOrErr() (*Room, error) {
+	if e.Room != nil {
+		return e.Room, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: room.Label}
+	}
+	return nil, &NotLoadedError{edge: "room"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Avatar) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case avatar.FieldUserID, avatar.FieldRoomID:
+			values[i] = &sql.NullScanner{S: new(pulid.ID)}
+		case avatar.FieldID, avatar.FieldFileID:
+			values[i] = new(pulid.ID)
+		case avatar.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Avatar fields.
+func (a *Avatar) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case avatar.FieldID:
+			if value, ok := values[i].(*pulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				a.ID = *value
+			}
+		case avatar.FieldFileID:
+			if value, ok := values[i].(*pulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field file_id", values[i])
+			} else if value != nil {
+				a.FileID = *value
+			}
+		case avatar.FieldUserID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				a.UserID = new(pulid.ID)
+				*a.UserID = *value.S.(*pulid.ID)
+			}
+		case avatar.FieldRoomID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field room_id", values[i])
+			} else if value.Valid {
+				a.RoomID = new(pulid.ID)
+				*a.RoomID = *value.S.(*pulid.ID)
+			}
+		case avatar.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				a.CreatedAt = value.Time
+			}
+		default:
+			a.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Avatar.
+// This includes values selected through modifiers, order, etc.
+func (a *Avatar) Value(name string) (ent.Value, error) {
+	return a.selectValues.Get(name)
+}
+
+// QueryFile queries the "file" edge of the Avatar entity.
+func (a *Avatar) QueryFile() *FileQuery {
+	return NewAvatarClient(a.config).QueryFile(a)
+}
+
+// QueryUser queries the "user" edge of the Avatar entity.
+func (a *Avatar) QueryUser() *UserQuery {
+	return NewAvatarClient(a.config).QueryUser(a)
+}
+
+// QueryRoom queries the "room" edge of the Avatar entity.
+func (a *Avatar) QueryRoom() *RoomQuery {
+	return NewAvatarClient(a.config).QueryRoom(a)
+}
+
+// Update returns a builder for updating this Avatar.
+// Note that you need to call Avatar.Unwrap() before calling this method if this Avatar
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Avatar) Update() *AvatarUpdateOne {
+	return NewAvatarClient(a.config).UpdateOne(a)
+}
+
+// Unwrap unwraps the Avatar entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *Avatar) Unwrap() *Avatar {
+	_tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Avatar is not a transactional entity")
+	}
+	a.config.driver = _tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Avatar) String() string {
+	var builder strings.Builder
+	builder.WriteString("Avatar(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
+	builder.WriteString("file_id=")
+	builder.WriteString(fmt.Sprintf("%v", a.FileID))
+	builder.WriteString(", ")
+	if v := a.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := a.RoomID; v != nil {
+		builder.WriteString("room_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(a.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Avatars is a parsable slice of Avatar.
+type Avatars []*Avatar
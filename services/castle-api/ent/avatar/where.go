@@ -0,0 +1,431 @@
+// Code generated by ent, DO NOT EDIT.
+
+package avatar
+
+import (
+	"journeyhub/ent/predicate"
+	"journeyhub/ent/schema/pulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldLTE(FieldID, id))
+}
+
+// FileID applies equality check predicate on the "file_id" field. It's identical to FileIDEQ.
+func FileID(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldEQ(FieldFileID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldEQ(FieldUserID, v))
+}
+
+// RoomID applies equality check predicate on the "room_id" field. It's identical to RoomIDEQ.
+func RoomID(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldEQ(FieldRoomID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Avatar {
+	return predicate.Avatar(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FileIDEQ applies the EQ predicate on the "file_id" field.
+func FileIDEQ(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldEQ(FieldFileID, v))
+}
+
+// FileIDNEQ applies the NEQ predicate on the "file_id" field.
+func FileIDNEQ(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldNEQ(FieldFileID, v))
+}
+
+// FileIDIn applies the In predicate on the "file_id" field.
+func FileIDIn(vs ...pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldIn(FieldFileID, vs...))
+}
+
+// FileIDNotIn applies the NotIn predicate on the "file_id" field.
+func FileIDNotIn(vs ...pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldNotIn(FieldFileID, vs...))
+}
+
+// FileIDGT applies the GT predicate on the "file_id" field.
+func FileIDGT(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldGT(FieldFileID, v))
+}
+
+// FileIDGTE applies the GTE predicate on the "file_id" field.
+func FileIDGTE(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldGTE(FieldFileID, v))
+}
+
+// FileIDLT applies the LT predicate on the "file_id" field.
+func FileIDLT(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldLT(FieldFileID, v))
+}
+
+// FileIDLTE applies the LTE predicate on the "file_id" field.
+func FileIDLTE(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldLTE(FieldFileID, v))
+}
+
+// FileIDContains applies the Contains predicate on the "file_id" field.
+func FileIDContains(v pulid.ID) predicate.Avatar {
+	vc := string(v)
+	return predicate.Avatar(sql.FieldContains(FieldFileID, vc))
+}
+
+// FileIDHasPrefix applies the HasPrefix predicate on the "file_id" field.
+func FileIDHasPrefix(v pulid.ID) predicate.Avatar {
+	vc := string(v)
+	return predicate.Avatar(sql.FieldHasPrefix(FieldFileID, vc))
+}
+
+// FileIDHasSuffix applies the HasSuffix predicate on the "file_id" field.
+func FileIDHasSuffix(v pulid.ID) predicate.Avatar {
+	vc := string(v)
+	return predicate.Avatar(sql.FieldHasSuffix(FieldFileID, vc))
+}
+
+// FileIDEqualFold applies the EqualFold predicate on the "file_id" field.
+func FileIDEqualFold(v pulid.ID) predicate.Avatar {
+	vc := string(v)
+	return predicate.Avatar(sql.FieldEqualFold(FieldFileID, vc))
+}
+
+// FileIDContainsFold applies the ContainsFold predicate on the "file_id" field.
+func FileIDContainsFold(v pulid.ID) predicate.Avatar {
+	vc := string(v)
+	return predicate.Avatar(sql.FieldContainsFold(FieldFileID, vc))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v pulid.ID) predicate.Avatar {
+	vc := string(v)
+	return predicate.Avatar(sql.FieldContains(FieldUserID, vc))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v pulid.ID) predicate.Avatar {
+	vc := string(v)
+	return predicate.Avatar(sql.FieldHasPrefix(FieldUserID, vc))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v pulid.ID) predicate.Avatar {
+	vc := string(v)
+	return predicate.Avatar(sql.FieldHasSuffix(FieldUserID, vc))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.Avatar {
+	return predicate.Avatar(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.Avatar {
+	return predicate.Avatar(sql.FieldNotNull(FieldUserID))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v pulid.ID) predicate.Avatar {
+	vc := string(v)
+	return predicate.Avatar(sql.FieldEqualFold(FieldUserID, vc))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v pulid.ID) predicate.Avatar {
+	vc := string(v)
+	return predicate.Avatar(sql.FieldContainsFold(FieldUserID, vc))
+}
+
+// RoomIDEQ applies the EQ predicate on the "room_id" field.
+func RoomIDEQ(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldEQ(FieldRoomID, v))
+}
+
+// RoomIDNEQ applies the NEQ predicate on the "room_id" field.
+func RoomIDNEQ(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldNEQ(FieldRoomID, v))
+}
+
+// RoomIDIn applies the In predicate on the "room_id" field.
+func RoomIDIn(vs ...pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldIn(FieldRoomID, vs...))
+}
+
+// RoomIDNotIn applies the NotIn predicate on the "room_id" field.
+func RoomIDNotIn(vs ...pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldNotIn(FieldRoomID, vs...))
+}
+
+// RoomIDGT applies the GT predicate on the "room_id" field.
+func RoomIDGT(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldGT(FieldRoomID, v))
+}
+
+// RoomIDGTE applies the GTE predicate on the "room_id" field.
+func RoomIDGTE(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldGTE(FieldRoomID, v))
+}
+
+// RoomIDLT applies the LT predicate on the "room_id" field.
+func RoomIDLT(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldLT(FieldRoomID, v))
+}
+
+// RoomIDLTE applies the LTE predicate on the "room_id" field.
+func RoomIDLTE(v pulid.ID) predicate.Avatar {
+	return predicate.Avatar(sql.FieldLTE(FieldRoomID, v))
+}
+
+// RoomIDContains applies the Contains predicate on the "room_id" field.
+func RoomIDContains(v pulid.ID) predicate.Avatar {
+	vc := string(v)
+	return predicate.Avatar(sql.FieldContains(FieldRoomID, vc))
+}
+
+// RoomIDHasPrefix applies the HasPrefix predicate on the "room_id" field.
+func RoomIDHasPrefix(v pulid.ID) predicate.Avatar {
+	vc := string(v)
+	return predicate.Avatar(sql.FieldHasPrefix(FieldRoomID, vc))
+}
+
+// RoomIDHasSuffix applies the HasSuffix predicate on the "room_id" field.
+func RoomIDHasSuffix(v pulid.ID) predicate.Avatar {
+	vc := string(v)
+	return predicate.Avatar(sql.FieldHasSuffix(FieldRoomID, vc))
+}
+
+// RoomIDIsNil applies the IsNil predicate on the "room_id" field.
+func RoomIDIsNil() predicate.Avatar {
+	return predicate.Avatar(sql.FieldIsNull(FieldRoomID))
+}
+
+// RoomIDNotNil applies the NotNil predicate on the "room_id" field.
+func RoomIDNotNil() predicate.Avatar {
+	return predicate.Avatar(sql.FieldNotNull(FieldRoomID))
+}
+
+// RoomIDEqualFold applies the EqualFold predicate on the "room_id" field.
+func RoomIDEqualFold(v pulid.ID) predicate.Avatar {
+	vc := string(v)
+	return predicate.Avatar(sql.FieldEqualFold(FieldRoomID, vc))
+}
+
+// RoomIDContainsFold applies the ContainsFold predicate on the "room_id" field.
+func RoomIDContainsFold(v pulid.ID) predicate.Avatar {
+	vc := string(v)
+	return predicate.Avatar(sql.FieldContainsFold(FieldRoomID, vc))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Avatar {
+	return predicate.Avatar(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Avatar {
+	return predicate.Avatar(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Avatar {
+	return predicate.Avatar(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Avatar {
+	return predicate.Avatar(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Avatar {
+	return predicate.Avatar(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Avatar {
+	return predicate.Avatar(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Avatar {
+	return predicate.Avatar(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Avatar {
+	return predicate.Avatar(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasFile applies the HasEdge predicate on the "file" edge.
+func HasFile() predicate.Avatar {
+	return predicate.Avatar(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, FileTable, FileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFileWith applies the HasEdge predicate on the "file" edge with a given conditions (other predicates).
+func HasFileWith(preds ...predicate.File) predicate.Avatar {
+	return predicate.Avatar(func(s *sql.Selector) {
+		step := newFileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Avatar {
+	return predicate.Avatar(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Avatar {
+	return predicate.Avatar(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRoom applies the HasEdge predicate on the "room" edge.
+func HasRoom() predicate.Avatar {
+	return predicate.Avatar(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RoomTable, RoomColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoomWith applies the HasEdge predicate on the "room" edge with a given conditions (other predicates).
+func HasRoomWith(preds ...predicate.Room) predicate.Avatar {
+	return predicate.Avatar(func(s *sql.Selector) {
+		step := newRoomStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Avatar) predicate.Avatar {
+	return predicate.Avatar(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Avatar) predicate.Avatar {
+	return predicate.Avatar(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Avatar) predicate.Avatar {
+	return predicate.Avatar(sql.NotPredicates(p))
+}
@@ -0,0 +1,756 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journeyhub/ent/avatar"
+	"journeyhub/ent/file"
+	"journeyhub/ent/room"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AvatarCreate is the builder for creating a Avatar entity.
+type AvatarCreate struct {
+	config
+	mutation *AvatarMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetFileID sets the "file_id" field.
+func (ac *AvatarCreate) SetFileID(pu pulid.ID) *AvatarCreate {
+	ac.mutation.SetFileID(pu)
+	return ac
+}
+
+// SetUserID sets the "user_id" field.
+func (ac *AvatarCreate) SetUserID(pu pulid.ID) *AvatarCreate {
+	ac.mutation.SetUserID(pu)
+	return ac
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ac *AvatarCreate) SetNillableUserID(pu *pulid.ID) *AvatarCreate {
+	if pu != nil {
+		ac.SetUserID(*pu)
+	}
+	return ac
+}
+
+// SetRoomID sets the "room_id" field.
+func (ac *AvatarCreate) SetRoomID(pu pulid.ID) *AvatarCreate {
+	ac.mutation.SetRoomID(pu)
+	return ac
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (ac *AvatarCreate) SetNillableRoomID(pu *pulid.ID) *AvatarCreate {
+	if pu != nil {
+		ac.SetRoomID(*pu)
+	}
+	return ac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ac *AvatarCreate) SetCreatedAt(t time.Time) *AvatarCreate {
+	ac.mutation.SetCreatedAt(t)
+	return ac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ac *AvatarCreate) SetNillableCreatedAt(t *time.Time) *AvatarCreate {
+	if t != nil {
+		ac.SetCreatedAt(*t)
+	}
+	return ac
+}
+
+// SetID sets the "id" field.
+func (ac *AvatarCreate) SetID(pu pulid.ID) *AvatarCreate {
+	ac.mutation.SetID(pu)
+	return ac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ac *AvatarCreate) SetNillableID(pu *pulid.ID) *AvatarCreate {
+	if pu != nil {
+		ac.SetID(*pu)
+	}
+	return ac
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (ac *AvatarCreate) SetFile(f *File) *AvatarCreate {
+	return ac.SetFileID(f.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ac *AvatarCreate) SetUser(u *User) *AvatarCreate {
+	return ac.SetUserID(u.ID)
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (ac *AvatarCreate) SetRoom(r *Room) *AvatarCreate {
+	return ac.SetRoomID(r.ID)
+}
+
+// Mutation returns the AvatarMutation object of the builder.
+func (ac *AvatarCreate) Mutation() *AvatarMutation {
+	return ac.mutation
+}
+
+// Save creates the Avatar in the database.
+func (ac *AvatarCreate) Save(ctx context.Context) (*Avatar, error) {
+	ac.defaults()
+	return withHooks(ctx, ac.sqlSave, ac.mutation, ac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *AvatarCreate) SaveX(ctx context.Context) *Avatar {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *AvatarCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *AvatarCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *AvatarCreate) defaults() {
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		v := avatar.DefaultCreatedAt()
+		ac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ac.mutation.ID(); !ok {
+		v := avatar.DefaultID()
+		ac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *AvatarCreate) check() error {
+	if _, ok := ac.mutation.FileID(); !ok {
+		return &ValidationError{Name: "file_id", err: errors.New(`ent: missing required field "Avatar.file_id"`)}
+	}
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Avatar.created_at"`)}
+	}
+	if len(ac.mutation.FileIDs()) == 0 {
+		return &ValidationError{Name: "file", err: errors.New(`ent: missing required edge "Avatar.file"`)}
+	}
+	return nil
+}
+
+func (ac *AvatarCreate) sqlSave(ctx context.Context) (*Avatar, error) {
+	if err := ac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*pulid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ac.mutation.id = &_node.ID
+	ac.mutation.done = true
+	return _node, nil
+}
+
+func (ac *AvatarCreate) createSpec() (*Avatar, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Avatar{config: ac.config}
+		_spec = sqlgraph.NewCreateSpec(avatar.Table, sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = ac.conflict
+	if id, ok := ac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ac.mutation.CreatedAt(); ok {
+		_spec.SetField(avatar.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ac.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   avatar.FileTable,
+			Columns: []string{avatar.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FileID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   avatar.UserTable,
+			Columns: []string{avatar.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   avatar.RoomTable,
+			Columns: []string{avatar.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoomID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Avatar.Create().
+//		SetFileID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AvatarUpsert) {
+//			SetFileID(v+v).
+//		}).
+//		Exec(ctx)
+func (ac *AvatarCreate) OnConflict(opts ...sql.ConflictOption) *AvatarUpsertOne {
+	ac.conflict = opts
+	return &AvatarUpsertOne{
+		create: ac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Avatar.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ac *AvatarCreate) OnConflictColumns(columns ...string) *AvatarUpsertOne {
+	ac.conflict = append(ac.conflict, sql.ConflictColumns(columns...))
+	return &AvatarUpsertOne{
+		create: ac,
+	}
+}
+
+type (
+	// AvatarUpsertOne is the builder for "upsert"-ing
+	//  one Avatar node.
+	AvatarUpsertOne struct {
+		create *AvatarCreate
+	}
+
+	// AvatarUpsert is the "OnConflict" setter.
+	AvatarUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetFileID sets the "file_id" field.
+func (u *AvatarUpsert) SetFileID(v pulid.ID) *AvatarUpsert {
+	u.Set(avatar.FieldFileID, v)
+	return u
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *AvatarUpsert) UpdateFileID() *AvatarUpsert {
+	u.SetExcluded(avatar.FieldFileID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AvatarUpsert) SetUserID(v pulid.ID) *AvatarUpsert {
+	u.Set(avatar.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AvatarUpsert) UpdateUserID() *AvatarUpsert {
+	u.SetExcluded(avatar.FieldUserID)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *AvatarUpsert) ClearUserID() *AvatarUpsert {
+	u.SetNull(avatar.FieldUserID)
+	return u
+}
+
+// SetRoomID sets the "room_id" field.
+func (u *AvatarUpsert) SetRoomID(v pulid.ID) *AvatarUpsert {
+	u.Set(avatar.FieldRoomID, v)
+	return u
+}
+
+// UpdateRoomID sets the "room_id" field to the value that was provided on create.
+func (u *AvatarUpsert) UpdateRoomID() *AvatarUpsert {
+	u.SetExcluded(avatar.FieldRoomID)
+	return u
+}
+
+// ClearRoomID clears the value of the "room_id" field.
+func (u *AvatarUpsert) ClearRoomID() *AvatarUpsert {
+	u.SetNull(avatar.FieldRoomID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Avatar.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(avatar.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AvatarUpsertOne) UpdateNewValues() *AvatarUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(avatar.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(avatar.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Avatar.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AvatarUpsertOne) Ignore() *AvatarUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AvatarUpsertOne) DoNothing() *AvatarUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AvatarCreate.OnConflict
+// documentation for more info.
+func (u *AvatarUpsertOne) Update(set func(*AvatarUpsert)) *AvatarUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AvatarUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetFileID sets the "file_id" field.
+func (u *AvatarUpsertOne) SetFileID(v pulid.ID) *AvatarUpsertOne {
+	return u.Update(func(s *AvatarUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *AvatarUpsertOne) UpdateFileID() *AvatarUpsertOne {
+	return u.Update(func(s *AvatarUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AvatarUpsertOne) SetUserID(v pulid.ID) *AvatarUpsertOne {
+	return u.Update(func(s *AvatarUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AvatarUpsertOne) UpdateUserID() *AvatarUpsertOne {
+	return u.Update(func(s *AvatarUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *AvatarUpsertOne) ClearUserID() *AvatarUpsertOne {
+	return u.Update(func(s *AvatarUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetRoomID sets the "room_id" field.
+func (u *AvatarUpsertOne) SetRoomID(v pulid.ID) *AvatarUpsertOne {
+	return u.Update(func(s *AvatarUpsert) {
+		s.SetRoomID(v)
+	})
+}
+
+// UpdateRoomID sets the "room_id" field to the value that was provided on create.
+func (u *AvatarUpsertOne) UpdateRoomID() *AvatarUpsertOne {
+	return u.Update(func(s *AvatarUpsert) {
+		s.UpdateRoomID()
+	})
+}
+
+// ClearRoomID clears the value of the "room_id" field.
+func (u *AvatarUpsertOne) ClearRoomID() *AvatarUpsertOne {
+	return u.Update(func(s *AvatarUpsert) {
+		s.ClearRoomID()
+	})
+}
+
+// Exec executes the query.
+func (u *AvatarUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AvatarCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AvatarUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AvatarUpsertOne) ID(ctx context.Context) (id pulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AvatarUpsertOne.ID is not supported by MySQL driver. Use AvatarUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AvatarUpsertOne) IDX(ctx context.Context) pulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AvatarCreateBulk is the builder for creating many Avatar entities in bulk.
+type AvatarCreateBulk struct {
+	config
+	err      error
+	builders []*AvatarCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Avatar entities in the database.
+func (acb *AvatarCreateBulk) Save(ctx context.Context) ([]*Avatar, error) {
+	if acb.err != nil {
+		return nil, acb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Avatar, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AvatarMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = acb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *AvatarCreateBulk) SaveX(ctx context.Context) []*Avatar {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *AvatarCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *AvatarCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Avatar.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AvatarUpsert) {
+//			SetFileID(v+v).
+//		}).
+//		Exec(ctx)
+func (acb *AvatarCreateBulk) OnConflict(opts ...sql.ConflictOption) *AvatarUpsertBulk {
+	acb.conflict = opts
+	return &AvatarUpsertBulk{
+		create: acb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Avatar.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (acb *AvatarCreateBulk) OnConflictColumns(columns ...string) *AvatarUpsertBulk {
+	acb.conflict = append(acb.conflict, sql.ConflictColumns(columns...))
+	return &AvatarUpsertBulk{
+		create: acb,
+	}
+}
+
+// AvatarUpsertBulk is the builder for "upsert"-ing
+// a bulk of Avatar nodes.
+type AvatarUpsertBulk struct {
+	create *AvatarCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Avatar.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(avatar.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AvatarUpsertBulk) UpdateNewValues() *AvatarUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(avatar.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(avatar.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Avatar.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AvatarUpsertBulk) Ignore() *AvatarUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AvatarUpsertBulk) DoNothing() *AvatarUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AvatarCreateBulk.OnConflict
+// documentation for more info.
+func (u *AvatarUpsertBulk) Update(set func(*AvatarUpsert)) *AvatarUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AvatarUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetFileID sets the "file_id" field.
+func (u *AvatarUpsertBulk) SetFileID(v pulid.ID) *AvatarUpsertBulk {
+	return u.Update(func(s *AvatarUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *AvatarUpsertBulk) UpdateFileID() *AvatarUpsertBulk {
+	return u.Update(func(s *AvatarUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AvatarUpsertBulk) SetUserID(v pulid.ID) *AvatarUpsertBulk {
+	return u.Update(func(s *AvatarUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AvatarUpsertBulk) UpdateUserID() *AvatarUpsertBulk {
+	return u.Update(func(s *AvatarUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *AvatarUpsertBulk) ClearUserID() *AvatarUpsertBulk {
+	return u.Update(func(s *AvatarUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetRoomID sets the "room_id" field.
+func (u *AvatarUpsertBulk) SetRoomID(v pulid.ID) *AvatarUpsertBulk {
+	return u.Update(func(s *AvatarUpsert) {
+		s.SetRoomID(v)
+	})
+}
+
+// UpdateRoomID sets the "room_id" field to the value that was provided on create.
+func (u *AvatarUpsertBulk) UpdateRoomID() *AvatarUpsertBulk {
+	return u.Update(func(s *AvatarUpsert) {
+		s.UpdateRoomID()
+	})
+}
+
+// ClearRoomID clears the value of the "room_id" field.
+func (u *AvatarUpsertBulk) ClearRoomID() *AvatarUpsertBulk {
+	return u.Update(func(s *AvatarUpsert) {
+		s.ClearRoomID()
+	})
+}
+
+// Exec executes the query.
+func (u *AvatarUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AvatarCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AvatarCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AvatarUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journeyhub/ent/avatar"
+	"journeyhub/ent/file"
+	"journeyhub/ent/predicate"
+	"journeyhub/ent/room"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AvatarUpdate is the builder for updating Avatar entities.
+type AvatarUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AvatarMutation
+}
+
+// Where appends a list predicates to the AvatarUpdate builder.
+func (au *AvatarUpdate) Where(ps ...predicate.Avatar) *AvatarUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetFileID sets the "file_id" field.
+func (au *AvatarUpdate) SetFileID(pu pulid.ID) *AvatarUpdate {
+	au.mutation.SetFileID(pu)
+	return au
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (au *AvatarUpdate) SetNillableFileID(pu *pulid.ID) *AvatarUpdate {
+	if pu != nil {
+		au.SetFileID(*pu)
+	}
+	return au
+}
+
+// SetUserID sets the "user_id" field.
+func (au *AvatarUpdate) SetUserID(pu pulid.ID) *AvatarUpdate {
+	au.mutation.SetUserID(pu)
+	return au
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (au *AvatarUpdate) SetNillableUserID(pu *pulid.ID) *AvatarUpdate {
+	if pu != nil {
+		au.SetUserID(*pu)
+	}
+	return au
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (au *AvatarUpdate) ClearUserID() *AvatarUpdate {
+	au.mutation.ClearUserID()
+	return au
+}
+
+// SetRoomID sets the "room_id" field.
+func (au *AvatarUpdate) SetRoomID(pu pulid.ID) *AvatarUpdate {
+	au.mutation.SetRoomID(pu)
+	return au
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (au *AvatarUpdate) SetNillableRoomID(pu *pulid.ID) *AvatarUpdate {
+	if pu != nil {
+		au.SetRoomID(*pu)
+	}
+	return au
+}
+
+// ClearRoomID clears the value of the "room_id" field.
+func (au *AvatarUpdate) ClearRoomID() *AvatarUpdate {
+	au.mutation.ClearRoomID()
+	return au
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (au *AvatarUpdate) SetFile(f *File) *AvatarUpdate {
+	return au.SetFileID(f.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (au *AvatarUpdate) SetUser(u *User) *AvatarUpdate {
+	return au.SetUserID(u.ID)
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (au *AvatarUpdate) SetRoom(r *Room) *AvatarUpdate {
+	return au.SetRoomID(r.ID)
+}
+
+// Mutation returns the AvatarMutation object of the builder.
+func (au *AvatarUpdate) Mutation() *AvatarMutation {
+	return au.mutation
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (au *AvatarUpdate) ClearFile() *AvatarUpdate {
+	au.mutation.ClearFile()
+	return au
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (au *AvatarUpdate) ClearUser() *AvatarUpdate {
+	au.mutation.ClearUser()
+	return au
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (au *AvatarUpdate) ClearRoom() *AvatarUpdate {
+	au.mutation.ClearRoom()
+	return au
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AvatarUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AvatarUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AvatarUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AvatarUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *AvatarUpdate) check() error {
+	if au.mutation.FileCleared() && len(au.mutation.FileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Avatar.file"`)
+	}
+	return nil
+}
+
+func (au *AvatarUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := au.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(avatar.Table, avatar.Columns, sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if au.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   avatar.FileTable,
+			Columns: []string{avatar.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   avatar.FileTable,
+			Columns: []string{avatar.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   avatar.UserTable,
+			Columns: []string{avatar.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   avatar.UserTable,
+			Columns: []string{avatar.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   avatar.RoomTable,
+			Columns: []string{avatar.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   avatar.RoomTable,
+			Columns: []string{avatar.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{avatar.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AvatarUpdateOne is the builder for updating a single Avatar entity.
+type AvatarUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AvatarMutation
+}
+
+// SetFileID sets the "file_id" field.
+func (auo *AvatarUpdateOne) SetFileID(pu pulid.ID) *AvatarUpdateOne {
+	auo.mutation.SetFileID(pu)
+	return auo
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (auo *AvatarUpdateOne) SetNillableFileID(pu *pulid.ID) *AvatarUpdateOne {
+	if pu != nil {
+		auo.SetFileID(*pu)
+	}
+	return auo
+}
+
+// SetUserID sets the "user_id" field.
+func (auo *AvatarUpdateOne) SetUserID(pu pulid.ID) *AvatarUpdateOne {
+	auo.mutation.SetUserID(pu)
+	return auo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (auo *AvatarUpdateOne) SetNillableUserID(pu *pulid.ID) *AvatarUpdateOne {
+	if pu != nil {
+		auo.SetUserID(*pu)
+	}
+	return auo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (auo *AvatarUpdateOne) ClearUserID() *AvatarUpdateOne {
+	auo.mutation.ClearUserID()
+	return auo
+}
+
+// SetRoomID sets the "room_id" field.
+func (auo *AvatarUpdateOne) SetRoomID(pu pulid.ID) *AvatarUpdateOne {
+	auo.mutation.SetRoomID(pu)
+	return auo
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (auo *AvatarUpdateOne) SetNillableRoomID(pu *pulid.ID) *AvatarUpdateOne {
+	if pu != nil {
+		auo.SetRoomID(*pu)
+	}
+	return auo
+}
+
+// ClearRoomID clears the value of the "room_id" field.
+func (auo *AvatarUpdateOne) ClearRoomID() *AvatarUpdateOne {
+	auo.mutation.ClearRoomID()
+	return auo
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (auo *AvatarUpdateOne) SetFile(f *File) *AvatarUpdateOne {
+	return auo.SetFileID(f.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (auo *AvatarUpdateOne) SetUser(u *User) *AvatarUpdateOne {
+	return auo.SetUserID(u.ID)
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (auo *AvatarUpdateOne) SetRoom(r *Room) *AvatarUpdateOne {
+	return auo.SetRoomID(r.ID)
+}
+
+// Mutation returns the AvatarMutation object of the builder.
+func (auo *AvatarUpdateOne) Mutation() *AvatarMutation {
+	return auo.mutation
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (auo *AvatarUpdateOne) ClearFile() *AvatarUpdateOne {
+	auo.mutation.ClearFile()
+	return auo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (auo *AvatarUpdateOne) ClearUser() *AvatarUpdateOne {
+	auo.mutation.ClearUser()
+	return auo
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (auo *AvatarUpdateOne) ClearRoom() *AvatarUpdateOne {
+	auo.mutation.ClearRoom()
+	return auo
+}
+
+// Where appends a list predicates to the AvatarUpdate builder.
+func (auo *AvatarUpdateOne) Where(ps ...predicate.Avatar) *AvatarUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AvatarUpdateOne) Select(field string, fields ...string) *AvatarUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Avatar entity.
+func (auo *AvatarUpdateOne) Save(ctx context.Context) (*Avatar, error) {
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AvatarUpdateOne) SaveX(ctx context.Context) *Avatar {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AvatarUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AvatarUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (auo *AvatarUpdateOne) check() error {
+	if auo.mutation.FileCleared() && len(auo.mutation.FileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Avatar.file"`)
+	}
+	return nil
+}
+
+func (auo *AvatarUpdateOne) sqlSave(ctx context.Context) (_node *Avatar, err error) {
+	if err := auo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(avatar.Table, avatar.Columns, sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Avatar.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, avatar.FieldID)
+		for _, f := range fields {
+			if !avatar.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != avatar.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if auo.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   avatar.FileTable,
+			Columns: []string{avatar.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   avatar.FileTable,
+			Columns: []string{avatar.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   avatar.UserTable,
+			Columns: []string{avatar.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   avatar.UserTable,
+			Columns: []string{avatar.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   avatar.RoomTable,
+			Columns: []string{avatar.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   avatar.RoomTable,
+			Columns: []string{avatar.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Avatar{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{avatar.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"journeyhub/ent/call"
+	"journeyhub/ent/room"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Call is the model entity for the Call schema.
+type Call struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID pulid.ID `json:"id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type call.Type `json:"type,omitempty"`
+	// State holds the value of the "state" field.
+	State call.State `json:"state,omitempty"`
+	// RoomID holds the value of the "room_id" field.
+	RoomID pulid.ID `json:"room_id,omitempty"`
+	// CallerID holds the value of the "caller_id" field.
+	CallerID pulid.ID `json:"caller_id,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// AnsweredAt holds the value of the "answered_at" field.
+	AnsweredAt *time.Time `json:"answered_at,omitempty"`
+	// EndedAt holds the value of the "ended_at" field.
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// Duration of the conversation in seconds
+	Duration int `json:"duration,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CallQuery when eager-loading is set.
+	Edges        CallEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CallEdges holds the relations/edges for other nodes in the graph.
+type CallEdges struct {
+	// Room holds the value of the room edge.
+	Room *Room `json:"room,omitempty"`
+	// Caller holds the value of the caller edge.
+	Caller *User `json:"caller,omitempty"`
+	// Participants holds the value of the participants edge.
+	Participants []*CallParticipant `json:"participants,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+	// totalCount holds the count of the edges above.
+	totalCount [3]map[string]int
+
+	namedParticipants map[string][]*CallParticipant
+}
+
+// RoomOrErr returns the Room value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CallEdges) RoomOrErr() (*Room, error) {
+	if e.Room != nil {
+		return e.Room, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: room.Label}
+	}
+	return nil, &NotLoadedError{edge: "room"}
+}
+
+// CallerOrErr returns the Caller value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CallEdges) CallerOrErr() (*User, error) {
+	if e.Caller != nil {
+		return e.Caller, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "caller"}
+}
+
+// ParticipantsOrErr returns the Participants value or an error if the edge
+// was not loaded in eager-loading.
+func (e CallEdges) ParticipantsOrErr() ([]*CallParticipant, error) {
+	if e.loadedTypes[2] {
+		return e.Participants, nil
+	}
+	return nil, &NotLoadedError{edge: "participants"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Call) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case call.FieldID, call.FieldRoomID, call.FieldCallerID:
+			values[i] = new(pulid.ID)
+		case call.FieldDuration:
+			values[i] = new(sql.NullInt64)
+		case call.FieldType, call.FieldState:
+			values[i] = new(sql.NullString)
+		case call.FieldStartedAt, call.FieldAnsweredAt, call.FieldEndedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Call fields.
+func (c *Call) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case call.FieldID:
+			if value, ok := values[i].(*pulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				c.ID = *value
+			}
+		case call.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				c.Type = call.Type(value.String)
+			}
+		case call.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				c.State = call.State(value.String)
+			}
+		case call.FieldRoomID:
+			if value, ok := values[i].(*pulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field room_id", values[i])
+			} else if value != nil {
+				c.RoomID = *value
+			}
+		case call.FieldCallerID:
+			if value, ok := values[i].(*pulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field caller_id", values[i])
+			} else if value != nil {
+				c.CallerID = *value
+			}
+		case call.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				c.StartedAt = value.Time
+			}
+		case call.FieldAnsweredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field answered_at", values[i])
+			} else if value.Valid {
+				c.AnsweredAt = new(time.Time)
+				*c.AnsweredAt = value.Time
+			}
+		case call.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				c.EndedAt = new(time.Time)
+				*c.EndedAt = value.Time
+			}
+		case call.FieldDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration", values[i])
+			} else if value.Valid {
+				c.Duration = int(value.Int64)
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Call.
+// This includes values selected through modifiers, order, etc.
+func (c *Call) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryRoom queries the "room" edge of the Call entity.
+func (c *Call) QueryRoom() *RoomQuery {
+	return NewCallClient(c.config).QueryRoom(c)
+}
+
+// QueryCaller queries the "caller" edge of the Call entity.
+func (c *Call) QueryCaller() *UserQuery {
+	return NewCallClient(c.config).QueryCaller(c)
+}
+
+// QueryParticipants queries the "participants" edge of the Call entity.
+func (c *Call) QueryParticipants() *CallParticipantQuery {
+	return NewCallClient(c.config).QueryParticipants(c)
+}
+
+// Update returns a builder for updating this Call.
+// Note that you need to call Call.Unwrap() before calling this method if this Call
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Call) Update() *CallUpdateOne {
+	return NewCallClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Call entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Call) Unwrap() *Call {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Call is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Call) String() string {
+	var builder strings.Builder
+	builder.WriteString("Call(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", c.Type))
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(fmt.Sprintf("%v", c.State))
+	builder.WriteString(", ")
+	builder.WriteString("room_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.RoomID))
+	builder.WriteString(", ")
+	builder.WriteString("caller_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.CallerID))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(c.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := c.AnsweredAt; v != nil {
+		builder.WriteString("answered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := c.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("duration=")
+	builder.WriteString(fmt.Sprintf("%v", c.Duration))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedParticipants returns the Participants named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (c *Call) NamedParticipants(name string) ([]*CallParticipant, error) {
+	if c.Edges.namedParticipants == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := c.Edges.namedParticipants[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (c *Call) appendNamedParticipants(name string, edges ...*CallParticipant) {
+	if c.Edges.namedParticipants == nil {
+		c.Edges.namedParticipants = make(map[string][]*CallParticipant)
+	}
+	if len(edges) == 0 {
+		c.Edges.namedParticipants[name] = []*CallParticipant{}
+	} else {
+		c.Edges.namedParticipants[name] = append(c.Edges.namedParticipants[name], edges...)
+	}
+}
+
+// Calls is a parsable slice of Call.
+type Calls []*Call
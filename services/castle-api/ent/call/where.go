@@ -0,0 +1,531 @@
+// Code generated by ent, DO NOT EDIT.
+
+package call
+
+import (
+	"journeyhub/ent/predicate"
+	"journeyhub/ent/schema/pulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldLTE(FieldID, id))
+}
+
+// RoomID applies equality check predicate on the "room_id" field. It's identical to RoomIDEQ.
+func RoomID(v pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldEQ(FieldRoomID, v))
+}
+
+// CallerID applies equality check predicate on the "caller_id" field. It's identical to CallerIDEQ.
+func CallerID(v pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldEQ(FieldCallerID, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// AnsweredAt applies equality check predicate on the "answered_at" field. It's identical to AnsweredAtEQ.
+func AnsweredAt(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldEQ(FieldAnsweredAt, v))
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// Duration applies equality check predicate on the "duration" field. It's identical to DurationEQ.
+func Duration(v int) predicate.Call {
+	return predicate.Call(sql.FieldEQ(FieldDuration, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.Call {
+	return predicate.Call(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.Call {
+	return predicate.Call(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.Call {
+	return predicate.Call(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.Call {
+	return predicate.Call(sql.FieldNotIn(FieldType, vs...))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v State) predicate.Call {
+	return predicate.Call(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v State) predicate.Call {
+	return predicate.Call(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...State) predicate.Call {
+	return predicate.Call(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...State) predicate.Call {
+	return predicate.Call(sql.FieldNotIn(FieldState, vs...))
+}
+
+// RoomIDEQ applies the EQ predicate on the "room_id" field.
+func RoomIDEQ(v pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldEQ(FieldRoomID, v))
+}
+
+// RoomIDNEQ applies the NEQ predicate on the "room_id" field.
+func RoomIDNEQ(v pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldNEQ(FieldRoomID, v))
+}
+
+// RoomIDIn applies the In predicate on the "room_id" field.
+func RoomIDIn(vs ...pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldIn(FieldRoomID, vs...))
+}
+
+// RoomIDNotIn applies the NotIn predicate on the "room_id" field.
+func RoomIDNotIn(vs ...pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldNotIn(FieldRoomID, vs...))
+}
+
+// RoomIDGT applies the GT predicate on the "room_id" field.
+func RoomIDGT(v pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldGT(FieldRoomID, v))
+}
+
+// RoomIDGTE applies the GTE predicate on the "room_id" field.
+func RoomIDGTE(v pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldGTE(FieldRoomID, v))
+}
+
+// RoomIDLT applies the LT predicate on the "room_id" field.
+func RoomIDLT(v pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldLT(FieldRoomID, v))
+}
+
+// RoomIDLTE applies the LTE predicate on the "room_id" field.
+func RoomIDLTE(v pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldLTE(FieldRoomID, v))
+}
+
+// RoomIDContains applies the Contains predicate on the "room_id" field.
+func RoomIDContains(v pulid.ID) predicate.Call {
+	vc := string(v)
+	return predicate.Call(sql.FieldContains(FieldRoomID, vc))
+}
+
+// RoomIDHasPrefix applies the HasPrefix predicate on the "room_id" field.
+func RoomIDHasPrefix(v pulid.ID) predicate.Call {
+	vc := string(v)
+	return predicate.Call(sql.FieldHasPrefix(FieldRoomID, vc))
+}
+
+// RoomIDHasSuffix applies the HasSuffix predicate on the "room_id" field.
+func RoomIDHasSuffix(v pulid.ID) predicate.Call {
+	vc := string(v)
+	return predicate.Call(sql.FieldHasSuffix(FieldRoomID, vc))
+}
+
+// RoomIDEqualFold applies the EqualFold predicate on the "room_id" field.
+func RoomIDEqualFold(v pulid.ID) predicate.Call {
+	vc := string(v)
+	return predicate.Call(sql.FieldEqualFold(FieldRoomID, vc))
+}
+
+// RoomIDContainsFold applies the ContainsFold predicate on the "room_id" field.
+func RoomIDContainsFold(v pulid.ID) predicate.Call {
+	vc := string(v)
+	return predicate.Call(sql.FieldContainsFold(FieldRoomID, vc))
+}
+
+// CallerIDEQ applies the EQ predicate on the "caller_id" field.
+func CallerIDEQ(v pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldEQ(FieldCallerID, v))
+}
+
+// CallerIDNEQ applies the NEQ predicate on the "caller_id" field.
+func CallerIDNEQ(v pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldNEQ(FieldCallerID, v))
+}
+
+// CallerIDIn applies the In predicate on the "caller_id" field.
+func CallerIDIn(vs ...pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldIn(FieldCallerID, vs...))
+}
+
+// CallerIDNotIn applies the NotIn predicate on the "caller_id" field.
+func CallerIDNotIn(vs ...pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldNotIn(FieldCallerID, vs...))
+}
+
+// CallerIDGT applies the GT predicate on the "caller_id" field.
+func CallerIDGT(v pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldGT(FieldCallerID, v))
+}
+
+// CallerIDGTE applies the GTE predicate on the "caller_id" field.
+func CallerIDGTE(v pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldGTE(FieldCallerID, v))
+}
+
+// CallerIDLT applies the LT predicate on the "caller_id" field.
+func CallerIDLT(v pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldLT(FieldCallerID, v))
+}
+
+// CallerIDLTE applies the LTE predicate on the "caller_id" field.
+func CallerIDLTE(v pulid.ID) predicate.Call {
+	return predicate.Call(sql.FieldLTE(FieldCallerID, v))
+}
+
+// CallerIDContains applies the Contains predicate on the "caller_id" field.
+func CallerIDContains(v pulid.ID) predicate.Call {
+	vc := string(v)
+	return predicate.Call(sql.FieldContains(FieldCallerID, vc))
+}
+
+// CallerIDHasPrefix applies the HasPrefix predicate on the "caller_id" field.
+func CallerIDHasPrefix(v pulid.ID) predicate.Call {
+	vc := string(v)
+	return predicate.Call(sql.FieldHasPrefix(FieldCallerID, vc))
+}
+
+// CallerIDHasSuffix applies the HasSuffix predicate on the "caller_id" field.
+func CallerIDHasSuffix(v pulid.ID) predicate.Call {
+	vc := string(v)
+	return predicate.Call(sql.FieldHasSuffix(FieldCallerID, vc))
+}
+
+// CallerIDEqualFold applies the EqualFold predicate on the "caller_id" field.
+func CallerIDEqualFold(v pulid.ID) predicate.Call {
+	vc := string(v)
+	return predicate.Call(sql.FieldEqualFold(FieldCallerID, vc))
+}
+
+// CallerIDContainsFold applies the ContainsFold predicate on the "caller_id" field.
+func CallerIDContainsFold(v pulid.ID) predicate.Call {
+	vc := string(v)
+	return predicate.Call(sql.FieldContainsFold(FieldCallerID, vc))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.Call {
+	return predicate.Call(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.Call {
+	return predicate.Call(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// AnsweredAtEQ applies the EQ predicate on the "answered_at" field.
+func AnsweredAtEQ(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldEQ(FieldAnsweredAt, v))
+}
+
+// AnsweredAtNEQ applies the NEQ predicate on the "answered_at" field.
+func AnsweredAtNEQ(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldNEQ(FieldAnsweredAt, v))
+}
+
+// AnsweredAtIn applies the In predicate on the "answered_at" field.
+func AnsweredAtIn(vs ...time.Time) predicate.Call {
+	return predicate.Call(sql.FieldIn(FieldAnsweredAt, vs...))
+}
+
+// AnsweredAtNotIn applies the NotIn predicate on the "answered_at" field.
+func AnsweredAtNotIn(vs ...time.Time) predicate.Call {
+	return predicate.Call(sql.FieldNotIn(FieldAnsweredAt, vs...))
+}
+
+// AnsweredAtGT applies the GT predicate on the "answered_at" field.
+func AnsweredAtGT(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldGT(FieldAnsweredAt, v))
+}
+
+// AnsweredAtGTE applies the GTE predicate on the "answered_at" field.
+func AnsweredAtGTE(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldGTE(FieldAnsweredAt, v))
+}
+
+// AnsweredAtLT applies the LT predicate on the "answered_at" field.
+func AnsweredAtLT(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldLT(FieldAnsweredAt, v))
+}
+
+// AnsweredAtLTE applies the LTE predicate on the "answered_at" field.
+func AnsweredAtLTE(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldLTE(FieldAnsweredAt, v))
+}
+
+// AnsweredAtIsNil applies the IsNil predicate on the "answered_at" field.
+func AnsweredAtIsNil() predicate.Call {
+	return predicate.Call(sql.FieldIsNull(FieldAnsweredAt))
+}
+
+// AnsweredAtNotNil applies the NotNil predicate on the "answered_at" field.
+func AnsweredAtNotNil() predicate.Call {
+	return predicate.Call(sql.FieldNotNull(FieldAnsweredAt))
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldNEQ(FieldEndedAt, v))
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.Call {
+	return predicate.Call(sql.FieldIn(FieldEndedAt, vs...))
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.Call {
+	return predicate.Call(sql.FieldNotIn(FieldEndedAt, vs...))
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldGT(FieldEndedAt, v))
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldGTE(FieldEndedAt, v))
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldLT(FieldEndedAt, v))
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.Call {
+	return predicate.Call(sql.FieldLTE(FieldEndedAt, v))
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.Call {
+	return predicate.Call(sql.FieldIsNull(FieldEndedAt))
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.Call {
+	return predicate.Call(sql.FieldNotNull(FieldEndedAt))
+}
+
+// DurationEQ applies the EQ predicate on the "duration" field.
+func DurationEQ(v int) predicate.Call {
+	return predicate.Call(sql.FieldEQ(FieldDuration, v))
+}
+
+// DurationNEQ applies the NEQ predicate on the "duration" field.
+func DurationNEQ(v int) predicate.Call {
+	return predicate.Call(sql.FieldNEQ(FieldDuration, v))
+}
+
+// DurationIn applies the In predicate on the "duration" field.
+func DurationIn(vs ...int) predicate.Call {
+	return predicate.Call(sql.FieldIn(FieldDuration, vs...))
+}
+
+// DurationNotIn applies the NotIn predicate on the "duration" field.
+func DurationNotIn(vs ...int) predicate.Call {
+	return predicate.Call(sql.FieldNotIn(FieldDuration, vs...))
+}
+
+// DurationGT applies the GT predicate on the "duration" field.
+func DurationGT(v int) predicate.Call {
+	return predicate.Call(sql.FieldGT(FieldDuration, v))
+}
+
+// DurationGTE applies the GTE predicate on the "duration" field.
+func DurationGTE(v int) predicate.Call {
+	return predicate.Call(sql.FieldGTE(FieldDuration, v))
+}
+
+// DurationLT applies the LT predicate on the "duration" field.
+func DurationLT(v int) predicate.Call {
+	return predicate.Call(sql.FieldLT(FieldDuration, v))
+}
+
+// DurationLTE applies the LTE predicate on the "duration" field.
+func DurationLTE(v int) predicate.Call {
+	return predicate.Call(sql.FieldLTE(FieldDuration, v))
+}
+
+// HasRoom applies the HasEdge predicate on the "room" edge.
+func HasRoom() predicate.Call {
+	return predicate.Call(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RoomTable, RoomColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoomWith applies the HasEdge predicate on the "room" edge with a given conditions (other predicates).
+func HasRoomWith(preds ...predicate.Room) predicate.Call {
+	return predicate.Call(func(s *sql.Selector) {
+		step := newRoomStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCaller applies the HasEdge predicate on the "caller" edge.
+func HasCaller() predicate.Call {
+	return predicate.Call(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CallerTable, CallerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCallerWith applies the HasEdge predicate on the "caller" edge with a given conditions (other predicates).
+func HasCallerWith(preds ...predicate.User) predicate.Call {
+	return predicate.Call(func(s *sql.Selector) {
+		step := newCallerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParticipants applies the HasEdge predicate on the "participants" edge.
+func HasParticipants() predicate.Call {
+	return predicate.Call(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ParticipantsTable, ParticipantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParticipantsWith applies the HasEdge predicate on the "participants" edge with a given conditions (other predicates).
+func HasParticipantsWith(preds ...predicate.CallParticipant) predicate.Call {
+	return predicate.Call(func(s *sql.Selector) {
+		step := newParticipantsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Call) predicate.Call {
+	return predicate.Call(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Call) predicate.Call {
+	return predicate.Call(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Call) predicate.Call {
+	return predicate.Call(sql.NotPredicates(p))
+}
@@ -0,0 +1,1038 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journeyhub/ent/call"
+	"journeyhub/ent/callparticipant"
+	"journeyhub/ent/room"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CallCreate is the builder for creating a Call entity.
+type CallCreate struct {
+	config
+	mutation *CallMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetType sets the "type" field.
+func (cc *CallCreate) SetType(c call.Type) *CallCreate {
+	cc.mutation.SetType(c)
+	return cc
+}
+
+// SetState sets the "state" field.
+func (cc *CallCreate) SetState(c call.State) *CallCreate {
+	cc.mutation.SetState(c)
+	return cc
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (cc *CallCreate) SetNillableState(c *call.State) *CallCreate {
+	if c != nil {
+		cc.SetState(*c)
+	}
+	return cc
+}
+
+// SetRoomID sets the "room_id" field.
+func (cc *CallCreate) SetRoomID(pu pulid.ID) *CallCreate {
+	cc.mutation.SetRoomID(pu)
+	return cc
+}
+
+// SetCallerID sets the "caller_id" field.
+func (cc *CallCreate) SetCallerID(pu pulid.ID) *CallCreate {
+	cc.mutation.SetCallerID(pu)
+	return cc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (cc *CallCreate) SetStartedAt(t time.Time) *CallCreate {
+	cc.mutation.SetStartedAt(t)
+	return cc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (cc *CallCreate) SetNillableStartedAt(t *time.Time) *CallCreate {
+	if t != nil {
+		cc.SetStartedAt(*t)
+	}
+	return cc
+}
+
+// SetAnsweredAt sets the "answered_at" field.
+func (cc *CallCreate) SetAnsweredAt(t time.Time) *CallCreate {
+	cc.mutation.SetAnsweredAt(t)
+	return cc
+}
+
+// SetNillableAnsweredAt sets the "answered_at" field if the given value is not nil.
+func (cc *CallCreate) SetNillableAnsweredAt(t *time.Time) *CallCreate {
+	if t != nil {
+		cc.SetAnsweredAt(*t)
+	}
+	return cc
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (cc *CallCreate) SetEndedAt(t time.Time) *CallCreate {
+	cc.mutation.SetEndedAt(t)
+	return cc
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (cc *CallCreate) SetNillableEndedAt(t *time.Time) *CallCreate {
+	if t != nil {
+		cc.SetEndedAt(*t)
+	}
+	return cc
+}
+
+// SetDuration sets the "duration" field.
+func (cc *CallCreate) SetDuration(i int) *CallCreate {
+	cc.mutation.SetDuration(i)
+	return cc
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (cc *CallCreate) SetNillableDuration(i *int) *CallCreate {
+	if i != nil {
+		cc.SetDuration(*i)
+	}
+	return cc
+}
+
+// SetID sets the "id" field.
+func (cc *CallCreate) SetID(pu pulid.ID) *CallCreate {
+	cc.mutation.SetID(pu)
+	return cc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cc *CallCreate) SetNillableID(pu *pulid.ID) *CallCreate {
+	if pu != nil {
+		cc.SetID(*pu)
+	}
+	return cc
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (cc *CallCreate) SetRoom(r *Room) *CallCreate {
+	return cc.SetRoomID(r.ID)
+}
+
+// SetCaller sets the "caller" edge to the User entity.
+func (cc *CallCreate) SetCaller(u *User) *CallCreate {
+	return cc.SetCallerID(u.ID)
+}
+
+// AddParticipantIDs adds the "participants" edge to the CallParticipant entity by IDs.
+func (cc *CallCreate) AddParticipantIDs(ids ...pulid.ID) *CallCreate {
+	cc.mutation.AddParticipantIDs(ids...)
+	return cc
+}
+
+// AddParticipants adds the "participants" edges to the CallParticipant entity.
+func (cc *CallCreate) AddParticipants(c ...*CallParticipant) *CallCreate {
+	ids := make([]pulid.ID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddParticipantIDs(ids...)
+}
+
+// Mutation returns the CallMutation object of the builder.
+func (cc *CallCreate) Mutation() *CallMutation {
+	return cc.mutation
+}
+
+// Save creates the Call in the database.
+func (cc *CallCreate) Save(ctx context.Context) (*Call, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CallCreate) SaveX(ctx context.Context) *Call {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CallCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CallCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CallCreate) defaults() {
+	if _, ok := cc.mutation.State(); !ok {
+		v := call.DefaultState
+		cc.mutation.SetState(v)
+	}
+	if _, ok := cc.mutation.StartedAt(); !ok {
+		v := call.DefaultStartedAt()
+		cc.mutation.SetStartedAt(v)
+	}
+	if _, ok := cc.mutation.Duration(); !ok {
+		v := call.DefaultDuration
+		cc.mutation.SetDuration(v)
+	}
+	if _, ok := cc.mutation.ID(); !ok {
+		v := call.DefaultID()
+		cc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CallCreate) check() error {
+	if _, ok := cc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Call.type"`)}
+	}
+	if v, ok := cc.mutation.GetType(); ok {
+		if err := call.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Call.type": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "Call.state"`)}
+	}
+	if v, ok := cc.mutation.State(); ok {
+		if err := call.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Call.state": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.RoomID(); !ok {
+		return &ValidationError{Name: "room_id", err: errors.New(`ent: missing required field "Call.room_id"`)}
+	}
+	if _, ok := cc.mutation.CallerID(); !ok {
+		return &ValidationError{Name: "caller_id", err: errors.New(`ent: missing required field "Call.caller_id"`)}
+	}
+	if _, ok := cc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "Call.started_at"`)}
+	}
+	if _, ok := cc.mutation.Duration(); !ok {
+		return &ValidationError{Name: "duration", err: errors.New(`ent: missing required field "Call.duration"`)}
+	}
+	if len(cc.mutation.RoomIDs()) == 0 {
+		return &ValidationError{Name: "room", err: errors.New(`ent: missing required edge "Call.room"`)}
+	}
+	if len(cc.mutation.CallerIDs()) == 0 {
+		return &ValidationError{Name: "caller", err: errors.New(`ent: missing required edge "Call.caller"`)}
+	}
+	return nil
+}
+
+func (cc *CallCreate) sqlSave(ctx context.Context) (*Call, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*pulid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CallCreate) createSpec() (*Call, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Call{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(call.Table, sqlgraph.NewFieldSpec(call.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = cc.conflict
+	if id, ok := cc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cc.mutation.GetType(); ok {
+		_spec.SetField(call.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := cc.mutation.State(); ok {
+		_spec.SetField(call.FieldState, field.TypeEnum, value)
+		_node.State = value
+	}
+	if value, ok := cc.mutation.StartedAt(); ok {
+		_spec.SetField(call.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := cc.mutation.AnsweredAt(); ok {
+		_spec.SetField(call.FieldAnsweredAt, field.TypeTime, value)
+		_node.AnsweredAt = &value
+	}
+	if value, ok := cc.mutation.EndedAt(); ok {
+		_spec.SetField(call.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	if value, ok := cc.mutation.Duration(); ok {
+		_spec.SetField(call.FieldDuration, field.TypeInt, value)
+		_node.Duration = value
+	}
+	if nodes := cc.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   call.RoomTable,
+			Columns: []string{call.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoomID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.CallerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   call.CallerTable,
+			Columns: []string{call.CallerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CallerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.ParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   call.ParticipantsTable,
+			Columns: []string{call.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Call.Create().
+//		SetType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CallUpsert) {
+//			SetType(v+v).
+//		}).
+//		Exec(ctx)
+func (cc *CallCreate) OnConflict(opts ...sql.ConflictOption) *CallUpsertOne {
+	cc.conflict = opts
+	return &CallUpsertOne{
+		create: cc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Call.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cc *CallCreate) OnConflictColumns(columns ...string) *CallUpsertOne {
+	cc.conflict = append(cc.conflict, sql.ConflictColumns(columns...))
+	return &CallUpsertOne{
+		create: cc,
+	}
+}
+
+type (
+	// CallUpsertOne is the builder for "upsert"-ing
+	//  one Call node.
+	CallUpsertOne struct {
+		create *CallCreate
+	}
+
+	// CallUpsert is the "OnConflict" setter.
+	CallUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetType sets the "type" field.
+func (u *CallUpsert) SetType(v call.Type) *CallUpsert {
+	u.Set(call.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *CallUpsert) UpdateType() *CallUpsert {
+	u.SetExcluded(call.FieldType)
+	return u
+}
+
+// SetState sets the "state" field.
+func (u *CallUpsert) SetState(v call.State) *CallUpsert {
+	u.Set(call.FieldState, v)
+	return u
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *CallUpsert) UpdateState() *CallUpsert {
+	u.SetExcluded(call.FieldState)
+	return u
+}
+
+// SetRoomID sets the "room_id" field.
+func (u *CallUpsert) SetRoomID(v pulid.ID) *CallUpsert {
+	u.Set(call.FieldRoomID, v)
+	return u
+}
+
+// UpdateRoomID sets the "room_id" field to the value that was provided on create.
+func (u *CallUpsert) UpdateRoomID() *CallUpsert {
+	u.SetExcluded(call.FieldRoomID)
+	return u
+}
+
+// SetCallerID sets the "caller_id" field.
+func (u *CallUpsert) SetCallerID(v pulid.ID) *CallUpsert {
+	u.Set(call.FieldCallerID, v)
+	return u
+}
+
+// UpdateCallerID sets the "caller_id" field to the value that was provided on create.
+func (u *CallUpsert) UpdateCallerID() *CallUpsert {
+	u.SetExcluded(call.FieldCallerID)
+	return u
+}
+
+// SetAnsweredAt sets the "answered_at" field.
+func (u *CallUpsert) SetAnsweredAt(v time.Time) *CallUpsert {
+	u.Set(call.FieldAnsweredAt, v)
+	return u
+}
+
+// UpdateAnsweredAt sets the "answered_at" field to the value that was provided on create.
+func (u *CallUpsert) UpdateAnsweredAt() *CallUpsert {
+	u.SetExcluded(call.FieldAnsweredAt)
+	return u
+}
+
+// ClearAnsweredAt clears the value of the "answered_at" field.
+func (u *CallUpsert) ClearAnsweredAt() *CallUpsert {
+	u.SetNull(call.FieldAnsweredAt)
+	return u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *CallUpsert) SetEndedAt(v time.Time) *CallUpsert {
+	u.Set(call.FieldEndedAt, v)
+	return u
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *CallUpsert) UpdateEndedAt() *CallUpsert {
+	u.SetExcluded(call.FieldEndedAt)
+	return u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *CallUpsert) ClearEndedAt() *CallUpsert {
+	u.SetNull(call.FieldEndedAt)
+	return u
+}
+
+// SetDuration sets the "duration" field.
+func (u *CallUpsert) SetDuration(v int) *CallUpsert {
+	u.Set(call.FieldDuration, v)
+	return u
+}
+
+// UpdateDuration sets the "duration" field to the value that was provided on create.
+func (u *CallUpsert) UpdateDuration() *CallUpsert {
+	u.SetExcluded(call.FieldDuration)
+	return u
+}
+
+// AddDuration adds v to the "duration" field.
+func (u *CallUpsert) AddDuration(v int) *CallUpsert {
+	u.Add(call.FieldDuration, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Call.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(call.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CallUpsertOne) UpdateNewValues() *CallUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(call.FieldID)
+		}
+		if _, exists := u.create.mutation.StartedAt(); exists {
+			s.SetIgnore(call.FieldStartedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Call.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CallUpsertOne) Ignore() *CallUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CallUpsertOne) DoNothing() *CallUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CallCreate.OnConflict
+// documentation for more info.
+func (u *CallUpsertOne) Update(set func(*CallUpsert)) *CallUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CallUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *CallUpsertOne) SetType(v call.Type) *CallUpsertOne {
+	return u.Update(func(s *CallUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *CallUpsertOne) UpdateType() *CallUpsertOne {
+	return u.Update(func(s *CallUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *CallUpsertOne) SetState(v call.State) *CallUpsertOne {
+	return u.Update(func(s *CallUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *CallUpsertOne) UpdateState() *CallUpsertOne {
+	return u.Update(func(s *CallUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetRoomID sets the "room_id" field.
+func (u *CallUpsertOne) SetRoomID(v pulid.ID) *CallUpsertOne {
+	return u.Update(func(s *CallUpsert) {
+		s.SetRoomID(v)
+	})
+}
+
+// UpdateRoomID sets the "room_id" field to the value that was provided on create.
+func (u *CallUpsertOne) UpdateRoomID() *CallUpsertOne {
+	return u.Update(func(s *CallUpsert) {
+		s.UpdateRoomID()
+	})
+}
+
+// SetCallerID sets the "caller_id" field.
+func (u *CallUpsertOne) SetCallerID(v pulid.ID) *CallUpsertOne {
+	return u.Update(func(s *CallUpsert) {
+		s.SetCallerID(v)
+	})
+}
+
+// UpdateCallerID sets the "caller_id" field to the value that was provided on create.
+func (u *CallUpsertOne) UpdateCallerID() *CallUpsertOne {
+	return u.Update(func(s *CallUpsert) {
+		s.UpdateCallerID()
+	})
+}
+
+// SetAnsweredAt sets the "answered_at" field.
+func (u *CallUpsertOne) SetAnsweredAt(v time.Time) *CallUpsertOne {
+	return u.Update(func(s *CallUpsert) {
+		s.SetAnsweredAt(v)
+	})
+}
+
+// UpdateAnsweredAt sets the "answered_at" field to the value that was provided on create.
+func (u *CallUpsertOne) UpdateAnsweredAt() *CallUpsertOne {
+	return u.Update(func(s *CallUpsert) {
+		s.UpdateAnsweredAt()
+	})
+}
+
+// ClearAnsweredAt clears the value of the "answered_at" field.
+func (u *CallUpsertOne) ClearAnsweredAt() *CallUpsertOne {
+	return u.Update(func(s *CallUpsert) {
+		s.ClearAnsweredAt()
+	})
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *CallUpsertOne) SetEndedAt(v time.Time) *CallUpsertOne {
+	return u.Update(func(s *CallUpsert) {
+		s.SetEndedAt(v)
+	})
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *CallUpsertOne) UpdateEndedAt() *CallUpsertOne {
+	return u.Update(func(s *CallUpsert) {
+		s.UpdateEndedAt()
+	})
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *CallUpsertOne) ClearEndedAt() *CallUpsertOne {
+	return u.Update(func(s *CallUpsert) {
+		s.ClearEndedAt()
+	})
+}
+
+// SetDuration sets the "duration" field.
+func (u *CallUpsertOne) SetDuration(v int) *CallUpsertOne {
+	return u.Update(func(s *CallUpsert) {
+		s.SetDuration(v)
+	})
+}
+
+// AddDuration adds v to the "duration" field.
+func (u *CallUpsertOne) AddDuration(v int) *CallUpsertOne {
+	return u.Update(func(s *CallUpsert) {
+		s.AddDuration(v)
+	})
+}
+
+// UpdateDuration sets the "duration" field to the value that was provided on create.
+func (u *CallUpsertOne) UpdateDuration() *CallUpsertOne {
+	return u.Update(func(s *CallUpsert) {
+		s.UpdateDuration()
+	})
+}
+
+// Exec executes the query.
+func (u *CallUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CallCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CallUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CallUpsertOne) ID(ctx context.Context) (id pulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CallUpsertOne.ID is not supported by MySQL driver. Use CallUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CallUpsertOne) IDX(ctx context.Context) pulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CallCreateBulk is the builder for creating many Call entities in bulk.
+type CallCreateBulk struct {
+	config
+	err      error
+	builders []*CallCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Call entities in the database.
+func (ccb *CallCreateBulk) Save(ctx context.Context) ([]*Call, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Call, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CallMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CallCreateBulk) SaveX(ctx context.Context) []*Call {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CallCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CallCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Call.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CallUpsert) {
+//			SetType(v+v).
+//		}).
+//		Exec(ctx)
+func (ccb *CallCreateBulk) OnConflict(opts ...sql.ConflictOption) *CallUpsertBulk {
+	ccb.conflict = opts
+	return &CallUpsertBulk{
+		create: ccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Call.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ccb *CallCreateBulk) OnConflictColumns(columns ...string) *CallUpsertBulk {
+	ccb.conflict = append(ccb.conflict, sql.ConflictColumns(columns...))
+	return &CallUpsertBulk{
+		create: ccb,
+	}
+}
+
+// CallUpsertBulk is the builder for "upsert"-ing
+// a bulk of Call nodes.
+type CallUpsertBulk struct {
+	create *CallCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Call.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(call.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CallUpsertBulk) UpdateNewValues() *CallUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(call.FieldID)
+			}
+			if _, exists := b.mutation.StartedAt(); exists {
+				s.SetIgnore(call.FieldStartedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Call.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CallUpsertBulk) Ignore() *CallUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CallUpsertBulk) DoNothing() *CallUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CallCreateBulk.OnConflict
+// documentation for more info.
+func (u *CallUpsertBulk) Update(set func(*CallUpsert)) *CallUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CallUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *CallUpsertBulk) SetType(v call.Type) *CallUpsertBulk {
+	return u.Update(func(s *CallUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *CallUpsertBulk) UpdateType() *CallUpsertBulk {
+	return u.Update(func(s *CallUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *CallUpsertBulk) SetState(v call.State) *CallUpsertBulk {
+	return u.Update(func(s *CallUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *CallUpsertBulk) UpdateState() *CallUpsertBulk {
+	return u.Update(func(s *CallUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetRoomID sets the "room_id" field.
+func (u *CallUpsertBulk) SetRoomID(v pulid.ID) *CallUpsertBulk {
+	return u.Update(func(s *CallUpsert) {
+		s.SetRoomID(v)
+	})
+}
+
+// UpdateRoomID sets the "room_id" field to the value that was provided on create.
+func (u *CallUpsertBulk) UpdateRoomID() *CallUpsertBulk {
+	return u.Update(func(s *CallUpsert) {
+		s.UpdateRoomID()
+	})
+}
+
+// SetCallerID sets the "caller_id" field.
+func (u *CallUpsertBulk) SetCallerID(v pulid.ID) *CallUpsertBulk {
+	return u.Update(func(s *CallUpsert) {
+		s.SetCallerID(v)
+	})
+}
+
+// UpdateCallerID sets the "caller_id" field to the value that was provided on create.
+func (u *CallUpsertBulk) UpdateCallerID() *CallUpsertBulk {
+	return u.Update(func(s *CallUpsert) {
+		s.UpdateCallerID()
+	})
+}
+
+// SetAnsweredAt sets the "answered_at" field.
+func (u *CallUpsertBulk) SetAnsweredAt(v time.Time) *CallUpsertBulk {
+	return u.Update(func(s *CallUpsert) {
+		s.SetAnsweredAt(v)
+	})
+}
+
+// UpdateAnsweredAt sets the "answered_at" field to the value that was provided on create.
+func (u *CallUpsertBulk) UpdateAnsweredAt() *CallUpsertBulk {
+	return u.Update(func(s *CallUpsert) {
+		s.UpdateAnsweredAt()
+	})
+}
+
+// ClearAnsweredAt clears the value of the "answered_at" field.
+func (u *CallUpsertBulk) ClearAnsweredAt() *CallUpsertBulk {
+	return u.Update(func(s *CallUpsert) {
+		s.ClearAnsweredAt()
+	})
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *CallUpsertBulk) SetEndedAt(v time.Time) *CallUpsertBulk {
+	return u.Update(func(s *CallUpsert) {
+		s.SetEndedAt(v)
+	})
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *CallUpsertBulk) UpdateEndedAt() *CallUpsertBulk {
+	return u.Update(func(s *CallUpsert) {
+		s.UpdateEndedAt()
+	})
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *CallUpsertBulk) ClearEndedAt() *CallUpsertBulk {
+	return u.Update(func(s *CallUpsert) {
+		s.ClearEndedAt()
+	})
+}
+
+// SetDuration sets the "duration" field.
+func (u *CallUpsertBulk) SetDuration(v int) *CallUpsertBulk {
+	return u.Update(func(s *CallUpsert) {
+		s.SetDuration(v)
+	})
+}
+
+// AddDuration adds v to the "duration" field.
+func (u *CallUpsertBulk) AddDuration(v int) *CallUpsertBulk {
+	return u.Update(func(s *CallUpsert) {
+		s.AddDuration(v)
+	})
+}
+
+// UpdateDuration sets the "duration" field to the value that was provided on create.
+func (u *CallUpsertBulk) UpdateDuration() *CallUpsertBulk {
+	return u.Update(func(s *CallUpsert) {
+		s.UpdateDuration()
+	})
+}
+
+// Exec executes the query.
+func (u *CallUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CallCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CallCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CallUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,832 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journeyhub/ent/call"
+	"journeyhub/ent/callparticipant"
+	"journeyhub/ent/predicate"
+	"journeyhub/ent/room"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CallUpdate is the builder for updating Call entities.
+type CallUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CallMutation
+}
+
+// Where appends a list predicates to the CallUpdate builder.
+func (cu *CallUpdate) Where(ps ...predicate.Call) *CallUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetType sets the "type" field.
+func (cu *CallUpdate) SetType(c call.Type) *CallUpdate {
+	cu.mutation.SetType(c)
+	return cu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (cu *CallUpdate) SetNillableType(c *call.Type) *CallUpdate {
+	if c != nil {
+		cu.SetType(*c)
+	}
+	return cu
+}
+
+// SetState sets the "state" field.
+func (cu *CallUpdate) SetState(c call.State) *CallUpdate {
+	cu.mutation.SetState(c)
+	return cu
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (cu *CallUpdate) SetNillableState(c *call.State) *CallUpdate {
+	if c != nil {
+		cu.SetState(*c)
+	}
+	return cu
+}
+
+// SetRoomID sets the "room_id" field.
+func (cu *CallUpdate) SetRoomID(pu pulid.ID) *CallUpdate {
+	cu.mutation.SetRoomID(pu)
+	return cu
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (cu *CallUpdate) SetNillableRoomID(pu *pulid.ID) *CallUpdate {
+	if pu != nil {
+		cu.SetRoomID(*pu)
+	}
+	return cu
+}
+
+// SetCallerID sets the "caller_id" field.
+func (cu *CallUpdate) SetCallerID(pu pulid.ID) *CallUpdate {
+	cu.mutation.SetCallerID(pu)
+	return cu
+}
+
+// SetNillableCallerID sets the "caller_id" field if the given value is not nil.
+func (cu *CallUpdate) SetNillableCallerID(pu *pulid.ID) *CallUpdate {
+	if pu != nil {
+		cu.SetCallerID(*pu)
+	}
+	return cu
+}
+
+// SetAnsweredAt sets the "answered_at" field.
+func (cu *CallUpdate) SetAnsweredAt(t time.Time) *CallUpdate {
+	cu.mutation.SetAnsweredAt(t)
+	return cu
+}
+
+// SetNillableAnsweredAt sets the "answered_at" field if the given value is not nil.
+func (cu *CallUpdate) SetNillableAnsweredAt(t *time.Time) *CallUpdate {
+	if t != nil {
+		cu.SetAnsweredAt(*t)
+	}
+	return cu
+}
+
+// ClearAnsweredAt clears the value of the "answered_at" field.
+func (cu *CallUpdate) ClearAnsweredAt() *CallUpdate {
+	cu.mutation.ClearAnsweredAt()
+	return cu
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (cu *CallUpdate) SetEndedAt(t time.Time) *CallUpdate {
+	cu.mutation.SetEndedAt(t)
+	return cu
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (cu *CallUpdate) SetNillableEndedAt(t *time.Time) *CallUpdate {
+	if t != nil {
+		cu.SetEndedAt(*t)
+	}
+	return cu
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (cu *CallUpdate) ClearEndedAt() *CallUpdate {
+	cu.mutation.ClearEndedAt()
+	return cu
+}
+
+// SetDuration sets the "duration" field.
+func (cu *CallUpdate) SetDuration(i int) *CallUpdate {
+	cu.mutation.ResetDuration()
+	cu.mutation.SetDuration(i)
+	return cu
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (cu *CallUpdate) SetNillableDuration(i *int) *CallUpdate {
+	if i != nil {
+		cu.SetDuration(*i)
+	}
+	return cu
+}
+
+// AddDuration adds i to the "duration" field.
+func (cu *CallUpdate) AddDuration(i int) *CallUpdate {
+	cu.mutation.AddDuration(i)
+	return cu
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (cu *CallUpdate) SetRoom(r *Room) *CallUpdate {
+	return cu.SetRoomID(r.ID)
+}
+
+// SetCaller sets the "caller" edge to the User entity.
+func (cu *CallUpdate) SetCaller(u *User) *CallUpdate {
+	return cu.SetCallerID(u.ID)
+}
+
+// AddParticipantIDs adds the "participants" edge to the CallParticipant entity by IDs.
+func (cu *CallUpdate) AddParticipantIDs(ids ...pulid.ID) *CallUpdate {
+	cu.mutation.AddParticipantIDs(ids...)
+	return cu
+}
+
+// AddParticipants adds the "participants" edges to the CallParticipant entity.
+func (cu *CallUpdate) AddParticipants(c ...*CallParticipant) *CallUpdate {
+	ids := make([]pulid.ID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddParticipantIDs(ids...)
+}
+
+// Mutation returns the CallMutation object of the builder.
+func (cu *CallUpdate) Mutation() *CallMutation {
+	return cu.mutation
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (cu *CallUpdate) ClearRoom() *CallUpdate {
+	cu.mutation.ClearRoom()
+	return cu
+}
+
+// ClearCaller clears the "caller" edge to the User entity.
+func (cu *CallUpdate) ClearCaller() *CallUpdate {
+	cu.mutation.ClearCaller()
+	return cu
+}
+
+// ClearParticipants clears all "participants" edges to the CallParticipant entity.
+func (cu *CallUpdate) ClearParticipants() *CallUpdate {
+	cu.mutation.ClearParticipants()
+	return cu
+}
+
+// RemoveParticipantIDs removes the "participants" edge to CallParticipant entities by IDs.
+func (cu *CallUpdate) RemoveParticipantIDs(ids ...pulid.ID) *CallUpdate {
+	cu.mutation.RemoveParticipantIDs(ids...)
+	return cu
+}
+
+// RemoveParticipants removes "participants" edges to CallParticipant entities.
+func (cu *CallUpdate) RemoveParticipants(c ...*CallParticipant) *CallUpdate {
+	ids := make([]pulid.ID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveParticipantIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CallUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CallUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CallUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CallUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CallUpdate) check() error {
+	if v, ok := cu.mutation.GetType(); ok {
+		if err := call.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Call.type": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.State(); ok {
+		if err := call.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Call.state": %w`, err)}
+		}
+	}
+	if cu.mutation.RoomCleared() && len(cu.mutation.RoomIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Call.room"`)
+	}
+	if cu.mutation.CallerCleared() && len(cu.mutation.CallerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Call.caller"`)
+	}
+	return nil
+}
+
+func (cu *CallUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(call.Table, call.Columns, sqlgraph.NewFieldSpec(call.FieldID, field.TypeString))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.GetType(); ok {
+		_spec.SetField(call.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := cu.mutation.State(); ok {
+		_spec.SetField(call.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := cu.mutation.AnsweredAt(); ok {
+		_spec.SetField(call.FieldAnsweredAt, field.TypeTime, value)
+	}
+	if cu.mutation.AnsweredAtCleared() {
+		_spec.ClearField(call.FieldAnsweredAt, field.TypeTime)
+	}
+	if value, ok := cu.mutation.EndedAt(); ok {
+		_spec.SetField(call.FieldEndedAt, field.TypeTime, value)
+	}
+	if cu.mutation.EndedAtCleared() {
+		_spec.ClearField(call.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := cu.mutation.Duration(); ok {
+		_spec.SetField(call.FieldDuration, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedDuration(); ok {
+		_spec.AddField(call.FieldDuration, field.TypeInt, value)
+	}
+	if cu.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   call.RoomTable,
+			Columns: []string{call.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   call.RoomTable,
+			Columns: []string{call.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.CallerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   call.CallerTable,
+			Columns: []string{call.CallerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.CallerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   call.CallerTable,
+			Columns: []string{call.CallerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   call.ParticipantsTable,
+			Columns: []string{call.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedParticipantsIDs(); len(nodes) > 0 && !cu.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   call.ParticipantsTable,
+			Columns: []string{call.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   call.ParticipantsTable,
+			Columns: []string{call.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{call.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CallUpdateOne is the builder for updating a single Call entity.
+type CallUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CallMutation
+}
+
+// SetType sets the "type" field.
+func (cuo *CallUpdateOne) SetType(c call.Type) *CallUpdateOne {
+	cuo.mutation.SetType(c)
+	return cuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (cuo *CallUpdateOne) SetNillableType(c *call.Type) *CallUpdateOne {
+	if c != nil {
+		cuo.SetType(*c)
+	}
+	return cuo
+}
+
+// SetState sets the "state" field.
+func (cuo *CallUpdateOne) SetState(c call.State) *CallUpdateOne {
+	cuo.mutation.SetState(c)
+	return cuo
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (cuo *CallUpdateOne) SetNillableState(c *call.State) *CallUpdateOne {
+	if c != nil {
+		cuo.SetState(*c)
+	}
+	return cuo
+}
+
+// SetRoomID sets the "room_id" field.
+func (cuo *CallUpdateOne) SetRoomID(pu pulid.ID) *CallUpdateOne {
+	cuo.mutation.SetRoomID(pu)
+	return cuo
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (cuo *CallUpdateOne) SetNillableRoomID(pu *pulid.ID) *CallUpdateOne {
+	if pu != nil {
+		cuo.SetRoomID(*pu)
+	}
+	return cuo
+}
+
+// SetCallerID sets the "caller_id" field.
+func (cuo *CallUpdateOne) SetCallerID(pu pulid.ID) *CallUpdateOne {
+	cuo.mutation.SetCallerID(pu)
+	return cuo
+}
+
+// SetNillableCallerID sets the "caller_id" field if the given value is not nil.
+func (cuo *CallUpdateOne) SetNillableCallerID(pu *pulid.ID) *CallUpdateOne {
+	if pu != nil {
+		cuo.SetCallerID(*pu)
+	}
+	return cuo
+}
+
+// SetAnsweredAt sets the "answered_at" field.
+func (cuo *CallUpdateOne) SetAnsweredAt(t time.Time) *CallUpdateOne {
+	cuo.mutation.SetAnsweredAt(t)
+	return cuo
+}
+
+// SetNillableAnsweredAt sets the "answered_at" field if the given value is not nil.
+func (cuo *CallUpdateOne) SetNillableAnsweredAt(t *time.Time) *CallUpdateOne {
+	if t != nil {
+		cuo.SetAnsweredAt(*t)
+	}
+	return cuo
+}
+
+// ClearAnsweredAt clears the value of the "answered_at" field.
+func (cuo *CallUpdateOne) ClearAnsweredAt() *CallUpdateOne {
+	cuo.mutation.ClearAnsweredAt()
+	return cuo
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (cuo *CallUpdateOne) SetEndedAt(t time.Time) *CallUpdateOne {
+	cuo.mutation.SetEndedAt(t)
+	return cuo
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (cuo *CallUpdateOne) SetNillableEndedAt(t *time.Time) *CallUpdateOne {
+	if t != nil {
+		cuo.SetEndedAt(*t)
+	}
+	return cuo
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (cuo *CallUpdateOne) ClearEndedAt() *CallUpdateOne {
+	cuo.mutation.ClearEndedAt()
+	return cuo
+}
+
+// SetDuration sets the "duration" field.
+func (cuo *CallUpdateOne) SetDuration(i int) *CallUpdateOne {
+	cuo.mutation.ResetDuration()
+	cuo.mutation.SetDuration(i)
+	return cuo
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (cuo *CallUpdateOne) SetNillableDuration(i *int) *CallUpdateOne {
+	if i != nil {
+		cuo.SetDuration(*i)
+	}
+	return cuo
+}
+
+// AddDuration adds i to the "duration" field.
+func (cuo *CallUpdateOne) AddDuration(i int) *CallUpdateOne {
+	cuo.mutation.AddDuration(i)
+	return cuo
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (cuo *CallUpdateOne) SetRoom(r *Room) *CallUpdateOne {
+	return cuo.SetRoomID(r.ID)
+}
+
+// SetCaller sets the "caller" edge to the User entity.
+func (cuo *CallUpdateOne) SetCaller(u *User) *CallUpdateOne {
+	return cuo.SetCallerID(u.ID)
+}
+
+// AddParticipantIDs adds the "participants" edge to the CallParticipant entity by IDs.
+func (cuo *CallUpdateOne) AddParticipantIDs(ids ...pulid.ID) *CallUpdateOne {
+	cuo.mutation.AddParticipantIDs(ids...)
+	return cuo
+}
+
+// AddParticipants adds the "participants" edges to the CallParticipant entity.
+func (cuo *CallUpdateOne) AddParticipants(c ...*CallParticipant) *CallUpdateOne {
+	ids := make([]pulid.ID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddParticipantIDs(ids...)
+}
+
+// Mutation returns the CallMutation object of the builder.
+func (cuo *CallUpdateOne) Mutation() *CallMutation {
+	return cuo.mutation
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (cuo *CallUpdateOne) ClearRoom() *CallUpdateOne {
+	cuo.mutation.ClearRoom()
+	return cuo
+}
+
+// ClearCaller clears the "caller" edge to the User entity.
+func (cuo *CallUpdateOne) ClearCaller() *CallUpdateOne {
+	cuo.mutation.ClearCaller()
+	return cuo
+}
+
+// ClearParticipants clears all "participants" edges to the CallParticipant entity.
+func (cuo *CallUpdateOne) ClearParticipants() *CallUpdateOne {
+	cuo.mutation.ClearParticipants()
+	return cuo
+}
+
+// RemoveParticipantIDs removes the "participants" edge to CallParticipant entities by IDs.
+func (cuo *CallUpdateOne) RemoveParticipantIDs(ids ...pulid.ID) *CallUpdateOne {
+	cuo.mutation.RemoveParticipantIDs(ids...)
+	return cuo
+}
+
+// RemoveParticipants removes "participants" edges to CallParticipant entities.
+func (cuo *CallUpdateOne) RemoveParticipants(c ...*CallParticipant) *CallUpdateOne {
+	ids := make([]pulid.ID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveParticipantIDs(ids...)
+}
+
+// Where appends a list predicates to the CallUpdate builder.
+func (cuo *CallUpdateOne) Where(ps ...predicate.Call) *CallUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CallUpdateOne) Select(field string, fields ...string) *CallUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Call entity.
+func (cuo *CallUpdateOne) Save(ctx context.Context) (*Call, error) {
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CallUpdateOne) SaveX(ctx context.Context) *Call {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CallUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CallUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CallUpdateOne) check() error {
+	if v, ok := cuo.mutation.GetType(); ok {
+		if err := call.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Call.type": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.State(); ok {
+		if err := call.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Call.state": %w`, err)}
+		}
+	}
+	if cuo.mutation.RoomCleared() && len(cuo.mutation.RoomIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Call.room"`)
+	}
+	if cuo.mutation.CallerCleared() && len(cuo.mutation.CallerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Call.caller"`)
+	}
+	return nil
+}
+
+func (cuo *CallUpdateOne) sqlSave(ctx context.Context) (_node *Call, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(call.Table, call.Columns, sqlgraph.NewFieldSpec(call.FieldID, field.TypeString))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Call.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, call.FieldID)
+		for _, f := range fields {
+			if !call.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != call.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.GetType(); ok {
+		_spec.SetField(call.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := cuo.mutation.State(); ok {
+		_spec.SetField(call.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := cuo.mutation.AnsweredAt(); ok {
+		_spec.SetField(call.FieldAnsweredAt, field.TypeTime, value)
+	}
+	if cuo.mutation.AnsweredAtCleared() {
+		_spec.ClearField(call.FieldAnsweredAt, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.EndedAt(); ok {
+		_spec.SetField(call.FieldEndedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.EndedAtCleared() {
+		_spec.ClearField(call.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.Duration(); ok {
+		_spec.SetField(call.FieldDuration, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedDuration(); ok {
+		_spec.AddField(call.FieldDuration, field.TypeInt, value)
+	}
+	if cuo.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   call.RoomTable,
+			Columns: []string{call.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   call.RoomTable,
+			Columns: []string{call.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.CallerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   call.CallerTable,
+			Columns: []string{call.CallerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.CallerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   call.CallerTable,
+			Columns: []string{call.CallerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   call.ParticipantsTable,
+			Columns: []string{call.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedParticipantsIDs(); len(nodes) > 0 && !cuo.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   call.ParticipantsTable,
+			Columns: []string{call.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   call.ParticipantsTable,
+			Columns: []string{call.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Call{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{call.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
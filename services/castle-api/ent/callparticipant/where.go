@@ -0,0 +1,443 @@
+// Code generated by ent, DO NOT EDIT.
+
+package callparticipant
+
+import (
+	"journeyhub/ent/predicate"
+	"journeyhub/ent/schema/pulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldLTE(FieldID, id))
+}
+
+// CallID applies equality check predicate on the "call_id" field. It's identical to CallIDEQ.
+func CallID(v pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldEQ(FieldCallID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldEQ(FieldUserID, v))
+}
+
+// InvitedAt applies equality check predicate on the "invited_at" field. It's identical to InvitedAtEQ.
+func InvitedAt(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldEQ(FieldInvitedAt, v))
+}
+
+// JoinedAt applies equality check predicate on the "joined_at" field. It's identical to JoinedAtEQ.
+func JoinedAt(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldEQ(FieldJoinedAt, v))
+}
+
+// LeftAt applies equality check predicate on the "left_at" field. It's identical to LeftAtEQ.
+func LeftAt(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldEQ(FieldLeftAt, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v State) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v State) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...State) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...State) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldNotIn(FieldState, vs...))
+}
+
+// CallIDEQ applies the EQ predicate on the "call_id" field.
+func CallIDEQ(v pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldEQ(FieldCallID, v))
+}
+
+// CallIDNEQ applies the NEQ predicate on the "call_id" field.
+func CallIDNEQ(v pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldNEQ(FieldCallID, v))
+}
+
+// CallIDIn applies the In predicate on the "call_id" field.
+func CallIDIn(vs ...pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldIn(FieldCallID, vs...))
+}
+
+// CallIDNotIn applies the NotIn predicate on the "call_id" field.
+func CallIDNotIn(vs ...pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldNotIn(FieldCallID, vs...))
+}
+
+// CallIDGT applies the GT predicate on the "call_id" field.
+func CallIDGT(v pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldGT(FieldCallID, v))
+}
+
+// CallIDGTE applies the GTE predicate on the "call_id" field.
+func CallIDGTE(v pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldGTE(FieldCallID, v))
+}
+
+// CallIDLT applies the LT predicate on the "call_id" field.
+func CallIDLT(v pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldLT(FieldCallID, v))
+}
+
+// CallIDLTE applies the LTE predicate on the "call_id" field.
+func CallIDLTE(v pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldLTE(FieldCallID, v))
+}
+
+// CallIDContains applies the Contains predicate on the "call_id" field.
+func CallIDContains(v pulid.ID) predicate.CallParticipant {
+	vc := string(v)
+	return predicate.CallParticipant(sql.FieldContains(FieldCallID, vc))
+}
+
+// CallIDHasPrefix applies the HasPrefix predicate on the "call_id" field.
+func CallIDHasPrefix(v pulid.ID) predicate.CallParticipant {
+	vc := string(v)
+	return predicate.CallParticipant(sql.FieldHasPrefix(FieldCallID, vc))
+}
+
+// CallIDHasSuffix applies the HasSuffix predicate on the "call_id" field.
+func CallIDHasSuffix(v pulid.ID) predicate.CallParticipant {
+	vc := string(v)
+	return predicate.CallParticipant(sql.FieldHasSuffix(FieldCallID, vc))
+}
+
+// CallIDEqualFold applies the EqualFold predicate on the "call_id" field.
+func CallIDEqualFold(v pulid.ID) predicate.CallParticipant {
+	vc := string(v)
+	return predicate.CallParticipant(sql.FieldEqualFold(FieldCallID, vc))
+}
+
+// CallIDContainsFold applies the ContainsFold predicate on the "call_id" field.
+func CallIDContainsFold(v pulid.ID) predicate.CallParticipant {
+	vc := string(v)
+	return predicate.CallParticipant(sql.FieldContainsFold(FieldCallID, vc))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v pulid.ID) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v pulid.ID) predicate.CallParticipant {
+	vc := string(v)
+	return predicate.CallParticipant(sql.FieldContains(FieldUserID, vc))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v pulid.ID) predicate.CallParticipant {
+	vc := string(v)
+	return predicate.CallParticipant(sql.FieldHasPrefix(FieldUserID, vc))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v pulid.ID) predicate.CallParticipant {
+	vc := string(v)
+	return predicate.CallParticipant(sql.FieldHasSuffix(FieldUserID, vc))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v pulid.ID) predicate.CallParticipant {
+	vc := string(v)
+	return predicate.CallParticipant(sql.FieldEqualFold(FieldUserID, vc))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v pulid.ID) predicate.CallParticipant {
+	vc := string(v)
+	return predicate.CallParticipant(sql.FieldContainsFold(FieldUserID, vc))
+}
+
+// InvitedAtEQ applies the EQ predicate on the "invited_at" field.
+func InvitedAtEQ(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldEQ(FieldInvitedAt, v))
+}
+
+// InvitedAtNEQ applies the NEQ predicate on the "invited_at" field.
+func InvitedAtNEQ(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldNEQ(FieldInvitedAt, v))
+}
+
+// InvitedAtIn applies the In predicate on the "invited_at" field.
+func InvitedAtIn(vs ...time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldIn(FieldInvitedAt, vs...))
+}
+
+// InvitedAtNotIn applies the NotIn predicate on the "invited_at" field.
+func InvitedAtNotIn(vs ...time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldNotIn(FieldInvitedAt, vs...))
+}
+
+// InvitedAtGT applies the GT predicate on the "invited_at" field.
+func InvitedAtGT(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldGT(FieldInvitedAt, v))
+}
+
+// InvitedAtGTE applies the GTE predicate on the "invited_at" field.
+func InvitedAtGTE(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldGTE(FieldInvitedAt, v))
+}
+
+// InvitedAtLT applies the LT predicate on the "invited_at" field.
+func InvitedAtLT(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldLT(FieldInvitedAt, v))
+}
+
+// InvitedAtLTE applies the LTE predicate on the "invited_at" field.
+func InvitedAtLTE(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldLTE(FieldInvitedAt, v))
+}
+
+// JoinedAtEQ applies the EQ predicate on the "joined_at" field.
+func JoinedAtEQ(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldEQ(FieldJoinedAt, v))
+}
+
+// JoinedAtNEQ applies the NEQ predicate on the "joined_at" field.
+func JoinedAtNEQ(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldNEQ(FieldJoinedAt, v))
+}
+
+// JoinedAtIn applies the In predicate on the "joined_at" field.
+func JoinedAtIn(vs ...time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldIn(FieldJoinedAt, vs...))
+}
+
+// JoinedAtNotIn applies the NotIn predicate on the "joined_at" field.
+func JoinedAtNotIn(vs ...time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldNotIn(FieldJoinedAt, vs...))
+}
+
+// JoinedAtGT applies the GT predicate on the "joined_at" field.
+func JoinedAtGT(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldGT(FieldJoinedAt, v))
+}
+
+// JoinedAtGTE applies the GTE predicate on the "joined_at" field.
+func JoinedAtGTE(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldGTE(FieldJoinedAt, v))
+}
+
+// JoinedAtLT applies the LT predicate on the "joined_at" field.
+func JoinedAtLT(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldLT(FieldJoinedAt, v))
+}
+
+// JoinedAtLTE applies the LTE predicate on the "joined_at" field.
+func JoinedAtLTE(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldLTE(FieldJoinedAt, v))
+}
+
+// JoinedAtIsNil applies the IsNil predicate on the "joined_at" field.
+func JoinedAtIsNil() predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldIsNull(FieldJoinedAt))
+}
+
+// JoinedAtNotNil applies the NotNil predicate on the "joined_at" field.
+func JoinedAtNotNil() predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldNotNull(FieldJoinedAt))
+}
+
+// LeftAtEQ applies the EQ predicate on the "left_at" field.
+func LeftAtEQ(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldEQ(FieldLeftAt, v))
+}
+
+// LeftAtNEQ applies the NEQ predicate on the "left_at" field.
+func LeftAtNEQ(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldNEQ(FieldLeftAt, v))
+}
+
+// LeftAtIn applies the In predicate on the "left_at" field.
+func LeftAtIn(vs ...time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldIn(FieldLeftAt, vs...))
+}
+
+// LeftAtNotIn applies the NotIn predicate on the "left_at" field.
+func LeftAtNotIn(vs ...time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldNotIn(FieldLeftAt, vs...))
+}
+
+// LeftAtGT applies the GT predicate on the "left_at" field.
+func LeftAtGT(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldGT(FieldLeftAt, v))
+}
+
+// LeftAtGTE applies the GTE predicate on the "left_at" field.
+func LeftAtGTE(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldGTE(FieldLeftAt, v))
+}
+
+// LeftAtLT applies the LT predicate on the "left_at" field.
+func LeftAtLT(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldLT(FieldLeftAt, v))
+}
+
+// LeftAtLTE applies the LTE predicate on the "left_at" field.
+func LeftAtLTE(v time.Time) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldLTE(FieldLeftAt, v))
+}
+
+// LeftAtIsNil applies the IsNil predicate on the "left_at" field.
+func LeftAtIsNil() predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldIsNull(FieldLeftAt))
+}
+
+// LeftAtNotNil applies the NotNil predicate on the "left_at" field.
+func LeftAtNotNil() predicate.CallParticipant {
+	return predicate.CallParticipant(sql.FieldNotNull(FieldLeftAt))
+}
+
+// HasCall applies the HasEdge predicate on the "call" edge.
+func HasCall() predicate.CallParticipant {
+	return predicate.CallParticipant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CallTable, CallColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCallWith applies the HasEdge predicate on the "call" edge with a given conditions (other predicates).
+func HasCallWith(preds ...predicate.Call) predicate.CallParticipant {
+	return predicate.CallParticipant(func(s *sql.Selector) {
+		step := newCallStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.CallParticipant {
+	return predicate.CallParticipant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.CallParticipant {
+	return predicate.CallParticipant(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CallParticipant) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CallParticipant) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CallParticipant) predicate.CallParticipant {
+	return predicate.CallParticipant(sql.NotPredicates(p))
+}
@@ -0,0 +1,863 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journeyhub/ent/call"
+	"journeyhub/ent/callparticipant"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CallParticipantCreate is the builder for creating a CallParticipant entity.
+type CallParticipantCreate struct {
+	config
+	mutation *CallParticipantMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetState sets the "state" field.
+func (cpc *CallParticipantCreate) SetState(c callparticipant.State) *CallParticipantCreate {
+	cpc.mutation.SetState(c)
+	return cpc
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (cpc *CallParticipantCreate) SetNillableState(c *callparticipant.State) *CallParticipantCreate {
+	if c != nil {
+		cpc.SetState(*c)
+	}
+	return cpc
+}
+
+// SetCallID sets the "call_id" field.
+func (cpc *CallParticipantCreate) SetCallID(pu pulid.ID) *CallParticipantCreate {
+	cpc.mutation.SetCallID(pu)
+	return cpc
+}
+
+// SetUserID sets the "user_id" field.
+func (cpc *CallParticipantCreate) SetUserID(pu pulid.ID) *CallParticipantCreate {
+	cpc.mutation.SetUserID(pu)
+	return cpc
+}
+
+// SetInvitedAt sets the "invited_at" field.
+func (cpc *CallParticipantCreate) SetInvitedAt(t time.Time) *CallParticipantCreate {
+	cpc.mutation.SetInvitedAt(t)
+	return cpc
+}
+
+// SetNillableInvitedAt sets the "invited_at" field if the given value is not nil.
+func (cpc *CallParticipantCreate) SetNillableInvitedAt(t *time.Time) *CallParticipantCreate {
+	if t != nil {
+		cpc.SetInvitedAt(*t)
+	}
+	return cpc
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (cpc *CallParticipantCreate) SetJoinedAt(t time.Time) *CallParticipantCreate {
+	cpc.mutation.SetJoinedAt(t)
+	return cpc
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (cpc *CallParticipantCreate) SetNillableJoinedAt(t *time.Time) *CallParticipantCreate {
+	if t != nil {
+		cpc.SetJoinedAt(*t)
+	}
+	return cpc
+}
+
+// SetLeftAt sets the "left_at" field.
+func (cpc *CallParticipantCreate) SetLeftAt(t time.Time) *CallParticipantCreate {
+	cpc.mutation.SetLeftAt(t)
+	return cpc
+}
+
+// SetNillableLeftAt sets the "left_at" field if the given value is not nil.
+func (cpc *CallParticipantCreate) SetNillableLeftAt(t *time.Time) *CallParticipantCreate {
+	if t != nil {
+		cpc.SetLeftAt(*t)
+	}
+	return cpc
+}
+
+// SetID sets the "id" field.
+func (cpc *CallParticipantCreate) SetID(pu pulid.ID) *CallParticipantCreate {
+	cpc.mutation.SetID(pu)
+	return cpc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cpc *CallParticipantCreate) SetNillableID(pu *pulid.ID) *CallParticipantCreate {
+	if pu != nil {
+		cpc.SetID(*pu)
+	}
+	return cpc
+}
+
+// SetCall sets the "call" edge to the Call entity.
+func (cpc *CallParticipantCreate) SetCall(c *Call) *CallParticipantCreate {
+	return cpc.SetCallID(c.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cpc *CallParticipantCreate) SetUser(u *User) *CallParticipantCreate {
+	return cpc.SetUserID(u.ID)
+}
+
+// Mutation returns the CallParticipantMutation object of the builder.
+func (cpc *CallParticipantCreate) Mutation() *CallParticipantMutation {
+	return cpc.mutation
+}
+
+// Save creates the CallParticipant in the database.
+func (cpc *CallParticipantCreate) Save(ctx context.Context) (*CallParticipant, error) {
+	cpc.defaults()
+	return withHooks(ctx, cpc.sqlSave, cpc.mutation, cpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cpc *CallParticipantCreate) SaveX(ctx context.Context) *CallParticipant {
+	v, err := cpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cpc *CallParticipantCreate) Exec(ctx context.Context) error {
+	_, err := cpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpc *CallParticipantCreate) ExecX(ctx context.Context) {
+	if err := cpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cpc *CallParticipantCreate) defaults() {
+	if _, ok := cpc.mutation.State(); !ok {
+		v := callparticipant.DefaultState
+		cpc.mutation.SetState(v)
+	}
+	if _, ok := cpc.mutation.InvitedAt(); !ok {
+		v := callparticipant.DefaultInvitedAt()
+		cpc.mutation.SetInvitedAt(v)
+	}
+	if _, ok := cpc.mutation.ID(); !ok {
+		v := callparticipant.DefaultID()
+		cpc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cpc *CallParticipantCreate) check() error {
+	if _, ok := cpc.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "CallParticipant.state"`)}
+	}
+	if v, ok := cpc.mutation.State(); ok {
+		if err := callparticipant.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "CallParticipant.state": %w`, err)}
+		}
+	}
+	if _, ok := cpc.mutation.CallID(); !ok {
+		return &ValidationError{Name: "call_id", err: errors.New(`ent: missing required field "CallParticipant.call_id"`)}
+	}
+	if _, ok := cpc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "CallParticipant.user_id"`)}
+	}
+	if _, ok := cpc.mutation.InvitedAt(); !ok {
+		return &ValidationError{Name: "invited_at", err: errors.New(`ent: missing required field "CallParticipant.invited_at"`)}
+	}
+	if len(cpc.mutation.CallIDs()) == 0 {
+		return &ValidationError{Name: "call", err: errors.New(`ent: missing required edge "CallParticipant.call"`)}
+	}
+	if len(cpc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "CallParticipant.user"`)}
+	}
+	return nil
+}
+
+func (cpc *CallParticipantCreate) sqlSave(ctx context.Context) (*CallParticipant, error) {
+	if err := cpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*pulid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cpc.mutation.id = &_node.ID
+	cpc.mutation.done = true
+	return _node, nil
+}
+
+func (cpc *CallParticipantCreate) createSpec() (*CallParticipant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CallParticipant{config: cpc.config}
+		_spec = sqlgraph.NewCreateSpec(callparticipant.Table, sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = cpc.conflict
+	if id, ok := cpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cpc.mutation.State(); ok {
+		_spec.SetField(callparticipant.FieldState, field.TypeEnum, value)
+		_node.State = value
+	}
+	if value, ok := cpc.mutation.InvitedAt(); ok {
+		_spec.SetField(callparticipant.FieldInvitedAt, field.TypeTime, value)
+		_node.InvitedAt = value
+	}
+	if value, ok := cpc.mutation.JoinedAt(); ok {
+		_spec.SetField(callparticipant.FieldJoinedAt, field.TypeTime, value)
+		_node.JoinedAt = &value
+	}
+	if value, ok := cpc.mutation.LeftAt(); ok {
+		_spec.SetField(callparticipant.FieldLeftAt, field.TypeTime, value)
+		_node.LeftAt = &value
+	}
+	if nodes := cpc.mutation.CallIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   callparticipant.CallTable,
+			Columns: []string{callparticipant.CallColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CallID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cpc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   callparticipant.UserTable,
+			Columns: []string{callparticipant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CallParticipant.Create().
+//		SetState(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CallParticipantUpsert) {
+//			SetState(v+v).
+//		}).
+//		Exec(ctx)
+func (cpc *CallParticipantCreate) OnConflict(opts ...sql.ConflictOption) *CallParticipantUpsertOne {
+	cpc.conflict = opts
+	return &CallParticipantUpsertOne{
+		create: cpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CallParticipant.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cpc *CallParticipantCreate) OnConflictColumns(columns ...string) *CallParticipantUpsertOne {
+	cpc.conflict = append(cpc.conflict, sql.ConflictColumns(columns...))
+	return &CallParticipantUpsertOne{
+		create: cpc,
+	}
+}
+
+type (
+	// CallParticipantUpsertOne is the builder for "upsert"-ing
+	//  one CallParticipant node.
+	CallParticipantUpsertOne struct {
+		create *CallParticipantCreate
+	}
+
+	// CallParticipantUpsert is the "OnConflict" setter.
+	CallParticipantUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetState sets the "state" field.
+func (u *CallParticipantUpsert) SetState(v callparticipant.State) *CallParticipantUpsert {
+	u.Set(callparticipant.FieldState, v)
+	return u
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *CallParticipantUpsert) UpdateState() *CallParticipantUpsert {
+	u.SetExcluded(callparticipant.FieldState)
+	return u
+}
+
+// SetCallID sets the "call_id" field.
+func (u *CallParticipantUpsert) SetCallID(v pulid.ID) *CallParticipantUpsert {
+	u.Set(callparticipant.FieldCallID, v)
+	return u
+}
+
+// UpdateCallID sets the "call_id" field to the value that was provided on create.
+func (u *CallParticipantUpsert) UpdateCallID() *CallParticipantUpsert {
+	u.SetExcluded(callparticipant.FieldCallID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CallParticipantUpsert) SetUserID(v pulid.ID) *CallParticipantUpsert {
+	u.Set(callparticipant.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CallParticipantUpsert) UpdateUserID() *CallParticipantUpsert {
+	u.SetExcluded(callparticipant.FieldUserID)
+	return u
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (u *CallParticipantUpsert) SetJoinedAt(v time.Time) *CallParticipantUpsert {
+	u.Set(callparticipant.FieldJoinedAt, v)
+	return u
+}
+
+// UpdateJoinedAt sets the "joined_at" field to the value that was provided on create.
+func (u *CallParticipantUpsert) UpdateJoinedAt() *CallParticipantUpsert {
+	u.SetExcluded(callparticipant.FieldJoinedAt)
+	return u
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (u *CallParticipantUpsert) ClearJoinedAt() *CallParticipantUpsert {
+	u.SetNull(callparticipant.FieldJoinedAt)
+	return u
+}
+
+// SetLeftAt sets the "left_at" field.
+func (u *CallParticipantUpsert) SetLeftAt(v time.Time) *CallParticipantUpsert {
+	u.Set(callparticipant.FieldLeftAt, v)
+	return u
+}
+
+// UpdateLeftAt sets the "left_at" field to the value that was provided on create.
+func (u *CallParticipantUpsert) UpdateLeftAt() *CallParticipantUpsert {
+	u.SetExcluded(callparticipant.FieldLeftAt)
+	return u
+}
+
+// ClearLeftAt clears the value of the "left_at" field.
+func (u *CallParticipantUpsert) ClearLeftAt() *CallParticipantUpsert {
+	u.SetNull(callparticipant.FieldLeftAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CallParticipant.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(callparticipant.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CallParticipantUpsertOne) UpdateNewValues() *CallParticipantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(callparticipant.FieldID)
+		}
+		if _, exists := u.create.mutation.InvitedAt(); exists {
+			s.SetIgnore(callparticipant.FieldInvitedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CallParticipant.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CallParticipantUpsertOne) Ignore() *CallParticipantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CallParticipantUpsertOne) DoNothing() *CallParticipantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CallParticipantCreate.OnConflict
+// documentation for more info.
+func (u *CallParticipantUpsertOne) Update(set func(*CallParticipantUpsert)) *CallParticipantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CallParticipantUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetState sets the "state" field.
+func (u *CallParticipantUpsertOne) SetState(v callparticipant.State) *CallParticipantUpsertOne {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *CallParticipantUpsertOne) UpdateState() *CallParticipantUpsertOne {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetCallID sets the "call_id" field.
+func (u *CallParticipantUpsertOne) SetCallID(v pulid.ID) *CallParticipantUpsertOne {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.SetCallID(v)
+	})
+}
+
+// UpdateCallID sets the "call_id" field to the value that was provided on create.
+func (u *CallParticipantUpsertOne) UpdateCallID() *CallParticipantUpsertOne {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.UpdateCallID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CallParticipantUpsertOne) SetUserID(v pulid.ID) *CallParticipantUpsertOne {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CallParticipantUpsertOne) UpdateUserID() *CallParticipantUpsertOne {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (u *CallParticipantUpsertOne) SetJoinedAt(v time.Time) *CallParticipantUpsertOne {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.SetJoinedAt(v)
+	})
+}
+
+// UpdateJoinedAt sets the "joined_at" field to the value that was provided on create.
+func (u *CallParticipantUpsertOne) UpdateJoinedAt() *CallParticipantUpsertOne {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.UpdateJoinedAt()
+	})
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (u *CallParticipantUpsertOne) ClearJoinedAt() *CallParticipantUpsertOne {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.ClearJoinedAt()
+	})
+}
+
+// SetLeftAt sets the "left_at" field.
+func (u *CallParticipantUpsertOne) SetLeftAt(v time.Time) *CallParticipantUpsertOne {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.SetLeftAt(v)
+	})
+}
+
+// UpdateLeftAt sets the "left_at" field to the value that was provided on create.
+func (u *CallParticipantUpsertOne) UpdateLeftAt() *CallParticipantUpsertOne {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.UpdateLeftAt()
+	})
+}
+
+// ClearLeftAt clears the value of the "left_at" field.
+func (u *CallParticipantUpsertOne) ClearLeftAt() *CallParticipantUpsertOne {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.ClearLeftAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CallParticipantUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CallParticipantCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CallParticipantUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CallParticipantUpsertOne) ID(ctx context.Context) (id pulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CallParticipantUpsertOne.ID is not supported by MySQL driver. Use CallParticipantUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CallParticipantUpsertOne) IDX(ctx context.Context) pulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CallParticipantCreateBulk is the builder for creating many CallParticipant entities in bulk.
+type CallParticipantCreateBulk struct {
+	config
+	err      error
+	builders []*CallParticipantCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CallParticipant entities in the database.
+func (cpcb *CallParticipantCreateBulk) Save(ctx context.Context) ([]*CallParticipant, error) {
+	if cpcb.err != nil {
+		return nil, cpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cpcb.builders))
+	nodes := make([]*CallParticipant, len(cpcb.builders))
+	mutators := make([]Mutator, len(cpcb.builders))
+	for i := range cpcb.builders {
+		func(i int, root context.Context) {
+			builder := cpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CallParticipantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cpcb *CallParticipantCreateBulk) SaveX(ctx context.Context) []*CallParticipant {
+	v, err := cpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cpcb *CallParticipantCreateBulk) Exec(ctx context.Context) error {
+	_, err := cpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpcb *CallParticipantCreateBulk) ExecX(ctx context.Context) {
+	if err := cpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CallParticipant.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CallParticipantUpsert) {
+//			SetState(v+v).
+//		}).
+//		Exec(ctx)
+func (cpcb *CallParticipantCreateBulk) OnConflict(opts ...sql.ConflictOption) *CallParticipantUpsertBulk {
+	cpcb.conflict = opts
+	return &CallParticipantUpsertBulk{
+		create: cpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CallParticipant.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cpcb *CallParticipantCreateBulk) OnConflictColumns(columns ...string) *CallParticipantUpsertBulk {
+	cpcb.conflict = append(cpcb.conflict, sql.ConflictColumns(columns...))
+	return &CallParticipantUpsertBulk{
+		create: cpcb,
+	}
+}
+
+// CallParticipantUpsertBulk is the builder for "upsert"-ing
+// a bulk of CallParticipant nodes.
+type CallParticipantUpsertBulk struct {
+	create *CallParticipantCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CallParticipant.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(callparticipant.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CallParticipantUpsertBulk) UpdateNewValues() *CallParticipantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(callparticipant.FieldID)
+			}
+			if _, exists := b.mutation.InvitedAt(); exists {
+				s.SetIgnore(callparticipant.FieldInvitedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CallParticipant.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CallParticipantUpsertBulk) Ignore() *CallParticipantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CallParticipantUpsertBulk) DoNothing() *CallParticipantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CallParticipantCreateBulk.OnConflict
+// documentation for more info.
+func (u *CallParticipantUpsertBulk) Update(set func(*CallParticipantUpsert)) *CallParticipantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CallParticipantUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetState sets the "state" field.
+func (u *CallParticipantUpsertBulk) SetState(v callparticipant.State) *CallParticipantUpsertBulk {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *CallParticipantUpsertBulk) UpdateState() *CallParticipantUpsertBulk {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetCallID sets the "call_id" field.
+func (u *CallParticipantUpsertBulk) SetCallID(v pulid.ID) *CallParticipantUpsertBulk {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.SetCallID(v)
+	})
+}
+
+// UpdateCallID sets the "call_id" field to the value that was provided on create.
+func (u *CallParticipantUpsertBulk) UpdateCallID() *CallParticipantUpsertBulk {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.UpdateCallID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CallParticipantUpsertBulk) SetUserID(v pulid.ID) *CallParticipantUpsertBulk {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CallParticipantUpsertBulk) UpdateUserID() *CallParticipantUpsertBulk {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (u *CallParticipantUpsertBulk) SetJoinedAt(v time.Time) *CallParticipantUpsertBulk {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.SetJoinedAt(v)
+	})
+}
+
+// UpdateJoinedAt sets the "joined_at" field to the value that was provided on create.
+func (u *CallParticipantUpsertBulk) UpdateJoinedAt() *CallParticipantUpsertBulk {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.UpdateJoinedAt()
+	})
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (u *CallParticipantUpsertBulk) ClearJoinedAt() *CallParticipantUpsertBulk {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.ClearJoinedAt()
+	})
+}
+
+// SetLeftAt sets the "left_at" field.
+func (u *CallParticipantUpsertBulk) SetLeftAt(v time.Time) *CallParticipantUpsertBulk {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.SetLeftAt(v)
+	})
+}
+
+// UpdateLeftAt sets the "left_at" field to the value that was provided on create.
+func (u *CallParticipantUpsertBulk) UpdateLeftAt() *CallParticipantUpsertBulk {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.UpdateLeftAt()
+	})
+}
+
+// ClearLeftAt clears the value of the "left_at" field.
+func (u *CallParticipantUpsertBulk) ClearLeftAt() *CallParticipantUpsertBulk {
+	return u.Update(func(s *CallParticipantUpsert) {
+		s.ClearLeftAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CallParticipantUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CallParticipantCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CallParticipantCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CallParticipantUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
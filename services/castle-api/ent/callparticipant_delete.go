@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"journeyhub/ent/callparticipant"
+	"journeyhub/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CallParticipantDelete is the builder for deleting a CallParticipant entity.
+type CallParticipantDelete struct {
+	config
+	hooks    []Hook
+	mutation *CallParticipantMutation
+}
+
+// Where appends a list predicates to the CallParticipantDelete builder.
+func (cpd *CallParticipantDelete) Where(ps ...predicate.CallParticipant) *CallParticipantDelete {
+	cpd.mutation.Where(ps...)
+	return cpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cpd *CallParticipantDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cpd.sqlExec, cpd.mutation, cpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpd *CallParticipantDelete) ExecX(ctx context.Context) int {
+	n, err := cpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cpd *CallParticipantDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(callparticipant.Table, sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeString))
+	if ps := cpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cpd.mutation.done = true
+	return affected, err
+}
+
+// CallParticipantDeleteOne is the builder for deleting a single CallParticipant entity.
+type CallParticipantDeleteOne struct {
+	cpd *CallParticipantDelete
+}
+
+// Where appends a list predicates to the CallParticipantDelete builder.
+func (cpdo *CallParticipantDeleteOne) Where(ps ...predicate.CallParticipant) *CallParticipantDeleteOne {
+	cpdo.cpd.mutation.Where(ps...)
+	return cpdo
+}
+
+// Exec executes the deletion query.
+func (cpdo *CallParticipantDeleteOne) Exec(ctx context.Context) error {
+	n, err := cpdo.cpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{callparticipant.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpdo *CallParticipantDeleteOne) ExecX(ctx context.Context) {
+	if err := cpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
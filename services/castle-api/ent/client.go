@@ -12,6 +12,11 @@ import (
 	"journeyhub/ent/migrate"
 	"journeyhub/ent/schema/pulid"
 
+	"journeyhub/ent/avatar"
+	"journeyhub/ent/call"
+	"journeyhub/ent/callparticipant"
+	"journeyhub/ent/contactpin"
+	"journeyhub/ent/contactrequest"
 	"journeyhub/ent/device"
 	"journeyhub/ent/file"
 	"journeyhub/ent/message"
@@ -19,9 +24,13 @@ import (
 	"journeyhub/ent/messagelink"
 	"journeyhub/ent/messagevoice"
 	"journeyhub/ent/notification"
+	"journeyhub/ent/outboxmessage"
+	"journeyhub/ent/report"
 	"journeyhub/ent/room"
+	"journeyhub/ent/roominvite"
 	"journeyhub/ent/roommember"
 	"journeyhub/ent/user"
+	"journeyhub/ent/userblock"
 	"journeyhub/ent/usercontact"
 
 	"entgo.io/ent"
@@ -35,6 +44,16 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Avatar is the client for interacting with the Avatar builders.
+	Avatar *AvatarClient
+	// Call is the client for interacting with the Call builders.
+	Call *CallClient
+	// CallParticipant is the client for interacting with the CallParticipant builders.
+	CallParticipant *CallParticipantClient
+	// ContactPin is the client for interacting with the ContactPin builders.
+	ContactPin *ContactPinClient
+	// ContactRequest is the client for interacting with the ContactRequest builders.
+	ContactRequest *ContactRequestClient
 	// Device is the client for interacting with the Device builders.
 	Device *DeviceClient
 	// File is the client for interacting with the File builders.
@@ -49,12 +68,20 @@ type Client struct {
 	MessageVoice *MessageVoiceClient
 	// Notification is the client for interacting with the Notification builders.
 	Notification *NotificationClient
+	// OutboxMessage is the client for interacting with the OutboxMessage builders.
+	OutboxMessage *OutboxMessageClient
+	// Report is the client for interacting with the Report builders.
+	Report *ReportClient
 	// Room is the client for interacting with the Room builders.
 	Room *RoomClient
+	// RoomInvite is the client for interacting with the RoomInvite builders.
+	RoomInvite *RoomInviteClient
 	// RoomMember is the client for interacting with the RoomMember builders.
 	RoomMember *RoomMemberClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserBlock is the client for interacting with the UserBlock builders.
+	UserBlock *UserBlockClient
 	// UserContact is the client for interacting with the UserContact builders.
 	UserContact *UserContactClient
 }
@@ -68,6 +95,11 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Avatar = NewAvatarClient(c.config)
+	c.Call = NewCallClient(c.config)
+	c.CallParticipant = NewCallParticipantClient(c.config)
+	c.ContactPin = NewContactPinClient(c.config)
+	c.ContactRequest = NewContactRequestClient(c.config)
 	c.Device = NewDeviceClient(c.config)
 	c.File = NewFileClient(c.config)
 	c.Message = NewMessageClient(c.config)
@@ -75,9 +107,13 @@ func (c *Client) init() {
 	c.MessageLink = NewMessageLinkClient(c.config)
 	c.MessageVoice = NewMessageVoiceClient(c.config)
 	c.Notification = NewNotificationClient(c.config)
+	c.OutboxMessage = NewOutboxMessageClient(c.config)
+	c.Report = NewReportClient(c.config)
 	c.Room = NewRoomClient(c.config)
+	c.RoomInvite = NewRoomInviteClient(c.config)
 	c.RoomMember = NewRoomMemberClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.UserBlock = NewUserBlockClient(c.config)
 	c.UserContact = NewUserContactClient(c.config)
 }
 
@@ -171,6 +207,11 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:               ctx,
 		config:            cfg,
+		Avatar:            NewAvatarClient(cfg),
+		Call:              NewCallClient(cfg),
+		CallParticipant:   NewCallParticipantClient(cfg),
+		ContactPin:        NewContactPinClient(cfg),
+		ContactRequest:    NewContactRequestClient(cfg),
 		Device:            NewDeviceClient(cfg),
 		File:              NewFileClient(cfg),
 		Message:           NewMessageClient(cfg),
@@ -178,9 +219,13 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		MessageLink:       NewMessageLinkClient(cfg),
 		MessageVoice:      NewMessageVoiceClient(cfg),
 		Notification:      NewNotificationClient(cfg),
+		OutboxMessage:     NewOutboxMessageClient(cfg),
+		Report:            NewReportClient(cfg),
 		Room:              NewRoomClient(cfg),
+		RoomInvite:        NewRoomInviteClient(cfg),
 		RoomMember:        NewRoomMemberClient(cfg),
 		User:              NewUserClient(cfg),
+		UserBlock:         NewUserBlockClient(cfg),
 		UserContact:       NewUserContactClient(cfg),
 	}, nil
 }
@@ -201,6 +246,11 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:               ctx,
 		config:            cfg,
+		Avatar:            NewAvatarClient(cfg),
+		Call:              NewCallClient(cfg),
+		CallParticipant:   NewCallParticipantClient(cfg),
+		ContactPin:        NewContactPinClient(cfg),
+		ContactRequest:    NewContactRequestClient(cfg),
 		Device:            NewDeviceClient(cfg),
 		File:              NewFileClient(cfg),
 		Message:           NewMessageClient(cfg),
@@ -208,9 +258,13 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		MessageLink:       NewMessageLinkClient(cfg),
 		MessageVoice:      NewMessageVoiceClient(cfg),
 		Notification:      NewNotificationClient(cfg),
+		OutboxMessage:     NewOutboxMessageClient(cfg),
+		Report:            NewReportClient(cfg),
 		Room:              NewRoomClient(cfg),
+		RoomInvite:        NewRoomInviteClient(cfg),
 		RoomMember:        NewRoomMemberClient(cfg),
 		User:              NewUserClient(cfg),
+		UserBlock:         NewUserBlockClient(cfg),
 		UserContact:       NewUserContactClient(cfg),
 	}, nil
 }
@@ -218,7 +272,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Device.
+//		Avatar.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -241,8 +295,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Device, c.File, c.Message, c.MessageAttachment, c.MessageLink, c.MessageVoice,
-		c.Notification, c.Room, c.RoomMember, c.User, c.UserContact,
+		c.Avatar, c.Call, c.CallParticipant, c.ContactPin, c.ContactRequest, c.Device,
+		c.File, c.Message, c.MessageAttachment, c.MessageLink, c.MessageVoice,
+		c.Notification, c.OutboxMessage, c.Report, c.Room, c.RoomInvite, c.RoomMember,
+		c.User, c.UserBlock, c.UserContact,
 	} {
 		n.Use(hooks...)
 	}
@@ -252,8 +308,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Device, c.File, c.Message, c.MessageAttachment, c.MessageLink, c.MessageVoice,
-		c.Notification, c.Room, c.RoomMember, c.User, c.UserContact,
+		c.Avatar, c.Call, c.CallParticipant, c.ContactPin, c.ContactRequest, c.Device,
+		c.File, c.Message, c.MessageAttachment, c.MessageLink, c.MessageVoice,
+		c.Notification, c.OutboxMessage, c.Report, c.Room, c.RoomInvite, c.RoomMember,
+		c.User, c.UserBlock, c.UserContact,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -262,6 +320,16 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AvatarMutation:
+		return c.Avatar.mutate(ctx, m)
+	case *CallMutation:
+		return c.Call.mutate(ctx, m)
+	case *CallParticipantMutation:
+		return c.CallParticipant.mutate(ctx, m)
+	case *ContactPinMutation:
+		return c.ContactPin.mutate(ctx, m)
+	case *ContactRequestMutation:
+		return c.ContactRequest.mutate(ctx, m)
 	case *DeviceMutation:
 		return c.Device.mutate(ctx, m)
 	case *FileMutation:
@@ -276,12 +344,20 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.MessageVoice.mutate(ctx, m)
 	case *NotificationMutation:
 		return c.Notification.mutate(ctx, m)
+	case *OutboxMessageMutation:
+		return c.OutboxMessage.mutate(ctx, m)
+	case *ReportMutation:
+		return c.Report.mutate(ctx, m)
 	case *RoomMutation:
 		return c.Room.mutate(ctx, m)
+	case *RoomInviteMutation:
+		return c.RoomInvite.mutate(ctx, m)
 	case *RoomMemberMutation:
 		return c.RoomMember.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *UserBlockMutation:
+		return c.UserBlock.mutate(ctx, m)
 	case *UserContactMutation:
 		return c.UserContact.mutate(ctx, m)
 	default:
@@ -289,6 +365,847 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// AvatarClient is a client for the Avatar schema.
+type AvatarClient struct {
+	config
+}
+
+// NewAvatarClient returns a client for the Avatar from the given config.
+func NewAvatarClient(c config) *AvatarClient {
+	return &AvatarClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `avatar.Hooks(f(g(h())))`.
+func (c *AvatarClient) Use(hooks ...Hook) {
+	c.hooks.Avatar = append(c.hooks.Avatar, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `avatar.Intercept(f(g(h())))`.
+func (c *AvatarClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Avatar = append(c.inters.Avatar, interceptors...)
+}
+
+// Create returns a builder for creating a Avatar entity.
+func (c *AvatarClient) Create() *AvatarCreate {
+	mutation := newAvatarMutation(c.config, OpCreate)
+	return &AvatarCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Avatar entities.
+func (c *AvatarClient) CreateBulk(builders ...*AvatarCreate) *AvatarCreateBulk {
+	return &AvatarCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AvatarClient) MapCreateBulk(slice any, setFunc func(*AvatarCreate, int)) *AvatarCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AvatarCreateBulk{err: fmt.Errorf("calling to AvatarClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AvatarCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AvatarCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Avatar.
+func (c *AvatarClient) Update() *AvatarUpdate {
+	mutation := newAvatarMutation(c.config, OpUpdate)
+	return &AvatarUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AvatarClient) UpdateOne(a *Avatar) *AvatarUpdateOne {
+	mutation := newAvatarMutation(c.config, OpUpdateOne, withAvatar(a))
+	return &AvatarUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AvatarClient) UpdateOneID(id pulid.ID) *AvatarUpdateOne {
+	mutation := newAvatarMutation(c.config, OpUpdateOne, withAvatarID(id))
+	return &AvatarUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Avatar.
+func (c *AvatarClient) Delete() *AvatarDelete {
+	mutation := newAvatarMutation(c.config, OpDelete)
+	return &AvatarDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AvatarClient) DeleteOne(a *Avatar) *AvatarDeleteOne {
+	return c.DeleteOneID(a.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AvatarClient) DeleteOneID(id pulid.ID) *AvatarDeleteOne {
+	builder := c.Delete().Where(avatar.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AvatarDeleteOne{builder}
+}
+
+// Query returns a query builder for Avatar.
+func (c *AvatarClient) Query() *AvatarQuery {
+	return &AvatarQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAvatar},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Avatar entity by its id.
+func (c *AvatarClient) Get(ctx context.Context, id pulid.ID) (*Avatar, error) {
+	return c.Query().Where(avatar.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AvatarClient) GetX(ctx context.Context, id pulid.ID) *Avatar {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFile queries the file edge of a Avatar.
+func (c *AvatarClient) QueryFile(a *Avatar) *FileQuery {
+	query := (&FileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(avatar.Table, avatar.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, avatar.FileTable, avatar.FileColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a Avatar.
+func (c *AvatarClient) QueryUser(a *Avatar) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(avatar.Table, avatar.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, avatar.UserTable, avatar.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRoom queries the room edge of a Avatar.
+func (c *AvatarClient) QueryRoom(a *Avatar) *RoomQuery {
+	query := (&RoomClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(avatar.Table, avatar.FieldID, id),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, avatar.RoomTable, avatar.RoomColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AvatarClient) Hooks() []Hook {
+	return c.hooks.Avatar
+}
+
+// Interceptors returns the client interceptors.
+func (c *AvatarClient) Interceptors() []Interceptor {
+	return c.inters.Avatar
+}
+
+func (c *AvatarClient) mutate(ctx context.Context, m *AvatarMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AvatarCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AvatarUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AvatarUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AvatarDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Avatar mutation op: %q", m.Op())
+	}
+}
+
+// CallClient is a client for the Call schema.
+type CallClient struct {
+	config
+}
+
+// NewCallClient returns a client for the Call from the given config.
+func NewCallClient(c config) *CallClient {
+	return &CallClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `call.Hooks(f(g(h())))`.
+func (c *CallClient) Use(hooks ...Hook) {
+	c.hooks.Call = append(c.hooks.Call, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `call.Intercept(f(g(h())))`.
+func (c *CallClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Call = append(c.inters.Call, interceptors...)
+}
+
+// Create returns a builder for creating a Call entity.
+func (c *CallClient) Create() *CallCreate {
+	mutation := newCallMutation(c.config, OpCreate)
+	return &CallCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Call entities.
+func (c *CallClient) CreateBulk(builders ...*CallCreate) *CallCreateBulk {
+	return &CallCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CallClient) MapCreateBulk(slice any, setFunc func(*CallCreate, int)) *CallCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CallCreateBulk{err: fmt.Errorf("calling to CallClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CallCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CallCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Call.
+func (c *CallClient) Update() *CallUpdate {
+	mutation := newCallMutation(c.config, OpUpdate)
+	return &CallUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CallClient) UpdateOne(ca *Call) *CallUpdateOne {
+	mutation := newCallMutation(c.config, OpUpdateOne, withCall(ca))
+	return &CallUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CallClient) UpdateOneID(id pulid.ID) *CallUpdateOne {
+	mutation := newCallMutation(c.config, OpUpdateOne, withCallID(id))
+	return &CallUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Call.
+func (c *CallClient) Delete() *CallDelete {
+	mutation := newCallMutation(c.config, OpDelete)
+	return &CallDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CallClient) DeleteOne(ca *Call) *CallDeleteOne {
+	return c.DeleteOneID(ca.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CallClient) DeleteOneID(id pulid.ID) *CallDeleteOne {
+	builder := c.Delete().Where(call.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CallDeleteOne{builder}
+}
+
+// Query returns a query builder for Call.
+func (c *CallClient) Query() *CallQuery {
+	return &CallQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCall},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Call entity by its id.
+func (c *CallClient) Get(ctx context.Context, id pulid.ID) (*Call, error) {
+	return c.Query().Where(call.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CallClient) GetX(ctx context.Context, id pulid.ID) *Call {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRoom queries the room edge of a Call.
+func (c *CallClient) QueryRoom(ca *Call) *RoomQuery {
+	query := (&RoomClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(call.Table, call.FieldID, id),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, call.RoomTable, call.RoomColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCaller queries the caller edge of a Call.
+func (c *CallClient) QueryCaller(ca *Call) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(call.Table, call.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, call.CallerTable, call.CallerColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParticipants queries the participants edge of a Call.
+func (c *CallClient) QueryParticipants(ca *Call) *CallParticipantQuery {
+	query := (&CallParticipantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(call.Table, call.FieldID, id),
+			sqlgraph.To(callparticipant.Table, callparticipant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, call.ParticipantsTable, call.ParticipantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CallClient) Hooks() []Hook {
+	return c.hooks.Call
+}
+
+// Interceptors returns the client interceptors.
+func (c *CallClient) Interceptors() []Interceptor {
+	return c.inters.Call
+}
+
+func (c *CallClient) mutate(ctx context.Context, m *CallMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CallCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CallUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CallUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CallDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Call mutation op: %q", m.Op())
+	}
+}
+
+// CallParticipantClient is a client for the CallParticipant schema.
+type CallParticipantClient struct {
+	config
+}
+
+// NewCallParticipantClient returns a client for the CallParticipant from the given config.
+func NewCallParticipantClient(c config) *CallParticipantClient {
+	return &CallParticipantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `callparticipant.Hooks(f(g(h())))`.
+func (c *CallParticipantClient) Use(hooks ...Hook) {
+	c.hooks.CallParticipant = append(c.hooks.CallParticipant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `callparticipant.Intercept(f(g(h())))`.
+func (c *CallParticipantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CallParticipant = append(c.inters.CallParticipant, interceptors...)
+}
+
+// Create returns a builder for creating a CallParticipant entity.
+func (c *CallParticipantClient) Create() *CallParticipantCreate {
+	mutation := newCallParticipantMutation(c.config, OpCreate)
+	return &CallParticipantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CallParticipant entities.
+func (c *CallParticipantClient) CreateBulk(builders ...*CallParticipantCreate) *CallParticipantCreateBulk {
+	return &CallParticipantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CallParticipantClient) MapCreateBulk(slice any, setFunc func(*CallParticipantCreate, int)) *CallParticipantCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CallParticipantCreateBulk{err: fmt.Errorf("calling to CallParticipantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CallParticipantCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CallParticipantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CallParticipant.
+func (c *CallParticipantClient) Update() *CallParticipantUpdate {
+	mutation := newCallParticipantMutation(c.config, OpUpdate)
+	return &CallParticipantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CallParticipantClient) UpdateOne(cp *CallParticipant) *CallParticipantUpdateOne {
+	mutation := newCallParticipantMutation(c.config, OpUpdateOne, withCallParticipant(cp))
+	return &CallParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CallParticipantClient) UpdateOneID(id pulid.ID) *CallParticipantUpdateOne {
+	mutation := newCallParticipantMutation(c.config, OpUpdateOne, withCallParticipantID(id))
+	return &CallParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CallParticipant.
+func (c *CallParticipantClient) Delete() *CallParticipantDelete {
+	mutation := newCallParticipantMutation(c.config, OpDelete)
+	return &CallParticipantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CallParticipantClient) DeleteOne(cp *CallParticipant) *CallParticipantDeleteOne {
+	return c.DeleteOneID(cp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CallParticipantClient) DeleteOneID(id pulid.ID) *CallParticipantDeleteOne {
+	builder := c.Delete().Where(callparticipant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CallParticipantDeleteOne{builder}
+}
+
+// Query returns a query builder for CallParticipant.
+func (c *CallParticipantClient) Query() *CallParticipantQuery {
+	return &CallParticipantQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCallParticipant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CallParticipant entity by its id.
+func (c *CallParticipantClient) Get(ctx context.Context, id pulid.ID) (*CallParticipant, error) {
+	return c.Query().Where(callparticipant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CallParticipantClient) GetX(ctx context.Context, id pulid.ID) *CallParticipant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCall queries the call edge of a CallParticipant.
+func (c *CallParticipantClient) QueryCall(cp *CallParticipant) *CallQuery {
+	query := (&CallClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(callparticipant.Table, callparticipant.FieldID, id),
+			sqlgraph.To(call.Table, call.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, callparticipant.CallTable, callparticipant.CallColumn),
+		)
+		fromV = sqlgraph.Neighbors(cp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a CallParticipant.
+func (c *CallParticipantClient) QueryUser(cp *CallParticipant) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(callparticipant.Table, callparticipant.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, callparticipant.UserTable, callparticipant.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(cp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CallParticipantClient) Hooks() []Hook {
+	return c.hooks.CallParticipant
+}
+
+// Interceptors returns the client interceptors.
+func (c *CallParticipantClient) Interceptors() []Interceptor {
+	return c.inters.CallParticipant
+}
+
+func (c *CallParticipantClient) mutate(ctx context.Context, m *CallParticipantMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CallParticipantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CallParticipantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CallParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CallParticipantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CallParticipant mutation op: %q", m.Op())
+	}
+}
+
+// ContactPinClient is a client for the ContactPin schema.
+type ContactPinClient struct {
+	config
+}
+
+// NewContactPinClient returns a client for the ContactPin from the given config.
+func NewContactPinClient(c config) *ContactPinClient {
+	return &ContactPinClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `contactpin.Hooks(f(g(h())))`.
+func (c *ContactPinClient) Use(hooks ...Hook) {
+	c.hooks.ContactPin = append(c.hooks.ContactPin, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `contactpin.Intercept(f(g(h())))`.
+func (c *ContactPinClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ContactPin = append(c.inters.ContactPin, interceptors...)
+}
+
+// Create returns a builder for creating a ContactPin entity.
+func (c *ContactPinClient) Create() *ContactPinCreate {
+	mutation := newContactPinMutation(c.config, OpCreate)
+	return &ContactPinCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ContactPin entities.
+func (c *ContactPinClient) CreateBulk(builders ...*ContactPinCreate) *ContactPinCreateBulk {
+	return &ContactPinCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ContactPinClient) MapCreateBulk(slice any, setFunc func(*ContactPinCreate, int)) *ContactPinCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ContactPinCreateBulk{err: fmt.Errorf("calling to ContactPinClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ContactPinCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ContactPinCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ContactPin.
+func (c *ContactPinClient) Update() *ContactPinUpdate {
+	mutation := newContactPinMutation(c.config, OpUpdate)
+	return &ContactPinUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ContactPinClient) UpdateOne(cp *ContactPin) *ContactPinUpdateOne {
+	mutation := newContactPinMutation(c.config, OpUpdateOne, withContactPin(cp))
+	return &ContactPinUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ContactPinClient) UpdateOneID(id pulid.ID) *ContactPinUpdateOne {
+	mutation := newContactPinMutation(c.config, OpUpdateOne, withContactPinID(id))
+	return &ContactPinUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ContactPin.
+func (c *ContactPinClient) Delete() *ContactPinDelete {
+	mutation := newContactPinMutation(c.config, OpDelete)
+	return &ContactPinDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ContactPinClient) DeleteOne(cp *ContactPin) *ContactPinDeleteOne {
+	return c.DeleteOneID(cp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ContactPinClient) DeleteOneID(id pulid.ID) *ContactPinDeleteOne {
+	builder := c.Delete().Where(contactpin.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ContactPinDeleteOne{builder}
+}
+
+// Query returns a query builder for ContactPin.
+func (c *ContactPinClient) Query() *ContactPinQuery {
+	return &ContactPinQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeContactPin},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ContactPin entity by its id.
+func (c *ContactPinClient) Get(ctx context.Context, id pulid.ID) (*ContactPin, error) {
+	return c.Query().Where(contactpin.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ContactPinClient) GetX(ctx context.Context, id pulid.ID) *ContactPin {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a ContactPin.
+func (c *ContactPinClient) QueryUser(cp *ContactPin) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contactpin.Table, contactpin.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, contactpin.UserTable, contactpin.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(cp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ContactPinClient) Hooks() []Hook {
+	return c.hooks.ContactPin
+}
+
+// Interceptors returns the client interceptors.
+func (c *ContactPinClient) Interceptors() []Interceptor {
+	return c.inters.ContactPin
+}
+
+func (c *ContactPinClient) mutate(ctx context.Context, m *ContactPinMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ContactPinCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ContactPinUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ContactPinUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ContactPinDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ContactPin mutation op: %q", m.Op())
+	}
+}
+
+// ContactRequestClient is a client for the ContactRequest schema.
+type ContactRequestClient struct {
+	config
+}
+
+// NewContactRequestClient returns a client for the ContactRequest from the given config.
+func NewContactRequestClient(c config) *ContactRequestClient {
+	return &ContactRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `contactrequest.Hooks(f(g(h())))`.
+func (c *ContactRequestClient) Use(hooks ...Hook) {
+	c.hooks.ContactRequest = append(c.hooks.ContactRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `contactrequest.Intercept(f(g(h())))`.
+func (c *ContactRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ContactRequest = append(c.inters.ContactRequest, interceptors...)
+}
+
+// Create returns a builder for creating a ContactRequest entity.
+func (c *ContactRequestClient) Create() *ContactRequestCreate {
+	mutation := newContactRequestMutation(c.config, OpCreate)
+	return &ContactRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ContactRequest entities.
+func (c *ContactRequestClient) CreateBulk(builders ...*ContactRequestCreate) *ContactRequestCreateBulk {
+	return &ContactRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ContactRequestClient) MapCreateBulk(slice any, setFunc func(*ContactRequestCreate, int)) *ContactRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ContactRequestCreateBulk{err: fmt.Errorf("calling to ContactRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ContactRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ContactRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ContactRequest.
+func (c *ContactRequestClient) Update() *ContactRequestUpdate {
+	mutation := newContactRequestMutation(c.config, OpUpdate)
+	return &ContactRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ContactRequestClient) UpdateOne(cr *ContactRequest) *ContactRequestUpdateOne {
+	mutation := newContactRequestMutation(c.config, OpUpdateOne, withContactRequest(cr))
+	return &ContactRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ContactRequestClient) UpdateOneID(id pulid.ID) *ContactRequestUpdateOne {
+	mutation := newContactRequestMutation(c.config, OpUpdateOne, withContactRequestID(id))
+	return &ContactRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ContactRequest.
+func (c *ContactRequestClient) Delete() *ContactRequestDelete {
+	mutation := newContactRequestMutation(c.config, OpDelete)
+	return &ContactRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ContactRequestClient) DeleteOne(cr *ContactRequest) *ContactRequestDeleteOne {
+	return c.DeleteOneID(cr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ContactRequestClient) DeleteOneID(id pulid.ID) *ContactRequestDeleteOne {
+	builder := c.Delete().Where(contactrequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ContactRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for ContactRequest.
+func (c *ContactRequestClient) Query() *ContactRequestQuery {
+	return &ContactRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeContactRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ContactRequest entity by its id.
+func (c *ContactRequestClient) Get(ctx context.Context, id pulid.ID) (*ContactRequest, error) {
+	return c.Query().Where(contactrequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ContactRequestClient) GetX(ctx context.Context, id pulid.ID) *ContactRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySender queries the sender edge of a ContactRequest.
+func (c *ContactRequestClient) QuerySender(cr *ContactRequest) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contactrequest.Table, contactrequest.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, contactrequest.SenderTable, contactrequest.SenderColumn),
+		)
+		fromV = sqlgraph.Neighbors(cr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReceiver queries the receiver edge of a ContactRequest.
+func (c *ContactRequestClient) QueryReceiver(cr *ContactRequest) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contactrequest.Table, contactrequest.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, contactrequest.ReceiverTable, contactrequest.ReceiverColumn),
+		)
+		fromV = sqlgraph.Neighbors(cr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ContactRequestClient) Hooks() []Hook {
+	return c.hooks.ContactRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *ContactRequestClient) Interceptors() []Interceptor {
+	return c.inters.ContactRequest
+}
+
+func (c *ContactRequestClient) mutate(ctx context.Context, m *ContactRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ContactRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ContactRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ContactRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ContactRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ContactRequest mutation op: %q", m.Op())
+	}
+}
+
 // DeviceClient is a client for the Device schema.
 type DeviceClient struct {
 	config
@@ -578,9 +1495,74 @@ func (c *FileClient) QueryMessageVoice(f *File) *MessageVoiceQuery {
 	return query
 }
 
+// QueryOriginal queries the original edge of a File.
+func (c *FileClient) QueryOriginal(f *File) *FileQuery {
+	query := (&FileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, file.OriginalTable, file.OriginalColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryThumbnails queries the thumbnails edge of a File.
+func (c *FileClient) QueryThumbnails(f *File) *FileQuery {
+	query := (&FileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, file.ThumbnailsTable, file.ThumbnailsColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a File.
+func (c *FileClient) QueryUser(f *File) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, file.UserTable, file.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRoom queries the room edge of a File.
+func (c *FileClient) QueryRoom(f *File) *RoomQuery {
+	query := (&RoomClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, file.RoomTable, file.RoomColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *FileClient) Hooks() []Hook {
-	return c.hooks.File
+	hooks := c.hooks.File
+	return append(hooks[:len(hooks):len(hooks)], file.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -775,15 +1757,47 @@ func (c *MessageClient) QueryAttachments(m *Message) *MessageAttachmentQuery {
 	return query
 }
 
-// QueryLinks queries the links edge of a Message.
-func (c *MessageClient) QueryLinks(m *Message) *MessageLinkQuery {
-	query := (&MessageLinkClient{config: c.config}).Query()
+// QueryLinks queries the links edge of a Message.
+func (c *MessageClient) QueryLinks(m *Message) *MessageLinkQuery {
+	query := (&MessageLinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(messagelink.Table, messagelink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.LinksTable, message.LinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCall queries the call edge of a Message.
+func (c *MessageClient) QueryCall(m *Message) *CallQuery {
+	query := (&CallClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(call.Table, call.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, message.CallTable, message.CallColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAvatar queries the avatar edge of a Message.
+func (c *MessageClient) QueryAvatar(m *Message) *AvatarQuery {
+	query := (&AvatarClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(message.Table, message.FieldID, id),
-			sqlgraph.To(messagelink.Table, messagelink.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, message.LinksTable, message.LinksColumn),
+			sqlgraph.To(avatar.Table, avatar.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, message.AvatarTable, message.AvatarColumn),
 		)
 		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
 		return fromV, nil
@@ -1524,6 +2538,352 @@ func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation
 	}
 }
 
+// OutboxMessageClient is a client for the OutboxMessage schema.
+type OutboxMessageClient struct {
+	config
+}
+
+// NewOutboxMessageClient returns a client for the OutboxMessage from the given config.
+func NewOutboxMessageClient(c config) *OutboxMessageClient {
+	return &OutboxMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outboxmessage.Hooks(f(g(h())))`.
+func (c *OutboxMessageClient) Use(hooks ...Hook) {
+	c.hooks.OutboxMessage = append(c.hooks.OutboxMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `outboxmessage.Intercept(f(g(h())))`.
+func (c *OutboxMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OutboxMessage = append(c.inters.OutboxMessage, interceptors...)
+}
+
+// Create returns a builder for creating a OutboxMessage entity.
+func (c *OutboxMessageClient) Create() *OutboxMessageCreate {
+	mutation := newOutboxMessageMutation(c.config, OpCreate)
+	return &OutboxMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OutboxMessage entities.
+func (c *OutboxMessageClient) CreateBulk(builders ...*OutboxMessageCreate) *OutboxMessageCreateBulk {
+	return &OutboxMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OutboxMessageClient) MapCreateBulk(slice any, setFunc func(*OutboxMessageCreate, int)) *OutboxMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OutboxMessageCreateBulk{err: fmt.Errorf("calling to OutboxMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OutboxMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OutboxMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OutboxMessage.
+func (c *OutboxMessageClient) Update() *OutboxMessageUpdate {
+	mutation := newOutboxMessageMutation(c.config, OpUpdate)
+	return &OutboxMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutboxMessageClient) UpdateOne(om *OutboxMessage) *OutboxMessageUpdateOne {
+	mutation := newOutboxMessageMutation(c.config, OpUpdateOne, withOutboxMessage(om))
+	return &OutboxMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutboxMessageClient) UpdateOneID(id pulid.ID) *OutboxMessageUpdateOne {
+	mutation := newOutboxMessageMutation(c.config, OpUpdateOne, withOutboxMessageID(id))
+	return &OutboxMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OutboxMessage.
+func (c *OutboxMessageClient) Delete() *OutboxMessageDelete {
+	mutation := newOutboxMessageMutation(c.config, OpDelete)
+	return &OutboxMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutboxMessageClient) DeleteOne(om *OutboxMessage) *OutboxMessageDeleteOne {
+	return c.DeleteOneID(om.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OutboxMessageClient) DeleteOneID(id pulid.ID) *OutboxMessageDeleteOne {
+	builder := c.Delete().Where(outboxmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutboxMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for OutboxMessage.
+func (c *OutboxMessageClient) Query() *OutboxMessageQuery {
+	return &OutboxMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOutboxMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OutboxMessage entity by its id.
+func (c *OutboxMessageClient) Get(ctx context.Context, id pulid.ID) (*OutboxMessage, error) {
+	return c.Query().Where(outboxmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutboxMessageClient) GetX(ctx context.Context, id pulid.ID) *OutboxMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutboxMessageClient) Hooks() []Hook {
+	return c.hooks.OutboxMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *OutboxMessageClient) Interceptors() []Interceptor {
+	return c.inters.OutboxMessage
+}
+
+func (c *OutboxMessageClient) mutate(ctx context.Context, m *OutboxMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OutboxMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OutboxMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OutboxMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OutboxMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OutboxMessage mutation op: %q", m.Op())
+	}
+}
+
+// ReportClient is a client for the Report schema.
+type ReportClient struct {
+	config
+}
+
+// NewReportClient returns a client for the Report from the given config.
+func NewReportClient(c config) *ReportClient {
+	return &ReportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `report.Hooks(f(g(h())))`.
+func (c *ReportClient) Use(hooks ...Hook) {
+	c.hooks.Report = append(c.hooks.Report, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `report.Intercept(f(g(h())))`.
+func (c *ReportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Report = append(c.inters.Report, interceptors...)
+}
+
+// Create returns a builder for creating a Report entity.
+func (c *ReportClient) Create() *ReportCreate {
+	mutation := newReportMutation(c.config, OpCreate)
+	return &ReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Report entities.
+func (c *ReportClient) CreateBulk(builders ...*ReportCreate) *ReportCreateBulk {
+	return &ReportCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReportClient) MapCreateBulk(slice any, setFunc func(*ReportCreate, int)) *ReportCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReportCreateBulk{err: fmt.Errorf("calling to ReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReportCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Report.
+func (c *ReportClient) Update() *ReportUpdate {
+	mutation := newReportMutation(c.config, OpUpdate)
+	return &ReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReportClient) UpdateOne(r *Report) *ReportUpdateOne {
+	mutation := newReportMutation(c.config, OpUpdateOne, withReport(r))
+	return &ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReportClient) UpdateOneID(id pulid.ID) *ReportUpdateOne {
+	mutation := newReportMutation(c.config, OpUpdateOne, withReportID(id))
+	return &ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Report.
+func (c *ReportClient) Delete() *ReportDelete {
+	mutation := newReportMutation(c.config, OpDelete)
+	return &ReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReportClient) DeleteOne(r *Report) *ReportDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReportClient) DeleteOneID(id pulid.ID) *ReportDeleteOne {
+	builder := c.Delete().Where(report.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReportDeleteOne{builder}
+}
+
+// Query returns a query builder for Report.
+func (c *ReportClient) Query() *ReportQuery {
+	return &ReportQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Report entity by its id.
+func (c *ReportClient) Get(ctx context.Context, id pulid.ID) (*Report, error) {
+	return c.Query().Where(report.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReportClient) GetX(ctx context.Context, id pulid.ID) *Report {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryReporter queries the reporter edge of a Report.
+func (c *ReportClient) QueryReporter(r *Report) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(report.Table, report.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, report.ReporterTable, report.ReporterColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a Report.
+func (c *ReportClient) QueryUser(r *Report) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(report.Table, report.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, report.UserTable, report.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMessage queries the message edge of a Report.
+func (c *ReportClient) QueryMessage(r *Report) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(report.Table, report.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, report.MessageTable, report.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRoom queries the room edge of a Report.
+func (c *ReportClient) QueryRoom(r *Report) *RoomQuery {
+	query := (&RoomClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(report.Table, report.FieldID, id),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, report.RoomTable, report.RoomColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssignee queries the assignee edge of a Report.
+func (c *ReportClient) QueryAssignee(r *Report) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(report.Table, report.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, report.AssigneeTable, report.AssigneeColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReportClient) Hooks() []Hook {
+	return c.hooks.Report
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReportClient) Interceptors() []Interceptor {
+	return c.inters.Report
+}
+
+func (c *ReportClient) mutate(ctx context.Context, m *ReportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Report mutation op: %q", m.Op())
+	}
+}
+
 // RoomClient is a client for the Room schema.
 type RoomClient struct {
 	config
@@ -1719,8 +3079,56 @@ func (c *RoomClient) QueryMessageAttachments(r *Room) *MessageAttachmentQuery {
 		id := r.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(room.Table, room.FieldID, id),
-			sqlgraph.To(messageattachment.Table, messageattachment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, room.MessageAttachmentsTable, room.MessageAttachmentsColumn),
+			sqlgraph.To(messageattachment.Table, messageattachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, room.MessageAttachmentsTable, room.MessageAttachmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMessageLinks queries the message_links edge of a Room.
+func (c *RoomClient) QueryMessageLinks(r *Room) *MessageLinkQuery {
+	query := (&MessageLinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, id),
+			sqlgraph.To(messagelink.Table, messagelink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, room.MessageLinksTable, room.MessageLinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFiles queries the files edge of a Room.
+func (c *RoomClient) QueryFiles(r *Room) *FileQuery {
+	query := (&FileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, room.FilesTable, room.FilesColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAvatar queries the avatar edge of a Room.
+func (c *RoomClient) QueryAvatar(r *Room) *FileQuery {
+	query := (&FileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, room.AvatarTable, room.AvatarColumn),
 		)
 		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
 		return fromV, nil
@@ -1728,15 +3136,15 @@ func (c *RoomClient) QueryMessageAttachments(r *Room) *MessageAttachmentQuery {
 	return query
 }
 
-// QueryMessageLinks queries the message_links edge of a Room.
-func (c *RoomClient) QueryMessageLinks(r *Room) *MessageLinkQuery {
-	query := (&MessageLinkClient{config: c.config}).Query()
+// QueryAvatars queries the avatars edge of a Room.
+func (c *RoomClient) QueryAvatars(r *Room) *AvatarQuery {
+	query := (&AvatarClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := r.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(room.Table, room.FieldID, id),
-			sqlgraph.To(messagelink.Table, messagelink.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, room.MessageLinksTable, room.MessageLinksColumn),
+			sqlgraph.To(avatar.Table, avatar.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, room.AvatarsTable, room.AvatarsColumn),
 		)
 		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
 		return fromV, nil
@@ -1787,6 +3195,171 @@ func (c *RoomClient) mutate(ctx context.Context, m *RoomMutation) (Value, error)
 	}
 }
 
+// RoomInviteClient is a client for the RoomInvite schema.
+type RoomInviteClient struct {
+	config
+}
+
+// NewRoomInviteClient returns a client for the RoomInvite from the given config.
+func NewRoomInviteClient(c config) *RoomInviteClient {
+	return &RoomInviteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `roominvite.Hooks(f(g(h())))`.
+func (c *RoomInviteClient) Use(hooks ...Hook) {
+	c.hooks.RoomInvite = append(c.hooks.RoomInvite, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `roominvite.Intercept(f(g(h())))`.
+func (c *RoomInviteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoomInvite = append(c.inters.RoomInvite, interceptors...)
+}
+
+// Create returns a builder for creating a RoomInvite entity.
+func (c *RoomInviteClient) Create() *RoomInviteCreate {
+	mutation := newRoomInviteMutation(c.config, OpCreate)
+	return &RoomInviteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoomInvite entities.
+func (c *RoomInviteClient) CreateBulk(builders ...*RoomInviteCreate) *RoomInviteCreateBulk {
+	return &RoomInviteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoomInviteClient) MapCreateBulk(slice any, setFunc func(*RoomInviteCreate, int)) *RoomInviteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoomInviteCreateBulk{err: fmt.Errorf("calling to RoomInviteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoomInviteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoomInviteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoomInvite.
+func (c *RoomInviteClient) Update() *RoomInviteUpdate {
+	mutation := newRoomInviteMutation(c.config, OpUpdate)
+	return &RoomInviteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoomInviteClient) UpdateOne(ri *RoomInvite) *RoomInviteUpdateOne {
+	mutation := newRoomInviteMutation(c.config, OpUpdateOne, withRoomInvite(ri))
+	return &RoomInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoomInviteClient) UpdateOneID(id pulid.ID) *RoomInviteUpdateOne {
+	mutation := newRoomInviteMutation(c.config, OpUpdateOne, withRoomInviteID(id))
+	return &RoomInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoomInvite.
+func (c *RoomInviteClient) Delete() *RoomInviteDelete {
+	mutation := newRoomInviteMutation(c.config, OpDelete)
+	return &RoomInviteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoomInviteClient) DeleteOne(ri *RoomInvite) *RoomInviteDeleteOne {
+	return c.DeleteOneID(ri.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoomInviteClient) DeleteOneID(id pulid.ID) *RoomInviteDeleteOne {
+	builder := c.Delete().Where(roominvite.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoomInviteDeleteOne{builder}
+}
+
+// Query returns a query builder for RoomInvite.
+func (c *RoomInviteClient) Query() *RoomInviteQuery {
+	return &RoomInviteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoomInvite},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoomInvite entity by its id.
+func (c *RoomInviteClient) Get(ctx context.Context, id pulid.ID) (*RoomInvite, error) {
+	return c.Query().Where(roominvite.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoomInviteClient) GetX(ctx context.Context, id pulid.ID) *RoomInvite {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRoom queries the room edge of a RoomInvite.
+func (c *RoomInviteClient) QueryRoom(ri *RoomInvite) *RoomQuery {
+	query := (&RoomClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ri.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roominvite.Table, roominvite.FieldID, id),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, roominvite.RoomTable, roominvite.RoomColumn),
+		)
+		fromV = sqlgraph.Neighbors(ri.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreator queries the creator edge of a RoomInvite.
+func (c *RoomInviteClient) QueryCreator(ri *RoomInvite) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ri.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roominvite.Table, roominvite.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, roominvite.CreatorTable, roominvite.CreatorColumn),
+		)
+		fromV = sqlgraph.Neighbors(ri.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoomInviteClient) Hooks() []Hook {
+	return c.hooks.RoomInvite
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoomInviteClient) Interceptors() []Interceptor {
+	return c.inters.RoomInvite
+}
+
+func (c *RoomInviteClient) mutate(ctx context.Context, m *RoomInviteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoomInviteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoomInviteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoomInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoomInviteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoomInvite mutation op: %q", m.Op())
+	}
+}
+
 // RoomMemberClient is a client for the RoomMember schema.
 type RoomMemberClient struct {
 	config
@@ -2142,6 +3715,86 @@ func (c *UserClient) QueryMessages(u *User) *MessageQuery {
 	return query
 }
 
+// QueryFiles queries the files edge of a User.
+func (c *UserClient) QueryFiles(u *User) *FileQuery {
+	query := (&FileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.FilesTable, user.FilesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAvatar queries the avatar edge of a User.
+func (c *UserClient) QueryAvatar(u *User) *FileQuery {
+	query := (&FileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, user.AvatarTable, user.AvatarColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAvatars queries the avatars edge of a User.
+func (c *UserClient) QueryAvatars(u *User) *AvatarQuery {
+	query := (&AvatarClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(avatar.Table, avatar.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AvatarsTable, user.AvatarsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBlocks queries the blocks edge of a User.
+func (c *UserClient) QueryBlocks(u *User) *UserBlockQuery {
+	query := (&UserBlockClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(userblock.Table, userblock.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.BlocksTable, user.BlocksColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBlockedBy queries the blocked_by edge of a User.
+func (c *UserClient) QueryBlockedBy(u *User) *UserBlockQuery {
+	query := (&UserBlockClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(userblock.Table, userblock.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.BlockedByTable, user.BlockedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryUserContacts queries the user_contacts edge of a User.
 func (c *UserClient) QueryUserContacts(u *User) *UserContactQuery {
 	query := (&UserContactClient{config: c.config}).Query()
@@ -2199,6 +3852,171 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// UserBlockClient is a client for the UserBlock schema.
+type UserBlockClient struct {
+	config
+}
+
+// NewUserBlockClient returns a client for the UserBlock from the given config.
+func NewUserBlockClient(c config) *UserBlockClient {
+	return &UserBlockClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userblock.Hooks(f(g(h())))`.
+func (c *UserBlockClient) Use(hooks ...Hook) {
+	c.hooks.UserBlock = append(c.hooks.UserBlock, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userblock.Intercept(f(g(h())))`.
+func (c *UserBlockClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserBlock = append(c.inters.UserBlock, interceptors...)
+}
+
+// Create returns a builder for creating a UserBlock entity.
+func (c *UserBlockClient) Create() *UserBlockCreate {
+	mutation := newUserBlockMutation(c.config, OpCreate)
+	return &UserBlockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserBlock entities.
+func (c *UserBlockClient) CreateBulk(builders ...*UserBlockCreate) *UserBlockCreateBulk {
+	return &UserBlockCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserBlockClient) MapCreateBulk(slice any, setFunc func(*UserBlockCreate, int)) *UserBlockCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserBlockCreateBulk{err: fmt.Errorf("calling to UserBlockClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserBlockCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserBlockCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserBlock.
+func (c *UserBlockClient) Update() *UserBlockUpdate {
+	mutation := newUserBlockMutation(c.config, OpUpdate)
+	return &UserBlockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserBlockClient) UpdateOne(ub *UserBlock) *UserBlockUpdateOne {
+	mutation := newUserBlockMutation(c.config, OpUpdateOne, withUserBlock(ub))
+	return &UserBlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserBlockClient) UpdateOneID(id pulid.ID) *UserBlockUpdateOne {
+	mutation := newUserBlockMutation(c.config, OpUpdateOne, withUserBlockID(id))
+	return &UserBlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserBlock.
+func (c *UserBlockClient) Delete() *UserBlockDelete {
+	mutation := newUserBlockMutation(c.config, OpDelete)
+	return &UserBlockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserBlockClient) DeleteOne(ub *UserBlock) *UserBlockDeleteOne {
+	return c.DeleteOneID(ub.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserBlockClient) DeleteOneID(id pulid.ID) *UserBlockDeleteOne {
+	builder := c.Delete().Where(userblock.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserBlockDeleteOne{builder}
+}
+
+// Query returns a query builder for UserBlock.
+func (c *UserBlockClient) Query() *UserBlockQuery {
+	return &UserBlockQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserBlock},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserBlock entity by its id.
+func (c *UserBlockClient) Get(ctx context.Context, id pulid.ID) (*UserBlock, error) {
+	return c.Query().Where(userblock.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserBlockClient) GetX(ctx context.Context, id pulid.ID) *UserBlock {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a UserBlock.
+func (c *UserBlockClient) QueryUser(ub *UserBlock) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ub.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(userblock.Table, userblock.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, userblock.UserTable, userblock.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(ub.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBlockedUser queries the blocked_user edge of a UserBlock.
+func (c *UserBlockClient) QueryBlockedUser(ub *UserBlock) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ub.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(userblock.Table, userblock.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, userblock.BlockedUserTable, userblock.BlockedUserColumn),
+		)
+		fromV = sqlgraph.Neighbors(ub.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserBlockClient) Hooks() []Hook {
+	return c.hooks.UserBlock
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserBlockClient) Interceptors() []Interceptor {
+	return c.inters.UserBlock
+}
+
+func (c *UserBlockClient) mutate(ctx context.Context, m *UserBlockMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserBlockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserBlockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserBlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserBlockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserBlock mutation op: %q", m.Op())
+	}
+}
+
 // UserContactClient is a client for the UserContact schema.
 type UserContactClient struct {
 	config
@@ -2385,11 +4203,15 @@ func (c *UserContactClient) mutate(ctx context.Context, m *UserContactMutation)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Device, File, Message, MessageAttachment, MessageLink, MessageVoice,
-		Notification, Room, RoomMember, User, UserContact []ent.Hook
+		Avatar, Call, CallParticipant, ContactPin, ContactRequest, Device, File,
+		Message, MessageAttachment, MessageLink, MessageVoice, Notification,
+		OutboxMessage, Report, Room, RoomInvite, RoomMember, User, UserBlock,
+		UserContact []ent.Hook
 	}
 	inters struct {
-		Device, File, Message, MessageAttachment, MessageLink, MessageVoice,
-		Notification, Room, RoomMember, User, UserContact []ent.Interceptor
+		Avatar, Call, CallParticipant, ContactPin, ContactRequest, Device, File,
+		Message, MessageAttachment, MessageLink, MessageVoice, Notification,
+		OutboxMessage, Report, Room, RoomInvite, RoomMember, User, UserBlock,
+		UserContact []ent.Interceptor
 	}
 )
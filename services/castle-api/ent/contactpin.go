@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"journeyhub/ent/contactpin"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ContactPin is the model entity for the ContactPin schema.
+type ContactPin struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID pulid.ID `json:"id,omitempty"`
+	// Code holds the value of the "code" field.
+	Code string `json:"-"`
+	// UserID holds the value of the "user_id" field.
+	UserID pulid.ID `json:"user_id,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// MaxUses holds the value of the "max_uses" field.
+	MaxUses *int `json:"max_uses,omitempty"`
+	// Uses holds the value of the "uses" field.
+	Uses int `json:"uses,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ContactPinQuery when eager-loading is set.
+	Edges        ContactPinEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ContactPinEdges holds the relations/edges for other nodes in the graph.
+type ContactPinEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContactPinEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ContactPin) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case contactpin.FieldID, contactpin.FieldUserID:
+			values[i] = new(pulid.ID)
+		case contactpin.FieldMaxUses, contactpin.FieldUses:
+			values[i] = new(sql.NullInt64)
+		case contactpin.FieldCode:
+			values[i] = new(sql.NullString)
+		case contactpin.FieldExpiresAt, contactpin.FieldRevokedAt, contactpin.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ContactPin fields.
+func (cp *ContactPin) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case contactpin.FieldID:
+			if value, ok := values[i].(*pulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cp.ID = *value
+			}
+		case contactpin.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				cp.Code = value.String
+			}
+		case contactpin.FieldUserID:
+			if value, ok := values[i].(*pulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				cp.UserID = *value
+			}
+		case contactpin.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				cp.ExpiresAt = new(time.Time)
+				*cp.ExpiresAt = value.Time
+			}
+		case contactpin.FieldMaxUses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_uses", values[i])
+			} else if value.Valid {
+				cp.MaxUses = new(int)
+				*cp.MaxUses = int(value.Int64)
+			}
+		case contactpin.FieldUses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field uses", values[i])
+			} else if value.Valid {
+				cp.Uses = int(value.Int64)
+			}
+		case contactpin.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				cp.RevokedAt = new(time.Time)
+				*cp.RevokedAt = value.Time
+			}
+		case contactpin.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cp.CreatedAt = value.Time
+			}
+		default:
+			cp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ContactPin.
+// This includes values selected through modifiers, order, etc.
+func (cp *ContactPin) Value(name string) (ent.Value, error) {
+	return cp.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the ContactPin entity.
+func (cp *ContactPin) QueryUser() *UserQuery {
+	return NewContactPinClient(cp.config).QueryUser(cp)
+}
+
+// Update returns a builder for updating this ContactPin.
+// Note that you need to call ContactPin.Unwrap() before calling this method if this ContactPin
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cp *ContactPin) Update() *ContactPinUpdateOne {
+	return NewContactPinClient(cp.config).UpdateOne(cp)
+}
+
+// Unwrap unwraps the ContactPin entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cp *ContactPin) Unwrap() *ContactPin {
+	_tx, ok := cp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ContactPin is not a transactional entity")
+	}
+	cp.config.driver = _tx.drv
+	return cp
+}
+
+// String implements the fmt.Stringer.
+func (cp *ContactPin) String() string {
+	var builder strings.Builder
+	builder.WriteString("ContactPin(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cp.ID))
+	builder.WriteString("code=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", cp.UserID))
+	builder.WriteString(", ")
+	if v := cp.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := cp.MaxUses; v != nil {
+		builder.WriteString("max_uses=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("uses=")
+	builder.WriteString(fmt.Sprintf("%v", cp.Uses))
+	builder.WriteString(", ")
+	if v := cp.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cp.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ContactPins is a parsable slice of ContactPin.
+type ContactPins []*ContactPin
@@ -0,0 +1,891 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journeyhub/ent/contactpin"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ContactPinCreate is the builder for creating a ContactPin entity.
+type ContactPinCreate struct {
+	config
+	mutation *ContactPinMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCode sets the "code" field.
+func (cpc *ContactPinCreate) SetCode(s string) *ContactPinCreate {
+	cpc.mutation.SetCode(s)
+	return cpc
+}
+
+// SetUserID sets the "user_id" field.
+func (cpc *ContactPinCreate) SetUserID(pu pulid.ID) *ContactPinCreate {
+	cpc.mutation.SetUserID(pu)
+	return cpc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (cpc *ContactPinCreate) SetExpiresAt(t time.Time) *ContactPinCreate {
+	cpc.mutation.SetExpiresAt(t)
+	return cpc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cpc *ContactPinCreate) SetNillableExpiresAt(t *time.Time) *ContactPinCreate {
+	if t != nil {
+		cpc.SetExpiresAt(*t)
+	}
+	return cpc
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (cpc *ContactPinCreate) SetMaxUses(i int) *ContactPinCreate {
+	cpc.mutation.SetMaxUses(i)
+	return cpc
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (cpc *ContactPinCreate) SetNillableMaxUses(i *int) *ContactPinCreate {
+	if i != nil {
+		cpc.SetMaxUses(*i)
+	}
+	return cpc
+}
+
+// SetUses sets the "uses" field.
+func (cpc *ContactPinCreate) SetUses(i int) *ContactPinCreate {
+	cpc.mutation.SetUses(i)
+	return cpc
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (cpc *ContactPinCreate) SetNillableUses(i *int) *ContactPinCreate {
+	if i != nil {
+		cpc.SetUses(*i)
+	}
+	return cpc
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (cpc *ContactPinCreate) SetRevokedAt(t time.Time) *ContactPinCreate {
+	cpc.mutation.SetRevokedAt(t)
+	return cpc
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (cpc *ContactPinCreate) SetNillableRevokedAt(t *time.Time) *ContactPinCreate {
+	if t != nil {
+		cpc.SetRevokedAt(*t)
+	}
+	return cpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cpc *ContactPinCreate) SetCreatedAt(t time.Time) *ContactPinCreate {
+	cpc.mutation.SetCreatedAt(t)
+	return cpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cpc *ContactPinCreate) SetNillableCreatedAt(t *time.Time) *ContactPinCreate {
+	if t != nil {
+		cpc.SetCreatedAt(*t)
+	}
+	return cpc
+}
+
+// SetID sets the "id" field.
+func (cpc *ContactPinCreate) SetID(pu pulid.ID) *ContactPinCreate {
+	cpc.mutation.SetID(pu)
+	return cpc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cpc *ContactPinCreate) SetNillableID(pu *pulid.ID) *ContactPinCreate {
+	if pu != nil {
+		cpc.SetID(*pu)
+	}
+	return cpc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cpc *ContactPinCreate) SetUser(u *User) *ContactPinCreate {
+	return cpc.SetUserID(u.ID)
+}
+
+// Mutation returns the ContactPinMutation object of the builder.
+func (cpc *ContactPinCreate) Mutation() *ContactPinMutation {
+	return cpc.mutation
+}
+
+// Save creates the ContactPin in the database.
+func (cpc *ContactPinCreate) Save(ctx context.Context) (*ContactPin, error) {
+	cpc.defaults()
+	return withHooks(ctx, cpc.sqlSave, cpc.mutation, cpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cpc *ContactPinCreate) SaveX(ctx context.Context) *ContactPin {
+	v, err := cpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cpc *ContactPinCreate) Exec(ctx context.Context) error {
+	_, err := cpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpc *ContactPinCreate) ExecX(ctx context.Context) {
+	if err := cpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cpc *ContactPinCreate) defaults() {
+	if _, ok := cpc.mutation.Uses(); !ok {
+		v := contactpin.DefaultUses
+		cpc.mutation.SetUses(v)
+	}
+	if _, ok := cpc.mutation.CreatedAt(); !ok {
+		v := contactpin.DefaultCreatedAt()
+		cpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cpc.mutation.ID(); !ok {
+		v := contactpin.DefaultID()
+		cpc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cpc *ContactPinCreate) check() error {
+	if _, ok := cpc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "ContactPin.code"`)}
+	}
+	if _, ok := cpc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ContactPin.user_id"`)}
+	}
+	if v, ok := cpc.mutation.MaxUses(); ok {
+		if err := contactpin.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "ContactPin.max_uses": %w`, err)}
+		}
+	}
+	if _, ok := cpc.mutation.Uses(); !ok {
+		return &ValidationError{Name: "uses", err: errors.New(`ent: missing required field "ContactPin.uses"`)}
+	}
+	if _, ok := cpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ContactPin.created_at"`)}
+	}
+	if len(cpc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ContactPin.user"`)}
+	}
+	return nil
+}
+
+func (cpc *ContactPinCreate) sqlSave(ctx context.Context) (*ContactPin, error) {
+	if err := cpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*pulid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cpc.mutation.id = &_node.ID
+	cpc.mutation.done = true
+	return _node, nil
+}
+
+func (cpc *ContactPinCreate) createSpec() (*ContactPin, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ContactPin{config: cpc.config}
+		_spec = sqlgraph.NewCreateSpec(contactpin.Table, sqlgraph.NewFieldSpec(contactpin.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = cpc.conflict
+	if id, ok := cpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cpc.mutation.Code(); ok {
+		_spec.SetField(contactpin.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := cpc.mutation.ExpiresAt(); ok {
+		_spec.SetField(contactpin.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := cpc.mutation.MaxUses(); ok {
+		_spec.SetField(contactpin.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = &value
+	}
+	if value, ok := cpc.mutation.Uses(); ok {
+		_spec.SetField(contactpin.FieldUses, field.TypeInt, value)
+		_node.Uses = value
+	}
+	if value, ok := cpc.mutation.RevokedAt(); ok {
+		_spec.SetField(contactpin.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := cpc.mutation.CreatedAt(); ok {
+		_spec.SetField(contactpin.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := cpc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   contactpin.UserTable,
+			Columns: []string{contactpin.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ContactPin.Create().
+//		SetCode(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ContactPinUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (cpc *ContactPinCreate) OnConflict(opts ...sql.ConflictOption) *ContactPinUpsertOne {
+	cpc.conflict = opts
+	return &ContactPinUpsertOne{
+		create: cpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ContactPin.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cpc *ContactPinCreate) OnConflictColumns(columns ...string) *ContactPinUpsertOne {
+	cpc.conflict = append(cpc.conflict, sql.ConflictColumns(columns...))
+	return &ContactPinUpsertOne{
+		create: cpc,
+	}
+}
+
+type (
+	// ContactPinUpsertOne is the builder for "upsert"-ing
+	//  one ContactPin node.
+	ContactPinUpsertOne struct {
+		create *ContactPinCreate
+	}
+
+	// ContactPinUpsert is the "OnConflict" setter.
+	ContactPinUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ContactPinUpsert) SetExpiresAt(v time.Time) *ContactPinUpsert {
+	u.Set(contactpin.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ContactPinUpsert) UpdateExpiresAt() *ContactPinUpsert {
+	u.SetExcluded(contactpin.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ContactPinUpsert) ClearExpiresAt() *ContactPinUpsert {
+	u.SetNull(contactpin.FieldExpiresAt)
+	return u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *ContactPinUpsert) SetMaxUses(v int) *ContactPinUpsert {
+	u.Set(contactpin.FieldMaxUses, v)
+	return u
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *ContactPinUpsert) UpdateMaxUses() *ContactPinUpsert {
+	u.SetExcluded(contactpin.FieldMaxUses)
+	return u
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *ContactPinUpsert) AddMaxUses(v int) *ContactPinUpsert {
+	u.Add(contactpin.FieldMaxUses, v)
+	return u
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (u *ContactPinUpsert) ClearMaxUses() *ContactPinUpsert {
+	u.SetNull(contactpin.FieldMaxUses)
+	return u
+}
+
+// SetUses sets the "uses" field.
+func (u *ContactPinUpsert) SetUses(v int) *ContactPinUpsert {
+	u.Set(contactpin.FieldUses, v)
+	return u
+}
+
+// UpdateUses sets the "uses" field to the value that was provided on create.
+func (u *ContactPinUpsert) UpdateUses() *ContactPinUpsert {
+	u.SetExcluded(contactpin.FieldUses)
+	return u
+}
+
+// AddUses adds v to the "uses" field.
+func (u *ContactPinUpsert) AddUses(v int) *ContactPinUpsert {
+	u.Add(contactpin.FieldUses, v)
+	return u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *ContactPinUpsert) SetRevokedAt(v time.Time) *ContactPinUpsert {
+	u.Set(contactpin.FieldRevokedAt, v)
+	return u
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *ContactPinUpsert) UpdateRevokedAt() *ContactPinUpsert {
+	u.SetExcluded(contactpin.FieldRevokedAt)
+	return u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *ContactPinUpsert) ClearRevokedAt() *ContactPinUpsert {
+	u.SetNull(contactpin.FieldRevokedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ContactPin.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(contactpin.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ContactPinUpsertOne) UpdateNewValues() *ContactPinUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(contactpin.FieldID)
+		}
+		if _, exists := u.create.mutation.Code(); exists {
+			s.SetIgnore(contactpin.FieldCode)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(contactpin.FieldUserID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(contactpin.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ContactPin.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ContactPinUpsertOne) Ignore() *ContactPinUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ContactPinUpsertOne) DoNothing() *ContactPinUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ContactPinCreate.OnConflict
+// documentation for more info.
+func (u *ContactPinUpsertOne) Update(set func(*ContactPinUpsert)) *ContactPinUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ContactPinUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ContactPinUpsertOne) SetExpiresAt(v time.Time) *ContactPinUpsertOne {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ContactPinUpsertOne) UpdateExpiresAt() *ContactPinUpsertOne {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ContactPinUpsertOne) ClearExpiresAt() *ContactPinUpsertOne {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *ContactPinUpsertOne) SetMaxUses(v int) *ContactPinUpsertOne {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.SetMaxUses(v)
+	})
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *ContactPinUpsertOne) AddMaxUses(v int) *ContactPinUpsertOne {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.AddMaxUses(v)
+	})
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *ContactPinUpsertOne) UpdateMaxUses() *ContactPinUpsertOne {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.UpdateMaxUses()
+	})
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (u *ContactPinUpsertOne) ClearMaxUses() *ContactPinUpsertOne {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.ClearMaxUses()
+	})
+}
+
+// SetUses sets the "uses" field.
+func (u *ContactPinUpsertOne) SetUses(v int) *ContactPinUpsertOne {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.SetUses(v)
+	})
+}
+
+// AddUses adds v to the "uses" field.
+func (u *ContactPinUpsertOne) AddUses(v int) *ContactPinUpsertOne {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.AddUses(v)
+	})
+}
+
+// UpdateUses sets the "uses" field to the value that was provided on create.
+func (u *ContactPinUpsertOne) UpdateUses() *ContactPinUpsertOne {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.UpdateUses()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *ContactPinUpsertOne) SetRevokedAt(v time.Time) *ContactPinUpsertOne {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *ContactPinUpsertOne) UpdateRevokedAt() *ContactPinUpsertOne {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *ContactPinUpsertOne) ClearRevokedAt() *ContactPinUpsertOne {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ContactPinUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ContactPinCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ContactPinUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ContactPinUpsertOne) ID(ctx context.Context) (id pulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ContactPinUpsertOne.ID is not supported by MySQL driver. Use ContactPinUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ContactPinUpsertOne) IDX(ctx context.Context) pulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ContactPinCreateBulk is the builder for creating many ContactPin entities in bulk.
+type ContactPinCreateBulk struct {
+	config
+	err      error
+	builders []*ContactPinCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ContactPin entities in the database.
+func (cpcb *ContactPinCreateBulk) Save(ctx context.Context) ([]*ContactPin, error) {
+	if cpcb.err != nil {
+		return nil, cpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cpcb.builders))
+	nodes := make([]*ContactPin, len(cpcb.builders))
+	mutators := make([]Mutator, len(cpcb.builders))
+	for i := range cpcb.builders {
+		func(i int, root context.Context) {
+			builder := cpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContactPinMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cpcb *ContactPinCreateBulk) SaveX(ctx context.Context) []*ContactPin {
+	v, err := cpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cpcb *ContactPinCreateBulk) Exec(ctx context.Context) error {
+	_, err := cpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpcb *ContactPinCreateBulk) ExecX(ctx context.Context) {
+	if err := cpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ContactPin.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ContactPinUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (cpcb *ContactPinCreateBulk) OnConflict(opts ...sql.ConflictOption) *ContactPinUpsertBulk {
+	cpcb.conflict = opts
+	return &ContactPinUpsertBulk{
+		create: cpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ContactPin.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cpcb *ContactPinCreateBulk) OnConflictColumns(columns ...string) *ContactPinUpsertBulk {
+	cpcb.conflict = append(cpcb.conflict, sql.ConflictColumns(columns...))
+	return &ContactPinUpsertBulk{
+		create: cpcb,
+	}
+}
+
+// ContactPinUpsertBulk is the builder for "upsert"-ing
+// a bulk of ContactPin nodes.
+type ContactPinUpsertBulk struct {
+	create *ContactPinCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ContactPin.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(contactpin.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ContactPinUpsertBulk) UpdateNewValues() *ContactPinUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(contactpin.FieldID)
+			}
+			if _, exists := b.mutation.Code(); exists {
+				s.SetIgnore(contactpin.FieldCode)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(contactpin.FieldUserID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(contactpin.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ContactPin.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ContactPinUpsertBulk) Ignore() *ContactPinUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ContactPinUpsertBulk) DoNothing() *ContactPinUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ContactPinCreateBulk.OnConflict
+// documentation for more info.
+func (u *ContactPinUpsertBulk) Update(set func(*ContactPinUpsert)) *ContactPinUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ContactPinUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ContactPinUpsertBulk) SetExpiresAt(v time.Time) *ContactPinUpsertBulk {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ContactPinUpsertBulk) UpdateExpiresAt() *ContactPinUpsertBulk {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ContactPinUpsertBulk) ClearExpiresAt() *ContactPinUpsertBulk {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *ContactPinUpsertBulk) SetMaxUses(v int) *ContactPinUpsertBulk {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.SetMaxUses(v)
+	})
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *ContactPinUpsertBulk) AddMaxUses(v int) *ContactPinUpsertBulk {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.AddMaxUses(v)
+	})
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *ContactPinUpsertBulk) UpdateMaxUses() *ContactPinUpsertBulk {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.UpdateMaxUses()
+	})
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (u *ContactPinUpsertBulk) ClearMaxUses() *ContactPinUpsertBulk {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.ClearMaxUses()
+	})
+}
+
+// SetUses sets the "uses" field.
+func (u *ContactPinUpsertBulk) SetUses(v int) *ContactPinUpsertBulk {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.SetUses(v)
+	})
+}
+
+// AddUses adds v to the "uses" field.
+func (u *ContactPinUpsertBulk) AddUses(v int) *ContactPinUpsertBulk {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.AddUses(v)
+	})
+}
+
+// UpdateUses sets the "uses" field to the value that was provided on create.
+func (u *ContactPinUpsertBulk) UpdateUses() *ContactPinUpsertBulk {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.UpdateUses()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *ContactPinUpsertBulk) SetRevokedAt(v time.Time) *ContactPinUpsertBulk {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *ContactPinUpsertBulk) UpdateRevokedAt() *ContactPinUpsertBulk {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *ContactPinUpsertBulk) ClearRevokedAt() *ContactPinUpsertBulk {
+	return u.Update(func(s *ContactPinUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ContactPinUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ContactPinCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ContactPinCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ContactPinUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"journeyhub/ent/contactpin"
+	"journeyhub/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ContactPinDelete is the builder for deleting a ContactPin entity.
+type ContactPinDelete struct {
+	config
+	hooks    []Hook
+	mutation *ContactPinMutation
+}
+
+// Where appends a list predicates to the ContactPinDelete builder.
+func (cpd *ContactPinDelete) Where(ps ...predicate.ContactPin) *ContactPinDelete {
+	cpd.mutation.Where(ps...)
+	return cpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cpd *ContactPinDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cpd.sqlExec, cpd.mutation, cpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpd *ContactPinDelete) ExecX(ctx context.Context) int {
+	n, err := cpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cpd *ContactPinDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(contactpin.Table, sqlgraph.NewFieldSpec(contactpin.FieldID, field.TypeString))
+	if ps := cpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cpd.mutation.done = true
+	return affected, err
+}
+
+// ContactPinDeleteOne is the builder for deleting a single ContactPin entity.
+type ContactPinDeleteOne struct {
+	cpd *ContactPinDelete
+}
+
+// Where appends a list predicates to the ContactPinDelete builder.
+func (cpdo *ContactPinDeleteOne) Where(ps ...predicate.ContactPin) *ContactPinDeleteOne {
+	cpdo.cpd.mutation.Where(ps...)
+	return cpdo
+}
+
+// Exec executes the deletion query.
+func (cpdo *ContactPinDeleteOne) Exec(ctx context.Context) error {
+	n, err := cpdo.cpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{contactpin.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpdo *ContactPinDeleteOne) ExecX(ctx context.Context) {
+	if err := cpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"journeyhub/ent/contactpin"
+	"journeyhub/ent/predicate"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ContactPinQuery is the builder for querying ContactPin entities.
+type ContactPinQuery struct {
+	config
+	ctx        *QueryContext
+	order      []contactpin.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ContactPin
+	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
+	loadTotal  []func(context.Context, []*ContactPin) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ContactPinQuery builder.
+func (cpq *ContactPinQuery) Where(ps ...predicate.ContactPin) *ContactPinQuery {
+	cpq.predicates = append(cpq.predicates, ps...)
+	return cpq
+}
+
+// Limit the number of records to be returned by this query.
+func (cpq *ContactPinQuery) Limit(limit int) *ContactPinQuery {
+	cpq.ctx.Limit = &limit
+	return cpq
+}
+
+// Offset to start from.
+func (cpq *ContactPinQuery) Offset(offset int) *ContactPinQuery {
+	cpq.ctx.Offset = &offset
+	return cpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cpq *ContactPinQuery) Unique(unique bool) *ContactPinQuery {
+	cpq.ctx.Unique = &unique
+	return cpq
+}
+
+// Order specifies how the records should be ordered.
+func (cpq *ContactPinQuery) Order(o ...contactpin.OrderOption) *ContactPinQuery {
+	cpq.order = append(cpq.order, o...)
+	return cpq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (cpq *ContactPinQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: cpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contactpin.Table, contactpin.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, contactpin.UserTable, contactpin.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ContactPin entity from the query.
+// Returns a *NotFoundError when no ContactPin was found.
+func (cpq *ContactPinQuery) First(ctx context.Context) (*ContactPin, error) {
+	nodes, err := cpq.Limit(1).All(setContextOp(ctx, cpq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{contactpin.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cpq *ContactPinQuery) FirstX(ctx context.Context) *ContactPin {
+	node, err := cpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ContactPin ID from the query.
+// Returns a *NotFoundError when no ContactPin ID was found.
+func (cpq *ContactPinQuery) FirstID(ctx context.Context) (id pulid.ID, err error) {
+	var ids []pulid.ID
+	if ids, err = cpq.Limit(1).IDs(setContextOp(ctx, cpq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{contactpin.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cpq *ContactPinQuery) FirstIDX(ctx context.Context) pulid.ID {
+	id, err := cpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ContactPin entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ContactPin entity is found.
+// Returns a *NotFoundError when no ContactPin entities are found.
+func (cpq *ContactPinQuery) Only(ctx context.Context) (*ContactPin, error) {
+	nodes, err := cpq.Limit(2).All(setContextOp(ctx, cpq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{contactpin.Label}
+	default:
+		return nil, &NotSingularError{contactpin.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cpq *ContactPinQuery) OnlyX(ctx context.Context) *ContactPin {
+	node, err := cpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ContactPin ID in the query.
+// Returns a *NotSingularError when more than one ContactPin ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cpq *ContactPinQuery) OnlyID(ctx context.Context) (id pulid.ID, err error) {
+	var ids []pulid.ID
+	if ids, err = cpq.Limit(2).IDs(setContextOp(ctx, cpq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{contactpin.Label}
+	default:
+		err = &NotSingularError{contactpin.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cpq *ContactPinQuery) OnlyIDX(ctx context.Context) pulid.ID {
+	id, err := cpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ContactPins.
+func (cpq *ContactPinQuery) All(ctx context.Context) ([]*ContactPin, error) {
+	ctx = setContextOp(ctx, cpq.ctx, ent.OpQueryAll)
+	if err := cpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ContactPin, *ContactPinQuery]()
+	return withInterceptors[[]*ContactPin](ctx, cpq, qr, cpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cpq *ContactPinQuery) AllX(ctx context.Context) []*ContactPin {
+	nodes, err := cpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ContactPin IDs.
+func (cpq *ContactPinQuery) IDs(ctx context.Context) (ids []pulid.ID, err error) {
+	if cpq.ctx.Unique == nil && cpq.path != nil {
+		cpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cpq.ctx, ent.OpQueryIDs)
+	if err = cpq.Select(contactpin.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cpq *ContactPinQuery) IDsX(ctx context.Context) []pulid.ID {
+	ids, err := cpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cpq *ContactPinQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cpq.ctx, ent.OpQueryCount)
+	if err := cpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cpq, querierCount[*ContactPinQuery](), cpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cpq *ContactPinQuery) CountX(ctx context.Context) int {
+	count, err := cpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cpq *ContactPinQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cpq.ctx, ent.OpQueryExist)
+	switch _, err := cpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cpq *ContactPinQuery) ExistX(ctx context.Context) bool {
+	exist, err := cpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ContactPinQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cpq *ContactPinQuery) Clone() *ContactPinQuery {
+	if cpq == nil {
+		return nil
+	}
+	return &ContactPinQuery{
+		config:     cpq.config,
+		ctx:        cpq.ctx.Clone(),
+		order:      append([]contactpin.OrderOption{}, cpq.order...),
+		inters:     append([]Interceptor{}, cpq.inters...),
+		predicates: append([]predicate.ContactPin{}, cpq.predicates...),
+		withUser:   cpq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  cpq.sql.Clone(),
+		path: cpq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (cpq *ContactPinQuery) WithUser(opts ...func(*UserQuery)) *ContactPinQuery {
+	query := (&UserClient{config: cpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cpq.withUser = query
+	return cpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Code string `json:"code,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ContactPin.Query().
+//		GroupBy(contactpin.FieldCode).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cpq *ContactPinQuery) GroupBy(field string, fields ...string) *ContactPinGroupBy {
+	cpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ContactPinGroupBy{build: cpq}
+	grbuild.flds = &cpq.ctx.Fields
+	grbuild.label = contactpin.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Code string `json:"code,omitempty"`
+//	}
+//
+//	client.ContactPin.Query().
+//		Select(contactpin.FieldCode).
+//		Scan(ctx, &v)
+func (cpq *ContactPinQuery) Select(fields ...string) *ContactPinSelect {
+	cpq.ctx.Fields = append(cpq.ctx.Fields, fields...)
+	sbuild := &ContactPinSelect{ContactPinQuery: cpq}
+	sbuild.label = contactpin.Label
+	sbuild.flds, sbuild.scan = &cpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ContactPinSelect configured with the given aggregations.
+func (cpq *ContactPinQuery) Aggregate(fns ...AggregateFunc) *ContactPinSelect {
+	return cpq.Select().Aggregate(fns...)
+}
+
+func (cpq *ContactPinQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cpq.ctx.Fields {
+		if !contactpin.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cpq.path != nil {
+		prev, err := cpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cpq.sql = prev
+	}
+	return nil
+}
+
+func (cpq *ContactPinQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ContactPin, error) {
+	var (
+		nodes       = []*ContactPin{}
+		_spec       = cpq.querySpec()
+		loadedTypes = [1]bool{
+			cpq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ContactPin).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ContactPin{config: cpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(cpq.modifiers) > 0 {
+		_spec.Modifiers = cpq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cpq.withUser; query != nil {
+		if err := cpq.loadUser(ctx, query, nodes, nil,
+			func(n *ContactPin, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range cpq.loadTotal {
+		if err := cpq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cpq *ContactPinQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*ContactPin, init func(*ContactPin), assign func(*ContactPin, *User)) error {
+	ids := make([]pulid.ID, 0, len(nodes))
+	nodeids := make(map[pulid.ID][]*ContactPin)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (cpq *ContactPinQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cpq.querySpec()
+	if len(cpq.modifiers) > 0 {
+		_spec.Modifiers = cpq.modifiers
+	}
+	_spec.Node.Columns = cpq.ctx.Fields
+	if len(cpq.ctx.Fields) > 0 {
+		_spec.Unique = cpq.ctx.Unique != nil && *cpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cpq.driver, _spec)
+}
+
+func (cpq *ContactPinQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(contactpin.Table, contactpin.Columns, sqlgraph.NewFieldSpec(contactpin.FieldID, field.TypeString))
+	_spec.From = cpq.sql
+	if unique := cpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contactpin.FieldID)
+		for i := range fields {
+			if fields[i] != contactpin.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if cpq.withUser != nil {
+			_spec.Node.AddColumnOnce(contactpin.FieldUserID)
+		}
+	}
+	if ps := cpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cpq *ContactPinQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cpq.driver.Dialect())
+	t1 := builder.Table(contactpin.Table)
+	columns := cpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = contactpin.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cpq.sql != nil {
+		selector = cpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cpq.ctx.Unique != nil && *cpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cpq.predicates {
+		p(selector)
+	}
+	for _, p := range cpq.order {
+		p(selector)
+	}
+	if offset := cpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ContactPinGroupBy is the group-by builder for ContactPin entities.
+type ContactPinGroupBy struct {
+	selector
+	build *ContactPinQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cpgb *ContactPinGroupBy) Aggregate(fns ...AggregateFunc) *ContactPinGroupBy {
+	cpgb.fns = append(cpgb.fns, fns...)
+	return cpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cpgb *ContactPinGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cpgb.build.ctx, ent.OpQueryGroupBy)
+	if err := cpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ContactPinQuery, *ContactPinGroupBy](ctx, cpgb.build, cpgb, cpgb.build.inters, v)
+}
+
+func (cpgb *ContactPinGroupBy) sqlScan(ctx context.Context, root *ContactPinQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cpgb.fns))
+	for _, fn := range cpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cpgb.flds)+len(cpgb.fns))
+		for _, f := range *cpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ContactPinSelect is the builder for selecting fields of ContactPin entities.
+type ContactPinSelect struct {
+	*ContactPinQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cps *ContactPinSelect) Aggregate(fns ...AggregateFunc) *ContactPinSelect {
+	cps.fns = append(cps.fns, fns...)
+	return cps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cps *ContactPinSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cps.ctx, ent.OpQuerySelect)
+	if err := cps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ContactPinQuery, *ContactPinSelect](ctx, cps.ContactPinQuery, cps, cps.inters, v)
+}
+
+func (cps *ContactPinSelect) sqlScan(ctx context.Context, root *ContactPinQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cps.fns))
+	for _, fn := range cps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
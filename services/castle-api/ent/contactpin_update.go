@@ -0,0 +1,438 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journeyhub/ent/contactpin"
+	"journeyhub/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ContactPinUpdate is the builder for updating ContactPin entities.
+type ContactPinUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ContactPinMutation
+}
+
+// Where appends a list predicates to the ContactPinUpdate builder.
+func (cpu *ContactPinUpdate) Where(ps ...predicate.ContactPin) *ContactPinUpdate {
+	cpu.mutation.Where(ps...)
+	return cpu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (cpu *ContactPinUpdate) SetExpiresAt(t time.Time) *ContactPinUpdate {
+	cpu.mutation.SetExpiresAt(t)
+	return cpu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cpu *ContactPinUpdate) SetNillableExpiresAt(t *time.Time) *ContactPinUpdate {
+	if t != nil {
+		cpu.SetExpiresAt(*t)
+	}
+	return cpu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (cpu *ContactPinUpdate) ClearExpiresAt() *ContactPinUpdate {
+	cpu.mutation.ClearExpiresAt()
+	return cpu
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (cpu *ContactPinUpdate) SetMaxUses(i int) *ContactPinUpdate {
+	cpu.mutation.ResetMaxUses()
+	cpu.mutation.SetMaxUses(i)
+	return cpu
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (cpu *ContactPinUpdate) SetNillableMaxUses(i *int) *ContactPinUpdate {
+	if i != nil {
+		cpu.SetMaxUses(*i)
+	}
+	return cpu
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (cpu *ContactPinUpdate) AddMaxUses(i int) *ContactPinUpdate {
+	cpu.mutation.AddMaxUses(i)
+	return cpu
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (cpu *ContactPinUpdate) ClearMaxUses() *ContactPinUpdate {
+	cpu.mutation.ClearMaxUses()
+	return cpu
+}
+
+// SetUses sets the "uses" field.
+func (cpu *ContactPinUpdate) SetUses(i int) *ContactPinUpdate {
+	cpu.mutation.ResetUses()
+	cpu.mutation.SetUses(i)
+	return cpu
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (cpu *ContactPinUpdate) SetNillableUses(i *int) *ContactPinUpdate {
+	if i != nil {
+		cpu.SetUses(*i)
+	}
+	return cpu
+}
+
+// AddUses adds i to the "uses" field.
+func (cpu *ContactPinUpdate) AddUses(i int) *ContactPinUpdate {
+	cpu.mutation.AddUses(i)
+	return cpu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (cpu *ContactPinUpdate) SetRevokedAt(t time.Time) *ContactPinUpdate {
+	cpu.mutation.SetRevokedAt(t)
+	return cpu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (cpu *ContactPinUpdate) SetNillableRevokedAt(t *time.Time) *ContactPinUpdate {
+	if t != nil {
+		cpu.SetRevokedAt(*t)
+	}
+	return cpu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (cpu *ContactPinUpdate) ClearRevokedAt() *ContactPinUpdate {
+	cpu.mutation.ClearRevokedAt()
+	return cpu
+}
+
+// Mutation returns the ContactPinMutation object of the builder.
+func (cpu *ContactPinUpdate) Mutation() *ContactPinMutation {
+	return cpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cpu *ContactPinUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cpu.sqlSave, cpu.mutation, cpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cpu *ContactPinUpdate) SaveX(ctx context.Context) int {
+	affected, err := cpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cpu *ContactPinUpdate) Exec(ctx context.Context) error {
+	_, err := cpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpu *ContactPinUpdate) ExecX(ctx context.Context) {
+	if err := cpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cpu *ContactPinUpdate) check() error {
+	if v, ok := cpu.mutation.MaxUses(); ok {
+		if err := contactpin.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "ContactPin.max_uses": %w`, err)}
+		}
+	}
+	if cpu.mutation.UserCleared() && len(cpu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContactPin.user"`)
+	}
+	return nil
+}
+
+func (cpu *ContactPinUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contactpin.Table, contactpin.Columns, sqlgraph.NewFieldSpec(contactpin.FieldID, field.TypeString))
+	if ps := cpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cpu.mutation.ExpiresAt(); ok {
+		_spec.SetField(contactpin.FieldExpiresAt, field.TypeTime, value)
+	}
+	if cpu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(contactpin.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := cpu.mutation.MaxUses(); ok {
+		_spec.SetField(contactpin.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := cpu.mutation.AddedMaxUses(); ok {
+		_spec.AddField(contactpin.FieldMaxUses, field.TypeInt, value)
+	}
+	if cpu.mutation.MaxUsesCleared() {
+		_spec.ClearField(contactpin.FieldMaxUses, field.TypeInt)
+	}
+	if value, ok := cpu.mutation.Uses(); ok {
+		_spec.SetField(contactpin.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := cpu.mutation.AddedUses(); ok {
+		_spec.AddField(contactpin.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := cpu.mutation.RevokedAt(); ok {
+		_spec.SetField(contactpin.FieldRevokedAt, field.TypeTime, value)
+	}
+	if cpu.mutation.RevokedAtCleared() {
+		_spec.ClearField(contactpin.FieldRevokedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contactpin.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cpu.mutation.done = true
+	return n, nil
+}
+
+// ContactPinUpdateOne is the builder for updating a single ContactPin entity.
+type ContactPinUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ContactPinMutation
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (cpuo *ContactPinUpdateOne) SetExpiresAt(t time.Time) *ContactPinUpdateOne {
+	cpuo.mutation.SetExpiresAt(t)
+	return cpuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cpuo *ContactPinUpdateOne) SetNillableExpiresAt(t *time.Time) *ContactPinUpdateOne {
+	if t != nil {
+		cpuo.SetExpiresAt(*t)
+	}
+	return cpuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (cpuo *ContactPinUpdateOne) ClearExpiresAt() *ContactPinUpdateOne {
+	cpuo.mutation.ClearExpiresAt()
+	return cpuo
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (cpuo *ContactPinUpdateOne) SetMaxUses(i int) *ContactPinUpdateOne {
+	cpuo.mutation.ResetMaxUses()
+	cpuo.mutation.SetMaxUses(i)
+	return cpuo
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (cpuo *ContactPinUpdateOne) SetNillableMaxUses(i *int) *ContactPinUpdateOne {
+	if i != nil {
+		cpuo.SetMaxUses(*i)
+	}
+	return cpuo
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (cpuo *ContactPinUpdateOne) AddMaxUses(i int) *ContactPinUpdateOne {
+	cpuo.mutation.AddMaxUses(i)
+	return cpuo
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (cpuo *ContactPinUpdateOne) ClearMaxUses() *ContactPinUpdateOne {
+	cpuo.mutation.ClearMaxUses()
+	return cpuo
+}
+
+// SetUses sets the "uses" field.
+func (cpuo *ContactPinUpdateOne) SetUses(i int) *ContactPinUpdateOne {
+	cpuo.mutation.ResetUses()
+	cpuo.mutation.SetUses(i)
+	return cpuo
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (cpuo *ContactPinUpdateOne) SetNillableUses(i *int) *ContactPinUpdateOne {
+	if i != nil {
+		cpuo.SetUses(*i)
+	}
+	return cpuo
+}
+
+// AddUses adds i to the "uses" field.
+func (cpuo *ContactPinUpdateOne) AddUses(i int) *ContactPinUpdateOne {
+	cpuo.mutation.AddUses(i)
+	return cpuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (cpuo *ContactPinUpdateOne) SetRevokedAt(t time.Time) *ContactPinUpdateOne {
+	cpuo.mutation.SetRevokedAt(t)
+	return cpuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (cpuo *ContactPinUpdateOne) SetNillableRevokedAt(t *time.Time) *ContactPinUpdateOne {
+	if t != nil {
+		cpuo.SetRevokedAt(*t)
+	}
+	return cpuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (cpuo *ContactPinUpdateOne) ClearRevokedAt() *ContactPinUpdateOne {
+	cpuo.mutation.ClearRevokedAt()
+	return cpuo
+}
+
+// Mutation returns the ContactPinMutation object of the builder.
+func (cpuo *ContactPinUpdateOne) Mutation() *ContactPinMutation {
+	return cpuo.mutation
+}
+
+// Where appends a list predicates to the ContactPinUpdate builder.
+func (cpuo *ContactPinUpdateOne) Where(ps ...predicate.ContactPin) *ContactPinUpdateOne {
+	cpuo.mutation.Where(ps...)
+	return cpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cpuo *ContactPinUpdateOne) Select(field string, fields ...string) *ContactPinUpdateOne {
+	cpuo.fields = append([]string{field}, fields...)
+	return cpuo
+}
+
+// Save executes the query and returns the updated ContactPin entity.
+func (cpuo *ContactPinUpdateOne) Save(ctx context.Context) (*ContactPin, error) {
+	return withHooks(ctx, cpuo.sqlSave, cpuo.mutation, cpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cpuo *ContactPinUpdateOne) SaveX(ctx context.Context) *ContactPin {
+	node, err := cpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cpuo *ContactPinUpdateOne) Exec(ctx context.Context) error {
+	_, err := cpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpuo *ContactPinUpdateOne) ExecX(ctx context.Context) {
+	if err := cpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cpuo *ContactPinUpdateOne) check() error {
+	if v, ok := cpuo.mutation.MaxUses(); ok {
+		if err := contactpin.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "ContactPin.max_uses": %w`, err)}
+		}
+	}
+	if cpuo.mutation.UserCleared() && len(cpuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContactPin.user"`)
+	}
+	return nil
+}
+
+func (cpuo *ContactPinUpdateOne) sqlSave(ctx context.Context) (_node *ContactPin, err error) {
+	if err := cpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contactpin.Table, contactpin.Columns, sqlgraph.NewFieldSpec(contactpin.FieldID, field.TypeString))
+	id, ok := cpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ContactPin.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contactpin.FieldID)
+		for _, f := range fields {
+			if !contactpin.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != contactpin.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cpuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(contactpin.FieldExpiresAt, field.TypeTime, value)
+	}
+	if cpuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(contactpin.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := cpuo.mutation.MaxUses(); ok {
+		_spec.SetField(contactpin.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := cpuo.mutation.AddedMaxUses(); ok {
+		_spec.AddField(contactpin.FieldMaxUses, field.TypeInt, value)
+	}
+	if cpuo.mutation.MaxUsesCleared() {
+		_spec.ClearField(contactpin.FieldMaxUses, field.TypeInt)
+	}
+	if value, ok := cpuo.mutation.Uses(); ok {
+		_spec.SetField(contactpin.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := cpuo.mutation.AddedUses(); ok {
+		_spec.AddField(contactpin.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := cpuo.mutation.RevokedAt(); ok {
+		_spec.SetField(contactpin.FieldRevokedAt, field.TypeTime, value)
+	}
+	if cpuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(contactpin.FieldRevokedAt, field.TypeTime)
+	}
+	_node = &ContactPin{config: cpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contactpin.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cpuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"journeyhub/ent/contactrequest"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ContactRequest is the model entity for the ContactRequest schema.
+type ContactRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID pulid.ID `json:"id,omitempty"`
+	// SenderID holds the value of the "sender_id" field.
+	SenderID pulid.ID `json:"sender_id,omitempty"`
+	// ReceiverID holds the value of the "receiver_id" field.
+	ReceiverID pulid.ID `json:"receiver_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status contactrequest.Status `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ContactRequestQuery when eager-loading is set.
+	Edges        ContactRequestEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ContactRequestEdges holds the relations/edges for other nodes in the graph.
+type ContactRequestEdges struct {
+	// Sender holds the value of the sender edge.
+	Sender *User `json:"sender,omitempty"`
+	// Receiver holds the value of the receiver edge.
+	Receiver *User `json:"receiver,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+}
+
+// SenderOrErr returns the Sender value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContactRequestEdges) SenderOrErr() (*User, error) {
+	if e.Sender != nil {
+		return e.Sender, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "sender"}
+}
+
+// ReceiverOrErr returns the Receiver value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContactRequestEdges) ReceiverOrErr() (*User, error) {
+	if e.Receiver != nil {
+		return e.Receiver, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "receiver"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ContactRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case contactrequest.FieldID, contactrequest.FieldSenderID, contactrequest.FieldReceiverID:
+			values[i] = new(pulid.ID)
+		case contactrequest.FieldStatus:
+			values[i] = new(sql.NullString)
+		case contactrequest.FieldCreatedAt, contactrequest.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ContactRequest fields.
+func (cr *ContactRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case contactrequest.FieldID:
+			if value, ok := values[i].(*pulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cr.ID = *value
+			}
+		case contactrequest.FieldSenderID:
+			if value, ok := values[i].(*pulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_id", values[i])
+			} else if value != nil {
+				cr.SenderID = *value
+			}
+		case contactrequest.FieldReceiverID:
+			if value, ok := values[i].(*pulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field receiver_id", values[i])
+			} else if value != nil {
+				cr.ReceiverID = *value
+			}
+		case contactrequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				cr.Status = contactrequest.Status(value.String)
+			}
+		case contactrequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cr.CreatedAt = value.Time
+			}
+		case contactrequest.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				cr.UpdatedAt = value.Time
+			}
+		default:
+			cr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ContactRequest.
+// This includes values selected through modifiers, order, etc.
+func (cr *ContactRequest) Value(name string) (ent.Value, error) {
+	return cr.selectValues.Get(name)
+}
+
+// QuerySender queries the "sender" edge of the ContactRequest entity.
+func (cr *ContactRequest) QuerySender() *UserQuery {
+	return NewContactRequestClient(cr.config).QuerySender(cr)
+}
+
+// QueryReceiver queries the "receiver" edge of the ContactRequest entity.
+func (cr *ContactRequest) QueryReceiver() *UserQuery {
+	return NewContactRequestClient(cr.config).QueryReceiver(cr)
+}
+
+// Update returns a builder for updating this ContactRequest.
+// Note that you need to call ContactRequest.Unwrap() before calling this method if this ContactRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cr *ContactRequest) Update() *ContactRequestUpdateOne {
+	return NewContactRequestClient(cr.config).UpdateOne(cr)
+}
+
+// Unwrap unwraps the ContactRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cr *ContactRequest) Unwrap() *ContactRequest {
+	_tx, ok := cr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ContactRequest is not a transactional entity")
+	}
+	cr.config.driver = _tx.drv
+	return cr
+}
+
+// String implements the fmt.Stringer.
+func (cr *ContactRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("ContactRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cr.ID))
+	builder.WriteString("sender_id=")
+	builder.WriteString(fmt.Sprintf("%v", cr.SenderID))
+	builder.WriteString(", ")
+	builder.WriteString("receiver_id=")
+	builder.WriteString(fmt.Sprintf("%v", cr.ReceiverID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", cr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(cr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ContactRequests is a parsable slice of ContactRequest.
+type ContactRequests []*ContactRequest
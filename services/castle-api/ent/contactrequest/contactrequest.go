@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package contactrequest
+
+import (
+	"fmt"
+	"io"
+	"journeyhub/ent/schema/pulid"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the contactrequest type in the database.
+	Label = "contact_request"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSenderID holds the string denoting the sender_id field in the database.
+	FieldSenderID = "sender_id"
+	// FieldReceiverID holds the string denoting the receiver_id field in the database.
+	FieldReceiverID = "receiver_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeSender holds the string denoting the sender edge name in mutations.
+	EdgeSender = "sender"
+	// EdgeReceiver holds the string denoting the receiver edge name in mutations.
+	EdgeReceiver = "receiver"
+	// Table holds the table name of the contactrequest in the database.
+	Table = "contact_requests"
+	// SenderTable is the table that holds the sender relation/edge.
+	SenderTable = "contact_requests"
+	// SenderInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	SenderInverseTable = "users"
+	// SenderColumn is the table column denoting the sender relation/edge.
+	SenderColumn = "sender_id"
+	// ReceiverTable is the table that holds the receiver relation/edge.
+	ReceiverTable = "contact_requests"
+	// ReceiverInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ReceiverInverseTable = "users"
+	// ReceiverColumn is the table column denoting the receiver relation/edge.
+	ReceiverColumn = "receiver_id"
+)
+
+// Columns holds all SQL columns for contactrequest fields.
+var Columns = []string{
+	FieldID,
+	FieldSenderID,
+	FieldReceiverID,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() pulid.ID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending  Status = "Pending"
+	StatusAccepted Status = "Accepted"
+	StatusDeclined Status = "Declined"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusAccepted, StatusDeclined:
+		return nil
+	default:
+		return fmt.Errorf("contactrequest: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ContactRequest queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySenderID orders the results by the sender_id field.
+func BySenderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSenderID, opts...).ToFunc()
+}
+
+// ByReceiverID orders the results by the receiver_id field.
+func ByReceiverID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceiverID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// BySenderField orders the results by sender field.
+func BySenderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSenderStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByReceiverField orders the results by receiver field.
+func ByReceiverField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReceiverStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSenderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SenderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, SenderTable, SenderColumn),
+	)
+}
+func newReceiverStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReceiverInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ReceiverTable, ReceiverColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Status) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Status) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Status(str)
+	if err := StatusValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Status", str)
+	}
+	return nil
+}
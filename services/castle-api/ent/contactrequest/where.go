@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package contactrequest
+
+import (
+	"journeyhub/ent/predicate"
+	"journeyhub/ent/schema/pulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldLTE(FieldID, id))
+}
+
+// SenderID applies equality check predicate on the "sender_id" field. It's identical to SenderIDEQ.
+func SenderID(v pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldEQ(FieldSenderID, v))
+}
+
+// ReceiverID applies equality check predicate on the "receiver_id" field. It's identical to ReceiverIDEQ.
+func ReceiverID(v pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldEQ(FieldReceiverID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// SenderIDEQ applies the EQ predicate on the "sender_id" field.
+func SenderIDEQ(v pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldEQ(FieldSenderID, v))
+}
+
+// SenderIDNEQ applies the NEQ predicate on the "sender_id" field.
+func SenderIDNEQ(v pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldNEQ(FieldSenderID, v))
+}
+
+// SenderIDIn applies the In predicate on the "sender_id" field.
+func SenderIDIn(vs ...pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldIn(FieldSenderID, vs...))
+}
+
+// SenderIDNotIn applies the NotIn predicate on the "sender_id" field.
+func SenderIDNotIn(vs ...pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldNotIn(FieldSenderID, vs...))
+}
+
+// SenderIDGT applies the GT predicate on the "sender_id" field.
+func SenderIDGT(v pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldGT(FieldSenderID, v))
+}
+
+// SenderIDGTE applies the GTE predicate on the "sender_id" field.
+func SenderIDGTE(v pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldGTE(FieldSenderID, v))
+}
+
+// SenderIDLT applies the LT predicate on the "sender_id" field.
+func SenderIDLT(v pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldLT(FieldSenderID, v))
+}
+
+// SenderIDLTE applies the LTE predicate on the "sender_id" field.
+func SenderIDLTE(v pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldLTE(FieldSenderID, v))
+}
+
+// SenderIDContains applies the Contains predicate on the "sender_id" field.
+func SenderIDContains(v pulid.ID) predicate.ContactRequest {
+	vc := string(v)
+	return predicate.ContactRequest(sql.FieldContains(FieldSenderID, vc))
+}
+
+// SenderIDHasPrefix applies the HasPrefix predicate on the "sender_id" field.
+func SenderIDHasPrefix(v pulid.ID) predicate.ContactRequest {
+	vc := string(v)
+	return predicate.ContactRequest(sql.FieldHasPrefix(FieldSenderID, vc))
+}
+
+// SenderIDHasSuffix applies the HasSuffix predicate on the "sender_id" field.
+func SenderIDHasSuffix(v pulid.ID) predicate.ContactRequest {
+	vc := string(v)
+	return predicate.ContactRequest(sql.FieldHasSuffix(FieldSenderID, vc))
+}
+
+// SenderIDEqualFold applies the EqualFold predicate on the "sender_id" field.
+func SenderIDEqualFold(v pulid.ID) predicate.ContactRequest {
+	vc := string(v)
+	return predicate.ContactRequest(sql.FieldEqualFold(FieldSenderID, vc))
+}
+
+// SenderIDContainsFold applies the ContainsFold predicate on the "sender_id" field.
+func SenderIDContainsFold(v pulid.ID) predicate.ContactRequest {
+	vc := string(v)
+	return predicate.ContactRequest(sql.FieldContainsFold(FieldSenderID, vc))
+}
+
+// ReceiverIDEQ applies the EQ predicate on the "receiver_id" field.
+func ReceiverIDEQ(v pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldEQ(FieldReceiverID, v))
+}
+
+// ReceiverIDNEQ applies the NEQ predicate on the "receiver_id" field.
+func ReceiverIDNEQ(v pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldNEQ(FieldReceiverID, v))
+}
+
+// ReceiverIDIn applies the In predicate on the "receiver_id" field.
+func ReceiverIDIn(vs ...pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldIn(FieldReceiverID, vs...))
+}
+
+// ReceiverIDNotIn applies the NotIn predicate on the "receiver_id" field.
+func ReceiverIDNotIn(vs ...pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldNotIn(FieldReceiverID, vs...))
+}
+
+// ReceiverIDGT applies the GT predicate on the "receiver_id" field.
+func ReceiverIDGT(v pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldGT(FieldReceiverID, v))
+}
+
+// ReceiverIDGTE applies the GTE predicate on the "receiver_id" field.
+func ReceiverIDGTE(v pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldGTE(FieldReceiverID, v))
+}
+
+// ReceiverIDLT applies the LT predicate on the "receiver_id" field.
+func ReceiverIDLT(v pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldLT(FieldReceiverID, v))
+}
+
+// ReceiverIDLTE applies the LTE predicate on the "receiver_id" field.
+func ReceiverIDLTE(v pulid.ID) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldLTE(FieldReceiverID, v))
+}
+
+// ReceiverIDContains applies the Contains predicate on the "receiver_id" field.
+func ReceiverIDContains(v pulid.ID) predicate.ContactRequest {
+	vc := string(v)
+	return predicate.ContactRequest(sql.FieldContains(FieldReceiverID, vc))
+}
+
+// ReceiverIDHasPrefix applies the HasPrefix predicate on the "receiver_id" field.
+func ReceiverIDHasPrefix(v pulid.ID) predicate.ContactRequest {
+	vc := string(v)
+	return predicate.ContactRequest(sql.FieldHasPrefix(FieldReceiverID, vc))
+}
+
+// ReceiverIDHasSuffix applies the HasSuffix predicate on the "receiver_id" field.
+func ReceiverIDHasSuffix(v pulid.ID) predicate.ContactRequest {
+	vc := string(v)
+	return predicate.ContactRequest(sql.FieldHasSuffix(FieldReceiverID, vc))
+}
+
+// ReceiverIDEqualFold applies the EqualFold predicate on the "receiver_id" field.
+func ReceiverIDEqualFold(v pulid.ID) predicate.ContactRequest {
+	vc := string(v)
+	return predicate.ContactRequest(sql.FieldEqualFold(FieldReceiverID, vc))
+}
+
+// ReceiverIDContainsFold applies the ContainsFold predicate on the "receiver_id" field.
+func ReceiverIDContainsFold(v pulid.ID) predicate.ContactRequest {
+	vc := string(v)
+	return predicate.ContactRequest(sql.FieldContainsFold(FieldReceiverID, vc))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasSender applies the HasEdge predicate on the "sender" edge.
+func HasSender() predicate.ContactRequest {
+	return predicate.ContactRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, SenderTable, SenderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSenderWith applies the HasEdge predicate on the "sender" edge with a given conditions (other predicates).
+func HasSenderWith(preds ...predicate.User) predicate.ContactRequest {
+	return predicate.ContactRequest(func(s *sql.Selector) {
+		step := newSenderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReceiver applies the HasEdge predicate on the "receiver" edge.
+func HasReceiver() predicate.ContactRequest {
+	return predicate.ContactRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ReceiverTable, ReceiverColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReceiverWith applies the HasEdge predicate on the "receiver" edge with a given conditions (other predicates).
+func HasReceiverWith(preds ...predicate.User) predicate.ContactRequest {
+	return predicate.ContactRequest(func(s *sql.Selector) {
+		step := newReceiverStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ContactRequest) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ContactRequest) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ContactRequest) predicate.ContactRequest {
+	return predicate.ContactRequest(sql.NotPredicates(p))
+}
@@ -0,0 +1,737 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journeyhub/ent/contactrequest"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ContactRequestCreate is the builder for creating a ContactRequest entity.
+type ContactRequestCreate struct {
+	config
+	mutation *ContactRequestMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetSenderID sets the "sender_id" field.
+func (crc *ContactRequestCreate) SetSenderID(pu pulid.ID) *ContactRequestCreate {
+	crc.mutation.SetSenderID(pu)
+	return crc
+}
+
+// SetReceiverID sets the "receiver_id" field.
+func (crc *ContactRequestCreate) SetReceiverID(pu pulid.ID) *ContactRequestCreate {
+	crc.mutation.SetReceiverID(pu)
+	return crc
+}
+
+// SetStatus sets the "status" field.
+func (crc *ContactRequestCreate) SetStatus(c contactrequest.Status) *ContactRequestCreate {
+	crc.mutation.SetStatus(c)
+	return crc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (crc *ContactRequestCreate) SetNillableStatus(c *contactrequest.Status) *ContactRequestCreate {
+	if c != nil {
+		crc.SetStatus(*c)
+	}
+	return crc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (crc *ContactRequestCreate) SetCreatedAt(t time.Time) *ContactRequestCreate {
+	crc.mutation.SetCreatedAt(t)
+	return crc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (crc *ContactRequestCreate) SetNillableCreatedAt(t *time.Time) *ContactRequestCreate {
+	if t != nil {
+		crc.SetCreatedAt(*t)
+	}
+	return crc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (crc *ContactRequestCreate) SetUpdatedAt(t time.Time) *ContactRequestCreate {
+	crc.mutation.SetUpdatedAt(t)
+	return crc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (crc *ContactRequestCreate) SetNillableUpdatedAt(t *time.Time) *ContactRequestCreate {
+	if t != nil {
+		crc.SetUpdatedAt(*t)
+	}
+	return crc
+}
+
+// SetID sets the "id" field.
+func (crc *ContactRequestCreate) SetID(pu pulid.ID) *ContactRequestCreate {
+	crc.mutation.SetID(pu)
+	return crc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (crc *ContactRequestCreate) SetNillableID(pu *pulid.ID) *ContactRequestCreate {
+	if pu != nil {
+		crc.SetID(*pu)
+	}
+	return crc
+}
+
+// SetSender sets the "sender" edge to the User entity.
+func (crc *ContactRequestCreate) SetSender(u *User) *ContactRequestCreate {
+	return crc.SetSenderID(u.ID)
+}
+
+// SetReceiver sets the "receiver" edge to the User entity.
+func (crc *ContactRequestCreate) SetReceiver(u *User) *ContactRequestCreate {
+	return crc.SetReceiverID(u.ID)
+}
+
+// Mutation returns the ContactRequestMutation object of the builder.
+func (crc *ContactRequestCreate) Mutation() *ContactRequestMutation {
+	return crc.mutation
+}
+
+// Save creates the ContactRequest in the database.
+func (crc *ContactRequestCreate) Save(ctx context.Context) (*ContactRequest, error) {
+	crc.defaults()
+	return withHooks(ctx, crc.sqlSave, crc.mutation, crc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (crc *ContactRequestCreate) SaveX(ctx context.Context) *ContactRequest {
+	v, err := crc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (crc *ContactRequestCreate) Exec(ctx context.Context) error {
+	_, err := crc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crc *ContactRequestCreate) ExecX(ctx context.Context) {
+	if err := crc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (crc *ContactRequestCreate) defaults() {
+	if _, ok := crc.mutation.Status(); !ok {
+		v := contactrequest.DefaultStatus
+		crc.mutation.SetStatus(v)
+	}
+	if _, ok := crc.mutation.CreatedAt(); !ok {
+		v := contactrequest.DefaultCreatedAt()
+		crc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := crc.mutation.UpdatedAt(); !ok {
+		v := contactrequest.DefaultUpdatedAt()
+		crc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := crc.mutation.ID(); !ok {
+		v := contactrequest.DefaultID()
+		crc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (crc *ContactRequestCreate) check() error {
+	if _, ok := crc.mutation.SenderID(); !ok {
+		return &ValidationError{Name: "sender_id", err: errors.New(`ent: missing required field "ContactRequest.sender_id"`)}
+	}
+	if _, ok := crc.mutation.ReceiverID(); !ok {
+		return &ValidationError{Name: "receiver_id", err: errors.New(`ent: missing required field "ContactRequest.receiver_id"`)}
+	}
+	if _, ok := crc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ContactRequest.status"`)}
+	}
+	if v, ok := crc.mutation.Status(); ok {
+		if err := contactrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ContactRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := crc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ContactRequest.created_at"`)}
+	}
+	if _, ok := crc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ContactRequest.updated_at"`)}
+	}
+	if len(crc.mutation.SenderIDs()) == 0 {
+		return &ValidationError{Name: "sender", err: errors.New(`ent: missing required edge "ContactRequest.sender"`)}
+	}
+	if len(crc.mutation.ReceiverIDs()) == 0 {
+		return &ValidationError{Name: "receiver", err: errors.New(`ent: missing required edge "ContactRequest.receiver"`)}
+	}
+	return nil
+}
+
+func (crc *ContactRequestCreate) sqlSave(ctx context.Context) (*ContactRequest, error) {
+	if err := crc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := crc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, crc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*pulid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	crc.mutation.id = &_node.ID
+	crc.mutation.done = true
+	return _node, nil
+}
+
+func (crc *ContactRequestCreate) createSpec() (*ContactRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ContactRequest{config: crc.config}
+		_spec = sqlgraph.NewCreateSpec(contactrequest.Table, sqlgraph.NewFieldSpec(contactrequest.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = crc.conflict
+	if id, ok := crc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := crc.mutation.Status(); ok {
+		_spec.SetField(contactrequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := crc.mutation.CreatedAt(); ok {
+		_spec.SetField(contactrequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := crc.mutation.UpdatedAt(); ok {
+		_spec.SetField(contactrequest.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := crc.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   contactrequest.SenderTable,
+			Columns: []string{contactrequest.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SenderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := crc.mutation.ReceiverIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   contactrequest.ReceiverTable,
+			Columns: []string{contactrequest.ReceiverColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReceiverID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ContactRequest.Create().
+//		SetSenderID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ContactRequestUpsert) {
+//			SetSenderID(v+v).
+//		}).
+//		Exec(ctx)
+func (crc *ContactRequestCreate) OnConflict(opts ...sql.ConflictOption) *ContactRequestUpsertOne {
+	crc.conflict = opts
+	return &ContactRequestUpsertOne{
+		create: crc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ContactRequest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (crc *ContactRequestCreate) OnConflictColumns(columns ...string) *ContactRequestUpsertOne {
+	crc.conflict = append(crc.conflict, sql.ConflictColumns(columns...))
+	return &ContactRequestUpsertOne{
+		create: crc,
+	}
+}
+
+type (
+	// ContactRequestUpsertOne is the builder for "upsert"-ing
+	//  one ContactRequest node.
+	ContactRequestUpsertOne struct {
+		create *ContactRequestCreate
+	}
+
+	// ContactRequestUpsert is the "OnConflict" setter.
+	ContactRequestUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *ContactRequestUpsert) SetStatus(v contactrequest.Status) *ContactRequestUpsert {
+	u.Set(contactrequest.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ContactRequestUpsert) UpdateStatus() *ContactRequestUpsert {
+	u.SetExcluded(contactrequest.FieldStatus)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ContactRequestUpsert) SetCreatedAt(v time.Time) *ContactRequestUpsert {
+	u.Set(contactrequest.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ContactRequestUpsert) UpdateCreatedAt() *ContactRequestUpsert {
+	u.SetExcluded(contactrequest.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ContactRequestUpsert) SetUpdatedAt(v time.Time) *ContactRequestUpsert {
+	u.Set(contactrequest.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ContactRequestUpsert) UpdateUpdatedAt() *ContactRequestUpsert {
+	u.SetExcluded(contactrequest.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ContactRequest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(contactrequest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ContactRequestUpsertOne) UpdateNewValues() *ContactRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(contactrequest.FieldID)
+		}
+		if _, exists := u.create.mutation.SenderID(); exists {
+			s.SetIgnore(contactrequest.FieldSenderID)
+		}
+		if _, exists := u.create.mutation.ReceiverID(); exists {
+			s.SetIgnore(contactrequest.FieldReceiverID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ContactRequest.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ContactRequestUpsertOne) Ignore() *ContactRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ContactRequestUpsertOne) DoNothing() *ContactRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ContactRequestCreate.OnConflict
+// documentation for more info.
+func (u *ContactRequestUpsertOne) Update(set func(*ContactRequestUpsert)) *ContactRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ContactRequestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ContactRequestUpsertOne) SetStatus(v contactrequest.Status) *ContactRequestUpsertOne {
+	return u.Update(func(s *ContactRequestUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ContactRequestUpsertOne) UpdateStatus() *ContactRequestUpsertOne {
+	return u.Update(func(s *ContactRequestUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ContactRequestUpsertOne) SetCreatedAt(v time.Time) *ContactRequestUpsertOne {
+	return u.Update(func(s *ContactRequestUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ContactRequestUpsertOne) UpdateCreatedAt() *ContactRequestUpsertOne {
+	return u.Update(func(s *ContactRequestUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ContactRequestUpsertOne) SetUpdatedAt(v time.Time) *ContactRequestUpsertOne {
+	return u.Update(func(s *ContactRequestUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ContactRequestUpsertOne) UpdateUpdatedAt() *ContactRequestUpsertOne {
+	return u.Update(func(s *ContactRequestUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ContactRequestUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ContactRequestCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ContactRequestUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ContactRequestUpsertOne) ID(ctx context.Context) (id pulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ContactRequestUpsertOne.ID is not supported by MySQL driver. Use ContactRequestUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ContactRequestUpsertOne) IDX(ctx context.Context) pulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ContactRequestCreateBulk is the builder for creating many ContactRequest entities in bulk.
+type ContactRequestCreateBulk struct {
+	config
+	err      error
+	builders []*ContactRequestCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ContactRequest entities in the database.
+func (crcb *ContactRequestCreateBulk) Save(ctx context.Context) ([]*ContactRequest, error) {
+	if crcb.err != nil {
+		return nil, crcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(crcb.builders))
+	nodes := make([]*ContactRequest, len(crcb.builders))
+	mutators := make([]Mutator, len(crcb.builders))
+	for i := range crcb.builders {
+		func(i int, root context.Context) {
+			builder := crcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContactRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, crcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = crcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, crcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, crcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (crcb *ContactRequestCreateBulk) SaveX(ctx context.Context) []*ContactRequest {
+	v, err := crcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (crcb *ContactRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := crcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crcb *ContactRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := crcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ContactRequest.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ContactRequestUpsert) {
+//			SetSenderID(v+v).
+//		}).
+//		Exec(ctx)
+func (crcb *ContactRequestCreateBulk) OnConflict(opts ...sql.ConflictOption) *ContactRequestUpsertBulk {
+	crcb.conflict = opts
+	return &ContactRequestUpsertBulk{
+		create: crcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ContactRequest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (crcb *ContactRequestCreateBulk) OnConflictColumns(columns ...string) *ContactRequestUpsertBulk {
+	crcb.conflict = append(crcb.conflict, sql.ConflictColumns(columns...))
+	return &ContactRequestUpsertBulk{
+		create: crcb,
+	}
+}
+
+// ContactRequestUpsertBulk is the builder for "upsert"-ing
+// a bulk of ContactRequest nodes.
+type ContactRequestUpsertBulk struct {
+	create *ContactRequestCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ContactRequest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(contactrequest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ContactRequestUpsertBulk) UpdateNewValues() *ContactRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(contactrequest.FieldID)
+			}
+			if _, exists := b.mutation.SenderID(); exists {
+				s.SetIgnore(contactrequest.FieldSenderID)
+			}
+			if _, exists := b.mutation.ReceiverID(); exists {
+				s.SetIgnore(contactrequest.FieldReceiverID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ContactRequest.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ContactRequestUpsertBulk) Ignore() *ContactRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ContactRequestUpsertBulk) DoNothing() *ContactRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ContactRequestCreateBulk.OnConflict
+// documentation for more info.
+func (u *ContactRequestUpsertBulk) Update(set func(*ContactRequestUpsert)) *ContactRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ContactRequestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ContactRequestUpsertBulk) SetStatus(v contactrequest.Status) *ContactRequestUpsertBulk {
+	return u.Update(func(s *ContactRequestUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ContactRequestUpsertBulk) UpdateStatus() *ContactRequestUpsertBulk {
+	return u.Update(func(s *ContactRequestUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ContactRequestUpsertBulk) SetCreatedAt(v time.Time) *ContactRequestUpsertBulk {
+	return u.Update(func(s *ContactRequestUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ContactRequestUpsertBulk) UpdateCreatedAt() *ContactRequestUpsertBulk {
+	return u.Update(func(s *ContactRequestUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ContactRequestUpsertBulk) SetUpdatedAt(v time.Time) *ContactRequestUpsertBulk {
+	return u.Update(func(s *ContactRequestUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ContactRequestUpsertBulk) UpdateUpdatedAt() *ContactRequestUpsertBulk {
+	return u.Update(func(s *ContactRequestUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ContactRequestUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ContactRequestCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ContactRequestCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ContactRequestUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"journeyhub/ent/contactrequest"
+	"journeyhub/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ContactRequestDelete is the builder for deleting a ContactRequest entity.
+type ContactRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *ContactRequestMutation
+}
+
+// Where appends a list predicates to the ContactRequestDelete builder.
+func (crd *ContactRequestDelete) Where(ps ...predicate.ContactRequest) *ContactRequestDelete {
+	crd.mutation.Where(ps...)
+	return crd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (crd *ContactRequestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, crd.sqlExec, crd.mutation, crd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crd *ContactRequestDelete) ExecX(ctx context.Context) int {
+	n, err := crd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (crd *ContactRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(contactrequest.Table, sqlgraph.NewFieldSpec(contactrequest.FieldID, field.TypeString))
+	if ps := crd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, crd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	crd.mutation.done = true
+	return affected, err
+}
+
+// ContactRequestDeleteOne is the builder for deleting a single ContactRequest entity.
+type ContactRequestDeleteOne struct {
+	crd *ContactRequestDelete
+}
+
+// Where appends a list predicates to the ContactRequestDelete builder.
+func (crdo *ContactRequestDeleteOne) Where(ps ...predicate.ContactRequest) *ContactRequestDeleteOne {
+	crdo.crd.mutation.Where(ps...)
+	return crdo
+}
+
+// Exec executes the deletion query.
+func (crdo *ContactRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := crdo.crd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{contactrequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crdo *ContactRequestDeleteOne) ExecX(ctx context.Context) {
+	if err := crdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,694 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"journeyhub/ent/contactrequest"
+	"journeyhub/ent/predicate"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ContactRequestQuery is the builder for querying ContactRequest entities.
+type ContactRequestQuery struct {
+	config
+	ctx          *QueryContext
+	order        []contactrequest.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.ContactRequest
+	withSender   *UserQuery
+	withReceiver *UserQuery
+	modifiers    []func(*sql.Selector)
+	loadTotal    []func(context.Context, []*ContactRequest) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ContactRequestQuery builder.
+func (crq *ContactRequestQuery) Where(ps ...predicate.ContactRequest) *ContactRequestQuery {
+	crq.predicates = append(crq.predicates, ps...)
+	return crq
+}
+
+// Limit the number of records to be returned by this query.
+func (crq *ContactRequestQuery) Limit(limit int) *ContactRequestQuery {
+	crq.ctx.Limit = &limit
+	return crq
+}
+
+// Offset to start from.
+func (crq *ContactRequestQuery) Offset(offset int) *ContactRequestQuery {
+	crq.ctx.Offset = &offset
+	return crq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (crq *ContactRequestQuery) Unique(unique bool) *ContactRequestQuery {
+	crq.ctx.Unique = &unique
+	return crq
+}
+
+// Order specifies how the records should be ordered.
+func (crq *ContactRequestQuery) Order(o ...contactrequest.OrderOption) *ContactRequestQuery {
+	crq.order = append(crq.order, o...)
+	return crq
+}
+
+// QuerySender chains the current query on the "sender" edge.
+func (crq *ContactRequestQuery) QuerySender() *UserQuery {
+	query := (&UserClient{config: crq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := crq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := crq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contactrequest.Table, contactrequest.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, contactrequest.SenderTable, contactrequest.SenderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(crq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReceiver chains the current query on the "receiver" edge.
+func (crq *ContactRequestQuery) QueryReceiver() *UserQuery {
+	query := (&UserClient{config: crq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := crq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := crq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contactrequest.Table, contactrequest.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, contactrequest.ReceiverTable, contactrequest.ReceiverColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(crq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ContactRequest entity from the query.
+// Returns a *NotFoundError when no ContactRequest was found.
+func (crq *ContactRequestQuery) First(ctx context.Context) (*ContactRequest, error) {
+	nodes, err := crq.Limit(1).All(setContextOp(ctx, crq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{contactrequest.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (crq *ContactRequestQuery) FirstX(ctx context.Context) *ContactRequest {
+	node, err := crq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ContactRequest ID from the query.
+// Returns a *NotFoundError when no ContactRequest ID was found.
+func (crq *ContactRequestQuery) FirstID(ctx context.Context) (id pulid.ID, err error) {
+	var ids []pulid.ID
+	if ids, err = crq.Limit(1).IDs(setContextOp(ctx, crq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{contactrequest.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (crq *ContactRequestQuery) FirstIDX(ctx context.Context) pulid.ID {
+	id, err := crq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ContactRequest entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ContactRequest entity is found.
+// Returns a *NotFoundError when no ContactRequest entities are found.
+func (crq *ContactRequestQuery) Only(ctx context.Context) (*ContactRequest, error) {
+	nodes, err := crq.Limit(2).All(setContextOp(ctx, crq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{contactrequest.Label}
+	default:
+		return nil, &NotSingularError{contactrequest.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (crq *ContactRequestQuery) OnlyX(ctx context.Context) *ContactRequest {
+	node, err := crq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ContactRequest ID in the query.
+// Returns a *NotSingularError when more than one ContactRequest ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (crq *ContactRequestQuery) OnlyID(ctx context.Context) (id pulid.ID, err error) {
+	var ids []pulid.ID
+	if ids, err = crq.Limit(2).IDs(setContextOp(ctx, crq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{contactrequest.Label}
+	default:
+		err = &NotSingularError{contactrequest.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (crq *ContactRequestQuery) OnlyIDX(ctx context.Context) pulid.ID {
+	id, err := crq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ContactRequests.
+func (crq *ContactRequestQuery) All(ctx context.Context) ([]*ContactRequest, error) {
+	ctx = setContextOp(ctx, crq.ctx, ent.OpQueryAll)
+	if err := crq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ContactRequest, *ContactRequestQuery]()
+	return withInterceptors[[]*ContactRequest](ctx, crq, qr, crq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (crq *ContactRequestQuery) AllX(ctx context.Context) []*ContactRequest {
+	nodes, err := crq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ContactRequest IDs.
+func (crq *ContactRequestQuery) IDs(ctx context.Context) (ids []pulid.ID, err error) {
+	if crq.ctx.Unique == nil && crq.path != nil {
+		crq.Unique(true)
+	}
+	ctx = setContextOp(ctx, crq.ctx, ent.OpQueryIDs)
+	if err = crq.Select(contactrequest.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (crq *ContactRequestQuery) IDsX(ctx context.Context) []pulid.ID {
+	ids, err := crq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (crq *ContactRequestQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, crq.ctx, ent.OpQueryCount)
+	if err := crq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, crq, querierCount[*ContactRequestQuery](), crq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (crq *ContactRequestQuery) CountX(ctx context.Context) int {
+	count, err := crq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (crq *ContactRequestQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, crq.ctx, ent.OpQueryExist)
+	switch _, err := crq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (crq *ContactRequestQuery) ExistX(ctx context.Context) bool {
+	exist, err := crq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ContactRequestQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (crq *ContactRequestQuery) Clone() *ContactRequestQuery {
+	if crq == nil {
+		return nil
+	}
+	return &ContactRequestQuery{
+		config:       crq.config,
+		ctx:          crq.ctx.Clone(),
+		order:        append([]contactrequest.OrderOption{}, crq.order...),
+		inters:       append([]Interceptor{}, crq.inters...),
+		predicates:   append([]predicate.ContactRequest{}, crq.predicates...),
+		withSender:   crq.withSender.Clone(),
+		withReceiver: crq.withReceiver.Clone(),
+		// clone intermediate query.
+		sql:  crq.sql.Clone(),
+		path: crq.path,
+	}
+}
+
+// WithSender tells the query-builder to eager-load the nodes that are connected to
+// the "sender" edge. The optional arguments are used to configure the query builder of the edge.
+func (crq *ContactRequestQuery) WithSender(opts ...func(*UserQuery)) *ContactRequestQuery {
+	query := (&UserClient{config: crq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	crq.withSender = query
+	return crq
+}
+
+// WithReceiver tells the query-builder to eager-load the nodes that are connected to
+// the "receiver" edge. The optional arguments are used to configure the query builder of the edge.
+func (crq *ContactRequestQuery) WithReceiver(opts ...func(*UserQuery)) *ContactRequestQuery {
+	query := (&UserClient{config: crq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	crq.withReceiver = query
+	return crq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		SenderID pulid.ID `json:"sender_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ContactRequest.Query().
+//		GroupBy(contactrequest.FieldSenderID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (crq *ContactRequestQuery) GroupBy(field string, fields ...string) *ContactRequestGroupBy {
+	crq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ContactRequestGroupBy{build: crq}
+	grbuild.flds = &crq.ctx.Fields
+	grbuild.label = contactrequest.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		SenderID pulid.ID `json:"sender_id,omitempty"`
+//	}
+//
+//	client.ContactRequest.Query().
+//		Select(contactrequest.FieldSenderID).
+//		Scan(ctx, &v)
+func (crq *ContactRequestQuery) Select(fields ...string) *ContactRequestSelect {
+	crq.ctx.Fields = append(crq.ctx.Fields, fields...)
+	sbuild := &ContactRequestSelect{ContactRequestQuery: crq}
+	sbuild.label = contactrequest.Label
+	sbuild.flds, sbuild.scan = &crq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ContactRequestSelect configured with the given aggregations.
+func (crq *ContactRequestQuery) Aggregate(fns ...AggregateFunc) *ContactRequestSelect {
+	return crq.Select().Aggregate(fns...)
+}
+
+func (crq *ContactRequestQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range crq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, crq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range crq.ctx.Fields {
+		if !contactrequest.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if crq.path != nil {
+		prev, err := crq.path(ctx)
+		if err != nil {
+			return err
+		}
+		crq.sql = prev
+	}
+	return nil
+}
+
+func (crq *ContactRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ContactRequest, error) {
+	var (
+		nodes       = []*ContactRequest{}
+		_spec       = crq.querySpec()
+		loadedTypes = [2]bool{
+			crq.withSender != nil,
+			crq.withReceiver != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ContactRequest).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ContactRequest{config: crq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(crq.modifiers) > 0 {
+		_spec.Modifiers = crq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, crq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := crq.withSender; query != nil {
+		if err := crq.loadSender(ctx, query, nodes, nil,
+			func(n *ContactRequest, e *User) { n.Edges.Sender = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := crq.withReceiver; query != nil {
+		if err := crq.loadReceiver(ctx, query, nodes, nil,
+			func(n *ContactRequest, e *User) { n.Edges.Receiver = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range crq.loadTotal {
+		if err := crq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (crq *ContactRequestQuery) loadSender(ctx context.Context, query *UserQuery, nodes []*ContactRequest, init func(*ContactRequest), assign func(*ContactRequest, *User)) error {
+	ids := make([]pulid.ID, 0, len(nodes))
+	nodeids := make(map[pulid.ID][]*ContactRequest)
+	for i := range nodes {
+		fk := nodes[i].SenderID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "sender_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (crq *ContactRequestQuery) loadReceiver(ctx context.Context, query *UserQuery, nodes []*ContactRequest, init func(*ContactRequest), assign func(*ContactRequest, *User)) error {
+	ids := make([]pulid.ID, 0, len(nodes))
+	nodeids := make(map[pulid.ID][]*ContactRequest)
+	for i := range nodes {
+		fk := nodes[i].ReceiverID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "receiver_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (crq *ContactRequestQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := crq.querySpec()
+	if len(crq.modifiers) > 0 {
+		_spec.Modifiers = crq.modifiers
+	}
+	_spec.Node.Columns = crq.ctx.Fields
+	if len(crq.ctx.Fields) > 0 {
+		_spec.Unique = crq.ctx.Unique != nil && *crq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, crq.driver, _spec)
+}
+
+func (crq *ContactRequestQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(contactrequest.Table, contactrequest.Columns, sqlgraph.NewFieldSpec(contactrequest.FieldID, field.TypeString))
+	_spec.From = crq.sql
+	if unique := crq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if crq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := crq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contactrequest.FieldID)
+		for i := range fields {
+			if fields[i] != contactrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if crq.withSender != nil {
+			_spec.Node.AddColumnOnce(contactrequest.FieldSenderID)
+		}
+		if crq.withReceiver != nil {
+			_spec.Node.AddColumnOnce(contactrequest.FieldReceiverID)
+		}
+	}
+	if ps := crq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := crq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := crq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := crq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (crq *ContactRequestQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(crq.driver.Dialect())
+	t1 := builder.Table(contactrequest.Table)
+	columns := crq.ctx.Fields
+	if len(columns) == 0 {
+		columns = contactrequest.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if crq.sql != nil {
+		selector = crq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if crq.ctx.Unique != nil && *crq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range crq.predicates {
+		p(selector)
+	}
+	for _, p := range crq.order {
+		p(selector)
+	}
+	if offset := crq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := crq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ContactRequestGroupBy is the group-by builder for ContactRequest entities.
+type ContactRequestGroupBy struct {
+	selector
+	build *ContactRequestQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (crgb *ContactRequestGroupBy) Aggregate(fns ...AggregateFunc) *ContactRequestGroupBy {
+	crgb.fns = append(crgb.fns, fns...)
+	return crgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (crgb *ContactRequestGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, crgb.build.ctx, ent.OpQueryGroupBy)
+	if err := crgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ContactRequestQuery, *ContactRequestGroupBy](ctx, crgb.build, crgb, crgb.build.inters, v)
+}
+
+func (crgb *ContactRequestGroupBy) sqlScan(ctx context.Context, root *ContactRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(crgb.fns))
+	for _, fn := range crgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*crgb.flds)+len(crgb.fns))
+		for _, f := range *crgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*crgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := crgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ContactRequestSelect is the builder for selecting fields of ContactRequest entities.
+type ContactRequestSelect struct {
+	*ContactRequestQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (crs *ContactRequestSelect) Aggregate(fns ...AggregateFunc) *ContactRequestSelect {
+	crs.fns = append(crs.fns, fns...)
+	return crs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (crs *ContactRequestSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, crs.ctx, ent.OpQuerySelect)
+	if err := crs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ContactRequestQuery, *ContactRequestSelect](ctx, crs.ContactRequestQuery, crs, crs.inters, v)
+}
+
+func (crs *ContactRequestSelect) sqlScan(ctx context.Context, root *ContactRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(crs.fns))
+	for _, fn := range crs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*crs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := crs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
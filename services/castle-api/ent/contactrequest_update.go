@@ -0,0 +1,318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journeyhub/ent/contactrequest"
+	"journeyhub/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ContactRequestUpdate is the builder for updating ContactRequest entities.
+type ContactRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ContactRequestMutation
+}
+
+// Where appends a list predicates to the ContactRequestUpdate builder.
+func (cru *ContactRequestUpdate) Where(ps ...predicate.ContactRequest) *ContactRequestUpdate {
+	cru.mutation.Where(ps...)
+	return cru
+}
+
+// SetStatus sets the "status" field.
+func (cru *ContactRequestUpdate) SetStatus(c contactrequest.Status) *ContactRequestUpdate {
+	cru.mutation.SetStatus(c)
+	return cru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cru *ContactRequestUpdate) SetNillableStatus(c *contactrequest.Status) *ContactRequestUpdate {
+	if c != nil {
+		cru.SetStatus(*c)
+	}
+	return cru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cru *ContactRequestUpdate) SetCreatedAt(t time.Time) *ContactRequestUpdate {
+	cru.mutation.SetCreatedAt(t)
+	return cru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cru *ContactRequestUpdate) SetNillableCreatedAt(t *time.Time) *ContactRequestUpdate {
+	if t != nil {
+		cru.SetCreatedAt(*t)
+	}
+	return cru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cru *ContactRequestUpdate) SetUpdatedAt(t time.Time) *ContactRequestUpdate {
+	cru.mutation.SetUpdatedAt(t)
+	return cru
+}
+
+// Mutation returns the ContactRequestMutation object of the builder.
+func (cru *ContactRequestUpdate) Mutation() *ContactRequestMutation {
+	return cru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cru *ContactRequestUpdate) Save(ctx context.Context) (int, error) {
+	cru.defaults()
+	return withHooks(ctx, cru.sqlSave, cru.mutation, cru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cru *ContactRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := cru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cru *ContactRequestUpdate) Exec(ctx context.Context) error {
+	_, err := cru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cru *ContactRequestUpdate) ExecX(ctx context.Context) {
+	if err := cru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cru *ContactRequestUpdate) defaults() {
+	if _, ok := cru.mutation.UpdatedAt(); !ok {
+		v := contactrequest.UpdateDefaultUpdatedAt()
+		cru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cru *ContactRequestUpdate) check() error {
+	if v, ok := cru.mutation.Status(); ok {
+		if err := contactrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ContactRequest.status": %w`, err)}
+		}
+	}
+	if cru.mutation.SenderCleared() && len(cru.mutation.SenderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContactRequest.sender"`)
+	}
+	if cru.mutation.ReceiverCleared() && len(cru.mutation.ReceiverIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContactRequest.receiver"`)
+	}
+	return nil
+}
+
+func (cru *ContactRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contactrequest.Table, contactrequest.Columns, sqlgraph.NewFieldSpec(contactrequest.FieldID, field.TypeString))
+	if ps := cru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cru.mutation.Status(); ok {
+		_spec.SetField(contactrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := cru.mutation.CreatedAt(); ok {
+		_spec.SetField(contactrequest.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := cru.mutation.UpdatedAt(); ok {
+		_spec.SetField(contactrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contactrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cru.mutation.done = true
+	return n, nil
+}
+
+// ContactRequestUpdateOne is the builder for updating a single ContactRequest entity.
+type ContactRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ContactRequestMutation
+}
+
+// SetStatus sets the "status" field.
+func (cruo *ContactRequestUpdateOne) SetStatus(c contactrequest.Status) *ContactRequestUpdateOne {
+	cruo.mutation.SetStatus(c)
+	return cruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cruo *ContactRequestUpdateOne) SetNillableStatus(c *contactrequest.Status) *ContactRequestUpdateOne {
+	if c != nil {
+		cruo.SetStatus(*c)
+	}
+	return cruo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cruo *ContactRequestUpdateOne) SetCreatedAt(t time.Time) *ContactRequestUpdateOne {
+	cruo.mutation.SetCreatedAt(t)
+	return cruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cruo *ContactRequestUpdateOne) SetNillableCreatedAt(t *time.Time) *ContactRequestUpdateOne {
+	if t != nil {
+		cruo.SetCreatedAt(*t)
+	}
+	return cruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cruo *ContactRequestUpdateOne) SetUpdatedAt(t time.Time) *ContactRequestUpdateOne {
+	cruo.mutation.SetUpdatedAt(t)
+	return cruo
+}
+
+// Mutation returns the ContactRequestMutation object of the builder.
+func (cruo *ContactRequestUpdateOne) Mutation() *ContactRequestMutation {
+	return cruo.mutation
+}
+
+// Where appends a list predicates to the ContactRequestUpdate builder.
+func (cruo *ContactRequestUpdateOne) Where(ps ...predicate.ContactRequest) *ContactRequestUpdateOne {
+	cruo.mutation.Where(ps...)
+	return cruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cruo *ContactRequestUpdateOne) Select(field string, fields ...string) *ContactRequestUpdateOne {
+	cruo.fields = append([]string{field}, fields...)
+	return cruo
+}
+
+// Save executes the query and returns the updated ContactRequest entity.
+func (cruo *ContactRequestUpdateOne) Save(ctx context.Context) (*ContactRequest, error) {
+	cruo.defaults()
+	return withHooks(ctx, cruo.sqlSave, cruo.mutation, cruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cruo *ContactRequestUpdateOne) SaveX(ctx context.Context) *ContactRequest {
+	node, err := cruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cruo *ContactRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := cruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cruo *ContactRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := cruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cruo *ContactRequestUpdateOne) defaults() {
+	if _, ok := cruo.mutation.UpdatedAt(); !ok {
+		v := contactrequest.UpdateDefaultUpdatedAt()
+		cruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cruo *ContactRequestUpdateOne) check() error {
+	if v, ok := cruo.mutation.Status(); ok {
+		if err := contactrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ContactRequest.status": %w`, err)}
+		}
+	}
+	if cruo.mutation.SenderCleared() && len(cruo.mutation.SenderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContactRequest.sender"`)
+	}
+	if cruo.mutation.ReceiverCleared() && len(cruo.mutation.ReceiverIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContactRequest.receiver"`)
+	}
+	return nil
+}
+
+func (cruo *ContactRequestUpdateOne) sqlSave(ctx context.Context) (_node *ContactRequest, err error) {
+	if err := cruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contactrequest.Table, contactrequest.Columns, sqlgraph.NewFieldSpec(contactrequest.FieldID, field.TypeString))
+	id, ok := cruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ContactRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contactrequest.FieldID)
+		for _, f := range fields {
+			if !contactrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != contactrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cruo.mutation.Status(); ok {
+		_spec.SetField(contactrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := cruo.mutation.CreatedAt(); ok {
+		_spec.SetField(contactrequest.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := cruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(contactrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ContactRequest{config: cruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contactrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cruo.mutation.done = true
+	return _node, nil
+}
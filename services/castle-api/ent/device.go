@@ -23,6 +23,16 @@ type Device struct {
 	DeviceID string `json:"device_id,omitempty"`
 	// FcmToken holds the value of the "fcm_token" field.
 	FcmToken string `json:"fcm_token,omitempty"`
+	// Platform holds the value of the "platform" field.
+	Platform device.Platform `json:"platform,omitempty"`
+	// VoipToken holds the value of the "voip_token" field.
+	VoipToken string `json:"voip_token,omitempty"`
+	// LastPushAt holds the value of the "last_push_at" field.
+	LastPushAt *time.Time `json:"last_push_at,omitempty"`
+	// LastPushError holds the value of the "last_push_error" field.
+	LastPushError *string `json:"last_push_error,omitempty"`
+	// InvalidatedAt holds the value of the "invalidated_at" field.
+	InvalidatedAt *time.Time `json:"invalidated_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -63,9 +73,9 @@ func (*Device) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case device.FieldID:
 			values[i] = new(pulid.ID)
-		case device.FieldDeviceID, device.FieldFcmToken:
+		case device.FieldDeviceID, device.FieldFcmToken, device.FieldPlatform, device.FieldVoipToken, device.FieldLastPushError:
 			values[i] = new(sql.NullString)
-		case device.FieldCreatedAt, device.FieldUpdatedAt:
+		case device.FieldLastPushAt, device.FieldInvalidatedAt, device.FieldCreatedAt, device.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case device.ForeignKeys[0]: // user_device
 			values[i] = &sql.NullScanner{S: new(pulid.ID)}
@@ -102,6 +112,39 @@ func (d *Device) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				d.FcmToken = value.String
 			}
+		case device.FieldPlatform:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field platform", values[i])
+			} else if value.Valid {
+				d.Platform = device.Platform(value.String)
+			}
+		case device.FieldVoipToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field voip_token", values[i])
+			} else if value.Valid {
+				d.VoipToken = value.String
+			}
+		case device.FieldLastPushAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_push_at", values[i])
+			} else if value.Valid {
+				d.LastPushAt = new(time.Time)
+				*d.LastPushAt = value.Time
+			}
+		case device.FieldLastPushError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_push_error", values[i])
+			} else if value.Valid {
+				d.LastPushError = new(string)
+				*d.LastPushError = value.String
+			}
+		case device.FieldInvalidatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field invalidated_at", values[i])
+			} else if value.Valid {
+				d.InvalidatedAt = new(time.Time)
+				*d.InvalidatedAt = value.Time
+			}
 		case device.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -168,6 +211,27 @@ func (d *Device) String() string {
 	builder.WriteString("fcm_token=")
 	builder.WriteString(d.FcmToken)
 	builder.WriteString(", ")
+	builder.WriteString("platform=")
+	builder.WriteString(fmt.Sprintf("%v", d.Platform))
+	builder.WriteString(", ")
+	builder.WriteString("voip_token=")
+	builder.WriteString(d.VoipToken)
+	builder.WriteString(", ")
+	if v := d.LastPushAt; v != nil {
+		builder.WriteString("last_push_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := d.LastPushError; v != nil {
+		builder.WriteString("last_push_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := d.InvalidatedAt; v != nil {
+		builder.WriteString("invalidated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(d.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
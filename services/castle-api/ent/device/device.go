@@ -3,7 +3,10 @@
 package device
 
 import (
+	"fmt"
+	"io"
 	"journeyhub/ent/schema/pulid"
+	"strconv"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -19,6 +22,16 @@ const (
 	FieldDeviceID = "device_id"
 	// FieldFcmToken holds the string denoting the fcm_token field in the database.
 	FieldFcmToken = "fcm_token"
+	// FieldPlatform holds the string denoting the platform field in the database.
+	FieldPlatform = "platform"
+	// FieldVoipToken holds the string denoting the voip_token field in the database.
+	FieldVoipToken = "voip_token"
+	// FieldLastPushAt holds the string denoting the last_push_at field in the database.
+	FieldLastPushAt = "last_push_at"
+	// FieldLastPushError holds the string denoting the last_push_error field in the database.
+	FieldLastPushError = "last_push_error"
+	// FieldInvalidatedAt holds the string denoting the invalidated_at field in the database.
+	FieldInvalidatedAt = "invalidated_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -41,6 +54,11 @@ var Columns = []string{
 	FieldID,
 	FieldDeviceID,
 	FieldFcmToken,
+	FieldPlatform,
+	FieldVoipToken,
+	FieldLastPushAt,
+	FieldLastPushError,
+	FieldInvalidatedAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -77,6 +95,33 @@ var (
 	DefaultID func() pulid.ID
 )
 
+// Platform defines the type for the "platform" enum field.
+type Platform string
+
+// PlatformAndroid is the default value of the Platform enum.
+const DefaultPlatform = PlatformAndroid
+
+// Platform values.
+const (
+	PlatformAndroid Platform = "Android"
+	PlatformIOS     Platform = "IOS"
+	PlatformWeb     Platform = "Web"
+)
+
+func (pl Platform) String() string {
+	return string(pl)
+}
+
+// PlatformValidator is a validator for the "platform" field enum values. It is called by the builders before save.
+func PlatformValidator(pl Platform) error {
+	switch pl {
+	case PlatformAndroid, PlatformIOS, PlatformWeb:
+		return nil
+	default:
+		return fmt.Errorf("device: invalid enum value for platform field: %q", pl)
+	}
+}
+
 // OrderOption defines the ordering options for the Device queries.
 type OrderOption func(*sql.Selector)
 
@@ -95,6 +140,31 @@ func ByFcmToken(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFcmToken, opts...).ToFunc()
 }
 
+// ByPlatform orders the results by the platform field.
+func ByPlatform(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlatform, opts...).ToFunc()
+}
+
+// ByVoipToken orders the results by the voip_token field.
+func ByVoipToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVoipToken, opts...).ToFunc()
+}
+
+// ByLastPushAt orders the results by the last_push_at field.
+func ByLastPushAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastPushAt, opts...).ToFunc()
+}
+
+// ByLastPushError orders the results by the last_push_error field.
+func ByLastPushError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastPushError, opts...).ToFunc()
+}
+
+// ByInvalidatedAt orders the results by the invalidated_at field.
+func ByInvalidatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvalidatedAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -118,3 +188,21 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
 	)
 }
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Platform) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Platform) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Platform(str)
+	if err := PlatformValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Platform", str)
+	}
+	return nil
+}
@@ -66,6 +66,26 @@ func FcmToken(v string) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldFcmToken, v))
 }
 
+// VoipToken applies equality check predicate on the "voip_token" field. It's identical to VoipTokenEQ.
+func VoipToken(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldVoipToken, v))
+}
+
+// LastPushAt applies equality check predicate on the "last_push_at" field. It's identical to LastPushAtEQ.
+func LastPushAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastPushAt, v))
+}
+
+// LastPushError applies equality check predicate on the "last_push_error" field. It's identical to LastPushErrorEQ.
+func LastPushError(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastPushError, v))
+}
+
+// InvalidatedAt applies equality check predicate on the "invalidated_at" field. It's identical to InvalidatedAtEQ.
+func InvalidatedAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldInvalidatedAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldCreatedAt, v))
@@ -206,6 +226,276 @@ func FcmTokenContainsFold(v string) predicate.Device {
 	return predicate.Device(sql.FieldContainsFold(FieldFcmToken, v))
 }
 
+// PlatformEQ applies the EQ predicate on the "platform" field.
+func PlatformEQ(v Platform) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldPlatform, v))
+}
+
+// PlatformNEQ applies the NEQ predicate on the "platform" field.
+func PlatformNEQ(v Platform) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldPlatform, v))
+}
+
+// PlatformIn applies the In predicate on the "platform" field.
+func PlatformIn(vs ...Platform) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldPlatform, vs...))
+}
+
+// PlatformNotIn applies the NotIn predicate on the "platform" field.
+func PlatformNotIn(vs ...Platform) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldPlatform, vs...))
+}
+
+// VoipTokenEQ applies the EQ predicate on the "voip_token" field.
+func VoipTokenEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldVoipToken, v))
+}
+
+// VoipTokenNEQ applies the NEQ predicate on the "voip_token" field.
+func VoipTokenNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldVoipToken, v))
+}
+
+// VoipTokenIn applies the In predicate on the "voip_token" field.
+func VoipTokenIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldVoipToken, vs...))
+}
+
+// VoipTokenNotIn applies the NotIn predicate on the "voip_token" field.
+func VoipTokenNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldVoipToken, vs...))
+}
+
+// VoipTokenGT applies the GT predicate on the "voip_token" field.
+func VoipTokenGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldVoipToken, v))
+}
+
+// VoipTokenGTE applies the GTE predicate on the "voip_token" field.
+func VoipTokenGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldVoipToken, v))
+}
+
+// VoipTokenLT applies the LT predicate on the "voip_token" field.
+func VoipTokenLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldVoipToken, v))
+}
+
+// VoipTokenLTE applies the LTE predicate on the "voip_token" field.
+func VoipTokenLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldVoipToken, v))
+}
+
+// VoipTokenContains applies the Contains predicate on the "voip_token" field.
+func VoipTokenContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldVoipToken, v))
+}
+
+// VoipTokenHasPrefix applies the HasPrefix predicate on the "voip_token" field.
+func VoipTokenHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldVoipToken, v))
+}
+
+// VoipTokenHasSuffix applies the HasSuffix predicate on the "voip_token" field.
+func VoipTokenHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldVoipToken, v))
+}
+
+// VoipTokenIsNil applies the IsNil predicate on the "voip_token" field.
+func VoipTokenIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldVoipToken))
+}
+
+// VoipTokenNotNil applies the NotNil predicate on the "voip_token" field.
+func VoipTokenNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldVoipToken))
+}
+
+// VoipTokenEqualFold applies the EqualFold predicate on the "voip_token" field.
+func VoipTokenEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldVoipToken, v))
+}
+
+// VoipTokenContainsFold applies the ContainsFold predicate on the "voip_token" field.
+func VoipTokenContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldVoipToken, v))
+}
+
+// LastPushAtEQ applies the EQ predicate on the "last_push_at" field.
+func LastPushAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastPushAt, v))
+}
+
+// LastPushAtNEQ applies the NEQ predicate on the "last_push_at" field.
+func LastPushAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldLastPushAt, v))
+}
+
+// LastPushAtIn applies the In predicate on the "last_push_at" field.
+func LastPushAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldLastPushAt, vs...))
+}
+
+// LastPushAtNotIn applies the NotIn predicate on the "last_push_at" field.
+func LastPushAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldLastPushAt, vs...))
+}
+
+// LastPushAtGT applies the GT predicate on the "last_push_at" field.
+func LastPushAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldLastPushAt, v))
+}
+
+// LastPushAtGTE applies the GTE predicate on the "last_push_at" field.
+func LastPushAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldLastPushAt, v))
+}
+
+// LastPushAtLT applies the LT predicate on the "last_push_at" field.
+func LastPushAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldLastPushAt, v))
+}
+
+// LastPushAtLTE applies the LTE predicate on the "last_push_at" field.
+func LastPushAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldLastPushAt, v))
+}
+
+// LastPushAtIsNil applies the IsNil predicate on the "last_push_at" field.
+func LastPushAtIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldLastPushAt))
+}
+
+// LastPushAtNotNil applies the NotNil predicate on the "last_push_at" field.
+func LastPushAtNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldLastPushAt))
+}
+
+// LastPushErrorEQ applies the EQ predicate on the "last_push_error" field.
+func LastPushErrorEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastPushError, v))
+}
+
+// LastPushErrorNEQ applies the NEQ predicate on the "last_push_error" field.
+func LastPushErrorNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldLastPushError, v))
+}
+
+// LastPushErrorIn applies the In predicate on the "last_push_error" field.
+func LastPushErrorIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldLastPushError, vs...))
+}
+
+// LastPushErrorNotIn applies the NotIn predicate on the "last_push_error" field.
+func LastPushErrorNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldLastPushError, vs...))
+}
+
+// LastPushErrorGT applies the GT predicate on the "last_push_error" field.
+func LastPushErrorGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldLastPushError, v))
+}
+
+// LastPushErrorGTE applies the GTE predicate on the "last_push_error" field.
+func LastPushErrorGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldLastPushError, v))
+}
+
+// LastPushErrorLT applies the LT predicate on the "last_push_error" field.
+func LastPushErrorLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldLastPushError, v))
+}
+
+// LastPushErrorLTE applies the LTE predicate on the "last_push_error" field.
+func LastPushErrorLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldLastPushError, v))
+}
+
+// LastPushErrorContains applies the Contains predicate on the "last_push_error" field.
+func LastPushErrorContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldLastPushError, v))
+}
+
+// LastPushErrorHasPrefix applies the HasPrefix predicate on the "last_push_error" field.
+func LastPushErrorHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldLastPushError, v))
+}
+
+// LastPushErrorHasSuffix applies the HasSuffix predicate on the "last_push_error" field.
+func LastPushErrorHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldLastPushError, v))
+}
+
+// LastPushErrorIsNil applies the IsNil predicate on the "last_push_error" field.
+func LastPushErrorIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldLastPushError))
+}
+
+// LastPushErrorNotNil applies the NotNil predicate on the "last_push_error" field.
+func LastPushErrorNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldLastPushError))
+}
+
+// LastPushErrorEqualFold applies the EqualFold predicate on the "last_push_error" field.
+func LastPushErrorEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldLastPushError, v))
+}
+
+// LastPushErrorContainsFold applies the ContainsFold predicate on the "last_push_error" field.
+func LastPushErrorContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldLastPushError, v))
+}
+
+// InvalidatedAtEQ applies the EQ predicate on the "invalidated_at" field.
+func InvalidatedAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldInvalidatedAt, v))
+}
+
+// InvalidatedAtNEQ applies the NEQ predicate on the "invalidated_at" field.
+func InvalidatedAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldInvalidatedAt, v))
+}
+
+// InvalidatedAtIn applies the In predicate on the "invalidated_at" field.
+func InvalidatedAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldInvalidatedAt, vs...))
+}
+
+// InvalidatedAtNotIn applies the NotIn predicate on the "invalidated_at" field.
+func InvalidatedAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldInvalidatedAt, vs...))
+}
+
+// InvalidatedAtGT applies the GT predicate on the "invalidated_at" field.
+func InvalidatedAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldInvalidatedAt, v))
+}
+
+// InvalidatedAtGTE applies the GTE predicate on the "invalidated_at" field.
+func InvalidatedAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldInvalidatedAt, v))
+}
+
+// InvalidatedAtLT applies the LT predicate on the "invalidated_at" field.
+func InvalidatedAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldInvalidatedAt, v))
+}
+
+// InvalidatedAtLTE applies the LTE predicate on the "invalidated_at" field.
+func InvalidatedAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldInvalidatedAt, v))
+}
+
+// InvalidatedAtIsNil applies the IsNil predicate on the "invalidated_at" field.
+func InvalidatedAtIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldInvalidatedAt))
+}
+
+// InvalidatedAtNotNil applies the NotNil predicate on the "invalidated_at" field.
+func InvalidatedAtNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldInvalidatedAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldCreatedAt, v))
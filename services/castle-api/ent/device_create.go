@@ -37,6 +37,76 @@ func (dc *DeviceCreate) SetFcmToken(s string) *DeviceCreate {
 	return dc
 }
 
+// SetPlatform sets the "platform" field.
+func (dc *DeviceCreate) SetPlatform(d device.Platform) *DeviceCreate {
+	dc.mutation.SetPlatform(d)
+	return dc
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillablePlatform(d *device.Platform) *DeviceCreate {
+	if d != nil {
+		dc.SetPlatform(*d)
+	}
+	return dc
+}
+
+// SetVoipToken sets the "voip_token" field.
+func (dc *DeviceCreate) SetVoipToken(s string) *DeviceCreate {
+	dc.mutation.SetVoipToken(s)
+	return dc
+}
+
+// SetNillableVoipToken sets the "voip_token" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableVoipToken(s *string) *DeviceCreate {
+	if s != nil {
+		dc.SetVoipToken(*s)
+	}
+	return dc
+}
+
+// SetLastPushAt sets the "last_push_at" field.
+func (dc *DeviceCreate) SetLastPushAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetLastPushAt(t)
+	return dc
+}
+
+// SetNillableLastPushAt sets the "last_push_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableLastPushAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetLastPushAt(*t)
+	}
+	return dc
+}
+
+// SetLastPushError sets the "last_push_error" field.
+func (dc *DeviceCreate) SetLastPushError(s string) *DeviceCreate {
+	dc.mutation.SetLastPushError(s)
+	return dc
+}
+
+// SetNillableLastPushError sets the "last_push_error" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableLastPushError(s *string) *DeviceCreate {
+	if s != nil {
+		dc.SetLastPushError(*s)
+	}
+	return dc
+}
+
+// SetInvalidatedAt sets the "invalidated_at" field.
+func (dc *DeviceCreate) SetInvalidatedAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetInvalidatedAt(t)
+	return dc
+}
+
+// SetNillableInvalidatedAt sets the "invalidated_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableInvalidatedAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetInvalidatedAt(*t)
+	}
+	return dc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (dc *DeviceCreate) SetCreatedAt(t time.Time) *DeviceCreate {
 	dc.mutation.SetCreatedAt(t)
@@ -125,6 +195,10 @@ func (dc *DeviceCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (dc *DeviceCreate) defaults() {
+	if _, ok := dc.mutation.Platform(); !ok {
+		v := device.DefaultPlatform
+		dc.mutation.SetPlatform(v)
+	}
 	if _, ok := dc.mutation.CreatedAt(); !ok {
 		v := device.DefaultCreatedAt()
 		dc.mutation.SetCreatedAt(v)
@@ -147,6 +221,14 @@ func (dc *DeviceCreate) check() error {
 	if _, ok := dc.mutation.FcmToken(); !ok {
 		return &ValidationError{Name: "fcm_token", err: errors.New(`ent: missing required field "Device.fcm_token"`)}
 	}
+	if _, ok := dc.mutation.Platform(); !ok {
+		return &ValidationError{Name: "platform", err: errors.New(`ent: missing required field "Device.platform"`)}
+	}
+	if v, ok := dc.mutation.Platform(); ok {
+		if err := device.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "Device.platform": %w`, err)}
+		}
+	}
 	if _, ok := dc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Device.created_at"`)}
 	}
@@ -200,6 +282,26 @@ func (dc *DeviceCreate) createSpec() (*Device, *sqlgraph.CreateSpec) {
 		_spec.SetField(device.FieldFcmToken, field.TypeString, value)
 		_node.FcmToken = value
 	}
+	if value, ok := dc.mutation.Platform(); ok {
+		_spec.SetField(device.FieldPlatform, field.TypeEnum, value)
+		_node.Platform = value
+	}
+	if value, ok := dc.mutation.VoipToken(); ok {
+		_spec.SetField(device.FieldVoipToken, field.TypeString, value)
+		_node.VoipToken = value
+	}
+	if value, ok := dc.mutation.LastPushAt(); ok {
+		_spec.SetField(device.FieldLastPushAt, field.TypeTime, value)
+		_node.LastPushAt = &value
+	}
+	if value, ok := dc.mutation.LastPushError(); ok {
+		_spec.SetField(device.FieldLastPushError, field.TypeString, value)
+		_node.LastPushError = &value
+	}
+	if value, ok := dc.mutation.InvalidatedAt(); ok {
+		_spec.SetField(device.FieldInvalidatedAt, field.TypeTime, value)
+		_node.InvalidatedAt = &value
+	}
 	if value, ok := dc.mutation.CreatedAt(); ok {
 		_spec.SetField(device.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -301,6 +403,90 @@ func (u *DeviceUpsert) UpdateFcmToken() *DeviceUpsert {
 	return u
 }
 
+// SetPlatform sets the "platform" field.
+func (u *DeviceUpsert) SetPlatform(v device.Platform) *DeviceUpsert {
+	u.Set(device.FieldPlatform, v)
+	return u
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdatePlatform() *DeviceUpsert {
+	u.SetExcluded(device.FieldPlatform)
+	return u
+}
+
+// SetVoipToken sets the "voip_token" field.
+func (u *DeviceUpsert) SetVoipToken(v string) *DeviceUpsert {
+	u.Set(device.FieldVoipToken, v)
+	return u
+}
+
+// UpdateVoipToken sets the "voip_token" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateVoipToken() *DeviceUpsert {
+	u.SetExcluded(device.FieldVoipToken)
+	return u
+}
+
+// ClearVoipToken clears the value of the "voip_token" field.
+func (u *DeviceUpsert) ClearVoipToken() *DeviceUpsert {
+	u.SetNull(device.FieldVoipToken)
+	return u
+}
+
+// SetLastPushAt sets the "last_push_at" field.
+func (u *DeviceUpsert) SetLastPushAt(v time.Time) *DeviceUpsert {
+	u.Set(device.FieldLastPushAt, v)
+	return u
+}
+
+// UpdateLastPushAt sets the "last_push_at" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateLastPushAt() *DeviceUpsert {
+	u.SetExcluded(device.FieldLastPushAt)
+	return u
+}
+
+// ClearLastPushAt clears the value of the "last_push_at" field.
+func (u *DeviceUpsert) ClearLastPushAt() *DeviceUpsert {
+	u.SetNull(device.FieldLastPushAt)
+	return u
+}
+
+// SetLastPushError sets the "last_push_error" field.
+func (u *DeviceUpsert) SetLastPushError(v string) *DeviceUpsert {
+	u.Set(device.FieldLastPushError, v)
+	return u
+}
+
+// UpdateLastPushError sets the "last_push_error" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateLastPushError() *DeviceUpsert {
+	u.SetExcluded(device.FieldLastPushError)
+	return u
+}
+
+// ClearLastPushError clears the value of the "last_push_error" field.
+func (u *DeviceUpsert) ClearLastPushError() *DeviceUpsert {
+	u.SetNull(device.FieldLastPushError)
+	return u
+}
+
+// SetInvalidatedAt sets the "invalidated_at" field.
+func (u *DeviceUpsert) SetInvalidatedAt(v time.Time) *DeviceUpsert {
+	u.Set(device.FieldInvalidatedAt, v)
+	return u
+}
+
+// UpdateInvalidatedAt sets the "invalidated_at" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateInvalidatedAt() *DeviceUpsert {
+	u.SetExcluded(device.FieldInvalidatedAt)
+	return u
+}
+
+// ClearInvalidatedAt clears the value of the "invalidated_at" field.
+func (u *DeviceUpsert) ClearInvalidatedAt() *DeviceUpsert {
+	u.SetNull(device.FieldInvalidatedAt)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *DeviceUpsert) SetUpdatedAt(v time.Time) *DeviceUpsert {
 	u.Set(device.FieldUpdatedAt, v)
@@ -392,6 +578,104 @@ func (u *DeviceUpsertOne) UpdateFcmToken() *DeviceUpsertOne {
 	})
 }
 
+// SetPlatform sets the "platform" field.
+func (u *DeviceUpsertOne) SetPlatform(v device.Platform) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetPlatform(v)
+	})
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdatePlatform() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdatePlatform()
+	})
+}
+
+// SetVoipToken sets the "voip_token" field.
+func (u *DeviceUpsertOne) SetVoipToken(v string) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetVoipToken(v)
+	})
+}
+
+// UpdateVoipToken sets the "voip_token" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateVoipToken() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateVoipToken()
+	})
+}
+
+// ClearVoipToken clears the value of the "voip_token" field.
+func (u *DeviceUpsertOne) ClearVoipToken() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearVoipToken()
+	})
+}
+
+// SetLastPushAt sets the "last_push_at" field.
+func (u *DeviceUpsertOne) SetLastPushAt(v time.Time) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetLastPushAt(v)
+	})
+}
+
+// UpdateLastPushAt sets the "last_push_at" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateLastPushAt() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateLastPushAt()
+	})
+}
+
+// ClearLastPushAt clears the value of the "last_push_at" field.
+func (u *DeviceUpsertOne) ClearLastPushAt() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearLastPushAt()
+	})
+}
+
+// SetLastPushError sets the "last_push_error" field.
+func (u *DeviceUpsertOne) SetLastPushError(v string) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetLastPushError(v)
+	})
+}
+
+// UpdateLastPushError sets the "last_push_error" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateLastPushError() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateLastPushError()
+	})
+}
+
+// ClearLastPushError clears the value of the "last_push_error" field.
+func (u *DeviceUpsertOne) ClearLastPushError() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearLastPushError()
+	})
+}
+
+// SetInvalidatedAt sets the "invalidated_at" field.
+func (u *DeviceUpsertOne) SetInvalidatedAt(v time.Time) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetInvalidatedAt(v)
+	})
+}
+
+// UpdateInvalidatedAt sets the "invalidated_at" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateInvalidatedAt() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateInvalidatedAt()
+	})
+}
+
+// ClearInvalidatedAt clears the value of the "invalidated_at" field.
+func (u *DeviceUpsertOne) ClearInvalidatedAt() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearInvalidatedAt()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *DeviceUpsertOne) SetUpdatedAt(v time.Time) *DeviceUpsertOne {
 	return u.Update(func(s *DeviceUpsert) {
@@ -652,6 +936,104 @@ func (u *DeviceUpsertBulk) UpdateFcmToken() *DeviceUpsertBulk {
 	})
 }
 
+// SetPlatform sets the "platform" field.
+func (u *DeviceUpsertBulk) SetPlatform(v device.Platform) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetPlatform(v)
+	})
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdatePlatform() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdatePlatform()
+	})
+}
+
+// SetVoipToken sets the "voip_token" field.
+func (u *DeviceUpsertBulk) SetVoipToken(v string) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetVoipToken(v)
+	})
+}
+
+// UpdateVoipToken sets the "voip_token" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateVoipToken() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateVoipToken()
+	})
+}
+
+// ClearVoipToken clears the value of the "voip_token" field.
+func (u *DeviceUpsertBulk) ClearVoipToken() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearVoipToken()
+	})
+}
+
+// SetLastPushAt sets the "last_push_at" field.
+func (u *DeviceUpsertBulk) SetLastPushAt(v time.Time) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetLastPushAt(v)
+	})
+}
+
+// UpdateLastPushAt sets the "last_push_at" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateLastPushAt() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateLastPushAt()
+	})
+}
+
+// ClearLastPushAt clears the value of the "last_push_at" field.
+func (u *DeviceUpsertBulk) ClearLastPushAt() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearLastPushAt()
+	})
+}
+
+// SetLastPushError sets the "last_push_error" field.
+func (u *DeviceUpsertBulk) SetLastPushError(v string) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetLastPushError(v)
+	})
+}
+
+// UpdateLastPushError sets the "last_push_error" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateLastPushError() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateLastPushError()
+	})
+}
+
+// ClearLastPushError clears the value of the "last_push_error" field.
+func (u *DeviceUpsertBulk) ClearLastPushError() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearLastPushError()
+	})
+}
+
+// SetInvalidatedAt sets the "invalidated_at" field.
+func (u *DeviceUpsertBulk) SetInvalidatedAt(v time.Time) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetInvalidatedAt(v)
+	})
+}
+
+// UpdateInvalidatedAt sets the "invalidated_at" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateInvalidatedAt() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateInvalidatedAt()
+	})
+}
+
+// ClearInvalidatedAt clears the value of the "invalidated_at" field.
+func (u *DeviceUpsertBulk) ClearInvalidatedAt() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearInvalidatedAt()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *DeviceUpsertBulk) SetUpdatedAt(v time.Time) *DeviceUpsertBulk {
 	return u.Update(func(s *DeviceUpsert) {
@@ -58,6 +58,100 @@ func (du *DeviceUpdate) SetNillableFcmToken(s *string) *DeviceUpdate {
 	return du
 }
 
+// SetPlatform sets the "platform" field.
+func (du *DeviceUpdate) SetPlatform(d device.Platform) *DeviceUpdate {
+	du.mutation.SetPlatform(d)
+	return du
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillablePlatform(d *device.Platform) *DeviceUpdate {
+	if d != nil {
+		du.SetPlatform(*d)
+	}
+	return du
+}
+
+// SetVoipToken sets the "voip_token" field.
+func (du *DeviceUpdate) SetVoipToken(s string) *DeviceUpdate {
+	du.mutation.SetVoipToken(s)
+	return du
+}
+
+// SetNillableVoipToken sets the "voip_token" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableVoipToken(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetVoipToken(*s)
+	}
+	return du
+}
+
+// ClearVoipToken clears the value of the "voip_token" field.
+func (du *DeviceUpdate) ClearVoipToken() *DeviceUpdate {
+	du.mutation.ClearVoipToken()
+	return du
+}
+
+// SetLastPushAt sets the "last_push_at" field.
+func (du *DeviceUpdate) SetLastPushAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetLastPushAt(t)
+	return du
+}
+
+// SetNillableLastPushAt sets the "last_push_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableLastPushAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetLastPushAt(*t)
+	}
+	return du
+}
+
+// ClearLastPushAt clears the value of the "last_push_at" field.
+func (du *DeviceUpdate) ClearLastPushAt() *DeviceUpdate {
+	du.mutation.ClearLastPushAt()
+	return du
+}
+
+// SetLastPushError sets the "last_push_error" field.
+func (du *DeviceUpdate) SetLastPushError(s string) *DeviceUpdate {
+	du.mutation.SetLastPushError(s)
+	return du
+}
+
+// SetNillableLastPushError sets the "last_push_error" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableLastPushError(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetLastPushError(*s)
+	}
+	return du
+}
+
+// ClearLastPushError clears the value of the "last_push_error" field.
+func (du *DeviceUpdate) ClearLastPushError() *DeviceUpdate {
+	du.mutation.ClearLastPushError()
+	return du
+}
+
+// SetInvalidatedAt sets the "invalidated_at" field.
+func (du *DeviceUpdate) SetInvalidatedAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetInvalidatedAt(t)
+	return du
+}
+
+// SetNillableInvalidatedAt sets the "invalidated_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableInvalidatedAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetInvalidatedAt(*t)
+	}
+	return du
+}
+
+// ClearInvalidatedAt clears the value of the "invalidated_at" field.
+func (du *DeviceUpdate) ClearInvalidatedAt() *DeviceUpdate {
+	du.mutation.ClearInvalidatedAt()
+	return du
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (du *DeviceUpdate) SetUpdatedAt(t time.Time) *DeviceUpdate {
 	du.mutation.SetUpdatedAt(t)
@@ -124,6 +218,11 @@ func (du *DeviceUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (du *DeviceUpdate) check() error {
+	if v, ok := du.mutation.Platform(); ok {
+		if err := device.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "Device.platform": %w`, err)}
+		}
+	}
 	if du.mutation.UserCleared() && len(du.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Device.user"`)
 	}
@@ -148,6 +247,33 @@ func (du *DeviceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := du.mutation.FcmToken(); ok {
 		_spec.SetField(device.FieldFcmToken, field.TypeString, value)
 	}
+	if value, ok := du.mutation.Platform(); ok {
+		_spec.SetField(device.FieldPlatform, field.TypeEnum, value)
+	}
+	if value, ok := du.mutation.VoipToken(); ok {
+		_spec.SetField(device.FieldVoipToken, field.TypeString, value)
+	}
+	if du.mutation.VoipTokenCleared() {
+		_spec.ClearField(device.FieldVoipToken, field.TypeString)
+	}
+	if value, ok := du.mutation.LastPushAt(); ok {
+		_spec.SetField(device.FieldLastPushAt, field.TypeTime, value)
+	}
+	if du.mutation.LastPushAtCleared() {
+		_spec.ClearField(device.FieldLastPushAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.LastPushError(); ok {
+		_spec.SetField(device.FieldLastPushError, field.TypeString, value)
+	}
+	if du.mutation.LastPushErrorCleared() {
+		_spec.ClearField(device.FieldLastPushError, field.TypeString)
+	}
+	if value, ok := du.mutation.InvalidatedAt(); ok {
+		_spec.SetField(device.FieldInvalidatedAt, field.TypeTime, value)
+	}
+	if du.mutation.InvalidatedAtCleared() {
+		_spec.ClearField(device.FieldInvalidatedAt, field.TypeTime)
+	}
 	if value, ok := du.mutation.UpdatedAt(); ok {
 		_spec.SetField(device.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -228,6 +354,100 @@ func (duo *DeviceUpdateOne) SetNillableFcmToken(s *string) *DeviceUpdateOne {
 	return duo
 }
 
+// SetPlatform sets the "platform" field.
+func (duo *DeviceUpdateOne) SetPlatform(d device.Platform) *DeviceUpdateOne {
+	duo.mutation.SetPlatform(d)
+	return duo
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillablePlatform(d *device.Platform) *DeviceUpdateOne {
+	if d != nil {
+		duo.SetPlatform(*d)
+	}
+	return duo
+}
+
+// SetVoipToken sets the "voip_token" field.
+func (duo *DeviceUpdateOne) SetVoipToken(s string) *DeviceUpdateOne {
+	duo.mutation.SetVoipToken(s)
+	return duo
+}
+
+// SetNillableVoipToken sets the "voip_token" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableVoipToken(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetVoipToken(*s)
+	}
+	return duo
+}
+
+// ClearVoipToken clears the value of the "voip_token" field.
+func (duo *DeviceUpdateOne) ClearVoipToken() *DeviceUpdateOne {
+	duo.mutation.ClearVoipToken()
+	return duo
+}
+
+// SetLastPushAt sets the "last_push_at" field.
+func (duo *DeviceUpdateOne) SetLastPushAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetLastPushAt(t)
+	return duo
+}
+
+// SetNillableLastPushAt sets the "last_push_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableLastPushAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetLastPushAt(*t)
+	}
+	return duo
+}
+
+// ClearLastPushAt clears the value of the "last_push_at" field.
+func (duo *DeviceUpdateOne) ClearLastPushAt() *DeviceUpdateOne {
+	duo.mutation.ClearLastPushAt()
+	return duo
+}
+
+// SetLastPushError sets the "last_push_error" field.
+func (duo *DeviceUpdateOne) SetLastPushError(s string) *DeviceUpdateOne {
+	duo.mutation.SetLastPushError(s)
+	return duo
+}
+
+// SetNillableLastPushError sets the "last_push_error" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableLastPushError(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetLastPushError(*s)
+	}
+	return duo
+}
+
+// ClearLastPushError clears the value of the "last_push_error" field.
+func (duo *DeviceUpdateOne) ClearLastPushError() *DeviceUpdateOne {
+	duo.mutation.ClearLastPushError()
+	return duo
+}
+
+// SetInvalidatedAt sets the "invalidated_at" field.
+func (duo *DeviceUpdateOne) SetInvalidatedAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetInvalidatedAt(t)
+	return duo
+}
+
+// SetNillableInvalidatedAt sets the "invalidated_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableInvalidatedAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetInvalidatedAt(*t)
+	}
+	return duo
+}
+
+// ClearInvalidatedAt clears the value of the "invalidated_at" field.
+func (duo *DeviceUpdateOne) ClearInvalidatedAt() *DeviceUpdateOne {
+	duo.mutation.ClearInvalidatedAt()
+	return duo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (duo *DeviceUpdateOne) SetUpdatedAt(t time.Time) *DeviceUpdateOne {
 	duo.mutation.SetUpdatedAt(t)
@@ -307,6 +527,11 @@ func (duo *DeviceUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (duo *DeviceUpdateOne) check() error {
+	if v, ok := duo.mutation.Platform(); ok {
+		if err := device.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "Device.platform": %w`, err)}
+		}
+	}
 	if duo.mutation.UserCleared() && len(duo.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Device.user"`)
 	}
@@ -348,6 +573,33 @@ func (duo *DeviceUpdateOne) sqlSave(ctx context.Context) (_node *Device, err err
 	if value, ok := duo.mutation.FcmToken(); ok {
 		_spec.SetField(device.FieldFcmToken, field.TypeString, value)
 	}
+	if value, ok := duo.mutation.Platform(); ok {
+		_spec.SetField(device.FieldPlatform, field.TypeEnum, value)
+	}
+	if value, ok := duo.mutation.VoipToken(); ok {
+		_spec.SetField(device.FieldVoipToken, field.TypeString, value)
+	}
+	if duo.mutation.VoipTokenCleared() {
+		_spec.ClearField(device.FieldVoipToken, field.TypeString)
+	}
+	if value, ok := duo.mutation.LastPushAt(); ok {
+		_spec.SetField(device.FieldLastPushAt, field.TypeTime, value)
+	}
+	if duo.mutation.LastPushAtCleared() {
+		_spec.ClearField(device.FieldLastPushAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.LastPushError(); ok {
+		_spec.SetField(device.FieldLastPushError, field.TypeString, value)
+	}
+	if duo.mutation.LastPushErrorCleared() {
+		_spec.ClearField(device.FieldLastPushError, field.TypeString)
+	}
+	if value, ok := duo.mutation.InvalidatedAt(); ok {
+		_spec.SetField(device.FieldInvalidatedAt, field.TypeTime, value)
+	}
+	if duo.mutation.InvalidatedAtCleared() {
+		_spec.ClearField(device.FieldInvalidatedAt, field.TypeTime)
+	}
 	if value, ok := duo.mutation.UpdatedAt(); ok {
 		_spec.SetField(device.FieldUpdatedAt, field.TypeTime, value)
 	}
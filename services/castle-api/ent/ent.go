@@ -6,6 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"journeyhub/ent/avatar"
+	"journeyhub/ent/call"
+	"journeyhub/ent/callparticipant"
+	"journeyhub/ent/contactpin"
+	"journeyhub/ent/contactrequest"
 	"journeyhub/ent/device"
 	"journeyhub/ent/file"
 	"journeyhub/ent/message"
@@ -13,9 +18,13 @@ import (
 	"journeyhub/ent/messagelink"
 	"journeyhub/ent/messagevoice"
 	"journeyhub/ent/notification"
+	"journeyhub/ent/outboxmessage"
+	"journeyhub/ent/report"
 	"journeyhub/ent/room"
+	"journeyhub/ent/roominvite"
 	"journeyhub/ent/roommember"
 	"journeyhub/ent/user"
+	"journeyhub/ent/userblock"
 	"journeyhub/ent/usercontact"
 	"reflect"
 	"sync"
@@ -83,6 +92,11 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			avatar.Table:            avatar.ValidColumn,
+			call.Table:              call.ValidColumn,
+			callparticipant.Table:   callparticipant.ValidColumn,
+			contactpin.Table:        contactpin.ValidColumn,
+			contactrequest.Table:    contactrequest.ValidColumn,
 			device.Table:            device.ValidColumn,
 			file.Table:              file.ValidColumn,
 			message.Table:           message.ValidColumn,
@@ -90,9 +104,13 @@ func checkColumn(table, column string) error {
 			messagelink.Table:       messagelink.ValidColumn,
 			messagevoice.Table:      messagevoice.ValidColumn,
 			notification.Table:      notification.ValidColumn,
+			outboxmessage.Table:     outboxmessage.ValidColumn,
+			report.Table:            report.ValidColumn,
 			room.Table:              room.ValidColumn,
+			roominvite.Table:        roominvite.ValidColumn,
 			roommember.Table:        roommember.ValidColumn,
 			user.Table:              user.ValidColumn,
+			userblock.Table:         userblock.ValidColumn,
 			usercontact.Table:       usercontact.ValidColumn,
 		})
 	})
@@ -7,7 +7,9 @@ import (
 	"journeyhub/ent/file"
 	"journeyhub/ent/messageattachment"
 	"journeyhub/ent/messagevoice"
+	"journeyhub/ent/room"
 	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
 	"strings"
 	"time"
 
@@ -32,14 +34,23 @@ type File struct {
 	Bucket string `json:"bucket,omitempty"`
 	// Path holds the value of the "path" field.
 	Path string `json:"path,omitempty"`
+	// Width of images in pixels
+	Width *int `json:"width,omitempty"`
+	// Height of images in pixels
+	Height *int `json:"height,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID pulid.ID `json:"user_id,omitempty"`
+	// RoomID holds the value of the "room_id" field.
+	RoomID pulid.ID `json:"room_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FileQuery when eager-loading is set.
-	Edges        FileEdges `json:"edges"`
-	selectValues sql.SelectValues
+	Edges           FileEdges `json:"edges"`
+	file_thumbnails *pulid.ID
+	selectValues    sql.SelectValues
 }
 
 // FileEdges holds the relations/edges for other nodes in the graph.
@@ -48,11 +59,21 @@ type FileEdges struct {
 	MessageAttachment *MessageAttachment `json:"message_attachment,omitempty"`
 	// MessageVoice holds the value of the message_voice edge.
 	MessageVoice *MessageVoice `json:"message_voice,omitempty"`
+	// Original holds the value of the original edge.
+	Original *File `json:"original,omitempty"`
+	// Thumbnails holds the value of the thumbnails edge.
+	Thumbnails []*File `json:"thumbnails,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Room holds the value of the room edge.
+	Room *Room `json:"room,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [6]bool
 	// totalCount holds the count of the edges above.
-	totalCount [2]map[string]int
+	totalCount [4]map[string]int
+
+	namedThumbnails map[string][]*File
 }
 
 // MessageAttachmentOrErr returns the MessageAttachment value or an error if the edge
@@ -77,19 +98,63 @@ func (e FileEdges) MessageVoiceOrErr() (*MessageVoice, error) {
 	return nil, &NotLoadedError{edge: "message_voice"}
 }
 
+// OriginalOrErr returns the Original value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FileEdges) OriginalOrErr() (*File, error) {
+	if e.Original != nil {
+		return e.Original, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: file.Label}
+	}
+	return nil, &NotLoadedError{edge: "original"}
+}
+
+// ThumbnailsOrErr returns the Thumbnails value or an error if the edge
+// was not loaded in eager-loading.
+func (e FileEdges) ThumbnailsOrErr() ([]*File, error) {
+	if e.loadedTypes[3] {
+		return e.Thumbnails, nil
+	}
+	return nil, &NotLoadedError{edge: "thumbnails"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FileEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// RoomOrErr returns the Room value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FileEdges) RoomOrErr() (*Room, error) {
+	if e.Room != nil {
+		return e.Room, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: room.Label}
+	}
+	return nil, &NotLoadedError{edge: "room"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*File) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case file.FieldID:
+		case file.FieldID, file.FieldUserID, file.FieldRoomID:
 			values[i] = new(pulid.ID)
-		case file.FieldSize:
+		case file.FieldSize, file.FieldWidth, file.FieldHeight:
 			values[i] = new(sql.NullInt64)
 		case file.FieldName, file.FieldContentType, file.FieldLocation, file.FieldBucket, file.FieldPath:
 			values[i] = new(sql.NullString)
 		case file.FieldCreatedAt, file.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
+		case file.ForeignKeys[0]: // file_thumbnails
+			values[i] = &sql.NullScanner{S: new(pulid.ID)}
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -147,6 +212,32 @@ func (f *File) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				f.Path = value.String
 			}
+		case file.FieldWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				f.Width = new(int)
+				*f.Width = int(value.Int64)
+			}
+		case file.FieldHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				f.Height = new(int)
+				*f.Height = int(value.Int64)
+			}
+		case file.FieldUserID:
+			if value, ok := values[i].(*pulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				f.UserID = *value
+			}
+		case file.FieldRoomID:
+			if value, ok := values[i].(*pulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field room_id", values[i])
+			} else if value != nil {
+				f.RoomID = *value
+			}
 		case file.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -159,6 +250,13 @@ func (f *File) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				f.UpdatedAt = value.Time
 			}
+		case file.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field file_thumbnails", values[i])
+			} else if value.Valid {
+				f.file_thumbnails = new(pulid.ID)
+				*f.file_thumbnails = *value.S.(*pulid.ID)
+			}
 		default:
 			f.selectValues.Set(columns[i], values[i])
 		}
@@ -182,6 +280,26 @@ func (f *File) QueryMessageVoice() *MessageVoiceQuery {
 	return NewFileClient(f.config).QueryMessageVoice(f)
 }
 
+// QueryOriginal queries the "original" edge of the File entity.
+func (f *File) QueryOriginal() *FileQuery {
+	return NewFileClient(f.config).QueryOriginal(f)
+}
+
+// QueryThumbnails queries the "thumbnails" edge of the File entity.
+func (f *File) QueryThumbnails() *FileQuery {
+	return NewFileClient(f.config).QueryThumbnails(f)
+}
+
+// QueryUser queries the "user" edge of the File entity.
+func (f *File) QueryUser() *UserQuery {
+	return NewFileClient(f.config).QueryUser(f)
+}
+
+// QueryRoom queries the "room" edge of the File entity.
+func (f *File) QueryRoom() *RoomQuery {
+	return NewFileClient(f.config).QueryRoom(f)
+}
+
 // Update returns a builder for updating this File.
 // Note that you need to call File.Unwrap() before calling this method if this File
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -223,6 +341,22 @@ func (f *File) String() string {
 	builder.WriteString("path=")
 	builder.WriteString(f.Path)
 	builder.WriteString(", ")
+	if v := f.Width; v != nil {
+		builder.WriteString("width=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := f.Height; v != nil {
+		builder.WriteString("height=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", f.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("room_id=")
+	builder.WriteString(fmt.Sprintf("%v", f.RoomID))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(f.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -232,5 +366,29 @@ func (f *File) String() string {
 	return builder.String()
 }
 
+// NamedThumbnails returns the Thumbnails named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (f *File) NamedThumbnails(name string) ([]*File, error) {
+	if f.Edges.namedThumbnails == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := f.Edges.namedThumbnails[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (f *File) appendNamedThumbnails(name string, edges ...*File) {
+	if f.Edges.namedThumbnails == nil {
+		f.Edges.namedThumbnails = make(map[string][]*File)
+	}
+	if len(edges) == 0 {
+		f.Edges.namedThumbnails[name] = []*File{}
+	} else {
+		f.Edges.namedThumbnails[name] = append(f.Edges.namedThumbnails[name], edges...)
+	}
+}
+
 // Files is a parsable slice of File.
 type Files []*File
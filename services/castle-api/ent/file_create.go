@@ -9,7 +9,9 @@ import (
 	"journeyhub/ent/file"
 	"journeyhub/ent/messageattachment"
 	"journeyhub/ent/messagevoice"
+	"journeyhub/ent/room"
 	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
 	"time"
 
 	"entgo.io/ent/dialect"
@@ -70,6 +72,62 @@ func (fc *FileCreate) SetPath(s string) *FileCreate {
 	return fc
 }
 
+// SetWidth sets the "width" field.
+func (fc *FileCreate) SetWidth(i int) *FileCreate {
+	fc.mutation.SetWidth(i)
+	return fc
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (fc *FileCreate) SetNillableWidth(i *int) *FileCreate {
+	if i != nil {
+		fc.SetWidth(*i)
+	}
+	return fc
+}
+
+// SetHeight sets the "height" field.
+func (fc *FileCreate) SetHeight(i int) *FileCreate {
+	fc.mutation.SetHeight(i)
+	return fc
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (fc *FileCreate) SetNillableHeight(i *int) *FileCreate {
+	if i != nil {
+		fc.SetHeight(*i)
+	}
+	return fc
+}
+
+// SetUserID sets the "user_id" field.
+func (fc *FileCreate) SetUserID(pu pulid.ID) *FileCreate {
+	fc.mutation.SetUserID(pu)
+	return fc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (fc *FileCreate) SetNillableUserID(pu *pulid.ID) *FileCreate {
+	if pu != nil {
+		fc.SetUserID(*pu)
+	}
+	return fc
+}
+
+// SetRoomID sets the "room_id" field.
+func (fc *FileCreate) SetRoomID(pu pulid.ID) *FileCreate {
+	fc.mutation.SetRoomID(pu)
+	return fc
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (fc *FileCreate) SetNillableRoomID(pu *pulid.ID) *FileCreate {
+	if pu != nil {
+		fc.SetRoomID(*pu)
+	}
+	return fc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (fc *FileCreate) SetCreatedAt(t time.Time) *FileCreate {
 	fc.mutation.SetCreatedAt(t)
@@ -150,6 +208,50 @@ func (fc *FileCreate) SetMessageVoice(m *MessageVoice) *FileCreate {
 	return fc.SetMessageVoiceID(m.ID)
 }
 
+// SetOriginalID sets the "original" edge to the File entity by ID.
+func (fc *FileCreate) SetOriginalID(id pulid.ID) *FileCreate {
+	fc.mutation.SetOriginalID(id)
+	return fc
+}
+
+// SetNillableOriginalID sets the "original" edge to the File entity by ID if the given value is not nil.
+func (fc *FileCreate) SetNillableOriginalID(id *pulid.ID) *FileCreate {
+	if id != nil {
+		fc = fc.SetOriginalID(*id)
+	}
+	return fc
+}
+
+// SetOriginal sets the "original" edge to the File entity.
+func (fc *FileCreate) SetOriginal(f *File) *FileCreate {
+	return fc.SetOriginalID(f.ID)
+}
+
+// AddThumbnailIDs adds the "thumbnails" edge to the File entity by IDs.
+func (fc *FileCreate) AddThumbnailIDs(ids ...pulid.ID) *FileCreate {
+	fc.mutation.AddThumbnailIDs(ids...)
+	return fc
+}
+
+// AddThumbnails adds the "thumbnails" edges to the File entity.
+func (fc *FileCreate) AddThumbnails(f ...*File) *FileCreate {
+	ids := make([]pulid.ID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fc.AddThumbnailIDs(ids...)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (fc *FileCreate) SetUser(u *User) *FileCreate {
+	return fc.SetUserID(u.ID)
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (fc *FileCreate) SetRoom(r *Room) *FileCreate {
+	return fc.SetRoomID(r.ID)
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (fc *FileCreate) Mutation() *FileMutation {
 	return fc.mutation
@@ -157,7 +259,9 @@ func (fc *FileCreate) Mutation() *FileMutation {
 
 // Save creates the File in the database.
 func (fc *FileCreate) Save(ctx context.Context) (*File, error) {
-	fc.defaults()
+	if err := fc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, fc.sqlSave, fc.mutation, fc.hooks)
 }
 
@@ -184,19 +288,29 @@ func (fc *FileCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (fc *FileCreate) defaults() {
+func (fc *FileCreate) defaults() error {
 	if _, ok := fc.mutation.CreatedAt(); !ok {
+		if file.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized file.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := file.DefaultCreatedAt()
 		fc.mutation.SetCreatedAt(v)
 	}
 	if _, ok := fc.mutation.UpdatedAt(); !ok {
+		if file.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized file.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := file.DefaultUpdatedAt()
 		fc.mutation.SetUpdatedAt(v)
 	}
 	if _, ok := fc.mutation.ID(); !ok {
+		if file.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized file.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := file.DefaultID()
 		fc.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -282,6 +396,14 @@ func (fc *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 		_spec.SetField(file.FieldPath, field.TypeString, value)
 		_node.Path = value
 	}
+	if value, ok := fc.mutation.Width(); ok {
+		_spec.SetField(file.FieldWidth, field.TypeInt, value)
+		_node.Width = &value
+	}
+	if value, ok := fc.mutation.Height(); ok {
+		_spec.SetField(file.FieldHeight, field.TypeInt, value)
+		_node.Height = &value
+	}
 	if value, ok := fc.mutation.CreatedAt(); ok {
 		_spec.SetField(file.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -322,6 +444,73 @@ func (fc *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := fc.mutation.OriginalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.OriginalTable,
+			Columns: []string{file.OriginalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.file_thumbnails = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.ThumbnailsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.ThumbnailsTable,
+			Columns: []string{file.ThumbnailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.UserTable,
+			Columns: []string{file.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.RoomTable,
+			Columns: []string{file.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoomID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -458,6 +647,90 @@ func (u *FileUpsert) UpdatePath() *FileUpsert {
 	return u
 }
 
+// SetWidth sets the "width" field.
+func (u *FileUpsert) SetWidth(v int) *FileUpsert {
+	u.Set(file.FieldWidth, v)
+	return u
+}
+
+// UpdateWidth sets the "width" field to the value that was provided on create.
+func (u *FileUpsert) UpdateWidth() *FileUpsert {
+	u.SetExcluded(file.FieldWidth)
+	return u
+}
+
+// AddWidth adds v to the "width" field.
+func (u *FileUpsert) AddWidth(v int) *FileUpsert {
+	u.Add(file.FieldWidth, v)
+	return u
+}
+
+// ClearWidth clears the value of the "width" field.
+func (u *FileUpsert) ClearWidth() *FileUpsert {
+	u.SetNull(file.FieldWidth)
+	return u
+}
+
+// SetHeight sets the "height" field.
+func (u *FileUpsert) SetHeight(v int) *FileUpsert {
+	u.Set(file.FieldHeight, v)
+	return u
+}
+
+// UpdateHeight sets the "height" field to the value that was provided on create.
+func (u *FileUpsert) UpdateHeight() *FileUpsert {
+	u.SetExcluded(file.FieldHeight)
+	return u
+}
+
+// AddHeight adds v to the "height" field.
+func (u *FileUpsert) AddHeight(v int) *FileUpsert {
+	u.Add(file.FieldHeight, v)
+	return u
+}
+
+// ClearHeight clears the value of the "height" field.
+func (u *FileUpsert) ClearHeight() *FileUpsert {
+	u.SetNull(file.FieldHeight)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *FileUpsert) SetUserID(v pulid.ID) *FileUpsert {
+	u.Set(file.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *FileUpsert) UpdateUserID() *FileUpsert {
+	u.SetExcluded(file.FieldUserID)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *FileUpsert) ClearUserID() *FileUpsert {
+	u.SetNull(file.FieldUserID)
+	return u
+}
+
+// SetRoomID sets the "room_id" field.
+func (u *FileUpsert) SetRoomID(v pulid.ID) *FileUpsert {
+	u.Set(file.FieldRoomID, v)
+	return u
+}
+
+// UpdateRoomID sets the "room_id" field to the value that was provided on create.
+func (u *FileUpsert) UpdateRoomID() *FileUpsert {
+	u.SetExcluded(file.FieldRoomID)
+	return u
+}
+
+// ClearRoomID clears the value of the "room_id" field.
+func (u *FileUpsert) ClearRoomID() *FileUpsert {
+	u.SetNull(file.FieldRoomID)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *FileUpsert) SetUpdatedAt(v time.Time) *FileUpsert {
 	u.Set(file.FieldUpdatedAt, v)
@@ -619,6 +892,104 @@ func (u *FileUpsertOne) UpdatePath() *FileUpsertOne {
 	})
 }
 
+// SetWidth sets the "width" field.
+func (u *FileUpsertOne) SetWidth(v int) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetWidth(v)
+	})
+}
+
+// AddWidth adds v to the "width" field.
+func (u *FileUpsertOne) AddWidth(v int) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.AddWidth(v)
+	})
+}
+
+// UpdateWidth sets the "width" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateWidth() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateWidth()
+	})
+}
+
+// ClearWidth clears the value of the "width" field.
+func (u *FileUpsertOne) ClearWidth() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearWidth()
+	})
+}
+
+// SetHeight sets the "height" field.
+func (u *FileUpsertOne) SetHeight(v int) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetHeight(v)
+	})
+}
+
+// AddHeight adds v to the "height" field.
+func (u *FileUpsertOne) AddHeight(v int) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.AddHeight(v)
+	})
+}
+
+// UpdateHeight sets the "height" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateHeight() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateHeight()
+	})
+}
+
+// ClearHeight clears the value of the "height" field.
+func (u *FileUpsertOne) ClearHeight() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearHeight()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *FileUpsertOne) SetUserID(v pulid.ID) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateUserID() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *FileUpsertOne) ClearUserID() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetRoomID sets the "room_id" field.
+func (u *FileUpsertOne) SetRoomID(v pulid.ID) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetRoomID(v)
+	})
+}
+
+// UpdateRoomID sets the "room_id" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateRoomID() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateRoomID()
+	})
+}
+
+// ClearRoomID clears the value of the "room_id" field.
+func (u *FileUpsertOne) ClearRoomID() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearRoomID()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *FileUpsertOne) SetUpdatedAt(v time.Time) *FileUpsertOne {
 	return u.Update(func(s *FileUpsert) {
@@ -949,6 +1320,104 @@ func (u *FileUpsertBulk) UpdatePath() *FileUpsertBulk {
 	})
 }
 
+// SetWidth sets the "width" field.
+func (u *FileUpsertBulk) SetWidth(v int) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetWidth(v)
+	})
+}
+
+// AddWidth adds v to the "width" field.
+func (u *FileUpsertBulk) AddWidth(v int) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.AddWidth(v)
+	})
+}
+
+// UpdateWidth sets the "width" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateWidth() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateWidth()
+	})
+}
+
+// ClearWidth clears the value of the "width" field.
+func (u *FileUpsertBulk) ClearWidth() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearWidth()
+	})
+}
+
+// SetHeight sets the "height" field.
+func (u *FileUpsertBulk) SetHeight(v int) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetHeight(v)
+	})
+}
+
+// AddHeight adds v to the "height" field.
+func (u *FileUpsertBulk) AddHeight(v int) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.AddHeight(v)
+	})
+}
+
+// UpdateHeight sets the "height" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateHeight() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateHeight()
+	})
+}
+
+// ClearHeight clears the value of the "height" field.
+func (u *FileUpsertBulk) ClearHeight() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearHeight()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *FileUpsertBulk) SetUserID(v pulid.ID) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateUserID() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *FileUpsertBulk) ClearUserID() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetRoomID sets the "room_id" field.
+func (u *FileUpsertBulk) SetRoomID(v pulid.ID) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetRoomID(v)
+	})
+}
+
+// UpdateRoomID sets the "room_id" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateRoomID() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateRoomID()
+	})
+}
+
+// ClearRoomID clears the value of the "room_id" field.
+func (u *FileUpsertBulk) ClearRoomID() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearRoomID()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *FileUpsertBulk) SetUpdatedAt(v time.Time) *FileUpsertBulk {
 	return u.Update(func(s *FileUpsert) {
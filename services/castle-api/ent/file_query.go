@@ -10,7 +10,9 @@ import (
 	"journeyhub/ent/messageattachment"
 	"journeyhub/ent/messagevoice"
 	"journeyhub/ent/predicate"
+	"journeyhub/ent/room"
 	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
 	"math"
 
 	"entgo.io/ent"
@@ -28,8 +30,14 @@ type FileQuery struct {
 	predicates            []predicate.File
 	withMessageAttachment *MessageAttachmentQuery
 	withMessageVoice      *MessageVoiceQuery
+	withOriginal          *FileQuery
+	withThumbnails        *FileQuery
+	withUser              *UserQuery
+	withRoom              *RoomQuery
+	withFKs               bool
 	modifiers             []func(*sql.Selector)
 	loadTotal             []func(context.Context, []*File) error
+	withNamedThumbnails   map[string]*FileQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -110,6 +118,94 @@ func (fq *FileQuery) QueryMessageVoice() *MessageVoiceQuery {
 	return query
 }
 
+// QueryOriginal chains the current query on the "original" edge.
+func (fq *FileQuery) QueryOriginal() *FileQuery {
+	query := (&FileClient{config: fq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, selector),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, file.OriginalTable, file.OriginalColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryThumbnails chains the current query on the "thumbnails" edge.
+func (fq *FileQuery) QueryThumbnails() *FileQuery {
+	query := (&FileClient{config: fq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, selector),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, file.ThumbnailsTable, file.ThumbnailsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (fq *FileQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: fq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, file.UserTable, file.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRoom chains the current query on the "room" edge.
+func (fq *FileQuery) QueryRoom() *RoomQuery {
+	query := (&RoomClient{config: fq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, selector),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, file.RoomTable, file.RoomColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first File entity from the query.
 // Returns a *NotFoundError when no File was found.
 func (fq *FileQuery) First(ctx context.Context) (*File, error) {
@@ -304,6 +400,10 @@ func (fq *FileQuery) Clone() *FileQuery {
 		predicates:            append([]predicate.File{}, fq.predicates...),
 		withMessageAttachment: fq.withMessageAttachment.Clone(),
 		withMessageVoice:      fq.withMessageVoice.Clone(),
+		withOriginal:          fq.withOriginal.Clone(),
+		withThumbnails:        fq.withThumbnails.Clone(),
+		withUser:              fq.withUser.Clone(),
+		withRoom:              fq.withRoom.Clone(),
 		// clone intermediate query.
 		sql:  fq.sql.Clone(),
 		path: fq.path,
@@ -332,6 +432,50 @@ func (fq *FileQuery) WithMessageVoice(opts ...func(*MessageVoiceQuery)) *FileQue
 	return fq
 }
 
+// WithOriginal tells the query-builder to eager-load the nodes that are connected to
+// the "original" edge. The optional arguments are used to configure the query builder of the edge.
+func (fq *FileQuery) WithOriginal(opts ...func(*FileQuery)) *FileQuery {
+	query := (&FileClient{config: fq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withOriginal = query
+	return fq
+}
+
+// WithThumbnails tells the query-builder to eager-load the nodes that are connected to
+// the "thumbnails" edge. The optional arguments are used to configure the query builder of the edge.
+func (fq *FileQuery) WithThumbnails(opts ...func(*FileQuery)) *FileQuery {
+	query := (&FileClient{config: fq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withThumbnails = query
+	return fq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (fq *FileQuery) WithUser(opts ...func(*UserQuery)) *FileQuery {
+	query := (&UserClient{config: fq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withUser = query
+	return fq
+}
+
+// WithRoom tells the query-builder to eager-load the nodes that are connected to
+// the "room" edge. The optional arguments are used to configure the query builder of the edge.
+func (fq *FileQuery) WithRoom(opts ...func(*RoomQuery)) *FileQuery {
+	query := (&RoomClient{config: fq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withRoom = query
+	return fq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,12 +553,23 @@ func (fq *FileQuery) prepareQuery(ctx context.Context) error {
 func (fq *FileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*File, error) {
 	var (
 		nodes       = []*File{}
+		withFKs     = fq.withFKs
 		_spec       = fq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [6]bool{
 			fq.withMessageAttachment != nil,
 			fq.withMessageVoice != nil,
+			fq.withOriginal != nil,
+			fq.withThumbnails != nil,
+			fq.withUser != nil,
+			fq.withRoom != nil,
 		}
 	)
+	if fq.withOriginal != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, file.ForeignKeys...)
+	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*File).scanValues(nil, columns)
 	}
@@ -448,6 +603,38 @@ func (fq *FileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*File, e
 			return nil, err
 		}
 	}
+	if query := fq.withOriginal; query != nil {
+		if err := fq.loadOriginal(ctx, query, nodes, nil,
+			func(n *File, e *File) { n.Edges.Original = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := fq.withThumbnails; query != nil {
+		if err := fq.loadThumbnails(ctx, query, nodes,
+			func(n *File) { n.Edges.Thumbnails = []*File{} },
+			func(n *File, e *File) { n.Edges.Thumbnails = append(n.Edges.Thumbnails, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := fq.withUser; query != nil {
+		if err := fq.loadUser(ctx, query, nodes, nil,
+			func(n *File, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := fq.withRoom; query != nil {
+		if err := fq.loadRoom(ctx, query, nodes, nil,
+			func(n *File, e *Room) { n.Edges.Room = e }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range fq.withNamedThumbnails {
+		if err := fq.loadThumbnails(ctx, query, nodes,
+			func(n *File) { n.appendNamedThumbnails(name) },
+			func(n *File, e *File) { n.appendNamedThumbnails(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range fq.loadTotal {
 		if err := fq.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -512,6 +699,127 @@ func (fq *FileQuery) loadMessageVoice(ctx context.Context, query *MessageVoiceQu
 	}
 	return nil
 }
+func (fq *FileQuery) loadOriginal(ctx context.Context, query *FileQuery, nodes []*File, init func(*File), assign func(*File, *File)) error {
+	ids := make([]pulid.ID, 0, len(nodes))
+	nodeids := make(map[pulid.ID][]*File)
+	for i := range nodes {
+		if nodes[i].file_thumbnails == nil {
+			continue
+		}
+		fk := *nodes[i].file_thumbnails
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(file.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "file_thumbnails" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (fq *FileQuery) loadThumbnails(ctx context.Context, query *FileQuery, nodes []*File, init func(*File), assign func(*File, *File)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[pulid.ID]*File)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.File(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(file.ThumbnailsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.file_thumbnails
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "file_thumbnails" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "file_thumbnails" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (fq *FileQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*File, init func(*File), assign func(*File, *User)) error {
+	ids := make([]pulid.ID, 0, len(nodes))
+	nodeids := make(map[pulid.ID][]*File)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (fq *FileQuery) loadRoom(ctx context.Context, query *RoomQuery, nodes []*File, init func(*File), assign func(*File, *Room)) error {
+	ids := make([]pulid.ID, 0, len(nodes))
+	nodeids := make(map[pulid.ID][]*File)
+	for i := range nodes {
+		fk := nodes[i].RoomID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(room.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "room_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (fq *FileQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := fq.querySpec()
@@ -541,6 +849,12 @@ func (fq *FileQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if fq.withUser != nil {
+			_spec.Node.AddColumnOnce(file.FieldUserID)
+		}
+		if fq.withRoom != nil {
+			_spec.Node.AddColumnOnce(file.FieldRoomID)
+		}
 	}
 	if ps := fq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -597,6 +911,20 @@ func (fq *FileQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedThumbnails tells the query-builder to eager-load the nodes that are connected to the "thumbnails"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (fq *FileQuery) WithNamedThumbnails(name string, opts ...func(*FileQuery)) *FileQuery {
+	query := (&FileClient{config: fq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if fq.withNamedThumbnails == nil {
+		fq.withNamedThumbnails = make(map[string]*FileQuery)
+	}
+	fq.withNamedThumbnails[name] = query
+	return fq
+}
+
 // FileGroupBy is the group-by builder for File entities.
 type FileGroupBy struct {
 	selector
@@ -10,7 +10,9 @@ import (
 	"journeyhub/ent/messageattachment"
 	"journeyhub/ent/messagevoice"
 	"journeyhub/ent/predicate"
+	"journeyhub/ent/room"
 	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -128,6 +130,100 @@ func (fu *FileUpdate) SetNillablePath(s *string) *FileUpdate {
 	return fu
 }
 
+// SetWidth sets the "width" field.
+func (fu *FileUpdate) SetWidth(i int) *FileUpdate {
+	fu.mutation.ResetWidth()
+	fu.mutation.SetWidth(i)
+	return fu
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableWidth(i *int) *FileUpdate {
+	if i != nil {
+		fu.SetWidth(*i)
+	}
+	return fu
+}
+
+// AddWidth adds i to the "width" field.
+func (fu *FileUpdate) AddWidth(i int) *FileUpdate {
+	fu.mutation.AddWidth(i)
+	return fu
+}
+
+// ClearWidth clears the value of the "width" field.
+func (fu *FileUpdate) ClearWidth() *FileUpdate {
+	fu.mutation.ClearWidth()
+	return fu
+}
+
+// SetHeight sets the "height" field.
+func (fu *FileUpdate) SetHeight(i int) *FileUpdate {
+	fu.mutation.ResetHeight()
+	fu.mutation.SetHeight(i)
+	return fu
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableHeight(i *int) *FileUpdate {
+	if i != nil {
+		fu.SetHeight(*i)
+	}
+	return fu
+}
+
+// AddHeight adds i to the "height" field.
+func (fu *FileUpdate) AddHeight(i int) *FileUpdate {
+	fu.mutation.AddHeight(i)
+	return fu
+}
+
+// ClearHeight clears the value of the "height" field.
+func (fu *FileUpdate) ClearHeight() *FileUpdate {
+	fu.mutation.ClearHeight()
+	return fu
+}
+
+// SetUserID sets the "user_id" field.
+func (fu *FileUpdate) SetUserID(pu pulid.ID) *FileUpdate {
+	fu.mutation.SetUserID(pu)
+	return fu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableUserID(pu *pulid.ID) *FileUpdate {
+	if pu != nil {
+		fu.SetUserID(*pu)
+	}
+	return fu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (fu *FileUpdate) ClearUserID() *FileUpdate {
+	fu.mutation.ClearUserID()
+	return fu
+}
+
+// SetRoomID sets the "room_id" field.
+func (fu *FileUpdate) SetRoomID(pu pulid.ID) *FileUpdate {
+	fu.mutation.SetRoomID(pu)
+	return fu
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableRoomID(pu *pulid.ID) *FileUpdate {
+	if pu != nil {
+		fu.SetRoomID(*pu)
+	}
+	return fu
+}
+
+// ClearRoomID clears the value of the "room_id" field.
+func (fu *FileUpdate) ClearRoomID() *FileUpdate {
+	fu.mutation.ClearRoomID()
+	return fu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (fu *FileUpdate) SetUpdatedAt(t time.Time) *FileUpdate {
 	fu.mutation.SetUpdatedAt(t)
@@ -172,6 +268,50 @@ func (fu *FileUpdate) SetMessageVoice(m *MessageVoice) *FileUpdate {
 	return fu.SetMessageVoiceID(m.ID)
 }
 
+// SetOriginalID sets the "original" edge to the File entity by ID.
+func (fu *FileUpdate) SetOriginalID(id pulid.ID) *FileUpdate {
+	fu.mutation.SetOriginalID(id)
+	return fu
+}
+
+// SetNillableOriginalID sets the "original" edge to the File entity by ID if the given value is not nil.
+func (fu *FileUpdate) SetNillableOriginalID(id *pulid.ID) *FileUpdate {
+	if id != nil {
+		fu = fu.SetOriginalID(*id)
+	}
+	return fu
+}
+
+// SetOriginal sets the "original" edge to the File entity.
+func (fu *FileUpdate) SetOriginal(f *File) *FileUpdate {
+	return fu.SetOriginalID(f.ID)
+}
+
+// AddThumbnailIDs adds the "thumbnails" edge to the File entity by IDs.
+func (fu *FileUpdate) AddThumbnailIDs(ids ...pulid.ID) *FileUpdate {
+	fu.mutation.AddThumbnailIDs(ids...)
+	return fu
+}
+
+// AddThumbnails adds the "thumbnails" edges to the File entity.
+func (fu *FileUpdate) AddThumbnails(f ...*File) *FileUpdate {
+	ids := make([]pulid.ID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fu.AddThumbnailIDs(ids...)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (fu *FileUpdate) SetUser(u *User) *FileUpdate {
+	return fu.SetUserID(u.ID)
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (fu *FileUpdate) SetRoom(r *Room) *FileUpdate {
+	return fu.SetRoomID(r.ID)
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (fu *FileUpdate) Mutation() *FileMutation {
 	return fu.mutation
@@ -189,9 +329,50 @@ func (fu *FileUpdate) ClearMessageVoice() *FileUpdate {
 	return fu
 }
 
+// ClearOriginal clears the "original" edge to the File entity.
+func (fu *FileUpdate) ClearOriginal() *FileUpdate {
+	fu.mutation.ClearOriginal()
+	return fu
+}
+
+// ClearThumbnails clears all "thumbnails" edges to the File entity.
+func (fu *FileUpdate) ClearThumbnails() *FileUpdate {
+	fu.mutation.ClearThumbnails()
+	return fu
+}
+
+// RemoveThumbnailIDs removes the "thumbnails" edge to File entities by IDs.
+func (fu *FileUpdate) RemoveThumbnailIDs(ids ...pulid.ID) *FileUpdate {
+	fu.mutation.RemoveThumbnailIDs(ids...)
+	return fu
+}
+
+// RemoveThumbnails removes "thumbnails" edges to File entities.
+func (fu *FileUpdate) RemoveThumbnails(f ...*File) *FileUpdate {
+	ids := make([]pulid.ID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fu.RemoveThumbnailIDs(ids...)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (fu *FileUpdate) ClearUser() *FileUpdate {
+	fu.mutation.ClearUser()
+	return fu
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (fu *FileUpdate) ClearRoom() *FileUpdate {
+	fu.mutation.ClearRoom()
+	return fu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (fu *FileUpdate) Save(ctx context.Context) (int, error) {
-	fu.defaults()
+	if err := fu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, fu.sqlSave, fu.mutation, fu.hooks)
 }
 
@@ -218,11 +399,15 @@ func (fu *FileUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (fu *FileUpdate) defaults() {
+func (fu *FileUpdate) defaults() error {
 	if _, ok := fu.mutation.UpdatedAt(); !ok {
+		if file.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized file.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := file.UpdateDefaultUpdatedAt()
 		fu.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -258,6 +443,24 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := fu.mutation.Path(); ok {
 		_spec.SetField(file.FieldPath, field.TypeString, value)
 	}
+	if value, ok := fu.mutation.Width(); ok {
+		_spec.SetField(file.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := fu.mutation.AddedWidth(); ok {
+		_spec.AddField(file.FieldWidth, field.TypeInt, value)
+	}
+	if fu.mutation.WidthCleared() {
+		_spec.ClearField(file.FieldWidth, field.TypeInt)
+	}
+	if value, ok := fu.mutation.Height(); ok {
+		_spec.SetField(file.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := fu.mutation.AddedHeight(); ok {
+		_spec.AddField(file.FieldHeight, field.TypeInt, value)
+	}
+	if fu.mutation.HeightCleared() {
+		_spec.ClearField(file.FieldHeight, field.TypeInt)
+	}
 	if value, ok := fu.mutation.UpdatedAt(); ok {
 		_spec.SetField(file.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -319,6 +522,138 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if fu.mutation.OriginalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.OriginalTable,
+			Columns: []string{file.OriginalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.OriginalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.OriginalTable,
+			Columns: []string{file.OriginalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fu.mutation.ThumbnailsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.ThumbnailsTable,
+			Columns: []string{file.ThumbnailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.RemovedThumbnailsIDs(); len(nodes) > 0 && !fu.mutation.ThumbnailsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.ThumbnailsTable,
+			Columns: []string{file.ThumbnailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.ThumbnailsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.ThumbnailsTable,
+			Columns: []string{file.ThumbnailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.UserTable,
+			Columns: []string{file.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.UserTable,
+			Columns: []string{file.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fu.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.RoomTable,
+			Columns: []string{file.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.RoomTable,
+			Columns: []string{file.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{file.Label}
@@ -436,6 +771,100 @@ func (fuo *FileUpdateOne) SetNillablePath(s *string) *FileUpdateOne {
 	return fuo
 }
 
+// SetWidth sets the "width" field.
+func (fuo *FileUpdateOne) SetWidth(i int) *FileUpdateOne {
+	fuo.mutation.ResetWidth()
+	fuo.mutation.SetWidth(i)
+	return fuo
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableWidth(i *int) *FileUpdateOne {
+	if i != nil {
+		fuo.SetWidth(*i)
+	}
+	return fuo
+}
+
+// AddWidth adds i to the "width" field.
+func (fuo *FileUpdateOne) AddWidth(i int) *FileUpdateOne {
+	fuo.mutation.AddWidth(i)
+	return fuo
+}
+
+// ClearWidth clears the value of the "width" field.
+func (fuo *FileUpdateOne) ClearWidth() *FileUpdateOne {
+	fuo.mutation.ClearWidth()
+	return fuo
+}
+
+// SetHeight sets the "height" field.
+func (fuo *FileUpdateOne) SetHeight(i int) *FileUpdateOne {
+	fuo.mutation.ResetHeight()
+	fuo.mutation.SetHeight(i)
+	return fuo
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableHeight(i *int) *FileUpdateOne {
+	if i != nil {
+		fuo.SetHeight(*i)
+	}
+	return fuo
+}
+
+// AddHeight adds i to the "height" field.
+func (fuo *FileUpdateOne) AddHeight(i int) *FileUpdateOne {
+	fuo.mutation.AddHeight(i)
+	return fuo
+}
+
+// ClearHeight clears the value of the "height" field.
+func (fuo *FileUpdateOne) ClearHeight() *FileUpdateOne {
+	fuo.mutation.ClearHeight()
+	return fuo
+}
+
+// SetUserID sets the "user_id" field.
+func (fuo *FileUpdateOne) SetUserID(pu pulid.ID) *FileUpdateOne {
+	fuo.mutation.SetUserID(pu)
+	return fuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableUserID(pu *pulid.ID) *FileUpdateOne {
+	if pu != nil {
+		fuo.SetUserID(*pu)
+	}
+	return fuo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (fuo *FileUpdateOne) ClearUserID() *FileUpdateOne {
+	fuo.mutation.ClearUserID()
+	return fuo
+}
+
+// SetRoomID sets the "room_id" field.
+func (fuo *FileUpdateOne) SetRoomID(pu pulid.ID) *FileUpdateOne {
+	fuo.mutation.SetRoomID(pu)
+	return fuo
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableRoomID(pu *pulid.ID) *FileUpdateOne {
+	if pu != nil {
+		fuo.SetRoomID(*pu)
+	}
+	return fuo
+}
+
+// ClearRoomID clears the value of the "room_id" field.
+func (fuo *FileUpdateOne) ClearRoomID() *FileUpdateOne {
+	fuo.mutation.ClearRoomID()
+	return fuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (fuo *FileUpdateOne) SetUpdatedAt(t time.Time) *FileUpdateOne {
 	fuo.mutation.SetUpdatedAt(t)
@@ -480,6 +909,50 @@ func (fuo *FileUpdateOne) SetMessageVoice(m *MessageVoice) *FileUpdateOne {
 	return fuo.SetMessageVoiceID(m.ID)
 }
 
+// SetOriginalID sets the "original" edge to the File entity by ID.
+func (fuo *FileUpdateOne) SetOriginalID(id pulid.ID) *FileUpdateOne {
+	fuo.mutation.SetOriginalID(id)
+	return fuo
+}
+
+// SetNillableOriginalID sets the "original" edge to the File entity by ID if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableOriginalID(id *pulid.ID) *FileUpdateOne {
+	if id != nil {
+		fuo = fuo.SetOriginalID(*id)
+	}
+	return fuo
+}
+
+// SetOriginal sets the "original" edge to the File entity.
+func (fuo *FileUpdateOne) SetOriginal(f *File) *FileUpdateOne {
+	return fuo.SetOriginalID(f.ID)
+}
+
+// AddThumbnailIDs adds the "thumbnails" edge to the File entity by IDs.
+func (fuo *FileUpdateOne) AddThumbnailIDs(ids ...pulid.ID) *FileUpdateOne {
+	fuo.mutation.AddThumbnailIDs(ids...)
+	return fuo
+}
+
+// AddThumbnails adds the "thumbnails" edges to the File entity.
+func (fuo *FileUpdateOne) AddThumbnails(f ...*File) *FileUpdateOne {
+	ids := make([]pulid.ID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fuo.AddThumbnailIDs(ids...)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (fuo *FileUpdateOne) SetUser(u *User) *FileUpdateOne {
+	return fuo.SetUserID(u.ID)
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (fuo *FileUpdateOne) SetRoom(r *Room) *FileUpdateOne {
+	return fuo.SetRoomID(r.ID)
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (fuo *FileUpdateOne) Mutation() *FileMutation {
 	return fuo.mutation
@@ -497,6 +970,45 @@ func (fuo *FileUpdateOne) ClearMessageVoice() *FileUpdateOne {
 	return fuo
 }
 
+// ClearOriginal clears the "original" edge to the File entity.
+func (fuo *FileUpdateOne) ClearOriginal() *FileUpdateOne {
+	fuo.mutation.ClearOriginal()
+	return fuo
+}
+
+// ClearThumbnails clears all "thumbnails" edges to the File entity.
+func (fuo *FileUpdateOne) ClearThumbnails() *FileUpdateOne {
+	fuo.mutation.ClearThumbnails()
+	return fuo
+}
+
+// RemoveThumbnailIDs removes the "thumbnails" edge to File entities by IDs.
+func (fuo *FileUpdateOne) RemoveThumbnailIDs(ids ...pulid.ID) *FileUpdateOne {
+	fuo.mutation.RemoveThumbnailIDs(ids...)
+	return fuo
+}
+
+// RemoveThumbnails removes "thumbnails" edges to File entities.
+func (fuo *FileUpdateOne) RemoveThumbnails(f ...*File) *FileUpdateOne {
+	ids := make([]pulid.ID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fuo.RemoveThumbnailIDs(ids...)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (fuo *FileUpdateOne) ClearUser() *FileUpdateOne {
+	fuo.mutation.ClearUser()
+	return fuo
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (fuo *FileUpdateOne) ClearRoom() *FileUpdateOne {
+	fuo.mutation.ClearRoom()
+	return fuo
+}
+
 // Where appends a list predicates to the FileUpdate builder.
 func (fuo *FileUpdateOne) Where(ps ...predicate.File) *FileUpdateOne {
 	fuo.mutation.Where(ps...)
@@ -512,7 +1024,9 @@ func (fuo *FileUpdateOne) Select(field string, fields ...string) *FileUpdateOne
 
 // Save executes the query and returns the updated File entity.
 func (fuo *FileUpdateOne) Save(ctx context.Context) (*File, error) {
-	fuo.defaults()
+	if err := fuo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, fuo.sqlSave, fuo.mutation, fuo.hooks)
 }
 
@@ -539,11 +1053,15 @@ func (fuo *FileUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (fuo *FileUpdateOne) defaults() {
+func (fuo *FileUpdateOne) defaults() error {
 	if _, ok := fuo.mutation.UpdatedAt(); !ok {
+		if file.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized file.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := file.UpdateDefaultUpdatedAt()
 		fuo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error) {
@@ -596,6 +1114,24 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error)
 	if value, ok := fuo.mutation.Path(); ok {
 		_spec.SetField(file.FieldPath, field.TypeString, value)
 	}
+	if value, ok := fuo.mutation.Width(); ok {
+		_spec.SetField(file.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := fuo.mutation.AddedWidth(); ok {
+		_spec.AddField(file.FieldWidth, field.TypeInt, value)
+	}
+	if fuo.mutation.WidthCleared() {
+		_spec.ClearField(file.FieldWidth, field.TypeInt)
+	}
+	if value, ok := fuo.mutation.Height(); ok {
+		_spec.SetField(file.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := fuo.mutation.AddedHeight(); ok {
+		_spec.AddField(file.FieldHeight, field.TypeInt, value)
+	}
+	if fuo.mutation.HeightCleared() {
+		_spec.ClearField(file.FieldHeight, field.TypeInt)
+	}
 	if value, ok := fuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(file.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -657,6 +1193,138 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if fuo.mutation.OriginalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.OriginalTable,
+			Columns: []string{file.OriginalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.OriginalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.OriginalTable,
+			Columns: []string{file.OriginalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fuo.mutation.ThumbnailsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.ThumbnailsTable,
+			Columns: []string{file.ThumbnailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.RemovedThumbnailsIDs(); len(nodes) > 0 && !fuo.mutation.ThumbnailsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.ThumbnailsTable,
+			Columns: []string{file.ThumbnailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.ThumbnailsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.ThumbnailsTable,
+			Columns: []string{file.ThumbnailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.UserTable,
+			Columns: []string{file.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.UserTable,
+			Columns: []string{file.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fuo.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.RoomTable,
+			Columns: []string{file.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.RoomTable,
+			Columns: []string{file.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &File{config: fuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
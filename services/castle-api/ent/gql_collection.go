@@ -6,6 +6,11 @@ import (
 	"context"
 	"database/sql/driver"
 	"fmt"
+	"journeyhub/ent/avatar"
+	"journeyhub/ent/call"
+	"journeyhub/ent/callparticipant"
+	"journeyhub/ent/contactpin"
+	"journeyhub/ent/contactrequest"
 	"journeyhub/ent/device"
 	"journeyhub/ent/file"
 	"journeyhub/ent/message"
@@ -13,7 +18,9 @@ import (
 	"journeyhub/ent/messagelink"
 	"journeyhub/ent/messagevoice"
 	"journeyhub/ent/notification"
+	"journeyhub/ent/report"
 	"journeyhub/ent/room"
+	"journeyhub/ent/roominvite"
 	"journeyhub/ent/roommember"
 	"journeyhub/ent/schema/pulid"
 	"journeyhub/ent/user"
@@ -24,6 +31,730 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 )
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (a *AvatarQuery) CollectFields(ctx context.Context, satisfies ...string) (*AvatarQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return a, nil
+	}
+	if err := a.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (a *AvatarQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(avatar.Columns))
+		selectedFields = []string{avatar.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "file":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&FileClient{config: a.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, fileImplementors)...); err != nil {
+				return err
+			}
+			a.withFile = query
+			if _, ok := fieldSeen[avatar.FieldFileID]; !ok {
+				selectedFields = append(selectedFields, avatar.FieldFileID)
+				fieldSeen[avatar.FieldFileID] = struct{}{}
+			}
+
+		case "user":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: a.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, userImplementors)...); err != nil {
+				return err
+			}
+			a.withUser = query
+			if _, ok := fieldSeen[avatar.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, avatar.FieldUserID)
+				fieldSeen[avatar.FieldUserID] = struct{}{}
+			}
+
+		case "room":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&RoomClient{config: a.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, roomImplementors)...); err != nil {
+				return err
+			}
+			a.withRoom = query
+			if _, ok := fieldSeen[avatar.FieldRoomID]; !ok {
+				selectedFields = append(selectedFields, avatar.FieldRoomID)
+				fieldSeen[avatar.FieldRoomID] = struct{}{}
+			}
+		case "fileID":
+			if _, ok := fieldSeen[avatar.FieldFileID]; !ok {
+				selectedFields = append(selectedFields, avatar.FieldFileID)
+				fieldSeen[avatar.FieldFileID] = struct{}{}
+			}
+		case "userID":
+			if _, ok := fieldSeen[avatar.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, avatar.FieldUserID)
+				fieldSeen[avatar.FieldUserID] = struct{}{}
+			}
+		case "roomID":
+			if _, ok := fieldSeen[avatar.FieldRoomID]; !ok {
+				selectedFields = append(selectedFields, avatar.FieldRoomID)
+				fieldSeen[avatar.FieldRoomID] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[avatar.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, avatar.FieldCreatedAt)
+				fieldSeen[avatar.FieldCreatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		a.Select(selectedFields...)
+	}
+	return nil
+}
+
+type avatarPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []AvatarPaginateOption
+}
+
+func newAvatarPaginateArgs(rv map[string]any) *avatarPaginateArgs {
+	args := &avatarPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &AvatarOrder{Field: &AvatarOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithAvatarOrder(order))
+			}
+		case *AvatarOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithAvatarOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*AvatarWhereInput); ok {
+		args.opts = append(args.opts, WithAvatarFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (c *CallQuery) CollectFields(ctx context.Context, satisfies ...string) (*CallQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return c, nil
+	}
+	if err := c.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *CallQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(call.Columns))
+		selectedFields = []string{call.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "room":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&RoomClient{config: c.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, roomImplementors)...); err != nil {
+				return err
+			}
+			c.withRoom = query
+			if _, ok := fieldSeen[call.FieldRoomID]; !ok {
+				selectedFields = append(selectedFields, call.FieldRoomID)
+				fieldSeen[call.FieldRoomID] = struct{}{}
+			}
+
+		case "caller":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: c.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, userImplementors)...); err != nil {
+				return err
+			}
+			c.withCaller = query
+			if _, ok := fieldSeen[call.FieldCallerID]; !ok {
+				selectedFields = append(selectedFields, call.FieldCallerID)
+				fieldSeen[call.FieldCallerID] = struct{}{}
+			}
+
+		case "participants":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&CallParticipantClient{config: c.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, callparticipantImplementors)...); err != nil {
+				return err
+			}
+			c.WithNamedParticipants(alias, func(wq *CallParticipantQuery) {
+				*wq = *query
+			})
+		case "type":
+			if _, ok := fieldSeen[call.FieldType]; !ok {
+				selectedFields = append(selectedFields, call.FieldType)
+				fieldSeen[call.FieldType] = struct{}{}
+			}
+		case "state":
+			if _, ok := fieldSeen[call.FieldState]; !ok {
+				selectedFields = append(selectedFields, call.FieldState)
+				fieldSeen[call.FieldState] = struct{}{}
+			}
+		case "roomID":
+			if _, ok := fieldSeen[call.FieldRoomID]; !ok {
+				selectedFields = append(selectedFields, call.FieldRoomID)
+				fieldSeen[call.FieldRoomID] = struct{}{}
+			}
+		case "callerID":
+			if _, ok := fieldSeen[call.FieldCallerID]; !ok {
+				selectedFields = append(selectedFields, call.FieldCallerID)
+				fieldSeen[call.FieldCallerID] = struct{}{}
+			}
+		case "startedAt":
+			if _, ok := fieldSeen[call.FieldStartedAt]; !ok {
+				selectedFields = append(selectedFields, call.FieldStartedAt)
+				fieldSeen[call.FieldStartedAt] = struct{}{}
+			}
+		case "answeredAt":
+			if _, ok := fieldSeen[call.FieldAnsweredAt]; !ok {
+				selectedFields = append(selectedFields, call.FieldAnsweredAt)
+				fieldSeen[call.FieldAnsweredAt] = struct{}{}
+			}
+		case "endedAt":
+			if _, ok := fieldSeen[call.FieldEndedAt]; !ok {
+				selectedFields = append(selectedFields, call.FieldEndedAt)
+				fieldSeen[call.FieldEndedAt] = struct{}{}
+			}
+		case "duration":
+			if _, ok := fieldSeen[call.FieldDuration]; !ok {
+				selectedFields = append(selectedFields, call.FieldDuration)
+				fieldSeen[call.FieldDuration] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		c.Select(selectedFields...)
+	}
+	return nil
+}
+
+type callPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []CallPaginateOption
+}
+
+func newCallPaginateArgs(rv map[string]any) *callPaginateArgs {
+	args := &callPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case []*CallOrder:
+			args.opts = append(args.opts, WithCallOrder(v))
+		case []any:
+			var orders []*CallOrder
+			for i := range v {
+				mv, ok := v[i].(map[string]any)
+				if !ok {
+					continue
+				}
+				var (
+					err1, err2 error
+					order      = &CallOrder{Field: &CallOrderField{}, Direction: entgql.OrderDirectionAsc}
+				)
+				if d, ok := mv[directionField]; ok {
+					err1 = order.Direction.UnmarshalGQL(d)
+				}
+				if f, ok := mv[fieldField]; ok {
+					err2 = order.Field.UnmarshalGQL(f)
+				}
+				if err1 == nil && err2 == nil {
+					orders = append(orders, order)
+				}
+			}
+			args.opts = append(args.opts, WithCallOrder(orders))
+		}
+	}
+	if v, ok := rv[whereField].(*CallWhereInput); ok {
+		args.opts = append(args.opts, WithCallFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (cp *CallParticipantQuery) CollectFields(ctx context.Context, satisfies ...string) (*CallParticipantQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return cp, nil
+	}
+	if err := cp.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
+
+func (cp *CallParticipantQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(callparticipant.Columns))
+		selectedFields = []string{callparticipant.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "call":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&CallClient{config: cp.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, callImplementors)...); err != nil {
+				return err
+			}
+			cp.withCall = query
+			if _, ok := fieldSeen[callparticipant.FieldCallID]; !ok {
+				selectedFields = append(selectedFields, callparticipant.FieldCallID)
+				fieldSeen[callparticipant.FieldCallID] = struct{}{}
+			}
+
+		case "user":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: cp.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, userImplementors)...); err != nil {
+				return err
+			}
+			cp.withUser = query
+			if _, ok := fieldSeen[callparticipant.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, callparticipant.FieldUserID)
+				fieldSeen[callparticipant.FieldUserID] = struct{}{}
+			}
+		case "state":
+			if _, ok := fieldSeen[callparticipant.FieldState]; !ok {
+				selectedFields = append(selectedFields, callparticipant.FieldState)
+				fieldSeen[callparticipant.FieldState] = struct{}{}
+			}
+		case "callID":
+			if _, ok := fieldSeen[callparticipant.FieldCallID]; !ok {
+				selectedFields = append(selectedFields, callparticipant.FieldCallID)
+				fieldSeen[callparticipant.FieldCallID] = struct{}{}
+			}
+		case "userID":
+			if _, ok := fieldSeen[callparticipant.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, callparticipant.FieldUserID)
+				fieldSeen[callparticipant.FieldUserID] = struct{}{}
+			}
+		case "invitedAt":
+			if _, ok := fieldSeen[callparticipant.FieldInvitedAt]; !ok {
+				selectedFields = append(selectedFields, callparticipant.FieldInvitedAt)
+				fieldSeen[callparticipant.FieldInvitedAt] = struct{}{}
+			}
+		case "joinedAt":
+			if _, ok := fieldSeen[callparticipant.FieldJoinedAt]; !ok {
+				selectedFields = append(selectedFields, callparticipant.FieldJoinedAt)
+				fieldSeen[callparticipant.FieldJoinedAt] = struct{}{}
+			}
+		case "leftAt":
+			if _, ok := fieldSeen[callparticipant.FieldLeftAt]; !ok {
+				selectedFields = append(selectedFields, callparticipant.FieldLeftAt)
+				fieldSeen[callparticipant.FieldLeftAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		cp.Select(selectedFields...)
+	}
+	return nil
+}
+
+type callparticipantPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []CallParticipantPaginateOption
+}
+
+func newCallParticipantPaginateArgs(rv map[string]any) *callparticipantPaginateArgs {
+	args := &callparticipantPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*CallParticipantWhereInput); ok {
+		args.opts = append(args.opts, WithCallParticipantFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (cp *ContactPinQuery) CollectFields(ctx context.Context, satisfies ...string) (*ContactPinQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return cp, nil
+	}
+	if err := cp.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
+
+func (cp *ContactPinQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(contactpin.Columns))
+		selectedFields = []string{contactpin.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "user":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: cp.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, userImplementors)...); err != nil {
+				return err
+			}
+			cp.withUser = query
+			if _, ok := fieldSeen[contactpin.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, contactpin.FieldUserID)
+				fieldSeen[contactpin.FieldUserID] = struct{}{}
+			}
+		case "userID":
+			if _, ok := fieldSeen[contactpin.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, contactpin.FieldUserID)
+				fieldSeen[contactpin.FieldUserID] = struct{}{}
+			}
+		case "expiresAt":
+			if _, ok := fieldSeen[contactpin.FieldExpiresAt]; !ok {
+				selectedFields = append(selectedFields, contactpin.FieldExpiresAt)
+				fieldSeen[contactpin.FieldExpiresAt] = struct{}{}
+			}
+		case "maxUses":
+			if _, ok := fieldSeen[contactpin.FieldMaxUses]; !ok {
+				selectedFields = append(selectedFields, contactpin.FieldMaxUses)
+				fieldSeen[contactpin.FieldMaxUses] = struct{}{}
+			}
+		case "uses":
+			if _, ok := fieldSeen[contactpin.FieldUses]; !ok {
+				selectedFields = append(selectedFields, contactpin.FieldUses)
+				fieldSeen[contactpin.FieldUses] = struct{}{}
+			}
+		case "revokedAt":
+			if _, ok := fieldSeen[contactpin.FieldRevokedAt]; !ok {
+				selectedFields = append(selectedFields, contactpin.FieldRevokedAt)
+				fieldSeen[contactpin.FieldRevokedAt] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[contactpin.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, contactpin.FieldCreatedAt)
+				fieldSeen[contactpin.FieldCreatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		cp.Select(selectedFields...)
+	}
+	return nil
+}
+
+type contactpinPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []ContactPinPaginateOption
+}
+
+func newContactPinPaginateArgs(rv map[string]any) *contactpinPaginateArgs {
+	args := &contactpinPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case []*ContactPinOrder:
+			args.opts = append(args.opts, WithContactPinOrder(v))
+		case []any:
+			var orders []*ContactPinOrder
+			for i := range v {
+				mv, ok := v[i].(map[string]any)
+				if !ok {
+					continue
+				}
+				var (
+					err1, err2 error
+					order      = &ContactPinOrder{Field: &ContactPinOrderField{}, Direction: entgql.OrderDirectionAsc}
+				)
+				if d, ok := mv[directionField]; ok {
+					err1 = order.Direction.UnmarshalGQL(d)
+				}
+				if f, ok := mv[fieldField]; ok {
+					err2 = order.Field.UnmarshalGQL(f)
+				}
+				if err1 == nil && err2 == nil {
+					orders = append(orders, order)
+				}
+			}
+			args.opts = append(args.opts, WithContactPinOrder(orders))
+		}
+	}
+	if v, ok := rv[whereField].(*ContactPinWhereInput); ok {
+		args.opts = append(args.opts, WithContactPinFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (cr *ContactRequestQuery) CollectFields(ctx context.Context, satisfies ...string) (*ContactRequestQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return cr, nil
+	}
+	if err := cr.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return cr, nil
+}
+
+func (cr *ContactRequestQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(contactrequest.Columns))
+		selectedFields = []string{contactrequest.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "sender":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: cr.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, userImplementors)...); err != nil {
+				return err
+			}
+			cr.withSender = query
+			if _, ok := fieldSeen[contactrequest.FieldSenderID]; !ok {
+				selectedFields = append(selectedFields, contactrequest.FieldSenderID)
+				fieldSeen[contactrequest.FieldSenderID] = struct{}{}
+			}
+
+		case "receiver":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: cr.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, userImplementors)...); err != nil {
+				return err
+			}
+			cr.withReceiver = query
+			if _, ok := fieldSeen[contactrequest.FieldReceiverID]; !ok {
+				selectedFields = append(selectedFields, contactrequest.FieldReceiverID)
+				fieldSeen[contactrequest.FieldReceiverID] = struct{}{}
+			}
+		case "senderID":
+			if _, ok := fieldSeen[contactrequest.FieldSenderID]; !ok {
+				selectedFields = append(selectedFields, contactrequest.FieldSenderID)
+				fieldSeen[contactrequest.FieldSenderID] = struct{}{}
+			}
+		case "receiverID":
+			if _, ok := fieldSeen[contactrequest.FieldReceiverID]; !ok {
+				selectedFields = append(selectedFields, contactrequest.FieldReceiverID)
+				fieldSeen[contactrequest.FieldReceiverID] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[contactrequest.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, contactrequest.FieldStatus)
+				fieldSeen[contactrequest.FieldStatus] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[contactrequest.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, contactrequest.FieldCreatedAt)
+				fieldSeen[contactrequest.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[contactrequest.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, contactrequest.FieldUpdatedAt)
+				fieldSeen[contactrequest.FieldUpdatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		cr.Select(selectedFields...)
+	}
+	return nil
+}
+
+type contactrequestPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []ContactRequestPaginateOption
+}
+
+func newContactRequestPaginateArgs(rv map[string]any) *contactrequestPaginateArgs {
+	args := &contactrequestPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case []*ContactRequestOrder:
+			args.opts = append(args.opts, WithContactRequestOrder(v))
+		case []any:
+			var orders []*ContactRequestOrder
+			for i := range v {
+				mv, ok := v[i].(map[string]any)
+				if !ok {
+					continue
+				}
+				var (
+					err1, err2 error
+					order      = &ContactRequestOrder{Field: &ContactRequestOrderField{}, Direction: entgql.OrderDirectionAsc}
+				)
+				if d, ok := mv[directionField]; ok {
+					err1 = order.Direction.UnmarshalGQL(d)
+				}
+				if f, ok := mv[fieldField]; ok {
+					err2 = order.Field.UnmarshalGQL(f)
+				}
+				if err1 == nil && err2 == nil {
+					orders = append(orders, order)
+				}
+			}
+			args.opts = append(args.opts, WithContactRequestOrder(orders))
+		}
+	}
+	if v, ok := rv[whereField].(*ContactRequestWhereInput); ok {
+		args.opts = append(args.opts, WithContactRequestFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (d *DeviceQuery) CollectFields(ctx context.Context, satisfies ...string) (*DeviceQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -61,10 +792,20 @@ func (d *DeviceQuery) collectField(ctx context.Context, oneNode bool, opCtx *gra
 				selectedFields = append(selectedFields, device.FieldDeviceID)
 				fieldSeen[device.FieldDeviceID] = struct{}{}
 			}
-		case "fcmToken":
-			if _, ok := fieldSeen[device.FieldFcmToken]; !ok {
-				selectedFields = append(selectedFields, device.FieldFcmToken)
-				fieldSeen[device.FieldFcmToken] = struct{}{}
+		case "platform":
+			if _, ok := fieldSeen[device.FieldPlatform]; !ok {
+				selectedFields = append(selectedFields, device.FieldPlatform)
+				fieldSeen[device.FieldPlatform] = struct{}{}
+			}
+		case "lastPushAt":
+			if _, ok := fieldSeen[device.FieldLastPushAt]; !ok {
+				selectedFields = append(selectedFields, device.FieldLastPushAt)
+				fieldSeen[device.FieldLastPushAt] = struct{}{}
+			}
+		case "lastPushError":
+			if _, ok := fieldSeen[device.FieldLastPushError]; !ok {
+				selectedFields = append(selectedFields, device.FieldLastPushError)
+				fieldSeen[device.FieldLastPushError] = struct{}{}
 			}
 		case "createdAt":
 			if _, ok := fieldSeen[device.FieldCreatedAt]; !ok {
@@ -188,6 +929,30 @@ func (f *FileQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 				return err
 			}
 			f.withMessageVoice = query
+
+		case "original":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&FileClient{config: f.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, fileImplementors)...); err != nil {
+				return err
+			}
+			f.withOriginal = query
+
+		case "thumbnails":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&FileClient{config: f.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, fileImplementors)...); err != nil {
+				return err
+			}
+			f.WithNamedThumbnails(alias, func(wq *FileQuery) {
+				*wq = *query
+			})
 		case "name":
 			if _, ok := fieldSeen[file.FieldName]; !ok {
 				selectedFields = append(selectedFields, file.FieldName)
@@ -218,6 +983,16 @@ func (f *FileQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 				selectedFields = append(selectedFields, file.FieldPath)
 				fieldSeen[file.FieldPath] = struct{}{}
 			}
+		case "width":
+			if _, ok := fieldSeen[file.FieldWidth]; !ok {
+				selectedFields = append(selectedFields, file.FieldWidth)
+				fieldSeen[file.FieldWidth] = struct{}{}
+			}
+		case "height":
+			if _, ok := fieldSeen[file.FieldHeight]; !ok {
+				selectedFields = append(selectedFields, file.FieldHeight)
+				fieldSeen[file.FieldHeight] = struct{}{}
+			}
 		case "createdAt":
 			if _, ok := fieldSeen[file.FieldCreatedAt]; !ok {
 				selectedFields = append(selectedFields, file.FieldCreatedAt)
@@ -374,6 +1149,36 @@ func (m *MessageQuery) collectField(ctx context.Context, oneNode bool, opCtx *gr
 				*wq = *query
 			})
 
+		case "call":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&CallClient{config: m.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, callImplementors)...); err != nil {
+				return err
+			}
+			m.withCall = query
+			if _, ok := fieldSeen[message.FieldCallID]; !ok {
+				selectedFields = append(selectedFields, message.FieldCallID)
+				fieldSeen[message.FieldCallID] = struct{}{}
+			}
+
+		case "avatar":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&AvatarClient{config: m.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, avatarImplementors)...); err != nil {
+				return err
+			}
+			m.withAvatar = query
+			if _, ok := fieldSeen[message.FieldAvatarID]; !ok {
+				selectedFields = append(selectedFields, message.FieldAvatarID)
+				fieldSeen[message.FieldAvatarID] = struct{}{}
+			}
+
 		case "user":
 			var (
 				alias = field.Alias
@@ -395,11 +1200,26 @@ func (m *MessageQuery) collectField(ctx context.Context, oneNode bool, opCtx *gr
 				return err
 			}
 			m.withRoom = query
+		case "type":
+			if _, ok := fieldSeen[message.FieldType]; !ok {
+				selectedFields = append(selectedFields, message.FieldType)
+				fieldSeen[message.FieldType] = struct{}{}
+			}
 		case "content":
 			if _, ok := fieldSeen[message.FieldContent]; !ok {
 				selectedFields = append(selectedFields, message.FieldContent)
 				fieldSeen[message.FieldContent] = struct{}{}
 			}
+		case "callID":
+			if _, ok := fieldSeen[message.FieldCallID]; !ok {
+				selectedFields = append(selectedFields, message.FieldCallID)
+				fieldSeen[message.FieldCallID] = struct{}{}
+			}
+		case "avatarID":
+			if _, ok := fieldSeen[message.FieldAvatarID]; !ok {
+				selectedFields = append(selectedFields, message.FieldAvatarID)
+				fieldSeen[message.FieldAvatarID] = struct{}{}
+			}
 		case "createdAt":
 			if _, ok := fieldSeen[message.FieldCreatedAt]; !ok {
 				selectedFields = append(selectedFields, message.FieldCreatedAt)
@@ -924,6 +1744,11 @@ func (n *NotificationQuery) collectField(ctx context.Context, oneNode bool, opCt
 				return err
 			}
 			n.withUser = query
+		case "type":
+			if _, ok := fieldSeen[notification.FieldType]; !ok {
+				selectedFields = append(selectedFields, notification.FieldType)
+				fieldSeen[notification.FieldType] = struct{}{}
+			}
 		case "title":
 			if _, ok := fieldSeen[notification.FieldTitle]; !ok {
 				selectedFields = append(selectedFields, notification.FieldTitle)
@@ -939,6 +1764,11 @@ func (n *NotificationQuery) collectField(ctx context.Context, oneNode bool, opCt
 				selectedFields = append(selectedFields, notification.FieldData)
 				fieldSeen[notification.FieldData] = struct{}{}
 			}
+		case "readAt":
+			if _, ok := fieldSeen[notification.FieldReadAt]; !ok {
+				selectedFields = append(selectedFields, notification.FieldReadAt)
+				fieldSeen[notification.FieldReadAt] = struct{}{}
+			}
 		case "createdAt":
 			if _, ok := fieldSeen[notification.FieldCreatedAt]; !ok {
 				selectedFields = append(selectedFields, notification.FieldCreatedAt)
@@ -1018,6 +1848,246 @@ func newNotificationPaginateArgs(rv map[string]any) *notificationPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (r *ReportQuery) CollectFields(ctx context.Context, satisfies ...string) (*ReportQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return r, nil
+	}
+	if err := r.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *ReportQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(report.Columns))
+		selectedFields = []string{report.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "reporter":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: r.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, userImplementors)...); err != nil {
+				return err
+			}
+			r.withReporter = query
+			if _, ok := fieldSeen[report.FieldReporterID]; !ok {
+				selectedFields = append(selectedFields, report.FieldReporterID)
+				fieldSeen[report.FieldReporterID] = struct{}{}
+			}
+
+		case "user":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: r.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, userImplementors)...); err != nil {
+				return err
+			}
+			r.withUser = query
+			if _, ok := fieldSeen[report.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, report.FieldUserID)
+				fieldSeen[report.FieldUserID] = struct{}{}
+			}
+
+		case "message":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&MessageClient{config: r.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, messageImplementors)...); err != nil {
+				return err
+			}
+			r.withMessage = query
+			if _, ok := fieldSeen[report.FieldMessageID]; !ok {
+				selectedFields = append(selectedFields, report.FieldMessageID)
+				fieldSeen[report.FieldMessageID] = struct{}{}
+			}
+
+		case "room":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&RoomClient{config: r.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, roomImplementors)...); err != nil {
+				return err
+			}
+			r.withRoom = query
+			if _, ok := fieldSeen[report.FieldRoomID]; !ok {
+				selectedFields = append(selectedFields, report.FieldRoomID)
+				fieldSeen[report.FieldRoomID] = struct{}{}
+			}
+
+		case "assignee":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: r.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, userImplementors)...); err != nil {
+				return err
+			}
+			r.withAssignee = query
+			if _, ok := fieldSeen[report.FieldAssigneeID]; !ok {
+				selectedFields = append(selectedFields, report.FieldAssigneeID)
+				fieldSeen[report.FieldAssigneeID] = struct{}{}
+			}
+		case "type":
+			if _, ok := fieldSeen[report.FieldType]; !ok {
+				selectedFields = append(selectedFields, report.FieldType)
+				fieldSeen[report.FieldType] = struct{}{}
+			}
+		case "reason":
+			if _, ok := fieldSeen[report.FieldReason]; !ok {
+				selectedFields = append(selectedFields, report.FieldReason)
+				fieldSeen[report.FieldReason] = struct{}{}
+			}
+		case "text":
+			if _, ok := fieldSeen[report.FieldText]; !ok {
+				selectedFields = append(selectedFields, report.FieldText)
+				fieldSeen[report.FieldText] = struct{}{}
+			}
+		case "snapshot":
+			if _, ok := fieldSeen[report.FieldSnapshot]; !ok {
+				selectedFields = append(selectedFields, report.FieldSnapshot)
+				fieldSeen[report.FieldSnapshot] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[report.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, report.FieldStatus)
+				fieldSeen[report.FieldStatus] = struct{}{}
+			}
+		case "actions":
+			if _, ok := fieldSeen[report.FieldActions]; !ok {
+				selectedFields = append(selectedFields, report.FieldActions)
+				fieldSeen[report.FieldActions] = struct{}{}
+			}
+		case "note":
+			if _, ok := fieldSeen[report.FieldNote]; !ok {
+				selectedFields = append(selectedFields, report.FieldNote)
+				fieldSeen[report.FieldNote] = struct{}{}
+			}
+		case "reporterID":
+			if _, ok := fieldSeen[report.FieldReporterID]; !ok {
+				selectedFields = append(selectedFields, report.FieldReporterID)
+				fieldSeen[report.FieldReporterID] = struct{}{}
+			}
+		case "userID":
+			if _, ok := fieldSeen[report.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, report.FieldUserID)
+				fieldSeen[report.FieldUserID] = struct{}{}
+			}
+		case "messageID":
+			if _, ok := fieldSeen[report.FieldMessageID]; !ok {
+				selectedFields = append(selectedFields, report.FieldMessageID)
+				fieldSeen[report.FieldMessageID] = struct{}{}
+			}
+		case "roomID":
+			if _, ok := fieldSeen[report.FieldRoomID]; !ok {
+				selectedFields = append(selectedFields, report.FieldRoomID)
+				fieldSeen[report.FieldRoomID] = struct{}{}
+			}
+		case "assigneeID":
+			if _, ok := fieldSeen[report.FieldAssigneeID]; !ok {
+				selectedFields = append(selectedFields, report.FieldAssigneeID)
+				fieldSeen[report.FieldAssigneeID] = struct{}{}
+			}
+		case "closedAt":
+			if _, ok := fieldSeen[report.FieldClosedAt]; !ok {
+				selectedFields = append(selectedFields, report.FieldClosedAt)
+				fieldSeen[report.FieldClosedAt] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[report.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, report.FieldCreatedAt)
+				fieldSeen[report.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[report.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, report.FieldUpdatedAt)
+				fieldSeen[report.FieldUpdatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		r.Select(selectedFields...)
+	}
+	return nil
+}
+
+type reportPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []ReportPaginateOption
+}
+
+func newReportPaginateArgs(rv map[string]any) *reportPaginateArgs {
+	args := &reportPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case []*ReportOrder:
+			args.opts = append(args.opts, WithReportOrder(v))
+		case []any:
+			var orders []*ReportOrder
+			for i := range v {
+				mv, ok := v[i].(map[string]any)
+				if !ok {
+					continue
+				}
+				var (
+					err1, err2 error
+					order      = &ReportOrder{Field: &ReportOrderField{}, Direction: entgql.OrderDirectionAsc}
+				)
+				if d, ok := mv[directionField]; ok {
+					err1 = order.Direction.UnmarshalGQL(d)
+				}
+				if f, ok := mv[fieldField]; ok {
+					err2 = order.Field.UnmarshalGQL(f)
+				}
+				if err1 == nil && err2 == nil {
+					orders = append(orders, order)
+				}
+			}
+			args.opts = append(args.opts, WithReportOrder(orders))
+		}
+	}
+	if v, ok := rv[whereField].(*ReportWhereInput); ok {
+		args.opts = append(args.opts, WithReportFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (r *RoomQuery) CollectFields(ctx context.Context, satisfies ...string) (*RoomQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -1513,6 +2583,34 @@ func (r *RoomQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 				*wq = *query
 			})
 
+		case "avatar":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&FileClient{config: r.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, fileImplementors)...); err != nil {
+				return err
+			}
+			r.withAvatar = query
+			if _, ok := fieldSeen[room.FieldAvatarID]; !ok {
+				selectedFields = append(selectedFields, room.FieldAvatarID)
+				fieldSeen[room.FieldAvatarID] = struct{}{}
+			}
+
+		case "avatars":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&AvatarClient{config: r.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, avatarImplementors)...); err != nil {
+				return err
+			}
+			r.WithNamedAvatars(alias, func(wq *AvatarQuery) {
+				*wq = *query
+			})
+
 		case "roomMembers":
 			var (
 				alias = field.Alias
@@ -1556,10 +2654,10 @@ func (r *RoomQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 						}
 						for i := range nodes {
 							n := m[nodes[i].ID]
-							if nodes[i].Edges.totalCount[7] == nil {
-								nodes[i].Edges.totalCount[7] = make(map[string]int)
+							if nodes[i].Edges.totalCount[9] == nil {
+								nodes[i].Edges.totalCount[9] = make(map[string]int)
 							}
-							nodes[i].Edges.totalCount[7][alias] = n
+							nodes[i].Edges.totalCount[9][alias] = n
 						}
 						return nil
 					})
@@ -1567,10 +2665,10 @@ func (r *RoomQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 					r.loadTotal = append(r.loadTotal, func(_ context.Context, nodes []*Room) error {
 						for i := range nodes {
 							n := len(nodes[i].Edges.RoomMembers)
-							if nodes[i].Edges.totalCount[7] == nil {
-								nodes[i].Edges.totalCount[7] = make(map[string]int)
+							if nodes[i].Edges.totalCount[9] == nil {
+								nodes[i].Edges.totalCount[9] = make(map[string]int)
 							}
-							nodes[i].Edges.totalCount[7][alias] = n
+							nodes[i].Edges.totalCount[9][alias] = n
 						}
 						return nil
 					})
@@ -1616,6 +2714,11 @@ func (r *RoomQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 				selectedFields = append(selectedFields, room.FieldVersion)
 				fieldSeen[room.FieldVersion] = struct{}{}
 			}
+		case "avatarID":
+			if _, ok := fieldSeen[room.FieldAvatarID]; !ok {
+				selectedFields = append(selectedFields, room.FieldAvatarID)
+				fieldSeen[room.FieldAvatarID] = struct{}{}
+			}
 		case "type":
 			if _, ok := fieldSeen[room.FieldType]; !ok {
 				selectedFields = append(selectedFields, room.FieldType)
@@ -1700,6 +2803,161 @@ func newRoomPaginateArgs(rv map[string]any) *roomPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (ri *RoomInviteQuery) CollectFields(ctx context.Context, satisfies ...string) (*RoomInviteQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return ri, nil
+	}
+	if err := ri.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return ri, nil
+}
+
+func (ri *RoomInviteQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(roominvite.Columns))
+		selectedFields = []string{roominvite.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "room":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&RoomClient{config: ri.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, roomImplementors)...); err != nil {
+				return err
+			}
+			ri.withRoom = query
+			if _, ok := fieldSeen[roominvite.FieldRoomID]; !ok {
+				selectedFields = append(selectedFields, roominvite.FieldRoomID)
+				fieldSeen[roominvite.FieldRoomID] = struct{}{}
+			}
+
+		case "creator":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: ri.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, userImplementors)...); err != nil {
+				return err
+			}
+			ri.withCreator = query
+			if _, ok := fieldSeen[roominvite.FieldCreatorID]; !ok {
+				selectedFields = append(selectedFields, roominvite.FieldCreatorID)
+				fieldSeen[roominvite.FieldCreatorID] = struct{}{}
+			}
+		case "roomID":
+			if _, ok := fieldSeen[roominvite.FieldRoomID]; !ok {
+				selectedFields = append(selectedFields, roominvite.FieldRoomID)
+				fieldSeen[roominvite.FieldRoomID] = struct{}{}
+			}
+		case "creatorID":
+			if _, ok := fieldSeen[roominvite.FieldCreatorID]; !ok {
+				selectedFields = append(selectedFields, roominvite.FieldCreatorID)
+				fieldSeen[roominvite.FieldCreatorID] = struct{}{}
+			}
+		case "expiresAt":
+			if _, ok := fieldSeen[roominvite.FieldExpiresAt]; !ok {
+				selectedFields = append(selectedFields, roominvite.FieldExpiresAt)
+				fieldSeen[roominvite.FieldExpiresAt] = struct{}{}
+			}
+		case "maxUses":
+			if _, ok := fieldSeen[roominvite.FieldMaxUses]; !ok {
+				selectedFields = append(selectedFields, roominvite.FieldMaxUses)
+				fieldSeen[roominvite.FieldMaxUses] = struct{}{}
+			}
+		case "uses":
+			if _, ok := fieldSeen[roominvite.FieldUses]; !ok {
+				selectedFields = append(selectedFields, roominvite.FieldUses)
+				fieldSeen[roominvite.FieldUses] = struct{}{}
+			}
+		case "revokedAt":
+			if _, ok := fieldSeen[roominvite.FieldRevokedAt]; !ok {
+				selectedFields = append(selectedFields, roominvite.FieldRevokedAt)
+				fieldSeen[roominvite.FieldRevokedAt] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[roominvite.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, roominvite.FieldCreatedAt)
+				fieldSeen[roominvite.FieldCreatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		ri.Select(selectedFields...)
+	}
+	return nil
+}
+
+type roominvitePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []RoomInvitePaginateOption
+}
+
+func newRoomInvitePaginateArgs(rv map[string]any) *roominvitePaginateArgs {
+	args := &roominvitePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case []*RoomInviteOrder:
+			args.opts = append(args.opts, WithRoomInviteOrder(v))
+		case []any:
+			var orders []*RoomInviteOrder
+			for i := range v {
+				mv, ok := v[i].(map[string]any)
+				if !ok {
+					continue
+				}
+				var (
+					err1, err2 error
+					order      = &RoomInviteOrder{Field: &RoomInviteOrderField{}, Direction: entgql.OrderDirectionAsc}
+				)
+				if d, ok := mv[directionField]; ok {
+					err1 = order.Direction.UnmarshalGQL(d)
+				}
+				if f, ok := mv[fieldField]; ok {
+					err2 = order.Field.UnmarshalGQL(f)
+				}
+				if err1 == nil && err2 == nil {
+					orders = append(orders, order)
+				}
+			}
+			args.opts = append(args.opts, WithRoomInviteOrder(orders))
+		}
+	}
+	if v, ok := rv[whereField].(*RoomInviteWhereInput); ok {
+		args.opts = append(args.opts, WithRoomInviteFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (rm *RoomMemberQuery) CollectFields(ctx context.Context, satisfies ...string) (*RoomMemberQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -1761,6 +3019,21 @@ func (rm *RoomMemberQuery) collectField(ctx context.Context, oneNode bool, opCtx
 				selectedFields = append(selectedFields, roommember.FieldUnreadMessagesCount)
 				fieldSeen[roommember.FieldUnreadMessagesCount] = struct{}{}
 			}
+		case "notificationLevel":
+			if _, ok := fieldSeen[roommember.FieldNotificationLevel]; !ok {
+				selectedFields = append(selectedFields, roommember.FieldNotificationLevel)
+				fieldSeen[roommember.FieldNotificationLevel] = struct{}{}
+			}
+		case "muteUntil":
+			if _, ok := fieldSeen[roommember.FieldMuteUntil]; !ok {
+				selectedFields = append(selectedFields, roommember.FieldMuteUntil)
+				fieldSeen[roommember.FieldMuteUntil] = struct{}{}
+			}
+		case "role":
+			if _, ok := fieldSeen[roommember.FieldRole]; !ok {
+				selectedFields = append(selectedFields, roommember.FieldRole)
+				fieldSeen[roommember.FieldRole] = struct{}{}
+			}
 		case "userID":
 			if _, ok := fieldSeen[roommember.FieldUserID]; !ok {
 				selectedFields = append(selectedFields, roommember.FieldUserID)
@@ -1883,95 +3156,6 @@ func (u *UserQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 			}
 			u.withDevice = query
 
-		case "notifications":
-			var (
-				alias = field.Alias
-				path  = append(path, alias)
-				query = (&NotificationClient{config: u.config}).Query()
-			)
-			args := newNotificationPaginateArgs(fieldArgs(ctx, new(NotificationWhereInput), path...))
-			if err := validateFirstLast(args.first, args.last); err != nil {
-				return fmt.Errorf("validate first and last in path %q: %w", path, err)
-			}
-			pager, err := newNotificationPager(args.opts, args.last != nil)
-			if err != nil {
-				return fmt.Errorf("create new pager in path %q: %w", path, err)
-			}
-			if query, err = pager.applyFilter(query); err != nil {
-				return err
-			}
-			ignoredEdges := !hasCollectedField(ctx, append(path, edgesField)...)
-			if hasCollectedField(ctx, append(path, totalCountField)...) || hasCollectedField(ctx, append(path, pageInfoField)...) {
-				hasPagination := args.after != nil || args.first != nil || args.before != nil || args.last != nil
-				if hasPagination || ignoredEdges {
-					query := query.Clone()
-					u.loadTotal = append(u.loadTotal, func(ctx context.Context, nodes []*User) error {
-						ids := make([]driver.Value, len(nodes))
-						for i := range nodes {
-							ids[i] = nodes[i].ID
-						}
-						var v []struct {
-							NodeID pulid.ID `sql:"user_notifications"`
-							Count  int      `sql:"count"`
-						}
-						query.Where(func(s *sql.Selector) {
-							s.Where(sql.InValues(s.C(user.NotificationsColumn), ids...))
-						})
-						if err := query.GroupBy(user.NotificationsColumn).Aggregate(Count()).Scan(ctx, &v); err != nil {
-							return err
-						}
-						m := make(map[pulid.ID]int, len(v))
-						for i := range v {
-							m[v[i].NodeID] = v[i].Count
-						}
-						for i := range nodes {
-							n := m[nodes[i].ID]
-							if nodes[i].Edges.totalCount[1] == nil {
-								nodes[i].Edges.totalCount[1] = make(map[string]int)
-							}
-							nodes[i].Edges.totalCount[1][alias] = n
-						}
-						return nil
-					})
-				} else {
-					u.loadTotal = append(u.loadTotal, func(_ context.Context, nodes []*User) error {
-						for i := range nodes {
-							n := len(nodes[i].Edges.Notifications)
-							if nodes[i].Edges.totalCount[1] == nil {
-								nodes[i].Edges.totalCount[1] = make(map[string]int)
-							}
-							nodes[i].Edges.totalCount[1][alias] = n
-						}
-						return nil
-					})
-				}
-			}
-			if ignoredEdges || (args.first != nil && *args.first == 0) || (args.last != nil && *args.last == 0) {
-				continue
-			}
-			if query, err = pager.applyCursors(query, args.after, args.before); err != nil {
-				return err
-			}
-			path = append(path, edgesField, nodeField)
-			if field := collectedField(ctx, path...); field != nil {
-				if err := query.collectField(ctx, false, opCtx, *field, path, mayAddCondition(satisfies, notificationImplementors)...); err != nil {
-					return err
-				}
-			}
-			if limit := paginateLimit(args.first, args.last); limit > 0 {
-				if oneNode {
-					pager.applyOrder(query.Limit(limit))
-				} else {
-					modify := entgql.LimitPerRow(user.NotificationsColumn, limit, pager.orderExpr(query))
-					query.modifiers = append(query.modifiers, modify)
-				}
-			} else {
-				query = pager.applyOrder(query)
-			}
-			u.WithNamedNotifications(alias, func(wq *NotificationQuery) {
-				*wq = *query
-			})
-
 		case "contacts":
 			var (
 				alias = field.Alias
@@ -2019,10 +3203,10 @@ func (u *UserQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 						}
 						for i := range nodes {
 							n := m[nodes[i].ID]
-							if nodes[i].Edges.totalCount[2] == nil {
-								nodes[i].Edges.totalCount[2] = make(map[string]int)
+							if nodes[i].Edges.totalCount[1] == nil {
+								nodes[i].Edges.totalCount[1] = make(map[string]int)
 							}
-							nodes[i].Edges.totalCount[2][alias] = n
+							nodes[i].Edges.totalCount[1][alias] = n
 						}
 						return nil
 					})
@@ -2030,10 +3214,10 @@ func (u *UserQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 					u.loadTotal = append(u.loadTotal, func(_ context.Context, nodes []*User) error {
 						for i := range nodes {
 							n := len(nodes[i].Edges.Contacts)
-							if nodes[i].Edges.totalCount[2] == nil {
-								nodes[i].Edges.totalCount[2] = make(map[string]int)
+							if nodes[i].Edges.totalCount[1] == nil {
+								nodes[i].Edges.totalCount[1] = make(map[string]int)
 							}
-							nodes[i].Edges.totalCount[2][alias] = n
+							nodes[i].Edges.totalCount[1][alias] = n
 						}
 						return nil
 					})
@@ -2112,10 +3296,10 @@ func (u *UserQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 						}
 						for i := range nodes {
 							n := m[nodes[i].ID]
-							if nodes[i].Edges.totalCount[3] == nil {
-								nodes[i].Edges.totalCount[3] = make(map[string]int)
+							if nodes[i].Edges.totalCount[2] == nil {
+								nodes[i].Edges.totalCount[2] = make(map[string]int)
 							}
-							nodes[i].Edges.totalCount[3][alias] = n
+							nodes[i].Edges.totalCount[2][alias] = n
 						}
 						return nil
 					})
@@ -2123,10 +3307,10 @@ func (u *UserQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 					u.loadTotal = append(u.loadTotal, func(_ context.Context, nodes []*User) error {
 						for i := range nodes {
 							n := len(nodes[i].Edges.Rooms)
-							if nodes[i].Edges.totalCount[3] == nil {
-								nodes[i].Edges.totalCount[3] = make(map[string]int)
+							if nodes[i].Edges.totalCount[2] == nil {
+								nodes[i].Edges.totalCount[2] = make(map[string]int)
 							}
-							nodes[i].Edges.totalCount[3][alias] = n
+							nodes[i].Edges.totalCount[2][alias] = n
 						}
 						return nil
 					})
@@ -2201,10 +3385,10 @@ func (u *UserQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 						}
 						for i := range nodes {
 							n := m[nodes[i].ID]
-							if nodes[i].Edges.totalCount[4] == nil {
-								nodes[i].Edges.totalCount[4] = make(map[string]int)
+							if nodes[i].Edges.totalCount[3] == nil {
+								nodes[i].Edges.totalCount[3] = make(map[string]int)
 							}
-							nodes[i].Edges.totalCount[4][alias] = n
+							nodes[i].Edges.totalCount[3][alias] = n
 						}
 						return nil
 					})
@@ -2212,10 +3396,10 @@ func (u *UserQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 					u.loadTotal = append(u.loadTotal, func(_ context.Context, nodes []*User) error {
 						for i := range nodes {
 							n := len(nodes[i].Edges.Messages)
-							if nodes[i].Edges.totalCount[4] == nil {
-								nodes[i].Edges.totalCount[4] = make(map[string]int)
+							if nodes[i].Edges.totalCount[3] == nil {
+								nodes[i].Edges.totalCount[3] = make(map[string]int)
 							}
-							nodes[i].Edges.totalCount[4][alias] = n
+							nodes[i].Edges.totalCount[3][alias] = n
 						}
 						return nil
 					})
@@ -2247,6 +3431,34 @@ func (u *UserQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 				*wq = *query
 			})
 
+		case "avatar":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&FileClient{config: u.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, fileImplementors)...); err != nil {
+				return err
+			}
+			u.withAvatar = query
+			if _, ok := fieldSeen[user.FieldAvatarID]; !ok {
+				selectedFields = append(selectedFields, user.FieldAvatarID)
+				fieldSeen[user.FieldAvatarID] = struct{}{}
+			}
+
+		case "avatars":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&AvatarClient{config: u.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, avatarImplementors)...); err != nil {
+				return err
+			}
+			u.WithNamedAvatars(alias, func(wq *AvatarQuery) {
+				*wq = *query
+			})
+
 		case "userContacts":
 			var (
 				alias = field.Alias
@@ -2290,10 +3502,10 @@ func (u *UserQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 						}
 						for i := range nodes {
 							n := m[nodes[i].ID]
-							if nodes[i].Edges.totalCount[5] == nil {
-								nodes[i].Edges.totalCount[5] = make(map[string]int)
+							if nodes[i].Edges.totalCount[6] == nil {
+								nodes[i].Edges.totalCount[6] = make(map[string]int)
 							}
-							nodes[i].Edges.totalCount[5][alias] = n
+							nodes[i].Edges.totalCount[6][alias] = n
 						}
 						return nil
 					})
@@ -2301,10 +3513,10 @@ func (u *UserQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 					u.loadTotal = append(u.loadTotal, func(_ context.Context, nodes []*User) error {
 						for i := range nodes {
 							n := len(nodes[i].Edges.UserContacts)
-							if nodes[i].Edges.totalCount[5] == nil {
-								nodes[i].Edges.totalCount[5] = make(map[string]int)
+							if nodes[i].Edges.totalCount[6] == nil {
+								nodes[i].Edges.totalCount[6] = make(map[string]int)
 							}
-							nodes[i].Edges.totalCount[5][alias] = n
+							nodes[i].Edges.totalCount[6][alias] = n
 						}
 						return nil
 					})
@@ -2379,10 +3591,10 @@ func (u *UserQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 						}
 						for i := range nodes {
 							n := m[nodes[i].ID]
-							if nodes[i].Edges.totalCount[6] == nil {
-								nodes[i].Edges.totalCount[6] = make(map[string]int)
+							if nodes[i].Edges.totalCount[7] == nil {
+								nodes[i].Edges.totalCount[7] = make(map[string]int)
 							}
-							nodes[i].Edges.totalCount[6][alias] = n
+							nodes[i].Edges.totalCount[7][alias] = n
 						}
 						return nil
 					})
@@ -2390,10 +3602,10 @@ func (u *UserQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 					u.loadTotal = append(u.loadTotal, func(_ context.Context, nodes []*User) error {
 						for i := range nodes {
 							n := len(nodes[i].Edges.Memberships)
-							if nodes[i].Edges.totalCount[6] == nil {
-								nodes[i].Edges.totalCount[6] = make(map[string]int)
+							if nodes[i].Edges.totalCount[7] == nil {
+								nodes[i].Edges.totalCount[7] = make(map[string]int)
 							}
-							nodes[i].Edges.totalCount[6][alias] = n
+							nodes[i].Edges.totalCount[7][alias] = n
 						}
 						return nil
 					})
@@ -2444,10 +3656,15 @@ func (u *UserQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 				selectedFields = append(selectedFields, user.FieldEmail)
 				fieldSeen[user.FieldEmail] = struct{}{}
 			}
-		case "contactPin":
-			if _, ok := fieldSeen[user.FieldContactPin]; !ok {
-				selectedFields = append(selectedFields, user.FieldContactPin)
-				fieldSeen[user.FieldContactPin] = struct{}{}
+		case "role":
+			if _, ok := fieldSeen[user.FieldRole]; !ok {
+				selectedFields = append(selectedFields, user.FieldRole)
+				fieldSeen[user.FieldRole] = struct{}{}
+			}
+		case "avatarID":
+			if _, ok := fieldSeen[user.FieldAvatarID]; !ok {
+				selectedFields = append(selectedFields, user.FieldAvatarID)
+				fieldSeen[user.FieldAvatarID] = struct{}{}
 			}
 		case "createdAt":
 			if _, ok := fieldSeen[user.FieldCreatedAt]; !ok {
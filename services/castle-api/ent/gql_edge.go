@@ -8,6 +8,98 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 )
 
+func (a *Avatar) File(ctx context.Context) (*File, error) {
+	result, err := a.Edges.FileOrErr()
+	if IsNotLoaded(err) {
+		result, err = a.QueryFile().Only(ctx)
+	}
+	return result, err
+}
+
+func (a *Avatar) User(ctx context.Context) (*User, error) {
+	result, err := a.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		result, err = a.QueryUser().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (a *Avatar) Room(ctx context.Context) (*Room, error) {
+	result, err := a.Edges.RoomOrErr()
+	if IsNotLoaded(err) {
+		result, err = a.QueryRoom().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (c *Call) Room(ctx context.Context) (*Room, error) {
+	result, err := c.Edges.RoomOrErr()
+	if IsNotLoaded(err) {
+		result, err = c.QueryRoom().Only(ctx)
+	}
+	return result, err
+}
+
+func (c *Call) Caller(ctx context.Context) (*User, error) {
+	result, err := c.Edges.CallerOrErr()
+	if IsNotLoaded(err) {
+		result, err = c.QueryCaller().Only(ctx)
+	}
+	return result, err
+}
+
+func (c *Call) Participants(ctx context.Context) (result []*CallParticipant, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = c.NamedParticipants(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = c.Edges.ParticipantsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = c.QueryParticipants().All(ctx)
+	}
+	return result, err
+}
+
+func (cp *CallParticipant) Call(ctx context.Context) (*Call, error) {
+	result, err := cp.Edges.CallOrErr()
+	if IsNotLoaded(err) {
+		result, err = cp.QueryCall().Only(ctx)
+	}
+	return result, err
+}
+
+func (cp *CallParticipant) User(ctx context.Context) (*User, error) {
+	result, err := cp.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		result, err = cp.QueryUser().Only(ctx)
+	}
+	return result, err
+}
+
+func (cp *ContactPin) User(ctx context.Context) (*User, error) {
+	result, err := cp.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		result, err = cp.QueryUser().Only(ctx)
+	}
+	return result, err
+}
+
+func (cr *ContactRequest) Sender(ctx context.Context) (*User, error) {
+	result, err := cr.Edges.SenderOrErr()
+	if IsNotLoaded(err) {
+		result, err = cr.QuerySender().Only(ctx)
+	}
+	return result, err
+}
+
+func (cr *ContactRequest) Receiver(ctx context.Context) (*User, error) {
+	result, err := cr.Edges.ReceiverOrErr()
+	if IsNotLoaded(err) {
+		result, err = cr.QueryReceiver().Only(ctx)
+	}
+	return result, err
+}
+
 func (d *Device) User(ctx context.Context) (*User, error) {
 	result, err := d.Edges.UserOrErr()
 	if IsNotLoaded(err) {
@@ -32,6 +124,26 @@ func (f *File) MessageVoice(ctx context.Context) (*MessageVoice, error) {
 	return result, MaskNotFound(err)
 }
 
+func (f *File) Original(ctx context.Context) (*File, error) {
+	result, err := f.Edges.OriginalOrErr()
+	if IsNotLoaded(err) {
+		result, err = f.QueryOriginal().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (f *File) Thumbnails(ctx context.Context) (result []*File, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = f.NamedThumbnails(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = f.Edges.ThumbnailsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = f.QueryThumbnails().All(ctx)
+	}
+	return result, err
+}
+
 func (m *Message) Voice(ctx context.Context) (*MessageVoice, error) {
 	result, err := m.Edges.VoiceOrErr()
 	if IsNotLoaded(err) {
@@ -84,6 +196,22 @@ func (m *Message) Links(ctx context.Context) (result []*MessageLink, err error)
 	return result, err
 }
 
+func (m *Message) Call(ctx context.Context) (*Call, error) {
+	result, err := m.Edges.CallOrErr()
+	if IsNotLoaded(err) {
+		result, err = m.QueryCall().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (m *Message) Avatar(ctx context.Context) (*Avatar, error) {
+	result, err := m.Edges.AvatarOrErr()
+	if IsNotLoaded(err) {
+		result, err = m.QueryAvatar().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
 func (m *Message) User(ctx context.Context) (*User, error) {
 	result, err := m.Edges.UserOrErr()
 	if IsNotLoaded(err) {
@@ -172,6 +300,46 @@ func (n *Notification) User(ctx context.Context) (*User, error) {
 	return result, MaskNotFound(err)
 }
 
+func (r *Report) Reporter(ctx context.Context) (*User, error) {
+	result, err := r.Edges.ReporterOrErr()
+	if IsNotLoaded(err) {
+		result, err = r.QueryReporter().Only(ctx)
+	}
+	return result, err
+}
+
+func (r *Report) User(ctx context.Context) (*User, error) {
+	result, err := r.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		result, err = r.QueryUser().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (r *Report) Message(ctx context.Context) (*Message, error) {
+	result, err := r.Edges.MessageOrErr()
+	if IsNotLoaded(err) {
+		result, err = r.QueryMessage().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (r *Report) Room(ctx context.Context) (*Room, error) {
+	result, err := r.Edges.RoomOrErr()
+	if IsNotLoaded(err) {
+		result, err = r.QueryRoom().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (r *Report) Assignee(ctx context.Context) (*User, error) {
+	result, err := r.Edges.AssigneeOrErr()
+	if IsNotLoaded(err) {
+		result, err = r.QueryAssignee().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
 func (r *Room) UserContacts(ctx context.Context) (result []*UserContact, err error) {
 	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
 		result, err = r.NamedUserContacts(graphql.GetFieldContext(ctx).Field.Alias)
@@ -297,6 +465,26 @@ func (r *Room) MessageLinks(
 	return r.QueryMessageLinks().Paginate(ctx, after, first, before, last, opts...)
 }
 
+func (r *Room) Avatar(ctx context.Context) (*File, error) {
+	result, err := r.Edges.AvatarOrErr()
+	if IsNotLoaded(err) {
+		result, err = r.QueryAvatar().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (r *Room) Avatars(ctx context.Context) (result []*Avatar, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = r.NamedAvatars(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = r.Edges.AvatarsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = r.QueryAvatars().All(ctx)
+	}
+	return result, err
+}
+
 func (r *Room) RoomMembers(
 	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy []*RoomMemberOrder, where *RoomMemberWhereInput,
 ) (*RoomMemberConnection, error) {
@@ -305,7 +493,7 @@ func (r *Room) RoomMembers(
 		WithRoomMemberFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := r.Edges.totalCount[7][alias]
+	totalCount, hasTotalCount := r.Edges.totalCount[9][alias]
 	if nodes, err := r.NamedRoomMembers(alias); err == nil || hasTotalCount {
 		pager, err := newRoomMemberPager(opts, last != nil)
 		if err != nil {
@@ -318,6 +506,22 @@ func (r *Room) RoomMembers(
 	return r.QueryRoomMembers().Paginate(ctx, after, first, before, last, opts...)
 }
 
+func (ri *RoomInvite) Room(ctx context.Context) (*Room, error) {
+	result, err := ri.Edges.RoomOrErr()
+	if IsNotLoaded(err) {
+		result, err = ri.QueryRoom().Only(ctx)
+	}
+	return result, err
+}
+
+func (ri *RoomInvite) Creator(ctx context.Context) (*User, error) {
+	result, err := ri.Edges.CreatorOrErr()
+	if IsNotLoaded(err) {
+		result, err = ri.QueryCreator().Only(ctx)
+	}
+	return result, err
+}
+
 func (rm *RoomMember) User(ctx context.Context) (*User, error) {
 	result, err := rm.Edges.UserOrErr()
 	if IsNotLoaded(err) {
@@ -342,27 +546,6 @@ func (u *User) Device(ctx context.Context) (*Device, error) {
 	return result, MaskNotFound(err)
 }
 
-func (u *User) Notifications(
-	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy []*NotificationOrder, where *NotificationWhereInput,
-) (*NotificationConnection, error) {
-	opts := []NotificationPaginateOption{
-		WithNotificationOrder(orderBy),
-		WithNotificationFilter(where.Filter),
-	}
-	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := u.Edges.totalCount[1][alias]
-	if nodes, err := u.NamedNotifications(alias); err == nil || hasTotalCount {
-		pager, err := newNotificationPager(opts, last != nil)
-		if err != nil {
-			return nil, err
-		}
-		conn := &NotificationConnection{Edges: []*NotificationEdge{}, TotalCount: totalCount}
-		conn.build(nodes, pager, after, first, before, last)
-		return conn, nil
-	}
-	return u.QueryNotifications().Paginate(ctx, after, first, before, last, opts...)
-}
-
 func (u *User) Contacts(
 	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy []*UserOrder, where *UserWhereInput,
 ) (*UserConnection, error) {
@@ -371,7 +554,7 @@ func (u *User) Contacts(
 		WithUserFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := u.Edges.totalCount[2][alias]
+	totalCount, hasTotalCount := u.Edges.totalCount[1][alias]
 	if nodes, err := u.NamedContacts(alias); err == nil || hasTotalCount {
 		pager, err := newUserPager(opts, last != nil)
 		if err != nil {
@@ -392,7 +575,7 @@ func (u *User) Rooms(
 		WithRoomFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := u.Edges.totalCount[3][alias]
+	totalCount, hasTotalCount := u.Edges.totalCount[2][alias]
 	if nodes, err := u.NamedRooms(alias); err == nil || hasTotalCount {
 		pager, err := newRoomPager(opts, last != nil)
 		if err != nil {
@@ -413,7 +596,7 @@ func (u *User) Messages(
 		WithMessageFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := u.Edges.totalCount[4][alias]
+	totalCount, hasTotalCount := u.Edges.totalCount[3][alias]
 	if nodes, err := u.NamedMessages(alias); err == nil || hasTotalCount {
 		pager, err := newMessagePager(opts, last != nil)
 		if err != nil {
@@ -426,6 +609,26 @@ func (u *User) Messages(
 	return u.QueryMessages().Paginate(ctx, after, first, before, last, opts...)
 }
 
+func (u *User) Avatar(ctx context.Context) (*File, error) {
+	result, err := u.Edges.AvatarOrErr()
+	if IsNotLoaded(err) {
+		result, err = u.QueryAvatar().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (u *User) Avatars(ctx context.Context) (result []*Avatar, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = u.NamedAvatars(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = u.Edges.AvatarsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = u.QueryAvatars().All(ctx)
+	}
+	return result, err
+}
+
 func (u *User) UserContacts(
 	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy []*UserContactOrder, where *UserContactWhereInput,
 ) (*UserContactConnection, error) {
@@ -434,7 +637,7 @@ func (u *User) UserContacts(
 		WithUserContactFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := u.Edges.totalCount[5][alias]
+	totalCount, hasTotalCount := u.Edges.totalCount[6][alias]
 	if nodes, err := u.NamedUserContacts(alias); err == nil || hasTotalCount {
 		pager, err := newUserContactPager(opts, last != nil)
 		if err != nil {
@@ -455,7 +658,7 @@ func (u *User) Memberships(
 		WithRoomMemberFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := u.Edges.totalCount[6][alias]
+	totalCount, hasTotalCount := u.Edges.totalCount[7][alias]
 	if nodes, err := u.NamedMemberships(alias); err == nil || hasTotalCount {
 		pager, err := newRoomMemberPager(opts, last != nil)
 		if err != nil {
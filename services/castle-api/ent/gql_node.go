@@ -5,6 +5,11 @@ package ent
 import (
 	"context"
 	"fmt"
+	"journeyhub/ent/avatar"
+	"journeyhub/ent/call"
+	"journeyhub/ent/callparticipant"
+	"journeyhub/ent/contactpin"
+	"journeyhub/ent/contactrequest"
 	"journeyhub/ent/device"
 	"journeyhub/ent/file"
 	"journeyhub/ent/message"
@@ -12,7 +17,9 @@ import (
 	"journeyhub/ent/messagelink"
 	"journeyhub/ent/messagevoice"
 	"journeyhub/ent/notification"
+	"journeyhub/ent/report"
 	"journeyhub/ent/room"
+	"journeyhub/ent/roominvite"
 	"journeyhub/ent/roommember"
 	"journeyhub/ent/schema/pulid"
 	"journeyhub/ent/user"
@@ -28,6 +35,31 @@ type Noder interface {
 	IsNode()
 }
 
+var avatarImplementors = []string{"Avatar", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Avatar) IsNode() {}
+
+var callImplementors = []string{"Call", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Call) IsNode() {}
+
+var callparticipantImplementors = []string{"CallParticipant", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*CallParticipant) IsNode() {}
+
+var contactpinImplementors = []string{"ContactPin", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*ContactPin) IsNode() {}
+
+var contactrequestImplementors = []string{"ContactRequest", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*ContactRequest) IsNode() {}
+
 var deviceImplementors = []string{"Device", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
@@ -63,11 +95,21 @@ var notificationImplementors = []string{"Notification", "Node"}
 // IsNode implements the Node interface check for GQLGen.
 func (*Notification) IsNode() {}
 
+var reportImplementors = []string{"Report", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Report) IsNode() {}
+
 var roomImplementors = []string{"Room", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
 func (*Room) IsNode() {}
 
+var roominviteImplementors = []string{"RoomInvite", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*RoomInvite) IsNode() {}
+
 var roommemberImplementors = []string{"RoomMember", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
@@ -141,6 +183,71 @@ func (c *Client) Noder(ctx context.Context, id pulid.ID, opts ...NodeOption) (_
 
 func (c *Client) noder(ctx context.Context, table string, id pulid.ID) (Noder, error) {
 	switch table {
+	case avatar.Table:
+		var uid pulid.ID
+		if err := uid.UnmarshalGQL(id); err != nil {
+			return nil, err
+		}
+		query := c.Avatar.Query().
+			Where(avatar.ID(uid))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, avatarImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case call.Table:
+		var uid pulid.ID
+		if err := uid.UnmarshalGQL(id); err != nil {
+			return nil, err
+		}
+		query := c.Call.Query().
+			Where(call.ID(uid))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, callImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case callparticipant.Table:
+		var uid pulid.ID
+		if err := uid.UnmarshalGQL(id); err != nil {
+			return nil, err
+		}
+		query := c.CallParticipant.Query().
+			Where(callparticipant.ID(uid))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, callparticipantImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case contactpin.Table:
+		var uid pulid.ID
+		if err := uid.UnmarshalGQL(id); err != nil {
+			return nil, err
+		}
+		query := c.ContactPin.Query().
+			Where(contactpin.ID(uid))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, contactpinImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case contactrequest.Table:
+		var uid pulid.ID
+		if err := uid.UnmarshalGQL(id); err != nil {
+			return nil, err
+		}
+		query := c.ContactRequest.Query().
+			Where(contactrequest.ID(uid))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, contactrequestImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case device.Table:
 		var uid pulid.ID
 		if err := uid.UnmarshalGQL(id); err != nil {
@@ -232,6 +339,19 @@ func (c *Client) noder(ctx context.Context, table string, id pulid.ID) (Noder, e
 			}
 		}
 		return query.Only(ctx)
+	case report.Table:
+		var uid pulid.ID
+		if err := uid.UnmarshalGQL(id); err != nil {
+			return nil, err
+		}
+		query := c.Report.Query().
+			Where(report.ID(uid))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, reportImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case room.Table:
 		var uid pulid.ID
 		if err := uid.UnmarshalGQL(id); err != nil {
@@ -245,6 +365,19 @@ func (c *Client) noder(ctx context.Context, table string, id pulid.ID) (Noder, e
 			}
 		}
 		return query.Only(ctx)
+	case roominvite.Table:
+		var uid pulid.ID
+		if err := uid.UnmarshalGQL(id); err != nil {
+			return nil, err
+		}
+		query := c.RoomInvite.Query().
+			Where(roominvite.ID(uid))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, roominviteImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case roommember.Table:
 		var uid pulid.ID
 		if err := uid.UnmarshalGQL(id); err != nil {
@@ -357,6 +490,86 @@ func (c *Client) noders(ctx context.Context, table string, ids []pulid.ID) ([]No
 		idmap[id] = append(idmap[id], &noders[i])
 	}
 	switch table {
+	case avatar.Table:
+		query := c.Avatar.Query().
+			Where(avatar.IDIn(ids...))
+		query, err := query.CollectFields(ctx, avatarImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case call.Table:
+		query := c.Call.Query().
+			Where(call.IDIn(ids...))
+		query, err := query.CollectFields(ctx, callImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case callparticipant.Table:
+		query := c.CallParticipant.Query().
+			Where(callparticipant.IDIn(ids...))
+		query, err := query.CollectFields(ctx, callparticipantImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case contactpin.Table:
+		query := c.ContactPin.Query().
+			Where(contactpin.IDIn(ids...))
+		query, err := query.CollectFields(ctx, contactpinImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case contactrequest.Table:
+		query := c.ContactRequest.Query().
+			Where(contactrequest.IDIn(ids...))
+		query, err := query.CollectFields(ctx, contactrequestImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case device.Table:
 		query := c.Device.Query().
 			Where(device.IDIn(ids...))
@@ -469,6 +682,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []pulid.ID) ([]No
 				*noder = node
 			}
 		}
+	case report.Table:
+		query := c.Report.Query().
+			Where(report.IDIn(ids...))
+		query, err := query.CollectFields(ctx, reportImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case room.Table:
 		query := c.Room.Query().
 			Where(room.IDIn(ids...))
@@ -485,6 +714,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []pulid.ID) ([]No
 				*noder = node
 			}
 		}
+	case roominvite.Table:
+		query := c.RoomInvite.Query().
+			Where(roominvite.IDIn(ids...))
+		query, err := query.CollectFields(ctx, roominviteImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case roommember.Table:
 		query := c.RoomMember.Query().
 			Where(roommember.IDIn(ids...))
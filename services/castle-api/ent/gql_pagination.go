@@ -7,6 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"journeyhub/ent/avatar"
+	"journeyhub/ent/call"
+	"journeyhub/ent/callparticipant"
+	"journeyhub/ent/contactpin"
+	"journeyhub/ent/contactrequest"
 	"journeyhub/ent/device"
 	"journeyhub/ent/file"
 	"journeyhub/ent/message"
@@ -14,7 +19,9 @@ import (
 	"journeyhub/ent/messagelink"
 	"journeyhub/ent/messagevoice"
 	"journeyhub/ent/notification"
+	"journeyhub/ent/report"
 	"journeyhub/ent/room"
+	"journeyhub/ent/roominvite"
 	"journeyhub/ent/roommember"
 	"journeyhub/ent/schema/pulid"
 	"journeyhub/ent/user"
@@ -109,20 +116,20 @@ func paginateLimit(first, last *int) int {
 	return limit
 }
 
-// DeviceEdge is the edge representation of Device.
-type DeviceEdge struct {
-	Node   *Device `json:"node"`
+// AvatarEdge is the edge representation of Avatar.
+type AvatarEdge struct {
+	Node   *Avatar `json:"node"`
 	Cursor Cursor  `json:"cursor"`
 }
 
-// DeviceConnection is the connection containing edges to Device.
-type DeviceConnection struct {
-	Edges      []*DeviceEdge `json:"edges"`
+// AvatarConnection is the connection containing edges to Avatar.
+type AvatarConnection struct {
+	Edges      []*AvatarEdge `json:"edges"`
 	PageInfo   PageInfo      `json:"pageInfo"`
 	TotalCount int           `json:"totalCount"`
 }
 
-func (c *DeviceConnection) build(nodes []*Device, pager *devicePager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *AvatarConnection) build(nodes []*Avatar, pager *avatarPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -132,21 +139,21 @@ func (c *DeviceConnection) build(nodes []*Device, pager *devicePager, after *Cur
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Device
+	var nodeAt func(int) *Avatar
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Device {
+		nodeAt = func(i int) *Avatar {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Device {
+		nodeAt = func(i int) *Avatar {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*DeviceEdge, len(nodes))
+	c.Edges = make([]*AvatarEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &DeviceEdge{
+		c.Edges[i] = &AvatarEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -160,167 +167,131 @@ func (c *DeviceConnection) build(nodes []*Device, pager *devicePager, after *Cur
 	}
 }
 
-// DevicePaginateOption enables pagination customization.
-type DevicePaginateOption func(*devicePager) error
+// AvatarPaginateOption enables pagination customization.
+type AvatarPaginateOption func(*avatarPager) error
 
-// WithDeviceOrder configures pagination ordering.
-func WithDeviceOrder(order []*DeviceOrder) DevicePaginateOption {
-	return func(pager *devicePager) error {
-		for _, o := range order {
-			if err := o.Direction.Validate(); err != nil {
-				return err
-			}
+// WithAvatarOrder configures pagination ordering.
+func WithAvatarOrder(order *AvatarOrder) AvatarPaginateOption {
+	if order == nil {
+		order = DefaultAvatarOrder
+	}
+	o := *order
+	return func(pager *avatarPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
 		}
-		pager.order = append(pager.order, order...)
+		if o.Field == nil {
+			o.Field = DefaultAvatarOrder.Field
+		}
+		pager.order = &o
 		return nil
 	}
 }
 
-// WithDeviceFilter configures pagination filter.
-func WithDeviceFilter(filter func(*DeviceQuery) (*DeviceQuery, error)) DevicePaginateOption {
-	return func(pager *devicePager) error {
+// WithAvatarFilter configures pagination filter.
+func WithAvatarFilter(filter func(*AvatarQuery) (*AvatarQuery, error)) AvatarPaginateOption {
+	return func(pager *avatarPager) error {
 		if filter == nil {
-			return errors.New("DeviceQuery filter cannot be nil")
+			return errors.New("AvatarQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type devicePager struct {
+type avatarPager struct {
 	reverse bool
-	order   []*DeviceOrder
-	filter  func(*DeviceQuery) (*DeviceQuery, error)
+	order   *AvatarOrder
+	filter  func(*AvatarQuery) (*AvatarQuery, error)
 }
 
-func newDevicePager(opts []DevicePaginateOption, reverse bool) (*devicePager, error) {
-	pager := &devicePager{reverse: reverse}
+func newAvatarPager(opts []AvatarPaginateOption, reverse bool) (*avatarPager, error) {
+	pager := &avatarPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
-	for i, o := range pager.order {
-		if i > 0 && o.Field == pager.order[i-1].Field {
-			return nil, fmt.Errorf("duplicate order direction %q", o.Direction)
-		}
+	if pager.order == nil {
+		pager.order = DefaultAvatarOrder
 	}
 	return pager, nil
 }
 
-func (p *devicePager) applyFilter(query *DeviceQuery) (*DeviceQuery, error) {
+func (p *avatarPager) applyFilter(query *AvatarQuery) (*AvatarQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *devicePager) toCursor(d *Device) Cursor {
-	cs_ := make([]any, 0, len(p.order))
-	for _, o_ := range p.order {
-		cs_ = append(cs_, o_.Field.toCursor(d).Value)
-	}
-	return Cursor{ID: d.ID, Value: cs_}
+func (p *avatarPager) toCursor(a *Avatar) Cursor {
+	return p.order.Field.toCursor(a)
 }
 
-func (p *devicePager) applyCursors(query *DeviceQuery, after, before *Cursor) (*DeviceQuery, error) {
-	idDirection := entgql.OrderDirectionAsc
+func (p *avatarPager) applyCursors(query *AvatarQuery, after, before *Cursor) (*AvatarQuery, error) {
+	direction := p.order.Direction
 	if p.reverse {
-		idDirection = entgql.OrderDirectionDesc
-	}
-	fields, directions := make([]string, 0, len(p.order)), make([]OrderDirection, 0, len(p.order))
-	for _, o := range p.order {
-		fields = append(fields, o.Field.column)
-		direction := o.Direction
-		if p.reverse {
-			direction = direction.Reverse()
-		}
-		directions = append(directions, direction)
-	}
-	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultDeviceOrder.Field.column,
-		DirectionID: idDirection,
-		Fields:      fields,
-		Directions:  directions,
-	})
-	if err != nil {
-		return nil, err
+		direction = direction.Reverse()
 	}
-	for _, predicate := range predicates {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultAvatarOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *devicePager) applyOrder(query *DeviceQuery) *DeviceQuery {
-	var defaultOrdered bool
-	for _, o := range p.order {
-		direction := o.Direction
-		if p.reverse {
-			direction = direction.Reverse()
-		}
-		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultDeviceOrder.Field.column {
-			defaultOrdered = true
-		}
-		if len(query.ctx.Fields) > 0 {
-			query.ctx.AppendFieldOnce(o.Field.column)
-		}
+func (p *avatarPager) applyOrder(query *AvatarQuery) *AvatarQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
 	}
-	if !defaultOrdered {
-		direction := entgql.OrderDirectionAsc
-		if p.reverse {
-			direction = direction.Reverse()
-		}
-		query = query.Order(DefaultDeviceOrder.Field.toTerm(direction.OrderTermOption()))
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultAvatarOrder.Field {
+		query = query.Order(DefaultAvatarOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
 	}
 	return query
 }
 
-func (p *devicePager) orderExpr(query *DeviceQuery) sql.Querier {
+func (p *avatarPager) orderExpr(query *AvatarQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
 	if len(query.ctx.Fields) > 0 {
-		for _, o := range p.order {
-			query.ctx.AppendFieldOnce(o.Field.column)
-		}
+		query.ctx.AppendFieldOnce(p.order.Field.column)
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
-		for _, o := range p.order {
-			direction := o.Direction
-			if p.reverse {
-				direction = direction.Reverse()
-			}
-			b.Ident(o.Field.column).Pad().WriteString(string(direction))
-			b.Comma()
-		}
-		direction := entgql.OrderDirectionAsc
-		if p.reverse {
-			direction = direction.Reverse()
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultAvatarOrder.Field {
+			b.Comma().Ident(DefaultAvatarOrder.Field.column).Pad().WriteString(string(direction))
 		}
-		b.Ident(DefaultDeviceOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Device.
-func (d *DeviceQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Avatar.
+func (a *AvatarQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...DevicePaginateOption,
-) (*DeviceConnection, error) {
+	before *Cursor, last *int, opts ...AvatarPaginateOption,
+) (*AvatarConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newDevicePager(opts, last != nil)
+	pager, err := newAvatarPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if d, err = pager.applyFilter(d); err != nil {
+	if a, err = pager.applyFilter(a); err != nil {
 		return nil, err
 	}
-	conn := &DeviceConnection{Edges: []*DeviceEdge{}}
+	conn := &AvatarConnection{Edges: []*AvatarEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			c := d.Clone()
+			c := a.Clone()
 			c.ctx.Fields = nil
 			if conn.TotalCount, err = c.Count(ctx); err != nil {
 				return nil, err
@@ -332,20 +303,20 @@ func (d *DeviceQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if d, err = pager.applyCursors(d, after, before); err != nil {
+	if a, err = pager.applyCursors(a, after, before); err != nil {
 		return nil, err
 	}
 	limit := paginateLimit(first, last)
 	if limit != 0 {
-		d.Limit(limit)
+		a.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := d.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := a.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	d = pager.applyOrder(d)
-	nodes, err := d.All(ctx)
+	a = pager.applyOrder(a)
+	nodes, err := a.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -354,161 +325,107 @@ func (d *DeviceQuery) Paginate(
 }
 
 var (
-	// DeviceOrderFieldDeviceID orders Device by device_id.
-	DeviceOrderFieldDeviceID = &DeviceOrderField{
-		Value: func(d *Device) (ent.Value, error) {
-			return d.DeviceID, nil
-		},
-		column: device.FieldDeviceID,
-		toTerm: device.ByDeviceID,
-		toCursor: func(d *Device) Cursor {
-			return Cursor{
-				ID:    d.ID,
-				Value: d.DeviceID,
-			}
-		},
-	}
-	// DeviceOrderFieldFcmToken orders Device by fcm_token.
-	DeviceOrderFieldFcmToken = &DeviceOrderField{
-		Value: func(d *Device) (ent.Value, error) {
-			return d.FcmToken, nil
-		},
-		column: device.FieldFcmToken,
-		toTerm: device.ByFcmToken,
-		toCursor: func(d *Device) Cursor {
-			return Cursor{
-				ID:    d.ID,
-				Value: d.FcmToken,
-			}
-		},
-	}
-	// DeviceOrderFieldCreatedAt orders Device by created_at.
-	DeviceOrderFieldCreatedAt = &DeviceOrderField{
-		Value: func(d *Device) (ent.Value, error) {
-			return d.CreatedAt, nil
-		},
-		column: device.FieldCreatedAt,
-		toTerm: device.ByCreatedAt,
-		toCursor: func(d *Device) Cursor {
-			return Cursor{
-				ID:    d.ID,
-				Value: d.CreatedAt,
-			}
-		},
-	}
-	// DeviceOrderFieldUpdatedAt orders Device by updated_at.
-	DeviceOrderFieldUpdatedAt = &DeviceOrderField{
-		Value: func(d *Device) (ent.Value, error) {
-			return d.UpdatedAt, nil
+	// AvatarOrderFieldCreatedAt orders Avatar by created_at.
+	AvatarOrderFieldCreatedAt = &AvatarOrderField{
+		Value: func(a *Avatar) (ent.Value, error) {
+			return a.CreatedAt, nil
 		},
-		column: device.FieldUpdatedAt,
-		toTerm: device.ByUpdatedAt,
-		toCursor: func(d *Device) Cursor {
+		column: avatar.FieldCreatedAt,
+		toTerm: avatar.ByCreatedAt,
+		toCursor: func(a *Avatar) Cursor {
 			return Cursor{
-				ID:    d.ID,
-				Value: d.UpdatedAt,
+				ID:    a.ID,
+				Value: a.CreatedAt,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f DeviceOrderField) String() string {
+func (f AvatarOrderField) String() string {
 	var str string
 	switch f.column {
-	case DeviceOrderFieldDeviceID.column:
-		str = "DEVICE_ID"
-	case DeviceOrderFieldFcmToken.column:
-		str = "FCM_TOKEN"
-	case DeviceOrderFieldCreatedAt.column:
+	case AvatarOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case DeviceOrderFieldUpdatedAt.column:
-		str = "UPDATED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f DeviceOrderField) MarshalGQL(w io.Writer) {
+func (f AvatarOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *DeviceOrderField) UnmarshalGQL(v interface{}) error {
+func (f *AvatarOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("DeviceOrderField %T must be a string", v)
+		return fmt.Errorf("AvatarOrderField %T must be a string", v)
 	}
 	switch str {
-	case "DEVICE_ID":
-		*f = *DeviceOrderFieldDeviceID
-	case "FCM_TOKEN":
-		*f = *DeviceOrderFieldFcmToken
 	case "CREATED_AT":
-		*f = *DeviceOrderFieldCreatedAt
-	case "UPDATED_AT":
-		*f = *DeviceOrderFieldUpdatedAt
+		*f = *AvatarOrderFieldCreatedAt
 	default:
-		return fmt.Errorf("%s is not a valid DeviceOrderField", str)
+		return fmt.Errorf("%s is not a valid AvatarOrderField", str)
 	}
 	return nil
 }
 
-// DeviceOrderField defines the ordering field of Device.
-type DeviceOrderField struct {
-	// Value extracts the ordering value from the given Device.
-	Value    func(*Device) (ent.Value, error)
+// AvatarOrderField defines the ordering field of Avatar.
+type AvatarOrderField struct {
+	// Value extracts the ordering value from the given Avatar.
+	Value    func(*Avatar) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) device.OrderOption
-	toCursor func(*Device) Cursor
+	toTerm   func(...sql.OrderTermOption) avatar.OrderOption
+	toCursor func(*Avatar) Cursor
 }
 
-// DeviceOrder defines the ordering of Device.
-type DeviceOrder struct {
+// AvatarOrder defines the ordering of Avatar.
+type AvatarOrder struct {
 	Direction OrderDirection    `json:"direction"`
-	Field     *DeviceOrderField `json:"field"`
+	Field     *AvatarOrderField `json:"field"`
 }
 
-// DefaultDeviceOrder is the default ordering of Device.
-var DefaultDeviceOrder = &DeviceOrder{
+// DefaultAvatarOrder is the default ordering of Avatar.
+var DefaultAvatarOrder = &AvatarOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &DeviceOrderField{
-		Value: func(d *Device) (ent.Value, error) {
-			return d.ID, nil
+	Field: &AvatarOrderField{
+		Value: func(a *Avatar) (ent.Value, error) {
+			return a.ID, nil
 		},
-		column: device.FieldID,
-		toTerm: device.ByID,
-		toCursor: func(d *Device) Cursor {
-			return Cursor{ID: d.ID}
+		column: avatar.FieldID,
+		toTerm: avatar.ByID,
+		toCursor: func(a *Avatar) Cursor {
+			return Cursor{ID: a.ID}
 		},
 	},
 }
 
-// ToEdge converts Device into DeviceEdge.
-func (d *Device) ToEdge(order *DeviceOrder) *DeviceEdge {
+// ToEdge converts Avatar into AvatarEdge.
+func (a *Avatar) ToEdge(order *AvatarOrder) *AvatarEdge {
 	if order == nil {
-		order = DefaultDeviceOrder
+		order = DefaultAvatarOrder
 	}
-	return &DeviceEdge{
-		Node:   d,
-		Cursor: order.Field.toCursor(d),
+	return &AvatarEdge{
+		Node:   a,
+		Cursor: order.Field.toCursor(a),
 	}
 }
 
-// FileEdge is the edge representation of File.
-type FileEdge struct {
-	Node   *File  `json:"node"`
+// CallEdge is the edge representation of Call.
+type CallEdge struct {
+	Node   *Call  `json:"node"`
 	Cursor Cursor `json:"cursor"`
 }
 
-// FileConnection is the connection containing edges to File.
-type FileConnection struct {
-	Edges      []*FileEdge `json:"edges"`
+// CallConnection is the connection containing edges to Call.
+type CallConnection struct {
+	Edges      []*CallEdge `json:"edges"`
 	PageInfo   PageInfo    `json:"pageInfo"`
 	TotalCount int         `json:"totalCount"`
 }
 
-func (c *FileConnection) build(nodes []*File, pager *filePager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *CallConnection) build(nodes []*Call, pager *callPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -518,21 +435,21 @@ func (c *FileConnection) build(nodes []*File, pager *filePager, after *Cursor, f
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *File
+	var nodeAt func(int) *Call
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *File {
+		nodeAt = func(i int) *Call {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *File {
+		nodeAt = func(i int) *Call {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*FileEdge, len(nodes))
+	c.Edges = make([]*CallEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &FileEdge{
+		c.Edges[i] = &CallEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -546,131 +463,167 @@ func (c *FileConnection) build(nodes []*File, pager *filePager, after *Cursor, f
 	}
 }
 
-// FilePaginateOption enables pagination customization.
-type FilePaginateOption func(*filePager) error
+// CallPaginateOption enables pagination customization.
+type CallPaginateOption func(*callPager) error
 
-// WithFileOrder configures pagination ordering.
-func WithFileOrder(order *FileOrder) FilePaginateOption {
-	if order == nil {
-		order = DefaultFileOrder
-	}
-	o := *order
-	return func(pager *filePager) error {
-		if err := o.Direction.Validate(); err != nil {
-			return err
-		}
-		if o.Field == nil {
-			o.Field = DefaultFileOrder.Field
+// WithCallOrder configures pagination ordering.
+func WithCallOrder(order []*CallOrder) CallPaginateOption {
+	return func(pager *callPager) error {
+		for _, o := range order {
+			if err := o.Direction.Validate(); err != nil {
+				return err
+			}
 		}
-		pager.order = &o
+		pager.order = append(pager.order, order...)
 		return nil
 	}
 }
 
-// WithFileFilter configures pagination filter.
-func WithFileFilter(filter func(*FileQuery) (*FileQuery, error)) FilePaginateOption {
-	return func(pager *filePager) error {
+// WithCallFilter configures pagination filter.
+func WithCallFilter(filter func(*CallQuery) (*CallQuery, error)) CallPaginateOption {
+	return func(pager *callPager) error {
 		if filter == nil {
-			return errors.New("FileQuery filter cannot be nil")
+			return errors.New("CallQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type filePager struct {
+type callPager struct {
 	reverse bool
-	order   *FileOrder
-	filter  func(*FileQuery) (*FileQuery, error)
+	order   []*CallOrder
+	filter  func(*CallQuery) (*CallQuery, error)
 }
 
-func newFilePager(opts []FilePaginateOption, reverse bool) (*filePager, error) {
-	pager := &filePager{reverse: reverse}
+func newCallPager(opts []CallPaginateOption, reverse bool) (*callPager, error) {
+	pager := &callPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
-	if pager.order == nil {
-		pager.order = DefaultFileOrder
+	for i, o := range pager.order {
+		if i > 0 && o.Field == pager.order[i-1].Field {
+			return nil, fmt.Errorf("duplicate order direction %q", o.Direction)
+		}
 	}
 	return pager, nil
 }
 
-func (p *filePager) applyFilter(query *FileQuery) (*FileQuery, error) {
+func (p *callPager) applyFilter(query *CallQuery) (*CallQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *filePager) toCursor(f *File) Cursor {
-	return p.order.Field.toCursor(f)
+func (p *callPager) toCursor(c *Call) Cursor {
+	cs_ := make([]any, 0, len(p.order))
+	for _, o_ := range p.order {
+		cs_ = append(cs_, o_.Field.toCursor(c).Value)
+	}
+	return Cursor{ID: c.ID, Value: cs_}
 }
 
-func (p *filePager) applyCursors(query *FileQuery, after, before *Cursor) (*FileQuery, error) {
-	direction := p.order.Direction
+func (p *callPager) applyCursors(query *CallQuery, after, before *Cursor) (*CallQuery, error) {
+	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
-		direction = direction.Reverse()
+		idDirection = entgql.OrderDirectionDesc
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultFileOrder.Field.column, p.order.Field.column, direction) {
+	fields, directions := make([]string, 0, len(p.order)), make([]OrderDirection, 0, len(p.order))
+	for _, o := range p.order {
+		fields = append(fields, o.Field.column)
+		direction := o.Direction
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		directions = append(directions, direction)
+	}
+	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
+		FieldID:     DefaultCallOrder.Field.column,
+		DirectionID: idDirection,
+		Fields:      fields,
+		Directions:  directions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, predicate := range predicates {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *filePager) applyOrder(query *FileQuery) *FileQuery {
-	direction := p.order.Direction
-	if p.reverse {
-		direction = direction.Reverse()
-	}
-	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultFileOrder.Field {
-		query = query.Order(DefaultFileOrder.Field.toTerm(direction.OrderTermOption()))
+func (p *callPager) applyOrder(query *CallQuery) *CallQuery {
+	var defaultOrdered bool
+	for _, o := range p.order {
+		direction := o.Direction
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
+		if o.Field.column == DefaultCallOrder.Field.column {
+			defaultOrdered = true
+		}
+		if len(query.ctx.Fields) > 0 {
+			query.ctx.AppendFieldOnce(o.Field.column)
+		}
 	}
-	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(p.order.Field.column)
+	if !defaultOrdered {
+		direction := entgql.OrderDirectionAsc
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		query = query.Order(DefaultCallOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *filePager) orderExpr(query *FileQuery) sql.Querier {
-	direction := p.order.Direction
-	if p.reverse {
-		direction = direction.Reverse()
-	}
+func (p *callPager) orderExpr(query *CallQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(p.order.Field.column)
+		for _, o := range p.order {
+			query.ctx.AppendFieldOnce(o.Field.column)
+		}
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultFileOrder.Field {
-			b.Comma().Ident(DefaultFileOrder.Field.column).Pad().WriteString(string(direction))
+		for _, o := range p.order {
+			direction := o.Direction
+			if p.reverse {
+				direction = direction.Reverse()
+			}
+			b.Ident(o.Field.column).Pad().WriteString(string(direction))
+			b.Comma()
 		}
+		direction := entgql.OrderDirectionAsc
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		b.Ident(DefaultCallOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to File.
-func (f *FileQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Call.
+func (c *CallQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...FilePaginateOption,
-) (*FileConnection, error) {
+	before *Cursor, last *int, opts ...CallPaginateOption,
+) (*CallConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newFilePager(opts, last != nil)
+	pager, err := newCallPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if f, err = pager.applyFilter(f); err != nil {
+	if c, err = pager.applyFilter(c); err != nil {
 		return nil, err
 	}
-	conn := &FileConnection{Edges: []*FileEdge{}}
+	conn := &CallConnection{Edges: []*CallEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			c := f.Clone()
+			c := c.Clone()
 			c.ctx.Fields = nil
 			if conn.TotalCount, err = c.Count(ctx); err != nil {
 				return nil, err
@@ -682,20 +635,20 @@ func (f *FileQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if f, err = pager.applyCursors(f, after, before); err != nil {
+	if c, err = pager.applyCursors(c, after, before); err != nil {
 		return nil, err
 	}
 	limit := paginateLimit(first, last)
 	if limit != 0 {
-		f.Limit(limit)
+		c.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := f.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := c.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	f = pager.applyOrder(f)
-	nodes, err := f.All(ctx)
+	c = pager.applyOrder(c)
+	nodes, err := c.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -704,233 +657,2214 @@ func (f *FileQuery) Paginate(
 }
 
 var (
-	// FileOrderFieldName orders File by name.
-	FileOrderFieldName = &FileOrderField{
-		Value: func(f *File) (ent.Value, error) {
-			return f.Name, nil
-		},
-		column: file.FieldName,
-		toTerm: file.ByName,
-		toCursor: func(f *File) Cursor {
-			return Cursor{
-				ID:    f.ID,
-				Value: f.Name,
-			}
-		},
-	}
-	// FileOrderFieldContentType orders File by content_type.
-	FileOrderFieldContentType = &FileOrderField{
-		Value: func(f *File) (ent.Value, error) {
-			return f.ContentType, nil
-		},
-		column: file.FieldContentType,
-		toTerm: file.ByContentType,
-		toCursor: func(f *File) Cursor {
-			return Cursor{
-				ID:    f.ID,
-				Value: f.ContentType,
-			}
-		},
-	}
-	// FileOrderFieldSize orders File by size.
-	FileOrderFieldSize = &FileOrderField{
-		Value: func(f *File) (ent.Value, error) {
-			return f.Size, nil
-		},
-		column: file.FieldSize,
-		toTerm: file.BySize,
-		toCursor: func(f *File) Cursor {
-			return Cursor{
-				ID:    f.ID,
-				Value: f.Size,
-			}
-		},
-	}
-	// FileOrderFieldLocation orders File by location.
-	FileOrderFieldLocation = &FileOrderField{
-		Value: func(f *File) (ent.Value, error) {
-			return f.Location, nil
-		},
-		column: file.FieldLocation,
-		toTerm: file.ByLocation,
-		toCursor: func(f *File) Cursor {
-			return Cursor{
-				ID:    f.ID,
-				Value: f.Location,
-			}
-		},
-	}
-	// FileOrderFieldBucket orders File by bucket.
-	FileOrderFieldBucket = &FileOrderField{
-		Value: func(f *File) (ent.Value, error) {
-			return f.Bucket, nil
-		},
-		column: file.FieldBucket,
-		toTerm: file.ByBucket,
-		toCursor: func(f *File) Cursor {
-			return Cursor{
-				ID:    f.ID,
-				Value: f.Bucket,
-			}
-		},
-	}
-	// FileOrderFieldPath orders File by path.
-	FileOrderFieldPath = &FileOrderField{
-		Value: func(f *File) (ent.Value, error) {
-			return f.Path, nil
-		},
-		column: file.FieldPath,
-		toTerm: file.ByPath,
-		toCursor: func(f *File) Cursor {
-			return Cursor{
-				ID:    f.ID,
-				Value: f.Path,
-			}
-		},
-	}
-	// FileOrderFieldCreatedAt orders File by created_at.
-	FileOrderFieldCreatedAt = &FileOrderField{
-		Value: func(f *File) (ent.Value, error) {
-			return f.CreatedAt, nil
-		},
-		column: file.FieldCreatedAt,
-		toTerm: file.ByCreatedAt,
-		toCursor: func(f *File) Cursor {
-			return Cursor{
-				ID:    f.ID,
-				Value: f.CreatedAt,
-			}
-		},
-	}
-	// FileOrderFieldUpdatedAt orders File by updated_at.
-	FileOrderFieldUpdatedAt = &FileOrderField{
-		Value: func(f *File) (ent.Value, error) {
-			return f.UpdatedAt, nil
+	// CallOrderFieldStartedAt orders Call by started_at.
+	CallOrderFieldStartedAt = &CallOrderField{
+		Value: func(c *Call) (ent.Value, error) {
+			return c.StartedAt, nil
 		},
-		column: file.FieldUpdatedAt,
-		toTerm: file.ByUpdatedAt,
-		toCursor: func(f *File) Cursor {
+		column: call.FieldStartedAt,
+		toTerm: call.ByStartedAt,
+		toCursor: func(c *Call) Cursor {
 			return Cursor{
-				ID:    f.ID,
-				Value: f.UpdatedAt,
+				ID:    c.ID,
+				Value: c.StartedAt,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f FileOrderField) String() string {
+func (f CallOrderField) String() string {
 	var str string
 	switch f.column {
-	case FileOrderFieldName.column:
-		str = "NAME"
-	case FileOrderFieldContentType.column:
-		str = "CONTENT_TYPE"
-	case FileOrderFieldSize.column:
-		str = "SIZE"
-	case FileOrderFieldLocation.column:
-		str = "LOCATION"
-	case FileOrderFieldBucket.column:
-		str = "BUCKET"
-	case FileOrderFieldPath.column:
-		str = "PATH"
-	case FileOrderFieldCreatedAt.column:
-		str = "CREATED_AT"
-	case FileOrderFieldUpdatedAt.column:
-		str = "UPDATED_AT"
+	case CallOrderFieldStartedAt.column:
+		str = "STARTED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f FileOrderField) MarshalGQL(w io.Writer) {
+func (f CallOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *FileOrderField) UnmarshalGQL(v interface{}) error {
+func (f *CallOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("FileOrderField %T must be a string", v)
+		return fmt.Errorf("CallOrderField %T must be a string", v)
+	}
+	switch str {
+	case "STARTED_AT":
+		*f = *CallOrderFieldStartedAt
+	default:
+		return fmt.Errorf("%s is not a valid CallOrderField", str)
+	}
+	return nil
+}
+
+// CallOrderField defines the ordering field of Call.
+type CallOrderField struct {
+	// Value extracts the ordering value from the given Call.
+	Value    func(*Call) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) call.OrderOption
+	toCursor func(*Call) Cursor
+}
+
+// CallOrder defines the ordering of Call.
+type CallOrder struct {
+	Direction OrderDirection  `json:"direction"`
+	Field     *CallOrderField `json:"field"`
+}
+
+// DefaultCallOrder is the default ordering of Call.
+var DefaultCallOrder = &CallOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &CallOrderField{
+		Value: func(c *Call) (ent.Value, error) {
+			return c.ID, nil
+		},
+		column: call.FieldID,
+		toTerm: call.ByID,
+		toCursor: func(c *Call) Cursor {
+			return Cursor{ID: c.ID}
+		},
+	},
+}
+
+// ToEdge converts Call into CallEdge.
+func (c *Call) ToEdge(order *CallOrder) *CallEdge {
+	if order == nil {
+		order = DefaultCallOrder
+	}
+	return &CallEdge{
+		Node:   c,
+		Cursor: order.Field.toCursor(c),
+	}
+}
+
+// CallParticipantEdge is the edge representation of CallParticipant.
+type CallParticipantEdge struct {
+	Node   *CallParticipant `json:"node"`
+	Cursor Cursor           `json:"cursor"`
+}
+
+// CallParticipantConnection is the connection containing edges to CallParticipant.
+type CallParticipantConnection struct {
+	Edges      []*CallParticipantEdge `json:"edges"`
+	PageInfo   PageInfo               `json:"pageInfo"`
+	TotalCount int                    `json:"totalCount"`
+}
+
+func (c *CallParticipantConnection) build(nodes []*CallParticipant, pager *callparticipantPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *CallParticipant
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *CallParticipant {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *CallParticipant {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*CallParticipantEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &CallParticipantEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// CallParticipantPaginateOption enables pagination customization.
+type CallParticipantPaginateOption func(*callparticipantPager) error
+
+// WithCallParticipantOrder configures pagination ordering.
+func WithCallParticipantOrder(order *CallParticipantOrder) CallParticipantPaginateOption {
+	if order == nil {
+		order = DefaultCallParticipantOrder
+	}
+	o := *order
+	return func(pager *callparticipantPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultCallParticipantOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithCallParticipantFilter configures pagination filter.
+func WithCallParticipantFilter(filter func(*CallParticipantQuery) (*CallParticipantQuery, error)) CallParticipantPaginateOption {
+	return func(pager *callparticipantPager) error {
+		if filter == nil {
+			return errors.New("CallParticipantQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type callparticipantPager struct {
+	reverse bool
+	order   *CallParticipantOrder
+	filter  func(*CallParticipantQuery) (*CallParticipantQuery, error)
+}
+
+func newCallParticipantPager(opts []CallParticipantPaginateOption, reverse bool) (*callparticipantPager, error) {
+	pager := &callparticipantPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultCallParticipantOrder
+	}
+	return pager, nil
+}
+
+func (p *callparticipantPager) applyFilter(query *CallParticipantQuery) (*CallParticipantQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *callparticipantPager) toCursor(cp *CallParticipant) Cursor {
+	return p.order.Field.toCursor(cp)
+}
+
+func (p *callparticipantPager) applyCursors(query *CallParticipantQuery, after, before *Cursor) (*CallParticipantQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultCallParticipantOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *callparticipantPager) applyOrder(query *CallParticipantQuery) *CallParticipantQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultCallParticipantOrder.Field {
+		query = query.Order(DefaultCallParticipantOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *callparticipantPager) orderExpr(query *CallParticipantQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultCallParticipantOrder.Field {
+			b.Comma().Ident(DefaultCallParticipantOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to CallParticipant.
+func (cp *CallParticipantQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...CallParticipantPaginateOption,
+) (*CallParticipantConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newCallParticipantPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if cp, err = pager.applyFilter(cp); err != nil {
+		return nil, err
+	}
+	conn := &CallParticipantConnection{Edges: []*CallParticipantEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := cp.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if cp, err = pager.applyCursors(cp, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		cp.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := cp.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	cp = pager.applyOrder(cp)
+	nodes, err := cp.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// CallParticipantOrderField defines the ordering field of CallParticipant.
+type CallParticipantOrderField struct {
+	// Value extracts the ordering value from the given CallParticipant.
+	Value    func(*CallParticipant) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) callparticipant.OrderOption
+	toCursor func(*CallParticipant) Cursor
+}
+
+// CallParticipantOrder defines the ordering of CallParticipant.
+type CallParticipantOrder struct {
+	Direction OrderDirection             `json:"direction"`
+	Field     *CallParticipantOrderField `json:"field"`
+}
+
+// DefaultCallParticipantOrder is the default ordering of CallParticipant.
+var DefaultCallParticipantOrder = &CallParticipantOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &CallParticipantOrderField{
+		Value: func(cp *CallParticipant) (ent.Value, error) {
+			return cp.ID, nil
+		},
+		column: callparticipant.FieldID,
+		toTerm: callparticipant.ByID,
+		toCursor: func(cp *CallParticipant) Cursor {
+			return Cursor{ID: cp.ID}
+		},
+	},
+}
+
+// ToEdge converts CallParticipant into CallParticipantEdge.
+func (cp *CallParticipant) ToEdge(order *CallParticipantOrder) *CallParticipantEdge {
+	if order == nil {
+		order = DefaultCallParticipantOrder
+	}
+	return &CallParticipantEdge{
+		Node:   cp,
+		Cursor: order.Field.toCursor(cp),
+	}
+}
+
+// ContactPinEdge is the edge representation of ContactPin.
+type ContactPinEdge struct {
+	Node   *ContactPin `json:"node"`
+	Cursor Cursor      `json:"cursor"`
+}
+
+// ContactPinConnection is the connection containing edges to ContactPin.
+type ContactPinConnection struct {
+	Edges      []*ContactPinEdge `json:"edges"`
+	PageInfo   PageInfo          `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
+}
+
+func (c *ContactPinConnection) build(nodes []*ContactPin, pager *contactpinPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *ContactPin
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *ContactPin {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *ContactPin {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*ContactPinEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &ContactPinEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// ContactPinPaginateOption enables pagination customization.
+type ContactPinPaginateOption func(*contactpinPager) error
+
+// WithContactPinOrder configures pagination ordering.
+func WithContactPinOrder(order []*ContactPinOrder) ContactPinPaginateOption {
+	return func(pager *contactpinPager) error {
+		for _, o := range order {
+			if err := o.Direction.Validate(); err != nil {
+				return err
+			}
+		}
+		pager.order = append(pager.order, order...)
+		return nil
+	}
+}
+
+// WithContactPinFilter configures pagination filter.
+func WithContactPinFilter(filter func(*ContactPinQuery) (*ContactPinQuery, error)) ContactPinPaginateOption {
+	return func(pager *contactpinPager) error {
+		if filter == nil {
+			return errors.New("ContactPinQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type contactpinPager struct {
+	reverse bool
+	order   []*ContactPinOrder
+	filter  func(*ContactPinQuery) (*ContactPinQuery, error)
+}
+
+func newContactPinPager(opts []ContactPinPaginateOption, reverse bool) (*contactpinPager, error) {
+	pager := &contactpinPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	for i, o := range pager.order {
+		if i > 0 && o.Field == pager.order[i-1].Field {
+			return nil, fmt.Errorf("duplicate order direction %q", o.Direction)
+		}
+	}
+	return pager, nil
+}
+
+func (p *contactpinPager) applyFilter(query *ContactPinQuery) (*ContactPinQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *contactpinPager) toCursor(cp *ContactPin) Cursor {
+	cs_ := make([]any, 0, len(p.order))
+	for _, o_ := range p.order {
+		cs_ = append(cs_, o_.Field.toCursor(cp).Value)
+	}
+	return Cursor{ID: cp.ID, Value: cs_}
+}
+
+func (p *contactpinPager) applyCursors(query *ContactPinQuery, after, before *Cursor) (*ContactPinQuery, error) {
+	idDirection := entgql.OrderDirectionAsc
+	if p.reverse {
+		idDirection = entgql.OrderDirectionDesc
+	}
+	fields, directions := make([]string, 0, len(p.order)), make([]OrderDirection, 0, len(p.order))
+	for _, o := range p.order {
+		fields = append(fields, o.Field.column)
+		direction := o.Direction
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		directions = append(directions, direction)
+	}
+	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
+		FieldID:     DefaultContactPinOrder.Field.column,
+		DirectionID: idDirection,
+		Fields:      fields,
+		Directions:  directions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, predicate := range predicates {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *contactpinPager) applyOrder(query *ContactPinQuery) *ContactPinQuery {
+	var defaultOrdered bool
+	for _, o := range p.order {
+		direction := o.Direction
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
+		if o.Field.column == DefaultContactPinOrder.Field.column {
+			defaultOrdered = true
+		}
+		if len(query.ctx.Fields) > 0 {
+			query.ctx.AppendFieldOnce(o.Field.column)
+		}
+	}
+	if !defaultOrdered {
+		direction := entgql.OrderDirectionAsc
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		query = query.Order(DefaultContactPinOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	return query
+}
+
+func (p *contactpinPager) orderExpr(query *ContactPinQuery) sql.Querier {
+	if len(query.ctx.Fields) > 0 {
+		for _, o := range p.order {
+			query.ctx.AppendFieldOnce(o.Field.column)
+		}
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		for _, o := range p.order {
+			direction := o.Direction
+			if p.reverse {
+				direction = direction.Reverse()
+			}
+			b.Ident(o.Field.column).Pad().WriteString(string(direction))
+			b.Comma()
+		}
+		direction := entgql.OrderDirectionAsc
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		b.Ident(DefaultContactPinOrder.Field.column).Pad().WriteString(string(direction))
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to ContactPin.
+func (cp *ContactPinQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...ContactPinPaginateOption,
+) (*ContactPinConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newContactPinPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if cp, err = pager.applyFilter(cp); err != nil {
+		return nil, err
+	}
+	conn := &ContactPinConnection{Edges: []*ContactPinEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := cp.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if cp, err = pager.applyCursors(cp, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		cp.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := cp.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	cp = pager.applyOrder(cp)
+	nodes, err := cp.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// ContactPinOrderFieldCreatedAt orders ContactPin by created_at.
+	ContactPinOrderFieldCreatedAt = &ContactPinOrderField{
+		Value: func(cp *ContactPin) (ent.Value, error) {
+			return cp.CreatedAt, nil
+		},
+		column: contactpin.FieldCreatedAt,
+		toTerm: contactpin.ByCreatedAt,
+		toCursor: func(cp *ContactPin) Cursor {
+			return Cursor{
+				ID:    cp.ID,
+				Value: cp.CreatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f ContactPinOrderField) String() string {
+	var str string
+	switch f.column {
+	case ContactPinOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f ContactPinOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *ContactPinOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("ContactPinOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *ContactPinOrderFieldCreatedAt
+	default:
+		return fmt.Errorf("%s is not a valid ContactPinOrderField", str)
+	}
+	return nil
+}
+
+// ContactPinOrderField defines the ordering field of ContactPin.
+type ContactPinOrderField struct {
+	// Value extracts the ordering value from the given ContactPin.
+	Value    func(*ContactPin) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) contactpin.OrderOption
+	toCursor func(*ContactPin) Cursor
+}
+
+// ContactPinOrder defines the ordering of ContactPin.
+type ContactPinOrder struct {
+	Direction OrderDirection        `json:"direction"`
+	Field     *ContactPinOrderField `json:"field"`
+}
+
+// DefaultContactPinOrder is the default ordering of ContactPin.
+var DefaultContactPinOrder = &ContactPinOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &ContactPinOrderField{
+		Value: func(cp *ContactPin) (ent.Value, error) {
+			return cp.ID, nil
+		},
+		column: contactpin.FieldID,
+		toTerm: contactpin.ByID,
+		toCursor: func(cp *ContactPin) Cursor {
+			return Cursor{ID: cp.ID}
+		},
+	},
+}
+
+// ToEdge converts ContactPin into ContactPinEdge.
+func (cp *ContactPin) ToEdge(order *ContactPinOrder) *ContactPinEdge {
+	if order == nil {
+		order = DefaultContactPinOrder
+	}
+	return &ContactPinEdge{
+		Node:   cp,
+		Cursor: order.Field.toCursor(cp),
+	}
+}
+
+// ContactRequestEdge is the edge representation of ContactRequest.
+type ContactRequestEdge struct {
+	Node   *ContactRequest `json:"node"`
+	Cursor Cursor          `json:"cursor"`
+}
+
+// ContactRequestConnection is the connection containing edges to ContactRequest.
+type ContactRequestConnection struct {
+	Edges      []*ContactRequestEdge `json:"edges"`
+	PageInfo   PageInfo              `json:"pageInfo"`
+	TotalCount int                   `json:"totalCount"`
+}
+
+func (c *ContactRequestConnection) build(nodes []*ContactRequest, pager *contactrequestPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *ContactRequest
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *ContactRequest {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *ContactRequest {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*ContactRequestEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &ContactRequestEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// ContactRequestPaginateOption enables pagination customization.
+type ContactRequestPaginateOption func(*contactrequestPager) error
+
+// WithContactRequestOrder configures pagination ordering.
+func WithContactRequestOrder(order []*ContactRequestOrder) ContactRequestPaginateOption {
+	return func(pager *contactrequestPager) error {
+		for _, o := range order {
+			if err := o.Direction.Validate(); err != nil {
+				return err
+			}
+		}
+		pager.order = append(pager.order, order...)
+		return nil
+	}
+}
+
+// WithContactRequestFilter configures pagination filter.
+func WithContactRequestFilter(filter func(*ContactRequestQuery) (*ContactRequestQuery, error)) ContactRequestPaginateOption {
+	return func(pager *contactrequestPager) error {
+		if filter == nil {
+			return errors.New("ContactRequestQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type contactrequestPager struct {
+	reverse bool
+	order   []*ContactRequestOrder
+	filter  func(*ContactRequestQuery) (*ContactRequestQuery, error)
+}
+
+func newContactRequestPager(opts []ContactRequestPaginateOption, reverse bool) (*contactrequestPager, error) {
+	pager := &contactrequestPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	for i, o := range pager.order {
+		if i > 0 && o.Field == pager.order[i-1].Field {
+			return nil, fmt.Errorf("duplicate order direction %q", o.Direction)
+		}
+	}
+	return pager, nil
+}
+
+func (p *contactrequestPager) applyFilter(query *ContactRequestQuery) (*ContactRequestQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *contactrequestPager) toCursor(cr *ContactRequest) Cursor {
+	cs_ := make([]any, 0, len(p.order))
+	for _, o_ := range p.order {
+		cs_ = append(cs_, o_.Field.toCursor(cr).Value)
+	}
+	return Cursor{ID: cr.ID, Value: cs_}
+}
+
+func (p *contactrequestPager) applyCursors(query *ContactRequestQuery, after, before *Cursor) (*ContactRequestQuery, error) {
+	idDirection := entgql.OrderDirectionAsc
+	if p.reverse {
+		idDirection = entgql.OrderDirectionDesc
+	}
+	fields, directions := make([]string, 0, len(p.order)), make([]OrderDirection, 0, len(p.order))
+	for _, o := range p.order {
+		fields = append(fields, o.Field.column)
+		direction := o.Direction
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		directions = append(directions, direction)
+	}
+	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
+		FieldID:     DefaultContactRequestOrder.Field.column,
+		DirectionID: idDirection,
+		Fields:      fields,
+		Directions:  directions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, predicate := range predicates {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *contactrequestPager) applyOrder(query *ContactRequestQuery) *ContactRequestQuery {
+	var defaultOrdered bool
+	for _, o := range p.order {
+		direction := o.Direction
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
+		if o.Field.column == DefaultContactRequestOrder.Field.column {
+			defaultOrdered = true
+		}
+		if len(query.ctx.Fields) > 0 {
+			query.ctx.AppendFieldOnce(o.Field.column)
+		}
+	}
+	if !defaultOrdered {
+		direction := entgql.OrderDirectionAsc
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		query = query.Order(DefaultContactRequestOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	return query
+}
+
+func (p *contactrequestPager) orderExpr(query *ContactRequestQuery) sql.Querier {
+	if len(query.ctx.Fields) > 0 {
+		for _, o := range p.order {
+			query.ctx.AppendFieldOnce(o.Field.column)
+		}
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		for _, o := range p.order {
+			direction := o.Direction
+			if p.reverse {
+				direction = direction.Reverse()
+			}
+			b.Ident(o.Field.column).Pad().WriteString(string(direction))
+			b.Comma()
+		}
+		direction := entgql.OrderDirectionAsc
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		b.Ident(DefaultContactRequestOrder.Field.column).Pad().WriteString(string(direction))
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to ContactRequest.
+func (cr *ContactRequestQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...ContactRequestPaginateOption,
+) (*ContactRequestConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newContactRequestPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if cr, err = pager.applyFilter(cr); err != nil {
+		return nil, err
+	}
+	conn := &ContactRequestConnection{Edges: []*ContactRequestEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := cr.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if cr, err = pager.applyCursors(cr, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		cr.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := cr.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	cr = pager.applyOrder(cr)
+	nodes, err := cr.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// ContactRequestOrderFieldStatus orders ContactRequest by status.
+	ContactRequestOrderFieldStatus = &ContactRequestOrderField{
+		Value: func(cr *ContactRequest) (ent.Value, error) {
+			return cr.Status, nil
+		},
+		column: contactrequest.FieldStatus,
+		toTerm: contactrequest.ByStatus,
+		toCursor: func(cr *ContactRequest) Cursor {
+			return Cursor{
+				ID:    cr.ID,
+				Value: cr.Status,
+			}
+		},
+	}
+	// ContactRequestOrderFieldCreatedAt orders ContactRequest by created_at.
+	ContactRequestOrderFieldCreatedAt = &ContactRequestOrderField{
+		Value: func(cr *ContactRequest) (ent.Value, error) {
+			return cr.CreatedAt, nil
+		},
+		column: contactrequest.FieldCreatedAt,
+		toTerm: contactrequest.ByCreatedAt,
+		toCursor: func(cr *ContactRequest) Cursor {
+			return Cursor{
+				ID:    cr.ID,
+				Value: cr.CreatedAt,
+			}
+		},
+	}
+	// ContactRequestOrderFieldUpdatedAt orders ContactRequest by updated_at.
+	ContactRequestOrderFieldUpdatedAt = &ContactRequestOrderField{
+		Value: func(cr *ContactRequest) (ent.Value, error) {
+			return cr.UpdatedAt, nil
+		},
+		column: contactrequest.FieldUpdatedAt,
+		toTerm: contactrequest.ByUpdatedAt,
+		toCursor: func(cr *ContactRequest) Cursor {
+			return Cursor{
+				ID:    cr.ID,
+				Value: cr.UpdatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f ContactRequestOrderField) String() string {
+	var str string
+	switch f.column {
+	case ContactRequestOrderFieldStatus.column:
+		str = "STATUS"
+	case ContactRequestOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	case ContactRequestOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f ContactRequestOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *ContactRequestOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("ContactRequestOrderField %T must be a string", v)
+	}
+	switch str {
+	case "STATUS":
+		*f = *ContactRequestOrderFieldStatus
+	case "CREATED_AT":
+		*f = *ContactRequestOrderFieldCreatedAt
+	case "UPDATED_AT":
+		*f = *ContactRequestOrderFieldUpdatedAt
+	default:
+		return fmt.Errorf("%s is not a valid ContactRequestOrderField", str)
+	}
+	return nil
+}
+
+// ContactRequestOrderField defines the ordering field of ContactRequest.
+type ContactRequestOrderField struct {
+	// Value extracts the ordering value from the given ContactRequest.
+	Value    func(*ContactRequest) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) contactrequest.OrderOption
+	toCursor func(*ContactRequest) Cursor
+}
+
+// ContactRequestOrder defines the ordering of ContactRequest.
+type ContactRequestOrder struct {
+	Direction OrderDirection            `json:"direction"`
+	Field     *ContactRequestOrderField `json:"field"`
+}
+
+// DefaultContactRequestOrder is the default ordering of ContactRequest.
+var DefaultContactRequestOrder = &ContactRequestOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &ContactRequestOrderField{
+		Value: func(cr *ContactRequest) (ent.Value, error) {
+			return cr.ID, nil
+		},
+		column: contactrequest.FieldID,
+		toTerm: contactrequest.ByID,
+		toCursor: func(cr *ContactRequest) Cursor {
+			return Cursor{ID: cr.ID}
+		},
+	},
+}
+
+// ToEdge converts ContactRequest into ContactRequestEdge.
+func (cr *ContactRequest) ToEdge(order *ContactRequestOrder) *ContactRequestEdge {
+	if order == nil {
+		order = DefaultContactRequestOrder
+	}
+	return &ContactRequestEdge{
+		Node:   cr,
+		Cursor: order.Field.toCursor(cr),
+	}
+}
+
+// DeviceEdge is the edge representation of Device.
+type DeviceEdge struct {
+	Node   *Device `json:"node"`
+	Cursor Cursor  `json:"cursor"`
+}
+
+// DeviceConnection is the connection containing edges to Device.
+type DeviceConnection struct {
+	Edges      []*DeviceEdge `json:"edges"`
+	PageInfo   PageInfo      `json:"pageInfo"`
+	TotalCount int           `json:"totalCount"`
+}
+
+func (c *DeviceConnection) build(nodes []*Device, pager *devicePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Device
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Device {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Device {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*DeviceEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &DeviceEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// DevicePaginateOption enables pagination customization.
+type DevicePaginateOption func(*devicePager) error
+
+// WithDeviceOrder configures pagination ordering.
+func WithDeviceOrder(order []*DeviceOrder) DevicePaginateOption {
+	return func(pager *devicePager) error {
+		for _, o := range order {
+			if err := o.Direction.Validate(); err != nil {
+				return err
+			}
+		}
+		pager.order = append(pager.order, order...)
+		return nil
+	}
+}
+
+// WithDeviceFilter configures pagination filter.
+func WithDeviceFilter(filter func(*DeviceQuery) (*DeviceQuery, error)) DevicePaginateOption {
+	return func(pager *devicePager) error {
+		if filter == nil {
+			return errors.New("DeviceQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type devicePager struct {
+	reverse bool
+	order   []*DeviceOrder
+	filter  func(*DeviceQuery) (*DeviceQuery, error)
+}
+
+func newDevicePager(opts []DevicePaginateOption, reverse bool) (*devicePager, error) {
+	pager := &devicePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	for i, o := range pager.order {
+		if i > 0 && o.Field == pager.order[i-1].Field {
+			return nil, fmt.Errorf("duplicate order direction %q", o.Direction)
+		}
+	}
+	return pager, nil
+}
+
+func (p *devicePager) applyFilter(query *DeviceQuery) (*DeviceQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *devicePager) toCursor(d *Device) Cursor {
+	cs_ := make([]any, 0, len(p.order))
+	for _, o_ := range p.order {
+		cs_ = append(cs_, o_.Field.toCursor(d).Value)
+	}
+	return Cursor{ID: d.ID, Value: cs_}
+}
+
+func (p *devicePager) applyCursors(query *DeviceQuery, after, before *Cursor) (*DeviceQuery, error) {
+	idDirection := entgql.OrderDirectionAsc
+	if p.reverse {
+		idDirection = entgql.OrderDirectionDesc
+	}
+	fields, directions := make([]string, 0, len(p.order)), make([]OrderDirection, 0, len(p.order))
+	for _, o := range p.order {
+		fields = append(fields, o.Field.column)
+		direction := o.Direction
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		directions = append(directions, direction)
+	}
+	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
+		FieldID:     DefaultDeviceOrder.Field.column,
+		DirectionID: idDirection,
+		Fields:      fields,
+		Directions:  directions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, predicate := range predicates {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *devicePager) applyOrder(query *DeviceQuery) *DeviceQuery {
+	var defaultOrdered bool
+	for _, o := range p.order {
+		direction := o.Direction
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
+		if o.Field.column == DefaultDeviceOrder.Field.column {
+			defaultOrdered = true
+		}
+		if len(query.ctx.Fields) > 0 {
+			query.ctx.AppendFieldOnce(o.Field.column)
+		}
+	}
+	if !defaultOrdered {
+		direction := entgql.OrderDirectionAsc
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		query = query.Order(DefaultDeviceOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	return query
+}
+
+func (p *devicePager) orderExpr(query *DeviceQuery) sql.Querier {
+	if len(query.ctx.Fields) > 0 {
+		for _, o := range p.order {
+			query.ctx.AppendFieldOnce(o.Field.column)
+		}
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		for _, o := range p.order {
+			direction := o.Direction
+			if p.reverse {
+				direction = direction.Reverse()
+			}
+			b.Ident(o.Field.column).Pad().WriteString(string(direction))
+			b.Comma()
+		}
+		direction := entgql.OrderDirectionAsc
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		b.Ident(DefaultDeviceOrder.Field.column).Pad().WriteString(string(direction))
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Device.
+func (d *DeviceQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...DevicePaginateOption,
+) (*DeviceConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newDevicePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if d, err = pager.applyFilter(d); err != nil {
+		return nil, err
+	}
+	conn := &DeviceConnection{Edges: []*DeviceEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := d.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if d, err = pager.applyCursors(d, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		d.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := d.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	d = pager.applyOrder(d)
+	nodes, err := d.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// DeviceOrderFieldDeviceID orders Device by device_id.
+	DeviceOrderFieldDeviceID = &DeviceOrderField{
+		Value: func(d *Device) (ent.Value, error) {
+			return d.DeviceID, nil
+		},
+		column: device.FieldDeviceID,
+		toTerm: device.ByDeviceID,
+		toCursor: func(d *Device) Cursor {
+			return Cursor{
+				ID:    d.ID,
+				Value: d.DeviceID,
+			}
+		},
+	}
+	// DeviceOrderFieldPlatform orders Device by platform.
+	DeviceOrderFieldPlatform = &DeviceOrderField{
+		Value: func(d *Device) (ent.Value, error) {
+			return d.Platform, nil
+		},
+		column: device.FieldPlatform,
+		toTerm: device.ByPlatform,
+		toCursor: func(d *Device) Cursor {
+			return Cursor{
+				ID:    d.ID,
+				Value: d.Platform,
+			}
+		},
+	}
+	// DeviceOrderFieldCreatedAt orders Device by created_at.
+	DeviceOrderFieldCreatedAt = &DeviceOrderField{
+		Value: func(d *Device) (ent.Value, error) {
+			return d.CreatedAt, nil
+		},
+		column: device.FieldCreatedAt,
+		toTerm: device.ByCreatedAt,
+		toCursor: func(d *Device) Cursor {
+			return Cursor{
+				ID:    d.ID,
+				Value: d.CreatedAt,
+			}
+		},
+	}
+	// DeviceOrderFieldUpdatedAt orders Device by updated_at.
+	DeviceOrderFieldUpdatedAt = &DeviceOrderField{
+		Value: func(d *Device) (ent.Value, error) {
+			return d.UpdatedAt, nil
+		},
+		column: device.FieldUpdatedAt,
+		toTerm: device.ByUpdatedAt,
+		toCursor: func(d *Device) Cursor {
+			return Cursor{
+				ID:    d.ID,
+				Value: d.UpdatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f DeviceOrderField) String() string {
+	var str string
+	switch f.column {
+	case DeviceOrderFieldDeviceID.column:
+		str = "DEVICE_ID"
+	case DeviceOrderFieldPlatform.column:
+		str = "PLATFORM"
+	case DeviceOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	case DeviceOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f DeviceOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *DeviceOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("DeviceOrderField %T must be a string", v)
+	}
+	switch str {
+	case "DEVICE_ID":
+		*f = *DeviceOrderFieldDeviceID
+	case "PLATFORM":
+		*f = *DeviceOrderFieldPlatform
+	case "CREATED_AT":
+		*f = *DeviceOrderFieldCreatedAt
+	case "UPDATED_AT":
+		*f = *DeviceOrderFieldUpdatedAt
+	default:
+		return fmt.Errorf("%s is not a valid DeviceOrderField", str)
+	}
+	return nil
+}
+
+// DeviceOrderField defines the ordering field of Device.
+type DeviceOrderField struct {
+	// Value extracts the ordering value from the given Device.
+	Value    func(*Device) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) device.OrderOption
+	toCursor func(*Device) Cursor
+}
+
+// DeviceOrder defines the ordering of Device.
+type DeviceOrder struct {
+	Direction OrderDirection    `json:"direction"`
+	Field     *DeviceOrderField `json:"field"`
+}
+
+// DefaultDeviceOrder is the default ordering of Device.
+var DefaultDeviceOrder = &DeviceOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &DeviceOrderField{
+		Value: func(d *Device) (ent.Value, error) {
+			return d.ID, nil
+		},
+		column: device.FieldID,
+		toTerm: device.ByID,
+		toCursor: func(d *Device) Cursor {
+			return Cursor{ID: d.ID}
+		},
+	},
+}
+
+// ToEdge converts Device into DeviceEdge.
+func (d *Device) ToEdge(order *DeviceOrder) *DeviceEdge {
+	if order == nil {
+		order = DefaultDeviceOrder
+	}
+	return &DeviceEdge{
+		Node:   d,
+		Cursor: order.Field.toCursor(d),
+	}
+}
+
+// FileEdge is the edge representation of File.
+type FileEdge struct {
+	Node   *File  `json:"node"`
+	Cursor Cursor `json:"cursor"`
+}
+
+// FileConnection is the connection containing edges to File.
+type FileConnection struct {
+	Edges      []*FileEdge `json:"edges"`
+	PageInfo   PageInfo    `json:"pageInfo"`
+	TotalCount int         `json:"totalCount"`
+}
+
+func (c *FileConnection) build(nodes []*File, pager *filePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *File
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *File {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *File {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*FileEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &FileEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// FilePaginateOption enables pagination customization.
+type FilePaginateOption func(*filePager) error
+
+// WithFileOrder configures pagination ordering.
+func WithFileOrder(order *FileOrder) FilePaginateOption {
+	if order == nil {
+		order = DefaultFileOrder
+	}
+	o := *order
+	return func(pager *filePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultFileOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithFileFilter configures pagination filter.
+func WithFileFilter(filter func(*FileQuery) (*FileQuery, error)) FilePaginateOption {
+	return func(pager *filePager) error {
+		if filter == nil {
+			return errors.New("FileQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type filePager struct {
+	reverse bool
+	order   *FileOrder
+	filter  func(*FileQuery) (*FileQuery, error)
+}
+
+func newFilePager(opts []FilePaginateOption, reverse bool) (*filePager, error) {
+	pager := &filePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultFileOrder
+	}
+	return pager, nil
+}
+
+func (p *filePager) applyFilter(query *FileQuery) (*FileQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *filePager) toCursor(f *File) Cursor {
+	return p.order.Field.toCursor(f)
+}
+
+func (p *filePager) applyCursors(query *FileQuery, after, before *Cursor) (*FileQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultFileOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *filePager) applyOrder(query *FileQuery) *FileQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultFileOrder.Field {
+		query = query.Order(DefaultFileOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *filePager) orderExpr(query *FileQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultFileOrder.Field {
+			b.Comma().Ident(DefaultFileOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to File.
+func (f *FileQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...FilePaginateOption,
+) (*FileConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newFilePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if f, err = pager.applyFilter(f); err != nil {
+		return nil, err
+	}
+	conn := &FileConnection{Edges: []*FileEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := f.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if f, err = pager.applyCursors(f, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		f.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := f.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	f = pager.applyOrder(f)
+	nodes, err := f.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// FileOrderFieldName orders File by name.
+	FileOrderFieldName = &FileOrderField{
+		Value: func(f *File) (ent.Value, error) {
+			return f.Name, nil
+		},
+		column: file.FieldName,
+		toTerm: file.ByName,
+		toCursor: func(f *File) Cursor {
+			return Cursor{
+				ID:    f.ID,
+				Value: f.Name,
+			}
+		},
+	}
+	// FileOrderFieldContentType orders File by content_type.
+	FileOrderFieldContentType = &FileOrderField{
+		Value: func(f *File) (ent.Value, error) {
+			return f.ContentType, nil
+		},
+		column: file.FieldContentType,
+		toTerm: file.ByContentType,
+		toCursor: func(f *File) Cursor {
+			return Cursor{
+				ID:    f.ID,
+				Value: f.ContentType,
+			}
+		},
+	}
+	// FileOrderFieldSize orders File by size.
+	FileOrderFieldSize = &FileOrderField{
+		Value: func(f *File) (ent.Value, error) {
+			return f.Size, nil
+		},
+		column: file.FieldSize,
+		toTerm: file.BySize,
+		toCursor: func(f *File) Cursor {
+			return Cursor{
+				ID:    f.ID,
+				Value: f.Size,
+			}
+		},
+	}
+	// FileOrderFieldLocation orders File by location.
+	FileOrderFieldLocation = &FileOrderField{
+		Value: func(f *File) (ent.Value, error) {
+			return f.Location, nil
+		},
+		column: file.FieldLocation,
+		toTerm: file.ByLocation,
+		toCursor: func(f *File) Cursor {
+			return Cursor{
+				ID:    f.ID,
+				Value: f.Location,
+			}
+		},
+	}
+	// FileOrderFieldBucket orders File by bucket.
+	FileOrderFieldBucket = &FileOrderField{
+		Value: func(f *File) (ent.Value, error) {
+			return f.Bucket, nil
+		},
+		column: file.FieldBucket,
+		toTerm: file.ByBucket,
+		toCursor: func(f *File) Cursor {
+			return Cursor{
+				ID:    f.ID,
+				Value: f.Bucket,
+			}
+		},
+	}
+	// FileOrderFieldPath orders File by path.
+	FileOrderFieldPath = &FileOrderField{
+		Value: func(f *File) (ent.Value, error) {
+			return f.Path, nil
+		},
+		column: file.FieldPath,
+		toTerm: file.ByPath,
+		toCursor: func(f *File) Cursor {
+			return Cursor{
+				ID:    f.ID,
+				Value: f.Path,
+			}
+		},
+	}
+	// FileOrderFieldCreatedAt orders File by created_at.
+	FileOrderFieldCreatedAt = &FileOrderField{
+		Value: func(f *File) (ent.Value, error) {
+			return f.CreatedAt, nil
+		},
+		column: file.FieldCreatedAt,
+		toTerm: file.ByCreatedAt,
+		toCursor: func(f *File) Cursor {
+			return Cursor{
+				ID:    f.ID,
+				Value: f.CreatedAt,
+			}
+		},
+	}
+	// FileOrderFieldUpdatedAt orders File by updated_at.
+	FileOrderFieldUpdatedAt = &FileOrderField{
+		Value: func(f *File) (ent.Value, error) {
+			return f.UpdatedAt, nil
+		},
+		column: file.FieldUpdatedAt,
+		toTerm: file.ByUpdatedAt,
+		toCursor: func(f *File) Cursor {
+			return Cursor{
+				ID:    f.ID,
+				Value: f.UpdatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f FileOrderField) String() string {
+	var str string
+	switch f.column {
+	case FileOrderFieldName.column:
+		str = "NAME"
+	case FileOrderFieldContentType.column:
+		str = "CONTENT_TYPE"
+	case FileOrderFieldSize.column:
+		str = "SIZE"
+	case FileOrderFieldLocation.column:
+		str = "LOCATION"
+	case FileOrderFieldBucket.column:
+		str = "BUCKET"
+	case FileOrderFieldPath.column:
+		str = "PATH"
+	case FileOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	case FileOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f FileOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *FileOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("FileOrderField %T must be a string", v)
+	}
+	switch str {
+	case "NAME":
+		*f = *FileOrderFieldName
+	case "CONTENT_TYPE":
+		*f = *FileOrderFieldContentType
+	case "SIZE":
+		*f = *FileOrderFieldSize
+	case "LOCATION":
+		*f = *FileOrderFieldLocation
+	case "BUCKET":
+		*f = *FileOrderFieldBucket
+	case "PATH":
+		*f = *FileOrderFieldPath
+	case "CREATED_AT":
+		*f = *FileOrderFieldCreatedAt
+	case "UPDATED_AT":
+		*f = *FileOrderFieldUpdatedAt
+	default:
+		return fmt.Errorf("%s is not a valid FileOrderField", str)
+	}
+	return nil
+}
+
+// FileOrderField defines the ordering field of File.
+type FileOrderField struct {
+	// Value extracts the ordering value from the given File.
+	Value    func(*File) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) file.OrderOption
+	toCursor func(*File) Cursor
+}
+
+// FileOrder defines the ordering of File.
+type FileOrder struct {
+	Direction OrderDirection  `json:"direction"`
+	Field     *FileOrderField `json:"field"`
+}
+
+// DefaultFileOrder is the default ordering of File.
+var DefaultFileOrder = &FileOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &FileOrderField{
+		Value: func(f *File) (ent.Value, error) {
+			return f.ID, nil
+		},
+		column: file.FieldID,
+		toTerm: file.ByID,
+		toCursor: func(f *File) Cursor {
+			return Cursor{ID: f.ID}
+		},
+	},
+}
+
+// ToEdge converts File into FileEdge.
+func (f *File) ToEdge(order *FileOrder) *FileEdge {
+	if order == nil {
+		order = DefaultFileOrder
+	}
+	return &FileEdge{
+		Node:   f,
+		Cursor: order.Field.toCursor(f),
+	}
+}
+
+// MessageEdge is the edge representation of Message.
+type MessageEdge struct {
+	Node   *Message `json:"node"`
+	Cursor Cursor   `json:"cursor"`
+}
+
+// MessageConnection is the connection containing edges to Message.
+type MessageConnection struct {
+	Edges      []*MessageEdge `json:"edges"`
+	PageInfo   PageInfo       `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
+}
+
+func (c *MessageConnection) build(nodes []*Message, pager *messagePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Message
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Message {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Message {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*MessageEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &MessageEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// MessagePaginateOption enables pagination customization.
+type MessagePaginateOption func(*messagePager) error
+
+// WithMessageOrder configures pagination ordering.
+func WithMessageOrder(order []*MessageOrder) MessagePaginateOption {
+	return func(pager *messagePager) error {
+		for _, o := range order {
+			if err := o.Direction.Validate(); err != nil {
+				return err
+			}
+		}
+		pager.order = append(pager.order, order...)
+		return nil
+	}
+}
+
+// WithMessageFilter configures pagination filter.
+func WithMessageFilter(filter func(*MessageQuery) (*MessageQuery, error)) MessagePaginateOption {
+	return func(pager *messagePager) error {
+		if filter == nil {
+			return errors.New("MessageQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type messagePager struct {
+	reverse bool
+	order   []*MessageOrder
+	filter  func(*MessageQuery) (*MessageQuery, error)
+}
+
+func newMessagePager(opts []MessagePaginateOption, reverse bool) (*messagePager, error) {
+	pager := &messagePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	for i, o := range pager.order {
+		if i > 0 && o.Field == pager.order[i-1].Field {
+			return nil, fmt.Errorf("duplicate order direction %q", o.Direction)
+		}
+	}
+	return pager, nil
+}
+
+func (p *messagePager) applyFilter(query *MessageQuery) (*MessageQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *messagePager) toCursor(m *Message) Cursor {
+	cs_ := make([]any, 0, len(p.order))
+	for _, o_ := range p.order {
+		cs_ = append(cs_, o_.Field.toCursor(m).Value)
+	}
+	return Cursor{ID: m.ID, Value: cs_}
+}
+
+func (p *messagePager) applyCursors(query *MessageQuery, after, before *Cursor) (*MessageQuery, error) {
+	idDirection := entgql.OrderDirectionAsc
+	if p.reverse {
+		idDirection = entgql.OrderDirectionDesc
+	}
+	fields, directions := make([]string, 0, len(p.order)), make([]OrderDirection, 0, len(p.order))
+	for _, o := range p.order {
+		fields = append(fields, o.Field.column)
+		direction := o.Direction
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		directions = append(directions, direction)
+	}
+	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
+		FieldID:     DefaultMessageOrder.Field.column,
+		DirectionID: idDirection,
+		Fields:      fields,
+		Directions:  directions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, predicate := range predicates {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *messagePager) applyOrder(query *MessageQuery) *MessageQuery {
+	var defaultOrdered bool
+	for _, o := range p.order {
+		direction := o.Direction
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
+		if o.Field.column == DefaultMessageOrder.Field.column {
+			defaultOrdered = true
+		}
+		if len(query.ctx.Fields) > 0 {
+			query.ctx.AppendFieldOnce(o.Field.column)
+		}
+	}
+	if !defaultOrdered {
+		direction := entgql.OrderDirectionAsc
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		query = query.Order(DefaultMessageOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	return query
+}
+
+func (p *messagePager) orderExpr(query *MessageQuery) sql.Querier {
+	if len(query.ctx.Fields) > 0 {
+		for _, o := range p.order {
+			query.ctx.AppendFieldOnce(o.Field.column)
+		}
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		for _, o := range p.order {
+			direction := o.Direction
+			if p.reverse {
+				direction = direction.Reverse()
+			}
+			b.Ident(o.Field.column).Pad().WriteString(string(direction))
+			b.Comma()
+		}
+		direction := entgql.OrderDirectionAsc
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		b.Ident(DefaultMessageOrder.Field.column).Pad().WriteString(string(direction))
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Message.
+func (m *MessageQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...MessagePaginateOption,
+) (*MessageConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newMessagePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if m, err = pager.applyFilter(m); err != nil {
+		return nil, err
+	}
+	conn := &MessageConnection{Edges: []*MessageEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if m, err = pager.applyCursors(m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	m = pager.applyOrder(m)
+	nodes, err := m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// MessageOrderFieldCreatedAt orders Message by created_at.
+	MessageOrderFieldCreatedAt = &MessageOrderField{
+		Value: func(m *Message) (ent.Value, error) {
+			return m.CreatedAt, nil
+		},
+		column: message.FieldCreatedAt,
+		toTerm: message.ByCreatedAt,
+		toCursor: func(m *Message) Cursor {
+			return Cursor{
+				ID:    m.ID,
+				Value: m.CreatedAt,
+			}
+		},
+	}
+	// MessageOrderFieldUpdatedAt orders Message by updated_at.
+	MessageOrderFieldUpdatedAt = &MessageOrderField{
+		Value: func(m *Message) (ent.Value, error) {
+			return m.UpdatedAt, nil
+		},
+		column: message.FieldUpdatedAt,
+		toTerm: message.ByUpdatedAt,
+		toCursor: func(m *Message) Cursor {
+			return Cursor{
+				ID:    m.ID,
+				Value: m.UpdatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f MessageOrderField) String() string {
+	var str string
+	switch f.column {
+	case MessageOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	case MessageOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f MessageOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *MessageOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("MessageOrderField %T must be a string", v)
 	}
 	switch str {
-	case "NAME":
-		*f = *FileOrderFieldName
-	case "CONTENT_TYPE":
-		*f = *FileOrderFieldContentType
-	case "SIZE":
-		*f = *FileOrderFieldSize
-	case "LOCATION":
-		*f = *FileOrderFieldLocation
-	case "BUCKET":
-		*f = *FileOrderFieldBucket
-	case "PATH":
-		*f = *FileOrderFieldPath
 	case "CREATED_AT":
-		*f = *FileOrderFieldCreatedAt
+		*f = *MessageOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *FileOrderFieldUpdatedAt
+		*f = *MessageOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid FileOrderField", str)
+		return fmt.Errorf("%s is not a valid MessageOrderField", str)
 	}
 	return nil
 }
 
-// FileOrderField defines the ordering field of File.
-type FileOrderField struct {
-	// Value extracts the ordering value from the given File.
-	Value    func(*File) (ent.Value, error)
+// MessageOrderField defines the ordering field of Message.
+type MessageOrderField struct {
+	// Value extracts the ordering value from the given Message.
+	Value    func(*Message) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) file.OrderOption
-	toCursor func(*File) Cursor
+	toTerm   func(...sql.OrderTermOption) message.OrderOption
+	toCursor func(*Message) Cursor
 }
 
-// FileOrder defines the ordering of File.
-type FileOrder struct {
-	Direction OrderDirection  `json:"direction"`
-	Field     *FileOrderField `json:"field"`
+// MessageOrder defines the ordering of Message.
+type MessageOrder struct {
+	Direction OrderDirection     `json:"direction"`
+	Field     *MessageOrderField `json:"field"`
 }
 
-// DefaultFileOrder is the default ordering of File.
-var DefaultFileOrder = &FileOrder{
+// DefaultMessageOrder is the default ordering of Message.
+var DefaultMessageOrder = &MessageOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &FileOrderField{
-		Value: func(f *File) (ent.Value, error) {
-			return f.ID, nil
+	Field: &MessageOrderField{
+		Value: func(m *Message) (ent.Value, error) {
+			return m.ID, nil
 		},
-		column: file.FieldID,
-		toTerm: file.ByID,
-		toCursor: func(f *File) Cursor {
-			return Cursor{ID: f.ID}
+		column: message.FieldID,
+		toTerm: message.ByID,
+		toCursor: func(m *Message) Cursor {
+			return Cursor{ID: m.ID}
 		},
 	},
 }
 
-// ToEdge converts File into FileEdge.
-func (f *File) ToEdge(order *FileOrder) *FileEdge {
+// ToEdge converts Message into MessageEdge.
+func (m *Message) ToEdge(order *MessageOrder) *MessageEdge {
 	if order == nil {
-		order = DefaultFileOrder
+		order = DefaultMessageOrder
 	}
-	return &FileEdge{
-		Node:   f,
-		Cursor: order.Field.toCursor(f),
+	return &MessageEdge{
+		Node:   m,
+		Cursor: order.Field.toCursor(m),
 	}
 }
 
-// MessageEdge is the edge representation of Message.
-type MessageEdge struct {
-	Node   *Message `json:"node"`
-	Cursor Cursor   `json:"cursor"`
+// MessageAttachmentEdge is the edge representation of MessageAttachment.
+type MessageAttachmentEdge struct {
+	Node   *MessageAttachment `json:"node"`
+	Cursor Cursor             `json:"cursor"`
 }
 
-// MessageConnection is the connection containing edges to Message.
-type MessageConnection struct {
-	Edges      []*MessageEdge `json:"edges"`
-	PageInfo   PageInfo       `json:"pageInfo"`
-	TotalCount int            `json:"totalCount"`
+// MessageAttachmentConnection is the connection containing edges to MessageAttachment.
+type MessageAttachmentConnection struct {
+	Edges      []*MessageAttachmentEdge `json:"edges"`
+	PageInfo   PageInfo                 `json:"pageInfo"`
+	TotalCount int                      `json:"totalCount"`
 }
 
-func (c *MessageConnection) build(nodes []*Message, pager *messagePager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *MessageAttachmentConnection) build(nodes []*MessageAttachment, pager *messageattachmentPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -940,21 +2874,21 @@ func (c *MessageConnection) build(nodes []*Message, pager *messagePager, after *
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Message
+	var nodeAt func(int) *MessageAttachment
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Message {
+		nodeAt = func(i int) *MessageAttachment {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Message {
+		nodeAt = func(i int) *MessageAttachment {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*MessageEdge, len(nodes))
+	c.Edges = make([]*MessageAttachmentEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &MessageEdge{
+		c.Edges[i] = &MessageAttachmentEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -968,12 +2902,12 @@ func (c *MessageConnection) build(nodes []*Message, pager *messagePager, after *
 	}
 }
 
-// MessagePaginateOption enables pagination customization.
-type MessagePaginateOption func(*messagePager) error
+// MessageAttachmentPaginateOption enables pagination customization.
+type MessageAttachmentPaginateOption func(*messageattachmentPager) error
 
-// WithMessageOrder configures pagination ordering.
-func WithMessageOrder(order []*MessageOrder) MessagePaginateOption {
-	return func(pager *messagePager) error {
+// WithMessageAttachmentOrder configures pagination ordering.
+func WithMessageAttachmentOrder(order []*MessageAttachmentOrder) MessageAttachmentPaginateOption {
+	return func(pager *messageattachmentPager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -984,25 +2918,25 @@ func WithMessageOrder(order []*MessageOrder) MessagePaginateOption {
 	}
 }
 
-// WithMessageFilter configures pagination filter.
-func WithMessageFilter(filter func(*MessageQuery) (*MessageQuery, error)) MessagePaginateOption {
-	return func(pager *messagePager) error {
+// WithMessageAttachmentFilter configures pagination filter.
+func WithMessageAttachmentFilter(filter func(*MessageAttachmentQuery) (*MessageAttachmentQuery, error)) MessageAttachmentPaginateOption {
+	return func(pager *messageattachmentPager) error {
 		if filter == nil {
-			return errors.New("MessageQuery filter cannot be nil")
+			return errors.New("MessageAttachmentQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type messagePager struct {
+type messageattachmentPager struct {
 	reverse bool
-	order   []*MessageOrder
-	filter  func(*MessageQuery) (*MessageQuery, error)
+	order   []*MessageAttachmentOrder
+	filter  func(*MessageAttachmentQuery) (*MessageAttachmentQuery, error)
 }
 
-func newMessagePager(opts []MessagePaginateOption, reverse bool) (*messagePager, error) {
-	pager := &messagePager{reverse: reverse}
+func newMessageAttachmentPager(opts []MessageAttachmentPaginateOption, reverse bool) (*messageattachmentPager, error) {
+	pager := &messageattachmentPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -1016,22 +2950,22 @@ func newMessagePager(opts []MessagePaginateOption, reverse bool) (*messagePager,
 	return pager, nil
 }
 
-func (p *messagePager) applyFilter(query *MessageQuery) (*MessageQuery, error) {
+func (p *messageattachmentPager) applyFilter(query *MessageAttachmentQuery) (*MessageAttachmentQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *messagePager) toCursor(m *Message) Cursor {
+func (p *messageattachmentPager) toCursor(ma *MessageAttachment) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
-		cs_ = append(cs_, o_.Field.toCursor(m).Value)
+		cs_ = append(cs_, o_.Field.toCursor(ma).Value)
 	}
-	return Cursor{ID: m.ID, Value: cs_}
+	return Cursor{ID: ma.ID, Value: cs_}
 }
 
-func (p *messagePager) applyCursors(query *MessageQuery, after, before *Cursor) (*MessageQuery, error) {
+func (p *messageattachmentPager) applyCursors(query *MessageAttachmentQuery, after, before *Cursor) (*MessageAttachmentQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -1046,7 +2980,7 @@ func (p *messagePager) applyCursors(query *MessageQuery, after, before *Cursor)
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultMessageOrder.Field.column,
+		FieldID:     DefaultMessageAttachmentOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -1060,7 +2994,7 @@ func (p *messagePager) applyCursors(query *MessageQuery, after, before *Cursor)
 	return query, nil
 }
 
-func (p *messagePager) applyOrder(query *MessageQuery) *MessageQuery {
+func (p *messageattachmentPager) applyOrder(query *MessageAttachmentQuery) *MessageAttachmentQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -1068,7 +3002,7 @@ func (p *messagePager) applyOrder(query *MessageQuery) *MessageQuery {
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultMessageOrder.Field.column {
+		if o.Field.column == DefaultMessageAttachmentOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -1080,12 +3014,12 @@ func (p *messagePager) applyOrder(query *MessageQuery) *MessageQuery {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultMessageOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultMessageAttachmentOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *messagePager) orderExpr(query *MessageQuery) sql.Querier {
+func (p *messageattachmentPager) orderExpr(query *MessageAttachmentQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -1104,31 +3038,31 @@ func (p *messagePager) orderExpr(query *MessageQuery) sql.Querier {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultMessageOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultMessageAttachmentOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Message.
-func (m *MessageQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to MessageAttachment.
+func (ma *MessageAttachmentQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...MessagePaginateOption,
-) (*MessageConnection, error) {
+	before *Cursor, last *int, opts ...MessageAttachmentPaginateOption,
+) (*MessageAttachmentConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newMessagePager(opts, last != nil)
+	pager, err := newMessageAttachmentPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if m, err = pager.applyFilter(m); err != nil {
+	if ma, err = pager.applyFilter(ma); err != nil {
 		return nil, err
 	}
-	conn := &MessageConnection{Edges: []*MessageEdge{}}
+	conn := &MessageAttachmentConnection{Edges: []*MessageAttachmentEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			c := m.Clone()
+			c := ma.Clone()
 			c.ctx.Fields = nil
 			if conn.TotalCount, err = c.Count(ctx); err != nil {
 				return nil, err
@@ -1140,20 +3074,20 @@ func (m *MessageQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if m, err = pager.applyCursors(m, after, before); err != nil {
+	if ma, err = pager.applyCursors(ma, after, before); err != nil {
 		return nil, err
 	}
 	limit := paginateLimit(first, last)
 	if limit != 0 {
-		m.Limit(limit)
+		ma.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := ma.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	m = pager.applyOrder(m)
-	nodes, err := m.All(ctx)
+	ma = pager.applyOrder(ma)
+	nodes, err := ma.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1162,125 +3096,143 @@ func (m *MessageQuery) Paginate(
 }
 
 var (
-	// MessageOrderFieldCreatedAt orders Message by created_at.
-	MessageOrderFieldCreatedAt = &MessageOrderField{
-		Value: func(m *Message) (ent.Value, error) {
-			return m.CreatedAt, nil
+	// MessageAttachmentOrderFieldType orders MessageAttachment by type.
+	MessageAttachmentOrderFieldType = &MessageAttachmentOrderField{
+		Value: func(ma *MessageAttachment) (ent.Value, error) {
+			return ma.Type, nil
 		},
-		column: message.FieldCreatedAt,
-		toTerm: message.ByCreatedAt,
-		toCursor: func(m *Message) Cursor {
+		column: messageattachment.FieldType,
+		toTerm: messageattachment.ByType,
+		toCursor: func(ma *MessageAttachment) Cursor {
 			return Cursor{
-				ID:    m.ID,
-				Value: m.CreatedAt,
+				ID:    ma.ID,
+				Value: ma.Type,
 			}
 		},
 	}
-	// MessageOrderFieldUpdatedAt orders Message by updated_at.
-	MessageOrderFieldUpdatedAt = &MessageOrderField{
-		Value: func(m *Message) (ent.Value, error) {
-			return m.UpdatedAt, nil
+	// MessageAttachmentOrderFieldOrder orders MessageAttachment by order.
+	MessageAttachmentOrderFieldOrder = &MessageAttachmentOrderField{
+		Value: func(ma *MessageAttachment) (ent.Value, error) {
+			return ma.Order, nil
 		},
-		column: message.FieldUpdatedAt,
-		toTerm: message.ByUpdatedAt,
-		toCursor: func(m *Message) Cursor {
+		column: messageattachment.FieldOrder,
+		toTerm: messageattachment.ByOrder,
+		toCursor: func(ma *MessageAttachment) Cursor {
 			return Cursor{
-				ID:    m.ID,
-				Value: m.UpdatedAt,
+				ID:    ma.ID,
+				Value: ma.Order,
+			}
+		},
+	}
+	// MessageAttachmentOrderFieldAttachedAt orders MessageAttachment by attached_at.
+	MessageAttachmentOrderFieldAttachedAt = &MessageAttachmentOrderField{
+		Value: func(ma *MessageAttachment) (ent.Value, error) {
+			return ma.AttachedAt, nil
+		},
+		column: messageattachment.FieldAttachedAt,
+		toTerm: messageattachment.ByAttachedAt,
+		toCursor: func(ma *MessageAttachment) Cursor {
+			return Cursor{
+				ID:    ma.ID,
+				Value: ma.AttachedAt,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f MessageOrderField) String() string {
+func (f MessageAttachmentOrderField) String() string {
 	var str string
 	switch f.column {
-	case MessageOrderFieldCreatedAt.column:
-		str = "CREATED_AT"
-	case MessageOrderFieldUpdatedAt.column:
-		str = "UPDATED_AT"
+	case MessageAttachmentOrderFieldType.column:
+		str = "TYPE"
+	case MessageAttachmentOrderFieldOrder.column:
+		str = "ORDER"
+	case MessageAttachmentOrderFieldAttachedAt.column:
+		str = "ATTACHED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f MessageOrderField) MarshalGQL(w io.Writer) {
+func (f MessageAttachmentOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *MessageOrderField) UnmarshalGQL(v interface{}) error {
+func (f *MessageAttachmentOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("MessageOrderField %T must be a string", v)
+		return fmt.Errorf("MessageAttachmentOrderField %T must be a string", v)
 	}
 	switch str {
-	case "CREATED_AT":
-		*f = *MessageOrderFieldCreatedAt
-	case "UPDATED_AT":
-		*f = *MessageOrderFieldUpdatedAt
+	case "TYPE":
+		*f = *MessageAttachmentOrderFieldType
+	case "ORDER":
+		*f = *MessageAttachmentOrderFieldOrder
+	case "ATTACHED_AT":
+		*f = *MessageAttachmentOrderFieldAttachedAt
 	default:
-		return fmt.Errorf("%s is not a valid MessageOrderField", str)
+		return fmt.Errorf("%s is not a valid MessageAttachmentOrderField", str)
 	}
 	return nil
 }
 
-// MessageOrderField defines the ordering field of Message.
-type MessageOrderField struct {
-	// Value extracts the ordering value from the given Message.
-	Value    func(*Message) (ent.Value, error)
+// MessageAttachmentOrderField defines the ordering field of MessageAttachment.
+type MessageAttachmentOrderField struct {
+	// Value extracts the ordering value from the given MessageAttachment.
+	Value    func(*MessageAttachment) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) message.OrderOption
-	toCursor func(*Message) Cursor
+	toTerm   func(...sql.OrderTermOption) messageattachment.OrderOption
+	toCursor func(*MessageAttachment) Cursor
 }
 
-// MessageOrder defines the ordering of Message.
-type MessageOrder struct {
-	Direction OrderDirection     `json:"direction"`
-	Field     *MessageOrderField `json:"field"`
+// MessageAttachmentOrder defines the ordering of MessageAttachment.
+type MessageAttachmentOrder struct {
+	Direction OrderDirection               `json:"direction"`
+	Field     *MessageAttachmentOrderField `json:"field"`
 }
 
-// DefaultMessageOrder is the default ordering of Message.
-var DefaultMessageOrder = &MessageOrder{
+// DefaultMessageAttachmentOrder is the default ordering of MessageAttachment.
+var DefaultMessageAttachmentOrder = &MessageAttachmentOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &MessageOrderField{
-		Value: func(m *Message) (ent.Value, error) {
-			return m.ID, nil
+	Field: &MessageAttachmentOrderField{
+		Value: func(ma *MessageAttachment) (ent.Value, error) {
+			return ma.ID, nil
 		},
-		column: message.FieldID,
-		toTerm: message.ByID,
-		toCursor: func(m *Message) Cursor {
-			return Cursor{ID: m.ID}
+		column: messageattachment.FieldID,
+		toTerm: messageattachment.ByID,
+		toCursor: func(ma *MessageAttachment) Cursor {
+			return Cursor{ID: ma.ID}
 		},
 	},
 }
 
-// ToEdge converts Message into MessageEdge.
-func (m *Message) ToEdge(order *MessageOrder) *MessageEdge {
+// ToEdge converts MessageAttachment into MessageAttachmentEdge.
+func (ma *MessageAttachment) ToEdge(order *MessageAttachmentOrder) *MessageAttachmentEdge {
 	if order == nil {
-		order = DefaultMessageOrder
+		order = DefaultMessageAttachmentOrder
 	}
-	return &MessageEdge{
-		Node:   m,
-		Cursor: order.Field.toCursor(m),
+	return &MessageAttachmentEdge{
+		Node:   ma,
+		Cursor: order.Field.toCursor(ma),
 	}
 }
 
-// MessageAttachmentEdge is the edge representation of MessageAttachment.
-type MessageAttachmentEdge struct {
-	Node   *MessageAttachment `json:"node"`
-	Cursor Cursor             `json:"cursor"`
+// MessageLinkEdge is the edge representation of MessageLink.
+type MessageLinkEdge struct {
+	Node   *MessageLink `json:"node"`
+	Cursor Cursor       `json:"cursor"`
 }
 
-// MessageAttachmentConnection is the connection containing edges to MessageAttachment.
-type MessageAttachmentConnection struct {
-	Edges      []*MessageAttachmentEdge `json:"edges"`
-	PageInfo   PageInfo                 `json:"pageInfo"`
-	TotalCount int                      `json:"totalCount"`
+// MessageLinkConnection is the connection containing edges to MessageLink.
+type MessageLinkConnection struct {
+	Edges      []*MessageLinkEdge `json:"edges"`
+	PageInfo   PageInfo           `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
 }
 
-func (c *MessageAttachmentConnection) build(nodes []*MessageAttachment, pager *messageattachmentPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *MessageLinkConnection) build(nodes []*MessageLink, pager *messagelinkPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -1290,21 +3242,21 @@ func (c *MessageAttachmentConnection) build(nodes []*MessageAttachment, pager *m
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *MessageAttachment
+	var nodeAt func(int) *MessageLink
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *MessageAttachment {
+		nodeAt = func(i int) *MessageLink {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *MessageAttachment {
+		nodeAt = func(i int) *MessageLink {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*MessageAttachmentEdge, len(nodes))
+	c.Edges = make([]*MessageLinkEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &MessageAttachmentEdge{
+		c.Edges[i] = &MessageLinkEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -1318,12 +3270,12 @@ func (c *MessageAttachmentConnection) build(nodes []*MessageAttachment, pager *m
 	}
 }
 
-// MessageAttachmentPaginateOption enables pagination customization.
-type MessageAttachmentPaginateOption func(*messageattachmentPager) error
+// MessageLinkPaginateOption enables pagination customization.
+type MessageLinkPaginateOption func(*messagelinkPager) error
 
-// WithMessageAttachmentOrder configures pagination ordering.
-func WithMessageAttachmentOrder(order []*MessageAttachmentOrder) MessageAttachmentPaginateOption {
-	return func(pager *messageattachmentPager) error {
+// WithMessageLinkOrder configures pagination ordering.
+func WithMessageLinkOrder(order []*MessageLinkOrder) MessageLinkPaginateOption {
+	return func(pager *messagelinkPager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -1334,25 +3286,25 @@ func WithMessageAttachmentOrder(order []*MessageAttachmentOrder) MessageAttachme
 	}
 }
 
-// WithMessageAttachmentFilter configures pagination filter.
-func WithMessageAttachmentFilter(filter func(*MessageAttachmentQuery) (*MessageAttachmentQuery, error)) MessageAttachmentPaginateOption {
-	return func(pager *messageattachmentPager) error {
+// WithMessageLinkFilter configures pagination filter.
+func WithMessageLinkFilter(filter func(*MessageLinkQuery) (*MessageLinkQuery, error)) MessageLinkPaginateOption {
+	return func(pager *messagelinkPager) error {
 		if filter == nil {
-			return errors.New("MessageAttachmentQuery filter cannot be nil")
+			return errors.New("MessageLinkQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type messageattachmentPager struct {
+type messagelinkPager struct {
 	reverse bool
-	order   []*MessageAttachmentOrder
-	filter  func(*MessageAttachmentQuery) (*MessageAttachmentQuery, error)
+	order   []*MessageLinkOrder
+	filter  func(*MessageLinkQuery) (*MessageLinkQuery, error)
 }
 
-func newMessageAttachmentPager(opts []MessageAttachmentPaginateOption, reverse bool) (*messageattachmentPager, error) {
-	pager := &messageattachmentPager{reverse: reverse}
+func newMessageLinkPager(opts []MessageLinkPaginateOption, reverse bool) (*messagelinkPager, error) {
+	pager := &messagelinkPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -1366,22 +3318,22 @@ func newMessageAttachmentPager(opts []MessageAttachmentPaginateOption, reverse b
 	return pager, nil
 }
 
-func (p *messageattachmentPager) applyFilter(query *MessageAttachmentQuery) (*MessageAttachmentQuery, error) {
+func (p *messagelinkPager) applyFilter(query *MessageLinkQuery) (*MessageLinkQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *messageattachmentPager) toCursor(ma *MessageAttachment) Cursor {
+func (p *messagelinkPager) toCursor(ml *MessageLink) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
-		cs_ = append(cs_, o_.Field.toCursor(ma).Value)
+		cs_ = append(cs_, o_.Field.toCursor(ml).Value)
 	}
-	return Cursor{ID: ma.ID, Value: cs_}
+	return Cursor{ID: ml.ID, Value: cs_}
 }
 
-func (p *messageattachmentPager) applyCursors(query *MessageAttachmentQuery, after, before *Cursor) (*MessageAttachmentQuery, error) {
+func (p *messagelinkPager) applyCursors(query *MessageLinkQuery, after, before *Cursor) (*MessageLinkQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -1396,7 +3348,7 @@ func (p *messageattachmentPager) applyCursors(query *MessageAttachmentQuery, aft
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultMessageAttachmentOrder.Field.column,
+		FieldID:     DefaultMessageLinkOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -1410,7 +3362,7 @@ func (p *messageattachmentPager) applyCursors(query *MessageAttachmentQuery, aft
 	return query, nil
 }
 
-func (p *messageattachmentPager) applyOrder(query *MessageAttachmentQuery) *MessageAttachmentQuery {
+func (p *messagelinkPager) applyOrder(query *MessageLinkQuery) *MessageLinkQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -1418,7 +3370,7 @@ func (p *messageattachmentPager) applyOrder(query *MessageAttachmentQuery) *Mess
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultMessageAttachmentOrder.Field.column {
+		if o.Field.column == DefaultMessageLinkOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -1430,12 +3382,12 @@ func (p *messageattachmentPager) applyOrder(query *MessageAttachmentQuery) *Mess
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultMessageAttachmentOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultMessageLinkOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *messageattachmentPager) orderExpr(query *MessageAttachmentQuery) sql.Querier {
+func (p *messagelinkPager) orderExpr(query *MessageLinkQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -1454,31 +3406,31 @@ func (p *messageattachmentPager) orderExpr(query *MessageAttachmentQuery) sql.Qu
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultMessageAttachmentOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultMessageLinkOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to MessageAttachment.
-func (ma *MessageAttachmentQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to MessageLink.
+func (ml *MessageLinkQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...MessageAttachmentPaginateOption,
-) (*MessageAttachmentConnection, error) {
+	before *Cursor, last *int, opts ...MessageLinkPaginateOption,
+) (*MessageLinkConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newMessageAttachmentPager(opts, last != nil)
+	pager, err := newMessageLinkPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if ma, err = pager.applyFilter(ma); err != nil {
+	if ml, err = pager.applyFilter(ml); err != nil {
 		return nil, err
 	}
-	conn := &MessageAttachmentConnection{Edges: []*MessageAttachmentEdge{}}
+	conn := &MessageLinkConnection{Edges: []*MessageLinkEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			c := ma.Clone()
+			c := ml.Clone()
 			c.ctx.Fields = nil
 			if conn.TotalCount, err = c.Count(ctx); err != nil {
 				return nil, err
@@ -1490,20 +3442,20 @@ func (ma *MessageAttachmentQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if ma, err = pager.applyCursors(ma, after, before); err != nil {
+	if ml, err = pager.applyCursors(ml, after, before); err != nil {
 		return nil, err
 	}
 	limit := paginateLimit(first, last)
 	if limit != 0 {
-		ma.Limit(limit)
+		ml.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := ma.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := ml.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	ma = pager.applyOrder(ma)
-	nodes, err := ma.All(ctx)
+	ml = pager.applyOrder(ml)
+	nodes, err := ml.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1512,143 +3464,197 @@ func (ma *MessageAttachmentQuery) Paginate(
 }
 
 var (
-	// MessageAttachmentOrderFieldType orders MessageAttachment by type.
-	MessageAttachmentOrderFieldType = &MessageAttachmentOrderField{
-		Value: func(ma *MessageAttachment) (ent.Value, error) {
-			return ma.Type, nil
+	// MessageLinkOrderFieldLink orders MessageLink by link.
+	MessageLinkOrderFieldLink = &MessageLinkOrderField{
+		Value: func(ml *MessageLink) (ent.Value, error) {
+			return ml.Link, nil
 		},
-		column: messageattachment.FieldType,
-		toTerm: messageattachment.ByType,
-		toCursor: func(ma *MessageAttachment) Cursor {
+		column: messagelink.FieldLink,
+		toTerm: messagelink.ByLink,
+		toCursor: func(ml *MessageLink) Cursor {
 			return Cursor{
-				ID:    ma.ID,
-				Value: ma.Type,
+				ID:    ml.ID,
+				Value: ml.Link,
 			}
 		},
 	}
-	// MessageAttachmentOrderFieldOrder orders MessageAttachment by order.
-	MessageAttachmentOrderFieldOrder = &MessageAttachmentOrderField{
-		Value: func(ma *MessageAttachment) (ent.Value, error) {
-			return ma.Order, nil
+	// MessageLinkOrderFieldTitle orders MessageLink by title.
+	MessageLinkOrderFieldTitle = &MessageLinkOrderField{
+		Value: func(ml *MessageLink) (ent.Value, error) {
+			return ml.Title, nil
 		},
-		column: messageattachment.FieldOrder,
-		toTerm: messageattachment.ByOrder,
-		toCursor: func(ma *MessageAttachment) Cursor {
+		column: messagelink.FieldTitle,
+		toTerm: messagelink.ByTitle,
+		toCursor: func(ml *MessageLink) Cursor {
 			return Cursor{
-				ID:    ma.ID,
-				Value: ma.Order,
+				ID:    ml.ID,
+				Value: ml.Title,
 			}
 		},
 	}
-	// MessageAttachmentOrderFieldAttachedAt orders MessageAttachment by attached_at.
-	MessageAttachmentOrderFieldAttachedAt = &MessageAttachmentOrderField{
-		Value: func(ma *MessageAttachment) (ent.Value, error) {
-			return ma.AttachedAt, nil
+	// MessageLinkOrderFieldDescription orders MessageLink by description.
+	MessageLinkOrderFieldDescription = &MessageLinkOrderField{
+		Value: func(ml *MessageLink) (ent.Value, error) {
+			return ml.Description, nil
 		},
-		column: messageattachment.FieldAttachedAt,
-		toTerm: messageattachment.ByAttachedAt,
-		toCursor: func(ma *MessageAttachment) Cursor {
+		column: messagelink.FieldDescription,
+		toTerm: messagelink.ByDescription,
+		toCursor: func(ml *MessageLink) Cursor {
 			return Cursor{
-				ID:    ma.ID,
-				Value: ma.AttachedAt,
+				ID:    ml.ID,
+				Value: ml.Description,
+			}
+		},
+	}
+	// MessageLinkOrderFieldImageURL orders MessageLink by image_url.
+	MessageLinkOrderFieldImageURL = &MessageLinkOrderField{
+		Value: func(ml *MessageLink) (ent.Value, error) {
+			return ml.ImageURL, nil
+		},
+		column: messagelink.FieldImageURL,
+		toTerm: messagelink.ByImageURL,
+		toCursor: func(ml *MessageLink) Cursor {
+			return Cursor{
+				ID:    ml.ID,
+				Value: ml.ImageURL,
+			}
+		},
+	}
+	// MessageLinkOrderFieldCreatedAt orders MessageLink by created_at.
+	MessageLinkOrderFieldCreatedAt = &MessageLinkOrderField{
+		Value: func(ml *MessageLink) (ent.Value, error) {
+			return ml.CreatedAt, nil
+		},
+		column: messagelink.FieldCreatedAt,
+		toTerm: messagelink.ByCreatedAt,
+		toCursor: func(ml *MessageLink) Cursor {
+			return Cursor{
+				ID:    ml.ID,
+				Value: ml.CreatedAt,
+			}
+		},
+	}
+	// MessageLinkOrderFieldUpdatedAt orders MessageLink by updated_at.
+	MessageLinkOrderFieldUpdatedAt = &MessageLinkOrderField{
+		Value: func(ml *MessageLink) (ent.Value, error) {
+			return ml.UpdatedAt, nil
+		},
+		column: messagelink.FieldUpdatedAt,
+		toTerm: messagelink.ByUpdatedAt,
+		toCursor: func(ml *MessageLink) Cursor {
+			return Cursor{
+				ID:    ml.ID,
+				Value: ml.UpdatedAt,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f MessageAttachmentOrderField) String() string {
+func (f MessageLinkOrderField) String() string {
 	var str string
 	switch f.column {
-	case MessageAttachmentOrderFieldType.column:
-		str = "TYPE"
-	case MessageAttachmentOrderFieldOrder.column:
-		str = "ORDER"
-	case MessageAttachmentOrderFieldAttachedAt.column:
-		str = "ATTACHED_AT"
+	case MessageLinkOrderFieldLink.column:
+		str = "LINK"
+	case MessageLinkOrderFieldTitle.column:
+		str = "TITLE"
+	case MessageLinkOrderFieldDescription.column:
+		str = "DESCRIPTION"
+	case MessageLinkOrderFieldImageURL.column:
+		str = "IMAGE_URL"
+	case MessageLinkOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	case MessageLinkOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f MessageAttachmentOrderField) MarshalGQL(w io.Writer) {
+func (f MessageLinkOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *MessageAttachmentOrderField) UnmarshalGQL(v interface{}) error {
+func (f *MessageLinkOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("MessageAttachmentOrderField %T must be a string", v)
+		return fmt.Errorf("MessageLinkOrderField %T must be a string", v)
 	}
 	switch str {
-	case "TYPE":
-		*f = *MessageAttachmentOrderFieldType
-	case "ORDER":
-		*f = *MessageAttachmentOrderFieldOrder
-	case "ATTACHED_AT":
-		*f = *MessageAttachmentOrderFieldAttachedAt
+	case "LINK":
+		*f = *MessageLinkOrderFieldLink
+	case "TITLE":
+		*f = *MessageLinkOrderFieldTitle
+	case "DESCRIPTION":
+		*f = *MessageLinkOrderFieldDescription
+	case "IMAGE_URL":
+		*f = *MessageLinkOrderFieldImageURL
+	case "CREATED_AT":
+		*f = *MessageLinkOrderFieldCreatedAt
+	case "UPDATED_AT":
+		*f = *MessageLinkOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid MessageAttachmentOrderField", str)
+		return fmt.Errorf("%s is not a valid MessageLinkOrderField", str)
 	}
 	return nil
 }
 
-// MessageAttachmentOrderField defines the ordering field of MessageAttachment.
-type MessageAttachmentOrderField struct {
-	// Value extracts the ordering value from the given MessageAttachment.
-	Value    func(*MessageAttachment) (ent.Value, error)
+// MessageLinkOrderField defines the ordering field of MessageLink.
+type MessageLinkOrderField struct {
+	// Value extracts the ordering value from the given MessageLink.
+	Value    func(*MessageLink) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) messageattachment.OrderOption
-	toCursor func(*MessageAttachment) Cursor
+	toTerm   func(...sql.OrderTermOption) messagelink.OrderOption
+	toCursor func(*MessageLink) Cursor
 }
 
-// MessageAttachmentOrder defines the ordering of MessageAttachment.
-type MessageAttachmentOrder struct {
-	Direction OrderDirection               `json:"direction"`
-	Field     *MessageAttachmentOrderField `json:"field"`
+// MessageLinkOrder defines the ordering of MessageLink.
+type MessageLinkOrder struct {
+	Direction OrderDirection         `json:"direction"`
+	Field     *MessageLinkOrderField `json:"field"`
 }
 
-// DefaultMessageAttachmentOrder is the default ordering of MessageAttachment.
-var DefaultMessageAttachmentOrder = &MessageAttachmentOrder{
+// DefaultMessageLinkOrder is the default ordering of MessageLink.
+var DefaultMessageLinkOrder = &MessageLinkOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &MessageAttachmentOrderField{
-		Value: func(ma *MessageAttachment) (ent.Value, error) {
-			return ma.ID, nil
+	Field: &MessageLinkOrderField{
+		Value: func(ml *MessageLink) (ent.Value, error) {
+			return ml.ID, nil
 		},
-		column: messageattachment.FieldID,
-		toTerm: messageattachment.ByID,
-		toCursor: func(ma *MessageAttachment) Cursor {
-			return Cursor{ID: ma.ID}
+		column: messagelink.FieldID,
+		toTerm: messagelink.ByID,
+		toCursor: func(ml *MessageLink) Cursor {
+			return Cursor{ID: ml.ID}
 		},
 	},
 }
 
-// ToEdge converts MessageAttachment into MessageAttachmentEdge.
-func (ma *MessageAttachment) ToEdge(order *MessageAttachmentOrder) *MessageAttachmentEdge {
+// ToEdge converts MessageLink into MessageLinkEdge.
+func (ml *MessageLink) ToEdge(order *MessageLinkOrder) *MessageLinkEdge {
 	if order == nil {
-		order = DefaultMessageAttachmentOrder
+		order = DefaultMessageLinkOrder
 	}
-	return &MessageAttachmentEdge{
-		Node:   ma,
-		Cursor: order.Field.toCursor(ma),
+	return &MessageLinkEdge{
+		Node:   ml,
+		Cursor: order.Field.toCursor(ml),
 	}
 }
 
-// MessageLinkEdge is the edge representation of MessageLink.
-type MessageLinkEdge struct {
-	Node   *MessageLink `json:"node"`
-	Cursor Cursor       `json:"cursor"`
+// MessageVoiceEdge is the edge representation of MessageVoice.
+type MessageVoiceEdge struct {
+	Node   *MessageVoice `json:"node"`
+	Cursor Cursor        `json:"cursor"`
 }
 
-// MessageLinkConnection is the connection containing edges to MessageLink.
-type MessageLinkConnection struct {
-	Edges      []*MessageLinkEdge `json:"edges"`
-	PageInfo   PageInfo           `json:"pageInfo"`
-	TotalCount int                `json:"totalCount"`
+// MessageVoiceConnection is the connection containing edges to MessageVoice.
+type MessageVoiceConnection struct {
+	Edges      []*MessageVoiceEdge `json:"edges"`
+	PageInfo   PageInfo            `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
 }
 
-func (c *MessageLinkConnection) build(nodes []*MessageLink, pager *messagelinkPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *MessageVoiceConnection) build(nodes []*MessageVoice, pager *messagevoicePager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -1658,21 +3664,21 @@ func (c *MessageLinkConnection) build(nodes []*MessageLink, pager *messagelinkPa
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *MessageLink
+	var nodeAt func(int) *MessageVoice
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *MessageLink {
+		nodeAt = func(i int) *MessageVoice {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *MessageLink {
+		nodeAt = func(i int) *MessageVoice {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*MessageLinkEdge, len(nodes))
+	c.Edges = make([]*MessageVoiceEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &MessageLinkEdge{
+		c.Edges[i] = &MessageVoiceEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -1686,12 +3692,12 @@ func (c *MessageLinkConnection) build(nodes []*MessageLink, pager *messagelinkPa
 	}
 }
 
-// MessageLinkPaginateOption enables pagination customization.
-type MessageLinkPaginateOption func(*messagelinkPager) error
+// MessageVoicePaginateOption enables pagination customization.
+type MessageVoicePaginateOption func(*messagevoicePager) error
 
-// WithMessageLinkOrder configures pagination ordering.
-func WithMessageLinkOrder(order []*MessageLinkOrder) MessageLinkPaginateOption {
-	return func(pager *messagelinkPager) error {
+// WithMessageVoiceOrder configures pagination ordering.
+func WithMessageVoiceOrder(order []*MessageVoiceOrder) MessageVoicePaginateOption {
+	return func(pager *messagevoicePager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -1702,25 +3708,25 @@ func WithMessageLinkOrder(order []*MessageLinkOrder) MessageLinkPaginateOption {
 	}
 }
 
-// WithMessageLinkFilter configures pagination filter.
-func WithMessageLinkFilter(filter func(*MessageLinkQuery) (*MessageLinkQuery, error)) MessageLinkPaginateOption {
-	return func(pager *messagelinkPager) error {
+// WithMessageVoiceFilter configures pagination filter.
+func WithMessageVoiceFilter(filter func(*MessageVoiceQuery) (*MessageVoiceQuery, error)) MessageVoicePaginateOption {
+	return func(pager *messagevoicePager) error {
 		if filter == nil {
-			return errors.New("MessageLinkQuery filter cannot be nil")
+			return errors.New("MessageVoiceQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type messagelinkPager struct {
+type messagevoicePager struct {
 	reverse bool
-	order   []*MessageLinkOrder
-	filter  func(*MessageLinkQuery) (*MessageLinkQuery, error)
+	order   []*MessageVoiceOrder
+	filter  func(*MessageVoiceQuery) (*MessageVoiceQuery, error)
 }
 
-func newMessageLinkPager(opts []MessageLinkPaginateOption, reverse bool) (*messagelinkPager, error) {
-	pager := &messagelinkPager{reverse: reverse}
+func newMessageVoicePager(opts []MessageVoicePaginateOption, reverse bool) (*messagevoicePager, error) {
+	pager := &messagevoicePager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -1734,22 +3740,22 @@ func newMessageLinkPager(opts []MessageLinkPaginateOption, reverse bool) (*messa
 	return pager, nil
 }
 
-func (p *messagelinkPager) applyFilter(query *MessageLinkQuery) (*MessageLinkQuery, error) {
+func (p *messagevoicePager) applyFilter(query *MessageVoiceQuery) (*MessageVoiceQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *messagelinkPager) toCursor(ml *MessageLink) Cursor {
+func (p *messagevoicePager) toCursor(mv *MessageVoice) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
-		cs_ = append(cs_, o_.Field.toCursor(ml).Value)
+		cs_ = append(cs_, o_.Field.toCursor(mv).Value)
 	}
-	return Cursor{ID: ml.ID, Value: cs_}
+	return Cursor{ID: mv.ID, Value: cs_}
 }
 
-func (p *messagelinkPager) applyCursors(query *MessageLinkQuery, after, before *Cursor) (*MessageLinkQuery, error) {
+func (p *messagevoicePager) applyCursors(query *MessageVoiceQuery, after, before *Cursor) (*MessageVoiceQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -1764,7 +3770,7 @@ func (p *messagelinkPager) applyCursors(query *MessageLinkQuery, after, before *
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultMessageLinkOrder.Field.column,
+		FieldID:     DefaultMessageVoiceOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -1778,7 +3784,7 @@ func (p *messagelinkPager) applyCursors(query *MessageLinkQuery, after, before *
 	return query, nil
 }
 
-func (p *messagelinkPager) applyOrder(query *MessageLinkQuery) *MessageLinkQuery {
+func (p *messagevoicePager) applyOrder(query *MessageVoiceQuery) *MessageVoiceQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -1786,7 +3792,7 @@ func (p *messagelinkPager) applyOrder(query *MessageLinkQuery) *MessageLinkQuery
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultMessageLinkOrder.Field.column {
+		if o.Field.column == DefaultMessageVoiceOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -1798,12 +3804,12 @@ func (p *messagelinkPager) applyOrder(query *MessageLinkQuery) *MessageLinkQuery
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultMessageLinkOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultMessageVoiceOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *messagelinkPager) orderExpr(query *MessageLinkQuery) sql.Querier {
+func (p *messagevoicePager) orderExpr(query *MessageVoiceQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -1822,31 +3828,31 @@ func (p *messagelinkPager) orderExpr(query *MessageLinkQuery) sql.Querier {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultMessageLinkOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultMessageVoiceOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to MessageLink.
-func (ml *MessageLinkQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to MessageVoice.
+func (mv *MessageVoiceQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...MessageLinkPaginateOption,
-) (*MessageLinkConnection, error) {
+	before *Cursor, last *int, opts ...MessageVoicePaginateOption,
+) (*MessageVoiceConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newMessageLinkPager(opts, last != nil)
+	pager, err := newMessageVoicePager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if ml, err = pager.applyFilter(ml); err != nil {
+	if mv, err = pager.applyFilter(mv); err != nil {
 		return nil, err
 	}
-	conn := &MessageLinkConnection{Edges: []*MessageLinkEdge{}}
+	conn := &MessageVoiceConnection{Edges: []*MessageVoiceEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			c := ml.Clone()
+			c := mv.Clone()
 			c.ctx.Fields = nil
 			if conn.TotalCount, err = c.Count(ctx); err != nil {
 				return nil, err
@@ -1858,20 +3864,20 @@ func (ml *MessageLinkQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if ml, err = pager.applyCursors(ml, after, before); err != nil {
+	if mv, err = pager.applyCursors(mv, after, before); err != nil {
 		return nil, err
 	}
 	limit := paginateLimit(first, last)
 	if limit != 0 {
-		ml.Limit(limit)
+		mv.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := ml.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := mv.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	ml = pager.applyOrder(ml)
-	nodes, err := ml.All(ctx)
+	mv = pager.applyOrder(mv)
+	nodes, err := mv.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1880,197 +3886,125 @@ func (ml *MessageLinkQuery) Paginate(
 }
 
 var (
-	// MessageLinkOrderFieldLink orders MessageLink by link.
-	MessageLinkOrderFieldLink = &MessageLinkOrderField{
-		Value: func(ml *MessageLink) (ent.Value, error) {
-			return ml.Link, nil
-		},
-		column: messagelink.FieldLink,
-		toTerm: messagelink.ByLink,
-		toCursor: func(ml *MessageLink) Cursor {
-			return Cursor{
-				ID:    ml.ID,
-				Value: ml.Link,
-			}
-		},
-	}
-	// MessageLinkOrderFieldTitle orders MessageLink by title.
-	MessageLinkOrderFieldTitle = &MessageLinkOrderField{
-		Value: func(ml *MessageLink) (ent.Value, error) {
-			return ml.Title, nil
-		},
-		column: messagelink.FieldTitle,
-		toTerm: messagelink.ByTitle,
-		toCursor: func(ml *MessageLink) Cursor {
-			return Cursor{
-				ID:    ml.ID,
-				Value: ml.Title,
-			}
-		},
-	}
-	// MessageLinkOrderFieldDescription orders MessageLink by description.
-	MessageLinkOrderFieldDescription = &MessageLinkOrderField{
-		Value: func(ml *MessageLink) (ent.Value, error) {
-			return ml.Description, nil
-		},
-		column: messagelink.FieldDescription,
-		toTerm: messagelink.ByDescription,
-		toCursor: func(ml *MessageLink) Cursor {
-			return Cursor{
-				ID:    ml.ID,
-				Value: ml.Description,
-			}
-		},
-	}
-	// MessageLinkOrderFieldImageURL orders MessageLink by image_url.
-	MessageLinkOrderFieldImageURL = &MessageLinkOrderField{
-		Value: func(ml *MessageLink) (ent.Value, error) {
-			return ml.ImageURL, nil
-		},
-		column: messagelink.FieldImageURL,
-		toTerm: messagelink.ByImageURL,
-		toCursor: func(ml *MessageLink) Cursor {
-			return Cursor{
-				ID:    ml.ID,
-				Value: ml.ImageURL,
-			}
-		},
-	}
-	// MessageLinkOrderFieldCreatedAt orders MessageLink by created_at.
-	MessageLinkOrderFieldCreatedAt = &MessageLinkOrderField{
-		Value: func(ml *MessageLink) (ent.Value, error) {
-			return ml.CreatedAt, nil
+	// MessageVoiceOrderFieldLength orders MessageVoice by length.
+	MessageVoiceOrderFieldLength = &MessageVoiceOrderField{
+		Value: func(mv *MessageVoice) (ent.Value, error) {
+			return mv.Length, nil
 		},
-		column: messagelink.FieldCreatedAt,
-		toTerm: messagelink.ByCreatedAt,
-		toCursor: func(ml *MessageLink) Cursor {
+		column: messagevoice.FieldLength,
+		toTerm: messagevoice.ByLength,
+		toCursor: func(mv *MessageVoice) Cursor {
 			return Cursor{
-				ID:    ml.ID,
-				Value: ml.CreatedAt,
+				ID:    mv.ID,
+				Value: mv.Length,
 			}
 		},
 	}
-	// MessageLinkOrderFieldUpdatedAt orders MessageLink by updated_at.
-	MessageLinkOrderFieldUpdatedAt = &MessageLinkOrderField{
-		Value: func(ml *MessageLink) (ent.Value, error) {
-			return ml.UpdatedAt, nil
+	// MessageVoiceOrderFieldAttachedAt orders MessageVoice by attached_at.
+	MessageVoiceOrderFieldAttachedAt = &MessageVoiceOrderField{
+		Value: func(mv *MessageVoice) (ent.Value, error) {
+			return mv.AttachedAt, nil
 		},
-		column: messagelink.FieldUpdatedAt,
-		toTerm: messagelink.ByUpdatedAt,
-		toCursor: func(ml *MessageLink) Cursor {
+		column: messagevoice.FieldAttachedAt,
+		toTerm: messagevoice.ByAttachedAt,
+		toCursor: func(mv *MessageVoice) Cursor {
 			return Cursor{
-				ID:    ml.ID,
-				Value: ml.UpdatedAt,
+				ID:    mv.ID,
+				Value: mv.AttachedAt,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f MessageLinkOrderField) String() string {
+func (f MessageVoiceOrderField) String() string {
 	var str string
 	switch f.column {
-	case MessageLinkOrderFieldLink.column:
-		str = "LINK"
-	case MessageLinkOrderFieldTitle.column:
-		str = "TITLE"
-	case MessageLinkOrderFieldDescription.column:
-		str = "DESCRIPTION"
-	case MessageLinkOrderFieldImageURL.column:
-		str = "IMAGE_URL"
-	case MessageLinkOrderFieldCreatedAt.column:
-		str = "CREATED_AT"
-	case MessageLinkOrderFieldUpdatedAt.column:
-		str = "UPDATED_AT"
+	case MessageVoiceOrderFieldLength.column:
+		str = "LENGTH"
+	case MessageVoiceOrderFieldAttachedAt.column:
+		str = "ATTACHED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f MessageLinkOrderField) MarshalGQL(w io.Writer) {
+func (f MessageVoiceOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *MessageLinkOrderField) UnmarshalGQL(v interface{}) error {
+func (f *MessageVoiceOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("MessageLinkOrderField %T must be a string", v)
+		return fmt.Errorf("MessageVoiceOrderField %T must be a string", v)
 	}
 	switch str {
-	case "LINK":
-		*f = *MessageLinkOrderFieldLink
-	case "TITLE":
-		*f = *MessageLinkOrderFieldTitle
-	case "DESCRIPTION":
-		*f = *MessageLinkOrderFieldDescription
-	case "IMAGE_URL":
-		*f = *MessageLinkOrderFieldImageURL
-	case "CREATED_AT":
-		*f = *MessageLinkOrderFieldCreatedAt
-	case "UPDATED_AT":
-		*f = *MessageLinkOrderFieldUpdatedAt
+	case "LENGTH":
+		*f = *MessageVoiceOrderFieldLength
+	case "ATTACHED_AT":
+		*f = *MessageVoiceOrderFieldAttachedAt
 	default:
-		return fmt.Errorf("%s is not a valid MessageLinkOrderField", str)
+		return fmt.Errorf("%s is not a valid MessageVoiceOrderField", str)
 	}
 	return nil
 }
 
-// MessageLinkOrderField defines the ordering field of MessageLink.
-type MessageLinkOrderField struct {
-	// Value extracts the ordering value from the given MessageLink.
-	Value    func(*MessageLink) (ent.Value, error)
+// MessageVoiceOrderField defines the ordering field of MessageVoice.
+type MessageVoiceOrderField struct {
+	// Value extracts the ordering value from the given MessageVoice.
+	Value    func(*MessageVoice) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) messagelink.OrderOption
-	toCursor func(*MessageLink) Cursor
+	toTerm   func(...sql.OrderTermOption) messagevoice.OrderOption
+	toCursor func(*MessageVoice) Cursor
 }
 
-// MessageLinkOrder defines the ordering of MessageLink.
-type MessageLinkOrder struct {
-	Direction OrderDirection         `json:"direction"`
-	Field     *MessageLinkOrderField `json:"field"`
+// MessageVoiceOrder defines the ordering of MessageVoice.
+type MessageVoiceOrder struct {
+	Direction OrderDirection          `json:"direction"`
+	Field     *MessageVoiceOrderField `json:"field"`
 }
 
-// DefaultMessageLinkOrder is the default ordering of MessageLink.
-var DefaultMessageLinkOrder = &MessageLinkOrder{
+// DefaultMessageVoiceOrder is the default ordering of MessageVoice.
+var DefaultMessageVoiceOrder = &MessageVoiceOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &MessageLinkOrderField{
-		Value: func(ml *MessageLink) (ent.Value, error) {
-			return ml.ID, nil
+	Field: &MessageVoiceOrderField{
+		Value: func(mv *MessageVoice) (ent.Value, error) {
+			return mv.ID, nil
 		},
-		column: messagelink.FieldID,
-		toTerm: messagelink.ByID,
-		toCursor: func(ml *MessageLink) Cursor {
-			return Cursor{ID: ml.ID}
+		column: messagevoice.FieldID,
+		toTerm: messagevoice.ByID,
+		toCursor: func(mv *MessageVoice) Cursor {
+			return Cursor{ID: mv.ID}
 		},
 	},
 }
 
-// ToEdge converts MessageLink into MessageLinkEdge.
-func (ml *MessageLink) ToEdge(order *MessageLinkOrder) *MessageLinkEdge {
+// ToEdge converts MessageVoice into MessageVoiceEdge.
+func (mv *MessageVoice) ToEdge(order *MessageVoiceOrder) *MessageVoiceEdge {
 	if order == nil {
-		order = DefaultMessageLinkOrder
+		order = DefaultMessageVoiceOrder
 	}
-	return &MessageLinkEdge{
-		Node:   ml,
-		Cursor: order.Field.toCursor(ml),
+	return &MessageVoiceEdge{
+		Node:   mv,
+		Cursor: order.Field.toCursor(mv),
 	}
 }
 
-// MessageVoiceEdge is the edge representation of MessageVoice.
-type MessageVoiceEdge struct {
-	Node   *MessageVoice `json:"node"`
+// NotificationEdge is the edge representation of Notification.
+type NotificationEdge struct {
+	Node   *Notification `json:"node"`
 	Cursor Cursor        `json:"cursor"`
 }
 
-// MessageVoiceConnection is the connection containing edges to MessageVoice.
-type MessageVoiceConnection struct {
-	Edges      []*MessageVoiceEdge `json:"edges"`
+// NotificationConnection is the connection containing edges to Notification.
+type NotificationConnection struct {
+	Edges      []*NotificationEdge `json:"edges"`
 	PageInfo   PageInfo            `json:"pageInfo"`
 	TotalCount int                 `json:"totalCount"`
 }
 
-func (c *MessageVoiceConnection) build(nodes []*MessageVoice, pager *messagevoicePager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *NotificationConnection) build(nodes []*Notification, pager *notificationPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -2080,21 +4014,21 @@ func (c *MessageVoiceConnection) build(nodes []*MessageVoice, pager *messagevoic
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *MessageVoice
+	var nodeAt func(int) *Notification
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *MessageVoice {
+		nodeAt = func(i int) *Notification {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *MessageVoice {
+		nodeAt = func(i int) *Notification {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*MessageVoiceEdge, len(nodes))
+	c.Edges = make([]*NotificationEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &MessageVoiceEdge{
+		c.Edges[i] = &NotificationEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -2108,12 +4042,12 @@ func (c *MessageVoiceConnection) build(nodes []*MessageVoice, pager *messagevoic
 	}
 }
 
-// MessageVoicePaginateOption enables pagination customization.
-type MessageVoicePaginateOption func(*messagevoicePager) error
+// NotificationPaginateOption enables pagination customization.
+type NotificationPaginateOption func(*notificationPager) error
 
-// WithMessageVoiceOrder configures pagination ordering.
-func WithMessageVoiceOrder(order []*MessageVoiceOrder) MessageVoicePaginateOption {
-	return func(pager *messagevoicePager) error {
+// WithNotificationOrder configures pagination ordering.
+func WithNotificationOrder(order []*NotificationOrder) NotificationPaginateOption {
+	return func(pager *notificationPager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -2124,25 +4058,25 @@ func WithMessageVoiceOrder(order []*MessageVoiceOrder) MessageVoicePaginateOptio
 	}
 }
 
-// WithMessageVoiceFilter configures pagination filter.
-func WithMessageVoiceFilter(filter func(*MessageVoiceQuery) (*MessageVoiceQuery, error)) MessageVoicePaginateOption {
-	return func(pager *messagevoicePager) error {
+// WithNotificationFilter configures pagination filter.
+func WithNotificationFilter(filter func(*NotificationQuery) (*NotificationQuery, error)) NotificationPaginateOption {
+	return func(pager *notificationPager) error {
 		if filter == nil {
-			return errors.New("MessageVoiceQuery filter cannot be nil")
+			return errors.New("NotificationQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type messagevoicePager struct {
+type notificationPager struct {
 	reverse bool
-	order   []*MessageVoiceOrder
-	filter  func(*MessageVoiceQuery) (*MessageVoiceQuery, error)
+	order   []*NotificationOrder
+	filter  func(*NotificationQuery) (*NotificationQuery, error)
 }
 
-func newMessageVoicePager(opts []MessageVoicePaginateOption, reverse bool) (*messagevoicePager, error) {
-	pager := &messagevoicePager{reverse: reverse}
+func newNotificationPager(opts []NotificationPaginateOption, reverse bool) (*notificationPager, error) {
+	pager := &notificationPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -2156,22 +4090,22 @@ func newMessageVoicePager(opts []MessageVoicePaginateOption, reverse bool) (*mes
 	return pager, nil
 }
 
-func (p *messagevoicePager) applyFilter(query *MessageVoiceQuery) (*MessageVoiceQuery, error) {
+func (p *notificationPager) applyFilter(query *NotificationQuery) (*NotificationQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *messagevoicePager) toCursor(mv *MessageVoice) Cursor {
+func (p *notificationPager) toCursor(n *Notification) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
-		cs_ = append(cs_, o_.Field.toCursor(mv).Value)
+		cs_ = append(cs_, o_.Field.toCursor(n).Value)
 	}
-	return Cursor{ID: mv.ID, Value: cs_}
+	return Cursor{ID: n.ID, Value: cs_}
 }
 
-func (p *messagevoicePager) applyCursors(query *MessageVoiceQuery, after, before *Cursor) (*MessageVoiceQuery, error) {
+func (p *notificationPager) applyCursors(query *NotificationQuery, after, before *Cursor) (*NotificationQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -2186,7 +4120,7 @@ func (p *messagevoicePager) applyCursors(query *MessageVoiceQuery, after, before
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultMessageVoiceOrder.Field.column,
+		FieldID:     DefaultNotificationOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -2200,7 +4134,7 @@ func (p *messagevoicePager) applyCursors(query *MessageVoiceQuery, after, before
 	return query, nil
 }
 
-func (p *messagevoicePager) applyOrder(query *MessageVoiceQuery) *MessageVoiceQuery {
+func (p *notificationPager) applyOrder(query *NotificationQuery) *NotificationQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -2208,7 +4142,7 @@ func (p *messagevoicePager) applyOrder(query *MessageVoiceQuery) *MessageVoiceQu
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultMessageVoiceOrder.Field.column {
+		if o.Field.column == DefaultNotificationOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -2220,12 +4154,12 @@ func (p *messagevoicePager) applyOrder(query *MessageVoiceQuery) *MessageVoiceQu
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultMessageVoiceOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultNotificationOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *messagevoicePager) orderExpr(query *MessageVoiceQuery) sql.Querier {
+func (p *notificationPager) orderExpr(query *NotificationQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -2244,31 +4178,31 @@ func (p *messagevoicePager) orderExpr(query *MessageVoiceQuery) sql.Querier {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultMessageVoiceOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultNotificationOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to MessageVoice.
-func (mv *MessageVoiceQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Notification.
+func (n *NotificationQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...MessageVoicePaginateOption,
-) (*MessageVoiceConnection, error) {
+	before *Cursor, last *int, opts ...NotificationPaginateOption,
+) (*NotificationConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newMessageVoicePager(opts, last != nil)
+	pager, err := newNotificationPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if mv, err = pager.applyFilter(mv); err != nil {
+	if n, err = pager.applyFilter(n); err != nil {
 		return nil, err
 	}
-	conn := &MessageVoiceConnection{Edges: []*MessageVoiceEdge{}}
+	conn := &NotificationConnection{Edges: []*NotificationEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			c := mv.Clone()
+			c := n.Clone()
 			c.ctx.Fields = nil
 			if conn.TotalCount, err = c.Count(ctx); err != nil {
 				return nil, err
@@ -2280,20 +4214,20 @@ func (mv *MessageVoiceQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if mv, err = pager.applyCursors(mv, after, before); err != nil {
+	if n, err = pager.applyCursors(n, after, before); err != nil {
 		return nil, err
 	}
 	limit := paginateLimit(first, last)
 	if limit != 0 {
-		mv.Limit(limit)
+		n.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := mv.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := n.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	mv = pager.applyOrder(mv)
-	nodes, err := mv.All(ctx)
+	n = pager.applyOrder(n)
+	nodes, err := n.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -2302,125 +4236,179 @@ func (mv *MessageVoiceQuery) Paginate(
 }
 
 var (
-	// MessageVoiceOrderFieldLength orders MessageVoice by length.
-	MessageVoiceOrderFieldLength = &MessageVoiceOrderField{
-		Value: func(mv *MessageVoice) (ent.Value, error) {
-			return mv.Length, nil
+	// NotificationOrderFieldType orders Notification by type.
+	NotificationOrderFieldType = &NotificationOrderField{
+		Value: func(n *Notification) (ent.Value, error) {
+			return n.Type, nil
+		},
+		column: notification.FieldType,
+		toTerm: notification.ByType,
+		toCursor: func(n *Notification) Cursor {
+			return Cursor{
+				ID:    n.ID,
+				Value: n.Type,
+			}
+		},
+	}
+	// NotificationOrderFieldTitle orders Notification by title.
+	NotificationOrderFieldTitle = &NotificationOrderField{
+		Value: func(n *Notification) (ent.Value, error) {
+			return n.Title, nil
+		},
+		column: notification.FieldTitle,
+		toTerm: notification.ByTitle,
+		toCursor: func(n *Notification) Cursor {
+			return Cursor{
+				ID:    n.ID,
+				Value: n.Title,
+			}
+		},
+	}
+	// NotificationOrderFieldReadAt orders Notification by read_at.
+	NotificationOrderFieldReadAt = &NotificationOrderField{
+		Value: func(n *Notification) (ent.Value, error) {
+			return n.ReadAt, nil
+		},
+		column: notification.FieldReadAt,
+		toTerm: notification.ByReadAt,
+		toCursor: func(n *Notification) Cursor {
+			return Cursor{
+				ID:    n.ID,
+				Value: n.ReadAt,
+			}
+		},
+	}
+	// NotificationOrderFieldCreatedAt orders Notification by created_at.
+	NotificationOrderFieldCreatedAt = &NotificationOrderField{
+		Value: func(n *Notification) (ent.Value, error) {
+			return n.CreatedAt, nil
 		},
-		column: messagevoice.FieldLength,
-		toTerm: messagevoice.ByLength,
-		toCursor: func(mv *MessageVoice) Cursor {
+		column: notification.FieldCreatedAt,
+		toTerm: notification.ByCreatedAt,
+		toCursor: func(n *Notification) Cursor {
 			return Cursor{
-				ID:    mv.ID,
-				Value: mv.Length,
+				ID:    n.ID,
+				Value: n.CreatedAt,
 			}
 		},
 	}
-	// MessageVoiceOrderFieldAttachedAt orders MessageVoice by attached_at.
-	MessageVoiceOrderFieldAttachedAt = &MessageVoiceOrderField{
-		Value: func(mv *MessageVoice) (ent.Value, error) {
-			return mv.AttachedAt, nil
+	// NotificationOrderFieldUpdatedAt orders Notification by updated_at.
+	NotificationOrderFieldUpdatedAt = &NotificationOrderField{
+		Value: func(n *Notification) (ent.Value, error) {
+			return n.UpdatedAt, nil
 		},
-		column: messagevoice.FieldAttachedAt,
-		toTerm: messagevoice.ByAttachedAt,
-		toCursor: func(mv *MessageVoice) Cursor {
+		column: notification.FieldUpdatedAt,
+		toTerm: notification.ByUpdatedAt,
+		toCursor: func(n *Notification) Cursor {
 			return Cursor{
-				ID:    mv.ID,
-				Value: mv.AttachedAt,
+				ID:    n.ID,
+				Value: n.UpdatedAt,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f MessageVoiceOrderField) String() string {
+func (f NotificationOrderField) String() string {
 	var str string
 	switch f.column {
-	case MessageVoiceOrderFieldLength.column:
-		str = "LENGTH"
-	case MessageVoiceOrderFieldAttachedAt.column:
-		str = "ATTACHED_AT"
+	case NotificationOrderFieldType.column:
+		str = "TYPE"
+	case NotificationOrderFieldTitle.column:
+		str = "TITLE"
+	case NotificationOrderFieldReadAt.column:
+		str = "READ_AT"
+	case NotificationOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	case NotificationOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f MessageVoiceOrderField) MarshalGQL(w io.Writer) {
+func (f NotificationOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *MessageVoiceOrderField) UnmarshalGQL(v interface{}) error {
+func (f *NotificationOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("MessageVoiceOrderField %T must be a string", v)
+		return fmt.Errorf("NotificationOrderField %T must be a string", v)
 	}
 	switch str {
-	case "LENGTH":
-		*f = *MessageVoiceOrderFieldLength
-	case "ATTACHED_AT":
-		*f = *MessageVoiceOrderFieldAttachedAt
+	case "TYPE":
+		*f = *NotificationOrderFieldType
+	case "TITLE":
+		*f = *NotificationOrderFieldTitle
+	case "READ_AT":
+		*f = *NotificationOrderFieldReadAt
+	case "CREATED_AT":
+		*f = *NotificationOrderFieldCreatedAt
+	case "UPDATED_AT":
+		*f = *NotificationOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid MessageVoiceOrderField", str)
+		return fmt.Errorf("%s is not a valid NotificationOrderField", str)
 	}
 	return nil
 }
 
-// MessageVoiceOrderField defines the ordering field of MessageVoice.
-type MessageVoiceOrderField struct {
-	// Value extracts the ordering value from the given MessageVoice.
-	Value    func(*MessageVoice) (ent.Value, error)
+// NotificationOrderField defines the ordering field of Notification.
+type NotificationOrderField struct {
+	// Value extracts the ordering value from the given Notification.
+	Value    func(*Notification) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) messagevoice.OrderOption
-	toCursor func(*MessageVoice) Cursor
+	toTerm   func(...sql.OrderTermOption) notification.OrderOption
+	toCursor func(*Notification) Cursor
 }
 
-// MessageVoiceOrder defines the ordering of MessageVoice.
-type MessageVoiceOrder struct {
+// NotificationOrder defines the ordering of Notification.
+type NotificationOrder struct {
 	Direction OrderDirection          `json:"direction"`
-	Field     *MessageVoiceOrderField `json:"field"`
+	Field     *NotificationOrderField `json:"field"`
 }
 
-// DefaultMessageVoiceOrder is the default ordering of MessageVoice.
-var DefaultMessageVoiceOrder = &MessageVoiceOrder{
+// DefaultNotificationOrder is the default ordering of Notification.
+var DefaultNotificationOrder = &NotificationOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &MessageVoiceOrderField{
-		Value: func(mv *MessageVoice) (ent.Value, error) {
-			return mv.ID, nil
+	Field: &NotificationOrderField{
+		Value: func(n *Notification) (ent.Value, error) {
+			return n.ID, nil
 		},
-		column: messagevoice.FieldID,
-		toTerm: messagevoice.ByID,
-		toCursor: func(mv *MessageVoice) Cursor {
-			return Cursor{ID: mv.ID}
+		column: notification.FieldID,
+		toTerm: notification.ByID,
+		toCursor: func(n *Notification) Cursor {
+			return Cursor{ID: n.ID}
 		},
 	},
 }
 
-// ToEdge converts MessageVoice into MessageVoiceEdge.
-func (mv *MessageVoice) ToEdge(order *MessageVoiceOrder) *MessageVoiceEdge {
+// ToEdge converts Notification into NotificationEdge.
+func (n *Notification) ToEdge(order *NotificationOrder) *NotificationEdge {
 	if order == nil {
-		order = DefaultMessageVoiceOrder
+		order = DefaultNotificationOrder
 	}
-	return &MessageVoiceEdge{
-		Node:   mv,
-		Cursor: order.Field.toCursor(mv),
+	return &NotificationEdge{
+		Node:   n,
+		Cursor: order.Field.toCursor(n),
 	}
 }
 
-// NotificationEdge is the edge representation of Notification.
-type NotificationEdge struct {
-	Node   *Notification `json:"node"`
-	Cursor Cursor        `json:"cursor"`
+// ReportEdge is the edge representation of Report.
+type ReportEdge struct {
+	Node   *Report `json:"node"`
+	Cursor Cursor  `json:"cursor"`
 }
 
-// NotificationConnection is the connection containing edges to Notification.
-type NotificationConnection struct {
-	Edges      []*NotificationEdge `json:"edges"`
-	PageInfo   PageInfo            `json:"pageInfo"`
-	TotalCount int                 `json:"totalCount"`
+// ReportConnection is the connection containing edges to Report.
+type ReportConnection struct {
+	Edges      []*ReportEdge `json:"edges"`
+	PageInfo   PageInfo      `json:"pageInfo"`
+	TotalCount int           `json:"totalCount"`
 }
 
-func (c *NotificationConnection) build(nodes []*Notification, pager *notificationPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *ReportConnection) build(nodes []*Report, pager *reportPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -2430,21 +4418,21 @@ func (c *NotificationConnection) build(nodes []*Notification, pager *notificatio
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Notification
+	var nodeAt func(int) *Report
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Notification {
+		nodeAt = func(i int) *Report {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Notification {
+		nodeAt = func(i int) *Report {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*NotificationEdge, len(nodes))
+	c.Edges = make([]*ReportEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &NotificationEdge{
+		c.Edges[i] = &ReportEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -2458,12 +4446,12 @@ func (c *NotificationConnection) build(nodes []*Notification, pager *notificatio
 	}
 }
 
-// NotificationPaginateOption enables pagination customization.
-type NotificationPaginateOption func(*notificationPager) error
+// ReportPaginateOption enables pagination customization.
+type ReportPaginateOption func(*reportPager) error
 
-// WithNotificationOrder configures pagination ordering.
-func WithNotificationOrder(order []*NotificationOrder) NotificationPaginateOption {
-	return func(pager *notificationPager) error {
+// WithReportOrder configures pagination ordering.
+func WithReportOrder(order []*ReportOrder) ReportPaginateOption {
+	return func(pager *reportPager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -2474,25 +4462,25 @@ func WithNotificationOrder(order []*NotificationOrder) NotificationPaginateOptio
 	}
 }
 
-// WithNotificationFilter configures pagination filter.
-func WithNotificationFilter(filter func(*NotificationQuery) (*NotificationQuery, error)) NotificationPaginateOption {
-	return func(pager *notificationPager) error {
+// WithReportFilter configures pagination filter.
+func WithReportFilter(filter func(*ReportQuery) (*ReportQuery, error)) ReportPaginateOption {
+	return func(pager *reportPager) error {
 		if filter == nil {
-			return errors.New("NotificationQuery filter cannot be nil")
+			return errors.New("ReportQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type notificationPager struct {
+type reportPager struct {
 	reverse bool
-	order   []*NotificationOrder
-	filter  func(*NotificationQuery) (*NotificationQuery, error)
+	order   []*ReportOrder
+	filter  func(*ReportQuery) (*ReportQuery, error)
 }
 
-func newNotificationPager(opts []NotificationPaginateOption, reverse bool) (*notificationPager, error) {
-	pager := &notificationPager{reverse: reverse}
+func newReportPager(opts []ReportPaginateOption, reverse bool) (*reportPager, error) {
+	pager := &reportPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -2506,22 +4494,22 @@ func newNotificationPager(opts []NotificationPaginateOption, reverse bool) (*not
 	return pager, nil
 }
 
-func (p *notificationPager) applyFilter(query *NotificationQuery) (*NotificationQuery, error) {
+func (p *reportPager) applyFilter(query *ReportQuery) (*ReportQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *notificationPager) toCursor(n *Notification) Cursor {
+func (p *reportPager) toCursor(r *Report) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
-		cs_ = append(cs_, o_.Field.toCursor(n).Value)
+		cs_ = append(cs_, o_.Field.toCursor(r).Value)
 	}
-	return Cursor{ID: n.ID, Value: cs_}
+	return Cursor{ID: r.ID, Value: cs_}
 }
 
-func (p *notificationPager) applyCursors(query *NotificationQuery, after, before *Cursor) (*NotificationQuery, error) {
+func (p *reportPager) applyCursors(query *ReportQuery, after, before *Cursor) (*ReportQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -2536,7 +4524,7 @@ func (p *notificationPager) applyCursors(query *NotificationQuery, after, before
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultNotificationOrder.Field.column,
+		FieldID:     DefaultReportOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -2550,7 +4538,7 @@ func (p *notificationPager) applyCursors(query *NotificationQuery, after, before
 	return query, nil
 }
 
-func (p *notificationPager) applyOrder(query *NotificationQuery) *NotificationQuery {
+func (p *reportPager) applyOrder(query *ReportQuery) *ReportQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -2558,7 +4546,7 @@ func (p *notificationPager) applyOrder(query *NotificationQuery) *NotificationQu
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultNotificationOrder.Field.column {
+		if o.Field.column == DefaultReportOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -2570,12 +4558,12 @@ func (p *notificationPager) applyOrder(query *NotificationQuery) *NotificationQu
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultNotificationOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultReportOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *notificationPager) orderExpr(query *NotificationQuery) sql.Querier {
+func (p *reportPager) orderExpr(query *ReportQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -2594,31 +4582,31 @@ func (p *notificationPager) orderExpr(query *NotificationQuery) sql.Querier {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultNotificationOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultReportOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Notification.
-func (n *NotificationQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Report.
+func (r *ReportQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...NotificationPaginateOption,
-) (*NotificationConnection, error) {
+	before *Cursor, last *int, opts ...ReportPaginateOption,
+) (*ReportConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newNotificationPager(opts, last != nil)
+	pager, err := newReportPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if n, err = pager.applyFilter(n); err != nil {
+	if r, err = pager.applyFilter(r); err != nil {
 		return nil, err
 	}
-	conn := &NotificationConnection{Edges: []*NotificationEdge{}}
+	conn := &ReportConnection{Edges: []*ReportEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			c := n.Clone()
+			c := r.Clone()
 			c.ctx.Fields = nil
 			if conn.TotalCount, err = c.Count(ctx); err != nil {
 				return nil, err
@@ -2630,20 +4618,20 @@ func (n *NotificationQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if n, err = pager.applyCursors(n, after, before); err != nil {
+	if r, err = pager.applyCursors(r, after, before); err != nil {
 		return nil, err
 	}
 	limit := paginateLimit(first, last)
 	if limit != 0 {
-		n.Limit(limit)
+		r.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := n.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := r.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	n = pager.applyOrder(n)
-	nodes, err := n.All(ctx)
+	r = pager.applyOrder(r)
+	nodes, err := r.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -2652,126 +4640,162 @@ func (n *NotificationQuery) Paginate(
 }
 
 var (
-	// NotificationOrderFieldTitle orders Notification by title.
-	NotificationOrderFieldTitle = &NotificationOrderField{
-		Value: func(n *Notification) (ent.Value, error) {
-			return n.Title, nil
+	// ReportOrderFieldType orders Report by type.
+	ReportOrderFieldType = &ReportOrderField{
+		Value: func(r *Report) (ent.Value, error) {
+			return r.Type, nil
 		},
-		column: notification.FieldTitle,
-		toTerm: notification.ByTitle,
-		toCursor: func(n *Notification) Cursor {
+		column: report.FieldType,
+		toTerm: report.ByType,
+		toCursor: func(r *Report) Cursor {
 			return Cursor{
-				ID:    n.ID,
-				Value: n.Title,
+				ID:    r.ID,
+				Value: r.Type,
 			}
 		},
 	}
-	// NotificationOrderFieldCreatedAt orders Notification by created_at.
-	NotificationOrderFieldCreatedAt = &NotificationOrderField{
-		Value: func(n *Notification) (ent.Value, error) {
-			return n.CreatedAt, nil
+	// ReportOrderFieldStatus orders Report by status.
+	ReportOrderFieldStatus = &ReportOrderField{
+		Value: func(r *Report) (ent.Value, error) {
+			return r.Status, nil
 		},
-		column: notification.FieldCreatedAt,
-		toTerm: notification.ByCreatedAt,
-		toCursor: func(n *Notification) Cursor {
+		column: report.FieldStatus,
+		toTerm: report.ByStatus,
+		toCursor: func(r *Report) Cursor {
 			return Cursor{
-				ID:    n.ID,
-				Value: n.CreatedAt,
+				ID:    r.ID,
+				Value: r.Status,
 			}
 		},
 	}
-	// NotificationOrderFieldUpdatedAt orders Notification by updated_at.
-	NotificationOrderFieldUpdatedAt = &NotificationOrderField{
-		Value: func(n *Notification) (ent.Value, error) {
-			return n.UpdatedAt, nil
+	// ReportOrderFieldClosedAt orders Report by closed_at.
+	ReportOrderFieldClosedAt = &ReportOrderField{
+		Value: func(r *Report) (ent.Value, error) {
+			return r.ClosedAt, nil
 		},
-		column: notification.FieldUpdatedAt,
-		toTerm: notification.ByUpdatedAt,
-		toCursor: func(n *Notification) Cursor {
+		column: report.FieldClosedAt,
+		toTerm: report.ByClosedAt,
+		toCursor: func(r *Report) Cursor {
 			return Cursor{
-				ID:    n.ID,
-				Value: n.UpdatedAt,
+				ID:    r.ID,
+				Value: r.ClosedAt,
+			}
+		},
+	}
+	// ReportOrderFieldCreatedAt orders Report by created_at.
+	ReportOrderFieldCreatedAt = &ReportOrderField{
+		Value: func(r *Report) (ent.Value, error) {
+			return r.CreatedAt, nil
+		},
+		column: report.FieldCreatedAt,
+		toTerm: report.ByCreatedAt,
+		toCursor: func(r *Report) Cursor {
+			return Cursor{
+				ID:    r.ID,
+				Value: r.CreatedAt,
+			}
+		},
+	}
+	// ReportOrderFieldUpdatedAt orders Report by updated_at.
+	ReportOrderFieldUpdatedAt = &ReportOrderField{
+		Value: func(r *Report) (ent.Value, error) {
+			return r.UpdatedAt, nil
+		},
+		column: report.FieldUpdatedAt,
+		toTerm: report.ByUpdatedAt,
+		toCursor: func(r *Report) Cursor {
+			return Cursor{
+				ID:    r.ID,
+				Value: r.UpdatedAt,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f NotificationOrderField) String() string {
+func (f ReportOrderField) String() string {
 	var str string
 	switch f.column {
-	case NotificationOrderFieldTitle.column:
-		str = "TITLE"
-	case NotificationOrderFieldCreatedAt.column:
+	case ReportOrderFieldType.column:
+		str = "TYPE"
+	case ReportOrderFieldStatus.column:
+		str = "STATUS"
+	case ReportOrderFieldClosedAt.column:
+		str = "CLOSED_AT"
+	case ReportOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case NotificationOrderFieldUpdatedAt.column:
+	case ReportOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f NotificationOrderField) MarshalGQL(w io.Writer) {
+func (f ReportOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *NotificationOrderField) UnmarshalGQL(v interface{}) error {
+func (f *ReportOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("NotificationOrderField %T must be a string", v)
+		return fmt.Errorf("ReportOrderField %T must be a string", v)
 	}
 	switch str {
-	case "TITLE":
-		*f = *NotificationOrderFieldTitle
+	case "TYPE":
+		*f = *ReportOrderFieldType
+	case "STATUS":
+		*f = *ReportOrderFieldStatus
+	case "CLOSED_AT":
+		*f = *ReportOrderFieldClosedAt
 	case "CREATED_AT":
-		*f = *NotificationOrderFieldCreatedAt
+		*f = *ReportOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *NotificationOrderFieldUpdatedAt
+		*f = *ReportOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid NotificationOrderField", str)
+		return fmt.Errorf("%s is not a valid ReportOrderField", str)
 	}
 	return nil
 }
 
-// NotificationOrderField defines the ordering field of Notification.
-type NotificationOrderField struct {
-	// Value extracts the ordering value from the given Notification.
-	Value    func(*Notification) (ent.Value, error)
+// ReportOrderField defines the ordering field of Report.
+type ReportOrderField struct {
+	// Value extracts the ordering value from the given Report.
+	Value    func(*Report) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) notification.OrderOption
-	toCursor func(*Notification) Cursor
+	toTerm   func(...sql.OrderTermOption) report.OrderOption
+	toCursor func(*Report) Cursor
 }
 
-// NotificationOrder defines the ordering of Notification.
-type NotificationOrder struct {
-	Direction OrderDirection          `json:"direction"`
-	Field     *NotificationOrderField `json:"field"`
+// ReportOrder defines the ordering of Report.
+type ReportOrder struct {
+	Direction OrderDirection    `json:"direction"`
+	Field     *ReportOrderField `json:"field"`
 }
 
-// DefaultNotificationOrder is the default ordering of Notification.
-var DefaultNotificationOrder = &NotificationOrder{
+// DefaultReportOrder is the default ordering of Report.
+var DefaultReportOrder = &ReportOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &NotificationOrderField{
-		Value: func(n *Notification) (ent.Value, error) {
-			return n.ID, nil
+	Field: &ReportOrderField{
+		Value: func(r *Report) (ent.Value, error) {
+			return r.ID, nil
 		},
-		column: notification.FieldID,
-		toTerm: notification.ByID,
-		toCursor: func(n *Notification) Cursor {
-			return Cursor{ID: n.ID}
+		column: report.FieldID,
+		toTerm: report.ByID,
+		toCursor: func(r *Report) Cursor {
+			return Cursor{ID: r.ID}
 		},
 	},
 }
 
-// ToEdge converts Notification into NotificationEdge.
-func (n *Notification) ToEdge(order *NotificationOrder) *NotificationEdge {
+// ToEdge converts Report into ReportEdge.
+func (r *Report) ToEdge(order *ReportOrder) *ReportEdge {
 	if order == nil {
-		order = DefaultNotificationOrder
+		order = DefaultReportOrder
 	}
-	return &NotificationEdge{
-		Node:   n,
-		Cursor: order.Field.toCursor(n),
+	return &ReportEdge{
+		Node:   r,
+		Cursor: order.Field.toCursor(r),
 	}
 }
 
@@ -3234,6 +5258,338 @@ func (r *Room) ToEdge(order *RoomOrder) *RoomEdge {
 	}
 }
 
+// RoomInviteEdge is the edge representation of RoomInvite.
+type RoomInviteEdge struct {
+	Node   *RoomInvite `json:"node"`
+	Cursor Cursor      `json:"cursor"`
+}
+
+// RoomInviteConnection is the connection containing edges to RoomInvite.
+type RoomInviteConnection struct {
+	Edges      []*RoomInviteEdge `json:"edges"`
+	PageInfo   PageInfo          `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
+}
+
+func (c *RoomInviteConnection) build(nodes []*RoomInvite, pager *roominvitePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *RoomInvite
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *RoomInvite {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *RoomInvite {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*RoomInviteEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &RoomInviteEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// RoomInvitePaginateOption enables pagination customization.
+type RoomInvitePaginateOption func(*roominvitePager) error
+
+// WithRoomInviteOrder configures pagination ordering.
+func WithRoomInviteOrder(order []*RoomInviteOrder) RoomInvitePaginateOption {
+	return func(pager *roominvitePager) error {
+		for _, o := range order {
+			if err := o.Direction.Validate(); err != nil {
+				return err
+			}
+		}
+		pager.order = append(pager.order, order...)
+		return nil
+	}
+}
+
+// WithRoomInviteFilter configures pagination filter.
+func WithRoomInviteFilter(filter func(*RoomInviteQuery) (*RoomInviteQuery, error)) RoomInvitePaginateOption {
+	return func(pager *roominvitePager) error {
+		if filter == nil {
+			return errors.New("RoomInviteQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type roominvitePager struct {
+	reverse bool
+	order   []*RoomInviteOrder
+	filter  func(*RoomInviteQuery) (*RoomInviteQuery, error)
+}
+
+func newRoomInvitePager(opts []RoomInvitePaginateOption, reverse bool) (*roominvitePager, error) {
+	pager := &roominvitePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	for i, o := range pager.order {
+		if i > 0 && o.Field == pager.order[i-1].Field {
+			return nil, fmt.Errorf("duplicate order direction %q", o.Direction)
+		}
+	}
+	return pager, nil
+}
+
+func (p *roominvitePager) applyFilter(query *RoomInviteQuery) (*RoomInviteQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *roominvitePager) toCursor(ri *RoomInvite) Cursor {
+	cs_ := make([]any, 0, len(p.order))
+	for _, o_ := range p.order {
+		cs_ = append(cs_, o_.Field.toCursor(ri).Value)
+	}
+	return Cursor{ID: ri.ID, Value: cs_}
+}
+
+func (p *roominvitePager) applyCursors(query *RoomInviteQuery, after, before *Cursor) (*RoomInviteQuery, error) {
+	idDirection := entgql.OrderDirectionAsc
+	if p.reverse {
+		idDirection = entgql.OrderDirectionDesc
+	}
+	fields, directions := make([]string, 0, len(p.order)), make([]OrderDirection, 0, len(p.order))
+	for _, o := range p.order {
+		fields = append(fields, o.Field.column)
+		direction := o.Direction
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		directions = append(directions, direction)
+	}
+	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
+		FieldID:     DefaultRoomInviteOrder.Field.column,
+		DirectionID: idDirection,
+		Fields:      fields,
+		Directions:  directions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, predicate := range predicates {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *roominvitePager) applyOrder(query *RoomInviteQuery) *RoomInviteQuery {
+	var defaultOrdered bool
+	for _, o := range p.order {
+		direction := o.Direction
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
+		if o.Field.column == DefaultRoomInviteOrder.Field.column {
+			defaultOrdered = true
+		}
+		if len(query.ctx.Fields) > 0 {
+			query.ctx.AppendFieldOnce(o.Field.column)
+		}
+	}
+	if !defaultOrdered {
+		direction := entgql.OrderDirectionAsc
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		query = query.Order(DefaultRoomInviteOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	return query
+}
+
+func (p *roominvitePager) orderExpr(query *RoomInviteQuery) sql.Querier {
+	if len(query.ctx.Fields) > 0 {
+		for _, o := range p.order {
+			query.ctx.AppendFieldOnce(o.Field.column)
+		}
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		for _, o := range p.order {
+			direction := o.Direction
+			if p.reverse {
+				direction = direction.Reverse()
+			}
+			b.Ident(o.Field.column).Pad().WriteString(string(direction))
+			b.Comma()
+		}
+		direction := entgql.OrderDirectionAsc
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		b.Ident(DefaultRoomInviteOrder.Field.column).Pad().WriteString(string(direction))
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to RoomInvite.
+func (ri *RoomInviteQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...RoomInvitePaginateOption,
+) (*RoomInviteConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newRoomInvitePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if ri, err = pager.applyFilter(ri); err != nil {
+		return nil, err
+	}
+	conn := &RoomInviteConnection{Edges: []*RoomInviteEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := ri.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if ri, err = pager.applyCursors(ri, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		ri.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := ri.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	ri = pager.applyOrder(ri)
+	nodes, err := ri.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// RoomInviteOrderFieldCreatedAt orders RoomInvite by created_at.
+	RoomInviteOrderFieldCreatedAt = &RoomInviteOrderField{
+		Value: func(ri *RoomInvite) (ent.Value, error) {
+			return ri.CreatedAt, nil
+		},
+		column: roominvite.FieldCreatedAt,
+		toTerm: roominvite.ByCreatedAt,
+		toCursor: func(ri *RoomInvite) Cursor {
+			return Cursor{
+				ID:    ri.ID,
+				Value: ri.CreatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f RoomInviteOrderField) String() string {
+	var str string
+	switch f.column {
+	case RoomInviteOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f RoomInviteOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *RoomInviteOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("RoomInviteOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *RoomInviteOrderFieldCreatedAt
+	default:
+		return fmt.Errorf("%s is not a valid RoomInviteOrderField", str)
+	}
+	return nil
+}
+
+// RoomInviteOrderField defines the ordering field of RoomInvite.
+type RoomInviteOrderField struct {
+	// Value extracts the ordering value from the given RoomInvite.
+	Value    func(*RoomInvite) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) roominvite.OrderOption
+	toCursor func(*RoomInvite) Cursor
+}
+
+// RoomInviteOrder defines the ordering of RoomInvite.
+type RoomInviteOrder struct {
+	Direction OrderDirection        `json:"direction"`
+	Field     *RoomInviteOrderField `json:"field"`
+}
+
+// DefaultRoomInviteOrder is the default ordering of RoomInvite.
+var DefaultRoomInviteOrder = &RoomInviteOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &RoomInviteOrderField{
+		Value: func(ri *RoomInvite) (ent.Value, error) {
+			return ri.ID, nil
+		},
+		column: roominvite.FieldID,
+		toTerm: roominvite.ByID,
+		toCursor: func(ri *RoomInvite) Cursor {
+			return Cursor{ID: ri.ID}
+		},
+	},
+}
+
+// ToEdge converts RoomInvite into RoomInviteEdge.
+func (ri *RoomInvite) ToEdge(order *RoomInviteOrder) *RoomInviteEdge {
+	if order == nil {
+		order = DefaultRoomInviteOrder
+	}
+	return &RoomInviteEdge{
+		Node:   ri,
+		Cursor: order.Field.toCursor(ri),
+	}
+}
+
 // RoomMemberEdge is the edge representation of RoomMember.
 type RoomMemberEdge struct {
 	Node   *RoomMember `json:"node"`
@@ -5,6 +5,11 @@ package ent
 import (
 	"errors"
 	"fmt"
+	"journeyhub/ent/avatar"
+	"journeyhub/ent/call"
+	"journeyhub/ent/callparticipant"
+	"journeyhub/ent/contactpin"
+	"journeyhub/ent/contactrequest"
 	"journeyhub/ent/device"
 	"journeyhub/ent/file"
 	"journeyhub/ent/message"
@@ -13,7 +18,9 @@ import (
 	"journeyhub/ent/messagevoice"
 	"journeyhub/ent/notification"
 	"journeyhub/ent/predicate"
+	"journeyhub/ent/report"
 	"journeyhub/ent/room"
+	"journeyhub/ent/roominvite"
 	"journeyhub/ent/roommember"
 	"journeyhub/ent/schema/pulid"
 	"journeyhub/ent/user"
@@ -21,12 +28,12 @@ import (
 	"time"
 )
 
-// DeviceWhereInput represents a where input for filtering Device queries.
-type DeviceWhereInput struct {
-	Predicates []predicate.Device  `json:"-"`
-	Not        *DeviceWhereInput   `json:"not,omitempty"`
-	Or         []*DeviceWhereInput `json:"or,omitempty"`
-	And        []*DeviceWhereInput `json:"and,omitempty"`
+// AvatarWhereInput represents a where input for filtering Avatar queries.
+type AvatarWhereInput struct {
+	Predicates []predicate.Avatar  `json:"-"`
+	Not        *AvatarWhereInput   `json:"not,omitempty"`
+	Or         []*AvatarWhereInput `json:"or,omitempty"`
+	And        []*AvatarWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *pulid.ID  `json:"id,omitempty"`
@@ -38,35 +45,54 @@ type DeviceWhereInput struct {
 	IDLT    *pulid.ID  `json:"idLT,omitempty"`
 	IDLTE   *pulid.ID  `json:"idLTE,omitempty"`
 
-	// "device_id" field predicates.
-	DeviceID             *string  `json:"deviceID,omitempty"`
-	DeviceIDNEQ          *string  `json:"deviceIDNEQ,omitempty"`
-	DeviceIDIn           []string `json:"deviceIDIn,omitempty"`
-	DeviceIDNotIn        []string `json:"deviceIDNotIn,omitempty"`
-	DeviceIDGT           *string  `json:"deviceIDGT,omitempty"`
-	DeviceIDGTE          *string  `json:"deviceIDGTE,omitempty"`
-	DeviceIDLT           *string  `json:"deviceIDLT,omitempty"`
-	DeviceIDLTE          *string  `json:"deviceIDLTE,omitempty"`
-	DeviceIDContains     *string  `json:"deviceIDContains,omitempty"`
-	DeviceIDHasPrefix    *string  `json:"deviceIDHasPrefix,omitempty"`
-	DeviceIDHasSuffix    *string  `json:"deviceIDHasSuffix,omitempty"`
-	DeviceIDEqualFold    *string  `json:"deviceIDEqualFold,omitempty"`
-	DeviceIDContainsFold *string  `json:"deviceIDContainsFold,omitempty"`
+	// "file_id" field predicates.
+	FileID             *pulid.ID  `json:"fileID,omitempty"`
+	FileIDNEQ          *pulid.ID  `json:"fileIDNEQ,omitempty"`
+	FileIDIn           []pulid.ID `json:"fileIDIn,omitempty"`
+	FileIDNotIn        []pulid.ID `json:"fileIDNotIn,omitempty"`
+	FileIDGT           *pulid.ID  `json:"fileIDGT,omitempty"`
+	FileIDGTE          *pulid.ID  `json:"fileIDGTE,omitempty"`
+	FileIDLT           *pulid.ID  `json:"fileIDLT,omitempty"`
+	FileIDLTE          *pulid.ID  `json:"fileIDLTE,omitempty"`
+	FileIDContains     *pulid.ID  `json:"fileIDContains,omitempty"`
+	FileIDHasPrefix    *pulid.ID  `json:"fileIDHasPrefix,omitempty"`
+	FileIDHasSuffix    *pulid.ID  `json:"fileIDHasSuffix,omitempty"`
+	FileIDEqualFold    *pulid.ID  `json:"fileIDEqualFold,omitempty"`
+	FileIDContainsFold *pulid.ID  `json:"fileIDContainsFold,omitempty"`
+
+	// "user_id" field predicates.
+	UserID             *pulid.ID  `json:"userID,omitempty"`
+	UserIDNEQ          *pulid.ID  `json:"userIDNEQ,omitempty"`
+	UserIDIn           []pulid.ID `json:"userIDIn,omitempty"`
+	UserIDNotIn        []pulid.ID `json:"userIDNotIn,omitempty"`
+	UserIDGT           *pulid.ID  `json:"userIDGT,omitempty"`
+	UserIDGTE          *pulid.ID  `json:"userIDGTE,omitempty"`
+	UserIDLT           *pulid.ID  `json:"userIDLT,omitempty"`
+	UserIDLTE          *pulid.ID  `json:"userIDLTE,omitempty"`
+	UserIDContains     *pulid.ID  `json:"userIDContains,omitempty"`
+	UserIDHasPrefix    *pulid.ID  `json:"userIDHasPrefix,omitempty"`
+	UserIDHasSuffix    *pulid.ID  `json:"userIDHasSuffix,omitempty"`
+	UserIDIsNil        bool       `json:"userIDIsNil,omitempty"`
+	UserIDNotNil       bool       `json:"userIDNotNil,omitempty"`
+	UserIDEqualFold    *pulid.ID  `json:"userIDEqualFold,omitempty"`
+	UserIDContainsFold *pulid.ID  `json:"userIDContainsFold,omitempty"`
 
-	// "fcm_token" field predicates.
-	FcmToken             *string  `json:"fcmToken,omitempty"`
-	FcmTokenNEQ          *string  `json:"fcmTokenNEQ,omitempty"`
-	FcmTokenIn           []string `json:"fcmTokenIn,omitempty"`
-	FcmTokenNotIn        []string `json:"fcmTokenNotIn,omitempty"`
-	FcmTokenGT           *string  `json:"fcmTokenGT,omitempty"`
-	FcmTokenGTE          *string  `json:"fcmTokenGTE,omitempty"`
-	FcmTokenLT           *string  `json:"fcmTokenLT,omitempty"`
-	FcmTokenLTE          *string  `json:"fcmTokenLTE,omitempty"`
-	FcmTokenContains     *string  `json:"fcmTokenContains,omitempty"`
-	FcmTokenHasPrefix    *string  `json:"fcmTokenHasPrefix,omitempty"`
-	FcmTokenHasSuffix    *string  `json:"fcmTokenHasSuffix,omitempty"`
-	FcmTokenEqualFold    *string  `json:"fcmTokenEqualFold,omitempty"`
-	FcmTokenContainsFold *string  `json:"fcmTokenContainsFold,omitempty"`
+	// "room_id" field predicates.
+	RoomID             *pulid.ID  `json:"roomID,omitempty"`
+	RoomIDNEQ          *pulid.ID  `json:"roomIDNEQ,omitempty"`
+	RoomIDIn           []pulid.ID `json:"roomIDIn,omitempty"`
+	RoomIDNotIn        []pulid.ID `json:"roomIDNotIn,omitempty"`
+	RoomIDGT           *pulid.ID  `json:"roomIDGT,omitempty"`
+	RoomIDGTE          *pulid.ID  `json:"roomIDGTE,omitempty"`
+	RoomIDLT           *pulid.ID  `json:"roomIDLT,omitempty"`
+	RoomIDLTE          *pulid.ID  `json:"roomIDLTE,omitempty"`
+	RoomIDContains     *pulid.ID  `json:"roomIDContains,omitempty"`
+	RoomIDHasPrefix    *pulid.ID  `json:"roomIDHasPrefix,omitempty"`
+	RoomIDHasSuffix    *pulid.ID  `json:"roomIDHasSuffix,omitempty"`
+	RoomIDIsNil        bool       `json:"roomIDIsNil,omitempty"`
+	RoomIDNotNil       bool       `json:"roomIDNotNil,omitempty"`
+	RoomIDEqualFold    *pulid.ID  `json:"roomIDEqualFold,omitempty"`
+	RoomIDContainsFold *pulid.ID  `json:"roomIDContainsFold,omitempty"`
 
 	// "created_at" field predicates.
 	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
@@ -78,34 +104,32 @@ type DeviceWhereInput struct {
 	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
 	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
 
-	// "updated_at" field predicates.
-	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
-	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
-	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
-	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
-	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
-	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
-	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
-	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+	// "file" edge predicates.
+	HasFile     *bool             `json:"hasFile,omitempty"`
+	HasFileWith []*FileWhereInput `json:"hasFileWith,omitempty"`
 
 	// "user" edge predicates.
 	HasUser     *bool             `json:"hasUser,omitempty"`
 	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+
+	// "room" edge predicates.
+	HasRoom     *bool             `json:"hasRoom,omitempty"`
+	HasRoomWith []*RoomWhereInput `json:"hasRoomWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *DeviceWhereInput) AddPredicates(predicates ...predicate.Device) {
+func (i *AvatarWhereInput) AddPredicates(predicates ...predicate.Avatar) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the DeviceWhereInput filter on the DeviceQuery builder.
-func (i *DeviceWhereInput) Filter(q *DeviceQuery) (*DeviceQuery, error) {
+// Filter applies the AvatarWhereInput filter on the AvatarQuery builder.
+func (i *AvatarWhereInput) Filter(q *AvatarQuery) (*AvatarQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyDeviceWhereInput {
+		if err == ErrEmptyAvatarWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -113,19 +137,19 @@ func (i *DeviceWhereInput) Filter(q *DeviceQuery) (*DeviceQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptyDeviceWhereInput is returned in case the DeviceWhereInput is empty.
-var ErrEmptyDeviceWhereInput = errors.New("ent: empty predicate DeviceWhereInput")
+// ErrEmptyAvatarWhereInput is returned in case the AvatarWhereInput is empty.
+var ErrEmptyAvatarWhereInput = errors.New("ent: empty predicate AvatarWhereInput")
 
-// P returns a predicate for filtering devices.
+// P returns a predicate for filtering avatars.
 // An error is returned if the input is empty or invalid.
-func (i *DeviceWhereInput) P() (predicate.Device, error) {
-	var predicates []predicate.Device
+func (i *AvatarWhereInput) P() (predicate.Avatar, error) {
+	var predicates []predicate.Avatar
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, device.Not(p))
+		predicates = append(predicates, avatar.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -135,7 +159,7 @@ func (i *DeviceWhereInput) P() (predicate.Device, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Device, 0, n)
+		or := make([]predicate.Avatar, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -143,7 +167,7 @@ func (i *DeviceWhereInput) P() (predicate.Device, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, device.Or(or...))
+		predicates = append(predicates, avatar.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -153,7 +177,7 @@ func (i *DeviceWhereInput) P() (predicate.Device, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Device, 0, n)
+		and := make([]predicate.Avatar, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -161,164 +185,209 @@ func (i *DeviceWhereInput) P() (predicate.Device, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, device.And(and...))
+		predicates = append(predicates, avatar.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, device.IDEQ(*i.ID))
+		predicates = append(predicates, avatar.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, device.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, avatar.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, device.IDIn(i.IDIn...))
+		predicates = append(predicates, avatar.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, device.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, avatar.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, device.IDGT(*i.IDGT))
+		predicates = append(predicates, avatar.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, device.IDGTE(*i.IDGTE))
+		predicates = append(predicates, avatar.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, device.IDLT(*i.IDLT))
+		predicates = append(predicates, avatar.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, device.IDLTE(*i.IDLTE))
+		predicates = append(predicates, avatar.IDLTE(*i.IDLTE))
 	}
-	if i.DeviceID != nil {
-		predicates = append(predicates, device.DeviceIDEQ(*i.DeviceID))
+	if i.FileID != nil {
+		predicates = append(predicates, avatar.FileIDEQ(*i.FileID))
 	}
-	if i.DeviceIDNEQ != nil {
-		predicates = append(predicates, device.DeviceIDNEQ(*i.DeviceIDNEQ))
+	if i.FileIDNEQ != nil {
+		predicates = append(predicates, avatar.FileIDNEQ(*i.FileIDNEQ))
 	}
-	if len(i.DeviceIDIn) > 0 {
-		predicates = append(predicates, device.DeviceIDIn(i.DeviceIDIn...))
+	if len(i.FileIDIn) > 0 {
+		predicates = append(predicates, avatar.FileIDIn(i.FileIDIn...))
 	}
-	if len(i.DeviceIDNotIn) > 0 {
-		predicates = append(predicates, device.DeviceIDNotIn(i.DeviceIDNotIn...))
+	if len(i.FileIDNotIn) > 0 {
+		predicates = append(predicates, avatar.FileIDNotIn(i.FileIDNotIn...))
 	}
-	if i.DeviceIDGT != nil {
-		predicates = append(predicates, device.DeviceIDGT(*i.DeviceIDGT))
+	if i.FileIDGT != nil {
+		predicates = append(predicates, avatar.FileIDGT(*i.FileIDGT))
 	}
-	if i.DeviceIDGTE != nil {
-		predicates = append(predicates, device.DeviceIDGTE(*i.DeviceIDGTE))
+	if i.FileIDGTE != nil {
+		predicates = append(predicates, avatar.FileIDGTE(*i.FileIDGTE))
 	}
-	if i.DeviceIDLT != nil {
-		predicates = append(predicates, device.DeviceIDLT(*i.DeviceIDLT))
+	if i.FileIDLT != nil {
+		predicates = append(predicates, avatar.FileIDLT(*i.FileIDLT))
 	}
-	if i.DeviceIDLTE != nil {
-		predicates = append(predicates, device.DeviceIDLTE(*i.DeviceIDLTE))
+	if i.FileIDLTE != nil {
+		predicates = append(predicates, avatar.FileIDLTE(*i.FileIDLTE))
 	}
-	if i.DeviceIDContains != nil {
-		predicates = append(predicates, device.DeviceIDContains(*i.DeviceIDContains))
+	if i.FileIDContains != nil {
+		predicates = append(predicates, avatar.FileIDContains(*i.FileIDContains))
 	}
-	if i.DeviceIDHasPrefix != nil {
-		predicates = append(predicates, device.DeviceIDHasPrefix(*i.DeviceIDHasPrefix))
+	if i.FileIDHasPrefix != nil {
+		predicates = append(predicates, avatar.FileIDHasPrefix(*i.FileIDHasPrefix))
 	}
-	if i.DeviceIDHasSuffix != nil {
-		predicates = append(predicates, device.DeviceIDHasSuffix(*i.DeviceIDHasSuffix))
+	if i.FileIDHasSuffix != nil {
+		predicates = append(predicates, avatar.FileIDHasSuffix(*i.FileIDHasSuffix))
 	}
-	if i.DeviceIDEqualFold != nil {
-		predicates = append(predicates, device.DeviceIDEqualFold(*i.DeviceIDEqualFold))
+	if i.FileIDEqualFold != nil {
+		predicates = append(predicates, avatar.FileIDEqualFold(*i.FileIDEqualFold))
 	}
-	if i.DeviceIDContainsFold != nil {
-		predicates = append(predicates, device.DeviceIDContainsFold(*i.DeviceIDContainsFold))
+	if i.FileIDContainsFold != nil {
+		predicates = append(predicates, avatar.FileIDContainsFold(*i.FileIDContainsFold))
+	}
+	if i.UserID != nil {
+		predicates = append(predicates, avatar.UserIDEQ(*i.UserID))
+	}
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, avatar.UserIDNEQ(*i.UserIDNEQ))
+	}
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, avatar.UserIDIn(i.UserIDIn...))
+	}
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, avatar.UserIDNotIn(i.UserIDNotIn...))
+	}
+	if i.UserIDGT != nil {
+		predicates = append(predicates, avatar.UserIDGT(*i.UserIDGT))
+	}
+	if i.UserIDGTE != nil {
+		predicates = append(predicates, avatar.UserIDGTE(*i.UserIDGTE))
+	}
+	if i.UserIDLT != nil {
+		predicates = append(predicates, avatar.UserIDLT(*i.UserIDLT))
+	}
+	if i.UserIDLTE != nil {
+		predicates = append(predicates, avatar.UserIDLTE(*i.UserIDLTE))
+	}
+	if i.UserIDContains != nil {
+		predicates = append(predicates, avatar.UserIDContains(*i.UserIDContains))
+	}
+	if i.UserIDHasPrefix != nil {
+		predicates = append(predicates, avatar.UserIDHasPrefix(*i.UserIDHasPrefix))
+	}
+	if i.UserIDHasSuffix != nil {
+		predicates = append(predicates, avatar.UserIDHasSuffix(*i.UserIDHasSuffix))
+	}
+	if i.UserIDIsNil {
+		predicates = append(predicates, avatar.UserIDIsNil())
+	}
+	if i.UserIDNotNil {
+		predicates = append(predicates, avatar.UserIDNotNil())
+	}
+	if i.UserIDEqualFold != nil {
+		predicates = append(predicates, avatar.UserIDEqualFold(*i.UserIDEqualFold))
+	}
+	if i.UserIDContainsFold != nil {
+		predicates = append(predicates, avatar.UserIDContainsFold(*i.UserIDContainsFold))
+	}
+	if i.RoomID != nil {
+		predicates = append(predicates, avatar.RoomIDEQ(*i.RoomID))
+	}
+	if i.RoomIDNEQ != nil {
+		predicates = append(predicates, avatar.RoomIDNEQ(*i.RoomIDNEQ))
 	}
-	if i.FcmToken != nil {
-		predicates = append(predicates, device.FcmTokenEQ(*i.FcmToken))
+	if len(i.RoomIDIn) > 0 {
+		predicates = append(predicates, avatar.RoomIDIn(i.RoomIDIn...))
 	}
-	if i.FcmTokenNEQ != nil {
-		predicates = append(predicates, device.FcmTokenNEQ(*i.FcmTokenNEQ))
+	if len(i.RoomIDNotIn) > 0 {
+		predicates = append(predicates, avatar.RoomIDNotIn(i.RoomIDNotIn...))
 	}
-	if len(i.FcmTokenIn) > 0 {
-		predicates = append(predicates, device.FcmTokenIn(i.FcmTokenIn...))
+	if i.RoomIDGT != nil {
+		predicates = append(predicates, avatar.RoomIDGT(*i.RoomIDGT))
 	}
-	if len(i.FcmTokenNotIn) > 0 {
-		predicates = append(predicates, device.FcmTokenNotIn(i.FcmTokenNotIn...))
+	if i.RoomIDGTE != nil {
+		predicates = append(predicates, avatar.RoomIDGTE(*i.RoomIDGTE))
 	}
-	if i.FcmTokenGT != nil {
-		predicates = append(predicates, device.FcmTokenGT(*i.FcmTokenGT))
+	if i.RoomIDLT != nil {
+		predicates = append(predicates, avatar.RoomIDLT(*i.RoomIDLT))
 	}
-	if i.FcmTokenGTE != nil {
-		predicates = append(predicates, device.FcmTokenGTE(*i.FcmTokenGTE))
+	if i.RoomIDLTE != nil {
+		predicates = append(predicates, avatar.RoomIDLTE(*i.RoomIDLTE))
 	}
-	if i.FcmTokenLT != nil {
-		predicates = append(predicates, device.FcmTokenLT(*i.FcmTokenLT))
+	if i.RoomIDContains != nil {
+		predicates = append(predicates, avatar.RoomIDContains(*i.RoomIDContains))
 	}
-	if i.FcmTokenLTE != nil {
-		predicates = append(predicates, device.FcmTokenLTE(*i.FcmTokenLTE))
+	if i.RoomIDHasPrefix != nil {
+		predicates = append(predicates, avatar.RoomIDHasPrefix(*i.RoomIDHasPrefix))
 	}
-	if i.FcmTokenContains != nil {
-		predicates = append(predicates, device.FcmTokenContains(*i.FcmTokenContains))
+	if i.RoomIDHasSuffix != nil {
+		predicates = append(predicates, avatar.RoomIDHasSuffix(*i.RoomIDHasSuffix))
 	}
-	if i.FcmTokenHasPrefix != nil {
-		predicates = append(predicates, device.FcmTokenHasPrefix(*i.FcmTokenHasPrefix))
+	if i.RoomIDIsNil {
+		predicates = append(predicates, avatar.RoomIDIsNil())
 	}
-	if i.FcmTokenHasSuffix != nil {
-		predicates = append(predicates, device.FcmTokenHasSuffix(*i.FcmTokenHasSuffix))
+	if i.RoomIDNotNil {
+		predicates = append(predicates, avatar.RoomIDNotNil())
 	}
-	if i.FcmTokenEqualFold != nil {
-		predicates = append(predicates, device.FcmTokenEqualFold(*i.FcmTokenEqualFold))
+	if i.RoomIDEqualFold != nil {
+		predicates = append(predicates, avatar.RoomIDEqualFold(*i.RoomIDEqualFold))
 	}
-	if i.FcmTokenContainsFold != nil {
-		predicates = append(predicates, device.FcmTokenContainsFold(*i.FcmTokenContainsFold))
+	if i.RoomIDContainsFold != nil {
+		predicates = append(predicates, avatar.RoomIDContainsFold(*i.RoomIDContainsFold))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, device.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, avatar.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, device.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, avatar.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, device.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, avatar.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, device.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, avatar.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, device.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, avatar.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, device.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, avatar.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, device.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, avatar.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, device.CreatedAtLTE(*i.CreatedAtLTE))
-	}
-	if i.UpdatedAt != nil {
-		predicates = append(predicates, device.UpdatedAtEQ(*i.UpdatedAt))
-	}
-	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, device.UpdatedAtNEQ(*i.UpdatedAtNEQ))
-	}
-	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, device.UpdatedAtIn(i.UpdatedAtIn...))
-	}
-	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, device.UpdatedAtNotIn(i.UpdatedAtNotIn...))
-	}
-	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, device.UpdatedAtGT(*i.UpdatedAtGT))
-	}
-	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, device.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, avatar.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, device.UpdatedAtLT(*i.UpdatedAtLT))
+
+	if i.HasFile != nil {
+		p := avatar.HasFile()
+		if !*i.HasFile {
+			p = avatar.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, device.UpdatedAtLTE(*i.UpdatedAtLTE))
+	if len(i.HasFileWith) > 0 {
+		with := make([]predicate.File, 0, len(i.HasFileWith))
+		for _, w := range i.HasFileWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasFileWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, avatar.HasFileWith(with...))
 	}
-
 	if i.HasUser != nil {
-		p := device.HasUser()
+		p := avatar.HasUser()
 		if !*i.HasUser {
-			p = device.Not(p)
+			p = avatar.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
@@ -331,24 +400,42 @@ func (i *DeviceWhereInput) P() (predicate.Device, error) {
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, device.HasUserWith(with...))
+		predicates = append(predicates, avatar.HasUserWith(with...))
+	}
+	if i.HasRoom != nil {
+		p := avatar.HasRoom()
+		if !*i.HasRoom {
+			p = avatar.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasRoomWith) > 0 {
+		with := make([]predicate.Room, 0, len(i.HasRoomWith))
+		for _, w := range i.HasRoomWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasRoomWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, avatar.HasRoomWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyDeviceWhereInput
+		return nil, ErrEmptyAvatarWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return device.And(predicates...), nil
+		return avatar.And(predicates...), nil
 	}
 }
 
-// FileWhereInput represents a where input for filtering File queries.
-type FileWhereInput struct {
-	Predicates []predicate.File  `json:"-"`
-	Not        *FileWhereInput   `json:"not,omitempty"`
-	Or         []*FileWhereInput `json:"or,omitempty"`
-	And        []*FileWhereInput `json:"and,omitempty"`
+// CallWhereInput represents a where input for filtering Call queries.
+type CallWhereInput struct {
+	Predicates []predicate.Call  `json:"-"`
+	Not        *CallWhereInput   `json:"not,omitempty"`
+	Or         []*CallWhereInput `json:"or,omitempty"`
+	And        []*CallWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *pulid.ID  `json:"id,omitempty"`
@@ -360,135 +447,118 @@ type FileWhereInput struct {
 	IDLT    *pulid.ID  `json:"idLT,omitempty"`
 	IDLTE   *pulid.ID  `json:"idLTE,omitempty"`
 
-	// "name" field predicates.
-	Name             *string  `json:"name,omitempty"`
-	NameNEQ          *string  `json:"nameNEQ,omitempty"`
-	NameIn           []string `json:"nameIn,omitempty"`
-	NameNotIn        []string `json:"nameNotIn,omitempty"`
-	NameGT           *string  `json:"nameGT,omitempty"`
-	NameGTE          *string  `json:"nameGTE,omitempty"`
-	NameLT           *string  `json:"nameLT,omitempty"`
-	NameLTE          *string  `json:"nameLTE,omitempty"`
-	NameContains     *string  `json:"nameContains,omitempty"`
-	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
-	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
-	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
-	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// "type" field predicates.
+	Type      *call.Type  `json:"type,omitempty"`
+	TypeNEQ   *call.Type  `json:"typeNEQ,omitempty"`
+	TypeIn    []call.Type `json:"typeIn,omitempty"`
+	TypeNotIn []call.Type `json:"typeNotIn,omitempty"`
 
-	// "content_type" field predicates.
-	ContentType             *string  `json:"contentType,omitempty"`
-	ContentTypeNEQ          *string  `json:"contentTypeNEQ,omitempty"`
-	ContentTypeIn           []string `json:"contentTypeIn,omitempty"`
-	ContentTypeNotIn        []string `json:"contentTypeNotIn,omitempty"`
-	ContentTypeGT           *string  `json:"contentTypeGT,omitempty"`
-	ContentTypeGTE          *string  `json:"contentTypeGTE,omitempty"`
-	ContentTypeLT           *string  `json:"contentTypeLT,omitempty"`
-	ContentTypeLTE          *string  `json:"contentTypeLTE,omitempty"`
-	ContentTypeContains     *string  `json:"contentTypeContains,omitempty"`
-	ContentTypeHasPrefix    *string  `json:"contentTypeHasPrefix,omitempty"`
-	ContentTypeHasSuffix    *string  `json:"contentTypeHasSuffix,omitempty"`
-	ContentTypeEqualFold    *string  `json:"contentTypeEqualFold,omitempty"`
-	ContentTypeContainsFold *string  `json:"contentTypeContainsFold,omitempty"`
-
-	// "size" field predicates.
-	Size      *uint64  `json:"size,omitempty"`
-	SizeNEQ   *uint64  `json:"sizeNEQ,omitempty"`
-	SizeIn    []uint64 `json:"sizeIn,omitempty"`
-	SizeNotIn []uint64 `json:"sizeNotIn,omitempty"`
-	SizeGT    *uint64  `json:"sizeGT,omitempty"`
-	SizeGTE   *uint64  `json:"sizeGTE,omitempty"`
-	SizeLT    *uint64  `json:"sizeLT,omitempty"`
-	SizeLTE   *uint64  `json:"sizeLTE,omitempty"`
-
-	// "location" field predicates.
-	Location             *string  `json:"location,omitempty"`
-	LocationNEQ          *string  `json:"locationNEQ,omitempty"`
-	LocationIn           []string `json:"locationIn,omitempty"`
-	LocationNotIn        []string `json:"locationNotIn,omitempty"`
-	LocationGT           *string  `json:"locationGT,omitempty"`
-	LocationGTE          *string  `json:"locationGTE,omitempty"`
-	LocationLT           *string  `json:"locationLT,omitempty"`
-	LocationLTE          *string  `json:"locationLTE,omitempty"`
-	LocationContains     *string  `json:"locationContains,omitempty"`
-	LocationHasPrefix    *string  `json:"locationHasPrefix,omitempty"`
-	LocationHasSuffix    *string  `json:"locationHasSuffix,omitempty"`
-	LocationIsNil        bool     `json:"locationIsNil,omitempty"`
-	LocationNotNil       bool     `json:"locationNotNil,omitempty"`
-	LocationEqualFold    *string  `json:"locationEqualFold,omitempty"`
-	LocationContainsFold *string  `json:"locationContainsFold,omitempty"`
-
-	// "bucket" field predicates.
-	Bucket             *string  `json:"bucket,omitempty"`
-	BucketNEQ          *string  `json:"bucketNEQ,omitempty"`
-	BucketIn           []string `json:"bucketIn,omitempty"`
-	BucketNotIn        []string `json:"bucketNotIn,omitempty"`
-	BucketGT           *string  `json:"bucketGT,omitempty"`
-	BucketGTE          *string  `json:"bucketGTE,omitempty"`
-	BucketLT           *string  `json:"bucketLT,omitempty"`
-	BucketLTE          *string  `json:"bucketLTE,omitempty"`
-	BucketContains     *string  `json:"bucketContains,omitempty"`
-	BucketHasPrefix    *string  `json:"bucketHasPrefix,omitempty"`
-	BucketHasSuffix    *string  `json:"bucketHasSuffix,omitempty"`
-	BucketEqualFold    *string  `json:"bucketEqualFold,omitempty"`
-	BucketContainsFold *string  `json:"bucketContainsFold,omitempty"`
+	// "state" field predicates.
+	State      *call.State  `json:"state,omitempty"`
+	StateNEQ   *call.State  `json:"stateNEQ,omitempty"`
+	StateIn    []call.State `json:"stateIn,omitempty"`
+	StateNotIn []call.State `json:"stateNotIn,omitempty"`
 
-	// "path" field predicates.
-	Path             *string  `json:"path,omitempty"`
-	PathNEQ          *string  `json:"pathNEQ,omitempty"`
-	PathIn           []string `json:"pathIn,omitempty"`
-	PathNotIn        []string `json:"pathNotIn,omitempty"`
-	PathGT           *string  `json:"pathGT,omitempty"`
-	PathGTE          *string  `json:"pathGTE,omitempty"`
-	PathLT           *string  `json:"pathLT,omitempty"`
-	PathLTE          *string  `json:"pathLTE,omitempty"`
-	PathContains     *string  `json:"pathContains,omitempty"`
-	PathHasPrefix    *string  `json:"pathHasPrefix,omitempty"`
-	PathHasSuffix    *string  `json:"pathHasSuffix,omitempty"`
-	PathEqualFold    *string  `json:"pathEqualFold,omitempty"`
-	PathContainsFold *string  `json:"pathContainsFold,omitempty"`
+	// "room_id" field predicates.
+	RoomID             *pulid.ID  `json:"roomID,omitempty"`
+	RoomIDNEQ          *pulid.ID  `json:"roomIDNEQ,omitempty"`
+	RoomIDIn           []pulid.ID `json:"roomIDIn,omitempty"`
+	RoomIDNotIn        []pulid.ID `json:"roomIDNotIn,omitempty"`
+	RoomIDGT           *pulid.ID  `json:"roomIDGT,omitempty"`
+	RoomIDGTE          *pulid.ID  `json:"roomIDGTE,omitempty"`
+	RoomIDLT           *pulid.ID  `json:"roomIDLT,omitempty"`
+	RoomIDLTE          *pulid.ID  `json:"roomIDLTE,omitempty"`
+	RoomIDContains     *pulid.ID  `json:"roomIDContains,omitempty"`
+	RoomIDHasPrefix    *pulid.ID  `json:"roomIDHasPrefix,omitempty"`
+	RoomIDHasSuffix    *pulid.ID  `json:"roomIDHasSuffix,omitempty"`
+	RoomIDEqualFold    *pulid.ID  `json:"roomIDEqualFold,omitempty"`
+	RoomIDContainsFold *pulid.ID  `json:"roomIDContainsFold,omitempty"`
 
-	// "created_at" field predicates.
-	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
-	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
-	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
-	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
-	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
-	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
-	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
-	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+	// "caller_id" field predicates.
+	CallerID             *pulid.ID  `json:"callerID,omitempty"`
+	CallerIDNEQ          *pulid.ID  `json:"callerIDNEQ,omitempty"`
+	CallerIDIn           []pulid.ID `json:"callerIDIn,omitempty"`
+	CallerIDNotIn        []pulid.ID `json:"callerIDNotIn,omitempty"`
+	CallerIDGT           *pulid.ID  `json:"callerIDGT,omitempty"`
+	CallerIDGTE          *pulid.ID  `json:"callerIDGTE,omitempty"`
+	CallerIDLT           *pulid.ID  `json:"callerIDLT,omitempty"`
+	CallerIDLTE          *pulid.ID  `json:"callerIDLTE,omitempty"`
+	CallerIDContains     *pulid.ID  `json:"callerIDContains,omitempty"`
+	CallerIDHasPrefix    *pulid.ID  `json:"callerIDHasPrefix,omitempty"`
+	CallerIDHasSuffix    *pulid.ID  `json:"callerIDHasSuffix,omitempty"`
+	CallerIDEqualFold    *pulid.ID  `json:"callerIDEqualFold,omitempty"`
+	CallerIDContainsFold *pulid.ID  `json:"callerIDContainsFold,omitempty"`
+
+	// "started_at" field predicates.
+	StartedAt      *time.Time  `json:"startedAt,omitempty"`
+	StartedAtNEQ   *time.Time  `json:"startedAtNEQ,omitempty"`
+	StartedAtIn    []time.Time `json:"startedAtIn,omitempty"`
+	StartedAtNotIn []time.Time `json:"startedAtNotIn,omitempty"`
+	StartedAtGT    *time.Time  `json:"startedAtGT,omitempty"`
+	StartedAtGTE   *time.Time  `json:"startedAtGTE,omitempty"`
+	StartedAtLT    *time.Time  `json:"startedAtLT,omitempty"`
+	StartedAtLTE   *time.Time  `json:"startedAtLTE,omitempty"`
+
+	// "answered_at" field predicates.
+	AnsweredAt       *time.Time  `json:"answeredAt,omitempty"`
+	AnsweredAtNEQ    *time.Time  `json:"answeredAtNEQ,omitempty"`
+	AnsweredAtIn     []time.Time `json:"answeredAtIn,omitempty"`
+	AnsweredAtNotIn  []time.Time `json:"answeredAtNotIn,omitempty"`
+	AnsweredAtGT     *time.Time  `json:"answeredAtGT,omitempty"`
+	AnsweredAtGTE    *time.Time  `json:"answeredAtGTE,omitempty"`
+	AnsweredAtLT     *time.Time  `json:"answeredAtLT,omitempty"`
+	AnsweredAtLTE    *time.Time  `json:"answeredAtLTE,omitempty"`
+	AnsweredAtIsNil  bool        `json:"answeredAtIsNil,omitempty"`
+	AnsweredAtNotNil bool        `json:"answeredAtNotNil,omitempty"`
+
+	// "ended_at" field predicates.
+	EndedAt       *time.Time  `json:"endedAt,omitempty"`
+	EndedAtNEQ    *time.Time  `json:"endedAtNEQ,omitempty"`
+	EndedAtIn     []time.Time `json:"endedAtIn,omitempty"`
+	EndedAtNotIn  []time.Time `json:"endedAtNotIn,omitempty"`
+	EndedAtGT     *time.Time  `json:"endedAtGT,omitempty"`
+	EndedAtGTE    *time.Time  `json:"endedAtGTE,omitempty"`
+	EndedAtLT     *time.Time  `json:"endedAtLT,omitempty"`
+	EndedAtLTE    *time.Time  `json:"endedAtLTE,omitempty"`
+	EndedAtIsNil  bool        `json:"endedAtIsNil,omitempty"`
+	EndedAtNotNil bool        `json:"endedAtNotNil,omitempty"`
+
+	// "duration" field predicates.
+	Duration      *int  `json:"duration,omitempty"`
+	DurationNEQ   *int  `json:"durationNEQ,omitempty"`
+	DurationIn    []int `json:"durationIn,omitempty"`
+	DurationNotIn []int `json:"durationNotIn,omitempty"`
+	DurationGT    *int  `json:"durationGT,omitempty"`
+	DurationGTE   *int  `json:"durationGTE,omitempty"`
+	DurationLT    *int  `json:"durationLT,omitempty"`
+	DurationLTE   *int  `json:"durationLTE,omitempty"`
 
-	// "updated_at" field predicates.
-	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
-	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
-	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
-	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
-	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
-	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
-	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
-	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+	// "room" edge predicates.
+	HasRoom     *bool             `json:"hasRoom,omitempty"`
+	HasRoomWith []*RoomWhereInput `json:"hasRoomWith,omitempty"`
 
-	// "message_attachment" edge predicates.
-	HasMessageAttachment     *bool                          `json:"hasMessageAttachment,omitempty"`
-	HasMessageAttachmentWith []*MessageAttachmentWhereInput `json:"hasMessageAttachmentWith,omitempty"`
+	// "caller" edge predicates.
+	HasCaller     *bool             `json:"hasCaller,omitempty"`
+	HasCallerWith []*UserWhereInput `json:"hasCallerWith,omitempty"`
 
-	// "message_voice" edge predicates.
-	HasMessageVoice     *bool                     `json:"hasMessageVoice,omitempty"`
-	HasMessageVoiceWith []*MessageVoiceWhereInput `json:"hasMessageVoiceWith,omitempty"`
+	// "participants" edge predicates.
+	HasParticipants     *bool                        `json:"hasParticipants,omitempty"`
+	HasParticipantsWith []*CallParticipantWhereInput `json:"hasParticipantsWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *FileWhereInput) AddPredicates(predicates ...predicate.File) {
+func (i *CallWhereInput) AddPredicates(predicates ...predicate.Call) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the FileWhereInput filter on the FileQuery builder.
-func (i *FileWhereInput) Filter(q *FileQuery) (*FileQuery, error) {
+// Filter applies the CallWhereInput filter on the CallQuery builder.
+func (i *CallWhereInput) Filter(q *CallQuery) (*CallQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyFileWhereInput {
+		if err == ErrEmptyCallWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -496,19 +566,19 @@ func (i *FileWhereInput) Filter(q *FileQuery) (*FileQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptyFileWhereInput is returned in case the FileWhereInput is empty.
-var ErrEmptyFileWhereInput = errors.New("ent: empty predicate FileWhereInput")
+// ErrEmptyCallWhereInput is returned in case the CallWhereInput is empty.
+var ErrEmptyCallWhereInput = errors.New("ent: empty predicate CallWhereInput")
 
-// P returns a predicate for filtering files.
+// P returns a predicate for filtering calls.
 // An error is returned if the input is empty or invalid.
-func (i *FileWhereInput) P() (predicate.File, error) {
-	var predicates []predicate.File
+func (i *CallWhereInput) P() (predicate.Call, error) {
+	var predicates []predicate.Call
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, file.Not(p))
+		predicates = append(predicates, call.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -518,7 +588,7 @@ func (i *FileWhereInput) P() (predicate.File, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.File, 0, n)
+		or := make([]predicate.Call, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -526,7 +596,7 @@ func (i *FileWhereInput) P() (predicate.File, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, file.Or(or...))
+		predicates = append(predicates, call.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -536,7 +606,7 @@ func (i *FileWhereInput) P() (predicate.File, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.File, 0, n)
+		and := make([]predicate.Call, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -544,359 +614,314 @@ func (i *FileWhereInput) P() (predicate.File, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, file.And(and...))
+		predicates = append(predicates, call.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, file.IDEQ(*i.ID))
+		predicates = append(predicates, call.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, file.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, call.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, file.IDIn(i.IDIn...))
+		predicates = append(predicates, call.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, file.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, call.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, file.IDGT(*i.IDGT))
+		predicates = append(predicates, call.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, file.IDGTE(*i.IDGTE))
+		predicates = append(predicates, call.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, file.IDLT(*i.IDLT))
+		predicates = append(predicates, call.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, file.IDLTE(*i.IDLTE))
+		predicates = append(predicates, call.IDLTE(*i.IDLTE))
 	}
-	if i.Name != nil {
-		predicates = append(predicates, file.NameEQ(*i.Name))
+	if i.Type != nil {
+		predicates = append(predicates, call.TypeEQ(*i.Type))
 	}
-	if i.NameNEQ != nil {
-		predicates = append(predicates, file.NameNEQ(*i.NameNEQ))
+	if i.TypeNEQ != nil {
+		predicates = append(predicates, call.TypeNEQ(*i.TypeNEQ))
 	}
-	if len(i.NameIn) > 0 {
-		predicates = append(predicates, file.NameIn(i.NameIn...))
+	if len(i.TypeIn) > 0 {
+		predicates = append(predicates, call.TypeIn(i.TypeIn...))
 	}
-	if len(i.NameNotIn) > 0 {
-		predicates = append(predicates, file.NameNotIn(i.NameNotIn...))
+	if len(i.TypeNotIn) > 0 {
+		predicates = append(predicates, call.TypeNotIn(i.TypeNotIn...))
 	}
-	if i.NameGT != nil {
-		predicates = append(predicates, file.NameGT(*i.NameGT))
+	if i.State != nil {
+		predicates = append(predicates, call.StateEQ(*i.State))
 	}
-	if i.NameGTE != nil {
-		predicates = append(predicates, file.NameGTE(*i.NameGTE))
+	if i.StateNEQ != nil {
+		predicates = append(predicates, call.StateNEQ(*i.StateNEQ))
 	}
-	if i.NameLT != nil {
-		predicates = append(predicates, file.NameLT(*i.NameLT))
+	if len(i.StateIn) > 0 {
+		predicates = append(predicates, call.StateIn(i.StateIn...))
 	}
-	if i.NameLTE != nil {
-		predicates = append(predicates, file.NameLTE(*i.NameLTE))
+	if len(i.StateNotIn) > 0 {
+		predicates = append(predicates, call.StateNotIn(i.StateNotIn...))
 	}
-	if i.NameContains != nil {
-		predicates = append(predicates, file.NameContains(*i.NameContains))
+	if i.RoomID != nil {
+		predicates = append(predicates, call.RoomIDEQ(*i.RoomID))
 	}
-	if i.NameHasPrefix != nil {
-		predicates = append(predicates, file.NameHasPrefix(*i.NameHasPrefix))
+	if i.RoomIDNEQ != nil {
+		predicates = append(predicates, call.RoomIDNEQ(*i.RoomIDNEQ))
 	}
-	if i.NameHasSuffix != nil {
-		predicates = append(predicates, file.NameHasSuffix(*i.NameHasSuffix))
+	if len(i.RoomIDIn) > 0 {
+		predicates = append(predicates, call.RoomIDIn(i.RoomIDIn...))
 	}
-	if i.NameEqualFold != nil {
-		predicates = append(predicates, file.NameEqualFold(*i.NameEqualFold))
+	if len(i.RoomIDNotIn) > 0 {
+		predicates = append(predicates, call.RoomIDNotIn(i.RoomIDNotIn...))
 	}
-	if i.NameContainsFold != nil {
-		predicates = append(predicates, file.NameContainsFold(*i.NameContainsFold))
+	if i.RoomIDGT != nil {
+		predicates = append(predicates, call.RoomIDGT(*i.RoomIDGT))
 	}
-	if i.ContentType != nil {
-		predicates = append(predicates, file.ContentTypeEQ(*i.ContentType))
+	if i.RoomIDGTE != nil {
+		predicates = append(predicates, call.RoomIDGTE(*i.RoomIDGTE))
 	}
-	if i.ContentTypeNEQ != nil {
-		predicates = append(predicates, file.ContentTypeNEQ(*i.ContentTypeNEQ))
+	if i.RoomIDLT != nil {
+		predicates = append(predicates, call.RoomIDLT(*i.RoomIDLT))
 	}
-	if len(i.ContentTypeIn) > 0 {
-		predicates = append(predicates, file.ContentTypeIn(i.ContentTypeIn...))
+	if i.RoomIDLTE != nil {
+		predicates = append(predicates, call.RoomIDLTE(*i.RoomIDLTE))
 	}
-	if len(i.ContentTypeNotIn) > 0 {
-		predicates = append(predicates, file.ContentTypeNotIn(i.ContentTypeNotIn...))
+	if i.RoomIDContains != nil {
+		predicates = append(predicates, call.RoomIDContains(*i.RoomIDContains))
 	}
-	if i.ContentTypeGT != nil {
-		predicates = append(predicates, file.ContentTypeGT(*i.ContentTypeGT))
+	if i.RoomIDHasPrefix != nil {
+		predicates = append(predicates, call.RoomIDHasPrefix(*i.RoomIDHasPrefix))
 	}
-	if i.ContentTypeGTE != nil {
-		predicates = append(predicates, file.ContentTypeGTE(*i.ContentTypeGTE))
+	if i.RoomIDHasSuffix != nil {
+		predicates = append(predicates, call.RoomIDHasSuffix(*i.RoomIDHasSuffix))
 	}
-	if i.ContentTypeLT != nil {
-		predicates = append(predicates, file.ContentTypeLT(*i.ContentTypeLT))
+	if i.RoomIDEqualFold != nil {
+		predicates = append(predicates, call.RoomIDEqualFold(*i.RoomIDEqualFold))
 	}
-	if i.ContentTypeLTE != nil {
-		predicates = append(predicates, file.ContentTypeLTE(*i.ContentTypeLTE))
+	if i.RoomIDContainsFold != nil {
+		predicates = append(predicates, call.RoomIDContainsFold(*i.RoomIDContainsFold))
 	}
-	if i.ContentTypeContains != nil {
-		predicates = append(predicates, file.ContentTypeContains(*i.ContentTypeContains))
+	if i.CallerID != nil {
+		predicates = append(predicates, call.CallerIDEQ(*i.CallerID))
 	}
-	if i.ContentTypeHasPrefix != nil {
-		predicates = append(predicates, file.ContentTypeHasPrefix(*i.ContentTypeHasPrefix))
+	if i.CallerIDNEQ != nil {
+		predicates = append(predicates, call.CallerIDNEQ(*i.CallerIDNEQ))
 	}
-	if i.ContentTypeHasSuffix != nil {
-		predicates = append(predicates, file.ContentTypeHasSuffix(*i.ContentTypeHasSuffix))
+	if len(i.CallerIDIn) > 0 {
+		predicates = append(predicates, call.CallerIDIn(i.CallerIDIn...))
 	}
-	if i.ContentTypeEqualFold != nil {
-		predicates = append(predicates, file.ContentTypeEqualFold(*i.ContentTypeEqualFold))
+	if len(i.CallerIDNotIn) > 0 {
+		predicates = append(predicates, call.CallerIDNotIn(i.CallerIDNotIn...))
 	}
-	if i.ContentTypeContainsFold != nil {
-		predicates = append(predicates, file.ContentTypeContainsFold(*i.ContentTypeContainsFold))
+	if i.CallerIDGT != nil {
+		predicates = append(predicates, call.CallerIDGT(*i.CallerIDGT))
 	}
-	if i.Size != nil {
-		predicates = append(predicates, file.SizeEQ(*i.Size))
+	if i.CallerIDGTE != nil {
+		predicates = append(predicates, call.CallerIDGTE(*i.CallerIDGTE))
 	}
-	if i.SizeNEQ != nil {
-		predicates = append(predicates, file.SizeNEQ(*i.SizeNEQ))
+	if i.CallerIDLT != nil {
+		predicates = append(predicates, call.CallerIDLT(*i.CallerIDLT))
 	}
-	if len(i.SizeIn) > 0 {
-		predicates = append(predicates, file.SizeIn(i.SizeIn...))
+	if i.CallerIDLTE != nil {
+		predicates = append(predicates, call.CallerIDLTE(*i.CallerIDLTE))
 	}
-	if len(i.SizeNotIn) > 0 {
-		predicates = append(predicates, file.SizeNotIn(i.SizeNotIn...))
+	if i.CallerIDContains != nil {
+		predicates = append(predicates, call.CallerIDContains(*i.CallerIDContains))
 	}
-	if i.SizeGT != nil {
-		predicates = append(predicates, file.SizeGT(*i.SizeGT))
+	if i.CallerIDHasPrefix != nil {
+		predicates = append(predicates, call.CallerIDHasPrefix(*i.CallerIDHasPrefix))
 	}
-	if i.SizeGTE != nil {
-		predicates = append(predicates, file.SizeGTE(*i.SizeGTE))
+	if i.CallerIDHasSuffix != nil {
+		predicates = append(predicates, call.CallerIDHasSuffix(*i.CallerIDHasSuffix))
 	}
-	if i.SizeLT != nil {
-		predicates = append(predicates, file.SizeLT(*i.SizeLT))
+	if i.CallerIDEqualFold != nil {
+		predicates = append(predicates, call.CallerIDEqualFold(*i.CallerIDEqualFold))
 	}
-	if i.SizeLTE != nil {
-		predicates = append(predicates, file.SizeLTE(*i.SizeLTE))
+	if i.CallerIDContainsFold != nil {
+		predicates = append(predicates, call.CallerIDContainsFold(*i.CallerIDContainsFold))
 	}
-	if i.Location != nil {
-		predicates = append(predicates, file.LocationEQ(*i.Location))
+	if i.StartedAt != nil {
+		predicates = append(predicates, call.StartedAtEQ(*i.StartedAt))
 	}
-	if i.LocationNEQ != nil {
-		predicates = append(predicates, file.LocationNEQ(*i.LocationNEQ))
+	if i.StartedAtNEQ != nil {
+		predicates = append(predicates, call.StartedAtNEQ(*i.StartedAtNEQ))
 	}
-	if len(i.LocationIn) > 0 {
-		predicates = append(predicates, file.LocationIn(i.LocationIn...))
+	if len(i.StartedAtIn) > 0 {
+		predicates = append(predicates, call.StartedAtIn(i.StartedAtIn...))
 	}
-	if len(i.LocationNotIn) > 0 {
-		predicates = append(predicates, file.LocationNotIn(i.LocationNotIn...))
+	if len(i.StartedAtNotIn) > 0 {
+		predicates = append(predicates, call.StartedAtNotIn(i.StartedAtNotIn...))
 	}
-	if i.LocationGT != nil {
-		predicates = append(predicates, file.LocationGT(*i.LocationGT))
+	if i.StartedAtGT != nil {
+		predicates = append(predicates, call.StartedAtGT(*i.StartedAtGT))
 	}
-	if i.LocationGTE != nil {
-		predicates = append(predicates, file.LocationGTE(*i.LocationGTE))
+	if i.StartedAtGTE != nil {
+		predicates = append(predicates, call.StartedAtGTE(*i.StartedAtGTE))
 	}
-	if i.LocationLT != nil {
-		predicates = append(predicates, file.LocationLT(*i.LocationLT))
+	if i.StartedAtLT != nil {
+		predicates = append(predicates, call.StartedAtLT(*i.StartedAtLT))
 	}
-	if i.LocationLTE != nil {
-		predicates = append(predicates, file.LocationLTE(*i.LocationLTE))
+	if i.StartedAtLTE != nil {
+		predicates = append(predicates, call.StartedAtLTE(*i.StartedAtLTE))
 	}
-	if i.LocationContains != nil {
-		predicates = append(predicates, file.LocationContains(*i.LocationContains))
+	if i.AnsweredAt != nil {
+		predicates = append(predicates, call.AnsweredAtEQ(*i.AnsweredAt))
 	}
-	if i.LocationHasPrefix != nil {
-		predicates = append(predicates, file.LocationHasPrefix(*i.LocationHasPrefix))
+	if i.AnsweredAtNEQ != nil {
+		predicates = append(predicates, call.AnsweredAtNEQ(*i.AnsweredAtNEQ))
 	}
-	if i.LocationHasSuffix != nil {
-		predicates = append(predicates, file.LocationHasSuffix(*i.LocationHasSuffix))
+	if len(i.AnsweredAtIn) > 0 {
+		predicates = append(predicates, call.AnsweredAtIn(i.AnsweredAtIn...))
 	}
-	if i.LocationIsNil {
-		predicates = append(predicates, file.LocationIsNil())
+	if len(i.AnsweredAtNotIn) > 0 {
+		predicates = append(predicates, call.AnsweredAtNotIn(i.AnsweredAtNotIn...))
 	}
-	if i.LocationNotNil {
-		predicates = append(predicates, file.LocationNotNil())
+	if i.AnsweredAtGT != nil {
+		predicates = append(predicates, call.AnsweredAtGT(*i.AnsweredAtGT))
 	}
-	if i.LocationEqualFold != nil {
-		predicates = append(predicates, file.LocationEqualFold(*i.LocationEqualFold))
+	if i.AnsweredAtGTE != nil {
+		predicates = append(predicates, call.AnsweredAtGTE(*i.AnsweredAtGTE))
 	}
-	if i.LocationContainsFold != nil {
-		predicates = append(predicates, file.LocationContainsFold(*i.LocationContainsFold))
+	if i.AnsweredAtLT != nil {
+		predicates = append(predicates, call.AnsweredAtLT(*i.AnsweredAtLT))
 	}
-	if i.Bucket != nil {
-		predicates = append(predicates, file.BucketEQ(*i.Bucket))
+	if i.AnsweredAtLTE != nil {
+		predicates = append(predicates, call.AnsweredAtLTE(*i.AnsweredAtLTE))
 	}
-	if i.BucketNEQ != nil {
-		predicates = append(predicates, file.BucketNEQ(*i.BucketNEQ))
+	if i.AnsweredAtIsNil {
+		predicates = append(predicates, call.AnsweredAtIsNil())
 	}
-	if len(i.BucketIn) > 0 {
-		predicates = append(predicates, file.BucketIn(i.BucketIn...))
+	if i.AnsweredAtNotNil {
+		predicates = append(predicates, call.AnsweredAtNotNil())
 	}
-	if len(i.BucketNotIn) > 0 {
-		predicates = append(predicates, file.BucketNotIn(i.BucketNotIn...))
+	if i.EndedAt != nil {
+		predicates = append(predicates, call.EndedAtEQ(*i.EndedAt))
 	}
-	if i.BucketGT != nil {
-		predicates = append(predicates, file.BucketGT(*i.BucketGT))
+	if i.EndedAtNEQ != nil {
+		predicates = append(predicates, call.EndedAtNEQ(*i.EndedAtNEQ))
 	}
-	if i.BucketGTE != nil {
-		predicates = append(predicates, file.BucketGTE(*i.BucketGTE))
+	if len(i.EndedAtIn) > 0 {
+		predicates = append(predicates, call.EndedAtIn(i.EndedAtIn...))
 	}
-	if i.BucketLT != nil {
-		predicates = append(predicates, file.BucketLT(*i.BucketLT))
+	if len(i.EndedAtNotIn) > 0 {
+		predicates = append(predicates, call.EndedAtNotIn(i.EndedAtNotIn...))
 	}
-	if i.BucketLTE != nil {
-		predicates = append(predicates, file.BucketLTE(*i.BucketLTE))
+	if i.EndedAtGT != nil {
+		predicates = append(predicates, call.EndedAtGT(*i.EndedAtGT))
 	}
-	if i.BucketContains != nil {
-		predicates = append(predicates, file.BucketContains(*i.BucketContains))
+	if i.EndedAtGTE != nil {
+		predicates = append(predicates, call.EndedAtGTE(*i.EndedAtGTE))
 	}
-	if i.BucketHasPrefix != nil {
-		predicates = append(predicates, file.BucketHasPrefix(*i.BucketHasPrefix))
-	}
-	if i.BucketHasSuffix != nil {
-		predicates = append(predicates, file.BucketHasSuffix(*i.BucketHasSuffix))
-	}
-	if i.BucketEqualFold != nil {
-		predicates = append(predicates, file.BucketEqualFold(*i.BucketEqualFold))
-	}
-	if i.BucketContainsFold != nil {
-		predicates = append(predicates, file.BucketContainsFold(*i.BucketContainsFold))
-	}
-	if i.Path != nil {
-		predicates = append(predicates, file.PathEQ(*i.Path))
-	}
-	if i.PathNEQ != nil {
-		predicates = append(predicates, file.PathNEQ(*i.PathNEQ))
-	}
-	if len(i.PathIn) > 0 {
-		predicates = append(predicates, file.PathIn(i.PathIn...))
-	}
-	if len(i.PathNotIn) > 0 {
-		predicates = append(predicates, file.PathNotIn(i.PathNotIn...))
-	}
-	if i.PathGT != nil {
-		predicates = append(predicates, file.PathGT(*i.PathGT))
-	}
-	if i.PathGTE != nil {
-		predicates = append(predicates, file.PathGTE(*i.PathGTE))
-	}
-	if i.PathLT != nil {
-		predicates = append(predicates, file.PathLT(*i.PathLT))
+	if i.EndedAtLT != nil {
+		predicates = append(predicates, call.EndedAtLT(*i.EndedAtLT))
 	}
-	if i.PathLTE != nil {
-		predicates = append(predicates, file.PathLTE(*i.PathLTE))
-	}
-	if i.PathContains != nil {
-		predicates = append(predicates, file.PathContains(*i.PathContains))
-	}
-	if i.PathHasPrefix != nil {
-		predicates = append(predicates, file.PathHasPrefix(*i.PathHasPrefix))
-	}
-	if i.PathHasSuffix != nil {
-		predicates = append(predicates, file.PathHasSuffix(*i.PathHasSuffix))
-	}
-	if i.PathEqualFold != nil {
-		predicates = append(predicates, file.PathEqualFold(*i.PathEqualFold))
-	}
-	if i.PathContainsFold != nil {
-		predicates = append(predicates, file.PathContainsFold(*i.PathContainsFold))
-	}
-	if i.CreatedAt != nil {
-		predicates = append(predicates, file.CreatedAtEQ(*i.CreatedAt))
-	}
-	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, file.CreatedAtNEQ(*i.CreatedAtNEQ))
-	}
-	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, file.CreatedAtIn(i.CreatedAtIn...))
-	}
-	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, file.CreatedAtNotIn(i.CreatedAtNotIn...))
+	if i.EndedAtLTE != nil {
+		predicates = append(predicates, call.EndedAtLTE(*i.EndedAtLTE))
 	}
-	if i.CreatedAtGT != nil {
-		predicates = append(predicates, file.CreatedAtGT(*i.CreatedAtGT))
+	if i.EndedAtIsNil {
+		predicates = append(predicates, call.EndedAtIsNil())
 	}
-	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, file.CreatedAtGTE(*i.CreatedAtGTE))
+	if i.EndedAtNotNil {
+		predicates = append(predicates, call.EndedAtNotNil())
 	}
-	if i.CreatedAtLT != nil {
-		predicates = append(predicates, file.CreatedAtLT(*i.CreatedAtLT))
+	if i.Duration != nil {
+		predicates = append(predicates, call.DurationEQ(*i.Duration))
 	}
-	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, file.CreatedAtLTE(*i.CreatedAtLTE))
+	if i.DurationNEQ != nil {
+		predicates = append(predicates, call.DurationNEQ(*i.DurationNEQ))
 	}
-	if i.UpdatedAt != nil {
-		predicates = append(predicates, file.UpdatedAtEQ(*i.UpdatedAt))
+	if len(i.DurationIn) > 0 {
+		predicates = append(predicates, call.DurationIn(i.DurationIn...))
 	}
-	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, file.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	if len(i.DurationNotIn) > 0 {
+		predicates = append(predicates, call.DurationNotIn(i.DurationNotIn...))
 	}
-	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, file.UpdatedAtIn(i.UpdatedAtIn...))
+	if i.DurationGT != nil {
+		predicates = append(predicates, call.DurationGT(*i.DurationGT))
 	}
-	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, file.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	if i.DurationGTE != nil {
+		predicates = append(predicates, call.DurationGTE(*i.DurationGTE))
 	}
-	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, file.UpdatedAtGT(*i.UpdatedAtGT))
+	if i.DurationLT != nil {
+		predicates = append(predicates, call.DurationLT(*i.DurationLT))
 	}
-	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, file.UpdatedAtGTE(*i.UpdatedAtGTE))
+	if i.DurationLTE != nil {
+		predicates = append(predicates, call.DurationLTE(*i.DurationLTE))
 	}
-	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, file.UpdatedAtLT(*i.UpdatedAtLT))
+
+	if i.HasRoom != nil {
+		p := call.HasRoom()
+		if !*i.HasRoom {
+			p = call.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, file.UpdatedAtLTE(*i.UpdatedAtLTE))
+	if len(i.HasRoomWith) > 0 {
+		with := make([]predicate.Room, 0, len(i.HasRoomWith))
+		for _, w := range i.HasRoomWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasRoomWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, call.HasRoomWith(with...))
 	}
-
-	if i.HasMessageAttachment != nil {
-		p := file.HasMessageAttachment()
-		if !*i.HasMessageAttachment {
-			p = file.Not(p)
+	if i.HasCaller != nil {
+		p := call.HasCaller()
+		if !*i.HasCaller {
+			p = call.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasMessageAttachmentWith) > 0 {
-		with := make([]predicate.MessageAttachment, 0, len(i.HasMessageAttachmentWith))
-		for _, w := range i.HasMessageAttachmentWith {
+	if len(i.HasCallerWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasCallerWith))
+		for _, w := range i.HasCallerWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasMessageAttachmentWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasCallerWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, file.HasMessageAttachmentWith(with...))
+		predicates = append(predicates, call.HasCallerWith(with...))
 	}
-	if i.HasMessageVoice != nil {
-		p := file.HasMessageVoice()
-		if !*i.HasMessageVoice {
-			p = file.Not(p)
+	if i.HasParticipants != nil {
+		p := call.HasParticipants()
+		if !*i.HasParticipants {
+			p = call.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasMessageVoiceWith) > 0 {
-		with := make([]predicate.MessageVoice, 0, len(i.HasMessageVoiceWith))
-		for _, w := range i.HasMessageVoiceWith {
+	if len(i.HasParticipantsWith) > 0 {
+		with := make([]predicate.CallParticipant, 0, len(i.HasParticipantsWith))
+		for _, w := range i.HasParticipantsWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasMessageVoiceWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasParticipantsWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, file.HasMessageVoiceWith(with...))
+		predicates = append(predicates, call.HasParticipantsWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyFileWhereInput
+		return nil, ErrEmptyCallWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return file.And(predicates...), nil
+		return call.And(predicates...), nil
 	}
 }
 
-// MessageWhereInput represents a where input for filtering Message queries.
-type MessageWhereInput struct {
-	Predicates []predicate.Message  `json:"-"`
-	Not        *MessageWhereInput   `json:"not,omitempty"`
-	Or         []*MessageWhereInput `json:"or,omitempty"`
-	And        []*MessageWhereInput `json:"and,omitempty"`
+// CallParticipantWhereInput represents a where input for filtering CallParticipant queries.
+type CallParticipantWhereInput struct {
+	Predicates []predicate.CallParticipant  `json:"-"`
+	Not        *CallParticipantWhereInput   `json:"not,omitempty"`
+	Or         []*CallParticipantWhereInput `json:"or,omitempty"`
+	And        []*CallParticipantWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *pulid.ID  `json:"id,omitempty"`
@@ -908,85 +933,94 @@ type MessageWhereInput struct {
 	IDLT    *pulid.ID  `json:"idLT,omitempty"`
 	IDLTE   *pulid.ID  `json:"idLTE,omitempty"`
 
-	// "content" field predicates.
-	Content             *string  `json:"content,omitempty"`
-	ContentNEQ          *string  `json:"contentNEQ,omitempty"`
-	ContentIn           []string `json:"contentIn,omitempty"`
-	ContentNotIn        []string `json:"contentNotIn,omitempty"`
-	ContentGT           *string  `json:"contentGT,omitempty"`
-	ContentGTE          *string  `json:"contentGTE,omitempty"`
-	ContentLT           *string  `json:"contentLT,omitempty"`
-	ContentLTE          *string  `json:"contentLTE,omitempty"`
-	ContentContains     *string  `json:"contentContains,omitempty"`
-	ContentHasPrefix    *string  `json:"contentHasPrefix,omitempty"`
-	ContentHasSuffix    *string  `json:"contentHasSuffix,omitempty"`
-	ContentIsNil        bool     `json:"contentIsNil,omitempty"`
-	ContentNotNil       bool     `json:"contentNotNil,omitempty"`
-	ContentEqualFold    *string  `json:"contentEqualFold,omitempty"`
-	ContentContainsFold *string  `json:"contentContainsFold,omitempty"`
-
-	// "created_at" field predicates.
-	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
-	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
-	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
-	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
-	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
-	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
-	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
-	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
-
-	// "updated_at" field predicates.
-	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
-	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
-	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
-	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
-	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
-	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
-	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
-	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
-
-	// "voice" edge predicates.
-	HasVoice     *bool                     `json:"hasVoice,omitempty"`
-	HasVoiceWith []*MessageVoiceWhereInput `json:"hasVoiceWith,omitempty"`
-
-	// "reply_to" edge predicates.
-	HasReplyTo     *bool                `json:"hasReplyTo,omitempty"`
-	HasReplyToWith []*MessageWhereInput `json:"hasReplyToWith,omitempty"`
-
-	// "replies" edge predicates.
-	HasReplies     *bool                `json:"hasReplies,omitempty"`
-	HasRepliesWith []*MessageWhereInput `json:"hasRepliesWith,omitempty"`
-
-	// "attachments" edge predicates.
-	HasAttachments     *bool                          `json:"hasAttachments,omitempty"`
-	HasAttachmentsWith []*MessageAttachmentWhereInput `json:"hasAttachmentsWith,omitempty"`
+	// "state" field predicates.
+	State      *callparticipant.State  `json:"state,omitempty"`
+	StateNEQ   *callparticipant.State  `json:"stateNEQ,omitempty"`
+	StateIn    []callparticipant.State `json:"stateIn,omitempty"`
+	StateNotIn []callparticipant.State `json:"stateNotIn,omitempty"`
+
+	// "call_id" field predicates.
+	CallID             *pulid.ID  `json:"callID,omitempty"`
+	CallIDNEQ          *pulid.ID  `json:"callIDNEQ,omitempty"`
+	CallIDIn           []pulid.ID `json:"callIDIn,omitempty"`
+	CallIDNotIn        []pulid.ID `json:"callIDNotIn,omitempty"`
+	CallIDGT           *pulid.ID  `json:"callIDGT,omitempty"`
+	CallIDGTE          *pulid.ID  `json:"callIDGTE,omitempty"`
+	CallIDLT           *pulid.ID  `json:"callIDLT,omitempty"`
+	CallIDLTE          *pulid.ID  `json:"callIDLTE,omitempty"`
+	CallIDContains     *pulid.ID  `json:"callIDContains,omitempty"`
+	CallIDHasPrefix    *pulid.ID  `json:"callIDHasPrefix,omitempty"`
+	CallIDHasSuffix    *pulid.ID  `json:"callIDHasSuffix,omitempty"`
+	CallIDEqualFold    *pulid.ID  `json:"callIDEqualFold,omitempty"`
+	CallIDContainsFold *pulid.ID  `json:"callIDContainsFold,omitempty"`
 
-	// "links" edge predicates.
-	HasLinks     *bool                    `json:"hasLinks,omitempty"`
-	HasLinksWith []*MessageLinkWhereInput `json:"hasLinksWith,omitempty"`
+	// "user_id" field predicates.
+	UserID             *pulid.ID  `json:"userID,omitempty"`
+	UserIDNEQ          *pulid.ID  `json:"userIDNEQ,omitempty"`
+	UserIDIn           []pulid.ID `json:"userIDIn,omitempty"`
+	UserIDNotIn        []pulid.ID `json:"userIDNotIn,omitempty"`
+	UserIDGT           *pulid.ID  `json:"userIDGT,omitempty"`
+	UserIDGTE          *pulid.ID  `json:"userIDGTE,omitempty"`
+	UserIDLT           *pulid.ID  `json:"userIDLT,omitempty"`
+	UserIDLTE          *pulid.ID  `json:"userIDLTE,omitempty"`
+	UserIDContains     *pulid.ID  `json:"userIDContains,omitempty"`
+	UserIDHasPrefix    *pulid.ID  `json:"userIDHasPrefix,omitempty"`
+	UserIDHasSuffix    *pulid.ID  `json:"userIDHasSuffix,omitempty"`
+	UserIDEqualFold    *pulid.ID  `json:"userIDEqualFold,omitempty"`
+	UserIDContainsFold *pulid.ID  `json:"userIDContainsFold,omitempty"`
 
-	// "user" edge predicates.
-	HasUser     *bool             `json:"hasUser,omitempty"`
-	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+	// "invited_at" field predicates.
+	InvitedAt      *time.Time  `json:"invitedAt,omitempty"`
+	InvitedAtNEQ   *time.Time  `json:"invitedAtNEQ,omitempty"`
+	InvitedAtIn    []time.Time `json:"invitedAtIn,omitempty"`
+	InvitedAtNotIn []time.Time `json:"invitedAtNotIn,omitempty"`
+	InvitedAtGT    *time.Time  `json:"invitedAtGT,omitempty"`
+	InvitedAtGTE   *time.Time  `json:"invitedAtGTE,omitempty"`
+	InvitedAtLT    *time.Time  `json:"invitedAtLT,omitempty"`
+	InvitedAtLTE   *time.Time  `json:"invitedAtLTE,omitempty"`
 
-	// "room" edge predicates.
-	HasRoom     *bool             `json:"hasRoom,omitempty"`
-	HasRoomWith []*RoomWhereInput `json:"hasRoomWith,omitempty"`
+	// "joined_at" field predicates.
+	JoinedAt       *time.Time  `json:"joinedAt,omitempty"`
+	JoinedAtNEQ    *time.Time  `json:"joinedAtNEQ,omitempty"`
+	JoinedAtIn     []time.Time `json:"joinedAtIn,omitempty"`
+	JoinedAtNotIn  []time.Time `json:"joinedAtNotIn,omitempty"`
+	JoinedAtGT     *time.Time  `json:"joinedAtGT,omitempty"`
+	JoinedAtGTE    *time.Time  `json:"joinedAtGTE,omitempty"`
+	JoinedAtLT     *time.Time  `json:"joinedAtLT,omitempty"`
+	JoinedAtLTE    *time.Time  `json:"joinedAtLTE,omitempty"`
+	JoinedAtIsNil  bool        `json:"joinedAtIsNil,omitempty"`
+	JoinedAtNotNil bool        `json:"joinedAtNotNil,omitempty"`
+
+	// "left_at" field predicates.
+	LeftAt       *time.Time  `json:"leftAt,omitempty"`
+	LeftAtNEQ    *time.Time  `json:"leftAtNEQ,omitempty"`
+	LeftAtIn     []time.Time `json:"leftAtIn,omitempty"`
+	LeftAtNotIn  []time.Time `json:"leftAtNotIn,omitempty"`
+	LeftAtGT     *time.Time  `json:"leftAtGT,omitempty"`
+	LeftAtGTE    *time.Time  `json:"leftAtGTE,omitempty"`
+	LeftAtLT     *time.Time  `json:"leftAtLT,omitempty"`
+	LeftAtLTE    *time.Time  `json:"leftAtLTE,omitempty"`
+	LeftAtIsNil  bool        `json:"leftAtIsNil,omitempty"`
+	LeftAtNotNil bool        `json:"leftAtNotNil,omitempty"`
+
+	// "call" edge predicates.
+	HasCall     *bool             `json:"hasCall,omitempty"`
+	HasCallWith []*CallWhereInput `json:"hasCallWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *MessageWhereInput) AddPredicates(predicates ...predicate.Message) {
+func (i *CallParticipantWhereInput) AddPredicates(predicates ...predicate.CallParticipant) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the MessageWhereInput filter on the MessageQuery builder.
-func (i *MessageWhereInput) Filter(q *MessageQuery) (*MessageQuery, error) {
+// Filter applies the CallParticipantWhereInput filter on the CallParticipantQuery builder.
+func (i *CallParticipantWhereInput) Filter(q *CallParticipantQuery) (*CallParticipantQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyMessageWhereInput {
+		if err == ErrEmptyCallParticipantWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -994,19 +1028,19 @@ func (i *MessageWhereInput) Filter(q *MessageQuery) (*MessageQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptyMessageWhereInput is returned in case the MessageWhereInput is empty.
-var ErrEmptyMessageWhereInput = errors.New("ent: empty predicate MessageWhereInput")
+// ErrEmptyCallParticipantWhereInput is returned in case the CallParticipantWhereInput is empty.
+var ErrEmptyCallParticipantWhereInput = errors.New("ent: empty predicate CallParticipantWhereInput")
 
-// P returns a predicate for filtering messages.
+// P returns a predicate for filtering callparticipants.
 // An error is returned if the input is empty or invalid.
-func (i *MessageWhereInput) P() (predicate.Message, error) {
-	var predicates []predicate.Message
+func (i *CallParticipantWhereInput) P() (predicate.CallParticipant, error) {
+	var predicates []predicate.CallParticipant
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, message.Not(p))
+		predicates = append(predicates, callparticipant.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -1016,7 +1050,7 @@ func (i *MessageWhereInput) P() (predicate.Message, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Message, 0, n)
+		or := make([]predicate.CallParticipant, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -1024,7 +1058,7 @@ func (i *MessageWhereInput) P() (predicate.Message, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, message.Or(or...))
+		predicates = append(predicates, callparticipant.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -1034,7 +1068,7 @@ func (i *MessageWhereInput) P() (predicate.Message, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Message, 0, n)
+		and := make([]predicate.CallParticipant, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -1042,269 +1076,242 @@ func (i *MessageWhereInput) P() (predicate.Message, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, message.And(and...))
+		predicates = append(predicates, callparticipant.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, message.IDEQ(*i.ID))
+		predicates = append(predicates, callparticipant.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, message.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, callparticipant.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, message.IDIn(i.IDIn...))
+		predicates = append(predicates, callparticipant.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, message.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, callparticipant.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, message.IDGT(*i.IDGT))
+		predicates = append(predicates, callparticipant.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, message.IDGTE(*i.IDGTE))
+		predicates = append(predicates, callparticipant.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, message.IDLT(*i.IDLT))
+		predicates = append(predicates, callparticipant.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, message.IDLTE(*i.IDLTE))
+		predicates = append(predicates, callparticipant.IDLTE(*i.IDLTE))
 	}
-	if i.Content != nil {
-		predicates = append(predicates, message.ContentEQ(*i.Content))
+	if i.State != nil {
+		predicates = append(predicates, callparticipant.StateEQ(*i.State))
 	}
-	if i.ContentNEQ != nil {
-		predicates = append(predicates, message.ContentNEQ(*i.ContentNEQ))
+	if i.StateNEQ != nil {
+		predicates = append(predicates, callparticipant.StateNEQ(*i.StateNEQ))
 	}
-	if len(i.ContentIn) > 0 {
-		predicates = append(predicates, message.ContentIn(i.ContentIn...))
+	if len(i.StateIn) > 0 {
+		predicates = append(predicates, callparticipant.StateIn(i.StateIn...))
 	}
-	if len(i.ContentNotIn) > 0 {
-		predicates = append(predicates, message.ContentNotIn(i.ContentNotIn...))
+	if len(i.StateNotIn) > 0 {
+		predicates = append(predicates, callparticipant.StateNotIn(i.StateNotIn...))
 	}
-	if i.ContentGT != nil {
-		predicates = append(predicates, message.ContentGT(*i.ContentGT))
+	if i.CallID != nil {
+		predicates = append(predicates, callparticipant.CallIDEQ(*i.CallID))
 	}
-	if i.ContentGTE != nil {
-		predicates = append(predicates, message.ContentGTE(*i.ContentGTE))
+	if i.CallIDNEQ != nil {
+		predicates = append(predicates, callparticipant.CallIDNEQ(*i.CallIDNEQ))
 	}
-	if i.ContentLT != nil {
-		predicates = append(predicates, message.ContentLT(*i.ContentLT))
+	if len(i.CallIDIn) > 0 {
+		predicates = append(predicates, callparticipant.CallIDIn(i.CallIDIn...))
 	}
-	if i.ContentLTE != nil {
-		predicates = append(predicates, message.ContentLTE(*i.ContentLTE))
+	if len(i.CallIDNotIn) > 0 {
+		predicates = append(predicates, callparticipant.CallIDNotIn(i.CallIDNotIn...))
 	}
-	if i.ContentContains != nil {
-		predicates = append(predicates, message.ContentContains(*i.ContentContains))
+	if i.CallIDGT != nil {
+		predicates = append(predicates, callparticipant.CallIDGT(*i.CallIDGT))
 	}
-	if i.ContentHasPrefix != nil {
-		predicates = append(predicates, message.ContentHasPrefix(*i.ContentHasPrefix))
+	if i.CallIDGTE != nil {
+		predicates = append(predicates, callparticipant.CallIDGTE(*i.CallIDGTE))
 	}
-	if i.ContentHasSuffix != nil {
-		predicates = append(predicates, message.ContentHasSuffix(*i.ContentHasSuffix))
+	if i.CallIDLT != nil {
+		predicates = append(predicates, callparticipant.CallIDLT(*i.CallIDLT))
 	}
-	if i.ContentIsNil {
-		predicates = append(predicates, message.ContentIsNil())
+	if i.CallIDLTE != nil {
+		predicates = append(predicates, callparticipant.CallIDLTE(*i.CallIDLTE))
 	}
-	if i.ContentNotNil {
-		predicates = append(predicates, message.ContentNotNil())
+	if i.CallIDContains != nil {
+		predicates = append(predicates, callparticipant.CallIDContains(*i.CallIDContains))
 	}
-	if i.ContentEqualFold != nil {
-		predicates = append(predicates, message.ContentEqualFold(*i.ContentEqualFold))
+	if i.CallIDHasPrefix != nil {
+		predicates = append(predicates, callparticipant.CallIDHasPrefix(*i.CallIDHasPrefix))
 	}
-	if i.ContentContainsFold != nil {
-		predicates = append(predicates, message.ContentContainsFold(*i.ContentContainsFold))
+	if i.CallIDHasSuffix != nil {
+		predicates = append(predicates, callparticipant.CallIDHasSuffix(*i.CallIDHasSuffix))
 	}
-	if i.CreatedAt != nil {
-		predicates = append(predicates, message.CreatedAtEQ(*i.CreatedAt))
+	if i.CallIDEqualFold != nil {
+		predicates = append(predicates, callparticipant.CallIDEqualFold(*i.CallIDEqualFold))
 	}
-	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, message.CreatedAtNEQ(*i.CreatedAtNEQ))
+	if i.CallIDContainsFold != nil {
+		predicates = append(predicates, callparticipant.CallIDContainsFold(*i.CallIDContainsFold))
 	}
-	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, message.CreatedAtIn(i.CreatedAtIn...))
+	if i.UserID != nil {
+		predicates = append(predicates, callparticipant.UserIDEQ(*i.UserID))
 	}
-	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, message.CreatedAtNotIn(i.CreatedAtNotIn...))
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, callparticipant.UserIDNEQ(*i.UserIDNEQ))
 	}
-	if i.CreatedAtGT != nil {
-		predicates = append(predicates, message.CreatedAtGT(*i.CreatedAtGT))
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, callparticipant.UserIDIn(i.UserIDIn...))
 	}
-	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, message.CreatedAtGTE(*i.CreatedAtGTE))
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, callparticipant.UserIDNotIn(i.UserIDNotIn...))
 	}
-	if i.CreatedAtLT != nil {
-		predicates = append(predicates, message.CreatedAtLT(*i.CreatedAtLT))
+	if i.UserIDGT != nil {
+		predicates = append(predicates, callparticipant.UserIDGT(*i.UserIDGT))
 	}
-	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, message.CreatedAtLTE(*i.CreatedAtLTE))
+	if i.UserIDGTE != nil {
+		predicates = append(predicates, callparticipant.UserIDGTE(*i.UserIDGTE))
 	}
-	if i.UpdatedAt != nil {
-		predicates = append(predicates, message.UpdatedAtEQ(*i.UpdatedAt))
+	if i.UserIDLT != nil {
+		predicates = append(predicates, callparticipant.UserIDLT(*i.UserIDLT))
 	}
-	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, message.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	if i.UserIDLTE != nil {
+		predicates = append(predicates, callparticipant.UserIDLTE(*i.UserIDLTE))
 	}
-	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, message.UpdatedAtIn(i.UpdatedAtIn...))
+	if i.UserIDContains != nil {
+		predicates = append(predicates, callparticipant.UserIDContains(*i.UserIDContains))
 	}
-	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, message.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	if i.UserIDHasPrefix != nil {
+		predicates = append(predicates, callparticipant.UserIDHasPrefix(*i.UserIDHasPrefix))
 	}
-	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, message.UpdatedAtGT(*i.UpdatedAtGT))
+	if i.UserIDHasSuffix != nil {
+		predicates = append(predicates, callparticipant.UserIDHasSuffix(*i.UserIDHasSuffix))
 	}
-	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, message.UpdatedAtGTE(*i.UpdatedAtGTE))
+	if i.UserIDEqualFold != nil {
+		predicates = append(predicates, callparticipant.UserIDEqualFold(*i.UserIDEqualFold))
 	}
-	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, message.UpdatedAtLT(*i.UpdatedAtLT))
+	if i.UserIDContainsFold != nil {
+		predicates = append(predicates, callparticipant.UserIDContainsFold(*i.UserIDContainsFold))
 	}
-	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, message.UpdatedAtLTE(*i.UpdatedAtLTE))
+	if i.InvitedAt != nil {
+		predicates = append(predicates, callparticipant.InvitedAtEQ(*i.InvitedAt))
 	}
-
-	if i.HasVoice != nil {
-		p := message.HasVoice()
-		if !*i.HasVoice {
-			p = message.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.InvitedAtNEQ != nil {
+		predicates = append(predicates, callparticipant.InvitedAtNEQ(*i.InvitedAtNEQ))
 	}
-	if len(i.HasVoiceWith) > 0 {
-		with := make([]predicate.MessageVoice, 0, len(i.HasVoiceWith))
-		for _, w := range i.HasVoiceWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasVoiceWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, message.HasVoiceWith(with...))
+	if len(i.InvitedAtIn) > 0 {
+		predicates = append(predicates, callparticipant.InvitedAtIn(i.InvitedAtIn...))
 	}
-	if i.HasReplyTo != nil {
-		p := message.HasReplyTo()
-		if !*i.HasReplyTo {
-			p = message.Not(p)
-		}
-		predicates = append(predicates, p)
+	if len(i.InvitedAtNotIn) > 0 {
+		predicates = append(predicates, callparticipant.InvitedAtNotIn(i.InvitedAtNotIn...))
 	}
-	if len(i.HasReplyToWith) > 0 {
-		with := make([]predicate.Message, 0, len(i.HasReplyToWith))
-		for _, w := range i.HasReplyToWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasReplyToWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, message.HasReplyToWith(with...))
+	if i.InvitedAtGT != nil {
+		predicates = append(predicates, callparticipant.InvitedAtGT(*i.InvitedAtGT))
 	}
-	if i.HasReplies != nil {
-		p := message.HasReplies()
-		if !*i.HasReplies {
-			p = message.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.InvitedAtGTE != nil {
+		predicates = append(predicates, callparticipant.InvitedAtGTE(*i.InvitedAtGTE))
 	}
-	if len(i.HasRepliesWith) > 0 {
-		with := make([]predicate.Message, 0, len(i.HasRepliesWith))
-		for _, w := range i.HasRepliesWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasRepliesWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, message.HasRepliesWith(with...))
+	if i.InvitedAtLT != nil {
+		predicates = append(predicates, callparticipant.InvitedAtLT(*i.InvitedAtLT))
 	}
-	if i.HasAttachments != nil {
-		p := message.HasAttachments()
-		if !*i.HasAttachments {
-			p = message.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.InvitedAtLTE != nil {
+		predicates = append(predicates, callparticipant.InvitedAtLTE(*i.InvitedAtLTE))
 	}
-	if len(i.HasAttachmentsWith) > 0 {
-		with := make([]predicate.MessageAttachment, 0, len(i.HasAttachmentsWith))
-		for _, w := range i.HasAttachmentsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasAttachmentsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, message.HasAttachmentsWith(with...))
+	if i.JoinedAt != nil {
+		predicates = append(predicates, callparticipant.JoinedAtEQ(*i.JoinedAt))
 	}
-	if i.HasLinks != nil {
-		p := message.HasLinks()
-		if !*i.HasLinks {
-			p = message.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.JoinedAtNEQ != nil {
+		predicates = append(predicates, callparticipant.JoinedAtNEQ(*i.JoinedAtNEQ))
 	}
-	if len(i.HasLinksWith) > 0 {
-		with := make([]predicate.MessageLink, 0, len(i.HasLinksWith))
-		for _, w := range i.HasLinksWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasLinksWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, message.HasLinksWith(with...))
+	if len(i.JoinedAtIn) > 0 {
+		predicates = append(predicates, callparticipant.JoinedAtIn(i.JoinedAtIn...))
 	}
-	if i.HasUser != nil {
-		p := message.HasUser()
-		if !*i.HasUser {
-			p = message.Not(p)
-		}
-		predicates = append(predicates, p)
+	if len(i.JoinedAtNotIn) > 0 {
+		predicates = append(predicates, callparticipant.JoinedAtNotIn(i.JoinedAtNotIn...))
 	}
-	if len(i.HasUserWith) > 0 {
-		with := make([]predicate.User, 0, len(i.HasUserWith))
-		for _, w := range i.HasUserWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, message.HasUserWith(with...))
+	if i.JoinedAtGT != nil {
+		predicates = append(predicates, callparticipant.JoinedAtGT(*i.JoinedAtGT))
 	}
-	if i.HasRoom != nil {
-		p := message.HasRoom()
-		if !*i.HasRoom {
-			p = message.Not(p)
+	if i.JoinedAtGTE != nil {
+		predicates = append(predicates, callparticipant.JoinedAtGTE(*i.JoinedAtGTE))
+	}
+	if i.JoinedAtLT != nil {
+		predicates = append(predicates, callparticipant.JoinedAtLT(*i.JoinedAtLT))
+	}
+	if i.JoinedAtLTE != nil {
+		predicates = append(predicates, callparticipant.JoinedAtLTE(*i.JoinedAtLTE))
+	}
+	if i.JoinedAtIsNil {
+		predicates = append(predicates, callparticipant.JoinedAtIsNil())
+	}
+	if i.JoinedAtNotNil {
+		predicates = append(predicates, callparticipant.JoinedAtNotNil())
+	}
+	if i.LeftAt != nil {
+		predicates = append(predicates, callparticipant.LeftAtEQ(*i.LeftAt))
+	}
+	if i.LeftAtNEQ != nil {
+		predicates = append(predicates, callparticipant.LeftAtNEQ(*i.LeftAtNEQ))
+	}
+	if len(i.LeftAtIn) > 0 {
+		predicates = append(predicates, callparticipant.LeftAtIn(i.LeftAtIn...))
+	}
+	if len(i.LeftAtNotIn) > 0 {
+		predicates = append(predicates, callparticipant.LeftAtNotIn(i.LeftAtNotIn...))
+	}
+	if i.LeftAtGT != nil {
+		predicates = append(predicates, callparticipant.LeftAtGT(*i.LeftAtGT))
+	}
+	if i.LeftAtGTE != nil {
+		predicates = append(predicates, callparticipant.LeftAtGTE(*i.LeftAtGTE))
+	}
+	if i.LeftAtLT != nil {
+		predicates = append(predicates, callparticipant.LeftAtLT(*i.LeftAtLT))
+	}
+	if i.LeftAtLTE != nil {
+		predicates = append(predicates, callparticipant.LeftAtLTE(*i.LeftAtLTE))
+	}
+	if i.LeftAtIsNil {
+		predicates = append(predicates, callparticipant.LeftAtIsNil())
+	}
+	if i.LeftAtNotNil {
+		predicates = append(predicates, callparticipant.LeftAtNotNil())
+	}
+
+	if i.HasCall != nil {
+		p := callparticipant.HasCall()
+		if !*i.HasCall {
+			p = callparticipant.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasRoomWith) > 0 {
-		with := make([]predicate.Room, 0, len(i.HasRoomWith))
-		for _, w := range i.HasRoomWith {
+	if len(i.HasCallWith) > 0 {
+		with := make([]predicate.Call, 0, len(i.HasCallWith))
+		for _, w := range i.HasCallWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasRoomWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasCallWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, message.HasRoomWith(with...))
+		predicates = append(predicates, callparticipant.HasCallWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyMessageWhereInput
+		return nil, ErrEmptyCallParticipantWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return message.And(predicates...), nil
+		return callparticipant.And(predicates...), nil
 	}
 }
 
-// MessageAttachmentWhereInput represents a where input for filtering MessageAttachment queries.
-type MessageAttachmentWhereInput struct {
-	Predicates []predicate.MessageAttachment  `json:"-"`
-	Not        *MessageAttachmentWhereInput   `json:"not,omitempty"`
-	Or         []*MessageAttachmentWhereInput `json:"or,omitempty"`
-	And        []*MessageAttachmentWhereInput `json:"and,omitempty"`
+// ContactPinWhereInput represents a where input for filtering ContactPin queries.
+type ContactPinWhereInput struct {
+	Predicates []predicate.ContactPin  `json:"-"`
+	Not        *ContactPinWhereInput   `json:"not,omitempty"`
+	Or         []*ContactPinWhereInput `json:"or,omitempty"`
+	And        []*ContactPinWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *pulid.ID  `json:"id,omitempty"`
@@ -1316,58 +1323,106 @@ type MessageAttachmentWhereInput struct {
 	IDLT    *pulid.ID  `json:"idLT,omitempty"`
 	IDLTE   *pulid.ID  `json:"idLTE,omitempty"`
 
-	// "type" field predicates.
-	Type      *messageattachment.Type  `json:"type,omitempty"`
-	TypeNEQ   *messageattachment.Type  `json:"typeNEQ,omitempty"`
-	TypeIn    []messageattachment.Type `json:"typeIn,omitempty"`
-	TypeNotIn []messageattachment.Type `json:"typeNotIn,omitempty"`
-
-	// "order" field predicates.
-	Order      *uint  `json:"order,omitempty"`
-	OrderNEQ   *uint  `json:"orderNEQ,omitempty"`
-	OrderIn    []uint `json:"orderIn,omitempty"`
-	OrderNotIn []uint `json:"orderNotIn,omitempty"`
-	OrderGT    *uint  `json:"orderGT,omitempty"`
-	OrderGTE   *uint  `json:"orderGTE,omitempty"`
-	OrderLT    *uint  `json:"orderLT,omitempty"`
-	OrderLTE   *uint  `json:"orderLTE,omitempty"`
-
-	// "attached_at" field predicates.
-	AttachedAt      *time.Time  `json:"attachedAt,omitempty"`
-	AttachedAtNEQ   *time.Time  `json:"attachedAtNEQ,omitempty"`
-	AttachedAtIn    []time.Time `json:"attachedAtIn,omitempty"`
-	AttachedAtNotIn []time.Time `json:"attachedAtNotIn,omitempty"`
-	AttachedAtGT    *time.Time  `json:"attachedAtGT,omitempty"`
-	AttachedAtGTE   *time.Time  `json:"attachedAtGTE,omitempty"`
-	AttachedAtLT    *time.Time  `json:"attachedAtLT,omitempty"`
-	AttachedAtLTE   *time.Time  `json:"attachedAtLTE,omitempty"`
+	// "code" field predicates.
+	Code             *string  `json:"code,omitempty"`
+	CodeNEQ          *string  `json:"codeNEQ,omitempty"`
+	CodeIn           []string `json:"codeIn,omitempty"`
+	CodeNotIn        []string `json:"codeNotIn,omitempty"`
+	CodeGT           *string  `json:"codeGT,omitempty"`
+	CodeGTE          *string  `json:"codeGTE,omitempty"`
+	CodeLT           *string  `json:"codeLT,omitempty"`
+	CodeLTE          *string  `json:"codeLTE,omitempty"`
+	CodeContains     *string  `json:"codeContains,omitempty"`
+	CodeHasPrefix    *string  `json:"codeHasPrefix,omitempty"`
+	CodeHasSuffix    *string  `json:"codeHasSuffix,omitempty"`
+	CodeEqualFold    *string  `json:"codeEqualFold,omitempty"`
+	CodeContainsFold *string  `json:"codeContainsFold,omitempty"`
 
-	// "room" edge predicates.
-	HasRoom     *bool             `json:"hasRoom,omitempty"`
-	HasRoomWith []*RoomWhereInput `json:"hasRoomWith,omitempty"`
+	// "user_id" field predicates.
+	UserID             *pulid.ID  `json:"userID,omitempty"`
+	UserIDNEQ          *pulid.ID  `json:"userIDNEQ,omitempty"`
+	UserIDIn           []pulid.ID `json:"userIDIn,omitempty"`
+	UserIDNotIn        []pulid.ID `json:"userIDNotIn,omitempty"`
+	UserIDGT           *pulid.ID  `json:"userIDGT,omitempty"`
+	UserIDGTE          *pulid.ID  `json:"userIDGTE,omitempty"`
+	UserIDLT           *pulid.ID  `json:"userIDLT,omitempty"`
+	UserIDLTE          *pulid.ID  `json:"userIDLTE,omitempty"`
+	UserIDContains     *pulid.ID  `json:"userIDContains,omitempty"`
+	UserIDHasPrefix    *pulid.ID  `json:"userIDHasPrefix,omitempty"`
+	UserIDHasSuffix    *pulid.ID  `json:"userIDHasSuffix,omitempty"`
+	UserIDEqualFold    *pulid.ID  `json:"userIDEqualFold,omitempty"`
+	UserIDContainsFold *pulid.ID  `json:"userIDContainsFold,omitempty"`
 
-	// "message" edge predicates.
-	HasMessage     *bool                `json:"hasMessage,omitempty"`
-	HasMessageWith []*MessageWhereInput `json:"hasMessageWith,omitempty"`
+	// "expires_at" field predicates.
+	ExpiresAt       *time.Time  `json:"expiresAt,omitempty"`
+	ExpiresAtNEQ    *time.Time  `json:"expiresAtNEQ,omitempty"`
+	ExpiresAtIn     []time.Time `json:"expiresAtIn,omitempty"`
+	ExpiresAtNotIn  []time.Time `json:"expiresAtNotIn,omitempty"`
+	ExpiresAtGT     *time.Time  `json:"expiresAtGT,omitempty"`
+	ExpiresAtGTE    *time.Time  `json:"expiresAtGTE,omitempty"`
+	ExpiresAtLT     *time.Time  `json:"expiresAtLT,omitempty"`
+	ExpiresAtLTE    *time.Time  `json:"expiresAtLTE,omitempty"`
+	ExpiresAtIsNil  bool        `json:"expiresAtIsNil,omitempty"`
+	ExpiresAtNotNil bool        `json:"expiresAtNotNil,omitempty"`
+
+	// "max_uses" field predicates.
+	MaxUses       *int  `json:"maxUses,omitempty"`
+	MaxUsesNEQ    *int  `json:"maxUsesNEQ,omitempty"`
+	MaxUsesIn     []int `json:"maxUsesIn,omitempty"`
+	MaxUsesNotIn  []int `json:"maxUsesNotIn,omitempty"`
+	MaxUsesGT     *int  `json:"maxUsesGT,omitempty"`
+	MaxUsesGTE    *int  `json:"maxUsesGTE,omitempty"`
+	MaxUsesLT     *int  `json:"maxUsesLT,omitempty"`
+	MaxUsesLTE    *int  `json:"maxUsesLTE,omitempty"`
+	MaxUsesIsNil  bool  `json:"maxUsesIsNil,omitempty"`
+	MaxUsesNotNil bool  `json:"maxUsesNotNil,omitempty"`
+
+	// "uses" field predicates.
+	Uses      *int  `json:"uses,omitempty"`
+	UsesNEQ   *int  `json:"usesNEQ,omitempty"`
+	UsesIn    []int `json:"usesIn,omitempty"`
+	UsesNotIn []int `json:"usesNotIn,omitempty"`
+	UsesGT    *int  `json:"usesGT,omitempty"`
+	UsesGTE   *int  `json:"usesGTE,omitempty"`
+	UsesLT    *int  `json:"usesLT,omitempty"`
+	UsesLTE   *int  `json:"usesLTE,omitempty"`
+
+	// "revoked_at" field predicates.
+	RevokedAt       *time.Time  `json:"revokedAt,omitempty"`
+	RevokedAtNEQ    *time.Time  `json:"revokedAtNEQ,omitempty"`
+	RevokedAtIn     []time.Time `json:"revokedAtIn,omitempty"`
+	RevokedAtNotIn  []time.Time `json:"revokedAtNotIn,omitempty"`
+	RevokedAtGT     *time.Time  `json:"revokedAtGT,omitempty"`
+	RevokedAtGTE    *time.Time  `json:"revokedAtGTE,omitempty"`
+	RevokedAtLT     *time.Time  `json:"revokedAtLT,omitempty"`
+	RevokedAtLTE    *time.Time  `json:"revokedAtLTE,omitempty"`
+	RevokedAtIsNil  bool        `json:"revokedAtIsNil,omitempty"`
+	RevokedAtNotNil bool        `json:"revokedAtNotNil,omitempty"`
 
-	// "file" edge predicates.
-	HasFile     *bool             `json:"hasFile,omitempty"`
-	HasFileWith []*FileWhereInput `json:"hasFileWith,omitempty"`
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *MessageAttachmentWhereInput) AddPredicates(predicates ...predicate.MessageAttachment) {
+func (i *ContactPinWhereInput) AddPredicates(predicates ...predicate.ContactPin) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the MessageAttachmentWhereInput filter on the MessageAttachmentQuery builder.
-func (i *MessageAttachmentWhereInput) Filter(q *MessageAttachmentQuery) (*MessageAttachmentQuery, error) {
+// Filter applies the ContactPinWhereInput filter on the ContactPinQuery builder.
+func (i *ContactPinWhereInput) Filter(q *ContactPinQuery) (*ContactPinQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyMessageAttachmentWhereInput {
+		if err == ErrEmptyContactPinWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -1375,19 +1430,19 @@ func (i *MessageAttachmentWhereInput) Filter(q *MessageAttachmentQuery) (*Messag
 	return q.Where(p), nil
 }
 
-// ErrEmptyMessageAttachmentWhereInput is returned in case the MessageAttachmentWhereInput is empty.
-var ErrEmptyMessageAttachmentWhereInput = errors.New("ent: empty predicate MessageAttachmentWhereInput")
+// ErrEmptyContactPinWhereInput is returned in case the ContactPinWhereInput is empty.
+var ErrEmptyContactPinWhereInput = errors.New("ent: empty predicate ContactPinWhereInput")
 
-// P returns a predicate for filtering messageattachments.
+// P returns a predicate for filtering contactpins.
 // An error is returned if the input is empty or invalid.
-func (i *MessageAttachmentWhereInput) P() (predicate.MessageAttachment, error) {
-	var predicates []predicate.MessageAttachment
+func (i *ContactPinWhereInput) P() (predicate.ContactPin, error) {
+	var predicates []predicate.ContactPin
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, messageattachment.Not(p))
+		predicates = append(predicates, contactpin.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -1397,7 +1452,7 @@ func (i *MessageAttachmentWhereInput) P() (predicate.MessageAttachment, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.MessageAttachment, 0, n)
+		or := make([]predicate.ContactPin, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -1405,7 +1460,7 @@ func (i *MessageAttachmentWhereInput) P() (predicate.MessageAttachment, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, messageattachment.Or(or...))
+		predicates = append(predicates, contactpin.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -1415,7 +1470,7 @@ func (i *MessageAttachmentWhereInput) P() (predicate.MessageAttachment, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.MessageAttachment, 0, n)
+		and := make([]predicate.ContactPin, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -1423,164 +1478,266 @@ func (i *MessageAttachmentWhereInput) P() (predicate.MessageAttachment, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, messageattachment.And(and...))
+		predicates = append(predicates, contactpin.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, messageattachment.IDEQ(*i.ID))
+		predicates = append(predicates, contactpin.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, messageattachment.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, contactpin.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, messageattachment.IDIn(i.IDIn...))
+		predicates = append(predicates, contactpin.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, messageattachment.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, contactpin.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, messageattachment.IDGT(*i.IDGT))
+		predicates = append(predicates, contactpin.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, messageattachment.IDGTE(*i.IDGTE))
+		predicates = append(predicates, contactpin.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, messageattachment.IDLT(*i.IDLT))
+		predicates = append(predicates, contactpin.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, messageattachment.IDLTE(*i.IDLTE))
+		predicates = append(predicates, contactpin.IDLTE(*i.IDLTE))
 	}
-	if i.Type != nil {
-		predicates = append(predicates, messageattachment.TypeEQ(*i.Type))
+	if i.Code != nil {
+		predicates = append(predicates, contactpin.CodeEQ(*i.Code))
 	}
-	if i.TypeNEQ != nil {
-		predicates = append(predicates, messageattachment.TypeNEQ(*i.TypeNEQ))
+	if i.CodeNEQ != nil {
+		predicates = append(predicates, contactpin.CodeNEQ(*i.CodeNEQ))
 	}
-	if len(i.TypeIn) > 0 {
-		predicates = append(predicates, messageattachment.TypeIn(i.TypeIn...))
+	if len(i.CodeIn) > 0 {
+		predicates = append(predicates, contactpin.CodeIn(i.CodeIn...))
 	}
-	if len(i.TypeNotIn) > 0 {
-		predicates = append(predicates, messageattachment.TypeNotIn(i.TypeNotIn...))
+	if len(i.CodeNotIn) > 0 {
+		predicates = append(predicates, contactpin.CodeNotIn(i.CodeNotIn...))
 	}
-	if i.Order != nil {
-		predicates = append(predicates, messageattachment.OrderEQ(*i.Order))
+	if i.CodeGT != nil {
+		predicates = append(predicates, contactpin.CodeGT(*i.CodeGT))
 	}
-	if i.OrderNEQ != nil {
-		predicates = append(predicates, messageattachment.OrderNEQ(*i.OrderNEQ))
+	if i.CodeGTE != nil {
+		predicates = append(predicates, contactpin.CodeGTE(*i.CodeGTE))
 	}
-	if len(i.OrderIn) > 0 {
-		predicates = append(predicates, messageattachment.OrderIn(i.OrderIn...))
+	if i.CodeLT != nil {
+		predicates = append(predicates, contactpin.CodeLT(*i.CodeLT))
 	}
-	if len(i.OrderNotIn) > 0 {
-		predicates = append(predicates, messageattachment.OrderNotIn(i.OrderNotIn...))
+	if i.CodeLTE != nil {
+		predicates = append(predicates, contactpin.CodeLTE(*i.CodeLTE))
 	}
-	if i.OrderGT != nil {
-		predicates = append(predicates, messageattachment.OrderGT(*i.OrderGT))
+	if i.CodeContains != nil {
+		predicates = append(predicates, contactpin.CodeContains(*i.CodeContains))
 	}
-	if i.OrderGTE != nil {
-		predicates = append(predicates, messageattachment.OrderGTE(*i.OrderGTE))
+	if i.CodeHasPrefix != nil {
+		predicates = append(predicates, contactpin.CodeHasPrefix(*i.CodeHasPrefix))
 	}
-	if i.OrderLT != nil {
-		predicates = append(predicates, messageattachment.OrderLT(*i.OrderLT))
+	if i.CodeHasSuffix != nil {
+		predicates = append(predicates, contactpin.CodeHasSuffix(*i.CodeHasSuffix))
 	}
-	if i.OrderLTE != nil {
-		predicates = append(predicates, messageattachment.OrderLTE(*i.OrderLTE))
+	if i.CodeEqualFold != nil {
+		predicates = append(predicates, contactpin.CodeEqualFold(*i.CodeEqualFold))
 	}
-	if i.AttachedAt != nil {
-		predicates = append(predicates, messageattachment.AttachedAtEQ(*i.AttachedAt))
+	if i.CodeContainsFold != nil {
+		predicates = append(predicates, contactpin.CodeContainsFold(*i.CodeContainsFold))
 	}
-	if i.AttachedAtNEQ != nil {
-		predicates = append(predicates, messageattachment.AttachedAtNEQ(*i.AttachedAtNEQ))
+	if i.UserID != nil {
+		predicates = append(predicates, contactpin.UserIDEQ(*i.UserID))
 	}
-	if len(i.AttachedAtIn) > 0 {
-		predicates = append(predicates, messageattachment.AttachedAtIn(i.AttachedAtIn...))
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, contactpin.UserIDNEQ(*i.UserIDNEQ))
 	}
-	if len(i.AttachedAtNotIn) > 0 {
-		predicates = append(predicates, messageattachment.AttachedAtNotIn(i.AttachedAtNotIn...))
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, contactpin.UserIDIn(i.UserIDIn...))
 	}
-	if i.AttachedAtGT != nil {
-		predicates = append(predicates, messageattachment.AttachedAtGT(*i.AttachedAtGT))
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, contactpin.UserIDNotIn(i.UserIDNotIn...))
 	}
-	if i.AttachedAtGTE != nil {
-		predicates = append(predicates, messageattachment.AttachedAtGTE(*i.AttachedAtGTE))
+	if i.UserIDGT != nil {
+		predicates = append(predicates, contactpin.UserIDGT(*i.UserIDGT))
 	}
-	if i.AttachedAtLT != nil {
-		predicates = append(predicates, messageattachment.AttachedAtLT(*i.AttachedAtLT))
+	if i.UserIDGTE != nil {
+		predicates = append(predicates, contactpin.UserIDGTE(*i.UserIDGTE))
 	}
-	if i.AttachedAtLTE != nil {
-		predicates = append(predicates, messageattachment.AttachedAtLTE(*i.AttachedAtLTE))
+	if i.UserIDLT != nil {
+		predicates = append(predicates, contactpin.UserIDLT(*i.UserIDLT))
 	}
-
-	if i.HasRoom != nil {
-		p := messageattachment.HasRoom()
-		if !*i.HasRoom {
-			p = messageattachment.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.UserIDLTE != nil {
+		predicates = append(predicates, contactpin.UserIDLTE(*i.UserIDLTE))
 	}
-	if len(i.HasRoomWith) > 0 {
-		with := make([]predicate.Room, 0, len(i.HasRoomWith))
-		for _, w := range i.HasRoomWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasRoomWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, messageattachment.HasRoomWith(with...))
+	if i.UserIDContains != nil {
+		predicates = append(predicates, contactpin.UserIDContains(*i.UserIDContains))
 	}
-	if i.HasMessage != nil {
-		p := messageattachment.HasMessage()
-		if !*i.HasMessage {
-			p = messageattachment.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.UserIDHasPrefix != nil {
+		predicates = append(predicates, contactpin.UserIDHasPrefix(*i.UserIDHasPrefix))
 	}
-	if len(i.HasMessageWith) > 0 {
-		with := make([]predicate.Message, 0, len(i.HasMessageWith))
-		for _, w := range i.HasMessageWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasMessageWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, messageattachment.HasMessageWith(with...))
+	if i.UserIDHasSuffix != nil {
+		predicates = append(predicates, contactpin.UserIDHasSuffix(*i.UserIDHasSuffix))
 	}
-	if i.HasFile != nil {
-		p := messageattachment.HasFile()
-		if !*i.HasFile {
-			p = messageattachment.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.UserIDEqualFold != nil {
+		predicates = append(predicates, contactpin.UserIDEqualFold(*i.UserIDEqualFold))
 	}
-	if len(i.HasFileWith) > 0 {
-		with := make([]predicate.File, 0, len(i.HasFileWith))
-		for _, w := range i.HasFileWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasFileWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, messageattachment.HasFileWith(with...))
+	if i.UserIDContainsFold != nil {
+		predicates = append(predicates, contactpin.UserIDContainsFold(*i.UserIDContainsFold))
+	}
+	if i.ExpiresAt != nil {
+		predicates = append(predicates, contactpin.ExpiresAtEQ(*i.ExpiresAt))
+	}
+	if i.ExpiresAtNEQ != nil {
+		predicates = append(predicates, contactpin.ExpiresAtNEQ(*i.ExpiresAtNEQ))
+	}
+	if len(i.ExpiresAtIn) > 0 {
+		predicates = append(predicates, contactpin.ExpiresAtIn(i.ExpiresAtIn...))
+	}
+	if len(i.ExpiresAtNotIn) > 0 {
+		predicates = append(predicates, contactpin.ExpiresAtNotIn(i.ExpiresAtNotIn...))
+	}
+	if i.ExpiresAtGT != nil {
+		predicates = append(predicates, contactpin.ExpiresAtGT(*i.ExpiresAtGT))
+	}
+	if i.ExpiresAtGTE != nil {
+		predicates = append(predicates, contactpin.ExpiresAtGTE(*i.ExpiresAtGTE))
+	}
+	if i.ExpiresAtLT != nil {
+		predicates = append(predicates, contactpin.ExpiresAtLT(*i.ExpiresAtLT))
+	}
+	if i.ExpiresAtLTE != nil {
+		predicates = append(predicates, contactpin.ExpiresAtLTE(*i.ExpiresAtLTE))
+	}
+	if i.ExpiresAtIsNil {
+		predicates = append(predicates, contactpin.ExpiresAtIsNil())
+	}
+	if i.ExpiresAtNotNil {
+		predicates = append(predicates, contactpin.ExpiresAtNotNil())
+	}
+	if i.MaxUses != nil {
+		predicates = append(predicates, contactpin.MaxUsesEQ(*i.MaxUses))
+	}
+	if i.MaxUsesNEQ != nil {
+		predicates = append(predicates, contactpin.MaxUsesNEQ(*i.MaxUsesNEQ))
+	}
+	if len(i.MaxUsesIn) > 0 {
+		predicates = append(predicates, contactpin.MaxUsesIn(i.MaxUsesIn...))
+	}
+	if len(i.MaxUsesNotIn) > 0 {
+		predicates = append(predicates, contactpin.MaxUsesNotIn(i.MaxUsesNotIn...))
+	}
+	if i.MaxUsesGT != nil {
+		predicates = append(predicates, contactpin.MaxUsesGT(*i.MaxUsesGT))
+	}
+	if i.MaxUsesGTE != nil {
+		predicates = append(predicates, contactpin.MaxUsesGTE(*i.MaxUsesGTE))
+	}
+	if i.MaxUsesLT != nil {
+		predicates = append(predicates, contactpin.MaxUsesLT(*i.MaxUsesLT))
+	}
+	if i.MaxUsesLTE != nil {
+		predicates = append(predicates, contactpin.MaxUsesLTE(*i.MaxUsesLTE))
+	}
+	if i.MaxUsesIsNil {
+		predicates = append(predicates, contactpin.MaxUsesIsNil())
+	}
+	if i.MaxUsesNotNil {
+		predicates = append(predicates, contactpin.MaxUsesNotNil())
+	}
+	if i.Uses != nil {
+		predicates = append(predicates, contactpin.UsesEQ(*i.Uses))
+	}
+	if i.UsesNEQ != nil {
+		predicates = append(predicates, contactpin.UsesNEQ(*i.UsesNEQ))
 	}
+	if len(i.UsesIn) > 0 {
+		predicates = append(predicates, contactpin.UsesIn(i.UsesIn...))
+	}
+	if len(i.UsesNotIn) > 0 {
+		predicates = append(predicates, contactpin.UsesNotIn(i.UsesNotIn...))
+	}
+	if i.UsesGT != nil {
+		predicates = append(predicates, contactpin.UsesGT(*i.UsesGT))
+	}
+	if i.UsesGTE != nil {
+		predicates = append(predicates, contactpin.UsesGTE(*i.UsesGTE))
+	}
+	if i.UsesLT != nil {
+		predicates = append(predicates, contactpin.UsesLT(*i.UsesLT))
+	}
+	if i.UsesLTE != nil {
+		predicates = append(predicates, contactpin.UsesLTE(*i.UsesLTE))
+	}
+	if i.RevokedAt != nil {
+		predicates = append(predicates, contactpin.RevokedAtEQ(*i.RevokedAt))
+	}
+	if i.RevokedAtNEQ != nil {
+		predicates = append(predicates, contactpin.RevokedAtNEQ(*i.RevokedAtNEQ))
+	}
+	if len(i.RevokedAtIn) > 0 {
+		predicates = append(predicates, contactpin.RevokedAtIn(i.RevokedAtIn...))
+	}
+	if len(i.RevokedAtNotIn) > 0 {
+		predicates = append(predicates, contactpin.RevokedAtNotIn(i.RevokedAtNotIn...))
+	}
+	if i.RevokedAtGT != nil {
+		predicates = append(predicates, contactpin.RevokedAtGT(*i.RevokedAtGT))
+	}
+	if i.RevokedAtGTE != nil {
+		predicates = append(predicates, contactpin.RevokedAtGTE(*i.RevokedAtGTE))
+	}
+	if i.RevokedAtLT != nil {
+		predicates = append(predicates, contactpin.RevokedAtLT(*i.RevokedAtLT))
+	}
+	if i.RevokedAtLTE != nil {
+		predicates = append(predicates, contactpin.RevokedAtLTE(*i.RevokedAtLTE))
+	}
+	if i.RevokedAtIsNil {
+		predicates = append(predicates, contactpin.RevokedAtIsNil())
+	}
+	if i.RevokedAtNotNil {
+		predicates = append(predicates, contactpin.RevokedAtNotNil())
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, contactpin.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, contactpin.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, contactpin.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, contactpin.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, contactpin.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, contactpin.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, contactpin.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, contactpin.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyMessageAttachmentWhereInput
+		return nil, ErrEmptyContactPinWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return messageattachment.And(predicates...), nil
+		return contactpin.And(predicates...), nil
 	}
 }
 
-// MessageLinkWhereInput represents a where input for filtering MessageLink queries.
-type MessageLinkWhereInput struct {
-	Predicates []predicate.MessageLink  `json:"-"`
-	Not        *MessageLinkWhereInput   `json:"not,omitempty"`
-	Or         []*MessageLinkWhereInput `json:"or,omitempty"`
-	And        []*MessageLinkWhereInput `json:"and,omitempty"`
+// ContactRequestWhereInput represents a where input for filtering ContactRequest queries.
+type ContactRequestWhereInput struct {
+	Predicates []predicate.ContactRequest  `json:"-"`
+	Not        *ContactRequestWhereInput   `json:"not,omitempty"`
+	Or         []*ContactRequestWhereInput `json:"or,omitempty"`
+	And        []*ContactRequestWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *pulid.ID  `json:"id,omitempty"`
@@ -1592,71 +1749,41 @@ type MessageLinkWhereInput struct {
 	IDLT    *pulid.ID  `json:"idLT,omitempty"`
 	IDLTE   *pulid.ID  `json:"idLTE,omitempty"`
 
-	// "link" field predicates.
-	Link             *string  `json:"link,omitempty"`
-	LinkNEQ          *string  `json:"linkNEQ,omitempty"`
-	LinkIn           []string `json:"linkIn,omitempty"`
-	LinkNotIn        []string `json:"linkNotIn,omitempty"`
-	LinkGT           *string  `json:"linkGT,omitempty"`
-	LinkGTE          *string  `json:"linkGTE,omitempty"`
-	LinkLT           *string  `json:"linkLT,omitempty"`
-	LinkLTE          *string  `json:"linkLTE,omitempty"`
-	LinkContains     *string  `json:"linkContains,omitempty"`
-	LinkHasPrefix    *string  `json:"linkHasPrefix,omitempty"`
-	LinkHasSuffix    *string  `json:"linkHasSuffix,omitempty"`
-	LinkEqualFold    *string  `json:"linkEqualFold,omitempty"`
-	LinkContainsFold *string  `json:"linkContainsFold,omitempty"`
-
-	// "title" field predicates.
-	Title             *string  `json:"title,omitempty"`
-	TitleNEQ          *string  `json:"titleNEQ,omitempty"`
-	TitleIn           []string `json:"titleIn,omitempty"`
-	TitleNotIn        []string `json:"titleNotIn,omitempty"`
-	TitleGT           *string  `json:"titleGT,omitempty"`
-	TitleGTE          *string  `json:"titleGTE,omitempty"`
-	TitleLT           *string  `json:"titleLT,omitempty"`
-	TitleLTE          *string  `json:"titleLTE,omitempty"`
-	TitleContains     *string  `json:"titleContains,omitempty"`
-	TitleHasPrefix    *string  `json:"titleHasPrefix,omitempty"`
-	TitleHasSuffix    *string  `json:"titleHasSuffix,omitempty"`
-	TitleIsNil        bool     `json:"titleIsNil,omitempty"`
-	TitleNotNil       bool     `json:"titleNotNil,omitempty"`
-	TitleEqualFold    *string  `json:"titleEqualFold,omitempty"`
-	TitleContainsFold *string  `json:"titleContainsFold,omitempty"`
-
-	// "description" field predicates.
-	Description             *string  `json:"description,omitempty"`
-	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
-	DescriptionIn           []string `json:"descriptionIn,omitempty"`
-	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
-	DescriptionGT           *string  `json:"descriptionGT,omitempty"`
-	DescriptionGTE          *string  `json:"descriptionGTE,omitempty"`
-	DescriptionLT           *string  `json:"descriptionLT,omitempty"`
-	DescriptionLTE          *string  `json:"descriptionLTE,omitempty"`
-	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
-	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
-	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
-	DescriptionIsNil        bool     `json:"descriptionIsNil,omitempty"`
-	DescriptionNotNil       bool     `json:"descriptionNotNil,omitempty"`
-	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
-	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
-
-	// "image_url" field predicates.
-	ImageURL             *string  `json:"imageURL,omitempty"`
-	ImageURLNEQ          *string  `json:"imageURLNEQ,omitempty"`
-	ImageURLIn           []string `json:"imageURLIn,omitempty"`
-	ImageURLNotIn        []string `json:"imageURLNotIn,omitempty"`
-	ImageURLGT           *string  `json:"imageURLGT,omitempty"`
-	ImageURLGTE          *string  `json:"imageURLGTE,omitempty"`
-	ImageURLLT           *string  `json:"imageURLLT,omitempty"`
-	ImageURLLTE          *string  `json:"imageURLLTE,omitempty"`
-	ImageURLContains     *string  `json:"imageURLContains,omitempty"`
-	ImageURLHasPrefix    *string  `json:"imageURLHasPrefix,omitempty"`
-	ImageURLHasSuffix    *string  `json:"imageURLHasSuffix,omitempty"`
-	ImageURLIsNil        bool     `json:"imageURLIsNil,omitempty"`
-	ImageURLNotNil       bool     `json:"imageURLNotNil,omitempty"`
-	ImageURLEqualFold    *string  `json:"imageURLEqualFold,omitempty"`
-	ImageURLContainsFold *string  `json:"imageURLContainsFold,omitempty"`
+	// "sender_id" field predicates.
+	SenderID             *pulid.ID  `json:"senderID,omitempty"`
+	SenderIDNEQ          *pulid.ID  `json:"senderIDNEQ,omitempty"`
+	SenderIDIn           []pulid.ID `json:"senderIDIn,omitempty"`
+	SenderIDNotIn        []pulid.ID `json:"senderIDNotIn,omitempty"`
+	SenderIDGT           *pulid.ID  `json:"senderIDGT,omitempty"`
+	SenderIDGTE          *pulid.ID  `json:"senderIDGTE,omitempty"`
+	SenderIDLT           *pulid.ID  `json:"senderIDLT,omitempty"`
+	SenderIDLTE          *pulid.ID  `json:"senderIDLTE,omitempty"`
+	SenderIDContains     *pulid.ID  `json:"senderIDContains,omitempty"`
+	SenderIDHasPrefix    *pulid.ID  `json:"senderIDHasPrefix,omitempty"`
+	SenderIDHasSuffix    *pulid.ID  `json:"senderIDHasSuffix,omitempty"`
+	SenderIDEqualFold    *pulid.ID  `json:"senderIDEqualFold,omitempty"`
+	SenderIDContainsFold *pulid.ID  `json:"senderIDContainsFold,omitempty"`
+
+	// "receiver_id" field predicates.
+	ReceiverID             *pulid.ID  `json:"receiverID,omitempty"`
+	ReceiverIDNEQ          *pulid.ID  `json:"receiverIDNEQ,omitempty"`
+	ReceiverIDIn           []pulid.ID `json:"receiverIDIn,omitempty"`
+	ReceiverIDNotIn        []pulid.ID `json:"receiverIDNotIn,omitempty"`
+	ReceiverIDGT           *pulid.ID  `json:"receiverIDGT,omitempty"`
+	ReceiverIDGTE          *pulid.ID  `json:"receiverIDGTE,omitempty"`
+	ReceiverIDLT           *pulid.ID  `json:"receiverIDLT,omitempty"`
+	ReceiverIDLTE          *pulid.ID  `json:"receiverIDLTE,omitempty"`
+	ReceiverIDContains     *pulid.ID  `json:"receiverIDContains,omitempty"`
+	ReceiverIDHasPrefix    *pulid.ID  `json:"receiverIDHasPrefix,omitempty"`
+	ReceiverIDHasSuffix    *pulid.ID  `json:"receiverIDHasSuffix,omitempty"`
+	ReceiverIDEqualFold    *pulid.ID  `json:"receiverIDEqualFold,omitempty"`
+	ReceiverIDContainsFold *pulid.ID  `json:"receiverIDContainsFold,omitempty"`
+
+	// "status" field predicates.
+	Status      *contactrequest.Status  `json:"status,omitempty"`
+	StatusNEQ   *contactrequest.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []contactrequest.Status `json:"statusIn,omitempty"`
+	StatusNotIn []contactrequest.Status `json:"statusNotIn,omitempty"`
 
 	// "created_at" field predicates.
 	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
@@ -1678,28 +1805,28 @@ type MessageLinkWhereInput struct {
 	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
 	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
 
-	// "room" edge predicates.
-	HasRoom     *bool             `json:"hasRoom,omitempty"`
-	HasRoomWith []*RoomWhereInput `json:"hasRoomWith,omitempty"`
+	// "sender" edge predicates.
+	HasSender     *bool             `json:"hasSender,omitempty"`
+	HasSenderWith []*UserWhereInput `json:"hasSenderWith,omitempty"`
 
-	// "message" edge predicates.
-	HasMessage     *bool                `json:"hasMessage,omitempty"`
-	HasMessageWith []*MessageWhereInput `json:"hasMessageWith,omitempty"`
+	// "receiver" edge predicates.
+	HasReceiver     *bool             `json:"hasReceiver,omitempty"`
+	HasReceiverWith []*UserWhereInput `json:"hasReceiverWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *MessageLinkWhereInput) AddPredicates(predicates ...predicate.MessageLink) {
+func (i *ContactRequestWhereInput) AddPredicates(predicates ...predicate.ContactRequest) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the MessageLinkWhereInput filter on the MessageLinkQuery builder.
-func (i *MessageLinkWhereInput) Filter(q *MessageLinkQuery) (*MessageLinkQuery, error) {
+// Filter applies the ContactRequestWhereInput filter on the ContactRequestQuery builder.
+func (i *ContactRequestWhereInput) Filter(q *ContactRequestQuery) (*ContactRequestQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyMessageLinkWhereInput {
+		if err == ErrEmptyContactRequestWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -1707,19 +1834,19 @@ func (i *MessageLinkWhereInput) Filter(q *MessageLinkQuery) (*MessageLinkQuery,
 	return q.Where(p), nil
 }
 
-// ErrEmptyMessageLinkWhereInput is returned in case the MessageLinkWhereInput is empty.
-var ErrEmptyMessageLinkWhereInput = errors.New("ent: empty predicate MessageLinkWhereInput")
+// ErrEmptyContactRequestWhereInput is returned in case the ContactRequestWhereInput is empty.
+var ErrEmptyContactRequestWhereInput = errors.New("ent: empty predicate ContactRequestWhereInput")
 
-// P returns a predicate for filtering messagelinks.
+// P returns a predicate for filtering contactrequests.
 // An error is returned if the input is empty or invalid.
-func (i *MessageLinkWhereInput) P() (predicate.MessageLink, error) {
-	var predicates []predicate.MessageLink
+func (i *ContactRequestWhereInput) P() (predicate.ContactRequest, error) {
+	var predicates []predicate.ContactRequest
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, messagelink.Not(p))
+		predicates = append(predicates, contactrequest.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -1729,7 +1856,7 @@ func (i *MessageLinkWhereInput) P() (predicate.MessageLink, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.MessageLink, 0, n)
+		or := make([]predicate.ContactRequest, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -1737,7 +1864,7 @@ func (i *MessageLinkWhereInput) P() (predicate.MessageLink, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, messagelink.Or(or...))
+		predicates = append(predicates, contactrequest.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -1747,7 +1874,7 @@ func (i *MessageLinkWhereInput) P() (predicate.MessageLink, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.MessageLink, 0, n)
+		and := make([]predicate.ContactRequest, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -1755,308 +1882,224 @@ func (i *MessageLinkWhereInput) P() (predicate.MessageLink, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, messagelink.And(and...))
+		predicates = append(predicates, contactrequest.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, messagelink.IDEQ(*i.ID))
+		predicates = append(predicates, contactrequest.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, messagelink.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, contactrequest.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, messagelink.IDIn(i.IDIn...))
+		predicates = append(predicates, contactrequest.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, messagelink.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, contactrequest.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, messagelink.IDGT(*i.IDGT))
+		predicates = append(predicates, contactrequest.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, messagelink.IDGTE(*i.IDGTE))
+		predicates = append(predicates, contactrequest.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, messagelink.IDLT(*i.IDLT))
+		predicates = append(predicates, contactrequest.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, messagelink.IDLTE(*i.IDLTE))
-	}
-	if i.Link != nil {
-		predicates = append(predicates, messagelink.LinkEQ(*i.Link))
-	}
-	if i.LinkNEQ != nil {
-		predicates = append(predicates, messagelink.LinkNEQ(*i.LinkNEQ))
-	}
-	if len(i.LinkIn) > 0 {
-		predicates = append(predicates, messagelink.LinkIn(i.LinkIn...))
-	}
-	if len(i.LinkNotIn) > 0 {
-		predicates = append(predicates, messagelink.LinkNotIn(i.LinkNotIn...))
-	}
-	if i.LinkGT != nil {
-		predicates = append(predicates, messagelink.LinkGT(*i.LinkGT))
-	}
-	if i.LinkGTE != nil {
-		predicates = append(predicates, messagelink.LinkGTE(*i.LinkGTE))
-	}
-	if i.LinkLT != nil {
-		predicates = append(predicates, messagelink.LinkLT(*i.LinkLT))
-	}
-	if i.LinkLTE != nil {
-		predicates = append(predicates, messagelink.LinkLTE(*i.LinkLTE))
-	}
-	if i.LinkContains != nil {
-		predicates = append(predicates, messagelink.LinkContains(*i.LinkContains))
-	}
-	if i.LinkHasPrefix != nil {
-		predicates = append(predicates, messagelink.LinkHasPrefix(*i.LinkHasPrefix))
-	}
-	if i.LinkHasSuffix != nil {
-		predicates = append(predicates, messagelink.LinkHasSuffix(*i.LinkHasSuffix))
-	}
-	if i.LinkEqualFold != nil {
-		predicates = append(predicates, messagelink.LinkEqualFold(*i.LinkEqualFold))
-	}
-	if i.LinkContainsFold != nil {
-		predicates = append(predicates, messagelink.LinkContainsFold(*i.LinkContainsFold))
-	}
-	if i.Title != nil {
-		predicates = append(predicates, messagelink.TitleEQ(*i.Title))
-	}
-	if i.TitleNEQ != nil {
-		predicates = append(predicates, messagelink.TitleNEQ(*i.TitleNEQ))
-	}
-	if len(i.TitleIn) > 0 {
-		predicates = append(predicates, messagelink.TitleIn(i.TitleIn...))
-	}
-	if len(i.TitleNotIn) > 0 {
-		predicates = append(predicates, messagelink.TitleNotIn(i.TitleNotIn...))
-	}
-	if i.TitleGT != nil {
-		predicates = append(predicates, messagelink.TitleGT(*i.TitleGT))
-	}
-	if i.TitleGTE != nil {
-		predicates = append(predicates, messagelink.TitleGTE(*i.TitleGTE))
-	}
-	if i.TitleLT != nil {
-		predicates = append(predicates, messagelink.TitleLT(*i.TitleLT))
-	}
-	if i.TitleLTE != nil {
-		predicates = append(predicates, messagelink.TitleLTE(*i.TitleLTE))
-	}
-	if i.TitleContains != nil {
-		predicates = append(predicates, messagelink.TitleContains(*i.TitleContains))
-	}
-	if i.TitleHasPrefix != nil {
-		predicates = append(predicates, messagelink.TitleHasPrefix(*i.TitleHasPrefix))
-	}
-	if i.TitleHasSuffix != nil {
-		predicates = append(predicates, messagelink.TitleHasSuffix(*i.TitleHasSuffix))
-	}
-	if i.TitleIsNil {
-		predicates = append(predicates, messagelink.TitleIsNil())
-	}
-	if i.TitleNotNil {
-		predicates = append(predicates, messagelink.TitleNotNil())
-	}
-	if i.TitleEqualFold != nil {
-		predicates = append(predicates, messagelink.TitleEqualFold(*i.TitleEqualFold))
-	}
-	if i.TitleContainsFold != nil {
-		predicates = append(predicates, messagelink.TitleContainsFold(*i.TitleContainsFold))
+		predicates = append(predicates, contactrequest.IDLTE(*i.IDLTE))
 	}
-	if i.Description != nil {
-		predicates = append(predicates, messagelink.DescriptionEQ(*i.Description))
+	if i.SenderID != nil {
+		predicates = append(predicates, contactrequest.SenderIDEQ(*i.SenderID))
 	}
-	if i.DescriptionNEQ != nil {
-		predicates = append(predicates, messagelink.DescriptionNEQ(*i.DescriptionNEQ))
+	if i.SenderIDNEQ != nil {
+		predicates = append(predicates, contactrequest.SenderIDNEQ(*i.SenderIDNEQ))
 	}
-	if len(i.DescriptionIn) > 0 {
-		predicates = append(predicates, messagelink.DescriptionIn(i.DescriptionIn...))
+	if len(i.SenderIDIn) > 0 {
+		predicates = append(predicates, contactrequest.SenderIDIn(i.SenderIDIn...))
 	}
-	if len(i.DescriptionNotIn) > 0 {
-		predicates = append(predicates, messagelink.DescriptionNotIn(i.DescriptionNotIn...))
+	if len(i.SenderIDNotIn) > 0 {
+		predicates = append(predicates, contactrequest.SenderIDNotIn(i.SenderIDNotIn...))
 	}
-	if i.DescriptionGT != nil {
-		predicates = append(predicates, messagelink.DescriptionGT(*i.DescriptionGT))
+	if i.SenderIDGT != nil {
+		predicates = append(predicates, contactrequest.SenderIDGT(*i.SenderIDGT))
 	}
-	if i.DescriptionGTE != nil {
-		predicates = append(predicates, messagelink.DescriptionGTE(*i.DescriptionGTE))
+	if i.SenderIDGTE != nil {
+		predicates = append(predicates, contactrequest.SenderIDGTE(*i.SenderIDGTE))
 	}
-	if i.DescriptionLT != nil {
-		predicates = append(predicates, messagelink.DescriptionLT(*i.DescriptionLT))
+	if i.SenderIDLT != nil {
+		predicates = append(predicates, contactrequest.SenderIDLT(*i.SenderIDLT))
 	}
-	if i.DescriptionLTE != nil {
-		predicates = append(predicates, messagelink.DescriptionLTE(*i.DescriptionLTE))
+	if i.SenderIDLTE != nil {
+		predicates = append(predicates, contactrequest.SenderIDLTE(*i.SenderIDLTE))
 	}
-	if i.DescriptionContains != nil {
-		predicates = append(predicates, messagelink.DescriptionContains(*i.DescriptionContains))
+	if i.SenderIDContains != nil {
+		predicates = append(predicates, contactrequest.SenderIDContains(*i.SenderIDContains))
 	}
-	if i.DescriptionHasPrefix != nil {
-		predicates = append(predicates, messagelink.DescriptionHasPrefix(*i.DescriptionHasPrefix))
+	if i.SenderIDHasPrefix != nil {
+		predicates = append(predicates, contactrequest.SenderIDHasPrefix(*i.SenderIDHasPrefix))
 	}
-	if i.DescriptionHasSuffix != nil {
-		predicates = append(predicates, messagelink.DescriptionHasSuffix(*i.DescriptionHasSuffix))
+	if i.SenderIDHasSuffix != nil {
+		predicates = append(predicates, contactrequest.SenderIDHasSuffix(*i.SenderIDHasSuffix))
 	}
-	if i.DescriptionIsNil {
-		predicates = append(predicates, messagelink.DescriptionIsNil())
+	if i.SenderIDEqualFold != nil {
+		predicates = append(predicates, contactrequest.SenderIDEqualFold(*i.SenderIDEqualFold))
 	}
-	if i.DescriptionNotNil {
-		predicates = append(predicates, messagelink.DescriptionNotNil())
+	if i.SenderIDContainsFold != nil {
+		predicates = append(predicates, contactrequest.SenderIDContainsFold(*i.SenderIDContainsFold))
 	}
-	if i.DescriptionEqualFold != nil {
-		predicates = append(predicates, messagelink.DescriptionEqualFold(*i.DescriptionEqualFold))
+	if i.ReceiverID != nil {
+		predicates = append(predicates, contactrequest.ReceiverIDEQ(*i.ReceiverID))
 	}
-	if i.DescriptionContainsFold != nil {
-		predicates = append(predicates, messagelink.DescriptionContainsFold(*i.DescriptionContainsFold))
+	if i.ReceiverIDNEQ != nil {
+		predicates = append(predicates, contactrequest.ReceiverIDNEQ(*i.ReceiverIDNEQ))
 	}
-	if i.ImageURL != nil {
-		predicates = append(predicates, messagelink.ImageURLEQ(*i.ImageURL))
+	if len(i.ReceiverIDIn) > 0 {
+		predicates = append(predicates, contactrequest.ReceiverIDIn(i.ReceiverIDIn...))
 	}
-	if i.ImageURLNEQ != nil {
-		predicates = append(predicates, messagelink.ImageURLNEQ(*i.ImageURLNEQ))
+	if len(i.ReceiverIDNotIn) > 0 {
+		predicates = append(predicates, contactrequest.ReceiverIDNotIn(i.ReceiverIDNotIn...))
 	}
-	if len(i.ImageURLIn) > 0 {
-		predicates = append(predicates, messagelink.ImageURLIn(i.ImageURLIn...))
+	if i.ReceiverIDGT != nil {
+		predicates = append(predicates, contactrequest.ReceiverIDGT(*i.ReceiverIDGT))
 	}
-	if len(i.ImageURLNotIn) > 0 {
-		predicates = append(predicates, messagelink.ImageURLNotIn(i.ImageURLNotIn...))
+	if i.ReceiverIDGTE != nil {
+		predicates = append(predicates, contactrequest.ReceiverIDGTE(*i.ReceiverIDGTE))
 	}
-	if i.ImageURLGT != nil {
-		predicates = append(predicates, messagelink.ImageURLGT(*i.ImageURLGT))
+	if i.ReceiverIDLT != nil {
+		predicates = append(predicates, contactrequest.ReceiverIDLT(*i.ReceiverIDLT))
 	}
-	if i.ImageURLGTE != nil {
-		predicates = append(predicates, messagelink.ImageURLGTE(*i.ImageURLGTE))
+	if i.ReceiverIDLTE != nil {
+		predicates = append(predicates, contactrequest.ReceiverIDLTE(*i.ReceiverIDLTE))
 	}
-	if i.ImageURLLT != nil {
-		predicates = append(predicates, messagelink.ImageURLLT(*i.ImageURLLT))
+	if i.ReceiverIDContains != nil {
+		predicates = append(predicates, contactrequest.ReceiverIDContains(*i.ReceiverIDContains))
 	}
-	if i.ImageURLLTE != nil {
-		predicates = append(predicates, messagelink.ImageURLLTE(*i.ImageURLLTE))
+	if i.ReceiverIDHasPrefix != nil {
+		predicates = append(predicates, contactrequest.ReceiverIDHasPrefix(*i.ReceiverIDHasPrefix))
 	}
-	if i.ImageURLContains != nil {
-		predicates = append(predicates, messagelink.ImageURLContains(*i.ImageURLContains))
+	if i.ReceiverIDHasSuffix != nil {
+		predicates = append(predicates, contactrequest.ReceiverIDHasSuffix(*i.ReceiverIDHasSuffix))
 	}
-	if i.ImageURLHasPrefix != nil {
-		predicates = append(predicates, messagelink.ImageURLHasPrefix(*i.ImageURLHasPrefix))
+	if i.ReceiverIDEqualFold != nil {
+		predicates = append(predicates, contactrequest.ReceiverIDEqualFold(*i.ReceiverIDEqualFold))
 	}
-	if i.ImageURLHasSuffix != nil {
-		predicates = append(predicates, messagelink.ImageURLHasSuffix(*i.ImageURLHasSuffix))
+	if i.ReceiverIDContainsFold != nil {
+		predicates = append(predicates, contactrequest.ReceiverIDContainsFold(*i.ReceiverIDContainsFold))
 	}
-	if i.ImageURLIsNil {
-		predicates = append(predicates, messagelink.ImageURLIsNil())
+	if i.Status != nil {
+		predicates = append(predicates, contactrequest.StatusEQ(*i.Status))
 	}
-	if i.ImageURLNotNil {
-		predicates = append(predicates, messagelink.ImageURLNotNil())
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, contactrequest.StatusNEQ(*i.StatusNEQ))
 	}
-	if i.ImageURLEqualFold != nil {
-		predicates = append(predicates, messagelink.ImageURLEqualFold(*i.ImageURLEqualFold))
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, contactrequest.StatusIn(i.StatusIn...))
 	}
-	if i.ImageURLContainsFold != nil {
-		predicates = append(predicates, messagelink.ImageURLContainsFold(*i.ImageURLContainsFold))
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, contactrequest.StatusNotIn(i.StatusNotIn...))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, messagelink.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, contactrequest.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, messagelink.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, contactrequest.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, messagelink.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, contactrequest.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, messagelink.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, contactrequest.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, messagelink.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, contactrequest.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, messagelink.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, contactrequest.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, messagelink.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, contactrequest.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, messagelink.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, contactrequest.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, messagelink.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, contactrequest.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, messagelink.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, contactrequest.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, messagelink.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, contactrequest.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, messagelink.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, contactrequest.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, messagelink.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, contactrequest.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, messagelink.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, contactrequest.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, messagelink.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, contactrequest.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, messagelink.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, contactrequest.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
 
-	if i.HasRoom != nil {
-		p := messagelink.HasRoom()
-		if !*i.HasRoom {
-			p = messagelink.Not(p)
+	if i.HasSender != nil {
+		p := contactrequest.HasSender()
+		if !*i.HasSender {
+			p = contactrequest.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasRoomWith) > 0 {
-		with := make([]predicate.Room, 0, len(i.HasRoomWith))
-		for _, w := range i.HasRoomWith {
+	if len(i.HasSenderWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasSenderWith))
+		for _, w := range i.HasSenderWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasRoomWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasSenderWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, messagelink.HasRoomWith(with...))
+		predicates = append(predicates, contactrequest.HasSenderWith(with...))
 	}
-	if i.HasMessage != nil {
-		p := messagelink.HasMessage()
-		if !*i.HasMessage {
-			p = messagelink.Not(p)
+	if i.HasReceiver != nil {
+		p := contactrequest.HasReceiver()
+		if !*i.HasReceiver {
+			p = contactrequest.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasMessageWith) > 0 {
-		with := make([]predicate.Message, 0, len(i.HasMessageWith))
-		for _, w := range i.HasMessageWith {
+	if len(i.HasReceiverWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasReceiverWith))
+		for _, w := range i.HasReceiverWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasMessageWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasReceiverWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, messagelink.HasMessageWith(with...))
+		predicates = append(predicates, contactrequest.HasReceiverWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyMessageLinkWhereInput
+		return nil, ErrEmptyContactRequestWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return messagelink.And(predicates...), nil
+		return contactrequest.And(predicates...), nil
 	}
 }
 
-// MessageVoiceWhereInput represents a where input for filtering MessageVoice queries.
-type MessageVoiceWhereInput struct {
-	Predicates []predicate.MessageVoice  `json:"-"`
-	Not        *MessageVoiceWhereInput   `json:"not,omitempty"`
-	Or         []*MessageVoiceWhereInput `json:"or,omitempty"`
-	And        []*MessageVoiceWhereInput `json:"and,omitempty"`
+// DeviceWhereInput represents a where input for filtering Device queries.
+type DeviceWhereInput struct {
+	Predicates []predicate.Device  `json:"-"`
+	Not        *DeviceWhereInput   `json:"not,omitempty"`
+	Or         []*DeviceWhereInput `json:"or,omitempty"`
+	And        []*DeviceWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *pulid.ID  `json:"id,omitempty"`
@@ -2068,52 +2111,94 @@ type MessageVoiceWhereInput struct {
 	IDLT    *pulid.ID  `json:"idLT,omitempty"`
 	IDLTE   *pulid.ID  `json:"idLTE,omitempty"`
 
-	// "length" field predicates.
-	Length      *uint64  `json:"length,omitempty"`
-	LengthNEQ   *uint64  `json:"lengthNEQ,omitempty"`
-	LengthIn    []uint64 `json:"lengthIn,omitempty"`
-	LengthNotIn []uint64 `json:"lengthNotIn,omitempty"`
-	LengthGT    *uint64  `json:"lengthGT,omitempty"`
-	LengthGTE   *uint64  `json:"lengthGTE,omitempty"`
-	LengthLT    *uint64  `json:"lengthLT,omitempty"`
-	LengthLTE   *uint64  `json:"lengthLTE,omitempty"`
+	// "device_id" field predicates.
+	DeviceID             *string  `json:"deviceID,omitempty"`
+	DeviceIDNEQ          *string  `json:"deviceIDNEQ,omitempty"`
+	DeviceIDIn           []string `json:"deviceIDIn,omitempty"`
+	DeviceIDNotIn        []string `json:"deviceIDNotIn,omitempty"`
+	DeviceIDGT           *string  `json:"deviceIDGT,omitempty"`
+	DeviceIDGTE          *string  `json:"deviceIDGTE,omitempty"`
+	DeviceIDLT           *string  `json:"deviceIDLT,omitempty"`
+	DeviceIDLTE          *string  `json:"deviceIDLTE,omitempty"`
+	DeviceIDContains     *string  `json:"deviceIDContains,omitempty"`
+	DeviceIDHasPrefix    *string  `json:"deviceIDHasPrefix,omitempty"`
+	DeviceIDHasSuffix    *string  `json:"deviceIDHasSuffix,omitempty"`
+	DeviceIDEqualFold    *string  `json:"deviceIDEqualFold,omitempty"`
+	DeviceIDContainsFold *string  `json:"deviceIDContainsFold,omitempty"`
 
-	// "attached_at" field predicates.
-	AttachedAt      *time.Time  `json:"attachedAt,omitempty"`
-	AttachedAtNEQ   *time.Time  `json:"attachedAtNEQ,omitempty"`
-	AttachedAtIn    []time.Time `json:"attachedAtIn,omitempty"`
-	AttachedAtNotIn []time.Time `json:"attachedAtNotIn,omitempty"`
-	AttachedAtGT    *time.Time  `json:"attachedAtGT,omitempty"`
-	AttachedAtGTE   *time.Time  `json:"attachedAtGTE,omitempty"`
-	AttachedAtLT    *time.Time  `json:"attachedAtLT,omitempty"`
-	AttachedAtLTE   *time.Time  `json:"attachedAtLTE,omitempty"`
+	// "platform" field predicates.
+	Platform      *device.Platform  `json:"platform,omitempty"`
+	PlatformNEQ   *device.Platform  `json:"platformNEQ,omitempty"`
+	PlatformIn    []device.Platform `json:"platformIn,omitempty"`
+	PlatformNotIn []device.Platform `json:"platformNotIn,omitempty"`
+
+	// "last_push_at" field predicates.
+	LastPushAt       *time.Time  `json:"lastPushAt,omitempty"`
+	LastPushAtNEQ    *time.Time  `json:"lastPushAtNEQ,omitempty"`
+	LastPushAtIn     []time.Time `json:"lastPushAtIn,omitempty"`
+	LastPushAtNotIn  []time.Time `json:"lastPushAtNotIn,omitempty"`
+	LastPushAtGT     *time.Time  `json:"lastPushAtGT,omitempty"`
+	LastPushAtGTE    *time.Time  `json:"lastPushAtGTE,omitempty"`
+	LastPushAtLT     *time.Time  `json:"lastPushAtLT,omitempty"`
+	LastPushAtLTE    *time.Time  `json:"lastPushAtLTE,omitempty"`
+	LastPushAtIsNil  bool        `json:"lastPushAtIsNil,omitempty"`
+	LastPushAtNotNil bool        `json:"lastPushAtNotNil,omitempty"`
+
+	// "last_push_error" field predicates.
+	LastPushError             *string  `json:"lastPushError,omitempty"`
+	LastPushErrorNEQ          *string  `json:"lastPushErrorNEQ,omitempty"`
+	LastPushErrorIn           []string `json:"lastPushErrorIn,omitempty"`
+	LastPushErrorNotIn        []string `json:"lastPushErrorNotIn,omitempty"`
+	LastPushErrorGT           *string  `json:"lastPushErrorGT,omitempty"`
+	LastPushErrorGTE          *string  `json:"lastPushErrorGTE,omitempty"`
+	LastPushErrorLT           *string  `json:"lastPushErrorLT,omitempty"`
+	LastPushErrorLTE          *string  `json:"lastPushErrorLTE,omitempty"`
+	LastPushErrorContains     *string  `json:"lastPushErrorContains,omitempty"`
+	LastPushErrorHasPrefix    *string  `json:"lastPushErrorHasPrefix,omitempty"`
+	LastPushErrorHasSuffix    *string  `json:"lastPushErrorHasSuffix,omitempty"`
+	LastPushErrorIsNil        bool     `json:"lastPushErrorIsNil,omitempty"`
+	LastPushErrorNotNil       bool     `json:"lastPushErrorNotNil,omitempty"`
+	LastPushErrorEqualFold    *string  `json:"lastPushErrorEqualFold,omitempty"`
+	LastPushErrorContainsFold *string  `json:"lastPushErrorContainsFold,omitempty"`
 
-	// "room" edge predicates.
-	HasRoom     *bool             `json:"hasRoom,omitempty"`
-	HasRoomWith []*RoomWhereInput `json:"hasRoomWith,omitempty"`
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
 
-	// "message" edge predicates.
-	HasMessage     *bool                `json:"hasMessage,omitempty"`
-	HasMessageWith []*MessageWhereInput `json:"hasMessageWith,omitempty"`
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
 
-	// "file" edge predicates.
-	HasFile     *bool             `json:"hasFile,omitempty"`
-	HasFileWith []*FileWhereInput `json:"hasFileWith,omitempty"`
+	// "user" edge predicates.
+	HasUser     *bool             `json:"hasUser,omitempty"`
+	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *MessageVoiceWhereInput) AddPredicates(predicates ...predicate.MessageVoice) {
+func (i *DeviceWhereInput) AddPredicates(predicates ...predicate.Device) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the MessageVoiceWhereInput filter on the MessageVoiceQuery builder.
-func (i *MessageVoiceWhereInput) Filter(q *MessageVoiceQuery) (*MessageVoiceQuery, error) {
+// Filter applies the DeviceWhereInput filter on the DeviceQuery builder.
+func (i *DeviceWhereInput) Filter(q *DeviceQuery) (*DeviceQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyMessageVoiceWhereInput {
+		if err == ErrEmptyDeviceWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -2121,19 +2206,19 @@ func (i *MessageVoiceWhereInput) Filter(q *MessageVoiceQuery) (*MessageVoiceQuer
 	return q.Where(p), nil
 }
 
-// ErrEmptyMessageVoiceWhereInput is returned in case the MessageVoiceWhereInput is empty.
-var ErrEmptyMessageVoiceWhereInput = errors.New("ent: empty predicate MessageVoiceWhereInput")
+// ErrEmptyDeviceWhereInput is returned in case the DeviceWhereInput is empty.
+var ErrEmptyDeviceWhereInput = errors.New("ent: empty predicate DeviceWhereInput")
 
-// P returns a predicate for filtering messagevoices.
+// P returns a predicate for filtering devices.
 // An error is returned if the input is empty or invalid.
-func (i *MessageVoiceWhereInput) P() (predicate.MessageVoice, error) {
-	var predicates []predicate.MessageVoice
+func (i *DeviceWhereInput) P() (predicate.Device, error) {
+	var predicates []predicate.Device
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, messagevoice.Not(p))
+		predicates = append(predicates, device.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -2143,7 +2228,7 @@ func (i *MessageVoiceWhereInput) P() (predicate.MessageVoice, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.MessageVoice, 0, n)
+		or := make([]predicate.Device, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -2151,7 +2236,7 @@ func (i *MessageVoiceWhereInput) P() (predicate.MessageVoice, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, messagevoice.Or(or...))
+		predicates = append(predicates, device.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -2161,7 +2246,7 @@ func (i *MessageVoiceWhereInput) P() (predicate.MessageVoice, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.MessageVoice, 0, n)
+		and := make([]predicate.Device, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -2169,152 +2254,242 @@ func (i *MessageVoiceWhereInput) P() (predicate.MessageVoice, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, messagevoice.And(and...))
+		predicates = append(predicates, device.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, messagevoice.IDEQ(*i.ID))
+		predicates = append(predicates, device.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, messagevoice.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, device.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, messagevoice.IDIn(i.IDIn...))
+		predicates = append(predicates, device.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, messagevoice.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, device.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, messagevoice.IDGT(*i.IDGT))
+		predicates = append(predicates, device.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, messagevoice.IDGTE(*i.IDGTE))
+		predicates = append(predicates, device.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, messagevoice.IDLT(*i.IDLT))
+		predicates = append(predicates, device.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, messagevoice.IDLTE(*i.IDLTE))
+		predicates = append(predicates, device.IDLTE(*i.IDLTE))
 	}
-	if i.Length != nil {
-		predicates = append(predicates, messagevoice.LengthEQ(*i.Length))
+	if i.DeviceID != nil {
+		predicates = append(predicates, device.DeviceIDEQ(*i.DeviceID))
 	}
-	if i.LengthNEQ != nil {
-		predicates = append(predicates, messagevoice.LengthNEQ(*i.LengthNEQ))
+	if i.DeviceIDNEQ != nil {
+		predicates = append(predicates, device.DeviceIDNEQ(*i.DeviceIDNEQ))
 	}
-	if len(i.LengthIn) > 0 {
-		predicates = append(predicates, messagevoice.LengthIn(i.LengthIn...))
+	if len(i.DeviceIDIn) > 0 {
+		predicates = append(predicates, device.DeviceIDIn(i.DeviceIDIn...))
 	}
-	if len(i.LengthNotIn) > 0 {
-		predicates = append(predicates, messagevoice.LengthNotIn(i.LengthNotIn...))
+	if len(i.DeviceIDNotIn) > 0 {
+		predicates = append(predicates, device.DeviceIDNotIn(i.DeviceIDNotIn...))
 	}
-	if i.LengthGT != nil {
-		predicates = append(predicates, messagevoice.LengthGT(*i.LengthGT))
+	if i.DeviceIDGT != nil {
+		predicates = append(predicates, device.DeviceIDGT(*i.DeviceIDGT))
 	}
-	if i.LengthGTE != nil {
-		predicates = append(predicates, messagevoice.LengthGTE(*i.LengthGTE))
+	if i.DeviceIDGTE != nil {
+		predicates = append(predicates, device.DeviceIDGTE(*i.DeviceIDGTE))
 	}
-	if i.LengthLT != nil {
-		predicates = append(predicates, messagevoice.LengthLT(*i.LengthLT))
+	if i.DeviceIDLT != nil {
+		predicates = append(predicates, device.DeviceIDLT(*i.DeviceIDLT))
 	}
-	if i.LengthLTE != nil {
-		predicates = append(predicates, messagevoice.LengthLTE(*i.LengthLTE))
+	if i.DeviceIDLTE != nil {
+		predicates = append(predicates, device.DeviceIDLTE(*i.DeviceIDLTE))
 	}
-	if i.AttachedAt != nil {
-		predicates = append(predicates, messagevoice.AttachedAtEQ(*i.AttachedAt))
+	if i.DeviceIDContains != nil {
+		predicates = append(predicates, device.DeviceIDContains(*i.DeviceIDContains))
 	}
-	if i.AttachedAtNEQ != nil {
-		predicates = append(predicates, messagevoice.AttachedAtNEQ(*i.AttachedAtNEQ))
+	if i.DeviceIDHasPrefix != nil {
+		predicates = append(predicates, device.DeviceIDHasPrefix(*i.DeviceIDHasPrefix))
 	}
-	if len(i.AttachedAtIn) > 0 {
-		predicates = append(predicates, messagevoice.AttachedAtIn(i.AttachedAtIn...))
+	if i.DeviceIDHasSuffix != nil {
+		predicates = append(predicates, device.DeviceIDHasSuffix(*i.DeviceIDHasSuffix))
 	}
-	if len(i.AttachedAtNotIn) > 0 {
-		predicates = append(predicates, messagevoice.AttachedAtNotIn(i.AttachedAtNotIn...))
+	if i.DeviceIDEqualFold != nil {
+		predicates = append(predicates, device.DeviceIDEqualFold(*i.DeviceIDEqualFold))
 	}
-	if i.AttachedAtGT != nil {
-		predicates = append(predicates, messagevoice.AttachedAtGT(*i.AttachedAtGT))
+	if i.DeviceIDContainsFold != nil {
+		predicates = append(predicates, device.DeviceIDContainsFold(*i.DeviceIDContainsFold))
 	}
-	if i.AttachedAtGTE != nil {
-		predicates = append(predicates, messagevoice.AttachedAtGTE(*i.AttachedAtGTE))
+	if i.Platform != nil {
+		predicates = append(predicates, device.PlatformEQ(*i.Platform))
 	}
-	if i.AttachedAtLT != nil {
-		predicates = append(predicates, messagevoice.AttachedAtLT(*i.AttachedAtLT))
+	if i.PlatformNEQ != nil {
+		predicates = append(predicates, device.PlatformNEQ(*i.PlatformNEQ))
 	}
-	if i.AttachedAtLTE != nil {
-		predicates = append(predicates, messagevoice.AttachedAtLTE(*i.AttachedAtLTE))
+	if len(i.PlatformIn) > 0 {
+		predicates = append(predicates, device.PlatformIn(i.PlatformIn...))
 	}
-
-	if i.HasRoom != nil {
-		p := messagevoice.HasRoom()
-		if !*i.HasRoom {
-			p = messagevoice.Not(p)
-		}
-		predicates = append(predicates, p)
+	if len(i.PlatformNotIn) > 0 {
+		predicates = append(predicates, device.PlatformNotIn(i.PlatformNotIn...))
 	}
-	if len(i.HasRoomWith) > 0 {
-		with := make([]predicate.Room, 0, len(i.HasRoomWith))
-		for _, w := range i.HasRoomWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasRoomWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, messagevoice.HasRoomWith(with...))
+	if i.LastPushAt != nil {
+		predicates = append(predicates, device.LastPushAtEQ(*i.LastPushAt))
 	}
-	if i.HasMessage != nil {
-		p := messagevoice.HasMessage()
-		if !*i.HasMessage {
-			p = messagevoice.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.LastPushAtNEQ != nil {
+		predicates = append(predicates, device.LastPushAtNEQ(*i.LastPushAtNEQ))
 	}
-	if len(i.HasMessageWith) > 0 {
-		with := make([]predicate.Message, 0, len(i.HasMessageWith))
-		for _, w := range i.HasMessageWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasMessageWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, messagevoice.HasMessageWith(with...))
+	if len(i.LastPushAtIn) > 0 {
+		predicates = append(predicates, device.LastPushAtIn(i.LastPushAtIn...))
 	}
-	if i.HasFile != nil {
-		p := messagevoice.HasFile()
-		if !*i.HasFile {
-			p = messagevoice.Not(p)
+	if len(i.LastPushAtNotIn) > 0 {
+		predicates = append(predicates, device.LastPushAtNotIn(i.LastPushAtNotIn...))
+	}
+	if i.LastPushAtGT != nil {
+		predicates = append(predicates, device.LastPushAtGT(*i.LastPushAtGT))
+	}
+	if i.LastPushAtGTE != nil {
+		predicates = append(predicates, device.LastPushAtGTE(*i.LastPushAtGTE))
+	}
+	if i.LastPushAtLT != nil {
+		predicates = append(predicates, device.LastPushAtLT(*i.LastPushAtLT))
+	}
+	if i.LastPushAtLTE != nil {
+		predicates = append(predicates, device.LastPushAtLTE(*i.LastPushAtLTE))
+	}
+	if i.LastPushAtIsNil {
+		predicates = append(predicates, device.LastPushAtIsNil())
+	}
+	if i.LastPushAtNotNil {
+		predicates = append(predicates, device.LastPushAtNotNil())
+	}
+	if i.LastPushError != nil {
+		predicates = append(predicates, device.LastPushErrorEQ(*i.LastPushError))
+	}
+	if i.LastPushErrorNEQ != nil {
+		predicates = append(predicates, device.LastPushErrorNEQ(*i.LastPushErrorNEQ))
+	}
+	if len(i.LastPushErrorIn) > 0 {
+		predicates = append(predicates, device.LastPushErrorIn(i.LastPushErrorIn...))
+	}
+	if len(i.LastPushErrorNotIn) > 0 {
+		predicates = append(predicates, device.LastPushErrorNotIn(i.LastPushErrorNotIn...))
+	}
+	if i.LastPushErrorGT != nil {
+		predicates = append(predicates, device.LastPushErrorGT(*i.LastPushErrorGT))
+	}
+	if i.LastPushErrorGTE != nil {
+		predicates = append(predicates, device.LastPushErrorGTE(*i.LastPushErrorGTE))
+	}
+	if i.LastPushErrorLT != nil {
+		predicates = append(predicates, device.LastPushErrorLT(*i.LastPushErrorLT))
+	}
+	if i.LastPushErrorLTE != nil {
+		predicates = append(predicates, device.LastPushErrorLTE(*i.LastPushErrorLTE))
+	}
+	if i.LastPushErrorContains != nil {
+		predicates = append(predicates, device.LastPushErrorContains(*i.LastPushErrorContains))
+	}
+	if i.LastPushErrorHasPrefix != nil {
+		predicates = append(predicates, device.LastPushErrorHasPrefix(*i.LastPushErrorHasPrefix))
+	}
+	if i.LastPushErrorHasSuffix != nil {
+		predicates = append(predicates, device.LastPushErrorHasSuffix(*i.LastPushErrorHasSuffix))
+	}
+	if i.LastPushErrorIsNil {
+		predicates = append(predicates, device.LastPushErrorIsNil())
+	}
+	if i.LastPushErrorNotNil {
+		predicates = append(predicates, device.LastPushErrorNotNil())
+	}
+	if i.LastPushErrorEqualFold != nil {
+		predicates = append(predicates, device.LastPushErrorEqualFold(*i.LastPushErrorEqualFold))
+	}
+	if i.LastPushErrorContainsFold != nil {
+		predicates = append(predicates, device.LastPushErrorContainsFold(*i.LastPushErrorContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, device.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, device.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, device.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, device.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, device.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, device.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, device.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, device.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, device.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, device.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, device.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, device.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, device.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, device.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, device.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, device.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+
+	if i.HasUser != nil {
+		p := device.HasUser()
+		if !*i.HasUser {
+			p = device.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasFileWith) > 0 {
-		with := make([]predicate.File, 0, len(i.HasFileWith))
-		for _, w := range i.HasFileWith {
+	if len(i.HasUserWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUserWith))
+		for _, w := range i.HasUserWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasFileWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, messagevoice.HasFileWith(with...))
+		predicates = append(predicates, device.HasUserWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyMessageVoiceWhereInput
+		return nil, ErrEmptyDeviceWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return messagevoice.And(predicates...), nil
+		return device.And(predicates...), nil
 	}
 }
 
-// NotificationWhereInput represents a where input for filtering Notification queries.
-type NotificationWhereInput struct {
-	Predicates []predicate.Notification  `json:"-"`
-	Not        *NotificationWhereInput   `json:"not,omitempty"`
-	Or         []*NotificationWhereInput `json:"or,omitempty"`
-	And        []*NotificationWhereInput `json:"and,omitempty"`
+// FileWhereInput represents a where input for filtering File queries.
+type FileWhereInput struct {
+	Predicates []predicate.File  `json:"-"`
+	Not        *FileWhereInput   `json:"not,omitempty"`
+	Or         []*FileWhereInput `json:"or,omitempty"`
+	And        []*FileWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *pulid.ID  `json:"id,omitempty"`
@@ -2326,35 +2501,116 @@ type NotificationWhereInput struct {
 	IDLT    *pulid.ID  `json:"idLT,omitempty"`
 	IDLTE   *pulid.ID  `json:"idLTE,omitempty"`
 
-	// "title" field predicates.
-	Title             *string  `json:"title,omitempty"`
-	TitleNEQ          *string  `json:"titleNEQ,omitempty"`
-	TitleIn           []string `json:"titleIn,omitempty"`
-	TitleNotIn        []string `json:"titleNotIn,omitempty"`
-	TitleGT           *string  `json:"titleGT,omitempty"`
-	TitleGTE          *string  `json:"titleGTE,omitempty"`
-	TitleLT           *string  `json:"titleLT,omitempty"`
-	TitleLTE          *string  `json:"titleLTE,omitempty"`
-	TitleContains     *string  `json:"titleContains,omitempty"`
-	TitleHasPrefix    *string  `json:"titleHasPrefix,omitempty"`
-	TitleHasSuffix    *string  `json:"titleHasSuffix,omitempty"`
-	TitleEqualFold    *string  `json:"titleEqualFold,omitempty"`
-	TitleContainsFold *string  `json:"titleContainsFold,omitempty"`
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
 
-	// "body" field predicates.
-	Body             *string  `json:"body,omitempty"`
-	BodyNEQ          *string  `json:"bodyNEQ,omitempty"`
-	BodyIn           []string `json:"bodyIn,omitempty"`
-	BodyNotIn        []string `json:"bodyNotIn,omitempty"`
-	BodyGT           *string  `json:"bodyGT,omitempty"`
-	BodyGTE          *string  `json:"bodyGTE,omitempty"`
-	BodyLT           *string  `json:"bodyLT,omitempty"`
-	BodyLTE          *string  `json:"bodyLTE,omitempty"`
-	BodyContains     *string  `json:"bodyContains,omitempty"`
-	BodyHasPrefix    *string  `json:"bodyHasPrefix,omitempty"`
-	BodyHasSuffix    *string  `json:"bodyHasSuffix,omitempty"`
-	BodyEqualFold    *string  `json:"bodyEqualFold,omitempty"`
-	BodyContainsFold *string  `json:"bodyContainsFold,omitempty"`
+	// "content_type" field predicates.
+	ContentType             *string  `json:"contentType,omitempty"`
+	ContentTypeNEQ          *string  `json:"contentTypeNEQ,omitempty"`
+	ContentTypeIn           []string `json:"contentTypeIn,omitempty"`
+	ContentTypeNotIn        []string `json:"contentTypeNotIn,omitempty"`
+	ContentTypeGT           *string  `json:"contentTypeGT,omitempty"`
+	ContentTypeGTE          *string  `json:"contentTypeGTE,omitempty"`
+	ContentTypeLT           *string  `json:"contentTypeLT,omitempty"`
+	ContentTypeLTE          *string  `json:"contentTypeLTE,omitempty"`
+	ContentTypeContains     *string  `json:"contentTypeContains,omitempty"`
+	ContentTypeHasPrefix    *string  `json:"contentTypeHasPrefix,omitempty"`
+	ContentTypeHasSuffix    *string  `json:"contentTypeHasSuffix,omitempty"`
+	ContentTypeEqualFold    *string  `json:"contentTypeEqualFold,omitempty"`
+	ContentTypeContainsFold *string  `json:"contentTypeContainsFold,omitempty"`
+
+	// "size" field predicates.
+	Size      *uint64  `json:"size,omitempty"`
+	SizeNEQ   *uint64  `json:"sizeNEQ,omitempty"`
+	SizeIn    []uint64 `json:"sizeIn,omitempty"`
+	SizeNotIn []uint64 `json:"sizeNotIn,omitempty"`
+	SizeGT    *uint64  `json:"sizeGT,omitempty"`
+	SizeGTE   *uint64  `json:"sizeGTE,omitempty"`
+	SizeLT    *uint64  `json:"sizeLT,omitempty"`
+	SizeLTE   *uint64  `json:"sizeLTE,omitempty"`
+
+	// "location" field predicates.
+	Location             *string  `json:"location,omitempty"`
+	LocationNEQ          *string  `json:"locationNEQ,omitempty"`
+	LocationIn           []string `json:"locationIn,omitempty"`
+	LocationNotIn        []string `json:"locationNotIn,omitempty"`
+	LocationGT           *string  `json:"locationGT,omitempty"`
+	LocationGTE          *string  `json:"locationGTE,omitempty"`
+	LocationLT           *string  `json:"locationLT,omitempty"`
+	LocationLTE          *string  `json:"locationLTE,omitempty"`
+	LocationContains     *string  `json:"locationContains,omitempty"`
+	LocationHasPrefix    *string  `json:"locationHasPrefix,omitempty"`
+	LocationHasSuffix    *string  `json:"locationHasSuffix,omitempty"`
+	LocationIsNil        bool     `json:"locationIsNil,omitempty"`
+	LocationNotNil       bool     `json:"locationNotNil,omitempty"`
+	LocationEqualFold    *string  `json:"locationEqualFold,omitempty"`
+	LocationContainsFold *string  `json:"locationContainsFold,omitempty"`
+
+	// "bucket" field predicates.
+	Bucket             *string  `json:"bucket,omitempty"`
+	BucketNEQ          *string  `json:"bucketNEQ,omitempty"`
+	BucketIn           []string `json:"bucketIn,omitempty"`
+	BucketNotIn        []string `json:"bucketNotIn,omitempty"`
+	BucketGT           *string  `json:"bucketGT,omitempty"`
+	BucketGTE          *string  `json:"bucketGTE,omitempty"`
+	BucketLT           *string  `json:"bucketLT,omitempty"`
+	BucketLTE          *string  `json:"bucketLTE,omitempty"`
+	BucketContains     *string  `json:"bucketContains,omitempty"`
+	BucketHasPrefix    *string  `json:"bucketHasPrefix,omitempty"`
+	BucketHasSuffix    *string  `json:"bucketHasSuffix,omitempty"`
+	BucketEqualFold    *string  `json:"bucketEqualFold,omitempty"`
+	BucketContainsFold *string  `json:"bucketContainsFold,omitempty"`
+
+	// "path" field predicates.
+	Path             *string  `json:"path,omitempty"`
+	PathNEQ          *string  `json:"pathNEQ,omitempty"`
+	PathIn           []string `json:"pathIn,omitempty"`
+	PathNotIn        []string `json:"pathNotIn,omitempty"`
+	PathGT           *string  `json:"pathGT,omitempty"`
+	PathGTE          *string  `json:"pathGTE,omitempty"`
+	PathLT           *string  `json:"pathLT,omitempty"`
+	PathLTE          *string  `json:"pathLTE,omitempty"`
+	PathContains     *string  `json:"pathContains,omitempty"`
+	PathHasPrefix    *string  `json:"pathHasPrefix,omitempty"`
+	PathHasSuffix    *string  `json:"pathHasSuffix,omitempty"`
+	PathEqualFold    *string  `json:"pathEqualFold,omitempty"`
+	PathContainsFold *string  `json:"pathContainsFold,omitempty"`
+
+	// "width" field predicates.
+	Width       *int  `json:"width,omitempty"`
+	WidthNEQ    *int  `json:"widthNEQ,omitempty"`
+	WidthIn     []int `json:"widthIn,omitempty"`
+	WidthNotIn  []int `json:"widthNotIn,omitempty"`
+	WidthGT     *int  `json:"widthGT,omitempty"`
+	WidthGTE    *int  `json:"widthGTE,omitempty"`
+	WidthLT     *int  `json:"widthLT,omitempty"`
+	WidthLTE    *int  `json:"widthLTE,omitempty"`
+	WidthIsNil  bool  `json:"widthIsNil,omitempty"`
+	WidthNotNil bool  `json:"widthNotNil,omitempty"`
+
+	// "height" field predicates.
+	Height       *int  `json:"height,omitempty"`
+	HeightNEQ    *int  `json:"heightNEQ,omitempty"`
+	HeightIn     []int `json:"heightIn,omitempty"`
+	HeightNotIn  []int `json:"heightNotIn,omitempty"`
+	HeightGT     *int  `json:"heightGT,omitempty"`
+	HeightGTE    *int  `json:"heightGTE,omitempty"`
+	HeightLT     *int  `json:"heightLT,omitempty"`
+	HeightLTE    *int  `json:"heightLTE,omitempty"`
+	HeightIsNil  bool  `json:"heightIsNil,omitempty"`
+	HeightNotNil bool  `json:"heightNotNil,omitempty"`
 
 	// "created_at" field predicates.
 	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
@@ -2376,24 +2632,36 @@ type NotificationWhereInput struct {
 	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
 	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
 
-	// "user" edge predicates.
-	HasUser     *bool             `json:"hasUser,omitempty"`
-	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+	// "message_attachment" edge predicates.
+	HasMessageAttachment     *bool                          `json:"hasMessageAttachment,omitempty"`
+	HasMessageAttachmentWith []*MessageAttachmentWhereInput `json:"hasMessageAttachmentWith,omitempty"`
+
+	// "message_voice" edge predicates.
+	HasMessageVoice     *bool                     `json:"hasMessageVoice,omitempty"`
+	HasMessageVoiceWith []*MessageVoiceWhereInput `json:"hasMessageVoiceWith,omitempty"`
+
+	// "original" edge predicates.
+	HasOriginal     *bool             `json:"hasOriginal,omitempty"`
+	HasOriginalWith []*FileWhereInput `json:"hasOriginalWith,omitempty"`
+
+	// "thumbnails" edge predicates.
+	HasThumbnails     *bool             `json:"hasThumbnails,omitempty"`
+	HasThumbnailsWith []*FileWhereInput `json:"hasThumbnailsWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *NotificationWhereInput) AddPredicates(predicates ...predicate.Notification) {
+func (i *FileWhereInput) AddPredicates(predicates ...predicate.File) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the NotificationWhereInput filter on the NotificationQuery builder.
-func (i *NotificationWhereInput) Filter(q *NotificationQuery) (*NotificationQuery, error) {
+// Filter applies the FileWhereInput filter on the FileQuery builder.
+func (i *FileWhereInput) Filter(q *FileQuery) (*FileQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyNotificationWhereInput {
+		if err == ErrEmptyFileWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -2401,19 +2669,19 @@ func (i *NotificationWhereInput) Filter(q *NotificationQuery) (*NotificationQuer
 	return q.Where(p), nil
 }
 
-// ErrEmptyNotificationWhereInput is returned in case the NotificationWhereInput is empty.
-var ErrEmptyNotificationWhereInput = errors.New("ent: empty predicate NotificationWhereInput")
+// ErrEmptyFileWhereInput is returned in case the FileWhereInput is empty.
+var ErrEmptyFileWhereInput = errors.New("ent: empty predicate FileWhereInput")
 
-// P returns a predicate for filtering notifications.
+// P returns a predicate for filtering files.
 // An error is returned if the input is empty or invalid.
-func (i *NotificationWhereInput) P() (predicate.Notification, error) {
-	var predicates []predicate.Notification
+func (i *FileWhereInput) P() (predicate.File, error) {
+	var predicates []predicate.File
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, notification.Not(p))
+		predicates = append(predicates, file.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -2423,7 +2691,7 @@ func (i *NotificationWhereInput) P() (predicate.Notification, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Notification, 0, n)
+		or := make([]predicate.File, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -2431,7 +2699,7 @@ func (i *NotificationWhereInput) P() (predicate.Notification, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, notification.Or(or...))
+		predicates = append(predicates, file.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -2441,7 +2709,7 @@ func (i *NotificationWhereInput) P() (predicate.Notification, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Notification, 0, n)
+		and := make([]predicate.File, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -2449,194 +2717,3871 @@ func (i *NotificationWhereInput) P() (predicate.Notification, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, notification.And(and...))
+		predicates = append(predicates, file.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, notification.IDEQ(*i.ID))
+		predicates = append(predicates, file.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, notification.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, file.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, notification.IDIn(i.IDIn...))
+		predicates = append(predicates, file.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, notification.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, file.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, notification.IDGT(*i.IDGT))
+		predicates = append(predicates, file.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, notification.IDGTE(*i.IDGTE))
+		predicates = append(predicates, file.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, notification.IDLT(*i.IDLT))
+		predicates = append(predicates, file.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, notification.IDLTE(*i.IDLTE))
+		predicates = append(predicates, file.IDLTE(*i.IDLTE))
 	}
-	if i.Title != nil {
-		predicates = append(predicates, notification.TitleEQ(*i.Title))
+	if i.Name != nil {
+		predicates = append(predicates, file.NameEQ(*i.Name))
 	}
-	if i.TitleNEQ != nil {
-		predicates = append(predicates, notification.TitleNEQ(*i.TitleNEQ))
+	if i.NameNEQ != nil {
+		predicates = append(predicates, file.NameNEQ(*i.NameNEQ))
 	}
-	if len(i.TitleIn) > 0 {
-		predicates = append(predicates, notification.TitleIn(i.TitleIn...))
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, file.NameIn(i.NameIn...))
 	}
-	if len(i.TitleNotIn) > 0 {
-		predicates = append(predicates, notification.TitleNotIn(i.TitleNotIn...))
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, file.NameNotIn(i.NameNotIn...))
 	}
-	if i.TitleGT != nil {
-		predicates = append(predicates, notification.TitleGT(*i.TitleGT))
+	if i.NameGT != nil {
+		predicates = append(predicates, file.NameGT(*i.NameGT))
 	}
-	if i.TitleGTE != nil {
-		predicates = append(predicates, notification.TitleGTE(*i.TitleGTE))
+	if i.NameGTE != nil {
+		predicates = append(predicates, file.NameGTE(*i.NameGTE))
 	}
-	if i.TitleLT != nil {
-		predicates = append(predicates, notification.TitleLT(*i.TitleLT))
+	if i.NameLT != nil {
+		predicates = append(predicates, file.NameLT(*i.NameLT))
 	}
-	if i.TitleLTE != nil {
-		predicates = append(predicates, notification.TitleLTE(*i.TitleLTE))
+	if i.NameLTE != nil {
+		predicates = append(predicates, file.NameLTE(*i.NameLTE))
 	}
-	if i.TitleContains != nil {
-		predicates = append(predicates, notification.TitleContains(*i.TitleContains))
+	if i.NameContains != nil {
+		predicates = append(predicates, file.NameContains(*i.NameContains))
 	}
-	if i.TitleHasPrefix != nil {
-		predicates = append(predicates, notification.TitleHasPrefix(*i.TitleHasPrefix))
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, file.NameHasPrefix(*i.NameHasPrefix))
 	}
-	if i.TitleHasSuffix != nil {
-		predicates = append(predicates, notification.TitleHasSuffix(*i.TitleHasSuffix))
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, file.NameHasSuffix(*i.NameHasSuffix))
 	}
-	if i.TitleEqualFold != nil {
-		predicates = append(predicates, notification.TitleEqualFold(*i.TitleEqualFold))
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, file.NameEqualFold(*i.NameEqualFold))
 	}
-	if i.TitleContainsFold != nil {
-		predicates = append(predicates, notification.TitleContainsFold(*i.TitleContainsFold))
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, file.NameContainsFold(*i.NameContainsFold))
 	}
-	if i.Body != nil {
-		predicates = append(predicates, notification.BodyEQ(*i.Body))
+	if i.ContentType != nil {
+		predicates = append(predicates, file.ContentTypeEQ(*i.ContentType))
 	}
-	if i.BodyNEQ != nil {
-		predicates = append(predicates, notification.BodyNEQ(*i.BodyNEQ))
+	if i.ContentTypeNEQ != nil {
+		predicates = append(predicates, file.ContentTypeNEQ(*i.ContentTypeNEQ))
 	}
-	if len(i.BodyIn) > 0 {
-		predicates = append(predicates, notification.BodyIn(i.BodyIn...))
+	if len(i.ContentTypeIn) > 0 {
+		predicates = append(predicates, file.ContentTypeIn(i.ContentTypeIn...))
 	}
-	if len(i.BodyNotIn) > 0 {
-		predicates = append(predicates, notification.BodyNotIn(i.BodyNotIn...))
+	if len(i.ContentTypeNotIn) > 0 {
+		predicates = append(predicates, file.ContentTypeNotIn(i.ContentTypeNotIn...))
 	}
-	if i.BodyGT != nil {
-		predicates = append(predicates, notification.BodyGT(*i.BodyGT))
+	if i.ContentTypeGT != nil {
+		predicates = append(predicates, file.ContentTypeGT(*i.ContentTypeGT))
 	}
-	if i.BodyGTE != nil {
-		predicates = append(predicates, notification.BodyGTE(*i.BodyGTE))
+	if i.ContentTypeGTE != nil {
+		predicates = append(predicates, file.ContentTypeGTE(*i.ContentTypeGTE))
 	}
-	if i.BodyLT != nil {
-		predicates = append(predicates, notification.BodyLT(*i.BodyLT))
+	if i.ContentTypeLT != nil {
+		predicates = append(predicates, file.ContentTypeLT(*i.ContentTypeLT))
 	}
-	if i.BodyLTE != nil {
-		predicates = append(predicates, notification.BodyLTE(*i.BodyLTE))
+	if i.ContentTypeLTE != nil {
+		predicates = append(predicates, file.ContentTypeLTE(*i.ContentTypeLTE))
 	}
-	if i.BodyContains != nil {
-		predicates = append(predicates, notification.BodyContains(*i.BodyContains))
+	if i.ContentTypeContains != nil {
+		predicates = append(predicates, file.ContentTypeContains(*i.ContentTypeContains))
 	}
-	if i.BodyHasPrefix != nil {
-		predicates = append(predicates, notification.BodyHasPrefix(*i.BodyHasPrefix))
+	if i.ContentTypeHasPrefix != nil {
+		predicates = append(predicates, file.ContentTypeHasPrefix(*i.ContentTypeHasPrefix))
 	}
-	if i.BodyHasSuffix != nil {
-		predicates = append(predicates, notification.BodyHasSuffix(*i.BodyHasSuffix))
+	if i.ContentTypeHasSuffix != nil {
+		predicates = append(predicates, file.ContentTypeHasSuffix(*i.ContentTypeHasSuffix))
 	}
-	if i.BodyEqualFold != nil {
-		predicates = append(predicates, notification.BodyEqualFold(*i.BodyEqualFold))
+	if i.ContentTypeEqualFold != nil {
+		predicates = append(predicates, file.ContentTypeEqualFold(*i.ContentTypeEqualFold))
+	}
+	if i.ContentTypeContainsFold != nil {
+		predicates = append(predicates, file.ContentTypeContainsFold(*i.ContentTypeContainsFold))
+	}
+	if i.Size != nil {
+		predicates = append(predicates, file.SizeEQ(*i.Size))
+	}
+	if i.SizeNEQ != nil {
+		predicates = append(predicates, file.SizeNEQ(*i.SizeNEQ))
+	}
+	if len(i.SizeIn) > 0 {
+		predicates = append(predicates, file.SizeIn(i.SizeIn...))
+	}
+	if len(i.SizeNotIn) > 0 {
+		predicates = append(predicates, file.SizeNotIn(i.SizeNotIn...))
+	}
+	if i.SizeGT != nil {
+		predicates = append(predicates, file.SizeGT(*i.SizeGT))
+	}
+	if i.SizeGTE != nil {
+		predicates = append(predicates, file.SizeGTE(*i.SizeGTE))
+	}
+	if i.SizeLT != nil {
+		predicates = append(predicates, file.SizeLT(*i.SizeLT))
+	}
+	if i.SizeLTE != nil {
+		predicates = append(predicates, file.SizeLTE(*i.SizeLTE))
+	}
+	if i.Location != nil {
+		predicates = append(predicates, file.LocationEQ(*i.Location))
+	}
+	if i.LocationNEQ != nil {
+		predicates = append(predicates, file.LocationNEQ(*i.LocationNEQ))
+	}
+	if len(i.LocationIn) > 0 {
+		predicates = append(predicates, file.LocationIn(i.LocationIn...))
+	}
+	if len(i.LocationNotIn) > 0 {
+		predicates = append(predicates, file.LocationNotIn(i.LocationNotIn...))
+	}
+	if i.LocationGT != nil {
+		predicates = append(predicates, file.LocationGT(*i.LocationGT))
+	}
+	if i.LocationGTE != nil {
+		predicates = append(predicates, file.LocationGTE(*i.LocationGTE))
+	}
+	if i.LocationLT != nil {
+		predicates = append(predicates, file.LocationLT(*i.LocationLT))
+	}
+	if i.LocationLTE != nil {
+		predicates = append(predicates, file.LocationLTE(*i.LocationLTE))
+	}
+	if i.LocationContains != nil {
+		predicates = append(predicates, file.LocationContains(*i.LocationContains))
+	}
+	if i.LocationHasPrefix != nil {
+		predicates = append(predicates, file.LocationHasPrefix(*i.LocationHasPrefix))
+	}
+	if i.LocationHasSuffix != nil {
+		predicates = append(predicates, file.LocationHasSuffix(*i.LocationHasSuffix))
+	}
+	if i.LocationIsNil {
+		predicates = append(predicates, file.LocationIsNil())
+	}
+	if i.LocationNotNil {
+		predicates = append(predicates, file.LocationNotNil())
+	}
+	if i.LocationEqualFold != nil {
+		predicates = append(predicates, file.LocationEqualFold(*i.LocationEqualFold))
+	}
+	if i.LocationContainsFold != nil {
+		predicates = append(predicates, file.LocationContainsFold(*i.LocationContainsFold))
+	}
+	if i.Bucket != nil {
+		predicates = append(predicates, file.BucketEQ(*i.Bucket))
+	}
+	if i.BucketNEQ != nil {
+		predicates = append(predicates, file.BucketNEQ(*i.BucketNEQ))
+	}
+	if len(i.BucketIn) > 0 {
+		predicates = append(predicates, file.BucketIn(i.BucketIn...))
+	}
+	if len(i.BucketNotIn) > 0 {
+		predicates = append(predicates, file.BucketNotIn(i.BucketNotIn...))
+	}
+	if i.BucketGT != nil {
+		predicates = append(predicates, file.BucketGT(*i.BucketGT))
+	}
+	if i.BucketGTE != nil {
+		predicates = append(predicates, file.BucketGTE(*i.BucketGTE))
+	}
+	if i.BucketLT != nil {
+		predicates = append(predicates, file.BucketLT(*i.BucketLT))
+	}
+	if i.BucketLTE != nil {
+		predicates = append(predicates, file.BucketLTE(*i.BucketLTE))
+	}
+	if i.BucketContains != nil {
+		predicates = append(predicates, file.BucketContains(*i.BucketContains))
+	}
+	if i.BucketHasPrefix != nil {
+		predicates = append(predicates, file.BucketHasPrefix(*i.BucketHasPrefix))
+	}
+	if i.BucketHasSuffix != nil {
+		predicates = append(predicates, file.BucketHasSuffix(*i.BucketHasSuffix))
+	}
+	if i.BucketEqualFold != nil {
+		predicates = append(predicates, file.BucketEqualFold(*i.BucketEqualFold))
+	}
+	if i.BucketContainsFold != nil {
+		predicates = append(predicates, file.BucketContainsFold(*i.BucketContainsFold))
+	}
+	if i.Path != nil {
+		predicates = append(predicates, file.PathEQ(*i.Path))
+	}
+	if i.PathNEQ != nil {
+		predicates = append(predicates, file.PathNEQ(*i.PathNEQ))
+	}
+	if len(i.PathIn) > 0 {
+		predicates = append(predicates, file.PathIn(i.PathIn...))
+	}
+	if len(i.PathNotIn) > 0 {
+		predicates = append(predicates, file.PathNotIn(i.PathNotIn...))
+	}
+	if i.PathGT != nil {
+		predicates = append(predicates, file.PathGT(*i.PathGT))
+	}
+	if i.PathGTE != nil {
+		predicates = append(predicates, file.PathGTE(*i.PathGTE))
+	}
+	if i.PathLT != nil {
+		predicates = append(predicates, file.PathLT(*i.PathLT))
+	}
+	if i.PathLTE != nil {
+		predicates = append(predicates, file.PathLTE(*i.PathLTE))
+	}
+	if i.PathContains != nil {
+		predicates = append(predicates, file.PathContains(*i.PathContains))
+	}
+	if i.PathHasPrefix != nil {
+		predicates = append(predicates, file.PathHasPrefix(*i.PathHasPrefix))
+	}
+	if i.PathHasSuffix != nil {
+		predicates = append(predicates, file.PathHasSuffix(*i.PathHasSuffix))
+	}
+	if i.PathEqualFold != nil {
+		predicates = append(predicates, file.PathEqualFold(*i.PathEqualFold))
+	}
+	if i.PathContainsFold != nil {
+		predicates = append(predicates, file.PathContainsFold(*i.PathContainsFold))
+	}
+	if i.Width != nil {
+		predicates = append(predicates, file.WidthEQ(*i.Width))
+	}
+	if i.WidthNEQ != nil {
+		predicates = append(predicates, file.WidthNEQ(*i.WidthNEQ))
+	}
+	if len(i.WidthIn) > 0 {
+		predicates = append(predicates, file.WidthIn(i.WidthIn...))
+	}
+	if len(i.WidthNotIn) > 0 {
+		predicates = append(predicates, file.WidthNotIn(i.WidthNotIn...))
+	}
+	if i.WidthGT != nil {
+		predicates = append(predicates, file.WidthGT(*i.WidthGT))
+	}
+	if i.WidthGTE != nil {
+		predicates = append(predicates, file.WidthGTE(*i.WidthGTE))
+	}
+	if i.WidthLT != nil {
+		predicates = append(predicates, file.WidthLT(*i.WidthLT))
+	}
+	if i.WidthLTE != nil {
+		predicates = append(predicates, file.WidthLTE(*i.WidthLTE))
+	}
+	if i.WidthIsNil {
+		predicates = append(predicates, file.WidthIsNil())
+	}
+	if i.WidthNotNil {
+		predicates = append(predicates, file.WidthNotNil())
+	}
+	if i.Height != nil {
+		predicates = append(predicates, file.HeightEQ(*i.Height))
+	}
+	if i.HeightNEQ != nil {
+		predicates = append(predicates, file.HeightNEQ(*i.HeightNEQ))
+	}
+	if len(i.HeightIn) > 0 {
+		predicates = append(predicates, file.HeightIn(i.HeightIn...))
+	}
+	if len(i.HeightNotIn) > 0 {
+		predicates = append(predicates, file.HeightNotIn(i.HeightNotIn...))
+	}
+	if i.HeightGT != nil {
+		predicates = append(predicates, file.HeightGT(*i.HeightGT))
+	}
+	if i.HeightGTE != nil {
+		predicates = append(predicates, file.HeightGTE(*i.HeightGTE))
+	}
+	if i.HeightLT != nil {
+		predicates = append(predicates, file.HeightLT(*i.HeightLT))
+	}
+	if i.HeightLTE != nil {
+		predicates = append(predicates, file.HeightLTE(*i.HeightLTE))
+	}
+	if i.HeightIsNil {
+		predicates = append(predicates, file.HeightIsNil())
+	}
+	if i.HeightNotNil {
+		predicates = append(predicates, file.HeightNotNil())
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, file.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, file.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, file.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, file.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, file.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, file.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, file.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, file.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, file.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, file.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, file.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, file.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, file.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, file.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, file.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, file.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+
+	if i.HasMessageAttachment != nil {
+		p := file.HasMessageAttachment()
+		if !*i.HasMessageAttachment {
+			p = file.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasMessageAttachmentWith) > 0 {
+		with := make([]predicate.MessageAttachment, 0, len(i.HasMessageAttachmentWith))
+		for _, w := range i.HasMessageAttachmentWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasMessageAttachmentWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, file.HasMessageAttachmentWith(with...))
+	}
+	if i.HasMessageVoice != nil {
+		p := file.HasMessageVoice()
+		if !*i.HasMessageVoice {
+			p = file.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasMessageVoiceWith) > 0 {
+		with := make([]predicate.MessageVoice, 0, len(i.HasMessageVoiceWith))
+		for _, w := range i.HasMessageVoiceWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasMessageVoiceWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, file.HasMessageVoiceWith(with...))
+	}
+	if i.HasOriginal != nil {
+		p := file.HasOriginal()
+		if !*i.HasOriginal {
+			p = file.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOriginalWith) > 0 {
+		with := make([]predicate.File, 0, len(i.HasOriginalWith))
+		for _, w := range i.HasOriginalWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOriginalWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, file.HasOriginalWith(with...))
+	}
+	if i.HasThumbnails != nil {
+		p := file.HasThumbnails()
+		if !*i.HasThumbnails {
+			p = file.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasThumbnailsWith) > 0 {
+		with := make([]predicate.File, 0, len(i.HasThumbnailsWith))
+		for _, w := range i.HasThumbnailsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasThumbnailsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, file.HasThumbnailsWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyFileWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return file.And(predicates...), nil
+	}
+}
+
+// MessageWhereInput represents a where input for filtering Message queries.
+type MessageWhereInput struct {
+	Predicates []predicate.Message  `json:"-"`
+	Not        *MessageWhereInput   `json:"not,omitempty"`
+	Or         []*MessageWhereInput `json:"or,omitempty"`
+	And        []*MessageWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *pulid.ID  `json:"id,omitempty"`
+	IDNEQ   *pulid.ID  `json:"idNEQ,omitempty"`
+	IDIn    []pulid.ID `json:"idIn,omitempty"`
+	IDNotIn []pulid.ID `json:"idNotIn,omitempty"`
+	IDGT    *pulid.ID  `json:"idGT,omitempty"`
+	IDGTE   *pulid.ID  `json:"idGTE,omitempty"`
+	IDLT    *pulid.ID  `json:"idLT,omitempty"`
+	IDLTE   *pulid.ID  `json:"idLTE,omitempty"`
+
+	// "type" field predicates.
+	Type      *message.Type  `json:"type,omitempty"`
+	TypeNEQ   *message.Type  `json:"typeNEQ,omitempty"`
+	TypeIn    []message.Type `json:"typeIn,omitempty"`
+	TypeNotIn []message.Type `json:"typeNotIn,omitempty"`
+
+	// "content" field predicates.
+	Content             *string  `json:"content,omitempty"`
+	ContentNEQ          *string  `json:"contentNEQ,omitempty"`
+	ContentIn           []string `json:"contentIn,omitempty"`
+	ContentNotIn        []string `json:"contentNotIn,omitempty"`
+	ContentGT           *string  `json:"contentGT,omitempty"`
+	ContentGTE          *string  `json:"contentGTE,omitempty"`
+	ContentLT           *string  `json:"contentLT,omitempty"`
+	ContentLTE          *string  `json:"contentLTE,omitempty"`
+	ContentContains     *string  `json:"contentContains,omitempty"`
+	ContentHasPrefix    *string  `json:"contentHasPrefix,omitempty"`
+	ContentHasSuffix    *string  `json:"contentHasSuffix,omitempty"`
+	ContentIsNil        bool     `json:"contentIsNil,omitempty"`
+	ContentNotNil       bool     `json:"contentNotNil,omitempty"`
+	ContentEqualFold    *string  `json:"contentEqualFold,omitempty"`
+	ContentContainsFold *string  `json:"contentContainsFold,omitempty"`
+
+	// "call_id" field predicates.
+	CallID             *pulid.ID  `json:"callID,omitempty"`
+	CallIDNEQ          *pulid.ID  `json:"callIDNEQ,omitempty"`
+	CallIDIn           []pulid.ID `json:"callIDIn,omitempty"`
+	CallIDNotIn        []pulid.ID `json:"callIDNotIn,omitempty"`
+	CallIDGT           *pulid.ID  `json:"callIDGT,omitempty"`
+	CallIDGTE          *pulid.ID  `json:"callIDGTE,omitempty"`
+	CallIDLT           *pulid.ID  `json:"callIDLT,omitempty"`
+	CallIDLTE          *pulid.ID  `json:"callIDLTE,omitempty"`
+	CallIDContains     *pulid.ID  `json:"callIDContains,omitempty"`
+	CallIDHasPrefix    *pulid.ID  `json:"callIDHasPrefix,omitempty"`
+	CallIDHasSuffix    *pulid.ID  `json:"callIDHasSuffix,omitempty"`
+	CallIDIsNil        bool       `json:"callIDIsNil,omitempty"`
+	CallIDNotNil       bool       `json:"callIDNotNil,omitempty"`
+	CallIDEqualFold    *pulid.ID  `json:"callIDEqualFold,omitempty"`
+	CallIDContainsFold *pulid.ID  `json:"callIDContainsFold,omitempty"`
+
+	// "avatar_id" field predicates.
+	AvatarID             *pulid.ID  `json:"avatarID,omitempty"`
+	AvatarIDNEQ          *pulid.ID  `json:"avatarIDNEQ,omitempty"`
+	AvatarIDIn           []pulid.ID `json:"avatarIDIn,omitempty"`
+	AvatarIDNotIn        []pulid.ID `json:"avatarIDNotIn,omitempty"`
+	AvatarIDGT           *pulid.ID  `json:"avatarIDGT,omitempty"`
+	AvatarIDGTE          *pulid.ID  `json:"avatarIDGTE,omitempty"`
+	AvatarIDLT           *pulid.ID  `json:"avatarIDLT,omitempty"`
+	AvatarIDLTE          *pulid.ID  `json:"avatarIDLTE,omitempty"`
+	AvatarIDContains     *pulid.ID  `json:"avatarIDContains,omitempty"`
+	AvatarIDHasPrefix    *pulid.ID  `json:"avatarIDHasPrefix,omitempty"`
+	AvatarIDHasSuffix    *pulid.ID  `json:"avatarIDHasSuffix,omitempty"`
+	AvatarIDIsNil        bool       `json:"avatarIDIsNil,omitempty"`
+	AvatarIDNotNil       bool       `json:"avatarIDNotNil,omitempty"`
+	AvatarIDEqualFold    *pulid.ID  `json:"avatarIDEqualFold,omitempty"`
+	AvatarIDContainsFold *pulid.ID  `json:"avatarIDContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "voice" edge predicates.
+	HasVoice     *bool                     `json:"hasVoice,omitempty"`
+	HasVoiceWith []*MessageVoiceWhereInput `json:"hasVoiceWith,omitempty"`
+
+	// "reply_to" edge predicates.
+	HasReplyTo     *bool                `json:"hasReplyTo,omitempty"`
+	HasReplyToWith []*MessageWhereInput `json:"hasReplyToWith,omitempty"`
+
+	// "replies" edge predicates.
+	HasReplies     *bool                `json:"hasReplies,omitempty"`
+	HasRepliesWith []*MessageWhereInput `json:"hasRepliesWith,omitempty"`
+
+	// "attachments" edge predicates.
+	HasAttachments     *bool                          `json:"hasAttachments,omitempty"`
+	HasAttachmentsWith []*MessageAttachmentWhereInput `json:"hasAttachmentsWith,omitempty"`
+
+	// "links" edge predicates.
+	HasLinks     *bool                    `json:"hasLinks,omitempty"`
+	HasLinksWith []*MessageLinkWhereInput `json:"hasLinksWith,omitempty"`
+
+	// "call" edge predicates.
+	HasCall     *bool             `json:"hasCall,omitempty"`
+	HasCallWith []*CallWhereInput `json:"hasCallWith,omitempty"`
+
+	// "avatar" edge predicates.
+	HasAvatar     *bool               `json:"hasAvatar,omitempty"`
+	HasAvatarWith []*AvatarWhereInput `json:"hasAvatarWith,omitempty"`
+
+	// "user" edge predicates.
+	HasUser     *bool             `json:"hasUser,omitempty"`
+	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+
+	// "room" edge predicates.
+	HasRoom     *bool             `json:"hasRoom,omitempty"`
+	HasRoomWith []*RoomWhereInput `json:"hasRoomWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *MessageWhereInput) AddPredicates(predicates ...predicate.Message) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the MessageWhereInput filter on the MessageQuery builder.
+func (i *MessageWhereInput) Filter(q *MessageQuery) (*MessageQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyMessageWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyMessageWhereInput is returned in case the MessageWhereInput is empty.
+var ErrEmptyMessageWhereInput = errors.New("ent: empty predicate MessageWhereInput")
+
+// P returns a predicate for filtering messages.
+// An error is returned if the input is empty or invalid.
+func (i *MessageWhereInput) P() (predicate.Message, error) {
+	var predicates []predicate.Message
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, message.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Message, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, message.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Message, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, message.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, message.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, message.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, message.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, message.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, message.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, message.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, message.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, message.IDLTE(*i.IDLTE))
+	}
+	if i.Type != nil {
+		predicates = append(predicates, message.TypeEQ(*i.Type))
+	}
+	if i.TypeNEQ != nil {
+		predicates = append(predicates, message.TypeNEQ(*i.TypeNEQ))
+	}
+	if len(i.TypeIn) > 0 {
+		predicates = append(predicates, message.TypeIn(i.TypeIn...))
+	}
+	if len(i.TypeNotIn) > 0 {
+		predicates = append(predicates, message.TypeNotIn(i.TypeNotIn...))
+	}
+	if i.Content != nil {
+		predicates = append(predicates, message.ContentEQ(*i.Content))
+	}
+	if i.ContentNEQ != nil {
+		predicates = append(predicates, message.ContentNEQ(*i.ContentNEQ))
+	}
+	if len(i.ContentIn) > 0 {
+		predicates = append(predicates, message.ContentIn(i.ContentIn...))
+	}
+	if len(i.ContentNotIn) > 0 {
+		predicates = append(predicates, message.ContentNotIn(i.ContentNotIn...))
+	}
+	if i.ContentGT != nil {
+		predicates = append(predicates, message.ContentGT(*i.ContentGT))
+	}
+	if i.ContentGTE != nil {
+		predicates = append(predicates, message.ContentGTE(*i.ContentGTE))
+	}
+	if i.ContentLT != nil {
+		predicates = append(predicates, message.ContentLT(*i.ContentLT))
+	}
+	if i.ContentLTE != nil {
+		predicates = append(predicates, message.ContentLTE(*i.ContentLTE))
+	}
+	if i.ContentContains != nil {
+		predicates = append(predicates, message.ContentContains(*i.ContentContains))
+	}
+	if i.ContentHasPrefix != nil {
+		predicates = append(predicates, message.ContentHasPrefix(*i.ContentHasPrefix))
+	}
+	if i.ContentHasSuffix != nil {
+		predicates = append(predicates, message.ContentHasSuffix(*i.ContentHasSuffix))
+	}
+	if i.ContentIsNil {
+		predicates = append(predicates, message.ContentIsNil())
+	}
+	if i.ContentNotNil {
+		predicates = append(predicates, message.ContentNotNil())
+	}
+	if i.ContentEqualFold != nil {
+		predicates = append(predicates, message.ContentEqualFold(*i.ContentEqualFold))
+	}
+	if i.ContentContainsFold != nil {
+		predicates = append(predicates, message.ContentContainsFold(*i.ContentContainsFold))
+	}
+	if i.CallID != nil {
+		predicates = append(predicates, message.CallIDEQ(*i.CallID))
+	}
+	if i.CallIDNEQ != nil {
+		predicates = append(predicates, message.CallIDNEQ(*i.CallIDNEQ))
+	}
+	if len(i.CallIDIn) > 0 {
+		predicates = append(predicates, message.CallIDIn(i.CallIDIn...))
+	}
+	if len(i.CallIDNotIn) > 0 {
+		predicates = append(predicates, message.CallIDNotIn(i.CallIDNotIn...))
+	}
+	if i.CallIDGT != nil {
+		predicates = append(predicates, message.CallIDGT(*i.CallIDGT))
+	}
+	if i.CallIDGTE != nil {
+		predicates = append(predicates, message.CallIDGTE(*i.CallIDGTE))
+	}
+	if i.CallIDLT != nil {
+		predicates = append(predicates, message.CallIDLT(*i.CallIDLT))
+	}
+	if i.CallIDLTE != nil {
+		predicates = append(predicates, message.CallIDLTE(*i.CallIDLTE))
+	}
+	if i.CallIDContains != nil {
+		predicates = append(predicates, message.CallIDContains(*i.CallIDContains))
+	}
+	if i.CallIDHasPrefix != nil {
+		predicates = append(predicates, message.CallIDHasPrefix(*i.CallIDHasPrefix))
+	}
+	if i.CallIDHasSuffix != nil {
+		predicates = append(predicates, message.CallIDHasSuffix(*i.CallIDHasSuffix))
+	}
+	if i.CallIDIsNil {
+		predicates = append(predicates, message.CallIDIsNil())
+	}
+	if i.CallIDNotNil {
+		predicates = append(predicates, message.CallIDNotNil())
+	}
+	if i.CallIDEqualFold != nil {
+		predicates = append(predicates, message.CallIDEqualFold(*i.CallIDEqualFold))
+	}
+	if i.CallIDContainsFold != nil {
+		predicates = append(predicates, message.CallIDContainsFold(*i.CallIDContainsFold))
+	}
+	if i.AvatarID != nil {
+		predicates = append(predicates, message.AvatarIDEQ(*i.AvatarID))
+	}
+	if i.AvatarIDNEQ != nil {
+		predicates = append(predicates, message.AvatarIDNEQ(*i.AvatarIDNEQ))
+	}
+	if len(i.AvatarIDIn) > 0 {
+		predicates = append(predicates, message.AvatarIDIn(i.AvatarIDIn...))
+	}
+	if len(i.AvatarIDNotIn) > 0 {
+		predicates = append(predicates, message.AvatarIDNotIn(i.AvatarIDNotIn...))
+	}
+	if i.AvatarIDGT != nil {
+		predicates = append(predicates, message.AvatarIDGT(*i.AvatarIDGT))
+	}
+	if i.AvatarIDGTE != nil {
+		predicates = append(predicates, message.AvatarIDGTE(*i.AvatarIDGTE))
+	}
+	if i.AvatarIDLT != nil {
+		predicates = append(predicates, message.AvatarIDLT(*i.AvatarIDLT))
+	}
+	if i.AvatarIDLTE != nil {
+		predicates = append(predicates, message.AvatarIDLTE(*i.AvatarIDLTE))
+	}
+	if i.AvatarIDContains != nil {
+		predicates = append(predicates, message.AvatarIDContains(*i.AvatarIDContains))
+	}
+	if i.AvatarIDHasPrefix != nil {
+		predicates = append(predicates, message.AvatarIDHasPrefix(*i.AvatarIDHasPrefix))
+	}
+	if i.AvatarIDHasSuffix != nil {
+		predicates = append(predicates, message.AvatarIDHasSuffix(*i.AvatarIDHasSuffix))
+	}
+	if i.AvatarIDIsNil {
+		predicates = append(predicates, message.AvatarIDIsNil())
+	}
+	if i.AvatarIDNotNil {
+		predicates = append(predicates, message.AvatarIDNotNil())
+	}
+	if i.AvatarIDEqualFold != nil {
+		predicates = append(predicates, message.AvatarIDEqualFold(*i.AvatarIDEqualFold))
+	}
+	if i.AvatarIDContainsFold != nil {
+		predicates = append(predicates, message.AvatarIDContainsFold(*i.AvatarIDContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, message.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, message.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, message.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, message.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, message.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, message.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, message.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, message.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, message.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, message.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, message.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, message.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, message.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, message.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, message.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, message.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+
+	if i.HasVoice != nil {
+		p := message.HasVoice()
+		if !*i.HasVoice {
+			p = message.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasVoiceWith) > 0 {
+		with := make([]predicate.MessageVoice, 0, len(i.HasVoiceWith))
+		for _, w := range i.HasVoiceWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasVoiceWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, message.HasVoiceWith(with...))
+	}
+	if i.HasReplyTo != nil {
+		p := message.HasReplyTo()
+		if !*i.HasReplyTo {
+			p = message.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasReplyToWith) > 0 {
+		with := make([]predicate.Message, 0, len(i.HasReplyToWith))
+		for _, w := range i.HasReplyToWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasReplyToWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, message.HasReplyToWith(with...))
+	}
+	if i.HasReplies != nil {
+		p := message.HasReplies()
+		if !*i.HasReplies {
+			p = message.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasRepliesWith) > 0 {
+		with := make([]predicate.Message, 0, len(i.HasRepliesWith))
+		for _, w := range i.HasRepliesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasRepliesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, message.HasRepliesWith(with...))
+	}
+	if i.HasAttachments != nil {
+		p := message.HasAttachments()
+		if !*i.HasAttachments {
+			p = message.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasAttachmentsWith) > 0 {
+		with := make([]predicate.MessageAttachment, 0, len(i.HasAttachmentsWith))
+		for _, w := range i.HasAttachmentsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAttachmentsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, message.HasAttachmentsWith(with...))
+	}
+	if i.HasLinks != nil {
+		p := message.HasLinks()
+		if !*i.HasLinks {
+			p = message.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasLinksWith) > 0 {
+		with := make([]predicate.MessageLink, 0, len(i.HasLinksWith))
+		for _, w := range i.HasLinksWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasLinksWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, message.HasLinksWith(with...))
+	}
+	if i.HasCall != nil {
+		p := message.HasCall()
+		if !*i.HasCall {
+			p = message.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasCallWith) > 0 {
+		with := make([]predicate.Call, 0, len(i.HasCallWith))
+		for _, w := range i.HasCallWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasCallWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, message.HasCallWith(with...))
+	}
+	if i.HasAvatar != nil {
+		p := message.HasAvatar()
+		if !*i.HasAvatar {
+			p = message.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasAvatarWith) > 0 {
+		with := make([]predicate.Avatar, 0, len(i.HasAvatarWith))
+		for _, w := range i.HasAvatarWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAvatarWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, message.HasAvatarWith(with...))
+	}
+	if i.HasUser != nil {
+		p := message.HasUser()
+		if !*i.HasUser {
+			p = message.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasUserWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUserWith))
+		for _, w := range i.HasUserWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, message.HasUserWith(with...))
+	}
+	if i.HasRoom != nil {
+		p := message.HasRoom()
+		if !*i.HasRoom {
+			p = message.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasRoomWith) > 0 {
+		with := make([]predicate.Room, 0, len(i.HasRoomWith))
+		for _, w := range i.HasRoomWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasRoomWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, message.HasRoomWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyMessageWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return message.And(predicates...), nil
+	}
+}
+
+// MessageAttachmentWhereInput represents a where input for filtering MessageAttachment queries.
+type MessageAttachmentWhereInput struct {
+	Predicates []predicate.MessageAttachment  `json:"-"`
+	Not        *MessageAttachmentWhereInput   `json:"not,omitempty"`
+	Or         []*MessageAttachmentWhereInput `json:"or,omitempty"`
+	And        []*MessageAttachmentWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *pulid.ID  `json:"id,omitempty"`
+	IDNEQ   *pulid.ID  `json:"idNEQ,omitempty"`
+	IDIn    []pulid.ID `json:"idIn,omitempty"`
+	IDNotIn []pulid.ID `json:"idNotIn,omitempty"`
+	IDGT    *pulid.ID  `json:"idGT,omitempty"`
+	IDGTE   *pulid.ID  `json:"idGTE,omitempty"`
+	IDLT    *pulid.ID  `json:"idLT,omitempty"`
+	IDLTE   *pulid.ID  `json:"idLTE,omitempty"`
+
+	// "type" field predicates.
+	Type      *messageattachment.Type  `json:"type,omitempty"`
+	TypeNEQ   *messageattachment.Type  `json:"typeNEQ,omitempty"`
+	TypeIn    []messageattachment.Type `json:"typeIn,omitempty"`
+	TypeNotIn []messageattachment.Type `json:"typeNotIn,omitempty"`
+
+	// "order" field predicates.
+	Order      *uint  `json:"order,omitempty"`
+	OrderNEQ   *uint  `json:"orderNEQ,omitempty"`
+	OrderIn    []uint `json:"orderIn,omitempty"`
+	OrderNotIn []uint `json:"orderNotIn,omitempty"`
+	OrderGT    *uint  `json:"orderGT,omitempty"`
+	OrderGTE   *uint  `json:"orderGTE,omitempty"`
+	OrderLT    *uint  `json:"orderLT,omitempty"`
+	OrderLTE   *uint  `json:"orderLTE,omitempty"`
+
+	// "attached_at" field predicates.
+	AttachedAt      *time.Time  `json:"attachedAt,omitempty"`
+	AttachedAtNEQ   *time.Time  `json:"attachedAtNEQ,omitempty"`
+	AttachedAtIn    []time.Time `json:"attachedAtIn,omitempty"`
+	AttachedAtNotIn []time.Time `json:"attachedAtNotIn,omitempty"`
+	AttachedAtGT    *time.Time  `json:"attachedAtGT,omitempty"`
+	AttachedAtGTE   *time.Time  `json:"attachedAtGTE,omitempty"`
+	AttachedAtLT    *time.Time  `json:"attachedAtLT,omitempty"`
+	AttachedAtLTE   *time.Time  `json:"attachedAtLTE,omitempty"`
+
+	// "room" edge predicates.
+	HasRoom     *bool             `json:"hasRoom,omitempty"`
+	HasRoomWith []*RoomWhereInput `json:"hasRoomWith,omitempty"`
+
+	// "message" edge predicates.
+	HasMessage     *bool                `json:"hasMessage,omitempty"`
+	HasMessageWith []*MessageWhereInput `json:"hasMessageWith,omitempty"`
+
+	// "file" edge predicates.
+	HasFile     *bool             `json:"hasFile,omitempty"`
+	HasFileWith []*FileWhereInput `json:"hasFileWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *MessageAttachmentWhereInput) AddPredicates(predicates ...predicate.MessageAttachment) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the MessageAttachmentWhereInput filter on the MessageAttachmentQuery builder.
+func (i *MessageAttachmentWhereInput) Filter(q *MessageAttachmentQuery) (*MessageAttachmentQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyMessageAttachmentWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyMessageAttachmentWhereInput is returned in case the MessageAttachmentWhereInput is empty.
+var ErrEmptyMessageAttachmentWhereInput = errors.New("ent: empty predicate MessageAttachmentWhereInput")
+
+// P returns a predicate for filtering messageattachments.
+// An error is returned if the input is empty or invalid.
+func (i *MessageAttachmentWhereInput) P() (predicate.MessageAttachment, error) {
+	var predicates []predicate.MessageAttachment
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, messageattachment.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.MessageAttachment, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, messageattachment.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.MessageAttachment, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, messageattachment.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, messageattachment.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, messageattachment.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, messageattachment.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, messageattachment.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, messageattachment.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, messageattachment.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, messageattachment.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, messageattachment.IDLTE(*i.IDLTE))
+	}
+	if i.Type != nil {
+		predicates = append(predicates, messageattachment.TypeEQ(*i.Type))
+	}
+	if i.TypeNEQ != nil {
+		predicates = append(predicates, messageattachment.TypeNEQ(*i.TypeNEQ))
+	}
+	if len(i.TypeIn) > 0 {
+		predicates = append(predicates, messageattachment.TypeIn(i.TypeIn...))
+	}
+	if len(i.TypeNotIn) > 0 {
+		predicates = append(predicates, messageattachment.TypeNotIn(i.TypeNotIn...))
+	}
+	if i.Order != nil {
+		predicates = append(predicates, messageattachment.OrderEQ(*i.Order))
+	}
+	if i.OrderNEQ != nil {
+		predicates = append(predicates, messageattachment.OrderNEQ(*i.OrderNEQ))
+	}
+	if len(i.OrderIn) > 0 {
+		predicates = append(predicates, messageattachment.OrderIn(i.OrderIn...))
+	}
+	if len(i.OrderNotIn) > 0 {
+		predicates = append(predicates, messageattachment.OrderNotIn(i.OrderNotIn...))
+	}
+	if i.OrderGT != nil {
+		predicates = append(predicates, messageattachment.OrderGT(*i.OrderGT))
+	}
+	if i.OrderGTE != nil {
+		predicates = append(predicates, messageattachment.OrderGTE(*i.OrderGTE))
+	}
+	if i.OrderLT != nil {
+		predicates = append(predicates, messageattachment.OrderLT(*i.OrderLT))
+	}
+	if i.OrderLTE != nil {
+		predicates = append(predicates, messageattachment.OrderLTE(*i.OrderLTE))
+	}
+	if i.AttachedAt != nil {
+		predicates = append(predicates, messageattachment.AttachedAtEQ(*i.AttachedAt))
+	}
+	if i.AttachedAtNEQ != nil {
+		predicates = append(predicates, messageattachment.AttachedAtNEQ(*i.AttachedAtNEQ))
+	}
+	if len(i.AttachedAtIn) > 0 {
+		predicates = append(predicates, messageattachment.AttachedAtIn(i.AttachedAtIn...))
+	}
+	if len(i.AttachedAtNotIn) > 0 {
+		predicates = append(predicates, messageattachment.AttachedAtNotIn(i.AttachedAtNotIn...))
+	}
+	if i.AttachedAtGT != nil {
+		predicates = append(predicates, messageattachment.AttachedAtGT(*i.AttachedAtGT))
+	}
+	if i.AttachedAtGTE != nil {
+		predicates = append(predicates, messageattachment.AttachedAtGTE(*i.AttachedAtGTE))
+	}
+	if i.AttachedAtLT != nil {
+		predicates = append(predicates, messageattachment.AttachedAtLT(*i.AttachedAtLT))
+	}
+	if i.AttachedAtLTE != nil {
+		predicates = append(predicates, messageattachment.AttachedAtLTE(*i.AttachedAtLTE))
+	}
+
+	if i.HasRoom != nil {
+		p := messageattachment.HasRoom()
+		if !*i.HasRoom {
+			p = messageattachment.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasRoomWith) > 0 {
+		with := make([]predicate.Room, 0, len(i.HasRoomWith))
+		for _, w := range i.HasRoomWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasRoomWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, messageattachment.HasRoomWith(with...))
+	}
+	if i.HasMessage != nil {
+		p := messageattachment.HasMessage()
+		if !*i.HasMessage {
+			p = messageattachment.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasMessageWith) > 0 {
+		with := make([]predicate.Message, 0, len(i.HasMessageWith))
+		for _, w := range i.HasMessageWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasMessageWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, messageattachment.HasMessageWith(with...))
+	}
+	if i.HasFile != nil {
+		p := messageattachment.HasFile()
+		if !*i.HasFile {
+			p = messageattachment.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasFileWith) > 0 {
+		with := make([]predicate.File, 0, len(i.HasFileWith))
+		for _, w := range i.HasFileWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasFileWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, messageattachment.HasFileWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyMessageAttachmentWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return messageattachment.And(predicates...), nil
+	}
+}
+
+// MessageLinkWhereInput represents a where input for filtering MessageLink queries.
+type MessageLinkWhereInput struct {
+	Predicates []predicate.MessageLink  `json:"-"`
+	Not        *MessageLinkWhereInput   `json:"not,omitempty"`
+	Or         []*MessageLinkWhereInput `json:"or,omitempty"`
+	And        []*MessageLinkWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *pulid.ID  `json:"id,omitempty"`
+	IDNEQ   *pulid.ID  `json:"idNEQ,omitempty"`
+	IDIn    []pulid.ID `json:"idIn,omitempty"`
+	IDNotIn []pulid.ID `json:"idNotIn,omitempty"`
+	IDGT    *pulid.ID  `json:"idGT,omitempty"`
+	IDGTE   *pulid.ID  `json:"idGTE,omitempty"`
+	IDLT    *pulid.ID  `json:"idLT,omitempty"`
+	IDLTE   *pulid.ID  `json:"idLTE,omitempty"`
+
+	// "link" field predicates.
+	Link             *string  `json:"link,omitempty"`
+	LinkNEQ          *string  `json:"linkNEQ,omitempty"`
+	LinkIn           []string `json:"linkIn,omitempty"`
+	LinkNotIn        []string `json:"linkNotIn,omitempty"`
+	LinkGT           *string  `json:"linkGT,omitempty"`
+	LinkGTE          *string  `json:"linkGTE,omitempty"`
+	LinkLT           *string  `json:"linkLT,omitempty"`
+	LinkLTE          *string  `json:"linkLTE,omitempty"`
+	LinkContains     *string  `json:"linkContains,omitempty"`
+	LinkHasPrefix    *string  `json:"linkHasPrefix,omitempty"`
+	LinkHasSuffix    *string  `json:"linkHasSuffix,omitempty"`
+	LinkEqualFold    *string  `json:"linkEqualFold,omitempty"`
+	LinkContainsFold *string  `json:"linkContainsFold,omitempty"`
+
+	// "title" field predicates.
+	Title             *string  `json:"title,omitempty"`
+	TitleNEQ          *string  `json:"titleNEQ,omitempty"`
+	TitleIn           []string `json:"titleIn,omitempty"`
+	TitleNotIn        []string `json:"titleNotIn,omitempty"`
+	TitleGT           *string  `json:"titleGT,omitempty"`
+	TitleGTE          *string  `json:"titleGTE,omitempty"`
+	TitleLT           *string  `json:"titleLT,omitempty"`
+	TitleLTE          *string  `json:"titleLTE,omitempty"`
+	TitleContains     *string  `json:"titleContains,omitempty"`
+	TitleHasPrefix    *string  `json:"titleHasPrefix,omitempty"`
+	TitleHasSuffix    *string  `json:"titleHasSuffix,omitempty"`
+	TitleIsNil        bool     `json:"titleIsNil,omitempty"`
+	TitleNotNil       bool     `json:"titleNotNil,omitempty"`
+	TitleEqualFold    *string  `json:"titleEqualFold,omitempty"`
+	TitleContainsFold *string  `json:"titleContainsFold,omitempty"`
+
+	// "description" field predicates.
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGT           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGTE          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLT           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLTE          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionIsNil        bool     `json:"descriptionIsNil,omitempty"`
+	DescriptionNotNil       bool     `json:"descriptionNotNil,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+
+	// "image_url" field predicates.
+	ImageURL             *string  `json:"imageURL,omitempty"`
+	ImageURLNEQ          *string  `json:"imageURLNEQ,omitempty"`
+	ImageURLIn           []string `json:"imageURLIn,omitempty"`
+	ImageURLNotIn        []string `json:"imageURLNotIn,omitempty"`
+	ImageURLGT           *string  `json:"imageURLGT,omitempty"`
+	ImageURLGTE          *string  `json:"imageURLGTE,omitempty"`
+	ImageURLLT           *string  `json:"imageURLLT,omitempty"`
+	ImageURLLTE          *string  `json:"imageURLLTE,omitempty"`
+	ImageURLContains     *string  `json:"imageURLContains,omitempty"`
+	ImageURLHasPrefix    *string  `json:"imageURLHasPrefix,omitempty"`
+	ImageURLHasSuffix    *string  `json:"imageURLHasSuffix,omitempty"`
+	ImageURLIsNil        bool     `json:"imageURLIsNil,omitempty"`
+	ImageURLNotNil       bool     `json:"imageURLNotNil,omitempty"`
+	ImageURLEqualFold    *string  `json:"imageURLEqualFold,omitempty"`
+	ImageURLContainsFold *string  `json:"imageURLContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "room" edge predicates.
+	HasRoom     *bool             `json:"hasRoom,omitempty"`
+	HasRoomWith []*RoomWhereInput `json:"hasRoomWith,omitempty"`
+
+	// "message" edge predicates.
+	HasMessage     *bool                `json:"hasMessage,omitempty"`
+	HasMessageWith []*MessageWhereInput `json:"hasMessageWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *MessageLinkWhereInput) AddPredicates(predicates ...predicate.MessageLink) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the MessageLinkWhereInput filter on the MessageLinkQuery builder.
+func (i *MessageLinkWhereInput) Filter(q *MessageLinkQuery) (*MessageLinkQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyMessageLinkWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyMessageLinkWhereInput is returned in case the MessageLinkWhereInput is empty.
+var ErrEmptyMessageLinkWhereInput = errors.New("ent: empty predicate MessageLinkWhereInput")
+
+// P returns a predicate for filtering messagelinks.
+// An error is returned if the input is empty or invalid.
+func (i *MessageLinkWhereInput) P() (predicate.MessageLink, error) {
+	var predicates []predicate.MessageLink
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, messagelink.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.MessageLink, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, messagelink.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.MessageLink, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, messagelink.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, messagelink.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, messagelink.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, messagelink.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, messagelink.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, messagelink.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, messagelink.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, messagelink.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, messagelink.IDLTE(*i.IDLTE))
+	}
+	if i.Link != nil {
+		predicates = append(predicates, messagelink.LinkEQ(*i.Link))
+	}
+	if i.LinkNEQ != nil {
+		predicates = append(predicates, messagelink.LinkNEQ(*i.LinkNEQ))
+	}
+	if len(i.LinkIn) > 0 {
+		predicates = append(predicates, messagelink.LinkIn(i.LinkIn...))
+	}
+	if len(i.LinkNotIn) > 0 {
+		predicates = append(predicates, messagelink.LinkNotIn(i.LinkNotIn...))
+	}
+	if i.LinkGT != nil {
+		predicates = append(predicates, messagelink.LinkGT(*i.LinkGT))
+	}
+	if i.LinkGTE != nil {
+		predicates = append(predicates, messagelink.LinkGTE(*i.LinkGTE))
+	}
+	if i.LinkLT != nil {
+		predicates = append(predicates, messagelink.LinkLT(*i.LinkLT))
+	}
+	if i.LinkLTE != nil {
+		predicates = append(predicates, messagelink.LinkLTE(*i.LinkLTE))
+	}
+	if i.LinkContains != nil {
+		predicates = append(predicates, messagelink.LinkContains(*i.LinkContains))
+	}
+	if i.LinkHasPrefix != nil {
+		predicates = append(predicates, messagelink.LinkHasPrefix(*i.LinkHasPrefix))
+	}
+	if i.LinkHasSuffix != nil {
+		predicates = append(predicates, messagelink.LinkHasSuffix(*i.LinkHasSuffix))
+	}
+	if i.LinkEqualFold != nil {
+		predicates = append(predicates, messagelink.LinkEqualFold(*i.LinkEqualFold))
+	}
+	if i.LinkContainsFold != nil {
+		predicates = append(predicates, messagelink.LinkContainsFold(*i.LinkContainsFold))
+	}
+	if i.Title != nil {
+		predicates = append(predicates, messagelink.TitleEQ(*i.Title))
+	}
+	if i.TitleNEQ != nil {
+		predicates = append(predicates, messagelink.TitleNEQ(*i.TitleNEQ))
+	}
+	if len(i.TitleIn) > 0 {
+		predicates = append(predicates, messagelink.TitleIn(i.TitleIn...))
+	}
+	if len(i.TitleNotIn) > 0 {
+		predicates = append(predicates, messagelink.TitleNotIn(i.TitleNotIn...))
+	}
+	if i.TitleGT != nil {
+		predicates = append(predicates, messagelink.TitleGT(*i.TitleGT))
+	}
+	if i.TitleGTE != nil {
+		predicates = append(predicates, messagelink.TitleGTE(*i.TitleGTE))
+	}
+	if i.TitleLT != nil {
+		predicates = append(predicates, messagelink.TitleLT(*i.TitleLT))
+	}
+	if i.TitleLTE != nil {
+		predicates = append(predicates, messagelink.TitleLTE(*i.TitleLTE))
+	}
+	if i.TitleContains != nil {
+		predicates = append(predicates, messagelink.TitleContains(*i.TitleContains))
+	}
+	if i.TitleHasPrefix != nil {
+		predicates = append(predicates, messagelink.TitleHasPrefix(*i.TitleHasPrefix))
+	}
+	if i.TitleHasSuffix != nil {
+		predicates = append(predicates, messagelink.TitleHasSuffix(*i.TitleHasSuffix))
+	}
+	if i.TitleIsNil {
+		predicates = append(predicates, messagelink.TitleIsNil())
+	}
+	if i.TitleNotNil {
+		predicates = append(predicates, messagelink.TitleNotNil())
+	}
+	if i.TitleEqualFold != nil {
+		predicates = append(predicates, messagelink.TitleEqualFold(*i.TitleEqualFold))
+	}
+	if i.TitleContainsFold != nil {
+		predicates = append(predicates, messagelink.TitleContainsFold(*i.TitleContainsFold))
+	}
+	if i.Description != nil {
+		predicates = append(predicates, messagelink.DescriptionEQ(*i.Description))
+	}
+	if i.DescriptionNEQ != nil {
+		predicates = append(predicates, messagelink.DescriptionNEQ(*i.DescriptionNEQ))
+	}
+	if len(i.DescriptionIn) > 0 {
+		predicates = append(predicates, messagelink.DescriptionIn(i.DescriptionIn...))
+	}
+	if len(i.DescriptionNotIn) > 0 {
+		predicates = append(predicates, messagelink.DescriptionNotIn(i.DescriptionNotIn...))
+	}
+	if i.DescriptionGT != nil {
+		predicates = append(predicates, messagelink.DescriptionGT(*i.DescriptionGT))
+	}
+	if i.DescriptionGTE != nil {
+		predicates = append(predicates, messagelink.DescriptionGTE(*i.DescriptionGTE))
+	}
+	if i.DescriptionLT != nil {
+		predicates = append(predicates, messagelink.DescriptionLT(*i.DescriptionLT))
+	}
+	if i.DescriptionLTE != nil {
+		predicates = append(predicates, messagelink.DescriptionLTE(*i.DescriptionLTE))
+	}
+	if i.DescriptionContains != nil {
+		predicates = append(predicates, messagelink.DescriptionContains(*i.DescriptionContains))
+	}
+	if i.DescriptionHasPrefix != nil {
+		predicates = append(predicates, messagelink.DescriptionHasPrefix(*i.DescriptionHasPrefix))
+	}
+	if i.DescriptionHasSuffix != nil {
+		predicates = append(predicates, messagelink.DescriptionHasSuffix(*i.DescriptionHasSuffix))
+	}
+	if i.DescriptionIsNil {
+		predicates = append(predicates, messagelink.DescriptionIsNil())
+	}
+	if i.DescriptionNotNil {
+		predicates = append(predicates, messagelink.DescriptionNotNil())
+	}
+	if i.DescriptionEqualFold != nil {
+		predicates = append(predicates, messagelink.DescriptionEqualFold(*i.DescriptionEqualFold))
+	}
+	if i.DescriptionContainsFold != nil {
+		predicates = append(predicates, messagelink.DescriptionContainsFold(*i.DescriptionContainsFold))
+	}
+	if i.ImageURL != nil {
+		predicates = append(predicates, messagelink.ImageURLEQ(*i.ImageURL))
+	}
+	if i.ImageURLNEQ != nil {
+		predicates = append(predicates, messagelink.ImageURLNEQ(*i.ImageURLNEQ))
+	}
+	if len(i.ImageURLIn) > 0 {
+		predicates = append(predicates, messagelink.ImageURLIn(i.ImageURLIn...))
+	}
+	if len(i.ImageURLNotIn) > 0 {
+		predicates = append(predicates, messagelink.ImageURLNotIn(i.ImageURLNotIn...))
+	}
+	if i.ImageURLGT != nil {
+		predicates = append(predicates, messagelink.ImageURLGT(*i.ImageURLGT))
+	}
+	if i.ImageURLGTE != nil {
+		predicates = append(predicates, messagelink.ImageURLGTE(*i.ImageURLGTE))
+	}
+	if i.ImageURLLT != nil {
+		predicates = append(predicates, messagelink.ImageURLLT(*i.ImageURLLT))
+	}
+	if i.ImageURLLTE != nil {
+		predicates = append(predicates, messagelink.ImageURLLTE(*i.ImageURLLTE))
+	}
+	if i.ImageURLContains != nil {
+		predicates = append(predicates, messagelink.ImageURLContains(*i.ImageURLContains))
+	}
+	if i.ImageURLHasPrefix != nil {
+		predicates = append(predicates, messagelink.ImageURLHasPrefix(*i.ImageURLHasPrefix))
+	}
+	if i.ImageURLHasSuffix != nil {
+		predicates = append(predicates, messagelink.ImageURLHasSuffix(*i.ImageURLHasSuffix))
+	}
+	if i.ImageURLIsNil {
+		predicates = append(predicates, messagelink.ImageURLIsNil())
+	}
+	if i.ImageURLNotNil {
+		predicates = append(predicates, messagelink.ImageURLNotNil())
+	}
+	if i.ImageURLEqualFold != nil {
+		predicates = append(predicates, messagelink.ImageURLEqualFold(*i.ImageURLEqualFold))
+	}
+	if i.ImageURLContainsFold != nil {
+		predicates = append(predicates, messagelink.ImageURLContainsFold(*i.ImageURLContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, messagelink.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, messagelink.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, messagelink.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, messagelink.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, messagelink.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, messagelink.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, messagelink.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, messagelink.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, messagelink.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, messagelink.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, messagelink.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, messagelink.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, messagelink.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, messagelink.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, messagelink.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, messagelink.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+
+	if i.HasRoom != nil {
+		p := messagelink.HasRoom()
+		if !*i.HasRoom {
+			p = messagelink.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasRoomWith) > 0 {
+		with := make([]predicate.Room, 0, len(i.HasRoomWith))
+		for _, w := range i.HasRoomWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasRoomWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, messagelink.HasRoomWith(with...))
+	}
+	if i.HasMessage != nil {
+		p := messagelink.HasMessage()
+		if !*i.HasMessage {
+			p = messagelink.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasMessageWith) > 0 {
+		with := make([]predicate.Message, 0, len(i.HasMessageWith))
+		for _, w := range i.HasMessageWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasMessageWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, messagelink.HasMessageWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyMessageLinkWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return messagelink.And(predicates...), nil
+	}
+}
+
+// MessageVoiceWhereInput represents a where input for filtering MessageVoice queries.
+type MessageVoiceWhereInput struct {
+	Predicates []predicate.MessageVoice  `json:"-"`
+	Not        *MessageVoiceWhereInput   `json:"not,omitempty"`
+	Or         []*MessageVoiceWhereInput `json:"or,omitempty"`
+	And        []*MessageVoiceWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *pulid.ID  `json:"id,omitempty"`
+	IDNEQ   *pulid.ID  `json:"idNEQ,omitempty"`
+	IDIn    []pulid.ID `json:"idIn,omitempty"`
+	IDNotIn []pulid.ID `json:"idNotIn,omitempty"`
+	IDGT    *pulid.ID  `json:"idGT,omitempty"`
+	IDGTE   *pulid.ID  `json:"idGTE,omitempty"`
+	IDLT    *pulid.ID  `json:"idLT,omitempty"`
+	IDLTE   *pulid.ID  `json:"idLTE,omitempty"`
+
+	// "length" field predicates.
+	Length      *uint64  `json:"length,omitempty"`
+	LengthNEQ   *uint64  `json:"lengthNEQ,omitempty"`
+	LengthIn    []uint64 `json:"lengthIn,omitempty"`
+	LengthNotIn []uint64 `json:"lengthNotIn,omitempty"`
+	LengthGT    *uint64  `json:"lengthGT,omitempty"`
+	LengthGTE   *uint64  `json:"lengthGTE,omitempty"`
+	LengthLT    *uint64  `json:"lengthLT,omitempty"`
+	LengthLTE   *uint64  `json:"lengthLTE,omitempty"`
+
+	// "attached_at" field predicates.
+	AttachedAt      *time.Time  `json:"attachedAt,omitempty"`
+	AttachedAtNEQ   *time.Time  `json:"attachedAtNEQ,omitempty"`
+	AttachedAtIn    []time.Time `json:"attachedAtIn,omitempty"`
+	AttachedAtNotIn []time.Time `json:"attachedAtNotIn,omitempty"`
+	AttachedAtGT    *time.Time  `json:"attachedAtGT,omitempty"`
+	AttachedAtGTE   *time.Time  `json:"attachedAtGTE,omitempty"`
+	AttachedAtLT    *time.Time  `json:"attachedAtLT,omitempty"`
+	AttachedAtLTE   *time.Time  `json:"attachedAtLTE,omitempty"`
+
+	// "room" edge predicates.
+	HasRoom     *bool             `json:"hasRoom,omitempty"`
+	HasRoomWith []*RoomWhereInput `json:"hasRoomWith,omitempty"`
+
+	// "message" edge predicates.
+	HasMessage     *bool                `json:"hasMessage,omitempty"`
+	HasMessageWith []*MessageWhereInput `json:"hasMessageWith,omitempty"`
+
+	// "file" edge predicates.
+	HasFile     *bool             `json:"hasFile,omitempty"`
+	HasFileWith []*FileWhereInput `json:"hasFileWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *MessageVoiceWhereInput) AddPredicates(predicates ...predicate.MessageVoice) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the MessageVoiceWhereInput filter on the MessageVoiceQuery builder.
+func (i *MessageVoiceWhereInput) Filter(q *MessageVoiceQuery) (*MessageVoiceQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyMessageVoiceWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyMessageVoiceWhereInput is returned in case the MessageVoiceWhereInput is empty.
+var ErrEmptyMessageVoiceWhereInput = errors.New("ent: empty predicate MessageVoiceWhereInput")
+
+// P returns a predicate for filtering messagevoices.
+// An error is returned if the input is empty or invalid.
+func (i *MessageVoiceWhereInput) P() (predicate.MessageVoice, error) {
+	var predicates []predicate.MessageVoice
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, messagevoice.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.MessageVoice, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, messagevoice.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.MessageVoice, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, messagevoice.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, messagevoice.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, messagevoice.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, messagevoice.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, messagevoice.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, messagevoice.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, messagevoice.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, messagevoice.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, messagevoice.IDLTE(*i.IDLTE))
+	}
+	if i.Length != nil {
+		predicates = append(predicates, messagevoice.LengthEQ(*i.Length))
+	}
+	if i.LengthNEQ != nil {
+		predicates = append(predicates, messagevoice.LengthNEQ(*i.LengthNEQ))
+	}
+	if len(i.LengthIn) > 0 {
+		predicates = append(predicates, messagevoice.LengthIn(i.LengthIn...))
+	}
+	if len(i.LengthNotIn) > 0 {
+		predicates = append(predicates, messagevoice.LengthNotIn(i.LengthNotIn...))
+	}
+	if i.LengthGT != nil {
+		predicates = append(predicates, messagevoice.LengthGT(*i.LengthGT))
+	}
+	if i.LengthGTE != nil {
+		predicates = append(predicates, messagevoice.LengthGTE(*i.LengthGTE))
+	}
+	if i.LengthLT != nil {
+		predicates = append(predicates, messagevoice.LengthLT(*i.LengthLT))
+	}
+	if i.LengthLTE != nil {
+		predicates = append(predicates, messagevoice.LengthLTE(*i.LengthLTE))
+	}
+	if i.AttachedAt != nil {
+		predicates = append(predicates, messagevoice.AttachedAtEQ(*i.AttachedAt))
+	}
+	if i.AttachedAtNEQ != nil {
+		predicates = append(predicates, messagevoice.AttachedAtNEQ(*i.AttachedAtNEQ))
+	}
+	if len(i.AttachedAtIn) > 0 {
+		predicates = append(predicates, messagevoice.AttachedAtIn(i.AttachedAtIn...))
+	}
+	if len(i.AttachedAtNotIn) > 0 {
+		predicates = append(predicates, messagevoice.AttachedAtNotIn(i.AttachedAtNotIn...))
+	}
+	if i.AttachedAtGT != nil {
+		predicates = append(predicates, messagevoice.AttachedAtGT(*i.AttachedAtGT))
+	}
+	if i.AttachedAtGTE != nil {
+		predicates = append(predicates, messagevoice.AttachedAtGTE(*i.AttachedAtGTE))
+	}
+	if i.AttachedAtLT != nil {
+		predicates = append(predicates, messagevoice.AttachedAtLT(*i.AttachedAtLT))
+	}
+	if i.AttachedAtLTE != nil {
+		predicates = append(predicates, messagevoice.AttachedAtLTE(*i.AttachedAtLTE))
+	}
+
+	if i.HasRoom != nil {
+		p := messagevoice.HasRoom()
+		if !*i.HasRoom {
+			p = messagevoice.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasRoomWith) > 0 {
+		with := make([]predicate.Room, 0, len(i.HasRoomWith))
+		for _, w := range i.HasRoomWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasRoomWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, messagevoice.HasRoomWith(with...))
+	}
+	if i.HasMessage != nil {
+		p := messagevoice.HasMessage()
+		if !*i.HasMessage {
+			p = messagevoice.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasMessageWith) > 0 {
+		with := make([]predicate.Message, 0, len(i.HasMessageWith))
+		for _, w := range i.HasMessageWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasMessageWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, messagevoice.HasMessageWith(with...))
+	}
+	if i.HasFile != nil {
+		p := messagevoice.HasFile()
+		if !*i.HasFile {
+			p = messagevoice.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasFileWith) > 0 {
+		with := make([]predicate.File, 0, len(i.HasFileWith))
+		for _, w := range i.HasFileWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasFileWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, messagevoice.HasFileWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyMessageVoiceWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return messagevoice.And(predicates...), nil
+	}
+}
+
+// NotificationWhereInput represents a where input for filtering Notification queries.
+type NotificationWhereInput struct {
+	Predicates []predicate.Notification  `json:"-"`
+	Not        *NotificationWhereInput   `json:"not,omitempty"`
+	Or         []*NotificationWhereInput `json:"or,omitempty"`
+	And        []*NotificationWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *pulid.ID  `json:"id,omitempty"`
+	IDNEQ   *pulid.ID  `json:"idNEQ,omitempty"`
+	IDIn    []pulid.ID `json:"idIn,omitempty"`
+	IDNotIn []pulid.ID `json:"idNotIn,omitempty"`
+	IDGT    *pulid.ID  `json:"idGT,omitempty"`
+	IDGTE   *pulid.ID  `json:"idGTE,omitempty"`
+	IDLT    *pulid.ID  `json:"idLT,omitempty"`
+	IDLTE   *pulid.ID  `json:"idLTE,omitempty"`
+
+	// "type" field predicates.
+	Type      *notification.Type  `json:"type,omitempty"`
+	TypeNEQ   *notification.Type  `json:"typeNEQ,omitempty"`
+	TypeIn    []notification.Type `json:"typeIn,omitempty"`
+	TypeNotIn []notification.Type `json:"typeNotIn,omitempty"`
+
+	// "title" field predicates.
+	Title             *string  `json:"title,omitempty"`
+	TitleNEQ          *string  `json:"titleNEQ,omitempty"`
+	TitleIn           []string `json:"titleIn,omitempty"`
+	TitleNotIn        []string `json:"titleNotIn,omitempty"`
+	TitleGT           *string  `json:"titleGT,omitempty"`
+	TitleGTE          *string  `json:"titleGTE,omitempty"`
+	TitleLT           *string  `json:"titleLT,omitempty"`
+	TitleLTE          *string  `json:"titleLTE,omitempty"`
+	TitleContains     *string  `json:"titleContains,omitempty"`
+	TitleHasPrefix    *string  `json:"titleHasPrefix,omitempty"`
+	TitleHasSuffix    *string  `json:"titleHasSuffix,omitempty"`
+	TitleEqualFold    *string  `json:"titleEqualFold,omitempty"`
+	TitleContainsFold *string  `json:"titleContainsFold,omitempty"`
+
+	// "body" field predicates.
+	Body             *string  `json:"body,omitempty"`
+	BodyNEQ          *string  `json:"bodyNEQ,omitempty"`
+	BodyIn           []string `json:"bodyIn,omitempty"`
+	BodyNotIn        []string `json:"bodyNotIn,omitempty"`
+	BodyGT           *string  `json:"bodyGT,omitempty"`
+	BodyGTE          *string  `json:"bodyGTE,omitempty"`
+	BodyLT           *string  `json:"bodyLT,omitempty"`
+	BodyLTE          *string  `json:"bodyLTE,omitempty"`
+	BodyContains     *string  `json:"bodyContains,omitempty"`
+	BodyHasPrefix    *string  `json:"bodyHasPrefix,omitempty"`
+	BodyHasSuffix    *string  `json:"bodyHasSuffix,omitempty"`
+	BodyEqualFold    *string  `json:"bodyEqualFold,omitempty"`
+	BodyContainsFold *string  `json:"bodyContainsFold,omitempty"`
+
+	// "read_at" field predicates.
+	ReadAt       *time.Time  `json:"readAt,omitempty"`
+	ReadAtNEQ    *time.Time  `json:"readAtNEQ,omitempty"`
+	ReadAtIn     []time.Time `json:"readAtIn,omitempty"`
+	ReadAtNotIn  []time.Time `json:"readAtNotIn,omitempty"`
+	ReadAtGT     *time.Time  `json:"readAtGT,omitempty"`
+	ReadAtGTE    *time.Time  `json:"readAtGTE,omitempty"`
+	ReadAtLT     *time.Time  `json:"readAtLT,omitempty"`
+	ReadAtLTE    *time.Time  `json:"readAtLTE,omitempty"`
+	ReadAtIsNil  bool        `json:"readAtIsNil,omitempty"`
+	ReadAtNotNil bool        `json:"readAtNotNil,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "user" edge predicates.
+	HasUser     *bool             `json:"hasUser,omitempty"`
+	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *NotificationWhereInput) AddPredicates(predicates ...predicate.Notification) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the NotificationWhereInput filter on the NotificationQuery builder.
+func (i *NotificationWhereInput) Filter(q *NotificationQuery) (*NotificationQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyNotificationWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyNotificationWhereInput is returned in case the NotificationWhereInput is empty.
+var ErrEmptyNotificationWhereInput = errors.New("ent: empty predicate NotificationWhereInput")
+
+// P returns a predicate for filtering notifications.
+// An error is returned if the input is empty or invalid.
+func (i *NotificationWhereInput) P() (predicate.Notification, error) {
+	var predicates []predicate.Notification
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, notification.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Notification, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, notification.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Notification, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, notification.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, notification.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, notification.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, notification.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, notification.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, notification.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, notification.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, notification.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, notification.IDLTE(*i.IDLTE))
+	}
+	if i.Type != nil {
+		predicates = append(predicates, notification.TypeEQ(*i.Type))
+	}
+	if i.TypeNEQ != nil {
+		predicates = append(predicates, notification.TypeNEQ(*i.TypeNEQ))
+	}
+	if len(i.TypeIn) > 0 {
+		predicates = append(predicates, notification.TypeIn(i.TypeIn...))
+	}
+	if len(i.TypeNotIn) > 0 {
+		predicates = append(predicates, notification.TypeNotIn(i.TypeNotIn...))
+	}
+	if i.Title != nil {
+		predicates = append(predicates, notification.TitleEQ(*i.Title))
+	}
+	if i.TitleNEQ != nil {
+		predicates = append(predicates, notification.TitleNEQ(*i.TitleNEQ))
+	}
+	if len(i.TitleIn) > 0 {
+		predicates = append(predicates, notification.TitleIn(i.TitleIn...))
+	}
+	if len(i.TitleNotIn) > 0 {
+		predicates = append(predicates, notification.TitleNotIn(i.TitleNotIn...))
+	}
+	if i.TitleGT != nil {
+		predicates = append(predicates, notification.TitleGT(*i.TitleGT))
+	}
+	if i.TitleGTE != nil {
+		predicates = append(predicates, notification.TitleGTE(*i.TitleGTE))
+	}
+	if i.TitleLT != nil {
+		predicates = append(predicates, notification.TitleLT(*i.TitleLT))
+	}
+	if i.TitleLTE != nil {
+		predicates = append(predicates, notification.TitleLTE(*i.TitleLTE))
+	}
+	if i.TitleContains != nil {
+		predicates = append(predicates, notification.TitleContains(*i.TitleContains))
+	}
+	if i.TitleHasPrefix != nil {
+		predicates = append(predicates, notification.TitleHasPrefix(*i.TitleHasPrefix))
+	}
+	if i.TitleHasSuffix != nil {
+		predicates = append(predicates, notification.TitleHasSuffix(*i.TitleHasSuffix))
+	}
+	if i.TitleEqualFold != nil {
+		predicates = append(predicates, notification.TitleEqualFold(*i.TitleEqualFold))
+	}
+	if i.TitleContainsFold != nil {
+		predicates = append(predicates, notification.TitleContainsFold(*i.TitleContainsFold))
+	}
+	if i.Body != nil {
+		predicates = append(predicates, notification.BodyEQ(*i.Body))
+	}
+	if i.BodyNEQ != nil {
+		predicates = append(predicates, notification.BodyNEQ(*i.BodyNEQ))
+	}
+	if len(i.BodyIn) > 0 {
+		predicates = append(predicates, notification.BodyIn(i.BodyIn...))
+	}
+	if len(i.BodyNotIn) > 0 {
+		predicates = append(predicates, notification.BodyNotIn(i.BodyNotIn...))
+	}
+	if i.BodyGT != nil {
+		predicates = append(predicates, notification.BodyGT(*i.BodyGT))
+	}
+	if i.BodyGTE != nil {
+		predicates = append(predicates, notification.BodyGTE(*i.BodyGTE))
+	}
+	if i.BodyLT != nil {
+		predicates = append(predicates, notification.BodyLT(*i.BodyLT))
+	}
+	if i.BodyLTE != nil {
+		predicates = append(predicates, notification.BodyLTE(*i.BodyLTE))
+	}
+	if i.BodyContains != nil {
+		predicates = append(predicates, notification.BodyContains(*i.BodyContains))
+	}
+	if i.BodyHasPrefix != nil {
+		predicates = append(predicates, notification.BodyHasPrefix(*i.BodyHasPrefix))
+	}
+	if i.BodyHasSuffix != nil {
+		predicates = append(predicates, notification.BodyHasSuffix(*i.BodyHasSuffix))
+	}
+	if i.BodyEqualFold != nil {
+		predicates = append(predicates, notification.BodyEqualFold(*i.BodyEqualFold))
+	}
+	if i.BodyContainsFold != nil {
+		predicates = append(predicates, notification.BodyContainsFold(*i.BodyContainsFold))
+	}
+	if i.ReadAt != nil {
+		predicates = append(predicates, notification.ReadAtEQ(*i.ReadAt))
+	}
+	if i.ReadAtNEQ != nil {
+		predicates = append(predicates, notification.ReadAtNEQ(*i.ReadAtNEQ))
+	}
+	if len(i.ReadAtIn) > 0 {
+		predicates = append(predicates, notification.ReadAtIn(i.ReadAtIn...))
+	}
+	if len(i.ReadAtNotIn) > 0 {
+		predicates = append(predicates, notification.ReadAtNotIn(i.ReadAtNotIn...))
+	}
+	if i.ReadAtGT != nil {
+		predicates = append(predicates, notification.ReadAtGT(*i.ReadAtGT))
+	}
+	if i.ReadAtGTE != nil {
+		predicates = append(predicates, notification.ReadAtGTE(*i.ReadAtGTE))
+	}
+	if i.ReadAtLT != nil {
+		predicates = append(predicates, notification.ReadAtLT(*i.ReadAtLT))
+	}
+	if i.ReadAtLTE != nil {
+		predicates = append(predicates, notification.ReadAtLTE(*i.ReadAtLTE))
+	}
+	if i.ReadAtIsNil {
+		predicates = append(predicates, notification.ReadAtIsNil())
+	}
+	if i.ReadAtNotNil {
+		predicates = append(predicates, notification.ReadAtNotNil())
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, notification.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, notification.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, notification.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, notification.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, notification.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, notification.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, notification.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, notification.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, notification.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, notification.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, notification.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, notification.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, notification.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, notification.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, notification.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, notification.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+
+	if i.HasUser != nil {
+		p := notification.HasUser()
+		if !*i.HasUser {
+			p = notification.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasUserWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUserWith))
+		for _, w := range i.HasUserWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, notification.HasUserWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyNotificationWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return notification.And(predicates...), nil
+	}
+}
+
+// ReportWhereInput represents a where input for filtering Report queries.
+type ReportWhereInput struct {
+	Predicates []predicate.Report  `json:"-"`
+	Not        *ReportWhereInput   `json:"not,omitempty"`
+	Or         []*ReportWhereInput `json:"or,omitempty"`
+	And        []*ReportWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *pulid.ID  `json:"id,omitempty"`
+	IDNEQ   *pulid.ID  `json:"idNEQ,omitempty"`
+	IDIn    []pulid.ID `json:"idIn,omitempty"`
+	IDNotIn []pulid.ID `json:"idNotIn,omitempty"`
+	IDGT    *pulid.ID  `json:"idGT,omitempty"`
+	IDGTE   *pulid.ID  `json:"idGTE,omitempty"`
+	IDLT    *pulid.ID  `json:"idLT,omitempty"`
+	IDLTE   *pulid.ID  `json:"idLTE,omitempty"`
+
+	// "type" field predicates.
+	Type      *report.Type  `json:"type,omitempty"`
+	TypeNEQ   *report.Type  `json:"typeNEQ,omitempty"`
+	TypeIn    []report.Type `json:"typeIn,omitempty"`
+	TypeNotIn []report.Type `json:"typeNotIn,omitempty"`
+
+	// "reason" field predicates.
+	Reason      *report.Reason  `json:"reason,omitempty"`
+	ReasonNEQ   *report.Reason  `json:"reasonNEQ,omitempty"`
+	ReasonIn    []report.Reason `json:"reasonIn,omitempty"`
+	ReasonNotIn []report.Reason `json:"reasonNotIn,omitempty"`
+
+	// "text" field predicates.
+	Text             *string  `json:"text,omitempty"`
+	TextNEQ          *string  `json:"textNEQ,omitempty"`
+	TextIn           []string `json:"textIn,omitempty"`
+	TextNotIn        []string `json:"textNotIn,omitempty"`
+	TextGT           *string  `json:"textGT,omitempty"`
+	TextGTE          *string  `json:"textGTE,omitempty"`
+	TextLT           *string  `json:"textLT,omitempty"`
+	TextLTE          *string  `json:"textLTE,omitempty"`
+	TextContains     *string  `json:"textContains,omitempty"`
+	TextHasPrefix    *string  `json:"textHasPrefix,omitempty"`
+	TextHasSuffix    *string  `json:"textHasSuffix,omitempty"`
+	TextIsNil        bool     `json:"textIsNil,omitempty"`
+	TextNotNil       bool     `json:"textNotNil,omitempty"`
+	TextEqualFold    *string  `json:"textEqualFold,omitempty"`
+	TextContainsFold *string  `json:"textContainsFold,omitempty"`
+
+	// "status" field predicates.
+	Status      *report.Status  `json:"status,omitempty"`
+	StatusNEQ   *report.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []report.Status `json:"statusIn,omitempty"`
+	StatusNotIn []report.Status `json:"statusNotIn,omitempty"`
+
+	// "note" field predicates.
+	Note             *string  `json:"note,omitempty"`
+	NoteNEQ          *string  `json:"noteNEQ,omitempty"`
+	NoteIn           []string `json:"noteIn,omitempty"`
+	NoteNotIn        []string `json:"noteNotIn,omitempty"`
+	NoteGT           *string  `json:"noteGT,omitempty"`
+	NoteGTE          *string  `json:"noteGTE,omitempty"`
+	NoteLT           *string  `json:"noteLT,omitempty"`
+	NoteLTE          *string  `json:"noteLTE,omitempty"`
+	NoteContains     *string  `json:"noteContains,omitempty"`
+	NoteHasPrefix    *string  `json:"noteHasPrefix,omitempty"`
+	NoteHasSuffix    *string  `json:"noteHasSuffix,omitempty"`
+	NoteIsNil        bool     `json:"noteIsNil,omitempty"`
+	NoteNotNil       bool     `json:"noteNotNil,omitempty"`
+	NoteEqualFold    *string  `json:"noteEqualFold,omitempty"`
+	NoteContainsFold *string  `json:"noteContainsFold,omitempty"`
+
+	// "reporter_id" field predicates.
+	ReporterID             *pulid.ID  `json:"reporterID,omitempty"`
+	ReporterIDNEQ          *pulid.ID  `json:"reporterIDNEQ,omitempty"`
+	ReporterIDIn           []pulid.ID `json:"reporterIDIn,omitempty"`
+	ReporterIDNotIn        []pulid.ID `json:"reporterIDNotIn,omitempty"`
+	ReporterIDGT           *pulid.ID  `json:"reporterIDGT,omitempty"`
+	ReporterIDGTE          *pulid.ID  `json:"reporterIDGTE,omitempty"`
+	ReporterIDLT           *pulid.ID  `json:"reporterIDLT,omitempty"`
+	ReporterIDLTE          *pulid.ID  `json:"reporterIDLTE,omitempty"`
+	ReporterIDContains     *pulid.ID  `json:"reporterIDContains,omitempty"`
+	ReporterIDHasPrefix    *pulid.ID  `json:"reporterIDHasPrefix,omitempty"`
+	ReporterIDHasSuffix    *pulid.ID  `json:"reporterIDHasSuffix,omitempty"`
+	ReporterIDEqualFold    *pulid.ID  `json:"reporterIDEqualFold,omitempty"`
+	ReporterIDContainsFold *pulid.ID  `json:"reporterIDContainsFold,omitempty"`
+
+	// "user_id" field predicates.
+	UserID             *pulid.ID  `json:"userID,omitempty"`
+	UserIDNEQ          *pulid.ID  `json:"userIDNEQ,omitempty"`
+	UserIDIn           []pulid.ID `json:"userIDIn,omitempty"`
+	UserIDNotIn        []pulid.ID `json:"userIDNotIn,omitempty"`
+	UserIDGT           *pulid.ID  `json:"userIDGT,omitempty"`
+	UserIDGTE          *pulid.ID  `json:"userIDGTE,omitempty"`
+	UserIDLT           *pulid.ID  `json:"userIDLT,omitempty"`
+	UserIDLTE          *pulid.ID  `json:"userIDLTE,omitempty"`
+	UserIDContains     *pulid.ID  `json:"userIDContains,omitempty"`
+	UserIDHasPrefix    *pulid.ID  `json:"userIDHasPrefix,omitempty"`
+	UserIDHasSuffix    *pulid.ID  `json:"userIDHasSuffix,omitempty"`
+	UserIDIsNil        bool       `json:"userIDIsNil,omitempty"`
+	UserIDNotNil       bool       `json:"userIDNotNil,omitempty"`
+	UserIDEqualFold    *pulid.ID  `json:"userIDEqualFold,omitempty"`
+	UserIDContainsFold *pulid.ID  `json:"userIDContainsFold,omitempty"`
+
+	// "message_id" field predicates.
+	MessageID             *pulid.ID  `json:"messageID,omitempty"`
+	MessageIDNEQ          *pulid.ID  `json:"messageIDNEQ,omitempty"`
+	MessageIDIn           []pulid.ID `json:"messageIDIn,omitempty"`
+	MessageIDNotIn        []pulid.ID `json:"messageIDNotIn,omitempty"`
+	MessageIDGT           *pulid.ID  `json:"messageIDGT,omitempty"`
+	MessageIDGTE          *pulid.ID  `json:"messageIDGTE,omitempty"`
+	MessageIDLT           *pulid.ID  `json:"messageIDLT,omitempty"`
+	MessageIDLTE          *pulid.ID  `json:"messageIDLTE,omitempty"`
+	MessageIDContains     *pulid.ID  `json:"messageIDContains,omitempty"`
+	MessageIDHasPrefix    *pulid.ID  `json:"messageIDHasPrefix,omitempty"`
+	MessageIDHasSuffix    *pulid.ID  `json:"messageIDHasSuffix,omitempty"`
+	MessageIDIsNil        bool       `json:"messageIDIsNil,omitempty"`
+	MessageIDNotNil       bool       `json:"messageIDNotNil,omitempty"`
+	MessageIDEqualFold    *pulid.ID  `json:"messageIDEqualFold,omitempty"`
+	MessageIDContainsFold *pulid.ID  `json:"messageIDContainsFold,omitempty"`
+
+	// "room_id" field predicates.
+	RoomID             *pulid.ID  `json:"roomID,omitempty"`
+	RoomIDNEQ          *pulid.ID  `json:"roomIDNEQ,omitempty"`
+	RoomIDIn           []pulid.ID `json:"roomIDIn,omitempty"`
+	RoomIDNotIn        []pulid.ID `json:"roomIDNotIn,omitempty"`
+	RoomIDGT           *pulid.ID  `json:"roomIDGT,omitempty"`
+	RoomIDGTE          *pulid.ID  `json:"roomIDGTE,omitempty"`
+	RoomIDLT           *pulid.ID  `json:"roomIDLT,omitempty"`
+	RoomIDLTE          *pulid.ID  `json:"roomIDLTE,omitempty"`
+	RoomIDContains     *pulid.ID  `json:"roomIDContains,omitempty"`
+	RoomIDHasPrefix    *pulid.ID  `json:"roomIDHasPrefix,omitempty"`
+	RoomIDHasSuffix    *pulid.ID  `json:"roomIDHasSuffix,omitempty"`
+	RoomIDIsNil        bool       `json:"roomIDIsNil,omitempty"`
+	RoomIDNotNil       bool       `json:"roomIDNotNil,omitempty"`
+	RoomIDEqualFold    *pulid.ID  `json:"roomIDEqualFold,omitempty"`
+	RoomIDContainsFold *pulid.ID  `json:"roomIDContainsFold,omitempty"`
+
+	// "assignee_id" field predicates.
+	AssigneeID             *pulid.ID  `json:"assigneeID,omitempty"`
+	AssigneeIDNEQ          *pulid.ID  `json:"assigneeIDNEQ,omitempty"`
+	AssigneeIDIn           []pulid.ID `json:"assigneeIDIn,omitempty"`
+	AssigneeIDNotIn        []pulid.ID `json:"assigneeIDNotIn,omitempty"`
+	AssigneeIDGT           *pulid.ID  `json:"assigneeIDGT,omitempty"`
+	AssigneeIDGTE          *pulid.ID  `json:"assigneeIDGTE,omitempty"`
+	AssigneeIDLT           *pulid.ID  `json:"assigneeIDLT,omitempty"`
+	AssigneeIDLTE          *pulid.ID  `json:"assigneeIDLTE,omitempty"`
+	AssigneeIDContains     *pulid.ID  `json:"assigneeIDContains,omitempty"`
+	AssigneeIDHasPrefix    *pulid.ID  `json:"assigneeIDHasPrefix,omitempty"`
+	AssigneeIDHasSuffix    *pulid.ID  `json:"assigneeIDHasSuffix,omitempty"`
+	AssigneeIDIsNil        bool       `json:"assigneeIDIsNil,omitempty"`
+	AssigneeIDNotNil       bool       `json:"assigneeIDNotNil,omitempty"`
+	AssigneeIDEqualFold    *pulid.ID  `json:"assigneeIDEqualFold,omitempty"`
+	AssigneeIDContainsFold *pulid.ID  `json:"assigneeIDContainsFold,omitempty"`
+
+	// "closed_at" field predicates.
+	ClosedAt       *time.Time  `json:"closedAt,omitempty"`
+	ClosedAtNEQ    *time.Time  `json:"closedAtNEQ,omitempty"`
+	ClosedAtIn     []time.Time `json:"closedAtIn,omitempty"`
+	ClosedAtNotIn  []time.Time `json:"closedAtNotIn,omitempty"`
+	ClosedAtGT     *time.Time  `json:"closedAtGT,omitempty"`
+	ClosedAtGTE    *time.Time  `json:"closedAtGTE,omitempty"`
+	ClosedAtLT     *time.Time  `json:"closedAtLT,omitempty"`
+	ClosedAtLTE    *time.Time  `json:"closedAtLTE,omitempty"`
+	ClosedAtIsNil  bool        `json:"closedAtIsNil,omitempty"`
+	ClosedAtNotNil bool        `json:"closedAtNotNil,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "user" edge predicates.
+	HasUser     *bool             `json:"hasUser,omitempty"`
+	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+
+	// "message" edge predicates.
+	HasMessage     *bool                `json:"hasMessage,omitempty"`
+	HasMessageWith []*MessageWhereInput `json:"hasMessageWith,omitempty"`
+
+	// "room" edge predicates.
+	HasRoom     *bool             `json:"hasRoom,omitempty"`
+	HasRoomWith []*RoomWhereInput `json:"hasRoomWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *ReportWhereInput) AddPredicates(predicates ...predicate.Report) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the ReportWhereInput filter on the ReportQuery builder.
+func (i *ReportWhereInput) Filter(q *ReportQuery) (*ReportQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyReportWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyReportWhereInput is returned in case the ReportWhereInput is empty.
+var ErrEmptyReportWhereInput = errors.New("ent: empty predicate ReportWhereInput")
+
+// P returns a predicate for filtering reports.
+// An error is returned if the input is empty or invalid.
+func (i *ReportWhereInput) P() (predicate.Report, error) {
+	var predicates []predicate.Report
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, report.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Report, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, report.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Report, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, report.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, report.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, report.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, report.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, report.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, report.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, report.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, report.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, report.IDLTE(*i.IDLTE))
+	}
+	if i.Type != nil {
+		predicates = append(predicates, report.TypeEQ(*i.Type))
+	}
+	if i.TypeNEQ != nil {
+		predicates = append(predicates, report.TypeNEQ(*i.TypeNEQ))
+	}
+	if len(i.TypeIn) > 0 {
+		predicates = append(predicates, report.TypeIn(i.TypeIn...))
+	}
+	if len(i.TypeNotIn) > 0 {
+		predicates = append(predicates, report.TypeNotIn(i.TypeNotIn...))
+	}
+	if i.Reason != nil {
+		predicates = append(predicates, report.ReasonEQ(*i.Reason))
+	}
+	if i.ReasonNEQ != nil {
+		predicates = append(predicates, report.ReasonNEQ(*i.ReasonNEQ))
+	}
+	if len(i.ReasonIn) > 0 {
+		predicates = append(predicates, report.ReasonIn(i.ReasonIn...))
+	}
+	if len(i.ReasonNotIn) > 0 {
+		predicates = append(predicates, report.ReasonNotIn(i.ReasonNotIn...))
+	}
+	if i.Text != nil {
+		predicates = append(predicates, report.TextEQ(*i.Text))
+	}
+	if i.TextNEQ != nil {
+		predicates = append(predicates, report.TextNEQ(*i.TextNEQ))
+	}
+	if len(i.TextIn) > 0 {
+		predicates = append(predicates, report.TextIn(i.TextIn...))
+	}
+	if len(i.TextNotIn) > 0 {
+		predicates = append(predicates, report.TextNotIn(i.TextNotIn...))
+	}
+	if i.TextGT != nil {
+		predicates = append(predicates, report.TextGT(*i.TextGT))
+	}
+	if i.TextGTE != nil {
+		predicates = append(predicates, report.TextGTE(*i.TextGTE))
+	}
+	if i.TextLT != nil {
+		predicates = append(predicates, report.TextLT(*i.TextLT))
+	}
+	if i.TextLTE != nil {
+		predicates = append(predicates, report.TextLTE(*i.TextLTE))
+	}
+	if i.TextContains != nil {
+		predicates = append(predicates, report.TextContains(*i.TextContains))
+	}
+	if i.TextHasPrefix != nil {
+		predicates = append(predicates, report.TextHasPrefix(*i.TextHasPrefix))
+	}
+	if i.TextHasSuffix != nil {
+		predicates = append(predicates, report.TextHasSuffix(*i.TextHasSuffix))
+	}
+	if i.TextIsNil {
+		predicates = append(predicates, report.TextIsNil())
+	}
+	if i.TextNotNil {
+		predicates = append(predicates, report.TextNotNil())
+	}
+	if i.TextEqualFold != nil {
+		predicates = append(predicates, report.TextEqualFold(*i.TextEqualFold))
+	}
+	if i.TextContainsFold != nil {
+		predicates = append(predicates, report.TextContainsFold(*i.TextContainsFold))
+	}
+	if i.Status != nil {
+		predicates = append(predicates, report.StatusEQ(*i.Status))
+	}
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, report.StatusNEQ(*i.StatusNEQ))
+	}
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, report.StatusIn(i.StatusIn...))
+	}
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, report.StatusNotIn(i.StatusNotIn...))
+	}
+	if i.Note != nil {
+		predicates = append(predicates, report.NoteEQ(*i.Note))
+	}
+	if i.NoteNEQ != nil {
+		predicates = append(predicates, report.NoteNEQ(*i.NoteNEQ))
+	}
+	if len(i.NoteIn) > 0 {
+		predicates = append(predicates, report.NoteIn(i.NoteIn...))
+	}
+	if len(i.NoteNotIn) > 0 {
+		predicates = append(predicates, report.NoteNotIn(i.NoteNotIn...))
+	}
+	if i.NoteGT != nil {
+		predicates = append(predicates, report.NoteGT(*i.NoteGT))
+	}
+	if i.NoteGTE != nil {
+		predicates = append(predicates, report.NoteGTE(*i.NoteGTE))
+	}
+	if i.NoteLT != nil {
+		predicates = append(predicates, report.NoteLT(*i.NoteLT))
+	}
+	if i.NoteLTE != nil {
+		predicates = append(predicates, report.NoteLTE(*i.NoteLTE))
+	}
+	if i.NoteContains != nil {
+		predicates = append(predicates, report.NoteContains(*i.NoteContains))
+	}
+	if i.NoteHasPrefix != nil {
+		predicates = append(predicates, report.NoteHasPrefix(*i.NoteHasPrefix))
+	}
+	if i.NoteHasSuffix != nil {
+		predicates = append(predicates, report.NoteHasSuffix(*i.NoteHasSuffix))
+	}
+	if i.NoteIsNil {
+		predicates = append(predicates, report.NoteIsNil())
+	}
+	if i.NoteNotNil {
+		predicates = append(predicates, report.NoteNotNil())
+	}
+	if i.NoteEqualFold != nil {
+		predicates = append(predicates, report.NoteEqualFold(*i.NoteEqualFold))
+	}
+	if i.NoteContainsFold != nil {
+		predicates = append(predicates, report.NoteContainsFold(*i.NoteContainsFold))
+	}
+	if i.ReporterID != nil {
+		predicates = append(predicates, report.ReporterIDEQ(*i.ReporterID))
+	}
+	if i.ReporterIDNEQ != nil {
+		predicates = append(predicates, report.ReporterIDNEQ(*i.ReporterIDNEQ))
+	}
+	if len(i.ReporterIDIn) > 0 {
+		predicates = append(predicates, report.ReporterIDIn(i.ReporterIDIn...))
+	}
+	if len(i.ReporterIDNotIn) > 0 {
+		predicates = append(predicates, report.ReporterIDNotIn(i.ReporterIDNotIn...))
+	}
+	if i.ReporterIDGT != nil {
+		predicates = append(predicates, report.ReporterIDGT(*i.ReporterIDGT))
+	}
+	if i.ReporterIDGTE != nil {
+		predicates = append(predicates, report.ReporterIDGTE(*i.ReporterIDGTE))
+	}
+	if i.ReporterIDLT != nil {
+		predicates = append(predicates, report.ReporterIDLT(*i.ReporterIDLT))
+	}
+	if i.ReporterIDLTE != nil {
+		predicates = append(predicates, report.ReporterIDLTE(*i.ReporterIDLTE))
+	}
+	if i.ReporterIDContains != nil {
+		predicates = append(predicates, report.ReporterIDContains(*i.ReporterIDContains))
+	}
+	if i.ReporterIDHasPrefix != nil {
+		predicates = append(predicates, report.ReporterIDHasPrefix(*i.ReporterIDHasPrefix))
+	}
+	if i.ReporterIDHasSuffix != nil {
+		predicates = append(predicates, report.ReporterIDHasSuffix(*i.ReporterIDHasSuffix))
+	}
+	if i.ReporterIDEqualFold != nil {
+		predicates = append(predicates, report.ReporterIDEqualFold(*i.ReporterIDEqualFold))
+	}
+	if i.ReporterIDContainsFold != nil {
+		predicates = append(predicates, report.ReporterIDContainsFold(*i.ReporterIDContainsFold))
+	}
+	if i.UserID != nil {
+		predicates = append(predicates, report.UserIDEQ(*i.UserID))
+	}
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, report.UserIDNEQ(*i.UserIDNEQ))
+	}
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, report.UserIDIn(i.UserIDIn...))
+	}
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, report.UserIDNotIn(i.UserIDNotIn...))
+	}
+	if i.UserIDGT != nil {
+		predicates = append(predicates, report.UserIDGT(*i.UserIDGT))
+	}
+	if i.UserIDGTE != nil {
+		predicates = append(predicates, report.UserIDGTE(*i.UserIDGTE))
+	}
+	if i.UserIDLT != nil {
+		predicates = append(predicates, report.UserIDLT(*i.UserIDLT))
+	}
+	if i.UserIDLTE != nil {
+		predicates = append(predicates, report.UserIDLTE(*i.UserIDLTE))
+	}
+	if i.UserIDContains != nil {
+		predicates = append(predicates, report.UserIDContains(*i.UserIDContains))
+	}
+	if i.UserIDHasPrefix != nil {
+		predicates = append(predicates, report.UserIDHasPrefix(*i.UserIDHasPrefix))
+	}
+	if i.UserIDHasSuffix != nil {
+		predicates = append(predicates, report.UserIDHasSuffix(*i.UserIDHasSuffix))
+	}
+	if i.UserIDIsNil {
+		predicates = append(predicates, report.UserIDIsNil())
+	}
+	if i.UserIDNotNil {
+		predicates = append(predicates, report.UserIDNotNil())
+	}
+	if i.UserIDEqualFold != nil {
+		predicates = append(predicates, report.UserIDEqualFold(*i.UserIDEqualFold))
+	}
+	if i.UserIDContainsFold != nil {
+		predicates = append(predicates, report.UserIDContainsFold(*i.UserIDContainsFold))
+	}
+	if i.MessageID != nil {
+		predicates = append(predicates, report.MessageIDEQ(*i.MessageID))
+	}
+	if i.MessageIDNEQ != nil {
+		predicates = append(predicates, report.MessageIDNEQ(*i.MessageIDNEQ))
+	}
+	if len(i.MessageIDIn) > 0 {
+		predicates = append(predicates, report.MessageIDIn(i.MessageIDIn...))
+	}
+	if len(i.MessageIDNotIn) > 0 {
+		predicates = append(predicates, report.MessageIDNotIn(i.MessageIDNotIn...))
+	}
+	if i.MessageIDGT != nil {
+		predicates = append(predicates, report.MessageIDGT(*i.MessageIDGT))
+	}
+	if i.MessageIDGTE != nil {
+		predicates = append(predicates, report.MessageIDGTE(*i.MessageIDGTE))
+	}
+	if i.MessageIDLT != nil {
+		predicates = append(predicates, report.MessageIDLT(*i.MessageIDLT))
+	}
+	if i.MessageIDLTE != nil {
+		predicates = append(predicates, report.MessageIDLTE(*i.MessageIDLTE))
+	}
+	if i.MessageIDContains != nil {
+		predicates = append(predicates, report.MessageIDContains(*i.MessageIDContains))
+	}
+	if i.MessageIDHasPrefix != nil {
+		predicates = append(predicates, report.MessageIDHasPrefix(*i.MessageIDHasPrefix))
+	}
+	if i.MessageIDHasSuffix != nil {
+		predicates = append(predicates, report.MessageIDHasSuffix(*i.MessageIDHasSuffix))
+	}
+	if i.MessageIDIsNil {
+		predicates = append(predicates, report.MessageIDIsNil())
+	}
+	if i.MessageIDNotNil {
+		predicates = append(predicates, report.MessageIDNotNil())
+	}
+	if i.MessageIDEqualFold != nil {
+		predicates = append(predicates, report.MessageIDEqualFold(*i.MessageIDEqualFold))
+	}
+	if i.MessageIDContainsFold != nil {
+		predicates = append(predicates, report.MessageIDContainsFold(*i.MessageIDContainsFold))
+	}
+	if i.RoomID != nil {
+		predicates = append(predicates, report.RoomIDEQ(*i.RoomID))
+	}
+	if i.RoomIDNEQ != nil {
+		predicates = append(predicates, report.RoomIDNEQ(*i.RoomIDNEQ))
+	}
+	if len(i.RoomIDIn) > 0 {
+		predicates = append(predicates, report.RoomIDIn(i.RoomIDIn...))
+	}
+	if len(i.RoomIDNotIn) > 0 {
+		predicates = append(predicates, report.RoomIDNotIn(i.RoomIDNotIn...))
+	}
+	if i.RoomIDGT != nil {
+		predicates = append(predicates, report.RoomIDGT(*i.RoomIDGT))
+	}
+	if i.RoomIDGTE != nil {
+		predicates = append(predicates, report.RoomIDGTE(*i.RoomIDGTE))
+	}
+	if i.RoomIDLT != nil {
+		predicates = append(predicates, report.RoomIDLT(*i.RoomIDLT))
+	}
+	if i.RoomIDLTE != nil {
+		predicates = append(predicates, report.RoomIDLTE(*i.RoomIDLTE))
+	}
+	if i.RoomIDContains != nil {
+		predicates = append(predicates, report.RoomIDContains(*i.RoomIDContains))
+	}
+	if i.RoomIDHasPrefix != nil {
+		predicates = append(predicates, report.RoomIDHasPrefix(*i.RoomIDHasPrefix))
+	}
+	if i.RoomIDHasSuffix != nil {
+		predicates = append(predicates, report.RoomIDHasSuffix(*i.RoomIDHasSuffix))
+	}
+	if i.RoomIDIsNil {
+		predicates = append(predicates, report.RoomIDIsNil())
+	}
+	if i.RoomIDNotNil {
+		predicates = append(predicates, report.RoomIDNotNil())
+	}
+	if i.RoomIDEqualFold != nil {
+		predicates = append(predicates, report.RoomIDEqualFold(*i.RoomIDEqualFold))
+	}
+	if i.RoomIDContainsFold != nil {
+		predicates = append(predicates, report.RoomIDContainsFold(*i.RoomIDContainsFold))
+	}
+	if i.AssigneeID != nil {
+		predicates = append(predicates, report.AssigneeIDEQ(*i.AssigneeID))
+	}
+	if i.AssigneeIDNEQ != nil {
+		predicates = append(predicates, report.AssigneeIDNEQ(*i.AssigneeIDNEQ))
+	}
+	if len(i.AssigneeIDIn) > 0 {
+		predicates = append(predicates, report.AssigneeIDIn(i.AssigneeIDIn...))
+	}
+	if len(i.AssigneeIDNotIn) > 0 {
+		predicates = append(predicates, report.AssigneeIDNotIn(i.AssigneeIDNotIn...))
+	}
+	if i.AssigneeIDGT != nil {
+		predicates = append(predicates, report.AssigneeIDGT(*i.AssigneeIDGT))
+	}
+	if i.AssigneeIDGTE != nil {
+		predicates = append(predicates, report.AssigneeIDGTE(*i.AssigneeIDGTE))
+	}
+	if i.AssigneeIDLT != nil {
+		predicates = append(predicates, report.AssigneeIDLT(*i.AssigneeIDLT))
+	}
+	if i.AssigneeIDLTE != nil {
+		predicates = append(predicates, report.AssigneeIDLTE(*i.AssigneeIDLTE))
+	}
+	if i.AssigneeIDContains != nil {
+		predicates = append(predicates, report.AssigneeIDContains(*i.AssigneeIDContains))
+	}
+	if i.AssigneeIDHasPrefix != nil {
+		predicates = append(predicates, report.AssigneeIDHasPrefix(*i.AssigneeIDHasPrefix))
+	}
+	if i.AssigneeIDHasSuffix != nil {
+		predicates = append(predicates, report.AssigneeIDHasSuffix(*i.AssigneeIDHasSuffix))
+	}
+	if i.AssigneeIDIsNil {
+		predicates = append(predicates, report.AssigneeIDIsNil())
+	}
+	if i.AssigneeIDNotNil {
+		predicates = append(predicates, report.AssigneeIDNotNil())
+	}
+	if i.AssigneeIDEqualFold != nil {
+		predicates = append(predicates, report.AssigneeIDEqualFold(*i.AssigneeIDEqualFold))
+	}
+	if i.AssigneeIDContainsFold != nil {
+		predicates = append(predicates, report.AssigneeIDContainsFold(*i.AssigneeIDContainsFold))
+	}
+	if i.ClosedAt != nil {
+		predicates = append(predicates, report.ClosedAtEQ(*i.ClosedAt))
+	}
+	if i.ClosedAtNEQ != nil {
+		predicates = append(predicates, report.ClosedAtNEQ(*i.ClosedAtNEQ))
+	}
+	if len(i.ClosedAtIn) > 0 {
+		predicates = append(predicates, report.ClosedAtIn(i.ClosedAtIn...))
+	}
+	if len(i.ClosedAtNotIn) > 0 {
+		predicates = append(predicates, report.ClosedAtNotIn(i.ClosedAtNotIn...))
+	}
+	if i.ClosedAtGT != nil {
+		predicates = append(predicates, report.ClosedAtGT(*i.ClosedAtGT))
+	}
+	if i.ClosedAtGTE != nil {
+		predicates = append(predicates, report.ClosedAtGTE(*i.ClosedAtGTE))
+	}
+	if i.ClosedAtLT != nil {
+		predicates = append(predicates, report.ClosedAtLT(*i.ClosedAtLT))
+	}
+	if i.ClosedAtLTE != nil {
+		predicates = append(predicates, report.ClosedAtLTE(*i.ClosedAtLTE))
+	}
+	if i.ClosedAtIsNil {
+		predicates = append(predicates, report.ClosedAtIsNil())
+	}
+	if i.ClosedAtNotNil {
+		predicates = append(predicates, report.ClosedAtNotNil())
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, report.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, report.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, report.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, report.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, report.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, report.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, report.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, report.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, report.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, report.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, report.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, report.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, report.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, report.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, report.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, report.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+
+	if i.HasUser != nil {
+		p := report.HasUser()
+		if !*i.HasUser {
+			p = report.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasUserWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUserWith))
+		for _, w := range i.HasUserWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, report.HasUserWith(with...))
+	}
+	if i.HasMessage != nil {
+		p := report.HasMessage()
+		if !*i.HasMessage {
+			p = report.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasMessageWith) > 0 {
+		with := make([]predicate.Message, 0, len(i.HasMessageWith))
+		for _, w := range i.HasMessageWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasMessageWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, report.HasMessageWith(with...))
+	}
+	if i.HasRoom != nil {
+		p := report.HasRoom()
+		if !*i.HasRoom {
+			p = report.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasRoomWith) > 0 {
+		with := make([]predicate.Room, 0, len(i.HasRoomWith))
+		for _, w := range i.HasRoomWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasRoomWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, report.HasRoomWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyReportWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return report.And(predicates...), nil
+	}
+}
+
+// RoomWhereInput represents a where input for filtering Room queries.
+type RoomWhereInput struct {
+	Predicates []predicate.Room  `json:"-"`
+	Not        *RoomWhereInput   `json:"not,omitempty"`
+	Or         []*RoomWhereInput `json:"or,omitempty"`
+	And        []*RoomWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *pulid.ID  `json:"id,omitempty"`
+	IDNEQ   *pulid.ID  `json:"idNEQ,omitempty"`
+	IDIn    []pulid.ID `json:"idIn,omitempty"`
+	IDNotIn []pulid.ID `json:"idNotIn,omitempty"`
+	IDGT    *pulid.ID  `json:"idGT,omitempty"`
+	IDGTE   *pulid.ID  `json:"idGTE,omitempty"`
+	IDLT    *pulid.ID  `json:"idLT,omitempty"`
+	IDLTE   *pulid.ID  `json:"idLTE,omitempty"`
+
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameIsNil        bool     `json:"nameIsNil,omitempty"`
+	NameNotNil       bool     `json:"nameNotNil,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "description" field predicates.
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGT           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGTE          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLT           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLTE          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionIsNil        bool     `json:"descriptionIsNil,omitempty"`
+	DescriptionNotNil       bool     `json:"descriptionNotNil,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+
+	// "version" field predicates.
+	Version      *uint64  `json:"version,omitempty"`
+	VersionNEQ   *uint64  `json:"versionNEQ,omitempty"`
+	VersionIn    []uint64 `json:"versionIn,omitempty"`
+	VersionNotIn []uint64 `json:"versionNotIn,omitempty"`
+	VersionGT    *uint64  `json:"versionGT,omitempty"`
+	VersionGTE   *uint64  `json:"versionGTE,omitempty"`
+	VersionLT    *uint64  `json:"versionLT,omitempty"`
+	VersionLTE   *uint64  `json:"versionLTE,omitempty"`
+
+	// "avatar_id" field predicates.
+	AvatarID             *pulid.ID  `json:"avatarID,omitempty"`
+	AvatarIDNEQ          *pulid.ID  `json:"avatarIDNEQ,omitempty"`
+	AvatarIDIn           []pulid.ID `json:"avatarIDIn,omitempty"`
+	AvatarIDNotIn        []pulid.ID `json:"avatarIDNotIn,omitempty"`
+	AvatarIDGT           *pulid.ID  `json:"avatarIDGT,omitempty"`
+	AvatarIDGTE          *pulid.ID  `json:"avatarIDGTE,omitempty"`
+	AvatarIDLT           *pulid.ID  `json:"avatarIDLT,omitempty"`
+	AvatarIDLTE          *pulid.ID  `json:"avatarIDLTE,omitempty"`
+	AvatarIDContains     *pulid.ID  `json:"avatarIDContains,omitempty"`
+	AvatarIDHasPrefix    *pulid.ID  `json:"avatarIDHasPrefix,omitempty"`
+	AvatarIDHasSuffix    *pulid.ID  `json:"avatarIDHasSuffix,omitempty"`
+	AvatarIDIsNil        bool       `json:"avatarIDIsNil,omitempty"`
+	AvatarIDNotNil       bool       `json:"avatarIDNotNil,omitempty"`
+	AvatarIDEqualFold    *pulid.ID  `json:"avatarIDEqualFold,omitempty"`
+	AvatarIDContainsFold *pulid.ID  `json:"avatarIDContainsFold,omitempty"`
+
+	// "type" field predicates.
+	Type      *room.Type  `json:"type,omitempty"`
+	TypeNEQ   *room.Type  `json:"typeNEQ,omitempty"`
+	TypeIn    []room.Type `json:"typeIn,omitempty"`
+	TypeNotIn []room.Type `json:"typeNotIn,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "user_contacts" edge predicates.
+	HasUserContacts     *bool                    `json:"hasUserContacts,omitempty"`
+	HasUserContactsWith []*UserContactWhereInput `json:"hasUserContactsWith,omitempty"`
+
+	// "users" edge predicates.
+	HasUsers     *bool             `json:"hasUsers,omitempty"`
+	HasUsersWith []*UserWhereInput `json:"hasUsersWith,omitempty"`
+
+	// "last_message" edge predicates.
+	HasLastMessage     *bool                `json:"hasLastMessage,omitempty"`
+	HasLastMessageWith []*MessageWhereInput `json:"hasLastMessageWith,omitempty"`
+
+	// "messages" edge predicates.
+	HasMessages     *bool                `json:"hasMessages,omitempty"`
+	HasMessagesWith []*MessageWhereInput `json:"hasMessagesWith,omitempty"`
+
+	// "message_voices" edge predicates.
+	HasMessageVoices     *bool                     `json:"hasMessageVoices,omitempty"`
+	HasMessageVoicesWith []*MessageVoiceWhereInput `json:"hasMessageVoicesWith,omitempty"`
+
+	// "message_attachments" edge predicates.
+	HasMessageAttachments     *bool                          `json:"hasMessageAttachments,omitempty"`
+	HasMessageAttachmentsWith []*MessageAttachmentWhereInput `json:"hasMessageAttachmentsWith,omitempty"`
+
+	// "message_links" edge predicates.
+	HasMessageLinks     *bool                    `json:"hasMessageLinks,omitempty"`
+	HasMessageLinksWith []*MessageLinkWhereInput `json:"hasMessageLinksWith,omitempty"`
+
+	// "avatar" edge predicates.
+	HasAvatar     *bool             `json:"hasAvatar,omitempty"`
+	HasAvatarWith []*FileWhereInput `json:"hasAvatarWith,omitempty"`
+
+	// "avatars" edge predicates.
+	HasAvatars     *bool               `json:"hasAvatars,omitempty"`
+	HasAvatarsWith []*AvatarWhereInput `json:"hasAvatarsWith,omitempty"`
+
+	// "room_members" edge predicates.
+	HasRoomMembers     *bool                   `json:"hasRoomMembers,omitempty"`
+	HasRoomMembersWith []*RoomMemberWhereInput `json:"hasRoomMembersWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *RoomWhereInput) AddPredicates(predicates ...predicate.Room) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the RoomWhereInput filter on the RoomQuery builder.
+func (i *RoomWhereInput) Filter(q *RoomQuery) (*RoomQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyRoomWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyRoomWhereInput is returned in case the RoomWhereInput is empty.
+var ErrEmptyRoomWhereInput = errors.New("ent: empty predicate RoomWhereInput")
+
+// P returns a predicate for filtering rooms.
+// An error is returned if the input is empty or invalid.
+func (i *RoomWhereInput) P() (predicate.Room, error) {
+	var predicates []predicate.Room
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, room.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Room, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, room.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Room, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, room.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, room.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, room.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, room.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, room.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, room.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, room.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, room.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, room.IDLTE(*i.IDLTE))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, room.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, room.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, room.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, room.NameNotIn(i.NameNotIn...))
+	}
+	if i.NameGT != nil {
+		predicates = append(predicates, room.NameGT(*i.NameGT))
+	}
+	if i.NameGTE != nil {
+		predicates = append(predicates, room.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, room.NameLT(*i.NameLT))
+	}
+	if i.NameLTE != nil {
+		predicates = append(predicates, room.NameLTE(*i.NameLTE))
+	}
+	if i.NameContains != nil {
+		predicates = append(predicates, room.NameContains(*i.NameContains))
+	}
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, room.NameHasPrefix(*i.NameHasPrefix))
+	}
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, room.NameHasSuffix(*i.NameHasSuffix))
+	}
+	if i.NameIsNil {
+		predicates = append(predicates, room.NameIsNil())
+	}
+	if i.NameNotNil {
+		predicates = append(predicates, room.NameNotNil())
+	}
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, room.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, room.NameContainsFold(*i.NameContainsFold))
+	}
+	if i.Description != nil {
+		predicates = append(predicates, room.DescriptionEQ(*i.Description))
 	}
-	if i.BodyContainsFold != nil {
-		predicates = append(predicates, notification.BodyContainsFold(*i.BodyContainsFold))
+	if i.DescriptionNEQ != nil {
+		predicates = append(predicates, room.DescriptionNEQ(*i.DescriptionNEQ))
+	}
+	if len(i.DescriptionIn) > 0 {
+		predicates = append(predicates, room.DescriptionIn(i.DescriptionIn...))
+	}
+	if len(i.DescriptionNotIn) > 0 {
+		predicates = append(predicates, room.DescriptionNotIn(i.DescriptionNotIn...))
+	}
+	if i.DescriptionGT != nil {
+		predicates = append(predicates, room.DescriptionGT(*i.DescriptionGT))
+	}
+	if i.DescriptionGTE != nil {
+		predicates = append(predicates, room.DescriptionGTE(*i.DescriptionGTE))
+	}
+	if i.DescriptionLT != nil {
+		predicates = append(predicates, room.DescriptionLT(*i.DescriptionLT))
+	}
+	if i.DescriptionLTE != nil {
+		predicates = append(predicates, room.DescriptionLTE(*i.DescriptionLTE))
+	}
+	if i.DescriptionContains != nil {
+		predicates = append(predicates, room.DescriptionContains(*i.DescriptionContains))
+	}
+	if i.DescriptionHasPrefix != nil {
+		predicates = append(predicates, room.DescriptionHasPrefix(*i.DescriptionHasPrefix))
+	}
+	if i.DescriptionHasSuffix != nil {
+		predicates = append(predicates, room.DescriptionHasSuffix(*i.DescriptionHasSuffix))
+	}
+	if i.DescriptionIsNil {
+		predicates = append(predicates, room.DescriptionIsNil())
+	}
+	if i.DescriptionNotNil {
+		predicates = append(predicates, room.DescriptionNotNil())
+	}
+	if i.DescriptionEqualFold != nil {
+		predicates = append(predicates, room.DescriptionEqualFold(*i.DescriptionEqualFold))
+	}
+	if i.DescriptionContainsFold != nil {
+		predicates = append(predicates, room.DescriptionContainsFold(*i.DescriptionContainsFold))
+	}
+	if i.Version != nil {
+		predicates = append(predicates, room.VersionEQ(*i.Version))
+	}
+	if i.VersionNEQ != nil {
+		predicates = append(predicates, room.VersionNEQ(*i.VersionNEQ))
+	}
+	if len(i.VersionIn) > 0 {
+		predicates = append(predicates, room.VersionIn(i.VersionIn...))
+	}
+	if len(i.VersionNotIn) > 0 {
+		predicates = append(predicates, room.VersionNotIn(i.VersionNotIn...))
+	}
+	if i.VersionGT != nil {
+		predicates = append(predicates, room.VersionGT(*i.VersionGT))
+	}
+	if i.VersionGTE != nil {
+		predicates = append(predicates, room.VersionGTE(*i.VersionGTE))
+	}
+	if i.VersionLT != nil {
+		predicates = append(predicates, room.VersionLT(*i.VersionLT))
+	}
+	if i.VersionLTE != nil {
+		predicates = append(predicates, room.VersionLTE(*i.VersionLTE))
+	}
+	if i.AvatarID != nil {
+		predicates = append(predicates, room.AvatarIDEQ(*i.AvatarID))
+	}
+	if i.AvatarIDNEQ != nil {
+		predicates = append(predicates, room.AvatarIDNEQ(*i.AvatarIDNEQ))
+	}
+	if len(i.AvatarIDIn) > 0 {
+		predicates = append(predicates, room.AvatarIDIn(i.AvatarIDIn...))
+	}
+	if len(i.AvatarIDNotIn) > 0 {
+		predicates = append(predicates, room.AvatarIDNotIn(i.AvatarIDNotIn...))
+	}
+	if i.AvatarIDGT != nil {
+		predicates = append(predicates, room.AvatarIDGT(*i.AvatarIDGT))
+	}
+	if i.AvatarIDGTE != nil {
+		predicates = append(predicates, room.AvatarIDGTE(*i.AvatarIDGTE))
+	}
+	if i.AvatarIDLT != nil {
+		predicates = append(predicates, room.AvatarIDLT(*i.AvatarIDLT))
+	}
+	if i.AvatarIDLTE != nil {
+		predicates = append(predicates, room.AvatarIDLTE(*i.AvatarIDLTE))
+	}
+	if i.AvatarIDContains != nil {
+		predicates = append(predicates, room.AvatarIDContains(*i.AvatarIDContains))
+	}
+	if i.AvatarIDHasPrefix != nil {
+		predicates = append(predicates, room.AvatarIDHasPrefix(*i.AvatarIDHasPrefix))
+	}
+	if i.AvatarIDHasSuffix != nil {
+		predicates = append(predicates, room.AvatarIDHasSuffix(*i.AvatarIDHasSuffix))
+	}
+	if i.AvatarIDIsNil {
+		predicates = append(predicates, room.AvatarIDIsNil())
+	}
+	if i.AvatarIDNotNil {
+		predicates = append(predicates, room.AvatarIDNotNil())
+	}
+	if i.AvatarIDEqualFold != nil {
+		predicates = append(predicates, room.AvatarIDEqualFold(*i.AvatarIDEqualFold))
+	}
+	if i.AvatarIDContainsFold != nil {
+		predicates = append(predicates, room.AvatarIDContainsFold(*i.AvatarIDContainsFold))
+	}
+	if i.Type != nil {
+		predicates = append(predicates, room.TypeEQ(*i.Type))
+	}
+	if i.TypeNEQ != nil {
+		predicates = append(predicates, room.TypeNEQ(*i.TypeNEQ))
+	}
+	if len(i.TypeIn) > 0 {
+		predicates = append(predicates, room.TypeIn(i.TypeIn...))
+	}
+	if len(i.TypeNotIn) > 0 {
+		predicates = append(predicates, room.TypeNotIn(i.TypeNotIn...))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, notification.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, room.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, notification.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, room.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, notification.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, room.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, notification.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, room.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, notification.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, room.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, notification.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, room.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, notification.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, room.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, notification.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, room.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, notification.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, room.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, notification.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, room.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, notification.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, room.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, notification.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, room.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, notification.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, room.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, room.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, room.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, room.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+
+	if i.HasUserContacts != nil {
+		p := room.HasUserContacts()
+		if !*i.HasUserContacts {
+			p = room.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasUserContactsWith) > 0 {
+		with := make([]predicate.UserContact, 0, len(i.HasUserContactsWith))
+		for _, w := range i.HasUserContactsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasUserContactsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, room.HasUserContactsWith(with...))
+	}
+	if i.HasUsers != nil {
+		p := room.HasUsers()
+		if !*i.HasUsers {
+			p = room.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasUsersWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUsersWith))
+		for _, w := range i.HasUsersWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasUsersWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, room.HasUsersWith(with...))
+	}
+	if i.HasLastMessage != nil {
+		p := room.HasLastMessage()
+		if !*i.HasLastMessage {
+			p = room.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasLastMessageWith) > 0 {
+		with := make([]predicate.Message, 0, len(i.HasLastMessageWith))
+		for _, w := range i.HasLastMessageWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasLastMessageWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, room.HasLastMessageWith(with...))
+	}
+	if i.HasMessages != nil {
+		p := room.HasMessages()
+		if !*i.HasMessages {
+			p = room.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasMessagesWith) > 0 {
+		with := make([]predicate.Message, 0, len(i.HasMessagesWith))
+		for _, w := range i.HasMessagesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasMessagesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, room.HasMessagesWith(with...))
+	}
+	if i.HasMessageVoices != nil {
+		p := room.HasMessageVoices()
+		if !*i.HasMessageVoices {
+			p = room.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasMessageVoicesWith) > 0 {
+		with := make([]predicate.MessageVoice, 0, len(i.HasMessageVoicesWith))
+		for _, w := range i.HasMessageVoicesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasMessageVoicesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, room.HasMessageVoicesWith(with...))
+	}
+	if i.HasMessageAttachments != nil {
+		p := room.HasMessageAttachments()
+		if !*i.HasMessageAttachments {
+			p = room.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasMessageAttachmentsWith) > 0 {
+		with := make([]predicate.MessageAttachment, 0, len(i.HasMessageAttachmentsWith))
+		for _, w := range i.HasMessageAttachmentsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasMessageAttachmentsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, room.HasMessageAttachmentsWith(with...))
+	}
+	if i.HasMessageLinks != nil {
+		p := room.HasMessageLinks()
+		if !*i.HasMessageLinks {
+			p = room.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasMessageLinksWith) > 0 {
+		with := make([]predicate.MessageLink, 0, len(i.HasMessageLinksWith))
+		for _, w := range i.HasMessageLinksWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasMessageLinksWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, room.HasMessageLinksWith(with...))
 	}
-	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, notification.UpdatedAtGTE(*i.UpdatedAtGTE))
+	if i.HasAvatar != nil {
+		p := room.HasAvatar()
+		if !*i.HasAvatar {
+			p = room.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, notification.UpdatedAtLT(*i.UpdatedAtLT))
+	if len(i.HasAvatarWith) > 0 {
+		with := make([]predicate.File, 0, len(i.HasAvatarWith))
+		for _, w := range i.HasAvatarWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAvatarWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, room.HasAvatarWith(with...))
 	}
-	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, notification.UpdatedAtLTE(*i.UpdatedAtLTE))
+	if i.HasAvatars != nil {
+		p := room.HasAvatars()
+		if !*i.HasAvatars {
+			p = room.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-
-	if i.HasUser != nil {
-		p := notification.HasUser()
-		if !*i.HasUser {
-			p = notification.Not(p)
+	if len(i.HasAvatarsWith) > 0 {
+		with := make([]predicate.Avatar, 0, len(i.HasAvatarsWith))
+		for _, w := range i.HasAvatarsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAvatarsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, room.HasAvatarsWith(with...))
+	}
+	if i.HasRoomMembers != nil {
+		p := room.HasRoomMembers()
+		if !*i.HasRoomMembers {
+			p = room.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasUserWith) > 0 {
-		with := make([]predicate.User, 0, len(i.HasUserWith))
-		for _, w := range i.HasUserWith {
+	if len(i.HasRoomMembersWith) > 0 {
+		with := make([]predicate.RoomMember, 0, len(i.HasRoomMembersWith))
+		for _, w := range i.HasRoomMembersWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasRoomMembersWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, notification.HasUserWith(with...))
+		predicates = append(predicates, room.HasRoomMembersWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyNotificationWhereInput
+		return nil, ErrEmptyRoomWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return notification.And(predicates...), nil
+		return room.And(predicates...), nil
 	}
 }
 
-// RoomWhereInput represents a where input for filtering Room queries.
-type RoomWhereInput struct {
-	Predicates []predicate.Room  `json:"-"`
-	Not        *RoomWhereInput   `json:"not,omitempty"`
-	Or         []*RoomWhereInput `json:"or,omitempty"`
-	And        []*RoomWhereInput `json:"and,omitempty"`
+// RoomInviteWhereInput represents a where input for filtering RoomInvite queries.
+type RoomInviteWhereInput struct {
+	Predicates []predicate.RoomInvite  `json:"-"`
+	Not        *RoomInviteWhereInput   `json:"not,omitempty"`
+	Or         []*RoomInviteWhereInput `json:"or,omitempty"`
+	And        []*RoomInviteWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *pulid.ID  `json:"id,omitempty"`
@@ -2648,55 +6593,96 @@ type RoomWhereInput struct {
 	IDLT    *pulid.ID  `json:"idLT,omitempty"`
 	IDLTE   *pulid.ID  `json:"idLTE,omitempty"`
 
-	// "name" field predicates.
-	Name             *string  `json:"name,omitempty"`
-	NameNEQ          *string  `json:"nameNEQ,omitempty"`
-	NameIn           []string `json:"nameIn,omitempty"`
-	NameNotIn        []string `json:"nameNotIn,omitempty"`
-	NameGT           *string  `json:"nameGT,omitempty"`
-	NameGTE          *string  `json:"nameGTE,omitempty"`
-	NameLT           *string  `json:"nameLT,omitempty"`
-	NameLTE          *string  `json:"nameLTE,omitempty"`
-	NameContains     *string  `json:"nameContains,omitempty"`
-	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
-	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
-	NameIsNil        bool     `json:"nameIsNil,omitempty"`
-	NameNotNil       bool     `json:"nameNotNil,omitempty"`
-	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
-	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
-
-	// "description" field predicates.
-	Description             *string  `json:"description,omitempty"`
-	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
-	DescriptionIn           []string `json:"descriptionIn,omitempty"`
-	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
-	DescriptionGT           *string  `json:"descriptionGT,omitempty"`
-	DescriptionGTE          *string  `json:"descriptionGTE,omitempty"`
-	DescriptionLT           *string  `json:"descriptionLT,omitempty"`
-	DescriptionLTE          *string  `json:"descriptionLTE,omitempty"`
-	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
-	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
-	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
-	DescriptionIsNil        bool     `json:"descriptionIsNil,omitempty"`
-	DescriptionNotNil       bool     `json:"descriptionNotNil,omitempty"`
-	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
-	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+	// "token" field predicates.
+	Token             *string  `json:"token,omitempty"`
+	TokenNEQ          *string  `json:"tokenNEQ,omitempty"`
+	TokenIn           []string `json:"tokenIn,omitempty"`
+	TokenNotIn        []string `json:"tokenNotIn,omitempty"`
+	TokenGT           *string  `json:"tokenGT,omitempty"`
+	TokenGTE          *string  `json:"tokenGTE,omitempty"`
+	TokenLT           *string  `json:"tokenLT,omitempty"`
+	TokenLTE          *string  `json:"tokenLTE,omitempty"`
+	TokenContains     *string  `json:"tokenContains,omitempty"`
+	TokenHasPrefix    *string  `json:"tokenHasPrefix,omitempty"`
+	TokenHasSuffix    *string  `json:"tokenHasSuffix,omitempty"`
+	TokenEqualFold    *string  `json:"tokenEqualFold,omitempty"`
+	TokenContainsFold *string  `json:"tokenContainsFold,omitempty"`
 
-	// "version" field predicates.
-	Version      *uint64  `json:"version,omitempty"`
-	VersionNEQ   *uint64  `json:"versionNEQ,omitempty"`
-	VersionIn    []uint64 `json:"versionIn,omitempty"`
-	VersionNotIn []uint64 `json:"versionNotIn,omitempty"`
-	VersionGT    *uint64  `json:"versionGT,omitempty"`
-	VersionGTE   *uint64  `json:"versionGTE,omitempty"`
-	VersionLT    *uint64  `json:"versionLT,omitempty"`
-	VersionLTE   *uint64  `json:"versionLTE,omitempty"`
+	// "room_id" field predicates.
+	RoomID             *pulid.ID  `json:"roomID,omitempty"`
+	RoomIDNEQ          *pulid.ID  `json:"roomIDNEQ,omitempty"`
+	RoomIDIn           []pulid.ID `json:"roomIDIn,omitempty"`
+	RoomIDNotIn        []pulid.ID `json:"roomIDNotIn,omitempty"`
+	RoomIDGT           *pulid.ID  `json:"roomIDGT,omitempty"`
+	RoomIDGTE          *pulid.ID  `json:"roomIDGTE,omitempty"`
+	RoomIDLT           *pulid.ID  `json:"roomIDLT,omitempty"`
+	RoomIDLTE          *pulid.ID  `json:"roomIDLTE,omitempty"`
+	RoomIDContains     *pulid.ID  `json:"roomIDContains,omitempty"`
+	RoomIDHasPrefix    *pulid.ID  `json:"roomIDHasPrefix,omitempty"`
+	RoomIDHasSuffix    *pulid.ID  `json:"roomIDHasSuffix,omitempty"`
+	RoomIDEqualFold    *pulid.ID  `json:"roomIDEqualFold,omitempty"`
+	RoomIDContainsFold *pulid.ID  `json:"roomIDContainsFold,omitempty"`
 
-	// "type" field predicates.
-	Type      *room.Type  `json:"type,omitempty"`
-	TypeNEQ   *room.Type  `json:"typeNEQ,omitempty"`
-	TypeIn    []room.Type `json:"typeIn,omitempty"`
-	TypeNotIn []room.Type `json:"typeNotIn,omitempty"`
+	// "creator_id" field predicates.
+	CreatorID             *pulid.ID  `json:"creatorID,omitempty"`
+	CreatorIDNEQ          *pulid.ID  `json:"creatorIDNEQ,omitempty"`
+	CreatorIDIn           []pulid.ID `json:"creatorIDIn,omitempty"`
+	CreatorIDNotIn        []pulid.ID `json:"creatorIDNotIn,omitempty"`
+	CreatorIDGT           *pulid.ID  `json:"creatorIDGT,omitempty"`
+	CreatorIDGTE          *pulid.ID  `json:"creatorIDGTE,omitempty"`
+	CreatorIDLT           *pulid.ID  `json:"creatorIDLT,omitempty"`
+	CreatorIDLTE          *pulid.ID  `json:"creatorIDLTE,omitempty"`
+	CreatorIDContains     *pulid.ID  `json:"creatorIDContains,omitempty"`
+	CreatorIDHasPrefix    *pulid.ID  `json:"creatorIDHasPrefix,omitempty"`
+	CreatorIDHasSuffix    *pulid.ID  `json:"creatorIDHasSuffix,omitempty"`
+	CreatorIDEqualFold    *pulid.ID  `json:"creatorIDEqualFold,omitempty"`
+	CreatorIDContainsFold *pulid.ID  `json:"creatorIDContainsFold,omitempty"`
+
+	// "expires_at" field predicates.
+	ExpiresAt       *time.Time  `json:"expiresAt,omitempty"`
+	ExpiresAtNEQ    *time.Time  `json:"expiresAtNEQ,omitempty"`
+	ExpiresAtIn     []time.Time `json:"expiresAtIn,omitempty"`
+	ExpiresAtNotIn  []time.Time `json:"expiresAtNotIn,omitempty"`
+	ExpiresAtGT     *time.Time  `json:"expiresAtGT,omitempty"`
+	ExpiresAtGTE    *time.Time  `json:"expiresAtGTE,omitempty"`
+	ExpiresAtLT     *time.Time  `json:"expiresAtLT,omitempty"`
+	ExpiresAtLTE    *time.Time  `json:"expiresAtLTE,omitempty"`
+	ExpiresAtIsNil  bool        `json:"expiresAtIsNil,omitempty"`
+	ExpiresAtNotNil bool        `json:"expiresAtNotNil,omitempty"`
+
+	// "max_uses" field predicates.
+	MaxUses       *int  `json:"maxUses,omitempty"`
+	MaxUsesNEQ    *int  `json:"maxUsesNEQ,omitempty"`
+	MaxUsesIn     []int `json:"maxUsesIn,omitempty"`
+	MaxUsesNotIn  []int `json:"maxUsesNotIn,omitempty"`
+	MaxUsesGT     *int  `json:"maxUsesGT,omitempty"`
+	MaxUsesGTE    *int  `json:"maxUsesGTE,omitempty"`
+	MaxUsesLT     *int  `json:"maxUsesLT,omitempty"`
+	MaxUsesLTE    *int  `json:"maxUsesLTE,omitempty"`
+	MaxUsesIsNil  bool  `json:"maxUsesIsNil,omitempty"`
+	MaxUsesNotNil bool  `json:"maxUsesNotNil,omitempty"`
+
+	// "uses" field predicates.
+	Uses      *int  `json:"uses,omitempty"`
+	UsesNEQ   *int  `json:"usesNEQ,omitempty"`
+	UsesIn    []int `json:"usesIn,omitempty"`
+	UsesNotIn []int `json:"usesNotIn,omitempty"`
+	UsesGT    *int  `json:"usesGT,omitempty"`
+	UsesGTE   *int  `json:"usesGTE,omitempty"`
+	UsesLT    *int  `json:"usesLT,omitempty"`
+	UsesLTE   *int  `json:"usesLTE,omitempty"`
+
+	// "revoked_at" field predicates.
+	RevokedAt       *time.Time  `json:"revokedAt,omitempty"`
+	RevokedAtNEQ    *time.Time  `json:"revokedAtNEQ,omitempty"`
+	RevokedAtIn     []time.Time `json:"revokedAtIn,omitempty"`
+	RevokedAtNotIn  []time.Time `json:"revokedAtNotIn,omitempty"`
+	RevokedAtGT     *time.Time  `json:"revokedAtGT,omitempty"`
+	RevokedAtGTE    *time.Time  `json:"revokedAtGTE,omitempty"`
+	RevokedAtLT     *time.Time  `json:"revokedAtLT,omitempty"`
+	RevokedAtLTE    *time.Time  `json:"revokedAtLTE,omitempty"`
+	RevokedAtIsNil  bool        `json:"revokedAtIsNil,omitempty"`
+	RevokedAtNotNil bool        `json:"revokedAtNotNil,omitempty"`
 
 	// "created_at" field predicates.
 	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
@@ -2708,62 +6694,24 @@ type RoomWhereInput struct {
 	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
 	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
 
-	// "updated_at" field predicates.
-	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
-	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
-	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
-	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
-	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
-	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
-	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
-	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
-
-	// "user_contacts" edge predicates.
-	HasUserContacts     *bool                    `json:"hasUserContacts,omitempty"`
-	HasUserContactsWith []*UserContactWhereInput `json:"hasUserContactsWith,omitempty"`
-
-	// "users" edge predicates.
-	HasUsers     *bool             `json:"hasUsers,omitempty"`
-	HasUsersWith []*UserWhereInput `json:"hasUsersWith,omitempty"`
-
-	// "last_message" edge predicates.
-	HasLastMessage     *bool                `json:"hasLastMessage,omitempty"`
-	HasLastMessageWith []*MessageWhereInput `json:"hasLastMessageWith,omitempty"`
-
-	// "messages" edge predicates.
-	HasMessages     *bool                `json:"hasMessages,omitempty"`
-	HasMessagesWith []*MessageWhereInput `json:"hasMessagesWith,omitempty"`
-
-	// "message_voices" edge predicates.
-	HasMessageVoices     *bool                     `json:"hasMessageVoices,omitempty"`
-	HasMessageVoicesWith []*MessageVoiceWhereInput `json:"hasMessageVoicesWith,omitempty"`
-
-	// "message_attachments" edge predicates.
-	HasMessageAttachments     *bool                          `json:"hasMessageAttachments,omitempty"`
-	HasMessageAttachmentsWith []*MessageAttachmentWhereInput `json:"hasMessageAttachmentsWith,omitempty"`
-
-	// "message_links" edge predicates.
-	HasMessageLinks     *bool                    `json:"hasMessageLinks,omitempty"`
-	HasMessageLinksWith []*MessageLinkWhereInput `json:"hasMessageLinksWith,omitempty"`
-
-	// "room_members" edge predicates.
-	HasRoomMembers     *bool                   `json:"hasRoomMembers,omitempty"`
-	HasRoomMembersWith []*RoomMemberWhereInput `json:"hasRoomMembersWith,omitempty"`
+	// "room" edge predicates.
+	HasRoom     *bool             `json:"hasRoom,omitempty"`
+	HasRoomWith []*RoomWhereInput `json:"hasRoomWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *RoomWhereInput) AddPredicates(predicates ...predicate.Room) {
+func (i *RoomInviteWhereInput) AddPredicates(predicates ...predicate.RoomInvite) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the RoomWhereInput filter on the RoomQuery builder.
-func (i *RoomWhereInput) Filter(q *RoomQuery) (*RoomQuery, error) {
+// Filter applies the RoomInviteWhereInput filter on the RoomInviteQuery builder.
+func (i *RoomInviteWhereInput) Filter(q *RoomInviteQuery) (*RoomInviteQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyRoomWhereInput {
+		if err == ErrEmptyRoomInviteWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -2771,19 +6719,19 @@ func (i *RoomWhereInput) Filter(q *RoomQuery) (*RoomQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptyRoomWhereInput is returned in case the RoomWhereInput is empty.
-var ErrEmptyRoomWhereInput = errors.New("ent: empty predicate RoomWhereInput")
+// ErrEmptyRoomInviteWhereInput is returned in case the RoomInviteWhereInput is empty.
+var ErrEmptyRoomInviteWhereInput = errors.New("ent: empty predicate RoomInviteWhereInput")
 
-// P returns a predicate for filtering rooms.
+// P returns a predicate for filtering roominvites.
 // An error is returned if the input is empty or invalid.
-func (i *RoomWhereInput) P() (predicate.Room, error) {
-	var predicates []predicate.Room
+func (i *RoomInviteWhereInput) P() (predicate.RoomInvite, error) {
+	var predicates []predicate.RoomInvite
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, room.Not(p))
+		predicates = append(predicates, roominvite.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -2793,7 +6741,7 @@ func (i *RoomWhereInput) P() (predicate.Room, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Room, 0, n)
+		or := make([]predicate.RoomInvite, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -2801,7 +6749,7 @@ func (i *RoomWhereInput) P() (predicate.Room, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, room.Or(or...))
+		predicates = append(predicates, roominvite.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -2811,7 +6759,7 @@ func (i *RoomWhereInput) P() (predicate.Room, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Room, 0, n)
+		and := make([]predicate.RoomInvite, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -2819,359 +6767,314 @@ func (i *RoomWhereInput) P() (predicate.Room, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, room.And(and...))
+		predicates = append(predicates, roominvite.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, room.IDEQ(*i.ID))
+		predicates = append(predicates, roominvite.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, room.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, roominvite.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, room.IDIn(i.IDIn...))
+		predicates = append(predicates, roominvite.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, room.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, roominvite.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, room.IDGT(*i.IDGT))
+		predicates = append(predicates, roominvite.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, room.IDGTE(*i.IDGTE))
+		predicates = append(predicates, roominvite.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, room.IDLT(*i.IDLT))
+		predicates = append(predicates, roominvite.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, room.IDLTE(*i.IDLTE))
+		predicates = append(predicates, roominvite.IDLTE(*i.IDLTE))
 	}
-	if i.Name != nil {
-		predicates = append(predicates, room.NameEQ(*i.Name))
+	if i.Token != nil {
+		predicates = append(predicates, roominvite.TokenEQ(*i.Token))
 	}
-	if i.NameNEQ != nil {
-		predicates = append(predicates, room.NameNEQ(*i.NameNEQ))
+	if i.TokenNEQ != nil {
+		predicates = append(predicates, roominvite.TokenNEQ(*i.TokenNEQ))
 	}
-	if len(i.NameIn) > 0 {
-		predicates = append(predicates, room.NameIn(i.NameIn...))
+	if len(i.TokenIn) > 0 {
+		predicates = append(predicates, roominvite.TokenIn(i.TokenIn...))
 	}
-	if len(i.NameNotIn) > 0 {
-		predicates = append(predicates, room.NameNotIn(i.NameNotIn...))
+	if len(i.TokenNotIn) > 0 {
+		predicates = append(predicates, roominvite.TokenNotIn(i.TokenNotIn...))
 	}
-	if i.NameGT != nil {
-		predicates = append(predicates, room.NameGT(*i.NameGT))
+	if i.TokenGT != nil {
+		predicates = append(predicates, roominvite.TokenGT(*i.TokenGT))
 	}
-	if i.NameGTE != nil {
-		predicates = append(predicates, room.NameGTE(*i.NameGTE))
+	if i.TokenGTE != nil {
+		predicates = append(predicates, roominvite.TokenGTE(*i.TokenGTE))
 	}
-	if i.NameLT != nil {
-		predicates = append(predicates, room.NameLT(*i.NameLT))
+	if i.TokenLT != nil {
+		predicates = append(predicates, roominvite.TokenLT(*i.TokenLT))
 	}
-	if i.NameLTE != nil {
-		predicates = append(predicates, room.NameLTE(*i.NameLTE))
+	if i.TokenLTE != nil {
+		predicates = append(predicates, roominvite.TokenLTE(*i.TokenLTE))
 	}
-	if i.NameContains != nil {
-		predicates = append(predicates, room.NameContains(*i.NameContains))
+	if i.TokenContains != nil {
+		predicates = append(predicates, roominvite.TokenContains(*i.TokenContains))
 	}
-	if i.NameHasPrefix != nil {
-		predicates = append(predicates, room.NameHasPrefix(*i.NameHasPrefix))
+	if i.TokenHasPrefix != nil {
+		predicates = append(predicates, roominvite.TokenHasPrefix(*i.TokenHasPrefix))
 	}
-	if i.NameHasSuffix != nil {
-		predicates = append(predicates, room.NameHasSuffix(*i.NameHasSuffix))
+	if i.TokenHasSuffix != nil {
+		predicates = append(predicates, roominvite.TokenHasSuffix(*i.TokenHasSuffix))
 	}
-	if i.NameIsNil {
-		predicates = append(predicates, room.NameIsNil())
+	if i.TokenEqualFold != nil {
+		predicates = append(predicates, roominvite.TokenEqualFold(*i.TokenEqualFold))
 	}
-	if i.NameNotNil {
-		predicates = append(predicates, room.NameNotNil())
+	if i.TokenContainsFold != nil {
+		predicates = append(predicates, roominvite.TokenContainsFold(*i.TokenContainsFold))
 	}
-	if i.NameEqualFold != nil {
-		predicates = append(predicates, room.NameEqualFold(*i.NameEqualFold))
+	if i.RoomID != nil {
+		predicates = append(predicates, roominvite.RoomIDEQ(*i.RoomID))
 	}
-	if i.NameContainsFold != nil {
-		predicates = append(predicates, room.NameContainsFold(*i.NameContainsFold))
+	if i.RoomIDNEQ != nil {
+		predicates = append(predicates, roominvite.RoomIDNEQ(*i.RoomIDNEQ))
 	}
-	if i.Description != nil {
-		predicates = append(predicates, room.DescriptionEQ(*i.Description))
+	if len(i.RoomIDIn) > 0 {
+		predicates = append(predicates, roominvite.RoomIDIn(i.RoomIDIn...))
 	}
-	if i.DescriptionNEQ != nil {
-		predicates = append(predicates, room.DescriptionNEQ(*i.DescriptionNEQ))
+	if len(i.RoomIDNotIn) > 0 {
+		predicates = append(predicates, roominvite.RoomIDNotIn(i.RoomIDNotIn...))
 	}
-	if len(i.DescriptionIn) > 0 {
-		predicates = append(predicates, room.DescriptionIn(i.DescriptionIn...))
+	if i.RoomIDGT != nil {
+		predicates = append(predicates, roominvite.RoomIDGT(*i.RoomIDGT))
 	}
-	if len(i.DescriptionNotIn) > 0 {
-		predicates = append(predicates, room.DescriptionNotIn(i.DescriptionNotIn...))
+	if i.RoomIDGTE != nil {
+		predicates = append(predicates, roominvite.RoomIDGTE(*i.RoomIDGTE))
 	}
-	if i.DescriptionGT != nil {
-		predicates = append(predicates, room.DescriptionGT(*i.DescriptionGT))
+	if i.RoomIDLT != nil {
+		predicates = append(predicates, roominvite.RoomIDLT(*i.RoomIDLT))
 	}
-	if i.DescriptionGTE != nil {
-		predicates = append(predicates, room.DescriptionGTE(*i.DescriptionGTE))
+	if i.RoomIDLTE != nil {
+		predicates = append(predicates, roominvite.RoomIDLTE(*i.RoomIDLTE))
 	}
-	if i.DescriptionLT != nil {
-		predicates = append(predicates, room.DescriptionLT(*i.DescriptionLT))
+	if i.RoomIDContains != nil {
+		predicates = append(predicates, roominvite.RoomIDContains(*i.RoomIDContains))
 	}
-	if i.DescriptionLTE != nil {
-		predicates = append(predicates, room.DescriptionLTE(*i.DescriptionLTE))
+	if i.RoomIDHasPrefix != nil {
+		predicates = append(predicates, roominvite.RoomIDHasPrefix(*i.RoomIDHasPrefix))
 	}
-	if i.DescriptionContains != nil {
-		predicates = append(predicates, room.DescriptionContains(*i.DescriptionContains))
+	if i.RoomIDHasSuffix != nil {
+		predicates = append(predicates, roominvite.RoomIDHasSuffix(*i.RoomIDHasSuffix))
 	}
-	if i.DescriptionHasPrefix != nil {
-		predicates = append(predicates, room.DescriptionHasPrefix(*i.DescriptionHasPrefix))
+	if i.RoomIDEqualFold != nil {
+		predicates = append(predicates, roominvite.RoomIDEqualFold(*i.RoomIDEqualFold))
 	}
-	if i.DescriptionHasSuffix != nil {
-		predicates = append(predicates, room.DescriptionHasSuffix(*i.DescriptionHasSuffix))
+	if i.RoomIDContainsFold != nil {
+		predicates = append(predicates, roominvite.RoomIDContainsFold(*i.RoomIDContainsFold))
+	}
+	if i.CreatorID != nil {
+		predicates = append(predicates, roominvite.CreatorIDEQ(*i.CreatorID))
+	}
+	if i.CreatorIDNEQ != nil {
+		predicates = append(predicates, roominvite.CreatorIDNEQ(*i.CreatorIDNEQ))
+	}
+	if len(i.CreatorIDIn) > 0 {
+		predicates = append(predicates, roominvite.CreatorIDIn(i.CreatorIDIn...))
+	}
+	if len(i.CreatorIDNotIn) > 0 {
+		predicates = append(predicates, roominvite.CreatorIDNotIn(i.CreatorIDNotIn...))
+	}
+	if i.CreatorIDGT != nil {
+		predicates = append(predicates, roominvite.CreatorIDGT(*i.CreatorIDGT))
+	}
+	if i.CreatorIDGTE != nil {
+		predicates = append(predicates, roominvite.CreatorIDGTE(*i.CreatorIDGTE))
+	}
+	if i.CreatorIDLT != nil {
+		predicates = append(predicates, roominvite.CreatorIDLT(*i.CreatorIDLT))
+	}
+	if i.CreatorIDLTE != nil {
+		predicates = append(predicates, roominvite.CreatorIDLTE(*i.CreatorIDLTE))
+	}
+	if i.CreatorIDContains != nil {
+		predicates = append(predicates, roominvite.CreatorIDContains(*i.CreatorIDContains))
+	}
+	if i.CreatorIDHasPrefix != nil {
+		predicates = append(predicates, roominvite.CreatorIDHasPrefix(*i.CreatorIDHasPrefix))
+	}
+	if i.CreatorIDHasSuffix != nil {
+		predicates = append(predicates, roominvite.CreatorIDHasSuffix(*i.CreatorIDHasSuffix))
+	}
+	if i.CreatorIDEqualFold != nil {
+		predicates = append(predicates, roominvite.CreatorIDEqualFold(*i.CreatorIDEqualFold))
 	}
-	if i.DescriptionIsNil {
-		predicates = append(predicates, room.DescriptionIsNil())
+	if i.CreatorIDContainsFold != nil {
+		predicates = append(predicates, roominvite.CreatorIDContainsFold(*i.CreatorIDContainsFold))
 	}
-	if i.DescriptionNotNil {
-		predicates = append(predicates, room.DescriptionNotNil())
+	if i.ExpiresAt != nil {
+		predicates = append(predicates, roominvite.ExpiresAtEQ(*i.ExpiresAt))
 	}
-	if i.DescriptionEqualFold != nil {
-		predicates = append(predicates, room.DescriptionEqualFold(*i.DescriptionEqualFold))
+	if i.ExpiresAtNEQ != nil {
+		predicates = append(predicates, roominvite.ExpiresAtNEQ(*i.ExpiresAtNEQ))
 	}
-	if i.DescriptionContainsFold != nil {
-		predicates = append(predicates, room.DescriptionContainsFold(*i.DescriptionContainsFold))
+	if len(i.ExpiresAtIn) > 0 {
+		predicates = append(predicates, roominvite.ExpiresAtIn(i.ExpiresAtIn...))
 	}
-	if i.Version != nil {
-		predicates = append(predicates, room.VersionEQ(*i.Version))
+	if len(i.ExpiresAtNotIn) > 0 {
+		predicates = append(predicates, roominvite.ExpiresAtNotIn(i.ExpiresAtNotIn...))
 	}
-	if i.VersionNEQ != nil {
-		predicates = append(predicates, room.VersionNEQ(*i.VersionNEQ))
+	if i.ExpiresAtGT != nil {
+		predicates = append(predicates, roominvite.ExpiresAtGT(*i.ExpiresAtGT))
 	}
-	if len(i.VersionIn) > 0 {
-		predicates = append(predicates, room.VersionIn(i.VersionIn...))
+	if i.ExpiresAtGTE != nil {
+		predicates = append(predicates, roominvite.ExpiresAtGTE(*i.ExpiresAtGTE))
 	}
-	if len(i.VersionNotIn) > 0 {
-		predicates = append(predicates, room.VersionNotIn(i.VersionNotIn...))
+	if i.ExpiresAtLT != nil {
+		predicates = append(predicates, roominvite.ExpiresAtLT(*i.ExpiresAtLT))
 	}
-	if i.VersionGT != nil {
-		predicates = append(predicates, room.VersionGT(*i.VersionGT))
+	if i.ExpiresAtLTE != nil {
+		predicates = append(predicates, roominvite.ExpiresAtLTE(*i.ExpiresAtLTE))
 	}
-	if i.VersionGTE != nil {
-		predicates = append(predicates, room.VersionGTE(*i.VersionGTE))
+	if i.ExpiresAtIsNil {
+		predicates = append(predicates, roominvite.ExpiresAtIsNil())
 	}
-	if i.VersionLT != nil {
-		predicates = append(predicates, room.VersionLT(*i.VersionLT))
+	if i.ExpiresAtNotNil {
+		predicates = append(predicates, roominvite.ExpiresAtNotNil())
 	}
-	if i.VersionLTE != nil {
-		predicates = append(predicates, room.VersionLTE(*i.VersionLTE))
+	if i.MaxUses != nil {
+		predicates = append(predicates, roominvite.MaxUsesEQ(*i.MaxUses))
 	}
-	if i.Type != nil {
-		predicates = append(predicates, room.TypeEQ(*i.Type))
+	if i.MaxUsesNEQ != nil {
+		predicates = append(predicates, roominvite.MaxUsesNEQ(*i.MaxUsesNEQ))
 	}
-	if i.TypeNEQ != nil {
-		predicates = append(predicates, room.TypeNEQ(*i.TypeNEQ))
+	if len(i.MaxUsesIn) > 0 {
+		predicates = append(predicates, roominvite.MaxUsesIn(i.MaxUsesIn...))
 	}
-	if len(i.TypeIn) > 0 {
-		predicates = append(predicates, room.TypeIn(i.TypeIn...))
+	if len(i.MaxUsesNotIn) > 0 {
+		predicates = append(predicates, roominvite.MaxUsesNotIn(i.MaxUsesNotIn...))
 	}
-	if len(i.TypeNotIn) > 0 {
-		predicates = append(predicates, room.TypeNotIn(i.TypeNotIn...))
+	if i.MaxUsesGT != nil {
+		predicates = append(predicates, roominvite.MaxUsesGT(*i.MaxUsesGT))
 	}
-	if i.CreatedAt != nil {
-		predicates = append(predicates, room.CreatedAtEQ(*i.CreatedAt))
+	if i.MaxUsesGTE != nil {
+		predicates = append(predicates, roominvite.MaxUsesGTE(*i.MaxUsesGTE))
 	}
-	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, room.CreatedAtNEQ(*i.CreatedAtNEQ))
+	if i.MaxUsesLT != nil {
+		predicates = append(predicates, roominvite.MaxUsesLT(*i.MaxUsesLT))
 	}
-	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, room.CreatedAtIn(i.CreatedAtIn...))
+	if i.MaxUsesLTE != nil {
+		predicates = append(predicates, roominvite.MaxUsesLTE(*i.MaxUsesLTE))
 	}
-	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, room.CreatedAtNotIn(i.CreatedAtNotIn...))
+	if i.MaxUsesIsNil {
+		predicates = append(predicates, roominvite.MaxUsesIsNil())
 	}
-	if i.CreatedAtGT != nil {
-		predicates = append(predicates, room.CreatedAtGT(*i.CreatedAtGT))
+	if i.MaxUsesNotNil {
+		predicates = append(predicates, roominvite.MaxUsesNotNil())
 	}
-	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, room.CreatedAtGTE(*i.CreatedAtGTE))
+	if i.Uses != nil {
+		predicates = append(predicates, roominvite.UsesEQ(*i.Uses))
 	}
-	if i.CreatedAtLT != nil {
-		predicates = append(predicates, room.CreatedAtLT(*i.CreatedAtLT))
+	if i.UsesNEQ != nil {
+		predicates = append(predicates, roominvite.UsesNEQ(*i.UsesNEQ))
 	}
-	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, room.CreatedAtLTE(*i.CreatedAtLTE))
+	if len(i.UsesIn) > 0 {
+		predicates = append(predicates, roominvite.UsesIn(i.UsesIn...))
 	}
-	if i.UpdatedAt != nil {
-		predicates = append(predicates, room.UpdatedAtEQ(*i.UpdatedAt))
+	if len(i.UsesNotIn) > 0 {
+		predicates = append(predicates, roominvite.UsesNotIn(i.UsesNotIn...))
 	}
-	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, room.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	if i.UsesGT != nil {
+		predicates = append(predicates, roominvite.UsesGT(*i.UsesGT))
 	}
-	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, room.UpdatedAtIn(i.UpdatedAtIn...))
+	if i.UsesGTE != nil {
+		predicates = append(predicates, roominvite.UsesGTE(*i.UsesGTE))
 	}
-	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, room.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	if i.UsesLT != nil {
+		predicates = append(predicates, roominvite.UsesLT(*i.UsesLT))
 	}
-	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, room.UpdatedAtGT(*i.UpdatedAtGT))
+	if i.UsesLTE != nil {
+		predicates = append(predicates, roominvite.UsesLTE(*i.UsesLTE))
 	}
-	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, room.UpdatedAtGTE(*i.UpdatedAtGTE))
+	if i.RevokedAt != nil {
+		predicates = append(predicates, roominvite.RevokedAtEQ(*i.RevokedAt))
 	}
-	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, room.UpdatedAtLT(*i.UpdatedAtLT))
+	if i.RevokedAtNEQ != nil {
+		predicates = append(predicates, roominvite.RevokedAtNEQ(*i.RevokedAtNEQ))
 	}
-	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, room.UpdatedAtLTE(*i.UpdatedAtLTE))
+	if len(i.RevokedAtIn) > 0 {
+		predicates = append(predicates, roominvite.RevokedAtIn(i.RevokedAtIn...))
 	}
-
-	if i.HasUserContacts != nil {
-		p := room.HasUserContacts()
-		if !*i.HasUserContacts {
-			p = room.Not(p)
-		}
-		predicates = append(predicates, p)
+	if len(i.RevokedAtNotIn) > 0 {
+		predicates = append(predicates, roominvite.RevokedAtNotIn(i.RevokedAtNotIn...))
 	}
-	if len(i.HasUserContactsWith) > 0 {
-		with := make([]predicate.UserContact, 0, len(i.HasUserContactsWith))
-		for _, w := range i.HasUserContactsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasUserContactsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, room.HasUserContactsWith(with...))
+	if i.RevokedAtGT != nil {
+		predicates = append(predicates, roominvite.RevokedAtGT(*i.RevokedAtGT))
 	}
-	if i.HasUsers != nil {
-		p := room.HasUsers()
-		if !*i.HasUsers {
-			p = room.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.RevokedAtGTE != nil {
+		predicates = append(predicates, roominvite.RevokedAtGTE(*i.RevokedAtGTE))
 	}
-	if len(i.HasUsersWith) > 0 {
-		with := make([]predicate.User, 0, len(i.HasUsersWith))
-		for _, w := range i.HasUsersWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasUsersWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, room.HasUsersWith(with...))
+	if i.RevokedAtLT != nil {
+		predicates = append(predicates, roominvite.RevokedAtLT(*i.RevokedAtLT))
 	}
-	if i.HasLastMessage != nil {
-		p := room.HasLastMessage()
-		if !*i.HasLastMessage {
-			p = room.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.RevokedAtLTE != nil {
+		predicates = append(predicates, roominvite.RevokedAtLTE(*i.RevokedAtLTE))
 	}
-	if len(i.HasLastMessageWith) > 0 {
-		with := make([]predicate.Message, 0, len(i.HasLastMessageWith))
-		for _, w := range i.HasLastMessageWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasLastMessageWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, room.HasLastMessageWith(with...))
+	if i.RevokedAtIsNil {
+		predicates = append(predicates, roominvite.RevokedAtIsNil())
 	}
-	if i.HasMessages != nil {
-		p := room.HasMessages()
-		if !*i.HasMessages {
-			p = room.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.RevokedAtNotNil {
+		predicates = append(predicates, roominvite.RevokedAtNotNil())
 	}
-	if len(i.HasMessagesWith) > 0 {
-		with := make([]predicate.Message, 0, len(i.HasMessagesWith))
-		for _, w := range i.HasMessagesWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasMessagesWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, room.HasMessagesWith(with...))
+	if i.CreatedAt != nil {
+		predicates = append(predicates, roominvite.CreatedAtEQ(*i.CreatedAt))
 	}
-	if i.HasMessageVoices != nil {
-		p := room.HasMessageVoices()
-		if !*i.HasMessageVoices {
-			p = room.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, roominvite.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
-	if len(i.HasMessageVoicesWith) > 0 {
-		with := make([]predicate.MessageVoice, 0, len(i.HasMessageVoicesWith))
-		for _, w := range i.HasMessageVoicesWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasMessageVoicesWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, room.HasMessageVoicesWith(with...))
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, roominvite.CreatedAtIn(i.CreatedAtIn...))
 	}
-	if i.HasMessageAttachments != nil {
-		p := room.HasMessageAttachments()
-		if !*i.HasMessageAttachments {
-			p = room.Not(p)
-		}
-		predicates = append(predicates, p)
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, roominvite.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
-	if len(i.HasMessageAttachmentsWith) > 0 {
-		with := make([]predicate.MessageAttachment, 0, len(i.HasMessageAttachmentsWith))
-		for _, w := range i.HasMessageAttachmentsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasMessageAttachmentsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, room.HasMessageAttachmentsWith(with...))
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, roominvite.CreatedAtGT(*i.CreatedAtGT))
 	}
-	if i.HasMessageLinks != nil {
-		p := room.HasMessageLinks()
-		if !*i.HasMessageLinks {
-			p = room.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, roominvite.CreatedAtGTE(*i.CreatedAtGTE))
 	}
-	if len(i.HasMessageLinksWith) > 0 {
-		with := make([]predicate.MessageLink, 0, len(i.HasMessageLinksWith))
-		for _, w := range i.HasMessageLinksWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasMessageLinksWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, room.HasMessageLinksWith(with...))
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, roominvite.CreatedAtLT(*i.CreatedAtLT))
 	}
-	if i.HasRoomMembers != nil {
-		p := room.HasRoomMembers()
-		if !*i.HasRoomMembers {
-			p = room.Not(p)
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, roominvite.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+
+	if i.HasRoom != nil {
+		p := roominvite.HasRoom()
+		if !*i.HasRoom {
+			p = roominvite.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasRoomMembersWith) > 0 {
-		with := make([]predicate.RoomMember, 0, len(i.HasRoomMembersWith))
-		for _, w := range i.HasRoomMembersWith {
+	if len(i.HasRoomWith) > 0 {
+		with := make([]predicate.Room, 0, len(i.HasRoomWith))
+		for _, w := range i.HasRoomWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasRoomMembersWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasRoomWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, room.HasRoomMembersWith(with...))
+		predicates = append(predicates, roominvite.HasRoomWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyRoomWhereInput
+		return nil, ErrEmptyRoomInviteWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return room.And(predicates...), nil
+		return roominvite.And(predicates...), nil
 	}
 }
 
@@ -3219,6 +7122,30 @@ type RoomMemberWhereInput struct {
 	UnreadMessagesCountLT    *int  `json:"unreadMessagesCountLT,omitempty"`
 	UnreadMessagesCountLTE   *int  `json:"unreadMessagesCountLTE,omitempty"`
 
+	// "notification_level" field predicates.
+	NotificationLevel      *roommember.NotificationLevel  `json:"notificationLevel,omitempty"`
+	NotificationLevelNEQ   *roommember.NotificationLevel  `json:"notificationLevelNEQ,omitempty"`
+	NotificationLevelIn    []roommember.NotificationLevel `json:"notificationLevelIn,omitempty"`
+	NotificationLevelNotIn []roommember.NotificationLevel `json:"notificationLevelNotIn,omitempty"`
+
+	// "mute_until" field predicates.
+	MuteUntil       *time.Time  `json:"muteUntil,omitempty"`
+	MuteUntilNEQ    *time.Time  `json:"muteUntilNEQ,omitempty"`
+	MuteUntilIn     []time.Time `json:"muteUntilIn,omitempty"`
+	MuteUntilNotIn  []time.Time `json:"muteUntilNotIn,omitempty"`
+	MuteUntilGT     *time.Time  `json:"muteUntilGT,omitempty"`
+	MuteUntilGTE    *time.Time  `json:"muteUntilGTE,omitempty"`
+	MuteUntilLT     *time.Time  `json:"muteUntilLT,omitempty"`
+	MuteUntilLTE    *time.Time  `json:"muteUntilLTE,omitempty"`
+	MuteUntilIsNil  bool        `json:"muteUntilIsNil,omitempty"`
+	MuteUntilNotNil bool        `json:"muteUntilNotNil,omitempty"`
+
+	// "role" field predicates.
+	Role      *roommember.Role  `json:"role,omitempty"`
+	RoleNEQ   *roommember.Role  `json:"roleNEQ,omitempty"`
+	RoleIn    []roommember.Role `json:"roleIn,omitempty"`
+	RoleNotIn []roommember.Role `json:"roleNotIn,omitempty"`
+
 	// "user_id" field predicates.
 	UserID             *pulid.ID  `json:"userID,omitempty"`
 	UserIDNEQ          *pulid.ID  `json:"userIDNEQ,omitempty"`
@@ -3442,6 +7369,60 @@ func (i *RoomMemberWhereInput) P() (predicate.RoomMember, error) {
 	if i.UnreadMessagesCountLTE != nil {
 		predicates = append(predicates, roommember.UnreadMessagesCountLTE(*i.UnreadMessagesCountLTE))
 	}
+	if i.NotificationLevel != nil {
+		predicates = append(predicates, roommember.NotificationLevelEQ(*i.NotificationLevel))
+	}
+	if i.NotificationLevelNEQ != nil {
+		predicates = append(predicates, roommember.NotificationLevelNEQ(*i.NotificationLevelNEQ))
+	}
+	if len(i.NotificationLevelIn) > 0 {
+		predicates = append(predicates, roommember.NotificationLevelIn(i.NotificationLevelIn...))
+	}
+	if len(i.NotificationLevelNotIn) > 0 {
+		predicates = append(predicates, roommember.NotificationLevelNotIn(i.NotificationLevelNotIn...))
+	}
+	if i.MuteUntil != nil {
+		predicates = append(predicates, roommember.MuteUntilEQ(*i.MuteUntil))
+	}
+	if i.MuteUntilNEQ != nil {
+		predicates = append(predicates, roommember.MuteUntilNEQ(*i.MuteUntilNEQ))
+	}
+	if len(i.MuteUntilIn) > 0 {
+		predicates = append(predicates, roommember.MuteUntilIn(i.MuteUntilIn...))
+	}
+	if len(i.MuteUntilNotIn) > 0 {
+		predicates = append(predicates, roommember.MuteUntilNotIn(i.MuteUntilNotIn...))
+	}
+	if i.MuteUntilGT != nil {
+		predicates = append(predicates, roommember.MuteUntilGT(*i.MuteUntilGT))
+	}
+	if i.MuteUntilGTE != nil {
+		predicates = append(predicates, roommember.MuteUntilGTE(*i.MuteUntilGTE))
+	}
+	if i.MuteUntilLT != nil {
+		predicates = append(predicates, roommember.MuteUntilLT(*i.MuteUntilLT))
+	}
+	if i.MuteUntilLTE != nil {
+		predicates = append(predicates, roommember.MuteUntilLTE(*i.MuteUntilLTE))
+	}
+	if i.MuteUntilIsNil {
+		predicates = append(predicates, roommember.MuteUntilIsNil())
+	}
+	if i.MuteUntilNotNil {
+		predicates = append(predicates, roommember.MuteUntilNotNil())
+	}
+	if i.Role != nil {
+		predicates = append(predicates, roommember.RoleEQ(*i.Role))
+	}
+	if i.RoleNEQ != nil {
+		predicates = append(predicates, roommember.RoleNEQ(*i.RoleNEQ))
+	}
+	if len(i.RoleIn) > 0 {
+		predicates = append(predicates, roommember.RoleIn(i.RoleIn...))
+	}
+	if len(i.RoleNotIn) > 0 {
+		predicates = append(predicates, roommember.RoleNotIn(i.RoleNotIn...))
+	}
 	if i.UserID != nil {
 		predicates = append(predicates, roommember.UserIDEQ(*i.UserID))
 	}
@@ -3694,22 +7675,22 @@ type UserWhereInput struct {
 	EmailEqualFold    *string  `json:"emailEqualFold,omitempty"`
 	EmailContainsFold *string  `json:"emailContainsFold,omitempty"`
 
-	// "contact_pin" field predicates.
-	ContactPin             *string  `json:"contactPin,omitempty"`
-	ContactPinNEQ          *string  `json:"contactPinNEQ,omitempty"`
-	ContactPinIn           []string `json:"contactPinIn,omitempty"`
-	ContactPinNotIn        []string `json:"contactPinNotIn,omitempty"`
-	ContactPinGT           *string  `json:"contactPinGT,omitempty"`
-	ContactPinGTE          *string  `json:"contactPinGTE,omitempty"`
-	ContactPinLT           *string  `json:"contactPinLT,omitempty"`
-	ContactPinLTE          *string  `json:"contactPinLTE,omitempty"`
-	ContactPinContains     *string  `json:"contactPinContains,omitempty"`
-	ContactPinHasPrefix    *string  `json:"contactPinHasPrefix,omitempty"`
-	ContactPinHasSuffix    *string  `json:"contactPinHasSuffix,omitempty"`
-	ContactPinIsNil        bool     `json:"contactPinIsNil,omitempty"`
-	ContactPinNotNil       bool     `json:"contactPinNotNil,omitempty"`
-	ContactPinEqualFold    *string  `json:"contactPinEqualFold,omitempty"`
-	ContactPinContainsFold *string  `json:"contactPinContainsFold,omitempty"`
+	// "avatar_id" field predicates.
+	AvatarID             *pulid.ID  `json:"avatarID,omitempty"`
+	AvatarIDNEQ          *pulid.ID  `json:"avatarIDNEQ,omitempty"`
+	AvatarIDIn           []pulid.ID `json:"avatarIDIn,omitempty"`
+	AvatarIDNotIn        []pulid.ID `json:"avatarIDNotIn,omitempty"`
+	AvatarIDGT           *pulid.ID  `json:"avatarIDGT,omitempty"`
+	AvatarIDGTE          *pulid.ID  `json:"avatarIDGTE,omitempty"`
+	AvatarIDLT           *pulid.ID  `json:"avatarIDLT,omitempty"`
+	AvatarIDLTE          *pulid.ID  `json:"avatarIDLTE,omitempty"`
+	AvatarIDContains     *pulid.ID  `json:"avatarIDContains,omitempty"`
+	AvatarIDHasPrefix    *pulid.ID  `json:"avatarIDHasPrefix,omitempty"`
+	AvatarIDHasSuffix    *pulid.ID  `json:"avatarIDHasSuffix,omitempty"`
+	AvatarIDIsNil        bool       `json:"avatarIDIsNil,omitempty"`
+	AvatarIDNotNil       bool       `json:"avatarIDNotNil,omitempty"`
+	AvatarIDEqualFold    *pulid.ID  `json:"avatarIDEqualFold,omitempty"`
+	AvatarIDContainsFold *pulid.ID  `json:"avatarIDContainsFold,omitempty"`
 
 	// "created_at" field predicates.
 	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
@@ -3735,10 +7716,6 @@ type UserWhereInput struct {
 	HasDevice     *bool               `json:"hasDevice,omitempty"`
 	HasDeviceWith []*DeviceWhereInput `json:"hasDeviceWith,omitempty"`
 
-	// "notifications" edge predicates.
-	HasNotifications     *bool                     `json:"hasNotifications,omitempty"`
-	HasNotificationsWith []*NotificationWhereInput `json:"hasNotificationsWith,omitempty"`
-
 	// "contacts" edge predicates.
 	HasContacts     *bool             `json:"hasContacts,omitempty"`
 	HasContactsWith []*UserWhereInput `json:"hasContactsWith,omitempty"`
@@ -3751,6 +7728,14 @@ type UserWhereInput struct {
 	HasMessages     *bool                `json:"hasMessages,omitempty"`
 	HasMessagesWith []*MessageWhereInput `json:"hasMessagesWith,omitempty"`
 
+	// "avatar" edge predicates.
+	HasAvatar     *bool             `json:"hasAvatar,omitempty"`
+	HasAvatarWith []*FileWhereInput `json:"hasAvatarWith,omitempty"`
+
+	// "avatars" edge predicates.
+	HasAvatars     *bool               `json:"hasAvatars,omitempty"`
+	HasAvatarsWith []*AvatarWhereInput `json:"hasAvatarsWith,omitempty"`
+
 	// "user_contacts" edge predicates.
 	HasUserContacts     *bool                    `json:"hasUserContacts,omitempty"`
 	HasUserContactsWith []*UserContactWhereInput `json:"hasUserContactsWith,omitempty"`
@@ -4017,50 +8002,50 @@ func (i *UserWhereInput) P() (predicate.User, error) {
 	if i.EmailContainsFold != nil {
 		predicates = append(predicates, user.EmailContainsFold(*i.EmailContainsFold))
 	}
-	if i.ContactPin != nil {
-		predicates = append(predicates, user.ContactPinEQ(*i.ContactPin))
+	if i.AvatarID != nil {
+		predicates = append(predicates, user.AvatarIDEQ(*i.AvatarID))
 	}
-	if i.ContactPinNEQ != nil {
-		predicates = append(predicates, user.ContactPinNEQ(*i.ContactPinNEQ))
+	if i.AvatarIDNEQ != nil {
+		predicates = append(predicates, user.AvatarIDNEQ(*i.AvatarIDNEQ))
 	}
-	if len(i.ContactPinIn) > 0 {
-		predicates = append(predicates, user.ContactPinIn(i.ContactPinIn...))
+	if len(i.AvatarIDIn) > 0 {
+		predicates = append(predicates, user.AvatarIDIn(i.AvatarIDIn...))
 	}
-	if len(i.ContactPinNotIn) > 0 {
-		predicates = append(predicates, user.ContactPinNotIn(i.ContactPinNotIn...))
+	if len(i.AvatarIDNotIn) > 0 {
+		predicates = append(predicates, user.AvatarIDNotIn(i.AvatarIDNotIn...))
 	}
-	if i.ContactPinGT != nil {
-		predicates = append(predicates, user.ContactPinGT(*i.ContactPinGT))
+	if i.AvatarIDGT != nil {
+		predicates = append(predicates, user.AvatarIDGT(*i.AvatarIDGT))
 	}
-	if i.ContactPinGTE != nil {
-		predicates = append(predicates, user.ContactPinGTE(*i.ContactPinGTE))
+	if i.AvatarIDGTE != nil {
+		predicates = append(predicates, user.AvatarIDGTE(*i.AvatarIDGTE))
 	}
-	if i.ContactPinLT != nil {
-		predicates = append(predicates, user.ContactPinLT(*i.ContactPinLT))
+	if i.AvatarIDLT != nil {
+		predicates = append(predicates, user.AvatarIDLT(*i.AvatarIDLT))
 	}
-	if i.ContactPinLTE != nil {
-		predicates = append(predicates, user.ContactPinLTE(*i.ContactPinLTE))
+	if i.AvatarIDLTE != nil {
+		predicates = append(predicates, user.AvatarIDLTE(*i.AvatarIDLTE))
 	}
-	if i.ContactPinContains != nil {
-		predicates = append(predicates, user.ContactPinContains(*i.ContactPinContains))
+	if i.AvatarIDContains != nil {
+		predicates = append(predicates, user.AvatarIDContains(*i.AvatarIDContains))
 	}
-	if i.ContactPinHasPrefix != nil {
-		predicates = append(predicates, user.ContactPinHasPrefix(*i.ContactPinHasPrefix))
+	if i.AvatarIDHasPrefix != nil {
+		predicates = append(predicates, user.AvatarIDHasPrefix(*i.AvatarIDHasPrefix))
 	}
-	if i.ContactPinHasSuffix != nil {
-		predicates = append(predicates, user.ContactPinHasSuffix(*i.ContactPinHasSuffix))
+	if i.AvatarIDHasSuffix != nil {
+		predicates = append(predicates, user.AvatarIDHasSuffix(*i.AvatarIDHasSuffix))
 	}
-	if i.ContactPinIsNil {
-		predicates = append(predicates, user.ContactPinIsNil())
+	if i.AvatarIDIsNil {
+		predicates = append(predicates, user.AvatarIDIsNil())
 	}
-	if i.ContactPinNotNil {
-		predicates = append(predicates, user.ContactPinNotNil())
+	if i.AvatarIDNotNil {
+		predicates = append(predicates, user.AvatarIDNotNil())
 	}
-	if i.ContactPinEqualFold != nil {
-		predicates = append(predicates, user.ContactPinEqualFold(*i.ContactPinEqualFold))
+	if i.AvatarIDEqualFold != nil {
+		predicates = append(predicates, user.AvatarIDEqualFold(*i.AvatarIDEqualFold))
 	}
-	if i.ContactPinContainsFold != nil {
-		predicates = append(predicates, user.ContactPinContainsFold(*i.ContactPinContainsFold))
+	if i.AvatarIDContainsFold != nil {
+		predicates = append(predicates, user.AvatarIDContainsFold(*i.AvatarIDContainsFold))
 	}
 	if i.CreatedAt != nil {
 		predicates = append(predicates, user.CreatedAtEQ(*i.CreatedAt))
@@ -4129,24 +8114,6 @@ func (i *UserWhereInput) P() (predicate.User, error) {
 		}
 		predicates = append(predicates, user.HasDeviceWith(with...))
 	}
-	if i.HasNotifications != nil {
-		p := user.HasNotifications()
-		if !*i.HasNotifications {
-			p = user.Not(p)
-		}
-		predicates = append(predicates, p)
-	}
-	if len(i.HasNotificationsWith) > 0 {
-		with := make([]predicate.Notification, 0, len(i.HasNotificationsWith))
-		for _, w := range i.HasNotificationsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasNotificationsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, user.HasNotificationsWith(with...))
-	}
 	if i.HasContacts != nil {
 		p := user.HasContacts()
 		if !*i.HasContacts {
@@ -4201,6 +8168,42 @@ func (i *UserWhereInput) P() (predicate.User, error) {
 		}
 		predicates = append(predicates, user.HasMessagesWith(with...))
 	}
+	if i.HasAvatar != nil {
+		p := user.HasAvatar()
+		if !*i.HasAvatar {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasAvatarWith) > 0 {
+		with := make([]predicate.File, 0, len(i.HasAvatarWith))
+		for _, w := range i.HasAvatarWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAvatarWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasAvatarWith(with...))
+	}
+	if i.HasAvatars != nil {
+		p := user.HasAvatars()
+		if !*i.HasAvatars {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasAvatarsWith) > 0 {
+		with := make([]predicate.Avatar, 0, len(i.HasAvatarsWith))
+		for _, w := range i.HasAvatarsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAvatarsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasAvatarsWith(with...))
+	}
 	if i.HasUserContacts != nil {
 		p := user.HasUserContacts()
 		if !*i.HasUserContacts {
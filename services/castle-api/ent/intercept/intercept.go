@@ -7,6 +7,11 @@ import (
 	"fmt"
 
 	"journeyhub/ent"
+	"journeyhub/ent/avatar"
+	"journeyhub/ent/call"
+	"journeyhub/ent/callparticipant"
+	"journeyhub/ent/contactpin"
+	"journeyhub/ent/contactrequest"
 	"journeyhub/ent/device"
 	"journeyhub/ent/file"
 	"journeyhub/ent/message"
@@ -14,10 +19,14 @@ import (
 	"journeyhub/ent/messagelink"
 	"journeyhub/ent/messagevoice"
 	"journeyhub/ent/notification"
+	"journeyhub/ent/outboxmessage"
 	"journeyhub/ent/predicate"
+	"journeyhub/ent/report"
 	"journeyhub/ent/room"
+	"journeyhub/ent/roominvite"
 	"journeyhub/ent/roommember"
 	"journeyhub/ent/user"
+	"journeyhub/ent/userblock"
 	"journeyhub/ent/usercontact"
 
 	"entgo.io/ent/dialect/sql"
@@ -79,6 +88,141 @@ func (f TraverseFunc) Traverse(ctx context.Context, q ent.Query) error {
 	return f(ctx, query)
 }
 
+// The AvatarFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AvatarFunc func(context.Context, *ent.AvatarQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AvatarFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AvatarQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AvatarQuery", q)
+}
+
+// The TraverseAvatar type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAvatar func(context.Context, *ent.AvatarQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAvatar) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAvatar) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AvatarQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AvatarQuery", q)
+}
+
+// The CallFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CallFunc func(context.Context, *ent.CallQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CallFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CallQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CallQuery", q)
+}
+
+// The TraverseCall type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCall func(context.Context, *ent.CallQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCall) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCall) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CallQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CallQuery", q)
+}
+
+// The CallParticipantFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CallParticipantFunc func(context.Context, *ent.CallParticipantQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CallParticipantFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CallParticipantQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CallParticipantQuery", q)
+}
+
+// The TraverseCallParticipant type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCallParticipant func(context.Context, *ent.CallParticipantQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCallParticipant) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCallParticipant) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CallParticipantQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CallParticipantQuery", q)
+}
+
+// The ContactPinFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ContactPinFunc func(context.Context, *ent.ContactPinQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ContactPinFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ContactPinQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ContactPinQuery", q)
+}
+
+// The TraverseContactPin type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseContactPin func(context.Context, *ent.ContactPinQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseContactPin) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseContactPin) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ContactPinQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ContactPinQuery", q)
+}
+
+// The ContactRequestFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ContactRequestFunc func(context.Context, *ent.ContactRequestQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ContactRequestFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ContactRequestQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ContactRequestQuery", q)
+}
+
+// The TraverseContactRequest type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseContactRequest func(context.Context, *ent.ContactRequestQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseContactRequest) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseContactRequest) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ContactRequestQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ContactRequestQuery", q)
+}
+
 // The DeviceFunc type is an adapter to allow the use of ordinary function as a Querier.
 type DeviceFunc func(context.Context, *ent.DeviceQuery) (ent.Value, error)
 
@@ -268,6 +412,60 @@ func (f TraverseNotification) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.NotificationQuery", q)
 }
 
+// The OutboxMessageFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OutboxMessageFunc func(context.Context, *ent.OutboxMessageQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f OutboxMessageFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.OutboxMessageQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.OutboxMessageQuery", q)
+}
+
+// The TraverseOutboxMessage type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOutboxMessage func(context.Context, *ent.OutboxMessageQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOutboxMessage) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOutboxMessage) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OutboxMessageQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.OutboxMessageQuery", q)
+}
+
+// The ReportFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ReportFunc func(context.Context, *ent.ReportQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ReportFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ReportQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ReportQuery", q)
+}
+
+// The TraverseReport type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseReport func(context.Context, *ent.ReportQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseReport) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseReport) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ReportQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ReportQuery", q)
+}
+
 // The RoomFunc type is an adapter to allow the use of ordinary function as a Querier.
 type RoomFunc func(context.Context, *ent.RoomQuery) (ent.Value, error)
 
@@ -295,6 +493,33 @@ func (f TraverseRoom) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.RoomQuery", q)
 }
 
+// The RoomInviteFunc type is an adapter to allow the use of ordinary function as a Querier.
+type RoomInviteFunc func(context.Context, *ent.RoomInviteQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f RoomInviteFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.RoomInviteQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.RoomInviteQuery", q)
+}
+
+// The TraverseRoomInvite type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseRoomInvite func(context.Context, *ent.RoomInviteQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseRoomInvite) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseRoomInvite) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RoomInviteQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.RoomInviteQuery", q)
+}
+
 // The RoomMemberFunc type is an adapter to allow the use of ordinary function as a Querier.
 type RoomMemberFunc func(context.Context, *ent.RoomMemberQuery) (ent.Value, error)
 
@@ -349,6 +574,33 @@ func (f TraverseUser) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.UserQuery", q)
 }
 
+// The UserBlockFunc type is an adapter to allow the use of ordinary function as a Querier.
+type UserBlockFunc func(context.Context, *ent.UserBlockQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f UserBlockFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.UserBlockQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.UserBlockQuery", q)
+}
+
+// The TraverseUserBlock type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseUserBlock func(context.Context, *ent.UserBlockQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseUserBlock) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseUserBlock) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserBlockQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.UserBlockQuery", q)
+}
+
 // The UserContactFunc type is an adapter to allow the use of ordinary function as a Querier.
 type UserContactFunc func(context.Context, *ent.UserContactQuery) (ent.Value, error)
 
@@ -379,6 +631,16 @@ func (f TraverseUserContact) Traverse(ctx context.Context, q ent.Query) error {
 // NewQuery returns the generic Query interface for the given typed query.
 func NewQuery(q ent.Query) (Query, error) {
 	switch q := q.(type) {
+	case *ent.AvatarQuery:
+		return &query[*ent.AvatarQuery, predicate.Avatar, avatar.OrderOption]{typ: ent.TypeAvatar, tq: q}, nil
+	case *ent.CallQuery:
+		return &query[*ent.CallQuery, predicate.Call, call.OrderOption]{typ: ent.TypeCall, tq: q}, nil
+	case *ent.CallParticipantQuery:
+		return &query[*ent.CallParticipantQuery, predicate.CallParticipant, callparticipant.OrderOption]{typ: ent.TypeCallParticipant, tq: q}, nil
+	case *ent.ContactPinQuery:
+		return &query[*ent.ContactPinQuery, predicate.ContactPin, contactpin.OrderOption]{typ: ent.TypeContactPin, tq: q}, nil
+	case *ent.ContactRequestQuery:
+		return &query[*ent.ContactRequestQuery, predicate.ContactRequest, contactrequest.OrderOption]{typ: ent.TypeContactRequest, tq: q}, nil
 	case *ent.DeviceQuery:
 		return &query[*ent.DeviceQuery, predicate.Device, device.OrderOption]{typ: ent.TypeDevice, tq: q}, nil
 	case *ent.FileQuery:
@@ -393,12 +655,20 @@ func NewQuery(q ent.Query) (Query, error) {
 		return &query[*ent.MessageVoiceQuery, predicate.MessageVoice, messagevoice.OrderOption]{typ: ent.TypeMessageVoice, tq: q}, nil
 	case *ent.NotificationQuery:
 		return &query[*ent.NotificationQuery, predicate.Notification, notification.OrderOption]{typ: ent.TypeNotification, tq: q}, nil
+	case *ent.OutboxMessageQuery:
+		return &query[*ent.OutboxMessageQuery, predicate.OutboxMessage, outboxmessage.OrderOption]{typ: ent.TypeOutboxMessage, tq: q}, nil
+	case *ent.ReportQuery:
+		return &query[*ent.ReportQuery, predicate.Report, report.OrderOption]{typ: ent.TypeReport, tq: q}, nil
 	case *ent.RoomQuery:
 		return &query[*ent.RoomQuery, predicate.Room, room.OrderOption]{typ: ent.TypeRoom, tq: q}, nil
+	case *ent.RoomInviteQuery:
+		return &query[*ent.RoomInviteQuery, predicate.RoomInvite, roominvite.OrderOption]{typ: ent.TypeRoomInvite, tq: q}, nil
 	case *ent.RoomMemberQuery:
 		return &query[*ent.RoomMemberQuery, predicate.RoomMember, roommember.OrderOption]{typ: ent.TypeRoomMember, tq: q}, nil
 	case *ent.UserQuery:
 		return &query[*ent.UserQuery, predicate.User, user.OrderOption]{typ: ent.TypeUser, tq: q}, nil
+	case *ent.UserBlockQuery:
+		return &query[*ent.UserBlockQuery, predicate.UserBlock, userblock.OrderOption]{typ: ent.TypeUserBlock, tq: q}, nil
 	case *ent.UserContactQuery:
 		return &query[*ent.UserContactQuery, predicate.UserContact, usercontact.OrderOption]{typ: ent.TypeUserContact, tq: q}, nil
 	default:
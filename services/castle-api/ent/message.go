@@ -4,6 +4,8 @@ package ent
 
 import (
 	"fmt"
+	"journeyhub/ent/avatar"
+	"journeyhub/ent/call"
 	"journeyhub/ent/message"
 	"journeyhub/ent/messagevoice"
 	"journeyhub/ent/room"
@@ -21,8 +23,14 @@ type Message struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID pulid.ID `json:"id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type message.Type `json:"type,omitempty"`
 	// Content holds the value of the "content" field.
 	Content string `json:"content,omitempty"`
+	// CallID holds the value of the "call_id" field.
+	CallID *pulid.ID `json:"call_id,omitempty"`
+	// AvatarID holds the value of the "avatar_id" field.
+	AvatarID *pulid.ID `json:"avatar_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -48,15 +56,19 @@ type MessageEdges struct {
 	Attachments []*MessageAttachment `json:"attachments,omitempty"`
 	// Links holds the value of the links edge.
 	Links []*MessageLink `json:"links,omitempty"`
+	// Call holds the value of the call edge.
+	Call *Call `json:"call,omitempty"`
+	// Avatar holds the value of the avatar edge.
+	Avatar *Avatar `json:"avatar,omitempty"`
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
 	// Room holds the value of the room edge.
 	Room *Room `json:"room,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [9]bool
 	// totalCount holds the count of the edges above.
-	totalCount [7]map[string]int
+	totalCount [9]map[string]int
 
 	namedReplies     map[string][]*Message
 	namedAttachments map[string][]*MessageAttachment
@@ -112,12 +124,34 @@ func (e MessageEdges) LinksOrErr() ([]*MessageLink, error) {
 	return nil, &NotLoadedError{edge: "links"}
 }
 
+// CallOrErr returns the Call value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) CallOrErr() (*Call, error) {
+	if e.Call != nil {
+		return e.Call, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: call.Label}
+	}
+	return nil, &NotLoadedError{edge: "call"}
+}
+
+// AvatarOrErr returns the Avatar value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) AvatarOrErr() (*Avatar, error) {
+	if e.Avatar != nil {
+		return e.Avatar, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: avatar.Label}
+	}
+	return nil, &NotLoadedError{edge: "avatar"}
+}
+
 // UserOrErr returns the User value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e MessageEdges) UserOrErr() (*User, error) {
 	if e.User != nil {
 		return e.User, nil
-	} else if e.loadedTypes[5] {
+	} else if e.loadedTypes[7] {
 		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "user"}
@@ -128,7 +162,7 @@ func (e MessageEdges) UserOrErr() (*User, error) {
 func (e MessageEdges) RoomOrErr() (*Room, error) {
 	if e.Room != nil {
 		return e.Room, nil
-	} else if e.loadedTypes[6] {
+	} else if e.loadedTypes[8] {
 		return nil, &NotFoundError{label: room.Label}
 	}
 	return nil, &NotLoadedError{edge: "room"}
@@ -139,9 +173,11 @@ func (*Message) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case message.FieldCallID, message.FieldAvatarID:
+			values[i] = &sql.NullScanner{S: new(pulid.ID)}
 		case message.FieldID:
 			values[i] = new(pulid.ID)
-		case message.FieldContent:
+		case message.FieldType, message.FieldContent:
 			values[i] = new(sql.NullString)
 		case message.FieldCreatedAt, message.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -172,12 +208,32 @@ func (m *Message) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				m.ID = *value
 			}
+		case message.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				m.Type = message.Type(value.String)
+			}
 		case message.FieldContent:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field content", values[i])
 			} else if value.Valid {
 				m.Content = value.String
 			}
+		case message.FieldCallID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field call_id", values[i])
+			} else if value.Valid {
+				m.CallID = new(pulid.ID)
+				*m.CallID = *value.S.(*pulid.ID)
+			}
+		case message.FieldAvatarID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field avatar_id", values[i])
+			} else if value.Valid {
+				m.AvatarID = new(pulid.ID)
+				*m.AvatarID = *value.S.(*pulid.ID)
+			}
 		case message.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -249,6 +305,16 @@ func (m *Message) QueryLinks() *MessageLinkQuery {
 	return NewMessageClient(m.config).QueryLinks(m)
 }
 
+// QueryCall queries the "call" edge of the Message entity.
+func (m *Message) QueryCall() *CallQuery {
+	return NewMessageClient(m.config).QueryCall(m)
+}
+
+// QueryAvatar queries the "avatar" edge of the Message entity.
+func (m *Message) QueryAvatar() *AvatarQuery {
+	return NewMessageClient(m.config).QueryAvatar(m)
+}
+
 // QueryUser queries the "user" edge of the Message entity.
 func (m *Message) QueryUser() *UserQuery {
 	return NewMessageClient(m.config).QueryUser(m)
@@ -282,9 +348,22 @@ func (m *Message) String() string {
 	var builder strings.Builder
 	builder.WriteString("Message(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", m.ID))
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", m.Type))
+	builder.WriteString(", ")
 	builder.WriteString("content=")
 	builder.WriteString(m.Content)
 	builder.WriteString(", ")
+	if v := m.CallID; v != nil {
+		builder.WriteString("call_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := m.AvatarID; v != nil {
+		builder.WriteString("avatar_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -6,6 +6,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"journeyhub/ent/avatar"
+	"journeyhub/ent/call"
 	"journeyhub/ent/message"
 	"journeyhub/ent/messageattachment"
 	"journeyhub/ent/messagelink"
@@ -29,6 +31,20 @@ type MessageCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetType sets the "type" field.
+func (mc *MessageCreate) SetType(m message.Type) *MessageCreate {
+	mc.mutation.SetType(m)
+	return mc
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableType(m *message.Type) *MessageCreate {
+	if m != nil {
+		mc.SetType(*m)
+	}
+	return mc
+}
+
 // SetContent sets the "content" field.
 func (mc *MessageCreate) SetContent(s string) *MessageCreate {
 	mc.mutation.SetContent(s)
@@ -43,6 +59,34 @@ func (mc *MessageCreate) SetNillableContent(s *string) *MessageCreate {
 	return mc
 }
 
+// SetCallID sets the "call_id" field.
+func (mc *MessageCreate) SetCallID(pu pulid.ID) *MessageCreate {
+	mc.mutation.SetCallID(pu)
+	return mc
+}
+
+// SetNillableCallID sets the "call_id" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableCallID(pu *pulid.ID) *MessageCreate {
+	if pu != nil {
+		mc.SetCallID(*pu)
+	}
+	return mc
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (mc *MessageCreate) SetAvatarID(pu pulid.ID) *MessageCreate {
+	mc.mutation.SetAvatarID(pu)
+	return mc
+}
+
+// SetNillableAvatarID sets the "avatar_id" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableAvatarID(pu *pulid.ID) *MessageCreate {
+	if pu != nil {
+		mc.SetAvatarID(*pu)
+	}
+	return mc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (mc *MessageCreate) SetCreatedAt(t time.Time) *MessageCreate {
 	mc.mutation.SetCreatedAt(t)
@@ -168,6 +212,16 @@ func (mc *MessageCreate) AddLinks(m ...*MessageLink) *MessageCreate {
 	return mc.AddLinkIDs(ids...)
 }
 
+// SetCall sets the "call" edge to the Call entity.
+func (mc *MessageCreate) SetCall(c *Call) *MessageCreate {
+	return mc.SetCallID(c.ID)
+}
+
+// SetAvatar sets the "avatar" edge to the Avatar entity.
+func (mc *MessageCreate) SetAvatar(a *Avatar) *MessageCreate {
+	return mc.SetAvatarID(a.ID)
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (mc *MessageCreate) SetUserID(id pulid.ID) *MessageCreate {
 	mc.mutation.SetUserID(id)
@@ -241,6 +295,10 @@ func (mc *MessageCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (mc *MessageCreate) defaults() {
+	if _, ok := mc.mutation.GetType(); !ok {
+		v := message.DefaultType
+		mc.mutation.SetType(v)
+	}
 	if _, ok := mc.mutation.CreatedAt(); !ok {
 		v := message.DefaultCreatedAt()
 		mc.mutation.SetCreatedAt(v)
@@ -257,6 +315,14 @@ func (mc *MessageCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (mc *MessageCreate) check() error {
+	if _, ok := mc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Message.type"`)}
+	}
+	if v, ok := mc.mutation.GetType(); ok {
+		if err := message.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Message.type": %w`, err)}
+		}
+	}
 	if _, ok := mc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Message.created_at"`)}
 	}
@@ -299,6 +365,10 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := mc.mutation.GetType(); ok {
+		_spec.SetField(message.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
 	if value, ok := mc.mutation.Content(); ok {
 		_spec.SetField(message.FieldContent, field.TypeString, value)
 		_node.Content = value
@@ -392,6 +462,40 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := mc.mutation.CallIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.CallTable,
+			Columns: []string{message.CallColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CallID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.AvatarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.AvatarTable,
+			Columns: []string{message.AvatarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AvatarID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := mc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -433,7 +537,7 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.Message.Create().
-//		SetContent(v).
+//		SetType(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -442,7 +546,7 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.MessageUpsert) {
-//			SetContent(v+v).
+//			SetType(v+v).
 //		}).
 //		Exec(ctx)
 func (mc *MessageCreate) OnConflict(opts ...sql.ConflictOption) *MessageUpsertOne {
@@ -478,6 +582,18 @@ type (
 	}
 )
 
+// SetType sets the "type" field.
+func (u *MessageUpsert) SetType(v message.Type) *MessageUpsert {
+	u.Set(message.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateType() *MessageUpsert {
+	u.SetExcluded(message.FieldType)
+	return u
+}
+
 // SetContent sets the "content" field.
 func (u *MessageUpsert) SetContent(v string) *MessageUpsert {
 	u.Set(message.FieldContent, v)
@@ -496,6 +612,42 @@ func (u *MessageUpsert) ClearContent() *MessageUpsert {
 	return u
 }
 
+// SetCallID sets the "call_id" field.
+func (u *MessageUpsert) SetCallID(v pulid.ID) *MessageUpsert {
+	u.Set(message.FieldCallID, v)
+	return u
+}
+
+// UpdateCallID sets the "call_id" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateCallID() *MessageUpsert {
+	u.SetExcluded(message.FieldCallID)
+	return u
+}
+
+// ClearCallID clears the value of the "call_id" field.
+func (u *MessageUpsert) ClearCallID() *MessageUpsert {
+	u.SetNull(message.FieldCallID)
+	return u
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (u *MessageUpsert) SetAvatarID(v pulid.ID) *MessageUpsert {
+	u.Set(message.FieldAvatarID, v)
+	return u
+}
+
+// UpdateAvatarID sets the "avatar_id" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateAvatarID() *MessageUpsert {
+	u.SetExcluded(message.FieldAvatarID)
+	return u
+}
+
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (u *MessageUpsert) ClearAvatarID() *MessageUpsert {
+	u.SetNull(message.FieldAvatarID)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *MessageUpsert) SetUpdatedAt(v time.Time) *MessageUpsert {
 	u.Set(message.FieldUpdatedAt, v)
@@ -559,6 +711,20 @@ func (u *MessageUpsertOne) Update(set func(*MessageUpsert)) *MessageUpsertOne {
 	return u
 }
 
+// SetType sets the "type" field.
+func (u *MessageUpsertOne) SetType(v message.Type) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateType() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateType()
+	})
+}
+
 // SetContent sets the "content" field.
 func (u *MessageUpsertOne) SetContent(v string) *MessageUpsertOne {
 	return u.Update(func(s *MessageUpsert) {
@@ -580,6 +746,48 @@ func (u *MessageUpsertOne) ClearContent() *MessageUpsertOne {
 	})
 }
 
+// SetCallID sets the "call_id" field.
+func (u *MessageUpsertOne) SetCallID(v pulid.ID) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetCallID(v)
+	})
+}
+
+// UpdateCallID sets the "call_id" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateCallID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateCallID()
+	})
+}
+
+// ClearCallID clears the value of the "call_id" field.
+func (u *MessageUpsertOne) ClearCallID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearCallID()
+	})
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (u *MessageUpsertOne) SetAvatarID(v pulid.ID) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetAvatarID(v)
+	})
+}
+
+// UpdateAvatarID sets the "avatar_id" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateAvatarID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateAvatarID()
+	})
+}
+
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (u *MessageUpsertOne) ClearAvatarID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearAvatarID()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *MessageUpsertOne) SetUpdatedAt(v time.Time) *MessageUpsertOne {
 	return u.Update(func(s *MessageUpsert) {
@@ -730,7 +938,7 @@ func (mcb *MessageCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.MessageUpsert) {
-//			SetContent(v+v).
+//			SetType(v+v).
 //		}).
 //		Exec(ctx)
 func (mcb *MessageCreateBulk) OnConflict(opts ...sql.ConflictOption) *MessageUpsertBulk {
@@ -812,6 +1020,20 @@ func (u *MessageUpsertBulk) Update(set func(*MessageUpsert)) *MessageUpsertBulk
 	return u
 }
 
+// SetType sets the "type" field.
+func (u *MessageUpsertBulk) SetType(v message.Type) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateType() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateType()
+	})
+}
+
 // SetContent sets the "content" field.
 func (u *MessageUpsertBulk) SetContent(v string) *MessageUpsertBulk {
 	return u.Update(func(s *MessageUpsert) {
@@ -833,6 +1055,48 @@ func (u *MessageUpsertBulk) ClearContent() *MessageUpsertBulk {
 	})
 }
 
+// SetCallID sets the "call_id" field.
+func (u *MessageUpsertBulk) SetCallID(v pulid.ID) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetCallID(v)
+	})
+}
+
+// UpdateCallID sets the "call_id" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateCallID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateCallID()
+	})
+}
+
+// ClearCallID clears the value of the "call_id" field.
+func (u *MessageUpsertBulk) ClearCallID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearCallID()
+	})
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (u *MessageUpsertBulk) SetAvatarID(v pulid.ID) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetAvatarID(v)
+	})
+}
+
+// UpdateAvatarID sets the "avatar_id" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateAvatarID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateAvatarID()
+	})
+}
+
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (u *MessageUpsertBulk) ClearAvatarID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearAvatarID()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *MessageUpsertBulk) SetUpdatedAt(v time.Time) *MessageUpsertBulk {
 	return u.Update(func(s *MessageUpsert) {
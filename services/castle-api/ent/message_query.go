@@ -6,6 +6,8 @@ import (
 	"context"
 	"database/sql/driver"
 	"fmt"
+	"journeyhub/ent/avatar"
+	"journeyhub/ent/call"
 	"journeyhub/ent/message"
 	"journeyhub/ent/messageattachment"
 	"journeyhub/ent/messagelink"
@@ -34,6 +36,8 @@ type MessageQuery struct {
 	withReplies          *MessageQuery
 	withAttachments      *MessageAttachmentQuery
 	withLinks            *MessageLinkQuery
+	withCall             *CallQuery
+	withAvatar           *AvatarQuery
 	withUser             *UserQuery
 	withRoom             *RoomQuery
 	withFKs              bool
@@ -188,6 +192,50 @@ func (mq *MessageQuery) QueryLinks() *MessageLinkQuery {
 	return query
 }
 
+// QueryCall chains the current query on the "call" edge.
+func (mq *MessageQuery) QueryCall() *CallQuery {
+	query := (&CallClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(call.Table, call.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, message.CallTable, message.CallColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAvatar chains the current query on the "avatar" edge.
+func (mq *MessageQuery) QueryAvatar() *AvatarQuery {
+	query := (&AvatarClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(avatar.Table, avatar.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, message.AvatarTable, message.AvatarColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryUser chains the current query on the "user" edge.
 func (mq *MessageQuery) QueryUser() *UserQuery {
 	query := (&UserClient{config: mq.config}).Query()
@@ -429,6 +477,8 @@ func (mq *MessageQuery) Clone() *MessageQuery {
 		withReplies:     mq.withReplies.Clone(),
 		withAttachments: mq.withAttachments.Clone(),
 		withLinks:       mq.withLinks.Clone(),
+		withCall:        mq.withCall.Clone(),
+		withAvatar:      mq.withAvatar.Clone(),
 		withUser:        mq.withUser.Clone(),
 		withRoom:        mq.withRoom.Clone(),
 		// clone intermediate query.
@@ -492,6 +542,28 @@ func (mq *MessageQuery) WithLinks(opts ...func(*MessageLinkQuery)) *MessageQuery
 	return mq
 }
 
+// WithCall tells the query-builder to eager-load the nodes that are connected to
+// the "call" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithCall(opts ...func(*CallQuery)) *MessageQuery {
+	query := (&CallClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withCall = query
+	return mq
+}
+
+// WithAvatar tells the query-builder to eager-load the nodes that are connected to
+// the "avatar" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithAvatar(opts ...func(*AvatarQuery)) *MessageQuery {
+	query := (&AvatarClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withAvatar = query
+	return mq
+}
+
 // WithUser tells the query-builder to eager-load the nodes that are connected to
 // the "user" edge. The optional arguments are used to configure the query builder of the edge.
 func (mq *MessageQuery) WithUser(opts ...func(*UserQuery)) *MessageQuery {
@@ -520,12 +592,12 @@ func (mq *MessageQuery) WithRoom(opts ...func(*RoomQuery)) *MessageQuery {
 // Example:
 //
 //	var v []struct {
-//		Content string `json:"content,omitempty"`
+//		Type message.Type `json:"type,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Message.Query().
-//		GroupBy(message.FieldContent).
+//		GroupBy(message.FieldType).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (mq *MessageQuery) GroupBy(field string, fields ...string) *MessageGroupBy {
@@ -543,11 +615,11 @@ func (mq *MessageQuery) GroupBy(field string, fields ...string) *MessageGroupBy
 // Example:
 //
 //	var v []struct {
-//		Content string `json:"content,omitempty"`
+//		Type message.Type `json:"type,omitempty"`
 //	}
 //
 //	client.Message.Query().
-//		Select(message.FieldContent).
+//		Select(message.FieldType).
 //		Scan(ctx, &v)
 func (mq *MessageQuery) Select(fields ...string) *MessageSelect {
 	mq.ctx.Fields = append(mq.ctx.Fields, fields...)
@@ -593,12 +665,14 @@ func (mq *MessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Mess
 		nodes       = []*Message{}
 		withFKs     = mq.withFKs
 		_spec       = mq.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [9]bool{
 			mq.withVoice != nil,
 			mq.withReplyTo != nil,
 			mq.withReplies != nil,
 			mq.withAttachments != nil,
 			mq.withLinks != nil,
+			mq.withCall != nil,
+			mq.withAvatar != nil,
 			mq.withUser != nil,
 			mq.withRoom != nil,
 		}
@@ -663,6 +737,18 @@ func (mq *MessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Mess
 			return nil, err
 		}
 	}
+	if query := mq.withCall; query != nil {
+		if err := mq.loadCall(ctx, query, nodes, nil,
+			func(n *Message, e *Call) { n.Edges.Call = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withAvatar; query != nil {
+		if err := mq.loadAvatar(ctx, query, nodes, nil,
+			func(n *Message, e *Avatar) { n.Edges.Avatar = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := mq.withUser; query != nil {
 		if err := mq.loadUser(ctx, query, nodes, nil,
 			func(n *Message, e *User) { n.Edges.User = e }); err != nil {
@@ -857,6 +943,70 @@ func (mq *MessageQuery) loadLinks(ctx context.Context, query *MessageLinkQuery,
 	}
 	return nil
 }
+func (mq *MessageQuery) loadCall(ctx context.Context, query *CallQuery, nodes []*Message, init func(*Message), assign func(*Message, *Call)) error {
+	ids := make([]pulid.ID, 0, len(nodes))
+	nodeids := make(map[pulid.ID][]*Message)
+	for i := range nodes {
+		if nodes[i].CallID == nil {
+			continue
+		}
+		fk := *nodes[i].CallID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(call.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "call_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (mq *MessageQuery) loadAvatar(ctx context.Context, query *AvatarQuery, nodes []*Message, init func(*Message), assign func(*Message, *Avatar)) error {
+	ids := make([]pulid.ID, 0, len(nodes))
+	nodeids := make(map[pulid.ID][]*Message)
+	for i := range nodes {
+		if nodes[i].AvatarID == nil {
+			continue
+		}
+		fk := *nodes[i].AvatarID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(avatar.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "avatar_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (mq *MessageQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*Message, init func(*Message), assign func(*Message, *User)) error {
 	ids := make([]pulid.ID, 0, len(nodes))
 	nodeids := make(map[pulid.ID][]*Message)
@@ -950,6 +1100,12 @@ func (mq *MessageQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if mq.withCall != nil {
+			_spec.Node.AddColumnOnce(message.FieldCallID)
+		}
+		if mq.withAvatar != nil {
+			_spec.Node.AddColumnOnce(message.FieldAvatarID)
+		}
 	}
 	if ps := mq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -6,6 +6,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"journeyhub/ent/avatar"
+	"journeyhub/ent/call"
 	"journeyhub/ent/message"
 	"journeyhub/ent/messageattachment"
 	"journeyhub/ent/messagelink"
@@ -34,6 +36,20 @@ func (mu *MessageUpdate) Where(ps ...predicate.Message) *MessageUpdate {
 	return mu
 }
 
+// SetType sets the "type" field.
+func (mu *MessageUpdate) SetType(m message.Type) *MessageUpdate {
+	mu.mutation.SetType(m)
+	return mu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableType(m *message.Type) *MessageUpdate {
+	if m != nil {
+		mu.SetType(*m)
+	}
+	return mu
+}
+
 // SetContent sets the "content" field.
 func (mu *MessageUpdate) SetContent(s string) *MessageUpdate {
 	mu.mutation.SetContent(s)
@@ -54,6 +70,46 @@ func (mu *MessageUpdate) ClearContent() *MessageUpdate {
 	return mu
 }
 
+// SetCallID sets the "call_id" field.
+func (mu *MessageUpdate) SetCallID(pu pulid.ID) *MessageUpdate {
+	mu.mutation.SetCallID(pu)
+	return mu
+}
+
+// SetNillableCallID sets the "call_id" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableCallID(pu *pulid.ID) *MessageUpdate {
+	if pu != nil {
+		mu.SetCallID(*pu)
+	}
+	return mu
+}
+
+// ClearCallID clears the value of the "call_id" field.
+func (mu *MessageUpdate) ClearCallID() *MessageUpdate {
+	mu.mutation.ClearCallID()
+	return mu
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (mu *MessageUpdate) SetAvatarID(pu pulid.ID) *MessageUpdate {
+	mu.mutation.SetAvatarID(pu)
+	return mu
+}
+
+// SetNillableAvatarID sets the "avatar_id" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableAvatarID(pu *pulid.ID) *MessageUpdate {
+	if pu != nil {
+		mu.SetAvatarID(*pu)
+	}
+	return mu
+}
+
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (mu *MessageUpdate) ClearAvatarID() *MessageUpdate {
+	mu.mutation.ClearAvatarID()
+	return mu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (mu *MessageUpdate) SetUpdatedAt(t time.Time) *MessageUpdate {
 	mu.mutation.SetUpdatedAt(t)
@@ -143,6 +199,16 @@ func (mu *MessageUpdate) AddLinks(m ...*MessageLink) *MessageUpdate {
 	return mu.AddLinkIDs(ids...)
 }
 
+// SetCall sets the "call" edge to the Call entity.
+func (mu *MessageUpdate) SetCall(c *Call) *MessageUpdate {
+	return mu.SetCallID(c.ID)
+}
+
+// SetAvatar sets the "avatar" edge to the Avatar entity.
+func (mu *MessageUpdate) SetAvatar(a *Avatar) *MessageUpdate {
+	return mu.SetAvatarID(a.ID)
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (mu *MessageUpdate) SetUserID(id pulid.ID) *MessageUpdate {
 	mu.mutation.SetUserID(id)
@@ -261,6 +327,18 @@ func (mu *MessageUpdate) RemoveLinks(m ...*MessageLink) *MessageUpdate {
 	return mu.RemoveLinkIDs(ids...)
 }
 
+// ClearCall clears the "call" edge to the Call entity.
+func (mu *MessageUpdate) ClearCall() *MessageUpdate {
+	mu.mutation.ClearCall()
+	return mu
+}
+
+// ClearAvatar clears the "avatar" edge to the Avatar entity.
+func (mu *MessageUpdate) ClearAvatar() *MessageUpdate {
+	mu.mutation.ClearAvatar()
+	return mu
+}
+
 // ClearUser clears the "user" edge to the User entity.
 func (mu *MessageUpdate) ClearUser() *MessageUpdate {
 	mu.mutation.ClearUser()
@@ -309,7 +387,20 @@ func (mu *MessageUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (mu *MessageUpdate) check() error {
+	if v, ok := mu.mutation.GetType(); ok {
+		if err := message.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Message.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(message.Table, message.Columns, sqlgraph.NewFieldSpec(message.FieldID, field.TypeString))
 	if ps := mu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -318,6 +409,9 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := mu.mutation.GetType(); ok {
+		_spec.SetField(message.FieldType, field.TypeEnum, value)
+	}
 	if value, ok := mu.mutation.Content(); ok {
 		_spec.SetField(message.FieldContent, field.TypeString, value)
 	}
@@ -520,6 +614,64 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if mu.mutation.CallCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.CallTable,
+			Columns: []string{message.CallColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.CallIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.CallTable,
+			Columns: []string{message.CallColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.AvatarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.AvatarTable,
+			Columns: []string{message.AvatarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.AvatarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.AvatarTable,
+			Columns: []string{message.AvatarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if mu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -598,6 +750,20 @@ type MessageUpdateOne struct {
 	mutation *MessageMutation
 }
 
+// SetType sets the "type" field.
+func (muo *MessageUpdateOne) SetType(m message.Type) *MessageUpdateOne {
+	muo.mutation.SetType(m)
+	return muo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableType(m *message.Type) *MessageUpdateOne {
+	if m != nil {
+		muo.SetType(*m)
+	}
+	return muo
+}
+
 // SetContent sets the "content" field.
 func (muo *MessageUpdateOne) SetContent(s string) *MessageUpdateOne {
 	muo.mutation.SetContent(s)
@@ -618,6 +784,46 @@ func (muo *MessageUpdateOne) ClearContent() *MessageUpdateOne {
 	return muo
 }
 
+// SetCallID sets the "call_id" field.
+func (muo *MessageUpdateOne) SetCallID(pu pulid.ID) *MessageUpdateOne {
+	muo.mutation.SetCallID(pu)
+	return muo
+}
+
+// SetNillableCallID sets the "call_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableCallID(pu *pulid.ID) *MessageUpdateOne {
+	if pu != nil {
+		muo.SetCallID(*pu)
+	}
+	return muo
+}
+
+// ClearCallID clears the value of the "call_id" field.
+func (muo *MessageUpdateOne) ClearCallID() *MessageUpdateOne {
+	muo.mutation.ClearCallID()
+	return muo
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (muo *MessageUpdateOne) SetAvatarID(pu pulid.ID) *MessageUpdateOne {
+	muo.mutation.SetAvatarID(pu)
+	return muo
+}
+
+// SetNillableAvatarID sets the "avatar_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableAvatarID(pu *pulid.ID) *MessageUpdateOne {
+	if pu != nil {
+		muo.SetAvatarID(*pu)
+	}
+	return muo
+}
+
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (muo *MessageUpdateOne) ClearAvatarID() *MessageUpdateOne {
+	muo.mutation.ClearAvatarID()
+	return muo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (muo *MessageUpdateOne) SetUpdatedAt(t time.Time) *MessageUpdateOne {
 	muo.mutation.SetUpdatedAt(t)
@@ -707,6 +913,16 @@ func (muo *MessageUpdateOne) AddLinks(m ...*MessageLink) *MessageUpdateOne {
 	return muo.AddLinkIDs(ids...)
 }
 
+// SetCall sets the "call" edge to the Call entity.
+func (muo *MessageUpdateOne) SetCall(c *Call) *MessageUpdateOne {
+	return muo.SetCallID(c.ID)
+}
+
+// SetAvatar sets the "avatar" edge to the Avatar entity.
+func (muo *MessageUpdateOne) SetAvatar(a *Avatar) *MessageUpdateOne {
+	return muo.SetAvatarID(a.ID)
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (muo *MessageUpdateOne) SetUserID(id pulid.ID) *MessageUpdateOne {
 	muo.mutation.SetUserID(id)
@@ -825,6 +1041,18 @@ func (muo *MessageUpdateOne) RemoveLinks(m ...*MessageLink) *MessageUpdateOne {
 	return muo.RemoveLinkIDs(ids...)
 }
 
+// ClearCall clears the "call" edge to the Call entity.
+func (muo *MessageUpdateOne) ClearCall() *MessageUpdateOne {
+	muo.mutation.ClearCall()
+	return muo
+}
+
+// ClearAvatar clears the "avatar" edge to the Avatar entity.
+func (muo *MessageUpdateOne) ClearAvatar() *MessageUpdateOne {
+	muo.mutation.ClearAvatar()
+	return muo
+}
+
 // ClearUser clears the "user" edge to the User entity.
 func (muo *MessageUpdateOne) ClearUser() *MessageUpdateOne {
 	muo.mutation.ClearUser()
@@ -886,7 +1114,20 @@ func (muo *MessageUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (muo *MessageUpdateOne) check() error {
+	if v, ok := muo.mutation.GetType(); ok {
+		if err := message.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Message.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(message.Table, message.Columns, sqlgraph.NewFieldSpec(message.FieldID, field.TypeString))
 	id, ok := muo.mutation.ID()
 	if !ok {
@@ -912,6 +1153,9 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 			}
 		}
 	}
+	if value, ok := muo.mutation.GetType(); ok {
+		_spec.SetField(message.FieldType, field.TypeEnum, value)
+	}
 	if value, ok := muo.mutation.Content(); ok {
 		_spec.SetField(message.FieldContent, field.TypeString, value)
 	}
@@ -1114,6 +1358,64 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if muo.mutation.CallCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.CallTable,
+			Columns: []string{message.CallColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.CallIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.CallTable,
+			Columns: []string{message.CallColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.AvatarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.AvatarTable,
+			Columns: []string{message.AvatarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.AvatarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.AvatarTable,
+			Columns: []string{message.AvatarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if muo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
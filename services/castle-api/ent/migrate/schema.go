@@ -3,16 +3,213 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// AvatarsColumns holds the columns for the "avatars" table.
+	AvatarsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "file_id", Type: field.TypeString},
+		{Name: "room_id", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+	}
+	// AvatarsTable holds the schema information for the "avatars" table.
+	AvatarsTable = &schema.Table{
+		Name:       "avatars",
+		Columns:    AvatarsColumns,
+		PrimaryKey: []*schema.Column{AvatarsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "avatars_files_file",
+				Columns:    []*schema.Column{AvatarsColumns[2]},
+				RefColumns: []*schema.Column{FilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "avatars_rooms_avatars",
+				Columns:    []*schema.Column{AvatarsColumns[3]},
+				RefColumns: []*schema.Column{RoomsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "avatars_users_avatars",
+				Columns:    []*schema.Column{AvatarsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// CallsColumns holds the columns for the "calls" table.
+	CallsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"Audio", "Video"}},
+		{Name: "state", Type: field.TypeEnum, Enums: []string{"Ringing", "Active", "Ended", "Missed", "Declined"}, Default: "Ringing"},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "answered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "duration", Type: field.TypeInt, Default: 0},
+		{Name: "room_id", Type: field.TypeString},
+		{Name: "caller_id", Type: field.TypeString},
+	}
+	// CallsTable holds the schema information for the "calls" table.
+	CallsTable = &schema.Table{
+		Name:       "calls",
+		Columns:    CallsColumns,
+		PrimaryKey: []*schema.Column{CallsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "calls_rooms_room",
+				Columns:    []*schema.Column{CallsColumns[7]},
+				RefColumns: []*schema.Column{RoomsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "calls_users_caller",
+				Columns:    []*schema.Column{CallsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "call_room_id",
+				Unique:  true,
+				Columns: []*schema.Column{CallsColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "state IN ('Ringing', 'Active')",
+				},
+			},
+			{
+				Name:    "call_state_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{CallsColumns[2], CallsColumns[3]},
+			},
+		},
+	}
+	// CallParticipantsColumns holds the columns for the "call_participants" table.
+	CallParticipantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "state", Type: field.TypeEnum, Enums: []string{"Invited", "Joined", "Left", "Declined", "Missed"}, Default: "Invited"},
+		{Name: "invited_at", Type: field.TypeTime},
+		{Name: "joined_at", Type: field.TypeTime, Nullable: true},
+		{Name: "left_at", Type: field.TypeTime, Nullable: true},
+		{Name: "call_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// CallParticipantsTable holds the schema information for the "call_participants" table.
+	CallParticipantsTable = &schema.Table{
+		Name:       "call_participants",
+		Columns:    CallParticipantsColumns,
+		PrimaryKey: []*schema.Column{CallParticipantsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "call_participants_calls_participants",
+				Columns:    []*schema.Column{CallParticipantsColumns[5]},
+				RefColumns: []*schema.Column{CallsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "call_participants_users_user",
+				Columns:    []*schema.Column{CallParticipantsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "callparticipant_call_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{CallParticipantsColumns[5], CallParticipantsColumns[6]},
+			},
+		},
+	}
+	// ContactPinsColumns holds the columns for the "contact_pins" table.
+	ContactPinsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "code", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "max_uses", Type: field.TypeInt, Nullable: true},
+		{Name: "uses", Type: field.TypeInt, Default: 0},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// ContactPinsTable holds the schema information for the "contact_pins" table.
+	ContactPinsTable = &schema.Table{
+		Name:       "contact_pins",
+		Columns:    ContactPinsColumns,
+		PrimaryKey: []*schema.Column{ContactPinsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "contact_pins_users_user",
+				Columns:    []*schema.Column{ContactPinsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "contactpin_user_id_revoked_at",
+				Unique:  false,
+				Columns: []*schema.Column{ContactPinsColumns[7], ContactPinsColumns[5]},
+			},
+		},
+	}
+	// ContactRequestsColumns holds the columns for the "contact_requests" table.
+	ContactRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"Pending", "Accepted", "Declined"}, Default: "Pending"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "sender_id", Type: field.TypeString},
+		{Name: "receiver_id", Type: field.TypeString},
+	}
+	// ContactRequestsTable holds the schema information for the "contact_requests" table.
+	ContactRequestsTable = &schema.Table{
+		Name:       "contact_requests",
+		Columns:    ContactRequestsColumns,
+		PrimaryKey: []*schema.Column{ContactRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "contact_requests_users_sender",
+				Columns:    []*schema.Column{ContactRequestsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "contact_requests_users_receiver",
+				Columns:    []*schema.Column{ContactRequestsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "contactrequest_sender_id_receiver_id",
+				Unique:  true,
+				Columns: []*schema.Column{ContactRequestsColumns[4], ContactRequestsColumns[5]},
+			},
+			{
+				Name:    "contactrequest_receiver_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ContactRequestsColumns[5], ContactRequestsColumns[1]},
+			},
+		},
+	}
 	// DevicesColumns holds the columns for the "devices" table.
 	DevicesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
 		{Name: "device_id", Type: field.TypeString, Unique: true},
 		{Name: "fcm_token", Type: field.TypeString, Unique: true},
+		{Name: "platform", Type: field.TypeEnum, Enums: []string{"Android", "IOS", "Web"}, Default: "Android"},
+		{Name: "voip_token", Type: field.TypeString, Nullable: true},
+		{Name: "last_push_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_push_error", Type: field.TypeString, Nullable: true},
+		{Name: "invalidated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_device", Type: field.TypeString, Unique: true},
@@ -25,7 +222,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "devices_users_device",
-				Columns:    []*schema.Column{DevicesColumns[5]},
+				Columns:    []*schema.Column{DevicesColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -40,22 +237,50 @@ var (
 		{Name: "location", Type: field.TypeString, Nullable: true},
 		{Name: "bucket", Type: field.TypeString},
 		{Name: "path", Type: field.TypeString},
+		{Name: "width", Type: field.TypeInt, Nullable: true},
+		{Name: "height", Type: field.TypeInt, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "file_thumbnails", Type: field.TypeString, Nullable: true},
+		{Name: "room_id", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
 	}
 	// FilesTable holds the schema information for the "files" table.
 	FilesTable = &schema.Table{
 		Name:       "files",
 		Columns:    FilesColumns,
 		PrimaryKey: []*schema.Column{FilesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "files_files_thumbnails",
+				Columns:    []*schema.Column{FilesColumns[11]},
+				RefColumns: []*schema.Column{FilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "files_rooms_files",
+				Columns:    []*schema.Column{FilesColumns[12]},
+				RefColumns: []*schema.Column{RoomsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "files_users_files",
+				Columns:    []*schema.Column{FilesColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 	}
 	// MessagesColumns holds the columns for the "messages" table.
 	MessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"Text", "Call", "RoomAvatar"}, Default: "Text"},
 		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "message_replies", Type: field.TypeString, Nullable: true},
+		{Name: "call_id", Type: field.TypeString, Nullable: true},
+		{Name: "avatar_id", Type: field.TypeString, Nullable: true},
 		{Name: "room_messages", Type: field.TypeString, Nullable: true},
 		{Name: "user_messages", Type: field.TypeString, Nullable: true},
 	}
@@ -67,19 +292,31 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "messages_messages_replies",
-				Columns:    []*schema.Column{MessagesColumns[4]},
+				Columns:    []*schema.Column{MessagesColumns[5]},
 				RefColumns: []*schema.Column{MessagesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "messages_calls_call",
+				Columns:    []*schema.Column{MessagesColumns[6]},
+				RefColumns: []*schema.Column{CallsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "messages_avatars_avatar",
+				Columns:    []*schema.Column{MessagesColumns[7]},
+				RefColumns: []*schema.Column{AvatarsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
 			{
 				Symbol:     "messages_rooms_messages",
-				Columns:    []*schema.Column{MessagesColumns[5]},
+				Columns:    []*schema.Column{MessagesColumns[8]},
 				RefColumns: []*schema.Column{RoomsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "messages_users_messages",
-				Columns:    []*schema.Column{MessagesColumns[6]},
+				Columns:    []*schema.Column{MessagesColumns[9]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -191,9 +428,11 @@ var (
 	// NotificationsColumns holds the columns for the "notifications" table.
 	NotificationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"NewMessage", "Mention", "Call", "MissedCall", "ContactAdded", "ContactRequest"}},
 		{Name: "title", Type: field.TypeString},
 		{Name: "body", Type: field.TypeString, Size: 2147483647},
 		{Name: "data", Type: field.TypeJSON, Nullable: true},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_notifications", Type: field.TypeString, Nullable: true},
@@ -206,11 +445,102 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "notifications_users_notifications",
-				Columns:    []*schema.Column{NotificationsColumns[6]},
+				Columns:    []*schema.Column{NotificationsColumns[8]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_read_at_user_notifications",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[5], NotificationsColumns[8]},
+			},
+		},
+	}
+	// OutboxMessagesColumns holds the columns for the "outbox_messages" table.
+	OutboxMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OutboxMessagesTable holds the schema information for the "outbox_messages" table.
+	OutboxMessagesTable = &schema.Table{
+		Name:       "outbox_messages",
+		Columns:    OutboxMessagesColumns,
+		PrimaryKey: []*schema.Column{OutboxMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxmessage_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxMessagesColumns[3]},
+			},
+		},
+	}
+	// ReportsColumns holds the columns for the "reports" table.
+	ReportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"Message", "User", "Room"}},
+		{Name: "reason", Type: field.TypeEnum, Enums: []string{"Spam", "Harassment", "Violence", "Sexual", "Impersonation", "Other"}},
+		{Name: "text", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "snapshot", Type: field.TypeJSON},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"Open", "Resolved", "Dismissed"}, Default: "Open"},
+		{Name: "actions", Type: field.TypeJSON, Nullable: true},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "reporter_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+		{Name: "message_id", Type: field.TypeString, Nullable: true},
+		{Name: "room_id", Type: field.TypeString, Nullable: true},
+		{Name: "assignee_id", Type: field.TypeString, Nullable: true},
+	}
+	// ReportsTable holds the schema information for the "reports" table.
+	ReportsTable = &schema.Table{
+		Name:       "reports",
+		Columns:    ReportsColumns,
+		PrimaryKey: []*schema.Column{ReportsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reports_users_reporter",
+				Columns:    []*schema.Column{ReportsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "reports_users_user",
+				Columns:    []*schema.Column{ReportsColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "reports_messages_message",
+				Columns:    []*schema.Column{ReportsColumns[13]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "reports_rooms_room",
+				Columns:    []*schema.Column{ReportsColumns[14]},
+				RefColumns: []*schema.Column{RoomsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "reports_users_assignee",
+				Columns:    []*schema.Column{ReportsColumns[15]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "report_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReportsColumns[5], ReportsColumns[9]},
+			},
+		},
 	}
 	// RoomsColumns holds the columns for the "rooms" table.
 	RoomsColumns = []*schema.Column{
@@ -219,10 +549,12 @@ var (
 		{Name: "name", Type: field.TypeString, Nullable: true},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "version", Type: field.TypeUint64, Default: 1},
+		{Name: "storage_used", Type: field.TypeInt64, Default: 0},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"Personal", "Group"}},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "room_last_message", Type: field.TypeString, Nullable: true},
+		{Name: "avatar_id", Type: field.TypeString, Nullable: true},
 	}
 	// RoomsTable holds the schema information for the "rooms" table.
 	RoomsTable = &schema.Table{
@@ -232,10 +564,48 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "rooms_messages_last_message",
-				Columns:    []*schema.Column{RoomsColumns[8]},
+				Columns:    []*schema.Column{RoomsColumns[9]},
 				RefColumns: []*schema.Column{MessagesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "rooms_files_avatar",
+				Columns:    []*schema.Column{RoomsColumns[10]},
+				RefColumns: []*schema.Column{FilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// RoomInvitesColumns holds the columns for the "room_invites" table.
+	RoomInvitesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "token", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "max_uses", Type: field.TypeInt, Nullable: true},
+		{Name: "uses", Type: field.TypeInt, Default: 0},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "room_id", Type: field.TypeString},
+		{Name: "creator_id", Type: field.TypeString},
+	}
+	// RoomInvitesTable holds the schema information for the "room_invites" table.
+	RoomInvitesTable = &schema.Table{
+		Name:       "room_invites",
+		Columns:    RoomInvitesColumns,
+		PrimaryKey: []*schema.Column{RoomInvitesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "room_invites_rooms_room",
+				Columns:    []*schema.Column{RoomInvitesColumns[7]},
+				RefColumns: []*schema.Column{RoomsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "room_invites_users_creator",
+				Columns:    []*schema.Column{RoomInvitesColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
 		},
 	}
 	// RoomMembersColumns holds the columns for the "room_members" table.
@@ -244,6 +614,10 @@ var (
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "name", Type: field.TypeString, Nullable: true},
 		{Name: "unread_messages_count", Type: field.TypeInt, Default: 0},
+		{Name: "notification_level", Type: field.TypeEnum, Enums: []string{"All", "Mentions", "None"}, Default: "All"},
+		{Name: "mute_until", Type: field.TypeTime, Nullable: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"Owner", "Admin", "Member", "ReadOnly"}, Default: "Member"},
+		{Name: "banned_at", Type: field.TypeTime, Nullable: true},
 		{Name: "joined_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_id", Type: field.TypeString},
@@ -257,13 +631,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "room_members_users_user",
-				Columns:    []*schema.Column{RoomMembersColumns[6]},
+				Columns:    []*schema.Column{RoomMembersColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "room_members_rooms_room",
-				Columns:    []*schema.Column{RoomMembersColumns[7]},
+				Columns:    []*schema.Column{RoomMembersColumns[11]},
 				RefColumns: []*schema.Column{RoomsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -272,7 +646,7 @@ var (
 			{
 				Name:    "roommember_room_id_user_id",
 				Unique:  true,
-				Columns: []*schema.Column{RoomMembersColumns[7], RoomMembersColumns[6]},
+				Columns: []*schema.Column{RoomMembersColumns[11], RoomMembersColumns[10]},
 			},
 		},
 	}
@@ -283,16 +657,103 @@ var (
 		{Name: "last_name", Type: field.TypeString},
 		{Name: "nickname", Type: field.TypeString, Unique: true},
 		{Name: "email", Type: field.TypeString, Unique: true, Nullable: true},
-		{Name: "contact_pin", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "storage_used", Type: field.TypeInt64, Default: 0},
+		{Name: "locale", Type: field.TypeString, Default: "ru"},
+		{Name: "message_previews", Type: field.TypeBool, Default: true},
+		{Name: "timezone", Type: field.TypeString, Default: "UTC"},
+		{Name: "quiet_hours_start", Type: field.TypeString, Nullable: true},
+		{Name: "quiet_hours_end", Type: field.TypeString, Nullable: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"User", "Moderator"}, Default: "User"},
+		{Name: "suspended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "hide_last_seen", Type: field.TypeBool, Default: false},
+		{Name: "discoverability", Type: field.TypeEnum, Enums: []string{"Everyone", "ContactsOfContacts", "Nobody"}, Default: "ContactsOfContacts"},
+		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true},
 		{Name: "password", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "avatar_id", Type: field.TypeString, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
 		Name:       "users",
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "users_files_avatar",
+				Columns:    []*schema.Column{UsersColumns[19]},
+				RefColumns: []*schema.Column{FilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "user_nickname",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
+			{
+				Name:    "user_first_name",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
+			{
+				Name:    "user_last_name",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
+		},
+	}
+	// UserBlocksColumns holds the columns for the "user_blocks" table.
+	UserBlocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "blocked_user_id", Type: field.TypeString},
+	}
+	// UserBlocksTable holds the schema information for the "user_blocks" table.
+	UserBlocksTable = &schema.Table{
+		Name:       "user_blocks",
+		Columns:    UserBlocksColumns,
+		PrimaryKey: []*schema.Column{UserBlocksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_blocks_users_user",
+				Columns:    []*schema.Column{UserBlocksColumns[2]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "user_blocks_users_blocked_user",
+				Columns:    []*schema.Column{UserBlocksColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userblock_user_id_blocked_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserBlocksColumns[2], UserBlocksColumns[3]},
+			},
+			{
+				Name:    "userblock_blocked_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{UserBlocksColumns[3]},
+			},
+		},
 	}
 	// UserContactsColumns holds the columns for the "user_contacts" table.
 	UserContactsColumns = []*schema.Column{
@@ -338,6 +799,11 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AvatarsTable,
+		CallsTable,
+		CallParticipantsTable,
+		ContactPinsTable,
+		ContactRequestsTable,
 		DevicesTable,
 		FilesTable,
 		MessagesTable,
@@ -345,18 +811,37 @@ var (
 		MessageLinksTable,
 		MessageVoicesTable,
 		NotificationsTable,
+		OutboxMessagesTable,
+		ReportsTable,
 		RoomsTable,
+		RoomInvitesTable,
 		RoomMembersTable,
 		UsersTable,
+		UserBlocksTable,
 		UserContactsTable,
 	}
 )
 
 func init() {
+	AvatarsTable.ForeignKeys[0].RefTable = FilesTable
+	AvatarsTable.ForeignKeys[1].RefTable = RoomsTable
+	AvatarsTable.ForeignKeys[2].RefTable = UsersTable
+	CallsTable.ForeignKeys[0].RefTable = RoomsTable
+	CallsTable.ForeignKeys[1].RefTable = UsersTable
+	CallParticipantsTable.ForeignKeys[0].RefTable = CallsTable
+	CallParticipantsTable.ForeignKeys[1].RefTable = UsersTable
+	ContactPinsTable.ForeignKeys[0].RefTable = UsersTable
+	ContactRequestsTable.ForeignKeys[0].RefTable = UsersTable
+	ContactRequestsTable.ForeignKeys[1].RefTable = UsersTable
 	DevicesTable.ForeignKeys[0].RefTable = UsersTable
+	FilesTable.ForeignKeys[0].RefTable = FilesTable
+	FilesTable.ForeignKeys[1].RefTable = RoomsTable
+	FilesTable.ForeignKeys[2].RefTable = UsersTable
 	MessagesTable.ForeignKeys[0].RefTable = MessagesTable
-	MessagesTable.ForeignKeys[1].RefTable = RoomsTable
-	MessagesTable.ForeignKeys[2].RefTable = UsersTable
+	MessagesTable.ForeignKeys[1].RefTable = CallsTable
+	MessagesTable.ForeignKeys[2].RefTable = AvatarsTable
+	MessagesTable.ForeignKeys[3].RefTable = RoomsTable
+	MessagesTable.ForeignKeys[4].RefTable = UsersTable
 	MessageAttachmentsTable.ForeignKeys[0].RefTable = FilesTable
 	MessageAttachmentsTable.ForeignKeys[1].RefTable = MessagesTable
 	MessageAttachmentsTable.ForeignKeys[2].RefTable = RoomsTable
@@ -366,9 +851,20 @@ func init() {
 	MessageVoicesTable.ForeignKeys[1].RefTable = MessagesTable
 	MessageVoicesTable.ForeignKeys[2].RefTable = RoomsTable
 	NotificationsTable.ForeignKeys[0].RefTable = UsersTable
+	ReportsTable.ForeignKeys[0].RefTable = UsersTable
+	ReportsTable.ForeignKeys[1].RefTable = UsersTable
+	ReportsTable.ForeignKeys[2].RefTable = MessagesTable
+	ReportsTable.ForeignKeys[3].RefTable = RoomsTable
+	ReportsTable.ForeignKeys[4].RefTable = UsersTable
 	RoomsTable.ForeignKeys[0].RefTable = MessagesTable
+	RoomsTable.ForeignKeys[1].RefTable = FilesTable
+	RoomInvitesTable.ForeignKeys[0].RefTable = RoomsTable
+	RoomInvitesTable.ForeignKeys[1].RefTable = UsersTable
 	RoomMembersTable.ForeignKeys[0].RefTable = UsersTable
 	RoomMembersTable.ForeignKeys[1].RefTable = RoomsTable
+	UsersTable.ForeignKeys[0].RefTable = FilesTable
+	UserBlocksTable.ForeignKeys[0].RefTable = UsersTable
+	UserBlocksTable.ForeignKeys[1].RefTable = UsersTable
 	UserContactsTable.ForeignKeys[0].RefTable = UsersTable
 	UserContactsTable.ForeignKeys[1].RefTable = UsersTable
 	UserContactsTable.ForeignKeys[2].RefTable = RoomsTable
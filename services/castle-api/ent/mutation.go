@@ -6,6 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"journeyhub/ent/avatar"
+	"journeyhub/ent/call"
+	"journeyhub/ent/callparticipant"
+	"journeyhub/ent/contactpin"
+	"journeyhub/ent/contactrequest"
 	"journeyhub/ent/device"
 	"journeyhub/ent/file"
 	"journeyhub/ent/message"
@@ -13,11 +18,15 @@ import (
 	"journeyhub/ent/messagelink"
 	"journeyhub/ent/messagevoice"
 	"journeyhub/ent/notification"
+	"journeyhub/ent/outboxmessage"
 	"journeyhub/ent/predicate"
+	"journeyhub/ent/report"
 	"journeyhub/ent/room"
+	"journeyhub/ent/roominvite"
 	"journeyhub/ent/roommember"
 	"journeyhub/ent/schema/pulid"
 	"journeyhub/ent/user"
+	"journeyhub/ent/userblock"
 	"journeyhub/ent/usercontact"
 	"sync"
 	"time"
@@ -35,6 +44,11 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeAvatar            = "Avatar"
+	TypeCall              = "Call"
+	TypeCallParticipant   = "CallParticipant"
+	TypeContactPin        = "ContactPin"
+	TypeContactRequest    = "ContactRequest"
 	TypeDevice            = "Device"
 	TypeFile              = "File"
 	TypeMessage           = "Message"
@@ -42,41 +56,46 @@ const (
 	TypeMessageLink       = "MessageLink"
 	TypeMessageVoice      = "MessageVoice"
 	TypeNotification      = "Notification"
+	TypeOutboxMessage     = "OutboxMessage"
+	TypeReport            = "Report"
 	TypeRoom              = "Room"
+	TypeRoomInvite        = "RoomInvite"
 	TypeRoomMember        = "RoomMember"
 	TypeUser              = "User"
+	TypeUserBlock         = "UserBlock"
 	TypeUserContact       = "UserContact"
 )
 
-// DeviceMutation represents an operation that mutates the Device nodes in the graph.
-type DeviceMutation struct {
+// AvatarMutation represents an operation that mutates the Avatar nodes in the graph.
+type AvatarMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *pulid.ID
-	device_id     *string
-	fcm_token     *string
 	created_at    *time.Time
-	updated_at    *time.Time
 	clearedFields map[string]struct{}
+	file          *pulid.ID
+	clearedfile   bool
 	user          *pulid.ID
 	cleareduser   bool
+	room          *pulid.ID
+	clearedroom   bool
 	done          bool
-	oldValue      func(context.Context) (*Device, error)
-	predicates    []predicate.Device
+	oldValue      func(context.Context) (*Avatar, error)
+	predicates    []predicate.Avatar
 }
 
-var _ ent.Mutation = (*DeviceMutation)(nil)
+var _ ent.Mutation = (*AvatarMutation)(nil)
 
-// deviceOption allows management of the mutation configuration using functional options.
-type deviceOption func(*DeviceMutation)
+// avatarOption allows management of the mutation configuration using functional options.
+type avatarOption func(*AvatarMutation)
 
-// newDeviceMutation creates new mutation for the Device entity.
-func newDeviceMutation(c config, op Op, opts ...deviceOption) *DeviceMutation {
-	m := &DeviceMutation{
+// newAvatarMutation creates new mutation for the Avatar entity.
+func newAvatarMutation(c config, op Op, opts ...avatarOption) *AvatarMutation {
+	m := &AvatarMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDevice,
+		typ:           TypeAvatar,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -85,20 +104,20 @@ func newDeviceMutation(c config, op Op, opts ...deviceOption) *DeviceMutation {
 	return m
 }
 
-// withDeviceID sets the ID field of the mutation.
-func withDeviceID(id pulid.ID) deviceOption {
-	return func(m *DeviceMutation) {
+// withAvatarID sets the ID field of the mutation.
+func withAvatarID(id pulid.ID) avatarOption {
+	return func(m *AvatarMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Device
+			value *Avatar
 		)
-		m.oldValue = func(ctx context.Context) (*Device, error) {
+		m.oldValue = func(ctx context.Context) (*Avatar, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Device.Get(ctx, id)
+					value, err = m.Client().Avatar.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -107,10 +126,10 @@ func withDeviceID(id pulid.ID) deviceOption {
 	}
 }
 
-// withDevice sets the old Device of the mutation.
-func withDevice(node *Device) deviceOption {
-	return func(m *DeviceMutation) {
-		m.oldValue = func(context.Context) (*Device, error) {
+// withAvatar sets the old Avatar of the mutation.
+func withAvatar(node *Avatar) avatarOption {
+	return func(m *AvatarMutation) {
+		m.oldValue = func(context.Context) (*Avatar, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -119,7 +138,7 @@ func withDevice(node *Device) deviceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeviceMutation) Client() *Client {
+func (m AvatarMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -127,7 +146,7 @@ func (m DeviceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DeviceMutation) Tx() (*Tx, error) {
+func (m AvatarMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -137,14 +156,14 @@ func (m DeviceMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Device entities.
-func (m *DeviceMutation) SetID(id pulid.ID) {
+// operation is only accepted on creation of Avatar entities.
+func (m *AvatarMutation) SetID(id pulid.ID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DeviceMutation) ID() (id pulid.ID, exists bool) {
+func (m *AvatarMutation) ID() (id pulid.ID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -155,7 +174,7 @@ func (m *DeviceMutation) ID() (id pulid.ID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DeviceMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
+func (m *AvatarMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -164,183 +183,224 @@ func (m *DeviceMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Device.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Avatar.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetDeviceID sets the "device_id" field.
-func (m *DeviceMutation) SetDeviceID(s string) {
-	m.device_id = &s
+// SetFileID sets the "file_id" field.
+func (m *AvatarMutation) SetFileID(pu pulid.ID) {
+	m.file = &pu
 }
 
-// DeviceID returns the value of the "device_id" field in the mutation.
-func (m *DeviceMutation) DeviceID() (r string, exists bool) {
-	v := m.device_id
+// FileID returns the value of the "file_id" field in the mutation.
+func (m *AvatarMutation) FileID() (r pulid.ID, exists bool) {
+	v := m.file
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeviceID returns the old "device_id" field's value of the Device entity.
-// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// OldFileID returns the old "file_id" field's value of the Avatar entity.
+// If the Avatar object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceMutation) OldDeviceID(ctx context.Context) (v string, err error) {
+func (m *AvatarMutation) OldFileID(ctx context.Context) (v pulid.ID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeviceID requires an ID field in the mutation")
+		return v, errors.New("OldFileID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
 	}
-	return oldValue.DeviceID, nil
+	return oldValue.FileID, nil
 }
 
-// ResetDeviceID resets all changes to the "device_id" field.
-func (m *DeviceMutation) ResetDeviceID() {
-	m.device_id = nil
+// ResetFileID resets all changes to the "file_id" field.
+func (m *AvatarMutation) ResetFileID() {
+	m.file = nil
 }
 
-// SetFcmToken sets the "fcm_token" field.
-func (m *DeviceMutation) SetFcmToken(s string) {
-	m.fcm_token = &s
+// SetUserID sets the "user_id" field.
+func (m *AvatarMutation) SetUserID(pu pulid.ID) {
+	m.user = &pu
 }
 
-// FcmToken returns the value of the "fcm_token" field in the mutation.
-func (m *DeviceMutation) FcmToken() (r string, exists bool) {
-	v := m.fcm_token
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *AvatarMutation) UserID() (r pulid.ID, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFcmToken returns the old "fcm_token" field's value of the Device entity.
-// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Avatar entity.
+// If the Avatar object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceMutation) OldFcmToken(ctx context.Context) (v string, err error) {
+func (m *AvatarMutation) OldUserID(ctx context.Context) (v *pulid.ID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFcmToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFcmToken requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFcmToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.FcmToken, nil
+	return oldValue.UserID, nil
 }
 
-// ResetFcmToken resets all changes to the "fcm_token" field.
-func (m *DeviceMutation) ResetFcmToken() {
-	m.fcm_token = nil
+// ClearUserID clears the value of the "user_id" field.
+func (m *AvatarMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[avatar.FieldUserID] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *DeviceMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *AvatarMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[avatar.FieldUserID]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DeviceMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetUserID resets all changes to the "user_id" field.
+func (m *AvatarMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, avatar.FieldUserID)
+}
+
+// SetRoomID sets the "room_id" field.
+func (m *AvatarMutation) SetRoomID(pu pulid.ID) {
+	m.room = &pu
+}
+
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *AvatarMutation) RoomID() (r pulid.ID, exists bool) {
+	v := m.room
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Device entity.
-// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// OldRoomID returns the old "room_id" field's value of the Avatar entity.
+// If the Avatar object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AvatarMutation) OldRoomID(ctx context.Context) (v *pulid.ID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.RoomID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DeviceMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearRoomID clears the value of the "room_id" field.
+func (m *AvatarMutation) ClearRoomID() {
+	m.room = nil
+	m.clearedFields[avatar.FieldRoomID] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *DeviceMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// RoomIDCleared returns if the "room_id" field was cleared in this mutation.
+func (m *AvatarMutation) RoomIDCleared() bool {
+	_, ok := m.clearedFields[avatar.FieldRoomID]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DeviceMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *AvatarMutation) ResetRoomID() {
+	m.room = nil
+	delete(m.clearedFields, avatar.FieldRoomID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AvatarMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AvatarMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Device entity.
-// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Avatar entity.
+// If the Avatar object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AvatarMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DeviceMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AvatarMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *DeviceMutation) SetUserID(id pulid.ID) {
-	m.user = &id
+// ClearFile clears the "file" edge to the File entity.
+func (m *AvatarMutation) ClearFile() {
+	m.clearedfile = true
+	m.clearedFields[avatar.FieldFileID] = struct{}{}
+}
+
+// FileCleared reports if the "file" edge to the File entity was cleared.
+func (m *AvatarMutation) FileCleared() bool {
+	return m.clearedfile
+}
+
+// FileIDs returns the "file" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *AvatarMutation) FileIDs() (ids []pulid.ID) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFile resets all changes to the "file" edge.
+func (m *AvatarMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *DeviceMutation) ClearUser() {
+func (m *AvatarMutation) ClearUser() {
 	m.cleareduser = true
+	m.clearedFields[avatar.FieldUserID] = struct{}{}
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *DeviceMutation) UserCleared() bool {
-	return m.cleareduser
-}
-
-// UserID returns the "user" edge ID in the mutation.
-func (m *DeviceMutation) UserID() (id pulid.ID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
-	}
-	return
+func (m *AvatarMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
 }
 
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *DeviceMutation) UserIDs() (ids []pulid.ID) {
+func (m *AvatarMutation) UserIDs() (ids []pulid.ID) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -348,20 +408,47 @@ func (m *DeviceMutation) UserIDs() (ids []pulid.ID) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *DeviceMutation) ResetUser() {
+func (m *AvatarMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the DeviceMutation builder.
-func (m *DeviceMutation) Where(ps ...predicate.Device) {
+// ClearRoom clears the "room" edge to the Room entity.
+func (m *AvatarMutation) ClearRoom() {
+	m.clearedroom = true
+	m.clearedFields[avatar.FieldRoomID] = struct{}{}
+}
+
+// RoomCleared reports if the "room" edge to the Room entity was cleared.
+func (m *AvatarMutation) RoomCleared() bool {
+	return m.RoomIDCleared() || m.clearedroom
+}
+
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *AvatarMutation) RoomIDs() (ids []pulid.ID) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoom resets all changes to the "room" edge.
+func (m *AvatarMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
+}
+
+// Where appends a list predicates to the AvatarMutation builder.
+func (m *AvatarMutation) Where(ps ...predicate.Avatar) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DeviceMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AvatarMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeviceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Device, len(ps))
+func (m *AvatarMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Avatar, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -369,36 +456,36 @@ func (m *DeviceMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DeviceMutation) Op() Op {
+func (m *AvatarMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DeviceMutation) SetOp(op Op) {
+func (m *AvatarMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Device).
-func (m *DeviceMutation) Type() string {
+// Type returns the node type of this mutation (Avatar).
+func (m *AvatarMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DeviceMutation) Fields() []string {
+func (m *AvatarMutation) Fields() []string {
 	fields := make([]string, 0, 4)
-	if m.device_id != nil {
-		fields = append(fields, device.FieldDeviceID)
+	if m.file != nil {
+		fields = append(fields, avatar.FieldFileID)
 	}
-	if m.fcm_token != nil {
-		fields = append(fields, device.FieldFcmToken)
+	if m.user != nil {
+		fields = append(fields, avatar.FieldUserID)
 	}
-	if m.created_at != nil {
-		fields = append(fields, device.FieldCreatedAt)
+	if m.room != nil {
+		fields = append(fields, avatar.FieldRoomID)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, device.FieldUpdatedAt)
+	if m.created_at != nil {
+		fields = append(fields, avatar.FieldCreatedAt)
 	}
 	return fields
 }
@@ -406,16 +493,16 @@ func (m *DeviceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeviceMutation) Field(name string) (ent.Value, bool) {
+func (m *AvatarMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case device.FieldDeviceID:
-		return m.DeviceID()
-	case device.FieldFcmToken:
-		return m.FcmToken()
-	case device.FieldCreatedAt:
+	case avatar.FieldFileID:
+		return m.FileID()
+	case avatar.FieldUserID:
+		return m.UserID()
+	case avatar.FieldRoomID:
+		return m.RoomID()
+	case avatar.FieldCreatedAt:
 		return m.CreatedAt()
-	case device.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -423,228 +510,280 @@ func (m *DeviceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeviceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AvatarMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case device.FieldDeviceID:
-		return m.OldDeviceID(ctx)
-	case device.FieldFcmToken:
-		return m.OldFcmToken(ctx)
-	case device.FieldCreatedAt:
+	case avatar.FieldFileID:
+		return m.OldFileID(ctx)
+	case avatar.FieldUserID:
+		return m.OldUserID(ctx)
+	case avatar.FieldRoomID:
+		return m.OldRoomID(ctx)
+	case avatar.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case device.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Device field %s", name)
+	return nil, fmt.Errorf("unknown Avatar field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeviceMutation) SetField(name string, value ent.Value) error {
+func (m *AvatarMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case device.FieldDeviceID:
-		v, ok := value.(string)
+	case avatar.FieldFileID:
+		v, ok := value.(pulid.ID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeviceID(v)
+		m.SetFileID(v)
 		return nil
-	case device.FieldFcmToken:
-		v, ok := value.(string)
+	case avatar.FieldUserID:
+		v, ok := value.(pulid.ID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFcmToken(v)
+		m.SetUserID(v)
 		return nil
-	case device.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case avatar.FieldRoomID:
+		v, ok := value.(pulid.ID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetRoomID(v)
 		return nil
-	case device.FieldUpdatedAt:
+	case avatar.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Device field %s", name)
+	return fmt.Errorf("unknown Avatar field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeviceMutation) AddedFields() []string {
+func (m *AvatarMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeviceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AvatarMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeviceMutation) AddField(name string, value ent.Value) error {
+func (m *AvatarMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Device numeric field %s", name)
+	return fmt.Errorf("unknown Avatar numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeviceMutation) ClearedFields() []string {
-	return nil
+func (m *AvatarMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(avatar.FieldUserID) {
+		fields = append(fields, avatar.FieldUserID)
+	}
+	if m.FieldCleared(avatar.FieldRoomID) {
+		fields = append(fields, avatar.FieldRoomID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DeviceMutation) FieldCleared(name string) bool {
+func (m *AvatarMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DeviceMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Device nullable field %s", name)
+func (m *AvatarMutation) ClearField(name string) error {
+	switch name {
+	case avatar.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case avatar.FieldRoomID:
+		m.ClearRoomID()
+		return nil
+	}
+	return fmt.Errorf("unknown Avatar nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DeviceMutation) ResetField(name string) error {
+func (m *AvatarMutation) ResetField(name string) error {
 	switch name {
-	case device.FieldDeviceID:
-		m.ResetDeviceID()
+	case avatar.FieldFileID:
+		m.ResetFileID()
 		return nil
-	case device.FieldFcmToken:
-		m.ResetFcmToken()
+	case avatar.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case device.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case avatar.FieldRoomID:
+		m.ResetRoomID()
 		return nil
-	case device.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case avatar.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Device field %s", name)
+	return fmt.Errorf("unknown Avatar field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeviceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *AvatarMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.file != nil {
+		edges = append(edges, avatar.EdgeFile)
+	}
 	if m.user != nil {
-		edges = append(edges, device.EdgeUser)
+		edges = append(edges, avatar.EdgeUser)
+	}
+	if m.room != nil {
+		edges = append(edges, avatar.EdgeRoom)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeviceMutation) AddedIDs(name string) []ent.Value {
+func (m *AvatarMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case device.EdgeUser:
+	case avatar.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
+		}
+	case avatar.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
+	case avatar.EdgeRoom:
+		if id := m.room; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeviceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *AvatarMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DeviceMutation) RemovedIDs(name string) []ent.Value {
+func (m *AvatarMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeviceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *AvatarMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedfile {
+		edges = append(edges, avatar.EdgeFile)
+	}
 	if m.cleareduser {
-		edges = append(edges, device.EdgeUser)
+		edges = append(edges, avatar.EdgeUser)
+	}
+	if m.clearedroom {
+		edges = append(edges, avatar.EdgeRoom)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeviceMutation) EdgeCleared(name string) bool {
+func (m *AvatarMutation) EdgeCleared(name string) bool {
 	switch name {
-	case device.EdgeUser:
+	case avatar.EdgeFile:
+		return m.clearedfile
+	case avatar.EdgeUser:
 		return m.cleareduser
+	case avatar.EdgeRoom:
+		return m.clearedroom
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeviceMutation) ClearEdge(name string) error {
+func (m *AvatarMutation) ClearEdge(name string) error {
 	switch name {
-	case device.EdgeUser:
+	case avatar.EdgeFile:
+		m.ClearFile()
+		return nil
+	case avatar.EdgeUser:
 		m.ClearUser()
 		return nil
+	case avatar.EdgeRoom:
+		m.ClearRoom()
+		return nil
 	}
-	return fmt.Errorf("unknown Device unique edge %s", name)
+	return fmt.Errorf("unknown Avatar unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeviceMutation) ResetEdge(name string) error {
+func (m *AvatarMutation) ResetEdge(name string) error {
 	switch name {
-	case device.EdgeUser:
+	case avatar.EdgeFile:
+		m.ResetFile()
+		return nil
+	case avatar.EdgeUser:
 		m.ResetUser()
 		return nil
+	case avatar.EdgeRoom:
+		m.ResetRoom()
+		return nil
 	}
-	return fmt.Errorf("unknown Device edge %s", name)
+	return fmt.Errorf("unknown Avatar edge %s", name)
 }
 
-// FileMutation represents an operation that mutates the File nodes in the graph.
-type FileMutation struct {
+// CallMutation represents an operation that mutates the Call nodes in the graph.
+type CallMutation struct {
 	config
-	op                        Op
-	typ                       string
-	id                        *pulid.ID
-	name                      *string
-	content_type              *string
-	size                      *uint64
-	addsize                   *int64
-	location                  *string
-	bucket                    *string
-	_path                     *string
-	created_at                *time.Time
-	updated_at                *time.Time
-	clearedFields             map[string]struct{}
-	message_attachment        *pulid.ID
-	clearedmessage_attachment bool
-	message_voice             *pulid.ID
-	clearedmessage_voice      bool
-	done                      bool
-	oldValue                  func(context.Context) (*File, error)
-	predicates                []predicate.File
-}
-
-var _ ent.Mutation = (*FileMutation)(nil)
-
-// fileOption allows management of the mutation configuration using functional options.
-type fileOption func(*FileMutation)
-
-// newFileMutation creates new mutation for the File entity.
-func newFileMutation(c config, op Op, opts ...fileOption) *FileMutation {
-	m := &FileMutation{
+	op                  Op
+	typ                 string
+	id                  *pulid.ID
+	_type               *call.Type
+	state               *call.State
+	started_at          *time.Time
+	answered_at         *time.Time
+	ended_at            *time.Time
+	duration            *int
+	addduration         *int
+	clearedFields       map[string]struct{}
+	room                *pulid.ID
+	clearedroom         bool
+	caller              *pulid.ID
+	clearedcaller       bool
+	participants        map[pulid.ID]struct{}
+	removedparticipants map[pulid.ID]struct{}
+	clearedparticipants bool
+	done                bool
+	oldValue            func(context.Context) (*Call, error)
+	predicates          []predicate.Call
+}
+
+var _ ent.Mutation = (*CallMutation)(nil)
+
+// callOption allows management of the mutation configuration using functional options.
+type callOption func(*CallMutation)
+
+// newCallMutation creates new mutation for the Call entity.
+func newCallMutation(c config, op Op, opts ...callOption) *CallMutation {
+	m := &CallMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFile,
+		typ:           TypeCall,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -653,20 +792,20 @@ func newFileMutation(c config, op Op, opts ...fileOption) *FileMutation {
 	return m
 }
 
-// withFileID sets the ID field of the mutation.
-func withFileID(id pulid.ID) fileOption {
-	return func(m *FileMutation) {
+// withCallID sets the ID field of the mutation.
+func withCallID(id pulid.ID) callOption {
+	return func(m *CallMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *File
+			value *Call
 		)
-		m.oldValue = func(ctx context.Context) (*File, error) {
+		m.oldValue = func(ctx context.Context) (*Call, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().File.Get(ctx, id)
+					value, err = m.Client().Call.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -675,10 +814,10 @@ func withFileID(id pulid.ID) fileOption {
 	}
 }
 
-// withFile sets the old File of the mutation.
-func withFile(node *File) fileOption {
-	return func(m *FileMutation) {
-		m.oldValue = func(context.Context) (*File, error) {
+// withCall sets the old Call of the mutation.
+func withCall(node *Call) callOption {
+	return func(m *CallMutation) {
+		m.oldValue = func(context.Context) (*Call, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -687,7 +826,7 @@ func withFile(node *File) fileOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FileMutation) Client() *Client {
+func (m CallMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -695,7 +834,7 @@ func (m FileMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FileMutation) Tx() (*Tx, error) {
+func (m CallMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -705,14 +844,14 @@ func (m FileMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of File entities.
-func (m *FileMutation) SetID(id pulid.ID) {
+// operation is only accepted on creation of Call entities.
+func (m *CallMutation) SetID(id pulid.ID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FileMutation) ID() (id pulid.ID, exists bool) {
+func (m *CallMutation) ID() (id pulid.ID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -723,7 +862,7 @@ func (m *FileMutation) ID() (id pulid.ID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FileMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
+func (m *CallMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -732,420 +871,463 @@ func (m *FileMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().File.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Call.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *FileMutation) SetName(s string) {
-	m.name = &s
+// SetType sets the "type" field.
+func (m *CallMutation) SetType(c call.Type) {
+	m._type = &c
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *FileMutation) Name() (r string, exists bool) {
-	v := m.name
+// GetType returns the value of the "type" field in the mutation.
+func (m *CallMutation) GetType() (r call.Type, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the Call entity.
+// If the Call object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CallMutation) OldType(ctx context.Context) (v call.Type, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Type, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *FileMutation) ResetName() {
-	m.name = nil
+// ResetType resets all changes to the "type" field.
+func (m *CallMutation) ResetType() {
+	m._type = nil
 }
 
-// SetContentType sets the "content_type" field.
-func (m *FileMutation) SetContentType(s string) {
-	m.content_type = &s
+// SetState sets the "state" field.
+func (m *CallMutation) SetState(c call.State) {
+	m.state = &c
 }
 
-// ContentType returns the value of the "content_type" field in the mutation.
-func (m *FileMutation) ContentType() (r string, exists bool) {
-	v := m.content_type
+// State returns the value of the "state" field in the mutation.
+func (m *CallMutation) State() (r call.State, exists bool) {
+	v := m.state
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContentType returns the old "content_type" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldState returns the old "state" field's value of the Call entity.
+// If the Call object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldContentType(ctx context.Context) (v string, err error) {
+func (m *CallMutation) OldState(ctx context.Context) (v call.State, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentType requires an ID field in the mutation")
+		return v, errors.New("OldState requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
 	}
-	return oldValue.ContentType, nil
+	return oldValue.State, nil
 }
 
-// ResetContentType resets all changes to the "content_type" field.
-func (m *FileMutation) ResetContentType() {
-	m.content_type = nil
+// ResetState resets all changes to the "state" field.
+func (m *CallMutation) ResetState() {
+	m.state = nil
 }
 
-// SetSize sets the "size" field.
-func (m *FileMutation) SetSize(u uint64) {
-	m.size = &u
-	m.addsize = nil
+// SetRoomID sets the "room_id" field.
+func (m *CallMutation) SetRoomID(pu pulid.ID) {
+	m.room = &pu
 }
 
-// Size returns the value of the "size" field in the mutation.
-func (m *FileMutation) Size() (r uint64, exists bool) {
-	v := m.size
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *CallMutation) RoomID() (r pulid.ID, exists bool) {
+	v := m.room
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSize returns the old "size" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldRoomID returns the old "room_id" field's value of the Call entity.
+// If the Call object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldSize(ctx context.Context) (v uint64, err error) {
+func (m *CallMutation) OldRoomID(ctx context.Context) (v pulid.ID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSize requires an ID field in the mutation")
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSize: %w", err)
-	}
-	return oldValue.Size, nil
-}
-
-// AddSize adds u to the "size" field.
-func (m *FileMutation) AddSize(u int64) {
-	if m.addsize != nil {
-		*m.addsize += u
-	} else {
-		m.addsize = &u
-	}
-}
-
-// AddedSize returns the value that was added to the "size" field in this mutation.
-func (m *FileMutation) AddedSize() (r int64, exists bool) {
-	v := m.addsize
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
 	}
-	return *v, true
+	return oldValue.RoomID, nil
 }
 
-// ResetSize resets all changes to the "size" field.
-func (m *FileMutation) ResetSize() {
-	m.size = nil
-	m.addsize = nil
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *CallMutation) ResetRoomID() {
+	m.room = nil
 }
 
-// SetLocation sets the "location" field.
-func (m *FileMutation) SetLocation(s string) {
-	m.location = &s
+// SetCallerID sets the "caller_id" field.
+func (m *CallMutation) SetCallerID(pu pulid.ID) {
+	m.caller = &pu
 }
 
-// Location returns the value of the "location" field in the mutation.
-func (m *FileMutation) Location() (r string, exists bool) {
-	v := m.location
+// CallerID returns the value of the "caller_id" field in the mutation.
+func (m *CallMutation) CallerID() (r pulid.ID, exists bool) {
+	v := m.caller
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLocation returns the old "location" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldCallerID returns the old "caller_id" field's value of the Call entity.
+// If the Call object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldLocation(ctx context.Context) (v string, err error) {
+func (m *CallMutation) OldCallerID(ctx context.Context) (v pulid.ID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+		return v, errors.New("OldCallerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLocation requires an ID field in the mutation")
+		return v, errors.New("OldCallerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+		return v, fmt.Errorf("querying old value for OldCallerID: %w", err)
 	}
-	return oldValue.Location, nil
-}
-
-// ClearLocation clears the value of the "location" field.
-func (m *FileMutation) ClearLocation() {
-	m.location = nil
-	m.clearedFields[file.FieldLocation] = struct{}{}
-}
-
-// LocationCleared returns if the "location" field was cleared in this mutation.
-func (m *FileMutation) LocationCleared() bool {
-	_, ok := m.clearedFields[file.FieldLocation]
-	return ok
+	return oldValue.CallerID, nil
 }
 
-// ResetLocation resets all changes to the "location" field.
-func (m *FileMutation) ResetLocation() {
-	m.location = nil
-	delete(m.clearedFields, file.FieldLocation)
+// ResetCallerID resets all changes to the "caller_id" field.
+func (m *CallMutation) ResetCallerID() {
+	m.caller = nil
 }
 
-// SetBucket sets the "bucket" field.
-func (m *FileMutation) SetBucket(s string) {
-	m.bucket = &s
+// SetStartedAt sets the "started_at" field.
+func (m *CallMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
 }
 
-// Bucket returns the value of the "bucket" field in the mutation.
-func (m *FileMutation) Bucket() (r string, exists bool) {
-	v := m.bucket
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *CallMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBucket returns the old "bucket" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldStartedAt returns the old "started_at" field's value of the Call entity.
+// If the Call object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldBucket(ctx context.Context) (v string, err error) {
+func (m *CallMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBucket is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBucket requires an ID field in the mutation")
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBucket: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
 	}
-	return oldValue.Bucket, nil
+	return oldValue.StartedAt, nil
 }
 
-// ResetBucket resets all changes to the "bucket" field.
-func (m *FileMutation) ResetBucket() {
-	m.bucket = nil
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *CallMutation) ResetStartedAt() {
+	m.started_at = nil
 }
 
-// SetPath sets the "path" field.
-func (m *FileMutation) SetPath(s string) {
-	m._path = &s
+// SetAnsweredAt sets the "answered_at" field.
+func (m *CallMutation) SetAnsweredAt(t time.Time) {
+	m.answered_at = &t
 }
 
-// Path returns the value of the "path" field in the mutation.
-func (m *FileMutation) Path() (r string, exists bool) {
-	v := m._path
+// AnsweredAt returns the value of the "answered_at" field in the mutation.
+func (m *CallMutation) AnsweredAt() (r time.Time, exists bool) {
+	v := m.answered_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPath returns the old "path" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldAnsweredAt returns the old "answered_at" field's value of the Call entity.
+// If the Call object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldPath(ctx context.Context) (v string, err error) {
+func (m *CallMutation) OldAnsweredAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldAnsweredAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPath requires an ID field in the mutation")
+		return v, errors.New("OldAnsweredAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldAnsweredAt: %w", err)
 	}
-	return oldValue.Path, nil
+	return oldValue.AnsweredAt, nil
 }
 
-// ResetPath resets all changes to the "path" field.
-func (m *FileMutation) ResetPath() {
-	m._path = nil
+// ClearAnsweredAt clears the value of the "answered_at" field.
+func (m *CallMutation) ClearAnsweredAt() {
+	m.answered_at = nil
+	m.clearedFields[call.FieldAnsweredAt] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *FileMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AnsweredAtCleared returns if the "answered_at" field was cleared in this mutation.
+func (m *CallMutation) AnsweredAtCleared() bool {
+	_, ok := m.clearedFields[call.FieldAnsweredAt]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FileMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetAnsweredAt resets all changes to the "answered_at" field.
+func (m *CallMutation) ResetAnsweredAt() {
+	m.answered_at = nil
+	delete(m.clearedFields, call.FieldAnsweredAt)
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (m *CallMutation) SetEndedAt(t time.Time) {
+	m.ended_at = &t
+}
+
+// EndedAt returns the value of the "ended_at" field in the mutation.
+func (m *CallMutation) EndedAt() (r time.Time, exists bool) {
+	v := m.ended_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldEndedAt returns the old "ended_at" field's value of the Call entity.
+// If the Call object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CallMutation) OldEndedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldEndedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.EndedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FileMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearEndedAt clears the value of the "ended_at" field.
+func (m *CallMutation) ClearEndedAt() {
+	m.ended_at = nil
+	m.clearedFields[call.FieldEndedAt] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *FileMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
+func (m *CallMutation) EndedAtCleared() bool {
+	_, ok := m.clearedFields[call.FieldEndedAt]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *FileMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetEndedAt resets all changes to the "ended_at" field.
+func (m *CallMutation) ResetEndedAt() {
+	m.ended_at = nil
+	delete(m.clearedFields, call.FieldEndedAt)
+}
+
+// SetDuration sets the "duration" field.
+func (m *CallMutation) SetDuration(i int) {
+	m.duration = &i
+	m.addduration = nil
+}
+
+// Duration returns the value of the "duration" field in the mutation.
+func (m *CallMutation) Duration() (r int, exists bool) {
+	v := m.duration
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldDuration returns the old "duration" field's value of the Call entity.
+// If the Call object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CallMutation) OldDuration(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDuration is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldDuration requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Duration, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *FileMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AddDuration adds i to the "duration" field.
+func (m *CallMutation) AddDuration(i int) {
+	if m.addduration != nil {
+		*m.addduration += i
+	} else {
+		m.addduration = &i
+	}
 }
 
-// SetMessageAttachmentID sets the "message_attachment" edge to the MessageAttachment entity by id.
-func (m *FileMutation) SetMessageAttachmentID(id pulid.ID) {
-	m.message_attachment = &id
+// AddedDuration returns the value that was added to the "duration" field in this mutation.
+func (m *CallMutation) AddedDuration() (r int, exists bool) {
+	v := m.addduration
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearMessageAttachment clears the "message_attachment" edge to the MessageAttachment entity.
-func (m *FileMutation) ClearMessageAttachment() {
-	m.clearedmessage_attachment = true
+// ResetDuration resets all changes to the "duration" field.
+func (m *CallMutation) ResetDuration() {
+	m.duration = nil
+	m.addduration = nil
 }
 
-// MessageAttachmentCleared reports if the "message_attachment" edge to the MessageAttachment entity was cleared.
-func (m *FileMutation) MessageAttachmentCleared() bool {
-	return m.clearedmessage_attachment
+// ClearRoom clears the "room" edge to the Room entity.
+func (m *CallMutation) ClearRoom() {
+	m.clearedroom = true
+	m.clearedFields[call.FieldRoomID] = struct{}{}
 }
 
-// MessageAttachmentID returns the "message_attachment" edge ID in the mutation.
-func (m *FileMutation) MessageAttachmentID() (id pulid.ID, exists bool) {
-	if m.message_attachment != nil {
-		return *m.message_attachment, true
+// RoomCleared reports if the "room" edge to the Room entity was cleared.
+func (m *CallMutation) RoomCleared() bool {
+	return m.clearedroom
+}
+
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *CallMutation) RoomIDs() (ids []pulid.ID) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// MessageAttachmentIDs returns the "message_attachment" edge IDs in the mutation.
+// ResetRoom resets all changes to the "room" edge.
+func (m *CallMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
+}
+
+// ClearCaller clears the "caller" edge to the User entity.
+func (m *CallMutation) ClearCaller() {
+	m.clearedcaller = true
+	m.clearedFields[call.FieldCallerID] = struct{}{}
+}
+
+// CallerCleared reports if the "caller" edge to the User entity was cleared.
+func (m *CallMutation) CallerCleared() bool {
+	return m.clearedcaller
+}
+
+// CallerIDs returns the "caller" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MessageAttachmentID instead. It exists only for internal usage by the builders.
-func (m *FileMutation) MessageAttachmentIDs() (ids []pulid.ID) {
-	if id := m.message_attachment; id != nil {
+// CallerID instead. It exists only for internal usage by the builders.
+func (m *CallMutation) CallerIDs() (ids []pulid.ID) {
+	if id := m.caller; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetMessageAttachment resets all changes to the "message_attachment" edge.
-func (m *FileMutation) ResetMessageAttachment() {
-	m.message_attachment = nil
-	m.clearedmessage_attachment = false
+// ResetCaller resets all changes to the "caller" edge.
+func (m *CallMutation) ResetCaller() {
+	m.caller = nil
+	m.clearedcaller = false
 }
 
-// SetMessageVoiceID sets the "message_voice" edge to the MessageVoice entity by id.
-func (m *FileMutation) SetMessageVoiceID(id pulid.ID) {
-	m.message_voice = &id
+// AddParticipantIDs adds the "participants" edge to the CallParticipant entity by ids.
+func (m *CallMutation) AddParticipantIDs(ids ...pulid.ID) {
+	if m.participants == nil {
+		m.participants = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.participants[ids[i]] = struct{}{}
+	}
 }
 
-// ClearMessageVoice clears the "message_voice" edge to the MessageVoice entity.
-func (m *FileMutation) ClearMessageVoice() {
-	m.clearedmessage_voice = true
+// ClearParticipants clears the "participants" edge to the CallParticipant entity.
+func (m *CallMutation) ClearParticipants() {
+	m.clearedparticipants = true
 }
 
-// MessageVoiceCleared reports if the "message_voice" edge to the MessageVoice entity was cleared.
-func (m *FileMutation) MessageVoiceCleared() bool {
-	return m.clearedmessage_voice
+// ParticipantsCleared reports if the "participants" edge to the CallParticipant entity was cleared.
+func (m *CallMutation) ParticipantsCleared() bool {
+	return m.clearedparticipants
 }
 
-// MessageVoiceID returns the "message_voice" edge ID in the mutation.
-func (m *FileMutation) MessageVoiceID() (id pulid.ID, exists bool) {
-	if m.message_voice != nil {
-		return *m.message_voice, true
+// RemoveParticipantIDs removes the "participants" edge to the CallParticipant entity by IDs.
+func (m *CallMutation) RemoveParticipantIDs(ids ...pulid.ID) {
+	if m.removedparticipants == nil {
+		m.removedparticipants = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.participants, ids[i])
+		m.removedparticipants[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedParticipants returns the removed IDs of the "participants" edge to the CallParticipant entity.
+func (m *CallMutation) RemovedParticipantsIDs() (ids []pulid.ID) {
+	for id := range m.removedparticipants {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// MessageVoiceIDs returns the "message_voice" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MessageVoiceID instead. It exists only for internal usage by the builders.
-func (m *FileMutation) MessageVoiceIDs() (ids []pulid.ID) {
-	if id := m.message_voice; id != nil {
-		ids = append(ids, *id)
+// ParticipantsIDs returns the "participants" edge IDs in the mutation.
+func (m *CallMutation) ParticipantsIDs() (ids []pulid.ID) {
+	for id := range m.participants {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetMessageVoice resets all changes to the "message_voice" edge.
-func (m *FileMutation) ResetMessageVoice() {
-	m.message_voice = nil
-	m.clearedmessage_voice = false
+// ResetParticipants resets all changes to the "participants" edge.
+func (m *CallMutation) ResetParticipants() {
+	m.participants = nil
+	m.clearedparticipants = false
+	m.removedparticipants = nil
 }
 
-// Where appends a list predicates to the FileMutation builder.
-func (m *FileMutation) Where(ps ...predicate.File) {
+// Where appends a list predicates to the CallMutation builder.
+func (m *CallMutation) Where(ps ...predicate.Call) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FileMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CallMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.File, len(ps))
+func (m *CallMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Call, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1153,48 +1335,48 @@ func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FileMutation) Op() Op {
+func (m *CallMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FileMutation) SetOp(op Op) {
+func (m *CallMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (File).
-func (m *FileMutation) Type() string {
+// Type returns the node type of this mutation (Call).
+func (m *CallMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FileMutation) Fields() []string {
+func (m *CallMutation) Fields() []string {
 	fields := make([]string, 0, 8)
-	if m.name != nil {
-		fields = append(fields, file.FieldName)
+	if m._type != nil {
+		fields = append(fields, call.FieldType)
 	}
-	if m.content_type != nil {
-		fields = append(fields, file.FieldContentType)
+	if m.state != nil {
+		fields = append(fields, call.FieldState)
 	}
-	if m.size != nil {
-		fields = append(fields, file.FieldSize)
+	if m.room != nil {
+		fields = append(fields, call.FieldRoomID)
 	}
-	if m.location != nil {
-		fields = append(fields, file.FieldLocation)
+	if m.caller != nil {
+		fields = append(fields, call.FieldCallerID)
 	}
-	if m.bucket != nil {
-		fields = append(fields, file.FieldBucket)
+	if m.started_at != nil {
+		fields = append(fields, call.FieldStartedAt)
 	}
-	if m._path != nil {
-		fields = append(fields, file.FieldPath)
+	if m.answered_at != nil {
+		fields = append(fields, call.FieldAnsweredAt)
 	}
-	if m.created_at != nil {
-		fields = append(fields, file.FieldCreatedAt)
+	if m.ended_at != nil {
+		fields = append(fields, call.FieldEndedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, file.FieldUpdatedAt)
+	if m.duration != nil {
+		fields = append(fields, call.FieldDuration)
 	}
 	return fields
 }
@@ -1202,24 +1384,24 @@ func (m *FileMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FileMutation) Field(name string) (ent.Value, bool) {
+func (m *CallMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case file.FieldName:
-		return m.Name()
-	case file.FieldContentType:
-		return m.ContentType()
-	case file.FieldSize:
-		return m.Size()
-	case file.FieldLocation:
-		return m.Location()
-	case file.FieldBucket:
-		return m.Bucket()
-	case file.FieldPath:
-		return m.Path()
-	case file.FieldCreatedAt:
-		return m.CreatedAt()
-	case file.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case call.FieldType:
+		return m.GetType()
+	case call.FieldState:
+		return m.State()
+	case call.FieldRoomID:
+		return m.RoomID()
+	case call.FieldCallerID:
+		return m.CallerID()
+	case call.FieldStartedAt:
+		return m.StartedAt()
+	case call.FieldAnsweredAt:
+		return m.AnsweredAt()
+	case call.FieldEndedAt:
+		return m.EndedAt()
+	case call.FieldDuration:
+		return m.Duration()
 	}
 	return nil, false
 }
@@ -1227,99 +1409,99 @@ func (m *FileMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CallMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case file.FieldName:
-		return m.OldName(ctx)
-	case file.FieldContentType:
-		return m.OldContentType(ctx)
-	case file.FieldSize:
-		return m.OldSize(ctx)
-	case file.FieldLocation:
-		return m.OldLocation(ctx)
-	case file.FieldBucket:
-		return m.OldBucket(ctx)
-	case file.FieldPath:
-		return m.OldPath(ctx)
-	case file.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case file.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case call.FieldType:
+		return m.OldType(ctx)
+	case call.FieldState:
+		return m.OldState(ctx)
+	case call.FieldRoomID:
+		return m.OldRoomID(ctx)
+	case call.FieldCallerID:
+		return m.OldCallerID(ctx)
+	case call.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case call.FieldAnsweredAt:
+		return m.OldAnsweredAt(ctx)
+	case call.FieldEndedAt:
+		return m.OldEndedAt(ctx)
+	case call.FieldDuration:
+		return m.OldDuration(ctx)
 	}
-	return nil, fmt.Errorf("unknown File field %s", name)
+	return nil, fmt.Errorf("unknown Call field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileMutation) SetField(name string, value ent.Value) error {
+func (m *CallMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case file.FieldName:
-		v, ok := value.(string)
+	case call.FieldType:
+		v, ok := value.(call.Type)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetType(v)
 		return nil
-	case file.FieldContentType:
-		v, ok := value.(string)
+	case call.FieldState:
+		v, ok := value.(call.State)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContentType(v)
+		m.SetState(v)
 		return nil
-	case file.FieldSize:
-		v, ok := value.(uint64)
+	case call.FieldRoomID:
+		v, ok := value.(pulid.ID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSize(v)
+		m.SetRoomID(v)
 		return nil
-	case file.FieldLocation:
-		v, ok := value.(string)
+	case call.FieldCallerID:
+		v, ok := value.(pulid.ID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLocation(v)
+		m.SetCallerID(v)
 		return nil
-	case file.FieldBucket:
-		v, ok := value.(string)
+	case call.FieldStartedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBucket(v)
+		m.SetStartedAt(v)
 		return nil
-	case file.FieldPath:
-		v, ok := value.(string)
+	case call.FieldAnsweredAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPath(v)
+		m.SetAnsweredAt(v)
 		return nil
-	case file.FieldCreatedAt:
+	case call.FieldEndedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetEndedAt(v)
 		return nil
-	case file.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case call.FieldDuration:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetDuration(v)
 		return nil
 	}
-	return fmt.Errorf("unknown File field %s", name)
+	return fmt.Errorf("unknown Call field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FileMutation) AddedFields() []string {
+func (m *CallMutation) AddedFields() []string {
 	var fields []string
-	if m.addsize != nil {
-		fields = append(fields, file.FieldSize)
+	if m.addduration != nil {
+		fields = append(fields, call.FieldDuration)
 	}
 	return fields
 }
@@ -1327,10 +1509,10 @@ func (m *FileMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CallMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case file.FieldSize:
-		return m.AddedSize()
+	case call.FieldDuration:
+		return m.AddedDuration()
 	}
 	return nil, false
 }
@@ -1338,214 +1520,236 @@ func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileMutation) AddField(name string, value ent.Value) error {
+func (m *CallMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case file.FieldSize:
-		v, ok := value.(int64)
+	case call.FieldDuration:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSize(v)
+		m.AddDuration(v)
 		return nil
 	}
-	return fmt.Errorf("unknown File numeric field %s", name)
+	return fmt.Errorf("unknown Call numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FileMutation) ClearedFields() []string {
+func (m *CallMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(file.FieldLocation) {
-		fields = append(fields, file.FieldLocation)
+	if m.FieldCleared(call.FieldAnsweredAt) {
+		fields = append(fields, call.FieldAnsweredAt)
+	}
+	if m.FieldCleared(call.FieldEndedAt) {
+		fields = append(fields, call.FieldEndedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FileMutation) FieldCleared(name string) bool {
+func (m *CallMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FileMutation) ClearField(name string) error {
+func (m *CallMutation) ClearField(name string) error {
 	switch name {
-	case file.FieldLocation:
-		m.ClearLocation()
+	case call.FieldAnsweredAt:
+		m.ClearAnsweredAt()
+		return nil
+	case call.FieldEndedAt:
+		m.ClearEndedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown File nullable field %s", name)
+	return fmt.Errorf("unknown Call nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FileMutation) ResetField(name string) error {
+func (m *CallMutation) ResetField(name string) error {
 	switch name {
-	case file.FieldName:
-		m.ResetName()
+	case call.FieldType:
+		m.ResetType()
 		return nil
-	case file.FieldContentType:
-		m.ResetContentType()
+	case call.FieldState:
+		m.ResetState()
 		return nil
-	case file.FieldSize:
-		m.ResetSize()
+	case call.FieldRoomID:
+		m.ResetRoomID()
 		return nil
-	case file.FieldLocation:
-		m.ResetLocation()
+	case call.FieldCallerID:
+		m.ResetCallerID()
 		return nil
-	case file.FieldBucket:
-		m.ResetBucket()
+	case call.FieldStartedAt:
+		m.ResetStartedAt()
 		return nil
-	case file.FieldPath:
-		m.ResetPath()
+	case call.FieldAnsweredAt:
+		m.ResetAnsweredAt()
 		return nil
-	case file.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case call.FieldEndedAt:
+		m.ResetEndedAt()
 		return nil
-	case file.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case call.FieldDuration:
+		m.ResetDuration()
 		return nil
 	}
-	return fmt.Errorf("unknown File field %s", name)
+	return fmt.Errorf("unknown Call field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FileMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.message_attachment != nil {
-		edges = append(edges, file.EdgeMessageAttachment)
+func (m *CallMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.room != nil {
+		edges = append(edges, call.EdgeRoom)
 	}
-	if m.message_voice != nil {
-		edges = append(edges, file.EdgeMessageVoice)
+	if m.caller != nil {
+		edges = append(edges, call.EdgeCaller)
+	}
+	if m.participants != nil {
+		edges = append(edges, call.EdgeParticipants)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FileMutation) AddedIDs(name string) []ent.Value {
+func (m *CallMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case file.EdgeMessageAttachment:
-		if id := m.message_attachment; id != nil {
+	case call.EdgeRoom:
+		if id := m.room; id != nil {
 			return []ent.Value{*id}
 		}
-	case file.EdgeMessageVoice:
-		if id := m.message_voice; id != nil {
+	case call.EdgeCaller:
+		if id := m.caller; id != nil {
 			return []ent.Value{*id}
 		}
+	case call.EdgeParticipants:
+		ids := make([]ent.Value, 0, len(m.participants))
+		for id := range m.participants {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FileMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *CallMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedparticipants != nil {
+		edges = append(edges, call.EdgeParticipants)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FileMutation) RemovedIDs(name string) []ent.Value {
+func (m *CallMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case call.EdgeParticipants:
+		ids := make([]ent.Value, 0, len(m.removedparticipants))
+		for id := range m.removedparticipants {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FileMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedmessage_attachment {
-		edges = append(edges, file.EdgeMessageAttachment)
+func (m *CallMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedroom {
+		edges = append(edges, call.EdgeRoom)
 	}
-	if m.clearedmessage_voice {
-		edges = append(edges, file.EdgeMessageVoice)
+	if m.clearedcaller {
+		edges = append(edges, call.EdgeCaller)
+	}
+	if m.clearedparticipants {
+		edges = append(edges, call.EdgeParticipants)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FileMutation) EdgeCleared(name string) bool {
+func (m *CallMutation) EdgeCleared(name string) bool {
 	switch name {
-	case file.EdgeMessageAttachment:
-		return m.clearedmessage_attachment
-	case file.EdgeMessageVoice:
-		return m.clearedmessage_voice
+	case call.EdgeRoom:
+		return m.clearedroom
+	case call.EdgeCaller:
+		return m.clearedcaller
+	case call.EdgeParticipants:
+		return m.clearedparticipants
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FileMutation) ClearEdge(name string) error {
+func (m *CallMutation) ClearEdge(name string) error {
 	switch name {
-	case file.EdgeMessageAttachment:
-		m.ClearMessageAttachment()
+	case call.EdgeRoom:
+		m.ClearRoom()
 		return nil
-	case file.EdgeMessageVoice:
-		m.ClearMessageVoice()
+	case call.EdgeCaller:
+		m.ClearCaller()
 		return nil
 	}
-	return fmt.Errorf("unknown File unique edge %s", name)
+	return fmt.Errorf("unknown Call unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FileMutation) ResetEdge(name string) error {
+func (m *CallMutation) ResetEdge(name string) error {
 	switch name {
-	case file.EdgeMessageAttachment:
-		m.ResetMessageAttachment()
+	case call.EdgeRoom:
+		m.ResetRoom()
 		return nil
-	case file.EdgeMessageVoice:
-		m.ResetMessageVoice()
+	case call.EdgeCaller:
+		m.ResetCaller()
+		return nil
+	case call.EdgeParticipants:
+		m.ResetParticipants()
 		return nil
 	}
-	return fmt.Errorf("unknown File edge %s", name)
+	return fmt.Errorf("unknown Call edge %s", name)
 }
 
-// MessageMutation represents an operation that mutates the Message nodes in the graph.
-type MessageMutation struct {
+// CallParticipantMutation represents an operation that mutates the CallParticipant nodes in the graph.
+type CallParticipantMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *pulid.ID
-	content            *string
-	created_at         *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	voice              *pulid.ID
-	clearedvoice       bool
-	reply_to           *pulid.ID
-	clearedreply_to    bool
-	replies            map[pulid.ID]struct{}
-	removedreplies     map[pulid.ID]struct{}
-	clearedreplies     bool
-	attachments        map[pulid.ID]struct{}
-	removedattachments map[pulid.ID]struct{}
-	clearedattachments bool
-	links              map[pulid.ID]struct{}
-	removedlinks       map[pulid.ID]struct{}
-	clearedlinks       bool
-	user               *pulid.ID
-	cleareduser        bool
-	room               *pulid.ID
-	clearedroom        bool
-	done               bool
-	oldValue           func(context.Context) (*Message, error)
-	predicates         []predicate.Message
+	op            Op
+	typ           string
+	id            *pulid.ID
+	state         *callparticipant.State
+	invited_at    *time.Time
+	joined_at     *time.Time
+	left_at       *time.Time
+	clearedFields map[string]struct{}
+	call          *pulid.ID
+	clearedcall   bool
+	user          *pulid.ID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*CallParticipant, error)
+	predicates    []predicate.CallParticipant
 }
 
-var _ ent.Mutation = (*MessageMutation)(nil)
+var _ ent.Mutation = (*CallParticipantMutation)(nil)
 
-// messageOption allows management of the mutation configuration using functional options.
-type messageOption func(*MessageMutation)
+// callparticipantOption allows management of the mutation configuration using functional options.
+type callparticipantOption func(*CallParticipantMutation)
 
-// newMessageMutation creates new mutation for the Message entity.
-func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
-	m := &MessageMutation{
+// newCallParticipantMutation creates new mutation for the CallParticipant entity.
+func newCallParticipantMutation(c config, op Op, opts ...callparticipantOption) *CallParticipantMutation {
+	m := &CallParticipantMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessage,
+		typ:           TypeCallParticipant,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1554,20 +1758,20 @@ func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation
 	return m
 }
 
-// withMessageID sets the ID field of the mutation.
-func withMessageID(id pulid.ID) messageOption {
-	return func(m *MessageMutation) {
+// withCallParticipantID sets the ID field of the mutation.
+func withCallParticipantID(id pulid.ID) callparticipantOption {
+	return func(m *CallParticipantMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Message
+			value *CallParticipant
 		)
-		m.oldValue = func(ctx context.Context) (*Message, error) {
+		m.oldValue = func(ctx context.Context) (*CallParticipant, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Message.Get(ctx, id)
+					value, err = m.Client().CallParticipant.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1576,10 +1780,10 @@ func withMessageID(id pulid.ID) messageOption {
 	}
 }
 
-// withMessage sets the old Message of the mutation.
-func withMessage(node *Message) messageOption {
-	return func(m *MessageMutation) {
-		m.oldValue = func(context.Context) (*Message, error) {
+// withCallParticipant sets the old CallParticipant of the mutation.
+func withCallParticipant(node *CallParticipant) callparticipantOption {
+	return func(m *CallParticipantMutation) {
+		m.oldValue = func(context.Context) (*CallParticipant, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1588,7 +1792,7 @@ func withMessage(node *Message) messageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageMutation) Client() *Client {
+func (m CallParticipantMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1596,7 +1800,7 @@ func (m MessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageMutation) Tx() (*Tx, error) {
+func (m CallParticipantMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1606,14 +1810,14 @@ func (m MessageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Message entities.
-func (m *MessageMutation) SetID(id pulid.ID) {
+// operation is only accepted on creation of CallParticipant entities.
+func (m *CallParticipantMutation) SetID(id pulid.ID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageMutation) ID() (id pulid.ID, exists bool) {
+func (m *CallParticipantMutation) ID() (id pulid.ID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1624,7 +1828,7 @@ func (m *MessageMutation) ID() (id pulid.ID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
+func (m *CallParticipantMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1633,400 +1837,296 @@ func (m *MessageMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CallParticipant.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetContent sets the "content" field.
-func (m *MessageMutation) SetContent(s string) {
-	m.content = &s
+// SetState sets the "state" field.
+func (m *CallParticipantMutation) SetState(c callparticipant.State) {
+	m.state = &c
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *MessageMutation) Content() (r string, exists bool) {
-	v := m.content
+// State returns the value of the "state" field in the mutation.
+func (m *CallParticipantMutation) State() (r callparticipant.State, exists bool) {
+	v := m.state
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldState returns the old "state" field's value of the CallParticipant entity.
+// If the CallParticipant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *CallParticipantMutation) OldState(ctx context.Context) (v callparticipant.State, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldState requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
 	}
-	return oldValue.Content, nil
-}
-
-// ClearContent clears the value of the "content" field.
-func (m *MessageMutation) ClearContent() {
-	m.content = nil
-	m.clearedFields[message.FieldContent] = struct{}{}
-}
-
-// ContentCleared returns if the "content" field was cleared in this mutation.
-func (m *MessageMutation) ContentCleared() bool {
-	_, ok := m.clearedFields[message.FieldContent]
-	return ok
+	return oldValue.State, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *MessageMutation) ResetContent() {
-	m.content = nil
-	delete(m.clearedFields, message.FieldContent)
+// ResetState resets all changes to the "state" field.
+func (m *CallParticipantMutation) ResetState() {
+	m.state = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *MessageMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetCallID sets the "call_id" field.
+func (m *CallParticipantMutation) SetCallID(pu pulid.ID) {
+	m.call = &pu
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// CallID returns the value of the "call_id" field in the mutation.
+func (m *CallParticipantMutation) CallID() (r pulid.ID, exists bool) {
+	v := m.call
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldCallID returns the old "call_id" field's value of the CallParticipant entity.
+// If the CallParticipant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CallParticipantMutation) OldCallID(ctx context.Context) (v pulid.ID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCallID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCallID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCallID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.CallID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MessageMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetCallID resets all changes to the "call_id" field.
+func (m *CallParticipantMutation) ResetCallID() {
+	m.call = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *MessageMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetUserID sets the "user_id" field.
+func (m *CallParticipantMutation) SetUserID(pu pulid.ID) {
+	m.user = &pu
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *MessageMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *CallParticipantMutation) UserID() (r pulid.ID, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the CallParticipant entity.
+// If the CallParticipant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CallParticipantMutation) OldUserID(ctx context.Context) (v pulid.ID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *MessageMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+	return oldValue.UserID, nil
 }
 
-// SetVoiceID sets the "voice" edge to the MessageVoice entity by id.
-func (m *MessageMutation) SetVoiceID(id pulid.ID) {
-	m.voice = &id
+// ResetUserID resets all changes to the "user_id" field.
+func (m *CallParticipantMutation) ResetUserID() {
+	m.user = nil
 }
 
-// ClearVoice clears the "voice" edge to the MessageVoice entity.
-func (m *MessageMutation) ClearVoice() {
-	m.clearedvoice = true
+// SetInvitedAt sets the "invited_at" field.
+func (m *CallParticipantMutation) SetInvitedAt(t time.Time) {
+	m.invited_at = &t
 }
 
-// VoiceCleared reports if the "voice" edge to the MessageVoice entity was cleared.
-func (m *MessageMutation) VoiceCleared() bool {
-	return m.clearedvoice
+// InvitedAt returns the value of the "invited_at" field in the mutation.
+func (m *CallParticipantMutation) InvitedAt() (r time.Time, exists bool) {
+	v := m.invited_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// VoiceID returns the "voice" edge ID in the mutation.
-func (m *MessageMutation) VoiceID() (id pulid.ID, exists bool) {
-	if m.voice != nil {
-		return *m.voice, true
+// OldInvitedAt returns the old "invited_at" field's value of the CallParticipant entity.
+// If the CallParticipant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CallParticipantMutation) OldInvitedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInvitedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInvitedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInvitedAt: %w", err)
+	}
+	return oldValue.InvitedAt, nil
 }
 
-// VoiceIDs returns the "voice" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// VoiceID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) VoiceIDs() (ids []pulid.ID) {
-	if id := m.voice; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ResetInvitedAt resets all changes to the "invited_at" field.
+func (m *CallParticipantMutation) ResetInvitedAt() {
+	m.invited_at = nil
 }
 
-// ResetVoice resets all changes to the "voice" edge.
-func (m *MessageMutation) ResetVoice() {
-	m.voice = nil
-	m.clearedvoice = false
+// SetJoinedAt sets the "joined_at" field.
+func (m *CallParticipantMutation) SetJoinedAt(t time.Time) {
+	m.joined_at = &t
 }
 
-// SetReplyToID sets the "reply_to" edge to the Message entity by id.
-func (m *MessageMutation) SetReplyToID(id pulid.ID) {
-	m.reply_to = &id
-}
-
-// ClearReplyTo clears the "reply_to" edge to the Message entity.
-func (m *MessageMutation) ClearReplyTo() {
-	m.clearedreply_to = true
-}
-
-// ReplyToCleared reports if the "reply_to" edge to the Message entity was cleared.
-func (m *MessageMutation) ReplyToCleared() bool {
-	return m.clearedreply_to
-}
-
-// ReplyToID returns the "reply_to" edge ID in the mutation.
-func (m *MessageMutation) ReplyToID() (id pulid.ID, exists bool) {
-	if m.reply_to != nil {
-		return *m.reply_to, true
+// JoinedAt returns the value of the "joined_at" field in the mutation.
+func (m *CallParticipantMutation) JoinedAt() (r time.Time, exists bool) {
+	v := m.joined_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ReplyToIDs returns the "reply_to" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ReplyToID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) ReplyToIDs() (ids []pulid.ID) {
-	if id := m.reply_to; id != nil {
-		ids = append(ids, *id)
+// OldJoinedAt returns the old "joined_at" field's value of the CallParticipant entity.
+// If the CallParticipant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CallParticipantMutation) OldJoinedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetReplyTo resets all changes to the "reply_to" edge.
-func (m *MessageMutation) ResetReplyTo() {
-	m.reply_to = nil
-	m.clearedreply_to = false
-}
-
-// AddReplyIDs adds the "replies" edge to the Message entity by ids.
-func (m *MessageMutation) AddReplyIDs(ids ...pulid.ID) {
-	if m.replies == nil {
-		m.replies = make(map[pulid.ID]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.replies[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
 	}
+	return oldValue.JoinedAt, nil
 }
 
-// ClearReplies clears the "replies" edge to the Message entity.
-func (m *MessageMutation) ClearReplies() {
-	m.clearedreplies = true
-}
-
-// RepliesCleared reports if the "replies" edge to the Message entity was cleared.
-func (m *MessageMutation) RepliesCleared() bool {
-	return m.clearedreplies
-}
-
-// RemoveReplyIDs removes the "replies" edge to the Message entity by IDs.
-func (m *MessageMutation) RemoveReplyIDs(ids ...pulid.ID) {
-	if m.removedreplies == nil {
-		m.removedreplies = make(map[pulid.ID]struct{})
-	}
-	for i := range ids {
-		delete(m.replies, ids[i])
-		m.removedreplies[ids[i]] = struct{}{}
-	}
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (m *CallParticipantMutation) ClearJoinedAt() {
+	m.joined_at = nil
+	m.clearedFields[callparticipant.FieldJoinedAt] = struct{}{}
 }
 
-// RemovedReplies returns the removed IDs of the "replies" edge to the Message entity.
-func (m *MessageMutation) RemovedRepliesIDs() (ids []pulid.ID) {
-	for id := range m.removedreplies {
-		ids = append(ids, id)
-	}
-	return
+// JoinedAtCleared returns if the "joined_at" field was cleared in this mutation.
+func (m *CallParticipantMutation) JoinedAtCleared() bool {
+	_, ok := m.clearedFields[callparticipant.FieldJoinedAt]
+	return ok
 }
 
-// RepliesIDs returns the "replies" edge IDs in the mutation.
-func (m *MessageMutation) RepliesIDs() (ids []pulid.ID) {
-	for id := range m.replies {
-		ids = append(ids, id)
-	}
-	return
+// ResetJoinedAt resets all changes to the "joined_at" field.
+func (m *CallParticipantMutation) ResetJoinedAt() {
+	m.joined_at = nil
+	delete(m.clearedFields, callparticipant.FieldJoinedAt)
 }
 
-// ResetReplies resets all changes to the "replies" edge.
-func (m *MessageMutation) ResetReplies() {
-	m.replies = nil
-	m.clearedreplies = false
-	m.removedreplies = nil
+// SetLeftAt sets the "left_at" field.
+func (m *CallParticipantMutation) SetLeftAt(t time.Time) {
+	m.left_at = &t
 }
 
-// AddAttachmentIDs adds the "attachments" edge to the MessageAttachment entity by ids.
-func (m *MessageMutation) AddAttachmentIDs(ids ...pulid.ID) {
-	if m.attachments == nil {
-		m.attachments = make(map[pulid.ID]struct{})
-	}
-	for i := range ids {
-		m.attachments[ids[i]] = struct{}{}
+// LeftAt returns the value of the "left_at" field in the mutation.
+func (m *CallParticipantMutation) LeftAt() (r time.Time, exists bool) {
+	v := m.left_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearAttachments clears the "attachments" edge to the MessageAttachment entity.
-func (m *MessageMutation) ClearAttachments() {
-	m.clearedattachments = true
-}
-
-// AttachmentsCleared reports if the "attachments" edge to the MessageAttachment entity was cleared.
-func (m *MessageMutation) AttachmentsCleared() bool {
-	return m.clearedattachments
-}
-
-// RemoveAttachmentIDs removes the "attachments" edge to the MessageAttachment entity by IDs.
-func (m *MessageMutation) RemoveAttachmentIDs(ids ...pulid.ID) {
-	if m.removedattachments == nil {
-		m.removedattachments = make(map[pulid.ID]struct{})
-	}
-	for i := range ids {
-		delete(m.attachments, ids[i])
-		m.removedattachments[ids[i]] = struct{}{}
+// OldLeftAt returns the old "left_at" field's value of the CallParticipant entity.
+// If the CallParticipant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CallParticipantMutation) OldLeftAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeftAt is only allowed on UpdateOne operations")
 	}
-}
-
-// RemovedAttachments returns the removed IDs of the "attachments" edge to the MessageAttachment entity.
-func (m *MessageMutation) RemovedAttachmentsIDs() (ids []pulid.ID) {
-	for id := range m.removedattachments {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeftAt requires an ID field in the mutation")
 	}
-	return
-}
-
-// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
-func (m *MessageMutation) AttachmentsIDs() (ids []pulid.ID) {
-	for id := range m.attachments {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeftAt: %w", err)
 	}
-	return
-}
-
-// ResetAttachments resets all changes to the "attachments" edge.
-func (m *MessageMutation) ResetAttachments() {
-	m.attachments = nil
-	m.clearedattachments = false
-	m.removedattachments = nil
+	return oldValue.LeftAt, nil
 }
 
-// AddLinkIDs adds the "links" edge to the MessageLink entity by ids.
-func (m *MessageMutation) AddLinkIDs(ids ...pulid.ID) {
-	if m.links == nil {
-		m.links = make(map[pulid.ID]struct{})
-	}
-	for i := range ids {
-		m.links[ids[i]] = struct{}{}
-	}
+// ClearLeftAt clears the value of the "left_at" field.
+func (m *CallParticipantMutation) ClearLeftAt() {
+	m.left_at = nil
+	m.clearedFields[callparticipant.FieldLeftAt] = struct{}{}
 }
 
-// ClearLinks clears the "links" edge to the MessageLink entity.
-func (m *MessageMutation) ClearLinks() {
-	m.clearedlinks = true
+// LeftAtCleared returns if the "left_at" field was cleared in this mutation.
+func (m *CallParticipantMutation) LeftAtCleared() bool {
+	_, ok := m.clearedFields[callparticipant.FieldLeftAt]
+	return ok
 }
 
-// LinksCleared reports if the "links" edge to the MessageLink entity was cleared.
-func (m *MessageMutation) LinksCleared() bool {
-	return m.clearedlinks
+// ResetLeftAt resets all changes to the "left_at" field.
+func (m *CallParticipantMutation) ResetLeftAt() {
+	m.left_at = nil
+	delete(m.clearedFields, callparticipant.FieldLeftAt)
 }
 
-// RemoveLinkIDs removes the "links" edge to the MessageLink entity by IDs.
-func (m *MessageMutation) RemoveLinkIDs(ids ...pulid.ID) {
-	if m.removedlinks == nil {
-		m.removedlinks = make(map[pulid.ID]struct{})
-	}
-	for i := range ids {
-		delete(m.links, ids[i])
-		m.removedlinks[ids[i]] = struct{}{}
-	}
+// ClearCall clears the "call" edge to the Call entity.
+func (m *CallParticipantMutation) ClearCall() {
+	m.clearedcall = true
+	m.clearedFields[callparticipant.FieldCallID] = struct{}{}
 }
 
-// RemovedLinks returns the removed IDs of the "links" edge to the MessageLink entity.
-func (m *MessageMutation) RemovedLinksIDs() (ids []pulid.ID) {
-	for id := range m.removedlinks {
-		ids = append(ids, id)
-	}
-	return
+// CallCleared reports if the "call" edge to the Call entity was cleared.
+func (m *CallParticipantMutation) CallCleared() bool {
+	return m.clearedcall
 }
 
-// LinksIDs returns the "links" edge IDs in the mutation.
-func (m *MessageMutation) LinksIDs() (ids []pulid.ID) {
-	for id := range m.links {
-		ids = append(ids, id)
+// CallIDs returns the "call" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CallID instead. It exists only for internal usage by the builders.
+func (m *CallParticipantMutation) CallIDs() (ids []pulid.ID) {
+	if id := m.call; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetLinks resets all changes to the "links" edge.
-func (m *MessageMutation) ResetLinks() {
-	m.links = nil
-	m.clearedlinks = false
-	m.removedlinks = nil
-}
-
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *MessageMutation) SetUserID(id pulid.ID) {
-	m.user = &id
+// ResetCall resets all changes to the "call" edge.
+func (m *CallParticipantMutation) ResetCall() {
+	m.call = nil
+	m.clearedcall = false
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *MessageMutation) ClearUser() {
+func (m *CallParticipantMutation) ClearUser() {
 	m.cleareduser = true
+	m.clearedFields[callparticipant.FieldUserID] = struct{}{}
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *MessageMutation) UserCleared() bool {
+func (m *CallParticipantMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *MessageMutation) UserID() (id pulid.ID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
-	}
-	return
-}
-
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) UserIDs() (ids []pulid.ID) {
+func (m *CallParticipantMutation) UserIDs() (ids []pulid.ID) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -2034,59 +2134,20 @@ func (m *MessageMutation) UserIDs() (ids []pulid.ID) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *MessageMutation) ResetUser() {
+func (m *CallParticipantMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// SetRoomID sets the "room" edge to the Room entity by id.
-func (m *MessageMutation) SetRoomID(id pulid.ID) {
-	m.room = &id
-}
-
-// ClearRoom clears the "room" edge to the Room entity.
-func (m *MessageMutation) ClearRoom() {
-	m.clearedroom = true
-}
-
-// RoomCleared reports if the "room" edge to the Room entity was cleared.
-func (m *MessageMutation) RoomCleared() bool {
-	return m.clearedroom
-}
-
-// RoomID returns the "room" edge ID in the mutation.
-func (m *MessageMutation) RoomID() (id pulid.ID, exists bool) {
-	if m.room != nil {
-		return *m.room, true
-	}
-	return
-}
-
-// RoomIDs returns the "room" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RoomID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) RoomIDs() (ids []pulid.ID) {
-	if id := m.room; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetRoom resets all changes to the "room" edge.
-func (m *MessageMutation) ResetRoom() {
-	m.room = nil
-	m.clearedroom = false
-}
-
-// Where appends a list predicates to the MessageMutation builder.
-func (m *MessageMutation) Where(ps ...predicate.Message) {
+// Where appends a list predicates to the CallParticipantMutation builder.
+func (m *CallParticipantMutation) Where(ps ...predicate.CallParticipant) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CallParticipantMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Message, len(ps))
+func (m *CallParticipantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CallParticipant, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2094,33 +2155,42 @@ func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageMutation) Op() Op {
+func (m *CallParticipantMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageMutation) SetOp(op Op) {
+func (m *CallParticipantMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Message).
-func (m *MessageMutation) Type() string {
+// Type returns the node type of this mutation (CallParticipant).
+func (m *CallParticipantMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.content != nil {
-		fields = append(fields, message.FieldContent)
+func (m *CallParticipantMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.state != nil {
+		fields = append(fields, callparticipant.FieldState)
 	}
-	if m.created_at != nil {
-		fields = append(fields, message.FieldCreatedAt)
+	if m.call != nil {
+		fields = append(fields, callparticipant.FieldCallID)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, message.FieldUpdatedAt)
+	if m.user != nil {
+		fields = append(fields, callparticipant.FieldUserID)
+	}
+	if m.invited_at != nil {
+		fields = append(fields, callparticipant.FieldInvitedAt)
+	}
+	if m.joined_at != nil {
+		fields = append(fields, callparticipant.FieldJoinedAt)
+	}
+	if m.left_at != nil {
+		fields = append(fields, callparticipant.FieldLeftAt)
 	}
 	return fields
 }
@@ -2128,14 +2198,20 @@ func (m *MessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+func (m *CallParticipantMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case message.FieldContent:
-		return m.Content()
-	case message.FieldCreatedAt:
-		return m.CreatedAt()
-	case message.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case callparticipant.FieldState:
+		return m.State()
+	case callparticipant.FieldCallID:
+		return m.CallID()
+	case callparticipant.FieldUserID:
+		return m.UserID()
+	case callparticipant.FieldInvitedAt:
+		return m.InvitedAt()
+	case callparticipant.FieldJoinedAt:
+		return m.JoinedAt()
+	case callparticipant.FieldLeftAt:
+		return m.LeftAt()
 	}
 	return nil, false
 }
@@ -2143,356 +2219,282 @@ func (m *MessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CallParticipantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case message.FieldContent:
-		return m.OldContent(ctx)
-	case message.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case message.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case callparticipant.FieldState:
+		return m.OldState(ctx)
+	case callparticipant.FieldCallID:
+		return m.OldCallID(ctx)
+	case callparticipant.FieldUserID:
+		return m.OldUserID(ctx)
+	case callparticipant.FieldInvitedAt:
+		return m.OldInvitedAt(ctx)
+	case callparticipant.FieldJoinedAt:
+		return m.OldJoinedAt(ctx)
+	case callparticipant.FieldLeftAt:
+		return m.OldLeftAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Message field %s", name)
+	return nil, fmt.Errorf("unknown CallParticipant field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) SetField(name string, value ent.Value) error {
+func (m *CallParticipantMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case message.FieldContent:
-		v, ok := value.(string)
+	case callparticipant.FieldState:
+		v, ok := value.(callparticipant.State)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetState(v)
 		return nil
-	case message.FieldCreatedAt:
+	case callparticipant.FieldCallID:
+		v, ok := value.(pulid.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCallID(v)
+		return nil
+	case callparticipant.FieldUserID:
+		v, ok := value.(pulid.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case callparticipant.FieldInvitedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetInvitedAt(v)
 		return nil
-	case message.FieldUpdatedAt:
+	case callparticipant.FieldJoinedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetJoinedAt(v)
+		return nil
+	case callparticipant.FieldLeftAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeftAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown CallParticipant field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageMutation) AddedFields() []string {
+func (m *CallParticipantMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CallParticipantMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) AddField(name string, value ent.Value) error {
+func (m *CallParticipantMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Message numeric field %s", name)
+	return fmt.Errorf("unknown CallParticipant numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageMutation) ClearedFields() []string {
+func (m *CallParticipantMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(message.FieldContent) {
-		fields = append(fields, message.FieldContent)
+	if m.FieldCleared(callparticipant.FieldJoinedAt) {
+		fields = append(fields, callparticipant.FieldJoinedAt)
+	}
+	if m.FieldCleared(callparticipant.FieldLeftAt) {
+		fields = append(fields, callparticipant.FieldLeftAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageMutation) FieldCleared(name string) bool {
+func (m *CallParticipantMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageMutation) ClearField(name string) error {
+func (m *CallParticipantMutation) ClearField(name string) error {
 	switch name {
-	case message.FieldContent:
-		m.ClearContent()
+	case callparticipant.FieldJoinedAt:
+		m.ClearJoinedAt()
+		return nil
+	case callparticipant.FieldLeftAt:
+		m.ClearLeftAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Message nullable field %s", name)
+	return fmt.Errorf("unknown CallParticipant nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageMutation) ResetField(name string) error {
+func (m *CallParticipantMutation) ResetField(name string) error {
 	switch name {
-	case message.FieldContent:
-		m.ResetContent()
+	case callparticipant.FieldState:
+		m.ResetState()
 		return nil
-	case message.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case callparticipant.FieldCallID:
+		m.ResetCallID()
 		return nil
-	case message.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case callparticipant.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case callparticipant.FieldInvitedAt:
+		m.ResetInvitedAt()
+		return nil
+	case callparticipant.FieldJoinedAt:
+		m.ResetJoinedAt()
+		return nil
+	case callparticipant.FieldLeftAt:
+		m.ResetLeftAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown CallParticipant field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.voice != nil {
-		edges = append(edges, message.EdgeVoice)
-	}
-	if m.reply_to != nil {
-		edges = append(edges, message.EdgeReplyTo)
-	}
-	if m.replies != nil {
-		edges = append(edges, message.EdgeReplies)
-	}
-	if m.attachments != nil {
-		edges = append(edges, message.EdgeAttachments)
-	}
-	if m.links != nil {
-		edges = append(edges, message.EdgeLinks)
+func (m *CallParticipantMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.call != nil {
+		edges = append(edges, callparticipant.EdgeCall)
 	}
 	if m.user != nil {
-		edges = append(edges, message.EdgeUser)
-	}
-	if m.room != nil {
-		edges = append(edges, message.EdgeRoom)
+		edges = append(edges, callparticipant.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+func (m *CallParticipantMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case message.EdgeVoice:
-		if id := m.voice; id != nil {
-			return []ent.Value{*id}
-		}
-	case message.EdgeReplyTo:
-		if id := m.reply_to; id != nil {
+	case callparticipant.EdgeCall:
+		if id := m.call; id != nil {
 			return []ent.Value{*id}
 		}
-	case message.EdgeReplies:
-		ids := make([]ent.Value, 0, len(m.replies))
-		for id := range m.replies {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeAttachments:
-		ids := make([]ent.Value, 0, len(m.attachments))
-		for id := range m.attachments {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeLinks:
-		ids := make([]ent.Value, 0, len(m.links))
-		for id := range m.links {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeUser:
+	case callparticipant.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case message.EdgeRoom:
-		if id := m.room; id != nil {
-			return []ent.Value{*id}
-		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.removedreplies != nil {
-		edges = append(edges, message.EdgeReplies)
-	}
-	if m.removedattachments != nil {
-		edges = append(edges, message.EdgeAttachments)
-	}
-	if m.removedlinks != nil {
-		edges = append(edges, message.EdgeLinks)
-	}
+func (m *CallParticipantMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case message.EdgeReplies:
-		ids := make([]ent.Value, 0, len(m.removedreplies))
-		for id := range m.removedreplies {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeAttachments:
-		ids := make([]ent.Value, 0, len(m.removedattachments))
-		for id := range m.removedattachments {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeLinks:
-		ids := make([]ent.Value, 0, len(m.removedlinks))
-		for id := range m.removedlinks {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *CallParticipantMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.clearedvoice {
-		edges = append(edges, message.EdgeVoice)
-	}
-	if m.clearedreply_to {
-		edges = append(edges, message.EdgeReplyTo)
-	}
-	if m.clearedreplies {
-		edges = append(edges, message.EdgeReplies)
-	}
-	if m.clearedattachments {
-		edges = append(edges, message.EdgeAttachments)
-	}
-	if m.clearedlinks {
-		edges = append(edges, message.EdgeLinks)
+func (m *CallParticipantMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcall {
+		edges = append(edges, callparticipant.EdgeCall)
 	}
 	if m.cleareduser {
-		edges = append(edges, message.EdgeUser)
-	}
-	if m.clearedroom {
-		edges = append(edges, message.EdgeRoom)
+		edges = append(edges, callparticipant.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageMutation) EdgeCleared(name string) bool {
+func (m *CallParticipantMutation) EdgeCleared(name string) bool {
 	switch name {
-	case message.EdgeVoice:
-		return m.clearedvoice
-	case message.EdgeReplyTo:
-		return m.clearedreply_to
-	case message.EdgeReplies:
-		return m.clearedreplies
-	case message.EdgeAttachments:
-		return m.clearedattachments
-	case message.EdgeLinks:
-		return m.clearedlinks
-	case message.EdgeUser:
+	case callparticipant.EdgeCall:
+		return m.clearedcall
+	case callparticipant.EdgeUser:
 		return m.cleareduser
-	case message.EdgeRoom:
-		return m.clearedroom
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageMutation) ClearEdge(name string) error {
+func (m *CallParticipantMutation) ClearEdge(name string) error {
 	switch name {
-	case message.EdgeVoice:
-		m.ClearVoice()
+	case callparticipant.EdgeCall:
+		m.ClearCall()
 		return nil
-	case message.EdgeReplyTo:
-		m.ClearReplyTo()
-		return nil
-	case message.EdgeUser:
+	case callparticipant.EdgeUser:
 		m.ClearUser()
 		return nil
-	case message.EdgeRoom:
-		m.ClearRoom()
-		return nil
 	}
-	return fmt.Errorf("unknown Message unique edge %s", name)
+	return fmt.Errorf("unknown CallParticipant unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageMutation) ResetEdge(name string) error {
+func (m *CallParticipantMutation) ResetEdge(name string) error {
 	switch name {
-	case message.EdgeVoice:
-		m.ResetVoice()
-		return nil
-	case message.EdgeReplyTo:
-		m.ResetReplyTo()
-		return nil
-	case message.EdgeReplies:
-		m.ResetReplies()
-		return nil
-	case message.EdgeAttachments:
-		m.ResetAttachments()
+	case callparticipant.EdgeCall:
+		m.ResetCall()
 		return nil
-	case message.EdgeLinks:
-		m.ResetLinks()
-		return nil
-	case message.EdgeUser:
+	case callparticipant.EdgeUser:
 		m.ResetUser()
 		return nil
-	case message.EdgeRoom:
-		m.ResetRoom()
-		return nil
 	}
-	return fmt.Errorf("unknown Message edge %s", name)
+	return fmt.Errorf("unknown CallParticipant edge %s", name)
 }
 
-// MessageAttachmentMutation represents an operation that mutates the MessageAttachment nodes in the graph.
-type MessageAttachmentMutation struct {
+// ContactPinMutation represents an operation that mutates the ContactPin nodes in the graph.
+type ContactPinMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *pulid.ID
-	_type          *messageattachment.Type
-	_order         *uint
-	add_order      *int
-	attached_at    *time.Time
-	clearedFields  map[string]struct{}
-	room           *pulid.ID
-	clearedroom    bool
-	message        *pulid.ID
-	clearedmessage bool
-	file           *pulid.ID
-	clearedfile    bool
-	done           bool
-	oldValue       func(context.Context) (*MessageAttachment, error)
-	predicates     []predicate.MessageAttachment
-}
-
-var _ ent.Mutation = (*MessageAttachmentMutation)(nil)
-
-// messageattachmentOption allows management of the mutation configuration using functional options.
-type messageattachmentOption func(*MessageAttachmentMutation)
+	op            Op
+	typ           string
+	id            *pulid.ID
+	code          *string
+	expires_at    *time.Time
+	max_uses      *int
+	addmax_uses   *int
+	uses          *int
+	adduses       *int
+	revoked_at    *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *pulid.ID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*ContactPin, error)
+	predicates    []predicate.ContactPin
+}
 
-// newMessageAttachmentMutation creates new mutation for the MessageAttachment entity.
-func newMessageAttachmentMutation(c config, op Op, opts ...messageattachmentOption) *MessageAttachmentMutation {
-	m := &MessageAttachmentMutation{
+var _ ent.Mutation = (*ContactPinMutation)(nil)
+
+// contactpinOption allows management of the mutation configuration using functional options.
+type contactpinOption func(*ContactPinMutation)
+
+// newContactPinMutation creates new mutation for the ContactPin entity.
+func newContactPinMutation(c config, op Op, opts ...contactpinOption) *ContactPinMutation {
+	m := &ContactPinMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessageAttachment,
+		typ:           TypeContactPin,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2501,20 +2503,20 @@ func newMessageAttachmentMutation(c config, op Op, opts ...messageattachmentOpti
 	return m
 }
 
-// withMessageAttachmentID sets the ID field of the mutation.
-func withMessageAttachmentID(id pulid.ID) messageattachmentOption {
-	return func(m *MessageAttachmentMutation) {
+// withContactPinID sets the ID field of the mutation.
+func withContactPinID(id pulid.ID) contactpinOption {
+	return func(m *ContactPinMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MessageAttachment
+			value *ContactPin
 		)
-		m.oldValue = func(ctx context.Context) (*MessageAttachment, error) {
+		m.oldValue = func(ctx context.Context) (*ContactPin, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MessageAttachment.Get(ctx, id)
+					value, err = m.Client().ContactPin.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2523,10 +2525,10 @@ func withMessageAttachmentID(id pulid.ID) messageattachmentOption {
 	}
 }
 
-// withMessageAttachment sets the old MessageAttachment of the mutation.
-func withMessageAttachment(node *MessageAttachment) messageattachmentOption {
-	return func(m *MessageAttachmentMutation) {
-		m.oldValue = func(context.Context) (*MessageAttachment, error) {
+// withContactPin sets the old ContactPin of the mutation.
+func withContactPin(node *ContactPin) contactpinOption {
+	return func(m *ContactPinMutation) {
+		m.oldValue = func(context.Context) (*ContactPin, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2535,7 +2537,7 @@ func withMessageAttachment(node *MessageAttachment) messageattachmentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageAttachmentMutation) Client() *Client {
+func (m ContactPinMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2543,7 +2545,7 @@ func (m MessageAttachmentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageAttachmentMutation) Tx() (*Tx, error) {
+func (m ContactPinMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2553,14 +2555,14 @@ func (m MessageAttachmentMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of MessageAttachment entities.
-func (m *MessageAttachmentMutation) SetID(id pulid.ID) {
+// operation is only accepted on creation of ContactPin entities.
+func (m *ContactPinMutation) SetID(id pulid.ID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageAttachmentMutation) ID() (id pulid.ID, exists bool) {
+func (m *ContactPinMutation) ID() (id pulid.ID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2571,7 +2573,7 @@ func (m *MessageAttachmentMutation) ID() (id pulid.ID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageAttachmentMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
+func (m *ContactPinMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2580,266 +2582,380 @@ func (m *MessageAttachmentMutation) IDs(ctx context.Context) ([]pulid.ID, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MessageAttachment.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ContactPin.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetType sets the "type" field.
-func (m *MessageAttachmentMutation) SetType(value messageattachment.Type) {
-	m._type = &value
+// SetCode sets the "code" field.
+func (m *ContactPinMutation) SetCode(s string) {
+	m.code = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *MessageAttachmentMutation) GetType() (r messageattachment.Type, exists bool) {
-	v := m._type
+// Code returns the value of the "code" field in the mutation.
+func (m *ContactPinMutation) Code() (r string, exists bool) {
+	v := m.code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the MessageAttachment entity.
-// If the MessageAttachment object wasn't provided to the builder, the object is fetched from the database.
+// OldCode returns the old "code" field's value of the ContactPin entity.
+// If the ContactPin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageAttachmentMutation) OldType(ctx context.Context) (v messageattachment.Type, err error) {
+func (m *ContactPinMutation) OldCode(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Code, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *MessageAttachmentMutation) ResetType() {
-	m._type = nil
+// ResetCode resets all changes to the "code" field.
+func (m *ContactPinMutation) ResetCode() {
+	m.code = nil
 }
 
-// SetOrder sets the "order" field.
-func (m *MessageAttachmentMutation) SetOrder(u uint) {
-	m._order = &u
-	m.add_order = nil
+// SetUserID sets the "user_id" field.
+func (m *ContactPinMutation) SetUserID(pu pulid.ID) {
+	m.user = &pu
 }
 
-// Order returns the value of the "order" field in the mutation.
-func (m *MessageAttachmentMutation) Order() (r uint, exists bool) {
-	v := m._order
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ContactPinMutation) UserID() (r pulid.ID, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOrder returns the old "order" field's value of the MessageAttachment entity.
-// If the MessageAttachment object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the ContactPin entity.
+// If the ContactPin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageAttachmentMutation) OldOrder(ctx context.Context) (v uint, err error) {
+func (m *ContactPinMutation) OldUserID(ctx context.Context) (v pulid.ID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOrder is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOrder requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrder: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Order, nil
+	return oldValue.UserID, nil
 }
 
-// AddOrder adds u to the "order" field.
-func (m *MessageAttachmentMutation) AddOrder(u int) {
-	if m.add_order != nil {
-		*m.add_order += u
-	} else {
-		m.add_order = &u
-	}
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ContactPinMutation) ResetUserID() {
+	m.user = nil
 }
 
-// AddedOrder returns the value that was added to the "order" field in this mutation.
-func (m *MessageAttachmentMutation) AddedOrder() (r int, exists bool) {
-	v := m.add_order
+// SetExpiresAt sets the "expires_at" field.
+func (m *ContactPinMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ContactPinMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetOrder resets all changes to the "order" field.
-func (m *MessageAttachmentMutation) ResetOrder() {
-	m._order = nil
-	m.add_order = nil
+// OldExpiresAt returns the old "expires_at" field's value of the ContactPin entity.
+// If the ContactPin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContactPinMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
 }
 
-// SetAttachedAt sets the "attached_at" field.
-func (m *MessageAttachmentMutation) SetAttachedAt(t time.Time) {
-	m.attached_at = &t
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *ContactPinMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[contactpin.FieldExpiresAt] = struct{}{}
 }
 
-// AttachedAt returns the value of the "attached_at" field in the mutation.
-func (m *MessageAttachmentMutation) AttachedAt() (r time.Time, exists bool) {
-	v := m.attached_at
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *ContactPinMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[contactpin.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ContactPinMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, contactpin.FieldExpiresAt)
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (m *ContactPinMutation) SetMaxUses(i int) {
+	m.max_uses = &i
+	m.addmax_uses = nil
+}
+
+// MaxUses returns the value of the "max_uses" field in the mutation.
+func (m *ContactPinMutation) MaxUses() (r int, exists bool) {
+	v := m.max_uses
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAttachedAt returns the old "attached_at" field's value of the MessageAttachment entity.
-// If the MessageAttachment object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxUses returns the old "max_uses" field's value of the ContactPin entity.
+// If the ContactPin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageAttachmentMutation) OldAttachedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ContactPinMutation) OldMaxUses(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAttachedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxUses is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAttachedAt requires an ID field in the mutation")
+		return v, errors.New("OldMaxUses requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAttachedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxUses: %w", err)
 	}
-	return oldValue.AttachedAt, nil
+	return oldValue.MaxUses, nil
 }
 
-// ResetAttachedAt resets all changes to the "attached_at" field.
-func (m *MessageAttachmentMutation) ResetAttachedAt() {
-	m.attached_at = nil
+// AddMaxUses adds i to the "max_uses" field.
+func (m *ContactPinMutation) AddMaxUses(i int) {
+	if m.addmax_uses != nil {
+		*m.addmax_uses += i
+	} else {
+		m.addmax_uses = &i
+	}
 }
 
-// SetRoomID sets the "room" edge to the Room entity by id.
-func (m *MessageAttachmentMutation) SetRoomID(id pulid.ID) {
-	m.room = &id
+// AddedMaxUses returns the value that was added to the "max_uses" field in this mutation.
+func (m *ContactPinMutation) AddedMaxUses() (r int, exists bool) {
+	v := m.addmax_uses
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearRoom clears the "room" edge to the Room entity.
-func (m *MessageAttachmentMutation) ClearRoom() {
-	m.clearedroom = true
+// ClearMaxUses clears the value of the "max_uses" field.
+func (m *ContactPinMutation) ClearMaxUses() {
+	m.max_uses = nil
+	m.addmax_uses = nil
+	m.clearedFields[contactpin.FieldMaxUses] = struct{}{}
 }
 
-// RoomCleared reports if the "room" edge to the Room entity was cleared.
-func (m *MessageAttachmentMutation) RoomCleared() bool {
-	return m.clearedroom
+// MaxUsesCleared returns if the "max_uses" field was cleared in this mutation.
+func (m *ContactPinMutation) MaxUsesCleared() bool {
+	_, ok := m.clearedFields[contactpin.FieldMaxUses]
+	return ok
 }
 
-// RoomID returns the "room" edge ID in the mutation.
-func (m *MessageAttachmentMutation) RoomID() (id pulid.ID, exists bool) {
-	if m.room != nil {
-		return *m.room, true
+// ResetMaxUses resets all changes to the "max_uses" field.
+func (m *ContactPinMutation) ResetMaxUses() {
+	m.max_uses = nil
+	m.addmax_uses = nil
+	delete(m.clearedFields, contactpin.FieldMaxUses)
+}
+
+// SetUses sets the "uses" field.
+func (m *ContactPinMutation) SetUses(i int) {
+	m.uses = &i
+	m.adduses = nil
+}
+
+// Uses returns the value of the "uses" field in the mutation.
+func (m *ContactPinMutation) Uses() (r int, exists bool) {
+	v := m.uses
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// RoomIDs returns the "room" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RoomID instead. It exists only for internal usage by the builders.
-func (m *MessageAttachmentMutation) RoomIDs() (ids []pulid.ID) {
-	if id := m.room; id != nil {
-		ids = append(ids, *id)
+// OldUses returns the old "uses" field's value of the ContactPin entity.
+// If the ContactPin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContactPinMutation) OldUses(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUses is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUses: %w", err)
+	}
+	return oldValue.Uses, nil
 }
 
-// ResetRoom resets all changes to the "room" edge.
-func (m *MessageAttachmentMutation) ResetRoom() {
-	m.room = nil
-	m.clearedroom = false
+// AddUses adds i to the "uses" field.
+func (m *ContactPinMutation) AddUses(i int) {
+	if m.adduses != nil {
+		*m.adduses += i
+	} else {
+		m.adduses = &i
+	}
 }
 
-// SetMessageID sets the "message" edge to the Message entity by id.
-func (m *MessageAttachmentMutation) SetMessageID(id pulid.ID) {
-	m.message = &id
+// AddedUses returns the value that was added to the "uses" field in this mutation.
+func (m *ContactPinMutation) AddedUses() (r int, exists bool) {
+	v := m.adduses
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearMessage clears the "message" edge to the Message entity.
-func (m *MessageAttachmentMutation) ClearMessage() {
-	m.clearedmessage = true
+// ResetUses resets all changes to the "uses" field.
+func (m *ContactPinMutation) ResetUses() {
+	m.uses = nil
+	m.adduses = nil
 }
 
-// MessageCleared reports if the "message" edge to the Message entity was cleared.
-func (m *MessageAttachmentMutation) MessageCleared() bool {
-	return m.clearedmessage
+// SetRevokedAt sets the "revoked_at" field.
+func (m *ContactPinMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
 }
 
-// MessageID returns the "message" edge ID in the mutation.
-func (m *MessageAttachmentMutation) MessageID() (id pulid.ID, exists bool) {
-	if m.message != nil {
-		return *m.message, true
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *ContactPinMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// MessageIDs returns the "message" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MessageID instead. It exists only for internal usage by the builders.
-func (m *MessageAttachmentMutation) MessageIDs() (ids []pulid.ID) {
-	if id := m.message; id != nil {
-		ids = append(ids, *id)
+// OldRevokedAt returns the old "revoked_at" field's value of the ContactPin entity.
+// If the ContactPin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContactPinMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
 }
 
-// ResetMessage resets all changes to the "message" edge.
-func (m *MessageAttachmentMutation) ResetMessage() {
-	m.message = nil
-	m.clearedmessage = false
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *ContactPinMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[contactpin.FieldRevokedAt] = struct{}{}
 }
 
-// SetFileID sets the "file" edge to the File entity by id.
-func (m *MessageAttachmentMutation) SetFileID(id pulid.ID) {
-	m.file = &id
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *ContactPinMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[contactpin.FieldRevokedAt]
+	return ok
 }
 
-// ClearFile clears the "file" edge to the File entity.
-func (m *MessageAttachmentMutation) ClearFile() {
-	m.clearedfile = true
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *ContactPinMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, contactpin.FieldRevokedAt)
 }
 
-// FileCleared reports if the "file" edge to the File entity was cleared.
-func (m *MessageAttachmentMutation) FileCleared() bool {
-	return m.clearedfile
+// SetCreatedAt sets the "created_at" field.
+func (m *ContactPinMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// FileID returns the "file" edge ID in the mutation.
-func (m *MessageAttachmentMutation) FileID() (id pulid.ID, exists bool) {
-	if m.file != nil {
-		return *m.file, true
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ContactPinMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// FileIDs returns the "file" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FileID instead. It exists only for internal usage by the builders.
-func (m *MessageAttachmentMutation) FileIDs() (ids []pulid.ID) {
-	if id := m.file; id != nil {
-		ids = append(ids, *id)
+// OldCreatedAt returns the old "created_at" field's value of the ContactPin entity.
+// If the ContactPin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContactPinMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetFile resets all changes to the "file" edge.
-func (m *MessageAttachmentMutation) ResetFile() {
-	m.file = nil
-	m.clearedfile = false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ContactPinMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the MessageAttachmentMutation builder.
-func (m *MessageAttachmentMutation) Where(ps ...predicate.MessageAttachment) {
-	m.predicates = append(m.predicates, ps...)
+// ClearUser clears the "user" edge to the User entity.
+func (m *ContactPinMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[contactpin.FieldUserID] = struct{}{}
 }
 
-// WhereP appends storage-level predicates to the MessageAttachmentMutation builder. Using this method,
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ContactPinMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ContactPinMutation) UserIDs() (ids []pulid.ID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ContactPinMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the ContactPinMutation builder.
+func (m *ContactPinMutation) Where(ps ...predicate.ContactPin) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ContactPinMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageAttachmentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MessageAttachment, len(ps))
+func (m *ContactPinMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ContactPin, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2847,33 +2963,45 @@ func (m *MessageAttachmentMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageAttachmentMutation) Op() Op {
+func (m *ContactPinMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageAttachmentMutation) SetOp(op Op) {
+func (m *ContactPinMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MessageAttachment).
-func (m *MessageAttachmentMutation) Type() string {
+// Type returns the node type of this mutation (ContactPin).
+func (m *ContactPinMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageAttachmentMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m._type != nil {
-		fields = append(fields, messageattachment.FieldType)
+func (m *ContactPinMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.code != nil {
+		fields = append(fields, contactpin.FieldCode)
 	}
-	if m._order != nil {
-		fields = append(fields, messageattachment.FieldOrder)
+	if m.user != nil {
+		fields = append(fields, contactpin.FieldUserID)
 	}
-	if m.attached_at != nil {
-		fields = append(fields, messageattachment.FieldAttachedAt)
+	if m.expires_at != nil {
+		fields = append(fields, contactpin.FieldExpiresAt)
+	}
+	if m.max_uses != nil {
+		fields = append(fields, contactpin.FieldMaxUses)
+	}
+	if m.uses != nil {
+		fields = append(fields, contactpin.FieldUses)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, contactpin.FieldRevokedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, contactpin.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2881,14 +3009,22 @@ func (m *MessageAttachmentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageAttachmentMutation) Field(name string) (ent.Value, bool) {
+func (m *ContactPinMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case messageattachment.FieldType:
-		return m.GetType()
-	case messageattachment.FieldOrder:
-		return m.Order()
-	case messageattachment.FieldAttachedAt:
-		return m.AttachedAt()
+	case contactpin.FieldCode:
+		return m.Code()
+	case contactpin.FieldUserID:
+		return m.UserID()
+	case contactpin.FieldExpiresAt:
+		return m.ExpiresAt()
+	case contactpin.FieldMaxUses:
+		return m.MaxUses()
+	case contactpin.FieldUses:
+		return m.Uses()
+	case contactpin.FieldRevokedAt:
+		return m.RevokedAt()
+	case contactpin.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -2896,54 +3032,93 @@ func (m *MessageAttachmentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageAttachmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ContactPinMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case messageattachment.FieldType:
-		return m.OldType(ctx)
-	case messageattachment.FieldOrder:
-		return m.OldOrder(ctx)
-	case messageattachment.FieldAttachedAt:
-		return m.OldAttachedAt(ctx)
+	case contactpin.FieldCode:
+		return m.OldCode(ctx)
+	case contactpin.FieldUserID:
+		return m.OldUserID(ctx)
+	case contactpin.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case contactpin.FieldMaxUses:
+		return m.OldMaxUses(ctx)
+	case contactpin.FieldUses:
+		return m.OldUses(ctx)
+	case contactpin.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case contactpin.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown MessageAttachment field %s", name)
+	return nil, fmt.Errorf("unknown ContactPin field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageAttachmentMutation) SetField(name string, value ent.Value) error {
+func (m *ContactPinMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case messageattachment.FieldType:
-		v, ok := value.(messageattachment.Type)
+	case contactpin.FieldCode:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetCode(v)
 		return nil
-	case messageattachment.FieldOrder:
-		v, ok := value.(uint)
+	case contactpin.FieldUserID:
+		v, ok := value.(pulid.ID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOrder(v)
+		m.SetUserID(v)
 		return nil
-	case messageattachment.FieldAttachedAt:
+	case contactpin.FieldExpiresAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAttachedAt(v)
+		m.SetExpiresAt(v)
+		return nil
+	case contactpin.FieldMaxUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxUses(v)
+		return nil
+	case contactpin.FieldUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUses(v)
+		return nil
+	case contactpin.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case contactpin.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MessageAttachment field %s", name)
+	return fmt.Errorf("unknown ContactPin field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageAttachmentMutation) AddedFields() []string {
+func (m *ContactPinMutation) AddedFields() []string {
 	var fields []string
-	if m.add_order != nil {
-		fields = append(fields, messageattachment.FieldOrder)
+	if m.addmax_uses != nil {
+		fields = append(fields, contactpin.FieldMaxUses)
+	}
+	if m.adduses != nil {
+		fields = append(fields, contactpin.FieldUses)
 	}
 	return fields
 }
@@ -2951,10 +3126,12 @@ func (m *MessageAttachmentMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageAttachmentMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ContactPinMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case messageattachment.FieldOrder:
-		return m.AddedOrder()
+	case contactpin.FieldMaxUses:
+		return m.AddedMaxUses()
+	case contactpin.FieldUses:
+		return m.AddedUses()
 	}
 	return nil, false
 }
@@ -2962,84 +3139,110 @@ func (m *MessageAttachmentMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageAttachmentMutation) AddField(name string, value ent.Value) error {
+func (m *ContactPinMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case messageattachment.FieldOrder:
+	case contactpin.FieldMaxUses:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddOrder(v)
+		m.AddMaxUses(v)
+		return nil
+	case contactpin.FieldUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUses(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MessageAttachment numeric field %s", name)
+	return fmt.Errorf("unknown ContactPin numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageAttachmentMutation) ClearedFields() []string {
-	return nil
+func (m *ContactPinMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(contactpin.FieldExpiresAt) {
+		fields = append(fields, contactpin.FieldExpiresAt)
+	}
+	if m.FieldCleared(contactpin.FieldMaxUses) {
+		fields = append(fields, contactpin.FieldMaxUses)
+	}
+	if m.FieldCleared(contactpin.FieldRevokedAt) {
+		fields = append(fields, contactpin.FieldRevokedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageAttachmentMutation) FieldCleared(name string) bool {
+func (m *ContactPinMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageAttachmentMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MessageAttachment nullable field %s", name)
+func (m *ContactPinMutation) ClearField(name string) error {
+	switch name {
+	case contactpin.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case contactpin.FieldMaxUses:
+		m.ClearMaxUses()
+		return nil
+	case contactpin.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ContactPin nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageAttachmentMutation) ResetField(name string) error {
+func (m *ContactPinMutation) ResetField(name string) error {
 	switch name {
-	case messageattachment.FieldType:
-		m.ResetType()
+	case contactpin.FieldCode:
+		m.ResetCode()
 		return nil
-	case messageattachment.FieldOrder:
-		m.ResetOrder()
+	case contactpin.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case messageattachment.FieldAttachedAt:
-		m.ResetAttachedAt()
+	case contactpin.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case contactpin.FieldMaxUses:
+		m.ResetMaxUses()
+		return nil
+	case contactpin.FieldUses:
+		m.ResetUses()
+		return nil
+	case contactpin.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case contactpin.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageAttachment field %s", name)
+	return fmt.Errorf("unknown ContactPin field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageAttachmentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.room != nil {
-		edges = append(edges, messageattachment.EdgeRoom)
-	}
-	if m.message != nil {
-		edges = append(edges, messageattachment.EdgeMessage)
-	}
-	if m.file != nil {
-		edges = append(edges, messageattachment.EdgeFile)
+func (m *ContactPinMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, contactpin.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageAttachmentMutation) AddedIDs(name string) []ent.Value {
+func (m *ContactPinMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case messageattachment.EdgeRoom:
-		if id := m.room; id != nil {
-			return []ent.Value{*id}
-		}
-	case messageattachment.EdgeMessage:
-		if id := m.message; id != nil {
-			return []ent.Value{*id}
-		}
-	case messageattachment.EdgeFile:
-		if id := m.file; id != nil {
+	case contactpin.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -3047,113 +3250,88 @@ func (m *MessageAttachmentMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageAttachmentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *ContactPinMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageAttachmentMutation) RemovedIDs(name string) []ent.Value {
+func (m *ContactPinMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageAttachmentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedroom {
-		edges = append(edges, messageattachment.EdgeRoom)
-	}
-	if m.clearedmessage {
-		edges = append(edges, messageattachment.EdgeMessage)
-	}
-	if m.clearedfile {
-		edges = append(edges, messageattachment.EdgeFile)
+func (m *ContactPinMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, contactpin.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageAttachmentMutation) EdgeCleared(name string) bool {
+func (m *ContactPinMutation) EdgeCleared(name string) bool {
 	switch name {
-	case messageattachment.EdgeRoom:
-		return m.clearedroom
-	case messageattachment.EdgeMessage:
-		return m.clearedmessage
-	case messageattachment.EdgeFile:
-		return m.clearedfile
+	case contactpin.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageAttachmentMutation) ClearEdge(name string) error {
+func (m *ContactPinMutation) ClearEdge(name string) error {
 	switch name {
-	case messageattachment.EdgeRoom:
-		m.ClearRoom()
-		return nil
-	case messageattachment.EdgeMessage:
-		m.ClearMessage()
-		return nil
-	case messageattachment.EdgeFile:
-		m.ClearFile()
+	case contactpin.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageAttachment unique edge %s", name)
+	return fmt.Errorf("unknown ContactPin unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageAttachmentMutation) ResetEdge(name string) error {
+func (m *ContactPinMutation) ResetEdge(name string) error {
 	switch name {
-	case messageattachment.EdgeRoom:
-		m.ResetRoom()
-		return nil
-	case messageattachment.EdgeMessage:
-		m.ResetMessage()
-		return nil
-	case messageattachment.EdgeFile:
-		m.ResetFile()
+	case contactpin.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageAttachment edge %s", name)
+	return fmt.Errorf("unknown ContactPin edge %s", name)
 }
 
-// MessageLinkMutation represents an operation that mutates the MessageLink nodes in the graph.
-type MessageLinkMutation struct {
+// ContactRequestMutation represents an operation that mutates the ContactRequest nodes in the graph.
+type ContactRequestMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *pulid.ID
-	link           *string
-	title          *string
-	description    *string
-	image_url      *string
-	created_at     *time.Time
-	updated_at     *time.Time
-	clearedFields  map[string]struct{}
-	room           *pulid.ID
-	clearedroom    bool
-	message        *pulid.ID
-	clearedmessage bool
-	done           bool
-	oldValue       func(context.Context) (*MessageLink, error)
-	predicates     []predicate.MessageLink
-}
-
-var _ ent.Mutation = (*MessageLinkMutation)(nil)
-
-// messagelinkOption allows management of the mutation configuration using functional options.
-type messagelinkOption func(*MessageLinkMutation)
-
-// newMessageLinkMutation creates new mutation for the MessageLink entity.
-func newMessageLinkMutation(c config, op Op, opts ...messagelinkOption) *MessageLinkMutation {
-	m := &MessageLinkMutation{
+	op              Op
+	typ             string
+	id              *pulid.ID
+	status          *contactrequest.Status
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	sender          *pulid.ID
+	clearedsender   bool
+	receiver        *pulid.ID
+	clearedreceiver bool
+	done            bool
+	oldValue        func(context.Context) (*ContactRequest, error)
+	predicates      []predicate.ContactRequest
+}
+
+var _ ent.Mutation = (*ContactRequestMutation)(nil)
+
+// contactrequestOption allows management of the mutation configuration using functional options.
+type contactrequestOption func(*ContactRequestMutation)
+
+// newContactRequestMutation creates new mutation for the ContactRequest entity.
+func newContactRequestMutation(c config, op Op, opts ...contactrequestOption) *ContactRequestMutation {
+	m := &ContactRequestMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessageLink,
+		typ:           TypeContactRequest,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3162,20 +3340,20 @@ func newMessageLinkMutation(c config, op Op, opts ...messagelinkOption) *Message
 	return m
 }
 
-// withMessageLinkID sets the ID field of the mutation.
-func withMessageLinkID(id pulid.ID) messagelinkOption {
-	return func(m *MessageLinkMutation) {
+// withContactRequestID sets the ID field of the mutation.
+func withContactRequestID(id pulid.ID) contactrequestOption {
+	return func(m *ContactRequestMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MessageLink
+			value *ContactRequest
 		)
-		m.oldValue = func(ctx context.Context) (*MessageLink, error) {
+		m.oldValue = func(ctx context.Context) (*ContactRequest, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MessageLink.Get(ctx, id)
+					value, err = m.Client().ContactRequest.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3184,10 +3362,10 @@ func withMessageLinkID(id pulid.ID) messagelinkOption {
 	}
 }
 
-// withMessageLink sets the old MessageLink of the mutation.
-func withMessageLink(node *MessageLink) messagelinkOption {
-	return func(m *MessageLinkMutation) {
-		m.oldValue = func(context.Context) (*MessageLink, error) {
+// withContactRequest sets the old ContactRequest of the mutation.
+func withContactRequest(node *ContactRequest) contactrequestOption {
+	return func(m *ContactRequestMutation) {
+		m.oldValue = func(context.Context) (*ContactRequest, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3196,7 +3374,7 @@ func withMessageLink(node *MessageLink) messagelinkOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageLinkMutation) Client() *Client {
+func (m ContactRequestMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3204,7 +3382,7 @@ func (m MessageLinkMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageLinkMutation) Tx() (*Tx, error) {
+func (m ContactRequestMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3214,14 +3392,14 @@ func (m MessageLinkMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of MessageLink entities.
-func (m *MessageLinkMutation) SetID(id pulid.ID) {
+// operation is only accepted on creation of ContactRequest entities.
+func (m *ContactRequestMutation) SetID(id pulid.ID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageLinkMutation) ID() (id pulid.ID, exists bool) {
+func (m *ContactRequestMutation) ID() (id pulid.ID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3232,7 +3410,7 @@ func (m *MessageLinkMutation) ID() (id pulid.ID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageLinkMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
+func (m *ContactRequestMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3241,202 +3419,127 @@ func (m *MessageLinkMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MessageLink.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ContactRequest.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetLink sets the "link" field.
-func (m *MessageLinkMutation) SetLink(s string) {
-	m.link = &s
-}
-
-// Link returns the value of the "link" field in the mutation.
-func (m *MessageLinkMutation) Link() (r string, exists bool) {
-	v := m.link
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldLink returns the old "link" field's value of the MessageLink entity.
-// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageLinkMutation) OldLink(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLink is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLink requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLink: %w", err)
-	}
-	return oldValue.Link, nil
-}
-
-// ResetLink resets all changes to the "link" field.
-func (m *MessageLinkMutation) ResetLink() {
-	m.link = nil
-}
-
-// SetTitle sets the "title" field.
-func (m *MessageLinkMutation) SetTitle(s string) {
-	m.title = &s
+// SetSenderID sets the "sender_id" field.
+func (m *ContactRequestMutation) SetSenderID(pu pulid.ID) {
+	m.sender = &pu
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *MessageLinkMutation) Title() (r string, exists bool) {
-	v := m.title
+// SenderID returns the value of the "sender_id" field in the mutation.
+func (m *ContactRequestMutation) SenderID() (r pulid.ID, exists bool) {
+	v := m.sender
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the MessageLink entity.
-// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// OldSenderID returns the old "sender_id" field's value of the ContactRequest entity.
+// If the ContactRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageLinkMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *ContactRequestMutation) OldSenderID(ctx context.Context) (v pulid.ID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldSenderID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldSenderID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldSenderID: %w", err)
 	}
-	return oldValue.Title, nil
-}
-
-// ClearTitle clears the value of the "title" field.
-func (m *MessageLinkMutation) ClearTitle() {
-	m.title = nil
-	m.clearedFields[messagelink.FieldTitle] = struct{}{}
-}
-
-// TitleCleared returns if the "title" field was cleared in this mutation.
-func (m *MessageLinkMutation) TitleCleared() bool {
-	_, ok := m.clearedFields[messagelink.FieldTitle]
-	return ok
+	return oldValue.SenderID, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *MessageLinkMutation) ResetTitle() {
-	m.title = nil
-	delete(m.clearedFields, messagelink.FieldTitle)
+// ResetSenderID resets all changes to the "sender_id" field.
+func (m *ContactRequestMutation) ResetSenderID() {
+	m.sender = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *MessageLinkMutation) SetDescription(s string) {
-	m.description = &s
+// SetReceiverID sets the "receiver_id" field.
+func (m *ContactRequestMutation) SetReceiverID(pu pulid.ID) {
+	m.receiver = &pu
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *MessageLinkMutation) Description() (r string, exists bool) {
-	v := m.description
+// ReceiverID returns the value of the "receiver_id" field in the mutation.
+func (m *ContactRequestMutation) ReceiverID() (r pulid.ID, exists bool) {
+	v := m.receiver
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the MessageLink entity.
-// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// OldReceiverID returns the old "receiver_id" field's value of the ContactRequest entity.
+// If the ContactRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageLinkMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *ContactRequestMutation) OldReceiverID(ctx context.Context) (v pulid.ID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldReceiverID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldReceiverID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldReceiverID: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *MessageLinkMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[messagelink.FieldDescription] = struct{}{}
+	return oldValue.ReceiverID, nil
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *MessageLinkMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[messagelink.FieldDescription]
-	return ok
-}
-
-// ResetDescription resets all changes to the "description" field.
-func (m *MessageLinkMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, messagelink.FieldDescription)
+// ResetReceiverID resets all changes to the "receiver_id" field.
+func (m *ContactRequestMutation) ResetReceiverID() {
+	m.receiver = nil
 }
 
-// SetImageURL sets the "image_url" field.
-func (m *MessageLinkMutation) SetImageURL(s string) {
-	m.image_url = &s
+// SetStatus sets the "status" field.
+func (m *ContactRequestMutation) SetStatus(c contactrequest.Status) {
+	m.status = &c
 }
 
-// ImageURL returns the value of the "image_url" field in the mutation.
-func (m *MessageLinkMutation) ImageURL() (r string, exists bool) {
-	v := m.image_url
+// Status returns the value of the "status" field in the mutation.
+func (m *ContactRequestMutation) Status() (r contactrequest.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImageURL returns the old "image_url" field's value of the MessageLink entity.
-// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the ContactRequest entity.
+// If the ContactRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageLinkMutation) OldImageURL(ctx context.Context) (v string, err error) {
+func (m *ContactRequestMutation) OldStatus(ctx context.Context) (v contactrequest.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImageURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImageURL requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImageURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.ImageURL, nil
-}
-
-// ClearImageURL clears the value of the "image_url" field.
-func (m *MessageLinkMutation) ClearImageURL() {
-	m.image_url = nil
-	m.clearedFields[messagelink.FieldImageURL] = struct{}{}
-}
-
-// ImageURLCleared returns if the "image_url" field was cleared in this mutation.
-func (m *MessageLinkMutation) ImageURLCleared() bool {
-	_, ok := m.clearedFields[messagelink.FieldImageURL]
-	return ok
+	return oldValue.Status, nil
 }
 
-// ResetImageURL resets all changes to the "image_url" field.
-func (m *MessageLinkMutation) ResetImageURL() {
-	m.image_url = nil
-	delete(m.clearedFields, messagelink.FieldImageURL)
+// ResetStatus resets all changes to the "status" field.
+func (m *ContactRequestMutation) ResetStatus() {
+	m.status = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MessageLinkMutation) SetCreatedAt(t time.Time) {
+func (m *ContactRequestMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MessageLinkMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ContactRequestMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3444,10 +3547,10 @@ func (m *MessageLinkMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the MessageLink entity.
-// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ContactRequest entity.
+// If the ContactRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageLinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ContactRequestMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3462,17 +3565,17 @@ func (m *MessageLinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MessageLinkMutation) ResetCreatedAt() {
+func (m *ContactRequestMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *MessageLinkMutation) SetUpdatedAt(t time.Time) {
+func (m *ContactRequestMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *MessageLinkMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *ContactRequestMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3480,10 +3583,10 @@ func (m *MessageLinkMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the MessageLink entity.
-// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ContactRequest entity.
+// If the ContactRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageLinkMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ContactRequestMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3498,97 +3601,73 @@ func (m *MessageLinkMutation) OldUpdatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *MessageLinkMutation) ResetUpdatedAt() {
+func (m *ContactRequestMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetRoomID sets the "room" edge to the Room entity by id.
-func (m *MessageLinkMutation) SetRoomID(id pulid.ID) {
-	m.room = &id
-}
-
-// ClearRoom clears the "room" edge to the Room entity.
-func (m *MessageLinkMutation) ClearRoom() {
-	m.clearedroom = true
-}
-
-// RoomCleared reports if the "room" edge to the Room entity was cleared.
-func (m *MessageLinkMutation) RoomCleared() bool {
-	return m.clearedroom
+// ClearSender clears the "sender" edge to the User entity.
+func (m *ContactRequestMutation) ClearSender() {
+	m.clearedsender = true
+	m.clearedFields[contactrequest.FieldSenderID] = struct{}{}
 }
 
-// RoomID returns the "room" edge ID in the mutation.
-func (m *MessageLinkMutation) RoomID() (id pulid.ID, exists bool) {
-	if m.room != nil {
-		return *m.room, true
-	}
-	return
+// SenderCleared reports if the "sender" edge to the User entity was cleared.
+func (m *ContactRequestMutation) SenderCleared() bool {
+	return m.clearedsender
 }
 
-// RoomIDs returns the "room" edge IDs in the mutation.
+// SenderIDs returns the "sender" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RoomID instead. It exists only for internal usage by the builders.
-func (m *MessageLinkMutation) RoomIDs() (ids []pulid.ID) {
-	if id := m.room; id != nil {
+// SenderID instead. It exists only for internal usage by the builders.
+func (m *ContactRequestMutation) SenderIDs() (ids []pulid.ID) {
+	if id := m.sender; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetRoom resets all changes to the "room" edge.
-func (m *MessageLinkMutation) ResetRoom() {
-	m.room = nil
-	m.clearedroom = false
-}
-
-// SetMessageID sets the "message" edge to the Message entity by id.
-func (m *MessageLinkMutation) SetMessageID(id pulid.ID) {
-	m.message = &id
-}
-
-// ClearMessage clears the "message" edge to the Message entity.
-func (m *MessageLinkMutation) ClearMessage() {
-	m.clearedmessage = true
+// ResetSender resets all changes to the "sender" edge.
+func (m *ContactRequestMutation) ResetSender() {
+	m.sender = nil
+	m.clearedsender = false
 }
 
-// MessageCleared reports if the "message" edge to the Message entity was cleared.
-func (m *MessageLinkMutation) MessageCleared() bool {
-	return m.clearedmessage
+// ClearReceiver clears the "receiver" edge to the User entity.
+func (m *ContactRequestMutation) ClearReceiver() {
+	m.clearedreceiver = true
+	m.clearedFields[contactrequest.FieldReceiverID] = struct{}{}
 }
 
-// MessageID returns the "message" edge ID in the mutation.
-func (m *MessageLinkMutation) MessageID() (id pulid.ID, exists bool) {
-	if m.message != nil {
-		return *m.message, true
-	}
-	return
+// ReceiverCleared reports if the "receiver" edge to the User entity was cleared.
+func (m *ContactRequestMutation) ReceiverCleared() bool {
+	return m.clearedreceiver
 }
 
-// MessageIDs returns the "message" edge IDs in the mutation.
+// ReceiverIDs returns the "receiver" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MessageID instead. It exists only for internal usage by the builders.
-func (m *MessageLinkMutation) MessageIDs() (ids []pulid.ID) {
-	if id := m.message; id != nil {
+// ReceiverID instead. It exists only for internal usage by the builders.
+func (m *ContactRequestMutation) ReceiverIDs() (ids []pulid.ID) {
+	if id := m.receiver; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetMessage resets all changes to the "message" edge.
-func (m *MessageLinkMutation) ResetMessage() {
-	m.message = nil
-	m.clearedmessage = false
+// ResetReceiver resets all changes to the "receiver" edge.
+func (m *ContactRequestMutation) ResetReceiver() {
+	m.receiver = nil
+	m.clearedreceiver = false
 }
 
-// Where appends a list predicates to the MessageLinkMutation builder.
-func (m *MessageLinkMutation) Where(ps ...predicate.MessageLink) {
+// Where appends a list predicates to the ContactRequestMutation builder.
+func (m *ContactRequestMutation) Where(ps ...predicate.ContactRequest) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageLinkMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ContactRequestMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageLinkMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MessageLink, len(ps))
+func (m *ContactRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ContactRequest, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3596,42 +3675,39 @@ func (m *MessageLinkMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageLinkMutation) Op() Op {
+func (m *ContactRequestMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageLinkMutation) SetOp(op Op) {
+func (m *ContactRequestMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MessageLink).
-func (m *MessageLinkMutation) Type() string {
+// Type returns the node type of this mutation (ContactRequest).
+func (m *ContactRequestMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageLinkMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.link != nil {
-		fields = append(fields, messagelink.FieldLink)
-	}
-	if m.title != nil {
-		fields = append(fields, messagelink.FieldTitle)
+func (m *ContactRequestMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.sender != nil {
+		fields = append(fields, contactrequest.FieldSenderID)
 	}
-	if m.description != nil {
-		fields = append(fields, messagelink.FieldDescription)
+	if m.receiver != nil {
+		fields = append(fields, contactrequest.FieldReceiverID)
 	}
-	if m.image_url != nil {
-		fields = append(fields, messagelink.FieldImageURL)
+	if m.status != nil {
+		fields = append(fields, contactrequest.FieldStatus)
 	}
 	if m.created_at != nil {
-		fields = append(fields, messagelink.FieldCreatedAt)
+		fields = append(fields, contactrequest.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, messagelink.FieldUpdatedAt)
+		fields = append(fields, contactrequest.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -3639,19 +3715,17 @@ func (m *MessageLinkMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageLinkMutation) Field(name string) (ent.Value, bool) {
+func (m *ContactRequestMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case messagelink.FieldLink:
-		return m.Link()
-	case messagelink.FieldTitle:
-		return m.Title()
-	case messagelink.FieldDescription:
-		return m.Description()
-	case messagelink.FieldImageURL:
-		return m.ImageURL()
-	case messagelink.FieldCreatedAt:
+	case contactrequest.FieldSenderID:
+		return m.SenderID()
+	case contactrequest.FieldReceiverID:
+		return m.ReceiverID()
+	case contactrequest.FieldStatus:
+		return m.Status()
+	case contactrequest.FieldCreatedAt:
 		return m.CreatedAt()
-	case messagelink.FieldUpdatedAt:
+	case contactrequest.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -3660,65 +3734,56 @@ func (m *MessageLinkMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ContactRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case messagelink.FieldLink:
-		return m.OldLink(ctx)
-	case messagelink.FieldTitle:
-		return m.OldTitle(ctx)
-	case messagelink.FieldDescription:
-		return m.OldDescription(ctx)
-	case messagelink.FieldImageURL:
-		return m.OldImageURL(ctx)
-	case messagelink.FieldCreatedAt:
+	case contactrequest.FieldSenderID:
+		return m.OldSenderID(ctx)
+	case contactrequest.FieldReceiverID:
+		return m.OldReceiverID(ctx)
+	case contactrequest.FieldStatus:
+		return m.OldStatus(ctx)
+	case contactrequest.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case messagelink.FieldUpdatedAt:
+	case contactrequest.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown MessageLink field %s", name)
+	return nil, fmt.Errorf("unknown ContactRequest field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageLinkMutation) SetField(name string, value ent.Value) error {
+func (m *ContactRequestMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case messagelink.FieldLink:
-		v, ok := value.(string)
+	case contactrequest.FieldSenderID:
+		v, ok := value.(pulid.ID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLink(v)
+		m.SetSenderID(v)
 		return nil
-	case messagelink.FieldTitle:
-		v, ok := value.(string)
+	case contactrequest.FieldReceiverID:
+		v, ok := value.(pulid.ID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetReceiverID(v)
 		return nil
-	case messagelink.FieldDescription:
-		v, ok := value.(string)
+	case contactrequest.FieldStatus:
+		v, ok := value.(contactrequest.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetStatus(v)
 		return nil
-	case messagelink.FieldImageURL:
-		v, ok := value.(string)
+	case contactrequest.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImageURL(v)
+		m.SetCreatedAt(v)
 		return nil
-	case messagelink.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case messagelink.FieldUpdatedAt:
+	case contactrequest.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3726,119 +3791,95 @@ func (m *MessageLinkMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MessageLink field %s", name)
+	return fmt.Errorf("unknown ContactRequest field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageLinkMutation) AddedFields() []string {
+func (m *ContactRequestMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageLinkMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ContactRequestMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageLinkMutation) AddField(name string, value ent.Value) error {
+func (m *ContactRequestMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown MessageLink numeric field %s", name)
+	return fmt.Errorf("unknown ContactRequest numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageLinkMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(messagelink.FieldTitle) {
-		fields = append(fields, messagelink.FieldTitle)
-	}
-	if m.FieldCleared(messagelink.FieldDescription) {
-		fields = append(fields, messagelink.FieldDescription)
-	}
-	if m.FieldCleared(messagelink.FieldImageURL) {
-		fields = append(fields, messagelink.FieldImageURL)
-	}
-	return fields
+func (m *ContactRequestMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageLinkMutation) FieldCleared(name string) bool {
+func (m *ContactRequestMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageLinkMutation) ClearField(name string) error {
-	switch name {
-	case messagelink.FieldTitle:
-		m.ClearTitle()
-		return nil
-	case messagelink.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case messagelink.FieldImageURL:
-		m.ClearImageURL()
-		return nil
-	}
-	return fmt.Errorf("unknown MessageLink nullable field %s", name)
+func (m *ContactRequestMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ContactRequest nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageLinkMutation) ResetField(name string) error {
+func (m *ContactRequestMutation) ResetField(name string) error {
 	switch name {
-	case messagelink.FieldLink:
-		m.ResetLink()
-		return nil
-	case messagelink.FieldTitle:
-		m.ResetTitle()
+	case contactrequest.FieldSenderID:
+		m.ResetSenderID()
 		return nil
-	case messagelink.FieldDescription:
-		m.ResetDescription()
+	case contactrequest.FieldReceiverID:
+		m.ResetReceiverID()
 		return nil
-	case messagelink.FieldImageURL:
-		m.ResetImageURL()
+	case contactrequest.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case messagelink.FieldCreatedAt:
+	case contactrequest.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case messagelink.FieldUpdatedAt:
+	case contactrequest.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageLink field %s", name)
+	return fmt.Errorf("unknown ContactRequest field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageLinkMutation) AddedEdges() []string {
+func (m *ContactRequestMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.room != nil {
-		edges = append(edges, messagelink.EdgeRoom)
+	if m.sender != nil {
+		edges = append(edges, contactrequest.EdgeSender)
 	}
-	if m.message != nil {
-		edges = append(edges, messagelink.EdgeMessage)
+	if m.receiver != nil {
+		edges = append(edges, contactrequest.EdgeReceiver)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageLinkMutation) AddedIDs(name string) []ent.Value {
+func (m *ContactRequestMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case messagelink.EdgeRoom:
-		if id := m.room; id != nil {
+	case contactrequest.EdgeSender:
+		if id := m.sender; id != nil {
 			return []ent.Value{*id}
 		}
-	case messagelink.EdgeMessage:
-		if id := m.message; id != nil {
+	case contactrequest.EdgeReceiver:
+		if id := m.receiver; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -3846,101 +3887,103 @@ func (m *MessageLinkMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageLinkMutation) RemovedEdges() []string {
+func (m *ContactRequestMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageLinkMutation) RemovedIDs(name string) []ent.Value {
+func (m *ContactRequestMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageLinkMutation) ClearedEdges() []string {
+func (m *ContactRequestMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedroom {
-		edges = append(edges, messagelink.EdgeRoom)
+	if m.clearedsender {
+		edges = append(edges, contactrequest.EdgeSender)
 	}
-	if m.clearedmessage {
-		edges = append(edges, messagelink.EdgeMessage)
+	if m.clearedreceiver {
+		edges = append(edges, contactrequest.EdgeReceiver)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageLinkMutation) EdgeCleared(name string) bool {
+func (m *ContactRequestMutation) EdgeCleared(name string) bool {
 	switch name {
-	case messagelink.EdgeRoom:
-		return m.clearedroom
-	case messagelink.EdgeMessage:
-		return m.clearedmessage
+	case contactrequest.EdgeSender:
+		return m.clearedsender
+	case contactrequest.EdgeReceiver:
+		return m.clearedreceiver
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageLinkMutation) ClearEdge(name string) error {
+func (m *ContactRequestMutation) ClearEdge(name string) error {
 	switch name {
-	case messagelink.EdgeRoom:
-		m.ClearRoom()
+	case contactrequest.EdgeSender:
+		m.ClearSender()
 		return nil
-	case messagelink.EdgeMessage:
-		m.ClearMessage()
+	case contactrequest.EdgeReceiver:
+		m.ClearReceiver()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageLink unique edge %s", name)
+	return fmt.Errorf("unknown ContactRequest unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageLinkMutation) ResetEdge(name string) error {
+func (m *ContactRequestMutation) ResetEdge(name string) error {
 	switch name {
-	case messagelink.EdgeRoom:
-		m.ResetRoom()
+	case contactrequest.EdgeSender:
+		m.ResetSender()
 		return nil
-	case messagelink.EdgeMessage:
-		m.ResetMessage()
+	case contactrequest.EdgeReceiver:
+		m.ResetReceiver()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageLink edge %s", name)
+	return fmt.Errorf("unknown ContactRequest edge %s", name)
 }
 
-// MessageVoiceMutation represents an operation that mutates the MessageVoice nodes in the graph.
-type MessageVoiceMutation struct {
+// DeviceMutation represents an operation that mutates the Device nodes in the graph.
+type DeviceMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *pulid.ID
-	length         *uint64
-	addlength      *int64
-	attached_at    *time.Time
-	clearedFields  map[string]struct{}
-	room           *pulid.ID
-	clearedroom    bool
-	message        *pulid.ID
-	clearedmessage bool
-	file           *pulid.ID
-	clearedfile    bool
-	done           bool
-	oldValue       func(context.Context) (*MessageVoice, error)
-	predicates     []predicate.MessageVoice
+	op              Op
+	typ             string
+	id              *pulid.ID
+	device_id       *string
+	fcm_token       *string
+	platform        *device.Platform
+	voip_token      *string
+	last_push_at    *time.Time
+	last_push_error *string
+	invalidated_at  *time.Time
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	user            *pulid.ID
+	cleareduser     bool
+	done            bool
+	oldValue        func(context.Context) (*Device, error)
+	predicates      []predicate.Device
 }
 
-var _ ent.Mutation = (*MessageVoiceMutation)(nil)
+var _ ent.Mutation = (*DeviceMutation)(nil)
 
-// messagevoiceOption allows management of the mutation configuration using functional options.
-type messagevoiceOption func(*MessageVoiceMutation)
+// deviceOption allows management of the mutation configuration using functional options.
+type deviceOption func(*DeviceMutation)
 
-// newMessageVoiceMutation creates new mutation for the MessageVoice entity.
-func newMessageVoiceMutation(c config, op Op, opts ...messagevoiceOption) *MessageVoiceMutation {
-	m := &MessageVoiceMutation{
+// newDeviceMutation creates new mutation for the Device entity.
+func newDeviceMutation(c config, op Op, opts ...deviceOption) *DeviceMutation {
+	m := &DeviceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessageVoice,
+		typ:           TypeDevice,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3949,20 +3992,20 @@ func newMessageVoiceMutation(c config, op Op, opts ...messagevoiceOption) *Messa
 	return m
 }
 
-// withMessageVoiceID sets the ID field of the mutation.
-func withMessageVoiceID(id pulid.ID) messagevoiceOption {
-	return func(m *MessageVoiceMutation) {
+// withDeviceID sets the ID field of the mutation.
+func withDeviceID(id pulid.ID) deviceOption {
+	return func(m *DeviceMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MessageVoice
+			value *Device
 		)
-		m.oldValue = func(ctx context.Context) (*MessageVoice, error) {
+		m.oldValue = func(ctx context.Context) (*Device, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MessageVoice.Get(ctx, id)
+					value, err = m.Client().Device.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3971,10 +4014,10 @@ func withMessageVoiceID(id pulid.ID) messagevoiceOption {
 	}
 }
 
-// withMessageVoice sets the old MessageVoice of the mutation.
-func withMessageVoice(node *MessageVoice) messagevoiceOption {
-	return func(m *MessageVoiceMutation) {
-		m.oldValue = func(context.Context) (*MessageVoice, error) {
+// withDevice sets the old Device of the mutation.
+func withDevice(node *Device) deviceOption {
+	return func(m *DeviceMutation) {
+		m.oldValue = func(context.Context) (*Device, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3983,7 +4026,7 @@ func withMessageVoice(node *MessageVoice) messagevoiceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageVoiceMutation) Client() *Client {
+func (m DeviceMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3991,7 +4034,7 @@ func (m MessageVoiceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageVoiceMutation) Tx() (*Tx, error) {
+func (m DeviceMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4001,14 +4044,14 @@ func (m MessageVoiceMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of MessageVoice entities.
-func (m *MessageVoiceMutation) SetID(id pulid.ID) {
+// operation is only accepted on creation of Device entities.
+func (m *DeviceMutation) SetID(id pulid.ID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageVoiceMutation) ID() (id pulid.ID, exists bool) {
+func (m *DeviceMutation) ID() (id pulid.ID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4019,7 +4062,7 @@ func (m *MessageVoiceMutation) ID() (id pulid.ID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageVoiceMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
+func (m *DeviceMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4028,230 +4071,436 @@ func (m *MessageVoiceMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MessageVoice.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Device.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetLength sets the "length" field.
-func (m *MessageVoiceMutation) SetLength(u uint64) {
-	m.length = &u
-	m.addlength = nil
+// SetDeviceID sets the "device_id" field.
+func (m *DeviceMutation) SetDeviceID(s string) {
+	m.device_id = &s
 }
 
-// Length returns the value of the "length" field in the mutation.
-func (m *MessageVoiceMutation) Length() (r uint64, exists bool) {
-	v := m.length
+// DeviceID returns the value of the "device_id" field in the mutation.
+func (m *DeviceMutation) DeviceID() (r string, exists bool) {
+	v := m.device_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLength returns the old "length" field's value of the MessageVoice entity.
-// If the MessageVoice object wasn't provided to the builder, the object is fetched from the database.
+// OldDeviceID returns the old "device_id" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageVoiceMutation) OldLength(ctx context.Context) (v uint64, err error) {
+func (m *DeviceMutation) OldDeviceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLength is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLength requires an ID field in the mutation")
+		return v, errors.New("OldDeviceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLength: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
 	}
-	return oldValue.Length, nil
+	return oldValue.DeviceID, nil
 }
 
-// AddLength adds u to the "length" field.
-func (m *MessageVoiceMutation) AddLength(u int64) {
-	if m.addlength != nil {
-		*m.addlength += u
-	} else {
-		m.addlength = &u
-	}
+// ResetDeviceID resets all changes to the "device_id" field.
+func (m *DeviceMutation) ResetDeviceID() {
+	m.device_id = nil
 }
 
-// AddedLength returns the value that was added to the "length" field in this mutation.
-func (m *MessageVoiceMutation) AddedLength() (r int64, exists bool) {
-	v := m.addlength
+// SetFcmToken sets the "fcm_token" field.
+func (m *DeviceMutation) SetFcmToken(s string) {
+	m.fcm_token = &s
+}
+
+// FcmToken returns the value of the "fcm_token" field in the mutation.
+func (m *DeviceMutation) FcmToken() (r string, exists bool) {
+	v := m.fcm_token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetLength resets all changes to the "length" field.
-func (m *MessageVoiceMutation) ResetLength() {
-	m.length = nil
-	m.addlength = nil
+// OldFcmToken returns the old "fcm_token" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldFcmToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFcmToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFcmToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFcmToken: %w", err)
+	}
+	return oldValue.FcmToken, nil
 }
 
-// SetAttachedAt sets the "attached_at" field.
-func (m *MessageVoiceMutation) SetAttachedAt(t time.Time) {
-	m.attached_at = &t
+// ResetFcmToken resets all changes to the "fcm_token" field.
+func (m *DeviceMutation) ResetFcmToken() {
+	m.fcm_token = nil
 }
 
-// AttachedAt returns the value of the "attached_at" field in the mutation.
-func (m *MessageVoiceMutation) AttachedAt() (r time.Time, exists bool) {
-	v := m.attached_at
+// SetPlatform sets the "platform" field.
+func (m *DeviceMutation) SetPlatform(d device.Platform) {
+	m.platform = &d
+}
+
+// Platform returns the value of the "platform" field in the mutation.
+func (m *DeviceMutation) Platform() (r device.Platform, exists bool) {
+	v := m.platform
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAttachedAt returns the old "attached_at" field's value of the MessageVoice entity.
-// If the MessageVoice object wasn't provided to the builder, the object is fetched from the database.
+// OldPlatform returns the old "platform" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageVoiceMutation) OldAttachedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DeviceMutation) OldPlatform(ctx context.Context) (v device.Platform, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAttachedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPlatform is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAttachedAt requires an ID field in the mutation")
+		return v, errors.New("OldPlatform requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAttachedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPlatform: %w", err)
 	}
-	return oldValue.AttachedAt, nil
+	return oldValue.Platform, nil
 }
 
-// ResetAttachedAt resets all changes to the "attached_at" field.
-func (m *MessageVoiceMutation) ResetAttachedAt() {
-	m.attached_at = nil
+// ResetPlatform resets all changes to the "platform" field.
+func (m *DeviceMutation) ResetPlatform() {
+	m.platform = nil
 }
 
-// SetRoomID sets the "room" edge to the Room entity by id.
-func (m *MessageVoiceMutation) SetRoomID(id pulid.ID) {
-	m.room = &id
+// SetVoipToken sets the "voip_token" field.
+func (m *DeviceMutation) SetVoipToken(s string) {
+	m.voip_token = &s
 }
 
-// ClearRoom clears the "room" edge to the Room entity.
-func (m *MessageVoiceMutation) ClearRoom() {
-	m.clearedroom = true
+// VoipToken returns the value of the "voip_token" field in the mutation.
+func (m *DeviceMutation) VoipToken() (r string, exists bool) {
+	v := m.voip_token
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RoomCleared reports if the "room" edge to the Room entity was cleared.
-func (m *MessageVoiceMutation) RoomCleared() bool {
-	return m.clearedroom
+// OldVoipToken returns the old "voip_token" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldVoipToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVoipToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVoipToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVoipToken: %w", err)
+	}
+	return oldValue.VoipToken, nil
 }
 
-// RoomID returns the "room" edge ID in the mutation.
-func (m *MessageVoiceMutation) RoomID() (id pulid.ID, exists bool) {
-	if m.room != nil {
-		return *m.room, true
+// ClearVoipToken clears the value of the "voip_token" field.
+func (m *DeviceMutation) ClearVoipToken() {
+	m.voip_token = nil
+	m.clearedFields[device.FieldVoipToken] = struct{}{}
+}
+
+// VoipTokenCleared returns if the "voip_token" field was cleared in this mutation.
+func (m *DeviceMutation) VoipTokenCleared() bool {
+	_, ok := m.clearedFields[device.FieldVoipToken]
+	return ok
+}
+
+// ResetVoipToken resets all changes to the "voip_token" field.
+func (m *DeviceMutation) ResetVoipToken() {
+	m.voip_token = nil
+	delete(m.clearedFields, device.FieldVoipToken)
+}
+
+// SetLastPushAt sets the "last_push_at" field.
+func (m *DeviceMutation) SetLastPushAt(t time.Time) {
+	m.last_push_at = &t
+}
+
+// LastPushAt returns the value of the "last_push_at" field in the mutation.
+func (m *DeviceMutation) LastPushAt() (r time.Time, exists bool) {
+	v := m.last_push_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// RoomIDs returns the "room" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RoomID instead. It exists only for internal usage by the builders.
-func (m *MessageVoiceMutation) RoomIDs() (ids []pulid.ID) {
-	if id := m.room; id != nil {
-		ids = append(ids, *id)
+// OldLastPushAt returns the old "last_push_at" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldLastPushAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastPushAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastPushAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastPushAt: %w", err)
+	}
+	return oldValue.LastPushAt, nil
 }
 
-// ResetRoom resets all changes to the "room" edge.
-func (m *MessageVoiceMutation) ResetRoom() {
-	m.room = nil
-	m.clearedroom = false
+// ClearLastPushAt clears the value of the "last_push_at" field.
+func (m *DeviceMutation) ClearLastPushAt() {
+	m.last_push_at = nil
+	m.clearedFields[device.FieldLastPushAt] = struct{}{}
 }
 
-// SetMessageID sets the "message" edge to the Message entity by id.
-func (m *MessageVoiceMutation) SetMessageID(id pulid.ID) {
-	m.message = &id
+// LastPushAtCleared returns if the "last_push_at" field was cleared in this mutation.
+func (m *DeviceMutation) LastPushAtCleared() bool {
+	_, ok := m.clearedFields[device.FieldLastPushAt]
+	return ok
 }
 
-// ClearMessage clears the "message" edge to the Message entity.
-func (m *MessageVoiceMutation) ClearMessage() {
-	m.clearedmessage = true
+// ResetLastPushAt resets all changes to the "last_push_at" field.
+func (m *DeviceMutation) ResetLastPushAt() {
+	m.last_push_at = nil
+	delete(m.clearedFields, device.FieldLastPushAt)
 }
 
-// MessageCleared reports if the "message" edge to the Message entity was cleared.
-func (m *MessageVoiceMutation) MessageCleared() bool {
-	return m.clearedmessage
+// SetLastPushError sets the "last_push_error" field.
+func (m *DeviceMutation) SetLastPushError(s string) {
+	m.last_push_error = &s
 }
 
-// MessageID returns the "message" edge ID in the mutation.
-func (m *MessageVoiceMutation) MessageID() (id pulid.ID, exists bool) {
-	if m.message != nil {
-		return *m.message, true
+// LastPushError returns the value of the "last_push_error" field in the mutation.
+func (m *DeviceMutation) LastPushError() (r string, exists bool) {
+	v := m.last_push_error
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// MessageIDs returns the "message" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MessageID instead. It exists only for internal usage by the builders.
-func (m *MessageVoiceMutation) MessageIDs() (ids []pulid.ID) {
-	if id := m.message; id != nil {
-		ids = append(ids, *id)
+// OldLastPushError returns the old "last_push_error" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldLastPushError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastPushError is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastPushError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastPushError: %w", err)
+	}
+	return oldValue.LastPushError, nil
 }
 
-// ResetMessage resets all changes to the "message" edge.
-func (m *MessageVoiceMutation) ResetMessage() {
-	m.message = nil
-	m.clearedmessage = false
+// ClearLastPushError clears the value of the "last_push_error" field.
+func (m *DeviceMutation) ClearLastPushError() {
+	m.last_push_error = nil
+	m.clearedFields[device.FieldLastPushError] = struct{}{}
 }
 
-// SetFileID sets the "file" edge to the File entity by id.
-func (m *MessageVoiceMutation) SetFileID(id pulid.ID) {
-	m.file = &id
+// LastPushErrorCleared returns if the "last_push_error" field was cleared in this mutation.
+func (m *DeviceMutation) LastPushErrorCleared() bool {
+	_, ok := m.clearedFields[device.FieldLastPushError]
+	return ok
 }
 
-// ClearFile clears the "file" edge to the File entity.
-func (m *MessageVoiceMutation) ClearFile() {
-	m.clearedfile = true
+// ResetLastPushError resets all changes to the "last_push_error" field.
+func (m *DeviceMutation) ResetLastPushError() {
+	m.last_push_error = nil
+	delete(m.clearedFields, device.FieldLastPushError)
 }
 
-// FileCleared reports if the "file" edge to the File entity was cleared.
-func (m *MessageVoiceMutation) FileCleared() bool {
-	return m.clearedfile
+// SetInvalidatedAt sets the "invalidated_at" field.
+func (m *DeviceMutation) SetInvalidatedAt(t time.Time) {
+	m.invalidated_at = &t
 }
 
-// FileID returns the "file" edge ID in the mutation.
-func (m *MessageVoiceMutation) FileID() (id pulid.ID, exists bool) {
-	if m.file != nil {
-		return *m.file, true
+// InvalidatedAt returns the value of the "invalidated_at" field in the mutation.
+func (m *DeviceMutation) InvalidatedAt() (r time.Time, exists bool) {
+	v := m.invalidated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInvalidatedAt returns the old "invalidated_at" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldInvalidatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInvalidatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInvalidatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInvalidatedAt: %w", err)
+	}
+	return oldValue.InvalidatedAt, nil
+}
+
+// ClearInvalidatedAt clears the value of the "invalidated_at" field.
+func (m *DeviceMutation) ClearInvalidatedAt() {
+	m.invalidated_at = nil
+	m.clearedFields[device.FieldInvalidatedAt] = struct{}{}
+}
+
+// InvalidatedAtCleared returns if the "invalidated_at" field was cleared in this mutation.
+func (m *DeviceMutation) InvalidatedAtCleared() bool {
+	_, ok := m.clearedFields[device.FieldInvalidatedAt]
+	return ok
+}
+
+// ResetInvalidatedAt resets all changes to the "invalidated_at" field.
+func (m *DeviceMutation) ResetInvalidatedAt() {
+	m.invalidated_at = nil
+	delete(m.clearedFields, device.FieldInvalidatedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DeviceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DeviceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DeviceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DeviceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DeviceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DeviceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *DeviceMutation) SetUserID(id pulid.ID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *DeviceMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *DeviceMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *DeviceMutation) UserID() (id pulid.ID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
 	return
 }
 
-// FileIDs returns the "file" edge IDs in the mutation.
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FileID instead. It exists only for internal usage by the builders.
-func (m *MessageVoiceMutation) FileIDs() (ids []pulid.ID) {
-	if id := m.file; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *DeviceMutation) UserIDs() (ids []pulid.ID) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetFile resets all changes to the "file" edge.
-func (m *MessageVoiceMutation) ResetFile() {
-	m.file = nil
-	m.clearedfile = false
+// ResetUser resets all changes to the "user" edge.
+func (m *DeviceMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the MessageVoiceMutation builder.
-func (m *MessageVoiceMutation) Where(ps ...predicate.MessageVoice) {
+// Where appends a list predicates to the DeviceMutation builder.
+func (m *DeviceMutation) Where(ps ...predicate.Device) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageVoiceMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DeviceMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageVoiceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MessageVoice, len(ps))
+func (m *DeviceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Device, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4259,30 +4508,51 @@ func (m *MessageVoiceMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageVoiceMutation) Op() Op {
+func (m *DeviceMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageVoiceMutation) SetOp(op Op) {
+func (m *DeviceMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MessageVoice).
-func (m *MessageVoiceMutation) Type() string {
+// Type returns the node type of this mutation (Device).
+func (m *DeviceMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageVoiceMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.length != nil {
-		fields = append(fields, messagevoice.FieldLength)
-	}
-	if m.attached_at != nil {
-		fields = append(fields, messagevoice.FieldAttachedAt)
+func (m *DeviceMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.device_id != nil {
+		fields = append(fields, device.FieldDeviceID)
+	}
+	if m.fcm_token != nil {
+		fields = append(fields, device.FieldFcmToken)
+	}
+	if m.platform != nil {
+		fields = append(fields, device.FieldPlatform)
+	}
+	if m.voip_token != nil {
+		fields = append(fields, device.FieldVoipToken)
+	}
+	if m.last_push_at != nil {
+		fields = append(fields, device.FieldLastPushAt)
+	}
+	if m.last_push_error != nil {
+		fields = append(fields, device.FieldLastPushError)
+	}
+	if m.invalidated_at != nil {
+		fields = append(fields, device.FieldInvalidatedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, device.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, device.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -4290,12 +4560,26 @@ func (m *MessageVoiceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageVoiceMutation) Field(name string) (ent.Value, bool) {
+func (m *DeviceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case messagevoice.FieldLength:
-		return m.Length()
-	case messagevoice.FieldAttachedAt:
-		return m.AttachedAt()
+	case device.FieldDeviceID:
+		return m.DeviceID()
+	case device.FieldFcmToken:
+		return m.FcmToken()
+	case device.FieldPlatform:
+		return m.Platform()
+	case device.FieldVoipToken:
+		return m.VoipToken()
+	case device.FieldLastPushAt:
+		return m.LastPushAt()
+	case device.FieldLastPushError:
+		return m.LastPushError()
+	case device.FieldInvalidatedAt:
+		return m.InvalidatedAt()
+	case device.FieldCreatedAt:
+		return m.CreatedAt()
+	case device.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -4303,138 +4587,220 @@ func (m *MessageVoiceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageVoiceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DeviceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case messagevoice.FieldLength:
-		return m.OldLength(ctx)
-	case messagevoice.FieldAttachedAt:
-		return m.OldAttachedAt(ctx)
+	case device.FieldDeviceID:
+		return m.OldDeviceID(ctx)
+	case device.FieldFcmToken:
+		return m.OldFcmToken(ctx)
+	case device.FieldPlatform:
+		return m.OldPlatform(ctx)
+	case device.FieldVoipToken:
+		return m.OldVoipToken(ctx)
+	case device.FieldLastPushAt:
+		return m.OldLastPushAt(ctx)
+	case device.FieldLastPushError:
+		return m.OldLastPushError(ctx)
+	case device.FieldInvalidatedAt:
+		return m.OldInvalidatedAt(ctx)
+	case device.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case device.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown MessageVoice field %s", name)
+	return nil, fmt.Errorf("unknown Device field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageVoiceMutation) SetField(name string, value ent.Value) error {
+func (m *DeviceMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case messagevoice.FieldLength:
-		v, ok := value.(uint64)
+	case device.FieldDeviceID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLength(v)
+		m.SetDeviceID(v)
 		return nil
-	case messagevoice.FieldAttachedAt:
+	case device.FieldFcmToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFcmToken(v)
+		return nil
+	case device.FieldPlatform:
+		v, ok := value.(device.Platform)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatform(v)
+		return nil
+	case device.FieldVoipToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVoipToken(v)
+		return nil
+	case device.FieldLastPushAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAttachedAt(v)
+		m.SetLastPushAt(v)
+		return nil
+	case device.FieldLastPushError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastPushError(v)
+		return nil
+	case device.FieldInvalidatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInvalidatedAt(v)
+		return nil
+	case device.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case device.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MessageVoice field %s", name)
+	return fmt.Errorf("unknown Device field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageVoiceMutation) AddedFields() []string {
-	var fields []string
-	if m.addlength != nil {
-		fields = append(fields, messagevoice.FieldLength)
-	}
-	return fields
+func (m *DeviceMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageVoiceMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case messagevoice.FieldLength:
-		return m.AddedLength()
-	}
+func (m *DeviceMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageVoiceMutation) AddField(name string, value ent.Value) error {
+func (m *DeviceMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case messagevoice.FieldLength:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddLength(v)
-		return nil
 	}
-	return fmt.Errorf("unknown MessageVoice numeric field %s", name)
+	return fmt.Errorf("unknown Device numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageVoiceMutation) ClearedFields() []string {
-	return nil
+func (m *DeviceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(device.FieldVoipToken) {
+		fields = append(fields, device.FieldVoipToken)
+	}
+	if m.FieldCleared(device.FieldLastPushAt) {
+		fields = append(fields, device.FieldLastPushAt)
+	}
+	if m.FieldCleared(device.FieldLastPushError) {
+		fields = append(fields, device.FieldLastPushError)
+	}
+	if m.FieldCleared(device.FieldInvalidatedAt) {
+		fields = append(fields, device.FieldInvalidatedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageVoiceMutation) FieldCleared(name string) bool {
+func (m *DeviceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageVoiceMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MessageVoice nullable field %s", name)
+func (m *DeviceMutation) ClearField(name string) error {
+	switch name {
+	case device.FieldVoipToken:
+		m.ClearVoipToken()
+		return nil
+	case device.FieldLastPushAt:
+		m.ClearLastPushAt()
+		return nil
+	case device.FieldLastPushError:
+		m.ClearLastPushError()
+		return nil
+	case device.FieldInvalidatedAt:
+		m.ClearInvalidatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Device nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageVoiceMutation) ResetField(name string) error {
+func (m *DeviceMutation) ResetField(name string) error {
 	switch name {
-	case messagevoice.FieldLength:
-		m.ResetLength()
+	case device.FieldDeviceID:
+		m.ResetDeviceID()
 		return nil
-	case messagevoice.FieldAttachedAt:
-		m.ResetAttachedAt()
+	case device.FieldFcmToken:
+		m.ResetFcmToken()
+		return nil
+	case device.FieldPlatform:
+		m.ResetPlatform()
+		return nil
+	case device.FieldVoipToken:
+		m.ResetVoipToken()
+		return nil
+	case device.FieldLastPushAt:
+		m.ResetLastPushAt()
+		return nil
+	case device.FieldLastPushError:
+		m.ResetLastPushError()
+		return nil
+	case device.FieldInvalidatedAt:
+		m.ResetInvalidatedAt()
+		return nil
+	case device.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case device.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageVoice field %s", name)
+	return fmt.Errorf("unknown Device field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageVoiceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.room != nil {
-		edges = append(edges, messagevoice.EdgeRoom)
-	}
-	if m.message != nil {
-		edges = append(edges, messagevoice.EdgeMessage)
-	}
-	if m.file != nil {
-		edges = append(edges, messagevoice.EdgeFile)
+func (m *DeviceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, device.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageVoiceMutation) AddedIDs(name string) []ent.Value {
+func (m *DeviceMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case messagevoice.EdgeRoom:
-		if id := m.room; id != nil {
-			return []ent.Value{*id}
-		}
-	case messagevoice.EdgeMessage:
-		if id := m.message; id != nil {
-			return []ent.Value{*id}
-		}
-	case messagevoice.EdgeFile:
-		if id := m.file; id != nil {
+	case device.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -4442,110 +4808,107 @@ func (m *MessageVoiceMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageVoiceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *DeviceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageVoiceMutation) RemovedIDs(name string) []ent.Value {
+func (m *DeviceMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageVoiceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedroom {
-		edges = append(edges, messagevoice.EdgeRoom)
-	}
-	if m.clearedmessage {
-		edges = append(edges, messagevoice.EdgeMessage)
-	}
-	if m.clearedfile {
-		edges = append(edges, messagevoice.EdgeFile)
+func (m *DeviceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, device.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageVoiceMutation) EdgeCleared(name string) bool {
+func (m *DeviceMutation) EdgeCleared(name string) bool {
 	switch name {
-	case messagevoice.EdgeRoom:
-		return m.clearedroom
-	case messagevoice.EdgeMessage:
-		return m.clearedmessage
-	case messagevoice.EdgeFile:
-		return m.clearedfile
+	case device.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageVoiceMutation) ClearEdge(name string) error {
+func (m *DeviceMutation) ClearEdge(name string) error {
 	switch name {
-	case messagevoice.EdgeRoom:
-		m.ClearRoom()
-		return nil
-	case messagevoice.EdgeMessage:
-		m.ClearMessage()
-		return nil
-	case messagevoice.EdgeFile:
-		m.ClearFile()
+	case device.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageVoice unique edge %s", name)
+	return fmt.Errorf("unknown Device unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageVoiceMutation) ResetEdge(name string) error {
+func (m *DeviceMutation) ResetEdge(name string) error {
 	switch name {
-	case messagevoice.EdgeRoom:
-		m.ResetRoom()
-		return nil
-	case messagevoice.EdgeMessage:
-		m.ResetMessage()
-		return nil
-	case messagevoice.EdgeFile:
-		m.ResetFile()
+	case device.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageVoice edge %s", name)
+	return fmt.Errorf("unknown Device edge %s", name)
 }
 
-// NotificationMutation represents an operation that mutates the Notification nodes in the graph.
-type NotificationMutation struct {
+// FileMutation represents an operation that mutates the File nodes in the graph.
+type FileMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *pulid.ID
-	title         *string
-	body          *string
-	data          *map[string]interface{}
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *pulid.ID
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*Notification, error)
-	predicates    []predicate.Notification
+	op                        Op
+	typ                       string
+	id                        *pulid.ID
+	name                      *string
+	content_type              *string
+	size                      *uint64
+	addsize                   *int64
+	location                  *string
+	bucket                    *string
+	_path                     *string
+	width                     *int
+	addwidth                  *int
+	height                    *int
+	addheight                 *int
+	created_at                *time.Time
+	updated_at                *time.Time
+	clearedFields             map[string]struct{}
+	message_attachment        *pulid.ID
+	clearedmessage_attachment bool
+	message_voice             *pulid.ID
+	clearedmessage_voice      bool
+	original                  *pulid.ID
+	clearedoriginal           bool
+	thumbnails                map[pulid.ID]struct{}
+	removedthumbnails         map[pulid.ID]struct{}
+	clearedthumbnails         bool
+	user                      *pulid.ID
+	cleareduser               bool
+	room                      *pulid.ID
+	clearedroom               bool
+	done                      bool
+	oldValue                  func(context.Context) (*File, error)
+	predicates                []predicate.File
 }
 
-var _ ent.Mutation = (*NotificationMutation)(nil)
+var _ ent.Mutation = (*FileMutation)(nil)
 
-// notificationOption allows management of the mutation configuration using functional options.
-type notificationOption func(*NotificationMutation)
+// fileOption allows management of the mutation configuration using functional options.
+type fileOption func(*FileMutation)
 
-// newNotificationMutation creates new mutation for the Notification entity.
-func newNotificationMutation(c config, op Op, opts ...notificationOption) *NotificationMutation {
-	m := &NotificationMutation{
+// newFileMutation creates new mutation for the File entity.
+func newFileMutation(c config, op Op, opts ...fileOption) *FileMutation {
+	m := &FileMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNotification,
+		typ:           TypeFile,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4554,20 +4917,20 @@ func newNotificationMutation(c config, op Op, opts ...notificationOption) *Notif
 	return m
 }
 
-// withNotificationID sets the ID field of the mutation.
-func withNotificationID(id pulid.ID) notificationOption {
-	return func(m *NotificationMutation) {
+// withFileID sets the ID field of the mutation.
+func withFileID(id pulid.ID) fileOption {
+	return func(m *FileMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Notification
+			value *File
 		)
-		m.oldValue = func(ctx context.Context) (*Notification, error) {
+		m.oldValue = func(ctx context.Context) (*File, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Notification.Get(ctx, id)
+					value, err = m.Client().File.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4576,10 +4939,10 @@ func withNotificationID(id pulid.ID) notificationOption {
 	}
 }
 
-// withNotification sets the old Notification of the mutation.
-func withNotification(node *Notification) notificationOption {
-	return func(m *NotificationMutation) {
-		m.oldValue = func(context.Context) (*Notification, error) {
+// withFile sets the old File of the mutation.
+func withFile(node *File) fileOption {
+	return func(m *FileMutation) {
+		m.oldValue = func(context.Context) (*File, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4588,7 +4951,7 @@ func withNotification(node *Notification) notificationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NotificationMutation) Client() *Client {
+func (m FileMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4596,7 +4959,7 @@ func (m NotificationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NotificationMutation) Tx() (*Tx, error) {
+func (m FileMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4606,14 +4969,14 @@ func (m NotificationMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Notification entities.
-func (m *NotificationMutation) SetID(id pulid.ID) {
+// operation is only accepted on creation of File entities.
+func (m *FileMutation) SetID(id pulid.ID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NotificationMutation) ID() (id pulid.ID, exists bool) {
+func (m *FileMutation) ID() (id pulid.ID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4624,7 +4987,7 @@ func (m *NotificationMutation) ID() (id pulid.ID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NotificationMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
+func (m *FileMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4633,2394 +4996,2116 @@ func (m *NotificationMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Notification.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().File.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *NotificationMutation) SetTitle(s string) {
-	m.title = &s
+// SetName sets the "name" field.
+func (m *FileMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *NotificationMutation) Title() (r string, exists bool) {
-	v := m.title
+// Name returns the value of the "name" field in the mutation.
+func (m *FileMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *FileMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Name, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *NotificationMutation) ResetTitle() {
-	m.title = nil
+// ResetName resets all changes to the "name" field.
+func (m *FileMutation) ResetName() {
+	m.name = nil
 }
 
-// SetBody sets the "body" field.
-func (m *NotificationMutation) SetBody(s string) {
-	m.body = &s
+// SetContentType sets the "content_type" field.
+func (m *FileMutation) SetContentType(s string) {
+	m.content_type = &s
 }
 
-// Body returns the value of the "body" field in the mutation.
-func (m *NotificationMutation) Body() (r string, exists bool) {
-	v := m.body
+// ContentType returns the value of the "content_type" field in the mutation.
+func (m *FileMutation) ContentType() (r string, exists bool) {
+	v := m.content_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBody returns the old "body" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// OldContentType returns the old "content_type" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldBody(ctx context.Context) (v string, err error) {
+func (m *FileMutation) OldContentType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBody requires an ID field in the mutation")
+		return v, errors.New("OldContentType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
 	}
-	return oldValue.Body, nil
+	return oldValue.ContentType, nil
 }
 
-// ResetBody resets all changes to the "body" field.
-func (m *NotificationMutation) ResetBody() {
-	m.body = nil
+// ResetContentType resets all changes to the "content_type" field.
+func (m *FileMutation) ResetContentType() {
+	m.content_type = nil
 }
 
-// SetData sets the "data" field.
-func (m *NotificationMutation) SetData(value map[string]interface{}) {
-	m.data = &value
+// SetSize sets the "size" field.
+func (m *FileMutation) SetSize(u uint64) {
+	m.size = &u
+	m.addsize = nil
 }
 
-// Data returns the value of the "data" field in the mutation.
-func (m *NotificationMutation) Data() (r map[string]interface{}, exists bool) {
-	v := m.data
+// Size returns the value of the "size" field in the mutation.
+func (m *FileMutation) Size() (r uint64, exists bool) {
+	v := m.size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldData returns the old "data" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// OldSize returns the old "size" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldData(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *FileMutation) OldSize(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldData is only allowed on UpdateOne operations")
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldData requires an ID field in the mutation")
+		return v, errors.New("OldSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldData: %w", err)
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
 	}
-	return oldValue.Data, nil
+	return oldValue.Size, nil
 }
 
-// ClearData clears the value of the "data" field.
-func (m *NotificationMutation) ClearData() {
-	m.data = nil
-	m.clearedFields[notification.FieldData] = struct{}{}
+// AddSize adds u to the "size" field.
+func (m *FileMutation) AddSize(u int64) {
+	if m.addsize != nil {
+		*m.addsize += u
+	} else {
+		m.addsize = &u
+	}
 }
 
-// DataCleared returns if the "data" field was cleared in this mutation.
-func (m *NotificationMutation) DataCleared() bool {
-	_, ok := m.clearedFields[notification.FieldData]
-	return ok
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *FileMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetData resets all changes to the "data" field.
-func (m *NotificationMutation) ResetData() {
-	m.data = nil
-	delete(m.clearedFields, notification.FieldData)
+// ResetSize resets all changes to the "size" field.
+func (m *FileMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *NotificationMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetLocation sets the "location" field.
+func (m *FileMutation) SetLocation(s string) {
+	m.location = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *NotificationMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Location returns the value of the "location" field in the mutation.
+func (m *FileMutation) Location() (r string, exists bool) {
+	v := m.location
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// OldLocation returns the old "location" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FileMutation) OldLocation(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldLocation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Location, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *NotificationMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearLocation clears the value of the "location" field.
+func (m *FileMutation) ClearLocation() {
+	m.location = nil
+	m.clearedFields[file.FieldLocation] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *NotificationMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// LocationCleared returns if the "location" field was cleared in this mutation.
+func (m *FileMutation) LocationCleared() bool {
+	_, ok := m.clearedFields[file.FieldLocation]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *NotificationMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetLocation resets all changes to the "location" field.
+func (m *FileMutation) ResetLocation() {
+	m.location = nil
+	delete(m.clearedFields, file.FieldLocation)
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+// SetBucket sets the "bucket" field.
+func (m *FileMutation) SetBucket(s string) {
+	m.bucket = &s
+}
+
+// Bucket returns the value of the "bucket" field in the mutation.
+func (m *FileMutation) Bucket() (r string, exists bool) {
+	v := m.bucket
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBucket returns the old "bucket" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldBucket(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldBucket is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldBucket requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldBucket: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Bucket, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *NotificationMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetBucket resets all changes to the "bucket" field.
+func (m *FileMutation) ResetBucket() {
+	m.bucket = nil
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *NotificationMutation) SetUserID(id pulid.ID) {
-	m.user = &id
+// SetPath sets the "path" field.
+func (m *FileMutation) SetPath(s string) {
+	m._path = &s
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *NotificationMutation) ClearUser() {
-	m.cleareduser = true
+// Path returns the value of the "path" field in the mutation.
+func (m *FileMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *NotificationMutation) UserCleared() bool {
-	return m.cleareduser
+// OldPath returns the old "path" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *NotificationMutation) UserID() (id pulid.ID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
-	}
-	return
+// ResetPath resets all changes to the "path" field.
+func (m *FileMutation) ResetPath() {
+	m._path = nil
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *NotificationMutation) UserIDs() (ids []pulid.ID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// SetWidth sets the "width" field.
+func (m *FileMutation) SetWidth(i int) {
+	m.width = &i
+	m.addwidth = nil
+}
+
+// Width returns the value of the "width" field in the mutation.
+func (m *FileMutation) Width() (r int, exists bool) {
+	v := m.width
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *NotificationMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// OldWidth returns the old "width" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldWidth(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+	}
+	return oldValue.Width, nil
 }
 
-// Where appends a list predicates to the NotificationMutation builder.
-func (m *NotificationMutation) Where(ps ...predicate.Notification) {
-	m.predicates = append(m.predicates, ps...)
+// AddWidth adds i to the "width" field.
+func (m *FileMutation) AddWidth(i int) {
+	if m.addwidth != nil {
+		*m.addwidth += i
+	} else {
+		m.addwidth = &i
+	}
 }
 
-// WhereP appends storage-level predicates to the NotificationMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NotificationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Notification, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *FileMutation) AddedWidth() (r int, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *NotificationMutation) Op() Op {
-	return m.op
+// ClearWidth clears the value of the "width" field.
+func (m *FileMutation) ClearWidth() {
+	m.width = nil
+	m.addwidth = nil
+	m.clearedFields[file.FieldWidth] = struct{}{}
 }
 
-// SetOp allows setting the mutation operation.
-func (m *NotificationMutation) SetOp(op Op) {
-	m.op = op
+// WidthCleared returns if the "width" field was cleared in this mutation.
+func (m *FileMutation) WidthCleared() bool {
+	_, ok := m.clearedFields[file.FieldWidth]
+	return ok
 }
 
-// Type returns the node type of this mutation (Notification).
-func (m *NotificationMutation) Type() string {
-	return m.typ
+// ResetWidth resets all changes to the "width" field.
+func (m *FileMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+	delete(m.clearedFields, file.FieldWidth)
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *NotificationMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.title != nil {
-		fields = append(fields, notification.FieldTitle)
-	}
-	if m.body != nil {
-		fields = append(fields, notification.FieldBody)
+// SetHeight sets the "height" field.
+func (m *FileMutation) SetHeight(i int) {
+	m.height = &i
+	m.addheight = nil
+}
+
+// Height returns the value of the "height" field in the mutation.
+func (m *FileMutation) Height() (r int, exists bool) {
+	v := m.height
+	if v == nil {
+		return
 	}
-	if m.data != nil {
-		fields = append(fields, notification.FieldData)
+	return *v, true
+}
+
+// OldHeight returns the old "height" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldHeight(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, notification.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeight requires an ID field in the mutation")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, notification.FieldUpdatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
 	}
-	return fields
+	return oldValue.Height, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *NotificationMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case notification.FieldTitle:
-		return m.Title()
-	case notification.FieldBody:
-		return m.Body()
-	case notification.FieldData:
-		return m.Data()
-	case notification.FieldCreatedAt:
-		return m.CreatedAt()
-	case notification.FieldUpdatedAt:
-		return m.UpdatedAt()
+// AddHeight adds i to the "height" field.
+func (m *FileMutation) AddHeight(i int) {
+	if m.addheight != nil {
+		*m.addheight += i
+	} else {
+		m.addheight = &i
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *NotificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case notification.FieldTitle:
-		return m.OldTitle(ctx)
-	case notification.FieldBody:
-		return m.OldBody(ctx)
-	case notification.FieldData:
-		return m.OldData(ctx)
-	case notification.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case notification.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *FileMutation) AddedHeight() (r int, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Notification field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *NotificationMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case notification.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case notification.FieldBody:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBody(v)
-		return nil
-	case notification.FieldData:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetData(v)
-		return nil
-	case notification.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case notification.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Notification field %s", name)
+// ClearHeight clears the value of the "height" field.
+func (m *FileMutation) ClearHeight() {
+	m.height = nil
+	m.addheight = nil
+	m.clearedFields[file.FieldHeight] = struct{}{}
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *NotificationMutation) AddedFields() []string {
-	return nil
+// HeightCleared returns if the "height" field was cleared in this mutation.
+func (m *FileMutation) HeightCleared() bool {
+	_, ok := m.clearedFields[file.FieldHeight]
+	return ok
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *NotificationMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// ResetHeight resets all changes to the "height" field.
+func (m *FileMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+	delete(m.clearedFields, file.FieldHeight)
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *NotificationMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Notification numeric field %s", name)
+// SetUserID sets the "user_id" field.
+func (m *FileMutation) SetUserID(pu pulid.ID) {
+	m.user = &pu
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *NotificationMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(notification.FieldData) {
-		fields = append(fields, notification.FieldData)
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *FileMutation) UserID() (r pulid.ID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *NotificationMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// OldUserID returns the old "user_id" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldUserID(ctx context.Context) (v pulid.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *FileMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[file.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *FileMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[file.FieldUserID]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *NotificationMutation) ClearField(name string) error {
-	switch name {
-	case notification.FieldData:
-		m.ClearData()
-		return nil
-	}
-	return fmt.Errorf("unknown Notification nullable field %s", name)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *FileMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, file.FieldUserID)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *NotificationMutation) ResetField(name string) error {
-	switch name {
-	case notification.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case notification.FieldBody:
-		m.ResetBody()
-		return nil
-	case notification.FieldData:
-		m.ResetData()
-		return nil
-	case notification.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case notification.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Notification field %s", name)
+// SetRoomID sets the "room_id" field.
+func (m *FileMutation) SetRoomID(pu pulid.ID) {
+	m.room = &pu
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NotificationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, notification.EdgeUser)
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *FileMutation) RoomID() (r pulid.ID, exists bool) {
+	v := m.room
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *NotificationMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case notification.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
+// OldRoomID returns the old "room_id" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldRoomID(ctx context.Context) (v pulid.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
 	}
-	return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
+	}
+	return oldValue.RoomID, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NotificationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// ClearRoomID clears the value of the "room_id" field.
+func (m *FileMutation) ClearRoomID() {
+	m.room = nil
+	m.clearedFields[file.FieldRoomID] = struct{}{}
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *NotificationMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// RoomIDCleared returns if the "room_id" field was cleared in this mutation.
+func (m *FileMutation) RoomIDCleared() bool {
+	_, ok := m.clearedFields[file.FieldRoomID]
+	return ok
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NotificationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, notification.EdgeUser)
-	}
-	return edges
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *FileMutation) ResetRoomID() {
+	m.room = nil
+	delete(m.clearedFields, file.FieldRoomID)
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *NotificationMutation) EdgeCleared(name string) bool {
-	switch name {
-	case notification.EdgeUser:
-		return m.cleareduser
-	}
-	return false
+// SetCreatedAt sets the "created_at" field.
+func (m *FileMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *NotificationMutation) ClearEdge(name string) error {
-	switch name {
-	case notification.EdgeUser:
-		m.ClearUser()
-		return nil
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FileMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Notification unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *NotificationMutation) ResetEdge(name string) error {
-	switch name {
-	case notification.EdgeUser:
-		m.ResetUser()
-		return nil
+// OldCreatedAt returns the old "created_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Notification edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// RoomMutation represents an operation that mutates the Room nodes in the graph.
-type RoomMutation struct {
-	config
-	op                         Op
-	typ                        string
-	id                         *pulid.ID
-	deleted_at                 *time.Time
-	name                       *string
-	description                *string
-	version                    *uint64
-	addversion                 *int64
-	_type                      *room.Type
-	created_at                 *time.Time
-	updated_at                 *time.Time
-	clearedFields              map[string]struct{}
-	user_contacts              map[pulid.ID]struct{}
-	removeduser_contacts       map[pulid.ID]struct{}
-	cleareduser_contacts       bool
-	users                      map[pulid.ID]struct{}
-	removedusers               map[pulid.ID]struct{}
-	clearedusers               bool
-	last_message               *pulid.ID
-	clearedlast_message        bool
-	messages                   map[pulid.ID]struct{}
-	removedmessages            map[pulid.ID]struct{}
-	clearedmessages            bool
-	message_voices             map[pulid.ID]struct{}
-	removedmessage_voices      map[pulid.ID]struct{}
-	clearedmessage_voices      bool
-	message_attachments        map[pulid.ID]struct{}
-	removedmessage_attachments map[pulid.ID]struct{}
-	clearedmessage_attachments bool
-	message_links              map[pulid.ID]struct{}
-	removedmessage_links       map[pulid.ID]struct{}
-	clearedmessage_links       bool
-	room_members               map[pulid.ID]struct{}
-	removedroom_members        map[pulid.ID]struct{}
-	clearedroom_members        bool
-	done                       bool
-	oldValue                   func(context.Context) (*Room, error)
-	predicates                 []predicate.Room
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FileMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-var _ ent.Mutation = (*RoomMutation)(nil)
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FileMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
 
-// roomOption allows management of the mutation configuration using functional options.
-type roomOption func(*RoomMutation)
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FileMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newRoomMutation creates new mutation for the Room entity.
-func newRoomMutation(c config, op Op, opts ...roomOption) *RoomMutation {
-	m := &RoomMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeRoom,
-		clearedFields: make(map[string]struct{}),
+// OldUpdatedAt returns the old "updated_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// withRoomID sets the ID field of the mutation.
-func withRoomID(id pulid.ID) roomOption {
-	return func(m *RoomMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Room
-		)
-		m.oldValue = func(ctx context.Context) (*Room, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Room.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FileMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// withRoom sets the old Room of the mutation.
-func withRoom(node *Room) roomOption {
-	return func(m *RoomMutation) {
-		m.oldValue = func(context.Context) (*Room, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// SetMessageAttachmentID sets the "message_attachment" edge to the MessageAttachment entity by id.
+func (m *FileMutation) SetMessageAttachmentID(id pulid.ID) {
+	m.message_attachment = &id
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RoomMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearMessageAttachment clears the "message_attachment" edge to the MessageAttachment entity.
+func (m *FileMutation) ClearMessageAttachment() {
+	m.clearedmessage_attachment = true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m RoomMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// MessageAttachmentCleared reports if the "message_attachment" edge to the MessageAttachment entity was cleared.
+func (m *FileMutation) MessageAttachmentCleared() bool {
+	return m.clearedmessage_attachment
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Room entities.
-func (m *RoomMutation) SetID(id pulid.ID) {
-	m.id = &id
+// MessageAttachmentID returns the "message_attachment" edge ID in the mutation.
+func (m *FileMutation) MessageAttachmentID() (id pulid.ID, exists bool) {
+	if m.message_attachment != nil {
+		return *m.message_attachment, true
+	}
+	return
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *RoomMutation) ID() (id pulid.ID, exists bool) {
-	if m.id == nil {
-		return
+// MessageAttachmentIDs returns the "message_attachment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageAttachmentID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) MessageAttachmentIDs() (ids []pulid.ID) {
+	if id := m.message_attachment; id != nil {
+		ids = append(ids, *id)
 	}
-	return *m.id, true
+	return
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *RoomMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []pulid.ID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Room.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetMessageAttachment resets all changes to the "message_attachment" edge.
+func (m *FileMutation) ResetMessageAttachment() {
+	m.message_attachment = nil
+	m.clearedmessage_attachment = false
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *RoomMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetMessageVoiceID sets the "message_voice" edge to the MessageVoice entity by id.
+func (m *FileMutation) SetMessageVoiceID(id pulid.ID) {
+	m.message_voice = &id
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *RoomMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearMessageVoice clears the "message_voice" edge to the MessageVoice entity.
+func (m *FileMutation) ClearMessageVoice() {
+	m.clearedmessage_voice = true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Room entity.
-// If the Room object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+// MessageVoiceCleared reports if the "message_voice" edge to the MessageVoice entity was cleared.
+func (m *FileMutation) MessageVoiceCleared() bool {
+	return m.clearedmessage_voice
+}
+
+// MessageVoiceID returns the "message_voice" edge ID in the mutation.
+func (m *FileMutation) MessageVoiceID() (id pulid.ID, exists bool) {
+	if m.message_voice != nil {
+		return *m.message_voice, true
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	return
+}
+
+// MessageVoiceIDs returns the "message_voice" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageVoiceID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) MessageVoiceIDs() (ids []pulid.ID) {
+	if id := m.message_voice; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.DeletedAt, nil
+	return
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *RoomMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[room.FieldDeletedAt] = struct{}{}
+// ResetMessageVoice resets all changes to the "message_voice" edge.
+func (m *FileMutation) ResetMessageVoice() {
+	m.message_voice = nil
+	m.clearedmessage_voice = false
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *RoomMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[room.FieldDeletedAt]
-	return ok
+// SetOriginalID sets the "original" edge to the File entity by id.
+func (m *FileMutation) SetOriginalID(id pulid.ID) {
+	m.original = &id
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *RoomMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, room.FieldDeletedAt)
+// ClearOriginal clears the "original" edge to the File entity.
+func (m *FileMutation) ClearOriginal() {
+	m.clearedoriginal = true
 }
 
-// SetName sets the "name" field.
-func (m *RoomMutation) SetName(s string) {
-	m.name = &s
+// OriginalCleared reports if the "original" edge to the File entity was cleared.
+func (m *FileMutation) OriginalCleared() bool {
+	return m.clearedoriginal
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *RoomMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
+// OriginalID returns the "original" edge ID in the mutation.
+func (m *FileMutation) OriginalID() (id pulid.ID, exists bool) {
+	if m.original != nil {
+		return *m.original, true
 	}
-	return *v, true
+	return
 }
 
-// OldName returns the old "name" field's value of the Room entity.
-// If the Room object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+// OriginalIDs returns the "original" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OriginalID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) OriginalIDs() (ids []pulid.ID) {
+	if id := m.original; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Name, nil
+	return
 }
 
-// ClearName clears the value of the "name" field.
-func (m *RoomMutation) ClearName() {
-	m.name = nil
-	m.clearedFields[room.FieldName] = struct{}{}
+// ResetOriginal resets all changes to the "original" edge.
+func (m *FileMutation) ResetOriginal() {
+	m.original = nil
+	m.clearedoriginal = false
 }
 
-// NameCleared returns if the "name" field was cleared in this mutation.
-func (m *RoomMutation) NameCleared() bool {
-	_, ok := m.clearedFields[room.FieldName]
-	return ok
+// AddThumbnailIDs adds the "thumbnails" edge to the File entity by ids.
+func (m *FileMutation) AddThumbnailIDs(ids ...pulid.ID) {
+	if m.thumbnails == nil {
+		m.thumbnails = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.thumbnails[ids[i]] = struct{}{}
+	}
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *RoomMutation) ResetName() {
-	m.name = nil
-	delete(m.clearedFields, room.FieldName)
+// ClearThumbnails clears the "thumbnails" edge to the File entity.
+func (m *FileMutation) ClearThumbnails() {
+	m.clearedthumbnails = true
 }
 
-// SetDescription sets the "description" field.
-func (m *RoomMutation) SetDescription(s string) {
-	m.description = &s
+// ThumbnailsCleared reports if the "thumbnails" edge to the File entity was cleared.
+func (m *FileMutation) ThumbnailsCleared() bool {
+	return m.clearedthumbnails
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *RoomMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
+// RemoveThumbnailIDs removes the "thumbnails" edge to the File entity by IDs.
+func (m *FileMutation) RemoveThumbnailIDs(ids ...pulid.ID) {
+	if m.removedthumbnails == nil {
+		m.removedthumbnails = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.thumbnails, ids[i])
+		m.removedthumbnails[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Room entity.
-// If the Room object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+// RemovedThumbnails returns the removed IDs of the "thumbnails" edge to the File entity.
+func (m *FileMutation) RemovedThumbnailsIDs() (ids []pulid.ID) {
+	for id := range m.removedthumbnails {
+		ids = append(ids, id)
 	}
-	return oldValue.Description, nil
+	return
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *RoomMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[room.FieldDescription] = struct{}{}
+// ThumbnailsIDs returns the "thumbnails" edge IDs in the mutation.
+func (m *FileMutation) ThumbnailsIDs() (ids []pulid.ID) {
+	for id := range m.thumbnails {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *RoomMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[room.FieldDescription]
-	return ok
+// ResetThumbnails resets all changes to the "thumbnails" edge.
+func (m *FileMutation) ResetThumbnails() {
+	m.thumbnails = nil
+	m.clearedthumbnails = false
+	m.removedthumbnails = nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *RoomMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, room.FieldDescription)
+// ClearUser clears the "user" edge to the User entity.
+func (m *FileMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[file.FieldUserID] = struct{}{}
 }
 
-// SetVersion sets the "version" field.
-func (m *RoomMutation) SetVersion(u uint64) {
-	m.version = &u
-	m.addversion = nil
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *FileMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
 }
 
-// Version returns the value of the "version" field in the mutation.
-func (m *RoomMutation) Version() (r uint64, exists bool) {
-	v := m.version
-	if v == nil {
-		return
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) UserIDs() (ids []pulid.ID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// OldVersion returns the old "version" field's value of the Room entity.
-// If the Room object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMutation) OldVersion(ctx context.Context) (v uint64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersion requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
-	}
-	return oldValue.Version, nil
+// ResetUser resets all changes to the "user" edge.
+func (m *FileMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// AddVersion adds u to the "version" field.
-func (m *RoomMutation) AddVersion(u int64) {
-	if m.addversion != nil {
-		*m.addversion += u
-	} else {
-		m.addversion = &u
-	}
+// ClearRoom clears the "room" edge to the Room entity.
+func (m *FileMutation) ClearRoom() {
+	m.clearedroom = true
+	m.clearedFields[file.FieldRoomID] = struct{}{}
 }
 
-// AddedVersion returns the value that was added to the "version" field in this mutation.
-func (m *RoomMutation) AddedVersion() (r int64, exists bool) {
-	v := m.addversion
-	if v == nil {
-		return
-	}
-	return *v, true
+// RoomCleared reports if the "room" edge to the Room entity was cleared.
+func (m *FileMutation) RoomCleared() bool {
+	return m.RoomIDCleared() || m.clearedroom
 }
 
-// ResetVersion resets all changes to the "version" field.
-func (m *RoomMutation) ResetVersion() {
-	m.version = nil
-	m.addversion = nil
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) RoomIDs() (ids []pulid.ID) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// SetType sets the "type" field.
-func (m *RoomMutation) SetType(r room.Type) {
-	m._type = &r
+// ResetRoom resets all changes to the "room" edge.
+func (m *FileMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *RoomMutation) GetType() (r room.Type, exists bool) {
-	v := m._type
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the FileMutation builder.
+func (m *FileMutation) Where(ps ...predicate.File) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldType returns the old "type" field's value of the Room entity.
-// If the Room object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMutation) OldType(ctx context.Context) (v room.Type, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+// WhereP appends storage-level predicates to the FileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.File, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.Type, nil
+	m.Where(p...)
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *RoomMutation) ResetType() {
-	m._type = nil
+// Op returns the operation name.
+func (m *FileMutation) Op() Op {
+	return m.op
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *RoomMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetOp allows setting the mutation operation.
+func (m *FileMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RoomMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (File).
+func (m *FileMutation) Type() string {
+	return m.typ
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Room entity.
-// If the Room object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FileMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.name != nil {
+		fields = append(fields, file.FieldName)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	if m.content_type != nil {
+		fields = append(fields, file.FieldContentType)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	if m.size != nil {
+		fields = append(fields, file.FieldSize)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RoomMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *RoomMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RoomMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+	if m.location != nil {
+		fields = append(fields, file.FieldLocation)
 	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the Room entity.
-// If the Room object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	if m.bucket != nil {
+		fields = append(fields, file.FieldBucket)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	if m._path != nil {
+		fields = append(fields, file.FieldPath)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	if m.width != nil {
+		fields = append(fields, file.FieldWidth)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RoomMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// AddUserContactIDs adds the "user_contacts" edge to the UserContact entity by ids.
-func (m *RoomMutation) AddUserContactIDs(ids ...pulid.ID) {
-	if m.user_contacts == nil {
-		m.user_contacts = make(map[pulid.ID]struct{})
+	if m.height != nil {
+		fields = append(fields, file.FieldHeight)
 	}
-	for i := range ids {
-		m.user_contacts[ids[i]] = struct{}{}
+	if m.user != nil {
+		fields = append(fields, file.FieldUserID)
 	}
-}
-
-// ClearUserContacts clears the "user_contacts" edge to the UserContact entity.
-func (m *RoomMutation) ClearUserContacts() {
-	m.cleareduser_contacts = true
-}
-
-// UserContactsCleared reports if the "user_contacts" edge to the UserContact entity was cleared.
-func (m *RoomMutation) UserContactsCleared() bool {
-	return m.cleareduser_contacts
-}
-
-// RemoveUserContactIDs removes the "user_contacts" edge to the UserContact entity by IDs.
-func (m *RoomMutation) RemoveUserContactIDs(ids ...pulid.ID) {
-	if m.removeduser_contacts == nil {
-		m.removeduser_contacts = make(map[pulid.ID]struct{})
+	if m.room != nil {
+		fields = append(fields, file.FieldRoomID)
 	}
-	for i := range ids {
-		delete(m.user_contacts, ids[i])
-		m.removeduser_contacts[ids[i]] = struct{}{}
+	if m.created_at != nil {
+		fields = append(fields, file.FieldCreatedAt)
 	}
-}
-
-// RemovedUserContacts returns the removed IDs of the "user_contacts" edge to the UserContact entity.
-func (m *RoomMutation) RemovedUserContactsIDs() (ids []pulid.ID) {
-	for id := range m.removeduser_contacts {
-		ids = append(ids, id)
+	if m.updated_at != nil {
+		fields = append(fields, file.FieldUpdatedAt)
 	}
-	return
+	return fields
 }
 
-// UserContactsIDs returns the "user_contacts" edge IDs in the mutation.
-func (m *RoomMutation) UserContactsIDs() (ids []pulid.ID) {
-	for id := range m.user_contacts {
-		ids = append(ids, id)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case file.FieldName:
+		return m.Name()
+	case file.FieldContentType:
+		return m.ContentType()
+	case file.FieldSize:
+		return m.Size()
+	case file.FieldLocation:
+		return m.Location()
+	case file.FieldBucket:
+		return m.Bucket()
+	case file.FieldPath:
+		return m.Path()
+	case file.FieldWidth:
+		return m.Width()
+	case file.FieldHeight:
+		return m.Height()
+	case file.FieldUserID:
+		return m.UserID()
+	case file.FieldRoomID:
+		return m.RoomID()
+	case file.FieldCreatedAt:
+		return m.CreatedAt()
+	case file.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
-	return
-}
-
-// ResetUserContacts resets all changes to the "user_contacts" edge.
-func (m *RoomMutation) ResetUserContacts() {
-	m.user_contacts = nil
-	m.cleareduser_contacts = false
-	m.removeduser_contacts = nil
+	return nil, false
 }
 
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *RoomMutation) AddUserIDs(ids ...pulid.ID) {
-	if m.users == nil {
-		m.users = make(map[pulid.ID]struct{})
-	}
-	for i := range ids {
-		m.users[ids[i]] = struct{}{}
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case file.FieldName:
+		return m.OldName(ctx)
+	case file.FieldContentType:
+		return m.OldContentType(ctx)
+	case file.FieldSize:
+		return m.OldSize(ctx)
+	case file.FieldLocation:
+		return m.OldLocation(ctx)
+	case file.FieldBucket:
+		return m.OldBucket(ctx)
+	case file.FieldPath:
+		return m.OldPath(ctx)
+	case file.FieldWidth:
+		return m.OldWidth(ctx)
+	case file.FieldHeight:
+		return m.OldHeight(ctx)
+	case file.FieldUserID:
+		return m.OldUserID(ctx)
+	case file.FieldRoomID:
+		return m.OldRoomID(ctx)
+	case file.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case file.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
+	return nil, fmt.Errorf("unknown File field %s", name)
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *RoomMutation) ClearUsers() {
-	m.clearedusers = true
-}
-
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *RoomMutation) UsersCleared() bool {
-	return m.clearedusers
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case file.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case file.FieldContentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentType(v)
+		return nil
+	case file.FieldSize:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case file.FieldLocation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	case file.FieldBucket:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBucket(v)
+		return nil
+	case file.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case file.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case file.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case file.FieldUserID:
+		v, ok := value.(pulid.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case file.FieldRoomID:
+		v, ok := value.(pulid.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomID(v)
+		return nil
+	case file.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case file.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown File field %s", name)
 }
 
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *RoomMutation) RemoveUserIDs(ids ...pulid.ID) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[pulid.ID]struct{})
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FileMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, file.FieldSize)
 	}
-	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
+	if m.addwidth != nil {
+		fields = append(fields, file.FieldWidth)
 	}
-}
-
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *RoomMutation) RemovedUsersIDs() (ids []pulid.ID) {
-	for id := range m.removedusers {
-		ids = append(ids, id)
+	if m.addheight != nil {
+		fields = append(fields, file.FieldHeight)
 	}
-	return
+	return fields
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *RoomMutation) UsersIDs() (ids []pulid.ID) {
-	for id := range m.users {
-		ids = append(ids, id)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case file.FieldSize:
+		return m.AddedSize()
+	case file.FieldWidth:
+		return m.AddedWidth()
+	case file.FieldHeight:
+		return m.AddedHeight()
 	}
-	return
-}
-
-// ResetUsers resets all changes to the "users" edge.
-func (m *RoomMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
-}
-
-// SetLastMessageID sets the "last_message" edge to the Message entity by id.
-func (m *RoomMutation) SetLastMessageID(id pulid.ID) {
-	m.last_message = &id
-}
-
-// ClearLastMessage clears the "last_message" edge to the Message entity.
-func (m *RoomMutation) ClearLastMessage() {
-	m.clearedlast_message = true
-}
-
-// LastMessageCleared reports if the "last_message" edge to the Message entity was cleared.
-func (m *RoomMutation) LastMessageCleared() bool {
-	return m.clearedlast_message
+	return nil, false
 }
 
-// LastMessageID returns the "last_message" edge ID in the mutation.
-func (m *RoomMutation) LastMessageID() (id pulid.ID, exists bool) {
-	if m.last_message != nil {
-		return *m.last_message, true
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case file.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case file.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case file.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown File numeric field %s", name)
 }
 
-// LastMessageIDs returns the "last_message" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// LastMessageID instead. It exists only for internal usage by the builders.
-func (m *RoomMutation) LastMessageIDs() (ids []pulid.ID) {
-	if id := m.last_message; id != nil {
-		ids = append(ids, *id)
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FileMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(file.FieldLocation) {
+		fields = append(fields, file.FieldLocation)
 	}
-	return
-}
-
-// ResetLastMessage resets all changes to the "last_message" edge.
-func (m *RoomMutation) ResetLastMessage() {
-	m.last_message = nil
-	m.clearedlast_message = false
-}
-
-// AddMessageIDs adds the "messages" edge to the Message entity by ids.
-func (m *RoomMutation) AddMessageIDs(ids ...pulid.ID) {
-	if m.messages == nil {
-		m.messages = make(map[pulid.ID]struct{})
+	if m.FieldCleared(file.FieldWidth) {
+		fields = append(fields, file.FieldWidth)
 	}
-	for i := range ids {
-		m.messages[ids[i]] = struct{}{}
+	if m.FieldCleared(file.FieldHeight) {
+		fields = append(fields, file.FieldHeight)
 	}
+	if m.FieldCleared(file.FieldUserID) {
+		fields = append(fields, file.FieldUserID)
+	}
+	if m.FieldCleared(file.FieldRoomID) {
+		fields = append(fields, file.FieldRoomID)
+	}
+	return fields
 }
 
-// ClearMessages clears the "messages" edge to the Message entity.
-func (m *RoomMutation) ClearMessages() {
-	m.clearedmessages = true
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
-func (m *RoomMutation) MessagesCleared() bool {
-	return m.clearedmessages
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FileMutation) ClearField(name string) error {
+	switch name {
+	case file.FieldLocation:
+		m.ClearLocation()
+		return nil
+	case file.FieldWidth:
+		m.ClearWidth()
+		return nil
+	case file.FieldHeight:
+		m.ClearHeight()
+		return nil
+	case file.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case file.FieldRoomID:
+		m.ClearRoomID()
+		return nil
+	}
+	return fmt.Errorf("unknown File nullable field %s", name)
 }
 
-// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
-func (m *RoomMutation) RemoveMessageIDs(ids ...pulid.ID) {
-	if m.removedmessages == nil {
-		m.removedmessages = make(map[pulid.ID]struct{})
-	}
-	for i := range ids {
-		delete(m.messages, ids[i])
-		m.removedmessages[ids[i]] = struct{}{}
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FileMutation) ResetField(name string) error {
+	switch name {
+	case file.FieldName:
+		m.ResetName()
+		return nil
+	case file.FieldContentType:
+		m.ResetContentType()
+		return nil
+	case file.FieldSize:
+		m.ResetSize()
+		return nil
+	case file.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case file.FieldBucket:
+		m.ResetBucket()
+		return nil
+	case file.FieldPath:
+		m.ResetPath()
+		return nil
+	case file.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case file.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case file.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case file.FieldRoomID:
+		m.ResetRoomID()
+		return nil
+	case file.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case file.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
+	return fmt.Errorf("unknown File field %s", name)
 }
 
-// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
-func (m *RoomMutation) RemovedMessagesIDs() (ids []pulid.ID) {
-	for id := range m.removedmessages {
-		ids = append(ids, id)
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.message_attachment != nil {
+		edges = append(edges, file.EdgeMessageAttachment)
 	}
-	return
-}
-
-// MessagesIDs returns the "messages" edge IDs in the mutation.
-func (m *RoomMutation) MessagesIDs() (ids []pulid.ID) {
-	for id := range m.messages {
-		ids = append(ids, id)
+	if m.message_voice != nil {
+		edges = append(edges, file.EdgeMessageVoice)
 	}
-	return
-}
-
-// ResetMessages resets all changes to the "messages" edge.
-func (m *RoomMutation) ResetMessages() {
-	m.messages = nil
-	m.clearedmessages = false
-	m.removedmessages = nil
-}
-
-// AddMessageVoiceIDs adds the "message_voices" edge to the MessageVoice entity by ids.
-func (m *RoomMutation) AddMessageVoiceIDs(ids ...pulid.ID) {
-	if m.message_voices == nil {
-		m.message_voices = make(map[pulid.ID]struct{})
+	if m.original != nil {
+		edges = append(edges, file.EdgeOriginal)
 	}
-	for i := range ids {
-		m.message_voices[ids[i]] = struct{}{}
+	if m.thumbnails != nil {
+		edges = append(edges, file.EdgeThumbnails)
 	}
-}
-
-// ClearMessageVoices clears the "message_voices" edge to the MessageVoice entity.
-func (m *RoomMutation) ClearMessageVoices() {
-	m.clearedmessage_voices = true
-}
-
-// MessageVoicesCleared reports if the "message_voices" edge to the MessageVoice entity was cleared.
-func (m *RoomMutation) MessageVoicesCleared() bool {
-	return m.clearedmessage_voices
-}
-
-// RemoveMessageVoiceIDs removes the "message_voices" edge to the MessageVoice entity by IDs.
-func (m *RoomMutation) RemoveMessageVoiceIDs(ids ...pulid.ID) {
-	if m.removedmessage_voices == nil {
-		m.removedmessage_voices = make(map[pulid.ID]struct{})
+	if m.user != nil {
+		edges = append(edges, file.EdgeUser)
 	}
-	for i := range ids {
-		delete(m.message_voices, ids[i])
-		m.removedmessage_voices[ids[i]] = struct{}{}
+	if m.room != nil {
+		edges = append(edges, file.EdgeRoom)
 	}
+	return edges
 }
 
-// RemovedMessageVoices returns the removed IDs of the "message_voices" edge to the MessageVoice entity.
-func (m *RoomMutation) RemovedMessageVoicesIDs() (ids []pulid.ID) {
-	for id := range m.removedmessage_voices {
-		ids = append(ids, id)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FileMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case file.EdgeMessageAttachment:
+		if id := m.message_attachment; id != nil {
+			return []ent.Value{*id}
+		}
+	case file.EdgeMessageVoice:
+		if id := m.message_voice; id != nil {
+			return []ent.Value{*id}
+		}
+	case file.EdgeOriginal:
+		if id := m.original; id != nil {
+			return []ent.Value{*id}
+		}
+	case file.EdgeThumbnails:
+		ids := make([]ent.Value, 0, len(m.thumbnails))
+		for id := range m.thumbnails {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case file.EdgeRoom:
+		if id := m.room; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return
+	return nil
 }
 
-// MessageVoicesIDs returns the "message_voices" edge IDs in the mutation.
-func (m *RoomMutation) MessageVoicesIDs() (ids []pulid.ID) {
-	for id := range m.message_voices {
-		ids = append(ids, id)
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedthumbnails != nil {
+		edges = append(edges, file.EdgeThumbnails)
 	}
-	return
+	return edges
 }
 
-// ResetMessageVoices resets all changes to the "message_voices" edge.
-func (m *RoomMutation) ResetMessageVoices() {
-	m.message_voices = nil
-	m.clearedmessage_voices = false
-	m.removedmessage_voices = nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FileMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case file.EdgeThumbnails:
+		ids := make([]ent.Value, 0, len(m.removedthumbnails))
+		for id := range m.removedthumbnails {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// AddMessageAttachmentIDs adds the "message_attachments" edge to the MessageAttachment entity by ids.
-func (m *RoomMutation) AddMessageAttachmentIDs(ids ...pulid.ID) {
-	if m.message_attachments == nil {
-		m.message_attachments = make(map[pulid.ID]struct{})
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedmessage_attachment {
+		edges = append(edges, file.EdgeMessageAttachment)
 	}
-	for i := range ids {
-		m.message_attachments[ids[i]] = struct{}{}
+	if m.clearedmessage_voice {
+		edges = append(edges, file.EdgeMessageVoice)
 	}
-}
-
-// ClearMessageAttachments clears the "message_attachments" edge to the MessageAttachment entity.
-func (m *RoomMutation) ClearMessageAttachments() {
-	m.clearedmessage_attachments = true
-}
-
-// MessageAttachmentsCleared reports if the "message_attachments" edge to the MessageAttachment entity was cleared.
-func (m *RoomMutation) MessageAttachmentsCleared() bool {
-	return m.clearedmessage_attachments
-}
-
-// RemoveMessageAttachmentIDs removes the "message_attachments" edge to the MessageAttachment entity by IDs.
-func (m *RoomMutation) RemoveMessageAttachmentIDs(ids ...pulid.ID) {
-	if m.removedmessage_attachments == nil {
-		m.removedmessage_attachments = make(map[pulid.ID]struct{})
+	if m.clearedoriginal {
+		edges = append(edges, file.EdgeOriginal)
 	}
-	for i := range ids {
-		delete(m.message_attachments, ids[i])
-		m.removedmessage_attachments[ids[i]] = struct{}{}
+	if m.clearedthumbnails {
+		edges = append(edges, file.EdgeThumbnails)
 	}
-}
-
-// RemovedMessageAttachments returns the removed IDs of the "message_attachments" edge to the MessageAttachment entity.
-func (m *RoomMutation) RemovedMessageAttachmentsIDs() (ids []pulid.ID) {
-	for id := range m.removedmessage_attachments {
-		ids = append(ids, id)
+	if m.cleareduser {
+		edges = append(edges, file.EdgeUser)
 	}
-	return
+	if m.clearedroom {
+		edges = append(edges, file.EdgeRoom)
+	}
+	return edges
 }
 
-// MessageAttachmentsIDs returns the "message_attachments" edge IDs in the mutation.
-func (m *RoomMutation) MessageAttachmentsIDs() (ids []pulid.ID) {
-	for id := range m.message_attachments {
-		ids = append(ids, id)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FileMutation) EdgeCleared(name string) bool {
+	switch name {
+	case file.EdgeMessageAttachment:
+		return m.clearedmessage_attachment
+	case file.EdgeMessageVoice:
+		return m.clearedmessage_voice
+	case file.EdgeOriginal:
+		return m.clearedoriginal
+	case file.EdgeThumbnails:
+		return m.clearedthumbnails
+	case file.EdgeUser:
+		return m.cleareduser
+	case file.EdgeRoom:
+		return m.clearedroom
 	}
-	return
+	return false
 }
 
-// ResetMessageAttachments resets all changes to the "message_attachments" edge.
-func (m *RoomMutation) ResetMessageAttachments() {
-	m.message_attachments = nil
-	m.clearedmessage_attachments = false
-	m.removedmessage_attachments = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FileMutation) ClearEdge(name string) error {
+	switch name {
+	case file.EdgeMessageAttachment:
+		m.ClearMessageAttachment()
+		return nil
+	case file.EdgeMessageVoice:
+		m.ClearMessageVoice()
+		return nil
+	case file.EdgeOriginal:
+		m.ClearOriginal()
+		return nil
+	case file.EdgeUser:
+		m.ClearUser()
+		return nil
+	case file.EdgeRoom:
+		m.ClearRoom()
+		return nil
+	}
+	return fmt.Errorf("unknown File unique edge %s", name)
 }
 
-// AddMessageLinkIDs adds the "message_links" edge to the MessageLink entity by ids.
-func (m *RoomMutation) AddMessageLinkIDs(ids ...pulid.ID) {
-	if m.message_links == nil {
-		m.message_links = make(map[pulid.ID]struct{})
-	}
-	for i := range ids {
-		m.message_links[ids[i]] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FileMutation) ResetEdge(name string) error {
+	switch name {
+	case file.EdgeMessageAttachment:
+		m.ResetMessageAttachment()
+		return nil
+	case file.EdgeMessageVoice:
+		m.ResetMessageVoice()
+		return nil
+	case file.EdgeOriginal:
+		m.ResetOriginal()
+		return nil
+	case file.EdgeThumbnails:
+		m.ResetThumbnails()
+		return nil
+	case file.EdgeUser:
+		m.ResetUser()
+		return nil
+	case file.EdgeRoom:
+		m.ResetRoom()
+		return nil
 	}
+	return fmt.Errorf("unknown File edge %s", name)
 }
 
-// ClearMessageLinks clears the "message_links" edge to the MessageLink entity.
-func (m *RoomMutation) ClearMessageLinks() {
-	m.clearedmessage_links = true
+// MessageMutation represents an operation that mutates the Message nodes in the graph.
+type MessageMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *pulid.ID
+	_type              *message.Type
+	content            *string
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	voice              *pulid.ID
+	clearedvoice       bool
+	reply_to           *pulid.ID
+	clearedreply_to    bool
+	replies            map[pulid.ID]struct{}
+	removedreplies     map[pulid.ID]struct{}
+	clearedreplies     bool
+	attachments        map[pulid.ID]struct{}
+	removedattachments map[pulid.ID]struct{}
+	clearedattachments bool
+	links              map[pulid.ID]struct{}
+	removedlinks       map[pulid.ID]struct{}
+	clearedlinks       bool
+	call               *pulid.ID
+	clearedcall        bool
+	avatar             *pulid.ID
+	clearedavatar      bool
+	user               *pulid.ID
+	cleareduser        bool
+	room               *pulid.ID
+	clearedroom        bool
+	done               bool
+	oldValue           func(context.Context) (*Message, error)
+	predicates         []predicate.Message
 }
 
-// MessageLinksCleared reports if the "message_links" edge to the MessageLink entity was cleared.
-func (m *RoomMutation) MessageLinksCleared() bool {
-	return m.clearedmessage_links
-}
+var _ ent.Mutation = (*MessageMutation)(nil)
 
-// RemoveMessageLinkIDs removes the "message_links" edge to the MessageLink entity by IDs.
-func (m *RoomMutation) RemoveMessageLinkIDs(ids ...pulid.ID) {
-	if m.removedmessage_links == nil {
-		m.removedmessage_links = make(map[pulid.ID]struct{})
+// messageOption allows management of the mutation configuration using functional options.
+type messageOption func(*MessageMutation)
+
+// newMessageMutation creates new mutation for the Message entity.
+func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
+	m := &MessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessage,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		delete(m.message_links, ids[i])
-		m.removedmessage_links[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// RemovedMessageLinks returns the removed IDs of the "message_links" edge to the MessageLink entity.
-func (m *RoomMutation) RemovedMessageLinksIDs() (ids []pulid.ID) {
-	for id := range m.removedmessage_links {
-		ids = append(ids, id)
+// withMessageID sets the ID field of the mutation.
+func withMessageID(id pulid.ID) messageOption {
+	return func(m *MessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Message
+		)
+		m.oldValue = func(ctx context.Context) (*Message, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Message.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// MessageLinksIDs returns the "message_links" edge IDs in the mutation.
-func (m *RoomMutation) MessageLinksIDs() (ids []pulid.ID) {
-	for id := range m.message_links {
-		ids = append(ids, id)
+// withMessage sets the old Message of the mutation.
+func withMessage(node *Message) messageOption {
+	return func(m *MessageMutation) {
+		m.oldValue = func(context.Context) (*Message, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// ResetMessageLinks resets all changes to the "message_links" edge.
-func (m *RoomMutation) ResetMessageLinks() {
-	m.message_links = nil
-	m.clearedmessage_links = false
-	m.removedmessage_links = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddRoomMemberIDs adds the "room_members" edge to the RoomMember entity by ids.
-func (m *RoomMutation) AddRoomMemberIDs(ids ...pulid.ID) {
-	if m.room_members == nil {
-		m.room_members = make(map[pulid.ID]struct{})
-	}
-	for i := range ids {
-		m.room_members[ids[i]] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearRoomMembers clears the "room_members" edge to the RoomMember entity.
-func (m *RoomMutation) ClearRoomMembers() {
-	m.clearedroom_members = true
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Message entities.
+func (m *MessageMutation) SetID(id pulid.ID) {
+	m.id = &id
 }
 
-// RoomMembersCleared reports if the "room_members" edge to the RoomMember entity was cleared.
-func (m *RoomMutation) RoomMembersCleared() bool {
-	return m.clearedroom_members
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageMutation) ID() (id pulid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// RemoveRoomMemberIDs removes the "room_members" edge to the RoomMember entity by IDs.
-func (m *RoomMutation) RemoveRoomMemberIDs(ids ...pulid.ID) {
-	if m.removedroom_members == nil {
-		m.removedroom_members = make(map[pulid.ID]struct{})
-	}
-	for i := range ids {
-		delete(m.room_members, ids[i])
-		m.removedroom_members[ids[i]] = struct{}{}
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []pulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// RemovedRoomMembers returns the removed IDs of the "room_members" edge to the RoomMember entity.
-func (m *RoomMutation) RemovedRoomMembersIDs() (ids []pulid.ID) {
-	for id := range m.removedroom_members {
-		ids = append(ids, id)
+// SetType sets the "type" field.
+func (m *MessageMutation) SetType(value message.Type) {
+	m._type = &value
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *MessageMutation) GetType() (r message.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// RoomMembersIDs returns the "room_members" edge IDs in the mutation.
-func (m *RoomMutation) RoomMembersIDs() (ids []pulid.ID) {
-	for id := range m.room_members {
-		ids = append(ids, id)
+// OldType returns the old "type" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldType(ctx context.Context) (v message.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
 }
 
-// ResetRoomMembers resets all changes to the "room_members" edge.
-func (m *RoomMutation) ResetRoomMembers() {
-	m.room_members = nil
-	m.clearedroom_members = false
-	m.removedroom_members = nil
+// ResetType resets all changes to the "type" field.
+func (m *MessageMutation) ResetType() {
+	m._type = nil
 }
 
-// Where appends a list predicates to the RoomMutation builder.
-func (m *RoomMutation) Where(ps ...predicate.Room) {
-	m.predicates = append(m.predicates, ps...)
+// SetContent sets the "content" field.
+func (m *MessageMutation) SetContent(s string) {
+	m.content = &s
 }
 
-// WhereP appends storage-level predicates to the RoomMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RoomMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Room, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Content returns the value of the "content" field in the mutation.
+func (m *MessageMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *RoomMutation) Op() Op {
-	return m.op
+// OldContent returns the old "content" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *RoomMutation) SetOp(op Op) {
-	m.op = op
+// ClearContent clears the value of the "content" field.
+func (m *MessageMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[message.FieldContent] = struct{}{}
 }
 
-// Type returns the node type of this mutation (Room).
-func (m *RoomMutation) Type() string {
-	return m.typ
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *MessageMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[message.FieldContent]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *RoomMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.deleted_at != nil {
-		fields = append(fields, room.FieldDeletedAt)
-	}
-	if m.name != nil {
-		fields = append(fields, room.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, room.FieldDescription)
-	}
-	if m.version != nil {
-		fields = append(fields, room.FieldVersion)
-	}
-	if m._type != nil {
-		fields = append(fields, room.FieldType)
-	}
-	if m.created_at != nil {
-		fields = append(fields, room.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, room.FieldUpdatedAt)
-	}
-	return fields
+// ResetContent resets all changes to the "content" field.
+func (m *MessageMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, message.FieldContent)
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *RoomMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case room.FieldDeletedAt:
-		return m.DeletedAt()
-	case room.FieldName:
-		return m.Name()
-	case room.FieldDescription:
-		return m.Description()
-	case room.FieldVersion:
-		return m.Version()
-	case room.FieldType:
-		return m.GetType()
-	case room.FieldCreatedAt:
-		return m.CreatedAt()
-	case room.FieldUpdatedAt:
-		return m.UpdatedAt()
-	}
-	return nil, false
+// SetCallID sets the "call_id" field.
+func (m *MessageMutation) SetCallID(pu pulid.ID) {
+	m.call = &pu
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *RoomMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case room.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case room.FieldName:
-		return m.OldName(ctx)
-	case room.FieldDescription:
-		return m.OldDescription(ctx)
-	case room.FieldVersion:
-		return m.OldVersion(ctx)
-	case room.FieldType:
-		return m.OldType(ctx)
-	case room.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case room.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// CallID returns the value of the "call_id" field in the mutation.
+func (m *MessageMutation) CallID() (r pulid.ID, exists bool) {
+	v := m.call
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Room field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *RoomMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case room.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
-	case room.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case room.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case room.FieldVersion:
-		v, ok := value.(uint64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetVersion(v)
-		return nil
-	case room.FieldType:
-		v, ok := value.(room.Type)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case room.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case room.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+// OldCallID returns the old "call_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCallID(ctx context.Context) (v *pulid.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCallID is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Room field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCallID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCallID: %w", err)
+	}
+	return oldValue.CallID, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *RoomMutation) AddedFields() []string {
-	var fields []string
-	if m.addversion != nil {
-		fields = append(fields, room.FieldVersion)
-	}
-	return fields
+// ClearCallID clears the value of the "call_id" field.
+func (m *MessageMutation) ClearCallID() {
+	m.call = nil
+	m.clearedFields[message.FieldCallID] = struct{}{}
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *RoomMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case room.FieldVersion:
-		return m.AddedVersion()
-	}
-	return nil, false
+// CallIDCleared returns if the "call_id" field was cleared in this mutation.
+func (m *MessageMutation) CallIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldCallID]
+	return ok
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *RoomMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case room.FieldVersion:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddVersion(v)
-		return nil
+// ResetCallID resets all changes to the "call_id" field.
+func (m *MessageMutation) ResetCallID() {
+	m.call = nil
+	delete(m.clearedFields, message.FieldCallID)
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (m *MessageMutation) SetAvatarID(pu pulid.ID) {
+	m.avatar = &pu
+}
+
+// AvatarID returns the value of the "avatar_id" field in the mutation.
+func (m *MessageMutation) AvatarID() (r pulid.ID, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Room numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *RoomMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(room.FieldDeletedAt) {
-		fields = append(fields, room.FieldDeletedAt)
+// OldAvatarID returns the old "avatar_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldAvatarID(ctx context.Context) (v *pulid.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatarID is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(room.FieldName) {
-		fields = append(fields, room.FieldName)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatarID requires an ID field in the mutation")
 	}
-	if m.FieldCleared(room.FieldDescription) {
-		fields = append(fields, room.FieldDescription)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatarID: %w", err)
 	}
-	return fields
+	return oldValue.AvatarID, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *RoomMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (m *MessageMutation) ClearAvatarID() {
+	m.avatar = nil
+	m.clearedFields[message.FieldAvatarID] = struct{}{}
+}
+
+// AvatarIDCleared returns if the "avatar_id" field was cleared in this mutation.
+func (m *MessageMutation) AvatarIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldAvatarID]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *RoomMutation) ClearField(name string) error {
-	switch name {
-	case room.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	case room.FieldName:
-		m.ClearName()
-		return nil
-	case room.FieldDescription:
-		m.ClearDescription()
-		return nil
-	}
-	return fmt.Errorf("unknown Room nullable field %s", name)
+// ResetAvatarID resets all changes to the "avatar_id" field.
+func (m *MessageMutation) ResetAvatarID() {
+	m.avatar = nil
+	delete(m.clearedFields, message.FieldAvatarID)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *RoomMutation) ResetField(name string) error {
-	switch name {
-	case room.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	case room.FieldName:
-		m.ResetName()
-		return nil
-	case room.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case room.FieldVersion:
-		m.ResetVersion()
-		return nil
-	case room.FieldType:
-		m.ResetType()
-		return nil
-	case room.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case room.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Room field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RoomMutation) AddedEdges() []string {
-	edges := make([]string, 0, 8)
-	if m.user_contacts != nil {
-		edges = append(edges, room.EdgeUserContacts)
+// OldCreatedAt returns the old "created_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	if m.users != nil {
-		edges = append(edges, room.EdgeUsers)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	if m.last_message != nil {
-		edges = append(edges, room.EdgeLastMessage)
-	}
-	if m.messages != nil {
-		edges = append(edges, room.EdgeMessages)
-	}
-	if m.message_voices != nil {
-		edges = append(edges, room.EdgeMessageVoices)
-	}
-	if m.message_attachments != nil {
-		edges = append(edges, room.EdgeMessageAttachments)
-	}
-	if m.message_links != nil {
-		edges = append(edges, room.EdgeMessageLinks)
-	}
-	if m.room_members != nil {
-		edges = append(edges, room.EdgeRoomMembers)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return edges
+	return oldValue.CreatedAt, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *RoomMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case room.EdgeUserContacts:
-		ids := make([]ent.Value, 0, len(m.user_contacts))
-		for id := range m.user_contacts {
-			ids = append(ids, id)
-		}
-		return ids
-	case room.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
-			ids = append(ids, id)
-		}
-		return ids
-	case room.EdgeLastMessage:
-		if id := m.last_message; id != nil {
-			return []ent.Value{*id}
-		}
-	case room.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.messages))
-		for id := range m.messages {
-			ids = append(ids, id)
-		}
-		return ids
-	case room.EdgeMessageVoices:
-		ids := make([]ent.Value, 0, len(m.message_voices))
-		for id := range m.message_voices {
-			ids = append(ids, id)
-		}
-		return ids
-	case room.EdgeMessageAttachments:
-		ids := make([]ent.Value, 0, len(m.message_attachments))
-		for id := range m.message_attachments {
-			ids = append(ids, id)
-		}
-		return ids
-	case room.EdgeMessageLinks:
-		ids := make([]ent.Value, 0, len(m.message_links))
-		for id := range m.message_links {
-			ids = append(ids, id)
-		}
-		return ids
-	case room.EdgeRoomMembers:
-		ids := make([]ent.Value, 0, len(m.room_members))
-		for id := range m.room_members {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RoomMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 8)
-	if m.removeduser_contacts != nil {
-		edges = append(edges, room.EdgeUserContacts)
-	}
-	if m.removedusers != nil {
-		edges = append(edges, room.EdgeUsers)
-	}
-	if m.removedmessages != nil {
-		edges = append(edges, room.EdgeMessages)
-	}
-	if m.removedmessage_voices != nil {
-		edges = append(edges, room.EdgeMessageVoices)
-	}
-	if m.removedmessage_attachments != nil {
-		edges = append(edges, room.EdgeMessageAttachments)
-	}
-	if m.removedmessage_links != nil {
-		edges = append(edges, room.EdgeMessageLinks)
-	}
-	if m.removedroom_members != nil {
-		edges = append(edges, room.EdgeRoomMembers)
-	}
-	return edges
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MessageMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *RoomMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case room.EdgeUserContacts:
-		ids := make([]ent.Value, 0, len(m.removeduser_contacts))
-		for id := range m.removeduser_contacts {
-			ids = append(ids, id)
-		}
-		return ids
-	case room.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
-			ids = append(ids, id)
-		}
-		return ids
-	case room.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.removedmessages))
-		for id := range m.removedmessages {
-			ids = append(ids, id)
-		}
-		return ids
-	case room.EdgeMessageVoices:
-		ids := make([]ent.Value, 0, len(m.removedmessage_voices))
-		for id := range m.removedmessage_voices {
-			ids = append(ids, id)
-		}
-		return ids
-	case room.EdgeMessageAttachments:
-		ids := make([]ent.Value, 0, len(m.removedmessage_attachments))
-		for id := range m.removedmessage_attachments {
-			ids = append(ids, id)
-		}
-		return ids
-	case room.EdgeMessageLinks:
-		ids := make([]ent.Value, 0, len(m.removedmessage_links))
-		for id := range m.removedmessage_links {
-			ids = append(ids, id)
-		}
-		return ids
-	case room.EdgeRoomMembers:
-		ids := make([]ent.Value, 0, len(m.removedroom_members))
-		for id := range m.removedroom_members {
-			ids = append(ids, id)
-		}
-		return ids
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MessageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RoomMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 8)
-	if m.cleareduser_contacts {
-		edges = append(edges, room.EdgeUserContacts)
-	}
-	if m.clearedusers {
-		edges = append(edges, room.EdgeUsers)
-	}
-	if m.clearedlast_message {
-		edges = append(edges, room.EdgeLastMessage)
-	}
-	if m.clearedmessages {
-		edges = append(edges, room.EdgeMessages)
-	}
-	if m.clearedmessage_voices {
-		edges = append(edges, room.EdgeMessageVoices)
-	}
-	if m.clearedmessage_attachments {
-		edges = append(edges, room.EdgeMessageAttachments)
+// OldUpdatedAt returns the old "updated_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	if m.clearedmessage_links {
-		edges = append(edges, room.EdgeMessageLinks)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	if m.clearedroom_members {
-		edges = append(edges, room.EdgeRoomMembers)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return edges
+	return oldValue.UpdatedAt, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *RoomMutation) EdgeCleared(name string) bool {
-	switch name {
-	case room.EdgeUserContacts:
-		return m.cleareduser_contacts
-	case room.EdgeUsers:
-		return m.clearedusers
-	case room.EdgeLastMessage:
-		return m.clearedlast_message
-	case room.EdgeMessages:
-		return m.clearedmessages
-	case room.EdgeMessageVoices:
-		return m.clearedmessage_voices
-	case room.EdgeMessageAttachments:
-		return m.clearedmessage_attachments
-	case room.EdgeMessageLinks:
-		return m.clearedmessage_links
-	case room.EdgeRoomMembers:
-		return m.clearedroom_members
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MessageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetVoiceID sets the "voice" edge to the MessageVoice entity by id.
+func (m *MessageMutation) SetVoiceID(id pulid.ID) {
+	m.voice = &id
+}
+
+// ClearVoice clears the "voice" edge to the MessageVoice entity.
+func (m *MessageMutation) ClearVoice() {
+	m.clearedvoice = true
+}
+
+// VoiceCleared reports if the "voice" edge to the MessageVoice entity was cleared.
+func (m *MessageMutation) VoiceCleared() bool {
+	return m.clearedvoice
+}
+
+// VoiceID returns the "voice" edge ID in the mutation.
+func (m *MessageMutation) VoiceID() (id pulid.ID, exists bool) {
+	if m.voice != nil {
+		return *m.voice, true
 	}
-	return false
+	return
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *RoomMutation) ClearEdge(name string) error {
-	switch name {
-	case room.EdgeLastMessage:
-		m.ClearLastMessage()
-		return nil
+// VoiceIDs returns the "voice" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// VoiceID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) VoiceIDs() (ids []pulid.ID) {
+	if id := m.voice; id != nil {
+		ids = append(ids, *id)
 	}
-	return fmt.Errorf("unknown Room unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *RoomMutation) ResetEdge(name string) error {
-	switch name {
-	case room.EdgeUserContacts:
-		m.ResetUserContacts()
-		return nil
-	case room.EdgeUsers:
-		m.ResetUsers()
-		return nil
-	case room.EdgeLastMessage:
-		m.ResetLastMessage()
-		return nil
-	case room.EdgeMessages:
-		m.ResetMessages()
-		return nil
-	case room.EdgeMessageVoices:
-		m.ResetMessageVoices()
-		return nil
-	case room.EdgeMessageAttachments:
-		m.ResetMessageAttachments()
-		return nil
-	case room.EdgeMessageLinks:
-		m.ResetMessageLinks()
-		return nil
-	case room.EdgeRoomMembers:
-		m.ResetRoomMembers()
-		return nil
-	}
-	return fmt.Errorf("unknown Room edge %s", name)
+// ResetVoice resets all changes to the "voice" edge.
+func (m *MessageMutation) ResetVoice() {
+	m.voice = nil
+	m.clearedvoice = false
 }
 
-// RoomMemberMutation represents an operation that mutates the RoomMember nodes in the graph.
-type RoomMemberMutation struct {
-	config
-	op                       Op
-	typ                      string
-	id                       *pulid.ID
-	deleted_at               *time.Time
-	name                     *string
-	unread_messages_count    *int
-	addunread_messages_count *int
-	joined_at                *time.Time
-	updated_at               *time.Time
-	clearedFields            map[string]struct{}
-	user                     *pulid.ID
-	cleareduser              bool
-	room                     *pulid.ID
-	clearedroom              bool
-	done                     bool
-	oldValue                 func(context.Context) (*RoomMember, error)
-	predicates               []predicate.RoomMember
+// SetReplyToID sets the "reply_to" edge to the Message entity by id.
+func (m *MessageMutation) SetReplyToID(id pulid.ID) {
+	m.reply_to = &id
 }
 
-var _ ent.Mutation = (*RoomMemberMutation)(nil)
-
-// roommemberOption allows management of the mutation configuration using functional options.
-type roommemberOption func(*RoomMemberMutation)
+// ClearReplyTo clears the "reply_to" edge to the Message entity.
+func (m *MessageMutation) ClearReplyTo() {
+	m.clearedreply_to = true
+}
 
-// newRoomMemberMutation creates new mutation for the RoomMember entity.
-func newRoomMemberMutation(c config, op Op, opts ...roommemberOption) *RoomMemberMutation {
-	m := &RoomMemberMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeRoomMember,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// ReplyToCleared reports if the "reply_to" edge to the Message entity was cleared.
+func (m *MessageMutation) ReplyToCleared() bool {
+	return m.clearedreply_to
 }
 
-// withRoomMemberID sets the ID field of the mutation.
-func withRoomMemberID(id pulid.ID) roommemberOption {
-	return func(m *RoomMemberMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *RoomMember
-		)
-		m.oldValue = func(ctx context.Context) (*RoomMember, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().RoomMember.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// ReplyToID returns the "reply_to" edge ID in the mutation.
+func (m *MessageMutation) ReplyToID() (id pulid.ID, exists bool) {
+	if m.reply_to != nil {
+		return *m.reply_to, true
 	}
+	return
 }
 
-// withRoomMember sets the old RoomMember of the mutation.
-func withRoomMember(node *RoomMember) roommemberOption {
-	return func(m *RoomMemberMutation) {
-		m.oldValue = func(context.Context) (*RoomMember, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// ReplyToIDs returns the "reply_to" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReplyToID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ReplyToIDs() (ids []pulid.ID) {
+	if id := m.reply_to; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RoomMemberMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetReplyTo resets all changes to the "reply_to" edge.
+func (m *MessageMutation) ResetReplyTo() {
+	m.reply_to = nil
+	m.clearedreply_to = false
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m RoomMemberMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddReplyIDs adds the "replies" edge to the Message entity by ids.
+func (m *MessageMutation) AddReplyIDs(ids ...pulid.ID) {
+	if m.replies == nil {
+		m.replies = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.replies[ids[i]] = struct{}{}
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of RoomMember entities.
-func (m *RoomMemberMutation) SetID(id pulid.ID) {
-	m.id = &id
+// ClearReplies clears the "replies" edge to the Message entity.
+func (m *MessageMutation) ClearReplies() {
+	m.clearedreplies = true
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *RoomMemberMutation) ID() (id pulid.ID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// RepliesCleared reports if the "replies" edge to the Message entity was cleared.
+func (m *MessageMutation) RepliesCleared() bool {
+	return m.clearedreplies
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *RoomMemberMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []pulid.ID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RoomMember.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// RemoveReplyIDs removes the "replies" edge to the Message entity by IDs.
+func (m *MessageMutation) RemoveReplyIDs(ids ...pulid.ID) {
+	if m.removedreplies == nil {
+		m.removedreplies = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.replies, ids[i])
+		m.removedreplies[ids[i]] = struct{}{}
 	}
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *RoomMemberMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// RemovedReplies returns the removed IDs of the "replies" edge to the Message entity.
+func (m *MessageMutation) RemovedRepliesIDs() (ids []pulid.ID) {
+	for id := range m.removedreplies {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *RoomMemberMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
+// RepliesIDs returns the "replies" edge IDs in the mutation.
+func (m *MessageMutation) RepliesIDs() (ids []pulid.ID) {
+	for id := range m.replies {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the RoomMember entity.
-// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMemberMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+// ResetReplies resets all changes to the "replies" edge.
+func (m *MessageMutation) ResetReplies() {
+	m.replies = nil
+	m.clearedreplies = false
+	m.removedreplies = nil
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the MessageAttachment entity by ids.
+func (m *MessageMutation) AddAttachmentIDs(ids ...pulid.ID) {
+	if m.attachments == nil {
+		m.attachments = make(map[pulid.ID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	for i := range ids {
+		m.attachments[ids[i]] = struct{}{}
 	}
-	return oldValue.DeletedAt, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *RoomMemberMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[roommember.FieldDeletedAt] = struct{}{}
+// ClearAttachments clears the "attachments" edge to the MessageAttachment entity.
+func (m *MessageMutation) ClearAttachments() {
+	m.clearedattachments = true
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *RoomMemberMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[roommember.FieldDeletedAt]
-	return ok
+// AttachmentsCleared reports if the "attachments" edge to the MessageAttachment entity was cleared.
+func (m *MessageMutation) AttachmentsCleared() bool {
+	return m.clearedattachments
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *RoomMemberMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, roommember.FieldDeletedAt)
+// RemoveAttachmentIDs removes the "attachments" edge to the MessageAttachment entity by IDs.
+func (m *MessageMutation) RemoveAttachmentIDs(ids ...pulid.ID) {
+	if m.removedattachments == nil {
+		m.removedattachments = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.attachments, ids[i])
+		m.removedattachments[ids[i]] = struct{}{}
+	}
 }
 
-// SetName sets the "name" field.
-func (m *RoomMemberMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *RoomMemberMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
+// RemovedAttachments returns the removed IDs of the "attachments" edge to the MessageAttachment entity.
+func (m *MessageMutation) RemovedAttachmentsIDs() (ids []pulid.ID) {
+	for id := range m.removedattachments {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldName returns the old "name" field's value of the RoomMember entity.
-// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMemberMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
+func (m *MessageMutation) AttachmentsIDs() (ids []pulid.ID) {
+	for id := range m.attachments {
+		ids = append(ids, id)
 	}
-	return oldValue.Name, nil
+	return
 }
 
-// ClearName clears the value of the "name" field.
-func (m *RoomMemberMutation) ClearName() {
-	m.name = nil
-	m.clearedFields[roommember.FieldName] = struct{}{}
+// ResetAttachments resets all changes to the "attachments" edge.
+func (m *MessageMutation) ResetAttachments() {
+	m.attachments = nil
+	m.clearedattachments = false
+	m.removedattachments = nil
 }
 
-// NameCleared returns if the "name" field was cleared in this mutation.
-func (m *RoomMemberMutation) NameCleared() bool {
-	_, ok := m.clearedFields[roommember.FieldName]
-	return ok
+// AddLinkIDs adds the "links" edge to the MessageLink entity by ids.
+func (m *MessageMutation) AddLinkIDs(ids ...pulid.ID) {
+	if m.links == nil {
+		m.links = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.links[ids[i]] = struct{}{}
+	}
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *RoomMemberMutation) ResetName() {
-	m.name = nil
-	delete(m.clearedFields, roommember.FieldName)
+// ClearLinks clears the "links" edge to the MessageLink entity.
+func (m *MessageMutation) ClearLinks() {
+	m.clearedlinks = true
 }
 
-// SetUnreadMessagesCount sets the "unread_messages_count" field.
-func (m *RoomMemberMutation) SetUnreadMessagesCount(i int) {
-	m.unread_messages_count = &i
-	m.addunread_messages_count = nil
+// LinksCleared reports if the "links" edge to the MessageLink entity was cleared.
+func (m *MessageMutation) LinksCleared() bool {
+	return m.clearedlinks
 }
 
-// UnreadMessagesCount returns the value of the "unread_messages_count" field in the mutation.
-func (m *RoomMemberMutation) UnreadMessagesCount() (r int, exists bool) {
-	v := m.unread_messages_count
-	if v == nil {
-		return
+// RemoveLinkIDs removes the "links" edge to the MessageLink entity by IDs.
+func (m *MessageMutation) RemoveLinkIDs(ids ...pulid.ID) {
+	if m.removedlinks == nil {
+		m.removedlinks = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.links, ids[i])
+		m.removedlinks[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldUnreadMessagesCount returns the old "unread_messages_count" field's value of the RoomMember entity.
-// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMemberMutation) OldUnreadMessagesCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUnreadMessagesCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUnreadMessagesCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUnreadMessagesCount: %w", err)
+// RemovedLinks returns the removed IDs of the "links" edge to the MessageLink entity.
+func (m *MessageMutation) RemovedLinksIDs() (ids []pulid.ID) {
+	for id := range m.removedlinks {
+		ids = append(ids, id)
 	}
-	return oldValue.UnreadMessagesCount, nil
+	return
 }
 
-// AddUnreadMessagesCount adds i to the "unread_messages_count" field.
-func (m *RoomMemberMutation) AddUnreadMessagesCount(i int) {
-	if m.addunread_messages_count != nil {
-		*m.addunread_messages_count += i
-	} else {
-		m.addunread_messages_count = &i
+// LinksIDs returns the "links" edge IDs in the mutation.
+func (m *MessageMutation) LinksIDs() (ids []pulid.ID) {
+	for id := range m.links {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedUnreadMessagesCount returns the value that was added to the "unread_messages_count" field in this mutation.
-func (m *RoomMemberMutation) AddedUnreadMessagesCount() (r int, exists bool) {
-	v := m.addunread_messages_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetLinks resets all changes to the "links" edge.
+func (m *MessageMutation) ResetLinks() {
+	m.links = nil
+	m.clearedlinks = false
+	m.removedlinks = nil
 }
 
-// ResetUnreadMessagesCount resets all changes to the "unread_messages_count" field.
-func (m *RoomMemberMutation) ResetUnreadMessagesCount() {
-	m.unread_messages_count = nil
-	m.addunread_messages_count = nil
+// ClearCall clears the "call" edge to the Call entity.
+func (m *MessageMutation) ClearCall() {
+	m.clearedcall = true
+	m.clearedFields[message.FieldCallID] = struct{}{}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *RoomMemberMutation) SetUserID(pu pulid.ID) {
-	m.user = &pu
+// CallCleared reports if the "call" edge to the Call entity was cleared.
+func (m *MessageMutation) CallCleared() bool {
+	return m.CallIDCleared() || m.clearedcall
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *RoomMemberMutation) UserID() (r pulid.ID, exists bool) {
-	v := m.user
-	if v == nil {
-		return
+// CallIDs returns the "call" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CallID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) CallIDs() (ids []pulid.ID) {
+	if id := m.call; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// OldUserID returns the old "user_id" field's value of the RoomMember entity.
-// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMemberMutation) OldUserID(ctx context.Context) (v pulid.ID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
-	}
-	return oldValue.UserID, nil
+// ResetCall resets all changes to the "call" edge.
+func (m *MessageMutation) ResetCall() {
+	m.call = nil
+	m.clearedcall = false
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *RoomMemberMutation) ResetUserID() {
-	m.user = nil
+// ClearAvatar clears the "avatar" edge to the Avatar entity.
+func (m *MessageMutation) ClearAvatar() {
+	m.clearedavatar = true
+	m.clearedFields[message.FieldAvatarID] = struct{}{}
 }
 
-// SetRoomID sets the "room_id" field.
-func (m *RoomMemberMutation) SetRoomID(pu pulid.ID) {
-	m.room = &pu
+// AvatarCleared reports if the "avatar" edge to the Avatar entity was cleared.
+func (m *MessageMutation) AvatarCleared() bool {
+	return m.AvatarIDCleared() || m.clearedavatar
 }
 
-// RoomID returns the value of the "room_id" field in the mutation.
-func (m *RoomMemberMutation) RoomID() (r pulid.ID, exists bool) {
-	v := m.room
-	if v == nil {
-		return
+// AvatarIDs returns the "avatar" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AvatarID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) AvatarIDs() (ids []pulid.ID) {
+	if id := m.avatar; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// OldRoomID returns the old "room_id" field's value of the RoomMember entity.
-// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMemberMutation) OldRoomID(ctx context.Context) (v pulid.ID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRoomID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
-	}
-	return oldValue.RoomID, nil
+// ResetAvatar resets all changes to the "avatar" edge.
+func (m *MessageMutation) ResetAvatar() {
+	m.avatar = nil
+	m.clearedavatar = false
 }
 
-// ResetRoomID resets all changes to the "room_id" field.
-func (m *RoomMemberMutation) ResetRoomID() {
-	m.room = nil
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *MessageMutation) SetUserID(id pulid.ID) {
+	m.user = &id
 }
 
-// SetJoinedAt sets the "joined_at" field.
-func (m *RoomMemberMutation) SetJoinedAt(t time.Time) {
-	m.joined_at = &t
+// ClearUser clears the "user" edge to the User entity.
+func (m *MessageMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// JoinedAt returns the value of the "joined_at" field in the mutation.
-func (m *RoomMemberMutation) JoinedAt() (r time.Time, exists bool) {
-	v := m.joined_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *MessageMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// OldJoinedAt returns the old "joined_at" field's value of the RoomMember entity.
-// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMemberMutation) OldJoinedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
+// UserID returns the "user" edge ID in the mutation.
+func (m *MessageMutation) UserID() (id pulid.ID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
-	return oldValue.JoinedAt, nil
+	return
 }
 
-// ResetJoinedAt resets all changes to the "joined_at" field.
-func (m *RoomMemberMutation) ResetJoinedAt() {
-	m.joined_at = nil
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) UserIDs() (ids []pulid.ID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *RoomMemberMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ResetUser resets all changes to the "user" edge.
+func (m *MessageMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RoomMemberMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the RoomMember entity.
-// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMemberMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RoomMemberMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (m *RoomMemberMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[roommember.FieldUserID] = struct{}{}
-}
-
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *RoomMemberMutation) UserCleared() bool {
-	return m.cleareduser
-}
-
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *RoomMemberMutation) UserIDs() (ids []pulid.ID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetUser resets all changes to the "user" edge.
-func (m *RoomMemberMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// SetRoomID sets the "room" edge to the Room entity by id.
+func (m *MessageMutation) SetRoomID(id pulid.ID) {
+	m.room = &id
 }
 
 // ClearRoom clears the "room" edge to the Room entity.
-func (m *RoomMemberMutation) ClearRoom() {
+func (m *MessageMutation) ClearRoom() {
 	m.clearedroom = true
-	m.clearedFields[roommember.FieldRoomID] = struct{}{}
 }
 
 // RoomCleared reports if the "room" edge to the Room entity was cleared.
-func (m *RoomMemberMutation) RoomCleared() bool {
+func (m *MessageMutation) RoomCleared() bool {
 	return m.clearedroom
 }
 
+// RoomID returns the "room" edge ID in the mutation.
+func (m *MessageMutation) RoomID() (id pulid.ID, exists bool) {
+	if m.room != nil {
+		return *m.room, true
+	}
+	return
+}
+
 // RoomIDs returns the "room" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // RoomID instead. It exists only for internal usage by the builders.
-func (m *RoomMemberMutation) RoomIDs() (ids []pulid.ID) {
+func (m *MessageMutation) RoomIDs() (ids []pulid.ID) {
 	if id := m.room; id != nil {
 		ids = append(ids, *id)
 	}
@@ -7028,20 +7113,20 @@ func (m *RoomMemberMutation) RoomIDs() (ids []pulid.ID) {
 }
 
 // ResetRoom resets all changes to the "room" edge.
-func (m *RoomMemberMutation) ResetRoom() {
+func (m *MessageMutation) ResetRoom() {
 	m.room = nil
 	m.clearedroom = false
 }
 
-// Where appends a list predicates to the RoomMemberMutation builder.
-func (m *RoomMemberMutation) Where(ps ...predicate.RoomMember) {
+// Where appends a list predicates to the MessageMutation builder.
+func (m *MessageMutation) Where(ps ...predicate.Message) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RoomMemberMutation builder. Using this method,
+// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RoomMemberMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RoomMember, len(ps))
+func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Message, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7049,45 +7134,42 @@ func (m *RoomMemberMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RoomMemberMutation) Op() Op {
+func (m *MessageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RoomMemberMutation) SetOp(op Op) {
+func (m *MessageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (RoomMember).
-func (m *RoomMemberMutation) Type() string {
+// Type returns the node type of this mutation (Message).
+func (m *MessageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RoomMemberMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.deleted_at != nil {
-		fields = append(fields, roommember.FieldDeletedAt)
-	}
-	if m.name != nil {
-		fields = append(fields, roommember.FieldName)
+func (m *MessageMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m._type != nil {
+		fields = append(fields, message.FieldType)
 	}
-	if m.unread_messages_count != nil {
-		fields = append(fields, roommember.FieldUnreadMessagesCount)
+	if m.content != nil {
+		fields = append(fields, message.FieldContent)
 	}
-	if m.user != nil {
-		fields = append(fields, roommember.FieldUserID)
+	if m.call != nil {
+		fields = append(fields, message.FieldCallID)
 	}
-	if m.room != nil {
-		fields = append(fields, roommember.FieldRoomID)
+	if m.avatar != nil {
+		fields = append(fields, message.FieldAvatarID)
 	}
-	if m.joined_at != nil {
-		fields = append(fields, roommember.FieldJoinedAt)
+	if m.created_at != nil {
+		fields = append(fields, message.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, roommember.FieldUpdatedAt)
+		fields = append(fields, message.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -7095,21 +7177,19 @@ func (m *RoomMemberMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RoomMemberMutation) Field(name string) (ent.Value, bool) {
+func (m *MessageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case roommember.FieldDeletedAt:
-		return m.DeletedAt()
-	case roommember.FieldName:
-		return m.Name()
-	case roommember.FieldUnreadMessagesCount:
-		return m.UnreadMessagesCount()
-	case roommember.FieldUserID:
-		return m.UserID()
-	case roommember.FieldRoomID:
-		return m.RoomID()
-	case roommember.FieldJoinedAt:
-		return m.JoinedAt()
-	case roommember.FieldUpdatedAt:
+	case message.FieldType:
+		return m.GetType()
+	case message.FieldContent:
+		return m.Content()
+	case message.FieldCallID:
+		return m.CallID()
+	case message.FieldAvatarID:
+		return m.AvatarID()
+	case message.FieldCreatedAt:
+		return m.CreatedAt()
+	case message.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -7118,74 +7198,65 @@ func (m *RoomMemberMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RoomMemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case roommember.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case roommember.FieldName:
-		return m.OldName(ctx)
-	case roommember.FieldUnreadMessagesCount:
-		return m.OldUnreadMessagesCount(ctx)
-	case roommember.FieldUserID:
-		return m.OldUserID(ctx)
-	case roommember.FieldRoomID:
-		return m.OldRoomID(ctx)
-	case roommember.FieldJoinedAt:
-		return m.OldJoinedAt(ctx)
-	case roommember.FieldUpdatedAt:
+	case message.FieldType:
+		return m.OldType(ctx)
+	case message.FieldContent:
+		return m.OldContent(ctx)
+	case message.FieldCallID:
+		return m.OldCallID(ctx)
+	case message.FieldAvatarID:
+		return m.OldAvatarID(ctx)
+	case message.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case message.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown RoomMember field %s", name)
+	return nil, fmt.Errorf("unknown Message field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoomMemberMutation) SetField(name string, value ent.Value) error {
+func (m *MessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case roommember.FieldDeletedAt:
-		v, ok := value.(time.Time)
+	case message.FieldType:
+		v, ok := value.(message.Type)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetType(v)
 		return nil
-	case roommember.FieldName:
+	case message.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
-		return nil
-	case roommember.FieldUnreadMessagesCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUnreadMessagesCount(v)
+		m.SetContent(v)
 		return nil
-	case roommember.FieldUserID:
+	case message.FieldCallID:
 		v, ok := value.(pulid.ID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetCallID(v)
 		return nil
-	case roommember.FieldRoomID:
+	case message.FieldAvatarID:
 		v, ok := value.(pulid.ID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRoomID(v)
+		m.SetAvatarID(v)
 		return nil
-	case roommember.FieldJoinedAt:
+	case message.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetJoinedAt(v)
+		m.SetCreatedAt(v)
 		return nil
-	case roommember.FieldUpdatedAt:
+	case message.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -7193,130 +7264,173 @@ func (m *RoomMemberMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown RoomMember field %s", name)
+	return fmt.Errorf("unknown Message field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RoomMemberMutation) AddedFields() []string {
-	var fields []string
-	if m.addunread_messages_count != nil {
-		fields = append(fields, roommember.FieldUnreadMessagesCount)
-	}
-	return fields
+func (m *MessageMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RoomMemberMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case roommember.FieldUnreadMessagesCount:
-		return m.AddedUnreadMessagesCount()
-	}
+func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoomMemberMutation) AddField(name string, value ent.Value) error {
+func (m *MessageMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case roommember.FieldUnreadMessagesCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUnreadMessagesCount(v)
-		return nil
 	}
-	return fmt.Errorf("unknown RoomMember numeric field %s", name)
+	return fmt.Errorf("unknown Message numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RoomMemberMutation) ClearedFields() []string {
+func (m *MessageMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(roommember.FieldDeletedAt) {
-		fields = append(fields, roommember.FieldDeletedAt)
+	if m.FieldCleared(message.FieldContent) {
+		fields = append(fields, message.FieldContent)
 	}
-	if m.FieldCleared(roommember.FieldName) {
-		fields = append(fields, roommember.FieldName)
+	if m.FieldCleared(message.FieldCallID) {
+		fields = append(fields, message.FieldCallID)
+	}
+	if m.FieldCleared(message.FieldAvatarID) {
+		fields = append(fields, message.FieldAvatarID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RoomMemberMutation) FieldCleared(name string) bool {
+func (m *MessageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RoomMemberMutation) ClearField(name string) error {
+func (m *MessageMutation) ClearField(name string) error {
 	switch name {
-	case roommember.FieldDeletedAt:
-		m.ClearDeletedAt()
+	case message.FieldContent:
+		m.ClearContent()
 		return nil
-	case roommember.FieldName:
-		m.ClearName()
+	case message.FieldCallID:
+		m.ClearCallID()
+		return nil
+	case message.FieldAvatarID:
+		m.ClearAvatarID()
 		return nil
 	}
-	return fmt.Errorf("unknown RoomMember nullable field %s", name)
+	return fmt.Errorf("unknown Message nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RoomMemberMutation) ResetField(name string) error {
+func (m *MessageMutation) ResetField(name string) error {
 	switch name {
-	case roommember.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	case roommember.FieldName:
-		m.ResetName()
+	case message.FieldType:
+		m.ResetType()
 		return nil
-	case roommember.FieldUnreadMessagesCount:
-		m.ResetUnreadMessagesCount()
+	case message.FieldContent:
+		m.ResetContent()
 		return nil
-	case roommember.FieldUserID:
-		m.ResetUserID()
+	case message.FieldCallID:
+		m.ResetCallID()
 		return nil
-	case roommember.FieldRoomID:
-		m.ResetRoomID()
+	case message.FieldAvatarID:
+		m.ResetAvatarID()
 		return nil
-	case roommember.FieldJoinedAt:
-		m.ResetJoinedAt()
+	case message.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case roommember.FieldUpdatedAt:
+	case message.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown RoomMember field %s", name)
+	return fmt.Errorf("unknown Message field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RoomMemberMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, roommember.EdgeUser)
-	}
+func (m *MessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.voice != nil {
+		edges = append(edges, message.EdgeVoice)
+	}
+	if m.reply_to != nil {
+		edges = append(edges, message.EdgeReplyTo)
+	}
+	if m.replies != nil {
+		edges = append(edges, message.EdgeReplies)
+	}
+	if m.attachments != nil {
+		edges = append(edges, message.EdgeAttachments)
+	}
+	if m.links != nil {
+		edges = append(edges, message.EdgeLinks)
+	}
+	if m.call != nil {
+		edges = append(edges, message.EdgeCall)
+	}
+	if m.avatar != nil {
+		edges = append(edges, message.EdgeAvatar)
+	}
+	if m.user != nil {
+		edges = append(edges, message.EdgeUser)
+	}
 	if m.room != nil {
-		edges = append(edges, roommember.EdgeRoom)
+		edges = append(edges, message.EdgeRoom)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RoomMemberMutation) AddedIDs(name string) []ent.Value {
+func (m *MessageMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case roommember.EdgeUser:
+	case message.EdgeVoice:
+		if id := m.voice; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeReplyTo:
+		if id := m.reply_to; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.replies))
+		for id := range m.replies {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.attachments))
+		for id := range m.attachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeLinks:
+		ids := make([]ent.Value, 0, len(m.links))
+		for id := range m.links {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeCall:
+		if id := m.call; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeAvatar:
+		if id := m.avatar; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case roommember.EdgeRoom:
+	case message.EdgeRoom:
 		if id := m.room; id != nil {
 			return []ent.Value{*id}
 		}
@@ -7325,36 +7439,100 @@ func (m *RoomMemberMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RoomMemberMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *MessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.removedreplies != nil {
+		edges = append(edges, message.EdgeReplies)
+	}
+	if m.removedattachments != nil {
+		edges = append(edges, message.EdgeAttachments)
+	}
+	if m.removedlinks != nil {
+		edges = append(edges, message.EdgeLinks)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RoomMemberMutation) RemovedIDs(name string) []ent.Value {
+func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.removedreplies))
+		for id := range m.removedreplies {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.removedattachments))
+		for id := range m.removedattachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeLinks:
+		ids := make([]ent.Value, 0, len(m.removedlinks))
+		for id := range m.removedlinks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RoomMemberMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *MessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.clearedvoice {
+		edges = append(edges, message.EdgeVoice)
+	}
+	if m.clearedreply_to {
+		edges = append(edges, message.EdgeReplyTo)
+	}
+	if m.clearedreplies {
+		edges = append(edges, message.EdgeReplies)
+	}
+	if m.clearedattachments {
+		edges = append(edges, message.EdgeAttachments)
+	}
+	if m.clearedlinks {
+		edges = append(edges, message.EdgeLinks)
+	}
+	if m.clearedcall {
+		edges = append(edges, message.EdgeCall)
+	}
+	if m.clearedavatar {
+		edges = append(edges, message.EdgeAvatar)
+	}
 	if m.cleareduser {
-		edges = append(edges, roommember.EdgeUser)
+		edges = append(edges, message.EdgeUser)
 	}
 	if m.clearedroom {
-		edges = append(edges, roommember.EdgeRoom)
+		edges = append(edges, message.EdgeRoom)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RoomMemberMutation) EdgeCleared(name string) bool {
+func (m *MessageMutation) EdgeCleared(name string) bool {
 	switch name {
-	case roommember.EdgeUser:
+	case message.EdgeVoice:
+		return m.clearedvoice
+	case message.EdgeReplyTo:
+		return m.clearedreply_to
+	case message.EdgeReplies:
+		return m.clearedreplies
+	case message.EdgeAttachments:
+		return m.clearedattachments
+	case message.EdgeLinks:
+		return m.clearedlinks
+	case message.EdgeCall:
+		return m.clearedcall
+	case message.EdgeAvatar:
+		return m.clearedavatar
+	case message.EdgeUser:
 		return m.cleareduser
-	case roommember.EdgeRoom:
+	case message.EdgeRoom:
 		return m.clearedroom
 	}
 	return false
@@ -7362,83 +7540,98 @@ func (m *RoomMemberMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RoomMemberMutation) ClearEdge(name string) error {
+func (m *MessageMutation) ClearEdge(name string) error {
 	switch name {
-	case roommember.EdgeUser:
+	case message.EdgeVoice:
+		m.ClearVoice()
+		return nil
+	case message.EdgeReplyTo:
+		m.ClearReplyTo()
+		return nil
+	case message.EdgeCall:
+		m.ClearCall()
+		return nil
+	case message.EdgeAvatar:
+		m.ClearAvatar()
+		return nil
+	case message.EdgeUser:
 		m.ClearUser()
 		return nil
-	case roommember.EdgeRoom:
+	case message.EdgeRoom:
 		m.ClearRoom()
 		return nil
 	}
-	return fmt.Errorf("unknown RoomMember unique edge %s", name)
+	return fmt.Errorf("unknown Message unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RoomMemberMutation) ResetEdge(name string) error {
+func (m *MessageMutation) ResetEdge(name string) error {
 	switch name {
-	case roommember.EdgeUser:
+	case message.EdgeVoice:
+		m.ResetVoice()
+		return nil
+	case message.EdgeReplyTo:
+		m.ResetReplyTo()
+		return nil
+	case message.EdgeReplies:
+		m.ResetReplies()
+		return nil
+	case message.EdgeAttachments:
+		m.ResetAttachments()
+		return nil
+	case message.EdgeLinks:
+		m.ResetLinks()
+		return nil
+	case message.EdgeCall:
+		m.ResetCall()
+		return nil
+	case message.EdgeAvatar:
+		m.ResetAvatar()
+		return nil
+	case message.EdgeUser:
 		m.ResetUser()
 		return nil
-	case roommember.EdgeRoom:
+	case message.EdgeRoom:
 		m.ResetRoom()
 		return nil
 	}
-	return fmt.Errorf("unknown RoomMember edge %s", name)
+	return fmt.Errorf("unknown Message edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// MessageAttachmentMutation represents an operation that mutates the MessageAttachment nodes in the graph.
+type MessageAttachmentMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *pulid.ID
-	first_name           *string
-	last_name            *string
-	nickname             *string
-	email                *string
-	contact_pin          *string
-	password             *string
-	created_at           *time.Time
-	updated_at           *time.Time
-	clearedFields        map[string]struct{}
-	device               *pulid.ID
-	cleareddevice        bool
-	notifications        map[pulid.ID]struct{}
-	removednotifications map[pulid.ID]struct{}
-	clearednotifications bool
-	contacts             map[pulid.ID]struct{}
-	removedcontacts      map[pulid.ID]struct{}
-	clearedcontacts      bool
-	rooms                map[pulid.ID]struct{}
-	removedrooms         map[pulid.ID]struct{}
-	clearedrooms         bool
-	messages             map[pulid.ID]struct{}
-	removedmessages      map[pulid.ID]struct{}
-	clearedmessages      bool
-	user_contacts        map[pulid.ID]struct{}
-	removeduser_contacts map[pulid.ID]struct{}
-	cleareduser_contacts bool
-	memberships          map[pulid.ID]struct{}
-	removedmemberships   map[pulid.ID]struct{}
-	clearedmemberships   bool
-	done                 bool
-	oldValue             func(context.Context) (*User, error)
-	predicates           []predicate.User
+	op             Op
+	typ            string
+	id             *pulid.ID
+	_type          *messageattachment.Type
+	_order         *uint
+	add_order      *int
+	attached_at    *time.Time
+	clearedFields  map[string]struct{}
+	room           *pulid.ID
+	clearedroom    bool
+	message        *pulid.ID
+	clearedmessage bool
+	file           *pulid.ID
+	clearedfile    bool
+	done           bool
+	oldValue       func(context.Context) (*MessageAttachment, error)
+	predicates     []predicate.MessageAttachment
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*MessageAttachmentMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// messageattachmentOption allows management of the mutation configuration using functional options.
+type messageattachmentOption func(*MessageAttachmentMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newMessageAttachmentMutation creates new mutation for the MessageAttachment entity.
+func newMessageAttachmentMutation(c config, op Op, opts ...messageattachmentOption) *MessageAttachmentMutation {
+	m := &MessageAttachmentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeMessageAttachment,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7447,20 +7640,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id pulid.ID) userOption {
-	return func(m *UserMutation) {
+// withMessageAttachmentID sets the ID field of the mutation.
+func withMessageAttachmentID(id pulid.ID) messageattachmentOption {
+	return func(m *MessageAttachmentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *MessageAttachment
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*MessageAttachment, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().MessageAttachment.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7469,10 +7662,10 @@ func withUserID(id pulid.ID) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withMessageAttachment sets the old MessageAttachment of the mutation.
+func withMessageAttachment(node *MessageAttachment) messageattachmentOption {
+	return func(m *MessageAttachmentMutation) {
+		m.oldValue = func(context.Context) (*MessageAttachment, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7481,7 +7674,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m MessageAttachmentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7489,7 +7682,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m MessageAttachmentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7499,14 +7692,14 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id pulid.ID) {
+// operation is only accepted on creation of MessageAttachment entities.
+func (m *MessageAttachmentMutation) SetID(id pulid.ID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id pulid.ID, exists bool) {
+func (m *MessageAttachmentMutation) ID() (id pulid.ID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7517,7 +7710,7 @@ func (m *UserMutation) ID() (id pulid.ID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
+func (m *MessageAttachmentMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7526,698 +7719,11139 @@ func (m *UserMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().MessageAttachment.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetFirstName sets the "first_name" field.
-func (m *UserMutation) SetFirstName(s string) {
-	m.first_name = &s
+// SetType sets the "type" field.
+func (m *MessageAttachmentMutation) SetType(value messageattachment.Type) {
+	m._type = &value
 }
 
-// FirstName returns the value of the "first_name" field in the mutation.
-func (m *UserMutation) FirstName() (r string, exists bool) {
-	v := m.first_name
+// GetType returns the value of the "type" field in the mutation.
+func (m *MessageAttachmentMutation) GetType() (r messageattachment.Type, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFirstName returns the old "first_name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the MessageAttachment entity.
+// If the MessageAttachment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldFirstName(ctx context.Context) (v string, err error) {
+func (m *MessageAttachmentMutation) OldType(ctx context.Context) (v messageattachment.Type, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFirstName is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFirstName requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFirstName: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.FirstName, nil
+	return oldValue.Type, nil
 }
 
-// ResetFirstName resets all changes to the "first_name" field.
-func (m *UserMutation) ResetFirstName() {
-	m.first_name = nil
+// ResetType resets all changes to the "type" field.
+func (m *MessageAttachmentMutation) ResetType() {
+	m._type = nil
 }
 
-// SetLastName sets the "last_name" field.
-func (m *UserMutation) SetLastName(s string) {
-	m.last_name = &s
+// SetOrder sets the "order" field.
+func (m *MessageAttachmentMutation) SetOrder(u uint) {
+	m._order = &u
+	m.add_order = nil
 }
 
-// LastName returns the value of the "last_name" field in the mutation.
-func (m *UserMutation) LastName() (r string, exists bool) {
-	v := m.last_name
+// Order returns the value of the "order" field in the mutation.
+func (m *MessageAttachmentMutation) Order() (r uint, exists bool) {
+	v := m._order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrder returns the old "order" field's value of the MessageAttachment entity.
+// If the MessageAttachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageAttachmentMutation) OldOrder(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrder: %w", err)
+	}
+	return oldValue.Order, nil
+}
+
+// AddOrder adds u to the "order" field.
+func (m *MessageAttachmentMutation) AddOrder(u int) {
+	if m.add_order != nil {
+		*m.add_order += u
+	} else {
+		m.add_order = &u
+	}
+}
+
+// AddedOrder returns the value that was added to the "order" field in this mutation.
+func (m *MessageAttachmentMutation) AddedOrder() (r int, exists bool) {
+	v := m.add_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOrder resets all changes to the "order" field.
+func (m *MessageAttachmentMutation) ResetOrder() {
+	m._order = nil
+	m.add_order = nil
+}
+
+// SetAttachedAt sets the "attached_at" field.
+func (m *MessageAttachmentMutation) SetAttachedAt(t time.Time) {
+	m.attached_at = &t
+}
+
+// AttachedAt returns the value of the "attached_at" field in the mutation.
+func (m *MessageAttachmentMutation) AttachedAt() (r time.Time, exists bool) {
+	v := m.attached_at
 	if v == nil {
 		return
 	}
-	return *v, true
+	return *v, true
+}
+
+// OldAttachedAt returns the old "attached_at" field's value of the MessageAttachment entity.
+// If the MessageAttachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageAttachmentMutation) OldAttachedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttachedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttachedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttachedAt: %w", err)
+	}
+	return oldValue.AttachedAt, nil
+}
+
+// ResetAttachedAt resets all changes to the "attached_at" field.
+func (m *MessageAttachmentMutation) ResetAttachedAt() {
+	m.attached_at = nil
+}
+
+// SetRoomID sets the "room" edge to the Room entity by id.
+func (m *MessageAttachmentMutation) SetRoomID(id pulid.ID) {
+	m.room = &id
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (m *MessageAttachmentMutation) ClearRoom() {
+	m.clearedroom = true
+}
+
+// RoomCleared reports if the "room" edge to the Room entity was cleared.
+func (m *MessageAttachmentMutation) RoomCleared() bool {
+	return m.clearedroom
+}
+
+// RoomID returns the "room" edge ID in the mutation.
+func (m *MessageAttachmentMutation) RoomID() (id pulid.ID, exists bool) {
+	if m.room != nil {
+		return *m.room, true
+	}
+	return
+}
+
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *MessageAttachmentMutation) RoomIDs() (ids []pulid.ID) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoom resets all changes to the "room" edge.
+func (m *MessageAttachmentMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
+}
+
+// SetMessageID sets the "message" edge to the Message entity by id.
+func (m *MessageAttachmentMutation) SetMessageID(id pulid.ID) {
+	m.message = &id
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageAttachmentMutation) ClearMessage() {
+	m.clearedmessage = true
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageAttachmentMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageID returns the "message" edge ID in the mutation.
+func (m *MessageAttachmentMutation) MessageID() (id pulid.ID, exists bool) {
+	if m.message != nil {
+		return *m.message, true
+	}
+	return
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageAttachmentMutation) MessageIDs() (ids []pulid.ID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageAttachmentMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// SetFileID sets the "file" edge to the File entity by id.
+func (m *MessageAttachmentMutation) SetFileID(id pulid.ID) {
+	m.file = &id
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (m *MessageAttachmentMutation) ClearFile() {
+	m.clearedfile = true
+}
+
+// FileCleared reports if the "file" edge to the File entity was cleared.
+func (m *MessageAttachmentMutation) FileCleared() bool {
+	return m.clearedfile
+}
+
+// FileID returns the "file" edge ID in the mutation.
+func (m *MessageAttachmentMutation) FileID() (id pulid.ID, exists bool) {
+	if m.file != nil {
+		return *m.file, true
+	}
+	return
+}
+
+// FileIDs returns the "file" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *MessageAttachmentMutation) FileIDs() (ids []pulid.ID) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFile resets all changes to the "file" edge.
+func (m *MessageAttachmentMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
+}
+
+// Where appends a list predicates to the MessageAttachmentMutation builder.
+func (m *MessageAttachmentMutation) Where(ps ...predicate.MessageAttachment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageAttachmentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageAttachmentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageAttachment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageAttachmentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageAttachmentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageAttachment).
+func (m *MessageAttachmentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageAttachmentMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m._type != nil {
+		fields = append(fields, messageattachment.FieldType)
+	}
+	if m._order != nil {
+		fields = append(fields, messageattachment.FieldOrder)
+	}
+	if m.attached_at != nil {
+		fields = append(fields, messageattachment.FieldAttachedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageAttachmentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messageattachment.FieldType:
+		return m.GetType()
+	case messageattachment.FieldOrder:
+		return m.Order()
+	case messageattachment.FieldAttachedAt:
+		return m.AttachedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageAttachmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messageattachment.FieldType:
+		return m.OldType(ctx)
+	case messageattachment.FieldOrder:
+		return m.OldOrder(ctx)
+	case messageattachment.FieldAttachedAt:
+		return m.OldAttachedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageAttachment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageAttachmentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messageattachment.FieldType:
+		v, ok := value.(messageattachment.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case messageattachment.FieldOrder:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrder(v)
+		return nil
+	case messageattachment.FieldAttachedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttachedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageAttachment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageAttachmentMutation) AddedFields() []string {
+	var fields []string
+	if m.add_order != nil {
+		fields = append(fields, messageattachment.FieldOrder)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageAttachmentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case messageattachment.FieldOrder:
+		return m.AddedOrder()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageAttachmentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case messageattachment.FieldOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOrder(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageAttachment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageAttachmentMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageAttachmentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageAttachmentMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageAttachment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageAttachmentMutation) ResetField(name string) error {
+	switch name {
+	case messageattachment.FieldType:
+		m.ResetType()
+		return nil
+	case messageattachment.FieldOrder:
+		m.ResetOrder()
+		return nil
+	case messageattachment.FieldAttachedAt:
+		m.ResetAttachedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageAttachment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageAttachmentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.room != nil {
+		edges = append(edges, messageattachment.EdgeRoom)
+	}
+	if m.message != nil {
+		edges = append(edges, messageattachment.EdgeMessage)
+	}
+	if m.file != nil {
+		edges = append(edges, messageattachment.EdgeFile)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageAttachmentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messageattachment.EdgeRoom:
+		if id := m.room; id != nil {
+			return []ent.Value{*id}
+		}
+	case messageattachment.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	case messageattachment.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageAttachmentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageAttachmentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageAttachmentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedroom {
+		edges = append(edges, messageattachment.EdgeRoom)
+	}
+	if m.clearedmessage {
+		edges = append(edges, messageattachment.EdgeMessage)
+	}
+	if m.clearedfile {
+		edges = append(edges, messageattachment.EdgeFile)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageAttachmentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messageattachment.EdgeRoom:
+		return m.clearedroom
+	case messageattachment.EdgeMessage:
+		return m.clearedmessage
+	case messageattachment.EdgeFile:
+		return m.clearedfile
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageAttachmentMutation) ClearEdge(name string) error {
+	switch name {
+	case messageattachment.EdgeRoom:
+		m.ClearRoom()
+		return nil
+	case messageattachment.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	case messageattachment.EdgeFile:
+		m.ClearFile()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageAttachment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageAttachmentMutation) ResetEdge(name string) error {
+	switch name {
+	case messageattachment.EdgeRoom:
+		m.ResetRoom()
+		return nil
+	case messageattachment.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	case messageattachment.EdgeFile:
+		m.ResetFile()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageAttachment edge %s", name)
+}
+
+// MessageLinkMutation represents an operation that mutates the MessageLink nodes in the graph.
+type MessageLinkMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *pulid.ID
+	link           *string
+	title          *string
+	description    *string
+	image_url      *string
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	room           *pulid.ID
+	clearedroom    bool
+	message        *pulid.ID
+	clearedmessage bool
+	done           bool
+	oldValue       func(context.Context) (*MessageLink, error)
+	predicates     []predicate.MessageLink
+}
+
+var _ ent.Mutation = (*MessageLinkMutation)(nil)
+
+// messagelinkOption allows management of the mutation configuration using functional options.
+type messagelinkOption func(*MessageLinkMutation)
+
+// newMessageLinkMutation creates new mutation for the MessageLink entity.
+func newMessageLinkMutation(c config, op Op, opts ...messagelinkOption) *MessageLinkMutation {
+	m := &MessageLinkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageLink,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageLinkID sets the ID field of the mutation.
+func withMessageLinkID(id pulid.ID) messagelinkOption {
+	return func(m *MessageLinkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageLink
+		)
+		m.oldValue = func(ctx context.Context) (*MessageLink, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageLink.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageLink sets the old MessageLink of the mutation.
+func withMessageLink(node *MessageLink) messagelinkOption {
+	return func(m *MessageLinkMutation) {
+		m.oldValue = func(context.Context) (*MessageLink, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageLinkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageLinkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageLink entities.
+func (m *MessageLinkMutation) SetID(id pulid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageLinkMutation) ID() (id pulid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageLinkMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []pulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageLink.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLink sets the "link" field.
+func (m *MessageLinkMutation) SetLink(s string) {
+	m.link = &s
+}
+
+// Link returns the value of the "link" field in the mutation.
+func (m *MessageLinkMutation) Link() (r string, exists bool) {
+	v := m.link
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLink returns the old "link" field's value of the MessageLink entity.
+// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageLinkMutation) OldLink(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLink is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLink requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLink: %w", err)
+	}
+	return oldValue.Link, nil
+}
+
+// ResetLink resets all changes to the "link" field.
+func (m *MessageLinkMutation) ResetLink() {
+	m.link = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *MessageLinkMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *MessageLinkMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the MessageLink entity.
+// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageLinkMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ClearTitle clears the value of the "title" field.
+func (m *MessageLinkMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[messagelink.FieldTitle] = struct{}{}
+}
+
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *MessageLinkMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[messagelink.FieldTitle]
+	return ok
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *MessageLinkMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, messagelink.FieldTitle)
+}
+
+// SetDescription sets the "description" field.
+func (m *MessageLinkMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *MessageLinkMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the MessageLink entity.
+// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageLinkMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *MessageLinkMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[messagelink.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *MessageLinkMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[messagelink.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *MessageLinkMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, messagelink.FieldDescription)
+}
+
+// SetImageURL sets the "image_url" field.
+func (m *MessageLinkMutation) SetImageURL(s string) {
+	m.image_url = &s
+}
+
+// ImageURL returns the value of the "image_url" field in the mutation.
+func (m *MessageLinkMutation) ImageURL() (r string, exists bool) {
+	v := m.image_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageURL returns the old "image_url" field's value of the MessageLink entity.
+// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageLinkMutation) OldImageURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageURL: %w", err)
+	}
+	return oldValue.ImageURL, nil
+}
+
+// ClearImageURL clears the value of the "image_url" field.
+func (m *MessageLinkMutation) ClearImageURL() {
+	m.image_url = nil
+	m.clearedFields[messagelink.FieldImageURL] = struct{}{}
+}
+
+// ImageURLCleared returns if the "image_url" field was cleared in this mutation.
+func (m *MessageLinkMutation) ImageURLCleared() bool {
+	_, ok := m.clearedFields[messagelink.FieldImageURL]
+	return ok
+}
+
+// ResetImageURL resets all changes to the "image_url" field.
+func (m *MessageLinkMutation) ResetImageURL() {
+	m.image_url = nil
+	delete(m.clearedFields, messagelink.FieldImageURL)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageLinkMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageLinkMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MessageLink entity.
+// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageLinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageLinkMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MessageLinkMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MessageLinkMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the MessageLink entity.
+// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageLinkMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MessageLinkMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetRoomID sets the "room" edge to the Room entity by id.
+func (m *MessageLinkMutation) SetRoomID(id pulid.ID) {
+	m.room = &id
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (m *MessageLinkMutation) ClearRoom() {
+	m.clearedroom = true
+}
+
+// RoomCleared reports if the "room" edge to the Room entity was cleared.
+func (m *MessageLinkMutation) RoomCleared() bool {
+	return m.clearedroom
+}
+
+// RoomID returns the "room" edge ID in the mutation.
+func (m *MessageLinkMutation) RoomID() (id pulid.ID, exists bool) {
+	if m.room != nil {
+		return *m.room, true
+	}
+	return
+}
+
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *MessageLinkMutation) RoomIDs() (ids []pulid.ID) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoom resets all changes to the "room" edge.
+func (m *MessageLinkMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
+}
+
+// SetMessageID sets the "message" edge to the Message entity by id.
+func (m *MessageLinkMutation) SetMessageID(id pulid.ID) {
+	m.message = &id
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageLinkMutation) ClearMessage() {
+	m.clearedmessage = true
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageLinkMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageID returns the "message" edge ID in the mutation.
+func (m *MessageLinkMutation) MessageID() (id pulid.ID, exists bool) {
+	if m.message != nil {
+		return *m.message, true
+	}
+	return
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageLinkMutation) MessageIDs() (ids []pulid.ID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageLinkMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// Where appends a list predicates to the MessageLinkMutation builder.
+func (m *MessageLinkMutation) Where(ps ...predicate.MessageLink) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageLinkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageLinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageLink, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageLinkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageLinkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageLink).
+func (m *MessageLinkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageLinkMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.link != nil {
+		fields = append(fields, messagelink.FieldLink)
+	}
+	if m.title != nil {
+		fields = append(fields, messagelink.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, messagelink.FieldDescription)
+	}
+	if m.image_url != nil {
+		fields = append(fields, messagelink.FieldImageURL)
+	}
+	if m.created_at != nil {
+		fields = append(fields, messagelink.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, messagelink.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageLinkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagelink.FieldLink:
+		return m.Link()
+	case messagelink.FieldTitle:
+		return m.Title()
+	case messagelink.FieldDescription:
+		return m.Description()
+	case messagelink.FieldImageURL:
+		return m.ImageURL()
+	case messagelink.FieldCreatedAt:
+		return m.CreatedAt()
+	case messagelink.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagelink.FieldLink:
+		return m.OldLink(ctx)
+	case messagelink.FieldTitle:
+		return m.OldTitle(ctx)
+	case messagelink.FieldDescription:
+		return m.OldDescription(ctx)
+	case messagelink.FieldImageURL:
+		return m.OldImageURL(ctx)
+	case messagelink.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case messagelink.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageLink field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageLinkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagelink.FieldLink:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLink(v)
+		return nil
+	case messagelink.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case messagelink.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case messagelink.FieldImageURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageURL(v)
+		return nil
+	case messagelink.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case messagelink.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageLink field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageLinkMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageLinkMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageLinkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessageLink numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageLinkMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(messagelink.FieldTitle) {
+		fields = append(fields, messagelink.FieldTitle)
+	}
+	if m.FieldCleared(messagelink.FieldDescription) {
+		fields = append(fields, messagelink.FieldDescription)
+	}
+	if m.FieldCleared(messagelink.FieldImageURL) {
+		fields = append(fields, messagelink.FieldImageURL)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageLinkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageLinkMutation) ClearField(name string) error {
+	switch name {
+	case messagelink.FieldTitle:
+		m.ClearTitle()
+		return nil
+	case messagelink.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case messagelink.FieldImageURL:
+		m.ClearImageURL()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageLink nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageLinkMutation) ResetField(name string) error {
+	switch name {
+	case messagelink.FieldLink:
+		m.ResetLink()
+		return nil
+	case messagelink.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case messagelink.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case messagelink.FieldImageURL:
+		m.ResetImageURL()
+		return nil
+	case messagelink.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case messagelink.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageLink field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageLinkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.room != nil {
+		edges = append(edges, messagelink.EdgeRoom)
+	}
+	if m.message != nil {
+		edges = append(edges, messagelink.EdgeMessage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageLinkMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messagelink.EdgeRoom:
+		if id := m.room; id != nil {
+			return []ent.Value{*id}
+		}
+	case messagelink.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageLinkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageLinkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageLinkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedroom {
+		edges = append(edges, messagelink.EdgeRoom)
+	}
+	if m.clearedmessage {
+		edges = append(edges, messagelink.EdgeMessage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageLinkMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messagelink.EdgeRoom:
+		return m.clearedroom
+	case messagelink.EdgeMessage:
+		return m.clearedmessage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageLinkMutation) ClearEdge(name string) error {
+	switch name {
+	case messagelink.EdgeRoom:
+		m.ClearRoom()
+		return nil
+	case messagelink.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageLink unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageLinkMutation) ResetEdge(name string) error {
+	switch name {
+	case messagelink.EdgeRoom:
+		m.ResetRoom()
+		return nil
+	case messagelink.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageLink edge %s", name)
+}
+
+// MessageVoiceMutation represents an operation that mutates the MessageVoice nodes in the graph.
+type MessageVoiceMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *pulid.ID
+	length         *uint64
+	addlength      *int64
+	attached_at    *time.Time
+	clearedFields  map[string]struct{}
+	room           *pulid.ID
+	clearedroom    bool
+	message        *pulid.ID
+	clearedmessage bool
+	file           *pulid.ID
+	clearedfile    bool
+	done           bool
+	oldValue       func(context.Context) (*MessageVoice, error)
+	predicates     []predicate.MessageVoice
+}
+
+var _ ent.Mutation = (*MessageVoiceMutation)(nil)
+
+// messagevoiceOption allows management of the mutation configuration using functional options.
+type messagevoiceOption func(*MessageVoiceMutation)
+
+// newMessageVoiceMutation creates new mutation for the MessageVoice entity.
+func newMessageVoiceMutation(c config, op Op, opts ...messagevoiceOption) *MessageVoiceMutation {
+	m := &MessageVoiceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageVoice,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageVoiceID sets the ID field of the mutation.
+func withMessageVoiceID(id pulid.ID) messagevoiceOption {
+	return func(m *MessageVoiceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageVoice
+		)
+		m.oldValue = func(ctx context.Context) (*MessageVoice, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageVoice.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageVoice sets the old MessageVoice of the mutation.
+func withMessageVoice(node *MessageVoice) messagevoiceOption {
+	return func(m *MessageVoiceMutation) {
+		m.oldValue = func(context.Context) (*MessageVoice, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageVoiceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageVoiceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageVoice entities.
+func (m *MessageVoiceMutation) SetID(id pulid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageVoiceMutation) ID() (id pulid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageVoiceMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []pulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageVoice.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLength sets the "length" field.
+func (m *MessageVoiceMutation) SetLength(u uint64) {
+	m.length = &u
+	m.addlength = nil
+}
+
+// Length returns the value of the "length" field in the mutation.
+func (m *MessageVoiceMutation) Length() (r uint64, exists bool) {
+	v := m.length
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLength returns the old "length" field's value of the MessageVoice entity.
+// If the MessageVoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageVoiceMutation) OldLength(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLength is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLength requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLength: %w", err)
+	}
+	return oldValue.Length, nil
+}
+
+// AddLength adds u to the "length" field.
+func (m *MessageVoiceMutation) AddLength(u int64) {
+	if m.addlength != nil {
+		*m.addlength += u
+	} else {
+		m.addlength = &u
+	}
+}
+
+// AddedLength returns the value that was added to the "length" field in this mutation.
+func (m *MessageVoiceMutation) AddedLength() (r int64, exists bool) {
+	v := m.addlength
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLength resets all changes to the "length" field.
+func (m *MessageVoiceMutation) ResetLength() {
+	m.length = nil
+	m.addlength = nil
+}
+
+// SetAttachedAt sets the "attached_at" field.
+func (m *MessageVoiceMutation) SetAttachedAt(t time.Time) {
+	m.attached_at = &t
+}
+
+// AttachedAt returns the value of the "attached_at" field in the mutation.
+func (m *MessageVoiceMutation) AttachedAt() (r time.Time, exists bool) {
+	v := m.attached_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttachedAt returns the old "attached_at" field's value of the MessageVoice entity.
+// If the MessageVoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageVoiceMutation) OldAttachedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttachedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttachedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttachedAt: %w", err)
+	}
+	return oldValue.AttachedAt, nil
+}
+
+// ResetAttachedAt resets all changes to the "attached_at" field.
+func (m *MessageVoiceMutation) ResetAttachedAt() {
+	m.attached_at = nil
+}
+
+// SetRoomID sets the "room" edge to the Room entity by id.
+func (m *MessageVoiceMutation) SetRoomID(id pulid.ID) {
+	m.room = &id
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (m *MessageVoiceMutation) ClearRoom() {
+	m.clearedroom = true
+}
+
+// RoomCleared reports if the "room" edge to the Room entity was cleared.
+func (m *MessageVoiceMutation) RoomCleared() bool {
+	return m.clearedroom
+}
+
+// RoomID returns the "room" edge ID in the mutation.
+func (m *MessageVoiceMutation) RoomID() (id pulid.ID, exists bool) {
+	if m.room != nil {
+		return *m.room, true
+	}
+	return
+}
+
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *MessageVoiceMutation) RoomIDs() (ids []pulid.ID) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoom resets all changes to the "room" edge.
+func (m *MessageVoiceMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
+}
+
+// SetMessageID sets the "message" edge to the Message entity by id.
+func (m *MessageVoiceMutation) SetMessageID(id pulid.ID) {
+	m.message = &id
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageVoiceMutation) ClearMessage() {
+	m.clearedmessage = true
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageVoiceMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageID returns the "message" edge ID in the mutation.
+func (m *MessageVoiceMutation) MessageID() (id pulid.ID, exists bool) {
+	if m.message != nil {
+		return *m.message, true
+	}
+	return
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageVoiceMutation) MessageIDs() (ids []pulid.ID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageVoiceMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// SetFileID sets the "file" edge to the File entity by id.
+func (m *MessageVoiceMutation) SetFileID(id pulid.ID) {
+	m.file = &id
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (m *MessageVoiceMutation) ClearFile() {
+	m.clearedfile = true
+}
+
+// FileCleared reports if the "file" edge to the File entity was cleared.
+func (m *MessageVoiceMutation) FileCleared() bool {
+	return m.clearedfile
+}
+
+// FileID returns the "file" edge ID in the mutation.
+func (m *MessageVoiceMutation) FileID() (id pulid.ID, exists bool) {
+	if m.file != nil {
+		return *m.file, true
+	}
+	return
+}
+
+// FileIDs returns the "file" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *MessageVoiceMutation) FileIDs() (ids []pulid.ID) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFile resets all changes to the "file" edge.
+func (m *MessageVoiceMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
+}
+
+// Where appends a list predicates to the MessageVoiceMutation builder.
+func (m *MessageVoiceMutation) Where(ps ...predicate.MessageVoice) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageVoiceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageVoiceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageVoice, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageVoiceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageVoiceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageVoice).
+func (m *MessageVoiceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageVoiceMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.length != nil {
+		fields = append(fields, messagevoice.FieldLength)
+	}
+	if m.attached_at != nil {
+		fields = append(fields, messagevoice.FieldAttachedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageVoiceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagevoice.FieldLength:
+		return m.Length()
+	case messagevoice.FieldAttachedAt:
+		return m.AttachedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageVoiceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagevoice.FieldLength:
+		return m.OldLength(ctx)
+	case messagevoice.FieldAttachedAt:
+		return m.OldAttachedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageVoice field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageVoiceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagevoice.FieldLength:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLength(v)
+		return nil
+	case messagevoice.FieldAttachedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttachedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageVoice field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageVoiceMutation) AddedFields() []string {
+	var fields []string
+	if m.addlength != nil {
+		fields = append(fields, messagevoice.FieldLength)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageVoiceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case messagevoice.FieldLength:
+		return m.AddedLength()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageVoiceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case messagevoice.FieldLength:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLength(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageVoice numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageVoiceMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageVoiceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageVoiceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageVoice nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageVoiceMutation) ResetField(name string) error {
+	switch name {
+	case messagevoice.FieldLength:
+		m.ResetLength()
+		return nil
+	case messagevoice.FieldAttachedAt:
+		m.ResetAttachedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageVoice field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageVoiceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.room != nil {
+		edges = append(edges, messagevoice.EdgeRoom)
+	}
+	if m.message != nil {
+		edges = append(edges, messagevoice.EdgeMessage)
+	}
+	if m.file != nil {
+		edges = append(edges, messagevoice.EdgeFile)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageVoiceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messagevoice.EdgeRoom:
+		if id := m.room; id != nil {
+			return []ent.Value{*id}
+		}
+	case messagevoice.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	case messagevoice.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageVoiceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageVoiceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageVoiceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedroom {
+		edges = append(edges, messagevoice.EdgeRoom)
+	}
+	if m.clearedmessage {
+		edges = append(edges, messagevoice.EdgeMessage)
+	}
+	if m.clearedfile {
+		edges = append(edges, messagevoice.EdgeFile)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageVoiceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messagevoice.EdgeRoom:
+		return m.clearedroom
+	case messagevoice.EdgeMessage:
+		return m.clearedmessage
+	case messagevoice.EdgeFile:
+		return m.clearedfile
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageVoiceMutation) ClearEdge(name string) error {
+	switch name {
+	case messagevoice.EdgeRoom:
+		m.ClearRoom()
+		return nil
+	case messagevoice.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	case messagevoice.EdgeFile:
+		m.ClearFile()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageVoice unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageVoiceMutation) ResetEdge(name string) error {
+	switch name {
+	case messagevoice.EdgeRoom:
+		m.ResetRoom()
+		return nil
+	case messagevoice.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	case messagevoice.EdgeFile:
+		m.ResetFile()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageVoice edge %s", name)
+}
+
+// NotificationMutation represents an operation that mutates the Notification nodes in the graph.
+type NotificationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *pulid.ID
+	_type         *notification.Type
+	title         *string
+	body          *string
+	data          *map[string]interface{}
+	read_at       *time.Time
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *pulid.ID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*Notification, error)
+	predicates    []predicate.Notification
+}
+
+var _ ent.Mutation = (*NotificationMutation)(nil)
+
+// notificationOption allows management of the mutation configuration using functional options.
+type notificationOption func(*NotificationMutation)
+
+// newNotificationMutation creates new mutation for the Notification entity.
+func newNotificationMutation(c config, op Op, opts ...notificationOption) *NotificationMutation {
+	m := &NotificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationID sets the ID field of the mutation.
+func withNotificationID(id pulid.ID) notificationOption {
+	return func(m *NotificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Notification
+		)
+		m.oldValue = func(ctx context.Context) (*Notification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Notification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotification sets the old Notification of the mutation.
+func withNotification(node *Notification) notificationOption {
+	return func(m *NotificationMutation) {
+		m.oldValue = func(context.Context) (*Notification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Notification entities.
+func (m *NotificationMutation) SetID(id pulid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationMutation) ID() (id pulid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []pulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Notification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *NotificationMutation) SetType(n notification.Type) {
+	m._type = &n
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *NotificationMutation) GetType() (r notification.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldType(ctx context.Context) (v notification.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *NotificationMutation) ResetType() {
+	m._type = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *NotificationMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *NotificationMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *NotificationMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetBody sets the "body" field.
+func (m *NotificationMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *NotificationMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *NotificationMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetData sets the "data" field.
+func (m *NotificationMutation) SetData(value map[string]interface{}) {
+	m.data = &value
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *NotificationMutation) Data() (r map[string]interface{}, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldData(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ClearData clears the value of the "data" field.
+func (m *NotificationMutation) ClearData() {
+	m.data = nil
+	m.clearedFields[notification.FieldData] = struct{}{}
+}
+
+// DataCleared returns if the "data" field was cleared in this mutation.
+func (m *NotificationMutation) DataCleared() bool {
+	_, ok := m.clearedFields[notification.FieldData]
+	return ok
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *NotificationMutation) ResetData() {
+	m.data = nil
+	delete(m.clearedFields, notification.FieldData)
+}
+
+// SetReadAt sets the "read_at" field.
+func (m *NotificationMutation) SetReadAt(t time.Time) {
+	m.read_at = &t
+}
+
+// ReadAt returns the value of the "read_at" field in the mutation.
+func (m *NotificationMutation) ReadAt() (r time.Time, exists bool) {
+	v := m.read_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadAt returns the old "read_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldReadAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadAt: %w", err)
+	}
+	return oldValue.ReadAt, nil
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (m *NotificationMutation) ClearReadAt() {
+	m.read_at = nil
+	m.clearedFields[notification.FieldReadAt] = struct{}{}
+}
+
+// ReadAtCleared returns if the "read_at" field was cleared in this mutation.
+func (m *NotificationMutation) ReadAtCleared() bool {
+	_, ok := m.clearedFields[notification.FieldReadAt]
+	return ok
+}
+
+// ResetReadAt resets all changes to the "read_at" field.
+func (m *NotificationMutation) ResetReadAt() {
+	m.read_at = nil
+	delete(m.clearedFields, notification.FieldReadAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NotificationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NotificationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NotificationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NotificationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NotificationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NotificationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *NotificationMutation) SetUserID(id pulid.ID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *NotificationMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *NotificationMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *NotificationMutation) UserID() (id pulid.ID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *NotificationMutation) UserIDs() (ids []pulid.ID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *NotificationMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the NotificationMutation builder.
+func (m *NotificationMutation) Where(ps ...predicate.Notification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Notification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Notification).
+func (m *NotificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m._type != nil {
+		fields = append(fields, notification.FieldType)
+	}
+	if m.title != nil {
+		fields = append(fields, notification.FieldTitle)
+	}
+	if m.body != nil {
+		fields = append(fields, notification.FieldBody)
+	}
+	if m.data != nil {
+		fields = append(fields, notification.FieldData)
+	}
+	if m.read_at != nil {
+		fields = append(fields, notification.FieldReadAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, notification.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, notification.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notification.FieldType:
+		return m.GetType()
+	case notification.FieldTitle:
+		return m.Title()
+	case notification.FieldBody:
+		return m.Body()
+	case notification.FieldData:
+		return m.Data()
+	case notification.FieldReadAt:
+		return m.ReadAt()
+	case notification.FieldCreatedAt:
+		return m.CreatedAt()
+	case notification.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notification.FieldType:
+		return m.OldType(ctx)
+	case notification.FieldTitle:
+		return m.OldTitle(ctx)
+	case notification.FieldBody:
+		return m.OldBody(ctx)
+	case notification.FieldData:
+		return m.OldData(ctx)
+	case notification.FieldReadAt:
+		return m.OldReadAt(ctx)
+	case notification.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case notification.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Notification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notification.FieldType:
+		v, ok := value.(notification.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case notification.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case notification.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case notification.FieldData:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case notification.FieldReadAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadAt(v)
+		return nil
+	case notification.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case notification.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Notification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Notification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notification.FieldData) {
+		fields = append(fields, notification.FieldData)
+	}
+	if m.FieldCleared(notification.FieldReadAt) {
+		fields = append(fields, notification.FieldReadAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationMutation) ClearField(name string) error {
+	switch name {
+	case notification.FieldData:
+		m.ClearData()
+		return nil
+	case notification.FieldReadAt:
+		m.ClearReadAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationMutation) ResetField(name string) error {
+	switch name {
+	case notification.FieldType:
+		m.ResetType()
+		return nil
+	case notification.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case notification.FieldBody:
+		m.ResetBody()
+		return nil
+	case notification.FieldData:
+		m.ResetData()
+		return nil
+	case notification.FieldReadAt:
+		m.ResetReadAt()
+		return nil
+	case notification.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case notification.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, notification.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case notification.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, notification.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case notification.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationMutation) ClearEdge(name string) error {
+	switch name {
+	case notification.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationMutation) ResetEdge(name string) error {
+	switch name {
+	case notification.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification edge %s", name)
+}
+
+// OutboxMessageMutation represents an operation that mutates the OutboxMessage nodes in the graph.
+type OutboxMessageMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *pulid.ID
+	subject       *string
+	payload       *[]byte
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*OutboxMessage, error)
+	predicates    []predicate.OutboxMessage
+}
+
+var _ ent.Mutation = (*OutboxMessageMutation)(nil)
+
+// outboxmessageOption allows management of the mutation configuration using functional options.
+type outboxmessageOption func(*OutboxMessageMutation)
+
+// newOutboxMessageMutation creates new mutation for the OutboxMessage entity.
+func newOutboxMessageMutation(c config, op Op, opts ...outboxmessageOption) *OutboxMessageMutation {
+	m := &OutboxMessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOutboxMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOutboxMessageID sets the ID field of the mutation.
+func withOutboxMessageID(id pulid.ID) outboxmessageOption {
+	return func(m *OutboxMessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OutboxMessage
+		)
+		m.oldValue = func(ctx context.Context) (*OutboxMessage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OutboxMessage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOutboxMessage sets the old OutboxMessage of the mutation.
+func withOutboxMessage(node *OutboxMessage) outboxmessageOption {
+	return func(m *OutboxMessageMutation) {
+		m.oldValue = func(context.Context) (*OutboxMessage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OutboxMessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OutboxMessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OutboxMessage entities.
+func (m *OutboxMessageMutation) SetID(id pulid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OutboxMessageMutation) ID() (id pulid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OutboxMessageMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []pulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OutboxMessage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSubject sets the "subject" field.
+func (m *OutboxMessageMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *OutboxMessageMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the OutboxMessage entity.
+// If the OutboxMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMessageMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *OutboxMessageMutation) ResetSubject() {
+	m.subject = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *OutboxMessageMutation) SetPayload(b []byte) {
+	m.payload = &b
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *OutboxMessageMutation) Payload() (r []byte, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the OutboxMessage entity.
+// If the OutboxMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMessageMutation) OldPayload(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *OutboxMessageMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OutboxMessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OutboxMessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OutboxMessage entity.
+// If the OutboxMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OutboxMessageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the OutboxMessageMutation builder.
+func (m *OutboxMessageMutation) Where(ps ...predicate.OutboxMessage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OutboxMessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OutboxMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OutboxMessage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OutboxMessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OutboxMessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OutboxMessage).
+func (m *OutboxMessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OutboxMessageMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.subject != nil {
+		fields = append(fields, outboxmessage.FieldSubject)
+	}
+	if m.payload != nil {
+		fields = append(fields, outboxmessage.FieldPayload)
+	}
+	if m.created_at != nil {
+		fields = append(fields, outboxmessage.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OutboxMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case outboxmessage.FieldSubject:
+		return m.Subject()
+	case outboxmessage.FieldPayload:
+		return m.Payload()
+	case outboxmessage.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OutboxMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case outboxmessage.FieldSubject:
+		return m.OldSubject(ctx)
+	case outboxmessage.FieldPayload:
+		return m.OldPayload(ctx)
+	case outboxmessage.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OutboxMessage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxMessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case outboxmessage.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case outboxmessage.FieldPayload:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case outboxmessage.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxMessage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OutboxMessageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OutboxMessageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OutboxMessage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OutboxMessageMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OutboxMessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OutboxMessageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown OutboxMessage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OutboxMessageMutation) ResetField(name string) error {
+	switch name {
+	case outboxmessage.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case outboxmessage.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case outboxmessage.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxMessage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OutboxMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OutboxMessageMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OutboxMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OutboxMessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OutboxMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OutboxMessageMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OutboxMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OutboxMessage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OutboxMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OutboxMessage edge %s", name)
+}
+
+// ReportMutation represents an operation that mutates the Report nodes in the graph.
+type ReportMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *pulid.ID
+	_type           *report.Type
+	reason          *report.Reason
+	text            *string
+	snapshot        *map[string]interface{}
+	status          *report.Status
+	actions         *[]string
+	appendactions   []string
+	note            *string
+	closed_at       *time.Time
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	reporter        *pulid.ID
+	clearedreporter bool
+	user            *pulid.ID
+	cleareduser     bool
+	message         *pulid.ID
+	clearedmessage  bool
+	room            *pulid.ID
+	clearedroom     bool
+	assignee        *pulid.ID
+	clearedassignee bool
+	done            bool
+	oldValue        func(context.Context) (*Report, error)
+	predicates      []predicate.Report
+}
+
+var _ ent.Mutation = (*ReportMutation)(nil)
+
+// reportOption allows management of the mutation configuration using functional options.
+type reportOption func(*ReportMutation)
+
+// newReportMutation creates new mutation for the Report entity.
+func newReportMutation(c config, op Op, opts ...reportOption) *ReportMutation {
+	m := &ReportMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReport,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReportID sets the ID field of the mutation.
+func withReportID(id pulid.ID) reportOption {
+	return func(m *ReportMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Report
+		)
+		m.oldValue = func(ctx context.Context) (*Report, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Report.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReport sets the old Report of the mutation.
+func withReport(node *Report) reportOption {
+	return func(m *ReportMutation) {
+		m.oldValue = func(context.Context) (*Report, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReportMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReportMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Report entities.
+func (m *ReportMutation) SetID(id pulid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReportMutation) ID() (id pulid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReportMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []pulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Report.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *ReportMutation) SetType(r report.Type) {
+	m._type = &r
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *ReportMutation) GetType() (r report.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldType(ctx context.Context) (v report.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *ReportMutation) ResetType() {
+	m._type = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *ReportMutation) SetReason(r report.Reason) {
+	m.reason = &r
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ReportMutation) Reason() (r report.Reason, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldReason(ctx context.Context) (v report.Reason, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *ReportMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetText sets the "text" field.
+func (m *ReportMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *ReportMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldText(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ClearText clears the value of the "text" field.
+func (m *ReportMutation) ClearText() {
+	m.text = nil
+	m.clearedFields[report.FieldText] = struct{}{}
+}
+
+// TextCleared returns if the "text" field was cleared in this mutation.
+func (m *ReportMutation) TextCleared() bool {
+	_, ok := m.clearedFields[report.FieldText]
+	return ok
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *ReportMutation) ResetText() {
+	m.text = nil
+	delete(m.clearedFields, report.FieldText)
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (m *ReportMutation) SetSnapshot(value map[string]interface{}) {
+	m.snapshot = &value
+}
+
+// Snapshot returns the value of the "snapshot" field in the mutation.
+func (m *ReportMutation) Snapshot() (r map[string]interface{}, exists bool) {
+	v := m.snapshot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSnapshot returns the old "snapshot" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldSnapshot(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSnapshot is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSnapshot requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSnapshot: %w", err)
+	}
+	return oldValue.Snapshot, nil
+}
+
+// ResetSnapshot resets all changes to the "snapshot" field.
+func (m *ReportMutation) ResetSnapshot() {
+	m.snapshot = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ReportMutation) SetStatus(r report.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ReportMutation) Status() (r report.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldStatus(ctx context.Context) (v report.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ReportMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetActions sets the "actions" field.
+func (m *ReportMutation) SetActions(s []string) {
+	m.actions = &s
+	m.appendactions = nil
+}
+
+// Actions returns the value of the "actions" field in the mutation.
+func (m *ReportMutation) Actions() (r []string, exists bool) {
+	v := m.actions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActions returns the old "actions" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldActions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActions: %w", err)
+	}
+	return oldValue.Actions, nil
+}
+
+// AppendActions adds s to the "actions" field.
+func (m *ReportMutation) AppendActions(s []string) {
+	m.appendactions = append(m.appendactions, s...)
+}
+
+// AppendedActions returns the list of values that were appended to the "actions" field in this mutation.
+func (m *ReportMutation) AppendedActions() ([]string, bool) {
+	if len(m.appendactions) == 0 {
+		return nil, false
+	}
+	return m.appendactions, true
+}
+
+// ClearActions clears the value of the "actions" field.
+func (m *ReportMutation) ClearActions() {
+	m.actions = nil
+	m.appendactions = nil
+	m.clearedFields[report.FieldActions] = struct{}{}
+}
+
+// ActionsCleared returns if the "actions" field was cleared in this mutation.
+func (m *ReportMutation) ActionsCleared() bool {
+	_, ok := m.clearedFields[report.FieldActions]
+	return ok
+}
+
+// ResetActions resets all changes to the "actions" field.
+func (m *ReportMutation) ResetActions() {
+	m.actions = nil
+	m.appendactions = nil
+	delete(m.clearedFields, report.FieldActions)
+}
+
+// SetNote sets the "note" field.
+func (m *ReportMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *ReportMutation) Note() (r string, exists bool) {
+	v := m.note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNote returns the old "note" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldNote(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+	}
+	return oldValue.Note, nil
+}
+
+// ClearNote clears the value of the "note" field.
+func (m *ReportMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[report.FieldNote] = struct{}{}
+}
+
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *ReportMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[report.FieldNote]
+	return ok
+}
+
+// ResetNote resets all changes to the "note" field.
+func (m *ReportMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, report.FieldNote)
+}
+
+// SetReporterID sets the "reporter_id" field.
+func (m *ReportMutation) SetReporterID(pu pulid.ID) {
+	m.reporter = &pu
+}
+
+// ReporterID returns the value of the "reporter_id" field in the mutation.
+func (m *ReportMutation) ReporterID() (r pulid.ID, exists bool) {
+	v := m.reporter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReporterID returns the old "reporter_id" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldReporterID(ctx context.Context) (v pulid.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReporterID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReporterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReporterID: %w", err)
+	}
+	return oldValue.ReporterID, nil
+}
+
+// ResetReporterID resets all changes to the "reporter_id" field.
+func (m *ReportMutation) ResetReporterID() {
+	m.reporter = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ReportMutation) SetUserID(pu pulid.ID) {
+	m.user = &pu
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ReportMutation) UserID() (r pulid.ID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldUserID(ctx context.Context) (v *pulid.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *ReportMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[report.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *ReportMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[report.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ReportMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, report.FieldUserID)
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *ReportMutation) SetMessageID(pu pulid.ID) {
+	m.message = &pu
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *ReportMutation) MessageID() (r pulid.ID, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldMessageID(ctx context.Context) (v *pulid.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (m *ReportMutation) ClearMessageID() {
+	m.message = nil
+	m.clearedFields[report.FieldMessageID] = struct{}{}
+}
+
+// MessageIDCleared returns if the "message_id" field was cleared in this mutation.
+func (m *ReportMutation) MessageIDCleared() bool {
+	_, ok := m.clearedFields[report.FieldMessageID]
+	return ok
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *ReportMutation) ResetMessageID() {
+	m.message = nil
+	delete(m.clearedFields, report.FieldMessageID)
+}
+
+// SetRoomID sets the "room_id" field.
+func (m *ReportMutation) SetRoomID(pu pulid.ID) {
+	m.room = &pu
+}
+
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *ReportMutation) RoomID() (r pulid.ID, exists bool) {
+	v := m.room
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoomID returns the old "room_id" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldRoomID(ctx context.Context) (v *pulid.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
+	}
+	return oldValue.RoomID, nil
+}
+
+// ClearRoomID clears the value of the "room_id" field.
+func (m *ReportMutation) ClearRoomID() {
+	m.room = nil
+	m.clearedFields[report.FieldRoomID] = struct{}{}
+}
+
+// RoomIDCleared returns if the "room_id" field was cleared in this mutation.
+func (m *ReportMutation) RoomIDCleared() bool {
+	_, ok := m.clearedFields[report.FieldRoomID]
+	return ok
+}
+
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *ReportMutation) ResetRoomID() {
+	m.room = nil
+	delete(m.clearedFields, report.FieldRoomID)
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (m *ReportMutation) SetAssigneeID(pu pulid.ID) {
+	m.assignee = &pu
+}
+
+// AssigneeID returns the value of the "assignee_id" field in the mutation.
+func (m *ReportMutation) AssigneeID() (r pulid.ID, exists bool) {
+	v := m.assignee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssigneeID returns the old "assignee_id" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldAssigneeID(ctx context.Context) (v *pulid.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssigneeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssigneeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssigneeID: %w", err)
+	}
+	return oldValue.AssigneeID, nil
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (m *ReportMutation) ClearAssigneeID() {
+	m.assignee = nil
+	m.clearedFields[report.FieldAssigneeID] = struct{}{}
+}
+
+// AssigneeIDCleared returns if the "assignee_id" field was cleared in this mutation.
+func (m *ReportMutation) AssigneeIDCleared() bool {
+	_, ok := m.clearedFields[report.FieldAssigneeID]
+	return ok
+}
+
+// ResetAssigneeID resets all changes to the "assignee_id" field.
+func (m *ReportMutation) ResetAssigneeID() {
+	m.assignee = nil
+	delete(m.clearedFields, report.FieldAssigneeID)
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (m *ReportMutation) SetClosedAt(t time.Time) {
+	m.closed_at = &t
+}
+
+// ClosedAt returns the value of the "closed_at" field in the mutation.
+func (m *ReportMutation) ClosedAt() (r time.Time, exists bool) {
+	v := m.closed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClosedAt returns the old "closed_at" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldClosedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClosedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClosedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClosedAt: %w", err)
+	}
+	return oldValue.ClosedAt, nil
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (m *ReportMutation) ClearClosedAt() {
+	m.closed_at = nil
+	m.clearedFields[report.FieldClosedAt] = struct{}{}
+}
+
+// ClosedAtCleared returns if the "closed_at" field was cleared in this mutation.
+func (m *ReportMutation) ClosedAtCleared() bool {
+	_, ok := m.clearedFields[report.FieldClosedAt]
+	return ok
+}
+
+// ResetClosedAt resets all changes to the "closed_at" field.
+func (m *ReportMutation) ResetClosedAt() {
+	m.closed_at = nil
+	delete(m.clearedFields, report.FieldClosedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReportMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReportMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReportMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ReportMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ReportMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ReportMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearReporter clears the "reporter" edge to the User entity.
+func (m *ReportMutation) ClearReporter() {
+	m.clearedreporter = true
+	m.clearedFields[report.FieldReporterID] = struct{}{}
+}
+
+// ReporterCleared reports if the "reporter" edge to the User entity was cleared.
+func (m *ReportMutation) ReporterCleared() bool {
+	return m.clearedreporter
+}
+
+// ReporterIDs returns the "reporter" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReporterID instead. It exists only for internal usage by the builders.
+func (m *ReportMutation) ReporterIDs() (ids []pulid.ID) {
+	if id := m.reporter; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReporter resets all changes to the "reporter" edge.
+func (m *ReportMutation) ResetReporter() {
+	m.reporter = nil
+	m.clearedreporter = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ReportMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[report.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ReportMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ReportMutation) UserIDs() (ids []pulid.ID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ReportMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *ReportMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[report.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *ReportMutation) MessageCleared() bool {
+	return m.MessageIDCleared() || m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *ReportMutation) MessageIDs() (ids []pulid.ID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *ReportMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (m *ReportMutation) ClearRoom() {
+	m.clearedroom = true
+	m.clearedFields[report.FieldRoomID] = struct{}{}
+}
+
+// RoomCleared reports if the "room" edge to the Room entity was cleared.
+func (m *ReportMutation) RoomCleared() bool {
+	return m.RoomIDCleared() || m.clearedroom
+}
+
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *ReportMutation) RoomIDs() (ids []pulid.ID) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoom resets all changes to the "room" edge.
+func (m *ReportMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
+}
+
+// ClearAssignee clears the "assignee" edge to the User entity.
+func (m *ReportMutation) ClearAssignee() {
+	m.clearedassignee = true
+	m.clearedFields[report.FieldAssigneeID] = struct{}{}
+}
+
+// AssigneeCleared reports if the "assignee" edge to the User entity was cleared.
+func (m *ReportMutation) AssigneeCleared() bool {
+	return m.AssigneeIDCleared() || m.clearedassignee
+}
+
+// AssigneeIDs returns the "assignee" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AssigneeID instead. It exists only for internal usage by the builders.
+func (m *ReportMutation) AssigneeIDs() (ids []pulid.ID) {
+	if id := m.assignee; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAssignee resets all changes to the "assignee" edge.
+func (m *ReportMutation) ResetAssignee() {
+	m.assignee = nil
+	m.clearedassignee = false
+}
+
+// Where appends a list predicates to the ReportMutation builder.
+func (m *ReportMutation) Where(ps ...predicate.Report) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReportMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReportMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Report, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReportMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReportMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Report).
+func (m *ReportMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReportMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m._type != nil {
+		fields = append(fields, report.FieldType)
+	}
+	if m.reason != nil {
+		fields = append(fields, report.FieldReason)
+	}
+	if m.text != nil {
+		fields = append(fields, report.FieldText)
+	}
+	if m.snapshot != nil {
+		fields = append(fields, report.FieldSnapshot)
+	}
+	if m.status != nil {
+		fields = append(fields, report.FieldStatus)
+	}
+	if m.actions != nil {
+		fields = append(fields, report.FieldActions)
+	}
+	if m.note != nil {
+		fields = append(fields, report.FieldNote)
+	}
+	if m.reporter != nil {
+		fields = append(fields, report.FieldReporterID)
+	}
+	if m.user != nil {
+		fields = append(fields, report.FieldUserID)
+	}
+	if m.message != nil {
+		fields = append(fields, report.FieldMessageID)
+	}
+	if m.room != nil {
+		fields = append(fields, report.FieldRoomID)
+	}
+	if m.assignee != nil {
+		fields = append(fields, report.FieldAssigneeID)
+	}
+	if m.closed_at != nil {
+		fields = append(fields, report.FieldClosedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, report.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, report.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReportMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case report.FieldType:
+		return m.GetType()
+	case report.FieldReason:
+		return m.Reason()
+	case report.FieldText:
+		return m.Text()
+	case report.FieldSnapshot:
+		return m.Snapshot()
+	case report.FieldStatus:
+		return m.Status()
+	case report.FieldActions:
+		return m.Actions()
+	case report.FieldNote:
+		return m.Note()
+	case report.FieldReporterID:
+		return m.ReporterID()
+	case report.FieldUserID:
+		return m.UserID()
+	case report.FieldMessageID:
+		return m.MessageID()
+	case report.FieldRoomID:
+		return m.RoomID()
+	case report.FieldAssigneeID:
+		return m.AssigneeID()
+	case report.FieldClosedAt:
+		return m.ClosedAt()
+	case report.FieldCreatedAt:
+		return m.CreatedAt()
+	case report.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case report.FieldType:
+		return m.OldType(ctx)
+	case report.FieldReason:
+		return m.OldReason(ctx)
+	case report.FieldText:
+		return m.OldText(ctx)
+	case report.FieldSnapshot:
+		return m.OldSnapshot(ctx)
+	case report.FieldStatus:
+		return m.OldStatus(ctx)
+	case report.FieldActions:
+		return m.OldActions(ctx)
+	case report.FieldNote:
+		return m.OldNote(ctx)
+	case report.FieldReporterID:
+		return m.OldReporterID(ctx)
+	case report.FieldUserID:
+		return m.OldUserID(ctx)
+	case report.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case report.FieldRoomID:
+		return m.OldRoomID(ctx)
+	case report.FieldAssigneeID:
+		return m.OldAssigneeID(ctx)
+	case report.FieldClosedAt:
+		return m.OldClosedAt(ctx)
+	case report.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case report.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Report field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReportMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case report.FieldType:
+		v, ok := value.(report.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case report.FieldReason:
+		v, ok := value.(report.Reason)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case report.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case report.FieldSnapshot:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSnapshot(v)
+		return nil
+	case report.FieldStatus:
+		v, ok := value.(report.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case report.FieldActions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActions(v)
+		return nil
+	case report.FieldNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNote(v)
+		return nil
+	case report.FieldReporterID:
+		v, ok := value.(pulid.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReporterID(v)
+		return nil
+	case report.FieldUserID:
+		v, ok := value.(pulid.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case report.FieldMessageID:
+		v, ok := value.(pulid.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case report.FieldRoomID:
+		v, ok := value.(pulid.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomID(v)
+		return nil
+	case report.FieldAssigneeID:
+		v, ok := value.(pulid.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssigneeID(v)
+		return nil
+	case report.FieldClosedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClosedAt(v)
+		return nil
+	case report.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case report.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Report field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReportMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReportMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReportMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Report numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReportMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(report.FieldText) {
+		fields = append(fields, report.FieldText)
+	}
+	if m.FieldCleared(report.FieldActions) {
+		fields = append(fields, report.FieldActions)
+	}
+	if m.FieldCleared(report.FieldNote) {
+		fields = append(fields, report.FieldNote)
+	}
+	if m.FieldCleared(report.FieldUserID) {
+		fields = append(fields, report.FieldUserID)
+	}
+	if m.FieldCleared(report.FieldMessageID) {
+		fields = append(fields, report.FieldMessageID)
+	}
+	if m.FieldCleared(report.FieldRoomID) {
+		fields = append(fields, report.FieldRoomID)
+	}
+	if m.FieldCleared(report.FieldAssigneeID) {
+		fields = append(fields, report.FieldAssigneeID)
+	}
+	if m.FieldCleared(report.FieldClosedAt) {
+		fields = append(fields, report.FieldClosedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReportMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReportMutation) ClearField(name string) error {
+	switch name {
+	case report.FieldText:
+		m.ClearText()
+		return nil
+	case report.FieldActions:
+		m.ClearActions()
+		return nil
+	case report.FieldNote:
+		m.ClearNote()
+		return nil
+	case report.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case report.FieldMessageID:
+		m.ClearMessageID()
+		return nil
+	case report.FieldRoomID:
+		m.ClearRoomID()
+		return nil
+	case report.FieldAssigneeID:
+		m.ClearAssigneeID()
+		return nil
+	case report.FieldClosedAt:
+		m.ClearClosedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Report nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReportMutation) ResetField(name string) error {
+	switch name {
+	case report.FieldType:
+		m.ResetType()
+		return nil
+	case report.FieldReason:
+		m.ResetReason()
+		return nil
+	case report.FieldText:
+		m.ResetText()
+		return nil
+	case report.FieldSnapshot:
+		m.ResetSnapshot()
+		return nil
+	case report.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case report.FieldActions:
+		m.ResetActions()
+		return nil
+	case report.FieldNote:
+		m.ResetNote()
+		return nil
+	case report.FieldReporterID:
+		m.ResetReporterID()
+		return nil
+	case report.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case report.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case report.FieldRoomID:
+		m.ResetRoomID()
+		return nil
+	case report.FieldAssigneeID:
+		m.ResetAssigneeID()
+		return nil
+	case report.FieldClosedAt:
+		m.ResetClosedAt()
+		return nil
+	case report.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case report.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Report field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReportMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.reporter != nil {
+		edges = append(edges, report.EdgeReporter)
+	}
+	if m.user != nil {
+		edges = append(edges, report.EdgeUser)
+	}
+	if m.message != nil {
+		edges = append(edges, report.EdgeMessage)
+	}
+	if m.room != nil {
+		edges = append(edges, report.EdgeRoom)
+	}
+	if m.assignee != nil {
+		edges = append(edges, report.EdgeAssignee)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReportMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case report.EdgeReporter:
+		if id := m.reporter; id != nil {
+			return []ent.Value{*id}
+		}
+	case report.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case report.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	case report.EdgeRoom:
+		if id := m.room; id != nil {
+			return []ent.Value{*id}
+		}
+	case report.EdgeAssignee:
+		if id := m.assignee; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReportMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReportMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReportMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedreporter {
+		edges = append(edges, report.EdgeReporter)
+	}
+	if m.cleareduser {
+		edges = append(edges, report.EdgeUser)
+	}
+	if m.clearedmessage {
+		edges = append(edges, report.EdgeMessage)
+	}
+	if m.clearedroom {
+		edges = append(edges, report.EdgeRoom)
+	}
+	if m.clearedassignee {
+		edges = append(edges, report.EdgeAssignee)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReportMutation) EdgeCleared(name string) bool {
+	switch name {
+	case report.EdgeReporter:
+		return m.clearedreporter
+	case report.EdgeUser:
+		return m.cleareduser
+	case report.EdgeMessage:
+		return m.clearedmessage
+	case report.EdgeRoom:
+		return m.clearedroom
+	case report.EdgeAssignee:
+		return m.clearedassignee
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReportMutation) ClearEdge(name string) error {
+	switch name {
+	case report.EdgeReporter:
+		m.ClearReporter()
+		return nil
+	case report.EdgeUser:
+		m.ClearUser()
+		return nil
+	case report.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	case report.EdgeRoom:
+		m.ClearRoom()
+		return nil
+	case report.EdgeAssignee:
+		m.ClearAssignee()
+		return nil
+	}
+	return fmt.Errorf("unknown Report unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReportMutation) ResetEdge(name string) error {
+	switch name {
+	case report.EdgeReporter:
+		m.ResetReporter()
+		return nil
+	case report.EdgeUser:
+		m.ResetUser()
+		return nil
+	case report.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	case report.EdgeRoom:
+		m.ResetRoom()
+		return nil
+	case report.EdgeAssignee:
+		m.ResetAssignee()
+		return nil
+	}
+	return fmt.Errorf("unknown Report edge %s", name)
+}
+
+// RoomMutation represents an operation that mutates the Room nodes in the graph.
+type RoomMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *pulid.ID
+	deleted_at                 *time.Time
+	name                       *string
+	description                *string
+	version                    *uint64
+	addversion                 *int64
+	storage_used               *int64
+	addstorage_used            *int64
+	_type                      *room.Type
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	clearedFields              map[string]struct{}
+	user_contacts              map[pulid.ID]struct{}
+	removeduser_contacts       map[pulid.ID]struct{}
+	cleareduser_contacts       bool
+	users                      map[pulid.ID]struct{}
+	removedusers               map[pulid.ID]struct{}
+	clearedusers               bool
+	last_message               *pulid.ID
+	clearedlast_message        bool
+	messages                   map[pulid.ID]struct{}
+	removedmessages            map[pulid.ID]struct{}
+	clearedmessages            bool
+	message_voices             map[pulid.ID]struct{}
+	removedmessage_voices      map[pulid.ID]struct{}
+	clearedmessage_voices      bool
+	message_attachments        map[pulid.ID]struct{}
+	removedmessage_attachments map[pulid.ID]struct{}
+	clearedmessage_attachments bool
+	message_links              map[pulid.ID]struct{}
+	removedmessage_links       map[pulid.ID]struct{}
+	clearedmessage_links       bool
+	files                      map[pulid.ID]struct{}
+	removedfiles               map[pulid.ID]struct{}
+	clearedfiles               bool
+	avatar                     *pulid.ID
+	clearedavatar              bool
+	avatars                    map[pulid.ID]struct{}
+	removedavatars             map[pulid.ID]struct{}
+	clearedavatars             bool
+	room_members               map[pulid.ID]struct{}
+	removedroom_members        map[pulid.ID]struct{}
+	clearedroom_members        bool
+	done                       bool
+	oldValue                   func(context.Context) (*Room, error)
+	predicates                 []predicate.Room
+}
+
+var _ ent.Mutation = (*RoomMutation)(nil)
+
+// roomOption allows management of the mutation configuration using functional options.
+type roomOption func(*RoomMutation)
+
+// newRoomMutation creates new mutation for the Room entity.
+func newRoomMutation(c config, op Op, opts ...roomOption) *RoomMutation {
+	m := &RoomMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoom,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoomID sets the ID field of the mutation.
+func withRoomID(id pulid.ID) roomOption {
+	return func(m *RoomMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Room
+		)
+		m.oldValue = func(ctx context.Context) (*Room, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Room.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoom sets the old Room of the mutation.
+func withRoom(node *Room) roomOption {
+	return func(m *RoomMutation) {
+		m.oldValue = func(context.Context) (*Room, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoomMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoomMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Room entities.
+func (m *RoomMutation) SetID(id pulid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoomMutation) ID() (id pulid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoomMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []pulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Room.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *RoomMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *RoomMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *RoomMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[room.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *RoomMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[room.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *RoomMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, room.FieldDeletedAt)
+}
+
+// SetName sets the "name" field.
+func (m *RoomMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RoomMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *RoomMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[room.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *RoomMutation) NameCleared() bool {
+	_, ok := m.clearedFields[room.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RoomMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, room.FieldName)
+}
+
+// SetDescription sets the "description" field.
+func (m *RoomMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *RoomMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *RoomMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[room.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *RoomMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[room.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *RoomMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, room.FieldDescription)
+}
+
+// SetVersion sets the "version" field.
+func (m *RoomMutation) SetVersion(u uint64) {
+	m.version = &u
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *RoomMutation) Version() (r uint64, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldVersion(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds u to the "version" field.
+func (m *RoomMutation) AddVersion(u int64) {
+	if m.addversion != nil {
+		*m.addversion += u
+	} else {
+		m.addversion = &u
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *RoomMutation) AddedVersion() (r int64, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *RoomMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetStorageUsed sets the "storage_used" field.
+func (m *RoomMutation) SetStorageUsed(i int64) {
+	m.storage_used = &i
+	m.addstorage_used = nil
+}
+
+// StorageUsed returns the value of the "storage_used" field in the mutation.
+func (m *RoomMutation) StorageUsed() (r int64, exists bool) {
+	v := m.storage_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorageUsed returns the old "storage_used" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldStorageUsed(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageUsed: %w", err)
+	}
+	return oldValue.StorageUsed, nil
+}
+
+// AddStorageUsed adds i to the "storage_used" field.
+func (m *RoomMutation) AddStorageUsed(i int64) {
+	if m.addstorage_used != nil {
+		*m.addstorage_used += i
+	} else {
+		m.addstorage_used = &i
+	}
+}
+
+// AddedStorageUsed returns the value that was added to the "storage_used" field in this mutation.
+func (m *RoomMutation) AddedStorageUsed() (r int64, exists bool) {
+	v := m.addstorage_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStorageUsed resets all changes to the "storage_used" field.
+func (m *RoomMutation) ResetStorageUsed() {
+	m.storage_used = nil
+	m.addstorage_used = nil
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (m *RoomMutation) SetAvatarID(pu pulid.ID) {
+	m.avatar = &pu
+}
+
+// AvatarID returns the value of the "avatar_id" field in the mutation.
+func (m *RoomMutation) AvatarID() (r pulid.ID, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatarID returns the old "avatar_id" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldAvatarID(ctx context.Context) (v *pulid.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatarID: %w", err)
+	}
+	return oldValue.AvatarID, nil
+}
+
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (m *RoomMutation) ClearAvatarID() {
+	m.avatar = nil
+	m.clearedFields[room.FieldAvatarID] = struct{}{}
+}
+
+// AvatarIDCleared returns if the "avatar_id" field was cleared in this mutation.
+func (m *RoomMutation) AvatarIDCleared() bool {
+	_, ok := m.clearedFields[room.FieldAvatarID]
+	return ok
+}
+
+// ResetAvatarID resets all changes to the "avatar_id" field.
+func (m *RoomMutation) ResetAvatarID() {
+	m.avatar = nil
+	delete(m.clearedFields, room.FieldAvatarID)
+}
+
+// SetType sets the "type" field.
+func (m *RoomMutation) SetType(r room.Type) {
+	m._type = &r
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *RoomMutation) GetType() (r room.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldType(ctx context.Context) (v room.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *RoomMutation) ResetType() {
+	m._type = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RoomMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RoomMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RoomMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RoomMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RoomMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RoomMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddUserContactIDs adds the "user_contacts" edge to the UserContact entity by ids.
+func (m *RoomMutation) AddUserContactIDs(ids ...pulid.ID) {
+	if m.user_contacts == nil {
+		m.user_contacts = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.user_contacts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserContacts clears the "user_contacts" edge to the UserContact entity.
+func (m *RoomMutation) ClearUserContacts() {
+	m.cleareduser_contacts = true
+}
+
+// UserContactsCleared reports if the "user_contacts" edge to the UserContact entity was cleared.
+func (m *RoomMutation) UserContactsCleared() bool {
+	return m.cleareduser_contacts
+}
+
+// RemoveUserContactIDs removes the "user_contacts" edge to the UserContact entity by IDs.
+func (m *RoomMutation) RemoveUserContactIDs(ids ...pulid.ID) {
+	if m.removeduser_contacts == nil {
+		m.removeduser_contacts = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.user_contacts, ids[i])
+		m.removeduser_contacts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserContacts returns the removed IDs of the "user_contacts" edge to the UserContact entity.
+func (m *RoomMutation) RemovedUserContactsIDs() (ids []pulid.ID) {
+	for id := range m.removeduser_contacts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserContactsIDs returns the "user_contacts" edge IDs in the mutation.
+func (m *RoomMutation) UserContactsIDs() (ids []pulid.ID) {
+	for id := range m.user_contacts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserContacts resets all changes to the "user_contacts" edge.
+func (m *RoomMutation) ResetUserContacts() {
+	m.user_contacts = nil
+	m.cleareduser_contacts = false
+	m.removeduser_contacts = nil
+}
+
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *RoomMutation) AddUserIDs(ids ...pulid.ID) {
+	if m.users == nil {
+		m.users = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *RoomMutation) ClearUsers() {
+	m.clearedusers = true
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *RoomMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *RoomMutation) RemoveUserIDs(ids ...pulid.ID) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *RoomMutation) RemovedUsersIDs() (ids []pulid.ID) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *RoomMutation) UsersIDs() (ids []pulid.ID) {
+	for id := range m.users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *RoomMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
+}
+
+// SetLastMessageID sets the "last_message" edge to the Message entity by id.
+func (m *RoomMutation) SetLastMessageID(id pulid.ID) {
+	m.last_message = &id
+}
+
+// ClearLastMessage clears the "last_message" edge to the Message entity.
+func (m *RoomMutation) ClearLastMessage() {
+	m.clearedlast_message = true
+}
+
+// LastMessageCleared reports if the "last_message" edge to the Message entity was cleared.
+func (m *RoomMutation) LastMessageCleared() bool {
+	return m.clearedlast_message
+}
+
+// LastMessageID returns the "last_message" edge ID in the mutation.
+func (m *RoomMutation) LastMessageID() (id pulid.ID, exists bool) {
+	if m.last_message != nil {
+		return *m.last_message, true
+	}
+	return
+}
+
+// LastMessageIDs returns the "last_message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LastMessageID instead. It exists only for internal usage by the builders.
+func (m *RoomMutation) LastMessageIDs() (ids []pulid.ID) {
+	if id := m.last_message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLastMessage resets all changes to the "last_message" edge.
+func (m *RoomMutation) ResetLastMessage() {
+	m.last_message = nil
+	m.clearedlast_message = false
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by ids.
+func (m *RoomMutation) AddMessageIDs(ids ...pulid.ID) {
+	if m.messages == nil {
+		m.messages = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessages clears the "messages" edge to the Message entity.
+func (m *RoomMutation) ClearMessages() {
+	m.clearedmessages = true
+}
+
+// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
+func (m *RoomMutation) MessagesCleared() bool {
+	return m.clearedmessages
+}
+
+// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
+func (m *RoomMutation) RemoveMessageIDs(ids ...pulid.ID) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
+func (m *RoomMutation) RemovedMessagesIDs() (ids []pulid.ID) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *RoomMutation) MessagesIDs() (ids []pulid.ID) {
+	for id := range m.messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessages resets all changes to the "messages" edge.
+func (m *RoomMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
+}
+
+// AddMessageVoiceIDs adds the "message_voices" edge to the MessageVoice entity by ids.
+func (m *RoomMutation) AddMessageVoiceIDs(ids ...pulid.ID) {
+	if m.message_voices == nil {
+		m.message_voices = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.message_voices[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessageVoices clears the "message_voices" edge to the MessageVoice entity.
+func (m *RoomMutation) ClearMessageVoices() {
+	m.clearedmessage_voices = true
+}
+
+// MessageVoicesCleared reports if the "message_voices" edge to the MessageVoice entity was cleared.
+func (m *RoomMutation) MessageVoicesCleared() bool {
+	return m.clearedmessage_voices
+}
+
+// RemoveMessageVoiceIDs removes the "message_voices" edge to the MessageVoice entity by IDs.
+func (m *RoomMutation) RemoveMessageVoiceIDs(ids ...pulid.ID) {
+	if m.removedmessage_voices == nil {
+		m.removedmessage_voices = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.message_voices, ids[i])
+		m.removedmessage_voices[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessageVoices returns the removed IDs of the "message_voices" edge to the MessageVoice entity.
+func (m *RoomMutation) RemovedMessageVoicesIDs() (ids []pulid.ID) {
+	for id := range m.removedmessage_voices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessageVoicesIDs returns the "message_voices" edge IDs in the mutation.
+func (m *RoomMutation) MessageVoicesIDs() (ids []pulid.ID) {
+	for id := range m.message_voices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessageVoices resets all changes to the "message_voices" edge.
+func (m *RoomMutation) ResetMessageVoices() {
+	m.message_voices = nil
+	m.clearedmessage_voices = false
+	m.removedmessage_voices = nil
+}
+
+// AddMessageAttachmentIDs adds the "message_attachments" edge to the MessageAttachment entity by ids.
+func (m *RoomMutation) AddMessageAttachmentIDs(ids ...pulid.ID) {
+	if m.message_attachments == nil {
+		m.message_attachments = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.message_attachments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessageAttachments clears the "message_attachments" edge to the MessageAttachment entity.
+func (m *RoomMutation) ClearMessageAttachments() {
+	m.clearedmessage_attachments = true
+}
+
+// MessageAttachmentsCleared reports if the "message_attachments" edge to the MessageAttachment entity was cleared.
+func (m *RoomMutation) MessageAttachmentsCleared() bool {
+	return m.clearedmessage_attachments
+}
+
+// RemoveMessageAttachmentIDs removes the "message_attachments" edge to the MessageAttachment entity by IDs.
+func (m *RoomMutation) RemoveMessageAttachmentIDs(ids ...pulid.ID) {
+	if m.removedmessage_attachments == nil {
+		m.removedmessage_attachments = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.message_attachments, ids[i])
+		m.removedmessage_attachments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessageAttachments returns the removed IDs of the "message_attachments" edge to the MessageAttachment entity.
+func (m *RoomMutation) RemovedMessageAttachmentsIDs() (ids []pulid.ID) {
+	for id := range m.removedmessage_attachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessageAttachmentsIDs returns the "message_attachments" edge IDs in the mutation.
+func (m *RoomMutation) MessageAttachmentsIDs() (ids []pulid.ID) {
+	for id := range m.message_attachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessageAttachments resets all changes to the "message_attachments" edge.
+func (m *RoomMutation) ResetMessageAttachments() {
+	m.message_attachments = nil
+	m.clearedmessage_attachments = false
+	m.removedmessage_attachments = nil
+}
+
+// AddMessageLinkIDs adds the "message_links" edge to the MessageLink entity by ids.
+func (m *RoomMutation) AddMessageLinkIDs(ids ...pulid.ID) {
+	if m.message_links == nil {
+		m.message_links = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.message_links[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessageLinks clears the "message_links" edge to the MessageLink entity.
+func (m *RoomMutation) ClearMessageLinks() {
+	m.clearedmessage_links = true
+}
+
+// MessageLinksCleared reports if the "message_links" edge to the MessageLink entity was cleared.
+func (m *RoomMutation) MessageLinksCleared() bool {
+	return m.clearedmessage_links
+}
+
+// RemoveMessageLinkIDs removes the "message_links" edge to the MessageLink entity by IDs.
+func (m *RoomMutation) RemoveMessageLinkIDs(ids ...pulid.ID) {
+	if m.removedmessage_links == nil {
+		m.removedmessage_links = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.message_links, ids[i])
+		m.removedmessage_links[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessageLinks returns the removed IDs of the "message_links" edge to the MessageLink entity.
+func (m *RoomMutation) RemovedMessageLinksIDs() (ids []pulid.ID) {
+	for id := range m.removedmessage_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessageLinksIDs returns the "message_links" edge IDs in the mutation.
+func (m *RoomMutation) MessageLinksIDs() (ids []pulid.ID) {
+	for id := range m.message_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessageLinks resets all changes to the "message_links" edge.
+func (m *RoomMutation) ResetMessageLinks() {
+	m.message_links = nil
+	m.clearedmessage_links = false
+	m.removedmessage_links = nil
+}
+
+// AddFileIDs adds the "files" edge to the File entity by ids.
+func (m *RoomMutation) AddFileIDs(ids ...pulid.ID) {
+	if m.files == nil {
+		m.files = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.files[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFiles clears the "files" edge to the File entity.
+func (m *RoomMutation) ClearFiles() {
+	m.clearedfiles = true
+}
+
+// FilesCleared reports if the "files" edge to the File entity was cleared.
+func (m *RoomMutation) FilesCleared() bool {
+	return m.clearedfiles
+}
+
+// RemoveFileIDs removes the "files" edge to the File entity by IDs.
+func (m *RoomMutation) RemoveFileIDs(ids ...pulid.ID) {
+	if m.removedfiles == nil {
+		m.removedfiles = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.files, ids[i])
+		m.removedfiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
+func (m *RoomMutation) RemovedFilesIDs() (ids []pulid.ID) {
+	for id := range m.removedfiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FilesIDs returns the "files" edge IDs in the mutation.
+func (m *RoomMutation) FilesIDs() (ids []pulid.ID) {
+	for id := range m.files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFiles resets all changes to the "files" edge.
+func (m *RoomMutation) ResetFiles() {
+	m.files = nil
+	m.clearedfiles = false
+	m.removedfiles = nil
+}
+
+// ClearAvatar clears the "avatar" edge to the File entity.
+func (m *RoomMutation) ClearAvatar() {
+	m.clearedavatar = true
+	m.clearedFields[room.FieldAvatarID] = struct{}{}
+}
+
+// AvatarCleared reports if the "avatar" edge to the File entity was cleared.
+func (m *RoomMutation) AvatarCleared() bool {
+	return m.AvatarIDCleared() || m.clearedavatar
+}
+
+// AvatarIDs returns the "avatar" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AvatarID instead. It exists only for internal usage by the builders.
+func (m *RoomMutation) AvatarIDs() (ids []pulid.ID) {
+	if id := m.avatar; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAvatar resets all changes to the "avatar" edge.
+func (m *RoomMutation) ResetAvatar() {
+	m.avatar = nil
+	m.clearedavatar = false
+}
+
+// AddAvatarIDs adds the "avatars" edge to the Avatar entity by ids.
+func (m *RoomMutation) AddAvatarIDs(ids ...pulid.ID) {
+	if m.avatars == nil {
+		m.avatars = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.avatars[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAvatars clears the "avatars" edge to the Avatar entity.
+func (m *RoomMutation) ClearAvatars() {
+	m.clearedavatars = true
+}
+
+// AvatarsCleared reports if the "avatars" edge to the Avatar entity was cleared.
+func (m *RoomMutation) AvatarsCleared() bool {
+	return m.clearedavatars
+}
+
+// RemoveAvatarIDs removes the "avatars" edge to the Avatar entity by IDs.
+func (m *RoomMutation) RemoveAvatarIDs(ids ...pulid.ID) {
+	if m.removedavatars == nil {
+		m.removedavatars = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.avatars, ids[i])
+		m.removedavatars[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAvatars returns the removed IDs of the "avatars" edge to the Avatar entity.
+func (m *RoomMutation) RemovedAvatarsIDs() (ids []pulid.ID) {
+	for id := range m.removedavatars {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AvatarsIDs returns the "avatars" edge IDs in the mutation.
+func (m *RoomMutation) AvatarsIDs() (ids []pulid.ID) {
+	for id := range m.avatars {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAvatars resets all changes to the "avatars" edge.
+func (m *RoomMutation) ResetAvatars() {
+	m.avatars = nil
+	m.clearedavatars = false
+	m.removedavatars = nil
+}
+
+// AddRoomMemberIDs adds the "room_members" edge to the RoomMember entity by ids.
+func (m *RoomMutation) AddRoomMemberIDs(ids ...pulid.ID) {
+	if m.room_members == nil {
+		m.room_members = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.room_members[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRoomMembers clears the "room_members" edge to the RoomMember entity.
+func (m *RoomMutation) ClearRoomMembers() {
+	m.clearedroom_members = true
+}
+
+// RoomMembersCleared reports if the "room_members" edge to the RoomMember entity was cleared.
+func (m *RoomMutation) RoomMembersCleared() bool {
+	return m.clearedroom_members
+}
+
+// RemoveRoomMemberIDs removes the "room_members" edge to the RoomMember entity by IDs.
+func (m *RoomMutation) RemoveRoomMemberIDs(ids ...pulid.ID) {
+	if m.removedroom_members == nil {
+		m.removedroom_members = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.room_members, ids[i])
+		m.removedroom_members[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRoomMembers returns the removed IDs of the "room_members" edge to the RoomMember entity.
+func (m *RoomMutation) RemovedRoomMembersIDs() (ids []pulid.ID) {
+	for id := range m.removedroom_members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RoomMembersIDs returns the "room_members" edge IDs in the mutation.
+func (m *RoomMutation) RoomMembersIDs() (ids []pulid.ID) {
+	for id := range m.room_members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRoomMembers resets all changes to the "room_members" edge.
+func (m *RoomMutation) ResetRoomMembers() {
+	m.room_members = nil
+	m.clearedroom_members = false
+	m.removedroom_members = nil
+}
+
+// Where appends a list predicates to the RoomMutation builder.
+func (m *RoomMutation) Where(ps ...predicate.Room) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoomMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoomMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Room, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoomMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoomMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Room).
+func (m *RoomMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoomMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.deleted_at != nil {
+		fields = append(fields, room.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, room.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, room.FieldDescription)
+	}
+	if m.version != nil {
+		fields = append(fields, room.FieldVersion)
+	}
+	if m.storage_used != nil {
+		fields = append(fields, room.FieldStorageUsed)
+	}
+	if m.avatar != nil {
+		fields = append(fields, room.FieldAvatarID)
+	}
+	if m._type != nil {
+		fields = append(fields, room.FieldType)
+	}
+	if m.created_at != nil {
+		fields = append(fields, room.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, room.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoomMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case room.FieldDeletedAt:
+		return m.DeletedAt()
+	case room.FieldName:
+		return m.Name()
+	case room.FieldDescription:
+		return m.Description()
+	case room.FieldVersion:
+		return m.Version()
+	case room.FieldStorageUsed:
+		return m.StorageUsed()
+	case room.FieldAvatarID:
+		return m.AvatarID()
+	case room.FieldType:
+		return m.GetType()
+	case room.FieldCreatedAt:
+		return m.CreatedAt()
+	case room.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoomMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case room.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case room.FieldName:
+		return m.OldName(ctx)
+	case room.FieldDescription:
+		return m.OldDescription(ctx)
+	case room.FieldVersion:
+		return m.OldVersion(ctx)
+	case room.FieldStorageUsed:
+		return m.OldStorageUsed(ctx)
+	case room.FieldAvatarID:
+		return m.OldAvatarID(ctx)
+	case room.FieldType:
+		return m.OldType(ctx)
+	case room.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case room.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Room field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case room.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case room.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case room.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case room.FieldVersion:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case room.FieldStorageUsed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageUsed(v)
+		return nil
+	case room.FieldAvatarID:
+		v, ok := value.(pulid.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatarID(v)
+		return nil
+	case room.FieldType:
+		v, ok := value.(room.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case room.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case room.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Room field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoomMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, room.FieldVersion)
+	}
+	if m.addstorage_used != nil {
+		fields = append(fields, room.FieldStorageUsed)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoomMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case room.FieldVersion:
+		return m.AddedVersion()
+	case room.FieldStorageUsed:
+		return m.AddedStorageUsed()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case room.FieldVersion:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	case room.FieldStorageUsed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStorageUsed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Room numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoomMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(room.FieldDeletedAt) {
+		fields = append(fields, room.FieldDeletedAt)
+	}
+	if m.FieldCleared(room.FieldName) {
+		fields = append(fields, room.FieldName)
+	}
+	if m.FieldCleared(room.FieldDescription) {
+		fields = append(fields, room.FieldDescription)
+	}
+	if m.FieldCleared(room.FieldAvatarID) {
+		fields = append(fields, room.FieldAvatarID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoomMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoomMutation) ClearField(name string) error {
+	switch name {
+	case room.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case room.FieldName:
+		m.ClearName()
+		return nil
+	case room.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case room.FieldAvatarID:
+		m.ClearAvatarID()
+		return nil
+	}
+	return fmt.Errorf("unknown Room nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoomMutation) ResetField(name string) error {
+	switch name {
+	case room.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case room.FieldName:
+		m.ResetName()
+		return nil
+	case room.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case room.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case room.FieldStorageUsed:
+		m.ResetStorageUsed()
+		return nil
+	case room.FieldAvatarID:
+		m.ResetAvatarID()
+		return nil
+	case room.FieldType:
+		m.ResetType()
+		return nil
+	case room.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case room.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Room field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoomMutation) AddedEdges() []string {
+	edges := make([]string, 0, 11)
+	if m.user_contacts != nil {
+		edges = append(edges, room.EdgeUserContacts)
+	}
+	if m.users != nil {
+		edges = append(edges, room.EdgeUsers)
+	}
+	if m.last_message != nil {
+		edges = append(edges, room.EdgeLastMessage)
+	}
+	if m.messages != nil {
+		edges = append(edges, room.EdgeMessages)
+	}
+	if m.message_voices != nil {
+		edges = append(edges, room.EdgeMessageVoices)
+	}
+	if m.message_attachments != nil {
+		edges = append(edges, room.EdgeMessageAttachments)
+	}
+	if m.message_links != nil {
+		edges = append(edges, room.EdgeMessageLinks)
+	}
+	if m.files != nil {
+		edges = append(edges, room.EdgeFiles)
+	}
+	if m.avatar != nil {
+		edges = append(edges, room.EdgeAvatar)
+	}
+	if m.avatars != nil {
+		edges = append(edges, room.EdgeAvatars)
+	}
+	if m.room_members != nil {
+		edges = append(edges, room.EdgeRoomMembers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoomMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case room.EdgeUserContacts:
+		ids := make([]ent.Value, 0, len(m.user_contacts))
+		for id := range m.user_contacts {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeLastMessage:
+		if id := m.last_message; id != nil {
+			return []ent.Value{*id}
+		}
+	case room.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.messages))
+		for id := range m.messages {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeMessageVoices:
+		ids := make([]ent.Value, 0, len(m.message_voices))
+		for id := range m.message_voices {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeMessageAttachments:
+		ids := make([]ent.Value, 0, len(m.message_attachments))
+		for id := range m.message_attachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeMessageLinks:
+		ids := make([]ent.Value, 0, len(m.message_links))
+		for id := range m.message_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.files))
+		for id := range m.files {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeAvatar:
+		if id := m.avatar; id != nil {
+			return []ent.Value{*id}
+		}
+	case room.EdgeAvatars:
+		ids := make([]ent.Value, 0, len(m.avatars))
+		for id := range m.avatars {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeRoomMembers:
+		ids := make([]ent.Value, 0, len(m.room_members))
+		for id := range m.room_members {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoomMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 11)
+	if m.removeduser_contacts != nil {
+		edges = append(edges, room.EdgeUserContacts)
+	}
+	if m.removedusers != nil {
+		edges = append(edges, room.EdgeUsers)
+	}
+	if m.removedmessages != nil {
+		edges = append(edges, room.EdgeMessages)
+	}
+	if m.removedmessage_voices != nil {
+		edges = append(edges, room.EdgeMessageVoices)
+	}
+	if m.removedmessage_attachments != nil {
+		edges = append(edges, room.EdgeMessageAttachments)
+	}
+	if m.removedmessage_links != nil {
+		edges = append(edges, room.EdgeMessageLinks)
+	}
+	if m.removedfiles != nil {
+		edges = append(edges, room.EdgeFiles)
+	}
+	if m.removedavatars != nil {
+		edges = append(edges, room.EdgeAvatars)
+	}
+	if m.removedroom_members != nil {
+		edges = append(edges, room.EdgeRoomMembers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoomMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case room.EdgeUserContacts:
+		ids := make([]ent.Value, 0, len(m.removeduser_contacts))
+		for id := range m.removeduser_contacts {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.removedmessages))
+		for id := range m.removedmessages {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeMessageVoices:
+		ids := make([]ent.Value, 0, len(m.removedmessage_voices))
+		for id := range m.removedmessage_voices {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeMessageAttachments:
+		ids := make([]ent.Value, 0, len(m.removedmessage_attachments))
+		for id := range m.removedmessage_attachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeMessageLinks:
+		ids := make([]ent.Value, 0, len(m.removedmessage_links))
+		for id := range m.removedmessage_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.removedfiles))
+		for id := range m.removedfiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeAvatars:
+		ids := make([]ent.Value, 0, len(m.removedavatars))
+		for id := range m.removedavatars {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeRoomMembers:
+		ids := make([]ent.Value, 0, len(m.removedroom_members))
+		for id := range m.removedroom_members {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoomMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 11)
+	if m.cleareduser_contacts {
+		edges = append(edges, room.EdgeUserContacts)
+	}
+	if m.clearedusers {
+		edges = append(edges, room.EdgeUsers)
+	}
+	if m.clearedlast_message {
+		edges = append(edges, room.EdgeLastMessage)
+	}
+	if m.clearedmessages {
+		edges = append(edges, room.EdgeMessages)
+	}
+	if m.clearedmessage_voices {
+		edges = append(edges, room.EdgeMessageVoices)
+	}
+	if m.clearedmessage_attachments {
+		edges = append(edges, room.EdgeMessageAttachments)
+	}
+	if m.clearedmessage_links {
+		edges = append(edges, room.EdgeMessageLinks)
+	}
+	if m.clearedfiles {
+		edges = append(edges, room.EdgeFiles)
+	}
+	if m.clearedavatar {
+		edges = append(edges, room.EdgeAvatar)
+	}
+	if m.clearedavatars {
+		edges = append(edges, room.EdgeAvatars)
+	}
+	if m.clearedroom_members {
+		edges = append(edges, room.EdgeRoomMembers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoomMutation) EdgeCleared(name string) bool {
+	switch name {
+	case room.EdgeUserContacts:
+		return m.cleareduser_contacts
+	case room.EdgeUsers:
+		return m.clearedusers
+	case room.EdgeLastMessage:
+		return m.clearedlast_message
+	case room.EdgeMessages:
+		return m.clearedmessages
+	case room.EdgeMessageVoices:
+		return m.clearedmessage_voices
+	case room.EdgeMessageAttachments:
+		return m.clearedmessage_attachments
+	case room.EdgeMessageLinks:
+		return m.clearedmessage_links
+	case room.EdgeFiles:
+		return m.clearedfiles
+	case room.EdgeAvatar:
+		return m.clearedavatar
+	case room.EdgeAvatars:
+		return m.clearedavatars
+	case room.EdgeRoomMembers:
+		return m.clearedroom_members
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoomMutation) ClearEdge(name string) error {
+	switch name {
+	case room.EdgeLastMessage:
+		m.ClearLastMessage()
+		return nil
+	case room.EdgeAvatar:
+		m.ClearAvatar()
+		return nil
+	}
+	return fmt.Errorf("unknown Room unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoomMutation) ResetEdge(name string) error {
+	switch name {
+	case room.EdgeUserContacts:
+		m.ResetUserContacts()
+		return nil
+	case room.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	case room.EdgeLastMessage:
+		m.ResetLastMessage()
+		return nil
+	case room.EdgeMessages:
+		m.ResetMessages()
+		return nil
+	case room.EdgeMessageVoices:
+		m.ResetMessageVoices()
+		return nil
+	case room.EdgeMessageAttachments:
+		m.ResetMessageAttachments()
+		return nil
+	case room.EdgeMessageLinks:
+		m.ResetMessageLinks()
+		return nil
+	case room.EdgeFiles:
+		m.ResetFiles()
+		return nil
+	case room.EdgeAvatar:
+		m.ResetAvatar()
+		return nil
+	case room.EdgeAvatars:
+		m.ResetAvatars()
+		return nil
+	case room.EdgeRoomMembers:
+		m.ResetRoomMembers()
+		return nil
+	}
+	return fmt.Errorf("unknown Room edge %s", name)
+}
+
+// RoomInviteMutation represents an operation that mutates the RoomInvite nodes in the graph.
+type RoomInviteMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *pulid.ID
+	token          *string
+	expires_at     *time.Time
+	max_uses       *int
+	addmax_uses    *int
+	uses           *int
+	adduses        *int
+	revoked_at     *time.Time
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	room           *pulid.ID
+	clearedroom    bool
+	creator        *pulid.ID
+	clearedcreator bool
+	done           bool
+	oldValue       func(context.Context) (*RoomInvite, error)
+	predicates     []predicate.RoomInvite
+}
+
+var _ ent.Mutation = (*RoomInviteMutation)(nil)
+
+// roominviteOption allows management of the mutation configuration using functional options.
+type roominviteOption func(*RoomInviteMutation)
+
+// newRoomInviteMutation creates new mutation for the RoomInvite entity.
+func newRoomInviteMutation(c config, op Op, opts ...roominviteOption) *RoomInviteMutation {
+	m := &RoomInviteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoomInvite,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoomInviteID sets the ID field of the mutation.
+func withRoomInviteID(id pulid.ID) roominviteOption {
+	return func(m *RoomInviteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RoomInvite
+		)
+		m.oldValue = func(ctx context.Context) (*RoomInvite, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RoomInvite.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoomInvite sets the old RoomInvite of the mutation.
+func withRoomInvite(node *RoomInvite) roominviteOption {
+	return func(m *RoomInviteMutation) {
+		m.oldValue = func(context.Context) (*RoomInvite, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoomInviteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoomInviteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RoomInvite entities.
+func (m *RoomInviteMutation) SetID(id pulid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoomInviteMutation) ID() (id pulid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoomInviteMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []pulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RoomInvite.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetToken sets the "token" field.
+func (m *RoomInviteMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *RoomInviteMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the RoomInvite entity.
+// If the RoomInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomInviteMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *RoomInviteMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetRoomID sets the "room_id" field.
+func (m *RoomInviteMutation) SetRoomID(pu pulid.ID) {
+	m.room = &pu
+}
+
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *RoomInviteMutation) RoomID() (r pulid.ID, exists bool) {
+	v := m.room
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoomID returns the old "room_id" field's value of the RoomInvite entity.
+// If the RoomInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomInviteMutation) OldRoomID(ctx context.Context) (v pulid.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
+	}
+	return oldValue.RoomID, nil
+}
+
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *RoomInviteMutation) ResetRoomID() {
+	m.room = nil
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (m *RoomInviteMutation) SetCreatorID(pu pulid.ID) {
+	m.creator = &pu
+}
+
+// CreatorID returns the value of the "creator_id" field in the mutation.
+func (m *RoomInviteMutation) CreatorID() (r pulid.ID, exists bool) {
+	v := m.creator
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatorID returns the old "creator_id" field's value of the RoomInvite entity.
+// If the RoomInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomInviteMutation) OldCreatorID(ctx context.Context) (v pulid.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatorID: %w", err)
+	}
+	return oldValue.CreatorID, nil
+}
+
+// ResetCreatorID resets all changes to the "creator_id" field.
+func (m *RoomInviteMutation) ResetCreatorID() {
+	m.creator = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *RoomInviteMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *RoomInviteMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the RoomInvite entity.
+// If the RoomInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomInviteMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *RoomInviteMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[roominvite.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *RoomInviteMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[roominvite.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *RoomInviteMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, roominvite.FieldExpiresAt)
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (m *RoomInviteMutation) SetMaxUses(i int) {
+	m.max_uses = &i
+	m.addmax_uses = nil
+}
+
+// MaxUses returns the value of the "max_uses" field in the mutation.
+func (m *RoomInviteMutation) MaxUses() (r int, exists bool) {
+	v := m.max_uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxUses returns the old "max_uses" field's value of the RoomInvite entity.
+// If the RoomInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomInviteMutation) OldMaxUses(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxUses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxUses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxUses: %w", err)
+	}
+	return oldValue.MaxUses, nil
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (m *RoomInviteMutation) AddMaxUses(i int) {
+	if m.addmax_uses != nil {
+		*m.addmax_uses += i
+	} else {
+		m.addmax_uses = &i
+	}
+}
+
+// AddedMaxUses returns the value that was added to the "max_uses" field in this mutation.
+func (m *RoomInviteMutation) AddedMaxUses() (r int, exists bool) {
+	v := m.addmax_uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (m *RoomInviteMutation) ClearMaxUses() {
+	m.max_uses = nil
+	m.addmax_uses = nil
+	m.clearedFields[roominvite.FieldMaxUses] = struct{}{}
+}
+
+// MaxUsesCleared returns if the "max_uses" field was cleared in this mutation.
+func (m *RoomInviteMutation) MaxUsesCleared() bool {
+	_, ok := m.clearedFields[roominvite.FieldMaxUses]
+	return ok
+}
+
+// ResetMaxUses resets all changes to the "max_uses" field.
+func (m *RoomInviteMutation) ResetMaxUses() {
+	m.max_uses = nil
+	m.addmax_uses = nil
+	delete(m.clearedFields, roominvite.FieldMaxUses)
+}
+
+// SetUses sets the "uses" field.
+func (m *RoomInviteMutation) SetUses(i int) {
+	m.uses = &i
+	m.adduses = nil
+}
+
+// Uses returns the value of the "uses" field in the mutation.
+func (m *RoomInviteMutation) Uses() (r int, exists bool) {
+	v := m.uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUses returns the old "uses" field's value of the RoomInvite entity.
+// If the RoomInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomInviteMutation) OldUses(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUses: %w", err)
+	}
+	return oldValue.Uses, nil
+}
+
+// AddUses adds i to the "uses" field.
+func (m *RoomInviteMutation) AddUses(i int) {
+	if m.adduses != nil {
+		*m.adduses += i
+	} else {
+		m.adduses = &i
+	}
+}
+
+// AddedUses returns the value that was added to the "uses" field in this mutation.
+func (m *RoomInviteMutation) AddedUses() (r int, exists bool) {
+	v := m.adduses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUses resets all changes to the "uses" field.
+func (m *RoomInviteMutation) ResetUses() {
+	m.uses = nil
+	m.adduses = nil
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *RoomInviteMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *RoomInviteMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the RoomInvite entity.
+// If the RoomInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomInviteMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *RoomInviteMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[roominvite.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *RoomInviteMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[roominvite.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *RoomInviteMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, roominvite.FieldRevokedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RoomInviteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RoomInviteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RoomInvite entity.
+// If the RoomInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomInviteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RoomInviteMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (m *RoomInviteMutation) ClearRoom() {
+	m.clearedroom = true
+	m.clearedFields[roominvite.FieldRoomID] = struct{}{}
+}
+
+// RoomCleared reports if the "room" edge to the Room entity was cleared.
+func (m *RoomInviteMutation) RoomCleared() bool {
+	return m.clearedroom
+}
+
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *RoomInviteMutation) RoomIDs() (ids []pulid.ID) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoom resets all changes to the "room" edge.
+func (m *RoomInviteMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (m *RoomInviteMutation) ClearCreator() {
+	m.clearedcreator = true
+	m.clearedFields[roominvite.FieldCreatorID] = struct{}{}
+}
+
+// CreatorCleared reports if the "creator" edge to the User entity was cleared.
+func (m *RoomInviteMutation) CreatorCleared() bool {
+	return m.clearedcreator
+}
+
+// CreatorIDs returns the "creator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreatorID instead. It exists only for internal usage by the builders.
+func (m *RoomInviteMutation) CreatorIDs() (ids []pulid.ID) {
+	if id := m.creator; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCreator resets all changes to the "creator" edge.
+func (m *RoomInviteMutation) ResetCreator() {
+	m.creator = nil
+	m.clearedcreator = false
+}
+
+// Where appends a list predicates to the RoomInviteMutation builder.
+func (m *RoomInviteMutation) Where(ps ...predicate.RoomInvite) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoomInviteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoomInviteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoomInvite, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoomInviteMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoomInviteMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RoomInvite).
+func (m *RoomInviteMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoomInviteMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.token != nil {
+		fields = append(fields, roominvite.FieldToken)
+	}
+	if m.room != nil {
+		fields = append(fields, roominvite.FieldRoomID)
+	}
+	if m.creator != nil {
+		fields = append(fields, roominvite.FieldCreatorID)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, roominvite.FieldExpiresAt)
+	}
+	if m.max_uses != nil {
+		fields = append(fields, roominvite.FieldMaxUses)
+	}
+	if m.uses != nil {
+		fields = append(fields, roominvite.FieldUses)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, roominvite.FieldRevokedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, roominvite.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoomInviteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case roominvite.FieldToken:
+		return m.Token()
+	case roominvite.FieldRoomID:
+		return m.RoomID()
+	case roominvite.FieldCreatorID:
+		return m.CreatorID()
+	case roominvite.FieldExpiresAt:
+		return m.ExpiresAt()
+	case roominvite.FieldMaxUses:
+		return m.MaxUses()
+	case roominvite.FieldUses:
+		return m.Uses()
+	case roominvite.FieldRevokedAt:
+		return m.RevokedAt()
+	case roominvite.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoomInviteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case roominvite.FieldToken:
+		return m.OldToken(ctx)
+	case roominvite.FieldRoomID:
+		return m.OldRoomID(ctx)
+	case roominvite.FieldCreatorID:
+		return m.OldCreatorID(ctx)
+	case roominvite.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case roominvite.FieldMaxUses:
+		return m.OldMaxUses(ctx)
+	case roominvite.FieldUses:
+		return m.OldUses(ctx)
+	case roominvite.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case roominvite.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RoomInvite field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomInviteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case roominvite.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case roominvite.FieldRoomID:
+		v, ok := value.(pulid.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomID(v)
+		return nil
+	case roominvite.FieldCreatorID:
+		v, ok := value.(pulid.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatorID(v)
+		return nil
+	case roominvite.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case roominvite.FieldMaxUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxUses(v)
+		return nil
+	case roominvite.FieldUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUses(v)
+		return nil
+	case roominvite.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case roominvite.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoomInvite field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoomInviteMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_uses != nil {
+		fields = append(fields, roominvite.FieldMaxUses)
+	}
+	if m.adduses != nil {
+		fields = append(fields, roominvite.FieldUses)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoomInviteMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case roominvite.FieldMaxUses:
+		return m.AddedMaxUses()
+	case roominvite.FieldUses:
+		return m.AddedUses()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomInviteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case roominvite.FieldMaxUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxUses(v)
+		return nil
+	case roominvite.FieldUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUses(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoomInvite numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoomInviteMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(roominvite.FieldExpiresAt) {
+		fields = append(fields, roominvite.FieldExpiresAt)
+	}
+	if m.FieldCleared(roominvite.FieldMaxUses) {
+		fields = append(fields, roominvite.FieldMaxUses)
+	}
+	if m.FieldCleared(roominvite.FieldRevokedAt) {
+		fields = append(fields, roominvite.FieldRevokedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoomInviteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoomInviteMutation) ClearField(name string) error {
+	switch name {
+	case roominvite.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case roominvite.FieldMaxUses:
+		m.ClearMaxUses()
+		return nil
+	case roominvite.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RoomInvite nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoomInviteMutation) ResetField(name string) error {
+	switch name {
+	case roominvite.FieldToken:
+		m.ResetToken()
+		return nil
+	case roominvite.FieldRoomID:
+		m.ResetRoomID()
+		return nil
+	case roominvite.FieldCreatorID:
+		m.ResetCreatorID()
+		return nil
+	case roominvite.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case roominvite.FieldMaxUses:
+		m.ResetMaxUses()
+		return nil
+	case roominvite.FieldUses:
+		m.ResetUses()
+		return nil
+	case roominvite.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case roominvite.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RoomInvite field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoomInviteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.room != nil {
+		edges = append(edges, roominvite.EdgeRoom)
+	}
+	if m.creator != nil {
+		edges = append(edges, roominvite.EdgeCreator)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoomInviteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case roominvite.EdgeRoom:
+		if id := m.room; id != nil {
+			return []ent.Value{*id}
+		}
+	case roominvite.EdgeCreator:
+		if id := m.creator; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoomInviteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoomInviteMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoomInviteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedroom {
+		edges = append(edges, roominvite.EdgeRoom)
+	}
+	if m.clearedcreator {
+		edges = append(edges, roominvite.EdgeCreator)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoomInviteMutation) EdgeCleared(name string) bool {
+	switch name {
+	case roominvite.EdgeRoom:
+		return m.clearedroom
+	case roominvite.EdgeCreator:
+		return m.clearedcreator
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoomInviteMutation) ClearEdge(name string) error {
+	switch name {
+	case roominvite.EdgeRoom:
+		m.ClearRoom()
+		return nil
+	case roominvite.EdgeCreator:
+		m.ClearCreator()
+		return nil
+	}
+	return fmt.Errorf("unknown RoomInvite unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoomInviteMutation) ResetEdge(name string) error {
+	switch name {
+	case roominvite.EdgeRoom:
+		m.ResetRoom()
+		return nil
+	case roominvite.EdgeCreator:
+		m.ResetCreator()
+		return nil
+	}
+	return fmt.Errorf("unknown RoomInvite edge %s", name)
+}
+
+// RoomMemberMutation represents an operation that mutates the RoomMember nodes in the graph.
+type RoomMemberMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *pulid.ID
+	deleted_at               *time.Time
+	name                     *string
+	unread_messages_count    *int
+	addunread_messages_count *int
+	notification_level       *roommember.NotificationLevel
+	mute_until               *time.Time
+	role                     *roommember.Role
+	banned_at                *time.Time
+	joined_at                *time.Time
+	updated_at               *time.Time
+	clearedFields            map[string]struct{}
+	user                     *pulid.ID
+	cleareduser              bool
+	room                     *pulid.ID
+	clearedroom              bool
+	done                     bool
+	oldValue                 func(context.Context) (*RoomMember, error)
+	predicates               []predicate.RoomMember
+}
+
+var _ ent.Mutation = (*RoomMemberMutation)(nil)
+
+// roommemberOption allows management of the mutation configuration using functional options.
+type roommemberOption func(*RoomMemberMutation)
+
+// newRoomMemberMutation creates new mutation for the RoomMember entity.
+func newRoomMemberMutation(c config, op Op, opts ...roommemberOption) *RoomMemberMutation {
+	m := &RoomMemberMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoomMember,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoomMemberID sets the ID field of the mutation.
+func withRoomMemberID(id pulid.ID) roommemberOption {
+	return func(m *RoomMemberMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RoomMember
+		)
+		m.oldValue = func(ctx context.Context) (*RoomMember, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RoomMember.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoomMember sets the old RoomMember of the mutation.
+func withRoomMember(node *RoomMember) roommemberOption {
+	return func(m *RoomMemberMutation) {
+		m.oldValue = func(context.Context) (*RoomMember, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoomMemberMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoomMemberMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RoomMember entities.
+func (m *RoomMemberMutation) SetID(id pulid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoomMemberMutation) ID() (id pulid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoomMemberMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []pulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RoomMember.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *RoomMemberMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *RoomMemberMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *RoomMemberMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[roommember.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *RoomMemberMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[roommember.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *RoomMemberMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, roommember.FieldDeletedAt)
+}
+
+// SetName sets the "name" field.
+func (m *RoomMemberMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RoomMemberMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *RoomMemberMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[roommember.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *RoomMemberMutation) NameCleared() bool {
+	_, ok := m.clearedFields[roommember.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RoomMemberMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, roommember.FieldName)
+}
+
+// SetUnreadMessagesCount sets the "unread_messages_count" field.
+func (m *RoomMemberMutation) SetUnreadMessagesCount(i int) {
+	m.unread_messages_count = &i
+	m.addunread_messages_count = nil
+}
+
+// UnreadMessagesCount returns the value of the "unread_messages_count" field in the mutation.
+func (m *RoomMemberMutation) UnreadMessagesCount() (r int, exists bool) {
+	v := m.unread_messages_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnreadMessagesCount returns the old "unread_messages_count" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldUnreadMessagesCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnreadMessagesCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnreadMessagesCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnreadMessagesCount: %w", err)
+	}
+	return oldValue.UnreadMessagesCount, nil
+}
+
+// AddUnreadMessagesCount adds i to the "unread_messages_count" field.
+func (m *RoomMemberMutation) AddUnreadMessagesCount(i int) {
+	if m.addunread_messages_count != nil {
+		*m.addunread_messages_count += i
+	} else {
+		m.addunread_messages_count = &i
+	}
+}
+
+// AddedUnreadMessagesCount returns the value that was added to the "unread_messages_count" field in this mutation.
+func (m *RoomMemberMutation) AddedUnreadMessagesCount() (r int, exists bool) {
+	v := m.addunread_messages_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUnreadMessagesCount resets all changes to the "unread_messages_count" field.
+func (m *RoomMemberMutation) ResetUnreadMessagesCount() {
+	m.unread_messages_count = nil
+	m.addunread_messages_count = nil
+}
+
+// SetNotificationLevel sets the "notification_level" field.
+func (m *RoomMemberMutation) SetNotificationLevel(rl roommember.NotificationLevel) {
+	m.notification_level = &rl
+}
+
+// NotificationLevel returns the value of the "notification_level" field in the mutation.
+func (m *RoomMemberMutation) NotificationLevel() (r roommember.NotificationLevel, exists bool) {
+	v := m.notification_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotificationLevel returns the old "notification_level" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldNotificationLevel(ctx context.Context) (v roommember.NotificationLevel, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotificationLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotificationLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotificationLevel: %w", err)
+	}
+	return oldValue.NotificationLevel, nil
+}
+
+// ResetNotificationLevel resets all changes to the "notification_level" field.
+func (m *RoomMemberMutation) ResetNotificationLevel() {
+	m.notification_level = nil
+}
+
+// SetMuteUntil sets the "mute_until" field.
+func (m *RoomMemberMutation) SetMuteUntil(t time.Time) {
+	m.mute_until = &t
+}
+
+// MuteUntil returns the value of the "mute_until" field in the mutation.
+func (m *RoomMemberMutation) MuteUntil() (r time.Time, exists bool) {
+	v := m.mute_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMuteUntil returns the old "mute_until" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldMuteUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMuteUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMuteUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMuteUntil: %w", err)
+	}
+	return oldValue.MuteUntil, nil
+}
+
+// ClearMuteUntil clears the value of the "mute_until" field.
+func (m *RoomMemberMutation) ClearMuteUntil() {
+	m.mute_until = nil
+	m.clearedFields[roommember.FieldMuteUntil] = struct{}{}
+}
+
+// MuteUntilCleared returns if the "mute_until" field was cleared in this mutation.
+func (m *RoomMemberMutation) MuteUntilCleared() bool {
+	_, ok := m.clearedFields[roommember.FieldMuteUntil]
+	return ok
+}
+
+// ResetMuteUntil resets all changes to the "mute_until" field.
+func (m *RoomMemberMutation) ResetMuteUntil() {
+	m.mute_until = nil
+	delete(m.clearedFields, roommember.FieldMuteUntil)
+}
+
+// SetRole sets the "role" field.
+func (m *RoomMemberMutation) SetRole(r roommember.Role) {
+	m.role = &r
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *RoomMemberMutation) Role() (r roommember.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldRole(ctx context.Context) (v roommember.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *RoomMemberMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetBannedAt sets the "banned_at" field.
+func (m *RoomMemberMutation) SetBannedAt(t time.Time) {
+	m.banned_at = &t
+}
+
+// BannedAt returns the value of the "banned_at" field in the mutation.
+func (m *RoomMemberMutation) BannedAt() (r time.Time, exists bool) {
+	v := m.banned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBannedAt returns the old "banned_at" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldBannedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBannedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBannedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBannedAt: %w", err)
+	}
+	return oldValue.BannedAt, nil
+}
+
+// ClearBannedAt clears the value of the "banned_at" field.
+func (m *RoomMemberMutation) ClearBannedAt() {
+	m.banned_at = nil
+	m.clearedFields[roommember.FieldBannedAt] = struct{}{}
+}
+
+// BannedAtCleared returns if the "banned_at" field was cleared in this mutation.
+func (m *RoomMemberMutation) BannedAtCleared() bool {
+	_, ok := m.clearedFields[roommember.FieldBannedAt]
+	return ok
+}
+
+// ResetBannedAt resets all changes to the "banned_at" field.
+func (m *RoomMemberMutation) ResetBannedAt() {
+	m.banned_at = nil
+	delete(m.clearedFields, roommember.FieldBannedAt)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RoomMemberMutation) SetUserID(pu pulid.ID) {
+	m.user = &pu
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RoomMemberMutation) UserID() (r pulid.ID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldUserID(ctx context.Context) (v pulid.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RoomMemberMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetRoomID sets the "room_id" field.
+func (m *RoomMemberMutation) SetRoomID(pu pulid.ID) {
+	m.room = &pu
+}
+
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *RoomMemberMutation) RoomID() (r pulid.ID, exists bool) {
+	v := m.room
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoomID returns the old "room_id" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldRoomID(ctx context.Context) (v pulid.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
+	}
+	return oldValue.RoomID, nil
+}
+
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *RoomMemberMutation) ResetRoomID() {
+	m.room = nil
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (m *RoomMemberMutation) SetJoinedAt(t time.Time) {
+	m.joined_at = &t
+}
+
+// JoinedAt returns the value of the "joined_at" field in the mutation.
+func (m *RoomMemberMutation) JoinedAt() (r time.Time, exists bool) {
+	v := m.joined_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJoinedAt returns the old "joined_at" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldJoinedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
+	}
+	return oldValue.JoinedAt, nil
+}
+
+// ResetJoinedAt resets all changes to the "joined_at" field.
+func (m *RoomMemberMutation) ResetJoinedAt() {
+	m.joined_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RoomMemberMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RoomMemberMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RoomMemberMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RoomMemberMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[roommember.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RoomMemberMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RoomMemberMutation) UserIDs() (ids []pulid.ID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RoomMemberMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (m *RoomMemberMutation) ClearRoom() {
+	m.clearedroom = true
+	m.clearedFields[roommember.FieldRoomID] = struct{}{}
+}
+
+// RoomCleared reports if the "room" edge to the Room entity was cleared.
+func (m *RoomMemberMutation) RoomCleared() bool {
+	return m.clearedroom
+}
+
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *RoomMemberMutation) RoomIDs() (ids []pulid.ID) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoom resets all changes to the "room" edge.
+func (m *RoomMemberMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
+}
+
+// Where appends a list predicates to the RoomMemberMutation builder.
+func (m *RoomMemberMutation) Where(ps ...predicate.RoomMember) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoomMemberMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoomMemberMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoomMember, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoomMemberMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoomMemberMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RoomMember).
+func (m *RoomMemberMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoomMemberMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.deleted_at != nil {
+		fields = append(fields, roommember.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, roommember.FieldName)
+	}
+	if m.unread_messages_count != nil {
+		fields = append(fields, roommember.FieldUnreadMessagesCount)
+	}
+	if m.notification_level != nil {
+		fields = append(fields, roommember.FieldNotificationLevel)
+	}
+	if m.mute_until != nil {
+		fields = append(fields, roommember.FieldMuteUntil)
+	}
+	if m.role != nil {
+		fields = append(fields, roommember.FieldRole)
+	}
+	if m.banned_at != nil {
+		fields = append(fields, roommember.FieldBannedAt)
+	}
+	if m.user != nil {
+		fields = append(fields, roommember.FieldUserID)
+	}
+	if m.room != nil {
+		fields = append(fields, roommember.FieldRoomID)
+	}
+	if m.joined_at != nil {
+		fields = append(fields, roommember.FieldJoinedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, roommember.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoomMemberMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case roommember.FieldDeletedAt:
+		return m.DeletedAt()
+	case roommember.FieldName:
+		return m.Name()
+	case roommember.FieldUnreadMessagesCount:
+		return m.UnreadMessagesCount()
+	case roommember.FieldNotificationLevel:
+		return m.NotificationLevel()
+	case roommember.FieldMuteUntil:
+		return m.MuteUntil()
+	case roommember.FieldRole:
+		return m.Role()
+	case roommember.FieldBannedAt:
+		return m.BannedAt()
+	case roommember.FieldUserID:
+		return m.UserID()
+	case roommember.FieldRoomID:
+		return m.RoomID()
+	case roommember.FieldJoinedAt:
+		return m.JoinedAt()
+	case roommember.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoomMemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case roommember.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case roommember.FieldName:
+		return m.OldName(ctx)
+	case roommember.FieldUnreadMessagesCount:
+		return m.OldUnreadMessagesCount(ctx)
+	case roommember.FieldNotificationLevel:
+		return m.OldNotificationLevel(ctx)
+	case roommember.FieldMuteUntil:
+		return m.OldMuteUntil(ctx)
+	case roommember.FieldRole:
+		return m.OldRole(ctx)
+	case roommember.FieldBannedAt:
+		return m.OldBannedAt(ctx)
+	case roommember.FieldUserID:
+		return m.OldUserID(ctx)
+	case roommember.FieldRoomID:
+		return m.OldRoomID(ctx)
+	case roommember.FieldJoinedAt:
+		return m.OldJoinedAt(ctx)
+	case roommember.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RoomMember field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomMemberMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case roommember.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case roommember.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case roommember.FieldUnreadMessagesCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnreadMessagesCount(v)
+		return nil
+	case roommember.FieldNotificationLevel:
+		v, ok := value.(roommember.NotificationLevel)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotificationLevel(v)
+		return nil
+	case roommember.FieldMuteUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMuteUntil(v)
+		return nil
+	case roommember.FieldRole:
+		v, ok := value.(roommember.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case roommember.FieldBannedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBannedAt(v)
+		return nil
+	case roommember.FieldUserID:
+		v, ok := value.(pulid.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case roommember.FieldRoomID:
+		v, ok := value.(pulid.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomID(v)
+		return nil
+	case roommember.FieldJoinedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJoinedAt(v)
+		return nil
+	case roommember.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoomMember field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoomMemberMutation) AddedFields() []string {
+	var fields []string
+	if m.addunread_messages_count != nil {
+		fields = append(fields, roommember.FieldUnreadMessagesCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoomMemberMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case roommember.FieldUnreadMessagesCount:
+		return m.AddedUnreadMessagesCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomMemberMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case roommember.FieldUnreadMessagesCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUnreadMessagesCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoomMember numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoomMemberMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(roommember.FieldDeletedAt) {
+		fields = append(fields, roommember.FieldDeletedAt)
+	}
+	if m.FieldCleared(roommember.FieldName) {
+		fields = append(fields, roommember.FieldName)
+	}
+	if m.FieldCleared(roommember.FieldMuteUntil) {
+		fields = append(fields, roommember.FieldMuteUntil)
+	}
+	if m.FieldCleared(roommember.FieldBannedAt) {
+		fields = append(fields, roommember.FieldBannedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoomMemberMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoomMemberMutation) ClearField(name string) error {
+	switch name {
+	case roommember.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case roommember.FieldName:
+		m.ClearName()
+		return nil
+	case roommember.FieldMuteUntil:
+		m.ClearMuteUntil()
+		return nil
+	case roommember.FieldBannedAt:
+		m.ClearBannedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RoomMember nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoomMemberMutation) ResetField(name string) error {
+	switch name {
+	case roommember.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case roommember.FieldName:
+		m.ResetName()
+		return nil
+	case roommember.FieldUnreadMessagesCount:
+		m.ResetUnreadMessagesCount()
+		return nil
+	case roommember.FieldNotificationLevel:
+		m.ResetNotificationLevel()
+		return nil
+	case roommember.FieldMuteUntil:
+		m.ResetMuteUntil()
+		return nil
+	case roommember.FieldRole:
+		m.ResetRole()
+		return nil
+	case roommember.FieldBannedAt:
+		m.ResetBannedAt()
+		return nil
+	case roommember.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case roommember.FieldRoomID:
+		m.ResetRoomID()
+		return nil
+	case roommember.FieldJoinedAt:
+		m.ResetJoinedAt()
+		return nil
+	case roommember.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RoomMember field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoomMemberMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, roommember.EdgeUser)
+	}
+	if m.room != nil {
+		edges = append(edges, roommember.EdgeRoom)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoomMemberMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case roommember.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case roommember.EdgeRoom:
+		if id := m.room; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoomMemberMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoomMemberMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoomMemberMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, roommember.EdgeUser)
+	}
+	if m.clearedroom {
+		edges = append(edges, roommember.EdgeRoom)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoomMemberMutation) EdgeCleared(name string) bool {
+	switch name {
+	case roommember.EdgeUser:
+		return m.cleareduser
+	case roommember.EdgeRoom:
+		return m.clearedroom
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoomMemberMutation) ClearEdge(name string) error {
+	switch name {
+	case roommember.EdgeUser:
+		m.ClearUser()
+		return nil
+	case roommember.EdgeRoom:
+		m.ClearRoom()
+		return nil
+	}
+	return fmt.Errorf("unknown RoomMember unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoomMemberMutation) ResetEdge(name string) error {
+	switch name {
+	case roommember.EdgeUser:
+		m.ResetUser()
+		return nil
+	case roommember.EdgeRoom:
+		m.ResetRoom()
+		return nil
+	}
+	return fmt.Errorf("unknown RoomMember edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *pulid.ID
+	first_name           *string
+	last_name            *string
+	nickname             *string
+	email                *string
+	storage_used         *int64
+	addstorage_used      *int64
+	locale               *string
+	message_previews     *bool
+	timezone             *string
+	quiet_hours_start    *string
+	quiet_hours_end      *string
+	role                 *user.Role
+	suspended_at         *time.Time
+	hide_last_seen       *bool
+	discoverability      *user.Discoverability
+	last_seen_at         *time.Time
+	password             *string
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	device               *pulid.ID
+	cleareddevice        bool
+	notifications        map[pulid.ID]struct{}
+	removednotifications map[pulid.ID]struct{}
+	clearednotifications bool
+	contacts             map[pulid.ID]struct{}
+	removedcontacts      map[pulid.ID]struct{}
+	clearedcontacts      bool
+	rooms                map[pulid.ID]struct{}
+	removedrooms         map[pulid.ID]struct{}
+	clearedrooms         bool
+	messages             map[pulid.ID]struct{}
+	removedmessages      map[pulid.ID]struct{}
+	clearedmessages      bool
+	files                map[pulid.ID]struct{}
+	removedfiles         map[pulid.ID]struct{}
+	clearedfiles         bool
+	avatar               *pulid.ID
+	clearedavatar        bool
+	avatars              map[pulid.ID]struct{}
+	removedavatars       map[pulid.ID]struct{}
+	clearedavatars       bool
+	blocks               map[pulid.ID]struct{}
+	removedblocks        map[pulid.ID]struct{}
+	clearedblocks        bool
+	blocked_by           map[pulid.ID]struct{}
+	removedblocked_by    map[pulid.ID]struct{}
+	clearedblocked_by    bool
+	user_contacts        map[pulid.ID]struct{}
+	removeduser_contacts map[pulid.ID]struct{}
+	cleareduser_contacts bool
+	memberships          map[pulid.ID]struct{}
+	removedmemberships   map[pulid.ID]struct{}
+	clearedmemberships   bool
+	done                 bool
+	oldValue             func(context.Context) (*User, error)
+	predicates           []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id pulid.ID) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id pulid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id pulid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []pulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFirstName sets the "first_name" field.
+func (m *UserMutation) SetFirstName(s string) {
+	m.first_name = &s
+}
+
+// FirstName returns the value of the "first_name" field in the mutation.
+func (m *UserMutation) FirstName() (r string, exists bool) {
+	v := m.first_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstName returns the old "first_name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldFirstName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstName: %w", err)
+	}
+	return oldValue.FirstName, nil
+}
+
+// ResetFirstName resets all changes to the "first_name" field.
+func (m *UserMutation) ResetFirstName() {
+	m.first_name = nil
+}
+
+// SetLastName sets the "last_name" field.
+func (m *UserMutation) SetLastName(s string) {
+	m.last_name = &s
+}
+
+// LastName returns the value of the "last_name" field in the mutation.
+func (m *UserMutation) LastName() (r string, exists bool) {
+	v := m.last_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastName returns the old "last_name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastName: %w", err)
+	}
+	return oldValue.LastName, nil
+}
+
+// ResetLastName resets all changes to the "last_name" field.
+func (m *UserMutation) ResetLastName() {
+	m.last_name = nil
+}
+
+// SetNickname sets the "nickname" field.
+func (m *UserMutation) SetNickname(s string) {
+	m.nickname = &s
+}
+
+// Nickname returns the value of the "nickname" field in the mutation.
+func (m *UserMutation) Nickname() (r string, exists bool) {
+	v := m.nickname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNickname returns the old "nickname" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldNickname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNickname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
+	}
+	return oldValue.Nickname, nil
+}
+
+// ResetNickname resets all changes to the "nickname" field.
+func (m *UserMutation) ResetNickname() {
+	m.nickname = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ClearEmail clears the value of the "email" field.
+func (m *UserMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[user.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *UserMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[user.FieldEmail]
+	return ok
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, user.FieldEmail)
+}
+
+// SetStorageUsed sets the "storage_used" field.
+func (m *UserMutation) SetStorageUsed(i int64) {
+	m.storage_used = &i
+	m.addstorage_used = nil
+}
+
+// StorageUsed returns the value of the "storage_used" field in the mutation.
+func (m *UserMutation) StorageUsed() (r int64, exists bool) {
+	v := m.storage_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorageUsed returns the old "storage_used" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStorageUsed(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageUsed: %w", err)
+	}
+	return oldValue.StorageUsed, nil
+}
+
+// AddStorageUsed adds i to the "storage_used" field.
+func (m *UserMutation) AddStorageUsed(i int64) {
+	if m.addstorage_used != nil {
+		*m.addstorage_used += i
+	} else {
+		m.addstorage_used = &i
+	}
+}
+
+// AddedStorageUsed returns the value that was added to the "storage_used" field in this mutation.
+func (m *UserMutation) AddedStorageUsed() (r int64, exists bool) {
+	v := m.addstorage_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStorageUsed resets all changes to the "storage_used" field.
+func (m *UserMutation) ResetStorageUsed() {
+	m.storage_used = nil
+	m.addstorage_used = nil
+}
+
+// SetLocale sets the "locale" field.
+func (m *UserMutation) SetLocale(s string) {
+	m.locale = &s
+}
+
+// Locale returns the value of the "locale" field in the mutation.
+func (m *UserMutation) Locale() (r string, exists bool) {
+	v := m.locale
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocale returns the old "locale" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLocale(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocale is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocale requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocale: %w", err)
+	}
+	return oldValue.Locale, nil
+}
+
+// ResetLocale resets all changes to the "locale" field.
+func (m *UserMutation) ResetLocale() {
+	m.locale = nil
+}
+
+// SetMessagePreviews sets the "message_previews" field.
+func (m *UserMutation) SetMessagePreviews(b bool) {
+	m.message_previews = &b
+}
+
+// MessagePreviews returns the value of the "message_previews" field in the mutation.
+func (m *UserMutation) MessagePreviews() (r bool, exists bool) {
+	v := m.message_previews
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessagePreviews returns the old "message_previews" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldMessagePreviews(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessagePreviews is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessagePreviews requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessagePreviews: %w", err)
+	}
+	return oldValue.MessagePreviews, nil
+}
+
+// ResetMessagePreviews resets all changes to the "message_previews" field.
+func (m *UserMutation) ResetMessagePreviews() {
+	m.message_previews = nil
+}
+
+// SetTimezone sets the "timezone" field.
+func (m *UserMutation) SetTimezone(s string) {
+	m.timezone = &s
+}
+
+// Timezone returns the value of the "timezone" field in the mutation.
+func (m *UserMutation) Timezone() (r string, exists bool) {
+	v := m.timezone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimezone returns the old "timezone" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTimezone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimezone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimezone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimezone: %w", err)
+	}
+	return oldValue.Timezone, nil
+}
+
+// ResetTimezone resets all changes to the "timezone" field.
+func (m *UserMutation) ResetTimezone() {
+	m.timezone = nil
+}
+
+// SetQuietHoursStart sets the "quiet_hours_start" field.
+func (m *UserMutation) SetQuietHoursStart(s string) {
+	m.quiet_hours_start = &s
+}
+
+// QuietHoursStart returns the value of the "quiet_hours_start" field in the mutation.
+func (m *UserMutation) QuietHoursStart() (r string, exists bool) {
+	v := m.quiet_hours_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuietHoursStart returns the old "quiet_hours_start" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldQuietHoursStart(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuietHoursStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuietHoursStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuietHoursStart: %w", err)
+	}
+	return oldValue.QuietHoursStart, nil
+}
+
+// ClearQuietHoursStart clears the value of the "quiet_hours_start" field.
+func (m *UserMutation) ClearQuietHoursStart() {
+	m.quiet_hours_start = nil
+	m.clearedFields[user.FieldQuietHoursStart] = struct{}{}
+}
+
+// QuietHoursStartCleared returns if the "quiet_hours_start" field was cleared in this mutation.
+func (m *UserMutation) QuietHoursStartCleared() bool {
+	_, ok := m.clearedFields[user.FieldQuietHoursStart]
+	return ok
+}
+
+// ResetQuietHoursStart resets all changes to the "quiet_hours_start" field.
+func (m *UserMutation) ResetQuietHoursStart() {
+	m.quiet_hours_start = nil
+	delete(m.clearedFields, user.FieldQuietHoursStart)
+}
+
+// SetQuietHoursEnd sets the "quiet_hours_end" field.
+func (m *UserMutation) SetQuietHoursEnd(s string) {
+	m.quiet_hours_end = &s
+}
+
+// QuietHoursEnd returns the value of the "quiet_hours_end" field in the mutation.
+func (m *UserMutation) QuietHoursEnd() (r string, exists bool) {
+	v := m.quiet_hours_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuietHoursEnd returns the old "quiet_hours_end" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldQuietHoursEnd(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuietHoursEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuietHoursEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuietHoursEnd: %w", err)
+	}
+	return oldValue.QuietHoursEnd, nil
+}
+
+// ClearQuietHoursEnd clears the value of the "quiet_hours_end" field.
+func (m *UserMutation) ClearQuietHoursEnd() {
+	m.quiet_hours_end = nil
+	m.clearedFields[user.FieldQuietHoursEnd] = struct{}{}
+}
+
+// QuietHoursEndCleared returns if the "quiet_hours_end" field was cleared in this mutation.
+func (m *UserMutation) QuietHoursEndCleared() bool {
+	_, ok := m.clearedFields[user.FieldQuietHoursEnd]
+	return ok
+}
+
+// ResetQuietHoursEnd resets all changes to the "quiet_hours_end" field.
+func (m *UserMutation) ResetQuietHoursEnd() {
+	m.quiet_hours_end = nil
+	delete(m.clearedFields, user.FieldQuietHoursEnd)
+}
+
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(u user.Role) {
+	m.role = &u
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r user.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (m *UserMutation) SetSuspendedAt(t time.Time) {
+	m.suspended_at = &t
+}
+
+// SuspendedAt returns the value of the "suspended_at" field in the mutation.
+func (m *UserMutation) SuspendedAt() (r time.Time, exists bool) {
+	v := m.suspended_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuspendedAt returns the old "suspended_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSuspendedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuspendedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuspendedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuspendedAt: %w", err)
+	}
+	return oldValue.SuspendedAt, nil
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (m *UserMutation) ClearSuspendedAt() {
+	m.suspended_at = nil
+	m.clearedFields[user.FieldSuspendedAt] = struct{}{}
+}
+
+// SuspendedAtCleared returns if the "suspended_at" field was cleared in this mutation.
+func (m *UserMutation) SuspendedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldSuspendedAt]
+	return ok
+}
+
+// ResetSuspendedAt resets all changes to the "suspended_at" field.
+func (m *UserMutation) ResetSuspendedAt() {
+	m.suspended_at = nil
+	delete(m.clearedFields, user.FieldSuspendedAt)
+}
+
+// SetHideLastSeen sets the "hide_last_seen" field.
+func (m *UserMutation) SetHideLastSeen(b bool) {
+	m.hide_last_seen = &b
+}
+
+// HideLastSeen returns the value of the "hide_last_seen" field in the mutation.
+func (m *UserMutation) HideLastSeen() (r bool, exists bool) {
+	v := m.hide_last_seen
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHideLastSeen returns the old "hide_last_seen" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldHideLastSeen(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHideLastSeen is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHideLastSeen requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHideLastSeen: %w", err)
+	}
+	return oldValue.HideLastSeen, nil
+}
+
+// ResetHideLastSeen resets all changes to the "hide_last_seen" field.
+func (m *UserMutation) ResetHideLastSeen() {
+	m.hide_last_seen = nil
+}
+
+// SetDiscoverability sets the "discoverability" field.
+func (m *UserMutation) SetDiscoverability(u user.Discoverability) {
+	m.discoverability = &u
+}
+
+// Discoverability returns the value of the "discoverability" field in the mutation.
+func (m *UserMutation) Discoverability() (r user.Discoverability, exists bool) {
+	v := m.discoverability
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiscoverability returns the old "discoverability" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDiscoverability(ctx context.Context) (v user.Discoverability, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiscoverability is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiscoverability requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiscoverability: %w", err)
+	}
+	return oldValue.Discoverability, nil
+}
+
+// ResetDiscoverability resets all changes to the "discoverability" field.
+func (m *UserMutation) ResetDiscoverability() {
+	m.discoverability = nil
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *UserMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *UserMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastSeenAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (m *UserMutation) ClearLastSeenAt() {
+	m.last_seen_at = nil
+	m.clearedFields[user.FieldLastSeenAt] = struct{}{}
+}
+
+// LastSeenAtCleared returns if the "last_seen_at" field was cleared in this mutation.
+func (m *UserMutation) LastSeenAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldLastSeenAt]
+	return ok
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *UserMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+	delete(m.clearedFields, user.FieldLastSeenAt)
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (m *UserMutation) SetAvatarID(pu pulid.ID) {
+	m.avatar = &pu
+}
+
+// AvatarID returns the value of the "avatar_id" field in the mutation.
+func (m *UserMutation) AvatarID() (r pulid.ID, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatarID returns the old "avatar_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAvatarID(ctx context.Context) (v *pulid.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatarID: %w", err)
+	}
+	return oldValue.AvatarID, nil
+}
+
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (m *UserMutation) ClearAvatarID() {
+	m.avatar = nil
+	m.clearedFields[user.FieldAvatarID] = struct{}{}
+}
+
+// AvatarIDCleared returns if the "avatar_id" field was cleared in this mutation.
+func (m *UserMutation) AvatarIDCleared() bool {
+	_, ok := m.clearedFields[user.FieldAvatarID]
+	return ok
+}
+
+// ResetAvatarID resets all changes to the "avatar_id" field.
+func (m *UserMutation) ResetAvatarID() {
+	m.avatar = nil
+	delete(m.clearedFields, user.FieldAvatarID)
+}
+
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by id.
+func (m *UserMutation) SetDeviceID(id pulid.ID) {
+	m.device = &id
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (m *UserMutation) ClearDevice() {
+	m.cleareddevice = true
+}
+
+// DeviceCleared reports if the "device" edge to the Device entity was cleared.
+func (m *UserMutation) DeviceCleared() bool {
+	return m.cleareddevice
+}
+
+// DeviceID returns the "device" edge ID in the mutation.
+func (m *UserMutation) DeviceID() (id pulid.ID, exists bool) {
+	if m.device != nil {
+		return *m.device, true
+	}
+	return
+}
+
+// DeviceIDs returns the "device" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeviceID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) DeviceIDs() (ids []pulid.ID) {
+	if id := m.device; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDevice resets all changes to the "device" edge.
+func (m *UserMutation) ResetDevice() {
+	m.device = nil
+	m.cleareddevice = false
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by ids.
+func (m *UserMutation) AddNotificationIDs(ids ...pulid.ID) {
+	if m.notifications == nil {
+		m.notifications = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.notifications[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotifications clears the "notifications" edge to the Notification entity.
+func (m *UserMutation) ClearNotifications() {
+	m.clearednotifications = true
+}
+
+// NotificationsCleared reports if the "notifications" edge to the Notification entity was cleared.
+func (m *UserMutation) NotificationsCleared() bool {
+	return m.clearednotifications
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to the Notification entity by IDs.
+func (m *UserMutation) RemoveNotificationIDs(ids ...pulid.ID) {
+	if m.removednotifications == nil {
+		m.removednotifications = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.notifications, ids[i])
+		m.removednotifications[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotifications returns the removed IDs of the "notifications" edge to the Notification entity.
+func (m *UserMutation) RemovedNotificationsIDs() (ids []pulid.ID) {
+	for id := range m.removednotifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotificationsIDs returns the "notifications" edge IDs in the mutation.
+func (m *UserMutation) NotificationsIDs() (ids []pulid.ID) {
+	for id := range m.notifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotifications resets all changes to the "notifications" edge.
+func (m *UserMutation) ResetNotifications() {
+	m.notifications = nil
+	m.clearednotifications = false
+	m.removednotifications = nil
+}
+
+// AddContactIDs adds the "contacts" edge to the User entity by ids.
+func (m *UserMutation) AddContactIDs(ids ...pulid.ID) {
+	if m.contacts == nil {
+		m.contacts = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.contacts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearContacts clears the "contacts" edge to the User entity.
+func (m *UserMutation) ClearContacts() {
+	m.clearedcontacts = true
+}
+
+// ContactsCleared reports if the "contacts" edge to the User entity was cleared.
+func (m *UserMutation) ContactsCleared() bool {
+	return m.clearedcontacts
+}
+
+// RemoveContactIDs removes the "contacts" edge to the User entity by IDs.
+func (m *UserMutation) RemoveContactIDs(ids ...pulid.ID) {
+	if m.removedcontacts == nil {
+		m.removedcontacts = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.contacts, ids[i])
+		m.removedcontacts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedContacts returns the removed IDs of the "contacts" edge to the User entity.
+func (m *UserMutation) RemovedContactsIDs() (ids []pulid.ID) {
+	for id := range m.removedcontacts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ContactsIDs returns the "contacts" edge IDs in the mutation.
+func (m *UserMutation) ContactsIDs() (ids []pulid.ID) {
+	for id := range m.contacts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetContacts resets all changes to the "contacts" edge.
+func (m *UserMutation) ResetContacts() {
+	m.contacts = nil
+	m.clearedcontacts = false
+	m.removedcontacts = nil
+}
+
+// AddRoomIDs adds the "rooms" edge to the Room entity by ids.
+func (m *UserMutation) AddRoomIDs(ids ...pulid.ID) {
+	if m.rooms == nil {
+		m.rooms = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.rooms[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRooms clears the "rooms" edge to the Room entity.
+func (m *UserMutation) ClearRooms() {
+	m.clearedrooms = true
+}
+
+// RoomsCleared reports if the "rooms" edge to the Room entity was cleared.
+func (m *UserMutation) RoomsCleared() bool {
+	return m.clearedrooms
+}
+
+// RemoveRoomIDs removes the "rooms" edge to the Room entity by IDs.
+func (m *UserMutation) RemoveRoomIDs(ids ...pulid.ID) {
+	if m.removedrooms == nil {
+		m.removedrooms = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.rooms, ids[i])
+		m.removedrooms[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRooms returns the removed IDs of the "rooms" edge to the Room entity.
+func (m *UserMutation) RemovedRoomsIDs() (ids []pulid.ID) {
+	for id := range m.removedrooms {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RoomsIDs returns the "rooms" edge IDs in the mutation.
+func (m *UserMutation) RoomsIDs() (ids []pulid.ID) {
+	for id := range m.rooms {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRooms resets all changes to the "rooms" edge.
+func (m *UserMutation) ResetRooms() {
+	m.rooms = nil
+	m.clearedrooms = false
+	m.removedrooms = nil
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by ids.
+func (m *UserMutation) AddMessageIDs(ids ...pulid.ID) {
+	if m.messages == nil {
+		m.messages = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessages clears the "messages" edge to the Message entity.
+func (m *UserMutation) ClearMessages() {
+	m.clearedmessages = true
+}
+
+// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
+func (m *UserMutation) MessagesCleared() bool {
+	return m.clearedmessages
+}
+
+// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
+func (m *UserMutation) RemoveMessageIDs(ids ...pulid.ID) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
+func (m *UserMutation) RemovedMessagesIDs() (ids []pulid.ID) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *UserMutation) MessagesIDs() (ids []pulid.ID) {
+	for id := range m.messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessages resets all changes to the "messages" edge.
+func (m *UserMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
+}
+
+// AddFileIDs adds the "files" edge to the File entity by ids.
+func (m *UserMutation) AddFileIDs(ids ...pulid.ID) {
+	if m.files == nil {
+		m.files = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.files[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFiles clears the "files" edge to the File entity.
+func (m *UserMutation) ClearFiles() {
+	m.clearedfiles = true
+}
+
+// FilesCleared reports if the "files" edge to the File entity was cleared.
+func (m *UserMutation) FilesCleared() bool {
+	return m.clearedfiles
+}
+
+// RemoveFileIDs removes the "files" edge to the File entity by IDs.
+func (m *UserMutation) RemoveFileIDs(ids ...pulid.ID) {
+	if m.removedfiles == nil {
+		m.removedfiles = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.files, ids[i])
+		m.removedfiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
+func (m *UserMutation) RemovedFilesIDs() (ids []pulid.ID) {
+	for id := range m.removedfiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FilesIDs returns the "files" edge IDs in the mutation.
+func (m *UserMutation) FilesIDs() (ids []pulid.ID) {
+	for id := range m.files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFiles resets all changes to the "files" edge.
+func (m *UserMutation) ResetFiles() {
+	m.files = nil
+	m.clearedfiles = false
+	m.removedfiles = nil
+}
+
+// ClearAvatar clears the "avatar" edge to the File entity.
+func (m *UserMutation) ClearAvatar() {
+	m.clearedavatar = true
+	m.clearedFields[user.FieldAvatarID] = struct{}{}
+}
+
+// AvatarCleared reports if the "avatar" edge to the File entity was cleared.
+func (m *UserMutation) AvatarCleared() bool {
+	return m.AvatarIDCleared() || m.clearedavatar
+}
+
+// AvatarIDs returns the "avatar" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AvatarID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) AvatarIDs() (ids []pulid.ID) {
+	if id := m.avatar; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAvatar resets all changes to the "avatar" edge.
+func (m *UserMutation) ResetAvatar() {
+	m.avatar = nil
+	m.clearedavatar = false
+}
+
+// AddAvatarIDs adds the "avatars" edge to the Avatar entity by ids.
+func (m *UserMutation) AddAvatarIDs(ids ...pulid.ID) {
+	if m.avatars == nil {
+		m.avatars = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.avatars[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAvatars clears the "avatars" edge to the Avatar entity.
+func (m *UserMutation) ClearAvatars() {
+	m.clearedavatars = true
+}
+
+// AvatarsCleared reports if the "avatars" edge to the Avatar entity was cleared.
+func (m *UserMutation) AvatarsCleared() bool {
+	return m.clearedavatars
+}
+
+// RemoveAvatarIDs removes the "avatars" edge to the Avatar entity by IDs.
+func (m *UserMutation) RemoveAvatarIDs(ids ...pulid.ID) {
+	if m.removedavatars == nil {
+		m.removedavatars = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.avatars, ids[i])
+		m.removedavatars[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAvatars returns the removed IDs of the "avatars" edge to the Avatar entity.
+func (m *UserMutation) RemovedAvatarsIDs() (ids []pulid.ID) {
+	for id := range m.removedavatars {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AvatarsIDs returns the "avatars" edge IDs in the mutation.
+func (m *UserMutation) AvatarsIDs() (ids []pulid.ID) {
+	for id := range m.avatars {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAvatars resets all changes to the "avatars" edge.
+func (m *UserMutation) ResetAvatars() {
+	m.avatars = nil
+	m.clearedavatars = false
+	m.removedavatars = nil
+}
+
+// AddBlockIDs adds the "blocks" edge to the UserBlock entity by ids.
+func (m *UserMutation) AddBlockIDs(ids ...pulid.ID) {
+	if m.blocks == nil {
+		m.blocks = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.blocks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBlocks clears the "blocks" edge to the UserBlock entity.
+func (m *UserMutation) ClearBlocks() {
+	m.clearedblocks = true
+}
+
+// BlocksCleared reports if the "blocks" edge to the UserBlock entity was cleared.
+func (m *UserMutation) BlocksCleared() bool {
+	return m.clearedblocks
+}
+
+// RemoveBlockIDs removes the "blocks" edge to the UserBlock entity by IDs.
+func (m *UserMutation) RemoveBlockIDs(ids ...pulid.ID) {
+	if m.removedblocks == nil {
+		m.removedblocks = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.blocks, ids[i])
+		m.removedblocks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBlocks returns the removed IDs of the "blocks" edge to the UserBlock entity.
+func (m *UserMutation) RemovedBlocksIDs() (ids []pulid.ID) {
+	for id := range m.removedblocks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BlocksIDs returns the "blocks" edge IDs in the mutation.
+func (m *UserMutation) BlocksIDs() (ids []pulid.ID) {
+	for id := range m.blocks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBlocks resets all changes to the "blocks" edge.
+func (m *UserMutation) ResetBlocks() {
+	m.blocks = nil
+	m.clearedblocks = false
+	m.removedblocks = nil
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the UserBlock entity by ids.
+func (m *UserMutation) AddBlockedByIDs(ids ...pulid.ID) {
+	if m.blocked_by == nil {
+		m.blocked_by = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.blocked_by[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBlockedBy clears the "blocked_by" edge to the UserBlock entity.
+func (m *UserMutation) ClearBlockedBy() {
+	m.clearedblocked_by = true
+}
+
+// BlockedByCleared reports if the "blocked_by" edge to the UserBlock entity was cleared.
+func (m *UserMutation) BlockedByCleared() bool {
+	return m.clearedblocked_by
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to the UserBlock entity by IDs.
+func (m *UserMutation) RemoveBlockedByIDs(ids ...pulid.ID) {
+	if m.removedblocked_by == nil {
+		m.removedblocked_by = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.blocked_by, ids[i])
+		m.removedblocked_by[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBlockedBy returns the removed IDs of the "blocked_by" edge to the UserBlock entity.
+func (m *UserMutation) RemovedBlockedByIDs() (ids []pulid.ID) {
+	for id := range m.removedblocked_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BlockedByIDs returns the "blocked_by" edge IDs in the mutation.
+func (m *UserMutation) BlockedByIDs() (ids []pulid.ID) {
+	for id := range m.blocked_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBlockedBy resets all changes to the "blocked_by" edge.
+func (m *UserMutation) ResetBlockedBy() {
+	m.blocked_by = nil
+	m.clearedblocked_by = false
+	m.removedblocked_by = nil
+}
+
+// AddUserContactIDs adds the "user_contacts" edge to the UserContact entity by ids.
+func (m *UserMutation) AddUserContactIDs(ids ...pulid.ID) {
+	if m.user_contacts == nil {
+		m.user_contacts = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.user_contacts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserContacts clears the "user_contacts" edge to the UserContact entity.
+func (m *UserMutation) ClearUserContacts() {
+	m.cleareduser_contacts = true
+}
+
+// UserContactsCleared reports if the "user_contacts" edge to the UserContact entity was cleared.
+func (m *UserMutation) UserContactsCleared() bool {
+	return m.cleareduser_contacts
+}
+
+// RemoveUserContactIDs removes the "user_contacts" edge to the UserContact entity by IDs.
+func (m *UserMutation) RemoveUserContactIDs(ids ...pulid.ID) {
+	if m.removeduser_contacts == nil {
+		m.removeduser_contacts = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.user_contacts, ids[i])
+		m.removeduser_contacts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserContacts returns the removed IDs of the "user_contacts" edge to the UserContact entity.
+func (m *UserMutation) RemovedUserContactsIDs() (ids []pulid.ID) {
+	for id := range m.removeduser_contacts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserContactsIDs returns the "user_contacts" edge IDs in the mutation.
+func (m *UserMutation) UserContactsIDs() (ids []pulid.ID) {
+	for id := range m.user_contacts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserContacts resets all changes to the "user_contacts" edge.
+func (m *UserMutation) ResetUserContacts() {
+	m.user_contacts = nil
+	m.cleareduser_contacts = false
+	m.removeduser_contacts = nil
+}
+
+// AddMembershipIDs adds the "memberships" edge to the RoomMember entity by ids.
+func (m *UserMutation) AddMembershipIDs(ids ...pulid.ID) {
+	if m.memberships == nil {
+		m.memberships = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		m.memberships[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMemberships clears the "memberships" edge to the RoomMember entity.
+func (m *UserMutation) ClearMemberships() {
+	m.clearedmemberships = true
+}
+
+// MembershipsCleared reports if the "memberships" edge to the RoomMember entity was cleared.
+func (m *UserMutation) MembershipsCleared() bool {
+	return m.clearedmemberships
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to the RoomMember entity by IDs.
+func (m *UserMutation) RemoveMembershipIDs(ids ...pulid.ID) {
+	if m.removedmemberships == nil {
+		m.removedmemberships = make(map[pulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.memberships, ids[i])
+		m.removedmemberships[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMemberships returns the removed IDs of the "memberships" edge to the RoomMember entity.
+func (m *UserMutation) RemovedMembershipsIDs() (ids []pulid.ID) {
+	for id := range m.removedmemberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembershipsIDs returns the "memberships" edge IDs in the mutation.
+func (m *UserMutation) MembershipsIDs() (ids []pulid.ID) {
+	for id := range m.memberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMemberships resets all changes to the "memberships" edge.
+func (m *UserMutation) ResetMemberships() {
+	m.memberships = nil
+	m.clearedmemberships = false
+	m.removedmemberships = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 19)
+	if m.first_name != nil {
+		fields = append(fields, user.FieldFirstName)
+	}
+	if m.last_name != nil {
+		fields = append(fields, user.FieldLastName)
+	}
+	if m.nickname != nil {
+		fields = append(fields, user.FieldNickname)
+	}
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.storage_used != nil {
+		fields = append(fields, user.FieldStorageUsed)
+	}
+	if m.locale != nil {
+		fields = append(fields, user.FieldLocale)
+	}
+	if m.message_previews != nil {
+		fields = append(fields, user.FieldMessagePreviews)
+	}
+	if m.timezone != nil {
+		fields = append(fields, user.FieldTimezone)
+	}
+	if m.quiet_hours_start != nil {
+		fields = append(fields, user.FieldQuietHoursStart)
+	}
+	if m.quiet_hours_end != nil {
+		fields = append(fields, user.FieldQuietHoursEnd)
+	}
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
+	}
+	if m.suspended_at != nil {
+		fields = append(fields, user.FieldSuspendedAt)
+	}
+	if m.hide_last_seen != nil {
+		fields = append(fields, user.FieldHideLastSeen)
+	}
+	if m.discoverability != nil {
+		fields = append(fields, user.FieldDiscoverability)
+	}
+	if m.last_seen_at != nil {
+		fields = append(fields, user.FieldLastSeenAt)
+	}
+	if m.avatar != nil {
+		fields = append(fields, user.FieldAvatarID)
+	}
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldFirstName:
+		return m.FirstName()
+	case user.FieldLastName:
+		return m.LastName()
+	case user.FieldNickname:
+		return m.Nickname()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldStorageUsed:
+		return m.StorageUsed()
+	case user.FieldLocale:
+		return m.Locale()
+	case user.FieldMessagePreviews:
+		return m.MessagePreviews()
+	case user.FieldTimezone:
+		return m.Timezone()
+	case user.FieldQuietHoursStart:
+		return m.QuietHoursStart()
+	case user.FieldQuietHoursEnd:
+		return m.QuietHoursEnd()
+	case user.FieldRole:
+		return m.Role()
+	case user.FieldSuspendedAt:
+		return m.SuspendedAt()
+	case user.FieldHideLastSeen:
+		return m.HideLastSeen()
+	case user.FieldDiscoverability:
+		return m.Discoverability()
+	case user.FieldLastSeenAt:
+		return m.LastSeenAt()
+	case user.FieldAvatarID:
+		return m.AvatarID()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldFirstName:
+		return m.OldFirstName(ctx)
+	case user.FieldLastName:
+		return m.OldLastName(ctx)
+	case user.FieldNickname:
+		return m.OldNickname(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldStorageUsed:
+		return m.OldStorageUsed(ctx)
+	case user.FieldLocale:
+		return m.OldLocale(ctx)
+	case user.FieldMessagePreviews:
+		return m.OldMessagePreviews(ctx)
+	case user.FieldTimezone:
+		return m.OldTimezone(ctx)
+	case user.FieldQuietHoursStart:
+		return m.OldQuietHoursStart(ctx)
+	case user.FieldQuietHoursEnd:
+		return m.OldQuietHoursEnd(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
+	case user.FieldSuspendedAt:
+		return m.OldSuspendedAt(ctx)
+	case user.FieldHideLastSeen:
+		return m.OldHideLastSeen(ctx)
+	case user.FieldDiscoverability:
+		return m.OldDiscoverability(ctx)
+	case user.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	case user.FieldAvatarID:
+		return m.OldAvatarID(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldFirstName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstName(v)
+		return nil
+	case user.FieldLastName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastName(v)
+		return nil
+	case user.FieldNickname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNickname(v)
+		return nil
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case user.FieldStorageUsed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageUsed(v)
+		return nil
+	case user.FieldLocale:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocale(v)
+		return nil
+	case user.FieldMessagePreviews:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessagePreviews(v)
+		return nil
+	case user.FieldTimezone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimezone(v)
+		return nil
+	case user.FieldQuietHoursStart:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuietHoursStart(v)
+		return nil
+	case user.FieldQuietHoursEnd:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuietHoursEnd(v)
+		return nil
+	case user.FieldRole:
+		v, ok := value.(user.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case user.FieldSuspendedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuspendedAt(v)
+		return nil
+	case user.FieldHideLastSeen:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHideLastSeen(v)
+		return nil
+	case user.FieldDiscoverability:
+		v, ok := value.(user.Discoverability)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiscoverability(v)
+		return nil
+	case user.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
+	case user.FieldAvatarID:
+		v, ok := value.(pulid.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatarID(v)
+		return nil
+	case user.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
 }
 
-// OldLastName returns the old "last_name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldLastName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastName: %w", err)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	var fields []string
+	if m.addstorage_used != nil {
+		fields = append(fields, user.FieldStorageUsed)
 	}
-	return oldValue.LastName, nil
-}
-
-// ResetLastName resets all changes to the "last_name" field.
-func (m *UserMutation) ResetLastName() {
-	m.last_name = nil
+	return fields
 }
 
-// SetNickname sets the "nickname" field.
-func (m *UserMutation) SetNickname(s string) {
-	m.nickname = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldStorageUsed:
+		return m.AddedStorageUsed()
+	}
+	return nil, false
 }
 
-// Nickname returns the value of the "nickname" field in the mutation.
-func (m *UserMutation) Nickname() (r string, exists bool) {
-	v := m.nickname
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldStorageUsed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStorageUsed(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
-// OldNickname returns the old "nickname" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldNickname(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldEmail) {
+		fields = append(fields, user.FieldEmail)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNickname requires an ID field in the mutation")
+	if m.FieldCleared(user.FieldQuietHoursStart) {
+		fields = append(fields, user.FieldQuietHoursStart)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
+	if m.FieldCleared(user.FieldQuietHoursEnd) {
+		fields = append(fields, user.FieldQuietHoursEnd)
 	}
-	return oldValue.Nickname, nil
+	if m.FieldCleared(user.FieldSuspendedAt) {
+		fields = append(fields, user.FieldSuspendedAt)
+	}
+	if m.FieldCleared(user.FieldLastSeenAt) {
+		fields = append(fields, user.FieldLastSeenAt)
+	}
+	if m.FieldCleared(user.FieldAvatarID) {
+		fields = append(fields, user.FieldAvatarID)
+	}
+	return fields
 }
 
-// ResetNickname resets all changes to the "nickname" field.
-func (m *UserMutation) ResetNickname() {
-	m.nickname = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldEmail:
+		m.ClearEmail()
+		return nil
+	case user.FieldQuietHoursStart:
+		m.ClearQuietHoursStart()
+		return nil
+	case user.FieldQuietHoursEnd:
+		m.ClearQuietHoursEnd()
+		return nil
+	case user.FieldSuspendedAt:
+		m.ClearSuspendedAt()
+		return nil
+	case user.FieldLastSeenAt:
+		m.ClearLastSeenAt()
+		return nil
+	case user.FieldAvatarID:
+		m.ClearAvatarID()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldFirstName:
+		m.ResetFirstName()
+		return nil
+	case user.FieldLastName:
+		m.ResetLastName()
+		return nil
+	case user.FieldNickname:
+		m.ResetNickname()
+		return nil
+	case user.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case user.FieldStorageUsed:
+		m.ResetStorageUsed()
+		return nil
+	case user.FieldLocale:
+		m.ResetLocale()
+		return nil
+	case user.FieldMessagePreviews:
+		m.ResetMessagePreviews()
+		return nil
+	case user.FieldTimezone:
+		m.ResetTimezone()
+		return nil
+	case user.FieldQuietHoursStart:
+		m.ResetQuietHoursStart()
+		return nil
+	case user.FieldQuietHoursEnd:
+		m.ResetQuietHoursEnd()
+		return nil
+	case user.FieldRole:
+		m.ResetRole()
+		return nil
+	case user.FieldSuspendedAt:
+		m.ResetSuspendedAt()
+		return nil
+	case user.FieldHideLastSeen:
+		m.ResetHideLastSeen()
+		return nil
+	case user.FieldDiscoverability:
+		m.ResetDiscoverability()
+		return nil
+	case user.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
+	case user.FieldAvatarID:
+		m.ResetAvatarID()
+		return nil
+	case user.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown User field %s", name)
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.device != nil {
+		edges = append(edges, user.EdgeDevice)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+	if m.notifications != nil {
+		edges = append(edges, user.EdgeNotifications)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	if m.contacts != nil {
+		edges = append(edges, user.EdgeContacts)
 	}
-	return oldValue.Email, nil
-}
-
-// ClearEmail clears the value of the "email" field.
-func (m *UserMutation) ClearEmail() {
-	m.email = nil
-	m.clearedFields[user.FieldEmail] = struct{}{}
-}
-
-// EmailCleared returns if the "email" field was cleared in this mutation.
-func (m *UserMutation) EmailCleared() bool {
-	_, ok := m.clearedFields[user.FieldEmail]
-	return ok
-}
-
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
-	delete(m.clearedFields, user.FieldEmail)
-}
-
-// SetContactPin sets the "contact_pin" field.
-func (m *UserMutation) SetContactPin(s string) {
-	m.contact_pin = &s
+	if m.rooms != nil {
+		edges = append(edges, user.EdgeRooms)
+	}
+	if m.messages != nil {
+		edges = append(edges, user.EdgeMessages)
+	}
+	if m.files != nil {
+		edges = append(edges, user.EdgeFiles)
+	}
+	if m.avatar != nil {
+		edges = append(edges, user.EdgeAvatar)
+	}
+	if m.avatars != nil {
+		edges = append(edges, user.EdgeAvatars)
+	}
+	if m.blocks != nil {
+		edges = append(edges, user.EdgeBlocks)
+	}
+	if m.blocked_by != nil {
+		edges = append(edges, user.EdgeBlockedBy)
+	}
+	if m.user_contacts != nil {
+		edges = append(edges, user.EdgeUserContacts)
+	}
+	if m.memberships != nil {
+		edges = append(edges, user.EdgeMemberships)
+	}
+	return edges
 }
 
-// ContactPin returns the value of the "contact_pin" field in the mutation.
-func (m *UserMutation) ContactPin() (r string, exists bool) {
-	v := m.contact_pin
-	if v == nil {
-		return
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeDevice:
+		if id := m.device; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.notifications))
+		for id := range m.notifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeContacts:
+		ids := make([]ent.Value, 0, len(m.contacts))
+		for id := range m.contacts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRooms:
+		ids := make([]ent.Value, 0, len(m.rooms))
+		for id := range m.rooms {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.messages))
+		for id := range m.messages {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.files))
+		for id := range m.files {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAvatar:
+		if id := m.avatar; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgeAvatars:
+		ids := make([]ent.Value, 0, len(m.avatars))
+		for id := range m.avatars {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBlocks:
+		ids := make([]ent.Value, 0, len(m.blocks))
+		for id := range m.blocks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBlockedBy:
+		ids := make([]ent.Value, 0, len(m.blocked_by))
+		for id := range m.blocked_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUserContacts:
+		ids := make([]ent.Value, 0, len(m.user_contacts))
+		for id := range m.user_contacts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.memberships))
+		for id := range m.memberships {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// OldContactPin returns the old "contact_pin" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldContactPin(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContactPin is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContactPin requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContactPin: %w", err)
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.removednotifications != nil {
+		edges = append(edges, user.EdgeNotifications)
 	}
-	return oldValue.ContactPin, nil
-}
-
-// ClearContactPin clears the value of the "contact_pin" field.
-func (m *UserMutation) ClearContactPin() {
-	m.contact_pin = nil
-	m.clearedFields[user.FieldContactPin] = struct{}{}
-}
-
-// ContactPinCleared returns if the "contact_pin" field was cleared in this mutation.
-func (m *UserMutation) ContactPinCleared() bool {
-	_, ok := m.clearedFields[user.FieldContactPin]
-	return ok
-}
-
-// ResetContactPin resets all changes to the "contact_pin" field.
-func (m *UserMutation) ResetContactPin() {
-	m.contact_pin = nil
-	delete(m.clearedFields, user.FieldContactPin)
-}
-
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
-}
-
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
-	if v == nil {
-		return
+	if m.removedcontacts != nil {
+		edges = append(edges, user.EdgeContacts)
 	}
-	return *v, true
-}
-
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	if m.removedrooms != nil {
+		edges = append(edges, user.EdgeRooms)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+	if m.removedmessages != nil {
+		edges = append(edges, user.EdgeMessages)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	if m.removedfiles != nil {
+		edges = append(edges, user.EdgeFiles)
 	}
-	return oldValue.Password, nil
-}
-
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+	if m.removedavatars != nil {
+		edges = append(edges, user.EdgeAvatars)
 	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	if m.removedblocks != nil {
+		edges = append(edges, user.EdgeBlocks)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	if m.removedblocked_by != nil {
+		edges = append(edges, user.EdgeBlockedBy)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	if m.removeduser_contacts != nil {
+		edges = append(edges, user.EdgeUserContacts)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+	if m.removedmemberships != nil {
+		edges = append(edges, user.EdgeMemberships)
 	}
-	return *v, true
+	return edges
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.removednotifications))
+		for id := range m.removednotifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeContacts:
+		ids := make([]ent.Value, 0, len(m.removedcontacts))
+		for id := range m.removedcontacts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRooms:
+		ids := make([]ent.Value, 0, len(m.removedrooms))
+		for id := range m.removedrooms {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.removedmessages))
+		for id := range m.removedmessages {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.removedfiles))
+		for id := range m.removedfiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAvatars:
+		ids := make([]ent.Value, 0, len(m.removedavatars))
+		for id := range m.removedavatars {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBlocks:
+		ids := make([]ent.Value, 0, len(m.removedblocks))
+		for id := range m.removedblocks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBlockedBy:
+		ids := make([]ent.Value, 0, len(m.removedblocked_by))
+		for id := range m.removedblocked_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUserContacts:
+		ids := make([]ent.Value, 0, len(m.removeduser_contacts))
+		for id := range m.removeduser_contacts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.removedmemberships))
+		for id := range m.removedmemberships {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetDeviceID sets the "device" edge to the Device entity by id.
-func (m *UserMutation) SetDeviceID(id pulid.ID) {
-	m.device = &id
-}
-
-// ClearDevice clears the "device" edge to the Device entity.
-func (m *UserMutation) ClearDevice() {
-	m.cleareddevice = true
-}
-
-// DeviceCleared reports if the "device" edge to the Device entity was cleared.
-func (m *UserMutation) DeviceCleared() bool {
-	return m.cleareddevice
+	return nil
 }
 
-// DeviceID returns the "device" edge ID in the mutation.
-func (m *UserMutation) DeviceID() (id pulid.ID, exists bool) {
-	if m.device != nil {
-		return *m.device, true
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.cleareddevice {
+		edges = append(edges, user.EdgeDevice)
 	}
-	return
-}
-
-// DeviceIDs returns the "device" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DeviceID instead. It exists only for internal usage by the builders.
-func (m *UserMutation) DeviceIDs() (ids []pulid.ID) {
-	if id := m.device; id != nil {
-		ids = append(ids, *id)
+	if m.clearednotifications {
+		edges = append(edges, user.EdgeNotifications)
 	}
-	return
-}
-
-// ResetDevice resets all changes to the "device" edge.
-func (m *UserMutation) ResetDevice() {
-	m.device = nil
-	m.cleareddevice = false
-}
-
-// AddNotificationIDs adds the "notifications" edge to the Notification entity by ids.
-func (m *UserMutation) AddNotificationIDs(ids ...pulid.ID) {
-	if m.notifications == nil {
-		m.notifications = make(map[pulid.ID]struct{})
+	if m.clearedcontacts {
+		edges = append(edges, user.EdgeContacts)
 	}
-	for i := range ids {
-		m.notifications[ids[i]] = struct{}{}
+	if m.clearedrooms {
+		edges = append(edges, user.EdgeRooms)
 	}
-}
-
-// ClearNotifications clears the "notifications" edge to the Notification entity.
-func (m *UserMutation) ClearNotifications() {
-	m.clearednotifications = true
-}
-
-// NotificationsCleared reports if the "notifications" edge to the Notification entity was cleared.
-func (m *UserMutation) NotificationsCleared() bool {
-	return m.clearednotifications
-}
-
-// RemoveNotificationIDs removes the "notifications" edge to the Notification entity by IDs.
-func (m *UserMutation) RemoveNotificationIDs(ids ...pulid.ID) {
-	if m.removednotifications == nil {
-		m.removednotifications = make(map[pulid.ID]struct{})
+	if m.clearedmessages {
+		edges = append(edges, user.EdgeMessages)
 	}
-	for i := range ids {
-		delete(m.notifications, ids[i])
-		m.removednotifications[ids[i]] = struct{}{}
+	if m.clearedfiles {
+		edges = append(edges, user.EdgeFiles)
 	}
-}
-
-// RemovedNotifications returns the removed IDs of the "notifications" edge to the Notification entity.
-func (m *UserMutation) RemovedNotificationsIDs() (ids []pulid.ID) {
-	for id := range m.removednotifications {
-		ids = append(ids, id)
+	if m.clearedavatar {
+		edges = append(edges, user.EdgeAvatar)
 	}
-	return
-}
-
-// NotificationsIDs returns the "notifications" edge IDs in the mutation.
-func (m *UserMutation) NotificationsIDs() (ids []pulid.ID) {
-	for id := range m.notifications {
-		ids = append(ids, id)
+	if m.clearedavatars {
+		edges = append(edges, user.EdgeAvatars)
 	}
-	return
-}
-
-// ResetNotifications resets all changes to the "notifications" edge.
-func (m *UserMutation) ResetNotifications() {
-	m.notifications = nil
-	m.clearednotifications = false
-	m.removednotifications = nil
-}
-
-// AddContactIDs adds the "contacts" edge to the User entity by ids.
-func (m *UserMutation) AddContactIDs(ids ...pulid.ID) {
-	if m.contacts == nil {
-		m.contacts = make(map[pulid.ID]struct{})
+	if m.clearedblocks {
+		edges = append(edges, user.EdgeBlocks)
 	}
-	for i := range ids {
-		m.contacts[ids[i]] = struct{}{}
+	if m.clearedblocked_by {
+		edges = append(edges, user.EdgeBlockedBy)
 	}
-}
-
-// ClearContacts clears the "contacts" edge to the User entity.
-func (m *UserMutation) ClearContacts() {
-	m.clearedcontacts = true
-}
-
-// ContactsCleared reports if the "contacts" edge to the User entity was cleared.
-func (m *UserMutation) ContactsCleared() bool {
-	return m.clearedcontacts
-}
-
-// RemoveContactIDs removes the "contacts" edge to the User entity by IDs.
-func (m *UserMutation) RemoveContactIDs(ids ...pulid.ID) {
-	if m.removedcontacts == nil {
-		m.removedcontacts = make(map[pulid.ID]struct{})
+	if m.cleareduser_contacts {
+		edges = append(edges, user.EdgeUserContacts)
 	}
-	for i := range ids {
-		delete(m.contacts, ids[i])
-		m.removedcontacts[ids[i]] = struct{}{}
+	if m.clearedmemberships {
+		edges = append(edges, user.EdgeMemberships)
 	}
+	return edges
 }
 
-// RemovedContacts returns the removed IDs of the "contacts" edge to the User entity.
-func (m *UserMutation) RemovedContactsIDs() (ids []pulid.ID) {
-	for id := range m.removedcontacts {
-		ids = append(ids, id)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeDevice:
+		return m.cleareddevice
+	case user.EdgeNotifications:
+		return m.clearednotifications
+	case user.EdgeContacts:
+		return m.clearedcontacts
+	case user.EdgeRooms:
+		return m.clearedrooms
+	case user.EdgeMessages:
+		return m.clearedmessages
+	case user.EdgeFiles:
+		return m.clearedfiles
+	case user.EdgeAvatar:
+		return m.clearedavatar
+	case user.EdgeAvatars:
+		return m.clearedavatars
+	case user.EdgeBlocks:
+		return m.clearedblocks
+	case user.EdgeBlockedBy:
+		return m.clearedblocked_by
+	case user.EdgeUserContacts:
+		return m.cleareduser_contacts
+	case user.EdgeMemberships:
+		return m.clearedmemberships
 	}
-	return
+	return false
 }
 
-// ContactsIDs returns the "contacts" edge IDs in the mutation.
-func (m *UserMutation) ContactsIDs() (ids []pulid.ID) {
-	for id := range m.contacts {
-		ids = append(ids, id)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	case user.EdgeDevice:
+		m.ClearDevice()
+		return nil
+	case user.EdgeAvatar:
+		m.ClearAvatar()
+		return nil
 	}
-	return
-}
-
-// ResetContacts resets all changes to the "contacts" edge.
-func (m *UserMutation) ResetContacts() {
-	m.contacts = nil
-	m.clearedcontacts = false
-	m.removedcontacts = nil
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
-// AddRoomIDs adds the "rooms" edge to the Room entity by ids.
-func (m *UserMutation) AddRoomIDs(ids ...pulid.ID) {
-	if m.rooms == nil {
-		m.rooms = make(map[pulid.ID]struct{})
-	}
-	for i := range ids {
-		m.rooms[ids[i]] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeDevice:
+		m.ResetDevice()
+		return nil
+	case user.EdgeNotifications:
+		m.ResetNotifications()
+		return nil
+	case user.EdgeContacts:
+		m.ResetContacts()
+		return nil
+	case user.EdgeRooms:
+		m.ResetRooms()
+		return nil
+	case user.EdgeMessages:
+		m.ResetMessages()
+		return nil
+	case user.EdgeFiles:
+		m.ResetFiles()
+		return nil
+	case user.EdgeAvatar:
+		m.ResetAvatar()
+		return nil
+	case user.EdgeAvatars:
+		m.ResetAvatars()
+		return nil
+	case user.EdgeBlocks:
+		m.ResetBlocks()
+		return nil
+	case user.EdgeBlockedBy:
+		m.ResetBlockedBy()
+		return nil
+	case user.EdgeUserContacts:
+		m.ResetUserContacts()
+		return nil
+	case user.EdgeMemberships:
+		m.ResetMemberships()
+		return nil
 	}
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// ClearRooms clears the "rooms" edge to the Room entity.
-func (m *UserMutation) ClearRooms() {
-	m.clearedrooms = true
-}
-
-// RoomsCleared reports if the "rooms" edge to the Room entity was cleared.
-func (m *UserMutation) RoomsCleared() bool {
-	return m.clearedrooms
-}
-
-// RemoveRoomIDs removes the "rooms" edge to the Room entity by IDs.
-func (m *UserMutation) RemoveRoomIDs(ids ...pulid.ID) {
-	if m.removedrooms == nil {
-		m.removedrooms = make(map[pulid.ID]struct{})
+// UserBlockMutation represents an operation that mutates the UserBlock nodes in the graph.
+type UserBlockMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *pulid.ID
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	user                *pulid.ID
+	cleareduser         bool
+	blocked_user        *pulid.ID
+	clearedblocked_user bool
+	done                bool
+	oldValue            func(context.Context) (*UserBlock, error)
+	predicates          []predicate.UserBlock
+}
+
+var _ ent.Mutation = (*UserBlockMutation)(nil)
+
+// userblockOption allows management of the mutation configuration using functional options.
+type userblockOption func(*UserBlockMutation)
+
+// newUserBlockMutation creates new mutation for the UserBlock entity.
+func newUserBlockMutation(c config, op Op, opts ...userblockOption) *UserBlockMutation {
+	m := &UserBlockMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserBlock,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		delete(m.rooms, ids[i])
-		m.removedrooms[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// RemovedRooms returns the removed IDs of the "rooms" edge to the Room entity.
-func (m *UserMutation) RemovedRoomsIDs() (ids []pulid.ID) {
-	for id := range m.removedrooms {
-		ids = append(ids, id)
+// withUserBlockID sets the ID field of the mutation.
+func withUserBlockID(id pulid.ID) userblockOption {
+	return func(m *UserBlockMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserBlock
+		)
+		m.oldValue = func(ctx context.Context) (*UserBlock, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserBlock.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// RoomsIDs returns the "rooms" edge IDs in the mutation.
-func (m *UserMutation) RoomsIDs() (ids []pulid.ID) {
-	for id := range m.rooms {
-		ids = append(ids, id)
+// withUserBlock sets the old UserBlock of the mutation.
+func withUserBlock(node *UserBlock) userblockOption {
+	return func(m *UserBlockMutation) {
+		m.oldValue = func(context.Context) (*UserBlock, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// ResetRooms resets all changes to the "rooms" edge.
-func (m *UserMutation) ResetRooms() {
-	m.rooms = nil
-	m.clearedrooms = false
-	m.removedrooms = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserBlockMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddMessageIDs adds the "messages" edge to the Message entity by ids.
-func (m *UserMutation) AddMessageIDs(ids ...pulid.ID) {
-	if m.messages == nil {
-		m.messages = make(map[pulid.ID]struct{})
-	}
-	for i := range ids {
-		m.messages[ids[i]] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserBlockMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearMessages clears the "messages" edge to the Message entity.
-func (m *UserMutation) ClearMessages() {
-	m.clearedmessages = true
-}
-
-// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
-func (m *UserMutation) MessagesCleared() bool {
-	return m.clearedmessages
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of UserBlock entities.
+func (m *UserBlockMutation) SetID(id pulid.ID) {
+	m.id = &id
 }
 
-// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
-func (m *UserMutation) RemoveMessageIDs(ids ...pulid.ID) {
-	if m.removedmessages == nil {
-		m.removedmessages = make(map[pulid.ID]struct{})
-	}
-	for i := range ids {
-		delete(m.messages, ids[i])
-		m.removedmessages[ids[i]] = struct{}{}
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserBlockMutation) ID() (id pulid.ID, exists bool) {
+	if m.id == nil {
+		return
 	}
+	return *m.id, true
 }
 
-// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
-func (m *UserMutation) RemovedMessagesIDs() (ids []pulid.ID) {
-	for id := range m.removedmessages {
-		ids = append(ids, id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserBlockMutation) IDs(ctx context.Context) ([]pulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []pulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserBlock.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// MessagesIDs returns the "messages" edge IDs in the mutation.
-func (m *UserMutation) MessagesIDs() (ids []pulid.ID) {
-	for id := range m.messages {
-		ids = append(ids, id)
-	}
-	return
+// SetUserID sets the "user_id" field.
+func (m *UserBlockMutation) SetUserID(pu pulid.ID) {
+	m.user = &pu
 }
 
-// ResetMessages resets all changes to the "messages" edge.
-func (m *UserMutation) ResetMessages() {
-	m.messages = nil
-	m.clearedmessages = false
-	m.removedmessages = nil
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserBlockMutation) UserID() (r pulid.ID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddUserContactIDs adds the "user_contacts" edge to the UserContact entity by ids.
-func (m *UserMutation) AddUserContactIDs(ids ...pulid.ID) {
-	if m.user_contacts == nil {
-		m.user_contacts = make(map[pulid.ID]struct{})
+// OldUserID returns the old "user_id" field's value of the UserBlock entity.
+// If the UserBlock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserBlockMutation) OldUserID(ctx context.Context) (v pulid.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.user_contacts[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
+	return oldValue.UserID, nil
 }
 
-// ClearUserContacts clears the "user_contacts" edge to the UserContact entity.
-func (m *UserMutation) ClearUserContacts() {
-	m.cleareduser_contacts = true
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserBlockMutation) ResetUserID() {
+	m.user = nil
 }
 
-// UserContactsCleared reports if the "user_contacts" edge to the UserContact entity was cleared.
-func (m *UserMutation) UserContactsCleared() bool {
-	return m.cleareduser_contacts
+// SetBlockedUserID sets the "blocked_user_id" field.
+func (m *UserBlockMutation) SetBlockedUserID(pu pulid.ID) {
+	m.blocked_user = &pu
 }
 
-// RemoveUserContactIDs removes the "user_contacts" edge to the UserContact entity by IDs.
-func (m *UserMutation) RemoveUserContactIDs(ids ...pulid.ID) {
-	if m.removeduser_contacts == nil {
-		m.removeduser_contacts = make(map[pulid.ID]struct{})
-	}
-	for i := range ids {
-		delete(m.user_contacts, ids[i])
-		m.removeduser_contacts[ids[i]] = struct{}{}
+// BlockedUserID returns the value of the "blocked_user_id" field in the mutation.
+func (m *UserBlockMutation) BlockedUserID() (r pulid.ID, exists bool) {
+	v := m.blocked_user
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedUserContacts returns the removed IDs of the "user_contacts" edge to the UserContact entity.
-func (m *UserMutation) RemovedUserContactsIDs() (ids []pulid.ID) {
-	for id := range m.removeduser_contacts {
-		ids = append(ids, id)
+// OldBlockedUserID returns the old "blocked_user_id" field's value of the UserBlock entity.
+// If the UserBlock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserBlockMutation) OldBlockedUserID(ctx context.Context) (v pulid.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockedUserID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockedUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockedUserID: %w", err)
+	}
+	return oldValue.BlockedUserID, nil
 }
 
-// UserContactsIDs returns the "user_contacts" edge IDs in the mutation.
-func (m *UserMutation) UserContactsIDs() (ids []pulid.ID) {
-	for id := range m.user_contacts {
-		ids = append(ids, id)
-	}
-	return
+// ResetBlockedUserID resets all changes to the "blocked_user_id" field.
+func (m *UserBlockMutation) ResetBlockedUserID() {
+	m.blocked_user = nil
 }
 
-// ResetUserContacts resets all changes to the "user_contacts" edge.
-func (m *UserMutation) ResetUserContacts() {
-	m.user_contacts = nil
-	m.cleareduser_contacts = false
-	m.removeduser_contacts = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *UserBlockMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// AddMembershipIDs adds the "memberships" edge to the RoomMember entity by ids.
-func (m *UserMutation) AddMembershipIDs(ids ...pulid.ID) {
-	if m.memberships == nil {
-		m.memberships = make(map[pulid.ID]struct{})
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserBlockMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.memberships[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the UserBlock entity.
+// If the UserBlock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserBlockMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ClearMemberships clears the "memberships" edge to the RoomMember entity.
-func (m *UserMutation) ClearMemberships() {
-	m.clearedmemberships = true
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserBlockMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// MembershipsCleared reports if the "memberships" edge to the RoomMember entity was cleared.
-func (m *UserMutation) MembershipsCleared() bool {
-	return m.clearedmemberships
+// ClearUser clears the "user" edge to the User entity.
+func (m *UserBlockMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[userblock.FieldUserID] = struct{}{}
 }
 
-// RemoveMembershipIDs removes the "memberships" edge to the RoomMember entity by IDs.
-func (m *UserMutation) RemoveMembershipIDs(ids ...pulid.ID) {
-	if m.removedmemberships == nil {
-		m.removedmemberships = make(map[pulid.ID]struct{})
-	}
-	for i := range ids {
-		delete(m.memberships, ids[i])
-		m.removedmemberships[ids[i]] = struct{}{}
-	}
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UserBlockMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// RemovedMemberships returns the removed IDs of the "memberships" edge to the RoomMember entity.
-func (m *UserMutation) RemovedMembershipsIDs() (ids []pulid.ID) {
-	for id := range m.removedmemberships {
-		ids = append(ids, id)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UserBlockMutation) UserIDs() (ids []pulid.ID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// MembershipsIDs returns the "memberships" edge IDs in the mutation.
-func (m *UserMutation) MembershipsIDs() (ids []pulid.ID) {
-	for id := range m.memberships {
-		ids = append(ids, id)
+// ResetUser resets all changes to the "user" edge.
+func (m *UserBlockMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearBlockedUser clears the "blocked_user" edge to the User entity.
+func (m *UserBlockMutation) ClearBlockedUser() {
+	m.clearedblocked_user = true
+	m.clearedFields[userblock.FieldBlockedUserID] = struct{}{}
+}
+
+// BlockedUserCleared reports if the "blocked_user" edge to the User entity was cleared.
+func (m *UserBlockMutation) BlockedUserCleared() bool {
+	return m.clearedblocked_user
+}
+
+// BlockedUserIDs returns the "blocked_user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BlockedUserID instead. It exists only for internal usage by the builders.
+func (m *UserBlockMutation) BlockedUserIDs() (ids []pulid.ID) {
+	if id := m.blocked_user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetMemberships resets all changes to the "memberships" edge.
-func (m *UserMutation) ResetMemberships() {
-	m.memberships = nil
-	m.clearedmemberships = false
-	m.removedmemberships = nil
+// ResetBlockedUser resets all changes to the "blocked_user" edge.
+func (m *UserBlockMutation) ResetBlockedUser() {
+	m.blocked_user = nil
+	m.clearedblocked_user = false
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the UserBlockMutation builder.
+func (m *UserBlockMutation) Where(ps ...predicate.UserBlock) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserBlockMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *UserBlockMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserBlock, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8225,48 +18859,33 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *UserBlockMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *UserBlockMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (UserBlock).
+func (m *UserBlockMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.first_name != nil {
-		fields = append(fields, user.FieldFirstName)
-	}
-	if m.last_name != nil {
-		fields = append(fields, user.FieldLastName)
-	}
-	if m.nickname != nil {
-		fields = append(fields, user.FieldNickname)
-	}
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
-	}
-	if m.contact_pin != nil {
-		fields = append(fields, user.FieldContactPin)
+func (m *UserBlockMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.user != nil {
+		fields = append(fields, userblock.FieldUserID)
 	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
+	if m.blocked_user != nil {
+		fields = append(fields, userblock.FieldBlockedUserID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+		fields = append(fields, userblock.FieldCreatedAt)
 	}
 	return fields
 }
@@ -8274,436 +18893,211 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *UserBlockMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldFirstName:
-		return m.FirstName()
-	case user.FieldLastName:
-		return m.LastName()
-	case user.FieldNickname:
-		return m.Nickname()
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldContactPin:
-		return m.ContactPin()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldCreatedAt:
+	case userblock.FieldUserID:
+		return m.UserID()
+	case userblock.FieldBlockedUserID:
+		return m.BlockedUserID()
+	case userblock.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case user.FieldFirstName:
-		return m.OldFirstName(ctx)
-	case user.FieldLastName:
-		return m.OldLastName(ctx)
-	case user.FieldNickname:
-		return m.OldNickname(ctx)
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldContactPin:
-		return m.OldContactPin(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
-	case user.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown User field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case user.FieldFirstName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFirstName(v)
-		return nil
-	case user.FieldLastName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastName(v)
-		return nil
-	case user.FieldNickname:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNickname(v)
-		return nil
-	case user.FieldEmail:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmail(v)
-		return nil
-	case user.FieldContactPin:
-		v, ok := value.(string)
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserBlockMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case userblock.FieldUserID:
+		return m.OldUserID(ctx)
+	case userblock.FieldBlockedUserID:
+		return m.OldBlockedUserID(ctx)
+	case userblock.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown UserBlock field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserBlockMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case userblock.FieldUserID:
+		v, ok := value.(pulid.ID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContactPin(v)
+		m.SetUserID(v)
 		return nil
-	case user.FieldPassword:
-		v, ok := value.(string)
+	case userblock.FieldBlockedUserID:
+		v, ok := value.(pulid.ID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassword(v)
+		m.SetBlockedUserID(v)
 		return nil
-	case user.FieldCreatedAt:
+	case userblock.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case user.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UserBlock field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *UserBlockMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserBlockMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *UserBlockMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown UserBlock numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(user.FieldEmail) {
-		fields = append(fields, user.FieldEmail)
-	}
-	if m.FieldCleared(user.FieldContactPin) {
-		fields = append(fields, user.FieldContactPin)
-	}
-	return fields
+func (m *UserBlockMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *UserBlockMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	switch name {
-	case user.FieldEmail:
-		m.ClearEmail()
-		return nil
-	case user.FieldContactPin:
-		m.ClearContactPin()
-		return nil
-	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *UserBlockMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserBlock nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *UserBlockMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldFirstName:
-		m.ResetFirstName()
-		return nil
-	case user.FieldLastName:
-		m.ResetLastName()
-		return nil
-	case user.FieldNickname:
-		m.ResetNickname()
-		return nil
-	case user.FieldEmail:
-		m.ResetEmail()
-		return nil
-	case user.FieldContactPin:
-		m.ResetContactPin()
+	case userblock.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
+	case userblock.FieldBlockedUserID:
+		m.ResetBlockedUserID()
 		return nil
-	case user.FieldCreatedAt:
+	case userblock.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case user.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UserBlock field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.device != nil {
-		edges = append(edges, user.EdgeDevice)
-	}
-	if m.notifications != nil {
-		edges = append(edges, user.EdgeNotifications)
-	}
-	if m.contacts != nil {
-		edges = append(edges, user.EdgeContacts)
-	}
-	if m.rooms != nil {
-		edges = append(edges, user.EdgeRooms)
-	}
-	if m.messages != nil {
-		edges = append(edges, user.EdgeMessages)
-	}
-	if m.user_contacts != nil {
-		edges = append(edges, user.EdgeUserContacts)
+func (m *UserBlockMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, userblock.EdgeUser)
 	}
-	if m.memberships != nil {
-		edges = append(edges, user.EdgeMemberships)
+	if m.blocked_user != nil {
+		edges = append(edges, userblock.EdgeBlockedUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *UserBlockMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeDevice:
-		if id := m.device; id != nil {
+	case userblock.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case user.EdgeNotifications:
-		ids := make([]ent.Value, 0, len(m.notifications))
-		for id := range m.notifications {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeContacts:
-		ids := make([]ent.Value, 0, len(m.contacts))
-		for id := range m.contacts {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeRooms:
-		ids := make([]ent.Value, 0, len(m.rooms))
-		for id := range m.rooms {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.messages))
-		for id := range m.messages {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeUserContacts:
-		ids := make([]ent.Value, 0, len(m.user_contacts))
-		for id := range m.user_contacts {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeMemberships:
-		ids := make([]ent.Value, 0, len(m.memberships))
-		for id := range m.memberships {
-			ids = append(ids, id)
+	case userblock.EdgeBlockedUser:
+		if id := m.blocked_user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.removednotifications != nil {
-		edges = append(edges, user.EdgeNotifications)
-	}
-	if m.removedcontacts != nil {
-		edges = append(edges, user.EdgeContacts)
-	}
-	if m.removedrooms != nil {
-		edges = append(edges, user.EdgeRooms)
-	}
-	if m.removedmessages != nil {
-		edges = append(edges, user.EdgeMessages)
-	}
-	if m.removeduser_contacts != nil {
-		edges = append(edges, user.EdgeUserContacts)
-	}
-	if m.removedmemberships != nil {
-		edges = append(edges, user.EdgeMemberships)
-	}
+func (m *UserBlockMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeNotifications:
-		ids := make([]ent.Value, 0, len(m.removednotifications))
-		for id := range m.removednotifications {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeContacts:
-		ids := make([]ent.Value, 0, len(m.removedcontacts))
-		for id := range m.removedcontacts {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeRooms:
-		ids := make([]ent.Value, 0, len(m.removedrooms))
-		for id := range m.removedrooms {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.removedmessages))
-		for id := range m.removedmessages {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeUserContacts:
-		ids := make([]ent.Value, 0, len(m.removeduser_contacts))
-		for id := range m.removeduser_contacts {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeMemberships:
-		ids := make([]ent.Value, 0, len(m.removedmemberships))
-		for id := range m.removedmemberships {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UserBlockMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.cleareddevice {
-		edges = append(edges, user.EdgeDevice)
-	}
-	if m.clearednotifications {
-		edges = append(edges, user.EdgeNotifications)
-	}
-	if m.clearedcontacts {
-		edges = append(edges, user.EdgeContacts)
-	}
-	if m.clearedrooms {
-		edges = append(edges, user.EdgeRooms)
-	}
-	if m.clearedmessages {
-		edges = append(edges, user.EdgeMessages)
-	}
-	if m.cleareduser_contacts {
-		edges = append(edges, user.EdgeUserContacts)
+func (m *UserBlockMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, userblock.EdgeUser)
 	}
-	if m.clearedmemberships {
-		edges = append(edges, user.EdgeMemberships)
+	if m.clearedblocked_user {
+		edges = append(edges, userblock.EdgeBlockedUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *UserBlockMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeDevice:
-		return m.cleareddevice
-	case user.EdgeNotifications:
-		return m.clearednotifications
-	case user.EdgeContacts:
-		return m.clearedcontacts
-	case user.EdgeRooms:
-		return m.clearedrooms
-	case user.EdgeMessages:
-		return m.clearedmessages
-	case user.EdgeUserContacts:
-		return m.cleareduser_contacts
-	case user.EdgeMemberships:
-		return m.clearedmemberships
+	case userblock.EdgeUser:
+		return m.cleareduser
+	case userblock.EdgeBlockedUser:
+		return m.clearedblocked_user
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *UserBlockMutation) ClearEdge(name string) error {
 	switch name {
-	case user.EdgeDevice:
-		m.ClearDevice()
+	case userblock.EdgeUser:
+		m.ClearUser()
+		return nil
+	case userblock.EdgeBlockedUser:
+		m.ClearBlockedUser()
 		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown UserBlock unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *UserBlockMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeDevice:
-		m.ResetDevice()
-		return nil
-	case user.EdgeNotifications:
-		m.ResetNotifications()
-		return nil
-	case user.EdgeContacts:
-		m.ResetContacts()
-		return nil
-	case user.EdgeRooms:
-		m.ResetRooms()
-		return nil
-	case user.EdgeMessages:
-		m.ResetMessages()
-		return nil
-	case user.EdgeUserContacts:
-		m.ResetUserContacts()
+	case userblock.EdgeUser:
+		m.ResetUser()
 		return nil
-	case user.EdgeMemberships:
-		m.ResetMemberships()
+	case userblock.EdgeBlockedUser:
+		m.ResetBlockedUser()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown UserBlock edge %s", name)
 }
 
 // UserContactMutation represents an operation that mutates the UserContact nodes in the graph.
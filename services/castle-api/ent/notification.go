@@ -20,12 +20,16 @@ type Notification struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID pulid.ID `json:"id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type notification.Type `json:"type,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
 	// Body holds the value of the "body" field.
 	Body string `json:"body,omitempty"`
 	// Data holds the value of the "data" field.
 	Data map[string]interface{} `json:"data,omitempty"`
+	// ReadAt holds the value of the "read_at" field.
+	ReadAt *time.Time `json:"read_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -68,9 +72,9 @@ func (*Notification) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case notification.FieldID:
 			values[i] = new(pulid.ID)
-		case notification.FieldTitle, notification.FieldBody:
+		case notification.FieldType, notification.FieldTitle, notification.FieldBody:
 			values[i] = new(sql.NullString)
-		case notification.FieldCreatedAt, notification.FieldUpdatedAt:
+		case notification.FieldReadAt, notification.FieldCreatedAt, notification.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case notification.ForeignKeys[0]: // user_notifications
 			values[i] = &sql.NullScanner{S: new(pulid.ID)}
@@ -95,6 +99,12 @@ func (n *Notification) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				n.ID = *value
 			}
+		case notification.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				n.Type = notification.Type(value.String)
+			}
 		case notification.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
@@ -115,6 +125,13 @@ func (n *Notification) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field data: %w", err)
 				}
 			}
+		case notification.FieldReadAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field read_at", values[i])
+			} else if value.Valid {
+				n.ReadAt = new(time.Time)
+				*n.ReadAt = value.Time
+			}
 		case notification.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -175,6 +192,9 @@ func (n *Notification) String() string {
 	var builder strings.Builder
 	builder.WriteString("Notification(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", n.ID))
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", n.Type))
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(n.Title)
 	builder.WriteString(", ")
@@ -184,6 +204,11 @@ func (n *Notification) String() string {
 	builder.WriteString("data=")
 	builder.WriteString(fmt.Sprintf("%v", n.Data))
 	builder.WriteString(", ")
+	if v := n.ReadAt; v != nil {
+		builder.WriteString("read_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(n.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -25,6 +25,12 @@ type NotificationCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetType sets the "type" field.
+func (nc *NotificationCreate) SetType(n notification.Type) *NotificationCreate {
+	nc.mutation.SetType(n)
+	return nc
+}
+
 // SetTitle sets the "title" field.
 func (nc *NotificationCreate) SetTitle(s string) *NotificationCreate {
 	nc.mutation.SetTitle(s)
@@ -43,6 +49,20 @@ func (nc *NotificationCreate) SetData(m map[string]interface{}) *NotificationCre
 	return nc
 }
 
+// SetReadAt sets the "read_at" field.
+func (nc *NotificationCreate) SetReadAt(t time.Time) *NotificationCreate {
+	nc.mutation.SetReadAt(t)
+	return nc
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableReadAt(t *time.Time) *NotificationCreate {
+	if t != nil {
+		nc.SetReadAt(*t)
+	}
+	return nc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (nc *NotificationCreate) SetCreatedAt(t time.Time) *NotificationCreate {
 	nc.mutation.SetCreatedAt(t)
@@ -155,6 +175,14 @@ func (nc *NotificationCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (nc *NotificationCreate) check() error {
+	if _, ok := nc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Notification.type"`)}
+	}
+	if v, ok := nc.mutation.GetType(); ok {
+		if err := notification.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Notification.type": %w`, err)}
+		}
+	}
 	if _, ok := nc.mutation.Title(); !ok {
 		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Notification.title"`)}
 	}
@@ -203,6 +231,10 @@ func (nc *NotificationCreate) createSpec() (*Notification, *sqlgraph.CreateSpec)
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := nc.mutation.GetType(); ok {
+		_spec.SetField(notification.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
 	if value, ok := nc.mutation.Title(); ok {
 		_spec.SetField(notification.FieldTitle, field.TypeString, value)
 		_node.Title = value
@@ -215,6 +247,10 @@ func (nc *NotificationCreate) createSpec() (*Notification, *sqlgraph.CreateSpec)
 		_spec.SetField(notification.FieldData, field.TypeJSON, value)
 		_node.Data = value
 	}
+	if value, ok := nc.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+		_node.ReadAt = &value
+	}
 	if value, ok := nc.mutation.CreatedAt(); ok {
 		_spec.SetField(notification.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -247,7 +283,7 @@ func (nc *NotificationCreate) createSpec() (*Notification, *sqlgraph.CreateSpec)
 // of the `INSERT` statement. For example:
 //
 //	client.Notification.Create().
-//		SetTitle(v).
+//		SetType(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -256,7 +292,7 @@ func (nc *NotificationCreate) createSpec() (*Notification, *sqlgraph.CreateSpec)
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.NotificationUpsert) {
-//			SetTitle(v+v).
+//			SetType(v+v).
 //		}).
 //		Exec(ctx)
 func (nc *NotificationCreate) OnConflict(opts ...sql.ConflictOption) *NotificationUpsertOne {
@@ -292,6 +328,18 @@ type (
 	}
 )
 
+// SetType sets the "type" field.
+func (u *NotificationUpsert) SetType(v notification.Type) *NotificationUpsert {
+	u.Set(notification.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateType() *NotificationUpsert {
+	u.SetExcluded(notification.FieldType)
+	return u
+}
+
 // SetTitle sets the "title" field.
 func (u *NotificationUpsert) SetTitle(v string) *NotificationUpsert {
 	u.Set(notification.FieldTitle, v)
@@ -334,6 +382,24 @@ func (u *NotificationUpsert) ClearData() *NotificationUpsert {
 	return u
 }
 
+// SetReadAt sets the "read_at" field.
+func (u *NotificationUpsert) SetReadAt(v time.Time) *NotificationUpsert {
+	u.Set(notification.FieldReadAt, v)
+	return u
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateReadAt() *NotificationUpsert {
+	u.SetExcluded(notification.FieldReadAt)
+	return u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *NotificationUpsert) ClearReadAt() *NotificationUpsert {
+	u.SetNull(notification.FieldReadAt)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *NotificationUpsert) SetUpdatedAt(v time.Time) *NotificationUpsert {
 	u.Set(notification.FieldUpdatedAt, v)
@@ -397,6 +463,20 @@ func (u *NotificationUpsertOne) Update(set func(*NotificationUpsert)) *Notificat
 	return u
 }
 
+// SetType sets the "type" field.
+func (u *NotificationUpsertOne) SetType(v notification.Type) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateType() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateType()
+	})
+}
+
 // SetTitle sets the "title" field.
 func (u *NotificationUpsertOne) SetTitle(v string) *NotificationUpsertOne {
 	return u.Update(func(s *NotificationUpsert) {
@@ -446,6 +526,27 @@ func (u *NotificationUpsertOne) ClearData() *NotificationUpsertOne {
 	})
 }
 
+// SetReadAt sets the "read_at" field.
+func (u *NotificationUpsertOne) SetReadAt(v time.Time) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetReadAt(v)
+	})
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateReadAt() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateReadAt()
+	})
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *NotificationUpsertOne) ClearReadAt() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearReadAt()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *NotificationUpsertOne) SetUpdatedAt(v time.Time) *NotificationUpsertOne {
 	return u.Update(func(s *NotificationUpsert) {
@@ -596,7 +697,7 @@ func (ncb *NotificationCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.NotificationUpsert) {
-//			SetTitle(v+v).
+//			SetType(v+v).
 //		}).
 //		Exec(ctx)
 func (ncb *NotificationCreateBulk) OnConflict(opts ...sql.ConflictOption) *NotificationUpsertBulk {
@@ -678,6 +779,20 @@ func (u *NotificationUpsertBulk) Update(set func(*NotificationUpsert)) *Notifica
 	return u
 }
 
+// SetType sets the "type" field.
+func (u *NotificationUpsertBulk) SetType(v notification.Type) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateType() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateType()
+	})
+}
+
 // SetTitle sets the "title" field.
 func (u *NotificationUpsertBulk) SetTitle(v string) *NotificationUpsertBulk {
 	return u.Update(func(s *NotificationUpsert) {
@@ -727,6 +842,27 @@ func (u *NotificationUpsertBulk) ClearData() *NotificationUpsertBulk {
 	})
 }
 
+// SetReadAt sets the "read_at" field.
+func (u *NotificationUpsertBulk) SetReadAt(v time.Time) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetReadAt(v)
+	})
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateReadAt() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateReadAt()
+	})
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *NotificationUpsertBulk) ClearReadAt() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearReadAt()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *NotificationUpsertBulk) SetUpdatedAt(v time.Time) *NotificationUpsertBulk {
 	return u.Update(func(s *NotificationUpsert) {
@@ -302,12 +302,12 @@ func (nq *NotificationQuery) WithUser(opts ...func(*UserQuery)) *NotificationQue
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		Type notification.Type `json:"type,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Notification.Query().
-//		GroupBy(notification.FieldTitle).
+//		GroupBy(notification.FieldType).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (nq *NotificationQuery) GroupBy(field string, fields ...string) *NotificationGroupBy {
@@ -325,11 +325,11 @@ func (nq *NotificationQuery) GroupBy(field string, fields ...string) *Notificati
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		Type notification.Type `json:"type,omitempty"`
 //	}
 //
 //	client.Notification.Query().
-//		Select(notification.FieldTitle).
+//		Select(notification.FieldType).
 //		Scan(ctx, &v)
 func (nq *NotificationQuery) Select(fields ...string) *NotificationSelect {
 	nq.ctx.Fields = append(nq.ctx.Fields, fields...)
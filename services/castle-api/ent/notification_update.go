@@ -30,6 +30,20 @@ func (nu *NotificationUpdate) Where(ps ...predicate.Notification) *NotificationU
 	return nu
 }
 
+// SetType sets the "type" field.
+func (nu *NotificationUpdate) SetType(n notification.Type) *NotificationUpdate {
+	nu.mutation.SetType(n)
+	return nu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableType(n *notification.Type) *NotificationUpdate {
+	if n != nil {
+		nu.SetType(*n)
+	}
+	return nu
+}
+
 // SetTitle sets the "title" field.
 func (nu *NotificationUpdate) SetTitle(s string) *NotificationUpdate {
 	nu.mutation.SetTitle(s)
@@ -70,6 +84,26 @@ func (nu *NotificationUpdate) ClearData() *NotificationUpdate {
 	return nu
 }
 
+// SetReadAt sets the "read_at" field.
+func (nu *NotificationUpdate) SetReadAt(t time.Time) *NotificationUpdate {
+	nu.mutation.SetReadAt(t)
+	return nu
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableReadAt(t *time.Time) *NotificationUpdate {
+	if t != nil {
+		nu.SetReadAt(*t)
+	}
+	return nu
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (nu *NotificationUpdate) ClearReadAt() *NotificationUpdate {
+	nu.mutation.ClearReadAt()
+	return nu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (nu *NotificationUpdate) SetUpdatedAt(t time.Time) *NotificationUpdate {
 	nu.mutation.SetUpdatedAt(t)
@@ -142,7 +176,20 @@ func (nu *NotificationUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (nu *NotificationUpdate) check() error {
+	if v, ok := nu.mutation.GetType(); ok {
+		if err := notification.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Notification.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (nu *NotificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := nu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(notification.Table, notification.Columns, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString))
 	if ps := nu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -151,6 +198,9 @@ func (nu *NotificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := nu.mutation.GetType(); ok {
+		_spec.SetField(notification.FieldType, field.TypeEnum, value)
+	}
 	if value, ok := nu.mutation.Title(); ok {
 		_spec.SetField(notification.FieldTitle, field.TypeString, value)
 	}
@@ -163,6 +213,12 @@ func (nu *NotificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if nu.mutation.DataCleared() {
 		_spec.ClearField(notification.FieldData, field.TypeJSON)
 	}
+	if value, ok := nu.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+	}
+	if nu.mutation.ReadAtCleared() {
+		_spec.ClearField(notification.FieldReadAt, field.TypeTime)
+	}
 	if value, ok := nu.mutation.UpdatedAt(); ok {
 		_spec.SetField(notification.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -215,6 +271,20 @@ type NotificationUpdateOne struct {
 	mutation *NotificationMutation
 }
 
+// SetType sets the "type" field.
+func (nuo *NotificationUpdateOne) SetType(n notification.Type) *NotificationUpdateOne {
+	nuo.mutation.SetType(n)
+	return nuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableType(n *notification.Type) *NotificationUpdateOne {
+	if n != nil {
+		nuo.SetType(*n)
+	}
+	return nuo
+}
+
 // SetTitle sets the "title" field.
 func (nuo *NotificationUpdateOne) SetTitle(s string) *NotificationUpdateOne {
 	nuo.mutation.SetTitle(s)
@@ -255,6 +325,26 @@ func (nuo *NotificationUpdateOne) ClearData() *NotificationUpdateOne {
 	return nuo
 }
 
+// SetReadAt sets the "read_at" field.
+func (nuo *NotificationUpdateOne) SetReadAt(t time.Time) *NotificationUpdateOne {
+	nuo.mutation.SetReadAt(t)
+	return nuo
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableReadAt(t *time.Time) *NotificationUpdateOne {
+	if t != nil {
+		nuo.SetReadAt(*t)
+	}
+	return nuo
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (nuo *NotificationUpdateOne) ClearReadAt() *NotificationUpdateOne {
+	nuo.mutation.ClearReadAt()
+	return nuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (nuo *NotificationUpdateOne) SetUpdatedAt(t time.Time) *NotificationUpdateOne {
 	nuo.mutation.SetUpdatedAt(t)
@@ -340,7 +430,20 @@ func (nuo *NotificationUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (nuo *NotificationUpdateOne) check() error {
+	if v, ok := nuo.mutation.GetType(); ok {
+		if err := notification.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Notification.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (nuo *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notification, err error) {
+	if err := nuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(notification.Table, notification.Columns, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString))
 	id, ok := nuo.mutation.ID()
 	if !ok {
@@ -366,6 +469,9 @@ func (nuo *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notificat
 			}
 		}
 	}
+	if value, ok := nuo.mutation.GetType(); ok {
+		_spec.SetField(notification.FieldType, field.TypeEnum, value)
+	}
 	if value, ok := nuo.mutation.Title(); ok {
 		_spec.SetField(notification.FieldTitle, field.TypeString, value)
 	}
@@ -378,6 +484,12 @@ func (nuo *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notificat
 	if nuo.mutation.DataCleared() {
 		_spec.ClearField(notification.FieldData, field.TypeJSON)
 	}
+	if value, ok := nuo.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+	}
+	if nuo.mutation.ReadAtCleared() {
+		_spec.ClearField(notification.FieldReadAt, field.TypeTime)
+	}
 	if value, ok := nuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(notification.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -0,0 +1,525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journeyhub/ent/outboxmessage"
+	"journeyhub/ent/schema/pulid"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OutboxMessageCreate is the builder for creating a OutboxMessage entity.
+type OutboxMessageCreate struct {
+	config
+	mutation *OutboxMessageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetSubject sets the "subject" field.
+func (omc *OutboxMessageCreate) SetSubject(s string) *OutboxMessageCreate {
+	omc.mutation.SetSubject(s)
+	return omc
+}
+
+// SetPayload sets the "payload" field.
+func (omc *OutboxMessageCreate) SetPayload(b []byte) *OutboxMessageCreate {
+	omc.mutation.SetPayload(b)
+	return omc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (omc *OutboxMessageCreate) SetCreatedAt(t time.Time) *OutboxMessageCreate {
+	omc.mutation.SetCreatedAt(t)
+	return omc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (omc *OutboxMessageCreate) SetNillableCreatedAt(t *time.Time) *OutboxMessageCreate {
+	if t != nil {
+		omc.SetCreatedAt(*t)
+	}
+	return omc
+}
+
+// SetID sets the "id" field.
+func (omc *OutboxMessageCreate) SetID(pu pulid.ID) *OutboxMessageCreate {
+	omc.mutation.SetID(pu)
+	return omc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (omc *OutboxMessageCreate) SetNillableID(pu *pulid.ID) *OutboxMessageCreate {
+	if pu != nil {
+		omc.SetID(*pu)
+	}
+	return omc
+}
+
+// Mutation returns the OutboxMessageMutation object of the builder.
+func (omc *OutboxMessageCreate) Mutation() *OutboxMessageMutation {
+	return omc.mutation
+}
+
+// Save creates the OutboxMessage in the database.
+func (omc *OutboxMessageCreate) Save(ctx context.Context) (*OutboxMessage, error) {
+	omc.defaults()
+	return withHooks(ctx, omc.sqlSave, omc.mutation, omc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (omc *OutboxMessageCreate) SaveX(ctx context.Context) *OutboxMessage {
+	v, err := omc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (omc *OutboxMessageCreate) Exec(ctx context.Context) error {
+	_, err := omc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (omc *OutboxMessageCreate) ExecX(ctx context.Context) {
+	if err := omc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (omc *OutboxMessageCreate) defaults() {
+	if _, ok := omc.mutation.CreatedAt(); !ok {
+		v := outboxmessage.DefaultCreatedAt()
+		omc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := omc.mutation.ID(); !ok {
+		v := outboxmessage.DefaultID()
+		omc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (omc *OutboxMessageCreate) check() error {
+	if _, ok := omc.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "OutboxMessage.subject"`)}
+	}
+	if _, ok := omc.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "OutboxMessage.payload"`)}
+	}
+	if _, ok := omc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OutboxMessage.created_at"`)}
+	}
+	return nil
+}
+
+func (omc *OutboxMessageCreate) sqlSave(ctx context.Context) (*OutboxMessage, error) {
+	if err := omc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := omc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, omc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*pulid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	omc.mutation.id = &_node.ID
+	omc.mutation.done = true
+	return _node, nil
+}
+
+func (omc *OutboxMessageCreate) createSpec() (*OutboxMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OutboxMessage{config: omc.config}
+		_spec = sqlgraph.NewCreateSpec(outboxmessage.Table, sqlgraph.NewFieldSpec(outboxmessage.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = omc.conflict
+	if id, ok := omc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := omc.mutation.Subject(); ok {
+		_spec.SetField(outboxmessage.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := omc.mutation.Payload(); ok {
+		_spec.SetField(outboxmessage.FieldPayload, field.TypeBytes, value)
+		_node.Payload = value
+	}
+	if value, ok := omc.mutation.CreatedAt(); ok {
+		_spec.SetField(outboxmessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OutboxMessage.Create().
+//		SetSubject(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OutboxMessageUpsert) {
+//			SetSubject(v+v).
+//		}).
+//		Exec(ctx)
+func (omc *OutboxMessageCreate) OnConflict(opts ...sql.ConflictOption) *OutboxMessageUpsertOne {
+	omc.conflict = opts
+	return &OutboxMessageUpsertOne{
+		create: omc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OutboxMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (omc *OutboxMessageCreate) OnConflictColumns(columns ...string) *OutboxMessageUpsertOne {
+	omc.conflict = append(omc.conflict, sql.ConflictColumns(columns...))
+	return &OutboxMessageUpsertOne{
+		create: omc,
+	}
+}
+
+type (
+	// OutboxMessageUpsertOne is the builder for "upsert"-ing
+	//  one OutboxMessage node.
+	OutboxMessageUpsertOne struct {
+		create *OutboxMessageCreate
+	}
+
+	// OutboxMessageUpsert is the "OnConflict" setter.
+	OutboxMessageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.OutboxMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(outboxmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OutboxMessageUpsertOne) UpdateNewValues() *OutboxMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(outboxmessage.FieldID)
+		}
+		if _, exists := u.create.mutation.Subject(); exists {
+			s.SetIgnore(outboxmessage.FieldSubject)
+		}
+		if _, exists := u.create.mutation.Payload(); exists {
+			s.SetIgnore(outboxmessage.FieldPayload)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(outboxmessage.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OutboxMessage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *OutboxMessageUpsertOne) Ignore() *OutboxMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OutboxMessageUpsertOne) DoNothing() *OutboxMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OutboxMessageCreate.OnConflict
+// documentation for more info.
+func (u *OutboxMessageUpsertOne) Update(set func(*OutboxMessageUpsert)) *OutboxMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OutboxMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *OutboxMessageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OutboxMessageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OutboxMessageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *OutboxMessageUpsertOne) ID(ctx context.Context) (id pulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: OutboxMessageUpsertOne.ID is not supported by MySQL driver. Use OutboxMessageUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *OutboxMessageUpsertOne) IDX(ctx context.Context) pulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// OutboxMessageCreateBulk is the builder for creating many OutboxMessage entities in bulk.
+type OutboxMessageCreateBulk struct {
+	config
+	err      error
+	builders []*OutboxMessageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the OutboxMessage entities in the database.
+func (omcb *OutboxMessageCreateBulk) Save(ctx context.Context) ([]*OutboxMessage, error) {
+	if omcb.err != nil {
+		return nil, omcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(omcb.builders))
+	nodes := make([]*OutboxMessage, len(omcb.builders))
+	mutators := make([]Mutator, len(omcb.builders))
+	for i := range omcb.builders {
+		func(i int, root context.Context) {
+			builder := omcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OutboxMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, omcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = omcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, omcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, omcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (omcb *OutboxMessageCreateBulk) SaveX(ctx context.Context) []*OutboxMessage {
+	v, err := omcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (omcb *OutboxMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := omcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (omcb *OutboxMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := omcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OutboxMessage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OutboxMessageUpsert) {
+//			SetSubject(v+v).
+//		}).
+//		Exec(ctx)
+func (omcb *OutboxMessageCreateBulk) OnConflict(opts ...sql.ConflictOption) *OutboxMessageUpsertBulk {
+	omcb.conflict = opts
+	return &OutboxMessageUpsertBulk{
+		create: omcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OutboxMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (omcb *OutboxMessageCreateBulk) OnConflictColumns(columns ...string) *OutboxMessageUpsertBulk {
+	omcb.conflict = append(omcb.conflict, sql.ConflictColumns(columns...))
+	return &OutboxMessageUpsertBulk{
+		create: omcb,
+	}
+}
+
+// OutboxMessageUpsertBulk is the builder for "upsert"-ing
+// a bulk of OutboxMessage nodes.
+type OutboxMessageUpsertBulk struct {
+	create *OutboxMessageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.OutboxMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(outboxmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OutboxMessageUpsertBulk) UpdateNewValues() *OutboxMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(outboxmessage.FieldID)
+			}
+			if _, exists := b.mutation.Subject(); exists {
+				s.SetIgnore(outboxmessage.FieldSubject)
+			}
+			if _, exists := b.mutation.Payload(); exists {
+				s.SetIgnore(outboxmessage.FieldPayload)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(outboxmessage.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OutboxMessage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *OutboxMessageUpsertBulk) Ignore() *OutboxMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OutboxMessageUpsertBulk) DoNothing() *OutboxMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OutboxMessageCreateBulk.OnConflict
+// documentation for more info.
+func (u *OutboxMessageUpsertBulk) Update(set func(*OutboxMessageUpsert)) *OutboxMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OutboxMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *OutboxMessageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OutboxMessageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OutboxMessageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OutboxMessageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
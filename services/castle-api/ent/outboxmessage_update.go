@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journeyhub/ent/outboxmessage"
+	"journeyhub/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OutboxMessageUpdate is the builder for updating OutboxMessage entities.
+type OutboxMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OutboxMessageMutation
+}
+
+// Where appends a list predicates to the OutboxMessageUpdate builder.
+func (omu *OutboxMessageUpdate) Where(ps ...predicate.OutboxMessage) *OutboxMessageUpdate {
+	omu.mutation.Where(ps...)
+	return omu
+}
+
+// Mutation returns the OutboxMessageMutation object of the builder.
+func (omu *OutboxMessageUpdate) Mutation() *OutboxMessageMutation {
+	return omu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (omu *OutboxMessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, omu.sqlSave, omu.mutation, omu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (omu *OutboxMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := omu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (omu *OutboxMessageUpdate) Exec(ctx context.Context) error {
+	_, err := omu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (omu *OutboxMessageUpdate) ExecX(ctx context.Context) {
+	if err := omu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (omu *OutboxMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(outboxmessage.Table, outboxmessage.Columns, sqlgraph.NewFieldSpec(outboxmessage.FieldID, field.TypeString))
+	if ps := omu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, omu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	omu.mutation.done = true
+	return n, nil
+}
+
+// OutboxMessageUpdateOne is the builder for updating a single OutboxMessage entity.
+type OutboxMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OutboxMessageMutation
+}
+
+// Mutation returns the OutboxMessageMutation object of the builder.
+func (omuo *OutboxMessageUpdateOne) Mutation() *OutboxMessageMutation {
+	return omuo.mutation
+}
+
+// Where appends a list predicates to the OutboxMessageUpdate builder.
+func (omuo *OutboxMessageUpdateOne) Where(ps ...predicate.OutboxMessage) *OutboxMessageUpdateOne {
+	omuo.mutation.Where(ps...)
+	return omuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (omuo *OutboxMessageUpdateOne) Select(field string, fields ...string) *OutboxMessageUpdateOne {
+	omuo.fields = append([]string{field}, fields...)
+	return omuo
+}
+
+// Save executes the query and returns the updated OutboxMessage entity.
+func (omuo *OutboxMessageUpdateOne) Save(ctx context.Context) (*OutboxMessage, error) {
+	return withHooks(ctx, omuo.sqlSave, omuo.mutation, omuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (omuo *OutboxMessageUpdateOne) SaveX(ctx context.Context) *OutboxMessage {
+	node, err := omuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (omuo *OutboxMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := omuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (omuo *OutboxMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := omuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (omuo *OutboxMessageUpdateOne) sqlSave(ctx context.Context) (_node *OutboxMessage, err error) {
+	_spec := sqlgraph.NewUpdateSpec(outboxmessage.Table, outboxmessage.Columns, sqlgraph.NewFieldSpec(outboxmessage.FieldID, field.TypeString))
+	id, ok := omuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OutboxMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := omuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outboxmessage.FieldID)
+		for _, f := range fields {
+			if !outboxmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != outboxmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := omuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &OutboxMessage{config: omuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, omuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	omuo.mutation.done = true
+	return _node, nil
+}
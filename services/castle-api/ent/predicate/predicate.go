@@ -6,6 +6,21 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Avatar is the predicate function for avatar builders.
+type Avatar func(*sql.Selector)
+
+// Call is the predicate function for call builders.
+type Call func(*sql.Selector)
+
+// CallParticipant is the predicate function for callparticipant builders.
+type CallParticipant func(*sql.Selector)
+
+// ContactPin is the predicate function for contactpin builders.
+type ContactPin func(*sql.Selector)
+
+// ContactRequest is the predicate function for contactrequest builders.
+type ContactRequest func(*sql.Selector)
+
 // Device is the predicate function for device builders.
 type Device func(*sql.Selector)
 
@@ -27,14 +42,26 @@ type MessageVoice func(*sql.Selector)
 // Notification is the predicate function for notification builders.
 type Notification func(*sql.Selector)
 
+// OutboxMessage is the predicate function for outboxmessage builders.
+type OutboxMessage func(*sql.Selector)
+
+// Report is the predicate function for report builders.
+type Report func(*sql.Selector)
+
 // Room is the predicate function for room builders.
 type Room func(*sql.Selector)
 
+// RoomInvite is the predicate function for roominvite builders.
+type RoomInvite func(*sql.Selector)
+
 // RoomMember is the predicate function for roommember builders.
 type RoomMember func(*sql.Selector)
 
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
+// UserBlock is the predicate function for userblock builders.
+type UserBlock func(*sql.Selector)
+
 // UserContact is the predicate function for usercontact builders.
 type UserContact func(*sql.Selector)
@@ -4,6 +4,11 @@ package ent
 
 import (
 	"fmt"
+	"journeyhub/ent/avatar"
+	"journeyhub/ent/call"
+	"journeyhub/ent/callparticipant"
+	"journeyhub/ent/contactpin"
+	"journeyhub/ent/contactrequest"
 	"journeyhub/ent/device"
 	"journeyhub/ent/file"
 	"journeyhub/ent/message"
@@ -11,15 +16,24 @@ import (
 	"journeyhub/ent/messagelink"
 	"journeyhub/ent/messagevoice"
 	"journeyhub/ent/notification"
+	"journeyhub/ent/outboxmessage"
+	"journeyhub/ent/report"
 	"journeyhub/ent/room"
+	"journeyhub/ent/roominvite"
 	"journeyhub/ent/roommember"
 	"journeyhub/ent/schema/pulid"
 	"journeyhub/ent/user"
+	"journeyhub/ent/userblock"
 	"journeyhub/ent/usercontact"
 )
 
 // prefixMap maps PULID prefixes to table names.
 var prefixMap = map[pulid.ID]string{
+	"AV": avatar.Table,
+	"CA": call.Table,
+	"CP": callparticipant.Table,
+	"PN": contactpin.Table,
+	"CR": contactrequest.Table,
 	"DE": device.Table,
 	"FE": file.Table,
 	"ME": message.Table,
@@ -27,14 +41,23 @@ var prefixMap = map[pulid.ID]string{
 	"ML": messagelink.Table,
 	"MV": messagevoice.Table,
 	"NN": notification.Table,
+	"OM": outboxmessage.Table,
+	"RP": report.Table,
 	"RO": room.Table,
+	"RI": roominvite.Table,
 	"RM": roommember.Table,
 	"UR": user.Table,
+	"UB": userblock.Table,
 	"UC": usercontact.Table,
 }
 
 // tableMap maps table names to PULID prefixes.
 var tableMap = map[string]pulid.ID{
+	avatar.Table:            "AV",
+	call.Table:              "CA",
+	callparticipant.Table:   "CP",
+	contactpin.Table:        "PN",
+	contactrequest.Table:    "CR",
 	device.Table:            "DE",
 	file.Table:              "FE",
 	message.Table:           "ME",
@@ -42,9 +65,13 @@ var tableMap = map[string]pulid.ID{
 	messagelink.Table:       "ML",
 	messagevoice.Table:      "MV",
 	notification.Table:      "NN",
+	outboxmessage.Table:     "OM",
+	report.Table:            "RP",
 	room.Table:              "RO",
+	roominvite.Table:        "RI",
 	roommember.Table:        "RM",
 	user.Table:              "UR",
+	userblock.Table:         "UB",
 	usercontact.Table:       "UC",
 }
 
@@ -0,0 +1,396 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"journeyhub/ent/message"
+	"journeyhub/ent/report"
+	"journeyhub/ent/room"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Report is the model entity for the Report schema.
+type Report struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID pulid.ID `json:"id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type report.Type `json:"type,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason report.Reason `json:"reason,omitempty"`
+	// Text holds the value of the "text" field.
+	Text *string `json:"text,omitempty"`
+	// Snapshot holds the value of the "snapshot" field.
+	Snapshot map[string]interface{} `json:"snapshot,omitempty"`
+	// Status holds the value of the "status" field.
+	Status report.Status `json:"status,omitempty"`
+	// Actions taken when resolving the report
+	Actions []string `json:"actions,omitempty"`
+	// Note of the moderator who closed the report
+	Note *string `json:"note,omitempty"`
+	// ReporterID holds the value of the "reporter_id" field.
+	ReporterID pulid.ID `json:"reporter_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *pulid.ID `json:"user_id,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID *pulid.ID `json:"message_id,omitempty"`
+	// RoomID holds the value of the "room_id" field.
+	RoomID *pulid.ID `json:"room_id,omitempty"`
+	// AssigneeID holds the value of the "assignee_id" field.
+	AssigneeID *pulid.ID `json:"assignee_id,omitempty"`
+	// ClosedAt holds the value of the "closed_at" field.
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReportQuery when eager-loading is set.
+	Edges        ReportEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReportEdges holds the relations/edges for other nodes in the graph.
+type ReportEdges struct {
+	// Reporter holds the value of the reporter edge.
+	Reporter *User `json:"reporter,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// Room holds the value of the room edge.
+	Room *Room `json:"room,omitempty"`
+	// Assignee holds the value of the assignee edge.
+	Assignee *User `json:"assignee,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+	// totalCount holds the count of the edges above.
+	totalCount [5]map[string]int
+}
+
+// ReporterOrErr returns the Reporter value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReportEdges) ReporterOrErr() (*User, error) {
+	if e.Reporter != nil {
+		return e.Reporter, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "reporter"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReportEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReportEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// RoomOrErr returns the Room value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReportEdges) RoomOrErr() (*Room, error) {
+	if e.Room != nil {
+		return e.Room, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: room.Label}
+	}
+	return nil, &NotLoadedError{edge: "room"}
+}
+
+// AssigneeOrErr returns the Assignee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReportEdges) AssigneeOrErr() (*User, error) {
+	if e.Assignee != nil {
+		return e.Assignee, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "assignee"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Report) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case report.FieldUserID, report.FieldMessageID, report.FieldRoomID, report.FieldAssigneeID:
+			values[i] = &sql.NullScanner{S: new(pulid.ID)}
+		case report.FieldSnapshot, report.FieldActions:
+			values[i] = new([]byte)
+		case report.FieldID, report.FieldReporterID:
+			values[i] = new(pulid.ID)
+		case report.FieldType, report.FieldReason, report.FieldText, report.FieldStatus, report.FieldNote:
+			values[i] = new(sql.NullString)
+		case report.FieldClosedAt, report.FieldCreatedAt, report.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Report fields.
+func (r *Report) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case report.FieldID:
+			if value, ok := values[i].(*pulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				r.ID = *value
+			}
+		case report.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				r.Type = report.Type(value.String)
+			}
+		case report.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				r.Reason = report.Reason(value.String)
+			}
+		case report.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				r.Text = new(string)
+				*r.Text = value.String
+			}
+		case report.FieldSnapshot:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.Snapshot); err != nil {
+					return fmt.Errorf("unmarshal field snapshot: %w", err)
+				}
+			}
+		case report.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				r.Status = report.Status(value.String)
+			}
+		case report.FieldActions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field actions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.Actions); err != nil {
+					return fmt.Errorf("unmarshal field actions: %w", err)
+				}
+			}
+		case report.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				r.Note = new(string)
+				*r.Note = value.String
+			}
+		case report.FieldReporterID:
+			if value, ok := values[i].(*pulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field reporter_id", values[i])
+			} else if value != nil {
+				r.ReporterID = *value
+			}
+		case report.FieldUserID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				r.UserID = new(pulid.ID)
+				*r.UserID = *value.S.(*pulid.ID)
+			}
+		case report.FieldMessageID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				r.MessageID = new(pulid.ID)
+				*r.MessageID = *value.S.(*pulid.ID)
+			}
+		case report.FieldRoomID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field room_id", values[i])
+			} else if value.Valid {
+				r.RoomID = new(pulid.ID)
+				*r.RoomID = *value.S.(*pulid.ID)
+			}
+		case report.FieldAssigneeID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field assignee_id", values[i])
+			} else if value.Valid {
+				r.AssigneeID = new(pulid.ID)
+				*r.AssigneeID = *value.S.(*pulid.ID)
+			}
+		case report.FieldClosedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_at", values[i])
+			} else if value.Valid {
+				r.ClosedAt = new(time.Time)
+				*r.ClosedAt = value.Time
+			}
+		case report.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		case report.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				r.UpdatedAt = value.Time
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Report.
+// This includes values selected through modifiers, order, etc.
+func (r *Report) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// QueryReporter queries the "reporter" edge of the Report entity.
+func (r *Report) QueryReporter() *UserQuery {
+	return NewReportClient(r.config).QueryReporter(r)
+}
+
+// QueryUser queries the "user" edge of the Report entity.
+func (r *Report) QueryUser() *UserQuery {
+	return NewReportClient(r.config).QueryUser(r)
+}
+
+// QueryMessage queries the "message" edge of the Report entity.
+func (r *Report) QueryMessage() *MessageQuery {
+	return NewReportClient(r.config).QueryMessage(r)
+}
+
+// QueryRoom queries the "room" edge of the Report entity.
+func (r *Report) QueryRoom() *RoomQuery {
+	return NewReportClient(r.config).QueryRoom(r)
+}
+
+// QueryAssignee queries the "assignee" edge of the Report entity.
+func (r *Report) QueryAssignee() *UserQuery {
+	return NewReportClient(r.config).QueryAssignee(r)
+}
+
+// Update returns a builder for updating this Report.
+// Note that you need to call Report.Unwrap() before calling this method if this Report
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Report) Update() *ReportUpdateOne {
+	return NewReportClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Report entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Report) Unwrap() *Report {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Report is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Report) String() string {
+	var builder strings.Builder
+	builder.WriteString("Report(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", r.Type))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(fmt.Sprintf("%v", r.Reason))
+	builder.WriteString(", ")
+	if v := r.Text; v != nil {
+		builder.WriteString("text=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("snapshot=")
+	builder.WriteString(fmt.Sprintf("%v", r.Snapshot))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", r.Status))
+	builder.WriteString(", ")
+	builder.WriteString("actions=")
+	builder.WriteString(fmt.Sprintf("%v", r.Actions))
+	builder.WriteString(", ")
+	if v := r.Note; v != nil {
+		builder.WriteString("note=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reporter_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.ReporterID))
+	builder.WriteString(", ")
+	if v := r.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := r.MessageID; v != nil {
+		builder.WriteString("message_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := r.RoomID; v != nil {
+		builder.WriteString("room_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := r.AssigneeID; v != nil {
+		builder.WriteString("assignee_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := r.ClosedAt; v != nil {
+		builder.WriteString("closed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(r.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reports is a parsable slice of Report.
+type Reports []*Report
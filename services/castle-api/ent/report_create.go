@@ -0,0 +1,1366 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journeyhub/ent/message"
+	"journeyhub/ent/report"
+	"journeyhub/ent/room"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReportCreate is the builder for creating a Report entity.
+type ReportCreate struct {
+	config
+	mutation *ReportMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetType sets the "type" field.
+func (rc *ReportCreate) SetType(r report.Type) *ReportCreate {
+	rc.mutation.SetType(r)
+	return rc
+}
+
+// SetReason sets the "reason" field.
+func (rc *ReportCreate) SetReason(r report.Reason) *ReportCreate {
+	rc.mutation.SetReason(r)
+	return rc
+}
+
+// SetText sets the "text" field.
+func (rc *ReportCreate) SetText(s string) *ReportCreate {
+	rc.mutation.SetText(s)
+	return rc
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableText(s *string) *ReportCreate {
+	if s != nil {
+		rc.SetText(*s)
+	}
+	return rc
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (rc *ReportCreate) SetSnapshot(m map[string]interface{}) *ReportCreate {
+	rc.mutation.SetSnapshot(m)
+	return rc
+}
+
+// SetStatus sets the "status" field.
+func (rc *ReportCreate) SetStatus(r report.Status) *ReportCreate {
+	rc.mutation.SetStatus(r)
+	return rc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableStatus(r *report.Status) *ReportCreate {
+	if r != nil {
+		rc.SetStatus(*r)
+	}
+	return rc
+}
+
+// SetActions sets the "actions" field.
+func (rc *ReportCreate) SetActions(s []string) *ReportCreate {
+	rc.mutation.SetActions(s)
+	return rc
+}
+
+// SetNote sets the "note" field.
+func (rc *ReportCreate) SetNote(s string) *ReportCreate {
+	rc.mutation.SetNote(s)
+	return rc
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableNote(s *string) *ReportCreate {
+	if s != nil {
+		rc.SetNote(*s)
+	}
+	return rc
+}
+
+// SetReporterID sets the "reporter_id" field.
+func (rc *ReportCreate) SetReporterID(pu pulid.ID) *ReportCreate {
+	rc.mutation.SetReporterID(pu)
+	return rc
+}
+
+// SetUserID sets the "user_id" field.
+func (rc *ReportCreate) SetUserID(pu pulid.ID) *ReportCreate {
+	rc.mutation.SetUserID(pu)
+	return rc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableUserID(pu *pulid.ID) *ReportCreate {
+	if pu != nil {
+		rc.SetUserID(*pu)
+	}
+	return rc
+}
+
+// SetMessageID sets the "message_id" field.
+func (rc *ReportCreate) SetMessageID(pu pulid.ID) *ReportCreate {
+	rc.mutation.SetMessageID(pu)
+	return rc
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableMessageID(pu *pulid.ID) *ReportCreate {
+	if pu != nil {
+		rc.SetMessageID(*pu)
+	}
+	return rc
+}
+
+// SetRoomID sets the "room_id" field.
+func (rc *ReportCreate) SetRoomID(pu pulid.ID) *ReportCreate {
+	rc.mutation.SetRoomID(pu)
+	return rc
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableRoomID(pu *pulid.ID) *ReportCreate {
+	if pu != nil {
+		rc.SetRoomID(*pu)
+	}
+	return rc
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (rc *ReportCreate) SetAssigneeID(pu pulid.ID) *ReportCreate {
+	rc.mutation.SetAssigneeID(pu)
+	return rc
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableAssigneeID(pu *pulid.ID) *ReportCreate {
+	if pu != nil {
+		rc.SetAssigneeID(*pu)
+	}
+	return rc
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (rc *ReportCreate) SetClosedAt(t time.Time) *ReportCreate {
+	rc.mutation.SetClosedAt(t)
+	return rc
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableClosedAt(t *time.Time) *ReportCreate {
+	if t != nil {
+		rc.SetClosedAt(*t)
+	}
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *ReportCreate) SetCreatedAt(t time.Time) *ReportCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableCreatedAt(t *time.Time) *ReportCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rc *ReportCreate) SetUpdatedAt(t time.Time) *ReportCreate {
+	rc.mutation.SetUpdatedAt(t)
+	return rc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableUpdatedAt(t *time.Time) *ReportCreate {
+	if t != nil {
+		rc.SetUpdatedAt(*t)
+	}
+	return rc
+}
+
+// SetID sets the "id" field.
+func (rc *ReportCreate) SetID(pu pulid.ID) *ReportCreate {
+	rc.mutation.SetID(pu)
+	return rc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableID(pu *pulid.ID) *ReportCreate {
+	if pu != nil {
+		rc.SetID(*pu)
+	}
+	return rc
+}
+
+// SetReporter sets the "reporter" edge to the User entity.
+func (rc *ReportCreate) SetReporter(u *User) *ReportCreate {
+	return rc.SetReporterID(u.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rc *ReportCreate) SetUser(u *User) *ReportCreate {
+	return rc.SetUserID(u.ID)
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (rc *ReportCreate) SetMessage(m *Message) *ReportCreate {
+	return rc.SetMessageID(m.ID)
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (rc *ReportCreate) SetRoom(r *Room) *ReportCreate {
+	return rc.SetRoomID(r.ID)
+}
+
+// SetAssignee sets the "assignee" edge to the User entity.
+func (rc *ReportCreate) SetAssignee(u *User) *ReportCreate {
+	return rc.SetAssigneeID(u.ID)
+}
+
+// Mutation returns the ReportMutation object of the builder.
+func (rc *ReportCreate) Mutation() *ReportMutation {
+	return rc.mutation
+}
+
+// Save creates the Report in the database.
+func (rc *ReportCreate) Save(ctx context.Context) (*Report, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReportCreate) SaveX(ctx context.Context) *Report {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReportCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReportCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReportCreate) defaults() {
+	if _, ok := rc.mutation.Status(); !ok {
+		v := report.DefaultStatus
+		rc.mutation.SetStatus(v)
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := report.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		v := report.DefaultUpdatedAt()
+		rc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rc.mutation.ID(); !ok {
+		v := report.DefaultID()
+		rc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReportCreate) check() error {
+	if _, ok := rc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Report.type"`)}
+	}
+	if v, ok := rc.mutation.GetType(); ok {
+		if err := report.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Report.type": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "Report.reason"`)}
+	}
+	if v, ok := rc.mutation.Reason(); ok {
+		if err := report.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Report.reason": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Snapshot(); !ok {
+		return &ValidationError{Name: "snapshot", err: errors.New(`ent: missing required field "Report.snapshot"`)}
+	}
+	if _, ok := rc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Report.status"`)}
+	}
+	if v, ok := rc.mutation.Status(); ok {
+		if err := report.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Report.status": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.ReporterID(); !ok {
+		return &ValidationError{Name: "reporter_id", err: errors.New(`ent: missing required field "Report.reporter_id"`)}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Report.created_at"`)}
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Report.updated_at"`)}
+	}
+	if len(rc.mutation.ReporterIDs()) == 0 {
+		return &ValidationError{Name: "reporter", err: errors.New(`ent: missing required edge "Report.reporter"`)}
+	}
+	return nil
+}
+
+func (rc *ReportCreate) sqlSave(ctx context.Context) (*Report, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*pulid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *ReportCreate) createSpec() (*Report, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Report{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(report.Table, sqlgraph.NewFieldSpec(report.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = rc.conflict
+	if id, ok := rc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rc.mutation.GetType(); ok {
+		_spec.SetField(report.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := rc.mutation.Reason(); ok {
+		_spec.SetField(report.FieldReason, field.TypeEnum, value)
+		_node.Reason = value
+	}
+	if value, ok := rc.mutation.Text(); ok {
+		_spec.SetField(report.FieldText, field.TypeString, value)
+		_node.Text = &value
+	}
+	if value, ok := rc.mutation.Snapshot(); ok {
+		_spec.SetField(report.FieldSnapshot, field.TypeJSON, value)
+		_node.Snapshot = value
+	}
+	if value, ok := rc.mutation.Status(); ok {
+		_spec.SetField(report.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := rc.mutation.Actions(); ok {
+		_spec.SetField(report.FieldActions, field.TypeJSON, value)
+		_node.Actions = value
+	}
+	if value, ok := rc.mutation.Note(); ok {
+		_spec.SetField(report.FieldNote, field.TypeString, value)
+		_node.Note = &value
+	}
+	if value, ok := rc.mutation.ClosedAt(); ok {
+		_spec.SetField(report.FieldClosedAt, field.TypeTime, value)
+		_node.ClosedAt = &value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(report.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rc.mutation.UpdatedAt(); ok {
+		_spec.SetField(report.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := rc.mutation.ReporterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.ReporterTable,
+			Columns: []string{report.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReporterID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.UserTable,
+			Columns: []string{report.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.MessageTable,
+			Columns: []string{report.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.RoomTable,
+			Columns: []string{report.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoomID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.AssigneeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.AssigneeTable,
+			Columns: []string{report.AssigneeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AssigneeID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Report.Create().
+//		SetType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReportUpsert) {
+//			SetType(v+v).
+//		}).
+//		Exec(ctx)
+func (rc *ReportCreate) OnConflict(opts ...sql.ConflictOption) *ReportUpsertOne {
+	rc.conflict = opts
+	return &ReportUpsertOne{
+		create: rc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rc *ReportCreate) OnConflictColumns(columns ...string) *ReportUpsertOne {
+	rc.conflict = append(rc.conflict, sql.ConflictColumns(columns...))
+	return &ReportUpsertOne{
+		create: rc,
+	}
+}
+
+type (
+	// ReportUpsertOne is the builder for "upsert"-ing
+	//  one Report node.
+	ReportUpsertOne struct {
+		create *ReportCreate
+	}
+
+	// ReportUpsert is the "OnConflict" setter.
+	ReportUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *ReportUpsert) SetStatus(v report.Status) *ReportUpsert {
+	u.Set(report.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateStatus() *ReportUpsert {
+	u.SetExcluded(report.FieldStatus)
+	return u
+}
+
+// SetActions sets the "actions" field.
+func (u *ReportUpsert) SetActions(v []string) *ReportUpsert {
+	u.Set(report.FieldActions, v)
+	return u
+}
+
+// UpdateActions sets the "actions" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateActions() *ReportUpsert {
+	u.SetExcluded(report.FieldActions)
+	return u
+}
+
+// ClearActions clears the value of the "actions" field.
+func (u *ReportUpsert) ClearActions() *ReportUpsert {
+	u.SetNull(report.FieldActions)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *ReportUpsert) SetNote(v string) *ReportUpsert {
+	u.Set(report.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateNote() *ReportUpsert {
+	u.SetExcluded(report.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *ReportUpsert) ClearNote() *ReportUpsert {
+	u.SetNull(report.FieldNote)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ReportUpsert) SetUserID(v pulid.ID) *ReportUpsert {
+	u.Set(report.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateUserID() *ReportUpsert {
+	u.SetExcluded(report.FieldUserID)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *ReportUpsert) ClearUserID() *ReportUpsert {
+	u.SetNull(report.FieldUserID)
+	return u
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *ReportUpsert) SetMessageID(v pulid.ID) *ReportUpsert {
+	u.Set(report.FieldMessageID, v)
+	return u
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateMessageID() *ReportUpsert {
+	u.SetExcluded(report.FieldMessageID)
+	return u
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (u *ReportUpsert) ClearMessageID() *ReportUpsert {
+	u.SetNull(report.FieldMessageID)
+	return u
+}
+
+// SetRoomID sets the "room_id" field.
+func (u *ReportUpsert) SetRoomID(v pulid.ID) *ReportUpsert {
+	u.Set(report.FieldRoomID, v)
+	return u
+}
+
+// UpdateRoomID sets the "room_id" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateRoomID() *ReportUpsert {
+	u.SetExcluded(report.FieldRoomID)
+	return u
+}
+
+// ClearRoomID clears the value of the "room_id" field.
+func (u *ReportUpsert) ClearRoomID() *ReportUpsert {
+	u.SetNull(report.FieldRoomID)
+	return u
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (u *ReportUpsert) SetAssigneeID(v pulid.ID) *ReportUpsert {
+	u.Set(report.FieldAssigneeID, v)
+	return u
+}
+
+// UpdateAssigneeID sets the "assignee_id" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateAssigneeID() *ReportUpsert {
+	u.SetExcluded(report.FieldAssigneeID)
+	return u
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (u *ReportUpsert) ClearAssigneeID() *ReportUpsert {
+	u.SetNull(report.FieldAssigneeID)
+	return u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *ReportUpsert) SetClosedAt(v time.Time) *ReportUpsert {
+	u.Set(report.FieldClosedAt, v)
+	return u
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateClosedAt() *ReportUpsert {
+	u.SetExcluded(report.FieldClosedAt)
+	return u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *ReportUpsert) ClearClosedAt() *ReportUpsert {
+	u.SetNull(report.FieldClosedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReportUpsert) SetUpdatedAt(v time.Time) *ReportUpsert {
+	u.Set(report.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateUpdatedAt() *ReportUpsert {
+	u.SetExcluded(report.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(report.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReportUpsertOne) UpdateNewValues() *ReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(report.FieldID)
+		}
+		if _, exists := u.create.mutation.GetType(); exists {
+			s.SetIgnore(report.FieldType)
+		}
+		if _, exists := u.create.mutation.Reason(); exists {
+			s.SetIgnore(report.FieldReason)
+		}
+		if _, exists := u.create.mutation.Text(); exists {
+			s.SetIgnore(report.FieldText)
+		}
+		if _, exists := u.create.mutation.Snapshot(); exists {
+			s.SetIgnore(report.FieldSnapshot)
+		}
+		if _, exists := u.create.mutation.ReporterID(); exists {
+			s.SetIgnore(report.FieldReporterID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(report.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReportUpsertOne) Ignore() *ReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReportUpsertOne) DoNothing() *ReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReportCreate.OnConflict
+// documentation for more info.
+func (u *ReportUpsertOne) Update(set func(*ReportUpsert)) *ReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReportUpsertOne) SetStatus(v report.Status) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateStatus() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetActions sets the "actions" field.
+func (u *ReportUpsertOne) SetActions(v []string) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetActions(v)
+	})
+}
+
+// UpdateActions sets the "actions" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateActions() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateActions()
+	})
+}
+
+// ClearActions clears the value of the "actions" field.
+func (u *ReportUpsertOne) ClearActions() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearActions()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *ReportUpsertOne) SetNote(v string) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateNote() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *ReportUpsertOne) ClearNote() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ReportUpsertOne) SetUserID(v pulid.ID) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateUserID() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *ReportUpsertOne) ClearUserID() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *ReportUpsertOne) SetMessageID(v pulid.ID) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateMessageID() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (u *ReportUpsertOne) ClearMessageID() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearMessageID()
+	})
+}
+
+// SetRoomID sets the "room_id" field.
+func (u *ReportUpsertOne) SetRoomID(v pulid.ID) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetRoomID(v)
+	})
+}
+
+// UpdateRoomID sets the "room_id" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateRoomID() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateRoomID()
+	})
+}
+
+// ClearRoomID clears the value of the "room_id" field.
+func (u *ReportUpsertOne) ClearRoomID() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearRoomID()
+	})
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (u *ReportUpsertOne) SetAssigneeID(v pulid.ID) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetAssigneeID(v)
+	})
+}
+
+// UpdateAssigneeID sets the "assignee_id" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateAssigneeID() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateAssigneeID()
+	})
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (u *ReportUpsertOne) ClearAssigneeID() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearAssigneeID()
+	})
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *ReportUpsertOne) SetClosedAt(v time.Time) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetClosedAt(v)
+	})
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateClosedAt() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateClosedAt()
+	})
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *ReportUpsertOne) ClearClosedAt() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearClosedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReportUpsertOne) SetUpdatedAt(v time.Time) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateUpdatedAt() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReportUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReportCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReportUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReportUpsertOne) ID(ctx context.Context) (id pulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReportUpsertOne.ID is not supported by MySQL driver. Use ReportUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReportUpsertOne) IDX(ctx context.Context) pulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReportCreateBulk is the builder for creating many Report entities in bulk.
+type ReportCreateBulk struct {
+	config
+	err      error
+	builders []*ReportCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Report entities in the database.
+func (rcb *ReportCreateBulk) Save(ctx context.Context) ([]*Report, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Report, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReportCreateBulk) SaveX(ctx context.Context) []*Report {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReportCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReportCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Report.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReportUpsert) {
+//			SetType(v+v).
+//		}).
+//		Exec(ctx)
+func (rcb *ReportCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReportUpsertBulk {
+	rcb.conflict = opts
+	return &ReportUpsertBulk{
+		create: rcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rcb *ReportCreateBulk) OnConflictColumns(columns ...string) *ReportUpsertBulk {
+	rcb.conflict = append(rcb.conflict, sql.ConflictColumns(columns...))
+	return &ReportUpsertBulk{
+		create: rcb,
+	}
+}
+
+// ReportUpsertBulk is the builder for "upsert"-ing
+// a bulk of Report nodes.
+type ReportUpsertBulk struct {
+	create *ReportCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(report.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReportUpsertBulk) UpdateNewValues() *ReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(report.FieldID)
+			}
+			if _, exists := b.mutation.GetType(); exists {
+				s.SetIgnore(report.FieldType)
+			}
+			if _, exists := b.mutation.Reason(); exists {
+				s.SetIgnore(report.FieldReason)
+			}
+			if _, exists := b.mutation.Text(); exists {
+				s.SetIgnore(report.FieldText)
+			}
+			if _, exists := b.mutation.Snapshot(); exists {
+				s.SetIgnore(report.FieldSnapshot)
+			}
+			if _, exists := b.mutation.ReporterID(); exists {
+				s.SetIgnore(report.FieldReporterID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(report.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReportUpsertBulk) Ignore() *ReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReportUpsertBulk) DoNothing() *ReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReportCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReportUpsertBulk) Update(set func(*ReportUpsert)) *ReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReportUpsertBulk) SetStatus(v report.Status) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateStatus() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetActions sets the "actions" field.
+func (u *ReportUpsertBulk) SetActions(v []string) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetActions(v)
+	})
+}
+
+// UpdateActions sets the "actions" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateActions() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateActions()
+	})
+}
+
+// ClearActions clears the value of the "actions" field.
+func (u *ReportUpsertBulk) ClearActions() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearActions()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *ReportUpsertBulk) SetNote(v string) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateNote() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *ReportUpsertBulk) ClearNote() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ReportUpsertBulk) SetUserID(v pulid.ID) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateUserID() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *ReportUpsertBulk) ClearUserID() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *ReportUpsertBulk) SetMessageID(v pulid.ID) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateMessageID() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (u *ReportUpsertBulk) ClearMessageID() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearMessageID()
+	})
+}
+
+// SetRoomID sets the "room_id" field.
+func (u *ReportUpsertBulk) SetRoomID(v pulid.ID) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetRoomID(v)
+	})
+}
+
+// UpdateRoomID sets the "room_id" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateRoomID() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateRoomID()
+	})
+}
+
+// ClearRoomID clears the value of the "room_id" field.
+func (u *ReportUpsertBulk) ClearRoomID() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearRoomID()
+	})
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (u *ReportUpsertBulk) SetAssigneeID(v pulid.ID) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetAssigneeID(v)
+	})
+}
+
+// UpdateAssigneeID sets the "assignee_id" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateAssigneeID() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateAssigneeID()
+	})
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (u *ReportUpsertBulk) ClearAssigneeID() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearAssigneeID()
+	})
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *ReportUpsertBulk) SetClosedAt(v time.Time) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetClosedAt(v)
+	})
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateClosedAt() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateClosedAt()
+	})
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *ReportUpsertBulk) ClearClosedAt() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearClosedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReportUpsertBulk) SetUpdatedAt(v time.Time) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateUpdatedAt() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReportUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReportCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReportCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReportUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,931 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journeyhub/ent/message"
+	"journeyhub/ent/predicate"
+	"journeyhub/ent/report"
+	"journeyhub/ent/room"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// ReportUpdate is the builder for updating Report entities.
+type ReportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReportMutation
+}
+
+// Where appends a list predicates to the ReportUpdate builder.
+func (ru *ReportUpdate) Where(ps ...predicate.Report) *ReportUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetStatus sets the "status" field.
+func (ru *ReportUpdate) SetStatus(r report.Status) *ReportUpdate {
+	ru.mutation.SetStatus(r)
+	return ru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableStatus(r *report.Status) *ReportUpdate {
+	if r != nil {
+		ru.SetStatus(*r)
+	}
+	return ru
+}
+
+// SetActions sets the "actions" field.
+func (ru *ReportUpdate) SetActions(s []string) *ReportUpdate {
+	ru.mutation.SetActions(s)
+	return ru
+}
+
+// AppendActions appends s to the "actions" field.
+func (ru *ReportUpdate) AppendActions(s []string) *ReportUpdate {
+	ru.mutation.AppendActions(s)
+	return ru
+}
+
+// ClearActions clears the value of the "actions" field.
+func (ru *ReportUpdate) ClearActions() *ReportUpdate {
+	ru.mutation.ClearActions()
+	return ru
+}
+
+// SetNote sets the "note" field.
+func (ru *ReportUpdate) SetNote(s string) *ReportUpdate {
+	ru.mutation.SetNote(s)
+	return ru
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableNote(s *string) *ReportUpdate {
+	if s != nil {
+		ru.SetNote(*s)
+	}
+	return ru
+}
+
+// ClearNote clears the value of the "note" field.
+func (ru *ReportUpdate) ClearNote() *ReportUpdate {
+	ru.mutation.ClearNote()
+	return ru
+}
+
+// SetUserID sets the "user_id" field.
+func (ru *ReportUpdate) SetUserID(pu pulid.ID) *ReportUpdate {
+	ru.mutation.SetUserID(pu)
+	return ru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableUserID(pu *pulid.ID) *ReportUpdate {
+	if pu != nil {
+		ru.SetUserID(*pu)
+	}
+	return ru
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (ru *ReportUpdate) ClearUserID() *ReportUpdate {
+	ru.mutation.ClearUserID()
+	return ru
+}
+
+// SetMessageID sets the "message_id" field.
+func (ru *ReportUpdate) SetMessageID(pu pulid.ID) *ReportUpdate {
+	ru.mutation.SetMessageID(pu)
+	return ru
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableMessageID(pu *pulid.ID) *ReportUpdate {
+	if pu != nil {
+		ru.SetMessageID(*pu)
+	}
+	return ru
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (ru *ReportUpdate) ClearMessageID() *ReportUpdate {
+	ru.mutation.ClearMessageID()
+	return ru
+}
+
+// SetRoomID sets the "room_id" field.
+func (ru *ReportUpdate) SetRoomID(pu pulid.ID) *ReportUpdate {
+	ru.mutation.SetRoomID(pu)
+	return ru
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableRoomID(pu *pulid.ID) *ReportUpdate {
+	if pu != nil {
+		ru.SetRoomID(*pu)
+	}
+	return ru
+}
+
+// ClearRoomID clears the value of the "room_id" field.
+func (ru *ReportUpdate) ClearRoomID() *ReportUpdate {
+	ru.mutation.ClearRoomID()
+	return ru
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (ru *ReportUpdate) SetAssigneeID(pu pulid.ID) *ReportUpdate {
+	ru.mutation.SetAssigneeID(pu)
+	return ru
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableAssigneeID(pu *pulid.ID) *ReportUpdate {
+	if pu != nil {
+		ru.SetAssigneeID(*pu)
+	}
+	return ru
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (ru *ReportUpdate) ClearAssigneeID() *ReportUpdate {
+	ru.mutation.ClearAssigneeID()
+	return ru
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (ru *ReportUpdate) SetClosedAt(t time.Time) *ReportUpdate {
+	ru.mutation.SetClosedAt(t)
+	return ru
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableClosedAt(t *time.Time) *ReportUpdate {
+	if t != nil {
+		ru.SetClosedAt(*t)
+	}
+	return ru
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (ru *ReportUpdate) ClearClosedAt() *ReportUpdate {
+	ru.mutation.ClearClosedAt()
+	return ru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ru *ReportUpdate) SetUpdatedAt(t time.Time) *ReportUpdate {
+	ru.mutation.SetUpdatedAt(t)
+	return ru
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ru *ReportUpdate) SetUser(u *User) *ReportUpdate {
+	return ru.SetUserID(u.ID)
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (ru *ReportUpdate) SetMessage(m *Message) *ReportUpdate {
+	return ru.SetMessageID(m.ID)
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (ru *ReportUpdate) SetRoom(r *Room) *ReportUpdate {
+	return ru.SetRoomID(r.ID)
+}
+
+// SetAssignee sets the "assignee" edge to the User entity.
+func (ru *ReportUpdate) SetAssignee(u *User) *ReportUpdate {
+	return ru.SetAssigneeID(u.ID)
+}
+
+// Mutation returns the ReportMutation object of the builder.
+func (ru *ReportUpdate) Mutation() *ReportMutation {
+	return ru.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ru *ReportUpdate) ClearUser() *ReportUpdate {
+	ru.mutation.ClearUser()
+	return ru
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (ru *ReportUpdate) ClearMessage() *ReportUpdate {
+	ru.mutation.ClearMessage()
+	return ru
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (ru *ReportUpdate) ClearRoom() *ReportUpdate {
+	ru.mutation.ClearRoom()
+	return ru
+}
+
+// ClearAssignee clears the "assignee" edge to the User entity.
+func (ru *ReportUpdate) ClearAssignee() *ReportUpdate {
+	ru.mutation.ClearAssignee()
+	return ru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReportUpdate) Save(ctx context.Context) (int, error) {
+	ru.defaults()
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReportUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReportUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReportUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ru *ReportUpdate) defaults() {
+	if _, ok := ru.mutation.UpdatedAt(); !ok {
+		v := report.UpdateDefaultUpdatedAt()
+		ru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReportUpdate) check() error {
+	if v, ok := ru.mutation.Status(); ok {
+		if err := report.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Report.status": %w`, err)}
+		}
+	}
+	if ru.mutation.ReporterCleared() && len(ru.mutation.ReporterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Report.reporter"`)
+	}
+	return nil
+}
+
+func (ru *ReportUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(report.Table, report.Columns, sqlgraph.NewFieldSpec(report.FieldID, field.TypeString))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ru.mutation.TextCleared() {
+		_spec.ClearField(report.FieldText, field.TypeString)
+	}
+	if value, ok := ru.mutation.Status(); ok {
+		_spec.SetField(report.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.Actions(); ok {
+		_spec.SetField(report.FieldActions, field.TypeJSON, value)
+	}
+	if value, ok := ru.mutation.AppendedActions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, report.FieldActions, value)
+		})
+	}
+	if ru.mutation.ActionsCleared() {
+		_spec.ClearField(report.FieldActions, field.TypeJSON)
+	}
+	if value, ok := ru.mutation.Note(); ok {
+		_spec.SetField(report.FieldNote, field.TypeString, value)
+	}
+	if ru.mutation.NoteCleared() {
+		_spec.ClearField(report.FieldNote, field.TypeString)
+	}
+	if value, ok := ru.mutation.ClosedAt(); ok {
+		_spec.SetField(report.FieldClosedAt, field.TypeTime, value)
+	}
+	if ru.mutation.ClosedAtCleared() {
+		_spec.ClearField(report.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := ru.mutation.UpdatedAt(); ok {
+		_spec.SetField(report.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ru.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.UserTable,
+			Columns: []string{report.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.UserTable,
+			Columns: []string{report.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.MessageTable,
+			Columns: []string{report.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.MessageTable,
+			Columns: []string{report.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.RoomTable,
+			Columns: []string{report.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.RoomTable,
+			Columns: []string{report.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.AssigneeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.AssigneeTable,
+			Columns: []string{report.AssigneeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.AssigneeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.AssigneeTable,
+			Columns: []string{report.AssigneeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{report.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ReportUpdateOne is the builder for updating a single Report entity.
+type ReportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReportMutation
+}
+
+// SetStatus sets the "status" field.
+func (ruo *ReportUpdateOne) SetStatus(r report.Status) *ReportUpdateOne {
+	ruo.mutation.SetStatus(r)
+	return ruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableStatus(r *report.Status) *ReportUpdateOne {
+	if r != nil {
+		ruo.SetStatus(*r)
+	}
+	return ruo
+}
+
+// SetActions sets the "actions" field.
+func (ruo *ReportUpdateOne) SetActions(s []string) *ReportUpdateOne {
+	ruo.mutation.SetActions(s)
+	return ruo
+}
+
+// AppendActions appends s to the "actions" field.
+func (ruo *ReportUpdateOne) AppendActions(s []string) *ReportUpdateOne {
+	ruo.mutation.AppendActions(s)
+	return ruo
+}
+
+// ClearActions clears the value of the "actions" field.
+func (ruo *ReportUpdateOne) ClearActions() *ReportUpdateOne {
+	ruo.mutation.ClearActions()
+	return ruo
+}
+
+// SetNote sets the "note" field.
+func (ruo *ReportUpdateOne) SetNote(s string) *ReportUpdateOne {
+	ruo.mutation.SetNote(s)
+	return ruo
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableNote(s *string) *ReportUpdateOne {
+	if s != nil {
+		ruo.SetNote(*s)
+	}
+	return ruo
+}
+
+// ClearNote clears the value of the "note" field.
+func (ruo *ReportUpdateOne) ClearNote() *ReportUpdateOne {
+	ruo.mutation.ClearNote()
+	return ruo
+}
+
+// SetUserID sets the "user_id" field.
+func (ruo *ReportUpdateOne) SetUserID(pu pulid.ID) *ReportUpdateOne {
+	ruo.mutation.SetUserID(pu)
+	return ruo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableUserID(pu *pulid.ID) *ReportUpdateOne {
+	if pu != nil {
+		ruo.SetUserID(*pu)
+	}
+	return ruo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (ruo *ReportUpdateOne) ClearUserID() *ReportUpdateOne {
+	ruo.mutation.ClearUserID()
+	return ruo
+}
+
+// SetMessageID sets the "message_id" field.
+func (ruo *ReportUpdateOne) SetMessageID(pu pulid.ID) *ReportUpdateOne {
+	ruo.mutation.SetMessageID(pu)
+	return ruo
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableMessageID(pu *pulid.ID) *ReportUpdateOne {
+	if pu != nil {
+		ruo.SetMessageID(*pu)
+	}
+	return ruo
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (ruo *ReportUpdateOne) ClearMessageID() *ReportUpdateOne {
+	ruo.mutation.ClearMessageID()
+	return ruo
+}
+
+// SetRoomID sets the "room_id" field.
+func (ruo *ReportUpdateOne) SetRoomID(pu pulid.ID) *ReportUpdateOne {
+	ruo.mutation.SetRoomID(pu)
+	return ruo
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableRoomID(pu *pulid.ID) *ReportUpdateOne {
+	if pu != nil {
+		ruo.SetRoomID(*pu)
+	}
+	return ruo
+}
+
+// ClearRoomID clears the value of the "room_id" field.
+func (ruo *ReportUpdateOne) ClearRoomID() *ReportUpdateOne {
+	ruo.mutation.ClearRoomID()
+	return ruo
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (ruo *ReportUpdateOne) SetAssigneeID(pu pulid.ID) *ReportUpdateOne {
+	ruo.mutation.SetAssigneeID(pu)
+	return ruo
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableAssigneeID(pu *pulid.ID) *ReportUpdateOne {
+	if pu != nil {
+		ruo.SetAssigneeID(*pu)
+	}
+	return ruo
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (ruo *ReportUpdateOne) ClearAssigneeID() *ReportUpdateOne {
+	ruo.mutation.ClearAssigneeID()
+	return ruo
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (ruo *ReportUpdateOne) SetClosedAt(t time.Time) *ReportUpdateOne {
+	ruo.mutation.SetClosedAt(t)
+	return ruo
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableClosedAt(t *time.Time) *ReportUpdateOne {
+	if t != nil {
+		ruo.SetClosedAt(*t)
+	}
+	return ruo
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (ruo *ReportUpdateOne) ClearClosedAt() *ReportUpdateOne {
+	ruo.mutation.ClearClosedAt()
+	return ruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ruo *ReportUpdateOne) SetUpdatedAt(t time.Time) *ReportUpdateOne {
+	ruo.mutation.SetUpdatedAt(t)
+	return ruo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ruo *ReportUpdateOne) SetUser(u *User) *ReportUpdateOne {
+	return ruo.SetUserID(u.ID)
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (ruo *ReportUpdateOne) SetMessage(m *Message) *ReportUpdateOne {
+	return ruo.SetMessageID(m.ID)
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (ruo *ReportUpdateOne) SetRoom(r *Room) *ReportUpdateOne {
+	return ruo.SetRoomID(r.ID)
+}
+
+// SetAssignee sets the "assignee" edge to the User entity.
+func (ruo *ReportUpdateOne) SetAssignee(u *User) *ReportUpdateOne {
+	return ruo.SetAssigneeID(u.ID)
+}
+
+// Mutation returns the ReportMutation object of the builder.
+func (ruo *ReportUpdateOne) Mutation() *ReportMutation {
+	return ruo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ruo *ReportUpdateOne) ClearUser() *ReportUpdateOne {
+	ruo.mutation.ClearUser()
+	return ruo
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (ruo *ReportUpdateOne) ClearMessage() *ReportUpdateOne {
+	ruo.mutation.ClearMessage()
+	return ruo
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (ruo *ReportUpdateOne) ClearRoom() *ReportUpdateOne {
+	ruo.mutation.ClearRoom()
+	return ruo
+}
+
+// ClearAssignee clears the "assignee" edge to the User entity.
+func (ruo *ReportUpdateOne) ClearAssignee() *ReportUpdateOne {
+	ruo.mutation.ClearAssignee()
+	return ruo
+}
+
+// Where appends a list predicates to the ReportUpdate builder.
+func (ruo *ReportUpdateOne) Where(ps ...predicate.Report) *ReportUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReportUpdateOne) Select(field string, fields ...string) *ReportUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Report entity.
+func (ruo *ReportUpdateOne) Save(ctx context.Context) (*Report, error) {
+	ruo.defaults()
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReportUpdateOne) SaveX(ctx context.Context) *Report {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReportUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReportUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ruo *ReportUpdateOne) defaults() {
+	if _, ok := ruo.mutation.UpdatedAt(); !ok {
+		v := report.UpdateDefaultUpdatedAt()
+		ruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReportUpdateOne) check() error {
+	if v, ok := ruo.mutation.Status(); ok {
+		if err := report.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Report.status": %w`, err)}
+		}
+	}
+	if ruo.mutation.ReporterCleared() && len(ruo.mutation.ReporterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Report.reporter"`)
+	}
+	return nil
+}
+
+func (ruo *ReportUpdateOne) sqlSave(ctx context.Context) (_node *Report, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(report.Table, report.Columns, sqlgraph.NewFieldSpec(report.FieldID, field.TypeString))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Report.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, report.FieldID)
+		for _, f := range fields {
+			if !report.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != report.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ruo.mutation.TextCleared() {
+		_spec.ClearField(report.FieldText, field.TypeString)
+	}
+	if value, ok := ruo.mutation.Status(); ok {
+		_spec.SetField(report.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.Actions(); ok {
+		_spec.SetField(report.FieldActions, field.TypeJSON, value)
+	}
+	if value, ok := ruo.mutation.AppendedActions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, report.FieldActions, value)
+		})
+	}
+	if ruo.mutation.ActionsCleared() {
+		_spec.ClearField(report.FieldActions, field.TypeJSON)
+	}
+	if value, ok := ruo.mutation.Note(); ok {
+		_spec.SetField(report.FieldNote, field.TypeString, value)
+	}
+	if ruo.mutation.NoteCleared() {
+		_spec.ClearField(report.FieldNote, field.TypeString)
+	}
+	if value, ok := ruo.mutation.ClosedAt(); ok {
+		_spec.SetField(report.FieldClosedAt, field.TypeTime, value)
+	}
+	if ruo.mutation.ClosedAtCleared() {
+		_spec.ClearField(report.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := ruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(report.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ruo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.UserTable,
+			Columns: []string{report.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.UserTable,
+			Columns: []string{report.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.MessageTable,
+			Columns: []string{report.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.MessageTable,
+			Columns: []string{report.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.RoomTable,
+			Columns: []string{report.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.RoomTable,
+			Columns: []string{report.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.AssigneeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.AssigneeTable,
+			Columns: []string{report.AssigneeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.AssigneeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   report.AssigneeTable,
+			Columns: []string{report.AssigneeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Report{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{report.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"fmt"
+	"journeyhub/ent/file"
 	"journeyhub/ent/message"
 	"journeyhub/ent/room"
 	"journeyhub/ent/schema/pulid"
@@ -27,6 +28,10 @@ type Room struct {
 	Description string `json:"description,omitempty"`
 	// Version holds the value of the "version" field.
 	Version uint64 `json:"version,omitempty"`
+	// StorageUsed holds the value of the "storage_used" field.
+	StorageUsed int64 `json:"storage_used,omitempty"`
+	// AvatarID holds the value of the "avatar_id" field.
+	AvatarID *pulid.ID `json:"avatar_id,omitempty"`
 	// Type holds the value of the "type" field.
 	Type room.Type `json:"type,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -56,13 +61,19 @@ type RoomEdges struct {
 	MessageAttachments []*MessageAttachment `json:"message_attachments,omitempty"`
 	// MessageLinks holds the value of the message_links edge.
 	MessageLinks []*MessageLink `json:"message_links,omitempty"`
+	// Files holds the value of the files edge.
+	Files []*File `json:"files,omitempty"`
+	// Avatar holds the value of the avatar edge.
+	Avatar *File `json:"avatar,omitempty"`
+	// Avatars holds the value of the avatars edge.
+	Avatars []*Avatar `json:"avatars,omitempty"`
 	// RoomMembers holds the value of the room_members edge.
 	RoomMembers []*RoomMember `json:"room_members,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [8]bool
+	loadedTypes [11]bool
 	// totalCount holds the count of the edges above.
-	totalCount [8]map[string]int
+	totalCount [10]map[string]int
 
 	namedUserContacts       map[string][]*UserContact
 	namedUsers              map[string][]*User
@@ -70,6 +81,8 @@ type RoomEdges struct {
 	namedMessageVoices      map[string][]*MessageVoice
 	namedMessageAttachments map[string][]*MessageAttachment
 	namedMessageLinks       map[string][]*MessageLink
+	namedFiles              map[string][]*File
+	namedAvatars            map[string][]*Avatar
 	namedRoomMembers        map[string][]*RoomMember
 }
 
@@ -138,10 +151,39 @@ func (e RoomEdges) MessageLinksOrErr() ([]*MessageLink, error) {
 	return nil, &NotLoadedError{edge: "message_links"}
 }
 
+// FilesOrErr returns the Files value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoomEdges) FilesOrErr() ([]*File, error) {
+	if e.loadedTypes[7] {
+		return e.Files, nil
+	}
+	return nil, &NotLoadedError{edge: "files"}
+}
+
+// AvatarOrErr returns the Avatar value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoomEdges) AvatarOrErr() (*File, error) {
+	if e.Avatar != nil {
+		return e.Avatar, nil
+	} else if e.loadedTypes[8] {
+		return nil, &NotFoundError{label: file.Label}
+	}
+	return nil, &NotLoadedError{edge: "avatar"}
+}
+
+// AvatarsOrErr returns the Avatars value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoomEdges) AvatarsOrErr() ([]*Avatar, error) {
+	if e.loadedTypes[9] {
+		return e.Avatars, nil
+	}
+	return nil, &NotLoadedError{edge: "avatars"}
+}
+
 // RoomMembersOrErr returns the RoomMembers value or an error if the edge
 // was not loaded in eager-loading.
 func (e RoomEdges) RoomMembersOrErr() ([]*RoomMember, error) {
-	if e.loadedTypes[7] {
+	if e.loadedTypes[10] {
 		return e.RoomMembers, nil
 	}
 	return nil, &NotLoadedError{edge: "room_members"}
@@ -152,9 +194,11 @@ func (*Room) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case room.FieldAvatarID:
+			values[i] = &sql.NullScanner{S: new(pulid.ID)}
 		case room.FieldID:
 			values[i] = new(pulid.ID)
-		case room.FieldVersion:
+		case room.FieldVersion, room.FieldStorageUsed:
 			values[i] = new(sql.NullInt64)
 		case room.FieldName, room.FieldDescription, room.FieldType:
 			values[i] = new(sql.NullString)
@@ -207,6 +251,19 @@ func (r *Room) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				r.Version = uint64(value.Int64)
 			}
+		case room.FieldStorageUsed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_used", values[i])
+			} else if value.Valid {
+				r.StorageUsed = value.Int64
+			}
+		case room.FieldAvatarID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field avatar_id", values[i])
+			} else if value.Valid {
+				r.AvatarID = new(pulid.ID)
+				*r.AvatarID = *value.S.(*pulid.ID)
+			}
 		case room.FieldType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field type", values[i])
@@ -280,6 +337,21 @@ func (r *Room) QueryMessageLinks() *MessageLinkQuery {
 	return NewRoomClient(r.config).QueryMessageLinks(r)
 }
 
+// QueryFiles queries the "files" edge of the Room entity.
+func (r *Room) QueryFiles() *FileQuery {
+	return NewRoomClient(r.config).QueryFiles(r)
+}
+
+// QueryAvatar queries the "avatar" edge of the Room entity.
+func (r *Room) QueryAvatar() *FileQuery {
+	return NewRoomClient(r.config).QueryAvatar(r)
+}
+
+// QueryAvatars queries the "avatars" edge of the Room entity.
+func (r *Room) QueryAvatars() *AvatarQuery {
+	return NewRoomClient(r.config).QueryAvatars(r)
+}
+
 // QueryRoomMembers queries the "room_members" edge of the Room entity.
 func (r *Room) QueryRoomMembers() *RoomMemberQuery {
 	return NewRoomClient(r.config).QueryRoomMembers(r)
@@ -320,6 +392,14 @@ func (r *Room) String() string {
 	builder.WriteString("version=")
 	builder.WriteString(fmt.Sprintf("%v", r.Version))
 	builder.WriteString(", ")
+	builder.WriteString("storage_used=")
+	builder.WriteString(fmt.Sprintf("%v", r.StorageUsed))
+	builder.WriteString(", ")
+	if v := r.AvatarID; v != nil {
+		builder.WriteString("avatar_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("type=")
 	builder.WriteString(fmt.Sprintf("%v", r.Type))
 	builder.WriteString(", ")
@@ -476,6 +556,54 @@ func (r *Room) appendNamedMessageLinks(name string, edges ...*MessageLink) {
 	}
 }
 
+// NamedFiles returns the Files named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (r *Room) NamedFiles(name string) ([]*File, error) {
+	if r.Edges.namedFiles == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := r.Edges.namedFiles[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (r *Room) appendNamedFiles(name string, edges ...*File) {
+	if r.Edges.namedFiles == nil {
+		r.Edges.namedFiles = make(map[string][]*File)
+	}
+	if len(edges) == 0 {
+		r.Edges.namedFiles[name] = []*File{}
+	} else {
+		r.Edges.namedFiles[name] = append(r.Edges.namedFiles[name], edges...)
+	}
+}
+
+// NamedAvatars returns the Avatars named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (r *Room) NamedAvatars(name string) ([]*Avatar, error) {
+	if r.Edges.namedAvatars == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := r.Edges.namedAvatars[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (r *Room) appendNamedAvatars(name string, edges ...*Avatar) {
+	if r.Edges.namedAvatars == nil {
+		r.Edges.namedAvatars = make(map[string][]*Avatar)
+	}
+	if len(edges) == 0 {
+		r.Edges.namedAvatars[name] = []*Avatar{}
+	} else {
+		r.Edges.namedAvatars[name] = append(r.Edges.namedAvatars[name], edges...)
+	}
+}
+
 // NamedRoomMembers returns the RoomMembers named value or an error if the edge was not
 // loaded in eager-loading with this name.
 func (r *Room) NamedRoomMembers(name string) ([]*RoomMember, error) {
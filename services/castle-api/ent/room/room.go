@@ -27,6 +27,10 @@ const (
 	FieldDescription = "description"
 	// FieldVersion holds the string denoting the version field in the database.
 	FieldVersion = "version"
+	// FieldStorageUsed holds the string denoting the storage_used field in the database.
+	FieldStorageUsed = "storage_used"
+	// FieldAvatarID holds the string denoting the avatar_id field in the database.
+	FieldAvatarID = "avatar_id"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -47,6 +51,12 @@ const (
 	EdgeMessageAttachments = "message_attachments"
 	// EdgeMessageLinks holds the string denoting the message_links edge name in mutations.
 	EdgeMessageLinks = "message_links"
+	// EdgeFiles holds the string denoting the files edge name in mutations.
+	EdgeFiles = "files"
+	// EdgeAvatar holds the string denoting the avatar edge name in mutations.
+	EdgeAvatar = "avatar"
+	// EdgeAvatars holds the string denoting the avatars edge name in mutations.
+	EdgeAvatars = "avatars"
 	// EdgeRoomMembers holds the string denoting the room_members edge name in mutations.
 	EdgeRoomMembers = "room_members"
 	// Table holds the table name of the room in the database.
@@ -98,6 +108,27 @@ const (
 	MessageLinksInverseTable = "message_links"
 	// MessageLinksColumn is the table column denoting the message_links relation/edge.
 	MessageLinksColumn = "room_message_links"
+	// FilesTable is the table that holds the files relation/edge.
+	FilesTable = "files"
+	// FilesInverseTable is the table name for the File entity.
+	// It exists in this package in order to avoid circular dependency with the "file" package.
+	FilesInverseTable = "files"
+	// FilesColumn is the table column denoting the files relation/edge.
+	FilesColumn = "room_id"
+	// AvatarTable is the table that holds the avatar relation/edge.
+	AvatarTable = "rooms"
+	// AvatarInverseTable is the table name for the File entity.
+	// It exists in this package in order to avoid circular dependency with the "file" package.
+	AvatarInverseTable = "files"
+	// AvatarColumn is the table column denoting the avatar relation/edge.
+	AvatarColumn = "avatar_id"
+	// AvatarsTable is the table that holds the avatars relation/edge.
+	AvatarsTable = "avatars"
+	// AvatarsInverseTable is the table name for the Avatar entity.
+	// It exists in this package in order to avoid circular dependency with the "avatar" package.
+	AvatarsInverseTable = "avatars"
+	// AvatarsColumn is the table column denoting the avatars relation/edge.
+	AvatarsColumn = "room_id"
 	// RoomMembersTable is the table that holds the room_members relation/edge.
 	RoomMembersTable = "room_members"
 	// RoomMembersInverseTable is the table name for the RoomMember entity.
@@ -114,6 +145,8 @@ var Columns = []string{
 	FieldName,
 	FieldDescription,
 	FieldVersion,
+	FieldStorageUsed,
+	FieldAvatarID,
 	FieldType,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -158,6 +191,8 @@ var (
 	DefaultVersion uint64
 	// VersionValidator is a validator for the "version" field. It is called by the builders before save.
 	VersionValidator func(uint64) error
+	// DefaultStorageUsed holds the default value on creation for the "storage_used" field.
+	DefaultStorageUsed int64
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -219,6 +254,16 @@ func ByVersion(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVersion, opts...).ToFunc()
 }
 
+// ByStorageUsed orders the results by the storage_used field.
+func ByStorageUsed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageUsed, opts...).ToFunc()
+}
+
+// ByAvatarID orders the results by the avatar_id field.
+func ByAvatarID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvatarID, opts...).ToFunc()
+}
+
 // ByType orders the results by the type field.
 func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
@@ -325,6 +370,41 @@ func ByMessageLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByFilesCount orders the results by files count.
+func ByFilesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFilesStep(), opts...)
+	}
+}
+
+// ByFiles orders the results by files terms.
+func ByFiles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFilesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAvatarField orders the results by avatar field.
+func ByAvatarField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAvatarStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAvatarsCount orders the results by avatars count.
+func ByAvatarsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAvatarsStep(), opts...)
+	}
+}
+
+// ByAvatars orders the results by avatars terms.
+func ByAvatars(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAvatarsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByRoomMembersCount orders the results by room_members count.
 func ByRoomMembersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -387,6 +467,27 @@ func newMessageLinksStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, MessageLinksTable, MessageLinksColumn),
 	)
 }
+func newFilesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FilesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FilesTable, FilesColumn),
+	)
+}
+func newAvatarStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AvatarInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, AvatarTable, AvatarColumn),
+	)
+}
+func newAvatarsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AvatarsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AvatarsTable, AvatarsColumn),
+	)
+}
 func newRoomMembersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -76,6 +76,16 @@ func Version(v uint64) predicate.Room {
 	return predicate.Room(sql.FieldEQ(FieldVersion, v))
 }
 
+// StorageUsed applies equality check predicate on the "storage_used" field. It's identical to StorageUsedEQ.
+func StorageUsed(v int64) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldStorageUsed, v))
+}
+
+// AvatarID applies equality check predicate on the "avatar_id" field. It's identical to AvatarIDEQ.
+func AvatarID(v pulid.ID) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldAvatarID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Room {
 	return predicate.Room(sql.FieldEQ(FieldCreatedAt, v))
@@ -326,6 +336,126 @@ func VersionLTE(v uint64) predicate.Room {
 	return predicate.Room(sql.FieldLTE(FieldVersion, v))
 }
 
+// StorageUsedEQ applies the EQ predicate on the "storage_used" field.
+func StorageUsedEQ(v int64) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldStorageUsed, v))
+}
+
+// StorageUsedNEQ applies the NEQ predicate on the "storage_used" field.
+func StorageUsedNEQ(v int64) predicate.Room {
+	return predicate.Room(sql.FieldNEQ(FieldStorageUsed, v))
+}
+
+// StorageUsedIn applies the In predicate on the "storage_used" field.
+func StorageUsedIn(vs ...int64) predicate.Room {
+	return predicate.Room(sql.FieldIn(FieldStorageUsed, vs...))
+}
+
+// StorageUsedNotIn applies the NotIn predicate on the "storage_used" field.
+func StorageUsedNotIn(vs ...int64) predicate.Room {
+	return predicate.Room(sql.FieldNotIn(FieldStorageUsed, vs...))
+}
+
+// StorageUsedGT applies the GT predicate on the "storage_used" field.
+func StorageUsedGT(v int64) predicate.Room {
+	return predicate.Room(sql.FieldGT(FieldStorageUsed, v))
+}
+
+// StorageUsedGTE applies the GTE predicate on the "storage_used" field.
+func StorageUsedGTE(v int64) predicate.Room {
+	return predicate.Room(sql.FieldGTE(FieldStorageUsed, v))
+}
+
+// StorageUsedLT applies the LT predicate on the "storage_used" field.
+func StorageUsedLT(v int64) predicate.Room {
+	return predicate.Room(sql.FieldLT(FieldStorageUsed, v))
+}
+
+// StorageUsedLTE applies the LTE predicate on the "storage_used" field.
+func StorageUsedLTE(v int64) predicate.Room {
+	return predicate.Room(sql.FieldLTE(FieldStorageUsed, v))
+}
+
+// AvatarIDEQ applies the EQ predicate on the "avatar_id" field.
+func AvatarIDEQ(v pulid.ID) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldAvatarID, v))
+}
+
+// AvatarIDNEQ applies the NEQ predicate on the "avatar_id" field.
+func AvatarIDNEQ(v pulid.ID) predicate.Room {
+	return predicate.Room(sql.FieldNEQ(FieldAvatarID, v))
+}
+
+// AvatarIDIn applies the In predicate on the "avatar_id" field.
+func AvatarIDIn(vs ...pulid.ID) predicate.Room {
+	return predicate.Room(sql.FieldIn(FieldAvatarID, vs...))
+}
+
+// AvatarIDNotIn applies the NotIn predicate on the "avatar_id" field.
+func AvatarIDNotIn(vs ...pulid.ID) predicate.Room {
+	return predicate.Room(sql.FieldNotIn(FieldAvatarID, vs...))
+}
+
+// AvatarIDGT applies the GT predicate on the "avatar_id" field.
+func AvatarIDGT(v pulid.ID) predicate.Room {
+	return predicate.Room(sql.FieldGT(FieldAvatarID, v))
+}
+
+// AvatarIDGTE applies the GTE predicate on the "avatar_id" field.
+func AvatarIDGTE(v pulid.ID) predicate.Room {
+	return predicate.Room(sql.FieldGTE(FieldAvatarID, v))
+}
+
+// AvatarIDLT applies the LT predicate on the "avatar_id" field.
+func AvatarIDLT(v pulid.ID) predicate.Room {
+	return predicate.Room(sql.FieldLT(FieldAvatarID, v))
+}
+
+// AvatarIDLTE applies the LTE predicate on the "avatar_id" field.
+func AvatarIDLTE(v pulid.ID) predicate.Room {
+	return predicate.Room(sql.FieldLTE(FieldAvatarID, v))
+}
+
+// AvatarIDContains applies the Contains predicate on the "avatar_id" field.
+func AvatarIDContains(v pulid.ID) predicate.Room {
+	vc := string(v)
+	return predicate.Room(sql.FieldContains(FieldAvatarID, vc))
+}
+
+// AvatarIDHasPrefix applies the HasPrefix predicate on the "avatar_id" field.
+func AvatarIDHasPrefix(v pulid.ID) predicate.Room {
+	vc := string(v)
+	return predicate.Room(sql.FieldHasPrefix(FieldAvatarID, vc))
+}
+
+// AvatarIDHasSuffix applies the HasSuffix predicate on the "avatar_id" field.
+func AvatarIDHasSuffix(v pulid.ID) predicate.Room {
+	vc := string(v)
+	return predicate.Room(sql.FieldHasSuffix(FieldAvatarID, vc))
+}
+
+// AvatarIDIsNil applies the IsNil predicate on the "avatar_id" field.
+func AvatarIDIsNil() predicate.Room {
+	return predicate.Room(sql.FieldIsNull(FieldAvatarID))
+}
+
+// AvatarIDNotNil applies the NotNil predicate on the "avatar_id" field.
+func AvatarIDNotNil() predicate.Room {
+	return predicate.Room(sql.FieldNotNull(FieldAvatarID))
+}
+
+// AvatarIDEqualFold applies the EqualFold predicate on the "avatar_id" field.
+func AvatarIDEqualFold(v pulid.ID) predicate.Room {
+	vc := string(v)
+	return predicate.Room(sql.FieldEqualFold(FieldAvatarID, vc))
+}
+
+// AvatarIDContainsFold applies the ContainsFold predicate on the "avatar_id" field.
+func AvatarIDContainsFold(v pulid.ID) predicate.Room {
+	vc := string(v)
+	return predicate.Room(sql.FieldContainsFold(FieldAvatarID, vc))
+}
+
 // TypeEQ applies the EQ predicate on the "type" field.
 func TypeEQ(v Type) predicate.Room {
 	return predicate.Room(sql.FieldEQ(FieldType, v))
@@ -587,6 +717,75 @@ func HasMessageLinksWith(preds ...predicate.MessageLink) predicate.Room {
 	})
 }
 
+// HasFiles applies the HasEdge predicate on the "files" edge.
+func HasFiles() predicate.Room {
+	return predicate.Room(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FilesTable, FilesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFilesWith applies the HasEdge predicate on the "files" edge with a given conditions (other predicates).
+func HasFilesWith(preds ...predicate.File) predicate.Room {
+	return predicate.Room(func(s *sql.Selector) {
+		step := newFilesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAvatar applies the HasEdge predicate on the "avatar" edge.
+func HasAvatar() predicate.Room {
+	return predicate.Room(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, AvatarTable, AvatarColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAvatarWith applies the HasEdge predicate on the "avatar" edge with a given conditions (other predicates).
+func HasAvatarWith(preds ...predicate.File) predicate.Room {
+	return predicate.Room(func(s *sql.Selector) {
+		step := newAvatarStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAvatars applies the HasEdge predicate on the "avatars" edge.
+func HasAvatars() predicate.Room {
+	return predicate.Room(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AvatarsTable, AvatarsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAvatarsWith applies the HasEdge predicate on the "avatars" edge with a given conditions (other predicates).
+func HasAvatarsWith(preds ...predicate.Avatar) predicate.Room {
+	return predicate.Room(func(s *sql.Selector) {
+		step := newAvatarsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasRoomMembers applies the HasEdge predicate on the "room_members" edge.
 func HasRoomMembers() predicate.Room {
 	return predicate.Room(func(s *sql.Selector) {
@@ -6,6 +6,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"journeyhub/ent/avatar"
+	"journeyhub/ent/file"
 	"journeyhub/ent/message"
 	"journeyhub/ent/messageattachment"
 	"journeyhub/ent/messagelink"
@@ -87,6 +89,34 @@ func (rc *RoomCreate) SetNillableVersion(u *uint64) *RoomCreate {
 	return rc
 }
 
+// SetStorageUsed sets the "storage_used" field.
+func (rc *RoomCreate) SetStorageUsed(i int64) *RoomCreate {
+	rc.mutation.SetStorageUsed(i)
+	return rc
+}
+
+// SetNillableStorageUsed sets the "storage_used" field if the given value is not nil.
+func (rc *RoomCreate) SetNillableStorageUsed(i *int64) *RoomCreate {
+	if i != nil {
+		rc.SetStorageUsed(*i)
+	}
+	return rc
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (rc *RoomCreate) SetAvatarID(pu pulid.ID) *RoomCreate {
+	rc.mutation.SetAvatarID(pu)
+	return rc
+}
+
+// SetNillableAvatarID sets the "avatar_id" field if the given value is not nil.
+func (rc *RoomCreate) SetNillableAvatarID(pu *pulid.ID) *RoomCreate {
+	if pu != nil {
+		rc.SetAvatarID(*pu)
+	}
+	return rc
+}
+
 // SetType sets the "type" field.
 func (rc *RoomCreate) SetType(r room.Type) *RoomCreate {
 	rc.mutation.SetType(r)
@@ -244,6 +274,41 @@ func (rc *RoomCreate) AddMessageLinks(m ...*MessageLink) *RoomCreate {
 	return rc.AddMessageLinkIDs(ids...)
 }
 
+// AddFileIDs adds the "files" edge to the File entity by IDs.
+func (rc *RoomCreate) AddFileIDs(ids ...pulid.ID) *RoomCreate {
+	rc.mutation.AddFileIDs(ids...)
+	return rc
+}
+
+// AddFiles adds the "files" edges to the File entity.
+func (rc *RoomCreate) AddFiles(f ...*File) *RoomCreate {
+	ids := make([]pulid.ID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return rc.AddFileIDs(ids...)
+}
+
+// SetAvatar sets the "avatar" edge to the File entity.
+func (rc *RoomCreate) SetAvatar(f *File) *RoomCreate {
+	return rc.SetAvatarID(f.ID)
+}
+
+// AddAvatarIDs adds the "avatars" edge to the Avatar entity by IDs.
+func (rc *RoomCreate) AddAvatarIDs(ids ...pulid.ID) *RoomCreate {
+	rc.mutation.AddAvatarIDs(ids...)
+	return rc
+}
+
+// AddAvatars adds the "avatars" edges to the Avatar entity.
+func (rc *RoomCreate) AddAvatars(a ...*Avatar) *RoomCreate {
+	ids := make([]pulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return rc.AddAvatarIDs(ids...)
+}
+
 // AddRoomMemberIDs adds the "room_members" edge to the RoomMember entity by IDs.
 func (rc *RoomCreate) AddRoomMemberIDs(ids ...pulid.ID) *RoomCreate {
 	rc.mutation.AddRoomMemberIDs(ids...)
@@ -300,6 +365,10 @@ func (rc *RoomCreate) defaults() error {
 		v := room.DefaultVersion
 		rc.mutation.SetVersion(v)
 	}
+	if _, ok := rc.mutation.StorageUsed(); !ok {
+		v := room.DefaultStorageUsed
+		rc.mutation.SetStorageUsed(v)
+	}
 	if _, ok := rc.mutation.CreatedAt(); !ok {
 		if room.DefaultCreatedAt == nil {
 			return fmt.Errorf("ent: uninitialized room.DefaultCreatedAt (forgotten import ent/runtime?)")
@@ -334,6 +403,9 @@ func (rc *RoomCreate) check() error {
 			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Room.version": %w`, err)}
 		}
 	}
+	if _, ok := rc.mutation.StorageUsed(); !ok {
+		return &ValidationError{Name: "storage_used", err: errors.New(`ent: missing required field "Room.storage_used"`)}
+	}
 	if _, ok := rc.mutation.GetType(); !ok {
 		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Room.type"`)}
 	}
@@ -400,6 +472,10 @@ func (rc *RoomCreate) createSpec() (*Room, *sqlgraph.CreateSpec) {
 		_spec.SetField(room.FieldVersion, field.TypeUint64, value)
 		_node.Version = value
 	}
+	if value, ok := rc.mutation.StorageUsed(); ok {
+		_spec.SetField(room.FieldStorageUsed, field.TypeInt64, value)
+		_node.StorageUsed = value
+	}
 	if value, ok := rc.mutation.GetType(); ok {
 		_spec.SetField(room.FieldType, field.TypeEnum, value)
 		_node.Type = value
@@ -532,6 +608,55 @@ func (rc *RoomCreate) createSpec() (*Room, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := rc.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.FilesTable,
+			Columns: []string{room.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.AvatarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   room.AvatarTable,
+			Columns: []string{room.AvatarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AvatarID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.AvatarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.AvatarsTable,
+			Columns: []string{room.AvatarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := rc.mutation.RoomMembersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -672,6 +797,42 @@ func (u *RoomUpsert) AddVersion(v uint64) *RoomUpsert {
 	return u
 }
 
+// SetStorageUsed sets the "storage_used" field.
+func (u *RoomUpsert) SetStorageUsed(v int64) *RoomUpsert {
+	u.Set(room.FieldStorageUsed, v)
+	return u
+}
+
+// UpdateStorageUsed sets the "storage_used" field to the value that was provided on create.
+func (u *RoomUpsert) UpdateStorageUsed() *RoomUpsert {
+	u.SetExcluded(room.FieldStorageUsed)
+	return u
+}
+
+// AddStorageUsed adds v to the "storage_used" field.
+func (u *RoomUpsert) AddStorageUsed(v int64) *RoomUpsert {
+	u.Add(room.FieldStorageUsed, v)
+	return u
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (u *RoomUpsert) SetAvatarID(v pulid.ID) *RoomUpsert {
+	u.Set(room.FieldAvatarID, v)
+	return u
+}
+
+// UpdateAvatarID sets the "avatar_id" field to the value that was provided on create.
+func (u *RoomUpsert) UpdateAvatarID() *RoomUpsert {
+	u.SetExcluded(room.FieldAvatarID)
+	return u
+}
+
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (u *RoomUpsert) ClearAvatarID() *RoomUpsert {
+	u.SetNull(room.FieldAvatarID)
+	return u
+}
+
 // SetType sets the "type" field.
 func (u *RoomUpsert) SetType(v room.Type) *RoomUpsert {
 	u.Set(room.FieldType, v)
@@ -831,6 +992,48 @@ func (u *RoomUpsertOne) UpdateVersion() *RoomUpsertOne {
 	})
 }
 
+// SetStorageUsed sets the "storage_used" field.
+func (u *RoomUpsertOne) SetStorageUsed(v int64) *RoomUpsertOne {
+	return u.Update(func(s *RoomUpsert) {
+		s.SetStorageUsed(v)
+	})
+}
+
+// AddStorageUsed adds v to the "storage_used" field.
+func (u *RoomUpsertOne) AddStorageUsed(v int64) *RoomUpsertOne {
+	return u.Update(func(s *RoomUpsert) {
+		s.AddStorageUsed(v)
+	})
+}
+
+// UpdateStorageUsed sets the "storage_used" field to the value that was provided on create.
+func (u *RoomUpsertOne) UpdateStorageUsed() *RoomUpsertOne {
+	return u.Update(func(s *RoomUpsert) {
+		s.UpdateStorageUsed()
+	})
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (u *RoomUpsertOne) SetAvatarID(v pulid.ID) *RoomUpsertOne {
+	return u.Update(func(s *RoomUpsert) {
+		s.SetAvatarID(v)
+	})
+}
+
+// UpdateAvatarID sets the "avatar_id" field to the value that was provided on create.
+func (u *RoomUpsertOne) UpdateAvatarID() *RoomUpsertOne {
+	return u.Update(func(s *RoomUpsert) {
+		s.UpdateAvatarID()
+	})
+}
+
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (u *RoomUpsertOne) ClearAvatarID() *RoomUpsertOne {
+	return u.Update(func(s *RoomUpsert) {
+		s.ClearAvatarID()
+	})
+}
+
 // SetType sets the "type" field.
 func (u *RoomUpsertOne) SetType(v room.Type) *RoomUpsertOne {
 	return u.Update(func(s *RoomUpsert) {
@@ -1161,6 +1364,48 @@ func (u *RoomUpsertBulk) UpdateVersion() *RoomUpsertBulk {
 	})
 }
 
+// SetStorageUsed sets the "storage_used" field.
+func (u *RoomUpsertBulk) SetStorageUsed(v int64) *RoomUpsertBulk {
+	return u.Update(func(s *RoomUpsert) {
+		s.SetStorageUsed(v)
+	})
+}
+
+// AddStorageUsed adds v to the "storage_used" field.
+func (u *RoomUpsertBulk) AddStorageUsed(v int64) *RoomUpsertBulk {
+	return u.Update(func(s *RoomUpsert) {
+		s.AddStorageUsed(v)
+	})
+}
+
+// UpdateStorageUsed sets the "storage_used" field to the value that was provided on create.
+func (u *RoomUpsertBulk) UpdateStorageUsed() *RoomUpsertBulk {
+	return u.Update(func(s *RoomUpsert) {
+		s.UpdateStorageUsed()
+	})
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (u *RoomUpsertBulk) SetAvatarID(v pulid.ID) *RoomUpsertBulk {
+	return u.Update(func(s *RoomUpsert) {
+		s.SetAvatarID(v)
+	})
+}
+
+// UpdateAvatarID sets the "avatar_id" field to the value that was provided on create.
+func (u *RoomUpsertBulk) UpdateAvatarID() *RoomUpsertBulk {
+	return u.Update(func(s *RoomUpsert) {
+		s.UpdateAvatarID()
+	})
+}
+
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (u *RoomUpsertBulk) ClearAvatarID() *RoomUpsertBulk {
+	return u.Update(func(s *RoomUpsert) {
+		s.ClearAvatarID()
+	})
+}
+
 // SetType sets the "type" field.
 func (u *RoomUpsertBulk) SetType(v room.Type) *RoomUpsertBulk {
 	return u.Update(func(s *RoomUpsert) {
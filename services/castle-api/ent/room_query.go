@@ -6,6 +6,8 @@ import (
 	"context"
 	"database/sql/driver"
 	"fmt"
+	"journeyhub/ent/avatar"
+	"journeyhub/ent/file"
 	"journeyhub/ent/message"
 	"journeyhub/ent/messageattachment"
 	"journeyhub/ent/messagelink"
@@ -38,6 +40,9 @@ type RoomQuery struct {
 	withMessageVoices           *MessageVoiceQuery
 	withMessageAttachments      *MessageAttachmentQuery
 	withMessageLinks            *MessageLinkQuery
+	withFiles                   *FileQuery
+	withAvatar                  *FileQuery
+	withAvatars                 *AvatarQuery
 	withRoomMembers             *RoomMemberQuery
 	withFKs                     bool
 	modifiers                   []func(*sql.Selector)
@@ -48,6 +53,8 @@ type RoomQuery struct {
 	withNamedMessageVoices      map[string]*MessageVoiceQuery
 	withNamedMessageAttachments map[string]*MessageAttachmentQuery
 	withNamedMessageLinks       map[string]*MessageLinkQuery
+	withNamedFiles              map[string]*FileQuery
+	withNamedAvatars            map[string]*AvatarQuery
 	withNamedRoomMembers        map[string]*RoomMemberQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -239,6 +246,72 @@ func (rq *RoomQuery) QueryMessageLinks() *MessageLinkQuery {
 	return query
 }
 
+// QueryFiles chains the current query on the "files" edge.
+func (rq *RoomQuery) QueryFiles() *FileQuery {
+	query := (&FileClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, selector),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, room.FilesTable, room.FilesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAvatar chains the current query on the "avatar" edge.
+func (rq *RoomQuery) QueryAvatar() *FileQuery {
+	query := (&FileClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, selector),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, room.AvatarTable, room.AvatarColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAvatars chains the current query on the "avatars" edge.
+func (rq *RoomQuery) QueryAvatars() *AvatarQuery {
+	query := (&AvatarClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, selector),
+			sqlgraph.To(avatar.Table, avatar.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, room.AvatarsTable, room.AvatarsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryRoomMembers chains the current query on the "room_members" edge.
 func (rq *RoomQuery) QueryRoomMembers() *RoomMemberQuery {
 	query := (&RoomMemberClient{config: rq.config}).Query()
@@ -460,6 +533,9 @@ func (rq *RoomQuery) Clone() *RoomQuery {
 		withMessageVoices:      rq.withMessageVoices.Clone(),
 		withMessageAttachments: rq.withMessageAttachments.Clone(),
 		withMessageLinks:       rq.withMessageLinks.Clone(),
+		withFiles:              rq.withFiles.Clone(),
+		withAvatar:             rq.withAvatar.Clone(),
+		withAvatars:            rq.withAvatars.Clone(),
 		withRoomMembers:        rq.withRoomMembers.Clone(),
 		// clone intermediate query.
 		sql:  rq.sql.Clone(),
@@ -544,6 +620,39 @@ func (rq *RoomQuery) WithMessageLinks(opts ...func(*MessageLinkQuery)) *RoomQuer
 	return rq
 }
 
+// WithFiles tells the query-builder to eager-load the nodes that are connected to
+// the "files" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RoomQuery) WithFiles(opts ...func(*FileQuery)) *RoomQuery {
+	query := (&FileClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withFiles = query
+	return rq
+}
+
+// WithAvatar tells the query-builder to eager-load the nodes that are connected to
+// the "avatar" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RoomQuery) WithAvatar(opts ...func(*FileQuery)) *RoomQuery {
+	query := (&FileClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withAvatar = query
+	return rq
+}
+
+// WithAvatars tells the query-builder to eager-load the nodes that are connected to
+// the "avatars" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RoomQuery) WithAvatars(opts ...func(*AvatarQuery)) *RoomQuery {
+	query := (&AvatarClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withAvatars = query
+	return rq
+}
+
 // WithRoomMembers tells the query-builder to eager-load the nodes that are connected to
 // the "room_members" edge. The optional arguments are used to configure the query builder of the edge.
 func (rq *RoomQuery) WithRoomMembers(opts ...func(*RoomMemberQuery)) *RoomQuery {
@@ -634,7 +743,7 @@ func (rq *RoomQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Room, e
 		nodes       = []*Room{}
 		withFKs     = rq.withFKs
 		_spec       = rq.querySpec()
-		loadedTypes = [8]bool{
+		loadedTypes = [11]bool{
 			rq.withUserContacts != nil,
 			rq.withUsers != nil,
 			rq.withLastMessage != nil,
@@ -642,6 +751,9 @@ func (rq *RoomQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Room, e
 			rq.withMessageVoices != nil,
 			rq.withMessageAttachments != nil,
 			rq.withMessageLinks != nil,
+			rq.withFiles != nil,
+			rq.withAvatar != nil,
+			rq.withAvatars != nil,
 			rq.withRoomMembers != nil,
 		}
 	)
@@ -722,6 +834,26 @@ func (rq *RoomQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Room, e
 			return nil, err
 		}
 	}
+	if query := rq.withFiles; query != nil {
+		if err := rq.loadFiles(ctx, query, nodes,
+			func(n *Room) { n.Edges.Files = []*File{} },
+			func(n *Room, e *File) { n.Edges.Files = append(n.Edges.Files, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withAvatar; query != nil {
+		if err := rq.loadAvatar(ctx, query, nodes, nil,
+			func(n *Room, e *File) { n.Edges.Avatar = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withAvatars; query != nil {
+		if err := rq.loadAvatars(ctx, query, nodes,
+			func(n *Room) { n.Edges.Avatars = []*Avatar{} },
+			func(n *Room, e *Avatar) { n.Edges.Avatars = append(n.Edges.Avatars, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := rq.withRoomMembers; query != nil {
 		if err := rq.loadRoomMembers(ctx, query, nodes,
 			func(n *Room) { n.Edges.RoomMembers = []*RoomMember{} },
@@ -771,6 +903,20 @@ func (rq *RoomQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Room, e
 			return nil, err
 		}
 	}
+	for name, query := range rq.withNamedFiles {
+		if err := rq.loadFiles(ctx, query, nodes,
+			func(n *Room) { n.appendNamedFiles(name) },
+			func(n *Room, e *File) { n.appendNamedFiles(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range rq.withNamedAvatars {
+		if err := rq.loadAvatars(ctx, query, nodes,
+			func(n *Room) { n.appendNamedAvatars(name) },
+			func(n *Room, e *Avatar) { n.appendNamedAvatars(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range rq.withNamedRoomMembers {
 		if err := rq.loadRoomMembers(ctx, query, nodes,
 			func(n *Room) { n.appendNamedRoomMembers(name) },
@@ -1033,6 +1179,102 @@ func (rq *RoomQuery) loadMessageLinks(ctx context.Context, query *MessageLinkQue
 	}
 	return nil
 }
+func (rq *RoomQuery) loadFiles(ctx context.Context, query *FileQuery, nodes []*Room, init func(*Room), assign func(*Room, *File)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[pulid.ID]*Room)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(file.FieldRoomID)
+	}
+	query.Where(predicate.File(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(room.FilesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RoomID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "room_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (rq *RoomQuery) loadAvatar(ctx context.Context, query *FileQuery, nodes []*Room, init func(*Room), assign func(*Room, *File)) error {
+	ids := make([]pulid.ID, 0, len(nodes))
+	nodeids := make(map[pulid.ID][]*Room)
+	for i := range nodes {
+		if nodes[i].AvatarID == nil {
+			continue
+		}
+		fk := *nodes[i].AvatarID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(file.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "avatar_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rq *RoomQuery) loadAvatars(ctx context.Context, query *AvatarQuery, nodes []*Room, init func(*Room), assign func(*Room, *Avatar)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[pulid.ID]*Room)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(avatar.FieldRoomID)
+	}
+	query.Where(predicate.Avatar(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(room.AvatarsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RoomID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "room_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "room_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (rq *RoomQuery) loadRoomMembers(ctx context.Context, query *RoomMemberQuery, nodes []*Room, init func(*Room), assign func(*Room, *RoomMember)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[pulid.ID]*Room)
@@ -1092,6 +1334,9 @@ func (rq *RoomQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if rq.withAvatar != nil {
+			_spec.Node.AddColumnOnce(room.FieldAvatarID)
+		}
 	}
 	if ps := rq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -1232,6 +1477,34 @@ func (rq *RoomQuery) WithNamedMessageLinks(name string, opts ...func(*MessageLin
 	return rq
 }
 
+// WithNamedFiles tells the query-builder to eager-load the nodes that are connected to the "files"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (rq *RoomQuery) WithNamedFiles(name string, opts ...func(*FileQuery)) *RoomQuery {
+	query := (&FileClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if rq.withNamedFiles == nil {
+		rq.withNamedFiles = make(map[string]*FileQuery)
+	}
+	rq.withNamedFiles[name] = query
+	return rq
+}
+
+// WithNamedAvatars tells the query-builder to eager-load the nodes that are connected to the "avatars"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (rq *RoomQuery) WithNamedAvatars(name string, opts ...func(*AvatarQuery)) *RoomQuery {
+	query := (&AvatarClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if rq.withNamedAvatars == nil {
+		rq.withNamedAvatars = make(map[string]*AvatarQuery)
+	}
+	rq.withNamedAvatars[name] = query
+	return rq
+}
+
 // WithNamedRoomMembers tells the query-builder to eager-load the nodes that are connected to the "room_members"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (rq *RoomQuery) WithNamedRoomMembers(name string, opts ...func(*RoomMemberQuery)) *RoomQuery {
@@ -6,6 +6,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"journeyhub/ent/avatar"
+	"journeyhub/ent/file"
 	"journeyhub/ent/message"
 	"journeyhub/ent/messageattachment"
 	"journeyhub/ent/messagelink"
@@ -117,6 +119,47 @@ func (ru *RoomUpdate) AddVersion(u int64) *RoomUpdate {
 	return ru
 }
 
+// SetStorageUsed sets the "storage_used" field.
+func (ru *RoomUpdate) SetStorageUsed(i int64) *RoomUpdate {
+	ru.mutation.ResetStorageUsed()
+	ru.mutation.SetStorageUsed(i)
+	return ru
+}
+
+// SetNillableStorageUsed sets the "storage_used" field if the given value is not nil.
+func (ru *RoomUpdate) SetNillableStorageUsed(i *int64) *RoomUpdate {
+	if i != nil {
+		ru.SetStorageUsed(*i)
+	}
+	return ru
+}
+
+// AddStorageUsed adds i to the "storage_used" field.
+func (ru *RoomUpdate) AddStorageUsed(i int64) *RoomUpdate {
+	ru.mutation.AddStorageUsed(i)
+	return ru
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (ru *RoomUpdate) SetAvatarID(pu pulid.ID) *RoomUpdate {
+	ru.mutation.SetAvatarID(pu)
+	return ru
+}
+
+// SetNillableAvatarID sets the "avatar_id" field if the given value is not nil.
+func (ru *RoomUpdate) SetNillableAvatarID(pu *pulid.ID) *RoomUpdate {
+	if pu != nil {
+		ru.SetAvatarID(*pu)
+	}
+	return ru
+}
+
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (ru *RoomUpdate) ClearAvatarID() *RoomUpdate {
+	ru.mutation.ClearAvatarID()
+	return ru
+}
+
 // SetType sets the "type" field.
 func (ru *RoomUpdate) SetType(r room.Type) *RoomUpdate {
 	ru.mutation.SetType(r)
@@ -246,6 +289,41 @@ func (ru *RoomUpdate) AddMessageLinks(m ...*MessageLink) *RoomUpdate {
 	return ru.AddMessageLinkIDs(ids...)
 }
 
+// AddFileIDs adds the "files" edge to the File entity by IDs.
+func (ru *RoomUpdate) AddFileIDs(ids ...pulid.ID) *RoomUpdate {
+	ru.mutation.AddFileIDs(ids...)
+	return ru
+}
+
+// AddFiles adds the "files" edges to the File entity.
+func (ru *RoomUpdate) AddFiles(f ...*File) *RoomUpdate {
+	ids := make([]pulid.ID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return ru.AddFileIDs(ids...)
+}
+
+// SetAvatar sets the "avatar" edge to the File entity.
+func (ru *RoomUpdate) SetAvatar(f *File) *RoomUpdate {
+	return ru.SetAvatarID(f.ID)
+}
+
+// AddAvatarIDs adds the "avatars" edge to the Avatar entity by IDs.
+func (ru *RoomUpdate) AddAvatarIDs(ids ...pulid.ID) *RoomUpdate {
+	ru.mutation.AddAvatarIDs(ids...)
+	return ru
+}
+
+// AddAvatars adds the "avatars" edges to the Avatar entity.
+func (ru *RoomUpdate) AddAvatars(a ...*Avatar) *RoomUpdate {
+	ids := make([]pulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ru.AddAvatarIDs(ids...)
+}
+
 // AddRoomMemberIDs adds the "room_members" edge to the RoomMember entity by IDs.
 func (ru *RoomUpdate) AddRoomMemberIDs(ids ...pulid.ID) *RoomUpdate {
 	ru.mutation.AddRoomMemberIDs(ids...)
@@ -398,6 +476,54 @@ func (ru *RoomUpdate) RemoveMessageLinks(m ...*MessageLink) *RoomUpdate {
 	return ru.RemoveMessageLinkIDs(ids...)
 }
 
+// ClearFiles clears all "files" edges to the File entity.
+func (ru *RoomUpdate) ClearFiles() *RoomUpdate {
+	ru.mutation.ClearFiles()
+	return ru
+}
+
+// RemoveFileIDs removes the "files" edge to File entities by IDs.
+func (ru *RoomUpdate) RemoveFileIDs(ids ...pulid.ID) *RoomUpdate {
+	ru.mutation.RemoveFileIDs(ids...)
+	return ru
+}
+
+// RemoveFiles removes "files" edges to File entities.
+func (ru *RoomUpdate) RemoveFiles(f ...*File) *RoomUpdate {
+	ids := make([]pulid.ID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return ru.RemoveFileIDs(ids...)
+}
+
+// ClearAvatar clears the "avatar" edge to the File entity.
+func (ru *RoomUpdate) ClearAvatar() *RoomUpdate {
+	ru.mutation.ClearAvatar()
+	return ru
+}
+
+// ClearAvatars clears all "avatars" edges to the Avatar entity.
+func (ru *RoomUpdate) ClearAvatars() *RoomUpdate {
+	ru.mutation.ClearAvatars()
+	return ru
+}
+
+// RemoveAvatarIDs removes the "avatars" edge to Avatar entities by IDs.
+func (ru *RoomUpdate) RemoveAvatarIDs(ids ...pulid.ID) *RoomUpdate {
+	ru.mutation.RemoveAvatarIDs(ids...)
+	return ru
+}
+
+// RemoveAvatars removes "avatars" edges to Avatar entities.
+func (ru *RoomUpdate) RemoveAvatars(a ...*Avatar) *RoomUpdate {
+	ids := make([]pulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ru.RemoveAvatarIDs(ids...)
+}
+
 // ClearRoomMembers clears all "room_members" edges to the RoomMember entity.
 func (ru *RoomUpdate) ClearRoomMembers() *RoomUpdate {
 	ru.mutation.ClearRoomMembers()
@@ -512,6 +638,12 @@ func (ru *RoomUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ru.mutation.AddedVersion(); ok {
 		_spec.AddField(room.FieldVersion, field.TypeUint64, value)
 	}
+	if value, ok := ru.mutation.StorageUsed(); ok {
+		_spec.SetField(room.FieldStorageUsed, field.TypeInt64, value)
+	}
+	if value, ok := ru.mutation.AddedStorageUsed(); ok {
+		_spec.AddField(room.FieldStorageUsed, field.TypeInt64, value)
+	}
 	if value, ok := ru.mutation.GetType(); ok {
 		_spec.SetField(room.FieldType, field.TypeEnum, value)
 	}
@@ -838,6 +970,125 @@ func (ru *RoomUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ru.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.FilesTable,
+			Columns: []string{room.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedFilesIDs(); len(nodes) > 0 && !ru.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.FilesTable,
+			Columns: []string{room.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.FilesTable,
+			Columns: []string{room.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.AvatarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   room.AvatarTable,
+			Columns: []string{room.AvatarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.AvatarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   room.AvatarTable,
+			Columns: []string{room.AvatarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.AvatarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.AvatarsTable,
+			Columns: []string{room.AvatarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedAvatarsIDs(); len(nodes) > 0 && !ru.mutation.AvatarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.AvatarsTable,
+			Columns: []string{room.AvatarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.AvatarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.AvatarsTable,
+			Columns: []string{room.AvatarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if ru.mutation.RoomMembersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -984,6 +1235,47 @@ func (ruo *RoomUpdateOne) AddVersion(u int64) *RoomUpdateOne {
 	return ruo
 }
 
+// SetStorageUsed sets the "storage_used" field.
+func (ruo *RoomUpdateOne) SetStorageUsed(i int64) *RoomUpdateOne {
+	ruo.mutation.ResetStorageUsed()
+	ruo.mutation.SetStorageUsed(i)
+	return ruo
+}
+
+// SetNillableStorageUsed sets the "storage_used" field if the given value is not nil.
+func (ruo *RoomUpdateOne) SetNillableStorageUsed(i *int64) *RoomUpdateOne {
+	if i != nil {
+		ruo.SetStorageUsed(*i)
+	}
+	return ruo
+}
+
+// AddStorageUsed adds i to the "storage_used" field.
+func (ruo *RoomUpdateOne) AddStorageUsed(i int64) *RoomUpdateOne {
+	ruo.mutation.AddStorageUsed(i)
+	return ruo
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (ruo *RoomUpdateOne) SetAvatarID(pu pulid.ID) *RoomUpdateOne {
+	ruo.mutation.SetAvatarID(pu)
+	return ruo
+}
+
+// SetNillableAvatarID sets the "avatar_id" field if the given value is not nil.
+func (ruo *RoomUpdateOne) SetNillableAvatarID(pu *pulid.ID) *RoomUpdateOne {
+	if pu != nil {
+		ruo.SetAvatarID(*pu)
+	}
+	return ruo
+}
+
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (ruo *RoomUpdateOne) ClearAvatarID() *RoomUpdateOne {
+	ruo.mutation.ClearAvatarID()
+	return ruo
+}
+
 // SetType sets the "type" field.
 func (ruo *RoomUpdateOne) SetType(r room.Type) *RoomUpdateOne {
 	ruo.mutation.SetType(r)
@@ -1113,6 +1405,41 @@ func (ruo *RoomUpdateOne) AddMessageLinks(m ...*MessageLink) *RoomUpdateOne {
 	return ruo.AddMessageLinkIDs(ids...)
 }
 
+// AddFileIDs adds the "files" edge to the File entity by IDs.
+func (ruo *RoomUpdateOne) AddFileIDs(ids ...pulid.ID) *RoomUpdateOne {
+	ruo.mutation.AddFileIDs(ids...)
+	return ruo
+}
+
+// AddFiles adds the "files" edges to the File entity.
+func (ruo *RoomUpdateOne) AddFiles(f ...*File) *RoomUpdateOne {
+	ids := make([]pulid.ID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return ruo.AddFileIDs(ids...)
+}
+
+// SetAvatar sets the "avatar" edge to the File entity.
+func (ruo *RoomUpdateOne) SetAvatar(f *File) *RoomUpdateOne {
+	return ruo.SetAvatarID(f.ID)
+}
+
+// AddAvatarIDs adds the "avatars" edge to the Avatar entity by IDs.
+func (ruo *RoomUpdateOne) AddAvatarIDs(ids ...pulid.ID) *RoomUpdateOne {
+	ruo.mutation.AddAvatarIDs(ids...)
+	return ruo
+}
+
+// AddAvatars adds the "avatars" edges to the Avatar entity.
+func (ruo *RoomUpdateOne) AddAvatars(a ...*Avatar) *RoomUpdateOne {
+	ids := make([]pulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ruo.AddAvatarIDs(ids...)
+}
+
 // AddRoomMemberIDs adds the "room_members" edge to the RoomMember entity by IDs.
 func (ruo *RoomUpdateOne) AddRoomMemberIDs(ids ...pulid.ID) *RoomUpdateOne {
 	ruo.mutation.AddRoomMemberIDs(ids...)
@@ -1265,6 +1592,54 @@ func (ruo *RoomUpdateOne) RemoveMessageLinks(m ...*MessageLink) *RoomUpdateOne {
 	return ruo.RemoveMessageLinkIDs(ids...)
 }
 
+// ClearFiles clears all "files" edges to the File entity.
+func (ruo *RoomUpdateOne) ClearFiles() *RoomUpdateOne {
+	ruo.mutation.ClearFiles()
+	return ruo
+}
+
+// RemoveFileIDs removes the "files" edge to File entities by IDs.
+func (ruo *RoomUpdateOne) RemoveFileIDs(ids ...pulid.ID) *RoomUpdateOne {
+	ruo.mutation.RemoveFileIDs(ids...)
+	return ruo
+}
+
+// RemoveFiles removes "files" edges to File entities.
+func (ruo *RoomUpdateOne) RemoveFiles(f ...*File) *RoomUpdateOne {
+	ids := make([]pulid.ID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return ruo.RemoveFileIDs(ids...)
+}
+
+// ClearAvatar clears the "avatar" edge to the File entity.
+func (ruo *RoomUpdateOne) ClearAvatar() *RoomUpdateOne {
+	ruo.mutation.ClearAvatar()
+	return ruo
+}
+
+// ClearAvatars clears all "avatars" edges to the Avatar entity.
+func (ruo *RoomUpdateOne) ClearAvatars() *RoomUpdateOne {
+	ruo.mutation.ClearAvatars()
+	return ruo
+}
+
+// RemoveAvatarIDs removes the "avatars" edge to Avatar entities by IDs.
+func (ruo *RoomUpdateOne) RemoveAvatarIDs(ids ...pulid.ID) *RoomUpdateOne {
+	ruo.mutation.RemoveAvatarIDs(ids...)
+	return ruo
+}
+
+// RemoveAvatars removes "avatars" edges to Avatar entities.
+func (ruo *RoomUpdateOne) RemoveAvatars(a ...*Avatar) *RoomUpdateOne {
+	ids := make([]pulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ruo.RemoveAvatarIDs(ids...)
+}
+
 // ClearRoomMembers clears all "room_members" edges to the RoomMember entity.
 func (ruo *RoomUpdateOne) ClearRoomMembers() *RoomUpdateOne {
 	ruo.mutation.ClearRoomMembers()
@@ -1409,6 +1784,12 @@ func (ruo *RoomUpdateOne) sqlSave(ctx context.Context) (_node *Room, err error)
 	if value, ok := ruo.mutation.AddedVersion(); ok {
 		_spec.AddField(room.FieldVersion, field.TypeUint64, value)
 	}
+	if value, ok := ruo.mutation.StorageUsed(); ok {
+		_spec.SetField(room.FieldStorageUsed, field.TypeInt64, value)
+	}
+	if value, ok := ruo.mutation.AddedStorageUsed(); ok {
+		_spec.AddField(room.FieldStorageUsed, field.TypeInt64, value)
+	}
 	if value, ok := ruo.mutation.GetType(); ok {
 		_spec.SetField(room.FieldType, field.TypeEnum, value)
 	}
@@ -1735,6 +2116,125 @@ func (ruo *RoomUpdateOne) sqlSave(ctx context.Context) (_node *Room, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ruo.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.FilesTable,
+			Columns: []string{room.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedFilesIDs(); len(nodes) > 0 && !ruo.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.FilesTable,
+			Columns: []string{room.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.FilesTable,
+			Columns: []string{room.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.AvatarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   room.AvatarTable,
+			Columns: []string{room.AvatarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.AvatarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   room.AvatarTable,
+			Columns: []string{room.AvatarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.AvatarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.AvatarsTable,
+			Columns: []string{room.AvatarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedAvatarsIDs(); len(nodes) > 0 && !ruo.mutation.AvatarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.AvatarsTable,
+			Columns: []string{room.AvatarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.AvatarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.AvatarsTable,
+			Columns: []string{room.AvatarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if ruo.mutation.RoomMembersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
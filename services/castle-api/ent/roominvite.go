@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"journeyhub/ent/room"
+	"journeyhub/ent/roominvite"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// RoomInvite is the model entity for the RoomInvite schema.
+type RoomInvite struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID pulid.ID `json:"id,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"-"`
+	// RoomID holds the value of the "room_id" field.
+	RoomID pulid.ID `json:"room_id,omitempty"`
+	// CreatorID holds the value of the "creator_id" field.
+	CreatorID pulid.ID `json:"creator_id,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// MaxUses holds the value of the "max_uses" field.
+	MaxUses *int `json:"max_uses,omitempty"`
+	// Uses holds the value of the "uses" field.
+	Uses int `json:"uses,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RoomInviteQuery when eager-loading is set.
+	Edges        RoomInviteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RoomInviteEdges holds the relations/edges for other nodes in the graph.
+type RoomInviteEdges struct {
+	// Room holds the value of the room edge.
+	Room *Room `json:"room,omitempty"`
+	// Creator holds the value of the creator edge.
+	Creator *User `json:"creator,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+}
+
+// RoomOrErr returns the Room value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoomInviteEdges) RoomOrErr() (*Room, error) {
+	if e.Room != nil {
+		return e.Room, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: room.Label}
+	}
+	return nil, &NotLoadedError{edge: "room"}
+}
+
+// CreatorOrErr returns the Creator value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoomInviteEdges) CreatorOrErr() (*User, error) {
+	if e.Creator != nil {
+		return e.Creator, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "creator"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoomInvite) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case roominvite.FieldID, roominvite.FieldRoomID, roominvite.FieldCreatorID:
+			values[i] = new(pulid.ID)
+		case roominvite.FieldMaxUses, roominvite.FieldUses:
+			values[i] = new(sql.NullInt64)
+		case roominvite.FieldToken:
+			values[i] = new(sql.NullString)
+		case roominvite.FieldExpiresAt, roominvite.FieldRevokedAt, roominvite.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoomInvite fields.
+func (ri *RoomInvite) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case roominvite.FieldID:
+			if value, ok := values[i].(*pulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ri.ID = *value
+			}
+		case roominvite.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				ri.Token = value.String
+			}
+		case roominvite.FieldRoomID:
+			if value, ok := values[i].(*pulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field room_id", values[i])
+			} else if value != nil {
+				ri.RoomID = *value
+			}
+		case roominvite.FieldCreatorID:
+			if value, ok := values[i].(*pulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field creator_id", values[i])
+			} else if value != nil {
+				ri.CreatorID = *value
+			}
+		case roominvite.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ri.ExpiresAt = new(time.Time)
+				*ri.ExpiresAt = value.Time
+			}
+		case roominvite.FieldMaxUses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_uses", values[i])
+			} else if value.Valid {
+				ri.MaxUses = new(int)
+				*ri.MaxUses = int(value.Int64)
+			}
+		case roominvite.FieldUses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field uses", values[i])
+			} else if value.Valid {
+				ri.Uses = int(value.Int64)
+			}
+		case roominvite.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				ri.RevokedAt = new(time.Time)
+				*ri.RevokedAt = value.Time
+			}
+		case roominvite.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ri.CreatedAt = value.Time
+			}
+		default:
+			ri.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoomInvite.
+// This includes values selected through modifiers, order, etc.
+func (ri *RoomInvite) Value(name string) (ent.Value, error) {
+	return ri.selectValues.Get(name)
+}
+
+// QueryRoom queries the "room" edge of the RoomInvite entity.
+func (ri *RoomInvite) QueryRoom() *RoomQuery {
+	return NewRoomInviteClient(ri.config).QueryRoom(ri)
+}
+
+// QueryCreator queries the "creator" edge of the RoomInvite entity.
+func (ri *RoomInvite) QueryCreator() *UserQuery {
+	return NewRoomInviteClient(ri.config).QueryCreator(ri)
+}
+
+// Update returns a builder for updating this RoomInvite.
+// Note that you need to call RoomInvite.Unwrap() before calling this method if this RoomInvite
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ri *RoomInvite) Update() *RoomInviteUpdateOne {
+	return NewRoomInviteClient(ri.config).UpdateOne(ri)
+}
+
+// Unwrap unwraps the RoomInvite entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ri *RoomInvite) Unwrap() *RoomInvite {
+	_tx, ok := ri.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RoomInvite is not a transactional entity")
+	}
+	ri.config.driver = _tx.drv
+	return ri
+}
+
+// String implements the fmt.Stringer.
+func (ri *RoomInvite) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoomInvite(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ri.ID))
+	builder.WriteString("token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("room_id=")
+	builder.WriteString(fmt.Sprintf("%v", ri.RoomID))
+	builder.WriteString(", ")
+	builder.WriteString("creator_id=")
+	builder.WriteString(fmt.Sprintf("%v", ri.CreatorID))
+	builder.WriteString(", ")
+	if v := ri.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ri.MaxUses; v != nil {
+		builder.WriteString("max_uses=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("uses=")
+	builder.WriteString(fmt.Sprintf("%v", ri.Uses))
+	builder.WriteString(", ")
+	if v := ri.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ri.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RoomInvites is a parsable slice of RoomInvite.
+type RoomInvites []*RoomInvite
@@ -0,0 +1,1000 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journeyhub/ent/room"
+	"journeyhub/ent/roominvite"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RoomInviteCreate is the builder for creating a RoomInvite entity.
+type RoomInviteCreate struct {
+	config
+	mutation *RoomInviteMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetToken sets the "token" field.
+func (ric *RoomInviteCreate) SetToken(s string) *RoomInviteCreate {
+	ric.mutation.SetToken(s)
+	return ric
+}
+
+// SetRoomID sets the "room_id" field.
+func (ric *RoomInviteCreate) SetRoomID(pu pulid.ID) *RoomInviteCreate {
+	ric.mutation.SetRoomID(pu)
+	return ric
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (ric *RoomInviteCreate) SetCreatorID(pu pulid.ID) *RoomInviteCreate {
+	ric.mutation.SetCreatorID(pu)
+	return ric
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ric *RoomInviteCreate) SetExpiresAt(t time.Time) *RoomInviteCreate {
+	ric.mutation.SetExpiresAt(t)
+	return ric
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (ric *RoomInviteCreate) SetNillableExpiresAt(t *time.Time) *RoomInviteCreate {
+	if t != nil {
+		ric.SetExpiresAt(*t)
+	}
+	return ric
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (ric *RoomInviteCreate) SetMaxUses(i int) *RoomInviteCreate {
+	ric.mutation.SetMaxUses(i)
+	return ric
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (ric *RoomInviteCreate) SetNillableMaxUses(i *int) *RoomInviteCreate {
+	if i != nil {
+		ric.SetMaxUses(*i)
+	}
+	return ric
+}
+
+// SetUses sets the "uses" field.
+func (ric *RoomInviteCreate) SetUses(i int) *RoomInviteCreate {
+	ric.mutation.SetUses(i)
+	return ric
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (ric *RoomInviteCreate) SetNillableUses(i *int) *RoomInviteCreate {
+	if i != nil {
+		ric.SetUses(*i)
+	}
+	return ric
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (ric *RoomInviteCreate) SetRevokedAt(t time.Time) *RoomInviteCreate {
+	ric.mutation.SetRevokedAt(t)
+	return ric
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (ric *RoomInviteCreate) SetNillableRevokedAt(t *time.Time) *RoomInviteCreate {
+	if t != nil {
+		ric.SetRevokedAt(*t)
+	}
+	return ric
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ric *RoomInviteCreate) SetCreatedAt(t time.Time) *RoomInviteCreate {
+	ric.mutation.SetCreatedAt(t)
+	return ric
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ric *RoomInviteCreate) SetNillableCreatedAt(t *time.Time) *RoomInviteCreate {
+	if t != nil {
+		ric.SetCreatedAt(*t)
+	}
+	return ric
+}
+
+// SetID sets the "id" field.
+func (ric *RoomInviteCreate) SetID(pu pulid.ID) *RoomInviteCreate {
+	ric.mutation.SetID(pu)
+	return ric
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ric *RoomInviteCreate) SetNillableID(pu *pulid.ID) *RoomInviteCreate {
+	if pu != nil {
+		ric.SetID(*pu)
+	}
+	return ric
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (ric *RoomInviteCreate) SetRoom(r *Room) *RoomInviteCreate {
+	return ric.SetRoomID(r.ID)
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (ric *RoomInviteCreate) SetCreator(u *User) *RoomInviteCreate {
+	return ric.SetCreatorID(u.ID)
+}
+
+// Mutation returns the RoomInviteMutation object of the builder.
+func (ric *RoomInviteCreate) Mutation() *RoomInviteMutation {
+	return ric.mutation
+}
+
+// Save creates the RoomInvite in the database.
+func (ric *RoomInviteCreate) Save(ctx context.Context) (*RoomInvite, error) {
+	ric.defaults()
+	return withHooks(ctx, ric.sqlSave, ric.mutation, ric.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ric *RoomInviteCreate) SaveX(ctx context.Context) *RoomInvite {
+	v, err := ric.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ric *RoomInviteCreate) Exec(ctx context.Context) error {
+	_, err := ric.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ric *RoomInviteCreate) ExecX(ctx context.Context) {
+	if err := ric.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ric *RoomInviteCreate) defaults() {
+	if _, ok := ric.mutation.Uses(); !ok {
+		v := roominvite.DefaultUses
+		ric.mutation.SetUses(v)
+	}
+	if _, ok := ric.mutation.CreatedAt(); !ok {
+		v := roominvite.DefaultCreatedAt()
+		ric.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ric.mutation.ID(); !ok {
+		v := roominvite.DefaultID()
+		ric.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ric *RoomInviteCreate) check() error {
+	if _, ok := ric.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "RoomInvite.token"`)}
+	}
+	if _, ok := ric.mutation.RoomID(); !ok {
+		return &ValidationError{Name: "room_id", err: errors.New(`ent: missing required field "RoomInvite.room_id"`)}
+	}
+	if _, ok := ric.mutation.CreatorID(); !ok {
+		return &ValidationError{Name: "creator_id", err: errors.New(`ent: missing required field "RoomInvite.creator_id"`)}
+	}
+	if v, ok := ric.mutation.MaxUses(); ok {
+		if err := roominvite.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "RoomInvite.max_uses": %w`, err)}
+		}
+	}
+	if _, ok := ric.mutation.Uses(); !ok {
+		return &ValidationError{Name: "uses", err: errors.New(`ent: missing required field "RoomInvite.uses"`)}
+	}
+	if _, ok := ric.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RoomInvite.created_at"`)}
+	}
+	if len(ric.mutation.RoomIDs()) == 0 {
+		return &ValidationError{Name: "room", err: errors.New(`ent: missing required edge "RoomInvite.room"`)}
+	}
+	if len(ric.mutation.CreatorIDs()) == 0 {
+		return &ValidationError{Name: "creator", err: errors.New(`ent: missing required edge "RoomInvite.creator"`)}
+	}
+	return nil
+}
+
+func (ric *RoomInviteCreate) sqlSave(ctx context.Context) (*RoomInvite, error) {
+	if err := ric.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ric.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ric.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*pulid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ric.mutation.id = &_node.ID
+	ric.mutation.done = true
+	return _node, nil
+}
+
+func (ric *RoomInviteCreate) createSpec() (*RoomInvite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RoomInvite{config: ric.config}
+		_spec = sqlgraph.NewCreateSpec(roominvite.Table, sqlgraph.NewFieldSpec(roominvite.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = ric.conflict
+	if id, ok := ric.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ric.mutation.Token(); ok {
+		_spec.SetField(roominvite.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := ric.mutation.ExpiresAt(); ok {
+		_spec.SetField(roominvite.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := ric.mutation.MaxUses(); ok {
+		_spec.SetField(roominvite.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = &value
+	}
+	if value, ok := ric.mutation.Uses(); ok {
+		_spec.SetField(roominvite.FieldUses, field.TypeInt, value)
+		_node.Uses = value
+	}
+	if value, ok := ric.mutation.RevokedAt(); ok {
+		_spec.SetField(roominvite.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := ric.mutation.CreatedAt(); ok {
+		_spec.SetField(roominvite.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ric.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roominvite.RoomTable,
+			Columns: []string{roominvite.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoomID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ric.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roominvite.CreatorTable,
+			Columns: []string{roominvite.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CreatorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RoomInvite.Create().
+//		SetToken(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RoomInviteUpsert) {
+//			SetToken(v+v).
+//		}).
+//		Exec(ctx)
+func (ric *RoomInviteCreate) OnConflict(opts ...sql.ConflictOption) *RoomInviteUpsertOne {
+	ric.conflict = opts
+	return &RoomInviteUpsertOne{
+		create: ric,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RoomInvite.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ric *RoomInviteCreate) OnConflictColumns(columns ...string) *RoomInviteUpsertOne {
+	ric.conflict = append(ric.conflict, sql.ConflictColumns(columns...))
+	return &RoomInviteUpsertOne{
+		create: ric,
+	}
+}
+
+type (
+	// RoomInviteUpsertOne is the builder for "upsert"-ing
+	//  one RoomInvite node.
+	RoomInviteUpsertOne struct {
+		create *RoomInviteCreate
+	}
+
+	// RoomInviteUpsert is the "OnConflict" setter.
+	RoomInviteUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRoomID sets the "room_id" field.
+func (u *RoomInviteUpsert) SetRoomID(v pulid.ID) *RoomInviteUpsert {
+	u.Set(roominvite.FieldRoomID, v)
+	return u
+}
+
+// UpdateRoomID sets the "room_id" field to the value that was provided on create.
+func (u *RoomInviteUpsert) UpdateRoomID() *RoomInviteUpsert {
+	u.SetExcluded(roominvite.FieldRoomID)
+	return u
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *RoomInviteUpsert) SetCreatorID(v pulid.ID) *RoomInviteUpsert {
+	u.Set(roominvite.FieldCreatorID, v)
+	return u
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *RoomInviteUpsert) UpdateCreatorID() *RoomInviteUpsert {
+	u.SetExcluded(roominvite.FieldCreatorID)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *RoomInviteUpsert) SetExpiresAt(v time.Time) *RoomInviteUpsert {
+	u.Set(roominvite.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *RoomInviteUpsert) UpdateExpiresAt() *RoomInviteUpsert {
+	u.SetExcluded(roominvite.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *RoomInviteUpsert) ClearExpiresAt() *RoomInviteUpsert {
+	u.SetNull(roominvite.FieldExpiresAt)
+	return u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *RoomInviteUpsert) SetMaxUses(v int) *RoomInviteUpsert {
+	u.Set(roominvite.FieldMaxUses, v)
+	return u
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *RoomInviteUpsert) UpdateMaxUses() *RoomInviteUpsert {
+	u.SetExcluded(roominvite.FieldMaxUses)
+	return u
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *RoomInviteUpsert) AddMaxUses(v int) *RoomInviteUpsert {
+	u.Add(roominvite.FieldMaxUses, v)
+	return u
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (u *RoomInviteUpsert) ClearMaxUses() *RoomInviteUpsert {
+	u.SetNull(roominvite.FieldMaxUses)
+	return u
+}
+
+// SetUses sets the "uses" field.
+func (u *RoomInviteUpsert) SetUses(v int) *RoomInviteUpsert {
+	u.Set(roominvite.FieldUses, v)
+	return u
+}
+
+// UpdateUses sets the "uses" field to the value that was provided on create.
+func (u *RoomInviteUpsert) UpdateUses() *RoomInviteUpsert {
+	u.SetExcluded(roominvite.FieldUses)
+	return u
+}
+
+// AddUses adds v to the "uses" field.
+func (u *RoomInviteUpsert) AddUses(v int) *RoomInviteUpsert {
+	u.Add(roominvite.FieldUses, v)
+	return u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *RoomInviteUpsert) SetRevokedAt(v time.Time) *RoomInviteUpsert {
+	u.Set(roominvite.FieldRevokedAt, v)
+	return u
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *RoomInviteUpsert) UpdateRevokedAt() *RoomInviteUpsert {
+	u.SetExcluded(roominvite.FieldRevokedAt)
+	return u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *RoomInviteUpsert) ClearRevokedAt() *RoomInviteUpsert {
+	u.SetNull(roominvite.FieldRevokedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RoomInvite.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(roominvite.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RoomInviteUpsertOne) UpdateNewValues() *RoomInviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(roominvite.FieldID)
+		}
+		if _, exists := u.create.mutation.Token(); exists {
+			s.SetIgnore(roominvite.FieldToken)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(roominvite.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RoomInvite.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RoomInviteUpsertOne) Ignore() *RoomInviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RoomInviteUpsertOne) DoNothing() *RoomInviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RoomInviteCreate.OnConflict
+// documentation for more info.
+func (u *RoomInviteUpsertOne) Update(set func(*RoomInviteUpsert)) *RoomInviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RoomInviteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRoomID sets the "room_id" field.
+func (u *RoomInviteUpsertOne) SetRoomID(v pulid.ID) *RoomInviteUpsertOne {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.SetRoomID(v)
+	})
+}
+
+// UpdateRoomID sets the "room_id" field to the value that was provided on create.
+func (u *RoomInviteUpsertOne) UpdateRoomID() *RoomInviteUpsertOne {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.UpdateRoomID()
+	})
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *RoomInviteUpsertOne) SetCreatorID(v pulid.ID) *RoomInviteUpsertOne {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.SetCreatorID(v)
+	})
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *RoomInviteUpsertOne) UpdateCreatorID() *RoomInviteUpsertOne {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.UpdateCreatorID()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *RoomInviteUpsertOne) SetExpiresAt(v time.Time) *RoomInviteUpsertOne {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *RoomInviteUpsertOne) UpdateExpiresAt() *RoomInviteUpsertOne {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *RoomInviteUpsertOne) ClearExpiresAt() *RoomInviteUpsertOne {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *RoomInviteUpsertOne) SetMaxUses(v int) *RoomInviteUpsertOne {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.SetMaxUses(v)
+	})
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *RoomInviteUpsertOne) AddMaxUses(v int) *RoomInviteUpsertOne {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.AddMaxUses(v)
+	})
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *RoomInviteUpsertOne) UpdateMaxUses() *RoomInviteUpsertOne {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.UpdateMaxUses()
+	})
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (u *RoomInviteUpsertOne) ClearMaxUses() *RoomInviteUpsertOne {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.ClearMaxUses()
+	})
+}
+
+// SetUses sets the "uses" field.
+func (u *RoomInviteUpsertOne) SetUses(v int) *RoomInviteUpsertOne {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.SetUses(v)
+	})
+}
+
+// AddUses adds v to the "uses" field.
+func (u *RoomInviteUpsertOne) AddUses(v int) *RoomInviteUpsertOne {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.AddUses(v)
+	})
+}
+
+// UpdateUses sets the "uses" field to the value that was provided on create.
+func (u *RoomInviteUpsertOne) UpdateUses() *RoomInviteUpsertOne {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.UpdateUses()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *RoomInviteUpsertOne) SetRevokedAt(v time.Time) *RoomInviteUpsertOne {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *RoomInviteUpsertOne) UpdateRevokedAt() *RoomInviteUpsertOne {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *RoomInviteUpsertOne) ClearRevokedAt() *RoomInviteUpsertOne {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RoomInviteUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RoomInviteCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RoomInviteUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RoomInviteUpsertOne) ID(ctx context.Context) (id pulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: RoomInviteUpsertOne.ID is not supported by MySQL driver. Use RoomInviteUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RoomInviteUpsertOne) IDX(ctx context.Context) pulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RoomInviteCreateBulk is the builder for creating many RoomInvite entities in bulk.
+type RoomInviteCreateBulk struct {
+	config
+	err      error
+	builders []*RoomInviteCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RoomInvite entities in the database.
+func (ricb *RoomInviteCreateBulk) Save(ctx context.Context) ([]*RoomInvite, error) {
+	if ricb.err != nil {
+		return nil, ricb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ricb.builders))
+	nodes := make([]*RoomInvite, len(ricb.builders))
+	mutators := make([]Mutator, len(ricb.builders))
+	for i := range ricb.builders {
+		func(i int, root context.Context) {
+			builder := ricb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RoomInviteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ricb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ricb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ricb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ricb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ricb *RoomInviteCreateBulk) SaveX(ctx context.Context) []*RoomInvite {
+	v, err := ricb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ricb *RoomInviteCreateBulk) Exec(ctx context.Context) error {
+	_, err := ricb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ricb *RoomInviteCreateBulk) ExecX(ctx context.Context) {
+	if err := ricb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RoomInvite.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RoomInviteUpsert) {
+//			SetToken(v+v).
+//		}).
+//		Exec(ctx)
+func (ricb *RoomInviteCreateBulk) OnConflict(opts ...sql.ConflictOption) *RoomInviteUpsertBulk {
+	ricb.conflict = opts
+	return &RoomInviteUpsertBulk{
+		create: ricb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RoomInvite.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ricb *RoomInviteCreateBulk) OnConflictColumns(columns ...string) *RoomInviteUpsertBulk {
+	ricb.conflict = append(ricb.conflict, sql.ConflictColumns(columns...))
+	return &RoomInviteUpsertBulk{
+		create: ricb,
+	}
+}
+
+// RoomInviteUpsertBulk is the builder for "upsert"-ing
+// a bulk of RoomInvite nodes.
+type RoomInviteUpsertBulk struct {
+	create *RoomInviteCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RoomInvite.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(roominvite.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RoomInviteUpsertBulk) UpdateNewValues() *RoomInviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(roominvite.FieldID)
+			}
+			if _, exists := b.mutation.Token(); exists {
+				s.SetIgnore(roominvite.FieldToken)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(roominvite.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RoomInvite.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RoomInviteUpsertBulk) Ignore() *RoomInviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RoomInviteUpsertBulk) DoNothing() *RoomInviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RoomInviteCreateBulk.OnConflict
+// documentation for more info.
+func (u *RoomInviteUpsertBulk) Update(set func(*RoomInviteUpsert)) *RoomInviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RoomInviteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRoomID sets the "room_id" field.
+func (u *RoomInviteUpsertBulk) SetRoomID(v pulid.ID) *RoomInviteUpsertBulk {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.SetRoomID(v)
+	})
+}
+
+// UpdateRoomID sets the "room_id" field to the value that was provided on create.
+func (u *RoomInviteUpsertBulk) UpdateRoomID() *RoomInviteUpsertBulk {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.UpdateRoomID()
+	})
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *RoomInviteUpsertBulk) SetCreatorID(v pulid.ID) *RoomInviteUpsertBulk {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.SetCreatorID(v)
+	})
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *RoomInviteUpsertBulk) UpdateCreatorID() *RoomInviteUpsertBulk {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.UpdateCreatorID()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *RoomInviteUpsertBulk) SetExpiresAt(v time.Time) *RoomInviteUpsertBulk {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *RoomInviteUpsertBulk) UpdateExpiresAt() *RoomInviteUpsertBulk {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *RoomInviteUpsertBulk) ClearExpiresAt() *RoomInviteUpsertBulk {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *RoomInviteUpsertBulk) SetMaxUses(v int) *RoomInviteUpsertBulk {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.SetMaxUses(v)
+	})
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *RoomInviteUpsertBulk) AddMaxUses(v int) *RoomInviteUpsertBulk {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.AddMaxUses(v)
+	})
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *RoomInviteUpsertBulk) UpdateMaxUses() *RoomInviteUpsertBulk {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.UpdateMaxUses()
+	})
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (u *RoomInviteUpsertBulk) ClearMaxUses() *RoomInviteUpsertBulk {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.ClearMaxUses()
+	})
+}
+
+// SetUses sets the "uses" field.
+func (u *RoomInviteUpsertBulk) SetUses(v int) *RoomInviteUpsertBulk {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.SetUses(v)
+	})
+}
+
+// AddUses adds v to the "uses" field.
+func (u *RoomInviteUpsertBulk) AddUses(v int) *RoomInviteUpsertBulk {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.AddUses(v)
+	})
+}
+
+// UpdateUses sets the "uses" field to the value that was provided on create.
+func (u *RoomInviteUpsertBulk) UpdateUses() *RoomInviteUpsertBulk {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.UpdateUses()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *RoomInviteUpsertBulk) SetRevokedAt(v time.Time) *RoomInviteUpsertBulk {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *RoomInviteUpsertBulk) UpdateRevokedAt() *RoomInviteUpsertBulk {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *RoomInviteUpsertBulk) ClearRevokedAt() *RoomInviteUpsertBulk {
+	return u.Update(func(s *RoomInviteUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RoomInviteUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RoomInviteCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RoomInviteCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RoomInviteUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"journeyhub/ent/predicate"
+	"journeyhub/ent/roominvite"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RoomInviteDelete is the builder for deleting a RoomInvite entity.
+type RoomInviteDelete struct {
+	config
+	hooks    []Hook
+	mutation *RoomInviteMutation
+}
+
+// Where appends a list predicates to the RoomInviteDelete builder.
+func (rid *RoomInviteDelete) Where(ps ...predicate.RoomInvite) *RoomInviteDelete {
+	rid.mutation.Where(ps...)
+	return rid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rid *RoomInviteDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rid.sqlExec, rid.mutation, rid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rid *RoomInviteDelete) ExecX(ctx context.Context) int {
+	n, err := rid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rid *RoomInviteDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(roominvite.Table, sqlgraph.NewFieldSpec(roominvite.FieldID, field.TypeString))
+	if ps := rid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rid.mutation.done = true
+	return affected, err
+}
+
+// RoomInviteDeleteOne is the builder for deleting a single RoomInvite entity.
+type RoomInviteDeleteOne struct {
+	rid *RoomInviteDelete
+}
+
+// Where appends a list predicates to the RoomInviteDelete builder.
+func (rido *RoomInviteDeleteOne) Where(ps ...predicate.RoomInvite) *RoomInviteDeleteOne {
+	rido.rid.mutation.Where(ps...)
+	return rido
+}
+
+// Exec executes the deletion query.
+func (rido *RoomInviteDeleteOne) Exec(ctx context.Context) error {
+	n, err := rido.rid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{roominvite.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rido *RoomInviteDeleteOne) ExecX(ctx context.Context) {
+	if err := rido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,695 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"journeyhub/ent/predicate"
+	"journeyhub/ent/room"
+	"journeyhub/ent/roominvite"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RoomInviteQuery is the builder for querying RoomInvite entities.
+type RoomInviteQuery struct {
+	config
+	ctx         *QueryContext
+	order       []roominvite.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.RoomInvite
+	withRoom    *RoomQuery
+	withCreator *UserQuery
+	modifiers   []func(*sql.Selector)
+	loadTotal   []func(context.Context, []*RoomInvite) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RoomInviteQuery builder.
+func (riq *RoomInviteQuery) Where(ps ...predicate.RoomInvite) *RoomInviteQuery {
+	riq.predicates = append(riq.predicates, ps...)
+	return riq
+}
+
+// Limit the number of records to be returned by this query.
+func (riq *RoomInviteQuery) Limit(limit int) *RoomInviteQuery {
+	riq.ctx.Limit = &limit
+	return riq
+}
+
+// Offset to start from.
+func (riq *RoomInviteQuery) Offset(offset int) *RoomInviteQuery {
+	riq.ctx.Offset = &offset
+	return riq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (riq *RoomInviteQuery) Unique(unique bool) *RoomInviteQuery {
+	riq.ctx.Unique = &unique
+	return riq
+}
+
+// Order specifies how the records should be ordered.
+func (riq *RoomInviteQuery) Order(o ...roominvite.OrderOption) *RoomInviteQuery {
+	riq.order = append(riq.order, o...)
+	return riq
+}
+
+// QueryRoom chains the current query on the "room" edge.
+func (riq *RoomInviteQuery) QueryRoom() *RoomQuery {
+	query := (&RoomClient{config: riq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := riq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := riq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roominvite.Table, roominvite.FieldID, selector),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, roominvite.RoomTable, roominvite.RoomColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(riq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCreator chains the current query on the "creator" edge.
+func (riq *RoomInviteQuery) QueryCreator() *UserQuery {
+	query := (&UserClient{config: riq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := riq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := riq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roominvite.Table, roominvite.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, roominvite.CreatorTable, roominvite.CreatorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(riq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RoomInvite entity from the query.
+// Returns a *NotFoundError when no RoomInvite was found.
+func (riq *RoomInviteQuery) First(ctx context.Context) (*RoomInvite, error) {
+	nodes, err := riq.Limit(1).All(setContextOp(ctx, riq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{roominvite.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (riq *RoomInviteQuery) FirstX(ctx context.Context) *RoomInvite {
+	node, err := riq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RoomInvite ID from the query.
+// Returns a *NotFoundError when no RoomInvite ID was found.
+func (riq *RoomInviteQuery) FirstID(ctx context.Context) (id pulid.ID, err error) {
+	var ids []pulid.ID
+	if ids, err = riq.Limit(1).IDs(setContextOp(ctx, riq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{roominvite.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (riq *RoomInviteQuery) FirstIDX(ctx context.Context) pulid.ID {
+	id, err := riq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RoomInvite entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RoomInvite entity is found.
+// Returns a *NotFoundError when no RoomInvite entities are found.
+func (riq *RoomInviteQuery) Only(ctx context.Context) (*RoomInvite, error) {
+	nodes, err := riq.Limit(2).All(setContextOp(ctx, riq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{roominvite.Label}
+	default:
+		return nil, &NotSingularError{roominvite.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (riq *RoomInviteQuery) OnlyX(ctx context.Context) *RoomInvite {
+	node, err := riq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RoomInvite ID in the query.
+// Returns a *NotSingularError when more than one RoomInvite ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (riq *RoomInviteQuery) OnlyID(ctx context.Context) (id pulid.ID, err error) {
+	var ids []pulid.ID
+	if ids, err = riq.Limit(2).IDs(setContextOp(ctx, riq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{roominvite.Label}
+	default:
+		err = &NotSingularError{roominvite.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (riq *RoomInviteQuery) OnlyIDX(ctx context.Context) pulid.ID {
+	id, err := riq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RoomInvites.
+func (riq *RoomInviteQuery) All(ctx context.Context) ([]*RoomInvite, error) {
+	ctx = setContextOp(ctx, riq.ctx, ent.OpQueryAll)
+	if err := riq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RoomInvite, *RoomInviteQuery]()
+	return withInterceptors[[]*RoomInvite](ctx, riq, qr, riq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (riq *RoomInviteQuery) AllX(ctx context.Context) []*RoomInvite {
+	nodes, err := riq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RoomInvite IDs.
+func (riq *RoomInviteQuery) IDs(ctx context.Context) (ids []pulid.ID, err error) {
+	if riq.ctx.Unique == nil && riq.path != nil {
+		riq.Unique(true)
+	}
+	ctx = setContextOp(ctx, riq.ctx, ent.OpQueryIDs)
+	if err = riq.Select(roominvite.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (riq *RoomInviteQuery) IDsX(ctx context.Context) []pulid.ID {
+	ids, err := riq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (riq *RoomInviteQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, riq.ctx, ent.OpQueryCount)
+	if err := riq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, riq, querierCount[*RoomInviteQuery](), riq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (riq *RoomInviteQuery) CountX(ctx context.Context) int {
+	count, err := riq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (riq *RoomInviteQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, riq.ctx, ent.OpQueryExist)
+	switch _, err := riq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (riq *RoomInviteQuery) ExistX(ctx context.Context) bool {
+	exist, err := riq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RoomInviteQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (riq *RoomInviteQuery) Clone() *RoomInviteQuery {
+	if riq == nil {
+		return nil
+	}
+	return &RoomInviteQuery{
+		config:      riq.config,
+		ctx:         riq.ctx.Clone(),
+		order:       append([]roominvite.OrderOption{}, riq.order...),
+		inters:      append([]Interceptor{}, riq.inters...),
+		predicates:  append([]predicate.RoomInvite{}, riq.predicates...),
+		withRoom:    riq.withRoom.Clone(),
+		withCreator: riq.withCreator.Clone(),
+		// clone intermediate query.
+		sql:  riq.sql.Clone(),
+		path: riq.path,
+	}
+}
+
+// WithRoom tells the query-builder to eager-load the nodes that are connected to
+// the "room" edge. The optional arguments are used to configure the query builder of the edge.
+func (riq *RoomInviteQuery) WithRoom(opts ...func(*RoomQuery)) *RoomInviteQuery {
+	query := (&RoomClient{config: riq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	riq.withRoom = query
+	return riq
+}
+
+// WithCreator tells the query-builder to eager-load the nodes that are connected to
+// the "creator" edge. The optional arguments are used to configure the query builder of the edge.
+func (riq *RoomInviteQuery) WithCreator(opts ...func(*UserQuery)) *RoomInviteQuery {
+	query := (&UserClient{config: riq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	riq.withCreator = query
+	return riq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Token string `json:"token,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RoomInvite.Query().
+//		GroupBy(roominvite.FieldToken).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (riq *RoomInviteQuery) GroupBy(field string, fields ...string) *RoomInviteGroupBy {
+	riq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RoomInviteGroupBy{build: riq}
+	grbuild.flds = &riq.ctx.Fields
+	grbuild.label = roominvite.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Token string `json:"token,omitempty"`
+//	}
+//
+//	client.RoomInvite.Query().
+//		Select(roominvite.FieldToken).
+//		Scan(ctx, &v)
+func (riq *RoomInviteQuery) Select(fields ...string) *RoomInviteSelect {
+	riq.ctx.Fields = append(riq.ctx.Fields, fields...)
+	sbuild := &RoomInviteSelect{RoomInviteQuery: riq}
+	sbuild.label = roominvite.Label
+	sbuild.flds, sbuild.scan = &riq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RoomInviteSelect configured with the given aggregations.
+func (riq *RoomInviteQuery) Aggregate(fns ...AggregateFunc) *RoomInviteSelect {
+	return riq.Select().Aggregate(fns...)
+}
+
+func (riq *RoomInviteQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range riq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, riq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range riq.ctx.Fields {
+		if !roominvite.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if riq.path != nil {
+		prev, err := riq.path(ctx)
+		if err != nil {
+			return err
+		}
+		riq.sql = prev
+	}
+	return nil
+}
+
+func (riq *RoomInviteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RoomInvite, error) {
+	var (
+		nodes       = []*RoomInvite{}
+		_spec       = riq.querySpec()
+		loadedTypes = [2]bool{
+			riq.withRoom != nil,
+			riq.withCreator != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RoomInvite).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RoomInvite{config: riq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(riq.modifiers) > 0 {
+		_spec.Modifiers = riq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, riq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := riq.withRoom; query != nil {
+		if err := riq.loadRoom(ctx, query, nodes, nil,
+			func(n *RoomInvite, e *Room) { n.Edges.Room = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := riq.withCreator; query != nil {
+		if err := riq.loadCreator(ctx, query, nodes, nil,
+			func(n *RoomInvite, e *User) { n.Edges.Creator = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range riq.loadTotal {
+		if err := riq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (riq *RoomInviteQuery) loadRoom(ctx context.Context, query *RoomQuery, nodes []*RoomInvite, init func(*RoomInvite), assign func(*RoomInvite, *Room)) error {
+	ids := make([]pulid.ID, 0, len(nodes))
+	nodeids := make(map[pulid.ID][]*RoomInvite)
+	for i := range nodes {
+		fk := nodes[i].RoomID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(room.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "room_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (riq *RoomInviteQuery) loadCreator(ctx context.Context, query *UserQuery, nodes []*RoomInvite, init func(*RoomInvite), assign func(*RoomInvite, *User)) error {
+	ids := make([]pulid.ID, 0, len(nodes))
+	nodeids := make(map[pulid.ID][]*RoomInvite)
+	for i := range nodes {
+		fk := nodes[i].CreatorID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "creator_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (riq *RoomInviteQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := riq.querySpec()
+	if len(riq.modifiers) > 0 {
+		_spec.Modifiers = riq.modifiers
+	}
+	_spec.Node.Columns = riq.ctx.Fields
+	if len(riq.ctx.Fields) > 0 {
+		_spec.Unique = riq.ctx.Unique != nil && *riq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, riq.driver, _spec)
+}
+
+func (riq *RoomInviteQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(roominvite.Table, roominvite.Columns, sqlgraph.NewFieldSpec(roominvite.FieldID, field.TypeString))
+	_spec.From = riq.sql
+	if unique := riq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if riq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := riq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, roominvite.FieldID)
+		for i := range fields {
+			if fields[i] != roominvite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if riq.withRoom != nil {
+			_spec.Node.AddColumnOnce(roominvite.FieldRoomID)
+		}
+		if riq.withCreator != nil {
+			_spec.Node.AddColumnOnce(roominvite.FieldCreatorID)
+		}
+	}
+	if ps := riq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := riq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := riq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := riq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (riq *RoomInviteQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(riq.driver.Dialect())
+	t1 := builder.Table(roominvite.Table)
+	columns := riq.ctx.Fields
+	if len(columns) == 0 {
+		columns = roominvite.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if riq.sql != nil {
+		selector = riq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if riq.ctx.Unique != nil && *riq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range riq.predicates {
+		p(selector)
+	}
+	for _, p := range riq.order {
+		p(selector)
+	}
+	if offset := riq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := riq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RoomInviteGroupBy is the group-by builder for RoomInvite entities.
+type RoomInviteGroupBy struct {
+	selector
+	build *RoomInviteQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rigb *RoomInviteGroupBy) Aggregate(fns ...AggregateFunc) *RoomInviteGroupBy {
+	rigb.fns = append(rigb.fns, fns...)
+	return rigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rigb *RoomInviteGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rigb.build.ctx, ent.OpQueryGroupBy)
+	if err := rigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoomInviteQuery, *RoomInviteGroupBy](ctx, rigb.build, rigb, rigb.build.inters, v)
+}
+
+func (rigb *RoomInviteGroupBy) sqlScan(ctx context.Context, root *RoomInviteQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rigb.fns))
+	for _, fn := range rigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rigb.flds)+len(rigb.fns))
+		for _, f := range *rigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RoomInviteSelect is the builder for selecting fields of RoomInvite entities.
+type RoomInviteSelect struct {
+	*RoomInviteQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ris *RoomInviteSelect) Aggregate(fns ...AggregateFunc) *RoomInviteSelect {
+	ris.fns = append(ris.fns, fns...)
+	return ris
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ris *RoomInviteSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ris.ctx, ent.OpQuerySelect)
+	if err := ris.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoomInviteQuery, *RoomInviteSelect](ctx, ris.RoomInviteQuery, ris, ris.inters, v)
+}
+
+func (ris *RoomInviteSelect) sqlScan(ctx context.Context, root *RoomInviteQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ris.fns))
+	for _, fn := range ris.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ris.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ris.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
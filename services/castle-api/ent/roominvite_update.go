@@ -0,0 +1,663 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journeyhub/ent/predicate"
+	"journeyhub/ent/room"
+	"journeyhub/ent/roominvite"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RoomInviteUpdate is the builder for updating RoomInvite entities.
+type RoomInviteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RoomInviteMutation
+}
+
+// Where appends a list predicates to the RoomInviteUpdate builder.
+func (riu *RoomInviteUpdate) Where(ps ...predicate.RoomInvite) *RoomInviteUpdate {
+	riu.mutation.Where(ps...)
+	return riu
+}
+
+// SetRoomID sets the "room_id" field.
+func (riu *RoomInviteUpdate) SetRoomID(pu pulid.ID) *RoomInviteUpdate {
+	riu.mutation.SetRoomID(pu)
+	return riu
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (riu *RoomInviteUpdate) SetNillableRoomID(pu *pulid.ID) *RoomInviteUpdate {
+	if pu != nil {
+		riu.SetRoomID(*pu)
+	}
+	return riu
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (riu *RoomInviteUpdate) SetCreatorID(pu pulid.ID) *RoomInviteUpdate {
+	riu.mutation.SetCreatorID(pu)
+	return riu
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (riu *RoomInviteUpdate) SetNillableCreatorID(pu *pulid.ID) *RoomInviteUpdate {
+	if pu != nil {
+		riu.SetCreatorID(*pu)
+	}
+	return riu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (riu *RoomInviteUpdate) SetExpiresAt(t time.Time) *RoomInviteUpdate {
+	riu.mutation.SetExpiresAt(t)
+	return riu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (riu *RoomInviteUpdate) SetNillableExpiresAt(t *time.Time) *RoomInviteUpdate {
+	if t != nil {
+		riu.SetExpiresAt(*t)
+	}
+	return riu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (riu *RoomInviteUpdate) ClearExpiresAt() *RoomInviteUpdate {
+	riu.mutation.ClearExpiresAt()
+	return riu
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (riu *RoomInviteUpdate) SetMaxUses(i int) *RoomInviteUpdate {
+	riu.mutation.ResetMaxUses()
+	riu.mutation.SetMaxUses(i)
+	return riu
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (riu *RoomInviteUpdate) SetNillableMaxUses(i *int) *RoomInviteUpdate {
+	if i != nil {
+		riu.SetMaxUses(*i)
+	}
+	return riu
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (riu *RoomInviteUpdate) AddMaxUses(i int) *RoomInviteUpdate {
+	riu.mutation.AddMaxUses(i)
+	return riu
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (riu *RoomInviteUpdate) ClearMaxUses() *RoomInviteUpdate {
+	riu.mutation.ClearMaxUses()
+	return riu
+}
+
+// SetUses sets the "uses" field.
+func (riu *RoomInviteUpdate) SetUses(i int) *RoomInviteUpdate {
+	riu.mutation.ResetUses()
+	riu.mutation.SetUses(i)
+	return riu
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (riu *RoomInviteUpdate) SetNillableUses(i *int) *RoomInviteUpdate {
+	if i != nil {
+		riu.SetUses(*i)
+	}
+	return riu
+}
+
+// AddUses adds i to the "uses" field.
+func (riu *RoomInviteUpdate) AddUses(i int) *RoomInviteUpdate {
+	riu.mutation.AddUses(i)
+	return riu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (riu *RoomInviteUpdate) SetRevokedAt(t time.Time) *RoomInviteUpdate {
+	riu.mutation.SetRevokedAt(t)
+	return riu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (riu *RoomInviteUpdate) SetNillableRevokedAt(t *time.Time) *RoomInviteUpdate {
+	if t != nil {
+		riu.SetRevokedAt(*t)
+	}
+	return riu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (riu *RoomInviteUpdate) ClearRevokedAt() *RoomInviteUpdate {
+	riu.mutation.ClearRevokedAt()
+	return riu
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (riu *RoomInviteUpdate) SetRoom(r *Room) *RoomInviteUpdate {
+	return riu.SetRoomID(r.ID)
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (riu *RoomInviteUpdate) SetCreator(u *User) *RoomInviteUpdate {
+	return riu.SetCreatorID(u.ID)
+}
+
+// Mutation returns the RoomInviteMutation object of the builder.
+func (riu *RoomInviteUpdate) Mutation() *RoomInviteMutation {
+	return riu.mutation
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (riu *RoomInviteUpdate) ClearRoom() *RoomInviteUpdate {
+	riu.mutation.ClearRoom()
+	return riu
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (riu *RoomInviteUpdate) ClearCreator() *RoomInviteUpdate {
+	riu.mutation.ClearCreator()
+	return riu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (riu *RoomInviteUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, riu.sqlSave, riu.mutation, riu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (riu *RoomInviteUpdate) SaveX(ctx context.Context) int {
+	affected, err := riu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (riu *RoomInviteUpdate) Exec(ctx context.Context) error {
+	_, err := riu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (riu *RoomInviteUpdate) ExecX(ctx context.Context) {
+	if err := riu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (riu *RoomInviteUpdate) check() error {
+	if v, ok := riu.mutation.MaxUses(); ok {
+		if err := roominvite.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "RoomInvite.max_uses": %w`, err)}
+		}
+	}
+	if riu.mutation.RoomCleared() && len(riu.mutation.RoomIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoomInvite.room"`)
+	}
+	if riu.mutation.CreatorCleared() && len(riu.mutation.CreatorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoomInvite.creator"`)
+	}
+	return nil
+}
+
+func (riu *RoomInviteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := riu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roominvite.Table, roominvite.Columns, sqlgraph.NewFieldSpec(roominvite.FieldID, field.TypeString))
+	if ps := riu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := riu.mutation.ExpiresAt(); ok {
+		_spec.SetField(roominvite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if riu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(roominvite.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := riu.mutation.MaxUses(); ok {
+		_spec.SetField(roominvite.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := riu.mutation.AddedMaxUses(); ok {
+		_spec.AddField(roominvite.FieldMaxUses, field.TypeInt, value)
+	}
+	if riu.mutation.MaxUsesCleared() {
+		_spec.ClearField(roominvite.FieldMaxUses, field.TypeInt)
+	}
+	if value, ok := riu.mutation.Uses(); ok {
+		_spec.SetField(roominvite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := riu.mutation.AddedUses(); ok {
+		_spec.AddField(roominvite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := riu.mutation.RevokedAt(); ok {
+		_spec.SetField(roominvite.FieldRevokedAt, field.TypeTime, value)
+	}
+	if riu.mutation.RevokedAtCleared() {
+		_spec.ClearField(roominvite.FieldRevokedAt, field.TypeTime)
+	}
+	if riu.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roominvite.RoomTable,
+			Columns: []string{roominvite.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := riu.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roominvite.RoomTable,
+			Columns: []string{roominvite.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if riu.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roominvite.CreatorTable,
+			Columns: []string{roominvite.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := riu.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roominvite.CreatorTable,
+			Columns: []string{roominvite.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, riu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roominvite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	riu.mutation.done = true
+	return n, nil
+}
+
+// RoomInviteUpdateOne is the builder for updating a single RoomInvite entity.
+type RoomInviteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RoomInviteMutation
+}
+
+// SetRoomID sets the "room_id" field.
+func (riuo *RoomInviteUpdateOne) SetRoomID(pu pulid.ID) *RoomInviteUpdateOne {
+	riuo.mutation.SetRoomID(pu)
+	return riuo
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (riuo *RoomInviteUpdateOne) SetNillableRoomID(pu *pulid.ID) *RoomInviteUpdateOne {
+	if pu != nil {
+		riuo.SetRoomID(*pu)
+	}
+	return riuo
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (riuo *RoomInviteUpdateOne) SetCreatorID(pu pulid.ID) *RoomInviteUpdateOne {
+	riuo.mutation.SetCreatorID(pu)
+	return riuo
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (riuo *RoomInviteUpdateOne) SetNillableCreatorID(pu *pulid.ID) *RoomInviteUpdateOne {
+	if pu != nil {
+		riuo.SetCreatorID(*pu)
+	}
+	return riuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (riuo *RoomInviteUpdateOne) SetExpiresAt(t time.Time) *RoomInviteUpdateOne {
+	riuo.mutation.SetExpiresAt(t)
+	return riuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (riuo *RoomInviteUpdateOne) SetNillableExpiresAt(t *time.Time) *RoomInviteUpdateOne {
+	if t != nil {
+		riuo.SetExpiresAt(*t)
+	}
+	return riuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (riuo *RoomInviteUpdateOne) ClearExpiresAt() *RoomInviteUpdateOne {
+	riuo.mutation.ClearExpiresAt()
+	return riuo
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (riuo *RoomInviteUpdateOne) SetMaxUses(i int) *RoomInviteUpdateOne {
+	riuo.mutation.ResetMaxUses()
+	riuo.mutation.SetMaxUses(i)
+	return riuo
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (riuo *RoomInviteUpdateOne) SetNillableMaxUses(i *int) *RoomInviteUpdateOne {
+	if i != nil {
+		riuo.SetMaxUses(*i)
+	}
+	return riuo
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (riuo *RoomInviteUpdateOne) AddMaxUses(i int) *RoomInviteUpdateOne {
+	riuo.mutation.AddMaxUses(i)
+	return riuo
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (riuo *RoomInviteUpdateOne) ClearMaxUses() *RoomInviteUpdateOne {
+	riuo.mutation.ClearMaxUses()
+	return riuo
+}
+
+// SetUses sets the "uses" field.
+func (riuo *RoomInviteUpdateOne) SetUses(i int) *RoomInviteUpdateOne {
+	riuo.mutation.ResetUses()
+	riuo.mutation.SetUses(i)
+	return riuo
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (riuo *RoomInviteUpdateOne) SetNillableUses(i *int) *RoomInviteUpdateOne {
+	if i != nil {
+		riuo.SetUses(*i)
+	}
+	return riuo
+}
+
+// AddUses adds i to the "uses" field.
+func (riuo *RoomInviteUpdateOne) AddUses(i int) *RoomInviteUpdateOne {
+	riuo.mutation.AddUses(i)
+	return riuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (riuo *RoomInviteUpdateOne) SetRevokedAt(t time.Time) *RoomInviteUpdateOne {
+	riuo.mutation.SetRevokedAt(t)
+	return riuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (riuo *RoomInviteUpdateOne) SetNillableRevokedAt(t *time.Time) *RoomInviteUpdateOne {
+	if t != nil {
+		riuo.SetRevokedAt(*t)
+	}
+	return riuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (riuo *RoomInviteUpdateOne) ClearRevokedAt() *RoomInviteUpdateOne {
+	riuo.mutation.ClearRevokedAt()
+	return riuo
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (riuo *RoomInviteUpdateOne) SetRoom(r *Room) *RoomInviteUpdateOne {
+	return riuo.SetRoomID(r.ID)
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (riuo *RoomInviteUpdateOne) SetCreator(u *User) *RoomInviteUpdateOne {
+	return riuo.SetCreatorID(u.ID)
+}
+
+// Mutation returns the RoomInviteMutation object of the builder.
+func (riuo *RoomInviteUpdateOne) Mutation() *RoomInviteMutation {
+	return riuo.mutation
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (riuo *RoomInviteUpdateOne) ClearRoom() *RoomInviteUpdateOne {
+	riuo.mutation.ClearRoom()
+	return riuo
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (riuo *RoomInviteUpdateOne) ClearCreator() *RoomInviteUpdateOne {
+	riuo.mutation.ClearCreator()
+	return riuo
+}
+
+// Where appends a list predicates to the RoomInviteUpdate builder.
+func (riuo *RoomInviteUpdateOne) Where(ps ...predicate.RoomInvite) *RoomInviteUpdateOne {
+	riuo.mutation.Where(ps...)
+	return riuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (riuo *RoomInviteUpdateOne) Select(field string, fields ...string) *RoomInviteUpdateOne {
+	riuo.fields = append([]string{field}, fields...)
+	return riuo
+}
+
+// Save executes the query and returns the updated RoomInvite entity.
+func (riuo *RoomInviteUpdateOne) Save(ctx context.Context) (*RoomInvite, error) {
+	return withHooks(ctx, riuo.sqlSave, riuo.mutation, riuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (riuo *RoomInviteUpdateOne) SaveX(ctx context.Context) *RoomInvite {
+	node, err := riuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (riuo *RoomInviteUpdateOne) Exec(ctx context.Context) error {
+	_, err := riuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (riuo *RoomInviteUpdateOne) ExecX(ctx context.Context) {
+	if err := riuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (riuo *RoomInviteUpdateOne) check() error {
+	if v, ok := riuo.mutation.MaxUses(); ok {
+		if err := roominvite.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "RoomInvite.max_uses": %w`, err)}
+		}
+	}
+	if riuo.mutation.RoomCleared() && len(riuo.mutation.RoomIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoomInvite.room"`)
+	}
+	if riuo.mutation.CreatorCleared() && len(riuo.mutation.CreatorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoomInvite.creator"`)
+	}
+	return nil
+}
+
+func (riuo *RoomInviteUpdateOne) sqlSave(ctx context.Context) (_node *RoomInvite, err error) {
+	if err := riuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roominvite.Table, roominvite.Columns, sqlgraph.NewFieldSpec(roominvite.FieldID, field.TypeString))
+	id, ok := riuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RoomInvite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := riuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, roominvite.FieldID)
+		for _, f := range fields {
+			if !roominvite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != roominvite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := riuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := riuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(roominvite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if riuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(roominvite.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := riuo.mutation.MaxUses(); ok {
+		_spec.SetField(roominvite.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := riuo.mutation.AddedMaxUses(); ok {
+		_spec.AddField(roominvite.FieldMaxUses, field.TypeInt, value)
+	}
+	if riuo.mutation.MaxUsesCleared() {
+		_spec.ClearField(roominvite.FieldMaxUses, field.TypeInt)
+	}
+	if value, ok := riuo.mutation.Uses(); ok {
+		_spec.SetField(roominvite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := riuo.mutation.AddedUses(); ok {
+		_spec.AddField(roominvite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := riuo.mutation.RevokedAt(); ok {
+		_spec.SetField(roominvite.FieldRevokedAt, field.TypeTime, value)
+	}
+	if riuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(roominvite.FieldRevokedAt, field.TypeTime)
+	}
+	if riuo.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roominvite.RoomTable,
+			Columns: []string{roominvite.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := riuo.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roominvite.RoomTable,
+			Columns: []string{roominvite.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if riuo.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roominvite.CreatorTable,
+			Columns: []string{roominvite.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := riuo.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roominvite.CreatorTable,
+			Columns: []string{roominvite.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RoomInvite{config: riuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, riuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roominvite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	riuo.mutation.done = true
+	return _node, nil
+}
@@ -26,6 +26,14 @@ type RoomMember struct {
 	Name string `json:"name,omitempty"`
 	// UnreadMessagesCount holds the value of the "unread_messages_count" field.
 	UnreadMessagesCount int `json:"unread_messages_count,omitempty"`
+	// NotificationLevel holds the value of the "notification_level" field.
+	NotificationLevel roommember.NotificationLevel `json:"notification_level,omitempty"`
+	// MuteUntil holds the value of the "mute_until" field.
+	MuteUntil *time.Time `json:"mute_until,omitempty"`
+	// Role holds the value of the "role" field.
+	Role roommember.Role `json:"role,omitempty"`
+	// BannedAt holds the value of the "banned_at" field.
+	BannedAt *time.Time `json:"banned_at,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID pulid.ID `json:"user_id,omitempty"`
 	// RoomID holds the value of the "room_id" field.
@@ -84,9 +92,9 @@ func (*RoomMember) scanValues(columns []string) ([]any, error) {
 			values[i] = new(pulid.ID)
 		case roommember.FieldUnreadMessagesCount:
 			values[i] = new(sql.NullInt64)
-		case roommember.FieldName:
+		case roommember.FieldName, roommember.FieldNotificationLevel, roommember.FieldRole:
 			values[i] = new(sql.NullString)
-		case roommember.FieldDeletedAt, roommember.FieldJoinedAt, roommember.FieldUpdatedAt:
+		case roommember.FieldDeletedAt, roommember.FieldMuteUntil, roommember.FieldBannedAt, roommember.FieldJoinedAt, roommember.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -127,6 +135,32 @@ func (rm *RoomMember) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				rm.UnreadMessagesCount = int(value.Int64)
 			}
+		case roommember.FieldNotificationLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notification_level", values[i])
+			} else if value.Valid {
+				rm.NotificationLevel = roommember.NotificationLevel(value.String)
+			}
+		case roommember.FieldMuteUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field mute_until", values[i])
+			} else if value.Valid {
+				rm.MuteUntil = new(time.Time)
+				*rm.MuteUntil = value.Time
+			}
+		case roommember.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				rm.Role = roommember.Role(value.String)
+			}
+		case roommember.FieldBannedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field banned_at", values[i])
+			} else if value.Valid {
+				rm.BannedAt = new(time.Time)
+				*rm.BannedAt = value.Time
+			}
 		case roommember.FieldUserID:
 			if value, ok := values[i].(*pulid.ID); !ok {
 				return fmt.Errorf("unexpected type %T for field user_id", values[i])
@@ -206,6 +240,22 @@ func (rm *RoomMember) String() string {
 	builder.WriteString("unread_messages_count=")
 	builder.WriteString(fmt.Sprintf("%v", rm.UnreadMessagesCount))
 	builder.WriteString(", ")
+	builder.WriteString("notification_level=")
+	builder.WriteString(fmt.Sprintf("%v", rm.NotificationLevel))
+	builder.WriteString(", ")
+	if v := rm.MuteUntil; v != nil {
+		builder.WriteString("mute_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", rm.Role))
+	builder.WriteString(", ")
+	if v := rm.BannedAt; v != nil {
+		builder.WriteString("banned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", rm.UserID))
 	builder.WriteString(", ")
@@ -3,7 +3,10 @@
 package roommember
 
 import (
+	"fmt"
+	"io"
 	"journeyhub/ent/schema/pulid"
+	"strconv"
 	"time"
 
 	"entgo.io/ent"
@@ -22,6 +25,14 @@ const (
 	FieldName = "name"
 	// FieldUnreadMessagesCount holds the string denoting the unread_messages_count field in the database.
 	FieldUnreadMessagesCount = "unread_messages_count"
+	// FieldNotificationLevel holds the string denoting the notification_level field in the database.
+	FieldNotificationLevel = "notification_level"
+	// FieldMuteUntil holds the string denoting the mute_until field in the database.
+	FieldMuteUntil = "mute_until"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldBannedAt holds the string denoting the banned_at field in the database.
+	FieldBannedAt = "banned_at"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
 	// FieldRoomID holds the string denoting the room_id field in the database.
@@ -58,6 +69,10 @@ var Columns = []string{
 	FieldDeletedAt,
 	FieldName,
 	FieldUnreadMessagesCount,
+	FieldNotificationLevel,
+	FieldMuteUntil,
+	FieldRole,
+	FieldBannedAt,
 	FieldUserID,
 	FieldRoomID,
 	FieldJoinedAt,
@@ -94,6 +109,61 @@ var (
 	DefaultID func() pulid.ID
 )
 
+// NotificationLevel defines the type for the "notification_level" enum field.
+type NotificationLevel string
+
+// NotificationLevelAll is the default value of the NotificationLevel enum.
+const DefaultNotificationLevel = NotificationLevelAll
+
+// NotificationLevel values.
+const (
+	NotificationLevelAll      NotificationLevel = "All"
+	NotificationLevelMentions NotificationLevel = "Mentions"
+	NotificationLevelNone     NotificationLevel = "None"
+)
+
+func (nl NotificationLevel) String() string {
+	return string(nl)
+}
+
+// NotificationLevelValidator is a validator for the "notification_level" field enum values. It is called by the builders before save.
+func NotificationLevelValidator(nl NotificationLevel) error {
+	switch nl {
+	case NotificationLevelAll, NotificationLevelMentions, NotificationLevelNone:
+		return nil
+	default:
+		return fmt.Errorf("roommember: invalid enum value for notification_level field: %q", nl)
+	}
+}
+
+// Role defines the type for the "role" enum field.
+type Role string
+
+// RoleMember is the default value of the Role enum.
+const DefaultRole = RoleMember
+
+// Role values.
+const (
+	RoleOwner    Role = "Owner"
+	RoleAdmin    Role = "Admin"
+	RoleMember   Role = "Member"
+	RoleReadOnly Role = "ReadOnly"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleOwner, RoleAdmin, RoleMember, RoleReadOnly:
+		return nil
+	default:
+		return fmt.Errorf("roommember: invalid enum value for role field: %q", r)
+	}
+}
+
 // OrderOption defines the ordering options for the RoomMember queries.
 type OrderOption func(*sql.Selector)
 
@@ -117,6 +187,26 @@ func ByUnreadMessagesCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUnreadMessagesCount, opts...).ToFunc()
 }
 
+// ByNotificationLevel orders the results by the notification_level field.
+func ByNotificationLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotificationLevel, opts...).ToFunc()
+}
+
+// ByMuteUntil orders the results by the mute_until field.
+func ByMuteUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMuteUntil, opts...).ToFunc()
+}
+
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
+// ByBannedAt orders the results by the banned_at field.
+func ByBannedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBannedAt, opts...).ToFunc()
+}
+
 // ByUserID orders the results by the user_id field.
 func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
@@ -164,3 +254,39 @@ func newRoomStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, RoomTable, RoomColumn),
 	)
 }
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e NotificationLevel) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *NotificationLevel) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = NotificationLevel(str)
+	if err := NotificationLevelValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid NotificationLevel", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Role) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Role) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Role(str)
+	if err := RoleValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
@@ -71,6 +71,16 @@ func UnreadMessagesCount(v int) predicate.RoomMember {
 	return predicate.RoomMember(sql.FieldEQ(FieldUnreadMessagesCount, v))
 }
 
+// MuteUntil applies equality check predicate on the "mute_until" field. It's identical to MuteUntilEQ.
+func MuteUntil(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldEQ(FieldMuteUntil, v))
+}
+
+// BannedAt applies equality check predicate on the "banned_at" field. It's identical to BannedAtEQ.
+func BannedAt(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldEQ(FieldBannedAt, v))
+}
+
 // UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
 func UserID(v pulid.ID) predicate.RoomMember {
 	return predicate.RoomMember(sql.FieldEQ(FieldUserID, v))
@@ -256,6 +266,146 @@ func UnreadMessagesCountLTE(v int) predicate.RoomMember {
 	return predicate.RoomMember(sql.FieldLTE(FieldUnreadMessagesCount, v))
 }
 
+// NotificationLevelEQ applies the EQ predicate on the "notification_level" field.
+func NotificationLevelEQ(v NotificationLevel) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldEQ(FieldNotificationLevel, v))
+}
+
+// NotificationLevelNEQ applies the NEQ predicate on the "notification_level" field.
+func NotificationLevelNEQ(v NotificationLevel) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldNEQ(FieldNotificationLevel, v))
+}
+
+// NotificationLevelIn applies the In predicate on the "notification_level" field.
+func NotificationLevelIn(vs ...NotificationLevel) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldIn(FieldNotificationLevel, vs...))
+}
+
+// NotificationLevelNotIn applies the NotIn predicate on the "notification_level" field.
+func NotificationLevelNotIn(vs ...NotificationLevel) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldNotIn(FieldNotificationLevel, vs...))
+}
+
+// MuteUntilEQ applies the EQ predicate on the "mute_until" field.
+func MuteUntilEQ(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldEQ(FieldMuteUntil, v))
+}
+
+// MuteUntilNEQ applies the NEQ predicate on the "mute_until" field.
+func MuteUntilNEQ(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldNEQ(FieldMuteUntil, v))
+}
+
+// MuteUntilIn applies the In predicate on the "mute_until" field.
+func MuteUntilIn(vs ...time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldIn(FieldMuteUntil, vs...))
+}
+
+// MuteUntilNotIn applies the NotIn predicate on the "mute_until" field.
+func MuteUntilNotIn(vs ...time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldNotIn(FieldMuteUntil, vs...))
+}
+
+// MuteUntilGT applies the GT predicate on the "mute_until" field.
+func MuteUntilGT(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldGT(FieldMuteUntil, v))
+}
+
+// MuteUntilGTE applies the GTE predicate on the "mute_until" field.
+func MuteUntilGTE(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldGTE(FieldMuteUntil, v))
+}
+
+// MuteUntilLT applies the LT predicate on the "mute_until" field.
+func MuteUntilLT(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldLT(FieldMuteUntil, v))
+}
+
+// MuteUntilLTE applies the LTE predicate on the "mute_until" field.
+func MuteUntilLTE(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldLTE(FieldMuteUntil, v))
+}
+
+// MuteUntilIsNil applies the IsNil predicate on the "mute_until" field.
+func MuteUntilIsNil() predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldIsNull(FieldMuteUntil))
+}
+
+// MuteUntilNotNil applies the NotNil predicate on the "mute_until" field.
+func MuteUntilNotNil() predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldNotNull(FieldMuteUntil))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// BannedAtEQ applies the EQ predicate on the "banned_at" field.
+func BannedAtEQ(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldEQ(FieldBannedAt, v))
+}
+
+// BannedAtNEQ applies the NEQ predicate on the "banned_at" field.
+func BannedAtNEQ(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldNEQ(FieldBannedAt, v))
+}
+
+// BannedAtIn applies the In predicate on the "banned_at" field.
+func BannedAtIn(vs ...time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldIn(FieldBannedAt, vs...))
+}
+
+// BannedAtNotIn applies the NotIn predicate on the "banned_at" field.
+func BannedAtNotIn(vs ...time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldNotIn(FieldBannedAt, vs...))
+}
+
+// BannedAtGT applies the GT predicate on the "banned_at" field.
+func BannedAtGT(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldGT(FieldBannedAt, v))
+}
+
+// BannedAtGTE applies the GTE predicate on the "banned_at" field.
+func BannedAtGTE(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldGTE(FieldBannedAt, v))
+}
+
+// BannedAtLT applies the LT predicate on the "banned_at" field.
+func BannedAtLT(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldLT(FieldBannedAt, v))
+}
+
+// BannedAtLTE applies the LTE predicate on the "banned_at" field.
+func BannedAtLTE(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldLTE(FieldBannedAt, v))
+}
+
+// BannedAtIsNil applies the IsNil predicate on the "banned_at" field.
+func BannedAtIsNil() predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldIsNull(FieldBannedAt))
+}
+
+// BannedAtNotNil applies the NotNil predicate on the "banned_at" field.
+func BannedAtNotNil() predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldNotNull(FieldBannedAt))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v pulid.ID) predicate.RoomMember {
 	return predicate.RoomMember(sql.FieldEQ(FieldUserID, v))
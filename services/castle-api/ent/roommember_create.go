@@ -68,6 +68,62 @@ func (rmc *RoomMemberCreate) SetNillableUnreadMessagesCount(i *int) *RoomMemberC
 	return rmc
 }
 
+// SetNotificationLevel sets the "notification_level" field.
+func (rmc *RoomMemberCreate) SetNotificationLevel(rl roommember.NotificationLevel) *RoomMemberCreate {
+	rmc.mutation.SetNotificationLevel(rl)
+	return rmc
+}
+
+// SetNillableNotificationLevel sets the "notification_level" field if the given value is not nil.
+func (rmc *RoomMemberCreate) SetNillableNotificationLevel(rl *roommember.NotificationLevel) *RoomMemberCreate {
+	if rl != nil {
+		rmc.SetNotificationLevel(*rl)
+	}
+	return rmc
+}
+
+// SetMuteUntil sets the "mute_until" field.
+func (rmc *RoomMemberCreate) SetMuteUntil(t time.Time) *RoomMemberCreate {
+	rmc.mutation.SetMuteUntil(t)
+	return rmc
+}
+
+// SetNillableMuteUntil sets the "mute_until" field if the given value is not nil.
+func (rmc *RoomMemberCreate) SetNillableMuteUntil(t *time.Time) *RoomMemberCreate {
+	if t != nil {
+		rmc.SetMuteUntil(*t)
+	}
+	return rmc
+}
+
+// SetRole sets the "role" field.
+func (rmc *RoomMemberCreate) SetRole(r roommember.Role) *RoomMemberCreate {
+	rmc.mutation.SetRole(r)
+	return rmc
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (rmc *RoomMemberCreate) SetNillableRole(r *roommember.Role) *RoomMemberCreate {
+	if r != nil {
+		rmc.SetRole(*r)
+	}
+	return rmc
+}
+
+// SetBannedAt sets the "banned_at" field.
+func (rmc *RoomMemberCreate) SetBannedAt(t time.Time) *RoomMemberCreate {
+	rmc.mutation.SetBannedAt(t)
+	return rmc
+}
+
+// SetNillableBannedAt sets the "banned_at" field if the given value is not nil.
+func (rmc *RoomMemberCreate) SetNillableBannedAt(t *time.Time) *RoomMemberCreate {
+	if t != nil {
+		rmc.SetBannedAt(*t)
+	}
+	return rmc
+}
+
 // SetUserID sets the "user_id" field.
 func (rmc *RoomMemberCreate) SetUserID(pu pulid.ID) *RoomMemberCreate {
 	rmc.mutation.SetUserID(pu)
@@ -173,6 +229,14 @@ func (rmc *RoomMemberCreate) defaults() error {
 		v := roommember.DefaultUnreadMessagesCount
 		rmc.mutation.SetUnreadMessagesCount(v)
 	}
+	if _, ok := rmc.mutation.NotificationLevel(); !ok {
+		v := roommember.DefaultNotificationLevel
+		rmc.mutation.SetNotificationLevel(v)
+	}
+	if _, ok := rmc.mutation.Role(); !ok {
+		v := roommember.DefaultRole
+		rmc.mutation.SetRole(v)
+	}
 	if _, ok := rmc.mutation.JoinedAt(); !ok {
 		if roommember.DefaultJoinedAt == nil {
 			return fmt.Errorf("ent: uninitialized roommember.DefaultJoinedAt (forgotten import ent/runtime?)")
@@ -202,6 +266,22 @@ func (rmc *RoomMemberCreate) check() error {
 	if _, ok := rmc.mutation.UnreadMessagesCount(); !ok {
 		return &ValidationError{Name: "unread_messages_count", err: errors.New(`ent: missing required field "RoomMember.unread_messages_count"`)}
 	}
+	if _, ok := rmc.mutation.NotificationLevel(); !ok {
+		return &ValidationError{Name: "notification_level", err: errors.New(`ent: missing required field "RoomMember.notification_level"`)}
+	}
+	if v, ok := rmc.mutation.NotificationLevel(); ok {
+		if err := roommember.NotificationLevelValidator(v); err != nil {
+			return &ValidationError{Name: "notification_level", err: fmt.Errorf(`ent: validator failed for field "RoomMember.notification_level": %w`, err)}
+		}
+	}
+	if _, ok := rmc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "RoomMember.role"`)}
+	}
+	if v, ok := rmc.mutation.Role(); ok {
+		if err := roommember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "RoomMember.role": %w`, err)}
+		}
+	}
 	if _, ok := rmc.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RoomMember.user_id"`)}
 	}
@@ -268,6 +348,22 @@ func (rmc *RoomMemberCreate) createSpec() (*RoomMember, *sqlgraph.CreateSpec) {
 		_spec.SetField(roommember.FieldUnreadMessagesCount, field.TypeInt, value)
 		_node.UnreadMessagesCount = value
 	}
+	if value, ok := rmc.mutation.NotificationLevel(); ok {
+		_spec.SetField(roommember.FieldNotificationLevel, field.TypeEnum, value)
+		_node.NotificationLevel = value
+	}
+	if value, ok := rmc.mutation.MuteUntil(); ok {
+		_spec.SetField(roommember.FieldMuteUntil, field.TypeTime, value)
+		_node.MuteUntil = &value
+	}
+	if value, ok := rmc.mutation.Role(); ok {
+		_spec.SetField(roommember.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := rmc.mutation.BannedAt(); ok {
+		_spec.SetField(roommember.FieldBannedAt, field.TypeTime, value)
+		_node.BannedAt = &value
+	}
 	if value, ok := rmc.mutation.JoinedAt(); ok {
 		_spec.SetField(roommember.FieldJoinedAt, field.TypeTime, value)
 		_node.JoinedAt = value
@@ -416,6 +512,66 @@ func (u *RoomMemberUpsert) AddUnreadMessagesCount(v int) *RoomMemberUpsert {
 	return u
 }
 
+// SetNotificationLevel sets the "notification_level" field.
+func (u *RoomMemberUpsert) SetNotificationLevel(v roommember.NotificationLevel) *RoomMemberUpsert {
+	u.Set(roommember.FieldNotificationLevel, v)
+	return u
+}
+
+// UpdateNotificationLevel sets the "notification_level" field to the value that was provided on create.
+func (u *RoomMemberUpsert) UpdateNotificationLevel() *RoomMemberUpsert {
+	u.SetExcluded(roommember.FieldNotificationLevel)
+	return u
+}
+
+// SetMuteUntil sets the "mute_until" field.
+func (u *RoomMemberUpsert) SetMuteUntil(v time.Time) *RoomMemberUpsert {
+	u.Set(roommember.FieldMuteUntil, v)
+	return u
+}
+
+// UpdateMuteUntil sets the "mute_until" field to the value that was provided on create.
+func (u *RoomMemberUpsert) UpdateMuteUntil() *RoomMemberUpsert {
+	u.SetExcluded(roommember.FieldMuteUntil)
+	return u
+}
+
+// ClearMuteUntil clears the value of the "mute_until" field.
+func (u *RoomMemberUpsert) ClearMuteUntil() *RoomMemberUpsert {
+	u.SetNull(roommember.FieldMuteUntil)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *RoomMemberUpsert) SetRole(v roommember.Role) *RoomMemberUpsert {
+	u.Set(roommember.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *RoomMemberUpsert) UpdateRole() *RoomMemberUpsert {
+	u.SetExcluded(roommember.FieldRole)
+	return u
+}
+
+// SetBannedAt sets the "banned_at" field.
+func (u *RoomMemberUpsert) SetBannedAt(v time.Time) *RoomMemberUpsert {
+	u.Set(roommember.FieldBannedAt, v)
+	return u
+}
+
+// UpdateBannedAt sets the "banned_at" field to the value that was provided on create.
+func (u *RoomMemberUpsert) UpdateBannedAt() *RoomMemberUpsert {
+	u.SetExcluded(roommember.FieldBannedAt)
+	return u
+}
+
+// ClearBannedAt clears the value of the "banned_at" field.
+func (u *RoomMemberUpsert) ClearBannedAt() *RoomMemberUpsert {
+	u.SetNull(roommember.FieldBannedAt)
+	return u
+}
+
 // SetUserID sets the "user_id" field.
 func (u *RoomMemberUpsert) SetUserID(v pulid.ID) *RoomMemberUpsert {
 	u.Set(roommember.FieldUserID, v)
@@ -566,6 +722,76 @@ func (u *RoomMemberUpsertOne) UpdateUnreadMessagesCount() *RoomMemberUpsertOne {
 	})
 }
 
+// SetNotificationLevel sets the "notification_level" field.
+func (u *RoomMemberUpsertOne) SetNotificationLevel(v roommember.NotificationLevel) *RoomMemberUpsertOne {
+	return u.Update(func(s *RoomMemberUpsert) {
+		s.SetNotificationLevel(v)
+	})
+}
+
+// UpdateNotificationLevel sets the "notification_level" field to the value that was provided on create.
+func (u *RoomMemberUpsertOne) UpdateNotificationLevel() *RoomMemberUpsertOne {
+	return u.Update(func(s *RoomMemberUpsert) {
+		s.UpdateNotificationLevel()
+	})
+}
+
+// SetMuteUntil sets the "mute_until" field.
+func (u *RoomMemberUpsertOne) SetMuteUntil(v time.Time) *RoomMemberUpsertOne {
+	return u.Update(func(s *RoomMemberUpsert) {
+		s.SetMuteUntil(v)
+	})
+}
+
+// UpdateMuteUntil sets the "mute_until" field to the value that was provided on create.
+func (u *RoomMemberUpsertOne) UpdateMuteUntil() *RoomMemberUpsertOne {
+	return u.Update(func(s *RoomMemberUpsert) {
+		s.UpdateMuteUntil()
+	})
+}
+
+// ClearMuteUntil clears the value of the "mute_until" field.
+func (u *RoomMemberUpsertOne) ClearMuteUntil() *RoomMemberUpsertOne {
+	return u.Update(func(s *RoomMemberUpsert) {
+		s.ClearMuteUntil()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *RoomMemberUpsertOne) SetRole(v roommember.Role) *RoomMemberUpsertOne {
+	return u.Update(func(s *RoomMemberUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *RoomMemberUpsertOne) UpdateRole() *RoomMemberUpsertOne {
+	return u.Update(func(s *RoomMemberUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetBannedAt sets the "banned_at" field.
+func (u *RoomMemberUpsertOne) SetBannedAt(v time.Time) *RoomMemberUpsertOne {
+	return u.Update(func(s *RoomMemberUpsert) {
+		s.SetBannedAt(v)
+	})
+}
+
+// UpdateBannedAt sets the "banned_at" field to the value that was provided on create.
+func (u *RoomMemberUpsertOne) UpdateBannedAt() *RoomMemberUpsertOne {
+	return u.Update(func(s *RoomMemberUpsert) {
+		s.UpdateBannedAt()
+	})
+}
+
+// ClearBannedAt clears the value of the "banned_at" field.
+func (u *RoomMemberUpsertOne) ClearBannedAt() *RoomMemberUpsertOne {
+	return u.Update(func(s *RoomMemberUpsert) {
+		s.ClearBannedAt()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *RoomMemberUpsertOne) SetUserID(v pulid.ID) *RoomMemberUpsertOne {
 	return u.Update(func(s *RoomMemberUpsert) {
@@ -889,6 +1115,76 @@ func (u *RoomMemberUpsertBulk) UpdateUnreadMessagesCount() *RoomMemberUpsertBulk
 	})
 }
 
+// SetNotificationLevel sets the "notification_level" field.
+func (u *RoomMemberUpsertBulk) SetNotificationLevel(v roommember.NotificationLevel) *RoomMemberUpsertBulk {
+	return u.Update(func(s *RoomMemberUpsert) {
+		s.SetNotificationLevel(v)
+	})
+}
+
+// UpdateNotificationLevel sets the "notification_level" field to the value that was provided on create.
+func (u *RoomMemberUpsertBulk) UpdateNotificationLevel() *RoomMemberUpsertBulk {
+	return u.Update(func(s *RoomMemberUpsert) {
+		s.UpdateNotificationLevel()
+	})
+}
+
+// SetMuteUntil sets the "mute_until" field.
+func (u *RoomMemberUpsertBulk) SetMuteUntil(v time.Time) *RoomMemberUpsertBulk {
+	return u.Update(func(s *RoomMemberUpsert) {
+		s.SetMuteUntil(v)
+	})
+}
+
+// UpdateMuteUntil sets the "mute_until" field to the value that was provided on create.
+func (u *RoomMemberUpsertBulk) UpdateMuteUntil() *RoomMemberUpsertBulk {
+	return u.Update(func(s *RoomMemberUpsert) {
+		s.UpdateMuteUntil()
+	})
+}
+
+// ClearMuteUntil clears the value of the "mute_until" field.
+func (u *RoomMemberUpsertBulk) ClearMuteUntil() *RoomMemberUpsertBulk {
+	return u.Update(func(s *RoomMemberUpsert) {
+		s.ClearMuteUntil()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *RoomMemberUpsertBulk) SetRole(v roommember.Role) *RoomMemberUpsertBulk {
+	return u.Update(func(s *RoomMemberUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *RoomMemberUpsertBulk) UpdateRole() *RoomMemberUpsertBulk {
+	return u.Update(func(s *RoomMemberUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetBannedAt sets the "banned_at" field.
+func (u *RoomMemberUpsertBulk) SetBannedAt(v time.Time) *RoomMemberUpsertBulk {
+	return u.Update(func(s *RoomMemberUpsert) {
+		s.SetBannedAt(v)
+	})
+}
+
+// UpdateBannedAt sets the "banned_at" field to the value that was provided on create.
+func (u *RoomMemberUpsertBulk) UpdateBannedAt() *RoomMemberUpsertBulk {
+	return u.Update(func(s *RoomMemberUpsert) {
+		s.UpdateBannedAt()
+	})
+}
+
+// ClearBannedAt clears the value of the "banned_at" field.
+func (u *RoomMemberUpsertBulk) ClearBannedAt() *RoomMemberUpsertBulk {
+	return u.Update(func(s *RoomMemberUpsert) {
+		s.ClearBannedAt()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *RoomMemberUpsertBulk) SetUserID(v pulid.ID) *RoomMemberUpsertBulk {
 	return u.Update(func(s *RoomMemberUpsert) {
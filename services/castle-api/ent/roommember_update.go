@@ -92,6 +92,74 @@ func (rmu *RoomMemberUpdate) AddUnreadMessagesCount(i int) *RoomMemberUpdate {
 	return rmu
 }
 
+// SetNotificationLevel sets the "notification_level" field.
+func (rmu *RoomMemberUpdate) SetNotificationLevel(rl roommember.NotificationLevel) *RoomMemberUpdate {
+	rmu.mutation.SetNotificationLevel(rl)
+	return rmu
+}
+
+// SetNillableNotificationLevel sets the "notification_level" field if the given value is not nil.
+func (rmu *RoomMemberUpdate) SetNillableNotificationLevel(rl *roommember.NotificationLevel) *RoomMemberUpdate {
+	if rl != nil {
+		rmu.SetNotificationLevel(*rl)
+	}
+	return rmu
+}
+
+// SetMuteUntil sets the "mute_until" field.
+func (rmu *RoomMemberUpdate) SetMuteUntil(t time.Time) *RoomMemberUpdate {
+	rmu.mutation.SetMuteUntil(t)
+	return rmu
+}
+
+// SetNillableMuteUntil sets the "mute_until" field if the given value is not nil.
+func (rmu *RoomMemberUpdate) SetNillableMuteUntil(t *time.Time) *RoomMemberUpdate {
+	if t != nil {
+		rmu.SetMuteUntil(*t)
+	}
+	return rmu
+}
+
+// ClearMuteUntil clears the value of the "mute_until" field.
+func (rmu *RoomMemberUpdate) ClearMuteUntil() *RoomMemberUpdate {
+	rmu.mutation.ClearMuteUntil()
+	return rmu
+}
+
+// SetRole sets the "role" field.
+func (rmu *RoomMemberUpdate) SetRole(r roommember.Role) *RoomMemberUpdate {
+	rmu.mutation.SetRole(r)
+	return rmu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (rmu *RoomMemberUpdate) SetNillableRole(r *roommember.Role) *RoomMemberUpdate {
+	if r != nil {
+		rmu.SetRole(*r)
+	}
+	return rmu
+}
+
+// SetBannedAt sets the "banned_at" field.
+func (rmu *RoomMemberUpdate) SetBannedAt(t time.Time) *RoomMemberUpdate {
+	rmu.mutation.SetBannedAt(t)
+	return rmu
+}
+
+// SetNillableBannedAt sets the "banned_at" field if the given value is not nil.
+func (rmu *RoomMemberUpdate) SetNillableBannedAt(t *time.Time) *RoomMemberUpdate {
+	if t != nil {
+		rmu.SetBannedAt(*t)
+	}
+	return rmu
+}
+
+// ClearBannedAt clears the value of the "banned_at" field.
+func (rmu *RoomMemberUpdate) ClearBannedAt() *RoomMemberUpdate {
+	rmu.mutation.ClearBannedAt()
+	return rmu
+}
+
 // SetUserID sets the "user_id" field.
 func (rmu *RoomMemberUpdate) SetUserID(pu pulid.ID) *RoomMemberUpdate {
 	rmu.mutation.SetUserID(pu)
@@ -197,6 +265,16 @@ func (rmu *RoomMemberUpdate) defaults() error {
 
 // check runs all checks and user-defined validators on the builder.
 func (rmu *RoomMemberUpdate) check() error {
+	if v, ok := rmu.mutation.NotificationLevel(); ok {
+		if err := roommember.NotificationLevelValidator(v); err != nil {
+			return &ValidationError{Name: "notification_level", err: fmt.Errorf(`ent: validator failed for field "RoomMember.notification_level": %w`, err)}
+		}
+	}
+	if v, ok := rmu.mutation.Role(); ok {
+		if err := roommember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "RoomMember.role": %w`, err)}
+		}
+	}
 	if rmu.mutation.UserCleared() && len(rmu.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "RoomMember.user"`)
 	}
@@ -236,6 +314,24 @@ func (rmu *RoomMemberUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := rmu.mutation.AddedUnreadMessagesCount(); ok {
 		_spec.AddField(roommember.FieldUnreadMessagesCount, field.TypeInt, value)
 	}
+	if value, ok := rmu.mutation.NotificationLevel(); ok {
+		_spec.SetField(roommember.FieldNotificationLevel, field.TypeEnum, value)
+	}
+	if value, ok := rmu.mutation.MuteUntil(); ok {
+		_spec.SetField(roommember.FieldMuteUntil, field.TypeTime, value)
+	}
+	if rmu.mutation.MuteUntilCleared() {
+		_spec.ClearField(roommember.FieldMuteUntil, field.TypeTime)
+	}
+	if value, ok := rmu.mutation.Role(); ok {
+		_spec.SetField(roommember.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := rmu.mutation.BannedAt(); ok {
+		_spec.SetField(roommember.FieldBannedAt, field.TypeTime, value)
+	}
+	if rmu.mutation.BannedAtCleared() {
+		_spec.ClearField(roommember.FieldBannedAt, field.TypeTime)
+	}
 	if value, ok := rmu.mutation.UpdatedAt(); ok {
 		_spec.SetField(roommember.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -378,6 +474,74 @@ func (rmuo *RoomMemberUpdateOne) AddUnreadMessagesCount(i int) *RoomMemberUpdate
 	return rmuo
 }
 
+// SetNotificationLevel sets the "notification_level" field.
+func (rmuo *RoomMemberUpdateOne) SetNotificationLevel(rl roommember.NotificationLevel) *RoomMemberUpdateOne {
+	rmuo.mutation.SetNotificationLevel(rl)
+	return rmuo
+}
+
+// SetNillableNotificationLevel sets the "notification_level" field if the given value is not nil.
+func (rmuo *RoomMemberUpdateOne) SetNillableNotificationLevel(rl *roommember.NotificationLevel) *RoomMemberUpdateOne {
+	if rl != nil {
+		rmuo.SetNotificationLevel(*rl)
+	}
+	return rmuo
+}
+
+// SetMuteUntil sets the "mute_until" field.
+func (rmuo *RoomMemberUpdateOne) SetMuteUntil(t time.Time) *RoomMemberUpdateOne {
+	rmuo.mutation.SetMuteUntil(t)
+	return rmuo
+}
+
+// SetNillableMuteUntil sets the "mute_until" field if the given value is not nil.
+func (rmuo *RoomMemberUpdateOne) SetNillableMuteUntil(t *time.Time) *RoomMemberUpdateOne {
+	if t != nil {
+		rmuo.SetMuteUntil(*t)
+	}
+	return rmuo
+}
+
+// ClearMuteUntil clears the value of the "mute_until" field.
+func (rmuo *RoomMemberUpdateOne) ClearMuteUntil() *RoomMemberUpdateOne {
+	rmuo.mutation.ClearMuteUntil()
+	return rmuo
+}
+
+// SetRole sets the "role" field.
+func (rmuo *RoomMemberUpdateOne) SetRole(r roommember.Role) *RoomMemberUpdateOne {
+	rmuo.mutation.SetRole(r)
+	return rmuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (rmuo *RoomMemberUpdateOne) SetNillableRole(r *roommember.Role) *RoomMemberUpdateOne {
+	if r != nil {
+		rmuo.SetRole(*r)
+	}
+	return rmuo
+}
+
+// SetBannedAt sets the "banned_at" field.
+func (rmuo *RoomMemberUpdateOne) SetBannedAt(t time.Time) *RoomMemberUpdateOne {
+	rmuo.mutation.SetBannedAt(t)
+	return rmuo
+}
+
+// SetNillableBannedAt sets the "banned_at" field if the given value is not nil.
+func (rmuo *RoomMemberUpdateOne) SetNillableBannedAt(t *time.Time) *RoomMemberUpdateOne {
+	if t != nil {
+		rmuo.SetBannedAt(*t)
+	}
+	return rmuo
+}
+
+// ClearBannedAt clears the value of the "banned_at" field.
+func (rmuo *RoomMemberUpdateOne) ClearBannedAt() *RoomMemberUpdateOne {
+	rmuo.mutation.ClearBannedAt()
+	return rmuo
+}
+
 // SetUserID sets the "user_id" field.
 func (rmuo *RoomMemberUpdateOne) SetUserID(pu pulid.ID) *RoomMemberUpdateOne {
 	rmuo.mutation.SetUserID(pu)
@@ -496,6 +660,16 @@ func (rmuo *RoomMemberUpdateOne) defaults() error {
 
 // check runs all checks and user-defined validators on the builder.
 func (rmuo *RoomMemberUpdateOne) check() error {
+	if v, ok := rmuo.mutation.NotificationLevel(); ok {
+		if err := roommember.NotificationLevelValidator(v); err != nil {
+			return &ValidationError{Name: "notification_level", err: fmt.Errorf(`ent: validator failed for field "RoomMember.notification_level": %w`, err)}
+		}
+	}
+	if v, ok := rmuo.mutation.Role(); ok {
+		if err := roommember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "RoomMember.role": %w`, err)}
+		}
+	}
 	if rmuo.mutation.UserCleared() && len(rmuo.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "RoomMember.user"`)
 	}
@@ -552,6 +726,24 @@ func (rmuo *RoomMemberUpdateOne) sqlSave(ctx context.Context) (_node *RoomMember
 	if value, ok := rmuo.mutation.AddedUnreadMessagesCount(); ok {
 		_spec.AddField(roommember.FieldUnreadMessagesCount, field.TypeInt, value)
 	}
+	if value, ok := rmuo.mutation.NotificationLevel(); ok {
+		_spec.SetField(roommember.FieldNotificationLevel, field.TypeEnum, value)
+	}
+	if value, ok := rmuo.mutation.MuteUntil(); ok {
+		_spec.SetField(roommember.FieldMuteUntil, field.TypeTime, value)
+	}
+	if rmuo.mutation.MuteUntilCleared() {
+		_spec.ClearField(roommember.FieldMuteUntil, field.TypeTime)
+	}
+	if value, ok := rmuo.mutation.Role(); ok {
+		_spec.SetField(roommember.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := rmuo.mutation.BannedAt(); ok {
+		_spec.SetField(roommember.FieldBannedAt, field.TypeTime, value)
+	}
+	if rmuo.mutation.BannedAtCleared() {
+		_spec.ClearField(roommember.FieldBannedAt, field.TypeTime)
+	}
 	if value, ok := rmuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(roommember.FieldUpdatedAt, field.TypeTime, value)
 	}
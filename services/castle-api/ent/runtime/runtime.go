@@ -3,6 +3,11 @@
 package runtime
 
 import (
+	"journeyhub/ent/avatar"
+	"journeyhub/ent/call"
+	"journeyhub/ent/callparticipant"
+	"journeyhub/ent/contactpin"
+	"journeyhub/ent/contactrequest"
 	"journeyhub/ent/device"
 	"journeyhub/ent/file"
 	"journeyhub/ent/message"
@@ -10,11 +15,15 @@ import (
 	"journeyhub/ent/messagelink"
 	"journeyhub/ent/messagevoice"
 	"journeyhub/ent/notification"
+	"journeyhub/ent/outboxmessage"
+	"journeyhub/ent/report"
 	"journeyhub/ent/room"
+	"journeyhub/ent/roominvite"
 	"journeyhub/ent/roommember"
 	"journeyhub/ent/schema"
 	"journeyhub/ent/schema/pulid"
 	"journeyhub/ent/user"
+	"journeyhub/ent/userblock"
 	"journeyhub/ent/usercontact"
 	"time"
 )
@@ -23,17 +32,100 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	avatarMixin := schema.Avatar{}.Mixin()
+	avatarMixinFields0 := avatarMixin[0].Fields()
+	_ = avatarMixinFields0
+	avatarFields := schema.Avatar{}.Fields()
+	_ = avatarFields
+	// avatarDescCreatedAt is the schema descriptor for created_at field.
+	avatarDescCreatedAt := avatarFields[3].Descriptor()
+	// avatar.DefaultCreatedAt holds the default value on creation for the created_at field.
+	avatar.DefaultCreatedAt = avatarDescCreatedAt.Default.(func() time.Time)
+	// avatarDescID is the schema descriptor for id field.
+	avatarDescID := avatarMixinFields0[0].Descriptor()
+	// avatar.DefaultID holds the default value on creation for the id field.
+	avatar.DefaultID = avatarDescID.Default.(func() pulid.ID)
+	callMixin := schema.Call{}.Mixin()
+	callMixinFields0 := callMixin[0].Fields()
+	_ = callMixinFields0
+	callFields := schema.Call{}.Fields()
+	_ = callFields
+	// callDescStartedAt is the schema descriptor for started_at field.
+	callDescStartedAt := callFields[4].Descriptor()
+	// call.DefaultStartedAt holds the default value on creation for the started_at field.
+	call.DefaultStartedAt = callDescStartedAt.Default.(func() time.Time)
+	// callDescDuration is the schema descriptor for duration field.
+	callDescDuration := callFields[7].Descriptor()
+	// call.DefaultDuration holds the default value on creation for the duration field.
+	call.DefaultDuration = callDescDuration.Default.(int)
+	// callDescID is the schema descriptor for id field.
+	callDescID := callMixinFields0[0].Descriptor()
+	// call.DefaultID holds the default value on creation for the id field.
+	call.DefaultID = callDescID.Default.(func() pulid.ID)
+	callparticipantMixin := schema.CallParticipant{}.Mixin()
+	callparticipantMixinFields0 := callparticipantMixin[0].Fields()
+	_ = callparticipantMixinFields0
+	callparticipantFields := schema.CallParticipant{}.Fields()
+	_ = callparticipantFields
+	// callparticipantDescInvitedAt is the schema descriptor for invited_at field.
+	callparticipantDescInvitedAt := callparticipantFields[3].Descriptor()
+	// callparticipant.DefaultInvitedAt holds the default value on creation for the invited_at field.
+	callparticipant.DefaultInvitedAt = callparticipantDescInvitedAt.Default.(func() time.Time)
+	// callparticipantDescID is the schema descriptor for id field.
+	callparticipantDescID := callparticipantMixinFields0[0].Descriptor()
+	// callparticipant.DefaultID holds the default value on creation for the id field.
+	callparticipant.DefaultID = callparticipantDescID.Default.(func() pulid.ID)
+	contactpinMixin := schema.ContactPin{}.Mixin()
+	contactpinMixinFields0 := contactpinMixin[0].Fields()
+	_ = contactpinMixinFields0
+	contactpinFields := schema.ContactPin{}.Fields()
+	_ = contactpinFields
+	// contactpinDescMaxUses is the schema descriptor for max_uses field.
+	contactpinDescMaxUses := contactpinFields[3].Descriptor()
+	// contactpin.MaxUsesValidator is a validator for the "max_uses" field. It is called by the builders before save.
+	contactpin.MaxUsesValidator = contactpinDescMaxUses.Validators[0].(func(int) error)
+	// contactpinDescUses is the schema descriptor for uses field.
+	contactpinDescUses := contactpinFields[4].Descriptor()
+	// contactpin.DefaultUses holds the default value on creation for the uses field.
+	contactpin.DefaultUses = contactpinDescUses.Default.(int)
+	// contactpinDescCreatedAt is the schema descriptor for created_at field.
+	contactpinDescCreatedAt := contactpinFields[6].Descriptor()
+	// contactpin.DefaultCreatedAt holds the default value on creation for the created_at field.
+	contactpin.DefaultCreatedAt = contactpinDescCreatedAt.Default.(func() time.Time)
+	// contactpinDescID is the schema descriptor for id field.
+	contactpinDescID := contactpinMixinFields0[0].Descriptor()
+	// contactpin.DefaultID holds the default value on creation for the id field.
+	contactpin.DefaultID = contactpinDescID.Default.(func() pulid.ID)
+	contactrequestMixin := schema.ContactRequest{}.Mixin()
+	contactrequestMixinFields0 := contactrequestMixin[0].Fields()
+	_ = contactrequestMixinFields0
+	contactrequestFields := schema.ContactRequest{}.Fields()
+	_ = contactrequestFields
+	// contactrequestDescCreatedAt is the schema descriptor for created_at field.
+	contactrequestDescCreatedAt := contactrequestFields[3].Descriptor()
+	// contactrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	contactrequest.DefaultCreatedAt = contactrequestDescCreatedAt.Default.(func() time.Time)
+	// contactrequestDescUpdatedAt is the schema descriptor for updated_at field.
+	contactrequestDescUpdatedAt := contactrequestFields[4].Descriptor()
+	// contactrequest.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	contactrequest.DefaultUpdatedAt = contactrequestDescUpdatedAt.Default.(func() time.Time)
+	// contactrequest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	contactrequest.UpdateDefaultUpdatedAt = contactrequestDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// contactrequestDescID is the schema descriptor for id field.
+	contactrequestDescID := contactrequestMixinFields0[0].Descriptor()
+	// contactrequest.DefaultID holds the default value on creation for the id field.
+	contactrequest.DefaultID = contactrequestDescID.Default.(func() pulid.ID)
 	deviceMixin := schema.Device{}.Mixin()
 	deviceMixinFields0 := deviceMixin[0].Fields()
 	_ = deviceMixinFields0
 	deviceFields := schema.Device{}.Fields()
 	_ = deviceFields
 	// deviceDescCreatedAt is the schema descriptor for created_at field.
-	deviceDescCreatedAt := deviceFields[2].Descriptor()
+	deviceDescCreatedAt := deviceFields[7].Descriptor()
 	// device.DefaultCreatedAt holds the default value on creation for the created_at field.
 	device.DefaultCreatedAt = deviceDescCreatedAt.Default.(func() time.Time)
 	// deviceDescUpdatedAt is the schema descriptor for updated_at field.
-	deviceDescUpdatedAt := deviceFields[3].Descriptor()
+	deviceDescUpdatedAt := deviceFields[8].Descriptor()
 	// device.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	device.DefaultUpdatedAt = deviceDescUpdatedAt.Default.(func() time.Time)
 	// device.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -43,16 +135,19 @@ func init() {
 	// device.DefaultID holds the default value on creation for the id field.
 	device.DefaultID = deviceDescID.Default.(func() pulid.ID)
 	fileMixin := schema.File{}.Mixin()
+	fileHooks := schema.File{}.Hooks()
+	file.Hooks[0] = fileHooks[0]
+	file.Hooks[1] = fileHooks[1]
 	fileMixinFields0 := fileMixin[0].Fields()
 	_ = fileMixinFields0
 	fileFields := schema.File{}.Fields()
 	_ = fileFields
 	// fileDescCreatedAt is the schema descriptor for created_at field.
-	fileDescCreatedAt := fileFields[6].Descriptor()
+	fileDescCreatedAt := fileFields[10].Descriptor()
 	// file.DefaultCreatedAt holds the default value on creation for the created_at field.
 	file.DefaultCreatedAt = fileDescCreatedAt.Default.(func() time.Time)
 	// fileDescUpdatedAt is the schema descriptor for updated_at field.
-	fileDescUpdatedAt := fileFields[7].Descriptor()
+	fileDescUpdatedAt := fileFields[11].Descriptor()
 	// file.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	file.DefaultUpdatedAt = fileDescUpdatedAt.Default.(func() time.Time)
 	// file.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -67,11 +162,11 @@ func init() {
 	messageFields := schema.Message{}.Fields()
 	_ = messageFields
 	// messageDescCreatedAt is the schema descriptor for created_at field.
-	messageDescCreatedAt := messageFields[1].Descriptor()
+	messageDescCreatedAt := messageFields[4].Descriptor()
 	// message.DefaultCreatedAt holds the default value on creation for the created_at field.
 	message.DefaultCreatedAt = messageDescCreatedAt.Default.(func() time.Time)
 	// messageDescUpdatedAt is the schema descriptor for updated_at field.
-	messageDescUpdatedAt := messageFields[2].Descriptor()
+	messageDescUpdatedAt := messageFields[5].Descriptor()
 	// message.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	message.DefaultUpdatedAt = messageDescUpdatedAt.Default.(func() time.Time)
 	// message.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -131,11 +226,11 @@ func init() {
 	notificationFields := schema.Notification{}.Fields()
 	_ = notificationFields
 	// notificationDescCreatedAt is the schema descriptor for created_at field.
-	notificationDescCreatedAt := notificationFields[3].Descriptor()
+	notificationDescCreatedAt := notificationFields[5].Descriptor()
 	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
 	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
 	// notificationDescUpdatedAt is the schema descriptor for updated_at field.
-	notificationDescUpdatedAt := notificationFields[4].Descriptor()
+	notificationDescUpdatedAt := notificationFields[6].Descriptor()
 	// notification.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	notification.DefaultUpdatedAt = notificationDescUpdatedAt.Default.(func() time.Time)
 	// notification.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -144,6 +239,38 @@ func init() {
 	notificationDescID := notificationMixinFields0[0].Descriptor()
 	// notification.DefaultID holds the default value on creation for the id field.
 	notification.DefaultID = notificationDescID.Default.(func() pulid.ID)
+	outboxmessageMixin := schema.OutboxMessage{}.Mixin()
+	outboxmessageMixinFields0 := outboxmessageMixin[0].Fields()
+	_ = outboxmessageMixinFields0
+	outboxmessageFields := schema.OutboxMessage{}.Fields()
+	_ = outboxmessageFields
+	// outboxmessageDescCreatedAt is the schema descriptor for created_at field.
+	outboxmessageDescCreatedAt := outboxmessageFields[2].Descriptor()
+	// outboxmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxmessage.DefaultCreatedAt = outboxmessageDescCreatedAt.Default.(func() time.Time)
+	// outboxmessageDescID is the schema descriptor for id field.
+	outboxmessageDescID := outboxmessageMixinFields0[0].Descriptor()
+	// outboxmessage.DefaultID holds the default value on creation for the id field.
+	outboxmessage.DefaultID = outboxmessageDescID.Default.(func() pulid.ID)
+	reportMixin := schema.Report{}.Mixin()
+	reportMixinFields0 := reportMixin[0].Fields()
+	_ = reportMixinFields0
+	reportFields := schema.Report{}.Fields()
+	_ = reportFields
+	// reportDescCreatedAt is the schema descriptor for created_at field.
+	reportDescCreatedAt := reportFields[13].Descriptor()
+	// report.DefaultCreatedAt holds the default value on creation for the created_at field.
+	report.DefaultCreatedAt = reportDescCreatedAt.Default.(func() time.Time)
+	// reportDescUpdatedAt is the schema descriptor for updated_at field.
+	reportDescUpdatedAt := reportFields[14].Descriptor()
+	// report.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	report.DefaultUpdatedAt = reportDescUpdatedAt.Default.(func() time.Time)
+	// report.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	report.UpdateDefaultUpdatedAt = reportDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// reportDescID is the schema descriptor for id field.
+	reportDescID := reportMixinFields0[0].Descriptor()
+	// report.DefaultID holds the default value on creation for the id field.
+	report.DefaultID = reportDescID.Default.(func() pulid.ID)
 	roomMixin := schema.Room{}.Mixin()
 	roomMixinHooks1 := roomMixin[1].Hooks()
 	room.Hooks[0] = roomMixinHooks1[0]
@@ -159,12 +286,16 @@ func init() {
 	room.DefaultVersion = roomDescVersion.Default.(uint64)
 	// room.VersionValidator is a validator for the "version" field. It is called by the builders before save.
 	room.VersionValidator = roomDescVersion.Validators[0].(func(uint64) error)
+	// roomDescStorageUsed is the schema descriptor for storage_used field.
+	roomDescStorageUsed := roomFields[3].Descriptor()
+	// room.DefaultStorageUsed holds the default value on creation for the storage_used field.
+	room.DefaultStorageUsed = roomDescStorageUsed.Default.(int64)
 	// roomDescCreatedAt is the schema descriptor for created_at field.
-	roomDescCreatedAt := roomFields[4].Descriptor()
+	roomDescCreatedAt := roomFields[6].Descriptor()
 	// room.DefaultCreatedAt holds the default value on creation for the created_at field.
 	room.DefaultCreatedAt = roomDescCreatedAt.Default.(func() time.Time)
 	// roomDescUpdatedAt is the schema descriptor for updated_at field.
-	roomDescUpdatedAt := roomFields[5].Descriptor()
+	roomDescUpdatedAt := roomFields[7].Descriptor()
 	// room.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	room.DefaultUpdatedAt = roomDescUpdatedAt.Default.(func() time.Time)
 	// room.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -173,6 +304,27 @@ func init() {
 	roomDescID := roomMixinFields0[0].Descriptor()
 	// room.DefaultID holds the default value on creation for the id field.
 	room.DefaultID = roomDescID.Default.(func() pulid.ID)
+	roominviteMixin := schema.RoomInvite{}.Mixin()
+	roominviteMixinFields0 := roominviteMixin[0].Fields()
+	_ = roominviteMixinFields0
+	roominviteFields := schema.RoomInvite{}.Fields()
+	_ = roominviteFields
+	// roominviteDescMaxUses is the schema descriptor for max_uses field.
+	roominviteDescMaxUses := roominviteFields[4].Descriptor()
+	// roominvite.MaxUsesValidator is a validator for the "max_uses" field. It is called by the builders before save.
+	roominvite.MaxUsesValidator = roominviteDescMaxUses.Validators[0].(func(int) error)
+	// roominviteDescUses is the schema descriptor for uses field.
+	roominviteDescUses := roominviteFields[5].Descriptor()
+	// roominvite.DefaultUses holds the default value on creation for the uses field.
+	roominvite.DefaultUses = roominviteDescUses.Default.(int)
+	// roominviteDescCreatedAt is the schema descriptor for created_at field.
+	roominviteDescCreatedAt := roominviteFields[7].Descriptor()
+	// roominvite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	roominvite.DefaultCreatedAt = roominviteDescCreatedAt.Default.(func() time.Time)
+	// roominviteDescID is the schema descriptor for id field.
+	roominviteDescID := roominviteMixinFields0[0].Descriptor()
+	// roominvite.DefaultID holds the default value on creation for the id field.
+	roominvite.DefaultID = roominviteDescID.Default.(func() pulid.ID)
 	roommemberMixin := schema.RoomMember{}.Mixin()
 	roommemberMixinHooks1 := roommemberMixin[1].Hooks()
 	roommember.Hooks[0] = roommemberMixinHooks1[0]
@@ -187,11 +339,11 @@ func init() {
 	// roommember.DefaultUnreadMessagesCount holds the default value on creation for the unread_messages_count field.
 	roommember.DefaultUnreadMessagesCount = roommemberDescUnreadMessagesCount.Default.(int)
 	// roommemberDescJoinedAt is the schema descriptor for joined_at field.
-	roommemberDescJoinedAt := roommemberFields[4].Descriptor()
+	roommemberDescJoinedAt := roommemberFields[8].Descriptor()
 	// roommember.DefaultJoinedAt holds the default value on creation for the joined_at field.
 	roommember.DefaultJoinedAt = roommemberDescJoinedAt.Default.(func() time.Time)
 	// roommemberDescUpdatedAt is the schema descriptor for updated_at field.
-	roommemberDescUpdatedAt := roommemberFields[5].Descriptor()
+	roommemberDescUpdatedAt := roommemberFields[9].Descriptor()
 	// roommember.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	roommember.DefaultUpdatedAt = roommemberDescUpdatedAt.Default.(func() time.Time)
 	// roommember.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -205,12 +357,32 @@ func init() {
 	_ = userMixinFields0
 	userFields := schema.User{}.Fields()
 	_ = userFields
+	// userDescStorageUsed is the schema descriptor for storage_used field.
+	userDescStorageUsed := userFields[4].Descriptor()
+	// user.DefaultStorageUsed holds the default value on creation for the storage_used field.
+	user.DefaultStorageUsed = userDescStorageUsed.Default.(int64)
+	// userDescLocale is the schema descriptor for locale field.
+	userDescLocale := userFields[5].Descriptor()
+	// user.DefaultLocale holds the default value on creation for the locale field.
+	user.DefaultLocale = userDescLocale.Default.(string)
+	// userDescMessagePreviews is the schema descriptor for message_previews field.
+	userDescMessagePreviews := userFields[6].Descriptor()
+	// user.DefaultMessagePreviews holds the default value on creation for the message_previews field.
+	user.DefaultMessagePreviews = userDescMessagePreviews.Default.(bool)
+	// userDescTimezone is the schema descriptor for timezone field.
+	userDescTimezone := userFields[7].Descriptor()
+	// user.DefaultTimezone holds the default value on creation for the timezone field.
+	user.DefaultTimezone = userDescTimezone.Default.(string)
+	// userDescHideLastSeen is the schema descriptor for hide_last_seen field.
+	userDescHideLastSeen := userFields[12].Descriptor()
+	// user.DefaultHideLastSeen holds the default value on creation for the hide_last_seen field.
+	user.DefaultHideLastSeen = userDescHideLastSeen.Default.(bool)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[6].Descriptor()
+	userDescCreatedAt := userFields[17].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[7].Descriptor()
+	userDescUpdatedAt := userFields[18].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -219,6 +391,19 @@ func init() {
 	userDescID := userMixinFields0[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() pulid.ID)
+	userblockMixin := schema.UserBlock{}.Mixin()
+	userblockMixinFields0 := userblockMixin[0].Fields()
+	_ = userblockMixinFields0
+	userblockFields := schema.UserBlock{}.Fields()
+	_ = userblockFields
+	// userblockDescCreatedAt is the schema descriptor for created_at field.
+	userblockDescCreatedAt := userblockFields[2].Descriptor()
+	// userblock.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userblock.DefaultCreatedAt = userblockDescCreatedAt.Default.(func() time.Time)
+	// userblockDescID is the schema descriptor for id field.
+	userblockDescID := userblockMixinFields0[0].Descriptor()
+	// userblock.DefaultID holds the default value on creation for the id field.
+	userblock.DefaultID = userblockDescID.Default.(func() pulid.ID)
 	usercontactMixin := schema.UserContact{}.Mixin()
 	usercontactMixinHooks1 := usercontactMixin[1].Hooks()
 	usercontact.Hooks[0] = usercontactMixinHooks1[0]
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"journeyhub/ent/schema/pulid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Avatar holds the schema definition for the Avatar entity. Avatars are
+// kept as the history of the images of a user or a room.
+type Avatar struct {
+	ent.Schema
+}
+
+// Mixin returns Avatar mixed-in schema.
+func (Avatar) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		pulid.MixinWithPrefix("AV"),
+	}
+}
+
+// Fields of the Avatar.
+func (Avatar) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("file_id").
+			GoType(pulid.ID("")),
+		field.String("user_id").
+			GoType(pulid.ID("")).
+			Optional().
+			Nillable(),
+		field.String("room_id").
+			GoType(pulid.ID("")).
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			Annotations(
+				entgql.OrderField("CREATED_AT"),
+			),
+	}
+}
+
+// Edges of the Avatar.
+func (Avatar) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("file", File.Type).
+			Required().
+			Unique().
+			Field("file_id").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.From("user", User.Type).
+			Ref("avatars").
+			Unique().
+			Field("user_id"),
+		edge.From("room", Room.Type).
+			Ref("avatars").
+			Unique().
+			Field("room_id"),
+	}
+}
@@ -0,0 +1,102 @@
+package schema
+
+import (
+	"time"
+
+	"journeyhub/ent/schema/pulid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Call holds the schema definition for the Call entity.
+type Call struct {
+	ent.Schema
+}
+
+// Mixin returns Call mixed-in schema.
+func (Call) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		pulid.MixinWithPrefix("CA"),
+	}
+}
+
+// Fields of the Call.
+func (Call) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("type").
+			Values("Audio", "Video"),
+		field.Enum("state").
+			Values("Ringing", "Active", "Ended", "Missed", "Declined").
+			Default("Ringing"),
+		field.String("room_id").
+			GoType(pulid.ID("")),
+		field.String("caller_id").
+			GoType(pulid.ID("")),
+		field.Time("started_at").
+			Immutable().
+			Default(time.Now).
+			Annotations(
+				entgql.OrderField("STARTED_AT"),
+			),
+		field.Time("answered_at").
+			Optional().
+			Nillable(),
+		field.Time("ended_at").
+			Optional().
+			Nillable(),
+		field.Int("duration").
+			Default(0).
+			Comment("Duration of the conversation in seconds"),
+	}
+}
+
+// Edges of the Call.
+func (Call) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("room", Room.Type).
+			Required().
+			Unique().
+			Field("room_id").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("caller", User.Type).
+			Required().
+			Unique().
+			Field("caller_id").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("participants", CallParticipant.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+	}
+}
+
+// Indexes of the Call.
+func (Call) Indexes() []ent.Index {
+	return []ent.Index{
+		// A room has at most one ongoing call.
+		index.Fields("room_id").
+			Unique().
+			Annotations(
+				entsql.IndexWhere("state IN ('Ringing', 'Active')"),
+			),
+		index.Fields("state", "started_at"),
+	}
+}
+
+// Annotations of the Call.
+func (Call) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.MultiOrder(),
+		entgql.RelayConnection(),
+	}
+}
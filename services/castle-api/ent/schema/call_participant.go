@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"journeyhub/ent/schema/pulid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CallParticipant holds the schema definition for the CallParticipant entity.
+type CallParticipant struct {
+	ent.Schema
+}
+
+// Mixin returns CallParticipant mixed-in schema.
+func (CallParticipant) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		pulid.MixinWithPrefix("CP"),
+	}
+}
+
+// Fields of the CallParticipant.
+func (CallParticipant) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("state").
+			Values("Invited", "Joined", "Left", "Declined", "Missed").
+			Default("Invited"),
+		field.String("call_id").
+			GoType(pulid.ID("")),
+		field.String("user_id").
+			GoType(pulid.ID("")),
+		field.Time("invited_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("joined_at").
+			Optional().
+			Nillable(),
+		field.Time("left_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the CallParticipant.
+func (CallParticipant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("call", Call.Type).
+			Ref("participants").
+			Required().
+			Unique().
+			Field("call_id"),
+		edge.To("user", User.Type).
+			Required().
+			Unique().
+			Field("user_id").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entgql.Skip(entgql.SkipWhereInput),
+			),
+	}
+}
+
+// Indexes of the CallParticipant.
+func (CallParticipant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("call_id", "user_id").
+			Unique(),
+	}
+}
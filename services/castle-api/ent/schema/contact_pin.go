@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"time"
+
+	"journeyhub/ent/schema/pulid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ContactPin holds the schema definition for the ContactPin entity. Others
+// send a contact request to the user with the code of the pin.
+type ContactPin struct {
+	ent.Schema
+}
+
+// Mixin returns ContactPin mixed-in schema.
+func (ContactPin) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		pulid.MixinWithPrefix("PN"),
+	}
+}
+
+// Fields of the ContactPin.
+func (ContactPin) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code").
+			Unique().
+			Immutable().
+			Sensitive(),
+		field.String("user_id").
+			GoType(pulid.ID("")).
+			Immutable(),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.Int("max_uses").
+			Optional().
+			Nillable().
+			Positive(),
+		field.Int("uses").
+			Default(0),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			Annotations(
+				entgql.OrderField("CREATED_AT"),
+			),
+	}
+}
+
+// Edges of the ContactPin.
+func (ContactPin) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Required().
+			Unique().
+			Immutable().
+			Field("user_id").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entgql.Skip(entgql.SkipWhereInput),
+			),
+	}
+}
+
+// Indexes of the ContactPin.
+func (ContactPin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "revoked_at"),
+	}
+}
+
+// Annotations of the ContactPin.
+func (ContactPin) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.MultiOrder(),
+		entgql.RelayConnection(),
+	}
+}
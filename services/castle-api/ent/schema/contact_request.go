@@ -0,0 +1,96 @@
+package schema
+
+import (
+	"time"
+
+	"journeyhub/ent/schema/pulid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ContactRequest holds the schema definition for the ContactRequest entity.
+// The users become contacts of each other once the receiver accepts it.
+type ContactRequest struct {
+	ent.Schema
+}
+
+// Mixin returns ContactRequest mixed-in schema.
+func (ContactRequest) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		pulid.MixinWithPrefix("CR"),
+	}
+}
+
+// Fields of the ContactRequest.
+func (ContactRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("sender_id").
+			GoType(pulid.ID("")).
+			Immutable(),
+		field.String("receiver_id").
+			GoType(pulid.ID("")).
+			Immutable(),
+		field.Enum("status").
+			Values("Pending", "Accepted", "Declined").
+			Default("Pending").
+			Annotations(
+				entgql.OrderField("STATUS"),
+			),
+		field.Time("created_at").
+			Default(time.Now).
+			Annotations(
+				entgql.OrderField("CREATED_AT"),
+			),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(
+				entgql.OrderField("UPDATED_AT"),
+			),
+	}
+}
+
+// Edges of the ContactRequest.
+func (ContactRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("sender", User.Type).
+			Required().
+			Unique().
+			Immutable().
+			Field("sender_id").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("receiver", User.Type).
+			Required().
+			Unique().
+			Immutable().
+			Field("receiver_id").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+	}
+}
+
+// Indexes of the ContactRequest.
+func (ContactRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("sender_id", "receiver_id").
+			Unique(),
+		index.Fields("receiver_id", "status"),
+	}
+}
+
+// Annotations of the ContactRequest.
+func (ContactRequest) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.MultiOrder(),
+		entgql.RelayConnection(),
+	}
+}
@@ -35,7 +35,30 @@ func (Device) Fields() []ent.Field {
 		field.String("fcm_token").
 			Unique().
 			Annotations(
-				entgql.OrderField("FCM_TOKEN"),
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.Enum("platform").
+			Values("Android", "IOS", "Web").
+			Default("Android").
+			Annotations(
+				entgql.OrderField("PLATFORM"),
+			),
+		field.String("voip_token").
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.Time("last_push_at").
+			Optional().
+			Nillable(),
+		field.String("last_push_error").
+			Optional().
+			Nillable(),
+		field.Time("invalidated_at").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
 			),
 		field.Time("created_at").
 			Immutable().
@@ -1,9 +1,15 @@
 package schema
 
 import (
-	"journeyhub/ent/schema/pulid"
+	"context"
 	"time"
 
+	"journeyhub/ent/file"
+	"journeyhub/ent/hook"
+	"journeyhub/ent/schema/pulid"
+
+	gen "journeyhub/ent"
+
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
@@ -52,6 +58,26 @@ func (File) Fields() []ent.Field {
 			Annotations(
 				entgql.OrderField("PATH"),
 			),
+		field.Int("width").
+			Optional().
+			Nillable().
+			Comment("Width of images in pixels"),
+		field.Int("height").
+			Optional().
+			Nillable().
+			Comment("Height of images in pixels"),
+		field.String("user_id").
+			Optional().
+			GoType(pulid.ID("")).
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.String("room_id").
+			Optional().
+			GoType(pulid.ID("")).
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
 		field.Time("created_at").
 			Immutable().
 			Default(time.Now).
@@ -80,5 +106,106 @@ func (File) Edges() []ent.Edge {
 			Annotations(
 				entsql.OnDelete(entsql.Cascade),
 			),
+		edge.To("thumbnails", File.Type).
+			From("original").
+			Unique().
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.From("user", User.Type).
+			Ref("files").
+			Unique().
+			Field("user_id").
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		edge.From("room", Room.Type).
+			Ref("files").
+			Unique().
+			Field("room_id").
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+	}
+}
+
+// Hooks of the File.
+func (File) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.FileFunc(func(ctx context.Context, m *gen.FileMutation) (ent.Value, error) {
+					v, err := next.Mutate(ctx, m)
+					if err != nil {
+						return nil, err
+					}
+					size, _ := m.Size()
+					userID, _ := m.UserID()
+					roomID, _ := m.RoomID()
+					if err := addStorageUsed(ctx, m.Client(), userID, roomID, int64(size)); err != nil {
+						return nil, err
+					}
+					return v, nil
+				})
+			},
+			ent.OpCreate,
+		),
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.FileFunc(func(ctx context.Context, m *gen.FileMutation) (ent.Value, error) {
+					ids, err := m.IDs(ctx)
+					if err != nil {
+						return nil, err
+					}
+					files, err := m.Client().File.Query().
+						Where(file.IDIn(ids...)).
+						All(ctx)
+					if err != nil {
+						return nil, err
+					}
+					v, err := next.Mutate(ctx, m)
+					if err != nil {
+						return nil, err
+					}
+					for _, f := range files {
+						if err := addStorageUsed(ctx, m.Client(), f.UserID, f.RoomID, -int64(f.Size)); err != nil {
+							return nil, err
+						}
+					}
+					return v, nil
+				})
+			},
+			ent.OpDelete|ent.OpDeleteOne,
+		),
+	}
+}
+
+// addStorageUsed moves the storage counters of the file owners by delta bytes.
+func addStorageUsed(
+	ctx context.Context,
+	client *gen.Client,
+	userID pulid.ID,
+	roomID pulid.ID,
+	delta int64,
+) error {
+	if delta == 0 {
+		return nil
+	}
+	if userID != "" {
+		if err := client.User.
+			UpdateOneID(userID).
+			AddStorageUsed(delta).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	if roomID != "" {
+		if err := client.Room.
+			UpdateOneID(roomID).
+			AddStorageUsed(delta).
+			Exec(ctx); err != nil {
+			return err
+		}
 	}
+	return nil
 }
@@ -28,8 +28,19 @@ func (Message) Mixin() []ent.Mixin {
 // Fields of the Message.
 func (Message) Fields() []ent.Field {
 	return []ent.Field{
+		field.Enum("type").
+			Values("Text", "Call", "RoomAvatar").
+			Default("Text"),
 		field.Text("content").
 			Optional(),
+		field.String("call_id").
+			GoType(pulid.ID("")).
+			Optional().
+			Nillable(),
+		field.String("avatar_id").
+			GoType(pulid.ID("")).
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Immutable().
 			Default(time.Now).
@@ -67,6 +78,18 @@ func (Message) Edges() []ent.Edge {
 			Annotations(
 				entsql.OnDelete(entsql.Cascade),
 			),
+		edge.To("call", Call.Type).
+			Unique().
+			Field("call_id").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("avatar", Avatar.Type).
+			Unique().
+			Field("avatar_id").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
 		edge.From("user", User.Type).
 			Ref("messages").
 			Unique(),
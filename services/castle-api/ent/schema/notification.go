@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Notification holds the schema definition for the Notification entity.
@@ -27,6 +28,11 @@ func (Notification) Mixin() []ent.Mixin {
 // Fields of the Notification.
 func (Notification) Fields() []ent.Field {
 	return []ent.Field{
+		field.Enum("type").
+			Values("NewMessage", "Mention", "Call", "MissedCall", "ContactAdded", "ContactRequest").
+			Annotations(
+				entgql.OrderField("TYPE"),
+			),
 		field.String("title").
 			Annotations(
 				entgql.OrderField("TITLE"),
@@ -34,6 +40,12 @@ func (Notification) Fields() []ent.Field {
 		field.Text("body"),
 		field.JSON("data", map[string]interface{}{}).
 			Optional(),
+		field.Time("read_at").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.OrderField("READ_AT"),
+			),
 		field.Time("created_at").
 			Immutable().
 			Default(time.Now).
@@ -58,6 +70,14 @@ func (Notification) Edges() []ent.Edge {
 	}
 }
 
+// Indexes of the Notification.
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("user").
+			Fields("read_at"),
+	}
+}
+
 // Annotations of the Notification.
 func (Notification) Annotations() []schema.Annotation {
 	return []schema.Annotation{
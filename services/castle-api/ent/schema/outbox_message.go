@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"journeyhub/ent/schema/pulid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OutboxMessage holds the schema definition for the OutboxMessage entity.
+// Rows are written together with the data they relate to and removed once
+// published to JetStream.
+type OutboxMessage struct {
+	ent.Schema
+}
+
+// Mixin returns OutboxMessage mixed-in schema.
+func (OutboxMessage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		pulid.MixinWithPrefix("OM"),
+	}
+}
+
+// Fields of the OutboxMessage.
+func (OutboxMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("subject").
+			Immutable(),
+		field.Bytes("payload").
+			Immutable(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Indexes of the OutboxMessage.
+func (OutboxMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
+
+// Annotations of the OutboxMessage.
+func (OutboxMessage) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
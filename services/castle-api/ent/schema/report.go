@@ -0,0 +1,154 @@
+package schema
+
+import (
+	"time"
+
+	"journeyhub/ent/schema/pulid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Report holds the schema definition for the Report entity. The snapshot
+// keeps the reported content as it was when reported.
+type Report struct {
+	ent.Schema
+}
+
+// Mixin returns Report mixed-in schema.
+func (Report) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		pulid.MixinWithPrefix("RP"),
+	}
+}
+
+// Fields of the Report.
+func (Report) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("type").
+			Values("Message", "User", "Room").
+			Immutable().
+			Annotations(
+				entgql.OrderField("TYPE"),
+			),
+		field.Enum("reason").
+			Values("Spam", "Harassment", "Violence", "Sexual", "Impersonation", "Other").
+			Immutable(),
+		field.Text("text").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.JSON("snapshot", map[string]interface{}{}).
+			Immutable(),
+		field.Enum("status").
+			Values("Open", "Resolved", "Dismissed").
+			Default("Open").
+			Annotations(
+				entgql.OrderField("STATUS"),
+			),
+		field.Strings("actions").
+			Optional().
+			Comment("Actions taken when resolving the report"),
+		field.Text("note").
+			Optional().
+			Nillable().
+			Comment("Note of the moderator who closed the report"),
+		field.String("reporter_id").
+			GoType(pulid.ID("")).
+			Immutable(),
+		field.String("user_id").
+			GoType(pulid.ID("")).
+			Optional().
+			Nillable(),
+		field.String("message_id").
+			GoType(pulid.ID("")).
+			Optional().
+			Nillable(),
+		field.String("room_id").
+			GoType(pulid.ID("")).
+			Optional().
+			Nillable(),
+		field.String("assignee_id").
+			GoType(pulid.ID("")).
+			Optional().
+			Nillable(),
+		field.Time("closed_at").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.OrderField("CLOSED_AT"),
+			),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			Annotations(
+				entgql.OrderField("CREATED_AT"),
+			),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(
+				entgql.OrderField("UPDATED_AT"),
+			),
+	}
+}
+
+// Edges of the Report.
+func (Report) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("reporter", User.Type).
+			Required().
+			Unique().
+			Immutable().
+			Field("reporter_id").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entgql.Skip(entgql.SkipWhereInput),
+			),
+		edge.To("user", User.Type).
+			Unique().
+			Field("user_id").
+			Annotations(
+				entsql.OnDelete(entsql.SetNull),
+			),
+		edge.To("message", Message.Type).
+			Unique().
+			Field("message_id").
+			Annotations(
+				entsql.OnDelete(entsql.SetNull),
+			),
+		edge.To("room", Room.Type).
+			Unique().
+			Field("room_id").
+			Annotations(
+				entsql.OnDelete(entsql.SetNull),
+			),
+		edge.To("assignee", User.Type).
+			Unique().
+			Field("assignee_id").
+			Annotations(
+				entsql.OnDelete(entsql.SetNull),
+				entgql.Skip(entgql.SkipWhereInput),
+			),
+	}
+}
+
+// Indexes of the Report.
+func (Report) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "created_at"),
+	}
+}
+
+// Annotations of the Report.
+func (Report) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.MultiOrder(),
+		entgql.RelayConnection(),
+	}
+}
@@ -47,6 +47,15 @@ func (Room) Fields() []ent.Field {
 				entgql.Type("Uint64"),
 				entgql.OrderField("VERSION"),
 			),
+		field.Int64("storage_used").
+			Default(0).
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.String("avatar_id").
+			GoType(pulid.ID("")).
+			Optional().
+			Nillable(),
 		field.Enum("type").
 			Values("Personal", "Group").
 			Annotations(
@@ -102,6 +111,21 @@ func (Room) Edges() []ent.Edge {
 				entsql.OnDelete(entsql.Cascade),
 				entgql.RelayConnection(),
 			),
+		edge.To("files", File.Type).
+			Annotations(
+				entsql.OnDelete(entsql.SetNull),
+				entgql.Skip(entgql.SkipAll),
+			),
+		edge.To("avatar", File.Type).
+			Unique().
+			Field("avatar_id").
+			Annotations(
+				entsql.OnDelete(entsql.SetNull),
+			),
+		edge.To("avatars", Avatar.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
 	}
 }
 
@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"time"
+
+	"journeyhub/ent/schema/pulid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// RoomInvite holds the schema definition for the RoomInvite entity.
+type RoomInvite struct {
+	ent.Schema
+}
+
+// Mixin returns RoomInvite mixed-in schema.
+func (RoomInvite) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		pulid.MixinWithPrefix("RI"),
+	}
+}
+
+// Fields of the RoomInvite.
+func (RoomInvite) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token").
+			Unique().
+			Immutable().
+			Sensitive(),
+		field.String("room_id").
+			GoType(pulid.ID("")),
+		field.String("creator_id").
+			GoType(pulid.ID("")),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.Int("max_uses").
+			Optional().
+			Nillable().
+			Positive(),
+		field.Int("uses").
+			Default(0),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			Annotations(
+				entgql.OrderField("CREATED_AT"),
+			),
+	}
+}
+
+// Edges of the RoomInvite.
+func (RoomInvite) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("room", Room.Type).
+			Required().
+			Unique().
+			Field("room_id").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("creator", User.Type).
+			Required().
+			Unique().
+			Field("creator_id").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entgql.Skip(entgql.SkipWhereInput),
+			),
+	}
+}
+
+// Annotations of the RoomInvite.
+func (RoomInvite) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.MultiOrder(),
+		entgql.RelayConnection(),
+	}
+}
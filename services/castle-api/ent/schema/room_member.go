@@ -40,6 +40,21 @@ func (RoomMember) Fields() []ent.Field {
 			Annotations(
 				entgql.OrderField("UNREAD_MESSAGES_COUNT"),
 			),
+		field.Enum("notification_level").
+			Values("All", "Mentions", "None").
+			Default("All"),
+		field.Time("mute_until").
+			Optional().
+			Nillable(),
+		field.Enum("role").
+			Values("Owner", "Admin", "Member", "ReadOnly").
+			Default("Member"),
+		field.Time("banned_at").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
 		field.String("user_id").
 			GoType(pulid.ID("")),
 		field.String("room_id").
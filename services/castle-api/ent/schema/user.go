@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // User holds the schema definition for the User entity.
@@ -47,9 +48,71 @@ func (User) Fields() []ent.Field {
 			Annotations(
 				entgql.OrderField("EMAIL"),
 			),
-		field.String("contact_pin").
+		field.Int64("storage_used").
+			Default(0).
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.String("locale").
+			Default("ru").
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.Bool("message_previews").
+			Default(true).
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.String("timezone").
+			Default("UTC").
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.String("quiet_hours_start").
 			Optional().
-			Unique(),
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.String("quiet_hours_end").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.Enum("role").
+			Values("User", "Moderator").
+			Default("User").
+			Annotations(
+				entgql.Skip(entgql.SkipWhereInput | entgql.SkipMutationCreateInput | entgql.SkipMutationUpdateInput),
+			),
+		field.Time("suspended_at").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.Bool("hide_last_seen").
+			Default(false).
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.Enum("discoverability").
+			Values("Everyone", "ContactsOfContacts", "Nobody").
+			Default("ContactsOfContacts").
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.Time("last_seen_at").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.String("avatar_id").
+			GoType(pulid.ID("")).
+			Optional().
+			Nillable(),
 		field.String("password").
 			Sensitive().
 			Annotations(
@@ -78,7 +141,7 @@ func (User) Edges() []ent.Edge {
 		edge.To("notifications", Notification.Type).
 			Annotations(
 				entsql.OnDelete(entsql.Cascade),
-				entgql.RelayConnection(),
+				entgql.Skip(entgql.SkipAll),
 			),
 		edge.To("contacts", User.Type).
 			Through("user_contacts", UserContact.Type).
@@ -96,6 +159,53 @@ func (User) Edges() []ent.Edge {
 				entsql.OnDelete(entsql.Cascade),
 				entgql.RelayConnection(),
 			),
+		edge.To("files", File.Type).
+			Annotations(
+				entsql.OnDelete(entsql.SetNull),
+				entgql.Skip(entgql.SkipAll),
+			),
+		edge.To("avatar", File.Type).
+			Unique().
+			Field("avatar_id").
+			Annotations(
+				entsql.OnDelete(entsql.SetNull),
+			),
+		edge.To("avatars", Avatar.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.From("blocks", UserBlock.Type).
+			Ref("user").
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		edge.From("blocked_by", UserBlock.Type).
+			Ref("blocked_user").
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+	}
+}
+
+// Indexes of the User.
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		// Trigram indexes of the user directory search.
+		index.Fields("nickname").
+			Annotations(
+				entsql.IndexType("GIN"),
+				entsql.OpClass("gin_trgm_ops"),
+			),
+		index.Fields("first_name").
+			Annotations(
+				entsql.IndexType("GIN"),
+				entsql.OpClass("gin_trgm_ops"),
+			),
+		index.Fields("last_name").
+			Annotations(
+				entsql.IndexType("GIN"),
+				entsql.OpClass("gin_trgm_ops"),
+			),
 	}
 }
 
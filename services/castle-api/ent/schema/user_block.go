@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"time"
+
+	"journeyhub/ent/schema/pulid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserBlock holds the schema definition for the UserBlock entity. The user
+// blocks the blocked user, who is not told about it.
+type UserBlock struct {
+	ent.Schema
+}
+
+// Mixin returns UserBlock mixed-in schema.
+func (UserBlock) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		pulid.MixinWithPrefix("UB"),
+	}
+}
+
+// Fields of the UserBlock.
+func (UserBlock) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id").
+			GoType(pulid.ID("")),
+		field.String("blocked_user_id").
+			GoType(pulid.ID("")),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the UserBlock.
+func (UserBlock) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Required().
+			Unique().
+			Field("user_id").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("blocked_user", User.Type).
+			Required().
+			Unique().
+			Field("blocked_user_id").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+	}
+}
+
+// Indexes of the UserBlock.
+func (UserBlock) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "blocked_user_id").
+			Unique(),
+		index.Fields("blocked_user_id"),
+	}
+}
+
+// Annotations of the UserBlock.
+func (UserBlock) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
@@ -12,6 +12,16 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Avatar is the client for interacting with the Avatar builders.
+	Avatar *AvatarClient
+	// Call is the client for interacting with the Call builders.
+	Call *CallClient
+	// CallParticipant is the client for interacting with the CallParticipant builders.
+	CallParticipant *CallParticipantClient
+	// ContactPin is the client for interacting with the ContactPin builders.
+	ContactPin *ContactPinClient
+	// ContactRequest is the client for interacting with the ContactRequest builders.
+	ContactRequest *ContactRequestClient
 	// Device is the client for interacting with the Device builders.
 	Device *DeviceClient
 	// File is the client for interacting with the File builders.
@@ -26,12 +36,20 @@ type Tx struct {
 	MessageVoice *MessageVoiceClient
 	// Notification is the client for interacting with the Notification builders.
 	Notification *NotificationClient
+	// OutboxMessage is the client for interacting with the OutboxMessage builders.
+	OutboxMessage *OutboxMessageClient
+	// Report is the client for interacting with the Report builders.
+	Report *ReportClient
 	// Room is the client for interacting with the Room builders.
 	Room *RoomClient
+	// RoomInvite is the client for interacting with the RoomInvite builders.
+	RoomInvite *RoomInviteClient
 	// RoomMember is the client for interacting with the RoomMember builders.
 	RoomMember *RoomMemberClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserBlock is the client for interacting with the UserBlock builders.
+	UserBlock *UserBlockClient
 	// UserContact is the client for interacting with the UserContact builders.
 	UserContact *UserContactClient
 
@@ -165,6 +183,11 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Avatar = NewAvatarClient(tx.config)
+	tx.Call = NewCallClient(tx.config)
+	tx.CallParticipant = NewCallParticipantClient(tx.config)
+	tx.ContactPin = NewContactPinClient(tx.config)
+	tx.ContactRequest = NewContactRequestClient(tx.config)
 	tx.Device = NewDeviceClient(tx.config)
 	tx.File = NewFileClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
@@ -172,9 +195,13 @@ func (tx *Tx) init() {
 	tx.MessageLink = NewMessageLinkClient(tx.config)
 	tx.MessageVoice = NewMessageVoiceClient(tx.config)
 	tx.Notification = NewNotificationClient(tx.config)
+	tx.OutboxMessage = NewOutboxMessageClient(tx.config)
+	tx.Report = NewReportClient(tx.config)
 	tx.Room = NewRoomClient(tx.config)
+	tx.RoomInvite = NewRoomInviteClient(tx.config)
 	tx.RoomMember = NewRoomMemberClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserBlock = NewUserBlockClient(tx.config)
 	tx.UserContact = NewUserContactClient(tx.config)
 }
 
@@ -185,7 +212,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Device.QueryXXX(), the query will be executed
+// applies a query, for example: Avatar.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
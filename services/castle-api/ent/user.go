@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"journeyhub/ent/device"
+	"journeyhub/ent/file"
 	"journeyhub/ent/schema/pulid"
 	"journeyhub/ent/user"
 	"strings"
@@ -27,8 +28,30 @@ type User struct {
 	Nickname string `json:"nickname,omitempty"`
 	// Email holds the value of the "email" field.
 	Email string `json:"email,omitempty"`
-	// ContactPin holds the value of the "contact_pin" field.
-	ContactPin string `json:"contact_pin,omitempty"`
+	// StorageUsed holds the value of the "storage_used" field.
+	StorageUsed int64 `json:"storage_used,omitempty"`
+	// Locale holds the value of the "locale" field.
+	Locale string `json:"locale,omitempty"`
+	// MessagePreviews holds the value of the "message_previews" field.
+	MessagePreviews bool `json:"message_previews,omitempty"`
+	// Timezone holds the value of the "timezone" field.
+	Timezone string `json:"timezone,omitempty"`
+	// QuietHoursStart holds the value of the "quiet_hours_start" field.
+	QuietHoursStart *string `json:"quiet_hours_start,omitempty"`
+	// QuietHoursEnd holds the value of the "quiet_hours_end" field.
+	QuietHoursEnd *string `json:"quiet_hours_end,omitempty"`
+	// Role holds the value of the "role" field.
+	Role user.Role `json:"role,omitempty"`
+	// SuspendedAt holds the value of the "suspended_at" field.
+	SuspendedAt *time.Time `json:"suspended_at,omitempty"`
+	// HideLastSeen holds the value of the "hide_last_seen" field.
+	HideLastSeen bool `json:"hide_last_seen,omitempty"`
+	// Discoverability holds the value of the "discoverability" field.
+	Discoverability user.Discoverability `json:"discoverability,omitempty"`
+	// LastSeenAt holds the value of the "last_seen_at" field.
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+	// AvatarID holds the value of the "avatar_id" field.
+	AvatarID *pulid.ID `json:"avatar_id,omitempty"`
 	// Password holds the value of the "password" field.
 	Password string `json:"-"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -53,20 +76,34 @@ type UserEdges struct {
 	Rooms []*Room `json:"rooms,omitempty"`
 	// Messages holds the value of the messages edge.
 	Messages []*Message `json:"messages,omitempty"`
+	// Files holds the value of the files edge.
+	Files []*File `json:"files,omitempty"`
+	// Avatar holds the value of the avatar edge.
+	Avatar *File `json:"avatar,omitempty"`
+	// Avatars holds the value of the avatars edge.
+	Avatars []*Avatar `json:"avatars,omitempty"`
+	// Blocks holds the value of the blocks edge.
+	Blocks []*UserBlock `json:"blocks,omitempty"`
+	// BlockedBy holds the value of the blocked_by edge.
+	BlockedBy []*UserBlock `json:"blocked_by,omitempty"`
 	// UserContacts holds the value of the user_contacts edge.
 	UserContacts []*UserContact `json:"user_contacts,omitempty"`
 	// Memberships holds the value of the memberships edge.
 	Memberships []*RoomMember `json:"memberships,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [12]bool
 	// totalCount holds the count of the edges above.
-	totalCount [7]map[string]int
+	totalCount [8]map[string]int
 
 	namedNotifications map[string][]*Notification
 	namedContacts      map[string][]*User
 	namedRooms         map[string][]*Room
 	namedMessages      map[string][]*Message
+	namedFiles         map[string][]*File
+	namedAvatars       map[string][]*Avatar
+	namedBlocks        map[string][]*UserBlock
+	namedBlockedBy     map[string][]*UserBlock
 	namedUserContacts  map[string][]*UserContact
 	namedMemberships   map[string][]*RoomMember
 }
@@ -118,10 +155,57 @@ func (e UserEdges) MessagesOrErr() ([]*Message, error) {
 	return nil, &NotLoadedError{edge: "messages"}
 }
 
+// FilesOrErr returns the Files value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FilesOrErr() ([]*File, error) {
+	if e.loadedTypes[5] {
+		return e.Files, nil
+	}
+	return nil, &NotLoadedError{edge: "files"}
+}
+
+// AvatarOrErr returns the Avatar value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) AvatarOrErr() (*File, error) {
+	if e.Avatar != nil {
+		return e.Avatar, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: file.Label}
+	}
+	return nil, &NotLoadedError{edge: "avatar"}
+}
+
+// AvatarsOrErr returns the Avatars value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AvatarsOrErr() ([]*Avatar, error) {
+	if e.loadedTypes[7] {
+		return e.Avatars, nil
+	}
+	return nil, &NotLoadedError{edge: "avatars"}
+}
+
+// BlocksOrErr returns the Blocks value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BlocksOrErr() ([]*UserBlock, error) {
+	if e.loadedTypes[8] {
+		return e.Blocks, nil
+	}
+	return nil, &NotLoadedError{edge: "blocks"}
+}
+
+// BlockedByOrErr returns the BlockedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BlockedByOrErr() ([]*UserBlock, error) {
+	if e.loadedTypes[9] {
+		return e.BlockedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "blocked_by"}
+}
+
 // UserContactsOrErr returns the UserContacts value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) UserContactsOrErr() ([]*UserContact, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[10] {
 		return e.UserContacts, nil
 	}
 	return nil, &NotLoadedError{edge: "user_contacts"}
@@ -130,7 +214,7 @@ func (e UserEdges) UserContactsOrErr() ([]*UserContact, error) {
 // MembershipsOrErr returns the Memberships value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) MembershipsOrErr() ([]*RoomMember, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[11] {
 		return e.Memberships, nil
 	}
 	return nil, &NotLoadedError{edge: "memberships"}
@@ -141,11 +225,17 @@ func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case user.FieldAvatarID:
+			values[i] = &sql.NullScanner{S: new(pulid.ID)}
 		case user.FieldID:
 			values[i] = new(pulid.ID)
-		case user.FieldFirstName, user.FieldLastName, user.FieldNickname, user.FieldEmail, user.FieldContactPin, user.FieldPassword:
+		case user.FieldMessagePreviews, user.FieldHideLastSeen:
+			values[i] = new(sql.NullBool)
+		case user.FieldStorageUsed:
+			values[i] = new(sql.NullInt64)
+		case user.FieldFirstName, user.FieldLastName, user.FieldNickname, user.FieldEmail, user.FieldLocale, user.FieldTimezone, user.FieldQuietHoursStart, user.FieldQuietHoursEnd, user.FieldRole, user.FieldDiscoverability, user.FieldPassword:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt:
+		case user.FieldSuspendedAt, user.FieldLastSeenAt, user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -192,11 +282,82 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Email = value.String
 			}
-		case user.FieldContactPin:
+		case user.FieldStorageUsed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_used", values[i])
+			} else if value.Valid {
+				u.StorageUsed = value.Int64
+			}
+		case user.FieldLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locale", values[i])
+			} else if value.Valid {
+				u.Locale = value.String
+			}
+		case user.FieldMessagePreviews:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field message_previews", values[i])
+			} else if value.Valid {
+				u.MessagePreviews = value.Bool
+			}
+		case user.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				u.Timezone = value.String
+			}
+		case user.FieldQuietHoursStart:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field quiet_hours_start", values[i])
+			} else if value.Valid {
+				u.QuietHoursStart = new(string)
+				*u.QuietHoursStart = value.String
+			}
+		case user.FieldQuietHoursEnd:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field contact_pin", values[i])
+				return fmt.Errorf("unexpected type %T for field quiet_hours_end", values[i])
 			} else if value.Valid {
-				u.ContactPin = value.String
+				u.QuietHoursEnd = new(string)
+				*u.QuietHoursEnd = value.String
+			}
+		case user.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				u.Role = user.Role(value.String)
+			}
+		case user.FieldSuspendedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field suspended_at", values[i])
+			} else if value.Valid {
+				u.SuspendedAt = new(time.Time)
+				*u.SuspendedAt = value.Time
+			}
+		case user.FieldHideLastSeen:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field hide_last_seen", values[i])
+			} else if value.Valid {
+				u.HideLastSeen = value.Bool
+			}
+		case user.FieldDiscoverability:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field discoverability", values[i])
+			} else if value.Valid {
+				u.Discoverability = user.Discoverability(value.String)
+			}
+		case user.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				u.LastSeenAt = new(time.Time)
+				*u.LastSeenAt = value.Time
+			}
+		case user.FieldAvatarID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field avatar_id", values[i])
+			} else if value.Valid {
+				u.AvatarID = new(pulid.ID)
+				*u.AvatarID = *value.S.(*pulid.ID)
 			}
 		case user.FieldPassword:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -254,6 +415,31 @@ func (u *User) QueryMessages() *MessageQuery {
 	return NewUserClient(u.config).QueryMessages(u)
 }
 
+// QueryFiles queries the "files" edge of the User entity.
+func (u *User) QueryFiles() *FileQuery {
+	return NewUserClient(u.config).QueryFiles(u)
+}
+
+// QueryAvatar queries the "avatar" edge of the User entity.
+func (u *User) QueryAvatar() *FileQuery {
+	return NewUserClient(u.config).QueryAvatar(u)
+}
+
+// QueryAvatars queries the "avatars" edge of the User entity.
+func (u *User) QueryAvatars() *AvatarQuery {
+	return NewUserClient(u.config).QueryAvatars(u)
+}
+
+// QueryBlocks queries the "blocks" edge of the User entity.
+func (u *User) QueryBlocks() *UserBlockQuery {
+	return NewUserClient(u.config).QueryBlocks(u)
+}
+
+// QueryBlockedBy queries the "blocked_by" edge of the User entity.
+func (u *User) QueryBlockedBy() *UserBlockQuery {
+	return NewUserClient(u.config).QueryBlockedBy(u)
+}
+
 // QueryUserContacts queries the "user_contacts" edge of the User entity.
 func (u *User) QueryUserContacts() *UserContactQuery {
 	return NewUserClient(u.config).QueryUserContacts(u)
@@ -299,8 +485,51 @@ func (u *User) String() string {
 	builder.WriteString("email=")
 	builder.WriteString(u.Email)
 	builder.WriteString(", ")
-	builder.WriteString("contact_pin=")
-	builder.WriteString(u.ContactPin)
+	builder.WriteString("storage_used=")
+	builder.WriteString(fmt.Sprintf("%v", u.StorageUsed))
+	builder.WriteString(", ")
+	builder.WriteString("locale=")
+	builder.WriteString(u.Locale)
+	builder.WriteString(", ")
+	builder.WriteString("message_previews=")
+	builder.WriteString(fmt.Sprintf("%v", u.MessagePreviews))
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(u.Timezone)
+	builder.WriteString(", ")
+	if v := u.QuietHoursStart; v != nil {
+		builder.WriteString("quiet_hours_start=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := u.QuietHoursEnd; v != nil {
+		builder.WriteString("quiet_hours_end=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", u.Role))
+	builder.WriteString(", ")
+	if v := u.SuspendedAt; v != nil {
+		builder.WriteString("suspended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("hide_last_seen=")
+	builder.WriteString(fmt.Sprintf("%v", u.HideLastSeen))
+	builder.WriteString(", ")
+	builder.WriteString("discoverability=")
+	builder.WriteString(fmt.Sprintf("%v", u.Discoverability))
+	builder.WriteString(", ")
+	if v := u.LastSeenAt; v != nil {
+		builder.WriteString("last_seen_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := u.AvatarID; v != nil {
+		builder.WriteString("avatar_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteString(", ")
 	builder.WriteString("password=<sensitive>")
 	builder.WriteString(", ")
@@ -409,6 +638,102 @@ func (u *User) appendNamedMessages(name string, edges ...*Message) {
 	}
 }
 
+// NamedFiles returns the Files named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedFiles(name string) ([]*File, error) {
+	if u.Edges.namedFiles == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedFiles[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedFiles(name string, edges ...*File) {
+	if u.Edges.namedFiles == nil {
+		u.Edges.namedFiles = make(map[string][]*File)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedFiles[name] = []*File{}
+	} else {
+		u.Edges.namedFiles[name] = append(u.Edges.namedFiles[name], edges...)
+	}
+}
+
+// NamedAvatars returns the Avatars named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedAvatars(name string) ([]*Avatar, error) {
+	if u.Edges.namedAvatars == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedAvatars[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedAvatars(name string, edges ...*Avatar) {
+	if u.Edges.namedAvatars == nil {
+		u.Edges.namedAvatars = make(map[string][]*Avatar)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedAvatars[name] = []*Avatar{}
+	} else {
+		u.Edges.namedAvatars[name] = append(u.Edges.namedAvatars[name], edges...)
+	}
+}
+
+// NamedBlocks returns the Blocks named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedBlocks(name string) ([]*UserBlock, error) {
+	if u.Edges.namedBlocks == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedBlocks[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedBlocks(name string, edges ...*UserBlock) {
+	if u.Edges.namedBlocks == nil {
+		u.Edges.namedBlocks = make(map[string][]*UserBlock)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedBlocks[name] = []*UserBlock{}
+	} else {
+		u.Edges.namedBlocks[name] = append(u.Edges.namedBlocks[name], edges...)
+	}
+}
+
+// NamedBlockedBy returns the BlockedBy named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedBlockedBy(name string) ([]*UserBlock, error) {
+	if u.Edges.namedBlockedBy == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedBlockedBy[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedBlockedBy(name string, edges ...*UserBlock) {
+	if u.Edges.namedBlockedBy == nil {
+		u.Edges.namedBlockedBy = make(map[string][]*UserBlock)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedBlockedBy[name] = []*UserBlock{}
+	} else {
+		u.Edges.namedBlockedBy[name] = append(u.Edges.namedBlockedBy[name], edges...)
+	}
+}
+
 // NamedUserContacts returns the UserContacts named value or an error if the edge was not
 // loaded in eager-loading with this name.
 func (u *User) NamedUserContacts(name string) ([]*UserContact, error) {
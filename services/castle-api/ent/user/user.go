@@ -3,7 +3,10 @@
 package user
 
 import (
+	"fmt"
+	"io"
 	"journeyhub/ent/schema/pulid"
+	"strconv"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -23,8 +26,30 @@ const (
 	FieldNickname = "nickname"
 	// FieldEmail holds the string denoting the email field in the database.
 	FieldEmail = "email"
-	// FieldContactPin holds the string denoting the contact_pin field in the database.
-	FieldContactPin = "contact_pin"
+	// FieldStorageUsed holds the string denoting the storage_used field in the database.
+	FieldStorageUsed = "storage_used"
+	// FieldLocale holds the string denoting the locale field in the database.
+	FieldLocale = "locale"
+	// FieldMessagePreviews holds the string denoting the message_previews field in the database.
+	FieldMessagePreviews = "message_previews"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
+	// FieldQuietHoursStart holds the string denoting the quiet_hours_start field in the database.
+	FieldQuietHoursStart = "quiet_hours_start"
+	// FieldQuietHoursEnd holds the string denoting the quiet_hours_end field in the database.
+	FieldQuietHoursEnd = "quiet_hours_end"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldSuspendedAt holds the string denoting the suspended_at field in the database.
+	FieldSuspendedAt = "suspended_at"
+	// FieldHideLastSeen holds the string denoting the hide_last_seen field in the database.
+	FieldHideLastSeen = "hide_last_seen"
+	// FieldDiscoverability holds the string denoting the discoverability field in the database.
+	FieldDiscoverability = "discoverability"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
+	// FieldAvatarID holds the string denoting the avatar_id field in the database.
+	FieldAvatarID = "avatar_id"
 	// FieldPassword holds the string denoting the password field in the database.
 	FieldPassword = "password"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -41,6 +66,16 @@ const (
 	EdgeRooms = "rooms"
 	// EdgeMessages holds the string denoting the messages edge name in mutations.
 	EdgeMessages = "messages"
+	// EdgeFiles holds the string denoting the files edge name in mutations.
+	EdgeFiles = "files"
+	// EdgeAvatar holds the string denoting the avatar edge name in mutations.
+	EdgeAvatar = "avatar"
+	// EdgeAvatars holds the string denoting the avatars edge name in mutations.
+	EdgeAvatars = "avatars"
+	// EdgeBlocks holds the string denoting the blocks edge name in mutations.
+	EdgeBlocks = "blocks"
+	// EdgeBlockedBy holds the string denoting the blocked_by edge name in mutations.
+	EdgeBlockedBy = "blocked_by"
 	// EdgeUserContacts holds the string denoting the user_contacts edge name in mutations.
 	EdgeUserContacts = "user_contacts"
 	// EdgeMemberships holds the string denoting the memberships edge name in mutations.
@@ -75,6 +110,41 @@ const (
 	MessagesInverseTable = "messages"
 	// MessagesColumn is the table column denoting the messages relation/edge.
 	MessagesColumn = "user_messages"
+	// FilesTable is the table that holds the files relation/edge.
+	FilesTable = "files"
+	// FilesInverseTable is the table name for the File entity.
+	// It exists in this package in order to avoid circular dependency with the "file" package.
+	FilesInverseTable = "files"
+	// FilesColumn is the table column denoting the files relation/edge.
+	FilesColumn = "user_id"
+	// AvatarTable is the table that holds the avatar relation/edge.
+	AvatarTable = "users"
+	// AvatarInverseTable is the table name for the File entity.
+	// It exists in this package in order to avoid circular dependency with the "file" package.
+	AvatarInverseTable = "files"
+	// AvatarColumn is the table column denoting the avatar relation/edge.
+	AvatarColumn = "avatar_id"
+	// AvatarsTable is the table that holds the avatars relation/edge.
+	AvatarsTable = "avatars"
+	// AvatarsInverseTable is the table name for the Avatar entity.
+	// It exists in this package in order to avoid circular dependency with the "avatar" package.
+	AvatarsInverseTable = "avatars"
+	// AvatarsColumn is the table column denoting the avatars relation/edge.
+	AvatarsColumn = "user_id"
+	// BlocksTable is the table that holds the blocks relation/edge.
+	BlocksTable = "user_blocks"
+	// BlocksInverseTable is the table name for the UserBlock entity.
+	// It exists in this package in order to avoid circular dependency with the "userblock" package.
+	BlocksInverseTable = "user_blocks"
+	// BlocksColumn is the table column denoting the blocks relation/edge.
+	BlocksColumn = "user_id"
+	// BlockedByTable is the table that holds the blocked_by relation/edge.
+	BlockedByTable = "user_blocks"
+	// BlockedByInverseTable is the table name for the UserBlock entity.
+	// It exists in this package in order to avoid circular dependency with the "userblock" package.
+	BlockedByInverseTable = "user_blocks"
+	// BlockedByColumn is the table column denoting the blocked_by relation/edge.
+	BlockedByColumn = "blocked_user_id"
 	// UserContactsTable is the table that holds the user_contacts relation/edge.
 	UserContactsTable = "user_contacts"
 	// UserContactsInverseTable is the table name for the UserContact entity.
@@ -98,7 +168,18 @@ var Columns = []string{
 	FieldLastName,
 	FieldNickname,
 	FieldEmail,
-	FieldContactPin,
+	FieldStorageUsed,
+	FieldLocale,
+	FieldMessagePreviews,
+	FieldTimezone,
+	FieldQuietHoursStart,
+	FieldQuietHoursEnd,
+	FieldRole,
+	FieldSuspendedAt,
+	FieldHideLastSeen,
+	FieldDiscoverability,
+	FieldLastSeenAt,
+	FieldAvatarID,
 	FieldPassword,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -124,6 +205,16 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultStorageUsed holds the default value on creation for the "storage_used" field.
+	DefaultStorageUsed int64
+	// DefaultLocale holds the default value on creation for the "locale" field.
+	DefaultLocale string
+	// DefaultMessagePreviews holds the default value on creation for the "message_previews" field.
+	DefaultMessagePreviews bool
+	// DefaultTimezone holds the default value on creation for the "timezone" field.
+	DefaultTimezone string
+	// DefaultHideLastSeen holds the default value on creation for the "hide_last_seen" field.
+	DefaultHideLastSeen bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -134,6 +225,59 @@ var (
 	DefaultID func() pulid.ID
 )
 
+// Role defines the type for the "role" enum field.
+type Role string
+
+// RoleUser is the default value of the Role enum.
+const DefaultRole = RoleUser
+
+// Role values.
+const (
+	RoleUser      Role = "User"
+	RoleModerator Role = "Moderator"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleUser, RoleModerator:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for role field: %q", r)
+	}
+}
+
+// Discoverability defines the type for the "discoverability" enum field.
+type Discoverability string
+
+// DiscoverabilityContactsOfContacts is the default value of the Discoverability enum.
+const DefaultDiscoverability = DiscoverabilityContactsOfContacts
+
+// Discoverability values.
+const (
+	DiscoverabilityEveryone           Discoverability = "Everyone"
+	DiscoverabilityContactsOfContacts Discoverability = "ContactsOfContacts"
+	DiscoverabilityNobody             Discoverability = "Nobody"
+)
+
+func (d Discoverability) String() string {
+	return string(d)
+}
+
+// DiscoverabilityValidator is a validator for the "discoverability" field enum values. It is called by the builders before save.
+func DiscoverabilityValidator(d Discoverability) error {
+	switch d {
+	case DiscoverabilityEveryone, DiscoverabilityContactsOfContacts, DiscoverabilityNobody:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for discoverability field: %q", d)
+	}
+}
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -162,9 +306,64 @@ func ByEmail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmail, opts...).ToFunc()
 }
 
-// ByContactPin orders the results by the contact_pin field.
-func ByContactPin(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldContactPin, opts...).ToFunc()
+// ByStorageUsed orders the results by the storage_used field.
+func ByStorageUsed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageUsed, opts...).ToFunc()
+}
+
+// ByLocale orders the results by the locale field.
+func ByLocale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocale, opts...).ToFunc()
+}
+
+// ByMessagePreviews orders the results by the message_previews field.
+func ByMessagePreviews(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessagePreviews, opts...).ToFunc()
+}
+
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
+// ByQuietHoursStart orders the results by the quiet_hours_start field.
+func ByQuietHoursStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuietHoursStart, opts...).ToFunc()
+}
+
+// ByQuietHoursEnd orders the results by the quiet_hours_end field.
+func ByQuietHoursEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuietHoursEnd, opts...).ToFunc()
+}
+
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
+// BySuspendedAt orders the results by the suspended_at field.
+func BySuspendedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuspendedAt, opts...).ToFunc()
+}
+
+// ByHideLastSeen orders the results by the hide_last_seen field.
+func ByHideLastSeen(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHideLastSeen, opts...).ToFunc()
+}
+
+// ByDiscoverability orders the results by the discoverability field.
+func ByDiscoverability(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscoverability, opts...).ToFunc()
+}
+
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
+
+// ByAvatarID orders the results by the avatar_id field.
+func ByAvatarID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvatarID, opts...).ToFunc()
 }
 
 // ByPassword orders the results by the password field.
@@ -245,6 +444,69 @@ func ByMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByFilesCount orders the results by files count.
+func ByFilesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFilesStep(), opts...)
+	}
+}
+
+// ByFiles orders the results by files terms.
+func ByFiles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFilesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAvatarField orders the results by avatar field.
+func ByAvatarField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAvatarStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAvatarsCount orders the results by avatars count.
+func ByAvatarsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAvatarsStep(), opts...)
+	}
+}
+
+// ByAvatars orders the results by avatars terms.
+func ByAvatars(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAvatarsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlocksCount orders the results by blocks count.
+func ByBlocksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlocksStep(), opts...)
+	}
+}
+
+// ByBlocks orders the results by blocks terms.
+func ByBlocks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlocksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlockedByCount orders the results by blocked_by count.
+func ByBlockedByCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlockedByStep(), opts...)
+	}
+}
+
+// ByBlockedBy orders the results by blocked_by terms.
+func ByBlockedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockedByStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByUserContactsCount orders the results by user_contacts count.
 func ByUserContactsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -307,6 +569,41 @@ func newMessagesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, MessagesTable, MessagesColumn),
 	)
 }
+func newFilesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FilesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FilesTable, FilesColumn),
+	)
+}
+func newAvatarStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AvatarInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, AvatarTable, AvatarColumn),
+	)
+}
+func newAvatarsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AvatarsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AvatarsTable, AvatarsColumn),
+	)
+}
+func newBlocksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlocksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, BlocksTable, BlocksColumn),
+	)
+}
+func newBlockedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlockedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, BlockedByTable, BlockedByColumn),
+	)
+}
 func newUserContactsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -321,3 +618,39 @@ func newMembershipsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, MembershipsTable, MembershipsColumn),
 	)
 }
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Role) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Role) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Role(str)
+	if err := RoleValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Discoverability) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Discoverability) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Discoverability(str)
+	if err := DiscoverabilityValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Discoverability", str)
+	}
+	return nil
+}
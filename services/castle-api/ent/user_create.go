@@ -6,13 +6,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"journeyhub/ent/avatar"
 	"journeyhub/ent/device"
+	"journeyhub/ent/file"
 	"journeyhub/ent/message"
 	"journeyhub/ent/notification"
 	"journeyhub/ent/room"
 	"journeyhub/ent/roommember"
 	"journeyhub/ent/schema/pulid"
 	"journeyhub/ent/user"
+	"journeyhub/ent/userblock"
 	"journeyhub/ent/usercontact"
 	"time"
 
@@ -62,16 +65,170 @@ func (uc *UserCreate) SetNillableEmail(s *string) *UserCreate {
 	return uc
 }
 
-// SetContactPin sets the "contact_pin" field.
-func (uc *UserCreate) SetContactPin(s string) *UserCreate {
-	uc.mutation.SetContactPin(s)
+// SetStorageUsed sets the "storage_used" field.
+func (uc *UserCreate) SetStorageUsed(i int64) *UserCreate {
+	uc.mutation.SetStorageUsed(i)
 	return uc
 }
 
-// SetNillableContactPin sets the "contact_pin" field if the given value is not nil.
-func (uc *UserCreate) SetNillableContactPin(s *string) *UserCreate {
+// SetNillableStorageUsed sets the "storage_used" field if the given value is not nil.
+func (uc *UserCreate) SetNillableStorageUsed(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetStorageUsed(*i)
+	}
+	return uc
+}
+
+// SetLocale sets the "locale" field.
+func (uc *UserCreate) SetLocale(s string) *UserCreate {
+	uc.mutation.SetLocale(s)
+	return uc
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (uc *UserCreate) SetNillableLocale(s *string) *UserCreate {
+	if s != nil {
+		uc.SetLocale(*s)
+	}
+	return uc
+}
+
+// SetMessagePreviews sets the "message_previews" field.
+func (uc *UserCreate) SetMessagePreviews(b bool) *UserCreate {
+	uc.mutation.SetMessagePreviews(b)
+	return uc
+}
+
+// SetNillableMessagePreviews sets the "message_previews" field if the given value is not nil.
+func (uc *UserCreate) SetNillableMessagePreviews(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetMessagePreviews(*b)
+	}
+	return uc
+}
+
+// SetTimezone sets the "timezone" field.
+func (uc *UserCreate) SetTimezone(s string) *UserCreate {
+	uc.mutation.SetTimezone(s)
+	return uc
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTimezone(s *string) *UserCreate {
+	if s != nil {
+		uc.SetTimezone(*s)
+	}
+	return uc
+}
+
+// SetQuietHoursStart sets the "quiet_hours_start" field.
+func (uc *UserCreate) SetQuietHoursStart(s string) *UserCreate {
+	uc.mutation.SetQuietHoursStart(s)
+	return uc
+}
+
+// SetNillableQuietHoursStart sets the "quiet_hours_start" field if the given value is not nil.
+func (uc *UserCreate) SetNillableQuietHoursStart(s *string) *UserCreate {
 	if s != nil {
-		uc.SetContactPin(*s)
+		uc.SetQuietHoursStart(*s)
+	}
+	return uc
+}
+
+// SetQuietHoursEnd sets the "quiet_hours_end" field.
+func (uc *UserCreate) SetQuietHoursEnd(s string) *UserCreate {
+	uc.mutation.SetQuietHoursEnd(s)
+	return uc
+}
+
+// SetNillableQuietHoursEnd sets the "quiet_hours_end" field if the given value is not nil.
+func (uc *UserCreate) SetNillableQuietHoursEnd(s *string) *UserCreate {
+	if s != nil {
+		uc.SetQuietHoursEnd(*s)
+	}
+	return uc
+}
+
+// SetRole sets the "role" field.
+func (uc *UserCreate) SetRole(u user.Role) *UserCreate {
+	uc.mutation.SetRole(u)
+	return uc
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uc *UserCreate) SetNillableRole(u *user.Role) *UserCreate {
+	if u != nil {
+		uc.SetRole(*u)
+	}
+	return uc
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (uc *UserCreate) SetSuspendedAt(t time.Time) *UserCreate {
+	uc.mutation.SetSuspendedAt(t)
+	return uc
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableSuspendedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetSuspendedAt(*t)
+	}
+	return uc
+}
+
+// SetHideLastSeen sets the "hide_last_seen" field.
+func (uc *UserCreate) SetHideLastSeen(b bool) *UserCreate {
+	uc.mutation.SetHideLastSeen(b)
+	return uc
+}
+
+// SetNillableHideLastSeen sets the "hide_last_seen" field if the given value is not nil.
+func (uc *UserCreate) SetNillableHideLastSeen(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetHideLastSeen(*b)
+	}
+	return uc
+}
+
+// SetDiscoverability sets the "discoverability" field.
+func (uc *UserCreate) SetDiscoverability(u user.Discoverability) *UserCreate {
+	uc.mutation.SetDiscoverability(u)
+	return uc
+}
+
+// SetNillableDiscoverability sets the "discoverability" field if the given value is not nil.
+func (uc *UserCreate) SetNillableDiscoverability(u *user.Discoverability) *UserCreate {
+	if u != nil {
+		uc.SetDiscoverability(*u)
+	}
+	return uc
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (uc *UserCreate) SetLastSeenAt(t time.Time) *UserCreate {
+	uc.mutation.SetLastSeenAt(t)
+	return uc
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableLastSeenAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetLastSeenAt(*t)
+	}
+	return uc
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (uc *UserCreate) SetAvatarID(pu pulid.ID) *UserCreate {
+	uc.mutation.SetAvatarID(pu)
+	return uc
+}
+
+// SetNillableAvatarID sets the "avatar_id" field if the given value is not nil.
+func (uc *UserCreate) SetNillableAvatarID(pu *pulid.ID) *UserCreate {
+	if pu != nil {
+		uc.SetAvatarID(*pu)
 	}
 	return uc
 }
@@ -203,6 +360,71 @@ func (uc *UserCreate) AddMessages(m ...*Message) *UserCreate {
 	return uc.AddMessageIDs(ids...)
 }
 
+// AddFileIDs adds the "files" edge to the File entity by IDs.
+func (uc *UserCreate) AddFileIDs(ids ...pulid.ID) *UserCreate {
+	uc.mutation.AddFileIDs(ids...)
+	return uc
+}
+
+// AddFiles adds the "files" edges to the File entity.
+func (uc *UserCreate) AddFiles(f ...*File) *UserCreate {
+	ids := make([]pulid.ID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uc.AddFileIDs(ids...)
+}
+
+// SetAvatar sets the "avatar" edge to the File entity.
+func (uc *UserCreate) SetAvatar(f *File) *UserCreate {
+	return uc.SetAvatarID(f.ID)
+}
+
+// AddAvatarIDs adds the "avatars" edge to the Avatar entity by IDs.
+func (uc *UserCreate) AddAvatarIDs(ids ...pulid.ID) *UserCreate {
+	uc.mutation.AddAvatarIDs(ids...)
+	return uc
+}
+
+// AddAvatars adds the "avatars" edges to the Avatar entity.
+func (uc *UserCreate) AddAvatars(a ...*Avatar) *UserCreate {
+	ids := make([]pulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddAvatarIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the UserBlock entity by IDs.
+func (uc *UserCreate) AddBlockIDs(ids ...pulid.ID) *UserCreate {
+	uc.mutation.AddBlockIDs(ids...)
+	return uc
+}
+
+// AddBlocks adds the "blocks" edges to the UserBlock entity.
+func (uc *UserCreate) AddBlocks(u ...*UserBlock) *UserCreate {
+	ids := make([]pulid.ID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uc.AddBlockIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the UserBlock entity by IDs.
+func (uc *UserCreate) AddBlockedByIDs(ids ...pulid.ID) *UserCreate {
+	uc.mutation.AddBlockedByIDs(ids...)
+	return uc
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the UserBlock entity.
+func (uc *UserCreate) AddBlockedBy(u ...*UserBlock) *UserCreate {
+	ids := make([]pulid.ID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uc.AddBlockedByIDs(ids...)
+}
+
 // AddUserContactIDs adds the "user_contacts" edge to the UserContact entity by IDs.
 func (uc *UserCreate) AddUserContactIDs(ids ...pulid.ID) *UserCreate {
 	uc.mutation.AddUserContactIDs(ids...)
@@ -268,6 +490,34 @@ func (uc *UserCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (uc *UserCreate) defaults() {
+	if _, ok := uc.mutation.StorageUsed(); !ok {
+		v := user.DefaultStorageUsed
+		uc.mutation.SetStorageUsed(v)
+	}
+	if _, ok := uc.mutation.Locale(); !ok {
+		v := user.DefaultLocale
+		uc.mutation.SetLocale(v)
+	}
+	if _, ok := uc.mutation.MessagePreviews(); !ok {
+		v := user.DefaultMessagePreviews
+		uc.mutation.SetMessagePreviews(v)
+	}
+	if _, ok := uc.mutation.Timezone(); !ok {
+		v := user.DefaultTimezone
+		uc.mutation.SetTimezone(v)
+	}
+	if _, ok := uc.mutation.Role(); !ok {
+		v := user.DefaultRole
+		uc.mutation.SetRole(v)
+	}
+	if _, ok := uc.mutation.HideLastSeen(); !ok {
+		v := user.DefaultHideLastSeen
+		uc.mutation.SetHideLastSeen(v)
+	}
+	if _, ok := uc.mutation.Discoverability(); !ok {
+		v := user.DefaultDiscoverability
+		uc.mutation.SetDiscoverability(v)
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		v := user.DefaultCreatedAt()
 		uc.mutation.SetCreatedAt(v)
@@ -293,6 +543,37 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.Nickname(); !ok {
 		return &ValidationError{Name: "nickname", err: errors.New(`ent: missing required field "User.nickname"`)}
 	}
+	if _, ok := uc.mutation.StorageUsed(); !ok {
+		return &ValidationError{Name: "storage_used", err: errors.New(`ent: missing required field "User.storage_used"`)}
+	}
+	if _, ok := uc.mutation.Locale(); !ok {
+		return &ValidationError{Name: "locale", err: errors.New(`ent: missing required field "User.locale"`)}
+	}
+	if _, ok := uc.mutation.MessagePreviews(); !ok {
+		return &ValidationError{Name: "message_previews", err: errors.New(`ent: missing required field "User.message_previews"`)}
+	}
+	if _, ok := uc.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "User.timezone"`)}
+	}
+	if _, ok := uc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
+	}
+	if v, ok := uc.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	if _, ok := uc.mutation.HideLastSeen(); !ok {
+		return &ValidationError{Name: "hide_last_seen", err: errors.New(`ent: missing required field "User.hide_last_seen"`)}
+	}
+	if _, ok := uc.mutation.Discoverability(); !ok {
+		return &ValidationError{Name: "discoverability", err: errors.New(`ent: missing required field "User.discoverability"`)}
+	}
+	if v, ok := uc.mutation.Discoverability(); ok {
+		if err := user.DiscoverabilityValidator(v); err != nil {
+			return &ValidationError{Name: "discoverability", err: fmt.Errorf(`ent: validator failed for field "User.discoverability": %w`, err)}
+		}
+	}
 	if _, ok := uc.mutation.Password(); !ok {
 		return &ValidationError{Name: "password", err: errors.New(`ent: missing required field "User.password"`)}
 	}
@@ -354,9 +635,49 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 		_node.Email = value
 	}
-	if value, ok := uc.mutation.ContactPin(); ok {
-		_spec.SetField(user.FieldContactPin, field.TypeString, value)
-		_node.ContactPin = value
+	if value, ok := uc.mutation.StorageUsed(); ok {
+		_spec.SetField(user.FieldStorageUsed, field.TypeInt64, value)
+		_node.StorageUsed = value
+	}
+	if value, ok := uc.mutation.Locale(); ok {
+		_spec.SetField(user.FieldLocale, field.TypeString, value)
+		_node.Locale = value
+	}
+	if value, ok := uc.mutation.MessagePreviews(); ok {
+		_spec.SetField(user.FieldMessagePreviews, field.TypeBool, value)
+		_node.MessagePreviews = value
+	}
+	if value, ok := uc.mutation.Timezone(); ok {
+		_spec.SetField(user.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := uc.mutation.QuietHoursStart(); ok {
+		_spec.SetField(user.FieldQuietHoursStart, field.TypeString, value)
+		_node.QuietHoursStart = &value
+	}
+	if value, ok := uc.mutation.QuietHoursEnd(); ok {
+		_spec.SetField(user.FieldQuietHoursEnd, field.TypeString, value)
+		_node.QuietHoursEnd = &value
+	}
+	if value, ok := uc.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := uc.mutation.SuspendedAt(); ok {
+		_spec.SetField(user.FieldSuspendedAt, field.TypeTime, value)
+		_node.SuspendedAt = &value
+	}
+	if value, ok := uc.mutation.HideLastSeen(); ok {
+		_spec.SetField(user.FieldHideLastSeen, field.TypeBool, value)
+		_node.HideLastSeen = value
+	}
+	if value, ok := uc.mutation.Discoverability(); ok {
+		_spec.SetField(user.FieldDiscoverability, field.TypeEnum, value)
+		_node.Discoverability = value
+	}
+	if value, ok := uc.mutation.LastSeenAt(); ok {
+		_spec.SetField(user.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = &value
 	}
 	if value, ok := uc.mutation.Password(); ok {
 		_spec.SetField(user.FieldPassword, field.TypeString, value)
@@ -464,6 +785,87 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FilesTable,
+			Columns: []string{user.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.AvatarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   user.AvatarTable,
+			Columns: []string{user.AvatarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AvatarID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.AvatarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AvatarsTable,
+			Columns: []string{user.AvatarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.BlocksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BlocksTable,
+			Columns: []string{user.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := uc.mutation.UserContactsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -602,21 +1004,183 @@ func (u *UserUpsert) ClearEmail() *UserUpsert {
 	return u
 }
 
-// SetContactPin sets the "contact_pin" field.
-func (u *UserUpsert) SetContactPin(v string) *UserUpsert {
-	u.Set(user.FieldContactPin, v)
+// SetStorageUsed sets the "storage_used" field.
+func (u *UserUpsert) SetStorageUsed(v int64) *UserUpsert {
+	u.Set(user.FieldStorageUsed, v)
+	return u
+}
+
+// UpdateStorageUsed sets the "storage_used" field to the value that was provided on create.
+func (u *UserUpsert) UpdateStorageUsed() *UserUpsert {
+	u.SetExcluded(user.FieldStorageUsed)
+	return u
+}
+
+// AddStorageUsed adds v to the "storage_used" field.
+func (u *UserUpsert) AddStorageUsed(v int64) *UserUpsert {
+	u.Add(user.FieldStorageUsed, v)
+	return u
+}
+
+// SetLocale sets the "locale" field.
+func (u *UserUpsert) SetLocale(v string) *UserUpsert {
+	u.Set(user.FieldLocale, v)
+	return u
+}
+
+// UpdateLocale sets the "locale" field to the value that was provided on create.
+func (u *UserUpsert) UpdateLocale() *UserUpsert {
+	u.SetExcluded(user.FieldLocale)
+	return u
+}
+
+// SetMessagePreviews sets the "message_previews" field.
+func (u *UserUpsert) SetMessagePreviews(v bool) *UserUpsert {
+	u.Set(user.FieldMessagePreviews, v)
+	return u
+}
+
+// UpdateMessagePreviews sets the "message_previews" field to the value that was provided on create.
+func (u *UserUpsert) UpdateMessagePreviews() *UserUpsert {
+	u.SetExcluded(user.FieldMessagePreviews)
+	return u
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *UserUpsert) SetTimezone(v string) *UserUpsert {
+	u.Set(user.FieldTimezone, v)
+	return u
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *UserUpsert) UpdateTimezone() *UserUpsert {
+	u.SetExcluded(user.FieldTimezone)
+	return u
+}
+
+// SetQuietHoursStart sets the "quiet_hours_start" field.
+func (u *UserUpsert) SetQuietHoursStart(v string) *UserUpsert {
+	u.Set(user.FieldQuietHoursStart, v)
+	return u
+}
+
+// UpdateQuietHoursStart sets the "quiet_hours_start" field to the value that was provided on create.
+func (u *UserUpsert) UpdateQuietHoursStart() *UserUpsert {
+	u.SetExcluded(user.FieldQuietHoursStart)
+	return u
+}
+
+// ClearQuietHoursStart clears the value of the "quiet_hours_start" field.
+func (u *UserUpsert) ClearQuietHoursStart() *UserUpsert {
+	u.SetNull(user.FieldQuietHoursStart)
+	return u
+}
+
+// SetQuietHoursEnd sets the "quiet_hours_end" field.
+func (u *UserUpsert) SetQuietHoursEnd(v string) *UserUpsert {
+	u.Set(user.FieldQuietHoursEnd, v)
+	return u
+}
+
+// UpdateQuietHoursEnd sets the "quiet_hours_end" field to the value that was provided on create.
+func (u *UserUpsert) UpdateQuietHoursEnd() *UserUpsert {
+	u.SetExcluded(user.FieldQuietHoursEnd)
+	return u
+}
+
+// ClearQuietHoursEnd clears the value of the "quiet_hours_end" field.
+func (u *UserUpsert) ClearQuietHoursEnd() *UserUpsert {
+	u.SetNull(user.FieldQuietHoursEnd)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *UserUpsert) SetRole(v user.Role) *UserUpsert {
+	u.Set(user.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *UserUpsert) UpdateRole() *UserUpsert {
+	u.SetExcluded(user.FieldRole)
 	return u
 }
 
-// UpdateContactPin sets the "contact_pin" field to the value that was provided on create.
-func (u *UserUpsert) UpdateContactPin() *UserUpsert {
-	u.SetExcluded(user.FieldContactPin)
+// SetSuspendedAt sets the "suspended_at" field.
+func (u *UserUpsert) SetSuspendedAt(v time.Time) *UserUpsert {
+	u.Set(user.FieldSuspendedAt, v)
 	return u
 }
 
-// ClearContactPin clears the value of the "contact_pin" field.
-func (u *UserUpsert) ClearContactPin() *UserUpsert {
-	u.SetNull(user.FieldContactPin)
+// UpdateSuspendedAt sets the "suspended_at" field to the value that was provided on create.
+func (u *UserUpsert) UpdateSuspendedAt() *UserUpsert {
+	u.SetExcluded(user.FieldSuspendedAt)
+	return u
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (u *UserUpsert) ClearSuspendedAt() *UserUpsert {
+	u.SetNull(user.FieldSuspendedAt)
+	return u
+}
+
+// SetHideLastSeen sets the "hide_last_seen" field.
+func (u *UserUpsert) SetHideLastSeen(v bool) *UserUpsert {
+	u.Set(user.FieldHideLastSeen, v)
+	return u
+}
+
+// UpdateHideLastSeen sets the "hide_last_seen" field to the value that was provided on create.
+func (u *UserUpsert) UpdateHideLastSeen() *UserUpsert {
+	u.SetExcluded(user.FieldHideLastSeen)
+	return u
+}
+
+// SetDiscoverability sets the "discoverability" field.
+func (u *UserUpsert) SetDiscoverability(v user.Discoverability) *UserUpsert {
+	u.Set(user.FieldDiscoverability, v)
+	return u
+}
+
+// UpdateDiscoverability sets the "discoverability" field to the value that was provided on create.
+func (u *UserUpsert) UpdateDiscoverability() *UserUpsert {
+	u.SetExcluded(user.FieldDiscoverability)
+	return u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *UserUpsert) SetLastSeenAt(v time.Time) *UserUpsert {
+	u.Set(user.FieldLastSeenAt, v)
+	return u
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *UserUpsert) UpdateLastSeenAt() *UserUpsert {
+	u.SetExcluded(user.FieldLastSeenAt)
+	return u
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (u *UserUpsert) ClearLastSeenAt() *UserUpsert {
+	u.SetNull(user.FieldLastSeenAt)
+	return u
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (u *UserUpsert) SetAvatarID(v pulid.ID) *UserUpsert {
+	u.Set(user.FieldAvatarID, v)
+	return u
+}
+
+// UpdateAvatarID sets the "avatar_id" field to the value that was provided on create.
+func (u *UserUpsert) UpdateAvatarID() *UserUpsert {
+	u.SetExcluded(user.FieldAvatarID)
+	return u
+}
+
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (u *UserUpsert) ClearAvatarID() *UserUpsert {
+	u.SetNull(user.FieldAvatarID)
 	return u
 }
 
@@ -758,24 +1322,213 @@ func (u *UserUpsertOne) ClearEmail() *UserUpsertOne {
 	})
 }
 
-// SetContactPin sets the "contact_pin" field.
-func (u *UserUpsertOne) SetContactPin(v string) *UserUpsertOne {
+// SetStorageUsed sets the "storage_used" field.
+func (u *UserUpsertOne) SetStorageUsed(v int64) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetStorageUsed(v)
+	})
+}
+
+// AddStorageUsed adds v to the "storage_used" field.
+func (u *UserUpsertOne) AddStorageUsed(v int64) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddStorageUsed(v)
+	})
+}
+
+// UpdateStorageUsed sets the "storage_used" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateStorageUsed() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateStorageUsed()
+	})
+}
+
+// SetLocale sets the "locale" field.
+func (u *UserUpsertOne) SetLocale(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetLocale(v)
+	})
+}
+
+// UpdateLocale sets the "locale" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateLocale() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateLocale()
+	})
+}
+
+// SetMessagePreviews sets the "message_previews" field.
+func (u *UserUpsertOne) SetMessagePreviews(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetMessagePreviews(v)
+	})
+}
+
+// UpdateMessagePreviews sets the "message_previews" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateMessagePreviews() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateMessagePreviews()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *UserUpsertOne) SetTimezone(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateTimezone() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetQuietHoursStart sets the "quiet_hours_start" field.
+func (u *UserUpsertOne) SetQuietHoursStart(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetQuietHoursStart(v)
+	})
+}
+
+// UpdateQuietHoursStart sets the "quiet_hours_start" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateQuietHoursStart() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateQuietHoursStart()
+	})
+}
+
+// ClearQuietHoursStart clears the value of the "quiet_hours_start" field.
+func (u *UserUpsertOne) ClearQuietHoursStart() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearQuietHoursStart()
+	})
+}
+
+// SetQuietHoursEnd sets the "quiet_hours_end" field.
+func (u *UserUpsertOne) SetQuietHoursEnd(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetQuietHoursEnd(v)
+	})
+}
+
+// UpdateQuietHoursEnd sets the "quiet_hours_end" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateQuietHoursEnd() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateQuietHoursEnd()
+	})
+}
+
+// ClearQuietHoursEnd clears the value of the "quiet_hours_end" field.
+func (u *UserUpsertOne) ClearQuietHoursEnd() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearQuietHoursEnd()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *UserUpsertOne) SetRole(v user.Role) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateRole() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (u *UserUpsertOne) SetSuspendedAt(v time.Time) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetSuspendedAt(v)
+	})
+}
+
+// UpdateSuspendedAt sets the "suspended_at" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateSuspendedAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateSuspendedAt()
+	})
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (u *UserUpsertOne) ClearSuspendedAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearSuspendedAt()
+	})
+}
+
+// SetHideLastSeen sets the "hide_last_seen" field.
+func (u *UserUpsertOne) SetHideLastSeen(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetHideLastSeen(v)
+	})
+}
+
+// UpdateHideLastSeen sets the "hide_last_seen" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateHideLastSeen() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateHideLastSeen()
+	})
+}
+
+// SetDiscoverability sets the "discoverability" field.
+func (u *UserUpsertOne) SetDiscoverability(v user.Discoverability) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetDiscoverability(v)
+	})
+}
+
+// UpdateDiscoverability sets the "discoverability" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateDiscoverability() *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
-		s.SetContactPin(v)
+		s.UpdateDiscoverability()
 	})
 }
 
-// UpdateContactPin sets the "contact_pin" field to the value that was provided on create.
-func (u *UserUpsertOne) UpdateContactPin() *UserUpsertOne {
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *UserUpsertOne) SetLastSeenAt(v time.Time) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
-		s.UpdateContactPin()
+		s.SetLastSeenAt(v)
 	})
 }
 
-// ClearContactPin clears the value of the "contact_pin" field.
-func (u *UserUpsertOne) ClearContactPin() *UserUpsertOne {
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateLastSeenAt() *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
-		s.ClearContactPin()
+		s.UpdateLastSeenAt()
+	})
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (u *UserUpsertOne) ClearLastSeenAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearLastSeenAt()
+	})
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (u *UserUpsertOne) SetAvatarID(v pulid.ID) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAvatarID(v)
+	})
+}
+
+// UpdateAvatarID sets the "avatar_id" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateAvatarID() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAvatarID()
+	})
+}
+
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (u *UserUpsertOne) ClearAvatarID() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearAvatarID()
 	})
 }
 
@@ -1088,24 +1841,213 @@ func (u *UserUpsertBulk) ClearEmail() *UserUpsertBulk {
 	})
 }
 
-// SetContactPin sets the "contact_pin" field.
-func (u *UserUpsertBulk) SetContactPin(v string) *UserUpsertBulk {
+// SetStorageUsed sets the "storage_used" field.
+func (u *UserUpsertBulk) SetStorageUsed(v int64) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetStorageUsed(v)
+	})
+}
+
+// AddStorageUsed adds v to the "storage_used" field.
+func (u *UserUpsertBulk) AddStorageUsed(v int64) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddStorageUsed(v)
+	})
+}
+
+// UpdateStorageUsed sets the "storage_used" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateStorageUsed() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateStorageUsed()
+	})
+}
+
+// SetLocale sets the "locale" field.
+func (u *UserUpsertBulk) SetLocale(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetLocale(v)
+	})
+}
+
+// UpdateLocale sets the "locale" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateLocale() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateLocale()
+	})
+}
+
+// SetMessagePreviews sets the "message_previews" field.
+func (u *UserUpsertBulk) SetMessagePreviews(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetMessagePreviews(v)
+	})
+}
+
+// UpdateMessagePreviews sets the "message_previews" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateMessagePreviews() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateMessagePreviews()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *UserUpsertBulk) SetTimezone(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateTimezone() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetQuietHoursStart sets the "quiet_hours_start" field.
+func (u *UserUpsertBulk) SetQuietHoursStart(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetQuietHoursStart(v)
+	})
+}
+
+// UpdateQuietHoursStart sets the "quiet_hours_start" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateQuietHoursStart() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateQuietHoursStart()
+	})
+}
+
+// ClearQuietHoursStart clears the value of the "quiet_hours_start" field.
+func (u *UserUpsertBulk) ClearQuietHoursStart() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearQuietHoursStart()
+	})
+}
+
+// SetQuietHoursEnd sets the "quiet_hours_end" field.
+func (u *UserUpsertBulk) SetQuietHoursEnd(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetQuietHoursEnd(v)
+	})
+}
+
+// UpdateQuietHoursEnd sets the "quiet_hours_end" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateQuietHoursEnd() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateQuietHoursEnd()
+	})
+}
+
+// ClearQuietHoursEnd clears the value of the "quiet_hours_end" field.
+func (u *UserUpsertBulk) ClearQuietHoursEnd() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearQuietHoursEnd()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *UserUpsertBulk) SetRole(v user.Role) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateRole() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (u *UserUpsertBulk) SetSuspendedAt(v time.Time) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetSuspendedAt(v)
+	})
+}
+
+// UpdateSuspendedAt sets the "suspended_at" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateSuspendedAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateSuspendedAt()
+	})
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (u *UserUpsertBulk) ClearSuspendedAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearSuspendedAt()
+	})
+}
+
+// SetHideLastSeen sets the "hide_last_seen" field.
+func (u *UserUpsertBulk) SetHideLastSeen(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetHideLastSeen(v)
+	})
+}
+
+// UpdateHideLastSeen sets the "hide_last_seen" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateHideLastSeen() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateHideLastSeen()
+	})
+}
+
+// SetDiscoverability sets the "discoverability" field.
+func (u *UserUpsertBulk) SetDiscoverability(v user.Discoverability) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetDiscoverability(v)
+	})
+}
+
+// UpdateDiscoverability sets the "discoverability" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateDiscoverability() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateDiscoverability()
+	})
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *UserUpsertBulk) SetLastSeenAt(v time.Time) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetLastSeenAt(v)
+	})
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateLastSeenAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateLastSeenAt()
+	})
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (u *UserUpsertBulk) ClearLastSeenAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearLastSeenAt()
+	})
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (u *UserUpsertBulk) SetAvatarID(v pulid.ID) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
-		s.SetContactPin(v)
+		s.SetAvatarID(v)
 	})
 }
 
-// UpdateContactPin sets the "contact_pin" field to the value that was provided on create.
-func (u *UserUpsertBulk) UpdateContactPin() *UserUpsertBulk {
+// UpdateAvatarID sets the "avatar_id" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateAvatarID() *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
-		s.UpdateContactPin()
+		s.UpdateAvatarID()
 	})
 }
 
-// ClearContactPin clears the value of the "contact_pin" field.
-func (u *UserUpsertBulk) ClearContactPin() *UserUpsertBulk {
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (u *UserUpsertBulk) ClearAvatarID() *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
-		s.ClearContactPin()
+		s.ClearAvatarID()
 	})
 }
 
@@ -6,7 +6,9 @@ import (
 	"context"
 	"database/sql/driver"
 	"fmt"
+	"journeyhub/ent/avatar"
 	"journeyhub/ent/device"
+	"journeyhub/ent/file"
 	"journeyhub/ent/message"
 	"journeyhub/ent/notification"
 	"journeyhub/ent/predicate"
@@ -14,6 +16,7 @@ import (
 	"journeyhub/ent/roommember"
 	"journeyhub/ent/schema/pulid"
 	"journeyhub/ent/user"
+	"journeyhub/ent/userblock"
 	"journeyhub/ent/usercontact"
 	"math"
 
@@ -35,6 +38,11 @@ type UserQuery struct {
 	withContacts           *UserQuery
 	withRooms              *RoomQuery
 	withMessages           *MessageQuery
+	withFiles              *FileQuery
+	withAvatar             *FileQuery
+	withAvatars            *AvatarQuery
+	withBlocks             *UserBlockQuery
+	withBlockedBy          *UserBlockQuery
 	withUserContacts       *UserContactQuery
 	withMemberships        *RoomMemberQuery
 	modifiers              []func(*sql.Selector)
@@ -43,6 +51,10 @@ type UserQuery struct {
 	withNamedContacts      map[string]*UserQuery
 	withNamedRooms         map[string]*RoomQuery
 	withNamedMessages      map[string]*MessageQuery
+	withNamedFiles         map[string]*FileQuery
+	withNamedAvatars       map[string]*AvatarQuery
+	withNamedBlocks        map[string]*UserBlockQuery
+	withNamedBlockedBy     map[string]*UserBlockQuery
 	withNamedUserContacts  map[string]*UserContactQuery
 	withNamedMemberships   map[string]*RoomMemberQuery
 	// intermediate query (i.e. traversal path).
@@ -191,6 +203,116 @@ func (uq *UserQuery) QueryMessages() *MessageQuery {
 	return query
 }
 
+// QueryFiles chains the current query on the "files" edge.
+func (uq *UserQuery) QueryFiles() *FileQuery {
+	query := (&FileClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.FilesTable, user.FilesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAvatar chains the current query on the "avatar" edge.
+func (uq *UserQuery) QueryAvatar() *FileQuery {
+	query := (&FileClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, user.AvatarTable, user.AvatarColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAvatars chains the current query on the "avatars" edge.
+func (uq *UserQuery) QueryAvatars() *AvatarQuery {
+	query := (&AvatarClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(avatar.Table, avatar.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AvatarsTable, user.AvatarsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBlocks chains the current query on the "blocks" edge.
+func (uq *UserQuery) QueryBlocks() *UserBlockQuery {
+	query := (&UserBlockClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(userblock.Table, userblock.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.BlocksTable, user.BlocksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBlockedBy chains the current query on the "blocked_by" edge.
+func (uq *UserQuery) QueryBlockedBy() *UserBlockQuery {
+	query := (&UserBlockClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(userblock.Table, userblock.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.BlockedByTable, user.BlockedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryUserContacts chains the current query on the "user_contacts" edge.
 func (uq *UserQuery) QueryUserContacts() *UserContactQuery {
 	query := (&UserContactClient{config: uq.config}).Query()
@@ -432,6 +554,11 @@ func (uq *UserQuery) Clone() *UserQuery {
 		withContacts:      uq.withContacts.Clone(),
 		withRooms:         uq.withRooms.Clone(),
 		withMessages:      uq.withMessages.Clone(),
+		withFiles:         uq.withFiles.Clone(),
+		withAvatar:        uq.withAvatar.Clone(),
+		withAvatars:       uq.withAvatars.Clone(),
+		withBlocks:        uq.withBlocks.Clone(),
+		withBlockedBy:     uq.withBlockedBy.Clone(),
 		withUserContacts:  uq.withUserContacts.Clone(),
 		withMemberships:   uq.withMemberships.Clone(),
 		// clone intermediate query.
@@ -495,6 +622,61 @@ func (uq *UserQuery) WithMessages(opts ...func(*MessageQuery)) *UserQuery {
 	return uq
 }
 
+// WithFiles tells the query-builder to eager-load the nodes that are connected to
+// the "files" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithFiles(opts ...func(*FileQuery)) *UserQuery {
+	query := (&FileClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withFiles = query
+	return uq
+}
+
+// WithAvatar tells the query-builder to eager-load the nodes that are connected to
+// the "avatar" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithAvatar(opts ...func(*FileQuery)) *UserQuery {
+	query := (&FileClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withAvatar = query
+	return uq
+}
+
+// WithAvatars tells the query-builder to eager-load the nodes that are connected to
+// the "avatars" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithAvatars(opts ...func(*AvatarQuery)) *UserQuery {
+	query := (&AvatarClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withAvatars = query
+	return uq
+}
+
+// WithBlocks tells the query-builder to eager-load the nodes that are connected to
+// the "blocks" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithBlocks(opts ...func(*UserBlockQuery)) *UserQuery {
+	query := (&UserBlockClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withBlocks = query
+	return uq
+}
+
+// WithBlockedBy tells the query-builder to eager-load the nodes that are connected to
+// the "blocked_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithBlockedBy(opts ...func(*UserBlockQuery)) *UserQuery {
+	query := (&UserBlockClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withBlockedBy = query
+	return uq
+}
+
 // WithUserContacts tells the query-builder to eager-load the nodes that are connected to
 // the "user_contacts" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithUserContacts(opts ...func(*UserContactQuery)) *UserQuery {
@@ -595,12 +777,17 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = uq.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [12]bool{
 			uq.withDevice != nil,
 			uq.withNotifications != nil,
 			uq.withContacts != nil,
 			uq.withRooms != nil,
 			uq.withMessages != nil,
+			uq.withFiles != nil,
+			uq.withAvatar != nil,
+			uq.withAvatars != nil,
+			uq.withBlocks != nil,
+			uq.withBlockedBy != nil,
 			uq.withUserContacts != nil,
 			uq.withMemberships != nil,
 		}
@@ -660,6 +847,40 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withFiles; query != nil {
+		if err := uq.loadFiles(ctx, query, nodes,
+			func(n *User) { n.Edges.Files = []*File{} },
+			func(n *User, e *File) { n.Edges.Files = append(n.Edges.Files, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withAvatar; query != nil {
+		if err := uq.loadAvatar(ctx, query, nodes, nil,
+			func(n *User, e *File) { n.Edges.Avatar = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withAvatars; query != nil {
+		if err := uq.loadAvatars(ctx, query, nodes,
+			func(n *User) { n.Edges.Avatars = []*Avatar{} },
+			func(n *User, e *Avatar) { n.Edges.Avatars = append(n.Edges.Avatars, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withBlocks; query != nil {
+		if err := uq.loadBlocks(ctx, query, nodes,
+			func(n *User) { n.Edges.Blocks = []*UserBlock{} },
+			func(n *User, e *UserBlock) { n.Edges.Blocks = append(n.Edges.Blocks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withBlockedBy; query != nil {
+		if err := uq.loadBlockedBy(ctx, query, nodes,
+			func(n *User) { n.Edges.BlockedBy = []*UserBlock{} },
+			func(n *User, e *UserBlock) { n.Edges.BlockedBy = append(n.Edges.BlockedBy, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := uq.withUserContacts; query != nil {
 		if err := uq.loadUserContacts(ctx, query, nodes,
 			func(n *User) { n.Edges.UserContacts = []*UserContact{} },
@@ -702,6 +923,34 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	for name, query := range uq.withNamedFiles {
+		if err := uq.loadFiles(ctx, query, nodes,
+			func(n *User) { n.appendNamedFiles(name) },
+			func(n *User, e *File) { n.appendNamedFiles(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedAvatars {
+		if err := uq.loadAvatars(ctx, query, nodes,
+			func(n *User) { n.appendNamedAvatars(name) },
+			func(n *User, e *Avatar) { n.appendNamedAvatars(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedBlocks {
+		if err := uq.loadBlocks(ctx, query, nodes,
+			func(n *User) { n.appendNamedBlocks(name) },
+			func(n *User, e *UserBlock) { n.appendNamedBlocks(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedBlockedBy {
+		if err := uq.loadBlockedBy(ctx, query, nodes,
+			func(n *User) { n.appendNamedBlockedBy(name) },
+			func(n *User, e *UserBlock) { n.appendNamedBlockedBy(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range uq.withNamedUserContacts {
 		if err := uq.loadUserContacts(ctx, query, nodes,
 			func(n *User) { n.appendNamedUserContacts(name) },
@@ -936,6 +1185,162 @@ func (uq *UserQuery) loadMessages(ctx context.Context, query *MessageQuery, node
 	}
 	return nil
 }
+func (uq *UserQuery) loadFiles(ctx context.Context, query *FileQuery, nodes []*User, init func(*User), assign func(*User, *File)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[pulid.ID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(file.FieldUserID)
+	}
+	query.Where(predicate.File(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.FilesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadAvatar(ctx context.Context, query *FileQuery, nodes []*User, init func(*User), assign func(*User, *File)) error {
+	ids := make([]pulid.ID, 0, len(nodes))
+	nodeids := make(map[pulid.ID][]*User)
+	for i := range nodes {
+		if nodes[i].AvatarID == nil {
+			continue
+		}
+		fk := *nodes[i].AvatarID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(file.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "avatar_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (uq *UserQuery) loadAvatars(ctx context.Context, query *AvatarQuery, nodes []*User, init func(*User), assign func(*User, *Avatar)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[pulid.ID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(avatar.FieldUserID)
+	}
+	query.Where(predicate.Avatar(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.AvatarsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadBlocks(ctx context.Context, query *UserBlockQuery, nodes []*User, init func(*User), assign func(*User, *UserBlock)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[pulid.ID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(userblock.FieldUserID)
+	}
+	query.Where(predicate.UserBlock(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.BlocksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadBlockedBy(ctx context.Context, query *UserBlockQuery, nodes []*User, init func(*User), assign func(*User, *UserBlock)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[pulid.ID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(userblock.FieldBlockedUserID)
+	}
+	query.Where(predicate.UserBlock(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.BlockedByColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BlockedUserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "blocked_user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (uq *UserQuery) loadUserContacts(ctx context.Context, query *UserContactQuery, nodes []*User, init func(*User), assign func(*User, *UserContact)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[pulid.ID]*User)
@@ -1025,6 +1430,9 @@ func (uq *UserQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if uq.withAvatar != nil {
+			_spec.Node.AddColumnOnce(user.FieldAvatarID)
+		}
 	}
 	if ps := uq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -1137,6 +1545,62 @@ func (uq *UserQuery) WithNamedMessages(name string, opts ...func(*MessageQuery))
 	return uq
 }
 
+// WithNamedFiles tells the query-builder to eager-load the nodes that are connected to the "files"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedFiles(name string, opts ...func(*FileQuery)) *UserQuery {
+	query := (&FileClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedFiles == nil {
+		uq.withNamedFiles = make(map[string]*FileQuery)
+	}
+	uq.withNamedFiles[name] = query
+	return uq
+}
+
+// WithNamedAvatars tells the query-builder to eager-load the nodes that are connected to the "avatars"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedAvatars(name string, opts ...func(*AvatarQuery)) *UserQuery {
+	query := (&AvatarClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedAvatars == nil {
+		uq.withNamedAvatars = make(map[string]*AvatarQuery)
+	}
+	uq.withNamedAvatars[name] = query
+	return uq
+}
+
+// WithNamedBlocks tells the query-builder to eager-load the nodes that are connected to the "blocks"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedBlocks(name string, opts ...func(*UserBlockQuery)) *UserQuery {
+	query := (&UserBlockClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedBlocks == nil {
+		uq.withNamedBlocks = make(map[string]*UserBlockQuery)
+	}
+	uq.withNamedBlocks[name] = query
+	return uq
+}
+
+// WithNamedBlockedBy tells the query-builder to eager-load the nodes that are connected to the "blocked_by"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedBlockedBy(name string, opts ...func(*UserBlockQuery)) *UserQuery {
+	query := (&UserBlockClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedBlockedBy == nil {
+		uq.withNamedBlockedBy = make(map[string]*UserBlockQuery)
+	}
+	uq.withNamedBlockedBy[name] = query
+	return uq
+}
+
 // WithNamedUserContacts tells the query-builder to eager-load the nodes that are connected to the "user_contacts"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithNamedUserContacts(name string, opts ...func(*UserContactQuery)) *UserQuery {
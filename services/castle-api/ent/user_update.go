@@ -6,7 +6,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"journeyhub/ent/avatar"
 	"journeyhub/ent/device"
+	"journeyhub/ent/file"
 	"journeyhub/ent/message"
 	"journeyhub/ent/notification"
 	"journeyhub/ent/predicate"
@@ -14,6 +16,7 @@ import (
 	"journeyhub/ent/roommember"
 	"journeyhub/ent/schema/pulid"
 	"journeyhub/ent/user"
+	"journeyhub/ent/userblock"
 	"journeyhub/ent/usercontact"
 	"time"
 
@@ -97,23 +100,208 @@ func (uu *UserUpdate) ClearEmail() *UserUpdate {
 	return uu
 }
 
-// SetContactPin sets the "contact_pin" field.
-func (uu *UserUpdate) SetContactPin(s string) *UserUpdate {
-	uu.mutation.SetContactPin(s)
+// SetStorageUsed sets the "storage_used" field.
+func (uu *UserUpdate) SetStorageUsed(i int64) *UserUpdate {
+	uu.mutation.ResetStorageUsed()
+	uu.mutation.SetStorageUsed(i)
 	return uu
 }
 
-// SetNillableContactPin sets the "contact_pin" field if the given value is not nil.
-func (uu *UserUpdate) SetNillableContactPin(s *string) *UserUpdate {
+// SetNillableStorageUsed sets the "storage_used" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableStorageUsed(i *int64) *UserUpdate {
+	if i != nil {
+		uu.SetStorageUsed(*i)
+	}
+	return uu
+}
+
+// AddStorageUsed adds i to the "storage_used" field.
+func (uu *UserUpdate) AddStorageUsed(i int64) *UserUpdate {
+	uu.mutation.AddStorageUsed(i)
+	return uu
+}
+
+// SetLocale sets the "locale" field.
+func (uu *UserUpdate) SetLocale(s string) *UserUpdate {
+	uu.mutation.SetLocale(s)
+	return uu
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableLocale(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetLocale(*s)
+	}
+	return uu
+}
+
+// SetMessagePreviews sets the "message_previews" field.
+func (uu *UserUpdate) SetMessagePreviews(b bool) *UserUpdate {
+	uu.mutation.SetMessagePreviews(b)
+	return uu
+}
+
+// SetNillableMessagePreviews sets the "message_previews" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableMessagePreviews(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetMessagePreviews(*b)
+	}
+	return uu
+}
+
+// SetTimezone sets the "timezone" field.
+func (uu *UserUpdate) SetTimezone(s string) *UserUpdate {
+	uu.mutation.SetTimezone(s)
+	return uu
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTimezone(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetTimezone(*s)
+	}
+	return uu
+}
+
+// SetQuietHoursStart sets the "quiet_hours_start" field.
+func (uu *UserUpdate) SetQuietHoursStart(s string) *UserUpdate {
+	uu.mutation.SetQuietHoursStart(s)
+	return uu
+}
+
+// SetNillableQuietHoursStart sets the "quiet_hours_start" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableQuietHoursStart(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetQuietHoursStart(*s)
+	}
+	return uu
+}
+
+// ClearQuietHoursStart clears the value of the "quiet_hours_start" field.
+func (uu *UserUpdate) ClearQuietHoursStart() *UserUpdate {
+	uu.mutation.ClearQuietHoursStart()
+	return uu
+}
+
+// SetQuietHoursEnd sets the "quiet_hours_end" field.
+func (uu *UserUpdate) SetQuietHoursEnd(s string) *UserUpdate {
+	uu.mutation.SetQuietHoursEnd(s)
+	return uu
+}
+
+// SetNillableQuietHoursEnd sets the "quiet_hours_end" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableQuietHoursEnd(s *string) *UserUpdate {
 	if s != nil {
-		uu.SetContactPin(*s)
+		uu.SetQuietHoursEnd(*s)
+	}
+	return uu
+}
+
+// ClearQuietHoursEnd clears the value of the "quiet_hours_end" field.
+func (uu *UserUpdate) ClearQuietHoursEnd() *UserUpdate {
+	uu.mutation.ClearQuietHoursEnd()
+	return uu
+}
+
+// SetRole sets the "role" field.
+func (uu *UserUpdate) SetRole(u user.Role) *UserUpdate {
+	uu.mutation.SetRole(u)
+	return uu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableRole(u *user.Role) *UserUpdate {
+	if u != nil {
+		uu.SetRole(*u)
+	}
+	return uu
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (uu *UserUpdate) SetSuspendedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetSuspendedAt(t)
+	return uu
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableSuspendedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetSuspendedAt(*t)
+	}
+	return uu
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (uu *UserUpdate) ClearSuspendedAt() *UserUpdate {
+	uu.mutation.ClearSuspendedAt()
+	return uu
+}
+
+// SetHideLastSeen sets the "hide_last_seen" field.
+func (uu *UserUpdate) SetHideLastSeen(b bool) *UserUpdate {
+	uu.mutation.SetHideLastSeen(b)
+	return uu
+}
+
+// SetNillableHideLastSeen sets the "hide_last_seen" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableHideLastSeen(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetHideLastSeen(*b)
+	}
+	return uu
+}
+
+// SetDiscoverability sets the "discoverability" field.
+func (uu *UserUpdate) SetDiscoverability(u user.Discoverability) *UserUpdate {
+	uu.mutation.SetDiscoverability(u)
+	return uu
+}
+
+// SetNillableDiscoverability sets the "discoverability" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDiscoverability(u *user.Discoverability) *UserUpdate {
+	if u != nil {
+		uu.SetDiscoverability(*u)
+	}
+	return uu
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (uu *UserUpdate) SetLastSeenAt(t time.Time) *UserUpdate {
+	uu.mutation.SetLastSeenAt(t)
+	return uu
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableLastSeenAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetLastSeenAt(*t)
+	}
+	return uu
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (uu *UserUpdate) ClearLastSeenAt() *UserUpdate {
+	uu.mutation.ClearLastSeenAt()
+	return uu
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (uu *UserUpdate) SetAvatarID(pu pulid.ID) *UserUpdate {
+	uu.mutation.SetAvatarID(pu)
+	return uu
+}
+
+// SetNillableAvatarID sets the "avatar_id" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableAvatarID(pu *pulid.ID) *UserUpdate {
+	if pu != nil {
+		uu.SetAvatarID(*pu)
 	}
 	return uu
 }
 
-// ClearContactPin clears the value of the "contact_pin" field.
-func (uu *UserUpdate) ClearContactPin() *UserUpdate {
-	uu.mutation.ClearContactPin()
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (uu *UserUpdate) ClearAvatarID() *UserUpdate {
+	uu.mutation.ClearAvatarID()
 	return uu
 }
 
@@ -216,6 +404,71 @@ func (uu *UserUpdate) AddMessages(m ...*Message) *UserUpdate {
 	return uu.AddMessageIDs(ids...)
 }
 
+// AddFileIDs adds the "files" edge to the File entity by IDs.
+func (uu *UserUpdate) AddFileIDs(ids ...pulid.ID) *UserUpdate {
+	uu.mutation.AddFileIDs(ids...)
+	return uu
+}
+
+// AddFiles adds the "files" edges to the File entity.
+func (uu *UserUpdate) AddFiles(f ...*File) *UserUpdate {
+	ids := make([]pulid.ID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.AddFileIDs(ids...)
+}
+
+// SetAvatar sets the "avatar" edge to the File entity.
+func (uu *UserUpdate) SetAvatar(f *File) *UserUpdate {
+	return uu.SetAvatarID(f.ID)
+}
+
+// AddAvatarIDs adds the "avatars" edge to the Avatar entity by IDs.
+func (uu *UserUpdate) AddAvatarIDs(ids ...pulid.ID) *UserUpdate {
+	uu.mutation.AddAvatarIDs(ids...)
+	return uu
+}
+
+// AddAvatars adds the "avatars" edges to the Avatar entity.
+func (uu *UserUpdate) AddAvatars(a ...*Avatar) *UserUpdate {
+	ids := make([]pulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.AddAvatarIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the UserBlock entity by IDs.
+func (uu *UserUpdate) AddBlockIDs(ids ...pulid.ID) *UserUpdate {
+	uu.mutation.AddBlockIDs(ids...)
+	return uu
+}
+
+// AddBlocks adds the "blocks" edges to the UserBlock entity.
+func (uu *UserUpdate) AddBlocks(u ...*UserBlock) *UserUpdate {
+	ids := make([]pulid.ID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.AddBlockIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the UserBlock entity by IDs.
+func (uu *UserUpdate) AddBlockedByIDs(ids ...pulid.ID) *UserUpdate {
+	uu.mutation.AddBlockedByIDs(ids...)
+	return uu
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the UserBlock entity.
+func (uu *UserUpdate) AddBlockedBy(u ...*UserBlock) *UserUpdate {
+	ids := make([]pulid.ID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.AddBlockedByIDs(ids...)
+}
+
 // AddUserContactIDs adds the "user_contacts" edge to the UserContact entity by IDs.
 func (uu *UserUpdate) AddUserContactIDs(ids ...pulid.ID) *UserUpdate {
 	uu.mutation.AddUserContactIDs(ids...)
@@ -341,6 +594,96 @@ func (uu *UserUpdate) RemoveMessages(m ...*Message) *UserUpdate {
 	return uu.RemoveMessageIDs(ids...)
 }
 
+// ClearFiles clears all "files" edges to the File entity.
+func (uu *UserUpdate) ClearFiles() *UserUpdate {
+	uu.mutation.ClearFiles()
+	return uu
+}
+
+// RemoveFileIDs removes the "files" edge to File entities by IDs.
+func (uu *UserUpdate) RemoveFileIDs(ids ...pulid.ID) *UserUpdate {
+	uu.mutation.RemoveFileIDs(ids...)
+	return uu
+}
+
+// RemoveFiles removes "files" edges to File entities.
+func (uu *UserUpdate) RemoveFiles(f ...*File) *UserUpdate {
+	ids := make([]pulid.ID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.RemoveFileIDs(ids...)
+}
+
+// ClearAvatar clears the "avatar" edge to the File entity.
+func (uu *UserUpdate) ClearAvatar() *UserUpdate {
+	uu.mutation.ClearAvatar()
+	return uu
+}
+
+// ClearAvatars clears all "avatars" edges to the Avatar entity.
+func (uu *UserUpdate) ClearAvatars() *UserUpdate {
+	uu.mutation.ClearAvatars()
+	return uu
+}
+
+// RemoveAvatarIDs removes the "avatars" edge to Avatar entities by IDs.
+func (uu *UserUpdate) RemoveAvatarIDs(ids ...pulid.ID) *UserUpdate {
+	uu.mutation.RemoveAvatarIDs(ids...)
+	return uu
+}
+
+// RemoveAvatars removes "avatars" edges to Avatar entities.
+func (uu *UserUpdate) RemoveAvatars(a ...*Avatar) *UserUpdate {
+	ids := make([]pulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.RemoveAvatarIDs(ids...)
+}
+
+// ClearBlocks clears all "blocks" edges to the UserBlock entity.
+func (uu *UserUpdate) ClearBlocks() *UserUpdate {
+	uu.mutation.ClearBlocks()
+	return uu
+}
+
+// RemoveBlockIDs removes the "blocks" edge to UserBlock entities by IDs.
+func (uu *UserUpdate) RemoveBlockIDs(ids ...pulid.ID) *UserUpdate {
+	uu.mutation.RemoveBlockIDs(ids...)
+	return uu
+}
+
+// RemoveBlocks removes "blocks" edges to UserBlock entities.
+func (uu *UserUpdate) RemoveBlocks(u ...*UserBlock) *UserUpdate {
+	ids := make([]pulid.ID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.RemoveBlockIDs(ids...)
+}
+
+// ClearBlockedBy clears all "blocked_by" edges to the UserBlock entity.
+func (uu *UserUpdate) ClearBlockedBy() *UserUpdate {
+	uu.mutation.ClearBlockedBy()
+	return uu
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to UserBlock entities by IDs.
+func (uu *UserUpdate) RemoveBlockedByIDs(ids ...pulid.ID) *UserUpdate {
+	uu.mutation.RemoveBlockedByIDs(ids...)
+	return uu
+}
+
+// RemoveBlockedBy removes "blocked_by" edges to UserBlock entities.
+func (uu *UserUpdate) RemoveBlockedBy(u ...*UserBlock) *UserUpdate {
+	ids := make([]pulid.ID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.RemoveBlockedByIDs(ids...)
+}
+
 // ClearUserContacts clears all "user_contacts" edges to the UserContact entity.
 func (uu *UserUpdate) ClearUserContacts() *UserUpdate {
 	uu.mutation.ClearUserContacts()
@@ -419,7 +762,25 @@ func (uu *UserUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (uu *UserUpdate) check() error {
+	if v, ok := uu.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.Discoverability(); ok {
+		if err := user.DiscoverabilityValidator(v); err != nil {
+			return &ValidationError{Name: "discoverability", err: fmt.Errorf(`ent: validator failed for field "User.discoverability": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := uu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeString))
 	if ps := uu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -443,11 +804,53 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.EmailCleared() {
 		_spec.ClearField(user.FieldEmail, field.TypeString)
 	}
-	if value, ok := uu.mutation.ContactPin(); ok {
-		_spec.SetField(user.FieldContactPin, field.TypeString, value)
+	if value, ok := uu.mutation.StorageUsed(); ok {
+		_spec.SetField(user.FieldStorageUsed, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.AddedStorageUsed(); ok {
+		_spec.AddField(user.FieldStorageUsed, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.Locale(); ok {
+		_spec.SetField(user.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := uu.mutation.MessagePreviews(); ok {
+		_spec.SetField(user.FieldMessagePreviews, field.TypeBool, value)
+	}
+	if value, ok := uu.mutation.Timezone(); ok {
+		_spec.SetField(user.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := uu.mutation.QuietHoursStart(); ok {
+		_spec.SetField(user.FieldQuietHoursStart, field.TypeString, value)
+	}
+	if uu.mutation.QuietHoursStartCleared() {
+		_spec.ClearField(user.FieldQuietHoursStart, field.TypeString)
+	}
+	if value, ok := uu.mutation.QuietHoursEnd(); ok {
+		_spec.SetField(user.FieldQuietHoursEnd, field.TypeString, value)
+	}
+	if uu.mutation.QuietHoursEndCleared() {
+		_spec.ClearField(user.FieldQuietHoursEnd, field.TypeString)
+	}
+	if value, ok := uu.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := uu.mutation.SuspendedAt(); ok {
+		_spec.SetField(user.FieldSuspendedAt, field.TypeTime, value)
+	}
+	if uu.mutation.SuspendedAtCleared() {
+		_spec.ClearField(user.FieldSuspendedAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.HideLastSeen(); ok {
+		_spec.SetField(user.FieldHideLastSeen, field.TypeBool, value)
+	}
+	if value, ok := uu.mutation.Discoverability(); ok {
+		_spec.SetField(user.FieldDiscoverability, field.TypeEnum, value)
 	}
-	if uu.mutation.ContactPinCleared() {
-		_spec.ClearField(user.FieldContactPin, field.TypeString)
+	if value, ok := uu.mutation.LastSeenAt(); ok {
+		_spec.SetField(user.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if uu.mutation.LastSeenAtCleared() {
+		_spec.ClearField(user.FieldLastSeenAt, field.TypeTime)
 	}
 	if value, ok := uu.mutation.Password(); ok {
 		_spec.SetField(user.FieldPassword, field.TypeString, value)
@@ -706,28 +1109,28 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uu.mutation.UserContactsCleared() {
+	if uu.mutation.FilesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.UserContactsTable,
-			Columns: []string{user.UserContactsColumn},
+			Inverse: false,
+			Table:   user.FilesTable,
+			Columns: []string{user.FilesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(usercontact.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.RemovedUserContactsIDs(); len(nodes) > 0 && !uu.mutation.UserContactsCleared() {
+	if nodes := uu.mutation.RemovedFilesIDs(); len(nodes) > 0 && !uu.mutation.FilesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.UserContactsTable,
-			Columns: []string{user.UserContactsColumn},
+			Inverse: false,
+			Table:   user.FilesTable,
+			Columns: []string{user.FilesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(usercontact.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -735,15 +1138,15 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.UserContactsIDs(); len(nodes) > 0 {
+	if nodes := uu.mutation.FilesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.UserContactsTable,
-			Columns: []string{user.UserContactsColumn},
+			Inverse: false,
+			Table:   user.FilesTable,
+			Columns: []string{user.FilesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(usercontact.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -751,28 +1154,57 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uu.mutation.MembershipsCleared() {
+	if uu.mutation.AvatarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   user.AvatarTable,
+			Columns: []string{user.AvatarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.AvatarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   user.AvatarTable,
+			Columns: []string{user.AvatarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.AvatarsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.MembershipsTable,
-			Columns: []string{user.MembershipsColumn},
+			Inverse: false,
+			Table:   user.AvatarsTable,
+			Columns: []string{user.AvatarsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(roommember.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !uu.mutation.MembershipsCleared() {
+	if nodes := uu.mutation.RemovedAvatarsIDs(); len(nodes) > 0 && !uu.mutation.AvatarsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.MembershipsTable,
-			Columns: []string{user.MembershipsColumn},
+			Inverse: false,
+			Table:   user.AvatarsTable,
+			Columns: []string{user.AvatarsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(roommember.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -780,15 +1212,15 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.MembershipsIDs(); len(nodes) > 0 {
+	if nodes := uu.mutation.AvatarsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.MembershipsTable,
-			Columns: []string{user.MembershipsColumn},
+			Inverse: false,
+			Table:   user.AvatarsTable,
+			Columns: []string{user.AvatarsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(roommember.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -796,14 +1228,194 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
-	}
+	if uu.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BlocksTable,
+			Columns: []string{user.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedBlocksIDs(); len(nodes) > 0 && !uu.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BlocksTable,
+			Columns: []string{user.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.BlocksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BlocksTable,
+			Columns: []string{user.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !uu.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.UserContactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.UserContactsTable,
+			Columns: []string{user.UserContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usercontact.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedUserContactsIDs(); len(nodes) > 0 && !uu.mutation.UserContactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.UserContactsTable,
+			Columns: []string{user.UserContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usercontact.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.UserContactsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.UserContactsTable,
+			Columns: []string{user.UserContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usercontact.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roommember.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !uu.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roommember.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roommember.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
 	uu.mutation.done = true
 	return n, nil
 }
@@ -878,23 +1490,208 @@ func (uuo *UserUpdateOne) ClearEmail() *UserUpdateOne {
 	return uuo
 }
 
-// SetContactPin sets the "contact_pin" field.
-func (uuo *UserUpdateOne) SetContactPin(s string) *UserUpdateOne {
-	uuo.mutation.SetContactPin(s)
+// SetStorageUsed sets the "storage_used" field.
+func (uuo *UserUpdateOne) SetStorageUsed(i int64) *UserUpdateOne {
+	uuo.mutation.ResetStorageUsed()
+	uuo.mutation.SetStorageUsed(i)
+	return uuo
+}
+
+// SetNillableStorageUsed sets the "storage_used" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableStorageUsed(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetStorageUsed(*i)
+	}
+	return uuo
+}
+
+// AddStorageUsed adds i to the "storage_used" field.
+func (uuo *UserUpdateOne) AddStorageUsed(i int64) *UserUpdateOne {
+	uuo.mutation.AddStorageUsed(i)
+	return uuo
+}
+
+// SetLocale sets the "locale" field.
+func (uuo *UserUpdateOne) SetLocale(s string) *UserUpdateOne {
+	uuo.mutation.SetLocale(s)
+	return uuo
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLocale(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetLocale(*s)
+	}
+	return uuo
+}
+
+// SetMessagePreviews sets the "message_previews" field.
+func (uuo *UserUpdateOne) SetMessagePreviews(b bool) *UserUpdateOne {
+	uuo.mutation.SetMessagePreviews(b)
+	return uuo
+}
+
+// SetNillableMessagePreviews sets the "message_previews" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableMessagePreviews(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetMessagePreviews(*b)
+	}
+	return uuo
+}
+
+// SetTimezone sets the "timezone" field.
+func (uuo *UserUpdateOne) SetTimezone(s string) *UserUpdateOne {
+	uuo.mutation.SetTimezone(s)
 	return uuo
 }
 
-// SetNillableContactPin sets the "contact_pin" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableContactPin(s *string) *UserUpdateOne {
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTimezone(s *string) *UserUpdateOne {
 	if s != nil {
-		uuo.SetContactPin(*s)
+		uuo.SetTimezone(*s)
+	}
+	return uuo
+}
+
+// SetQuietHoursStart sets the "quiet_hours_start" field.
+func (uuo *UserUpdateOne) SetQuietHoursStart(s string) *UserUpdateOne {
+	uuo.mutation.SetQuietHoursStart(s)
+	return uuo
+}
+
+// SetNillableQuietHoursStart sets the "quiet_hours_start" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableQuietHoursStart(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetQuietHoursStart(*s)
+	}
+	return uuo
+}
+
+// ClearQuietHoursStart clears the value of the "quiet_hours_start" field.
+func (uuo *UserUpdateOne) ClearQuietHoursStart() *UserUpdateOne {
+	uuo.mutation.ClearQuietHoursStart()
+	return uuo
+}
+
+// SetQuietHoursEnd sets the "quiet_hours_end" field.
+func (uuo *UserUpdateOne) SetQuietHoursEnd(s string) *UserUpdateOne {
+	uuo.mutation.SetQuietHoursEnd(s)
+	return uuo
+}
+
+// SetNillableQuietHoursEnd sets the "quiet_hours_end" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableQuietHoursEnd(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetQuietHoursEnd(*s)
+	}
+	return uuo
+}
+
+// ClearQuietHoursEnd clears the value of the "quiet_hours_end" field.
+func (uuo *UserUpdateOne) ClearQuietHoursEnd() *UserUpdateOne {
+	uuo.mutation.ClearQuietHoursEnd()
+	return uuo
+}
+
+// SetRole sets the "role" field.
+func (uuo *UserUpdateOne) SetRole(u user.Role) *UserUpdateOne {
+	uuo.mutation.SetRole(u)
+	return uuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableRole(u *user.Role) *UserUpdateOne {
+	if u != nil {
+		uuo.SetRole(*u)
+	}
+	return uuo
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (uuo *UserUpdateOne) SetSuspendedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetSuspendedAt(t)
+	return uuo
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSuspendedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetSuspendedAt(*t)
+	}
+	return uuo
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (uuo *UserUpdateOne) ClearSuspendedAt() *UserUpdateOne {
+	uuo.mutation.ClearSuspendedAt()
+	return uuo
+}
+
+// SetHideLastSeen sets the "hide_last_seen" field.
+func (uuo *UserUpdateOne) SetHideLastSeen(b bool) *UserUpdateOne {
+	uuo.mutation.SetHideLastSeen(b)
+	return uuo
+}
+
+// SetNillableHideLastSeen sets the "hide_last_seen" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableHideLastSeen(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetHideLastSeen(*b)
+	}
+	return uuo
+}
+
+// SetDiscoverability sets the "discoverability" field.
+func (uuo *UserUpdateOne) SetDiscoverability(u user.Discoverability) *UserUpdateOne {
+	uuo.mutation.SetDiscoverability(u)
+	return uuo
+}
+
+// SetNillableDiscoverability sets the "discoverability" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDiscoverability(u *user.Discoverability) *UserUpdateOne {
+	if u != nil {
+		uuo.SetDiscoverability(*u)
+	}
+	return uuo
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (uuo *UserUpdateOne) SetLastSeenAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetLastSeenAt(t)
+	return uuo
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLastSeenAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetLastSeenAt(*t)
+	}
+	return uuo
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (uuo *UserUpdateOne) ClearLastSeenAt() *UserUpdateOne {
+	uuo.mutation.ClearLastSeenAt()
+	return uuo
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (uuo *UserUpdateOne) SetAvatarID(pu pulid.ID) *UserUpdateOne {
+	uuo.mutation.SetAvatarID(pu)
+	return uuo
+}
+
+// SetNillableAvatarID sets the "avatar_id" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAvatarID(pu *pulid.ID) *UserUpdateOne {
+	if pu != nil {
+		uuo.SetAvatarID(*pu)
 	}
 	return uuo
 }
 
-// ClearContactPin clears the value of the "contact_pin" field.
-func (uuo *UserUpdateOne) ClearContactPin() *UserUpdateOne {
-	uuo.mutation.ClearContactPin()
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (uuo *UserUpdateOne) ClearAvatarID() *UserUpdateOne {
+	uuo.mutation.ClearAvatarID()
 	return uuo
 }
 
@@ -997,6 +1794,71 @@ func (uuo *UserUpdateOne) AddMessages(m ...*Message) *UserUpdateOne {
 	return uuo.AddMessageIDs(ids...)
 }
 
+// AddFileIDs adds the "files" edge to the File entity by IDs.
+func (uuo *UserUpdateOne) AddFileIDs(ids ...pulid.ID) *UserUpdateOne {
+	uuo.mutation.AddFileIDs(ids...)
+	return uuo
+}
+
+// AddFiles adds the "files" edges to the File entity.
+func (uuo *UserUpdateOne) AddFiles(f ...*File) *UserUpdateOne {
+	ids := make([]pulid.ID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.AddFileIDs(ids...)
+}
+
+// SetAvatar sets the "avatar" edge to the File entity.
+func (uuo *UserUpdateOne) SetAvatar(f *File) *UserUpdateOne {
+	return uuo.SetAvatarID(f.ID)
+}
+
+// AddAvatarIDs adds the "avatars" edge to the Avatar entity by IDs.
+func (uuo *UserUpdateOne) AddAvatarIDs(ids ...pulid.ID) *UserUpdateOne {
+	uuo.mutation.AddAvatarIDs(ids...)
+	return uuo
+}
+
+// AddAvatars adds the "avatars" edges to the Avatar entity.
+func (uuo *UserUpdateOne) AddAvatars(a ...*Avatar) *UserUpdateOne {
+	ids := make([]pulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.AddAvatarIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the UserBlock entity by IDs.
+func (uuo *UserUpdateOne) AddBlockIDs(ids ...pulid.ID) *UserUpdateOne {
+	uuo.mutation.AddBlockIDs(ids...)
+	return uuo
+}
+
+// AddBlocks adds the "blocks" edges to the UserBlock entity.
+func (uuo *UserUpdateOne) AddBlocks(u ...*UserBlock) *UserUpdateOne {
+	ids := make([]pulid.ID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.AddBlockIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the UserBlock entity by IDs.
+func (uuo *UserUpdateOne) AddBlockedByIDs(ids ...pulid.ID) *UserUpdateOne {
+	uuo.mutation.AddBlockedByIDs(ids...)
+	return uuo
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the UserBlock entity.
+func (uuo *UserUpdateOne) AddBlockedBy(u ...*UserBlock) *UserUpdateOne {
+	ids := make([]pulid.ID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.AddBlockedByIDs(ids...)
+}
+
 // AddUserContactIDs adds the "user_contacts" edge to the UserContact entity by IDs.
 func (uuo *UserUpdateOne) AddUserContactIDs(ids ...pulid.ID) *UserUpdateOne {
 	uuo.mutation.AddUserContactIDs(ids...)
@@ -1122,6 +1984,96 @@ func (uuo *UserUpdateOne) RemoveMessages(m ...*Message) *UserUpdateOne {
 	return uuo.RemoveMessageIDs(ids...)
 }
 
+// ClearFiles clears all "files" edges to the File entity.
+func (uuo *UserUpdateOne) ClearFiles() *UserUpdateOne {
+	uuo.mutation.ClearFiles()
+	return uuo
+}
+
+// RemoveFileIDs removes the "files" edge to File entities by IDs.
+func (uuo *UserUpdateOne) RemoveFileIDs(ids ...pulid.ID) *UserUpdateOne {
+	uuo.mutation.RemoveFileIDs(ids...)
+	return uuo
+}
+
+// RemoveFiles removes "files" edges to File entities.
+func (uuo *UserUpdateOne) RemoveFiles(f ...*File) *UserUpdateOne {
+	ids := make([]pulid.ID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.RemoveFileIDs(ids...)
+}
+
+// ClearAvatar clears the "avatar" edge to the File entity.
+func (uuo *UserUpdateOne) ClearAvatar() *UserUpdateOne {
+	uuo.mutation.ClearAvatar()
+	return uuo
+}
+
+// ClearAvatars clears all "avatars" edges to the Avatar entity.
+func (uuo *UserUpdateOne) ClearAvatars() *UserUpdateOne {
+	uuo.mutation.ClearAvatars()
+	return uuo
+}
+
+// RemoveAvatarIDs removes the "avatars" edge to Avatar entities by IDs.
+func (uuo *UserUpdateOne) RemoveAvatarIDs(ids ...pulid.ID) *UserUpdateOne {
+	uuo.mutation.RemoveAvatarIDs(ids...)
+	return uuo
+}
+
+// RemoveAvatars removes "avatars" edges to Avatar entities.
+func (uuo *UserUpdateOne) RemoveAvatars(a ...*Avatar) *UserUpdateOne {
+	ids := make([]pulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.RemoveAvatarIDs(ids...)
+}
+
+// ClearBlocks clears all "blocks" edges to the UserBlock entity.
+func (uuo *UserUpdateOne) ClearBlocks() *UserUpdateOne {
+	uuo.mutation.ClearBlocks()
+	return uuo
+}
+
+// RemoveBlockIDs removes the "blocks" edge to UserBlock entities by IDs.
+func (uuo *UserUpdateOne) RemoveBlockIDs(ids ...pulid.ID) *UserUpdateOne {
+	uuo.mutation.RemoveBlockIDs(ids...)
+	return uuo
+}
+
+// RemoveBlocks removes "blocks" edges to UserBlock entities.
+func (uuo *UserUpdateOne) RemoveBlocks(u ...*UserBlock) *UserUpdateOne {
+	ids := make([]pulid.ID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.RemoveBlockIDs(ids...)
+}
+
+// ClearBlockedBy clears all "blocked_by" edges to the UserBlock entity.
+func (uuo *UserUpdateOne) ClearBlockedBy() *UserUpdateOne {
+	uuo.mutation.ClearBlockedBy()
+	return uuo
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to UserBlock entities by IDs.
+func (uuo *UserUpdateOne) RemoveBlockedByIDs(ids ...pulid.ID) *UserUpdateOne {
+	uuo.mutation.RemoveBlockedByIDs(ids...)
+	return uuo
+}
+
+// RemoveBlockedBy removes "blocked_by" edges to UserBlock entities.
+func (uuo *UserUpdateOne) RemoveBlockedBy(u ...*UserBlock) *UserUpdateOne {
+	ids := make([]pulid.ID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.RemoveBlockedByIDs(ids...)
+}
+
 // ClearUserContacts clears all "user_contacts" edges to the UserContact entity.
 func (uuo *UserUpdateOne) ClearUserContacts() *UserUpdateOne {
 	uuo.mutation.ClearUserContacts()
@@ -1213,7 +2165,25 @@ func (uuo *UserUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UserUpdateOne) check() error {
+	if v, ok := uuo.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Discoverability(); ok {
+		if err := user.DiscoverabilityValidator(v); err != nil {
+			return &ValidationError{Name: "discoverability", err: fmt.Errorf(`ent: validator failed for field "User.discoverability": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	if err := uuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeString))
 	id, ok := uuo.mutation.ID()
 	if !ok {
@@ -1254,11 +2224,53 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if uuo.mutation.EmailCleared() {
 		_spec.ClearField(user.FieldEmail, field.TypeString)
 	}
-	if value, ok := uuo.mutation.ContactPin(); ok {
-		_spec.SetField(user.FieldContactPin, field.TypeString, value)
+	if value, ok := uuo.mutation.StorageUsed(); ok {
+		_spec.SetField(user.FieldStorageUsed, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedStorageUsed(); ok {
+		_spec.AddField(user.FieldStorageUsed, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.Locale(); ok {
+		_spec.SetField(user.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.MessagePreviews(); ok {
+		_spec.SetField(user.FieldMessagePreviews, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.Timezone(); ok {
+		_spec.SetField(user.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.QuietHoursStart(); ok {
+		_spec.SetField(user.FieldQuietHoursStart, field.TypeString, value)
+	}
+	if uuo.mutation.QuietHoursStartCleared() {
+		_spec.ClearField(user.FieldQuietHoursStart, field.TypeString)
+	}
+	if value, ok := uuo.mutation.QuietHoursEnd(); ok {
+		_spec.SetField(user.FieldQuietHoursEnd, field.TypeString, value)
 	}
-	if uuo.mutation.ContactPinCleared() {
-		_spec.ClearField(user.FieldContactPin, field.TypeString)
+	if uuo.mutation.QuietHoursEndCleared() {
+		_spec.ClearField(user.FieldQuietHoursEnd, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := uuo.mutation.SuspendedAt(); ok {
+		_spec.SetField(user.FieldSuspendedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.SuspendedAtCleared() {
+		_spec.ClearField(user.FieldSuspendedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.HideLastSeen(); ok {
+		_spec.SetField(user.FieldHideLastSeen, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.Discoverability(); ok {
+		_spec.SetField(user.FieldDiscoverability, field.TypeEnum, value)
+	}
+	if value, ok := uuo.mutation.LastSeenAt(); ok {
+		_spec.SetField(user.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if uuo.mutation.LastSeenAtCleared() {
+		_spec.ClearField(user.FieldLastSeenAt, field.TypeTime)
 	}
 	if value, ok := uuo.mutation.Password(); ok {
 		_spec.SetField(user.FieldPassword, field.TypeString, value)
@@ -1517,6 +2529,215 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FilesTable,
+			Columns: []string{user.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedFilesIDs(); len(nodes) > 0 && !uuo.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FilesTable,
+			Columns: []string{user.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FilesTable,
+			Columns: []string{user.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.AvatarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   user.AvatarTable,
+			Columns: []string{user.AvatarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.AvatarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   user.AvatarTable,
+			Columns: []string{user.AvatarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.AvatarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AvatarsTable,
+			Columns: []string{user.AvatarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedAvatarsIDs(); len(nodes) > 0 && !uuo.mutation.AvatarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AvatarsTable,
+			Columns: []string{user.AvatarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.AvatarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AvatarsTable,
+			Columns: []string{user.AvatarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(avatar.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BlocksTable,
+			Columns: []string{user.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedBlocksIDs(); len(nodes) > 0 && !uuo.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BlocksTable,
+			Columns: []string{user.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.BlocksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BlocksTable,
+			Columns: []string{user.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !uuo.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if uuo.mutation.UserContactsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
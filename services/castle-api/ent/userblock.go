@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"journeyhub/ent/userblock"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// UserBlock is the model entity for the UserBlock schema.
+type UserBlock struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID pulid.ID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID pulid.ID `json:"user_id,omitempty"`
+	// BlockedUserID holds the value of the "blocked_user_id" field.
+	BlockedUserID pulid.ID `json:"blocked_user_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserBlockQuery when eager-loading is set.
+	Edges        UserBlockEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UserBlockEdges holds the relations/edges for other nodes in the graph.
+type UserBlockEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// BlockedUser holds the value of the blocked_user edge.
+	BlockedUser *User `json:"blocked_user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserBlockEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// BlockedUserOrErr returns the BlockedUser value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserBlockEdges) BlockedUserOrErr() (*User, error) {
+	if e.BlockedUser != nil {
+		return e.BlockedUser, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "blocked_user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserBlock) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userblock.FieldID, userblock.FieldUserID, userblock.FieldBlockedUserID:
+			values[i] = new(pulid.ID)
+		case userblock.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserBlock fields.
+func (ub *UserBlock) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userblock.FieldID:
+			if value, ok := values[i].(*pulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ub.ID = *value
+			}
+		case userblock.FieldUserID:
+			if value, ok := values[i].(*pulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				ub.UserID = *value
+			}
+		case userblock.FieldBlockedUserID:
+			if value, ok := values[i].(*pulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field blocked_user_id", values[i])
+			} else if value != nil {
+				ub.BlockedUserID = *value
+			}
+		case userblock.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ub.CreatedAt = value.Time
+			}
+		default:
+			ub.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserBlock.
+// This includes values selected through modifiers, order, etc.
+func (ub *UserBlock) Value(name string) (ent.Value, error) {
+	return ub.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the UserBlock entity.
+func (ub *UserBlock) QueryUser() *UserQuery {
+	return NewUserBlockClient(ub.config).QueryUser(ub)
+}
+
+// QueryBlockedUser queries the "blocked_user" edge of the UserBlock entity.
+func (ub *UserBlock) QueryBlockedUser() *UserQuery {
+	return NewUserBlockClient(ub.config).QueryBlockedUser(ub)
+}
+
+// Update returns a builder for updating this UserBlock.
+// Note that you need to call UserBlock.Unwrap() before calling this method if this UserBlock
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ub *UserBlock) Update() *UserBlockUpdateOne {
+	return NewUserBlockClient(ub.config).UpdateOne(ub)
+}
+
+// Unwrap unwraps the UserBlock entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ub *UserBlock) Unwrap() *UserBlock {
+	_tx, ok := ub.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserBlock is not a transactional entity")
+	}
+	ub.config.driver = _tx.drv
+	return ub
+}
+
+// String implements the fmt.Stringer.
+func (ub *UserBlock) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserBlock(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ub.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ub.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("blocked_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ub.BlockedUserID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ub.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserBlocks is a parsable slice of UserBlock.
+type UserBlocks []*UserBlock
@@ -0,0 +1,120 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userblock
+
+import (
+	"journeyhub/ent/schema/pulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the userblock type in the database.
+	Label = "user_block"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldBlockedUserID holds the string denoting the blocked_user_id field in the database.
+	FieldBlockedUserID = "blocked_user_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeBlockedUser holds the string denoting the blocked_user edge name in mutations.
+	EdgeBlockedUser = "blocked_user"
+	// Table holds the table name of the userblock in the database.
+	Table = "user_blocks"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "user_blocks"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+	// BlockedUserTable is the table that holds the blocked_user relation/edge.
+	BlockedUserTable = "user_blocks"
+	// BlockedUserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	BlockedUserInverseTable = "users"
+	// BlockedUserColumn is the table column denoting the blocked_user relation/edge.
+	BlockedUserColumn = "blocked_user_id"
+)
+
+// Columns holds all SQL columns for userblock fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldBlockedUserID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() pulid.ID
+)
+
+// OrderOption defines the ordering options for the UserBlock queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByBlockedUserID orders the results by the blocked_user_id field.
+func ByBlockedUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockedUserID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBlockedUserField orders the results by blocked_user field.
+func ByBlockedUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockedUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+	)
+}
+func newBlockedUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlockedUserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, BlockedUserTable, BlockedUserColumn),
+	)
+}
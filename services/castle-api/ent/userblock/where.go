@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userblock
+
+import (
+	"journeyhub/ent/predicate"
+	"journeyhub/ent/schema/pulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldEQ(FieldUserID, v))
+}
+
+// BlockedUserID applies equality check predicate on the "blocked_user_id" field. It's identical to BlockedUserIDEQ.
+func BlockedUserID(v pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldEQ(FieldBlockedUserID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v pulid.ID) predicate.UserBlock {
+	vc := string(v)
+	return predicate.UserBlock(sql.FieldContains(FieldUserID, vc))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v pulid.ID) predicate.UserBlock {
+	vc := string(v)
+	return predicate.UserBlock(sql.FieldHasPrefix(FieldUserID, vc))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v pulid.ID) predicate.UserBlock {
+	vc := string(v)
+	return predicate.UserBlock(sql.FieldHasSuffix(FieldUserID, vc))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v pulid.ID) predicate.UserBlock {
+	vc := string(v)
+	return predicate.UserBlock(sql.FieldEqualFold(FieldUserID, vc))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v pulid.ID) predicate.UserBlock {
+	vc := string(v)
+	return predicate.UserBlock(sql.FieldContainsFold(FieldUserID, vc))
+}
+
+// BlockedUserIDEQ applies the EQ predicate on the "blocked_user_id" field.
+func BlockedUserIDEQ(v pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldEQ(FieldBlockedUserID, v))
+}
+
+// BlockedUserIDNEQ applies the NEQ predicate on the "blocked_user_id" field.
+func BlockedUserIDNEQ(v pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldNEQ(FieldBlockedUserID, v))
+}
+
+// BlockedUserIDIn applies the In predicate on the "blocked_user_id" field.
+func BlockedUserIDIn(vs ...pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldIn(FieldBlockedUserID, vs...))
+}
+
+// BlockedUserIDNotIn applies the NotIn predicate on the "blocked_user_id" field.
+func BlockedUserIDNotIn(vs ...pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldNotIn(FieldBlockedUserID, vs...))
+}
+
+// BlockedUserIDGT applies the GT predicate on the "blocked_user_id" field.
+func BlockedUserIDGT(v pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldGT(FieldBlockedUserID, v))
+}
+
+// BlockedUserIDGTE applies the GTE predicate on the "blocked_user_id" field.
+func BlockedUserIDGTE(v pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldGTE(FieldBlockedUserID, v))
+}
+
+// BlockedUserIDLT applies the LT predicate on the "blocked_user_id" field.
+func BlockedUserIDLT(v pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldLT(FieldBlockedUserID, v))
+}
+
+// BlockedUserIDLTE applies the LTE predicate on the "blocked_user_id" field.
+func BlockedUserIDLTE(v pulid.ID) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldLTE(FieldBlockedUserID, v))
+}
+
+// BlockedUserIDContains applies the Contains predicate on the "blocked_user_id" field.
+func BlockedUserIDContains(v pulid.ID) predicate.UserBlock {
+	vc := string(v)
+	return predicate.UserBlock(sql.FieldContains(FieldBlockedUserID, vc))
+}
+
+// BlockedUserIDHasPrefix applies the HasPrefix predicate on the "blocked_user_id" field.
+func BlockedUserIDHasPrefix(v pulid.ID) predicate.UserBlock {
+	vc := string(v)
+	return predicate.UserBlock(sql.FieldHasPrefix(FieldBlockedUserID, vc))
+}
+
+// BlockedUserIDHasSuffix applies the HasSuffix predicate on the "blocked_user_id" field.
+func BlockedUserIDHasSuffix(v pulid.ID) predicate.UserBlock {
+	vc := string(v)
+	return predicate.UserBlock(sql.FieldHasSuffix(FieldBlockedUserID, vc))
+}
+
+// BlockedUserIDEqualFold applies the EqualFold predicate on the "blocked_user_id" field.
+func BlockedUserIDEqualFold(v pulid.ID) predicate.UserBlock {
+	vc := string(v)
+	return predicate.UserBlock(sql.FieldEqualFold(FieldBlockedUserID, vc))
+}
+
+// BlockedUserIDContainsFold applies the ContainsFold predicate on the "blocked_user_id" field.
+func BlockedUserIDContainsFold(v pulid.ID) predicate.UserBlock {
+	vc := string(v)
+	return predicate.UserBlock(sql.FieldContainsFold(FieldBlockedUserID, vc))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserBlock {
+	return predicate.UserBlock(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.UserBlock {
+	return predicate.UserBlock(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.UserBlock {
+	return predicate.UserBlock(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlockedUser applies the HasEdge predicate on the "blocked_user" edge.
+func HasBlockedUser() predicate.UserBlock {
+	return predicate.UserBlock(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, BlockedUserTable, BlockedUserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockedUserWith applies the HasEdge predicate on the "blocked_user" edge with a given conditions (other predicates).
+func HasBlockedUserWith(preds ...predicate.User) predicate.UserBlock {
+	return predicate.UserBlock(func(s *sql.Selector) {
+		step := newBlockedUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserBlock) predicate.UserBlock {
+	return predicate.UserBlock(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserBlock) predicate.UserBlock {
+	return predicate.UserBlock(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserBlock) predicate.UserBlock {
+	return predicate.UserBlock(sql.NotPredicates(p))
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"journeyhub/ent/predicate"
+	"journeyhub/ent/userblock"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserBlockDelete is the builder for deleting a UserBlock entity.
+type UserBlockDelete struct {
+	config
+	hooks    []Hook
+	mutation *UserBlockMutation
+}
+
+// Where appends a list predicates to the UserBlockDelete builder.
+func (ubd *UserBlockDelete) Where(ps ...predicate.UserBlock) *UserBlockDelete {
+	ubd.mutation.Where(ps...)
+	return ubd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ubd *UserBlockDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ubd.sqlExec, ubd.mutation, ubd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ubd *UserBlockDelete) ExecX(ctx context.Context) int {
+	n, err := ubd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ubd *UserBlockDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(userblock.Table, sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString))
+	if ps := ubd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ubd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ubd.mutation.done = true
+	return affected, err
+}
+
+// UserBlockDeleteOne is the builder for deleting a single UserBlock entity.
+type UserBlockDeleteOne struct {
+	ubd *UserBlockDelete
+}
+
+// Where appends a list predicates to the UserBlockDelete builder.
+func (ubdo *UserBlockDeleteOne) Where(ps ...predicate.UserBlock) *UserBlockDeleteOne {
+	ubdo.ubd.mutation.Where(ps...)
+	return ubdo
+}
+
+// Exec executes the deletion query.
+func (ubdo *UserBlockDeleteOne) Exec(ctx context.Context) error {
+	n, err := ubdo.ubd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{userblock.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ubdo *UserBlockDeleteOne) ExecX(ctx context.Context) {
+	if err := ubdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
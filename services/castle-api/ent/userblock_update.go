@@ -0,0 +1,421 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journeyhub/ent/predicate"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"journeyhub/ent/userblock"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserBlockUpdate is the builder for updating UserBlock entities.
+type UserBlockUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserBlockMutation
+}
+
+// Where appends a list predicates to the UserBlockUpdate builder.
+func (ubu *UserBlockUpdate) Where(ps ...predicate.UserBlock) *UserBlockUpdate {
+	ubu.mutation.Where(ps...)
+	return ubu
+}
+
+// SetUserID sets the "user_id" field.
+func (ubu *UserBlockUpdate) SetUserID(pu pulid.ID) *UserBlockUpdate {
+	ubu.mutation.SetUserID(pu)
+	return ubu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ubu *UserBlockUpdate) SetNillableUserID(pu *pulid.ID) *UserBlockUpdate {
+	if pu != nil {
+		ubu.SetUserID(*pu)
+	}
+	return ubu
+}
+
+// SetBlockedUserID sets the "blocked_user_id" field.
+func (ubu *UserBlockUpdate) SetBlockedUserID(pu pulid.ID) *UserBlockUpdate {
+	ubu.mutation.SetBlockedUserID(pu)
+	return ubu
+}
+
+// SetNillableBlockedUserID sets the "blocked_user_id" field if the given value is not nil.
+func (ubu *UserBlockUpdate) SetNillableBlockedUserID(pu *pulid.ID) *UserBlockUpdate {
+	if pu != nil {
+		ubu.SetBlockedUserID(*pu)
+	}
+	return ubu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ubu *UserBlockUpdate) SetUser(u *User) *UserBlockUpdate {
+	return ubu.SetUserID(u.ID)
+}
+
+// SetBlockedUser sets the "blocked_user" edge to the User entity.
+func (ubu *UserBlockUpdate) SetBlockedUser(u *User) *UserBlockUpdate {
+	return ubu.SetBlockedUserID(u.ID)
+}
+
+// Mutation returns the UserBlockMutation object of the builder.
+func (ubu *UserBlockUpdate) Mutation() *UserBlockMutation {
+	return ubu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ubu *UserBlockUpdate) ClearUser() *UserBlockUpdate {
+	ubu.mutation.ClearUser()
+	return ubu
+}
+
+// ClearBlockedUser clears the "blocked_user" edge to the User entity.
+func (ubu *UserBlockUpdate) ClearBlockedUser() *UserBlockUpdate {
+	ubu.mutation.ClearBlockedUser()
+	return ubu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ubu *UserBlockUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ubu.sqlSave, ubu.mutation, ubu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ubu *UserBlockUpdate) SaveX(ctx context.Context) int {
+	affected, err := ubu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ubu *UserBlockUpdate) Exec(ctx context.Context) error {
+	_, err := ubu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ubu *UserBlockUpdate) ExecX(ctx context.Context) {
+	if err := ubu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ubu *UserBlockUpdate) check() error {
+	if ubu.mutation.UserCleared() && len(ubu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserBlock.user"`)
+	}
+	if ubu.mutation.BlockedUserCleared() && len(ubu.mutation.BlockedUserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserBlock.blocked_user"`)
+	}
+	return nil
+}
+
+func (ubu *UserBlockUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ubu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userblock.Table, userblock.Columns, sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString))
+	if ps := ubu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ubu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   userblock.UserTable,
+			Columns: []string{userblock.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ubu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   userblock.UserTable,
+			Columns: []string{userblock.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ubu.mutation.BlockedUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   userblock.BlockedUserTable,
+			Columns: []string{userblock.BlockedUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ubu.mutation.BlockedUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   userblock.BlockedUserTable,
+			Columns: []string{userblock.BlockedUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ubu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userblock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ubu.mutation.done = true
+	return n, nil
+}
+
+// UserBlockUpdateOne is the builder for updating a single UserBlock entity.
+type UserBlockUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserBlockMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (ubuo *UserBlockUpdateOne) SetUserID(pu pulid.ID) *UserBlockUpdateOne {
+	ubuo.mutation.SetUserID(pu)
+	return ubuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ubuo *UserBlockUpdateOne) SetNillableUserID(pu *pulid.ID) *UserBlockUpdateOne {
+	if pu != nil {
+		ubuo.SetUserID(*pu)
+	}
+	return ubuo
+}
+
+// SetBlockedUserID sets the "blocked_user_id" field.
+func (ubuo *UserBlockUpdateOne) SetBlockedUserID(pu pulid.ID) *UserBlockUpdateOne {
+	ubuo.mutation.SetBlockedUserID(pu)
+	return ubuo
+}
+
+// SetNillableBlockedUserID sets the "blocked_user_id" field if the given value is not nil.
+func (ubuo *UserBlockUpdateOne) SetNillableBlockedUserID(pu *pulid.ID) *UserBlockUpdateOne {
+	if pu != nil {
+		ubuo.SetBlockedUserID(*pu)
+	}
+	return ubuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ubuo *UserBlockUpdateOne) SetUser(u *User) *UserBlockUpdateOne {
+	return ubuo.SetUserID(u.ID)
+}
+
+// SetBlockedUser sets the "blocked_user" edge to the User entity.
+func (ubuo *UserBlockUpdateOne) SetBlockedUser(u *User) *UserBlockUpdateOne {
+	return ubuo.SetBlockedUserID(u.ID)
+}
+
+// Mutation returns the UserBlockMutation object of the builder.
+func (ubuo *UserBlockUpdateOne) Mutation() *UserBlockMutation {
+	return ubuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ubuo *UserBlockUpdateOne) ClearUser() *UserBlockUpdateOne {
+	ubuo.mutation.ClearUser()
+	return ubuo
+}
+
+// ClearBlockedUser clears the "blocked_user" edge to the User entity.
+func (ubuo *UserBlockUpdateOne) ClearBlockedUser() *UserBlockUpdateOne {
+	ubuo.mutation.ClearBlockedUser()
+	return ubuo
+}
+
+// Where appends a list predicates to the UserBlockUpdate builder.
+func (ubuo *UserBlockUpdateOne) Where(ps ...predicate.UserBlock) *UserBlockUpdateOne {
+	ubuo.mutation.Where(ps...)
+	return ubuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ubuo *UserBlockUpdateOne) Select(field string, fields ...string) *UserBlockUpdateOne {
+	ubuo.fields = append([]string{field}, fields...)
+	return ubuo
+}
+
+// Save executes the query and returns the updated UserBlock entity.
+func (ubuo *UserBlockUpdateOne) Save(ctx context.Context) (*UserBlock, error) {
+	return withHooks(ctx, ubuo.sqlSave, ubuo.mutation, ubuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ubuo *UserBlockUpdateOne) SaveX(ctx context.Context) *UserBlock {
+	node, err := ubuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ubuo *UserBlockUpdateOne) Exec(ctx context.Context) error {
+	_, err := ubuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ubuo *UserBlockUpdateOne) ExecX(ctx context.Context) {
+	if err := ubuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ubuo *UserBlockUpdateOne) check() error {
+	if ubuo.mutation.UserCleared() && len(ubuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserBlock.user"`)
+	}
+	if ubuo.mutation.BlockedUserCleared() && len(ubuo.mutation.BlockedUserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserBlock.blocked_user"`)
+	}
+	return nil
+}
+
+func (ubuo *UserBlockUpdateOne) sqlSave(ctx context.Context) (_node *UserBlock, err error) {
+	if err := ubuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userblock.Table, userblock.Columns, sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeString))
+	id, ok := ubuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserBlock.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ubuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userblock.FieldID)
+		for _, f := range fields {
+			if !userblock.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userblock.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ubuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ubuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   userblock.UserTable,
+			Columns: []string{userblock.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ubuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   userblock.UserTable,
+			Columns: []string{userblock.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ubuo.mutation.BlockedUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   userblock.BlockedUserTable,
+			Columns: []string{userblock.BlockedUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ubuo.mutation.BlockedUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   userblock.BlockedUserTable,
+			Columns: []string{userblock.BlockedUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &UserBlock{config: ubuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ubuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userblock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ubuo.mutation.done = true
+	return _node, nil
+}
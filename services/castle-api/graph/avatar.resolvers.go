@@ -0,0 +1,46 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"journeyhub/ent"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/graph/generated"
+	"journeyhub/graph/model"
+)
+
+// UpdateUserAvatar is the resolver for the updateUserAvatar field.
+func (r *mutationResolver) UpdateUserAvatar(ctx context.Context, input model.UpdateAvatarInput) (*ent.User, error) {
+	if validationErrors := r.validationService.ValidateGqlStruct(input); len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return r.avatarsService.UpdateUserAvatar(ctx, input)
+}
+
+// DeleteUserAvatar is the resolver for the deleteUserAvatar field.
+func (r *mutationResolver) DeleteUserAvatar(ctx context.Context) (*ent.User, error) {
+	return r.avatarsService.DeleteUserAvatar(ctx)
+}
+
+// UpdateRoomAvatar is the resolver for the updateRoomAvatar field.
+func (r *mutationResolver) UpdateRoomAvatar(ctx context.Context, roomID pulid.ID, input model.UpdateAvatarInput) (*ent.Room, error) {
+	if validationErrors := r.validationService.ValidateGqlStruct(input); len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return r.avatarsService.UpdateRoomAvatar(ctx, roomID, input)
+}
+
+// DeleteRoomAvatar is the resolver for the deleteRoomAvatar field.
+func (r *mutationResolver) DeleteRoomAvatar(ctx context.Context, roomID pulid.ID) (*ent.Room, error) {
+	return r.avatarsService.DeleteRoomAvatar(ctx, roomID)
+}
+
+// Mutation returns generated.MutationResolver implementation.
+func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+
+type mutationResolver struct{ *Resolver }
@@ -7,29 +7,34 @@ package graph
 import (
 	"context"
 
+	"journeyhub/ent"
+	"journeyhub/ent/call"
+	"journeyhub/ent/callparticipant"
 	"journeyhub/ent/schema/pulid"
 	"journeyhub/graph/generated"
 	"journeyhub/graph/model"
+
+	"entgo.io/contrib/entgql"
 )
 
 // StartCall is the resolver for the startCall field.
-func (r *mutationResolver) StartCall(ctx context.Context, input model.CallParamsInput) (bool, error) {
+func (r *mutationResolver) StartCall(ctx context.Context, input model.StartCallInput) (*ent.Call, error) {
 	return r.callsService.StartCall(ctx, input)
 }
 
 // EndCall is the resolver for the endCall field.
-func (r *mutationResolver) EndCall(ctx context.Context, input model.CallParamsInput) (bool, error) {
-	return r.callsService.EndCall(ctx, input)
+func (r *mutationResolver) EndCall(ctx context.Context, callID pulid.ID) (*ent.Call, error) {
+	return r.callsService.EndCall(ctx, callID)
 }
 
 // DeclineCall is the resolver for the declineCall field.
-func (r *mutationResolver) DeclineCall(ctx context.Context, input model.CallParamsInput) (bool, error) {
-	return r.callsService.DeclineCall(ctx, input)
+func (r *mutationResolver) DeclineCall(ctx context.Context, callID pulid.ID) (*ent.Call, error) {
+	return r.callsService.DeclineCall(ctx, callID)
 }
 
 // AnswerCall is the resolver for the answerCall field.
-func (r *mutationResolver) AnswerCall(ctx context.Context, input model.CallParamsInput) (bool, error) {
-	return r.callsService.AnswerCall(ctx, input)
+func (r *mutationResolver) AnswerCall(ctx context.Context, callID pulid.ID) (*ent.Call, error) {
+	return r.callsService.AnswerCall(ctx, callID)
 }
 
 // CallJoinToken is the resolver for the callJoinToken field.
@@ -37,7 +42,41 @@ func (r *queryResolver) CallJoinToken(ctx context.Context, roomID pulid.ID) (str
 	return r.callsService.GetCallJoinToken(ctx, roomID)
 }
 
-// Mutation returns generated.MutationResolver implementation.
-func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+// CallHistory is the resolver for the callHistory field.
+func (r *queryResolver) CallHistory(ctx context.Context, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.CallOrder, where *ent.CallWhereInput) (*ent.CallConnection, error) {
+	currentUserID, err := r.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.dbService.Client().Call.Query().
+		Where(
+			call.HasParticipantsWith(
+				callparticipant.UserID(currentUserID),
+			),
+		).
+		Paginate(
+			ctx,
+			after,
+			first,
+			before,
+			last,
+			ent.WithCallOrder(orderBy),
+			ent.WithCallFilter(where.Filter),
+		)
+}
+
+// ActiveCall is the resolver for the activeCall field.
+func (r *queryResolver) ActiveCall(ctx context.Context, roomID pulid.ID) (*ent.Call, error) {
+	return r.callsService.ActiveCall(ctx, roomID)
+}
+
+// CallEvents is the resolver for the callEvents field.
+func (r *subscriptionResolver) CallEvents(ctx context.Context, deviceID *string) (<-chan *model.CallEvent, error) {
+	return r.callsService.Subscriptions().SubscribeToCallEvents(ctx, deviceID)
+}
+
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
 
-type mutationResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
@@ -0,0 +1,61 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"journeyhub/ent"
+	"journeyhub/ent/contactpin"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/graph/model"
+
+	"entgo.io/contrib/entgql"
+)
+
+// CreateContactPin is the resolver for the createContactPin field.
+func (r *mutationResolver) CreateContactPin(ctx context.Context, input model.CreateContactPinInput) (*model.CreateContactPinPayload, error) {
+	if validationErrors := r.validationService.ValidateGqlStruct(input); len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return r.contactsService.CreateContactPin(ctx, input)
+}
+
+// RevokeContactPin is the resolver for the revokeContactPin field.
+func (r *mutationResolver) RevokeContactPin(ctx context.Context, pinID pulid.ID) (*ent.ContactPin, error) {
+	return r.contactsService.RevokeContactPin(ctx, pinID)
+}
+
+// AddUserContactByPayload is the resolver for the addUserContactByPayload field.
+func (r *mutationResolver) AddUserContactByPayload(ctx context.Context, payload string) (*ent.ContactRequestEdge, error) {
+	contactRequest, err := r.contactsService.AddUserContactByPayload(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return contactRequest.ToEdge(ent.DefaultContactRequestOrder), nil
+}
+
+// ContactPins is the resolver for the contactPins field.
+func (r *queryResolver) ContactPins(ctx context.Context, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.ContactPinOrder, where *ent.ContactPinWhereInput) (*ent.ContactPinConnection, error) {
+	currentUserID, err := r.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.dbService.Client().ContactPin.Query().
+		Where(
+			contactpin.UserID(currentUserID),
+		).
+		Paginate(
+			ctx,
+			after,
+			first,
+			before,
+			last,
+			ent.WithContactPinOrder(orderBy),
+			ent.WithContactPinFilter(where.Filter),
+		)
+}
@@ -0,0 +1,64 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"journeyhub/ent"
+	"journeyhub/ent/contactrequest"
+	"journeyhub/ent/schema/pulid"
+
+	"entgo.io/contrib/entgql"
+)
+
+// AcceptContactRequest is the resolver for the acceptContactRequest field.
+func (r *mutationResolver) AcceptContactRequest(ctx context.Context, contactRequestID pulid.ID) (*ent.ContactRequestEdge, error) {
+	contactRequest, err := r.contactsService.AcceptContactRequest(ctx, contactRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	return contactRequest.ToEdge(ent.DefaultContactRequestOrder), nil
+}
+
+// DeclineContactRequest is the resolver for the declineContactRequest field.
+func (r *mutationResolver) DeclineContactRequest(ctx context.Context, contactRequestID pulid.ID) (*ent.ContactRequestEdge, error) {
+	contactRequest, err := r.contactsService.DeclineContactRequest(ctx, contactRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	return contactRequest.ToEdge(ent.DefaultContactRequestOrder), nil
+}
+
+// ContactRequests is the resolver for the contactRequests field.
+func (r *queryResolver) ContactRequests(ctx context.Context, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.ContactRequestOrder, where *ent.ContactRequestWhereInput) (*ent.ContactRequestConnection, error) {
+	currentUserID, err := r.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.dbService.Client().ContactRequest.Query().
+		Where(
+			contactrequest.Or(
+				contactrequest.SenderID(currentUserID),
+				contactrequest.ReceiverID(currentUserID),
+			),
+		).
+		Paginate(
+			ctx,
+			after,
+			first,
+			before,
+			last,
+			ent.WithContactRequestOrder(orderBy),
+			ent.WithContactRequestFilter(where.Filter),
+		)
+}
+
+// ContactRequestReceived is the resolver for the contactRequestReceived field.
+func (r *subscriptionResolver) ContactRequestReceived(ctx context.Context) (<-chan *ent.ContactRequestEdge, error) {
+	return r.contactsService.Subscriptions().SubscribeToContactRequestReceivedEvent(ctx)
+}
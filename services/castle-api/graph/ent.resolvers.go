@@ -8,8 +8,10 @@ import (
 	"context"
 	"fmt"
 	"journeyhub/ent"
+	"journeyhub/ent/notification"
 	"journeyhub/ent/roommember"
 	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
 	"journeyhub/ent/usercontact"
 	"journeyhub/graph/generated"
 
@@ -18,12 +20,28 @@ import (
 
 // Node is the resolver for the node field.
 func (r *queryResolver) Node(ctx context.Context, id pulid.ID) (ent.Noder, error) {
-	return r.dbService.Client().Noder(ctx, id)
+	node, err := r.dbService.Client().Noder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.authorizeNodes(ctx, node); err != nil {
+		return nil, err
+	}
+
+	return node, nil
 }
 
 // Nodes is the resolver for the nodes field.
 func (r *queryResolver) Nodes(ctx context.Context, ids []pulid.ID) ([]ent.Noder, error) {
-	return r.dbService.Client().Noders(ctx, ids)
+	// Nodes that are not found are reported with the others.
+	nodes, err := r.dbService.Client().Noders(ctx, ids)
+
+	if aErr := r.authorizeNodes(ctx, nodes...); aErr != nil {
+		return nil, aErr
+	}
+
+	return nodes, err
 }
 
 // Devices is the resolver for the devices field.
@@ -33,7 +51,24 @@ func (r *queryResolver) Devices(ctx context.Context, after *entgql.Cursor[pulid.
 
 // Notifications is the resolver for the notifications field.
 func (r *queryResolver) Notifications(ctx context.Context, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.NotificationOrder, where *ent.NotificationWhereInput) (*ent.NotificationConnection, error) {
-	panic(fmt.Errorf("not implemented: Notifications - notifications"))
+	currentUserID, err := r.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.dbService.Client().Notification.Query().
+		Where(
+			notification.HasUserWith(user.ID(currentUserID)),
+		).
+		Paginate(
+			ctx,
+			after,
+			first,
+			before,
+			last,
+			ent.WithNotificationOrder(orderBy),
+			ent.WithNotificationFilter(where.Filter),
+		)
 }
 
 // RoomMembers is the resolver for the roomMembers field.
@@ -83,4 +118,8 @@ func (r *queryResolver) UserContacts(ctx context.Context, after *entgql.Cursor[p
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// User returns generated.UserResolver implementation.
+func (r *Resolver) User() generated.UserResolver { return &userResolver{r} }
+
 type queryResolver struct{ *Resolver }
+type userResolver struct{ *Resolver }
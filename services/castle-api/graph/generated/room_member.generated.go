@@ -526,6 +526,47 @@ func (ec *executionContext) fieldContext_RoomMemberUpdatedEvent_updatedAt(_ cont
 
 // region    **************************** input.gotpl *****************************
 
+func (ec *executionContext) unmarshalInputUpdateRoomMemberNotificationsInput(ctx context.Context, obj interface{}) (model.UpdateRoomMemberNotificationsInput, error) {
+	var it model.UpdateRoomMemberNotificationsInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"notificationLevel", "muteUntil", "clearMuteUntil"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "notificationLevel":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("notificationLevel"))
+			data, err := ec.unmarshalORoomMemberNotificationLevel2ᚖjourneyhubᚋentᚋroommemberᚐNotificationLevel(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NotificationLevel = data
+		case "muteUntil":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("muteUntil"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MuteUntil = data
+		case "clearMuteUntil":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clearMuteUntil"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ClearMuteUntil = data
+		}
+	}
+
+	return it, nil
+}
+
 // endregion **************************** input.gotpl *****************************
 
 // region    ************************** interface.gotpl ***************************
@@ -658,6 +699,11 @@ func (ec *executionContext) _RoomMemberUpdatedEvent(ctx context.Context, sel ast
 
 // region    ***************************** type.gotpl *****************************
 
+func (ec *executionContext) unmarshalNUpdateRoomMemberNotificationsInput2journeyhubᚋgraphᚋmodelᚐUpdateRoomMemberNotificationsInput(ctx context.Context, v interface{}) (model.UpdateRoomMemberNotificationsInput, error) {
+	res, err := ec.unmarshalInputUpdateRoomMemberNotificationsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalOLastMessageUpdatedEvent2ᚖjourneyhubᚋgraphᚋmodelᚐLastMessageUpdatedEvent(ctx context.Context, sel ast.SelectionSet, v *model.LastMessageUpdatedEvent) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
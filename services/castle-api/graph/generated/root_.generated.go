@@ -39,19 +39,137 @@ type ResolverRoot interface {
 	Mutation() MutationResolver
 	Query() QueryResolver
 	Subscription() SubscriptionResolver
+	User() UserResolver
 }
 
 type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
-	Device struct {
+	Avatar struct {
 		CreatedAt func(childComplexity int) int
-		DeviceID  func(childComplexity int) int
-		FcmToken  func(childComplexity int) int
+		File      func(childComplexity int) int
+		FileID    func(childComplexity int) int
 		ID        func(childComplexity int) int
-		UpdatedAt func(childComplexity int) int
+		Room      func(childComplexity int) int
+		RoomID    func(childComplexity int) int
+		User      func(childComplexity int) int
+		UserID    func(childComplexity int) int
+	}
+
+	Call struct {
+		AnsweredAt   func(childComplexity int) int
+		Caller       func(childComplexity int) int
+		CallerID     func(childComplexity int) int
+		Duration     func(childComplexity int) int
+		EndedAt      func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Participants func(childComplexity int) int
+		Room         func(childComplexity int) int
+		RoomID       func(childComplexity int) int
+		StartedAt    func(childComplexity int) int
+		State        func(childComplexity int) int
+		Type         func(childComplexity int) int
+	}
+
+	CallConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CallEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	CallEvent struct {
+		CallID               func(childComplexity int) int
+		CallNotificationType func(childComplexity int) int
+		CallState            func(childComplexity int) int
+		CallType             func(childComplexity int) int
+		RoomID               func(childComplexity int) int
+		UserFirstName        func(childComplexity int) int
+		UserID               func(childComplexity int) int
+		UserLastName         func(childComplexity int) int
+	}
+
+	CallParticipant struct {
+		Call      func(childComplexity int) int
+		CallID    func(childComplexity int) int
+		ID        func(childComplexity int) int
+		InvitedAt func(childComplexity int) int
+		JoinedAt  func(childComplexity int) int
+		LeftAt    func(childComplexity int) int
+		State     func(childComplexity int) int
+		User      func(childComplexity int) int
+		UserID    func(childComplexity int) int
+	}
+
+	ContactPin struct {
+		CreatedAt func(childComplexity int) int
+		ExpiresAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+		MaxUses   func(childComplexity int) int
+		RevokedAt func(childComplexity int) int
 		User      func(childComplexity int) int
+		UserID    func(childComplexity int) int
+		Uses      func(childComplexity int) int
+	}
+
+	ContactPinConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	ContactPinEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	ContactRequest struct {
+		CreatedAt  func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Receiver   func(childComplexity int) int
+		ReceiverID func(childComplexity int) int
+		Sender     func(childComplexity int) int
+		SenderID   func(childComplexity int) int
+		Status     func(childComplexity int) int
+		UpdatedAt  func(childComplexity int) int
+	}
+
+	ContactRequestConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	ContactRequestEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	CreateContactPinPayload struct {
+		Code    func(childComplexity int) int
+		Payload func(childComplexity int) int
+		Pin     func(childComplexity int) int
+	}
+
+	CreateRoomInvitePayload struct {
+		Invite func(childComplexity int) int
+		Token  func(childComplexity int) int
+	}
+
+	Device struct {
+		CreatedAt     func(childComplexity int) int
+		DeviceID      func(childComplexity int) int
+		ID            func(childComplexity int) int
+		LastPushAt    func(childComplexity int) int
+		LastPushError func(childComplexity int) int
+		Platform      func(childComplexity int) int
+		UpdatedAt     func(childComplexity int) int
+		User          func(childComplexity int) int
 	}
 
 	DeviceConnection struct {
@@ -69,14 +187,18 @@ type ComplexityRoot struct {
 		Bucket            func(childComplexity int) int
 		ContentType       func(childComplexity int) int
 		CreatedAt         func(childComplexity int) int
+		Height            func(childComplexity int) int
 		ID                func(childComplexity int) int
 		Location          func(childComplexity int) int
 		MessageAttachment func(childComplexity int) int
 		MessageVoice      func(childComplexity int) int
 		Name              func(childComplexity int) int
+		Original          func(childComplexity int) int
 		Path              func(childComplexity int) int
 		Size              func(childComplexity int) int
+		Thumbnails        func(childComplexity int) int
 		UpdatedAt         func(childComplexity int) int
+		Width             func(childComplexity int) int
 	}
 
 	LastMessageUpdatedEvent struct {
@@ -93,6 +215,10 @@ type ComplexityRoot struct {
 
 	Message struct {
 		Attachments func(childComplexity int) int
+		Avatar      func(childComplexity int) int
+		AvatarID    func(childComplexity int) int
+		Call        func(childComplexity int) int
+		CallID      func(childComplexity int) int
 		Content     func(childComplexity int) int
 		CreatedAt   func(childComplexity int) int
 		ID          func(childComplexity int) int
@@ -100,6 +226,7 @@ type ComplexityRoot struct {
 		Replies     func(childComplexity int) int
 		ReplyTo     func(childComplexity int) int
 		Room        func(childComplexity int) int
+		Type        func(childComplexity int) int
 		UpdatedAt   func(childComplexity int) int
 		User        func(childComplexity int) int
 		Voice       func(childComplexity int) int
@@ -181,23 +308,49 @@ type ComplexityRoot struct {
 	}
 
 	Mutation struct {
-		AddUserContact        func(childComplexity int, pincode string) int
-		AnswerCall            func(childComplexity int, input model.CallParamsInput) int
-		CreateRoom            func(childComplexity int, input model.CreateRoomInput) int
-		DeclineCall           func(childComplexity int, input model.CallParamsInput) int
-		DeleteMessage         func(childComplexity int, messageID pulid.ID) int
-		DeleteRoom            func(childComplexity int, roomID pulid.ID) int
-		DeleteRoomMember      func(childComplexity int, roomMemberID pulid.ID) int
-		DeleteUserContact     func(childComplexity int, userContactID pulid.ID) int
-		EndCall               func(childComplexity int, input model.CallParamsInput) int
-		GeneratePinCode       func(childComplexity int) int
-		Login                 func(childComplexity int, input model.UserLoginInput) int
-		MarkRoomMemeberAsSeen func(childComplexity int, roomMemberID pulid.ID) int
-		Register              func(childComplexity int, input model.UserRegisterInput) int
-		SendMessage           func(childComplexity int, input model.SendMessageInput) int
-		StartCall             func(childComplexity int, input model.CallParamsInput) int
-		UpdateMessage         func(childComplexity int, messageID pulid.ID, input model.UpdateMessageInput) int
-		UpdateRoom            func(childComplexity int, roomID pulid.ID, input model.UpdateRoomInput) int
+		AcceptContactRequest          func(childComplexity int, contactRequestID pulid.ID) int
+		AddUserContact                func(childComplexity int, pincode string) int
+		AddUserContactByPayload       func(childComplexity int, payload string) int
+		AnswerCall                    func(childComplexity int, callID pulid.ID) int
+		AssignReport                  func(childComplexity int, reportID pulid.ID, assigneeID *pulid.ID) int
+		BanRoomMember                 func(childComplexity int, roomMemberID pulid.ID) int
+		BlockUser                     func(childComplexity int, userID pulid.ID) int
+		CreateContactPin              func(childComplexity int, input model.CreateContactPinInput) int
+		CreateRoom                    func(childComplexity int, input model.CreateRoomInput) int
+		CreateRoomInvite              func(childComplexity int, input model.CreateRoomInviteInput) int
+		DeclineCall                   func(childComplexity int, callID pulid.ID) int
+		DeclineContactRequest         func(childComplexity int, contactRequestID pulid.ID) int
+		DeleteMessage                 func(childComplexity int, messageID pulid.ID) int
+		DeleteRoom                    func(childComplexity int, roomID pulid.ID) int
+		DeleteRoomAvatar              func(childComplexity int, roomID pulid.ID) int
+		DeleteRoomMember              func(childComplexity int, roomMemberID pulid.ID) int
+		DeleteUserAvatar              func(childComplexity int) int
+		DeleteUserContact             func(childComplexity int, userContactID pulid.ID) int
+		DemoteRoomMember              func(childComplexity int, roomMemberID pulid.ID) int
+		DismissReport                 func(childComplexity int, reportID pulid.ID, note *string) int
+		EndCall                       func(childComplexity int, callID pulid.ID) int
+		JoinRoomByInvite              func(childComplexity int, token string) int
+		KickRoomMember                func(childComplexity int, roomMemberID pulid.ID) int
+		Login                         func(childComplexity int, input model.UserLoginInput) int
+		MarkNotificationsRead         func(childComplexity int, notificationIDs []pulid.ID) int
+		MarkRoomMemeberAsSeen         func(childComplexity int, roomMemberID pulid.ID) int
+		PromoteRoomMember             func(childComplexity int, roomMemberID pulid.ID) int
+		Register                      func(childComplexity int, input model.UserRegisterInput) int
+		ReportMessage                 func(childComplexity int, messageID pulid.ID, input model.ReportInput) int
+		ReportRoom                    func(childComplexity int, roomID pulid.ID, input model.ReportInput) int
+		ReportUser                    func(childComplexity int, userID pulid.ID, input model.ReportInput) int
+		ResolveReport                 func(childComplexity int, reportID pulid.ID, input model.ResolveReportInput) int
+		RevokeContactPin              func(childComplexity int, pinID pulid.ID) int
+		RevokeRoomInvite              func(childComplexity int, inviteID pulid.ID) int
+		SendMessage                   func(childComplexity int, input model.SendMessageInput) int
+		StartCall                     func(childComplexity int, input model.StartCallInput) int
+		UnblockUser                   func(childComplexity int, userID pulid.ID) int
+		UpdateMessage                 func(childComplexity int, messageID pulid.ID, input model.UpdateMessageInput) int
+		UpdateRoom                    func(childComplexity int, roomID pulid.ID, input model.UpdateRoomInput) int
+		UpdateRoomAvatar              func(childComplexity int, roomID pulid.ID, input model.UpdateAvatarInput) int
+		UpdateRoomMemberNotifications func(childComplexity int, roomMemberID pulid.ID, input model.UpdateRoomMemberNotificationsInput) int
+		UpdateUserAvatar              func(childComplexity int, input model.UpdateAvatarInput) int
+		UpdateUserSettings            func(childComplexity int, input model.UpdateUserSettingsInput) int
 	}
 
 	Notification struct {
@@ -205,7 +358,9 @@ type ComplexityRoot struct {
 		CreatedAt func(childComplexity int) int
 		Data      func(childComplexity int) int
 		ID        func(childComplexity int) int
+		ReadAt    func(childComplexity int) int
 		Title     func(childComplexity int) int
+		Type      func(childComplexity int) int
 		UpdatedAt func(childComplexity int) int
 		User      func(childComplexity int) int
 	}
@@ -228,8 +383,23 @@ type ComplexityRoot struct {
 		StartCursor     func(childComplexity int) int
 	}
 
+	Presence struct {
+		LastSeenAt func(childComplexity int) int
+		Online     func(childComplexity int) int
+	}
+
+	PresenceChange struct {
+		Presence func(childComplexity int) int
+		UserID   func(childComplexity int) int
+	}
+
 	Query struct {
+		ActiveCall               func(childComplexity int, roomID pulid.ID) int
+		BlockedUsers             func(childComplexity int, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.UserOrder) int
+		CallHistory              func(childComplexity int, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.CallOrder, where *ent.CallWhereInput) int
 		CallJoinToken            func(childComplexity int, roomID pulid.ID) int
+		ContactPins              func(childComplexity int, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.ContactPinOrder, where *ent.ContactPinWhereInput) int
+		ContactRequests          func(childComplexity int, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.ContactRequestOrder, where *ent.ContactRequestWhereInput) int
 		Devices                  func(childComplexity int, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.DeviceOrder, where *ent.DeviceWhereInput) int
 		MessageAttachmentsByRoom func(childComplexity int, roomID pulid.ID, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.MessageAttachmentOrder, where *ent.MessageAttachmentWhereInput) int
 		MessageLinksByRoom       func(childComplexity int, roomID pulid.ID, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.MessageLinkOrder, where *ent.MessageLinkWhereInput) int
@@ -238,15 +408,58 @@ type ComplexityRoot struct {
 		Node                     func(childComplexity int, id pulid.ID) int
 		Nodes                    func(childComplexity int, ids []pulid.ID) int
 		Notifications            func(childComplexity int, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.NotificationOrder, where *ent.NotificationWhereInput) int
+		PreviewRoomInvite        func(childComplexity int, token string) int
+		Reports                  func(childComplexity int, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.ReportOrder, where *ent.ReportWhereInput) int
 		Room                     func(childComplexity int, roomID pulid.ID) int
+		RoomInvites              func(childComplexity int, roomID pulid.ID, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.RoomInviteOrder, where *ent.RoomInviteWhereInput) int
 		RoomMembers              func(childComplexity int, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.RoomMemberOrder, where *ent.RoomMemberWhereInput) int
 		RoomMembersByRoom        func(childComplexity int, roomID pulid.ID, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.RoomMemberOrder, where *ent.RoomMemberWhereInput) int
+		SearchUsers              func(childComplexity int, query string, first *int) int
 		Self                     func(childComplexity int) int
+		UnreadNotificationsCount func(childComplexity int) int
 		UserContact              func(childComplexity int, userContactID pulid.ID) int
 		UserContacts             func(childComplexity int, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.UserContactOrder, where *ent.UserContactWhereInput) int
 	}
 
+	Report struct {
+		Actions    func(childComplexity int) int
+		Assignee   func(childComplexity int) int
+		AssigneeID func(childComplexity int) int
+		ClosedAt   func(childComplexity int) int
+		CreatedAt  func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Message    func(childComplexity int) int
+		MessageID  func(childComplexity int) int
+		Note       func(childComplexity int) int
+		Reason     func(childComplexity int) int
+		Reporter   func(childComplexity int) int
+		ReporterID func(childComplexity int) int
+		Room       func(childComplexity int) int
+		RoomID     func(childComplexity int) int
+		Snapshot   func(childComplexity int) int
+		Status     func(childComplexity int) int
+		Text       func(childComplexity int) int
+		Type       func(childComplexity int) int
+		UpdatedAt  func(childComplexity int) int
+		User       func(childComplexity int) int
+		UserID     func(childComplexity int) int
+	}
+
+	ReportConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	ReportEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	Room struct {
+		Avatar             func(childComplexity int) int
+		AvatarID           func(childComplexity int) int
+		Avatars            func(childComplexity int) int
 		CreatedAt          func(childComplexity int) int
 		Description        func(childComplexity int) int
 		ID                 func(childComplexity int) int
@@ -275,10 +488,45 @@ type ComplexityRoot struct {
 		Node   func(childComplexity int) int
 	}
 
+	RoomInvite struct {
+		CreatedAt func(childComplexity int) int
+		Creator   func(childComplexity int) int
+		CreatorID func(childComplexity int) int
+		ExpiresAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+		MaxUses   func(childComplexity int) int
+		RevokedAt func(childComplexity int) int
+		Room      func(childComplexity int) int
+		RoomID    func(childComplexity int) int
+		Uses      func(childComplexity int) int
+	}
+
+	RoomInviteConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	RoomInviteEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	RoomInvitePreview struct {
+		Avatar       func(childComplexity int) int
+		Description  func(childComplexity int) int
+		MembersCount func(childComplexity int) int
+		Name         func(childComplexity int) int
+		RoomID       func(childComplexity int) int
+	}
+
 	RoomMember struct {
 		ID                  func(childComplexity int) int
 		JoinedAt            func(childComplexity int) int
+		MuteUntil           func(childComplexity int) int
 		Name                func(childComplexity int) int
+		NotificationLevel   func(childComplexity int) int
+		Role                func(childComplexity int) int
 		Room                func(childComplexity int) int
 		RoomID              func(childComplexity int) int
 		UnreadMessagesCount func(childComplexity int) int
@@ -308,31 +556,45 @@ type ComplexityRoot struct {
 		Version     func(childComplexity int) int
 	}
 
+	StorageUsage struct {
+		Quota func(childComplexity int) int
+		Used  func(childComplexity int) int
+	}
+
 	Subscription struct {
-		MessageCreated    func(childComplexity int, roomID pulid.ID) int
-		MessageDeleted    func(childComplexity int, roomID pulid.ID) int
-		MessageUpdated    func(childComplexity int, roomID pulid.ID) int
-		RoomMemberCreated func(childComplexity int) int
-		RoomMemberDeleted func(childComplexity int) int
-		RoomMemberUpdated func(childComplexity int) int
+		CallEvents             func(childComplexity int, deviceID *string) int
+		ContactRequestReceived func(childComplexity int) int
+		MessageCreated         func(childComplexity int, roomID pulid.ID) int
+		MessageDeleted         func(childComplexity int, roomID pulid.ID) int
+		MessageUpdated         func(childComplexity int, roomID pulid.ID) int
+		NotificationCreated    func(childComplexity int) int
+		PresenceChanged        func(childComplexity int) int
+		RoomMemberCreated      func(childComplexity int) int
+		RoomMemberDeleted      func(childComplexity int) int
+		RoomMemberUpdated      func(childComplexity int) int
 	}
 
 	User struct {
-		ContactPin    func(childComplexity int) int
-		Contacts      func(childComplexity int, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.UserOrder, where *ent.UserWhereInput) int
-		CreatedAt     func(childComplexity int) int
-		Device        func(childComplexity int) int
-		Email         func(childComplexity int) int
-		FirstName     func(childComplexity int) int
-		ID            func(childComplexity int) int
-		LastName      func(childComplexity int) int
-		Memberships   func(childComplexity int, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.RoomMemberOrder, where *ent.RoomMemberWhereInput) int
-		Messages      func(childComplexity int, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.MessageOrder, where *ent.MessageWhereInput) int
-		Nickname      func(childComplexity int) int
-		Notifications func(childComplexity int, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.NotificationOrder, where *ent.NotificationWhereInput) int
-		Rooms         func(childComplexity int, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.RoomOrder, where *ent.RoomWhereInput) int
-		UpdatedAt     func(childComplexity int) int
-		UserContacts  func(childComplexity int, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.UserContactOrder, where *ent.UserContactWhereInput) int
+		Avatar       func(childComplexity int) int
+		AvatarID     func(childComplexity int) int
+		Avatars      func(childComplexity int) int
+		Contacts     func(childComplexity int, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.UserOrder, where *ent.UserWhereInput) int
+		CreatedAt    func(childComplexity int) int
+		Device       func(childComplexity int) int
+		Email        func(childComplexity int) int
+		FirstName    func(childComplexity int) int
+		ID           func(childComplexity int) int
+		LastName     func(childComplexity int) int
+		Memberships  func(childComplexity int, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.RoomMemberOrder, where *ent.RoomMemberWhereInput) int
+		Messages     func(childComplexity int, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.MessageOrder, where *ent.MessageWhereInput) int
+		Nickname     func(childComplexity int) int
+		Presence     func(childComplexity int) int
+		Role         func(childComplexity int) int
+		Rooms        func(childComplexity int, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.RoomOrder, where *ent.RoomWhereInput) int
+		Settings     func(childComplexity int) int
+		StorageUsage func(childComplexity int) int
+		UpdatedAt    func(childComplexity int) int
+		UserContacts func(childComplexity int, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.UserContactOrder, where *ent.UserContactWhereInput) int
 	}
 
 	UserConnection struct {
@@ -367,6 +629,24 @@ type ComplexityRoot struct {
 		Cursor func(childComplexity int) int
 		Node   func(childComplexity int) int
 	}
+
+	UserSearchResult struct {
+		Avatar    func(childComplexity int) int
+		FirstName func(childComplexity int) int
+		ID        func(childComplexity int) int
+		LastName  func(childComplexity int) int
+		Nickname  func(childComplexity int) int
+	}
+
+	UserSettings struct {
+		Discoverability func(childComplexity int) int
+		HideLastSeen    func(childComplexity int) int
+		Locale          func(childComplexity int) int
+		MessagePreviews func(childComplexity int) int
+		QuietHoursEnd   func(childComplexity int) int
+		QuietHoursStart func(childComplexity int) int
+		Timezone        func(childComplexity int) int
+	}
 }
 
 type executableSchema struct {
@@ -388,1887 +668,4463 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
-	case "Device.createdAt":
-		if e.complexity.Device.CreatedAt == nil {
+	case "Avatar.createdAt":
+		if e.complexity.Avatar.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.Device.CreatedAt(childComplexity), true
+		return e.complexity.Avatar.CreatedAt(childComplexity), true
 
-	case "Device.deviceID":
-		if e.complexity.Device.DeviceID == nil {
+	case "Avatar.file":
+		if e.complexity.Avatar.File == nil {
 			break
 		}
 
-		return e.complexity.Device.DeviceID(childComplexity), true
+		return e.complexity.Avatar.File(childComplexity), true
 
-	case "Device.fcmToken":
-		if e.complexity.Device.FcmToken == nil {
+	case "Avatar.fileID":
+		if e.complexity.Avatar.FileID == nil {
 			break
 		}
 
-		return e.complexity.Device.FcmToken(childComplexity), true
+		return e.complexity.Avatar.FileID(childComplexity), true
 
-	case "Device.id":
-		if e.complexity.Device.ID == nil {
+	case "Avatar.id":
+		if e.complexity.Avatar.ID == nil {
 			break
 		}
 
-		return e.complexity.Device.ID(childComplexity), true
+		return e.complexity.Avatar.ID(childComplexity), true
 
-	case "Device.updatedAt":
-		if e.complexity.Device.UpdatedAt == nil {
+	case "Avatar.room":
+		if e.complexity.Avatar.Room == nil {
 			break
 		}
 
-		return e.complexity.Device.UpdatedAt(childComplexity), true
+		return e.complexity.Avatar.Room(childComplexity), true
 
-	case "Device.user":
-		if e.complexity.Device.User == nil {
+	case "Avatar.roomID":
+		if e.complexity.Avatar.RoomID == nil {
 			break
 		}
 
-		return e.complexity.Device.User(childComplexity), true
+		return e.complexity.Avatar.RoomID(childComplexity), true
 
-	case "DeviceConnection.edges":
-		if e.complexity.DeviceConnection.Edges == nil {
+	case "Avatar.user":
+		if e.complexity.Avatar.User == nil {
 			break
 		}
 
-		return e.complexity.DeviceConnection.Edges(childComplexity), true
+		return e.complexity.Avatar.User(childComplexity), true
 
-	case "DeviceConnection.pageInfo":
-		if e.complexity.DeviceConnection.PageInfo == nil {
+	case "Avatar.userID":
+		if e.complexity.Avatar.UserID == nil {
 			break
 		}
 
-		return e.complexity.DeviceConnection.PageInfo(childComplexity), true
+		return e.complexity.Avatar.UserID(childComplexity), true
 
-	case "DeviceConnection.totalCount":
-		if e.complexity.DeviceConnection.TotalCount == nil {
+	case "Call.answeredAt":
+		if e.complexity.Call.AnsweredAt == nil {
 			break
 		}
 
-		return e.complexity.DeviceConnection.TotalCount(childComplexity), true
+		return e.complexity.Call.AnsweredAt(childComplexity), true
 
-	case "DeviceEdge.cursor":
-		if e.complexity.DeviceEdge.Cursor == nil {
+	case "Call.caller":
+		if e.complexity.Call.Caller == nil {
 			break
 		}
 
-		return e.complexity.DeviceEdge.Cursor(childComplexity), true
+		return e.complexity.Call.Caller(childComplexity), true
 
-	case "DeviceEdge.node":
-		if e.complexity.DeviceEdge.Node == nil {
+	case "Call.callerID":
+		if e.complexity.Call.CallerID == nil {
 			break
 		}
 
-		return e.complexity.DeviceEdge.Node(childComplexity), true
+		return e.complexity.Call.CallerID(childComplexity), true
 
-	case "File.bucket":
-		if e.complexity.File.Bucket == nil {
+	case "Call.duration":
+		if e.complexity.Call.Duration == nil {
 			break
 		}
 
-		return e.complexity.File.Bucket(childComplexity), true
+		return e.complexity.Call.Duration(childComplexity), true
 
-	case "File.contentType":
-		if e.complexity.File.ContentType == nil {
+	case "Call.endedAt":
+		if e.complexity.Call.EndedAt == nil {
 			break
 		}
 
-		return e.complexity.File.ContentType(childComplexity), true
+		return e.complexity.Call.EndedAt(childComplexity), true
 
-	case "File.createdAt":
-		if e.complexity.File.CreatedAt == nil {
+	case "Call.id":
+		if e.complexity.Call.ID == nil {
 			break
 		}
 
-		return e.complexity.File.CreatedAt(childComplexity), true
+		return e.complexity.Call.ID(childComplexity), true
 
-	case "File.id":
-		if e.complexity.File.ID == nil {
+	case "Call.participants":
+		if e.complexity.Call.Participants == nil {
 			break
 		}
 
-		return e.complexity.File.ID(childComplexity), true
+		return e.complexity.Call.Participants(childComplexity), true
 
-	case "File.location":
-		if e.complexity.File.Location == nil {
+	case "Call.room":
+		if e.complexity.Call.Room == nil {
 			break
 		}
 
-		return e.complexity.File.Location(childComplexity), true
+		return e.complexity.Call.Room(childComplexity), true
 
-	case "File.messageAttachment":
-		if e.complexity.File.MessageAttachment == nil {
+	case "Call.roomID":
+		if e.complexity.Call.RoomID == nil {
 			break
 		}
 
-		return e.complexity.File.MessageAttachment(childComplexity), true
+		return e.complexity.Call.RoomID(childComplexity), true
 
-	case "File.messageVoice":
-		if e.complexity.File.MessageVoice == nil {
+	case "Call.startedAt":
+		if e.complexity.Call.StartedAt == nil {
 			break
 		}
 
-		return e.complexity.File.MessageVoice(childComplexity), true
+		return e.complexity.Call.StartedAt(childComplexity), true
 
-	case "File.name":
-		if e.complexity.File.Name == nil {
+	case "Call.state":
+		if e.complexity.Call.State == nil {
 			break
 		}
 
-		return e.complexity.File.Name(childComplexity), true
+		return e.complexity.Call.State(childComplexity), true
 
-	case "File.path":
-		if e.complexity.File.Path == nil {
+	case "Call.type":
+		if e.complexity.Call.Type == nil {
 			break
 		}
 
-		return e.complexity.File.Path(childComplexity), true
+		return e.complexity.Call.Type(childComplexity), true
 
-	case "File.size":
-		if e.complexity.File.Size == nil {
+	case "CallConnection.edges":
+		if e.complexity.CallConnection.Edges == nil {
 			break
 		}
 
-		return e.complexity.File.Size(childComplexity), true
+		return e.complexity.CallConnection.Edges(childComplexity), true
 
-	case "File.updatedAt":
-		if e.complexity.File.UpdatedAt == nil {
+	case "CallConnection.pageInfo":
+		if e.complexity.CallConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.File.UpdatedAt(childComplexity), true
+		return e.complexity.CallConnection.PageInfo(childComplexity), true
 
-	case "LastMessageUpdatedEvent.content":
-		if e.complexity.LastMessageUpdatedEvent.Content == nil {
+	case "CallConnection.totalCount":
+		if e.complexity.CallConnection.TotalCount == nil {
 			break
 		}
 
-		return e.complexity.LastMessageUpdatedEvent.Content(childComplexity), true
+		return e.complexity.CallConnection.TotalCount(childComplexity), true
 
-	case "LastMessageUpdatedEvent.createdAt":
-		if e.complexity.LastMessageUpdatedEvent.CreatedAt == nil {
+	case "CallEdge.cursor":
+		if e.complexity.CallEdge.Cursor == nil {
 			break
 		}
 
-		return e.complexity.LastMessageUpdatedEvent.CreatedAt(childComplexity), true
+		return e.complexity.CallEdge.Cursor(childComplexity), true
 
-	case "LastMessageUpdatedEvent.id":
-		if e.complexity.LastMessageUpdatedEvent.ID == nil {
+	case "CallEdge.node":
+		if e.complexity.CallEdge.Node == nil {
 			break
 		}
 
-		return e.complexity.LastMessageUpdatedEvent.ID(childComplexity), true
+		return e.complexity.CallEdge.Node(childComplexity), true
 
-	case "LastMessageUpdatedEvent.updatedAt":
-		if e.complexity.LastMessageUpdatedEvent.UpdatedAt == nil {
+	case "CallEvent.callID":
+		if e.complexity.CallEvent.CallID == nil {
 			break
 		}
 
-		return e.complexity.LastMessageUpdatedEvent.UpdatedAt(childComplexity), true
+		return e.complexity.CallEvent.CallID(childComplexity), true
 
-	case "LoginUser.token":
-		if e.complexity.LoginUser.Token == nil {
+	case "CallEvent.callNotificationType":
+		if e.complexity.CallEvent.CallNotificationType == nil {
 			break
 		}
 
-		return e.complexity.LoginUser.Token(childComplexity), true
+		return e.complexity.CallEvent.CallNotificationType(childComplexity), true
 
-	case "LoginUser.user":
-		if e.complexity.LoginUser.User == nil {
+	case "CallEvent.callState":
+		if e.complexity.CallEvent.CallState == nil {
 			break
 		}
 
-		return e.complexity.LoginUser.User(childComplexity), true
+		return e.complexity.CallEvent.CallState(childComplexity), true
 
-	case "Message.attachments":
-		if e.complexity.Message.Attachments == nil {
+	case "CallEvent.callType":
+		if e.complexity.CallEvent.CallType == nil {
 			break
 		}
 
-		return e.complexity.Message.Attachments(childComplexity), true
+		return e.complexity.CallEvent.CallType(childComplexity), true
 
-	case "Message.content":
-		if e.complexity.Message.Content == nil {
+	case "CallEvent.roomID":
+		if e.complexity.CallEvent.RoomID == nil {
 			break
 		}
 
-		return e.complexity.Message.Content(childComplexity), true
+		return e.complexity.CallEvent.RoomID(childComplexity), true
 
-	case "Message.createdAt":
-		if e.complexity.Message.CreatedAt == nil {
+	case "CallEvent.userFirstName":
+		if e.complexity.CallEvent.UserFirstName == nil {
 			break
 		}
 
-		return e.complexity.Message.CreatedAt(childComplexity), true
+		return e.complexity.CallEvent.UserFirstName(childComplexity), true
 
-	case "Message.id":
-		if e.complexity.Message.ID == nil {
+	case "CallEvent.userID":
+		if e.complexity.CallEvent.UserID == nil {
 			break
 		}
 
-		return e.complexity.Message.ID(childComplexity), true
+		return e.complexity.CallEvent.UserID(childComplexity), true
 
-	case "Message.links":
-		if e.complexity.Message.Links == nil {
+	case "CallEvent.userLastName":
+		if e.complexity.CallEvent.UserLastName == nil {
 			break
 		}
 
-		return e.complexity.Message.Links(childComplexity), true
+		return e.complexity.CallEvent.UserLastName(childComplexity), true
 
-	case "Message.replies":
-		if e.complexity.Message.Replies == nil {
+	case "CallParticipant.call":
+		if e.complexity.CallParticipant.Call == nil {
 			break
 		}
 
-		return e.complexity.Message.Replies(childComplexity), true
+		return e.complexity.CallParticipant.Call(childComplexity), true
 
-	case "Message.replyTo":
-		if e.complexity.Message.ReplyTo == nil {
+	case "CallParticipant.callID":
+		if e.complexity.CallParticipant.CallID == nil {
 			break
 		}
 
-		return e.complexity.Message.ReplyTo(childComplexity), true
+		return e.complexity.CallParticipant.CallID(childComplexity), true
 
-	case "Message.room":
-		if e.complexity.Message.Room == nil {
+	case "CallParticipant.id":
+		if e.complexity.CallParticipant.ID == nil {
 			break
 		}
 
-		return e.complexity.Message.Room(childComplexity), true
+		return e.complexity.CallParticipant.ID(childComplexity), true
 
-	case "Message.updatedAt":
-		if e.complexity.Message.UpdatedAt == nil {
+	case "CallParticipant.invitedAt":
+		if e.complexity.CallParticipant.InvitedAt == nil {
 			break
 		}
 
-		return e.complexity.Message.UpdatedAt(childComplexity), true
+		return e.complexity.CallParticipant.InvitedAt(childComplexity), true
 
-	case "Message.user":
-		if e.complexity.Message.User == nil {
+	case "CallParticipant.joinedAt":
+		if e.complexity.CallParticipant.JoinedAt == nil {
 			break
 		}
 
-		return e.complexity.Message.User(childComplexity), true
+		return e.complexity.CallParticipant.JoinedAt(childComplexity), true
 
-	case "Message.voice":
-		if e.complexity.Message.Voice == nil {
+	case "CallParticipant.leftAt":
+		if e.complexity.CallParticipant.LeftAt == nil {
 			break
 		}
 
-		return e.complexity.Message.Voice(childComplexity), true
+		return e.complexity.CallParticipant.LeftAt(childComplexity), true
 
-	case "MessageAttachment.attachedAt":
-		if e.complexity.MessageAttachment.AttachedAt == nil {
+	case "CallParticipant.state":
+		if e.complexity.CallParticipant.State == nil {
 			break
 		}
 
-		return e.complexity.MessageAttachment.AttachedAt(childComplexity), true
+		return e.complexity.CallParticipant.State(childComplexity), true
 
-	case "MessageAttachment.file":
-		if e.complexity.MessageAttachment.File == nil {
+	case "CallParticipant.user":
+		if e.complexity.CallParticipant.User == nil {
 			break
 		}
 
-		return e.complexity.MessageAttachment.File(childComplexity), true
+		return e.complexity.CallParticipant.User(childComplexity), true
 
-	case "MessageAttachment.id":
-		if e.complexity.MessageAttachment.ID == nil {
+	case "CallParticipant.userID":
+		if e.complexity.CallParticipant.UserID == nil {
 			break
 		}
 
-		return e.complexity.MessageAttachment.ID(childComplexity), true
+		return e.complexity.CallParticipant.UserID(childComplexity), true
 
-	case "MessageAttachment.message":
-		if e.complexity.MessageAttachment.Message == nil {
+	case "ContactPin.createdAt":
+		if e.complexity.ContactPin.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.MessageAttachment.Message(childComplexity), true
+		return e.complexity.ContactPin.CreatedAt(childComplexity), true
 
-	case "MessageAttachment.order":
-		if e.complexity.MessageAttachment.Order == nil {
+	case "ContactPin.expiresAt":
+		if e.complexity.ContactPin.ExpiresAt == nil {
 			break
 		}
 
-		return e.complexity.MessageAttachment.Order(childComplexity), true
+		return e.complexity.ContactPin.ExpiresAt(childComplexity), true
 
-	case "MessageAttachment.room":
-		if e.complexity.MessageAttachment.Room == nil {
+	case "ContactPin.id":
+		if e.complexity.ContactPin.ID == nil {
 			break
 		}
 
-		return e.complexity.MessageAttachment.Room(childComplexity), true
+		return e.complexity.ContactPin.ID(childComplexity), true
 
-	case "MessageAttachment.type":
-		if e.complexity.MessageAttachment.Type == nil {
+	case "ContactPin.maxUses":
+		if e.complexity.ContactPin.MaxUses == nil {
 			break
 		}
 
-		return e.complexity.MessageAttachment.Type(childComplexity), true
+		return e.complexity.ContactPin.MaxUses(childComplexity), true
 
-	case "MessageAttachmentConnection.edges":
-		if e.complexity.MessageAttachmentConnection.Edges == nil {
+	case "ContactPin.revokedAt":
+		if e.complexity.ContactPin.RevokedAt == nil {
 			break
 		}
 
-		return e.complexity.MessageAttachmentConnection.Edges(childComplexity), true
+		return e.complexity.ContactPin.RevokedAt(childComplexity), true
 
-	case "MessageAttachmentConnection.pageInfo":
-		if e.complexity.MessageAttachmentConnection.PageInfo == nil {
+	case "ContactPin.user":
+		if e.complexity.ContactPin.User == nil {
 			break
 		}
 
-		return e.complexity.MessageAttachmentConnection.PageInfo(childComplexity), true
+		return e.complexity.ContactPin.User(childComplexity), true
 
-	case "MessageAttachmentConnection.totalCount":
-		if e.complexity.MessageAttachmentConnection.TotalCount == nil {
+	case "ContactPin.userID":
+		if e.complexity.ContactPin.UserID == nil {
 			break
 		}
 
-		return e.complexity.MessageAttachmentConnection.TotalCount(childComplexity), true
+		return e.complexity.ContactPin.UserID(childComplexity), true
 
-	case "MessageAttachmentEdge.cursor":
-		if e.complexity.MessageAttachmentEdge.Cursor == nil {
+	case "ContactPin.uses":
+		if e.complexity.ContactPin.Uses == nil {
 			break
 		}
 
-		return e.complexity.MessageAttachmentEdge.Cursor(childComplexity), true
+		return e.complexity.ContactPin.Uses(childComplexity), true
 
-	case "MessageAttachmentEdge.node":
-		if e.complexity.MessageAttachmentEdge.Node == nil {
+	case "ContactPinConnection.edges":
+		if e.complexity.ContactPinConnection.Edges == nil {
 			break
 		}
 
-		return e.complexity.MessageAttachmentEdge.Node(childComplexity), true
+		return e.complexity.ContactPinConnection.Edges(childComplexity), true
 
-	case "MessageConnection.edges":
-		if e.complexity.MessageConnection.Edges == nil {
+	case "ContactPinConnection.pageInfo":
+		if e.complexity.ContactPinConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.MessageConnection.Edges(childComplexity), true
+		return e.complexity.ContactPinConnection.PageInfo(childComplexity), true
 
-	case "MessageConnection.pageInfo":
-		if e.complexity.MessageConnection.PageInfo == nil {
+	case "ContactPinConnection.totalCount":
+		if e.complexity.ContactPinConnection.TotalCount == nil {
 			break
 		}
 
-		return e.complexity.MessageConnection.PageInfo(childComplexity), true
+		return e.complexity.ContactPinConnection.TotalCount(childComplexity), true
 
-	case "MessageConnection.totalCount":
-		if e.complexity.MessageConnection.TotalCount == nil {
+	case "ContactPinEdge.cursor":
+		if e.complexity.ContactPinEdge.Cursor == nil {
 			break
 		}
 
-		return e.complexity.MessageConnection.TotalCount(childComplexity), true
+		return e.complexity.ContactPinEdge.Cursor(childComplexity), true
 
-	case "MessageEdge.cursor":
-		if e.complexity.MessageEdge.Cursor == nil {
+	case "ContactPinEdge.node":
+		if e.complexity.ContactPinEdge.Node == nil {
 			break
 		}
 
-		return e.complexity.MessageEdge.Cursor(childComplexity), true
+		return e.complexity.ContactPinEdge.Node(childComplexity), true
 
-	case "MessageEdge.node":
-		if e.complexity.MessageEdge.Node == nil {
+	case "ContactRequest.createdAt":
+		if e.complexity.ContactRequest.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.MessageEdge.Node(childComplexity), true
+		return e.complexity.ContactRequest.CreatedAt(childComplexity), true
 
-	case "MessageLink.createdAt":
-		if e.complexity.MessageLink.CreatedAt == nil {
+	case "ContactRequest.id":
+		if e.complexity.ContactRequest.ID == nil {
 			break
 		}
 
-		return e.complexity.MessageLink.CreatedAt(childComplexity), true
+		return e.complexity.ContactRequest.ID(childComplexity), true
 
-	case "MessageLink.description":
-		if e.complexity.MessageLink.Description == nil {
+	case "ContactRequest.receiver":
+		if e.complexity.ContactRequest.Receiver == nil {
 			break
 		}
 
-		return e.complexity.MessageLink.Description(childComplexity), true
+		return e.complexity.ContactRequest.Receiver(childComplexity), true
 
-	case "MessageLink.id":
-		if e.complexity.MessageLink.ID == nil {
+	case "ContactRequest.receiverID":
+		if e.complexity.ContactRequest.ReceiverID == nil {
 			break
 		}
 
-		return e.complexity.MessageLink.ID(childComplexity), true
+		return e.complexity.ContactRequest.ReceiverID(childComplexity), true
 
-	case "MessageLink.imageURL":
-		if e.complexity.MessageLink.ImageURL == nil {
+	case "ContactRequest.sender":
+		if e.complexity.ContactRequest.Sender == nil {
 			break
 		}
 
-		return e.complexity.MessageLink.ImageURL(childComplexity), true
+		return e.complexity.ContactRequest.Sender(childComplexity), true
 
-	case "MessageLink.link":
-		if e.complexity.MessageLink.Link == nil {
+	case "ContactRequest.senderID":
+		if e.complexity.ContactRequest.SenderID == nil {
 			break
 		}
 
-		return e.complexity.MessageLink.Link(childComplexity), true
+		return e.complexity.ContactRequest.SenderID(childComplexity), true
 
-	case "MessageLink.message":
-		if e.complexity.MessageLink.Message == nil {
+	case "ContactRequest.status":
+		if e.complexity.ContactRequest.Status == nil {
 			break
 		}
 
-		return e.complexity.MessageLink.Message(childComplexity), true
+		return e.complexity.ContactRequest.Status(childComplexity), true
 
-	case "MessageLink.room":
-		if e.complexity.MessageLink.Room == nil {
+	case "ContactRequest.updatedAt":
+		if e.complexity.ContactRequest.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.MessageLink.Room(childComplexity), true
+		return e.complexity.ContactRequest.UpdatedAt(childComplexity), true
 
-	case "MessageLink.title":
-		if e.complexity.MessageLink.Title == nil {
+	case "ContactRequestConnection.edges":
+		if e.complexity.ContactRequestConnection.Edges == nil {
 			break
 		}
 
-		return e.complexity.MessageLink.Title(childComplexity), true
+		return e.complexity.ContactRequestConnection.Edges(childComplexity), true
 
-	case "MessageLink.updatedAt":
-		if e.complexity.MessageLink.UpdatedAt == nil {
+	case "ContactRequestConnection.pageInfo":
+		if e.complexity.ContactRequestConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.MessageLink.UpdatedAt(childComplexity), true
+		return e.complexity.ContactRequestConnection.PageInfo(childComplexity), true
 
-	case "MessageLinkConnection.edges":
-		if e.complexity.MessageLinkConnection.Edges == nil {
+	case "ContactRequestConnection.totalCount":
+		if e.complexity.ContactRequestConnection.TotalCount == nil {
 			break
 		}
 
-		return e.complexity.MessageLinkConnection.Edges(childComplexity), true
+		return e.complexity.ContactRequestConnection.TotalCount(childComplexity), true
 
-	case "MessageLinkConnection.pageInfo":
-		if e.complexity.MessageLinkConnection.PageInfo == nil {
+	case "ContactRequestEdge.cursor":
+		if e.complexity.ContactRequestEdge.Cursor == nil {
 			break
 		}
 
-		return e.complexity.MessageLinkConnection.PageInfo(childComplexity), true
+		return e.complexity.ContactRequestEdge.Cursor(childComplexity), true
 
-	case "MessageLinkConnection.totalCount":
-		if e.complexity.MessageLinkConnection.TotalCount == nil {
+	case "ContactRequestEdge.node":
+		if e.complexity.ContactRequestEdge.Node == nil {
 			break
 		}
 
-		return e.complexity.MessageLinkConnection.TotalCount(childComplexity), true
+		return e.complexity.ContactRequestEdge.Node(childComplexity), true
 
-	case "MessageLinkEdge.cursor":
-		if e.complexity.MessageLinkEdge.Cursor == nil {
+	case "CreateContactPinPayload.code":
+		if e.complexity.CreateContactPinPayload.Code == nil {
 			break
 		}
 
-		return e.complexity.MessageLinkEdge.Cursor(childComplexity), true
+		return e.complexity.CreateContactPinPayload.Code(childComplexity), true
 
-	case "MessageLinkEdge.node":
-		if e.complexity.MessageLinkEdge.Node == nil {
+	case "CreateContactPinPayload.payload":
+		if e.complexity.CreateContactPinPayload.Payload == nil {
 			break
 		}
 
-		return e.complexity.MessageLinkEdge.Node(childComplexity), true
+		return e.complexity.CreateContactPinPayload.Payload(childComplexity), true
 
-	case "MessageVoice.attachedAt":
-		if e.complexity.MessageVoice.AttachedAt == nil {
+	case "CreateContactPinPayload.pin":
+		if e.complexity.CreateContactPinPayload.Pin == nil {
 			break
 		}
 
-		return e.complexity.MessageVoice.AttachedAt(childComplexity), true
+		return e.complexity.CreateContactPinPayload.Pin(childComplexity), true
 
-	case "MessageVoice.file":
-		if e.complexity.MessageVoice.File == nil {
+	case "CreateRoomInvitePayload.invite":
+		if e.complexity.CreateRoomInvitePayload.Invite == nil {
 			break
 		}
 
-		return e.complexity.MessageVoice.File(childComplexity), true
+		return e.complexity.CreateRoomInvitePayload.Invite(childComplexity), true
 
-	case "MessageVoice.id":
-		if e.complexity.MessageVoice.ID == nil {
+	case "CreateRoomInvitePayload.token":
+		if e.complexity.CreateRoomInvitePayload.Token == nil {
 			break
 		}
 
-		return e.complexity.MessageVoice.ID(childComplexity), true
+		return e.complexity.CreateRoomInvitePayload.Token(childComplexity), true
 
-	case "MessageVoice.length":
-		if e.complexity.MessageVoice.Length == nil {
+	case "Device.createdAt":
+		if e.complexity.Device.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.MessageVoice.Length(childComplexity), true
+		return e.complexity.Device.CreatedAt(childComplexity), true
 
-	case "MessageVoice.message":
-		if e.complexity.MessageVoice.Message == nil {
+	case "Device.deviceID":
+		if e.complexity.Device.DeviceID == nil {
 			break
 		}
 
-		return e.complexity.MessageVoice.Message(childComplexity), true
+		return e.complexity.Device.DeviceID(childComplexity), true
 
-	case "MessageVoice.room":
-		if e.complexity.MessageVoice.Room == nil {
+	case "Device.id":
+		if e.complexity.Device.ID == nil {
 			break
 		}
 
-		return e.complexity.MessageVoice.Room(childComplexity), true
+		return e.complexity.Device.ID(childComplexity), true
 
-	case "MessageVoiceConnection.edges":
-		if e.complexity.MessageVoiceConnection.Edges == nil {
+	case "Device.lastPushAt":
+		if e.complexity.Device.LastPushAt == nil {
 			break
 		}
 
-		return e.complexity.MessageVoiceConnection.Edges(childComplexity), true
+		return e.complexity.Device.LastPushAt(childComplexity), true
 
-	case "MessageVoiceConnection.pageInfo":
-		if e.complexity.MessageVoiceConnection.PageInfo == nil {
+	case "Device.lastPushError":
+		if e.complexity.Device.LastPushError == nil {
 			break
 		}
 
-		return e.complexity.MessageVoiceConnection.PageInfo(childComplexity), true
+		return e.complexity.Device.LastPushError(childComplexity), true
 
-	case "MessageVoiceConnection.totalCount":
-		if e.complexity.MessageVoiceConnection.TotalCount == nil {
+	case "Device.platform":
+		if e.complexity.Device.Platform == nil {
 			break
 		}
 
-		return e.complexity.MessageVoiceConnection.TotalCount(childComplexity), true
+		return e.complexity.Device.Platform(childComplexity), true
 
-	case "MessageVoiceEdge.cursor":
-		if e.complexity.MessageVoiceEdge.Cursor == nil {
+	case "Device.updatedAt":
+		if e.complexity.Device.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.MessageVoiceEdge.Cursor(childComplexity), true
+		return e.complexity.Device.UpdatedAt(childComplexity), true
 
-	case "MessageVoiceEdge.node":
-		if e.complexity.MessageVoiceEdge.Node == nil {
+	case "Device.user":
+		if e.complexity.Device.User == nil {
 			break
 		}
 
-		return e.complexity.MessageVoiceEdge.Node(childComplexity), true
+		return e.complexity.Device.User(childComplexity), true
 
-	case "Mutation.addUserContact":
-		if e.complexity.Mutation.AddUserContact == nil {
+	case "DeviceConnection.edges":
+		if e.complexity.DeviceConnection.Edges == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addUserContact_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.DeviceConnection.Edges(childComplexity), true
+
+	case "DeviceConnection.pageInfo":
+		if e.complexity.DeviceConnection.PageInfo == nil {
+			break
 		}
 
-		return e.complexity.Mutation.AddUserContact(childComplexity, args["pincode"].(string)), true
+		return e.complexity.DeviceConnection.PageInfo(childComplexity), true
 
-	case "Mutation.answerCall":
-		if e.complexity.Mutation.AnswerCall == nil {
+	case "DeviceConnection.totalCount":
+		if e.complexity.DeviceConnection.TotalCount == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_answerCall_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.DeviceConnection.TotalCount(childComplexity), true
+
+	case "DeviceEdge.cursor":
+		if e.complexity.DeviceEdge.Cursor == nil {
+			break
 		}
 
-		return e.complexity.Mutation.AnswerCall(childComplexity, args["input"].(model.CallParamsInput)), true
+		return e.complexity.DeviceEdge.Cursor(childComplexity), true
 
-	case "Mutation.createRoom":
-		if e.complexity.Mutation.CreateRoom == nil {
+	case "DeviceEdge.node":
+		if e.complexity.DeviceEdge.Node == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createRoom_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.DeviceEdge.Node(childComplexity), true
+
+	case "File.bucket":
+		if e.complexity.File.Bucket == nil {
+			break
 		}
 
-		return e.complexity.Mutation.CreateRoom(childComplexity, args["input"].(model.CreateRoomInput)), true
+		return e.complexity.File.Bucket(childComplexity), true
 
-	case "Mutation.declineCall":
-		if e.complexity.Mutation.DeclineCall == nil {
+	case "File.contentType":
+		if e.complexity.File.ContentType == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_declineCall_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.File.ContentType(childComplexity), true
+
+	case "File.createdAt":
+		if e.complexity.File.CreatedAt == nil {
+			break
 		}
 
-		return e.complexity.Mutation.DeclineCall(childComplexity, args["input"].(model.CallParamsInput)), true
+		return e.complexity.File.CreatedAt(childComplexity), true
 
-	case "Mutation.deleteMessage":
-		if e.complexity.Mutation.DeleteMessage == nil {
+	case "File.height":
+		if e.complexity.File.Height == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteMessage_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.File.Height(childComplexity), true
+
+	case "File.id":
+		if e.complexity.File.ID == nil {
+			break
 		}
 
-		return e.complexity.Mutation.DeleteMessage(childComplexity, args["messageID"].(pulid.ID)), true
+		return e.complexity.File.ID(childComplexity), true
 
-	case "Mutation.deleteRoom":
-		if e.complexity.Mutation.DeleteRoom == nil {
+	case "File.location":
+		if e.complexity.File.Location == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteRoom_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.File.Location(childComplexity), true
+
+	case "File.messageAttachment":
+		if e.complexity.File.MessageAttachment == nil {
+			break
 		}
 
-		return e.complexity.Mutation.DeleteRoom(childComplexity, args["roomID"].(pulid.ID)), true
+		return e.complexity.File.MessageAttachment(childComplexity), true
 
-	case "Mutation.deleteRoomMember":
-		if e.complexity.Mutation.DeleteRoomMember == nil {
+	case "File.messageVoice":
+		if e.complexity.File.MessageVoice == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteRoomMember_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.File.MessageVoice(childComplexity), true
+
+	case "File.name":
+		if e.complexity.File.Name == nil {
+			break
 		}
 
-		return e.complexity.Mutation.DeleteRoomMember(childComplexity, args["roomMemberID"].(pulid.ID)), true
+		return e.complexity.File.Name(childComplexity), true
 
-	case "Mutation.deleteUserContact":
-		if e.complexity.Mutation.DeleteUserContact == nil {
+	case "File.original":
+		if e.complexity.File.Original == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteUserContact_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.File.Original(childComplexity), true
+
+	case "File.path":
+		if e.complexity.File.Path == nil {
+			break
 		}
 
-		return e.complexity.Mutation.DeleteUserContact(childComplexity, args["userContactID"].(pulid.ID)), true
+		return e.complexity.File.Path(childComplexity), true
 
-	case "Mutation.endCall":
-		if e.complexity.Mutation.EndCall == nil {
+	case "File.size":
+		if e.complexity.File.Size == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_endCall_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.File.Size(childComplexity), true
+
+	case "File.thumbnails":
+		if e.complexity.File.Thumbnails == nil {
+			break
 		}
 
-		return e.complexity.Mutation.EndCall(childComplexity, args["input"].(model.CallParamsInput)), true
+		return e.complexity.File.Thumbnails(childComplexity), true
 
-	case "Mutation.generatePinCode":
-		if e.complexity.Mutation.GeneratePinCode == nil {
+	case "File.updatedAt":
+		if e.complexity.File.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.Mutation.GeneratePinCode(childComplexity), true
+		return e.complexity.File.UpdatedAt(childComplexity), true
 
-	case "Mutation.login":
-		if e.complexity.Mutation.Login == nil {
+	case "File.width":
+		if e.complexity.File.Width == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_login_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.File.Width(childComplexity), true
+
+	case "LastMessageUpdatedEvent.content":
+		if e.complexity.LastMessageUpdatedEvent.Content == nil {
+			break
 		}
 
-		return e.complexity.Mutation.Login(childComplexity, args["input"].(model.UserLoginInput)), true
+		return e.complexity.LastMessageUpdatedEvent.Content(childComplexity), true
 
-	case "Mutation.markRoomMemeberAsSeen":
-		if e.complexity.Mutation.MarkRoomMemeberAsSeen == nil {
+	case "LastMessageUpdatedEvent.createdAt":
+		if e.complexity.LastMessageUpdatedEvent.CreatedAt == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_markRoomMemeberAsSeen_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.LastMessageUpdatedEvent.CreatedAt(childComplexity), true
+
+	case "LastMessageUpdatedEvent.id":
+		if e.complexity.LastMessageUpdatedEvent.ID == nil {
+			break
 		}
 
-		return e.complexity.Mutation.MarkRoomMemeberAsSeen(childComplexity, args["roomMemberID"].(pulid.ID)), true
+		return e.complexity.LastMessageUpdatedEvent.ID(childComplexity), true
 
-	case "Mutation.register":
-		if e.complexity.Mutation.Register == nil {
+	case "LastMessageUpdatedEvent.updatedAt":
+		if e.complexity.LastMessageUpdatedEvent.UpdatedAt == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_register_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
-
-		return e.complexity.Mutation.Register(childComplexity, args["input"].(model.UserRegisterInput)), true
+		return e.complexity.LastMessageUpdatedEvent.UpdatedAt(childComplexity), true
 
-	case "Mutation.sendMessage":
-		if e.complexity.Mutation.SendMessage == nil {
+	case "LoginUser.token":
+		if e.complexity.LoginUser.Token == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_sendMessage_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.LoginUser.Token(childComplexity), true
+
+	case "LoginUser.user":
+		if e.complexity.LoginUser.User == nil {
+			break
 		}
 
-		return e.complexity.Mutation.SendMessage(childComplexity, args["input"].(model.SendMessageInput)), true
+		return e.complexity.LoginUser.User(childComplexity), true
 
-	case "Mutation.startCall":
-		if e.complexity.Mutation.StartCall == nil {
+	case "Message.attachments":
+		if e.complexity.Message.Attachments == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_startCall_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Message.Attachments(childComplexity), true
+
+	case "Message.avatar":
+		if e.complexity.Message.Avatar == nil {
+			break
 		}
 
-		return e.complexity.Mutation.StartCall(childComplexity, args["input"].(model.CallParamsInput)), true
+		return e.complexity.Message.Avatar(childComplexity), true
 
-	case "Mutation.updateMessage":
-		if e.complexity.Mutation.UpdateMessage == nil {
+	case "Message.avatarID":
+		if e.complexity.Message.AvatarID == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updateMessage_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Message.AvatarID(childComplexity), true
+
+	case "Message.call":
+		if e.complexity.Message.Call == nil {
+			break
 		}
 
-		return e.complexity.Mutation.UpdateMessage(childComplexity, args["messageID"].(pulid.ID), args["input"].(model.UpdateMessageInput)), true
+		return e.complexity.Message.Call(childComplexity), true
 
-	case "Mutation.updateRoom":
-		if e.complexity.Mutation.UpdateRoom == nil {
+	case "Message.callID":
+		if e.complexity.Message.CallID == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updateRoom_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Message.CallID(childComplexity), true
+
+	case "Message.content":
+		if e.complexity.Message.Content == nil {
+			break
 		}
 
-		return e.complexity.Mutation.UpdateRoom(childComplexity, args["roomID"].(pulid.ID), args["input"].(model.UpdateRoomInput)), true
+		return e.complexity.Message.Content(childComplexity), true
 
-	case "Notification.body":
-		if e.complexity.Notification.Body == nil {
+	case "Message.createdAt":
+		if e.complexity.Message.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.Notification.Body(childComplexity), true
+		return e.complexity.Message.CreatedAt(childComplexity), true
 
-	case "Notification.createdAt":
-		if e.complexity.Notification.CreatedAt == nil {
+	case "Message.id":
+		if e.complexity.Message.ID == nil {
 			break
 		}
 
-		return e.complexity.Notification.CreatedAt(childComplexity), true
+		return e.complexity.Message.ID(childComplexity), true
 
-	case "Notification.data":
-		if e.complexity.Notification.Data == nil {
+	case "Message.links":
+		if e.complexity.Message.Links == nil {
 			break
 		}
 
-		return e.complexity.Notification.Data(childComplexity), true
+		return e.complexity.Message.Links(childComplexity), true
 
-	case "Notification.id":
-		if e.complexity.Notification.ID == nil {
+	case "Message.replies":
+		if e.complexity.Message.Replies == nil {
 			break
 		}
 
-		return e.complexity.Notification.ID(childComplexity), true
+		return e.complexity.Message.Replies(childComplexity), true
 
-	case "Notification.title":
-		if e.complexity.Notification.Title == nil {
+	case "Message.replyTo":
+		if e.complexity.Message.ReplyTo == nil {
 			break
 		}
 
-		return e.complexity.Notification.Title(childComplexity), true
+		return e.complexity.Message.ReplyTo(childComplexity), true
 
-	case "Notification.updatedAt":
-		if e.complexity.Notification.UpdatedAt == nil {
+	case "Message.room":
+		if e.complexity.Message.Room == nil {
 			break
 		}
 
-		return e.complexity.Notification.UpdatedAt(childComplexity), true
+		return e.complexity.Message.Room(childComplexity), true
 
-	case "Notification.user":
-		if e.complexity.Notification.User == nil {
+	case "Message.type":
+		if e.complexity.Message.Type == nil {
 			break
 		}
 
-		return e.complexity.Notification.User(childComplexity), true
+		return e.complexity.Message.Type(childComplexity), true
 
-	case "NotificationConnection.edges":
-		if e.complexity.NotificationConnection.Edges == nil {
+	case "Message.updatedAt":
+		if e.complexity.Message.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.NotificationConnection.Edges(childComplexity), true
+		return e.complexity.Message.UpdatedAt(childComplexity), true
 
-	case "NotificationConnection.pageInfo":
-		if e.complexity.NotificationConnection.PageInfo == nil {
+	case "Message.user":
+		if e.complexity.Message.User == nil {
 			break
 		}
 
-		return e.complexity.NotificationConnection.PageInfo(childComplexity), true
+		return e.complexity.Message.User(childComplexity), true
 
-	case "NotificationConnection.totalCount":
-		if e.complexity.NotificationConnection.TotalCount == nil {
+	case "Message.voice":
+		if e.complexity.Message.Voice == nil {
 			break
 		}
 
-		return e.complexity.NotificationConnection.TotalCount(childComplexity), true
+		return e.complexity.Message.Voice(childComplexity), true
 
-	case "NotificationEdge.cursor":
-		if e.complexity.NotificationEdge.Cursor == nil {
+	case "MessageAttachment.attachedAt":
+		if e.complexity.MessageAttachment.AttachedAt == nil {
 			break
 		}
 
-		return e.complexity.NotificationEdge.Cursor(childComplexity), true
+		return e.complexity.MessageAttachment.AttachedAt(childComplexity), true
 
-	case "NotificationEdge.node":
-		if e.complexity.NotificationEdge.Node == nil {
+	case "MessageAttachment.file":
+		if e.complexity.MessageAttachment.File == nil {
 			break
 		}
 
-		return e.complexity.NotificationEdge.Node(childComplexity), true
+		return e.complexity.MessageAttachment.File(childComplexity), true
 
-	case "PageInfo.endCursor":
-		if e.complexity.PageInfo.EndCursor == nil {
+	case "MessageAttachment.id":
+		if e.complexity.MessageAttachment.ID == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.EndCursor(childComplexity), true
+		return e.complexity.MessageAttachment.ID(childComplexity), true
 
-	case "PageInfo.hasNextPage":
-		if e.complexity.PageInfo.HasNextPage == nil {
+	case "MessageAttachment.message":
+		if e.complexity.MessageAttachment.Message == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+		return e.complexity.MessageAttachment.Message(childComplexity), true
 
-	case "PageInfo.hasPreviousPage":
-		if e.complexity.PageInfo.HasPreviousPage == nil {
+	case "MessageAttachment.order":
+		if e.complexity.MessageAttachment.Order == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+		return e.complexity.MessageAttachment.Order(childComplexity), true
 
-	case "PageInfo.startCursor":
-		if e.complexity.PageInfo.StartCursor == nil {
+	case "MessageAttachment.room":
+		if e.complexity.MessageAttachment.Room == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.StartCursor(childComplexity), true
+		return e.complexity.MessageAttachment.Room(childComplexity), true
 
-	case "Query.callJoinToken":
-		if e.complexity.Query.CallJoinToken == nil {
+	case "MessageAttachment.type":
+		if e.complexity.MessageAttachment.Type == nil {
 			break
 		}
 
-		args, err := ec.field_Query_callJoinToken_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.MessageAttachment.Type(childComplexity), true
+
+	case "MessageAttachmentConnection.edges":
+		if e.complexity.MessageAttachmentConnection.Edges == nil {
+			break
 		}
 
-		return e.complexity.Query.CallJoinToken(childComplexity, args["roomID"].(pulid.ID)), true
+		return e.complexity.MessageAttachmentConnection.Edges(childComplexity), true
 
-	case "Query.devices":
-		if e.complexity.Query.Devices == nil {
+	case "MessageAttachmentConnection.pageInfo":
+		if e.complexity.MessageAttachmentConnection.PageInfo == nil {
 			break
 		}
 
-		args, err := ec.field_Query_devices_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.MessageAttachmentConnection.PageInfo(childComplexity), true
+
+	case "MessageAttachmentConnection.totalCount":
+		if e.complexity.MessageAttachmentConnection.TotalCount == nil {
+			break
 		}
 
-		return e.complexity.Query.Devices(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.DeviceOrder), args["where"].(*ent.DeviceWhereInput)), true
+		return e.complexity.MessageAttachmentConnection.TotalCount(childComplexity), true
 
-	case "Query.messageAttachmentsByRoom":
-		if e.complexity.Query.MessageAttachmentsByRoom == nil {
+	case "MessageAttachmentEdge.cursor":
+		if e.complexity.MessageAttachmentEdge.Cursor == nil {
 			break
 		}
 
-		args, err := ec.field_Query_messageAttachmentsByRoom_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.MessageAttachmentEdge.Cursor(childComplexity), true
+
+	case "MessageAttachmentEdge.node":
+		if e.complexity.MessageAttachmentEdge.Node == nil {
+			break
 		}
 
-		return e.complexity.Query.MessageAttachmentsByRoom(childComplexity, args["roomID"].(pulid.ID), args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.MessageAttachmentOrder), args["where"].(*ent.MessageAttachmentWhereInput)), true
+		return e.complexity.MessageAttachmentEdge.Node(childComplexity), true
 
-	case "Query.messageLinksByRoom":
-		if e.complexity.Query.MessageLinksByRoom == nil {
+	case "MessageConnection.edges":
+		if e.complexity.MessageConnection.Edges == nil {
 			break
 		}
 
-		args, err := ec.field_Query_messageLinksByRoom_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.MessageConnection.Edges(childComplexity), true
+
+	case "MessageConnection.pageInfo":
+		if e.complexity.MessageConnection.PageInfo == nil {
+			break
 		}
 
-		return e.complexity.Query.MessageLinksByRoom(childComplexity, args["roomID"].(pulid.ID), args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.MessageLinkOrder), args["where"].(*ent.MessageLinkWhereInput)), true
+		return e.complexity.MessageConnection.PageInfo(childComplexity), true
 
-	case "Query.messageVoicesByRoom":
-		if e.complexity.Query.MessageVoicesByRoom == nil {
+	case "MessageConnection.totalCount":
+		if e.complexity.MessageConnection.TotalCount == nil {
 			break
 		}
 
-		args, err := ec.field_Query_messageVoicesByRoom_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.MessageConnection.TotalCount(childComplexity), true
+
+	case "MessageEdge.cursor":
+		if e.complexity.MessageEdge.Cursor == nil {
+			break
 		}
 
-		return e.complexity.Query.MessageVoicesByRoom(childComplexity, args["roomID"].(pulid.ID), args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.MessageVoiceOrder), args["where"].(*ent.MessageVoiceWhereInput)), true
+		return e.complexity.MessageEdge.Cursor(childComplexity), true
 
-	case "Query.messagesByRoom":
-		if e.complexity.Query.MessagesByRoom == nil {
+	case "MessageEdge.node":
+		if e.complexity.MessageEdge.Node == nil {
 			break
 		}
 
-		args, err := ec.field_Query_messagesByRoom_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.MessageEdge.Node(childComplexity), true
+
+	case "MessageLink.createdAt":
+		if e.complexity.MessageLink.CreatedAt == nil {
+			break
 		}
 
-		return e.complexity.Query.MessagesByRoom(childComplexity, args["roomID"].(pulid.ID), args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.MessageOrder), args["where"].(*ent.MessageWhereInput)), true
+		return e.complexity.MessageLink.CreatedAt(childComplexity), true
 
-	case "Query.node":
-		if e.complexity.Query.Node == nil {
+	case "MessageLink.description":
+		if e.complexity.MessageLink.Description == nil {
 			break
 		}
 
-		args, err := ec.field_Query_node_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.MessageLink.Description(childComplexity), true
+
+	case "MessageLink.id":
+		if e.complexity.MessageLink.ID == nil {
+			break
 		}
 
-		return e.complexity.Query.Node(childComplexity, args["id"].(pulid.ID)), true
+		return e.complexity.MessageLink.ID(childComplexity), true
 
-	case "Query.nodes":
-		if e.complexity.Query.Nodes == nil {
+	case "MessageLink.imageURL":
+		if e.complexity.MessageLink.ImageURL == nil {
 			break
 		}
 
-		args, err := ec.field_Query_nodes_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.MessageLink.ImageURL(childComplexity), true
+
+	case "MessageLink.link":
+		if e.complexity.MessageLink.Link == nil {
+			break
 		}
 
-		return e.complexity.Query.Nodes(childComplexity, args["ids"].([]pulid.ID)), true
+		return e.complexity.MessageLink.Link(childComplexity), true
 
-	case "Query.notifications":
-		if e.complexity.Query.Notifications == nil {
+	case "MessageLink.message":
+		if e.complexity.MessageLink.Message == nil {
 			break
 		}
 
-		args, err := ec.field_Query_notifications_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.MessageLink.Message(childComplexity), true
+
+	case "MessageLink.room":
+		if e.complexity.MessageLink.Room == nil {
+			break
 		}
 
-		return e.complexity.Query.Notifications(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.NotificationOrder), args["where"].(*ent.NotificationWhereInput)), true
+		return e.complexity.MessageLink.Room(childComplexity), true
 
-	case "Query.room":
-		if e.complexity.Query.Room == nil {
+	case "MessageLink.title":
+		if e.complexity.MessageLink.Title == nil {
 			break
 		}
 
-		args, err := ec.field_Query_room_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.MessageLink.Title(childComplexity), true
+
+	case "MessageLink.updatedAt":
+		if e.complexity.MessageLink.UpdatedAt == nil {
+			break
 		}
 
-		return e.complexity.Query.Room(childComplexity, args["roomID"].(pulid.ID)), true
+		return e.complexity.MessageLink.UpdatedAt(childComplexity), true
 
-	case "Query.roomMembers":
-		if e.complexity.Query.RoomMembers == nil {
+	case "MessageLinkConnection.edges":
+		if e.complexity.MessageLinkConnection.Edges == nil {
 			break
 		}
 
-		args, err := ec.field_Query_roomMembers_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.MessageLinkConnection.Edges(childComplexity), true
+
+	case "MessageLinkConnection.pageInfo":
+		if e.complexity.MessageLinkConnection.PageInfo == nil {
+			break
 		}
 
-		return e.complexity.Query.RoomMembers(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.RoomMemberOrder), args["where"].(*ent.RoomMemberWhereInput)), true
+		return e.complexity.MessageLinkConnection.PageInfo(childComplexity), true
 
-	case "Query.roomMembersByRoom":
-		if e.complexity.Query.RoomMembersByRoom == nil {
+	case "MessageLinkConnection.totalCount":
+		if e.complexity.MessageLinkConnection.TotalCount == nil {
 			break
 		}
 
-		args, err := ec.field_Query_roomMembersByRoom_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.MessageLinkConnection.TotalCount(childComplexity), true
+
+	case "MessageLinkEdge.cursor":
+		if e.complexity.MessageLinkEdge.Cursor == nil {
+			break
 		}
 
-		return e.complexity.Query.RoomMembersByRoom(childComplexity, args["roomID"].(pulid.ID), args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.RoomMemberOrder), args["where"].(*ent.RoomMemberWhereInput)), true
+		return e.complexity.MessageLinkEdge.Cursor(childComplexity), true
 
-	case "Query.self":
-		if e.complexity.Query.Self == nil {
+	case "MessageLinkEdge.node":
+		if e.complexity.MessageLinkEdge.Node == nil {
 			break
 		}
 
-		return e.complexity.Query.Self(childComplexity), true
+		return e.complexity.MessageLinkEdge.Node(childComplexity), true
 
-	case "Query.userContact":
-		if e.complexity.Query.UserContact == nil {
+	case "MessageVoice.attachedAt":
+		if e.complexity.MessageVoice.AttachedAt == nil {
 			break
 		}
 
-		args, err := ec.field_Query_userContact_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.MessageVoice.AttachedAt(childComplexity), true
+
+	case "MessageVoice.file":
+		if e.complexity.MessageVoice.File == nil {
+			break
 		}
 
-		return e.complexity.Query.UserContact(childComplexity, args["userContactID"].(pulid.ID)), true
+		return e.complexity.MessageVoice.File(childComplexity), true
 
-	case "Query.userContacts":
-		if e.complexity.Query.UserContacts == nil {
+	case "MessageVoice.id":
+		if e.complexity.MessageVoice.ID == nil {
 			break
 		}
 
-		args, err := ec.field_Query_userContacts_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.MessageVoice.ID(childComplexity), true
+
+	case "MessageVoice.length":
+		if e.complexity.MessageVoice.Length == nil {
+			break
 		}
 
-		return e.complexity.Query.UserContacts(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.UserContactOrder), args["where"].(*ent.UserContactWhereInput)), true
+		return e.complexity.MessageVoice.Length(childComplexity), true
 
-	case "Room.createdAt":
-		if e.complexity.Room.CreatedAt == nil {
+	case "MessageVoice.message":
+		if e.complexity.MessageVoice.Message == nil {
 			break
 		}
 
-		return e.complexity.Room.CreatedAt(childComplexity), true
+		return e.complexity.MessageVoice.Message(childComplexity), true
 
-	case "Room.description":
-		if e.complexity.Room.Description == nil {
+	case "MessageVoice.room":
+		if e.complexity.MessageVoice.Room == nil {
 			break
 		}
 
-		return e.complexity.Room.Description(childComplexity), true
+		return e.complexity.MessageVoice.Room(childComplexity), true
 
-	case "Room.id":
-		if e.complexity.Room.ID == nil {
+	case "MessageVoiceConnection.edges":
+		if e.complexity.MessageVoiceConnection.Edges == nil {
 			break
 		}
 
-		return e.complexity.Room.ID(childComplexity), true
+		return e.complexity.MessageVoiceConnection.Edges(childComplexity), true
 
-	case "Room.lastMessage":
-		if e.complexity.Room.LastMessage == nil {
+	case "MessageVoiceConnection.pageInfo":
+		if e.complexity.MessageVoiceConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.Room.LastMessage(childComplexity), true
+		return e.complexity.MessageVoiceConnection.PageInfo(childComplexity), true
 
-	case "Room.messageAttachments":
-		if e.complexity.Room.MessageAttachments == nil {
+	case "MessageVoiceConnection.totalCount":
+		if e.complexity.MessageVoiceConnection.TotalCount == nil {
 			break
 		}
 
-		args, err := ec.field_Room_messageAttachments_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.MessageVoiceConnection.TotalCount(childComplexity), true
+
+	case "MessageVoiceEdge.cursor":
+		if e.complexity.MessageVoiceEdge.Cursor == nil {
+			break
 		}
 
-		return e.complexity.Room.MessageAttachments(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.MessageAttachmentOrder), args["where"].(*ent.MessageAttachmentWhereInput)), true
+		return e.complexity.MessageVoiceEdge.Cursor(childComplexity), true
 
-	case "Room.messageLinks":
-		if e.complexity.Room.MessageLinks == nil {
+	case "MessageVoiceEdge.node":
+		if e.complexity.MessageVoiceEdge.Node == nil {
 			break
 		}
 
-		args, err := ec.field_Room_messageLinks_args(context.TODO(), rawArgs)
+		return e.complexity.MessageVoiceEdge.Node(childComplexity), true
+
+	case "Mutation.acceptContactRequest":
+		if e.complexity.Mutation.AcceptContactRequest == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_acceptContactRequest_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Room.MessageLinks(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.MessageLinkOrder), args["where"].(*ent.MessageLinkWhereInput)), true
+		return e.complexity.Mutation.AcceptContactRequest(childComplexity, args["contactRequestID"].(pulid.ID)), true
 
-	case "Room.messageVoices":
-		if e.complexity.Room.MessageVoices == nil {
+	case "Mutation.addUserContact":
+		if e.complexity.Mutation.AddUserContact == nil {
 			break
 		}
 
-		args, err := ec.field_Room_messageVoices_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_addUserContact_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Room.MessageVoices(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.MessageVoiceOrder), args["where"].(*ent.MessageVoiceWhereInput)), true
+		return e.complexity.Mutation.AddUserContact(childComplexity, args["pincode"].(string)), true
 
-	case "Room.messages":
-		if e.complexity.Room.Messages == nil {
+	case "Mutation.addUserContactByPayload":
+		if e.complexity.Mutation.AddUserContactByPayload == nil {
 			break
 		}
 
-		args, err := ec.field_Room_messages_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_addUserContactByPayload_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Room.Messages(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.MessageOrder), args["where"].(*ent.MessageWhereInput)), true
+		return e.complexity.Mutation.AddUserContactByPayload(childComplexity, args["payload"].(string)), true
 
-	case "Room.name":
-		if e.complexity.Room.Name == nil {
+	case "Mutation.answerCall":
+		if e.complexity.Mutation.AnswerCall == nil {
 			break
 		}
 
-		return e.complexity.Room.Name(childComplexity), true
+		args, err := ec.field_Mutation_answerCall_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Room.roomMembers":
-		if e.complexity.Room.RoomMembers == nil {
+		return e.complexity.Mutation.AnswerCall(childComplexity, args["callID"].(pulid.ID)), true
+
+	case "Mutation.assignReport":
+		if e.complexity.Mutation.AssignReport == nil {
 			break
 		}
 
-		args, err := ec.field_Room_roomMembers_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_assignReport_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Room.RoomMembers(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.RoomMemberOrder), args["where"].(*ent.RoomMemberWhereInput)), true
+		return e.complexity.Mutation.AssignReport(childComplexity, args["reportID"].(pulid.ID), args["assigneeID"].(*pulid.ID)), true
 
-	case "Room.type":
-		if e.complexity.Room.Type == nil {
+	case "Mutation.banRoomMember":
+		if e.complexity.Mutation.BanRoomMember == nil {
 			break
 		}
 
-		return e.complexity.Room.Type(childComplexity), true
-
-	case "Room.updatedAt":
-		if e.complexity.Room.UpdatedAt == nil {
-			break
+		args, err := ec.field_Mutation_banRoomMember_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.Room.UpdatedAt(childComplexity), true
+		return e.complexity.Mutation.BanRoomMember(childComplexity, args["roomMemberID"].(pulid.ID)), true
 
-	case "Room.userContacts":
-		if e.complexity.Room.UserContacts == nil {
+	case "Mutation.blockUser":
+		if e.complexity.Mutation.BlockUser == nil {
 			break
 		}
 
-		return e.complexity.Room.UserContacts(childComplexity), true
+		args, err := ec.field_Mutation_blockUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Room.users":
-		if e.complexity.Room.Users == nil {
+		return e.complexity.Mutation.BlockUser(childComplexity, args["userID"].(pulid.ID)), true
+
+	case "Mutation.createContactPin":
+		if e.complexity.Mutation.CreateContactPin == nil {
 			break
 		}
 
-		args, err := ec.field_Room_users_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_createContactPin_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Room.Users(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.UserOrder), args["where"].(*ent.UserWhereInput)), true
+		return e.complexity.Mutation.CreateContactPin(childComplexity, args["input"].(model.CreateContactPinInput)), true
 
-	case "Room.version":
-		if e.complexity.Room.Version == nil {
+	case "Mutation.createRoom":
+		if e.complexity.Mutation.CreateRoom == nil {
 			break
 		}
 
-		return e.complexity.Room.Version(childComplexity), true
-
-	case "RoomConnection.edges":
-		if e.complexity.RoomConnection.Edges == nil {
-			break
+		args, err := ec.field_Mutation_createRoom_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.RoomConnection.Edges(childComplexity), true
+		return e.complexity.Mutation.CreateRoom(childComplexity, args["input"].(model.CreateRoomInput)), true
 
-	case "RoomConnection.pageInfo":
-		if e.complexity.RoomConnection.PageInfo == nil {
+	case "Mutation.createRoomInvite":
+		if e.complexity.Mutation.CreateRoomInvite == nil {
 			break
 		}
 
-		return e.complexity.RoomConnection.PageInfo(childComplexity), true
-
-	case "RoomConnection.totalCount":
-		if e.complexity.RoomConnection.TotalCount == nil {
-			break
+		args, err := ec.field_Mutation_createRoomInvite_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.RoomConnection.TotalCount(childComplexity), true
+		return e.complexity.Mutation.CreateRoomInvite(childComplexity, args["input"].(model.CreateRoomInviteInput)), true
 
-	case "RoomEdge.cursor":
-		if e.complexity.RoomEdge.Cursor == nil {
+	case "Mutation.declineCall":
+		if e.complexity.Mutation.DeclineCall == nil {
 			break
 		}
 
-		return e.complexity.RoomEdge.Cursor(childComplexity), true
-
-	case "RoomEdge.node":
-		if e.complexity.RoomEdge.Node == nil {
-			break
+		args, err := ec.field_Mutation_declineCall_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.RoomEdge.Node(childComplexity), true
+		return e.complexity.Mutation.DeclineCall(childComplexity, args["callID"].(pulid.ID)), true
 
-	case "RoomMember.id":
-		if e.complexity.RoomMember.ID == nil {
+	case "Mutation.declineContactRequest":
+		if e.complexity.Mutation.DeclineContactRequest == nil {
 			break
 		}
 
-		return e.complexity.RoomMember.ID(childComplexity), true
-
-	case "RoomMember.joinedAt":
-		if e.complexity.RoomMember.JoinedAt == nil {
-			break
+		args, err := ec.field_Mutation_declineContactRequest_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.RoomMember.JoinedAt(childComplexity), true
+		return e.complexity.Mutation.DeclineContactRequest(childComplexity, args["contactRequestID"].(pulid.ID)), true
 
-	case "RoomMember.name":
-		if e.complexity.RoomMember.Name == nil {
+	case "Mutation.deleteMessage":
+		if e.complexity.Mutation.DeleteMessage == nil {
 			break
 		}
 
-		return e.complexity.RoomMember.Name(childComplexity), true
-
-	case "RoomMember.room":
-		if e.complexity.RoomMember.Room == nil {
-			break
+		args, err := ec.field_Mutation_deleteMessage_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.RoomMember.Room(childComplexity), true
+		return e.complexity.Mutation.DeleteMessage(childComplexity, args["messageID"].(pulid.ID)), true
 
-	case "RoomMember.roomID":
-		if e.complexity.RoomMember.RoomID == nil {
+	case "Mutation.deleteRoom":
+		if e.complexity.Mutation.DeleteRoom == nil {
 			break
 		}
 
-		return e.complexity.RoomMember.RoomID(childComplexity), true
+		args, err := ec.field_Mutation_deleteRoom_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "RoomMember.unreadMessagesCount":
-		if e.complexity.RoomMember.UnreadMessagesCount == nil {
+		return e.complexity.Mutation.DeleteRoom(childComplexity, args["roomID"].(pulid.ID)), true
+
+	case "Mutation.deleteRoomAvatar":
+		if e.complexity.Mutation.DeleteRoomAvatar == nil {
 			break
 		}
 
-		return e.complexity.RoomMember.UnreadMessagesCount(childComplexity), true
-
-	case "RoomMember.updatedAt":
-		if e.complexity.RoomMember.UpdatedAt == nil {
-			break
+		args, err := ec.field_Mutation_deleteRoomAvatar_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.RoomMember.UpdatedAt(childComplexity), true
+		return e.complexity.Mutation.DeleteRoomAvatar(childComplexity, args["roomID"].(pulid.ID)), true
 
-	case "RoomMember.user":
-		if e.complexity.RoomMember.User == nil {
+	case "Mutation.deleteRoomMember":
+		if e.complexity.Mutation.DeleteRoomMember == nil {
 			break
 		}
 
-		return e.complexity.RoomMember.User(childComplexity), true
-
-	case "RoomMember.userID":
-		if e.complexity.RoomMember.UserID == nil {
-			break
+		args, err := ec.field_Mutation_deleteRoomMember_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.RoomMember.UserID(childComplexity), true
+		return e.complexity.Mutation.DeleteRoomMember(childComplexity, args["roomMemberID"].(pulid.ID)), true
 
-	case "RoomMemberConnection.edges":
-		if e.complexity.RoomMemberConnection.Edges == nil {
+	case "Mutation.deleteUserAvatar":
+		if e.complexity.Mutation.DeleteUserAvatar == nil {
 			break
 		}
 
-		return e.complexity.RoomMemberConnection.Edges(childComplexity), true
+		return e.complexity.Mutation.DeleteUserAvatar(childComplexity), true
 
-	case "RoomMemberConnection.pageInfo":
-		if e.complexity.RoomMemberConnection.PageInfo == nil {
+	case "Mutation.deleteUserContact":
+		if e.complexity.Mutation.DeleteUserContact == nil {
 			break
 		}
 
-		return e.complexity.RoomMemberConnection.PageInfo(childComplexity), true
-
-	case "RoomMemberConnection.totalCount":
-		if e.complexity.RoomMemberConnection.TotalCount == nil {
-			break
+		args, err := ec.field_Mutation_deleteUserContact_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.RoomMemberConnection.TotalCount(childComplexity), true
+		return e.complexity.Mutation.DeleteUserContact(childComplexity, args["userContactID"].(pulid.ID)), true
 
-	case "RoomMemberEdge.cursor":
-		if e.complexity.RoomMemberEdge.Cursor == nil {
+	case "Mutation.demoteRoomMember":
+		if e.complexity.Mutation.DemoteRoomMember == nil {
 			break
 		}
 
-		return e.complexity.RoomMemberEdge.Cursor(childComplexity), true
-
-	case "RoomMemberEdge.node":
-		if e.complexity.RoomMemberEdge.Node == nil {
-			break
+		args, err := ec.field_Mutation_demoteRoomMember_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.RoomMemberEdge.Node(childComplexity), true
+		return e.complexity.Mutation.DemoteRoomMember(childComplexity, args["roomMemberID"].(pulid.ID)), true
 
-	case "RoomMemberUpdatedEvent.createdAt":
-		if e.complexity.RoomMemberUpdatedEvent.CreatedAt == nil {
+	case "Mutation.dismissReport":
+		if e.complexity.Mutation.DismissReport == nil {
 			break
 		}
 
-		return e.complexity.RoomMemberUpdatedEvent.CreatedAt(childComplexity), true
-
-	case "RoomMemberUpdatedEvent.id":
-		if e.complexity.RoomMemberUpdatedEvent.ID == nil {
-			break
+		args, err := ec.field_Mutation_dismissReport_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.RoomMemberUpdatedEvent.ID(childComplexity), true
+		return e.complexity.Mutation.DismissReport(childComplexity, args["reportID"].(pulid.ID), args["note"].(*string)), true
 
-	case "RoomMemberUpdatedEvent.lastMessage":
-		if e.complexity.RoomMemberUpdatedEvent.LastMessage == nil {
+	case "Mutation.endCall":
+		if e.complexity.Mutation.EndCall == nil {
 			break
 		}
 
-		return e.complexity.RoomMemberUpdatedEvent.LastMessage(childComplexity), true
-
-	case "RoomMemberUpdatedEvent.name":
-		if e.complexity.RoomMemberUpdatedEvent.Name == nil {
-			break
+		args, err := ec.field_Mutation_endCall_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.RoomMemberUpdatedEvent.Name(childComplexity), true
+		return e.complexity.Mutation.EndCall(childComplexity, args["callID"].(pulid.ID)), true
 
-	case "RoomMemberUpdatedEvent.type":
-		if e.complexity.RoomMemberUpdatedEvent.Type == nil {
+	case "Mutation.joinRoomByInvite":
+		if e.complexity.Mutation.JoinRoomByInvite == nil {
 			break
 		}
 
-		return e.complexity.RoomMemberUpdatedEvent.Type(childComplexity), true
-
-	case "RoomMemberUpdatedEvent.updatedAt":
-		if e.complexity.RoomMemberUpdatedEvent.UpdatedAt == nil {
-			break
+		args, err := ec.field_Mutation_joinRoomByInvite_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.RoomMemberUpdatedEvent.UpdatedAt(childComplexity), true
+		return e.complexity.Mutation.JoinRoomByInvite(childComplexity, args["token"].(string)), true
 
-	case "RoomMemberUpdatedEvent.version":
-		if e.complexity.RoomMemberUpdatedEvent.Version == nil {
+	case "Mutation.kickRoomMember":
+		if e.complexity.Mutation.KickRoomMember == nil {
 			break
 		}
 
-		return e.complexity.RoomMemberUpdatedEvent.Version(childComplexity), true
+		args, err := ec.field_Mutation_kickRoomMember_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Subscription.messageCreated":
-		if e.complexity.Subscription.MessageCreated == nil {
+		return e.complexity.Mutation.KickRoomMember(childComplexity, args["roomMemberID"].(pulid.ID)), true
+
+	case "Mutation.login":
+		if e.complexity.Mutation.Login == nil {
 			break
 		}
 
-		args, err := ec.field_Subscription_messageCreated_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_login_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Subscription.MessageCreated(childComplexity, args["roomID"].(pulid.ID)), true
+		return e.complexity.Mutation.Login(childComplexity, args["input"].(model.UserLoginInput)), true
 
-	case "Subscription.messageDeleted":
-		if e.complexity.Subscription.MessageDeleted == nil {
+	case "Mutation.markNotificationsRead":
+		if e.complexity.Mutation.MarkNotificationsRead == nil {
 			break
 		}
 
-		args, err := ec.field_Subscription_messageDeleted_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_markNotificationsRead_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Subscription.MessageDeleted(childComplexity, args["roomID"].(pulid.ID)), true
+		return e.complexity.Mutation.MarkNotificationsRead(childComplexity, args["notificationIDs"].([]pulid.ID)), true
 
-	case "Subscription.messageUpdated":
-		if e.complexity.Subscription.MessageUpdated == nil {
+	case "Mutation.markRoomMemeberAsSeen":
+		if e.complexity.Mutation.MarkRoomMemeberAsSeen == nil {
 			break
 		}
 
-		args, err := ec.field_Subscription_messageUpdated_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_markRoomMemeberAsSeen_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Subscription.MessageUpdated(childComplexity, args["roomID"].(pulid.ID)), true
+		return e.complexity.Mutation.MarkRoomMemeberAsSeen(childComplexity, args["roomMemberID"].(pulid.ID)), true
 
-	case "Subscription.roomMemberCreated":
-		if e.complexity.Subscription.RoomMemberCreated == nil {
+	case "Mutation.promoteRoomMember":
+		if e.complexity.Mutation.PromoteRoomMember == nil {
 			break
 		}
 
-		return e.complexity.Subscription.RoomMemberCreated(childComplexity), true
-
-	case "Subscription.roomMemberDeleted":
-		if e.complexity.Subscription.RoomMemberDeleted == nil {
-			break
+		args, err := ec.field_Mutation_promoteRoomMember_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.Subscription.RoomMemberDeleted(childComplexity), true
+		return e.complexity.Mutation.PromoteRoomMember(childComplexity, args["roomMemberID"].(pulid.ID)), true
 
-	case "Subscription.roomMemberUpdated":
-		if e.complexity.Subscription.RoomMemberUpdated == nil {
+	case "Mutation.register":
+		if e.complexity.Mutation.Register == nil {
 			break
 		}
 
-		return e.complexity.Subscription.RoomMemberUpdated(childComplexity), true
-
-	case "User.contactPin":
-		if e.complexity.User.ContactPin == nil {
-			break
+		args, err := ec.field_Mutation_register_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.User.ContactPin(childComplexity), true
+		return e.complexity.Mutation.Register(childComplexity, args["input"].(model.UserRegisterInput)), true
 
-	case "User.contacts":
-		if e.complexity.User.Contacts == nil {
+	case "Mutation.reportMessage":
+		if e.complexity.Mutation.ReportMessage == nil {
 			break
 		}
 
-		args, err := ec.field_User_contacts_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_reportMessage_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.User.Contacts(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.UserOrder), args["where"].(*ent.UserWhereInput)), true
+		return e.complexity.Mutation.ReportMessage(childComplexity, args["messageID"].(pulid.ID), args["input"].(model.ReportInput)), true
 
-	case "User.createdAt":
-		if e.complexity.User.CreatedAt == nil {
+	case "Mutation.reportRoom":
+		if e.complexity.Mutation.ReportRoom == nil {
 			break
 		}
 
-		return e.complexity.User.CreatedAt(childComplexity), true
-
-	case "User.device":
-		if e.complexity.User.Device == nil {
-			break
+		args, err := ec.field_Mutation_reportRoom_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.User.Device(childComplexity), true
+		return e.complexity.Mutation.ReportRoom(childComplexity, args["roomID"].(pulid.ID), args["input"].(model.ReportInput)), true
 
-	case "User.email":
-		if e.complexity.User.Email == nil {
+	case "Mutation.reportUser":
+		if e.complexity.Mutation.ReportUser == nil {
 			break
 		}
 
-		return e.complexity.User.Email(childComplexity), true
+		args, err := ec.field_Mutation_reportUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "User.firstName":
-		if e.complexity.User.FirstName == nil {
+		return e.complexity.Mutation.ReportUser(childComplexity, args["userID"].(pulid.ID), args["input"].(model.ReportInput)), true
+
+	case "Mutation.resolveReport":
+		if e.complexity.Mutation.ResolveReport == nil {
 			break
 		}
 
-		return e.complexity.User.FirstName(childComplexity), true
+		args, err := ec.field_Mutation_resolveReport_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "User.id":
-		if e.complexity.User.ID == nil {
+		return e.complexity.Mutation.ResolveReport(childComplexity, args["reportID"].(pulid.ID), args["input"].(model.ResolveReportInput)), true
+
+	case "Mutation.revokeContactPin":
+		if e.complexity.Mutation.RevokeContactPin == nil {
 			break
 		}
 
-		return e.complexity.User.ID(childComplexity), true
+		args, err := ec.field_Mutation_revokeContactPin_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "User.lastName":
-		if e.complexity.User.LastName == nil {
+		return e.complexity.Mutation.RevokeContactPin(childComplexity, args["pinID"].(pulid.ID)), true
+
+	case "Mutation.revokeRoomInvite":
+		if e.complexity.Mutation.RevokeRoomInvite == nil {
 			break
 		}
 
-		return e.complexity.User.LastName(childComplexity), true
+		args, err := ec.field_Mutation_revokeRoomInvite_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "User.memberships":
-		if e.complexity.User.Memberships == nil {
+		return e.complexity.Mutation.RevokeRoomInvite(childComplexity, args["inviteID"].(pulid.ID)), true
+
+	case "Mutation.sendMessage":
+		if e.complexity.Mutation.SendMessage == nil {
 			break
 		}
 
-		args, err := ec.field_User_memberships_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_sendMessage_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.User.Memberships(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.RoomMemberOrder), args["where"].(*ent.RoomMemberWhereInput)), true
+		return e.complexity.Mutation.SendMessage(childComplexity, args["input"].(model.SendMessageInput)), true
 
-	case "User.messages":
-		if e.complexity.User.Messages == nil {
+	case "Mutation.startCall":
+		if e.complexity.Mutation.StartCall == nil {
 			break
 		}
 
-		args, err := ec.field_User_messages_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_startCall_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.User.Messages(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.MessageOrder), args["where"].(*ent.MessageWhereInput)), true
+		return e.complexity.Mutation.StartCall(childComplexity, args["input"].(model.StartCallInput)), true
 
-	case "User.nickname":
-		if e.complexity.User.Nickname == nil {
+	case "Mutation.unblockUser":
+		if e.complexity.Mutation.UnblockUser == nil {
 			break
 		}
 
-		return e.complexity.User.Nickname(childComplexity), true
+		args, err := ec.field_Mutation_unblockUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "User.notifications":
-		if e.complexity.User.Notifications == nil {
+		return e.complexity.Mutation.UnblockUser(childComplexity, args["userID"].(pulid.ID)), true
+
+	case "Mutation.updateMessage":
+		if e.complexity.Mutation.UpdateMessage == nil {
 			break
 		}
 
-		args, err := ec.field_User_notifications_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_updateMessage_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.User.Notifications(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.NotificationOrder), args["where"].(*ent.NotificationWhereInput)), true
+		return e.complexity.Mutation.UpdateMessage(childComplexity, args["messageID"].(pulid.ID), args["input"].(model.UpdateMessageInput)), true
 
-	case "User.rooms":
-		if e.complexity.User.Rooms == nil {
+	case "Mutation.updateRoom":
+		if e.complexity.Mutation.UpdateRoom == nil {
 			break
 		}
 
-		args, err := ec.field_User_rooms_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_updateRoom_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.User.Rooms(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.RoomOrder), args["where"].(*ent.RoomWhereInput)), true
+		return e.complexity.Mutation.UpdateRoom(childComplexity, args["roomID"].(pulid.ID), args["input"].(model.UpdateRoomInput)), true
 
-	case "User.updatedAt":
-		if e.complexity.User.UpdatedAt == nil {
+	case "Mutation.updateRoomAvatar":
+		if e.complexity.Mutation.UpdateRoomAvatar == nil {
 			break
 		}
 
-		return e.complexity.User.UpdatedAt(childComplexity), true
+		args, err := ec.field_Mutation_updateRoomAvatar_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "User.userContacts":
-		if e.complexity.User.UserContacts == nil {
+		return e.complexity.Mutation.UpdateRoomAvatar(childComplexity, args["roomID"].(pulid.ID), args["input"].(model.UpdateAvatarInput)), true
+
+	case "Mutation.updateRoomMemberNotifications":
+		if e.complexity.Mutation.UpdateRoomMemberNotifications == nil {
 			break
 		}
 
-		args, err := ec.field_User_userContacts_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_updateRoomMemberNotifications_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.User.UserContacts(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.UserContactOrder), args["where"].(*ent.UserContactWhereInput)), true
+		return e.complexity.Mutation.UpdateRoomMemberNotifications(childComplexity, args["roomMemberID"].(pulid.ID), args["input"].(model.UpdateRoomMemberNotificationsInput)), true
 
-	case "UserConnection.edges":
-		if e.complexity.UserConnection.Edges == nil {
+	case "Mutation.updateUserAvatar":
+		if e.complexity.Mutation.UpdateUserAvatar == nil {
 			break
 		}
 
-		return e.complexity.UserConnection.Edges(childComplexity), true
+		args, err := ec.field_Mutation_updateUserAvatar_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "UserConnection.pageInfo":
-		if e.complexity.UserConnection.PageInfo == nil {
+		return e.complexity.Mutation.UpdateUserAvatar(childComplexity, args["input"].(model.UpdateAvatarInput)), true
+
+	case "Mutation.updateUserSettings":
+		if e.complexity.Mutation.UpdateUserSettings == nil {
 			break
 		}
 
-		return e.complexity.UserConnection.PageInfo(childComplexity), true
+		args, err := ec.field_Mutation_updateUserSettings_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "UserConnection.totalCount":
-		if e.complexity.UserConnection.TotalCount == nil {
+		return e.complexity.Mutation.UpdateUserSettings(childComplexity, args["input"].(model.UpdateUserSettingsInput)), true
+
+	case "Notification.body":
+		if e.complexity.Notification.Body == nil {
 			break
 		}
 
-		return e.complexity.UserConnection.TotalCount(childComplexity), true
+		return e.complexity.Notification.Body(childComplexity), true
 
-	case "UserContact.contact":
-		if e.complexity.UserContact.Contact == nil {
+	case "Notification.createdAt":
+		if e.complexity.Notification.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.UserContact.Contact(childComplexity), true
+		return e.complexity.Notification.CreatedAt(childComplexity), true
 
-	case "UserContact.contactID":
-		if e.complexity.UserContact.ContactID == nil {
+	case "Notification.data":
+		if e.complexity.Notification.Data == nil {
 			break
 		}
 
-		return e.complexity.UserContact.ContactID(childComplexity), true
+		return e.complexity.Notification.Data(childComplexity), true
 
-	case "UserContact.createdAt":
-		if e.complexity.UserContact.CreatedAt == nil {
+	case "Notification.id":
+		if e.complexity.Notification.ID == nil {
 			break
 		}
 
-		return e.complexity.UserContact.CreatedAt(childComplexity), true
+		return e.complexity.Notification.ID(childComplexity), true
 
-	case "UserContact.id":
-		if e.complexity.UserContact.ID == nil {
+	case "Notification.readAt":
+		if e.complexity.Notification.ReadAt == nil {
 			break
 		}
 
-		return e.complexity.UserContact.ID(childComplexity), true
+		return e.complexity.Notification.ReadAt(childComplexity), true
 
-	case "UserContact.room":
-		if e.complexity.UserContact.Room == nil {
+	case "Notification.title":
+		if e.complexity.Notification.Title == nil {
 			break
 		}
 
-		return e.complexity.UserContact.Room(childComplexity), true
+		return e.complexity.Notification.Title(childComplexity), true
 
-	case "UserContact.roomID":
-		if e.complexity.UserContact.RoomID == nil {
+	case "Notification.type":
+		if e.complexity.Notification.Type == nil {
 			break
 		}
 
-		return e.complexity.UserContact.RoomID(childComplexity), true
+		return e.complexity.Notification.Type(childComplexity), true
 
-	case "UserContact.user":
-		if e.complexity.UserContact.User == nil {
+	case "Notification.updatedAt":
+		if e.complexity.Notification.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.UserContact.User(childComplexity), true
+		return e.complexity.Notification.UpdatedAt(childComplexity), true
 
-	case "UserContact.userID":
-		if e.complexity.UserContact.UserID == nil {
+	case "Notification.user":
+		if e.complexity.Notification.User == nil {
 			break
 		}
 
-		return e.complexity.UserContact.UserID(childComplexity), true
+		return e.complexity.Notification.User(childComplexity), true
 
-	case "UserContactConnection.edges":
-		if e.complexity.UserContactConnection.Edges == nil {
+	case "NotificationConnection.edges":
+		if e.complexity.NotificationConnection.Edges == nil {
 			break
 		}
 
-		return e.complexity.UserContactConnection.Edges(childComplexity), true
+		return e.complexity.NotificationConnection.Edges(childComplexity), true
 
-	case "UserContactConnection.pageInfo":
-		if e.complexity.UserContactConnection.PageInfo == nil {
+	case "NotificationConnection.pageInfo":
+		if e.complexity.NotificationConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.UserContactConnection.PageInfo(childComplexity), true
+		return e.complexity.NotificationConnection.PageInfo(childComplexity), true
 
-	case "UserContactConnection.totalCount":
-		if e.complexity.UserContactConnection.TotalCount == nil {
+	case "NotificationConnection.totalCount":
+		if e.complexity.NotificationConnection.TotalCount == nil {
 			break
 		}
 
-		return e.complexity.UserContactConnection.TotalCount(childComplexity), true
+		return e.complexity.NotificationConnection.TotalCount(childComplexity), true
 
-	case "UserContactEdge.cursor":
-		if e.complexity.UserContactEdge.Cursor == nil {
+	case "NotificationEdge.cursor":
+		if e.complexity.NotificationEdge.Cursor == nil {
 			break
 		}
 
-		return e.complexity.UserContactEdge.Cursor(childComplexity), true
+		return e.complexity.NotificationEdge.Cursor(childComplexity), true
 
-	case "UserContactEdge.node":
-		if e.complexity.UserContactEdge.Node == nil {
+	case "NotificationEdge.node":
+		if e.complexity.NotificationEdge.Node == nil {
 			break
 		}
 
-		return e.complexity.UserContactEdge.Node(childComplexity), true
+		return e.complexity.NotificationEdge.Node(childComplexity), true
 
-	case "UserEdge.cursor":
-		if e.complexity.UserEdge.Cursor == nil {
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
 			break
 		}
 
-		return e.complexity.UserEdge.Cursor(childComplexity), true
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
 
-	case "UserEdge.node":
-		if e.complexity.UserEdge.Node == nil {
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
 			break
 		}
 
-		return e.complexity.UserEdge.Node(childComplexity), true
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
 
-	}
-	return 0, false
-}
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
+		}
 
-func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	rc := graphql.GetOperationContext(ctx)
-	ec := executionContext{rc, e, 0, 0, make(chan graphql.DeferredResult)}
-	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
-		ec.unmarshalInputCallParamsInput,
-		ec.unmarshalInputCreateMessageLinkInput,
-		ec.unmarshalInputCreateRoomInput,
-		ec.unmarshalInputDeviceOrder,
-		ec.unmarshalInputDeviceWhereInput,
-		ec.unmarshalInputFileOrder,
-		ec.unmarshalInputFileWhereInput,
-		ec.unmarshalInputMessageAttachmentOrder,
-		ec.unmarshalInputMessageAttachmentWhereInput,
-		ec.unmarshalInputMessageLinkOrder,
-		ec.unmarshalInputMessageLinkWhereInput,
-		ec.unmarshalInputMessageOrder,
-		ec.unmarshalInputMessageVoiceOrder,
-		ec.unmarshalInputMessageVoiceWhereInput,
-		ec.unmarshalInputMessageWhereInput,
-		ec.unmarshalInputNotificationOrder,
-		ec.unmarshalInputNotificationWhereInput,
-		ec.unmarshalInputRoomMemberOrder,
-		ec.unmarshalInputRoomMemberWhereInput,
-		ec.unmarshalInputRoomOrder,
-		ec.unmarshalInputRoomWhereInput,
-		ec.unmarshalInputSendMessageInput,
-		ec.unmarshalInputUpdateMessageInput,
-		ec.unmarshalInputUpdateRoomInput,
-		ec.unmarshalInputUploadMessageFileInput,
-		ec.unmarshalInputUploadMessageVoiceInput,
-		ec.unmarshalInputUserContactOrder,
-		ec.unmarshalInputUserContactWhereInput,
-		ec.unmarshalInputUserLoginInput,
-		ec.unmarshalInputUserOrder,
-		ec.unmarshalInputUserRegisterInput,
-		ec.unmarshalInputUserWhereInput,
-	)
-	first := true
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
 
-	switch rc.Operation.Operation {
-	case ast.Query:
-		return func(ctx context.Context) *graphql.Response {
-			var response graphql.Response
-			var data graphql.Marshaler
-			if first {
-				first = false
-				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-				data = ec._Query(ctx, rc.Operation.SelectionSet)
-			} else {
-				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
-					result := <-ec.deferredResults
-					atomic.AddInt32(&ec.pendingDeferred, -1)
-					data = result.Result
-					response.Path = result.Path
-					response.Label = result.Label
-					response.Errors = result.Errors
-				} else {
-					return nil
-				}
-			}
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
-			response.Data = buf.Bytes()
-			if atomic.LoadInt32(&ec.deferred) > 0 {
-				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
-				response.HasNext = &hasNext
-			}
+	case "PageInfo.startCursor":
+		if e.complexity.PageInfo.StartCursor == nil {
+			break
+		}
 
-			return &response
+		return e.complexity.PageInfo.StartCursor(childComplexity), true
+
+	case "Presence.lastSeenAt":
+		if e.complexity.Presence.LastSeenAt == nil {
+			break
 		}
-	case ast.Mutation:
-		return func(ctx context.Context) *graphql.Response {
-			if !first {
-				return nil
-			}
-			first = false
-			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-			data := ec._Mutation(ctx, rc.Operation.SelectionSet)
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
+		return e.complexity.Presence.LastSeenAt(childComplexity), true
+
+	case "Presence.online":
+		if e.complexity.Presence.Online == nil {
+			break
 		}
-	case ast.Subscription:
-		next := ec._Subscription(ctx, rc.Operation.SelectionSet)
 
-		var buf bytes.Buffer
-		return func(ctx context.Context) *graphql.Response {
-			buf.Reset()
-			data := next(ctx)
+		return e.complexity.Presence.Online(childComplexity), true
 
-			if data == nil {
-				return nil
-			}
-			data.MarshalGQL(&buf)
+	case "PresenceChange.presence":
+		if e.complexity.PresenceChange.Presence == nil {
+			break
+		}
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
+		return e.complexity.PresenceChange.Presence(childComplexity), true
+
+	case "PresenceChange.userID":
+		if e.complexity.PresenceChange.UserID == nil {
+			break
 		}
 
-	default:
-		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
-	}
-}
+		return e.complexity.PresenceChange.UserID(childComplexity), true
 
-type executionContext struct {
-	*graphql.OperationContext
-	*executableSchema
-	deferred        int32
-	pendingDeferred int32
-	deferredResults chan graphql.DeferredResult
-}
+	case "Query.activeCall":
+		if e.complexity.Query.ActiveCall == nil {
+			break
+		}
 
-func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
-	atomic.AddInt32(&ec.pendingDeferred, 1)
-	go func() {
-		ctx := graphql.WithFreshResponseContext(dg.Context)
-		dg.FieldSet.Dispatch(ctx)
-		ds := graphql.DeferredResult{
-			Path:   dg.Path,
-			Label:  dg.Label,
-			Result: dg.FieldSet,
-			Errors: graphql.GetErrors(ctx),
+		args, err := ec.field_Query_activeCall_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-		// null fields should bubble up
-		if dg.FieldSet.Invalids > 0 {
-			ds.Result = graphql.Null
+
+		return e.complexity.Query.ActiveCall(childComplexity, args["roomID"].(pulid.ID)), true
+
+	case "Query.blockedUsers":
+		if e.complexity.Query.BlockedUsers == nil {
+			break
 		}
-		ec.deferredResults <- ds
-	}()
-}
 
-func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapSchema(ec.Schema()), nil
-}
+		args, err := ec.field_Query_blockedUsers_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
-}
+		return e.complexity.Query.BlockedUsers(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.UserOrder)), true
 
-var sources = []*ast.Source{
-	{Name: "../schema/call.graphql", Input: `enum CallType {
-  AUDIO
-  VIDEO
-}
+	case "Query.callHistory":
+		if e.complexity.Query.CallHistory == nil {
+			break
+		}
 
-enum CallNotificationType {
-  START_CALL
-  END_CALL
-  DECLINE_CALL
-  ANSWER_CALL
-}
+		args, err := ec.field_Query_callHistory_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-extend type Query {
-  callJoinToken(roomID: ID!): String!
-}
+		return e.complexity.Query.CallHistory(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.CallOrder), args["where"].(*ent.CallWhereInput)), true
 
-"""
-CallParamsInput is used for call configuration.
-"""
-input CallParamsInput {
-  roomID: ID!
-  callType: CallType!
-  callID: String!
-}
+	case "Query.callJoinToken":
+		if e.complexity.Query.CallJoinToken == nil {
+			break
+		}
 
-extend type Mutation {
-  startCall(input: CallParamsInput!): Boolean!
-  endCall(input: CallParamsInput!): Boolean!
-  declineCall(input: CallParamsInput!): Boolean!
-  answerCall(input: CallParamsInput!): Boolean!
-}
-`, BuiltIn: false},
-	{Name: "../schema/directives.graphql", Input: `directive @goTag(
-  key: String!
-  value: String
+		args, err := ec.field_Query_callJoinToken_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CallJoinToken(childComplexity, args["roomID"].(pulid.ID)), true
+
+	case "Query.contactPins":
+		if e.complexity.Query.ContactPins == nil {
+			break
+		}
+
+		args, err := ec.field_Query_contactPins_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ContactPins(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.ContactPinOrder), args["where"].(*ent.ContactPinWhereInput)), true
+
+	case "Query.contactRequests":
+		if e.complexity.Query.ContactRequests == nil {
+			break
+		}
+
+		args, err := ec.field_Query_contactRequests_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ContactRequests(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.ContactRequestOrder), args["where"].(*ent.ContactRequestWhereInput)), true
+
+	case "Query.devices":
+		if e.complexity.Query.Devices == nil {
+			break
+		}
+
+		args, err := ec.field_Query_devices_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Devices(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.DeviceOrder), args["where"].(*ent.DeviceWhereInput)), true
+
+	case "Query.messageAttachmentsByRoom":
+		if e.complexity.Query.MessageAttachmentsByRoom == nil {
+			break
+		}
+
+		args, err := ec.field_Query_messageAttachmentsByRoom_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MessageAttachmentsByRoom(childComplexity, args["roomID"].(pulid.ID), args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.MessageAttachmentOrder), args["where"].(*ent.MessageAttachmentWhereInput)), true
+
+	case "Query.messageLinksByRoom":
+		if e.complexity.Query.MessageLinksByRoom == nil {
+			break
+		}
+
+		args, err := ec.field_Query_messageLinksByRoom_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MessageLinksByRoom(childComplexity, args["roomID"].(pulid.ID), args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.MessageLinkOrder), args["where"].(*ent.MessageLinkWhereInput)), true
+
+	case "Query.messageVoicesByRoom":
+		if e.complexity.Query.MessageVoicesByRoom == nil {
+			break
+		}
+
+		args, err := ec.field_Query_messageVoicesByRoom_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MessageVoicesByRoom(childComplexity, args["roomID"].(pulid.ID), args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.MessageVoiceOrder), args["where"].(*ent.MessageVoiceWhereInput)), true
+
+	case "Query.messagesByRoom":
+		if e.complexity.Query.MessagesByRoom == nil {
+			break
+		}
+
+		args, err := ec.field_Query_messagesByRoom_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MessagesByRoom(childComplexity, args["roomID"].(pulid.ID), args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.MessageOrder), args["where"].(*ent.MessageWhereInput)), true
+
+	case "Query.node":
+		if e.complexity.Query.Node == nil {
+			break
+		}
+
+		args, err := ec.field_Query_node_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Node(childComplexity, args["id"].(pulid.ID)), true
+
+	case "Query.nodes":
+		if e.complexity.Query.Nodes == nil {
+			break
+		}
+
+		args, err := ec.field_Query_nodes_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Nodes(childComplexity, args["ids"].([]pulid.ID)), true
+
+	case "Query.notifications":
+		if e.complexity.Query.Notifications == nil {
+			break
+		}
+
+		args, err := ec.field_Query_notifications_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Notifications(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.NotificationOrder), args["where"].(*ent.NotificationWhereInput)), true
+
+	case "Query.previewRoomInvite":
+		if e.complexity.Query.PreviewRoomInvite == nil {
+			break
+		}
+
+		args, err := ec.field_Query_previewRoomInvite_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PreviewRoomInvite(childComplexity, args["token"].(string)), true
+
+	case "Query.reports":
+		if e.complexity.Query.Reports == nil {
+			break
+		}
+
+		args, err := ec.field_Query_reports_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Reports(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.ReportOrder), args["where"].(*ent.ReportWhereInput)), true
+
+	case "Query.room":
+		if e.complexity.Query.Room == nil {
+			break
+		}
+
+		args, err := ec.field_Query_room_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Room(childComplexity, args["roomID"].(pulid.ID)), true
+
+	case "Query.roomInvites":
+		if e.complexity.Query.RoomInvites == nil {
+			break
+		}
+
+		args, err := ec.field_Query_roomInvites_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.RoomInvites(childComplexity, args["roomID"].(pulid.ID), args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.RoomInviteOrder), args["where"].(*ent.RoomInviteWhereInput)), true
+
+	case "Query.roomMembers":
+		if e.complexity.Query.RoomMembers == nil {
+			break
+		}
+
+		args, err := ec.field_Query_roomMembers_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.RoomMembers(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.RoomMemberOrder), args["where"].(*ent.RoomMemberWhereInput)), true
+
+	case "Query.roomMembersByRoom":
+		if e.complexity.Query.RoomMembersByRoom == nil {
+			break
+		}
+
+		args, err := ec.field_Query_roomMembersByRoom_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.RoomMembersByRoom(childComplexity, args["roomID"].(pulid.ID), args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.RoomMemberOrder), args["where"].(*ent.RoomMemberWhereInput)), true
+
+	case "Query.searchUsers":
+		if e.complexity.Query.SearchUsers == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchUsers_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SearchUsers(childComplexity, args["query"].(string), args["first"].(*int)), true
+
+	case "Query.self":
+		if e.complexity.Query.Self == nil {
+			break
+		}
+
+		return e.complexity.Query.Self(childComplexity), true
+
+	case "Query.unreadNotificationsCount":
+		if e.complexity.Query.UnreadNotificationsCount == nil {
+			break
+		}
+
+		return e.complexity.Query.UnreadNotificationsCount(childComplexity), true
+
+	case "Query.userContact":
+		if e.complexity.Query.UserContact == nil {
+			break
+		}
+
+		args, err := ec.field_Query_userContact_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.UserContact(childComplexity, args["userContactID"].(pulid.ID)), true
+
+	case "Query.userContacts":
+		if e.complexity.Query.UserContacts == nil {
+			break
+		}
+
+		args, err := ec.field_Query_userContacts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.UserContacts(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.UserContactOrder), args["where"].(*ent.UserContactWhereInput)), true
+
+	case "Report.actions":
+		if e.complexity.Report.Actions == nil {
+			break
+		}
+
+		return e.complexity.Report.Actions(childComplexity), true
+
+	case "Report.assignee":
+		if e.complexity.Report.Assignee == nil {
+			break
+		}
+
+		return e.complexity.Report.Assignee(childComplexity), true
+
+	case "Report.assigneeID":
+		if e.complexity.Report.AssigneeID == nil {
+			break
+		}
+
+		return e.complexity.Report.AssigneeID(childComplexity), true
+
+	case "Report.closedAt":
+		if e.complexity.Report.ClosedAt == nil {
+			break
+		}
+
+		return e.complexity.Report.ClosedAt(childComplexity), true
+
+	case "Report.createdAt":
+		if e.complexity.Report.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Report.CreatedAt(childComplexity), true
+
+	case "Report.id":
+		if e.complexity.Report.ID == nil {
+			break
+		}
+
+		return e.complexity.Report.ID(childComplexity), true
+
+	case "Report.message":
+		if e.complexity.Report.Message == nil {
+			break
+		}
+
+		return e.complexity.Report.Message(childComplexity), true
+
+	case "Report.messageID":
+		if e.complexity.Report.MessageID == nil {
+			break
+		}
+
+		return e.complexity.Report.MessageID(childComplexity), true
+
+	case "Report.note":
+		if e.complexity.Report.Note == nil {
+			break
+		}
+
+		return e.complexity.Report.Note(childComplexity), true
+
+	case "Report.reason":
+		if e.complexity.Report.Reason == nil {
+			break
+		}
+
+		return e.complexity.Report.Reason(childComplexity), true
+
+	case "Report.reporter":
+		if e.complexity.Report.Reporter == nil {
+			break
+		}
+
+		return e.complexity.Report.Reporter(childComplexity), true
+
+	case "Report.reporterID":
+		if e.complexity.Report.ReporterID == nil {
+			break
+		}
+
+		return e.complexity.Report.ReporterID(childComplexity), true
+
+	case "Report.room":
+		if e.complexity.Report.Room == nil {
+			break
+		}
+
+		return e.complexity.Report.Room(childComplexity), true
+
+	case "Report.roomID":
+		if e.complexity.Report.RoomID == nil {
+			break
+		}
+
+		return e.complexity.Report.RoomID(childComplexity), true
+
+	case "Report.snapshot":
+		if e.complexity.Report.Snapshot == nil {
+			break
+		}
+
+		return e.complexity.Report.Snapshot(childComplexity), true
+
+	case "Report.status":
+		if e.complexity.Report.Status == nil {
+			break
+		}
+
+		return e.complexity.Report.Status(childComplexity), true
+
+	case "Report.text":
+		if e.complexity.Report.Text == nil {
+			break
+		}
+
+		return e.complexity.Report.Text(childComplexity), true
+
+	case "Report.type":
+		if e.complexity.Report.Type == nil {
+			break
+		}
+
+		return e.complexity.Report.Type(childComplexity), true
+
+	case "Report.updatedAt":
+		if e.complexity.Report.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Report.UpdatedAt(childComplexity), true
+
+	case "Report.user":
+		if e.complexity.Report.User == nil {
+			break
+		}
+
+		return e.complexity.Report.User(childComplexity), true
+
+	case "Report.userID":
+		if e.complexity.Report.UserID == nil {
+			break
+		}
+
+		return e.complexity.Report.UserID(childComplexity), true
+
+	case "ReportConnection.edges":
+		if e.complexity.ReportConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.ReportConnection.Edges(childComplexity), true
+
+	case "ReportConnection.pageInfo":
+		if e.complexity.ReportConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.ReportConnection.PageInfo(childComplexity), true
+
+	case "ReportConnection.totalCount":
+		if e.complexity.ReportConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.ReportConnection.TotalCount(childComplexity), true
+
+	case "ReportEdge.cursor":
+		if e.complexity.ReportEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.ReportEdge.Cursor(childComplexity), true
+
+	case "ReportEdge.node":
+		if e.complexity.ReportEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.ReportEdge.Node(childComplexity), true
+
+	case "Room.avatar":
+		if e.complexity.Room.Avatar == nil {
+			break
+		}
+
+		return e.complexity.Room.Avatar(childComplexity), true
+
+	case "Room.avatarID":
+		if e.complexity.Room.AvatarID == nil {
+			break
+		}
+
+		return e.complexity.Room.AvatarID(childComplexity), true
+
+	case "Room.avatars":
+		if e.complexity.Room.Avatars == nil {
+			break
+		}
+
+		return e.complexity.Room.Avatars(childComplexity), true
+
+	case "Room.createdAt":
+		if e.complexity.Room.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Room.CreatedAt(childComplexity), true
+
+	case "Room.description":
+		if e.complexity.Room.Description == nil {
+			break
+		}
+
+		return e.complexity.Room.Description(childComplexity), true
+
+	case "Room.id":
+		if e.complexity.Room.ID == nil {
+			break
+		}
+
+		return e.complexity.Room.ID(childComplexity), true
+
+	case "Room.lastMessage":
+		if e.complexity.Room.LastMessage == nil {
+			break
+		}
+
+		return e.complexity.Room.LastMessage(childComplexity), true
+
+	case "Room.messageAttachments":
+		if e.complexity.Room.MessageAttachments == nil {
+			break
+		}
+
+		args, err := ec.field_Room_messageAttachments_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Room.MessageAttachments(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.MessageAttachmentOrder), args["where"].(*ent.MessageAttachmentWhereInput)), true
+
+	case "Room.messageLinks":
+		if e.complexity.Room.MessageLinks == nil {
+			break
+		}
+
+		args, err := ec.field_Room_messageLinks_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Room.MessageLinks(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.MessageLinkOrder), args["where"].(*ent.MessageLinkWhereInput)), true
+
+	case "Room.messageVoices":
+		if e.complexity.Room.MessageVoices == nil {
+			break
+		}
+
+		args, err := ec.field_Room_messageVoices_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Room.MessageVoices(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.MessageVoiceOrder), args["where"].(*ent.MessageVoiceWhereInput)), true
+
+	case "Room.messages":
+		if e.complexity.Room.Messages == nil {
+			break
+		}
+
+		args, err := ec.field_Room_messages_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Room.Messages(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.MessageOrder), args["where"].(*ent.MessageWhereInput)), true
+
+	case "Room.name":
+		if e.complexity.Room.Name == nil {
+			break
+		}
+
+		return e.complexity.Room.Name(childComplexity), true
+
+	case "Room.roomMembers":
+		if e.complexity.Room.RoomMembers == nil {
+			break
+		}
+
+		args, err := ec.field_Room_roomMembers_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Room.RoomMembers(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.RoomMemberOrder), args["where"].(*ent.RoomMemberWhereInput)), true
+
+	case "Room.type":
+		if e.complexity.Room.Type == nil {
+			break
+		}
+
+		return e.complexity.Room.Type(childComplexity), true
+
+	case "Room.updatedAt":
+		if e.complexity.Room.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Room.UpdatedAt(childComplexity), true
+
+	case "Room.userContacts":
+		if e.complexity.Room.UserContacts == nil {
+			break
+		}
+
+		return e.complexity.Room.UserContacts(childComplexity), true
+
+	case "Room.users":
+		if e.complexity.Room.Users == nil {
+			break
+		}
+
+		args, err := ec.field_Room_users_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Room.Users(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.UserOrder), args["where"].(*ent.UserWhereInput)), true
+
+	case "Room.version":
+		if e.complexity.Room.Version == nil {
+			break
+		}
+
+		return e.complexity.Room.Version(childComplexity), true
+
+	case "RoomConnection.edges":
+		if e.complexity.RoomConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.RoomConnection.Edges(childComplexity), true
+
+	case "RoomConnection.pageInfo":
+		if e.complexity.RoomConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.RoomConnection.PageInfo(childComplexity), true
+
+	case "RoomConnection.totalCount":
+		if e.complexity.RoomConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.RoomConnection.TotalCount(childComplexity), true
+
+	case "RoomEdge.cursor":
+		if e.complexity.RoomEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.RoomEdge.Cursor(childComplexity), true
+
+	case "RoomEdge.node":
+		if e.complexity.RoomEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.RoomEdge.Node(childComplexity), true
+
+	case "RoomInvite.createdAt":
+		if e.complexity.RoomInvite.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.RoomInvite.CreatedAt(childComplexity), true
+
+	case "RoomInvite.creator":
+		if e.complexity.RoomInvite.Creator == nil {
+			break
+		}
+
+		return e.complexity.RoomInvite.Creator(childComplexity), true
+
+	case "RoomInvite.creatorID":
+		if e.complexity.RoomInvite.CreatorID == nil {
+			break
+		}
+
+		return e.complexity.RoomInvite.CreatorID(childComplexity), true
+
+	case "RoomInvite.expiresAt":
+		if e.complexity.RoomInvite.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.RoomInvite.ExpiresAt(childComplexity), true
+
+	case "RoomInvite.id":
+		if e.complexity.RoomInvite.ID == nil {
+			break
+		}
+
+		return e.complexity.RoomInvite.ID(childComplexity), true
+
+	case "RoomInvite.maxUses":
+		if e.complexity.RoomInvite.MaxUses == nil {
+			break
+		}
+
+		return e.complexity.RoomInvite.MaxUses(childComplexity), true
+
+	case "RoomInvite.revokedAt":
+		if e.complexity.RoomInvite.RevokedAt == nil {
+			break
+		}
+
+		return e.complexity.RoomInvite.RevokedAt(childComplexity), true
+
+	case "RoomInvite.room":
+		if e.complexity.RoomInvite.Room == nil {
+			break
+		}
+
+		return e.complexity.RoomInvite.Room(childComplexity), true
+
+	case "RoomInvite.roomID":
+		if e.complexity.RoomInvite.RoomID == nil {
+			break
+		}
+
+		return e.complexity.RoomInvite.RoomID(childComplexity), true
+
+	case "RoomInvite.uses":
+		if e.complexity.RoomInvite.Uses == nil {
+			break
+		}
+
+		return e.complexity.RoomInvite.Uses(childComplexity), true
+
+	case "RoomInviteConnection.edges":
+		if e.complexity.RoomInviteConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.RoomInviteConnection.Edges(childComplexity), true
+
+	case "RoomInviteConnection.pageInfo":
+		if e.complexity.RoomInviteConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.RoomInviteConnection.PageInfo(childComplexity), true
+
+	case "RoomInviteConnection.totalCount":
+		if e.complexity.RoomInviteConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.RoomInviteConnection.TotalCount(childComplexity), true
+
+	case "RoomInviteEdge.cursor":
+		if e.complexity.RoomInviteEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.RoomInviteEdge.Cursor(childComplexity), true
+
+	case "RoomInviteEdge.node":
+		if e.complexity.RoomInviteEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.RoomInviteEdge.Node(childComplexity), true
+
+	case "RoomInvitePreview.avatar":
+		if e.complexity.RoomInvitePreview.Avatar == nil {
+			break
+		}
+
+		return e.complexity.RoomInvitePreview.Avatar(childComplexity), true
+
+	case "RoomInvitePreview.description":
+		if e.complexity.RoomInvitePreview.Description == nil {
+			break
+		}
+
+		return e.complexity.RoomInvitePreview.Description(childComplexity), true
+
+	case "RoomInvitePreview.membersCount":
+		if e.complexity.RoomInvitePreview.MembersCount == nil {
+			break
+		}
+
+		return e.complexity.RoomInvitePreview.MembersCount(childComplexity), true
+
+	case "RoomInvitePreview.name":
+		if e.complexity.RoomInvitePreview.Name == nil {
+			break
+		}
+
+		return e.complexity.RoomInvitePreview.Name(childComplexity), true
+
+	case "RoomInvitePreview.roomID":
+		if e.complexity.RoomInvitePreview.RoomID == nil {
+			break
+		}
+
+		return e.complexity.RoomInvitePreview.RoomID(childComplexity), true
+
+	case "RoomMember.id":
+		if e.complexity.RoomMember.ID == nil {
+			break
+		}
+
+		return e.complexity.RoomMember.ID(childComplexity), true
+
+	case "RoomMember.joinedAt":
+		if e.complexity.RoomMember.JoinedAt == nil {
+			break
+		}
+
+		return e.complexity.RoomMember.JoinedAt(childComplexity), true
+
+	case "RoomMember.muteUntil":
+		if e.complexity.RoomMember.MuteUntil == nil {
+			break
+		}
+
+		return e.complexity.RoomMember.MuteUntil(childComplexity), true
+
+	case "RoomMember.name":
+		if e.complexity.RoomMember.Name == nil {
+			break
+		}
+
+		return e.complexity.RoomMember.Name(childComplexity), true
+
+	case "RoomMember.notificationLevel":
+		if e.complexity.RoomMember.NotificationLevel == nil {
+			break
+		}
+
+		return e.complexity.RoomMember.NotificationLevel(childComplexity), true
+
+	case "RoomMember.role":
+		if e.complexity.RoomMember.Role == nil {
+			break
+		}
+
+		return e.complexity.RoomMember.Role(childComplexity), true
+
+	case "RoomMember.room":
+		if e.complexity.RoomMember.Room == nil {
+			break
+		}
+
+		return e.complexity.RoomMember.Room(childComplexity), true
+
+	case "RoomMember.roomID":
+		if e.complexity.RoomMember.RoomID == nil {
+			break
+		}
+
+		return e.complexity.RoomMember.RoomID(childComplexity), true
+
+	case "RoomMember.unreadMessagesCount":
+		if e.complexity.RoomMember.UnreadMessagesCount == nil {
+			break
+		}
+
+		return e.complexity.RoomMember.UnreadMessagesCount(childComplexity), true
+
+	case "RoomMember.updatedAt":
+		if e.complexity.RoomMember.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.RoomMember.UpdatedAt(childComplexity), true
+
+	case "RoomMember.user":
+		if e.complexity.RoomMember.User == nil {
+			break
+		}
+
+		return e.complexity.RoomMember.User(childComplexity), true
+
+	case "RoomMember.userID":
+		if e.complexity.RoomMember.UserID == nil {
+			break
+		}
+
+		return e.complexity.RoomMember.UserID(childComplexity), true
+
+	case "RoomMemberConnection.edges":
+		if e.complexity.RoomMemberConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.RoomMemberConnection.Edges(childComplexity), true
+
+	case "RoomMemberConnection.pageInfo":
+		if e.complexity.RoomMemberConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.RoomMemberConnection.PageInfo(childComplexity), true
+
+	case "RoomMemberConnection.totalCount":
+		if e.complexity.RoomMemberConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.RoomMemberConnection.TotalCount(childComplexity), true
+
+	case "RoomMemberEdge.cursor":
+		if e.complexity.RoomMemberEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.RoomMemberEdge.Cursor(childComplexity), true
+
+	case "RoomMemberEdge.node":
+		if e.complexity.RoomMemberEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.RoomMemberEdge.Node(childComplexity), true
+
+	case "RoomMemberUpdatedEvent.createdAt":
+		if e.complexity.RoomMemberUpdatedEvent.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.RoomMemberUpdatedEvent.CreatedAt(childComplexity), true
+
+	case "RoomMemberUpdatedEvent.id":
+		if e.complexity.RoomMemberUpdatedEvent.ID == nil {
+			break
+		}
+
+		return e.complexity.RoomMemberUpdatedEvent.ID(childComplexity), true
+
+	case "RoomMemberUpdatedEvent.lastMessage":
+		if e.complexity.RoomMemberUpdatedEvent.LastMessage == nil {
+			break
+		}
+
+		return e.complexity.RoomMemberUpdatedEvent.LastMessage(childComplexity), true
+
+	case "RoomMemberUpdatedEvent.name":
+		if e.complexity.RoomMemberUpdatedEvent.Name == nil {
+			break
+		}
+
+		return e.complexity.RoomMemberUpdatedEvent.Name(childComplexity), true
+
+	case "RoomMemberUpdatedEvent.type":
+		if e.complexity.RoomMemberUpdatedEvent.Type == nil {
+			break
+		}
+
+		return e.complexity.RoomMemberUpdatedEvent.Type(childComplexity), true
+
+	case "RoomMemberUpdatedEvent.updatedAt":
+		if e.complexity.RoomMemberUpdatedEvent.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.RoomMemberUpdatedEvent.UpdatedAt(childComplexity), true
+
+	case "RoomMemberUpdatedEvent.version":
+		if e.complexity.RoomMemberUpdatedEvent.Version == nil {
+			break
+		}
+
+		return e.complexity.RoomMemberUpdatedEvent.Version(childComplexity), true
+
+	case "StorageUsage.quota":
+		if e.complexity.StorageUsage.Quota == nil {
+			break
+		}
+
+		return e.complexity.StorageUsage.Quota(childComplexity), true
+
+	case "StorageUsage.used":
+		if e.complexity.StorageUsage.Used == nil {
+			break
+		}
+
+		return e.complexity.StorageUsage.Used(childComplexity), true
+
+	case "Subscription.callEvents":
+		if e.complexity.Subscription.CallEvents == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_callEvents_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.CallEvents(childComplexity, args["deviceID"].(*string)), true
+
+	case "Subscription.contactRequestReceived":
+		if e.complexity.Subscription.ContactRequestReceived == nil {
+			break
+		}
+
+		return e.complexity.Subscription.ContactRequestReceived(childComplexity), true
+
+	case "Subscription.messageCreated":
+		if e.complexity.Subscription.MessageCreated == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_messageCreated_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.MessageCreated(childComplexity, args["roomID"].(pulid.ID)), true
+
+	case "Subscription.messageDeleted":
+		if e.complexity.Subscription.MessageDeleted == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_messageDeleted_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.MessageDeleted(childComplexity, args["roomID"].(pulid.ID)), true
+
+	case "Subscription.messageUpdated":
+		if e.complexity.Subscription.MessageUpdated == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_messageUpdated_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.MessageUpdated(childComplexity, args["roomID"].(pulid.ID)), true
+
+	case "Subscription.notificationCreated":
+		if e.complexity.Subscription.NotificationCreated == nil {
+			break
+		}
+
+		return e.complexity.Subscription.NotificationCreated(childComplexity), true
+
+	case "Subscription.presenceChanged":
+		if e.complexity.Subscription.PresenceChanged == nil {
+			break
+		}
+
+		return e.complexity.Subscription.PresenceChanged(childComplexity), true
+
+	case "Subscription.roomMemberCreated":
+		if e.complexity.Subscription.RoomMemberCreated == nil {
+			break
+		}
+
+		return e.complexity.Subscription.RoomMemberCreated(childComplexity), true
+
+	case "Subscription.roomMemberDeleted":
+		if e.complexity.Subscription.RoomMemberDeleted == nil {
+			break
+		}
+
+		return e.complexity.Subscription.RoomMemberDeleted(childComplexity), true
+
+	case "Subscription.roomMemberUpdated":
+		if e.complexity.Subscription.RoomMemberUpdated == nil {
+			break
+		}
+
+		return e.complexity.Subscription.RoomMemberUpdated(childComplexity), true
+
+	case "User.avatar":
+		if e.complexity.User.Avatar == nil {
+			break
+		}
+
+		return e.complexity.User.Avatar(childComplexity), true
+
+	case "User.avatarID":
+		if e.complexity.User.AvatarID == nil {
+			break
+		}
+
+		return e.complexity.User.AvatarID(childComplexity), true
+
+	case "User.avatars":
+		if e.complexity.User.Avatars == nil {
+			break
+		}
+
+		return e.complexity.User.Avatars(childComplexity), true
+
+	case "User.contacts":
+		if e.complexity.User.Contacts == nil {
+			break
+		}
+
+		args, err := ec.field_User_contacts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.User.Contacts(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.UserOrder), args["where"].(*ent.UserWhereInput)), true
+
+	case "User.createdAt":
+		if e.complexity.User.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.User.CreatedAt(childComplexity), true
+
+	case "User.device":
+		if e.complexity.User.Device == nil {
+			break
+		}
+
+		return e.complexity.User.Device(childComplexity), true
+
+	case "User.email":
+		if e.complexity.User.Email == nil {
+			break
+		}
+
+		return e.complexity.User.Email(childComplexity), true
+
+	case "User.firstName":
+		if e.complexity.User.FirstName == nil {
+			break
+		}
+
+		return e.complexity.User.FirstName(childComplexity), true
+
+	case "User.id":
+		if e.complexity.User.ID == nil {
+			break
+		}
+
+		return e.complexity.User.ID(childComplexity), true
+
+	case "User.lastName":
+		if e.complexity.User.LastName == nil {
+			break
+		}
+
+		return e.complexity.User.LastName(childComplexity), true
+
+	case "User.memberships":
+		if e.complexity.User.Memberships == nil {
+			break
+		}
+
+		args, err := ec.field_User_memberships_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.User.Memberships(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.RoomMemberOrder), args["where"].(*ent.RoomMemberWhereInput)), true
+
+	case "User.messages":
+		if e.complexity.User.Messages == nil {
+			break
+		}
+
+		args, err := ec.field_User_messages_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.User.Messages(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.MessageOrder), args["where"].(*ent.MessageWhereInput)), true
+
+	case "User.nickname":
+		if e.complexity.User.Nickname == nil {
+			break
+		}
+
+		return e.complexity.User.Nickname(childComplexity), true
+
+	case "User.presence":
+		if e.complexity.User.Presence == nil {
+			break
+		}
+
+		return e.complexity.User.Presence(childComplexity), true
+
+	case "User.role":
+		if e.complexity.User.Role == nil {
+			break
+		}
+
+		return e.complexity.User.Role(childComplexity), true
+
+	case "User.rooms":
+		if e.complexity.User.Rooms == nil {
+			break
+		}
+
+		args, err := ec.field_User_rooms_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.User.Rooms(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.RoomOrder), args["where"].(*ent.RoomWhereInput)), true
+
+	case "User.settings":
+		if e.complexity.User.Settings == nil {
+			break
+		}
+
+		return e.complexity.User.Settings(childComplexity), true
+
+	case "User.storageUsage":
+		if e.complexity.User.StorageUsage == nil {
+			break
+		}
+
+		return e.complexity.User.StorageUsage(childComplexity), true
+
+	case "User.updatedAt":
+		if e.complexity.User.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.User.UpdatedAt(childComplexity), true
+
+	case "User.userContacts":
+		if e.complexity.User.UserContacts == nil {
+			break
+		}
+
+		args, err := ec.field_User_userContacts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.User.UserContacts(childComplexity, args["after"].(*entgql.Cursor[pulid.ID]), args["first"].(*int), args["before"].(*entgql.Cursor[pulid.ID]), args["last"].(*int), args["orderBy"].([]*ent.UserContactOrder), args["where"].(*ent.UserContactWhereInput)), true
+
+	case "UserConnection.edges":
+		if e.complexity.UserConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.UserConnection.Edges(childComplexity), true
+
+	case "UserConnection.pageInfo":
+		if e.complexity.UserConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.UserConnection.PageInfo(childComplexity), true
+
+	case "UserConnection.totalCount":
+		if e.complexity.UserConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.UserConnection.TotalCount(childComplexity), true
+
+	case "UserContact.contact":
+		if e.complexity.UserContact.Contact == nil {
+			break
+		}
+
+		return e.complexity.UserContact.Contact(childComplexity), true
+
+	case "UserContact.contactID":
+		if e.complexity.UserContact.ContactID == nil {
+			break
+		}
+
+		return e.complexity.UserContact.ContactID(childComplexity), true
+
+	case "UserContact.createdAt":
+		if e.complexity.UserContact.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.UserContact.CreatedAt(childComplexity), true
+
+	case "UserContact.id":
+		if e.complexity.UserContact.ID == nil {
+			break
+		}
+
+		return e.complexity.UserContact.ID(childComplexity), true
+
+	case "UserContact.room":
+		if e.complexity.UserContact.Room == nil {
+			break
+		}
+
+		return e.complexity.UserContact.Room(childComplexity), true
+
+	case "UserContact.roomID":
+		if e.complexity.UserContact.RoomID == nil {
+			break
+		}
+
+		return e.complexity.UserContact.RoomID(childComplexity), true
+
+	case "UserContact.user":
+		if e.complexity.UserContact.User == nil {
+			break
+		}
+
+		return e.complexity.UserContact.User(childComplexity), true
+
+	case "UserContact.userID":
+		if e.complexity.UserContact.UserID == nil {
+			break
+		}
+
+		return e.complexity.UserContact.UserID(childComplexity), true
+
+	case "UserContactConnection.edges":
+		if e.complexity.UserContactConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.UserContactConnection.Edges(childComplexity), true
+
+	case "UserContactConnection.pageInfo":
+		if e.complexity.UserContactConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.UserContactConnection.PageInfo(childComplexity), true
+
+	case "UserContactConnection.totalCount":
+		if e.complexity.UserContactConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.UserContactConnection.TotalCount(childComplexity), true
+
+	case "UserContactEdge.cursor":
+		if e.complexity.UserContactEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.UserContactEdge.Cursor(childComplexity), true
+
+	case "UserContactEdge.node":
+		if e.complexity.UserContactEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.UserContactEdge.Node(childComplexity), true
+
+	case "UserEdge.cursor":
+		if e.complexity.UserEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.UserEdge.Cursor(childComplexity), true
+
+	case "UserEdge.node":
+		if e.complexity.UserEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.UserEdge.Node(childComplexity), true
+
+	case "UserSearchResult.avatar":
+		if e.complexity.UserSearchResult.Avatar == nil {
+			break
+		}
+
+		return e.complexity.UserSearchResult.Avatar(childComplexity), true
+
+	case "UserSearchResult.firstName":
+		if e.complexity.UserSearchResult.FirstName == nil {
+			break
+		}
+
+		return e.complexity.UserSearchResult.FirstName(childComplexity), true
+
+	case "UserSearchResult.id":
+		if e.complexity.UserSearchResult.ID == nil {
+			break
+		}
+
+		return e.complexity.UserSearchResult.ID(childComplexity), true
+
+	case "UserSearchResult.lastName":
+		if e.complexity.UserSearchResult.LastName == nil {
+			break
+		}
+
+		return e.complexity.UserSearchResult.LastName(childComplexity), true
+
+	case "UserSearchResult.nickname":
+		if e.complexity.UserSearchResult.Nickname == nil {
+			break
+		}
+
+		return e.complexity.UserSearchResult.Nickname(childComplexity), true
+
+	case "UserSettings.discoverability":
+		if e.complexity.UserSettings.Discoverability == nil {
+			break
+		}
+
+		return e.complexity.UserSettings.Discoverability(childComplexity), true
+
+	case "UserSettings.hideLastSeen":
+		if e.complexity.UserSettings.HideLastSeen == nil {
+			break
+		}
+
+		return e.complexity.UserSettings.HideLastSeen(childComplexity), true
+
+	case "UserSettings.locale":
+		if e.complexity.UserSettings.Locale == nil {
+			break
+		}
+
+		return e.complexity.UserSettings.Locale(childComplexity), true
+
+	case "UserSettings.messagePreviews":
+		if e.complexity.UserSettings.MessagePreviews == nil {
+			break
+		}
+
+		return e.complexity.UserSettings.MessagePreviews(childComplexity), true
+
+	case "UserSettings.quietHoursEnd":
+		if e.complexity.UserSettings.QuietHoursEnd == nil {
+			break
+		}
+
+		return e.complexity.UserSettings.QuietHoursEnd(childComplexity), true
+
+	case "UserSettings.quietHoursStart":
+		if e.complexity.UserSettings.QuietHoursStart == nil {
+			break
+		}
+
+		return e.complexity.UserSettings.QuietHoursStart(childComplexity), true
+
+	case "UserSettings.timezone":
+		if e.complexity.UserSettings.Timezone == nil {
+			break
+		}
+
+		return e.complexity.UserSettings.Timezone(childComplexity), true
+
+	}
+	return 0, false
+}
+
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	rc := graphql.GetOperationContext(ctx)
+	ec := executionContext{rc, e, 0, 0, make(chan graphql.DeferredResult)}
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAvatarCropInput,
+		ec.unmarshalInputAvatarOrder,
+		ec.unmarshalInputAvatarWhereInput,
+		ec.unmarshalInputCallOrder,
+		ec.unmarshalInputCallParticipantWhereInput,
+		ec.unmarshalInputCallWhereInput,
+		ec.unmarshalInputContactPinOrder,
+		ec.unmarshalInputContactPinWhereInput,
+		ec.unmarshalInputContactRequestOrder,
+		ec.unmarshalInputContactRequestWhereInput,
+		ec.unmarshalInputCreateContactPinInput,
+		ec.unmarshalInputCreateMessageLinkInput,
+		ec.unmarshalInputCreateRoomInput,
+		ec.unmarshalInputCreateRoomInviteInput,
+		ec.unmarshalInputDeviceOrder,
+		ec.unmarshalInputDeviceWhereInput,
+		ec.unmarshalInputFileOrder,
+		ec.unmarshalInputFileWhereInput,
+		ec.unmarshalInputMessageAttachmentOrder,
+		ec.unmarshalInputMessageAttachmentWhereInput,
+		ec.unmarshalInputMessageLinkOrder,
+		ec.unmarshalInputMessageLinkWhereInput,
+		ec.unmarshalInputMessageOrder,
+		ec.unmarshalInputMessageVoiceOrder,
+		ec.unmarshalInputMessageVoiceWhereInput,
+		ec.unmarshalInputMessageWhereInput,
+		ec.unmarshalInputNotificationOrder,
+		ec.unmarshalInputNotificationWhereInput,
+		ec.unmarshalInputReportInput,
+		ec.unmarshalInputReportOrder,
+		ec.unmarshalInputReportWhereInput,
+		ec.unmarshalInputResolveReportInput,
+		ec.unmarshalInputRoomInviteOrder,
+		ec.unmarshalInputRoomInviteWhereInput,
+		ec.unmarshalInputRoomMemberOrder,
+		ec.unmarshalInputRoomMemberWhereInput,
+		ec.unmarshalInputRoomOrder,
+		ec.unmarshalInputRoomWhereInput,
+		ec.unmarshalInputSendMessageInput,
+		ec.unmarshalInputStartCallInput,
+		ec.unmarshalInputUpdateAvatarInput,
+		ec.unmarshalInputUpdateMessageInput,
+		ec.unmarshalInputUpdateRoomInput,
+		ec.unmarshalInputUpdateRoomMemberNotificationsInput,
+		ec.unmarshalInputUpdateUserSettingsInput,
+		ec.unmarshalInputUploadMessageFileInput,
+		ec.unmarshalInputUploadMessageVoiceInput,
+		ec.unmarshalInputUserContactOrder,
+		ec.unmarshalInputUserContactWhereInput,
+		ec.unmarshalInputUserLoginInput,
+		ec.unmarshalInputUserOrder,
+		ec.unmarshalInputUserRegisterInput,
+		ec.unmarshalInputUserWhereInput,
+	)
+	first := true
+
+	switch rc.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			var response graphql.Response
+			var data graphql.Marshaler
+			if first {
+				first = false
+				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+				data = ec._Query(ctx, rc.Operation.SelectionSet)
+			} else {
+				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
+					result := <-ec.deferredResults
+					atomic.AddInt32(&ec.pendingDeferred, -1)
+					data = result.Result
+					response.Path = result.Path
+					response.Label = result.Label
+					response.Errors = result.Errors
+				} else {
+					return nil
+				}
+			}
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+			response.Data = buf.Bytes()
+			if atomic.LoadInt32(&ec.deferred) > 0 {
+				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
+				response.HasNext = &hasNext
+			}
+
+			return &response
+		}
+	case ast.Mutation:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+			data := ec._Mutation(ctx, rc.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, rc.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+
+	default:
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
+	}
+}
+
+type executionContext struct {
+	*graphql.OperationContext
+	*executableSchema
+	deferred        int32
+	pendingDeferred int32
+	deferredResults chan graphql.DeferredResult
+}
+
+func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
+	atomic.AddInt32(&ec.pendingDeferred, 1)
+	go func() {
+		ctx := graphql.WithFreshResponseContext(dg.Context)
+		dg.FieldSet.Dispatch(ctx)
+		ds := graphql.DeferredResult{
+			Path:   dg.Path,
+			Label:  dg.Label,
+			Result: dg.FieldSet,
+			Errors: graphql.GetErrors(ctx),
+		}
+		// null fields should bubble up
+		if dg.FieldSet.Invalids > 0 {
+			ds.Result = graphql.Null
+		}
+		ec.deferredResults <- ds
+	}()
+}
+
+func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapSchema(ec.Schema()), nil
+}
+
+func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
+}
+
+var sources = []*ast.Source{
+	{Name: "../schema/avatar.graphql", Input: `"""
+AvatarCropInput selects the square of the image used as avatar, in pixels
+of the uploaded image. The largest centered square is used without it.
+"""
+input AvatarCropInput {
+  x: Int! @goTag(key: "validate", value: "min=0")
+  y: Int! @goTag(key: "validate", value: "min=0")
+  size: Int! @goTag(key: "validate", value: "min=1")
+}
+
+"""
+UpdateAvatarInput is used for uploading the avatar of a user or a room.
+"""
+input UpdateAvatarInput {
+  file: Upload!
+  crop: AvatarCropInput
+}
+
+extend type Mutation {
+  updateUserAvatar(input: UpdateAvatarInput!): User!
+  deleteUserAvatar: User!
+  updateRoomAvatar(roomID: ID!, input: UpdateAvatarInput!): Room!
+  deleteRoomAvatar(roomID: ID!): Room!
+}
+`, BuiltIn: false},
+	{Name: "../schema/call.graphql", Input: `enum CallNotificationType {
+  START_CALL
+  END_CALL
+  DECLINE_CALL
+  ANSWER_CALL
+}
+
+extend type Query {
+  """
+  Returns the LiveKit access token for the ongoing call of the room. The
+  call has to be answered first, members may join an ongoing call late.
+  """
+  callJoinToken(roomID: ID!): String!
+
+  callHistory(
+    """
+    Returns the elements in the list that come after the specified cursor.
+    """
+    after: Cursor
+
+    """
+    Returns the first _n_ elements from the list.
+    """
+    first: Int
+
+    """
+    Returns the elements in the list that come before the specified cursor.
+    """
+    before: Cursor
+
+    """
+    Returns the last _n_ elements from the list.
+    """
+    last: Int
+
+    """
+    Ordering options for Calls returned from the connection.
+    """
+    orderBy: [CallOrder!]
+
+    """
+    Filtering options for Calls returned from the connection.
+    """
+    where: CallWhereInput
+  ): CallConnection!
+
+  activeCall(roomID: ID!): Call
+}
+
+"""
+CallEvent signals a change of a call to its participants.
+"""
+type CallEvent {
+  callNotificationType: CallNotificationType!
+  callID: ID!
+  callType: CallType!
+  callState: CallState!
+  roomID: ID!
+  userID: ID!
+  userFirstName: String!
+  userLastName: String!
+}
+
+"""
+StartCallInput is used for starting a call in a room.
+"""
+input StartCallInput {
+  roomID: ID!
+  callType: CallType!
+}
+
+extend type Mutation {
+  startCall(input: StartCallInput!): Call!
+  endCall(callID: ID!): Call!
+  declineCall(callID: ID!): Call!
+  answerCall(callID: ID!): Call!
+}
+
+extend type Subscription {
+  """
+  Signals the calls of the current user. Devices pass the deviceID they
+  logged in with, so they are not pushed the events they received here.
+  """
+  callEvents(deviceID: String): CallEvent!
+}
+`, BuiltIn: false},
+	{Name: "../schema/contact_pin.graphql", Input: `"""
+CreateContactPinInput is used for creating a contact pin. The pin expires
+after the configured validity when expiresAt is omitted, and a pin with
+maxUses of 1 is single use.
+"""
+input CreateContactPinInput {
+  expiresAt: Time
+  maxUses: Int @goTag(key: "validate", value: "omitempty,min=1")
+}
+
+"""
+CreateContactPinPayload carries the code of the pin and its signed payload
+for QR codes and deep links, which are only returned once.
+"""
+type CreateContactPinPayload {
+  pin: ContactPin!
+  code: String!
+  payload: String!
+}
+
+extend type Query {
+  """
+  Contact pins of the current user.
+  """
+  contactPins(
+    """
+    Returns the elements in the list that come after the specified cursor.
+    """
+    after: Cursor
+
+    """
+    Returns the first _n_ elements from the list.
+    """
+    first: Int
+
+    """
+    Returns the elements in the list that come before the specified cursor.
+    """
+    before: Cursor
+
+    """
+    Returns the last _n_ elements from the list.
+    """
+    last: Int
+
+    """
+    Ordering options for ContactPins returned from the connection.
+    """
+    orderBy: [ContactPinOrder!]
+
+    """
+    Filtering options for ContactPins returned from the connection.
+    """
+    where: ContactPinWhereInput
+  ): ContactPinConnection!
+}
+
+extend type Mutation {
+  createContactPin(input: CreateContactPinInput!): CreateContactPinPayload!
+  revokeContactPin(pinID: ID!): ContactPin!
+
+  """
+  Sends a contact request to the owner of the pin of a scanned QR code or
+  opened deep link.
+  """
+  addUserContactByPayload(payload: String!): ContactRequestEdge!
+}
+`, BuiltIn: false},
+	{Name: "../schema/contact_request.graphql", Input: `extend type Query {
+  """
+  Contact requests sent and received by the current user.
+  """
+  contactRequests(
+    """
+    Returns the elements in the list that come after the specified cursor.
+    """
+    after: Cursor
+
+    """
+    Returns the first _n_ elements from the list.
+    """
+    first: Int
+
+    """
+    Returns the elements in the list that come before the specified cursor.
+    """
+    before: Cursor
+
+    """
+    Returns the last _n_ elements from the list.
+    """
+    last: Int
+
+    """
+    Ordering options for ContactRequests returned from the connection.
+    """
+    orderBy: [ContactRequestOrder!]
+
+    """
+    Filtering options for ContactRequests returned from the connection.
+    """
+    where: ContactRequestWhereInput
+  ): ContactRequestConnection!
+}
+
+extend type Mutation {
+  """
+  Makes the users contacts of each other and opens their personal room.
+  """
+  acceptContactRequest(contactRequestID: ID!): ContactRequestEdge!
+  declineContactRequest(contactRequestID: ID!): ContactRequestEdge!
+}
+
+extend type Subscription {
+  contactRequestReceived: ContactRequestEdge!
+}
+`, BuiltIn: false},
+	{Name: "../schema/directives.graphql", Input: `directive @goTag(
+  key: String!
+  value: String
 ) on INPUT_FIELD_DEFINITION | FIELD_DEFINITION
 `, BuiltIn: false},
 	{Name: "../schema/ent.graphql", Input: `directive @goField(forceResolver: Boolean, name: String, omittable: Boolean) on FIELD_DEFINITION | INPUT_FIELD_DEFINITION
 directive @goModel(model: String, models: [String!], forceGenerate: Boolean) on OBJECT | INPUT_OBJECT | SCALAR | ENUM | INTERFACE | UNION
+type Avatar implements Node {
+  id: ID!
+  fileID: ID!
+  userID: ID
+  roomID: ID
+  createdAt: Time!
+  file: File!
+  user: User
+  room: Room
+}
+"""
+Ordering options for Avatar connections
+"""
+input AvatarOrder {
+  """
+  The ordering direction.
+  """
+  direction: OrderDirection! = ASC
+  """
+  The field by which to order Avatars.
+  """
+  field: AvatarOrderField!
+}
+"""
+Properties by which Avatar connections can be ordered.
+"""
+enum AvatarOrderField {
+  CREATED_AT
+}
+"""
+AvatarWhereInput is used for filtering Avatar objects.
+Input was generated by ent.
+"""
+input AvatarWhereInput {
+  not: AvatarWhereInput
+  and: [AvatarWhereInput!]
+  or: [AvatarWhereInput!]
+  """
+  id field predicates
+  """
+  id: ID
+  idNEQ: ID
+  idIn: [ID!]
+  idNotIn: [ID!]
+  idGT: ID
+  idGTE: ID
+  idLT: ID
+  idLTE: ID
+  """
+  file_id field predicates
+  """
+  fileID: ID
+  fileIDNEQ: ID
+  fileIDIn: [ID!]
+  fileIDNotIn: [ID!]
+  fileIDGT: ID
+  fileIDGTE: ID
+  fileIDLT: ID
+  fileIDLTE: ID
+  fileIDContains: ID
+  fileIDHasPrefix: ID
+  fileIDHasSuffix: ID
+  fileIDEqualFold: ID
+  fileIDContainsFold: ID
+  """
+  user_id field predicates
+  """
+  userID: ID
+  userIDNEQ: ID
+  userIDIn: [ID!]
+  userIDNotIn: [ID!]
+  userIDGT: ID
+  userIDGTE: ID
+  userIDLT: ID
+  userIDLTE: ID
+  userIDContains: ID
+  userIDHasPrefix: ID
+  userIDHasSuffix: ID
+  userIDIsNil: Boolean
+  userIDNotNil: Boolean
+  userIDEqualFold: ID
+  userIDContainsFold: ID
+  """
+  room_id field predicates
+  """
+  roomID: ID
+  roomIDNEQ: ID
+  roomIDIn: [ID!]
+  roomIDNotIn: [ID!]
+  roomIDGT: ID
+  roomIDGTE: ID
+  roomIDLT: ID
+  roomIDLTE: ID
+  roomIDContains: ID
+  roomIDHasPrefix: ID
+  roomIDHasSuffix: ID
+  roomIDIsNil: Boolean
+  roomIDNotNil: Boolean
+  roomIDEqualFold: ID
+  roomIDContainsFold: ID
+  """
+  created_at field predicates
+  """
+  createdAt: Time
+  createdAtNEQ: Time
+  createdAtIn: [Time!]
+  createdAtNotIn: [Time!]
+  createdAtGT: Time
+  createdAtGTE: Time
+  createdAtLT: Time
+  createdAtLTE: Time
+  """
+  file edge predicates
+  """
+  hasFile: Boolean
+  hasFileWith: [FileWhereInput!]
+  """
+  user edge predicates
+  """
+  hasUser: Boolean
+  hasUserWith: [UserWhereInput!]
+  """
+  room edge predicates
+  """
+  hasRoom: Boolean
+  hasRoomWith: [RoomWhereInput!]
+}
+type Call implements Node {
+  id: ID!
+  type: CallType!
+  state: CallState!
+  roomID: ID!
+  callerID: ID!
+  startedAt: Time!
+  answeredAt: Time
+  endedAt: Time
+  """
+  Duration of the conversation in seconds
+  """
+  duration: Int!
+  room: Room!
+  caller: User!
+  participants: [CallParticipant!]
+}
+"""
+A connection to a list of items.
+"""
+type CallConnection {
+  """
+  A list of edges.
+  """
+  edges: [CallEdge]
+  """
+  Information to aid in pagination.
+  """
+  pageInfo: PageInfo!
+  """
+  Identifies the total count of items in the connection.
+  """
+  totalCount: Int!
+}
+"""
+An edge in a connection.
+"""
+type CallEdge {
+  """
+  The item at the end of the edge.
+  """
+  node: Call
+  """
+  A cursor for use in pagination.
+  """
+  cursor: Cursor!
+}
+"""
+Ordering options for Call connections
+"""
+input CallOrder {
+  """
+  The ordering direction.
+  """
+  direction: OrderDirection! = ASC
+  """
+  The field by which to order Calls.
+  """
+  field: CallOrderField!
+}
+"""
+Properties by which Call connections can be ordered.
+"""
+enum CallOrderField {
+  STARTED_AT
+}
+type CallParticipant implements Node {
+  id: ID!
+  state: CallParticipantState!
+  callID: ID!
+  userID: ID!
+  invitedAt: Time!
+  joinedAt: Time
+  leftAt: Time
+  call: Call!
+  user: User!
+}
+"""
+CallParticipantState is enum for the field state
+"""
+enum CallParticipantState @goModel(model: "journeyhub/ent/callparticipant.State") {
+  Invited
+  Joined
+  Left
+  Declined
+  Missed
+}
+"""
+CallParticipantWhereInput is used for filtering CallParticipant objects.
+Input was generated by ent.
+"""
+input CallParticipantWhereInput {
+  not: CallParticipantWhereInput
+  and: [CallParticipantWhereInput!]
+  or: [CallParticipantWhereInput!]
+  """
+  id field predicates
+  """
+  id: ID
+  idNEQ: ID
+  idIn: [ID!]
+  idNotIn: [ID!]
+  idGT: ID
+  idGTE: ID
+  idLT: ID
+  idLTE: ID
+  """
+  state field predicates
+  """
+  state: CallParticipantState
+  stateNEQ: CallParticipantState
+  stateIn: [CallParticipantState!]
+  stateNotIn: [CallParticipantState!]
+  """
+  call_id field predicates
+  """
+  callID: ID
+  callIDNEQ: ID
+  callIDIn: [ID!]
+  callIDNotIn: [ID!]
+  callIDGT: ID
+  callIDGTE: ID
+  callIDLT: ID
+  callIDLTE: ID
+  callIDContains: ID
+  callIDHasPrefix: ID
+  callIDHasSuffix: ID
+  callIDEqualFold: ID
+  callIDContainsFold: ID
+  """
+  user_id field predicates
+  """
+  userID: ID
+  userIDNEQ: ID
+  userIDIn: [ID!]
+  userIDNotIn: [ID!]
+  userIDGT: ID
+  userIDGTE: ID
+  userIDLT: ID
+  userIDLTE: ID
+  userIDContains: ID
+  userIDHasPrefix: ID
+  userIDHasSuffix: ID
+  userIDEqualFold: ID
+  userIDContainsFold: ID
+  """
+  invited_at field predicates
+  """
+  invitedAt: Time
+  invitedAtNEQ: Time
+  invitedAtIn: [Time!]
+  invitedAtNotIn: [Time!]
+  invitedAtGT: Time
+  invitedAtGTE: Time
+  invitedAtLT: Time
+  invitedAtLTE: Time
+  """
+  joined_at field predicates
+  """
+  joinedAt: Time
+  joinedAtNEQ: Time
+  joinedAtIn: [Time!]
+  joinedAtNotIn: [Time!]
+  joinedAtGT: Time
+  joinedAtGTE: Time
+  joinedAtLT: Time
+  joinedAtLTE: Time
+  joinedAtIsNil: Boolean
+  joinedAtNotNil: Boolean
+  """
+  left_at field predicates
+  """
+  leftAt: Time
+  leftAtNEQ: Time
+  leftAtIn: [Time!]
+  leftAtNotIn: [Time!]
+  leftAtGT: Time
+  leftAtGTE: Time
+  leftAtLT: Time
+  leftAtLTE: Time
+  leftAtIsNil: Boolean
+  leftAtNotNil: Boolean
+  """
+  call edge predicates
+  """
+  hasCall: Boolean
+  hasCallWith: [CallWhereInput!]
+}
+"""
+CallState is enum for the field state
+"""
+enum CallState @goModel(model: "journeyhub/ent/call.State") {
+  Ringing
+  Active
+  Ended
+  Missed
+  Declined
+}
+"""
+CallType is enum for the field type
+"""
+enum CallType @goModel(model: "journeyhub/ent/call.Type") {
+  Audio
+  Video
+}
+"""
+CallWhereInput is used for filtering Call objects.
+Input was generated by ent.
+"""
+input CallWhereInput {
+  not: CallWhereInput
+  and: [CallWhereInput!]
+  or: [CallWhereInput!]
+  """
+  id field predicates
+  """
+  id: ID
+  idNEQ: ID
+  idIn: [ID!]
+  idNotIn: [ID!]
+  idGT: ID
+  idGTE: ID
+  idLT: ID
+  idLTE: ID
+  """
+  type field predicates
+  """
+  type: CallType
+  typeNEQ: CallType
+  typeIn: [CallType!]
+  typeNotIn: [CallType!]
+  """
+  state field predicates
+  """
+  state: CallState
+  stateNEQ: CallState
+  stateIn: [CallState!]
+  stateNotIn: [CallState!]
+  """
+  room_id field predicates
+  """
+  roomID: ID
+  roomIDNEQ: ID
+  roomIDIn: [ID!]
+  roomIDNotIn: [ID!]
+  roomIDGT: ID
+  roomIDGTE: ID
+  roomIDLT: ID
+  roomIDLTE: ID
+  roomIDContains: ID
+  roomIDHasPrefix: ID
+  roomIDHasSuffix: ID
+  roomIDEqualFold: ID
+  roomIDContainsFold: ID
+  """
+  caller_id field predicates
+  """
+  callerID: ID
+  callerIDNEQ: ID
+  callerIDIn: [ID!]
+  callerIDNotIn: [ID!]
+  callerIDGT: ID
+  callerIDGTE: ID
+  callerIDLT: ID
+  callerIDLTE: ID
+  callerIDContains: ID
+  callerIDHasPrefix: ID
+  callerIDHasSuffix: ID
+  callerIDEqualFold: ID
+  callerIDContainsFold: ID
+  """
+  started_at field predicates
+  """
+  startedAt: Time
+  startedAtNEQ: Time
+  startedAtIn: [Time!]
+  startedAtNotIn: [Time!]
+  startedAtGT: Time
+  startedAtGTE: Time
+  startedAtLT: Time
+  startedAtLTE: Time
+  """
+  answered_at field predicates
+  """
+  answeredAt: Time
+  answeredAtNEQ: Time
+  answeredAtIn: [Time!]
+  answeredAtNotIn: [Time!]
+  answeredAtGT: Time
+  answeredAtGTE: Time
+  answeredAtLT: Time
+  answeredAtLTE: Time
+  answeredAtIsNil: Boolean
+  answeredAtNotNil: Boolean
+  """
+  ended_at field predicates
+  """
+  endedAt: Time
+  endedAtNEQ: Time
+  endedAtIn: [Time!]
+  endedAtNotIn: [Time!]
+  endedAtGT: Time
+  endedAtGTE: Time
+  endedAtLT: Time
+  endedAtLTE: Time
+  endedAtIsNil: Boolean
+  endedAtNotNil: Boolean
+  """
+  duration field predicates
+  """
+  duration: Int
+  durationNEQ: Int
+  durationIn: [Int!]
+  durationNotIn: [Int!]
+  durationGT: Int
+  durationGTE: Int
+  durationLT: Int
+  durationLTE: Int
+  """
+  room edge predicates
+  """
+  hasRoom: Boolean
+  hasRoomWith: [RoomWhereInput!]
+  """
+  caller edge predicates
+  """
+  hasCaller: Boolean
+  hasCallerWith: [UserWhereInput!]
+  """
+  participants edge predicates
+  """
+  hasParticipants: Boolean
+  hasParticipantsWith: [CallParticipantWhereInput!]
+}
+type ContactPin implements Node {
+  id: ID!
+  userID: ID!
+  expiresAt: Time
+  maxUses: Int
+  uses: Int!
+  revokedAt: Time
+  createdAt: Time!
+  user: User!
+}
+"""
+A connection to a list of items.
+"""
+type ContactPinConnection {
+  """
+  A list of edges.
+  """
+  edges: [ContactPinEdge]
+  """
+  Information to aid in pagination.
+  """
+  pageInfo: PageInfo!
+  """
+  Identifies the total count of items in the connection.
+  """
+  totalCount: Int!
+}
+"""
+An edge in a connection.
+"""
+type ContactPinEdge {
+  """
+  The item at the end of the edge.
+  """
+  node: ContactPin
+  """
+  A cursor for use in pagination.
+  """
+  cursor: Cursor!
+}
+"""
+Ordering options for ContactPin connections
+"""
+input ContactPinOrder {
+  """
+  The ordering direction.
+  """
+  direction: OrderDirection! = ASC
+  """
+  The field by which to order ContactPins.
+  """
+  field: ContactPinOrderField!
+}
+"""
+Properties by which ContactPin connections can be ordered.
+"""
+enum ContactPinOrderField {
+  CREATED_AT
+}
+"""
+ContactPinWhereInput is used for filtering ContactPin objects.
+Input was generated by ent.
+"""
+input ContactPinWhereInput {
+  not: ContactPinWhereInput
+  and: [ContactPinWhereInput!]
+  or: [ContactPinWhereInput!]
+  """
+  id field predicates
+  """
+  id: ID
+  idNEQ: ID
+  idIn: [ID!]
+  idNotIn: [ID!]
+  idGT: ID
+  idGTE: ID
+  idLT: ID
+  idLTE: ID
+  """
+  user_id field predicates
+  """
+  userID: ID
+  userIDNEQ: ID
+  userIDIn: [ID!]
+  userIDNotIn: [ID!]
+  userIDGT: ID
+  userIDGTE: ID
+  userIDLT: ID
+  userIDLTE: ID
+  userIDContains: ID
+  userIDHasPrefix: ID
+  userIDHasSuffix: ID
+  userIDEqualFold: ID
+  userIDContainsFold: ID
+  """
+  expires_at field predicates
+  """
+  expiresAt: Time
+  expiresAtNEQ: Time
+  expiresAtIn: [Time!]
+  expiresAtNotIn: [Time!]
+  expiresAtGT: Time
+  expiresAtGTE: Time
+  expiresAtLT: Time
+  expiresAtLTE: Time
+  expiresAtIsNil: Boolean
+  expiresAtNotNil: Boolean
+  """
+  max_uses field predicates
+  """
+  maxUses: Int
+  maxUsesNEQ: Int
+  maxUsesIn: [Int!]
+  maxUsesNotIn: [Int!]
+  maxUsesGT: Int
+  maxUsesGTE: Int
+  maxUsesLT: Int
+  maxUsesLTE: Int
+  maxUsesIsNil: Boolean
+  maxUsesNotNil: Boolean
+  """
+  uses field predicates
+  """
+  uses: Int
+  usesNEQ: Int
+  usesIn: [Int!]
+  usesNotIn: [Int!]
+  usesGT: Int
+  usesGTE: Int
+  usesLT: Int
+  usesLTE: Int
+  """
+  revoked_at field predicates
+  """
+  revokedAt: Time
+  revokedAtNEQ: Time
+  revokedAtIn: [Time!]
+  revokedAtNotIn: [Time!]
+  revokedAtGT: Time
+  revokedAtGTE: Time
+  revokedAtLT: Time
+  revokedAtLTE: Time
+  revokedAtIsNil: Boolean
+  revokedAtNotNil: Boolean
+  """
+  created_at field predicates
+  """
+  createdAt: Time
+  createdAtNEQ: Time
+  createdAtIn: [Time!]
+  createdAtNotIn: [Time!]
+  createdAtGT: Time
+  createdAtGTE: Time
+  createdAtLT: Time
+  createdAtLTE: Time
+}
+type ContactRequest implements Node {
+  id: ID!
+  senderID: ID!
+  receiverID: ID!
+  status: ContactRequestStatus!
+  createdAt: Time!
+  updatedAt: Time!
+  sender: User!
+  receiver: User!
+}
+"""
+A connection to a list of items.
+"""
+type ContactRequestConnection {
+  """
+  A list of edges.
+  """
+  edges: [ContactRequestEdge]
+  """
+  Information to aid in pagination.
+  """
+  pageInfo: PageInfo!
+  """
+  Identifies the total count of items in the connection.
+  """
+  totalCount: Int!
+}
+"""
+An edge in a connection.
+"""
+type ContactRequestEdge {
+  """
+  The item at the end of the edge.
+  """
+  node: ContactRequest
+  """
+  A cursor for use in pagination.
+  """
+  cursor: Cursor!
+}
+"""
+Ordering options for ContactRequest connections
+"""
+input ContactRequestOrder {
+  """
+  The ordering direction.
+  """
+  direction: OrderDirection! = ASC
+  """
+  The field by which to order ContactRequests.
+  """
+  field: ContactRequestOrderField!
+}
+"""
+Properties by which ContactRequest connections can be ordered.
+"""
+enum ContactRequestOrderField {
+  STATUS
+  CREATED_AT
+  UPDATED_AT
+}
+"""
+ContactRequestStatus is enum for the field status
+"""
+enum ContactRequestStatus @goModel(model: "journeyhub/ent/contactrequest.Status") {
+  Pending
+  Accepted
+  Declined
+}
+"""
+ContactRequestWhereInput is used for filtering ContactRequest objects.
+Input was generated by ent.
+"""
+input ContactRequestWhereInput {
+  not: ContactRequestWhereInput
+  and: [ContactRequestWhereInput!]
+  or: [ContactRequestWhereInput!]
+  """
+  id field predicates
+  """
+  id: ID
+  idNEQ: ID
+  idIn: [ID!]
+  idNotIn: [ID!]
+  idGT: ID
+  idGTE: ID
+  idLT: ID
+  idLTE: ID
+  """
+  sender_id field predicates
+  """
+  senderID: ID
+  senderIDNEQ: ID
+  senderIDIn: [ID!]
+  senderIDNotIn: [ID!]
+  senderIDGT: ID
+  senderIDGTE: ID
+  senderIDLT: ID
+  senderIDLTE: ID
+  senderIDContains: ID
+  senderIDHasPrefix: ID
+  senderIDHasSuffix: ID
+  senderIDEqualFold: ID
+  senderIDContainsFold: ID
+  """
+  receiver_id field predicates
+  """
+  receiverID: ID
+  receiverIDNEQ: ID
+  receiverIDIn: [ID!]
+  receiverIDNotIn: [ID!]
+  receiverIDGT: ID
+  receiverIDGTE: ID
+  receiverIDLT: ID
+  receiverIDLTE: ID
+  receiverIDContains: ID
+  receiverIDHasPrefix: ID
+  receiverIDHasSuffix: ID
+  receiverIDEqualFold: ID
+  receiverIDContainsFold: ID
+  """
+  status field predicates
+  """
+  status: ContactRequestStatus
+  statusNEQ: ContactRequestStatus
+  statusIn: [ContactRequestStatus!]
+  statusNotIn: [ContactRequestStatus!]
+  """
+  created_at field predicates
+  """
+  createdAt: Time
+  createdAtNEQ: Time
+  createdAtIn: [Time!]
+  createdAtNotIn: [Time!]
+  createdAtGT: Time
+  createdAtGTE: Time
+  createdAtLT: Time
+  createdAtLTE: Time
+  """
+  updated_at field predicates
+  """
+  updatedAt: Time
+  updatedAtNEQ: Time
+  updatedAtIn: [Time!]
+  updatedAtNotIn: [Time!]
+  updatedAtGT: Time
+  updatedAtGTE: Time
+  updatedAtLT: Time
+  updatedAtLTE: Time
+  """
+  sender edge predicates
+  """
+  hasSender: Boolean
+  hasSenderWith: [UserWhereInput!]
+  """
+  receiver edge predicates
+  """
+  hasReceiver: Boolean
+  hasReceiverWith: [UserWhereInput!]
+}
 """
 Define a Relay Cursor type:
 https://relay.dev/graphql/connections.htm#sec-Cursor
@@ -2277,7 +5133,9 @@ scalar Cursor
 type Device implements Node {
   id: ID!
   deviceID: String!
-  fcmToken: String!
+  platform: DevicePlatform!
+  lastPushAt: Time
+  lastPushError: String
   createdAt: Time!
   updatedAt: Time!
   user: User!
@@ -2330,11 +5188,19 @@ Properties by which Device connections can be ordered.
 """
 enum DeviceOrderField {
   DEVICE_ID
-  FCM_TOKEN
+  PLATFORM
   CREATED_AT
   UPDATED_AT
 }
 """
+DevicePlatform is enum for the field platform
+"""
+enum DevicePlatform @goModel(model: "journeyhub/ent/device.Platform") {
+  Android
+  IOS
+  Web
+}
+"""
 DeviceWhereInput is used for filtering Device objects.
 Input was generated by ent.
 """
@@ -2370,21 +5236,43 @@ input DeviceWhereInput {
   deviceIDEqualFold: String
   deviceIDContainsFold: String
   """
-  fcm_token field predicates
-  """
-  fcmToken: String
-  fcmTokenNEQ: String
-  fcmTokenIn: [String!]
-  fcmTokenNotIn: [String!]
-  fcmTokenGT: String
-  fcmTokenGTE: String
-  fcmTokenLT: String
-  fcmTokenLTE: String
-  fcmTokenContains: String
-  fcmTokenHasPrefix: String
-  fcmTokenHasSuffix: String
-  fcmTokenEqualFold: String
-  fcmTokenContainsFold: String
+  platform field predicates
+  """
+  platform: DevicePlatform
+  platformNEQ: DevicePlatform
+  platformIn: [DevicePlatform!]
+  platformNotIn: [DevicePlatform!]
+  """
+  last_push_at field predicates
+  """
+  lastPushAt: Time
+  lastPushAtNEQ: Time
+  lastPushAtIn: [Time!]
+  lastPushAtNotIn: [Time!]
+  lastPushAtGT: Time
+  lastPushAtGTE: Time
+  lastPushAtLT: Time
+  lastPushAtLTE: Time
+  lastPushAtIsNil: Boolean
+  lastPushAtNotNil: Boolean
+  """
+  last_push_error field predicates
+  """
+  lastPushError: String
+  lastPushErrorNEQ: String
+  lastPushErrorIn: [String!]
+  lastPushErrorNotIn: [String!]
+  lastPushErrorGT: String
+  lastPushErrorGTE: String
+  lastPushErrorLT: String
+  lastPushErrorLTE: String
+  lastPushErrorContains: String
+  lastPushErrorHasPrefix: String
+  lastPushErrorHasSuffix: String
+  lastPushErrorIsNil: Boolean
+  lastPushErrorNotNil: Boolean
+  lastPushErrorEqualFold: String
+  lastPushErrorContainsFold: String
   """
   created_at field predicates
   """
@@ -2421,10 +5309,20 @@ type File implements Node {
   location: String
   bucket: String!
   path: String!
+  """
+  Width of images in pixels
+  """
+  width: Int
+  """
+  Height of images in pixels
+  """
+  height: Int
   createdAt: Time!
   updatedAt: Time!
   messageAttachment: MessageAttachment
   messageVoice: MessageVoice
+  original: File
+  thumbnails: [File!]
 }
 """
 Ordering options for File connections
@@ -2565,6 +5463,32 @@ input FileWhereInput {
   pathEqualFold: String
   pathContainsFold: String
   """
+  width field predicates
+  """
+  width: Int
+  widthNEQ: Int
+  widthIn: [Int!]
+  widthNotIn: [Int!]
+  widthGT: Int
+  widthGTE: Int
+  widthLT: Int
+  widthLTE: Int
+  widthIsNil: Boolean
+  widthNotNil: Boolean
+  """
+  height field predicates
+  """
+  height: Int
+  heightNEQ: Int
+  heightIn: [Int!]
+  heightNotIn: [Int!]
+  heightGT: Int
+  heightGTE: Int
+  heightLT: Int
+  heightLTE: Int
+  heightIsNil: Boolean
+  heightNotNil: Boolean
+  """
   created_at field predicates
   """
   createdAt: Time
@@ -2596,6 +5520,16 @@ input FileWhereInput {
   """
   hasMessageVoice: Boolean
   hasMessageVoiceWith: [MessageVoiceWhereInput!]
+  """
+  original edge predicates
+  """
+  hasOriginal: Boolean
+  hasOriginalWith: [FileWhereInput!]
+  """
+  thumbnails edge predicates
+  """
+  hasThumbnails: Boolean
+  hasThumbnailsWith: [FileWhereInput!]
 }
 """
 The builtin Map type
@@ -2603,7 +5537,10 @@ The builtin Map type
 scalar Map
 type Message implements Node {
   id: ID!
+  type: MessageType!
   content: String
+  callID: ID
+  avatarID: ID
   createdAt: Time!
   updatedAt: Time!
   voice: MessageVoice
@@ -2611,6 +5548,8 @@ type Message implements Node {
   replies: [Message!]
   attachments: [MessageAttachment!]
   links: [MessageLink!]
+  call: Call
+  avatar: Avatar
   user: User
   room: Room
 }
@@ -2982,6 +5921,14 @@ enum MessageOrderField {
   CREATED_AT
   UPDATED_AT
 }
+"""
+MessageType is enum for the field type
+"""
+enum MessageType @goModel(model: "journeyhub/ent/message.Type") {
+  Text
+  Call
+  RoomAvatar
+}
 type MessageVoice implements Node {
   id: ID!
   length: Uint64!
@@ -3117,6 +6064,13 @@ input MessageWhereInput {
   idLT: ID
   idLTE: ID
   """
+  type field predicates
+  """
+  type: MessageType
+  typeNEQ: MessageType
+  typeIn: [MessageType!]
+  typeNotIn: [MessageType!]
+  """
   content field predicates
   """
   content: String
@@ -3135,6 +6089,42 @@ input MessageWhereInput {
   contentEqualFold: String
   contentContainsFold: String
   """
+  call_id field predicates
+  """
+  callID: ID
+  callIDNEQ: ID
+  callIDIn: [ID!]
+  callIDNotIn: [ID!]
+  callIDGT: ID
+  callIDGTE: ID
+  callIDLT: ID
+  callIDLTE: ID
+  callIDContains: ID
+  callIDHasPrefix: ID
+  callIDHasSuffix: ID
+  callIDIsNil: Boolean
+  callIDNotNil: Boolean
+  callIDEqualFold: ID
+  callIDContainsFold: ID
+  """
+  avatar_id field predicates
+  """
+  avatarID: ID
+  avatarIDNEQ: ID
+  avatarIDIn: [ID!]
+  avatarIDNotIn: [ID!]
+  avatarIDGT: ID
+  avatarIDGTE: ID
+  avatarIDLT: ID
+  avatarIDLTE: ID
+  avatarIDContains: ID
+  avatarIDHasPrefix: ID
+  avatarIDHasSuffix: ID
+  avatarIDIsNil: Boolean
+  avatarIDNotNil: Boolean
+  avatarIDEqualFold: ID
+  avatarIDContainsFold: ID
+  """
   created_at field predicates
   """
   createdAt: Time
@@ -3182,6 +6172,16 @@ input MessageWhereInput {
   hasLinks: Boolean
   hasLinksWith: [MessageLinkWhereInput!]
   """
+  call edge predicates
+  """
+  hasCall: Boolean
+  hasCallWith: [CallWhereInput!]
+  """
+  avatar edge predicates
+  """
+  hasAvatar: Boolean
+  hasAvatarWith: [AvatarWhereInput!]
+  """
   user edge predicates
   """
   hasUser: Boolean
@@ -3204,9 +6204,11 @@ interface Node @goModel(model: "journeyhub/ent.Noder") {
 }
 type Notification implements Node {
   id: ID!
+  type: NotificationType!
   title: String!
   body: String!
   data: Map
+  readAt: Time
   createdAt: Time!
   updatedAt: Time!
   user: User
@@ -3258,11 +6260,24 @@ input NotificationOrder {
 Properties by which Notification connections can be ordered.
 """
 enum NotificationOrderField {
+  TYPE
   TITLE
+  READ_AT
   CREATED_AT
   UPDATED_AT
 }
 """
+NotificationType is enum for the field type
+"""
+enum NotificationType @goModel(model: "journeyhub/ent/notification.Type") {
+  NewMessage
+  Mention
+  Call
+  MissedCall
+  ContactAdded
+  ContactRequest
+}
+"""
 NotificationWhereInput is used for filtering Notification objects.
 Input was generated by ent.
 """
@@ -3282,6 +6297,13 @@ input NotificationWhereInput {
   idLT: ID
   idLTE: ID
   """
+  type field predicates
+  """
+  type: NotificationType
+  typeNEQ: NotificationType
+  typeIn: [NotificationType!]
+  typeNotIn: [NotificationType!]
+  """
   title field predicates
   """
   title: String
@@ -3314,6 +6336,19 @@ input NotificationWhereInput {
   bodyEqualFold: String
   bodyContainsFold: String
   """
+  read_at field predicates
+  """
+  readAt: Time
+  readAtNEQ: Time
+  readAtIn: [Time!]
+  readAtNotIn: [Time!]
+  readAtGT: Time
+  readAtGTE: Time
+  readAtLT: Time
+  readAtLTE: Time
+  readAtIsNil: Boolean
+  readAtNotNil: Boolean
+  """
   created_at field predicates
   """
   createdAt: Time
@@ -3520,11 +6555,336 @@ type Query {
     where: UserContactWhereInput
   ): UserContactConnection!
 }
+type Report implements Node {
+  id: ID!
+  type: ReportType!
+  reason: ReportReason!
+  text: String
+  snapshot: Map!
+  status: ReportStatus!
+  """
+  Actions taken when resolving the report
+  """
+  actions: [String!]
+  """
+  Note of the moderator who closed the report
+  """
+  note: String
+  reporterID: ID!
+  userID: ID
+  messageID: ID
+  roomID: ID
+  assigneeID: ID
+  closedAt: Time
+  createdAt: Time!
+  updatedAt: Time!
+  reporter: User!
+  user: User
+  message: Message
+  room: Room
+  assignee: User
+}
+"""
+A connection to a list of items.
+"""
+type ReportConnection {
+  """
+  A list of edges.
+  """
+  edges: [ReportEdge]
+  """
+  Information to aid in pagination.
+  """
+  pageInfo: PageInfo!
+  """
+  Identifies the total count of items in the connection.
+  """
+  totalCount: Int!
+}
+"""
+An edge in a connection.
+"""
+type ReportEdge {
+  """
+  The item at the end of the edge.
+  """
+  node: Report
+  """
+  A cursor for use in pagination.
+  """
+  cursor: Cursor!
+}
+"""
+Ordering options for Report connections
+"""
+input ReportOrder {
+  """
+  The ordering direction.
+  """
+  direction: OrderDirection! = ASC
+  """
+  The field by which to order Reports.
+  """
+  field: ReportOrderField!
+}
+"""
+Properties by which Report connections can be ordered.
+"""
+enum ReportOrderField {
+  TYPE
+  STATUS
+  CLOSED_AT
+  CREATED_AT
+  UPDATED_AT
+}
+"""
+ReportReason is enum for the field reason
+"""
+enum ReportReason @goModel(model: "journeyhub/ent/report.Reason") {
+  Spam
+  Harassment
+  Violence
+  Sexual
+  Impersonation
+  Other
+}
+"""
+ReportStatus is enum for the field status
+"""
+enum ReportStatus @goModel(model: "journeyhub/ent/report.Status") {
+  Open
+  Resolved
+  Dismissed
+}
+"""
+ReportType is enum for the field type
+"""
+enum ReportType @goModel(model: "journeyhub/ent/report.Type") {
+  Message
+  User
+  Room
+}
+"""
+ReportWhereInput is used for filtering Report objects.
+Input was generated by ent.
+"""
+input ReportWhereInput {
+  not: ReportWhereInput
+  and: [ReportWhereInput!]
+  or: [ReportWhereInput!]
+  """
+  id field predicates
+  """
+  id: ID
+  idNEQ: ID
+  idIn: [ID!]
+  idNotIn: [ID!]
+  idGT: ID
+  idGTE: ID
+  idLT: ID
+  idLTE: ID
+  """
+  type field predicates
+  """
+  type: ReportType
+  typeNEQ: ReportType
+  typeIn: [ReportType!]
+  typeNotIn: [ReportType!]
+  """
+  reason field predicates
+  """
+  reason: ReportReason
+  reasonNEQ: ReportReason
+  reasonIn: [ReportReason!]
+  reasonNotIn: [ReportReason!]
+  """
+  text field predicates
+  """
+  text: String
+  textNEQ: String
+  textIn: [String!]
+  textNotIn: [String!]
+  textGT: String
+  textGTE: String
+  textLT: String
+  textLTE: String
+  textContains: String
+  textHasPrefix: String
+  textHasSuffix: String
+  textIsNil: Boolean
+  textNotNil: Boolean
+  textEqualFold: String
+  textContainsFold: String
+  """
+  status field predicates
+  """
+  status: ReportStatus
+  statusNEQ: ReportStatus
+  statusIn: [ReportStatus!]
+  statusNotIn: [ReportStatus!]
+  """
+  note field predicates
+  """
+  note: String
+  noteNEQ: String
+  noteIn: [String!]
+  noteNotIn: [String!]
+  noteGT: String
+  noteGTE: String
+  noteLT: String
+  noteLTE: String
+  noteContains: String
+  noteHasPrefix: String
+  noteHasSuffix: String
+  noteIsNil: Boolean
+  noteNotNil: Boolean
+  noteEqualFold: String
+  noteContainsFold: String
+  """
+  reporter_id field predicates
+  """
+  reporterID: ID
+  reporterIDNEQ: ID
+  reporterIDIn: [ID!]
+  reporterIDNotIn: [ID!]
+  reporterIDGT: ID
+  reporterIDGTE: ID
+  reporterIDLT: ID
+  reporterIDLTE: ID
+  reporterIDContains: ID
+  reporterIDHasPrefix: ID
+  reporterIDHasSuffix: ID
+  reporterIDEqualFold: ID
+  reporterIDContainsFold: ID
+  """
+  user_id field predicates
+  """
+  userID: ID
+  userIDNEQ: ID
+  userIDIn: [ID!]
+  userIDNotIn: [ID!]
+  userIDGT: ID
+  userIDGTE: ID
+  userIDLT: ID
+  userIDLTE: ID
+  userIDContains: ID
+  userIDHasPrefix: ID
+  userIDHasSuffix: ID
+  userIDIsNil: Boolean
+  userIDNotNil: Boolean
+  userIDEqualFold: ID
+  userIDContainsFold: ID
+  """
+  message_id field predicates
+  """
+  messageID: ID
+  messageIDNEQ: ID
+  messageIDIn: [ID!]
+  messageIDNotIn: [ID!]
+  messageIDGT: ID
+  messageIDGTE: ID
+  messageIDLT: ID
+  messageIDLTE: ID
+  messageIDContains: ID
+  messageIDHasPrefix: ID
+  messageIDHasSuffix: ID
+  messageIDIsNil: Boolean
+  messageIDNotNil: Boolean
+  messageIDEqualFold: ID
+  messageIDContainsFold: ID
+  """
+  room_id field predicates
+  """
+  roomID: ID
+  roomIDNEQ: ID
+  roomIDIn: [ID!]
+  roomIDNotIn: [ID!]
+  roomIDGT: ID
+  roomIDGTE: ID
+  roomIDLT: ID
+  roomIDLTE: ID
+  roomIDContains: ID
+  roomIDHasPrefix: ID
+  roomIDHasSuffix: ID
+  roomIDIsNil: Boolean
+  roomIDNotNil: Boolean
+  roomIDEqualFold: ID
+  roomIDContainsFold: ID
+  """
+  assignee_id field predicates
+  """
+  assigneeID: ID
+  assigneeIDNEQ: ID
+  assigneeIDIn: [ID!]
+  assigneeIDNotIn: [ID!]
+  assigneeIDGT: ID
+  assigneeIDGTE: ID
+  assigneeIDLT: ID
+  assigneeIDLTE: ID
+  assigneeIDContains: ID
+  assigneeIDHasPrefix: ID
+  assigneeIDHasSuffix: ID
+  assigneeIDIsNil: Boolean
+  assigneeIDNotNil: Boolean
+  assigneeIDEqualFold: ID
+  assigneeIDContainsFold: ID
+  """
+  closed_at field predicates
+  """
+  closedAt: Time
+  closedAtNEQ: Time
+  closedAtIn: [Time!]
+  closedAtNotIn: [Time!]
+  closedAtGT: Time
+  closedAtGTE: Time
+  closedAtLT: Time
+  closedAtLTE: Time
+  closedAtIsNil: Boolean
+  closedAtNotNil: Boolean
+  """
+  created_at field predicates
+  """
+  createdAt: Time
+  createdAtNEQ: Time
+  createdAtIn: [Time!]
+  createdAtNotIn: [Time!]
+  createdAtGT: Time
+  createdAtGTE: Time
+  createdAtLT: Time
+  createdAtLTE: Time
+  """
+  updated_at field predicates
+  """
+  updatedAt: Time
+  updatedAtNEQ: Time
+  updatedAtIn: [Time!]
+  updatedAtNotIn: [Time!]
+  updatedAtGT: Time
+  updatedAtGTE: Time
+  updatedAtLT: Time
+  updatedAtLTE: Time
+  """
+  user edge predicates
+  """
+  hasUser: Boolean
+  hasUserWith: [UserWhereInput!]
+  """
+  message edge predicates
+  """
+  hasMessage: Boolean
+  hasMessageWith: [MessageWhereInput!]
+  """
+  room edge predicates
+  """
+  hasRoom: Boolean
+  hasRoomWith: [RoomWhereInput!]
+}
 type Room implements Node {
   id: ID!
   name: String
   description: String
   version: Uint64!
+  avatarID: ID
   type: RoomType!
   createdAt: Time!
   updatedAt: Time!
@@ -3685,6 +7045,8 @@ type Room implements Node {
     """
     where: MessageLinkWhereInput
   ): MessageLinkConnection!
+  avatar: File
+  avatars: [Avatar!]
   roomMembers(
     """
     Returns the elements in the list that come after the specified cursor.
@@ -3747,10 +7109,192 @@ type RoomEdge {
   """
   cursor: Cursor!
 }
+type RoomInvite implements Node {
+  id: ID!
+  roomID: ID!
+  creatorID: ID!
+  expiresAt: Time
+  maxUses: Int
+  uses: Int!
+  revokedAt: Time
+  createdAt: Time!
+  room: Room!
+  creator: User!
+}
+"""
+A connection to a list of items.
+"""
+type RoomInviteConnection {
+  """
+  A list of edges.
+  """
+  edges: [RoomInviteEdge]
+  """
+  Information to aid in pagination.
+  """
+  pageInfo: PageInfo!
+  """
+  Identifies the total count of items in the connection.
+  """
+  totalCount: Int!
+}
+"""
+An edge in a connection.
+"""
+type RoomInviteEdge {
+  """
+  The item at the end of the edge.
+  """
+  node: RoomInvite
+  """
+  A cursor for use in pagination.
+  """
+  cursor: Cursor!
+}
+"""
+Ordering options for RoomInvite connections
+"""
+input RoomInviteOrder {
+  """
+  The ordering direction.
+  """
+  direction: OrderDirection! = ASC
+  """
+  The field by which to order RoomInvites.
+  """
+  field: RoomInviteOrderField!
+}
+"""
+Properties by which RoomInvite connections can be ordered.
+"""
+enum RoomInviteOrderField {
+  CREATED_AT
+}
+"""
+RoomInviteWhereInput is used for filtering RoomInvite objects.
+Input was generated by ent.
+"""
+input RoomInviteWhereInput {
+  not: RoomInviteWhereInput
+  and: [RoomInviteWhereInput!]
+  or: [RoomInviteWhereInput!]
+  """
+  id field predicates
+  """
+  id: ID
+  idNEQ: ID
+  idIn: [ID!]
+  idNotIn: [ID!]
+  idGT: ID
+  idGTE: ID
+  idLT: ID
+  idLTE: ID
+  """
+  room_id field predicates
+  """
+  roomID: ID
+  roomIDNEQ: ID
+  roomIDIn: [ID!]
+  roomIDNotIn: [ID!]
+  roomIDGT: ID
+  roomIDGTE: ID
+  roomIDLT: ID
+  roomIDLTE: ID
+  roomIDContains: ID
+  roomIDHasPrefix: ID
+  roomIDHasSuffix: ID
+  roomIDEqualFold: ID
+  roomIDContainsFold: ID
+  """
+  creator_id field predicates
+  """
+  creatorID: ID
+  creatorIDNEQ: ID
+  creatorIDIn: [ID!]
+  creatorIDNotIn: [ID!]
+  creatorIDGT: ID
+  creatorIDGTE: ID
+  creatorIDLT: ID
+  creatorIDLTE: ID
+  creatorIDContains: ID
+  creatorIDHasPrefix: ID
+  creatorIDHasSuffix: ID
+  creatorIDEqualFold: ID
+  creatorIDContainsFold: ID
+  """
+  expires_at field predicates
+  """
+  expiresAt: Time
+  expiresAtNEQ: Time
+  expiresAtIn: [Time!]
+  expiresAtNotIn: [Time!]
+  expiresAtGT: Time
+  expiresAtGTE: Time
+  expiresAtLT: Time
+  expiresAtLTE: Time
+  expiresAtIsNil: Boolean
+  expiresAtNotNil: Boolean
+  """
+  max_uses field predicates
+  """
+  maxUses: Int
+  maxUsesNEQ: Int
+  maxUsesIn: [Int!]
+  maxUsesNotIn: [Int!]
+  maxUsesGT: Int
+  maxUsesGTE: Int
+  maxUsesLT: Int
+  maxUsesLTE: Int
+  maxUsesIsNil: Boolean
+  maxUsesNotNil: Boolean
+  """
+  uses field predicates
+  """
+  uses: Int
+  usesNEQ: Int
+  usesIn: [Int!]
+  usesNotIn: [Int!]
+  usesGT: Int
+  usesGTE: Int
+  usesLT: Int
+  usesLTE: Int
+  """
+  revoked_at field predicates
+  """
+  revokedAt: Time
+  revokedAtNEQ: Time
+  revokedAtIn: [Time!]
+  revokedAtNotIn: [Time!]
+  revokedAtGT: Time
+  revokedAtGTE: Time
+  revokedAtLT: Time
+  revokedAtLTE: Time
+  revokedAtIsNil: Boolean
+  revokedAtNotNil: Boolean
+  """
+  created_at field predicates
+  """
+  createdAt: Time
+  createdAtNEQ: Time
+  createdAtIn: [Time!]
+  createdAtNotIn: [Time!]
+  createdAtGT: Time
+  createdAtGTE: Time
+  createdAtLT: Time
+  createdAtLTE: Time
+  """
+  room edge predicates
+  """
+  hasRoom: Boolean
+  hasRoomWith: [RoomWhereInput!]
+}
 type RoomMember implements Node {
   id: ID!
   name: String
   unreadMessagesCount: Int!
+  notificationLevel: RoomMemberNotificationLevel!
+  muteUntil: Time
+  role: RoomMemberRole!
   userID: ID!
   roomID: ID!
   joinedAt: Time!
@@ -3789,6 +7333,14 @@ type RoomMemberEdge {
   cursor: Cursor!
 }
 """
+RoomMemberNotificationLevel is enum for the field notification_level
+"""
+enum RoomMemberNotificationLevel @goModel(model: "journeyhub/ent/roommember.NotificationLevel") {
+  All
+  Mentions
+  None
+}
+"""
 Ordering options for RoomMember connections
 """
 input RoomMemberOrder {
@@ -3812,6 +7364,15 @@ enum RoomMemberOrderField {
   ROOM_UPDATED_AT
 }
 """
+RoomMemberRole is enum for the field role
+"""
+enum RoomMemberRole @goModel(model: "journeyhub/ent/roommember.Role") {
+  Owner
+  Admin
+  Member
+  ReadOnly
+}
+"""
 RoomMemberWhereInput is used for filtering RoomMember objects.
 Input was generated by ent.
 """
@@ -3860,6 +7421,33 @@ input RoomMemberWhereInput {
   unreadMessagesCountLT: Int
   unreadMessagesCountLTE: Int
   """
+  notification_level field predicates
+  """
+  notificationLevel: RoomMemberNotificationLevel
+  notificationLevelNEQ: RoomMemberNotificationLevel
+  notificationLevelIn: [RoomMemberNotificationLevel!]
+  notificationLevelNotIn: [RoomMemberNotificationLevel!]
+  """
+  mute_until field predicates
+  """
+  muteUntil: Time
+  muteUntilNEQ: Time
+  muteUntilIn: [Time!]
+  muteUntilNotIn: [Time!]
+  muteUntilGT: Time
+  muteUntilGTE: Time
+  muteUntilLT: Time
+  muteUntilLTE: Time
+  muteUntilIsNil: Boolean
+  muteUntilNotNil: Boolean
+  """
+  role field predicates
+  """
+  role: RoomMemberRole
+  roleNEQ: RoomMemberRole
+  roleIn: [RoomMemberRole!]
+  roleNotIn: [RoomMemberRole!]
+  """
   joined_at field predicates
   """
   joinedAt: Time
@@ -3981,6 +7569,24 @@ input RoomWhereInput {
   versionLT: Uint64
   versionLTE: Uint64
   """
+  avatar_id field predicates
+  """
+  avatarID: ID
+  avatarIDNEQ: ID
+  avatarIDIn: [ID!]
+  avatarIDNotIn: [ID!]
+  avatarIDGT: ID
+  avatarIDGTE: ID
+  avatarIDLT: ID
+  avatarIDLTE: ID
+  avatarIDContains: ID
+  avatarIDHasPrefix: ID
+  avatarIDHasSuffix: ID
+  avatarIDIsNil: Boolean
+  avatarIDNotNil: Boolean
+  avatarIDEqualFold: ID
+  avatarIDContainsFold: ID
+  """
   type field predicates
   """
   type: RoomType
@@ -4040,10 +7646,20 @@ input RoomWhereInput {
   hasMessageAttachments: Boolean
   hasMessageAttachmentsWith: [MessageAttachmentWhereInput!]
   """
-  message_links edge predicates
+  message_links edge predicates
+  """
+  hasMessageLinks: Boolean
+  hasMessageLinksWith: [MessageLinkWhereInput!]
+  """
+  avatar edge predicates
+  """
+  hasAvatar: Boolean
+  hasAvatarWith: [FileWhereInput!]
+  """
+  avatars edge predicates
   """
-  hasMessageLinks: Boolean
-  hasMessageLinksWith: [MessageLinkWhereInput!]
+  hasAvatars: Boolean
+  hasAvatarsWith: [AvatarWhereInput!]
   """
   room_members edge predicates
   """
@@ -4068,41 +7684,11 @@ type User implements Node {
   lastName: String!
   nickname: String!
   email: String
-  contactPin: String
+  role: UserRole!
+  avatarID: ID
   createdAt: Time!
   updatedAt: Time!
   device: Device
-  notifications(
-    """
-    Returns the elements in the list that come after the specified cursor.
-    """
-    after: Cursor
-
-    """
-    Returns the first _n_ elements from the list.
-    """
-    first: Int
-
-    """
-    Returns the elements in the list that come before the specified cursor.
-    """
-    before: Cursor
-
-    """
-    Returns the last _n_ elements from the list.
-    """
-    last: Int
-
-    """
-    Ordering options for Notifications returned from the connection.
-    """
-    orderBy: [NotificationOrder!]
-
-    """
-    Filtering options for Notifications returned from the connection.
-    """
-    where: NotificationWhereInput
-  ): NotificationConnection!
   contacts(
     """
     Returns the elements in the list that come after the specified cursor.
@@ -4196,6 +7782,8 @@ type User implements Node {
     """
     where: MessageWhereInput
   ): MessageConnection!
+  avatar: File
+  avatars: [Avatar!]
   userContacts(
     """
     Returns the elements in the list that come after the specified cursor.
@@ -4404,6 +7992,13 @@ enum UserOrderField {
   UPDATED_AT
 }
 """
+UserRole is enum for the field role
+"""
+enum UserRole @goModel(model: "journeyhub/ent/user.Role") {
+  User
+  Moderator
+}
+"""
 UserWhereInput is used for filtering User objects.
 Input was generated by ent.
 """
@@ -4489,23 +8084,23 @@ input UserWhereInput {
   emailEqualFold: String
   emailContainsFold: String
   """
-  contact_pin field predicates
-  """
-  contactPin: String
-  contactPinNEQ: String
-  contactPinIn: [String!]
-  contactPinNotIn: [String!]
-  contactPinGT: String
-  contactPinGTE: String
-  contactPinLT: String
-  contactPinLTE: String
-  contactPinContains: String
-  contactPinHasPrefix: String
-  contactPinHasSuffix: String
-  contactPinIsNil: Boolean
-  contactPinNotNil: Boolean
-  contactPinEqualFold: String
-  contactPinContainsFold: String
+  avatar_id field predicates
+  """
+  avatarID: ID
+  avatarIDNEQ: ID
+  avatarIDIn: [ID!]
+  avatarIDNotIn: [ID!]
+  avatarIDGT: ID
+  avatarIDGTE: ID
+  avatarIDLT: ID
+  avatarIDLTE: ID
+  avatarIDContains: ID
+  avatarIDHasPrefix: ID
+  avatarIDHasSuffix: ID
+  avatarIDIsNil: Boolean
+  avatarIDNotNil: Boolean
+  avatarIDEqualFold: ID
+  avatarIDContainsFold: ID
   """
   created_at field predicates
   """
@@ -4534,11 +8129,6 @@ input UserWhereInput {
   hasDevice: Boolean
   hasDeviceWith: [DeviceWhereInput!]
   """
-  notifications edge predicates
-  """
-  hasNotifications: Boolean
-  hasNotificationsWith: [NotificationWhereInput!]
-  """
   contacts edge predicates
   """
   hasContacts: Boolean
@@ -4554,6 +8144,16 @@ input UserWhereInput {
   hasMessages: Boolean
   hasMessagesWith: [MessageWhereInput!]
   """
+  avatar edge predicates
+  """
+  hasAvatar: Boolean
+  hasAvatarWith: [FileWhereInput!]
+  """
+  avatars edge predicates
+  """
+  hasAvatars: Boolean
+  hasAvatarsWith: [AvatarWhereInput!]
+  """
   user_contacts edge predicates
   """
   hasUserContacts: Boolean
@@ -4592,6 +8192,7 @@ input SendMessageInput {
   files: [UploadMessageFileInput!] @goTag(key: "validate", value: "max=20")
   voice: UploadMessageVoiceInput
   links: [CreateMessageLinkInput!]
+  silent: Boolean
 }
 
 """
@@ -4779,22 +8380,159 @@ extend type Query {
     where: MessageVoiceWhereInput
   ): MessageVoiceConnection!
 }
+`, BuiltIn: false},
+	{Name: "../schema/notification.graphql", Input: `extend type Query {
+  unreadNotificationsCount: Int!
+}
+
+extend type Mutation {
+  """
+  Marks the given notifications of the current user as read,
+  or all of them when notificationIDs is omitted.
+  Returns the number of notifications marked.
+  """
+  markNotificationsRead(notificationIDs: [ID!]): Int!
+}
+
+extend type Subscription {
+  notificationCreated: NotificationEdge!
+}
+`, BuiltIn: false},
+	{Name: "../schema/presence.graphql", Input: `"""
+Presence tells whether a user is connected. The last seen time is hidden by
+users who chose to.
+"""
+type Presence {
+  online: Boolean!
+  lastSeenAt: Time
+}
+
+"""
+PresenceChange is sent when a contact or a room peer comes online or goes
+offline.
+"""
+type PresenceChange {
+  userID: ID!
+  presence: Presence!
+}
+
+extend type User {
+  """
+  Presence of the user, available for the current user, their contacts and
+  room peers only.
+  """
+  presence: Presence
+}
+
+extend type Subscription {
+  presenceChanged: PresenceChange!
+}
+`, BuiltIn: false},
+	{Name: "../schema/report.graphql", Input: `"""
+ReportInput is used for reporting a message, a user or a room.
+"""
+input ReportInput {
+  reason: ReportReason!
+  text: String @goTag(key: "validate", value: "omitempty,max=2048")
+}
+
+"""
+ReportAction is taken by a moderator when resolving a report.
+"""
+enum ReportAction {
+  """
+  Deletes the reported message or room.
+  """
+  DELETE_CONTENT
+  """
+  Suspends the account of the reported user.
+  """
+  SUSPEND_ACCOUNT
+  """
+  Bans the reported user from the room of the report.
+  """
+  BAN_FROM_ROOM
+}
+
+"""
+ResolveReportInput is used for resolving a report.
+"""
+input ResolveReportInput {
+  actions: [ReportAction!]!
+  note: String @goTag(key: "validate", value: "omitempty,max=2048")
+}
+
+extend type Query {
+  """
+  Reports of the moderation queue, available for moderators only.
+  """
+  reports(
+    """
+    Returns the elements in the list that come after the specified cursor.
+    """
+    after: Cursor
+
+    """
+    Returns the first _n_ elements from the list.
+    """
+    first: Int
+
+    """
+    Returns the elements in the list that come before the specified cursor.
+    """
+    before: Cursor
+
+    """
+    Returns the last _n_ elements from the list.
+    """
+    last: Int
+
+    """
+    Ordering options for Reports returned from the connection.
+    """
+    orderBy: [ReportOrder!]
+
+    """
+    Filtering options for Reports returned from the connection.
+    """
+    where: ReportWhereInput
+  ): ReportConnection!
+}
+
+extend type Mutation {
+  reportMessage(messageID: ID!, input: ReportInput!): Report!
+  reportUser(userID: ID!, input: ReportInput!): Report!
+  reportRoom(roomID: ID!, input: ReportInput!): Report!
+
+  """
+  Assigns the report to the moderator, the current user when omitted.
+  """
+  assignReport(reportID: ID!, assigneeID: ID): Report!
+  resolveReport(reportID: ID!, input: ResolveReportInput!): Report!
+  dismissReport(reportID: ID!, note: String): Report!
+}
 `, BuiltIn: false},
 	{Name: "../schema/room.graphql", Input: `"""
 CreateRoomInput is used for create Room object.
 """
 input CreateRoomInput {
-  name: String!
-  userIDs: [ID!]
+  name: String! @goTag(key: "validate", value: "min=1,max=255")
+  description: String @goTag(key: "validate", value: "omitempty,max=1024")
+  userIDs: [ID!] @goTag(key: "validate", value: "max=255")
 }
 
 """
 UpdateRoomInput is used for update Room object.
 """
 input UpdateRoomInput {
-  name: String
-  addUserIDs: [ID!]
+  name: String @goTag(key: "validate", value: "omitempty,min=1,max=255")
+  description: String @goTag(key: "validate", value: "omitempty,max=1024")
+  clearDescription: Boolean
+  addUserIDs: [ID!] @goTag(key: "validate", value: "max=255")
   removeUserIDs: [ID!]
+  """
+  Removes every member but the current user, before the added users are added.
+  """
   clearUsers: Boolean
 }
 
@@ -4812,6 +8550,82 @@ extend type Query {
     roomID: ID!
   ): RoomEdge!
 }
+`, BuiltIn: false},
+	{Name: "../schema/room_invite.graphql", Input: `"""
+CreateRoomInviteInput is used for creating an invite link of a group room.
+"""
+input CreateRoomInviteInput {
+  roomID: ID!
+  expiresAt: Time
+  maxUses: Int @goTag(key: "validate", value: "omitempty,min=1")
+}
+
+"""
+CreateRoomInvitePayload carries the token of the invite, which is only
+returned once.
+"""
+type CreateRoomInvitePayload {
+  invite: RoomInvite!
+  token: String!
+}
+
+"""
+RoomInvitePreview shows the room of an invite before joining it.
+"""
+type RoomInvitePreview {
+  roomID: ID!
+  name: String!
+  description: String
+  membersCount: Int!
+  avatar: File
+}
+
+extend type Query {
+  previewRoomInvite(token: String!): RoomInvitePreview!
+
+  roomInvites(
+    """
+    Returns the elements in the list that come with the specified roomID.
+    """
+    roomID: ID!
+
+    """
+    Returns the elements in the list that come after the specified cursor.
+    """
+    after: Cursor
+
+    """
+    Returns the first _n_ elements from the list.
+    """
+    first: Int
+
+    """
+    Returns the elements in the list that come before the specified cursor.
+    """
+    before: Cursor
+
+    """
+    Returns the last _n_ elements from the list.
+    """
+    last: Int
+
+    """
+    Ordering options for RoomInvites returned from the connection.
+    """
+    orderBy: [RoomInviteOrder!]
+
+    """
+    Filtering options for RoomInvites returned from the connection.
+    """
+    where: RoomInviteWhereInput
+  ): RoomInviteConnection!
+}
+
+extend type Mutation {
+  createRoomInvite(input: CreateRoomInviteInput!): CreateRoomInvitePayload!
+  revokeRoomInvite(inviteID: ID!): RoomInvite!
+  joinRoomByInvite(token: String!): RoomMemberEdge!
+}
 `, BuiltIn: false},
 	{Name: "../schema/room_member.graphql", Input: `extend input RoomMemberWhereInput {
   """
@@ -4865,9 +8679,35 @@ extend type Query {
   ): RoomMemberConnection!
 }
 
+"""
+UpdateRoomMemberNotificationsInput is used for updating the notification settings of a room member.
+"""
+input UpdateRoomMemberNotificationsInput {
+  notificationLevel: RoomMemberNotificationLevel
+  muteUntil: Time
+  clearMuteUntil: Boolean
+}
+
 extend type Mutation {
   deleteRoomMember(roomMemberID: ID!): RoomMemberEdge
   markRoomMemeberAsSeen(roomMemberID: ID!): RoomMemberEdge
+  updateRoomMemberNotifications(roomMemberID: ID!, input: UpdateRoomMemberNotificationsInput!): RoomMemberEdge
+  """
+  Moves the member one role up, from read-only to member to admin.
+  """
+  promoteRoomMember(roomMemberID: ID!): RoomMemberEdge
+  """
+  Moves the member one role down, from admin to member to read-only.
+  """
+  demoteRoomMember(roomMemberID: ID!): RoomMemberEdge
+  """
+  Removes the member from the room, it can be added again.
+  """
+  kickRoomMember(roomMemberID: ID!): RoomMemberEdge
+  """
+  Removes the member from the room and keeps new messages from restoring it.
+  """
+  banRoomMember(roomMemberID: ID!): RoomMemberEdge
 }
 
 type LastMessageUpdatedEvent {
@@ -4917,6 +8757,14 @@ input UserLoginInput {
   password: String!
   deviceID: String!
   fcmToken: String!
+  """
+  Platform of the device, Android when omitted.
+  """
+  platform: DevicePlatform
+  """
+  PushKit token used to ring incoming calls on iOS.
+  """
+  voipToken: String
 }
 
 type LoginUser {
@@ -4924,13 +8772,91 @@ type LoginUser {
   token: String!
 }
 
+"""
+StorageUsage describes how much storage a user occupies.
+"""
+type StorageUsage {
+  used: Uint64!
+  quota: Uint64
+}
+
+"""
+UserDiscoverability sets who finds the user in the user search. Contacts of
+the user always do. Users are found by the contacts of their contacts until
+they opt in.
+"""
+enum UserDiscoverability @goModel(model: "journeyhub/ent/user.Discoverability") {
+  Everyone
+  ContactsOfContacts
+  Nobody
+}
+
+"""
+UserSearchResult is the public profile of a user found by the user search.
+"""
+type UserSearchResult {
+  id: ID!
+  nickname: String!
+  firstName: String!
+  lastName: String!
+  avatar: File
+}
+
+"""
+UserSettings holds the personal preferences of a user.
+"""
+type UserSettings {
+  locale: String!
+  messagePreviews: Boolean!
+  timezone: String!
+  quietHoursStart: String
+  quietHoursEnd: String
+  hideLastSeen: Boolean!
+  discoverability: UserDiscoverability!
+}
+
+"""
+UpdateUserSettingsInput is used for updating user settings.
+"""
+input UpdateUserSettingsInput {
+  locale: String @goTag(key: "validate", value: "omitempty,bcp47_language_tag")
+  messagePreviews: Boolean
+  timezone: String @goTag(key: "validate", value: "omitempty,timezone")
+  quietHoursStart: String @goTag(key: "validate", value: "required_with=QuietHoursEnd,omitempty,datetime=15:04")
+  quietHoursEnd: String @goTag(key: "validate", value: "required_with=QuietHoursStart,omitempty,datetime=15:04")
+  clearQuietHours: Boolean
+  hideLastSeen: Boolean
+  discoverability: UserDiscoverability
+}
+
+extend type User {
+  """
+  Storage usage of the user, available for the current user only.
+  """
+  storageUsage: StorageUsage
+  """
+  Settings of the user, available for the current user only.
+  """
+  settings: UserSettings
+}
+
 extend type Mutation {
   register(input: UserRegisterInput!): User
   login(input: UserLoginInput!): LoginUser
+  updateUserSettings(input: UpdateUserSettingsInput!): User
 }
 
 extend type Query {
   self: User
+
+  """
+  Searches the users by nickname and name, contacts and room peers of the
+  current user first.
+  """
+  searchUsers(
+    query: String!
+    first: Int
+  ): [UserSearchResult!]!
 }
 `, BuiltIn: false},
 	{Name: "../schema/user_contacts.graphql", Input: `extend input UserContactWhereInput {
@@ -4952,9 +8878,13 @@ extend type Query {
 }
 
 extend type Mutation {
-  generatePinCode: String
-  addUserContact(pincode: String!): UserContactEdge
+  """
+  Sends a contact request to the owner of the pin code.
+  """
+  addUserContact(pincode: String!): ContactRequestEdge!
   deleteUserContact(userContactID: ID!): UserContactEdge
+  blockUser(userID: ID!): User!
+  unblockUser(userID: ID!): User!
 }
 
 extend type Query {
@@ -4964,6 +8894,33 @@ extend type Query {
     """
     userContactID: ID!
   ): UserContactEdge!
+
+  blockedUsers(
+    """
+    Returns the elements in the list that come after the specified cursor.
+    """
+    after: Cursor
+
+    """
+    Returns the first _n_ elements from the list.
+    """
+    first: Int
+
+    """
+    Returns the elements in the list that come before the specified cursor.
+    """
+    before: Cursor
+
+    """
+    Returns the last _n_ elements from the list.
+    """
+    last: Int
+
+    """
+    Ordering options for Users returned from the connection.
+    """
+    orderBy: [UserOrder!]
+  ): UserConnection!
 }
 `, BuiltIn: false},
 }
@@ -10,8 +10,8 @@ import (
 	"journeyhub/ent/message"
 	"journeyhub/ent/room"
 	"journeyhub/ent/schema/pulid"
-	"journeyhub/graph/generated"
 	"journeyhub/graph/model"
+	"journeyhub/internal/modules/contacts"
 
 	"entgo.io/contrib/entgql"
 )
@@ -52,7 +52,7 @@ func (r *mutationResolver) DeleteMessage(ctx context.Context, messageID pulid.ID
 
 // MessagesByRoom is the resolver for the messagesByRoom field.
 func (r *queryResolver) MessagesByRoom(ctx context.Context, roomID pulid.ID, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.MessageOrder, where *ent.MessageWhereInput) (*ent.MessageConnection, error) {
-	_, err := r.authService.Auth(ctx)
+	currentUserID, err := r.authService.Auth(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -62,6 +62,7 @@ func (r *queryResolver) MessagesByRoom(ctx context.Context, roomID pulid.ID, aft
 			message.HasRoomWith(
 				room.ID(roomID),
 			),
+			message.Not(message.HasUserWith(contacts.BlockedBy(currentUserID))),
 		).
 		Paginate(
 			ctx,
@@ -88,8 +89,3 @@ func (r *subscriptionResolver) MessageUpdated(ctx context.Context, roomID pulid.
 func (r *subscriptionResolver) MessageDeleted(ctx context.Context, roomID pulid.ID) (<-chan pulid.ID, error) {
 	return r.chatService.Subscriptions().SubscribeToMessageDeletedEvent(ctx, roomID)
 }
-
-// Subscription returns generated.SubscriptionResolver implementation.
-func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
-
-type subscriptionResolver struct{ *Resolver }
@@ -6,20 +6,54 @@ import (
 	"fmt"
 	"io"
 	"journeyhub/ent"
+	"journeyhub/ent/call"
+	"journeyhub/ent/device"
 	"journeyhub/ent/messageattachment"
+	"journeyhub/ent/report"
 	"journeyhub/ent/room"
+	"journeyhub/ent/roommember"
 	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
 	"strconv"
 	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 )
 
-// CallParamsInput is used for call configuration.
-type CallParamsInput struct {
-	RoomID   pulid.ID `json:"roomID"`
-	CallType CallType `json:"callType"`
-	CallID   string   `json:"callID"`
+// AvatarCropInput selects the square of the image used as avatar, in pixels
+// of the uploaded image. The largest centered square is used without it.
+type AvatarCropInput struct {
+	X    int `json:"x" validate:"min=0"`
+	Y    int `json:"y" validate:"min=0"`
+	Size int `json:"size" validate:"min=1"`
+}
+
+// CallEvent signals a change of a call to its participants.
+type CallEvent struct {
+	CallNotificationType CallNotificationType `json:"callNotificationType"`
+	CallID               pulid.ID             `json:"callID"`
+	CallType             call.Type            `json:"callType"`
+	CallState            call.State           `json:"callState"`
+	RoomID               pulid.ID             `json:"roomID"`
+	UserID               pulid.ID             `json:"userID"`
+	UserFirstName        string               `json:"userFirstName"`
+	UserLastName         string               `json:"userLastName"`
+}
+
+// CreateContactPinInput is used for creating a contact pin. The pin expires
+// after the configured validity when expiresAt is omitted, and a pin with
+// maxUses of 1 is single use.
+type CreateContactPinInput struct {
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	MaxUses   *int       `json:"maxUses,omitempty" validate:"omitempty,min=1"`
+}
+
+// CreateContactPinPayload carries the code of the pin and its signed payload
+// for QR codes and deep links, which are only returned once.
+type CreateContactPinPayload struct {
+	Pin     *ent.ContactPin `json:"pin"`
+	Code    string          `json:"code"`
+	Payload string          `json:"payload"`
 }
 
 // CreateMessageLinkInput is used for create message link object.
@@ -32,8 +66,23 @@ type CreateMessageLinkInput struct {
 
 // CreateRoomInput is used for create Room object.
 type CreateRoomInput struct {
-	Name    string     `json:"name"`
-	UserIDs []pulid.ID `json:"userIDs,omitempty"`
+	Name        string     `json:"name" validate:"min=1,max=255"`
+	Description *string    `json:"description,omitempty" validate:"omitempty,max=1024"`
+	UserIDs     []pulid.ID `json:"userIDs,omitempty" validate:"max=255"`
+}
+
+// CreateRoomInviteInput is used for creating an invite link of a group room.
+type CreateRoomInviteInput struct {
+	RoomID    pulid.ID   `json:"roomID"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	MaxUses   *int       `json:"maxUses,omitempty" validate:"omitempty,min=1"`
+}
+
+// CreateRoomInvitePayload carries the token of the invite, which is only
+// returned once.
+type CreateRoomInvitePayload struct {
+	Invite *ent.RoomInvite `json:"invite"`
+	Token  string          `json:"token"`
 }
 
 type LastMessageUpdatedEvent struct {
@@ -48,6 +97,41 @@ type LoginUser struct {
 	Token string    `json:"token"`
 }
 
+// Presence tells whether a user is connected. The last seen time is hidden by
+// users who chose to.
+type Presence struct {
+	Online     bool       `json:"online"`
+	LastSeenAt *time.Time `json:"lastSeenAt,omitempty"`
+}
+
+// PresenceChange is sent when a contact or a room peer comes online or goes
+// offline.
+type PresenceChange struct {
+	UserID   pulid.ID  `json:"userID"`
+	Presence *Presence `json:"presence"`
+}
+
+// ReportInput is used for reporting a message, a user or a room.
+type ReportInput struct {
+	Reason report.Reason `json:"reason"`
+	Text   *string       `json:"text,omitempty" validate:"omitempty,max=2048"`
+}
+
+// ResolveReportInput is used for resolving a report.
+type ResolveReportInput struct {
+	Actions []ReportAction `json:"actions"`
+	Note    *string        `json:"note,omitempty" validate:"omitempty,max=2048"`
+}
+
+// RoomInvitePreview shows the room of an invite before joining it.
+type RoomInvitePreview struct {
+	RoomID       pulid.ID  `json:"roomID"`
+	Name         string    `json:"name"`
+	Description  *string   `json:"description,omitempty"`
+	MembersCount int       `json:"membersCount"`
+	Avatar       *ent.File `json:"avatar,omitempty"`
+}
+
 type RoomMemberUpdatedEvent struct {
 	ID          pulid.ID                 `json:"id"`
 	Name        string                   `json:"name"`
@@ -67,11 +151,30 @@ type SendMessageInput struct {
 	Files        []*UploadMessageFileInput `json:"files,omitempty" validate:"max=20"`
 	Voice        *UploadMessageVoiceInput  `json:"voice,omitempty"`
 	Links        []*CreateMessageLinkInput `json:"links,omitempty"`
+	Silent       *bool                     `json:"silent,omitempty"`
+}
+
+// StartCallInput is used for starting a call in a room.
+type StartCallInput struct {
+	RoomID   pulid.ID  `json:"roomID"`
+	CallType call.Type `json:"callType"`
+}
+
+// StorageUsage describes how much storage a user occupies.
+type StorageUsage struct {
+	Used  uint64  `json:"used"`
+	Quota *uint64 `json:"quota,omitempty"`
 }
 
 type Subscription struct {
 }
 
+// UpdateAvatarInput is used for uploading the avatar of a user or a room.
+type UpdateAvatarInput struct {
+	File graphql.Upload   `json:"file"`
+	Crop *AvatarCropInput `json:"crop,omitempty"`
+}
+
 // UpdateMessageInput is used for update Message object.
 type UpdateMessageInput struct {
 	Content      string                    `json:"content" validate:"omitempty,max=4096"`
@@ -80,10 +183,32 @@ type UpdateMessageInput struct {
 
 // UpdateRoomInput is used for update Room object.
 type UpdateRoomInput struct {
-	Name          *string    `json:"name,omitempty"`
-	AddUserIDs    []pulid.ID `json:"addUserIDs,omitempty"`
-	RemoveUserIDs []pulid.ID `json:"removeUserIDs,omitempty"`
-	ClearUsers    *bool      `json:"clearUsers,omitempty"`
+	Name             *string    `json:"name,omitempty" validate:"omitempty,min=1,max=255"`
+	Description      *string    `json:"description,omitempty" validate:"omitempty,max=1024"`
+	ClearDescription *bool      `json:"clearDescription,omitempty"`
+	AddUserIDs       []pulid.ID `json:"addUserIDs,omitempty" validate:"max=255"`
+	RemoveUserIDs    []pulid.ID `json:"removeUserIDs,omitempty"`
+	// Removes every member but the current user, before the added users are added.
+	ClearUsers *bool `json:"clearUsers,omitempty"`
+}
+
+// UpdateRoomMemberNotificationsInput is used for updating the notification settings of a room member.
+type UpdateRoomMemberNotificationsInput struct {
+	NotificationLevel *roommember.NotificationLevel `json:"notificationLevel,omitempty"`
+	MuteUntil         *time.Time                    `json:"muteUntil,omitempty"`
+	ClearMuteUntil    *bool                         `json:"clearMuteUntil,omitempty"`
+}
+
+// UpdateUserSettingsInput is used for updating user settings.
+type UpdateUserSettingsInput struct {
+	Locale          *string               `json:"locale,omitempty" validate:"omitempty,bcp47_language_tag"`
+	MessagePreviews *bool                 `json:"messagePreviews,omitempty"`
+	Timezone        *string               `json:"timezone,omitempty" validate:"omitempty,timezone"`
+	QuietHoursStart *string               `json:"quietHoursStart,omitempty" validate:"required_with=QuietHoursEnd,omitempty,datetime=15:04"`
+	QuietHoursEnd   *string               `json:"quietHoursEnd,omitempty" validate:"required_with=QuietHoursStart,omitempty,datetime=15:04"`
+	ClearQuietHours *bool                 `json:"clearQuietHours,omitempty"`
+	HideLastSeen    *bool                 `json:"hideLastSeen,omitempty"`
+	Discoverability *user.Discoverability `json:"discoverability,omitempty"`
 }
 
 // UploadMessageFile is used for upload message files.
@@ -104,6 +229,10 @@ type UserLoginInput struct {
 	Password string `json:"password"`
 	DeviceID string `json:"deviceID"`
 	FcmToken string `json:"fcmToken"`
+	// Platform of the device, Android when omitted.
+	Platform *device.Platform `json:"platform,omitempty"`
+	// PushKit token used to ring incoming calls on iOS.
+	VoipToken *string `json:"voipToken,omitempty"`
 }
 
 // UserRegisterInput is used for user register.
@@ -115,6 +244,26 @@ type UserRegisterInput struct {
 	PasswordConfirmation string `json:"passwordConfirmation" validate:"min=8,max=64"`
 }
 
+// UserSearchResult is the public profile of a user found by the user search.
+type UserSearchResult struct {
+	ID        pulid.ID  `json:"id"`
+	Nickname  string    `json:"nickname"`
+	FirstName string    `json:"firstName"`
+	LastName  string    `json:"lastName"`
+	Avatar    *ent.File `json:"avatar,omitempty"`
+}
+
+// UserSettings holds the personal preferences of a user.
+type UserSettings struct {
+	Locale          string               `json:"locale"`
+	MessagePreviews bool                 `json:"messagePreviews"`
+	Timezone        string               `json:"timezone"`
+	QuietHoursStart *string              `json:"quietHoursStart,omitempty"`
+	QuietHoursEnd   *string              `json:"quietHoursEnd,omitempty"`
+	HideLastSeen    bool                 `json:"hideLastSeen"`
+	Discoverability user.Discoverability `json:"discoverability"`
+}
+
 type CallNotificationType string
 
 const (
@@ -160,43 +309,49 @@ func (e CallNotificationType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-type CallType string
+// ReportAction is taken by a moderator when resolving a report.
+type ReportAction string
 
 const (
-	CallTypeAudio CallType = "AUDIO"
-	CallTypeVideo CallType = "VIDEO"
+	// Deletes the reported message or room.
+	ReportActionDeleteContent ReportAction = "DELETE_CONTENT"
+	// Suspends the account of the reported user.
+	ReportActionSuspendAccount ReportAction = "SUSPEND_ACCOUNT"
+	// Bans the reported user from the room of the report.
+	ReportActionBanFromRoom ReportAction = "BAN_FROM_ROOM"
 )
 
-var AllCallType = []CallType{
-	CallTypeAudio,
-	CallTypeVideo,
+var AllReportAction = []ReportAction{
+	ReportActionDeleteContent,
+	ReportActionSuspendAccount,
+	ReportActionBanFromRoom,
 }
 
-func (e CallType) IsValid() bool {
+func (e ReportAction) IsValid() bool {
 	switch e {
-	case CallTypeAudio, CallTypeVideo:
+	case ReportActionDeleteContent, ReportActionSuspendAccount, ReportActionBanFromRoom:
 		return true
 	}
 	return false
 }
 
-func (e CallType) String() string {
+func (e ReportAction) String() string {
 	return string(e)
 }
 
-func (e *CallType) UnmarshalGQL(v interface{}) error {
+func (e *ReportAction) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = CallType(str)
+	*e = ReportAction(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid CallType", str)
+		return fmt.Errorf("%s is not a valid ReportAction", str)
 	}
 	return nil
 }
 
-func (e CallType) MarshalGQL(w io.Writer) {
+func (e ReportAction) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
@@ -0,0 +1,19 @@
+package graph
+
+import (
+	"context"
+
+	"journeyhub/ent"
+)
+
+// authorizeNodes keeps the nodes of the moderation queue to moderators, as
+// the node queries resolve any ID.
+func (r *queryResolver) authorizeNodes(ctx context.Context, nodes ...ent.Noder) error {
+	for _, node := range nodes {
+		if _, ok := node.(*ent.Report); ok {
+			_, err := r.reportsService.AuthModerator(ctx)
+			return err
+		}
+	}
+	return nil
+}
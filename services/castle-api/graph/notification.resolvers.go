@@ -0,0 +1,26 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"journeyhub/ent"
+	"journeyhub/ent/schema/pulid"
+)
+
+// MarkNotificationsRead is the resolver for the markNotificationsRead field.
+func (r *mutationResolver) MarkNotificationsRead(ctx context.Context, notificationIDs []pulid.ID) (int, error) {
+	return r.notificationsService.MarkNotificationsRead(ctx, notificationIDs)
+}
+
+// UnreadNotificationsCount is the resolver for the unreadNotificationsCount field.
+func (r *queryResolver) UnreadNotificationsCount(ctx context.Context) (int, error) {
+	return r.notificationsService.UnreadNotificationsCount(ctx)
+}
+
+// NotificationCreated is the resolver for the notificationCreated field.
+func (r *subscriptionResolver) NotificationCreated(ctx context.Context) (<-chan *ent.NotificationEdge, error) {
+	return r.notificationsService.Subscriptions().SubscribeToNotificationCreatedEvent(ctx)
+}
@@ -0,0 +1,21 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"journeyhub/ent"
+	"journeyhub/graph/model"
+)
+
+// PresenceChanged is the resolver for the presenceChanged field.
+func (r *subscriptionResolver) PresenceChanged(ctx context.Context) (<-chan *model.PresenceChange, error) {
+	return r.presenceService.SubscribeToPresenceChanges(ctx)
+}
+
+// Presence is the resolver for the presence field.
+func (r *userResolver) Presence(ctx context.Context, obj *ent.User) (*model.Presence, error) {
+	return r.presenceService.Presence(ctx, obj)
+}
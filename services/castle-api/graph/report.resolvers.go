@@ -0,0 +1,78 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"journeyhub/ent"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/graph/model"
+
+	"entgo.io/contrib/entgql"
+)
+
+// ReportMessage is the resolver for the reportMessage field.
+func (r *mutationResolver) ReportMessage(ctx context.Context, messageID pulid.ID, input model.ReportInput) (*ent.Report, error) {
+	if validationErrors := r.validationService.ValidateGqlStruct(input); len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return r.reportsService.ReportMessage(ctx, messageID, input)
+}
+
+// ReportUser is the resolver for the reportUser field.
+func (r *mutationResolver) ReportUser(ctx context.Context, userID pulid.ID, input model.ReportInput) (*ent.Report, error) {
+	if validationErrors := r.validationService.ValidateGqlStruct(input); len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return r.reportsService.ReportUser(ctx, userID, input)
+}
+
+// ReportRoom is the resolver for the reportRoom field.
+func (r *mutationResolver) ReportRoom(ctx context.Context, roomID pulid.ID, input model.ReportInput) (*ent.Report, error) {
+	if validationErrors := r.validationService.ValidateGqlStruct(input); len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return r.reportsService.ReportRoom(ctx, roomID, input)
+}
+
+// AssignReport is the resolver for the assignReport field.
+func (r *mutationResolver) AssignReport(ctx context.Context, reportID pulid.ID, assigneeID *pulid.ID) (*ent.Report, error) {
+	return r.reportsService.AssignReport(ctx, reportID, assigneeID)
+}
+
+// ResolveReport is the resolver for the resolveReport field.
+func (r *mutationResolver) ResolveReport(ctx context.Context, reportID pulid.ID, input model.ResolveReportInput) (*ent.Report, error) {
+	if validationErrors := r.validationService.ValidateGqlStruct(input); len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return r.reportsService.ResolveReport(ctx, reportID, input)
+}
+
+// DismissReport is the resolver for the dismissReport field.
+func (r *mutationResolver) DismissReport(ctx context.Context, reportID pulid.ID, note *string) (*ent.Report, error) {
+	return r.reportsService.DismissReport(ctx, reportID, note)
+}
+
+// Reports is the resolver for the reports field.
+func (r *queryResolver) Reports(ctx context.Context, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.ReportOrder, where *ent.ReportWhereInput) (*ent.ReportConnection, error) {
+	if _, err := r.reportsService.AuthModerator(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.dbService.Client().Report.Query().
+		Paginate(
+			ctx,
+			after,
+			first,
+			before,
+			last,
+			ent.WithReportOrder(orderBy),
+			ent.WithReportFilter(where.Filter),
+		)
+}
@@ -7,11 +7,17 @@ package graph
 import (
 	"journeyhub/graph/generated"
 	"journeyhub/internal/modules/auth"
+	"journeyhub/internal/modules/avatars"
 	"journeyhub/internal/modules/calls"
 	"journeyhub/internal/modules/chat"
 	"journeyhub/internal/modules/contacts"
+	"journeyhub/internal/modules/media"
+	"journeyhub/internal/modules/notifications"
+	"journeyhub/internal/modules/presence"
+	"journeyhub/internal/modules/reports"
 	"journeyhub/internal/modules/roommembers"
 	"journeyhub/internal/modules/rooms"
+	"journeyhub/internal/modules/users"
 	"journeyhub/internal/platform/db"
 	"journeyhub/internal/platform/validation"
 
@@ -20,14 +26,20 @@ import (
 
 // Resolver is the resolver root.
 type Resolver struct {
-	dbService         db.Service
-	validationService validation.Service
-	authService       auth.Service
-	roomsService      rooms.Service
-	roomMemberService roommembers.Service
-	contactsService   contacts.Service
-	callsService      calls.Service
-	chatService       chat.Service
+	dbService            db.Service
+	validationService    validation.Service
+	authService          auth.Service
+	roomsService         rooms.Service
+	roomMemberService    roommembers.Service
+	contactsService      contacts.Service
+	callsService         calls.Service
+	chatService          chat.Service
+	mediaService         media.Service
+	notificationsService notifications.Service
+	usersService         users.Service
+	avatarsService       avatars.Service
+	presenceService      presence.Service
+	reportsService       reports.Service
 }
 
 // NewSchema creates a graphql executable schema.
@@ -40,6 +52,12 @@ func NewSchema(
 	contactsService contacts.Service,
 	callService calls.Service,
 	chatService chat.Service,
+	mediaService media.Service,
+	notificationsService notifications.Service,
+	usersService users.Service,
+	avatarsService avatars.Service,
+	presenceService presence.Service,
+	reportsService reports.Service,
 ) graphql.ExecutableSchema {
 	return generated.NewExecutableSchema(generated.Config{
 		Resolvers: &Resolver{
@@ -51,6 +69,12 @@ func NewSchema(
 			contactsService,
 			callService,
 			chatService,
+			mediaService,
+			notificationsService,
+			usersService,
+			avatarsService,
+			presenceService,
+			reportsService,
 		},
 	})
 }
@@ -6,7 +6,6 @@ package graph
 
 import (
 	"context"
-	"fmt"
 	"journeyhub/ent"
 	"journeyhub/ent/schema/pulid"
 	"journeyhub/graph/model"
@@ -14,12 +13,28 @@ import (
 
 // CreateRoom is the resolver for the createRoom field.
 func (r *mutationResolver) CreateRoom(ctx context.Context, input model.CreateRoomInput) (*ent.RoomEdge, error) {
-	panic(fmt.Errorf("not implemented: CreateRoom - createRoom"))
+	if validationErrors := r.validationService.ValidateGqlStruct(input); len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	room, err := r.roomsService.CreateRoom(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return room.ToEdge(ent.DefaultRoomOrder), nil
 }
 
 // UpdateRoom is the resolver for the updateRoom field.
 func (r *mutationResolver) UpdateRoom(ctx context.Context, roomID pulid.ID, input model.UpdateRoomInput) (*ent.RoomEdge, error) {
-	panic(fmt.Errorf("not implemented: UpdateRoom - updateRoom"))
+	if validationErrors := r.validationService.ValidateGqlStruct(input); len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	room, err := r.roomsService.UpdateRoom(ctx, roomID, input)
+	if err != nil {
+		return nil, err
+	}
+	return room.ToEdge(ent.DefaultRoomOrder), nil
 }
 
 // DeleteRoom is the resolver for the deleteRoom field.
@@ -0,0 +1,75 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"journeyhub/ent"
+	"journeyhub/ent/roominvite"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/graph/model"
+	"journeyhub/internal/modules/roommembers"
+
+	"entgo.io/contrib/entgql"
+)
+
+// CreateRoomInvite is the resolver for the createRoomInvite field.
+func (r *mutationResolver) CreateRoomInvite(ctx context.Context, input model.CreateRoomInviteInput) (*model.CreateRoomInvitePayload, error) {
+	if validationErrors := r.validationService.ValidateGqlStruct(input); len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	invite, token, err := r.roomsService.CreateRoomInvite(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.CreateRoomInvitePayload{
+		Invite: invite,
+		Token:  token,
+	}, nil
+}
+
+// RevokeRoomInvite is the resolver for the revokeRoomInvite field.
+func (r *mutationResolver) RevokeRoomInvite(ctx context.Context, inviteID pulid.ID) (*ent.RoomInvite, error) {
+	return r.roomsService.RevokeRoomInvite(ctx, inviteID)
+}
+
+// JoinRoomByInvite is the resolver for the joinRoomByInvite field.
+func (r *mutationResolver) JoinRoomByInvite(ctx context.Context, token string) (*ent.RoomMemberEdge, error) {
+	roomMember, err := r.roomsService.JoinRoomByInvite(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return roomMember.ToEdge(ent.DefaultRoomMemberOrder), nil
+}
+
+// PreviewRoomInvite is the resolver for the previewRoomInvite field.
+func (r *queryResolver) PreviewRoomInvite(ctx context.Context, token string) (*model.RoomInvitePreview, error) {
+	return r.roomsService.PreviewRoomInvite(ctx, token)
+}
+
+// RoomInvites is the resolver for the roomInvites field.
+func (r *queryResolver) RoomInvites(ctx context.Context, roomID pulid.ID, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.RoomInviteOrder, where *ent.RoomInviteWhereInput) (*ent.RoomInviteConnection, error) {
+	_, err := r.roomMemberService.Authorize(ctx, roomID, roommembers.PermissionAddMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.dbService.Client().RoomInvite.Query().
+		Where(
+			roominvite.RoomID(roomID),
+		).
+		Paginate(
+			ctx,
+			after,
+			first,
+			before,
+			last,
+			ent.WithRoomInviteOrder(orderBy),
+			ent.WithRoomInviteFilter(where.Filter),
+		)
+}
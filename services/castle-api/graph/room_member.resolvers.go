@@ -10,6 +10,7 @@ import (
 	"journeyhub/ent/room"
 	"journeyhub/ent/roommember"
 	"journeyhub/ent/schema/pulid"
+	"journeyhub/graph/model"
 
 	"entgo.io/contrib/entgql"
 )
@@ -33,6 +34,56 @@ func (r *mutationResolver) MarkRoomMemeberAsSeen(ctx context.Context, roomMember
 	return roomMember.ToEdge(ent.DefaultRoomMemberOrder), nil
 }
 
+// UpdateRoomMemberNotifications is the resolver for the updateRoomMemberNotifications field.
+func (r *mutationResolver) UpdateRoomMemberNotifications(ctx context.Context, roomMemberID pulid.ID, input model.UpdateRoomMemberNotificationsInput) (*ent.RoomMemberEdge, error) {
+	roomMember, err := r.roomMemberService.UpdateRoomMemberNotifications(ctx, roomMemberID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return roomMember.ToEdge(ent.DefaultRoomMemberOrder), nil
+}
+
+// PromoteRoomMember is the resolver for the promoteRoomMember field.
+func (r *mutationResolver) PromoteRoomMember(ctx context.Context, roomMemberID pulid.ID) (*ent.RoomMemberEdge, error) {
+	roomMember, err := r.roomMemberService.PromoteRoomMember(ctx, roomMemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	return roomMember.ToEdge(ent.DefaultRoomMemberOrder), nil
+}
+
+// DemoteRoomMember is the resolver for the demoteRoomMember field.
+func (r *mutationResolver) DemoteRoomMember(ctx context.Context, roomMemberID pulid.ID) (*ent.RoomMemberEdge, error) {
+	roomMember, err := r.roomMemberService.DemoteRoomMember(ctx, roomMemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	return roomMember.ToEdge(ent.DefaultRoomMemberOrder), nil
+}
+
+// KickRoomMember is the resolver for the kickRoomMember field.
+func (r *mutationResolver) KickRoomMember(ctx context.Context, roomMemberID pulid.ID) (*ent.RoomMemberEdge, error) {
+	roomMember, err := r.roomMemberService.KickRoomMember(ctx, roomMemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	return roomMember.ToEdge(ent.DefaultRoomMemberOrder), nil
+}
+
+// BanRoomMember is the resolver for the banRoomMember field.
+func (r *mutationResolver) BanRoomMember(ctx context.Context, roomMemberID pulid.ID) (*ent.RoomMemberEdge, error) {
+	roomMember, err := r.roomMemberService.BanRoomMember(ctx, roomMemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	return roomMember.ToEdge(ent.DefaultRoomMemberOrder), nil
+}
+
 // RoomMembersByRoom is the resolver for the roomMembersByRoom field.
 func (r *queryResolver) RoomMembersByRoom(ctx context.Context, roomID pulid.ID, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.RoomMemberOrder, where *ent.RoomMemberWhereInput) (*ent.RoomMemberConnection, error) {
 	_, err := r.authService.Auth(ctx)
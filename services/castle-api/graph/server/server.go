@@ -2,11 +2,14 @@ package server
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"time"
 
 	"journeyhub/ent"
+	"journeyhub/internal/modules/auth"
 	"journeyhub/internal/modules/auth/jwtauth"
+	"journeyhub/internal/modules/presence"
 
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
@@ -28,7 +31,7 @@ func webSocketInit(ctx context.Context, ja *jwtauth.JWTAuth, initPayload transpo
 	return ctxNew, &initPayload, err
 }
 
-func NewDefaultServer(es graphql.ExecutableSchema, logger log.Logger, ja *jwtauth.JWTAuth, entClient *ent.Client) *handler.Server {
+func NewDefaultServer(es graphql.ExecutableSchema, logger log.Logger, ja *jwtauth.JWTAuth, entClient *ent.Client, authService auth.Service, presenceService presence.Service) *handler.Server {
 	srv := handler.New(es)
 
 	srv.AddTransport(&transport.Websocket{
@@ -44,10 +47,27 @@ func NewDefaultServer(es graphql.ExecutableSchema, logger log.Logger, ja *jwtaut
 		) (context.Context, *transport.InitPayload, error) {
 			ctx, payload, err := webSocketInit(ctx, ja, initPayload)
 			level.Debug(logger).Log("method", "InitFunc", "auth", payload.Authorization())
-			return ctx, payload, err
+			if err != nil {
+				return ctx, payload, err
+			}
+
+			if err := authService.CheckSuspended(ctx); err != nil {
+				return ctx, payload, err
+			}
+
+			// The connection is served without presence when tracking fails.
+			connCtx, pErr := presenceService.Connect(ctx)
+			if pErr != nil {
+				level.Error(logger).Log("method", "InitFunc", "err", pErr)
+				return ctx, payload, nil
+			}
+			return connCtx, payload, nil
 		},
 		CloseFunc: func(ctx context.Context, closeCode int) {
 			level.Error(logger).Log("method", "CloseFunc", "code", closeCode)
+			if err := presenceService.Disconnect(ctx); err != nil && !errors.Is(err, presence.ErrNotConnected) {
+				level.Error(logger).Log("method", "CloseFunc", "err", err)
+			}
 		},
 		ErrorFunc: func(ctx context.Context, err error) {
 			level.Error(logger).Log("method", "ErrorFunc", "err", err)
@@ -28,6 +28,15 @@ func (r *mutationResolver) Login(ctx context.Context, input model.UserLoginInput
 	return r.authService.Login(ctx, input)
 }
 
+// UpdateUserSettings is the resolver for the updateUserSettings field.
+func (r *mutationResolver) UpdateUserSettings(ctx context.Context, input model.UpdateUserSettingsInput) (*ent.User, error) {
+	if validationErrors := r.validationService.ValidateGqlStruct(input); len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return r.usersService.UpdateUserSettings(ctx, input)
+}
+
 // Self is the resolver for the self field.
 func (r *queryResolver) Self(ctx context.Context) (*ent.User, error) {
 	user, err := r.authService.AuthUser(ctx)
@@ -37,3 +46,44 @@ func (r *queryResolver) Self(ctx context.Context) (*ent.User, error) {
 
 	return user, nil
 }
+
+// SearchUsers is the resolver for the searchUsers field.
+func (r *queryResolver) SearchUsers(ctx context.Context, query string, first *int) ([]*model.UserSearchResult, error) {
+	return r.usersService.SearchUsers(ctx, query, first)
+}
+
+// StorageUsage is the resolver for the storageUsage field.
+func (r *userResolver) StorageUsage(ctx context.Context, obj *ent.User) (*model.StorageUsage, error) {
+	currentUserID, err := r.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj.ID != currentUserID {
+		return nil, nil
+	}
+
+	return r.mediaService.StorageUsage(ctx, obj.ID)
+}
+
+// Settings is the resolver for the settings field.
+func (r *userResolver) Settings(ctx context.Context, obj *ent.User) (*model.UserSettings, error) {
+	currentUserID, err := r.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj.ID != currentUserID {
+		return nil, nil
+	}
+
+	return &model.UserSettings{
+		Locale:          obj.Locale,
+		MessagePreviews: obj.MessagePreviews,
+		Timezone:        obj.Timezone,
+		QuietHoursStart: obj.QuietHoursStart,
+		QuietHoursEnd:   obj.QuietHoursEnd,
+		HideLastSeen:    obj.HideLastSeen,
+		Discoverability: obj.Discoverability,
+	}, nil
+}
@@ -8,21 +8,19 @@ import (
 	"context"
 	"journeyhub/ent"
 	"journeyhub/ent/schema/pulid"
-)
+	"journeyhub/internal/modules/contacts"
 
-// GeneratePinCode is the resolver for the generatePinCode field.
-func (r *mutationResolver) GeneratePinCode(ctx context.Context) (*string, error) {
-	return r.contactsService.GenerateUserPinCode(ctx)
-}
+	"entgo.io/contrib/entgql"
+)
 
 // AddUserContact is the resolver for the addUserContact field.
-func (r *mutationResolver) AddUserContact(ctx context.Context, pincode string) (*ent.UserContactEdge, error) {
-	userContact, err := r.contactsService.AddUserContact(ctx, pincode)
+func (r *mutationResolver) AddUserContact(ctx context.Context, pincode string) (*ent.ContactRequestEdge, error) {
+	contactRequest, err := r.contactsService.AddUserContact(ctx, pincode)
 	if err != nil {
 		return nil, err
 	}
 
-	return userContact.ToEdge(ent.DefaultUserContactOrder), nil
+	return contactRequest.ToEdge(ent.DefaultContactRequestOrder), nil
 }
 
 // DeleteUserContact is the resolver for the deleteUserContact field.
@@ -35,6 +33,16 @@ func (r *mutationResolver) DeleteUserContact(ctx context.Context, userContactID
 	return userContact.ToEdge(ent.DefaultUserContactOrder), nil
 }
 
+// BlockUser is the resolver for the blockUser field.
+func (r *mutationResolver) BlockUser(ctx context.Context, userID pulid.ID) (*ent.User, error) {
+	return r.contactsService.BlockUser(ctx, userID)
+}
+
+// UnblockUser is the resolver for the unblockUser field.
+func (r *mutationResolver) UnblockUser(ctx context.Context, userID pulid.ID) (*ent.User, error) {
+	return r.contactsService.UnblockUser(ctx, userID)
+}
+
 // UserContact is the resolver for the userContact field.
 func (r *queryResolver) UserContact(ctx context.Context, userContactID pulid.ID) (*ent.UserContactEdge, error) {
 	_, err := r.authService.Auth(ctx)
@@ -49,3 +57,24 @@ func (r *queryResolver) UserContact(ctx context.Context, userContactID pulid.ID)
 
 	return room.ToEdge(ent.DefaultUserContactOrder), nil
 }
+
+// BlockedUsers is the resolver for the blockedUsers field.
+func (r *queryResolver) BlockedUsers(ctx context.Context, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.UserOrder) (*ent.UserConnection, error) {
+	currentUserID, err := r.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.dbService.Client().User.Query().
+		Where(
+			contacts.BlockedBy(currentUserID),
+		).
+		Paginate(
+			ctx,
+			after,
+			first,
+			before,
+			last,
+			ent.WithUserOrder(orderBy),
+		)
+}
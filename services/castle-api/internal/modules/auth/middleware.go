@@ -0,0 +1,25 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+)
+
+// RejectSuspended answers the requests of suspended users with 403.
+func RejectSuspended(s Service) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			err := s.CheckSuspended(r.Context())
+			if errors.Is(err, ErrUserSuspended) {
+				http.Error(w, err.Error(), http.StatusForbidden)
+				return
+			}
+			if err != nil {
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
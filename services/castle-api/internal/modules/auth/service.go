@@ -25,6 +25,7 @@ var (
 	ErrUserNotFound             = errors.New("user not found")
 	ErrGenerateToken            = errors.New("failed to generate token")
 	ErrCreateOrUpdateUserDevice = errors.New("failed to create or update user device")
+	ErrUserSuspended            = errors.New("user is suspended")
 )
 
 type Service interface {
@@ -42,6 +43,8 @@ type Service interface {
 
 	AuthUser(ctx context.Context) (*ent.User, error)
 
+	CheckSuspended(ctx context.Context) error
+
 	JWTAuthClient() *jwtauth.JWTAuth
 }
 
@@ -108,6 +111,10 @@ func (s *service) Login(ctx context.Context, input model.UserLoginInput) (*model
 		return nil, errors.Join(ErrInvalidCredentials, err)
 	}
 
+	if existingUser.SuspendedAt != nil {
+		return nil, ErrUserSuspended
+	}
+
 	claims := map[string]interface{}{
 		"sub": string(existingUser.ID),
 	}
@@ -137,6 +144,8 @@ func (s *service) Login(ctx context.Context, input model.UserLoginInput) (*model
 		SetUserID(existingUser.ID).
 		SetDeviceID(input.DeviceID).
 		SetFcmToken(input.FcmToken).
+		SetNillablePlatform(input.Platform).
+		SetNillableVoipToken(input.VoipToken).
 		Save(ctx)
 	if err != nil {
 		return nil, errors.Join(ErrCreateOrUpdateUserDevice, err)
@@ -175,9 +184,38 @@ func (s *service) AuthUser(ctx context.Context) (*ent.User, error) {
 		return nil, ErrUserNotFound
 	}
 
+	if user.SuspendedAt != nil {
+		return nil, ErrUserSuspended
+	}
+
 	return user, nil
 }
 
+// CheckSuspended reports ErrUserSuspended for the tokens of suspended users.
+// Requests without a valid token are left to the resolvers.
+func (s *service) CheckSuspended(ctx context.Context) error {
+	jwtToken, _, err := jwtauth.FromContext(ctx)
+	if err != nil || jwtToken == nil {
+		return nil
+	}
+
+	suspended, err := s.entClient.User.
+		Query().
+		Where(
+			user.ID(pulid.ID(jwtToken.Subject())),
+			user.SuspendedAtNotNil(),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if suspended {
+		return ErrUserSuspended
+	}
+
+	return nil
+}
+
 func (s *service) JWTAuthClient() *jwtauth.JWTAuth {
 	return s.jwtAuth
 }
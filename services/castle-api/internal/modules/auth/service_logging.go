@@ -80,6 +80,18 @@ func (s *serviceLogging) AuthUser(ctx context.Context) (user *ent.User, err erro
 	return s.Service.AuthUser(ctx)
 }
 
+func (s *serviceLogging) CheckSuspended(ctx context.Context) (err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "CheckSuspended",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+
+	return s.Service.CheckSuspended(ctx)
+}
+
 func (s *serviceLogging) JWTAuthClient() *jwtauth.JWTAuth {
 	defer func(begin time.Time) {
 		level.Debug(s.logger).Log(
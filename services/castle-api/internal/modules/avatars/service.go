@@ -0,0 +1,273 @@
+package avatars
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"journeyhub/ent"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/graph/model"
+	"journeyhub/internal/modules/auth"
+	"journeyhub/internal/modules/chat"
+	"journeyhub/internal/modules/media"
+	"journeyhub/internal/modules/roommembers"
+	"journeyhub/internal/modules/rooms"
+	"journeyhub/internal/platform/db"
+)
+
+type Service interface {
+	UpdateUserAvatar(
+		ctx context.Context,
+		input model.UpdateAvatarInput,
+	) (*ent.User, error)
+
+	DeleteUserAvatar(
+		ctx context.Context,
+	) (*ent.User, error)
+
+	UpdateRoomAvatar(
+		ctx context.Context,
+		roomID pulid.ID,
+		input model.UpdateAvatarInput,
+	) (*ent.Room, error)
+
+	DeleteRoomAvatar(
+		ctx context.Context,
+		roomID pulid.ID,
+	) (*ent.Room, error)
+}
+
+type service struct {
+	entClient          *ent.Client
+	authService        auth.Service
+	mediaService       media.Service
+	roomMembersService roommembers.Service
+	roomsService       rooms.Service
+	chatService        chat.Service
+}
+
+func NewService(
+	entClient *ent.Client,
+	authService auth.Service,
+	mediaService media.Service,
+	roomMembersService roommembers.Service,
+	roomsService rooms.Service,
+	chatService chat.Service,
+) Service {
+	return &service{
+		entClient:          entClient,
+		authService:        authService,
+		mediaService:       mediaService,
+		roomMembersService: roomMembersService,
+		roomsService:       roomsService,
+		chatService:        chatService,
+	}
+}
+
+func (s *service) UpdateUserAvatar(
+	ctx context.Context,
+	input model.UpdateAvatarInput,
+) (*ent.User, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := media.UploadOwner{UserID: currentUserID}
+	uploadInfo, err := s.mediaService.UploadAvatar(
+		ctx,
+		owner,
+		fmt.Sprintf("users/%s/avatars", currentUserID),
+		&input.File,
+		input.Crop,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var u *ent.User
+	err = db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+		avatar, err := s.createAvatar(ctx, owner, uploadInfo)
+		if err != nil {
+			return err
+		}
+
+		u, err = db.Client(ctx, s.entClient).User.
+			UpdateOneID(currentUserID).
+			SetAvatarID(avatar.FileID).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Join(err, s.mediaService.RemoveUploads(context.WithoutCancel(ctx), uploadInfo))
+	}
+
+	return u, nil
+}
+
+// DeleteUserAvatar unsets the avatar of the current user. Previous avatars
+// stay in the history.
+func (s *service) DeleteUserAvatar(
+	ctx context.Context,
+) (*ent.User, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.entClient.User.
+		UpdateOneID(currentUserID).
+		ClearAvatarID().
+		Save(ctx)
+}
+
+func (s *service) UpdateRoomAvatar(
+	ctx context.Context,
+	roomID pulid.ID,
+	input model.UpdateAvatarInput,
+) (*ent.Room, error) {
+	actor, err := s.roomMembersService.Authorize(ctx, roomID, roommembers.PermissionRenameRoom)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := media.UploadOwner{UserID: actor.UserID, RoomID: roomID}
+	uploadInfo, err := s.mediaService.UploadAvatar(
+		ctx,
+		owner,
+		fmt.Sprintf("rooms/%s/avatars", roomID),
+		&input.File,
+		input.Crop,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *ent.Room
+	err = db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+		avatar, err := s.createAvatar(ctx, owner, uploadInfo)
+		if err != nil {
+			return err
+		}
+
+		err = db.Client(ctx, s.entClient).Room.
+			UpdateOneID(roomID).
+			SetAvatarID(avatar.FileID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.chatService.SendRoomAvatarMessage(ctx, avatar, actor.UserID)
+		if err != nil {
+			return err
+		}
+
+		r, err = s.roomUpdated(ctx, roomID)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Join(err, s.mediaService.RemoveUploads(context.WithoutCancel(ctx), uploadInfo))
+	}
+
+	return r, nil
+}
+
+// DeleteRoomAvatar unsets the avatar of the room. Previous avatars stay in
+// the history.
+func (s *service) DeleteRoomAvatar(
+	ctx context.Context,
+	roomID pulid.ID,
+) (*ent.Room, error) {
+	_, err := s.roomMembersService.Authorize(ctx, roomID, roommembers.PermissionRenameRoom)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *ent.Room
+	err = db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+		err := db.Client(ctx, s.entClient).Room.
+			UpdateOneID(roomID).
+			ClearAvatarID().
+			AddVersion(1).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		r, err = s.roomUpdated(ctx, roomID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// createAvatar stores the uploaded files of an avatar, the first one being
+// the avatar and the others its thumbnails, and adds it to the history of
+// the owner.
+func (s *service) createAvatar(
+	ctx context.Context,
+	owner media.UploadOwner,
+	uploadInfo []*media.UploadInfo,
+) (*ent.Avatar, error) {
+	repository := db.Client(ctx, s.entClient)
+
+	files, err := repository.File.MapCreateBulk(
+		uploadInfo,
+		func(f *ent.FileCreate, i int) {
+			f.SetID(uploadInfo[i].ID).
+				SetName(uploadInfo[i].Filename).
+				SetContentType(uploadInfo[i].ContentType).
+				SetSize(uint64(uploadInfo[i].Size)).
+				SetLocation(uploadInfo[i].Location).
+				SetBucket(uploadInfo[i].Bucket).
+				SetPath(uploadInfo[i].Path).
+				SetWidth(uploadInfo[i].Width).
+				SetHeight(uploadInfo[i].Height).
+				SetUserID(owner.UserID)
+			if owner.RoomID != "" {
+				f.SetRoomID(owner.RoomID)
+			}
+			if i > 0 {
+				f.SetOriginalID(uploadInfo[0].ID)
+			}
+		},
+	).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.mediaService.EnforceQuota(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	avatar := repository.Avatar.
+		Create().
+		SetFile(files[0])
+	if owner.RoomID != "" {
+		avatar.SetRoomID(owner.RoomID)
+	} else {
+		avatar.SetUserID(owner.UserID)
+	}
+
+	return avatar.Save(ctx)
+}
+
+// roomUpdated returns the room and notifies its members once the
+// transaction commits.
+func (s *service) roomUpdated(
+	ctx context.Context,
+	roomID pulid.ID,
+) (*ent.Room, error) {
+	err := s.roomsService.PublishRoomUpdated(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.Client(ctx, s.entClient).Room.Get(ctx, roomID)
+}
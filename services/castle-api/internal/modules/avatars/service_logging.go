@@ -0,0 +1,84 @@
+package avatars
+
+import (
+	"context"
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/graph/model"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+type serviceLogging struct {
+	logger log.Logger
+	Service
+}
+
+func NewServiceLogging(logger log.Logger, s Service) Service {
+	return &serviceLogging{logger, s}
+}
+
+func (s *serviceLogging) UpdateUserAvatar(
+	ctx context.Context,
+	input model.UpdateAvatarInput,
+) (user *ent.User, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "UpdateUserAvatar",
+			"filename", input.File.Filename,
+			"size", input.File.Size,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.UpdateUserAvatar(ctx, input)
+}
+
+func (s *serviceLogging) DeleteUserAvatar(
+	ctx context.Context,
+) (user *ent.User, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "DeleteUserAvatar",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.DeleteUserAvatar(ctx)
+}
+
+func (s *serviceLogging) UpdateRoomAvatar(
+	ctx context.Context,
+	roomID pulid.ID,
+	input model.UpdateAvatarInput,
+) (room *ent.Room, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "UpdateRoomAvatar",
+			"roomID", roomID,
+			"filename", input.File.Filename,
+			"size", input.File.Size,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.UpdateRoomAvatar(ctx, roomID, input)
+}
+
+func (s *serviceLogging) DeleteRoomAvatar(
+	ctx context.Context,
+	roomID pulid.ID,
+) (room *ent.Room, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "DeleteRoomAvatar",
+			"roomID", roomID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.DeleteRoomAvatar(ctx, roomID)
+}
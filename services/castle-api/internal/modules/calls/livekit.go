@@ -0,0 +1,164 @@
+package calls
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/ent/call"
+	"journeyhub/ent/callparticipant"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/graph/model"
+	"journeyhub/internal/platform/db"
+	livekitwebhook "journeyhub/internal/platform/livekit"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+// HandleLivekitEvent applies a LiveKit webhook event to its call. LiveKit
+// rooms are named after the call, so late events of a previous call in the
+// same room are not applied to the next one, and participants identify with
+// their user ID.
+func (s *service) HandleLivekitEvent(
+	ctx context.Context,
+	event *livekit.WebhookEvent,
+) error {
+	if event.GetRoom() == nil {
+		return nil
+	}
+
+	err := db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+		c, err := db.Client(ctx, s.entClient).Call.
+			Get(ctx, pulid.ID(event.GetRoom().GetName()))
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if c.State != call.StateRinging && c.State != call.StateActive {
+			return nil
+		}
+
+		switch event.GetEvent() {
+		case livekitwebhook.EventParticipantJoined:
+			return s.livekitParticipantJoined(ctx, c, pulid.ID(event.GetParticipant().GetIdentity()))
+		case livekitwebhook.EventParticipantLeft:
+			return s.livekitParticipantLeft(ctx, c, pulid.ID(event.GetParticipant().GetIdentity()))
+		case livekitwebhook.EventRoomFinished:
+			return s.livekitRoomFinished(ctx, c)
+		default:
+			// room_started only confirms the call created by startCall.
+			return nil
+		}
+	})
+	// The event may race with the mutations of the participants, whatever
+	// happened first wins.
+	if errors.Is(err, ErrInvalidCallTransition) || errors.Is(err, ErrCallNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (s *service) livekitParticipantJoined(
+	ctx context.Context,
+	c *ent.Call,
+	userID pulid.ID,
+) error {
+	participant, err := s.joiningParticipant(ctx, c.ID, userID)
+	if err != nil {
+		return err
+	}
+	if participant.State == callparticipant.StateJoined || !canJoin(c.State, participant.State) {
+		return nil
+	}
+
+	if c.State == call.StateRinging {
+		c, err = s.transition(ctx, c, call.StateActive)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = participant.
+		Update().
+		SetState(callparticipant.StateJoined).
+		SetJoinedAt(time.Now()).
+		ClearLeftAt().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	sender, err := participant.QueryUser().Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.notifyParticipants(ctx, model.CallNotificationTypeAnswerCall, c, sender)
+}
+
+func (s *service) livekitParticipantLeft(
+	ctx context.Context,
+	c *ent.Call,
+	userID pulid.ID,
+) error {
+	participant, err := s.participant(ctx, c.ID, userID)
+	if err != nil {
+		return err
+	}
+	if participant.State != callparticipant.StateJoined || c.State != call.StateActive {
+		return nil
+	}
+
+	err = participant.
+		Update().
+		SetState(callparticipant.StateLeft).
+		SetLeftAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	joined, invited, err := s.countParticipants(ctx, c.ID)
+	if err != nil || !shouldEnd(joined, invited) {
+		return err
+	}
+
+	c, err = s.endCall(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	sender, err := participant.QueryUser().Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.notifyParticipants(ctx, model.CallNotificationTypeEndCall, c, sender)
+}
+
+// livekitRoomFinished closes the call once its LiveKit room is gone. A call
+// nobody answered is missed.
+func (s *service) livekitRoomFinished(
+	ctx context.Context,
+	c *ent.Call,
+) error {
+	caller, err := c.QueryCaller().Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	if c.State == call.StateRinging {
+		_, err = s.missCall(ctx, c, caller)
+		return err
+	}
+
+	c, err = s.endCall(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	return s.notifyParticipants(ctx, model.CallNotificationTypeEndCall, c, caller)
+}
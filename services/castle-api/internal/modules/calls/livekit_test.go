@@ -0,0 +1,309 @@
+package calls
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/ent/call"
+	"journeyhub/ent/callparticipant"
+	"journeyhub/ent/enttest"
+	"journeyhub/ent/notification"
+	"journeyhub/ent/room"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/internal/modules/chat"
+	"journeyhub/internal/modules/notifications"
+	"journeyhub/internal/platform/config"
+	livekitwebhook "journeyhub/internal/platform/livekit"
+
+	_ "journeyhub/ent/runtime"
+
+	"github.com/go-kit/log"
+	"github.com/livekit/protocol/auth"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// The LiveKit room and the participant of the fixture payloads.
+const (
+	fixtureCallID = pulid.ID("CA01J9ZC5QK2T3V8X7B6N4M1P0")
+	fixtureUserID = pulid.ID("UR01J9ZC5QK2T3V8X7B6N4M1P1")
+)
+
+var testLivekitConfig = config.LivekitConfig{
+	Access: "devkey",
+	Secret: "secret",
+}
+
+func TestHandleLivekitEvent(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    call.State
+		callee   callparticipant.State
+		fixtures []string
+
+		wantState    call.State
+		wantCallee   callparticipant.State
+		wantDuration bool
+		wantMessages int
+		wantMissed   bool
+	}{
+		{
+			name:       "callee joins",
+			state:      call.StateRinging,
+			callee:     callparticipant.StateInvited,
+			fixtures:   []string{"participant_joined.json"},
+			wantState:  call.StateActive,
+			wantCallee: callparticipant.StateJoined,
+		},
+		{
+			name:         "callee leaves",
+			state:        call.StateRinging,
+			callee:       callparticipant.StateInvited,
+			fixtures:     []string{"participant_joined.json", "participant_left.json"},
+			wantState:    call.StateEnded,
+			wantCallee:   callparticipant.StateLeft,
+			wantMessages: 1,
+		},
+		{
+			name:         "room finishes an active call",
+			state:        call.StateActive,
+			callee:       callparticipant.StateJoined,
+			fixtures:     []string{"room_finished.json"},
+			wantState:    call.StateEnded,
+			wantCallee:   callparticipant.StateLeft,
+			wantDuration: true,
+			wantMessages: 1,
+		},
+		{
+			name:         "room finishes an unanswered call",
+			state:        call.StateRinging,
+			callee:       callparticipant.StateInvited,
+			fixtures:     []string{"room_finished.json"},
+			wantState:    call.StateMissed,
+			wantCallee:   callparticipant.StateMissed,
+			wantMessages: 1,
+			wantMissed:   true,
+		},
+		{
+			name:       "late event of a finished call",
+			state:      call.StateEnded,
+			callee:     callparticipant.StateLeft,
+			fixtures:   []string{"participant_joined.json"},
+			wantState:  call.StateEnded,
+			wantCallee: callparticipant.StateLeft,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+			defer client.Close()
+
+			chatService := &fakeChat{}
+			notificationsService := &fakeNotifications{}
+			s := &service{
+				config:               testLivekitConfig,
+				entClient:            client,
+				subscriptions:        fakeSubscriptions{},
+				chatService:          chatService,
+				notificationsService: notificationsService,
+				logger:               log.NewNopLogger(),
+			}
+
+			seedCall(t, client, tt.state, tt.callee)
+
+			handler := livekitwebhook.NewWebhookHandler(testLivekitConfig, s.HandleLivekitEvent)
+			for _, fixture := range tt.fixtures {
+				w := httptest.NewRecorder()
+				handler.ServeHTTP(w, signedWebhook(t, fixture))
+				if w.Code != http.StatusOK {
+					t.Fatalf("%s: expected %d, got %d", fixture, http.StatusOK, w.Code)
+				}
+			}
+
+			c := client.Call.GetX(ctx, fixtureCallID)
+			if c.State != tt.wantState {
+				t.Errorf("call state = %s, want %s", c.State, tt.wantState)
+			}
+			if tt.wantDuration && c.Duration == 0 {
+				t.Error("expected the duration of the answered call")
+			}
+
+			callee := client.CallParticipant.
+				Query().
+				Where(callparticipant.UserID(fixtureUserID)).
+				OnlyX(ctx)
+			if callee.State != tt.wantCallee {
+				t.Errorf("callee state = %s, want %s", callee.State, tt.wantCallee)
+			}
+
+			if n := chatService.calls(); n != tt.wantMessages {
+				t.Errorf("call messages = %d, want %d", n, tt.wantMessages)
+			}
+			if missed := notificationsService.sent(notification.TypeMissedCall); missed != tt.wantMissed {
+				t.Errorf("missed call notification = %v, want %v", missed, tt.wantMissed)
+			}
+		})
+	}
+}
+
+// seedCall stores a call of the caller to the user of the fixtures in their
+// personal room.
+func seedCall(
+	t *testing.T,
+	client *ent.Client,
+	state call.State,
+	callee callparticipant.State,
+) {
+	t.Helper()
+	ctx := context.Background()
+
+	caller := client.User.
+		Create().
+		SetFirstName("Bob").
+		SetLastName("Jones").
+		SetNickname("bobjones").
+		SetPassword("password").
+		SaveX(ctx)
+	client.User.
+		Create().
+		SetID(fixtureUserID).
+		SetFirstName("Alice").
+		SetLastName("Smith").
+		SetNickname("alicesmith").
+		SetPassword("password").
+		ExecX(ctx)
+
+	r := client.Room.
+		Create().
+		SetType(room.TypePersonal).
+		SaveX(ctx)
+	for _, userID := range []pulid.ID{caller.ID, fixtureUserID} {
+		client.RoomMember.
+			Create().
+			SetRoomID(r.ID).
+			SetUserID(userID).
+			ExecX(ctx)
+	}
+
+	create := client.Call.
+		Create().
+		SetID(fixtureCallID).
+		SetType(call.TypeAudio).
+		SetState(state).
+		SetRoomID(r.ID).
+		SetCallerID(caller.ID)
+	if state != call.StateRinging {
+		create.SetAnsweredAt(time.Now().Add(-2 * time.Minute))
+	}
+	create.ExecX(ctx)
+
+	client.CallParticipant.
+		Create().
+		SetCallID(fixtureCallID).
+		SetUserID(caller.ID).
+		SetState(callparticipant.StateJoined).
+		ExecX(ctx)
+	client.CallParticipant.
+		Create().
+		SetCallID(fixtureCallID).
+		SetUserID(fixtureUserID).
+		SetState(callee).
+		ExecX(ctx)
+}
+
+// signedWebhook signs a fixture payload of the LiveKit webhooks the way
+// LiveKit does.
+func signedWebhook(t *testing.T, fixture string) *http.Request {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("..", "..", "platform", "livekit", "testdata", fixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sum := sha256.Sum256(data)
+	token, err := auth.NewAccessToken(testLivekitConfig.Access, testLivekitConfig.Secret).
+		SetValidFor(time.Minute).
+		SetSha256(base64.StdEncoding.EncodeToString(sum[:])).
+		ToJWT()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/livekit/webhook", bytes.NewReader(data))
+	r.Header.Set("Authorization", token)
+	r.Header.Set("Content-Type", "application/webhook+json")
+	return r
+}
+
+type fakeChat struct {
+	chat.Service
+
+	mu       sync.Mutex
+	messages int
+}
+
+func (s *fakeChat) SendCallMessage(ctx context.Context, c *ent.Call) (*ent.Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages++
+	return &ent.Message{}, nil
+}
+
+func (s *fakeChat) calls() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.messages
+}
+
+type fakeNotifications struct {
+	notifications.Service
+
+	mu    sync.Mutex
+	types []notification.Type
+}
+
+func (s *fakeNotifications) Send(ctx context.Context, input notifications.SendInput) ([]*ent.Notification, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.types = append(s.types, input.Type)
+	return nil, nil
+}
+
+func (s *fakeNotifications) sent(t notification.Type) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sent := range s.types {
+		if sent == t {
+			return true
+		}
+	}
+	return false
+}
+
+// fakeSubscriptions reaches no device, the push fallback is left to the
+// notifications.
+type fakeSubscriptions struct {
+	Subscriptions
+}
+
+func (fakeSubscriptions) PublishCallEvent(
+	ctx context.Context,
+	userID pulid.ID,
+	data map[string]string,
+) (<-chan string, error) {
+	ack := make(chan string)
+	close(ack)
+	return ack, nil
+}
@@ -7,237 +7,508 @@ import (
 	"time"
 
 	"journeyhub/ent"
+	"journeyhub/ent/call"
+	"journeyhub/ent/callparticipant"
+	"journeyhub/ent/notification"
+	"journeyhub/ent/room"
+	"journeyhub/ent/roommember"
+	"journeyhub/ent/schema/mixin"
 	"journeyhub/ent/schema/pulid"
-	"journeyhub/ent/usercontact"
+	"journeyhub/ent/user"
 	"journeyhub/graph/model"
 	"journeyhub/internal/modules/auth"
+	"journeyhub/internal/modules/chat"
+	"journeyhub/internal/modules/contacts"
 	"journeyhub/internal/modules/notifications"
+	"journeyhub/internal/modules/roommembers"
 	"journeyhub/internal/platform/config"
+	"journeyhub/internal/platform/db"
 
-	"github.com/appleboy/gorush/rpc/proto"
-
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
 	livekitauth "github.com/livekit/protocol/auth"
-	"google.golang.org/protobuf/types/known/structpb"
+	"github.com/livekit/protocol/livekit"
 )
 
-var ErrFcmTokenNotExists = errors.New("fcm token not exist")
-
 type Service interface {
 	StartCall(
 		ctx context.Context,
-		input model.CallParamsInput,
-	) (bool, error)
+		input model.StartCallInput,
+	) (*ent.Call, error)
 
 	EndCall(
 		ctx context.Context,
-		input model.CallParamsInput,
-	) (bool, error)
+		callID pulid.ID,
+	) (*ent.Call, error)
 
 	DeclineCall(
 		ctx context.Context,
-		input model.CallParamsInput,
-	) (bool, error)
+		callID pulid.ID,
+	) (*ent.Call, error)
 
 	AnswerCall(
 		ctx context.Context,
-		input model.CallParamsInput,
-	) (bool, error)
+		callID pulid.ID,
+	) (*ent.Call, error)
+
+	ActiveCall(
+		ctx context.Context,
+		roomID pulid.ID,
+	) (*ent.Call, error)
+
+	// HandleLivekitEvent updates the call state from a LiveKit webhook
+	// event.
+	HandleLivekitEvent(
+		ctx context.Context,
+		event *livekit.WebhookEvent,
+	) error
+
+	// ExpireCalls marks the calls left unanswered for the ring timeout as
+	// missed. It is run every calls interval.
+	ExpireCalls(ctx context.Context) error
 
 	GetCallJoinToken(
 		ctx context.Context,
 		roomID pulid.ID,
 	) (string, error)
+
+	Subscriptions() Subscriptions
 }
 
 type service struct {
 	config               config.LivekitConfig
+	callsConfig          config.CallsConfig
 	entClient            *ent.Client
+	subscriptions        Subscriptions
 	authService          auth.Service
+	roomMembersService   roommembers.Service
+	chatService          chat.Service
 	notificationsService notifications.Service
+	logger               log.Logger
 }
 
 func NewService(
 	config config.LivekitConfig,
+	callsConfig config.CallsConfig,
 	entClient *ent.Client,
+	subscriptions Subscriptions,
 	authService auth.Service,
+	roomMembersService roommembers.Service,
+	chatService chat.Service,
 	notificationsService notifications.Service,
+	logger log.Logger,
 ) Service {
 	return &service{
 		config:               config,
+		callsConfig:          callsConfig,
 		entClient:            entClient,
+		subscriptions:        subscriptions,
 		authService:          authService,
+		roomMembersService:   roomMembersService,
+		chatService:          chatService,
 		notificationsService: notificationsService,
+		logger:               logger,
 	}
 }
 
 func (s *service) StartCall(
 	ctx context.Context,
-	input model.CallParamsInput,
-) (bool, error) {
+	input model.StartCallInput,
+) (*ent.Call, error) {
 	currentUser, err := s.authService.AuthUser(ctx)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	userContact, err := s.getInversedUserContact(ctx, input.RoomID, currentUser.ID)
+	member, err := s.roomMembersService.Authorize(ctx, input.RoomID, roommembers.PermissionStartCalls)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	if userContact != nil &&
-		userContact.Edges.User != nil &&
-		userContact.Edges.User.Edges.Device != nil &&
-		userContact.Edges.User.Edges.Device.FcmToken != "" {
+	// Members who hid the room are rung too, banned ones are gone.
+	membersCtx := mixin.SkipSoftDelete(ctx)
 
-		_, err = s.notificationsService.Client().Send(ctx, &proto.NotificationRequest{
-			Platform:         2,
-			Tokens:           []string{userContact.Edges.User.Edges.Device.FcmToken},
-			Priority:         proto.NotificationRequest_HIGH,
-			ContentAvailable: true,
-			Title:            "Start",
-			Data: s.getCallData(
-				model.CallNotificationTypeStartCall,
-				input.CallType,
-				input.CallID,
-				userContact,
-			),
-		})
+	// Members who blocked the caller are not rung, a personal room can't be
+	// called at all.
+	if member.Edges.Room.Type == room.TypePersonal {
+		blocked, err := s.entClient.RoomMember.
+			Query().
+			Where(
+				roommember.RoomID(input.RoomID),
+				roommember.BannedAtIsNil(),
+				roommember.HasUserWith(contacts.Blocking(currentUser.ID)),
+			).
+			Exist(membersCtx)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
+		if blocked {
+			return nil, contacts.ErrUserBlocked
+		}
+	}
+
+	roomMembers, err := s.entClient.RoomMember.
+		Query().
+		Where(
+			roommember.RoomID(input.RoomID),
+			roommember.BannedAtIsNil(),
+			roommember.Not(roommember.HasUserWith(contacts.Blocking(currentUser.ID))),
+		).
+		All(membersCtx)
+	if err != nil {
+		return nil, err
+	}
 
-		return true, nil
+	var calleeIDs []pulid.ID
+	isMember := false
+	for _, roomMember := range roomMembers {
+		if roomMember.UserID == currentUser.ID {
+			isMember = true
+			continue
+		}
+		calleeIDs = append(calleeIDs, roomMember.UserID)
+	}
+	if !isMember {
+		return nil, ErrCallNotFound
 	}
 
-	return false, ErrFcmTokenNotExists
+	var c *ent.Call
+	err = db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+		repository := db.Client(ctx, s.entClient)
+
+		active, err := s.activeCall(ctx, input.RoomID)
+		if err != nil {
+			return err
+		}
+		if active != nil {
+			return newCallInProgressError(active.ID)
+		}
+
+		c, err = repository.Call.
+			Create().
+			SetType(input.CallType).
+			SetRoomID(input.RoomID).
+			SetCallerID(currentUser.ID).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			return newCallInProgressError("")
+		}
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		err = repository.CallParticipant.
+			MapCreateBulk(roomMembers, func(p *ent.CallParticipantCreate, i int) {
+				p.SetCallID(c.ID).SetUserID(roomMembers[i].UserID)
+				if roomMembers[i].UserID == currentUser.ID {
+					p.SetState(callparticipant.StateJoined).SetJoinedAt(now)
+				}
+			}).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		s.sendCallNotification(ctx, model.CallNotificationTypeStartCall, c, currentUser, calleeIDs)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
 }
 
-func (s *service) EndCall(
+func (s *service) AnswerCall(
 	ctx context.Context,
-	input model.CallParamsInput,
-) (bool, error) {
+	callID pulid.ID,
+) (*ent.Call, error) {
 	currentUser, err := s.authService.AuthUser(ctx)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	userContact, err := s.getInversedUserContact(ctx, input.RoomID, currentUser.ID)
-	if err != nil {
-		return false, err
-	}
+	var c *ent.Call
+	err = db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+		participant, err := s.joiningParticipant(ctx, callID, currentUser.ID)
+		if err != nil {
+			return err
+		}
+		c = participant.Edges.Call
+		if !canJoin(c.State, participant.State) {
+			return newInvalidCallTransitionError(c.State, call.StateActive)
+		}
 
-	if userContact != nil &&
-		userContact.Edges.User != nil &&
-		userContact.Edges.User.Edges.Device != nil &&
-		userContact.Edges.User.Edges.Device.FcmToken != "" {
+		if c.State == call.StateRinging {
+			c, err = s.transition(ctx, c, call.StateActive)
+			if err != nil {
+				return err
+			}
+		}
 
-		_, err = s.notificationsService.Client().Send(ctx, &proto.NotificationRequest{
-			Platform:         2,
-			Tokens:           []string{userContact.Edges.User.Edges.Device.FcmToken},
-			Priority:         proto.NotificationRequest_HIGH,
-			ContentAvailable: true,
-			Title:            "End",
-			Data: s.getCallData(
-				model.CallNotificationTypeEndCall,
-				input.CallType,
-				input.CallID,
-				userContact,
-			),
-		})
+		err = participant.
+			Update().
+			SetState(callparticipant.StateJoined).
+			SetJoinedAt(time.Now()).
+			ClearLeftAt().
+			Exec(ctx)
 		if err != nil {
-			return false, err
+			return err
 		}
 
-		return true, nil
+		return s.notifyParticipants(ctx, model.CallNotificationTypeAnswerCall, c, currentUser)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return false, ErrFcmTokenNotExists
+	return c, nil
 }
 
 func (s *service) DeclineCall(
 	ctx context.Context,
-	input model.CallParamsInput,
-) (bool, error) {
+	callID pulid.ID,
+) (*ent.Call, error) {
 	currentUser, err := s.authService.AuthUser(ctx)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	userContact, err := s.getInversedUserContact(ctx, input.RoomID, currentUser.ID)
+	var c *ent.Call
+	err = db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+		participant, err := s.participant(ctx, callID, currentUser.ID)
+		if err != nil {
+			return err
+		}
+		c = participant.Edges.Call
+		if participant.State != callparticipant.StateInvited {
+			return newInvalidCallTransitionError(c.State, call.StateDeclined)
+		}
+
+		err = participant.
+			Update().
+			SetState(callparticipant.StateDeclined).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		joined, invited, err := s.countParticipants(ctx, c.ID)
+		if err != nil {
+			return err
+		}
+
+		// A ringing call is declined once nobody is left to answer it, an
+		// ongoing one ends if the last callee declined.
+		notificationType := model.CallNotificationTypeDeclineCall
+		switch {
+		case c.State == call.StateRinging && invited == 0:
+			c, err = s.transition(ctx, c, call.StateDeclined)
+		case c.State == call.StateActive && shouldEnd(joined, invited):
+			c, err = s.endCall(ctx, c)
+			notificationType = model.CallNotificationTypeEndCall
+		}
+		if err != nil {
+			return err
+		}
+
+		return s.notifyParticipants(ctx, notificationType, c, currentUser)
+	})
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	if userContact != nil &&
-		userContact.Edges.User != nil &&
-		userContact.Edges.User.Edges.Device != nil &&
-		userContact.Edges.User.Edges.Device.FcmToken != "" {
+	return c, nil
+}
 
-		_, err = s.notificationsService.Client().Send(ctx, &proto.NotificationRequest{
-			Platform:         2,
-			Tokens:           []string{userContact.Edges.User.Edges.Device.FcmToken},
-			Priority:         proto.NotificationRequest_HIGH,
-			ContentAvailable: true,
-			Title:            "Decline",
-			Data: s.getCallData(
-				model.CallNotificationTypeDeclineCall,
-				input.CallType,
-				input.CallID,
-				userContact,
-			),
-		})
+// EndCall hangs up the call for the current user. The call itself ends
+// when the last participant leaves.
+func (s *service) EndCall(
+	ctx context.Context,
+	callID pulid.ID,
+) (*ent.Call, error) {
+	currentUser, err := s.authService.AuthUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var c *ent.Call
+	err = db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+		participant, err := s.participant(ctx, callID, currentUser.ID)
+		if err != nil {
+			return err
+		}
+		c = participant.Edges.Call
+
+		// Hanging up before anybody answered cancels the call, which is
+		// then missed by the callees.
+		if c.State == call.StateRinging && c.CallerID == currentUser.ID {
+			c, err = s.missCall(ctx, c, currentUser)
+			return err
+		}
+		if c.State != call.StateActive || participant.State != callparticipant.StateJoined {
+			return newInvalidCallTransitionError(c.State, call.StateEnded)
+		}
+
+		err = participant.
+			Update().
+			SetState(callparticipant.StateLeft).
+			SetLeftAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		joined, invited, err := s.countParticipants(ctx, c.ID)
+		if err != nil {
+			return err
+		}
+		if !shouldEnd(joined, invited) {
+			return nil
+		}
+
+		c, err = s.endCall(ctx, c)
 		if err != nil {
-			return false, err
+			return err
 		}
 
-		return true, nil
+		return s.notifyParticipants(ctx, model.CallNotificationTypeEndCall, c, currentUser)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return false, ErrFcmTokenNotExists
+	return c, nil
 }
 
-func (s *service) AnswerCall(
+func (s *service) ActiveCall(
 	ctx context.Context,
-	input model.CallParamsInput,
-) (bool, error) {
-	currentUser, err := s.authService.AuthUser(ctx)
+	roomID pulid.ID,
+) (*ent.Call, error) {
+	currentUserID, err := s.authService.Auth(ctx)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	userContact, err := s.getInversedUserContact(ctx, input.RoomID, currentUser.ID)
+	isMember, err := s.entClient.RoomMember.
+		Query().
+		Where(
+			roommember.RoomID(roomID),
+			roommember.UserID(currentUserID),
+			roommember.BannedAtIsNil(),
+		).
+		Exist(mixin.SkipSoftDelete(ctx))
 	if err != nil {
-		return false, err
+		return nil, err
+	}
+	if !isMember {
+		return nil, nil
 	}
 
-	if userContact != nil &&
-		userContact.Edges.User != nil &&
-		userContact.Edges.User.Edges.Device != nil &&
-		userContact.Edges.User.Edges.Device.FcmToken != "" {
+	return s.activeCall(ctx, roomID)
+}
+
+func (s *service) ExpireCalls(ctx context.Context) error {
+	cutoff := time.Now().Add(-s.callsConfig.RingTimeout)
+
+	unanswered, err := s.entClient.Call.
+		Query().
+		Where(
+			call.StateEQ(call.StateRinging),
+			call.StartedAtLT(cutoff),
+		).
+		WithCaller().
+		All(ctx)
+	if err != nil {
+		return err
+	}
 
-		_, err = s.notificationsService.Client().Send(ctx, &proto.NotificationRequest{
-			Platform:         2,
-			Tokens:           []string{userContact.Edges.User.Edges.Device.FcmToken},
-			Priority:         proto.NotificationRequest_HIGH,
-			ContentAvailable: true,
-			Title:            "Answer",
-			Data: s.getCallData(
-				model.CallNotificationTypeAnswerCall,
-				input.CallType,
-				input.CallID,
-				userContact,
-			),
+	// A call failing to expire does not hold back the others.
+	var errs []error
+	for _, c := range unanswered {
+		err = db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+			_, err := s.missCall(ctx, c, c.Edges.Caller)
+			return err
 		})
-		if err != nil {
-			return false, err
+		// Another request may have answered or ended the call meanwhile.
+		if err != nil && !errors.Is(err, ErrInvalidCallTransition) {
+			errs = append(errs, err)
 		}
+	}
+
+	// Members of an ongoing group call who never answered stop ringing.
+	unansweredParticipants, err := s.entClient.CallParticipant.
+		Query().
+		Where(
+			callparticipant.StateEQ(callparticipant.StateInvited),
+			callparticipant.InvitedAtLT(cutoff),
+			callparticipant.HasCallWith(call.StateEQ(call.StateActive)),
+		).
+		WithCall(func(q *ent.CallQuery) {
+			q.WithCaller()
+		}).
+		All(ctx)
+	if err != nil {
+		return errors.Join(append(errs, err)...)
+	}
+
+	for _, participant := range unansweredParticipants {
+		err = db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+			return s.missParticipant(ctx, participant)
+		})
+		if err != nil && !errors.Is(err, ErrInvalidCallTransition) {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// missParticipant marks a member who did not answer an ongoing call as
+// having missed it, ending the call if nobody else is left in it.
+func (s *service) missParticipant(
+	ctx context.Context,
+	participant *ent.CallParticipant,
+) error {
+	repository := db.Client(ctx, s.entClient)
+	c, caller := participant.Edges.Call, participant.Edges.Call.Edges.Caller
+
+	n, err := repository.CallParticipant.
+		Update().
+		Where(
+			callparticipant.ID(participant.ID),
+			callparticipant.StateEQ(callparticipant.StateInvited),
+		).
+		SetState(callparticipant.StateMissed).
+		Save(ctx)
+	if err != nil || n == 0 {
+		return err
+	}
 
-		return true, nil
+	userIDs := []pulid.ID{participant.UserID}
+	s.sendCallNotification(ctx, model.CallNotificationTypeEndCall, c, caller, userIDs)
+
+	err = s.sendMissedCallNotification(ctx, c, caller, userIDs)
+	if err != nil {
+		return err
+	}
+
+	joined, invited, err := s.countParticipants(ctx, c.ID)
+	if err != nil || !shouldEnd(joined, invited) {
+		return err
+	}
+
+	c, err = s.endCall(ctx, c)
+	if err != nil {
+		return err
 	}
 
-	return false, ErrFcmTokenNotExists
+	return s.notifyParticipants(ctx, model.CallNotificationTypeEndCall, c, caller)
 }
 
+// GetCallJoinToken grants access to the LiveKit room of the ongoing call
+// of the room, once the user has joined the call.
 func (s *service) GetCallJoinToken(
 	ctx context.Context,
 	roomID pulid.ID,
@@ -247,69 +518,519 @@ func (s *service) GetCallJoinToken(
 		return "", err
 	}
 
-	at := livekitauth.NewAccessToken(s.config.Access, s.config.Secret)
-	grant := &livekitauth.VideoGrant{
-		RoomJoin: true,
-		Room:     string(roomID),
+	member, err := s.entClient.RoomMember.
+		Query().
+		Where(
+			roommember.RoomID(roomID),
+			roommember.UserID(user.ID),
+			roommember.BannedAtIsNil(),
+		).
+		Only(mixin.SkipSoftDelete(ctx))
+	if ent.IsNotFound(err) {
+		return "", ErrCallNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	c, err := s.activeCall(ctx, roomID)
+	if err != nil {
+		return "", err
 	}
-	at.AddGrant(grant).
+	if c == nil {
+		return "", ErrCallNotFound
+	}
+
+	participant, err := s.participant(ctx, c.ID, user.ID)
+	if err != nil {
+		return "", err
+	}
+	if participant.State != callparticipant.StateJoined {
+		return "", ErrCallNotFound
+	}
+
+	metadata, err := newParticipantMetadata(user, member)
+	if err != nil {
+		return "", err
+	}
+
+	at := livekitauth.NewAccessToken(s.config.Access, s.config.Secret)
+	at.AddGrant(newVideoGrant(c, member.Role)).
 		SetIdentity(string(user.ID)).
 		SetName(fmt.Sprintf("%s %s", user.FirstName, user.LastName)).
-		SetValidFor(time.Hour)
+		SetMetadata(metadata).
+		SetValidFor(s.config.TokenTTL)
 
 	return at.ToJWT()
 }
 
-func (s *service) getInversedUserContact(
+func (s *service) activeCall(
 	ctx context.Context,
 	roomID pulid.ID,
-	currentUserID pulid.ID,
-) (*ent.UserContact, error) {
-	return s.entClient.UserContact.
+) (*ent.Call, error) {
+	c, err := db.Client(ctx, s.entClient).Call.
+		Query().
+		Where(
+			call.RoomID(roomID),
+			call.StateIn(call.StateRinging, call.StateActive),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return c, err
+}
+
+func (s *service) participant(
+	ctx context.Context,
+	callID pulid.ID,
+	userID pulid.ID,
+) (*ent.CallParticipant, error) {
+	participant, err := db.Client(ctx, s.entClient).CallParticipant.
 		Query().
-		WithUser(func(q *ent.UserQuery) {
-			q.WithDevice()
-		}).
-		WithContact().
 		Where(
-			usercontact.RoomID(roomID),
-			usercontact.ContactID(currentUserID),
+			callparticipant.CallID(callID),
+			callparticipant.UserID(userID),
 		).
+		WithCall().
 		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrCallNotFound
+	}
+	return participant, err
+}
+
+// joiningParticipant returns the participant of the user in the call. Room
+// members who were not invited, e.g. because they joined the room after the
+// call started, are added to the call.
+func (s *service) joiningParticipant(
+	ctx context.Context,
+	callID pulid.ID,
+	userID pulid.ID,
+) (*ent.CallParticipant, error) {
+	participant, err := s.participant(ctx, callID, userID)
+	if !errors.Is(err, ErrCallNotFound) {
+		return participant, err
+	}
+
+	repository := db.Client(ctx, s.entClient)
+
+	c, err := repository.Call.Get(ctx, callID)
+	if ent.IsNotFound(err) {
+		return nil, ErrCallNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	isMember, err := repository.RoomMember.
+		Query().
+		Where(
+			roommember.RoomID(c.RoomID),
+			roommember.UserID(userID),
+			roommember.BannedAtIsNil(),
+		).
+		Exist(mixin.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, ErrCallNotFound
+	}
+
+	participant, err = repository.CallParticipant.
+		Create().
+		SetCallID(callID).
+		SetUserID(userID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	participant.Edges.Call = c
+
+	return participant, nil
+}
+
+// countParticipants counts who is in the call and who may still answer it.
+func (s *service) countParticipants(
+	ctx context.Context,
+	callID pulid.ID,
+) (joined int, invited int, err error) {
+	repository := db.Client(ctx, s.entClient)
+
+	joined, err = repository.CallParticipant.
+		Query().
+		Where(
+			callparticipant.CallID(callID),
+			callparticipant.StateEQ(callparticipant.StateJoined),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	invited, err = repository.CallParticipant.
+		Query().
+		Where(
+			callparticipant.CallID(callID),
+			callparticipant.StateEQ(callparticipant.StateInvited),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return joined, invited, nil
+}
+
+// endCall ends an ongoing call and closes its participants.
+func (s *service) endCall(
+	ctx context.Context,
+	c *ent.Call,
+) (*ent.Call, error) {
+	c, err := s.transition(ctx, c, call.StateEnded)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.closeParticipants(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// transition moves the call to the given state. The update only applies
+// if the call is still in the state it was read in, so concurrent requests
+// cannot both move it.
+func (s *service) transition(
+	ctx context.Context,
+	c *ent.Call,
+	to call.State,
+) (*ent.Call, error) {
+	if !canTransition(c.State, to) {
+		return nil, newInvalidCallTransitionError(c.State, to)
+	}
+
+	repository := db.Client(ctx, s.entClient)
+
+	now := time.Now()
+	update := repository.Call.
+		Update().
+		Where(
+			call.ID(c.ID),
+			call.StateEQ(c.State),
+		).
+		SetState(to)
+	if to == call.StateActive {
+		update.SetAnsweredAt(now)
+	} else {
+		update.SetEndedAt(now)
+		if c.AnsweredAt != nil {
+			update.SetDuration(int(now.Sub(*c.AnsweredAt).Seconds()))
+		}
+	}
+
+	n, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, newInvalidCallTransitionError(c.State, to)
+	}
+
+	c, err = repository.Call.Get(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A finished call leaves a message in the room timeline.
+	if to != call.StateActive {
+		_, err = s.chatService.SendCallMessage(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// missCall marks a call nobody answered as missed, and leaves the callees
+// a missed call notification.
+func (s *service) missCall(
+	ctx context.Context,
+	c *ent.Call,
+	caller *ent.User,
+) (*ent.Call, error) {
+	repository := db.Client(ctx, s.entClient)
+
+	c, err := s.transition(ctx, c, call.StateMissed)
+	if err != nil {
+		return nil, err
+	}
+
+	calleeIDs, err := repository.CallParticipant.
+		Query().
+		Where(
+			callparticipant.CallID(c.ID),
+			callparticipant.StateEQ(callparticipant.StateInvited),
+		).
+		Select(callparticipant.FieldUserID).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.closeParticipants(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]pulid.ID, len(calleeIDs))
+	for i, id := range calleeIDs {
+		userIDs[i] = pulid.ID(id)
+	}
+
+	s.sendCallNotification(ctx, model.CallNotificationTypeEndCall, c, caller, userIDs)
+
+	err = s.sendMissedCallNotification(ctx, c, caller, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (s *service) sendMissedCallNotification(
+	ctx context.Context,
+	c *ent.Call,
+	caller *ent.User,
+	userIDs []pulid.ID,
+) error {
+	_, err := s.notificationsService.Send(ctx, notifications.SendInput{
+		Type:     notification.TypeMissedCall,
+		UserIDs:  userIDs,
+		Template: notifications.TemplateMissedCall,
+		Params: notifications.TemplateParams{
+			SenderName: fmt.Sprintf("%s %s", caller.FirstName, caller.LastName),
+			CallType:   c.Type.String(),
+		},
+		Data:        s.getCallData(model.CallNotificationTypeEndCall, c, caller),
+		CollapseKey: "missed_call:" + string(c.CallerID),
+	})
+	return err
+}
+
+// closeParticipants marks who joined a finished call as left, and who was
+// still invited as having missed it.
+func (s *service) closeParticipants(
+	ctx context.Context,
+	callID pulid.ID,
+) error {
+	repository := db.Client(ctx, s.entClient)
+
+	now := time.Now()
+	err := repository.CallParticipant.
+		Update().
+		Where(
+			callparticipant.CallID(callID),
+			callparticipant.StateEQ(callparticipant.StateJoined),
+		).
+		SetState(callparticipant.StateLeft).
+		SetLeftAt(now).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return repository.CallParticipant.
+		Update().
+		Where(
+			callparticipant.CallID(callID),
+			callparticipant.StateEQ(callparticipant.StateInvited),
+		).
+		SetState(callparticipant.StateMissed).
+		Exec(ctx)
+}
+
+// notifyParticipants signals a change of the call to the other participants
+// that have not declined it.
+func (s *service) notifyParticipants(
+	ctx context.Context,
+	notificationType model.CallNotificationType,
+	c *ent.Call,
+	sender *ent.User,
+) error {
+	userIDs, err := db.Client(ctx, s.entClient).CallParticipant.
+		Query().
+		Where(
+			callparticipant.CallID(c.ID),
+			callparticipant.UserIDNEQ(sender.ID),
+			callparticipant.StateNEQ(callparticipant.StateDeclined),
+		).
+		Select(callparticipant.FieldUserID).
+		Strings(ctx)
+	if err != nil {
+		return err
+	}
+
+	recipientIDs := make([]pulid.ID, len(userIDs))
+	for i, id := range userIDs {
+		recipientIDs[i] = pulid.ID(id)
+	}
+
+	s.sendCallNotification(ctx, notificationType, c, sender, recipientIDs)
+	return nil
+}
+
+// sendCallNotification signals the call event to the active subscriptions
+// of the users once the transaction is committed, so they never see a call
+// that is not stored yet. The push fallback is then sent to the devices
+// whose subscription did not receive it.
+func (s *service) sendCallNotification(
+	ctx context.Context,
+	notificationType model.CallNotificationType,
+	c *ent.Call,
+	sender *ent.User,
+	userIDs []pulid.ID,
+) {
+	if len(userIDs) == 0 {
+		return
+	}
+
+	data := s.getCallData(notificationType, c, sender)
+
+	db.AfterCommit(ctx, func() {
+		ctx := db.Detach(ctx)
+
+		// The events are published in order, only the wait for the acks
+		// runs in the background.
+		acks := make(map[pulid.ID]<-chan string, len(userIDs))
+		for _, userID := range userIDs {
+			ack, err := s.subscriptions.PublishCallEvent(ctx, userID, data)
+			if err == nil {
+				acks[userID] = ack
+			}
+		}
+
+		go func() {
+			err := s.pushCallNotification(ctx, notificationType, c, sender, data, acks)
+			if err != nil {
+				level.Error(s.logger).Log(
+					"method", "pushCallNotification",
+					"callID", c.ID,
+					"type", notificationType,
+					"err", err,
+				)
+			}
+		}()
+	})
+}
+
+// pushCallNotification pushes the call event to the users whose device did
+// not receive it through a subscription. Only the incoming call is kept in
+// the inbox, the following signaling pushes of the same call are transient
+// data messages.
+func (s *service) pushCallNotification(
+	ctx context.Context,
+	notificationType model.CallNotificationType,
+	c *ent.Call,
+	sender *ent.User,
+	data map[string]string,
+	acks map[pulid.ID]<-chan string,
+) error {
+	received := make(map[pulid.ID]map[string]bool, len(acks))
+	userIDs := make([]pulid.ID, 0, len(acks))
+	for userID, ack := range acks {
+		received[userID] = make(map[string]bool)
+		for deviceID := range ack {
+			received[userID][deviceID] = true
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	// The call may have been hung up while waiting for the acks.
+	if notificationType == model.CallNotificationTypeStartCall {
+		ringing, err := s.entClient.Call.
+			Query().
+			Where(
+				call.ID(c.ID),
+				call.StateEQ(call.StateRinging),
+			).
+			Exist(ctx)
+		if err != nil || !ringing {
+			return err
+		}
+	}
+
+	recipients, err := s.entClient.User.
+		Query().
+		Where(user.IDIn(userIDs...)).
+		WithDevice().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	var pushIDs, reachedIDs []pulid.ID
+	for _, recipient := range recipients {
+		d := recipient.Edges.Device
+		if d != nil && !received[recipient.ID][d.DeviceID] {
+			pushIDs = append(pushIDs, recipient.ID)
+		} else {
+			reachedIDs = append(reachedIDs, recipient.ID)
+		}
+	}
+
+	sendInput := notifications.SendInput{
+		Type:             notification.TypeCall,
+		UserIDs:          pushIDs,
+		Data:             data,
+		ContentAvailable: true,
+	}
+
+	if notificationType != model.CallNotificationTypeStartCall {
+		sendInput.Transient = true
+		_, err := s.notificationsService.Send(ctx, sendInput)
+		return err
+	}
+
+	sendInput.Template = notifications.TemplateIncomingCall
+	sendInput.Params = notifications.TemplateParams{
+		SenderName: fmt.Sprintf("%s %s", sender.FirstName, sender.LastName),
+		CallType:   c.Type.String(),
+	}
+	sendInput.VoIP = true
+
+	_, err = s.notificationsService.Send(ctx, sendInput)
+	if err != nil {
+		return err
+	}
+
+	sendInput.UserIDs = reachedIDs
+	sendInput.InboxOnly = true
+	_, err = s.notificationsService.Send(ctx, sendInput)
+	return err
 }
 
 func (s *service) getCallData(
 	notificationType model.CallNotificationType,
-	callType model.CallType,
-	callID string,
-	userContact *ent.UserContact,
-) *structpb.Struct {
-	return &structpb.Struct{
-		Fields: map[string]*structpb.Value{
-			"callNotificationType": {
-				Kind: &structpb.Value_StringValue{StringValue: notificationType.String()},
-			},
-			"callID": {
-				Kind: &structpb.Value_StringValue{StringValue: callID},
-			},
-			"callType": {
-				Kind: &structpb.Value_StringValue{StringValue: callType.String()},
-			},
-			"contactID": {
-				Kind: &structpb.Value_StringValue{StringValue: string(userContact.ID)},
-			},
-			"roomID": {
-				Kind: &structpb.Value_StringValue{StringValue: string(userContact.RoomID)},
-			},
-			"userID": {
-				Kind: &structpb.Value_StringValue{StringValue: string(userContact.Edges.Contact.ID)},
-			},
-			"userFirstName": {
-				Kind: &structpb.Value_StringValue{StringValue: string(userContact.Edges.Contact.FirstName)},
-			},
-			"userLastName": {
-				Kind: &structpb.Value_StringValue{StringValue: string(userContact.Edges.Contact.LastName)},
-			},
-		},
+	c *ent.Call,
+	sender *ent.User,
+) map[string]string {
+	return map[string]string{
+		"callNotificationType": notificationType.String(),
+		"callID":               string(c.ID),
+		"callType":             c.Type.String(),
+		"callState":            c.State.String(),
+		"roomID":               string(c.RoomID),
+		"userID":               string(sender.ID),
+		"userFirstName":        sender.FirstName,
+		"userLastName":         sender.LastName,
 	}
 }
+
+func (s *service) Subscriptions() Subscriptions {
+	return s.subscriptions
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"time"
 
+	"journeyhub/ent"
 	"journeyhub/ent/schema/pulid"
+	"journeyhub/graph/model"
 
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
+	"github.com/livekit/protocol/livekit"
 )
 
 type serviceLogging struct {
@@ -34,3 +37,109 @@ func (s *serviceLogging) GetCallJoinToken(
 	}(time.Now())
 	return s.Service.GetCallJoinToken(ctx, roomID)
 }
+
+func (s *serviceLogging) StartCall(
+	ctx context.Context,
+	input model.StartCallInput,
+) (c *ent.Call, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "StartCall",
+			"roomID", input.RoomID,
+			"callType", input.CallType,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.StartCall(ctx, input)
+}
+
+func (s *serviceLogging) AnswerCall(
+	ctx context.Context,
+	callID pulid.ID,
+) (c *ent.Call, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "AnswerCall",
+			"callID", callID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.AnswerCall(ctx, callID)
+}
+
+func (s *serviceLogging) DeclineCall(
+	ctx context.Context,
+	callID pulid.ID,
+) (c *ent.Call, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "DeclineCall",
+			"callID", callID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.DeclineCall(ctx, callID)
+}
+
+func (s *serviceLogging) EndCall(
+	ctx context.Context,
+	callID pulid.ID,
+) (c *ent.Call, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "EndCall",
+			"callID", callID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.EndCall(ctx, callID)
+}
+
+func (s *serviceLogging) ActiveCall(
+	ctx context.Context,
+	roomID pulid.ID,
+) (c *ent.Call, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "ActiveCall",
+			"roomID", roomID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.ActiveCall(ctx, roomID)
+}
+
+func (s *serviceLogging) ExpireCalls(
+	ctx context.Context,
+) (err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "ExpireCalls",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.ExpireCalls(ctx)
+}
+
+func (s *serviceLogging) HandleLivekitEvent(
+	ctx context.Context,
+	event *livekit.WebhookEvent,
+) (err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "HandleLivekitEvent",
+			"event", event.GetEvent(),
+			"room", event.GetRoom().GetName(),
+			"participant", event.GetParticipant().GetIdentity(),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.HandleLivekitEvent(ctx, event)
+}
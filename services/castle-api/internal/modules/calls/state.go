@@ -0,0 +1,76 @@
+package calls
+
+import (
+	"errors"
+	"fmt"
+
+	"journeyhub/ent/call"
+	"journeyhub/ent/callparticipant"
+	"journeyhub/ent/schema/pulid"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+var (
+	ErrCallNotFound          = errors.New("call not found")
+	ErrCallInProgress        = errors.New("call is already in progress")
+	ErrInvalidCallTransition = errors.New("invalid call state transition")
+)
+
+// callTransitions lists the states a call may move to from each state.
+// Ended, missed and declined calls are final.
+var callTransitions = map[call.State][]call.State{
+	call.StateRinging: {call.StateActive, call.StateMissed, call.StateDeclined},
+	call.StateActive:  {call.StateEnded},
+}
+
+func canTransition(from, to call.State) bool {
+	for _, state := range callTransitions[from] {
+		if state == to {
+			return true
+		}
+	}
+	return false
+}
+
+// canJoin reports whether a participant may join the call. Members who
+// declined or left may come back as long as the call is ongoing.
+func canJoin(c call.State, participant callparticipant.State) bool {
+	switch participant {
+	case callparticipant.StateInvited:
+		return c == call.StateRinging || c == call.StateActive
+	case callparticipant.StateDeclined, callparticipant.StateLeft, callparticipant.StateMissed:
+		return c == call.StateActive
+	default:
+		return false
+	}
+}
+
+// shouldEnd reports whether an ongoing call is over: nobody is in it
+// anymore, or a single participant is left waiting for nobody.
+func shouldEnd(joined, invited int) bool {
+	return joined == 0 || (joined == 1 && invited == 0)
+}
+
+func newInvalidCallTransitionError(from, to call.State) error {
+	return &gqlerror.Error{
+		Err:     ErrInvalidCallTransition,
+		Message: fmt.Sprintf("%s: %s to %s", ErrInvalidCallTransition, from, to),
+		Extensions: map[string]interface{}{
+			"code":  "INVALID_CALL_TRANSITION",
+			"state": from,
+			"to":    to,
+		},
+	}
+}
+
+func newCallInProgressError(callID pulid.ID) error {
+	return &gqlerror.Error{
+		Err:     ErrCallInProgress,
+		Message: ErrCallInProgress.Error(),
+		Extensions: map[string]interface{}{
+			"code":   "CALL_IN_PROGRESS",
+			"callID": callID,
+		},
+	}
+}
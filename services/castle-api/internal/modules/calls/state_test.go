@@ -0,0 +1,81 @@
+package calls
+
+import (
+	"errors"
+	"testing"
+
+	"journeyhub/ent/call"
+	"journeyhub/ent/callparticipant"
+)
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		from, to call.State
+		want     bool
+	}{
+		{call.StateRinging, call.StateActive, true},
+		{call.StateRinging, call.StateDeclined, true},
+		{call.StateRinging, call.StateMissed, true},
+		{call.StateRinging, call.StateEnded, false},
+		{call.StateActive, call.StateEnded, true},
+		{call.StateActive, call.StateRinging, false},
+		{call.StateEnded, call.StateActive, false},
+		{call.StateDeclined, call.StateActive, false},
+		{call.StateMissed, call.StateEnded, false},
+	}
+
+	for _, tt := range tests {
+		if got := canTransition(tt.from, tt.to); got != tt.want {
+			t.Errorf("canTransition(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestInvalidCallTransitionError(t *testing.T) {
+	err := newInvalidCallTransitionError(call.StateEnded, call.StateActive)
+	if !errors.Is(err, ErrInvalidCallTransition) {
+		t.Fatalf("expected %v, got %v", ErrInvalidCallTransition, err)
+	}
+}
+
+func TestCanJoin(t *testing.T) {
+	tests := []struct {
+		call        call.State
+		participant callparticipant.State
+		want        bool
+	}{
+		{call.StateRinging, callparticipant.StateInvited, true},
+		{call.StateActive, callparticipant.StateInvited, true},
+		{call.StateActive, callparticipant.StateDeclined, true},
+		{call.StateActive, callparticipant.StateLeft, true},
+		{call.StateActive, callparticipant.StateJoined, false},
+		{call.StateRinging, callparticipant.StateDeclined, false},
+		{call.StateEnded, callparticipant.StateInvited, false},
+		{call.StateEnded, callparticipant.StateLeft, false},
+	}
+
+	for _, tt := range tests {
+		if got := canJoin(tt.call, tt.participant); got != tt.want {
+			t.Errorf("canJoin(%s, %s) = %v, want %v", tt.call, tt.participant, got, tt.want)
+		}
+	}
+}
+
+func TestShouldEnd(t *testing.T) {
+	tests := []struct {
+		joined, invited int
+		want            bool
+	}{
+		{0, 0, true},
+		{0, 2, true},
+		{1, 0, true},
+		{1, 1, false},
+		{2, 0, false},
+	}
+
+	for _, tt := range tests {
+		if got := shouldEnd(tt.joined, tt.invited); got != tt.want {
+			t.Errorf("shouldEnd(%d, %d) = %v, want %v", tt.joined, tt.invited, got, tt.want)
+		}
+	}
+}
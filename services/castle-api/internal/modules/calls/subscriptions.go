@@ -0,0 +1,138 @@
+package calls
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/graph/model"
+	"journeyhub/internal/modules/auth"
+	"journeyhub/internal/platform/nats"
+
+	natsgo "github.com/nats-io/nats.go"
+)
+
+// callEventAckTimeout bounds the wait for the active subscriptions to take a
+// call event before the push fallback is used.
+const callEventAckTimeout = 500 * time.Millisecond
+
+type Subscriptions interface {
+	// PublishCallEvent signals the call event to the active subscriptions
+	// of the user. The returned channel yields the device of each
+	// subscription that received it, and is closed once the ack timeout
+	// elapses.
+	PublishCallEvent(
+		ctx context.Context,
+		userID pulid.ID,
+		data map[string]string,
+	) (<-chan string, error)
+
+	SubscribeToCallEvents(
+		ctx context.Context,
+		deviceID *string,
+	) (<-chan *model.CallEvent, error)
+}
+
+type subscriptions struct {
+	authService auth.Service
+	natsService nats.Service
+}
+
+func NewSubscriptions(
+	authService auth.Service,
+	natsService nats.Service,
+) Subscriptions {
+	return &subscriptions{
+		authService: authService,
+		natsService: natsService,
+	}
+}
+
+func (s *subscriptions) PublishCallEvent(
+	ctx context.Context,
+	userID pulid.ID,
+	data map[string]string,
+) (<-chan string, error) {
+	natsClient := s.natsService.Client()
+
+	subject := fmt.Sprintf("users.%s.calls", userID)
+
+	// Every subscription acks, so the replies are collected on an inbox
+	// rather than taking the first one.
+	inbox := natsgo.NewInbox()
+	sub, err := natsClient.Conn.SubscribeSync(inbox)
+	if err != nil {
+		return nil, err
+	}
+
+	err = natsClient.PublishRequest(subject, inbox, data)
+	if err != nil {
+		sub.Unsubscribe()
+		return nil, err
+	}
+
+	acks := make(chan string)
+	go func() {
+		defer close(acks)
+		defer sub.Unsubscribe()
+
+		deadline := time.Now().Add(callEventAckTimeout)
+		for {
+			msg, err := sub.NextMsg(time.Until(deadline))
+			if err != nil {
+				return
+			}
+
+			var deviceID string
+			if err := natsClient.Enc.Decode(msg.Subject, msg.Data, &deviceID); err != nil {
+				continue
+			}
+			acks <- deviceID
+		}
+	}()
+
+	return acks, nil
+}
+
+func (s *subscriptions) SubscribeToCallEvents(
+	ctx context.Context,
+	deviceID *string,
+) (<-chan *model.CallEvent, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subject := fmt.Sprintf("users.%s.calls", currentUserID)
+
+	natsClient := s.natsService.Client()
+
+	var device string
+	if deviceID != nil {
+		device = *deviceID
+	}
+
+	ch := make(chan *model.CallEvent, 1)
+
+	sub, err := natsClient.Subscribe(subject, func(subject, reply string, event *model.CallEvent) {
+		select {
+		case ch <- event:
+		case <-ctx.Done():
+			return
+		}
+		if reply != "" {
+			natsClient.Publish(reply, device)
+		}
+	})
+	if err != nil {
+		return ch, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		sub.Unsubscribe()
+	}()
+
+	return ch, nil
+}
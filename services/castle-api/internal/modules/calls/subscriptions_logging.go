@@ -0,0 +1,53 @@
+package calls
+
+import (
+	"context"
+	"time"
+
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/graph/model"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+type subscriptionsLogging struct {
+	logger log.Logger
+	Subscriptions
+}
+
+func NewSubscriptionsLogging(logger log.Logger, s Subscriptions) Subscriptions {
+	return &subscriptionsLogging{logger, s}
+}
+
+func (s *subscriptionsLogging) PublishCallEvent(
+	ctx context.Context,
+	userID pulid.ID,
+	data map[string]string,
+) (acks <-chan string, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "PublishCallEvent",
+			"userID", userID,
+			"callNotificationType", data["callNotificationType"],
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Subscriptions.PublishCallEvent(ctx, userID, data)
+}
+
+func (s *subscriptionsLogging) SubscribeToCallEvents(
+	ctx context.Context,
+	deviceID *string,
+) (ch <-chan *model.CallEvent, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "SubscribeToCallEvents",
+			"deviceID", deviceID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Subscriptions.SubscribeToCallEvents(ctx, deviceID)
+}
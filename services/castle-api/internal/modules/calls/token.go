@@ -0,0 +1,69 @@
+package calls
+
+import (
+	"encoding/json"
+
+	"journeyhub/ent"
+	"journeyhub/ent/call"
+	"journeyhub/ent/roommember"
+	"journeyhub/internal/modules/roommembers"
+
+	livekitauth "github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+)
+
+// participantMetadata is attached to the LiveKit participant so clients can
+// render the call without querying the API for each participant.
+type participantMetadata struct {
+	UserID    string `json:"userID"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Nickname  string `json:"nickname"`
+	AvatarID  string `json:"avatarID,omitempty"`
+	Role      string `json:"role"`
+}
+
+func newParticipantMetadata(user *ent.User, member *ent.RoomMember) (string, error) {
+	m := participantMetadata{
+		UserID:    string(user.ID),
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Nickname:  user.Nickname,
+		Role:      member.Role.String(),
+	}
+	if user.AvatarID != nil {
+		m.AvatarID = string(*user.AvatarID)
+	}
+
+	metadata, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	return string(metadata), nil
+}
+
+// newVideoGrant scopes the grant to the LiveKit room of the call, which is
+// named after the call so that each call gets its own. Audio calls can't
+// publish video, room admins moderate the call.
+func newVideoGrant(c *ent.Call, role roommember.Role) *livekitauth.VideoGrant {
+	grant := &livekitauth.VideoGrant{
+		RoomJoin:  true,
+		Room:      string(c.ID),
+		RoomAdmin: roommembers.IsAdmin(role),
+	}
+	grant.SetCanSubscribe(true)
+	grant.SetCanPublishData(true)
+	grant.SetCanUpdateOwnMetadata(false)
+
+	sources := []livekit.TrackSource{livekit.TrackSource_MICROPHONE}
+	if c.Type == call.TypeVideo {
+		sources = append(sources,
+			livekit.TrackSource_CAMERA,
+			livekit.TrackSource_SCREEN_SHARE,
+			livekit.TrackSource_SCREEN_SHARE_AUDIO,
+		)
+	}
+	grant.SetCanPublishSources(sources)
+
+	return grant
+}
@@ -0,0 +1,63 @@
+package calls
+
+import (
+	"encoding/json"
+	"testing"
+
+	"journeyhub/ent"
+	"journeyhub/ent/call"
+	"journeyhub/ent/roommember"
+	"journeyhub/ent/schema/pulid"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+func TestNewVideoGrant(t *testing.T) {
+	audio := newVideoGrant(&ent.Call{ID: "CA1", RoomID: "RO1", Type: call.TypeAudio}, roommember.RoleMember)
+	if audio.Room != "CA1" || !audio.RoomJoin || audio.RoomAdmin {
+		t.Fatalf("unexpected audio grant: %+v", audio)
+	}
+	if !audio.GetCanPublishSource(livekit.TrackSource_MICROPHONE) ||
+		audio.GetCanPublishSource(livekit.TrackSource_CAMERA) ||
+		audio.GetCanPublishSource(livekit.TrackSource_SCREEN_SHARE) {
+		t.Fatalf("unexpected audio sources: %v", audio.GetCanPublishSources())
+	}
+	if !audio.GetCanSubscribe() {
+		t.Fatal("expected audio grant to subscribe")
+	}
+
+	video := newVideoGrant(&ent.Call{ID: "CA2", RoomID: "RO1", Type: call.TypeVideo}, roommember.RoleAdmin)
+	if !video.RoomAdmin {
+		t.Fatal("expected admin grant for room admins")
+	}
+	if !video.GetCanPublishSource(livekit.TrackSource_MICROPHONE) ||
+		!video.GetCanPublishSource(livekit.TrackSource_CAMERA) {
+		t.Fatalf("unexpected video sources: %v", video.GetCanPublishSources())
+	}
+}
+
+func TestNewParticipantMetadata(t *testing.T) {
+	avatarID := pulid.ID("FI1")
+	user := &ent.User{ID: "UR1", FirstName: "Alice", LastName: "Smith", Nickname: "alice", AvatarID: &avatarID}
+
+	data, err := newParticipantMetadata(user, &ent.RoomMember{Role: roommember.RoleAdmin})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var metadata participantMetadata
+	if err := json.Unmarshal([]byte(data), &metadata); err != nil {
+		t.Fatal(err)
+	}
+	want := participantMetadata{
+		UserID:    "UR1",
+		FirstName: "Alice",
+		LastName:  "Smith",
+		Nickname:  "alice",
+		AvatarID:  "FI1",
+		Role:      "Admin",
+	}
+	if metadata != want {
+		t.Fatalf("unexpected metadata: %+v", metadata)
+	}
+}
@@ -2,20 +2,34 @@ package chat
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"journeyhub/ent"
+	"journeyhub/ent/file"
 	"journeyhub/ent/message"
+	"journeyhub/ent/messageattachment"
+	"journeyhub/ent/messagevoice"
 	"journeyhub/ent/room"
+	"journeyhub/ent/roommember"
+	"journeyhub/ent/schema/mixin"
 	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
 	"journeyhub/graph/model"
 	"journeyhub/internal/modules/auth"
+	"journeyhub/internal/modules/contacts"
 	"journeyhub/internal/modules/media"
 	"journeyhub/internal/modules/notifications"
 	"journeyhub/internal/modules/roommembers"
 	"journeyhub/internal/modules/rooms"
+	"journeyhub/internal/platform/db"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
 )
 
+var ErrMessageNotEditable = errors.New("only the author edits their text messages")
+
 type Service interface {
 	SendMessage(
 		ctx context.Context,
@@ -33,6 +47,22 @@ type Service interface {
 		messageID pulid.ID,
 	) (*ent.Message, error)
 
+	RemoveMessage(
+		ctx context.Context,
+		messageID pulid.ID,
+	) (*ent.Message, error)
+
+	SendCallMessage(
+		ctx context.Context,
+		c *ent.Call,
+	) (*ent.Message, error)
+
+	SendRoomAvatarMessage(
+		ctx context.Context,
+		avatar *ent.Avatar,
+		userID pulid.ID,
+	) (*ent.Message, error)
+
 	Subscriptions() Subscriptions
 }
 
@@ -44,6 +74,7 @@ type service struct {
 	roomMembersService  roommembers.Service
 	mediaService        media.Service
 	notificationService notifications.Service
+	logger              log.Logger
 }
 
 func NewService(
@@ -53,6 +84,7 @@ func NewService(
 	roomsService rooms.Service,
 	roomMembersService roommembers.Service,
 	mediaService media.Service,
+	logger log.Logger,
 ) Service {
 	return &service{
 		entClient:          entClient,
@@ -61,6 +93,7 @@ func NewService(
 		roomsService:       roomsService,
 		roomMembersService: roomMembersService,
 		mediaService:       mediaService,
+		logger:             logger,
 	}
 }
 
@@ -73,15 +106,181 @@ func (s *service) SendMessage(
 		return nil, err
 	}
 
+	member, err := s.roomMembersService.Authorize(ctx, input.RoomID, roommembers.PermissionSendMessages)
+	if err != nil {
+		return nil, err
+	}
+
+	if member.Edges.Room.Type == room.TypePersonal {
+		blocked, err := s.entClient.RoomMember.
+			Query().
+			Where(
+				roommember.RoomID(input.RoomID),
+				roommember.HasUserWith(contacts.Blocking(currentUserID)),
+			).
+			Exist(mixin.SkipSoftDelete(ctx))
+		if err != nil {
+			return nil, err
+		}
+		if blocked {
+			return nil, contacts.ErrUserBlocked
+		}
+	}
+
 	_, err = s.roomMembersService.RestoreRoomMembersByRoom(ctx, input.RoomID)
 	if err != nil {
 		return nil, err
 	}
 
-	repository := s.entClient
+	messageIDPrefix, err := ent.TableToPrefix(message.Table)
+	if err != nil {
+		return nil, err
+	}
+	messageID := pulid.MustNew(messageIDPrefix)
+
+	uploadOwner := media.UploadOwner{
+		UserID: currentUserID,
+		RoomID: input.RoomID,
+	}
+
+	// Uploads are inspected and stored before the message exists, so a
+	// rejected attachment never leaves a partially created message behind.
+	var uAtchInfo []*media.UploadInfo
+	if len(input.Files) > 0 {
+		uploadPrefix := fmt.Sprintf("rooms/%s/%s/attachments", input.RoomID, messageID)
+		uAtchInfo, err = s.mediaService.UploadMessageFiles(ctx, uploadOwner, uploadPrefix, input.Files)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var uVoiceInfo *media.UploadInfo
+	if input.Voice != nil {
+		uploadPrefix := fmt.Sprintf("rooms/%s/%s/voice", input.RoomID, messageID)
+		uVoiceInfo, err = s.mediaService.UploadFile(ctx, uploadOwner, media.FileKindVoice, uploadPrefix, &input.Voice.File)
+		if err != nil {
+			return nil, errors.Join(err, s.mediaService.RemoveUploads(context.WithoutCancel(ctx), uAtchInfo))
+		}
+	}
+
+	// The message, its side effects and the queued notifications are
+	// committed together.
+	var message *ent.Message
+	err = db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+		var err error
+		message, err = s.createMessage(ctx, messageID, currentUserID, input, uAtchInfo, uVoiceInfo)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.roomMembersService.IncrementUnreadMessagesCount(ctx, input.RoomID, message, input.Silent != nil && *input.Silent)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.roomsService.IncrementRoomVersion(ctx, input.RoomID, message)
+		return err
+	})
+	if err != nil {
+		// The stored objects belong to no file once the message is rolled
+		// back.
+		uploads := uAtchInfo
+		if uVoiceInfo != nil {
+			uploads = append(uploads, uVoiceInfo)
+		}
+		return nil, errors.Join(err, s.mediaService.RemoveUploads(context.WithoutCancel(ctx), uploads))
+	}
+
+	_, err = s.subscriptions.PublishMessageCreatedEvent(ctx, input.RoomID, message.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// SendCallMessage leaves a message about the finished call in its room on
+// behalf of the caller. The call is the payload of the message.
+func (s *service) SendCallMessage(
+	ctx context.Context,
+	c *ent.Call,
+) (*ent.Message, error) {
+	return s.sendSystemMessage(ctx, c.RoomID, func(m *ent.MessageCreate) {
+		m.SetType(message.TypeCall).
+			SetCallID(c.ID).
+			SetUserID(c.CallerID)
+	})
+}
+
+// SendRoomAvatarMessage leaves a message about the new avatar of the room
+// on behalf of the user who changed it.
+func (s *service) SendRoomAvatarMessage(
+	ctx context.Context,
+	avatar *ent.Avatar,
+	userID pulid.ID,
+) (*ent.Message, error) {
+	return s.sendSystemMessage(ctx, *avatar.RoomID, func(m *ent.MessageCreate) {
+		m.SetType(message.TypeRoomAvatar).
+			SetAvatarID(avatar.ID).
+			SetUserID(userID)
+	})
+}
+
+// sendSystemMessage creates a typed message with the side effects of a
+// sent message.
+func (s *service) sendSystemMessage(
+	ctx context.Context,
+	roomID pulid.ID,
+	build func(m *ent.MessageCreate),
+) (*ent.Message, error) {
+	var msg *ent.Message
+	err := db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+		create := db.Client(ctx, s.entClient).Message.
+			Create().
+			SetRoomID(roomID)
+		build(create)
+
+		var err error
+		msg, err = create.Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.roomMembersService.IncrementUnreadMessagesCount(ctx, roomID, msg, true)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.roomsService.IncrementRoomVersion(ctx, roomID, msg)
+		if err != nil {
+			return err
+		}
+
+		db.AfterCommit(ctx, func() {
+			s.subscriptions.PublishMessageCreatedEvent(ctx, roomID, msg.ID)
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+func (s *service) createMessage(
+	ctx context.Context,
+	messageID pulid.ID,
+	currentUserID pulid.ID,
+	input model.SendMessageInput,
+	uAtchInfo []*media.UploadInfo,
+	uVoiceInfo *media.UploadInfo,
+) (*ent.Message, error) {
+	repository := db.Client(ctx, s.entClient)
 
 	message, err := repository.Message.
 		Create().
+		SetID(messageID).
 		SetRoomID(input.RoomID).
 		SetUserID(currentUserID).
 		SetNillableReplyToID(input.ReplyTo).
@@ -106,13 +305,7 @@ func (s *service) SendMessage(
 		return nil, err
 	}
 
-	if input.Files != nil && len(input.Files) > 0 {
-		uploadPrefix := fmt.Sprintf("rooms/%s/%s/attachments", input.RoomID, message.ID)
-		uAtchInfo, uErr := s.mediaService.UploadMessageFiles(ctx, uploadPrefix, input.Files)
-		if uErr != nil {
-			return nil, uErr
-		}
-
+	if len(uAtchInfo) > 0 {
 		msgFiles, uErr := repository.File.MapCreateBulk(
 			uAtchInfo,
 			func(a *ent.FileCreate, i int) {
@@ -122,7 +315,9 @@ func (s *service) SendMessage(
 					SetSize(uint64(uAtchInfo[i].Size)).
 					SetLocation(uAtchInfo[i].Location).
 					SetBucket(uAtchInfo[i].Bucket).
-					SetPath(uAtchInfo[i].Path)
+					SetPath(uAtchInfo[i].Path).
+					SetUserID(currentUserID).
+					SetRoomID(input.RoomID)
 			},
 		).Save(ctx)
 		if uErr != nil {
@@ -144,13 +339,7 @@ func (s *service) SendMessage(
 		}
 	}
 
-	if input.Voice != nil {
-		uploadPrefix := fmt.Sprintf("rooms/%s/%s/voice", input.RoomID, message.ID)
-		uVoiceInfo, uErr := s.mediaService.UploadFile(ctx, uploadPrefix, &input.Voice.File)
-		if uErr != nil {
-			return nil, uErr
-		}
-
+	if uVoiceInfo != nil {
 		voiceFile, uErr := repository.File.
 			Create().
 			SetID(uVoiceInfo.ID).
@@ -160,6 +349,8 @@ func (s *service) SendMessage(
 			SetLocation(uVoiceInfo.Location).
 			SetBucket(uVoiceInfo.Bucket).
 			SetPath(uVoiceInfo.Path).
+			SetUserID(currentUserID).
+			SetRoomID(input.RoomID).
 			Save(ctx)
 		if uErr != nil {
 			return nil, uErr
@@ -177,19 +368,11 @@ func (s *service) SendMessage(
 		}
 	}
 
-	_, err = s.roomMembersService.IncrementUnreadMessagesCount(ctx, input.RoomID)
-	if err != nil {
-		return nil, err
-	}
-
-	_, err = s.roomsService.IncrementRoomVersion(ctx, input.RoomID, message)
-	if err != nil {
-		return nil, err
-	}
-
-	_, err = s.subscriptions.PublishMessageCreatedEvent(ctx, input.RoomID, message.ID)
-	if err != nil {
-		return nil, err
+	if len(uAtchInfo) > 0 || uVoiceInfo != nil {
+		err = s.mediaService.EnforceQuota(ctx, media.UploadOwner{UserID: currentUserID, RoomID: input.RoomID})
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return message, nil
@@ -200,7 +383,7 @@ func (s *service) UpdateMessage(
 	messageID pulid.ID,
 	input model.UpdateMessageInput,
 ) (*ent.Message, error) {
-	_, err := s.authService.Auth(ctx)
+	currentUserID, err := s.authService.Auth(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -215,6 +398,27 @@ func (s *service) UpdateMessage(
 		return nil, err
 	}
 
+	_, err = s.roomMembersService.Authorize(ctx, room.ID, roommembers.PermissionSendMessages)
+	if err != nil {
+		return nil, err
+	}
+
+	// System messages such as calls and avatar changes are never edited.
+	editable, err := repository.Message.
+		Query().
+		Where(
+			message.ID(messageID),
+			message.TypeEQ(message.TypeText),
+			message.HasUserWith(user.ID(currentUserID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !editable {
+		return nil, ErrMessageNotEditable
+	}
+
 	message, err := repository.Message.
 		UpdateOneID(messageID).
 		SetContent(input.Content).
@@ -240,7 +444,7 @@ func (s *service) DeleteMessage(
 	ctx context.Context,
 	messageID pulid.ID,
 ) (*ent.Message, error) {
-	_, err := s.authService.Auth(ctx)
+	currentUserID, err := s.authService.Auth(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -255,26 +459,138 @@ func (s *service) DeleteMessage(
 		return nil, err
 	}
 
-	message, err := repository.Message.Get(ctx, messageID)
+	// Everyone deletes their own messages, the messages of others take
+	// moderation rights.
+	own, err := repository.Message.
+		Query().
+		Where(
+			message.ID(messageID),
+			message.HasUserWith(user.ID(currentUserID)),
+		).
+		Exist(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	err = repository.Message.DeleteOneID(messageID).Exec(ctx)
+	permissions := []roommembers.Permission{}
+	if !own {
+		permissions = append(permissions, roommembers.PermissionDeleteMessages)
+	}
+	_, err = s.roomMembersService.Authorize(ctx, room.ID, permissions...)
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = s.roomsService.IncrementRoomVersion(ctx, room.ID, nil)
+	var msg *ent.Message
+	err = db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+		var err error
+		msg, err = s.removeMessage(ctx, room.ID, messageID)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = s.subscriptions.PublishMessageDeletedEvent(ctx, room.ID, message.ID)
+	return msg, nil
+}
+
+// RemoveMessage deletes the message without authorizing the current user,
+// for moderators acting on reports.
+func (s *service) RemoveMessage(
+	ctx context.Context,
+	messageID pulid.ID,
+) (*ent.Message, error) {
+	var msg *ent.Message
+	err := db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+		r, err := db.Client(ctx, s.entClient).Room.
+			Query().
+			Where(room.HasMessagesWith(message.ID(messageID))).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		msg, err = s.removeMessage(ctx, r.ID, messageID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// removeMessage deletes the message of the room with its files.
+func (s *service) removeMessage(
+	ctx context.Context,
+	roomID pulid.ID,
+	messageID pulid.ID,
+) (*ent.Message, error) {
+	repository := db.Client(ctx, s.entClient)
+
+	files, err := repository.File.
+		Query().
+		Where(
+			file.Or(
+				file.HasMessageAttachmentWith(
+					messageattachment.HasMessageWith(message.ID(messageID)),
+				),
+				file.HasMessageVoiceWith(
+					messagevoice.HasMessageWith(message.ID(messageID)),
+				),
+			),
+		).
+		All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	if len(files) > 0 {
+		ids := make([]pulid.ID, len(files))
+		for i, f := range files {
+			ids[i] = f.ID
+		}
+
+		_, err = repository.File.
+			Delete().
+			Where(file.IDIn(ids...)).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	message, err := repository.Message.Get(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = repository.Message.DeleteOneID(messageID).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.roomsService.IncrementRoomVersion(ctx, roomID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	db.AfterCommit(ctx, func() {
+		s.subscriptions.PublishMessageDeletedEvent(ctx, roomID, message.ID)
+		if len(files) == 0 {
+			return
+		}
+		// The rows are gone, objects failing to delete are only left
+		// behind in the bucket.
+		if err := s.mediaService.RemoveFiles(db.Detach(ctx), files); err != nil {
+			level.Error(s.logger).Log(
+				"method", "removeMessage",
+				"messageID", messageID,
+				"err", err,
+			)
+		}
+	})
+
 	return message, nil
 }
 
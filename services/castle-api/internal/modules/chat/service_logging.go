@@ -73,3 +73,53 @@ func (s *serviceLogging) DeleteMessage(
 	}(time.Now())
 	return s.Service.DeleteMessage(ctx, messageID)
 }
+
+func (s *serviceLogging) RemoveMessage(
+	ctx context.Context,
+	messageID pulid.ID,
+) (msg *ent.Message, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "RemoveMessage",
+			"messageID", messageID,
+			"message", msg,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.RemoveMessage(ctx, messageID)
+}
+
+func (s *serviceLogging) SendCallMessage(
+	ctx context.Context,
+	c *ent.Call,
+) (msg *ent.Message, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "SendCallMessage",
+			"callID", c.ID,
+			"message", msg,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.SendCallMessage(ctx, c)
+}
+
+func (s *serviceLogging) SendRoomAvatarMessage(
+	ctx context.Context,
+	avatar *ent.Avatar,
+	userID pulid.ID,
+) (msg *ent.Message, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "SendRoomAvatarMessage",
+			"avatarID", avatar.ID,
+			"userID", userID,
+			"message", msg,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.SendRoomAvatarMessage(ctx, avatar, userID)
+}
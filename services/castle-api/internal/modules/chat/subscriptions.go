@@ -5,7 +5,10 @@ import (
 	"fmt"
 
 	"journeyhub/ent"
+	"journeyhub/ent/message"
 	"journeyhub/ent/schema/pulid"
+	"journeyhub/internal/modules/auth"
+	"journeyhub/internal/modules/contacts"
 	"journeyhub/internal/platform/nats"
 )
 
@@ -46,15 +49,18 @@ type Subscriptions interface {
 
 type subscriptions struct {
 	entClient   *ent.Client
+	authService auth.Service
 	natsService nats.Service
 }
 
 func NewSubscriptions(
 	entClient *ent.Client,
+	authService auth.Service,
 	natsService nats.Service,
 ) Subscriptions {
 	return &subscriptions{
 		entClient:   entClient,
+		authService: authService,
 		natsService: natsService,
 	}
 }
@@ -147,20 +153,33 @@ func (s *subscriptions) SubscribeToMessageDeletedEvent(
 	return ch, nil
 }
 
+// subscribe sends the messages of the subject, except the ones of users
+// blocked by the current user.
 func (s *subscriptions) subscribe(
 	ctx context.Context,
 	subject string,
 ) (<-chan *ent.MessageEdge, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	natsClient := s.natsService.Client()
 
 	ch := make(chan *ent.MessageEdge, 1)
 
 	sub, err := natsClient.Subscribe(subject, func(messageID pulid.ID) {
-		message, err := s.entClient.Message.Get(ctx, messageID)
+		msg, err := s.entClient.Message.
+			Query().
+			Where(
+				message.ID(messageID),
+				message.Not(message.HasUserWith(contacts.BlockedBy(currentUserID))),
+			).
+			Only(ctx)
 		if err != nil {
 			return
 		}
-		ch <- message.ToEdge(ent.DefaultMessageOrder)
+		ch <- msg.ToEdge(ent.DefaultMessageOrder)
 	})
 	if err != nil {
 		return ch, err
@@ -0,0 +1,105 @@
+package contacts
+
+import (
+	"context"
+	"errors"
+
+	"journeyhub/ent"
+	"journeyhub/ent/contactrequest"
+	"journeyhub/ent/predicate"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"journeyhub/ent/userblock"
+)
+
+var (
+	ErrUserBlocked     = errors.New("user is blocked")
+	ErrCannotBlockSelf = errors.New("users cannot block themselves")
+)
+
+// BlockedBy matches the users blocked by the user.
+func BlockedBy(userID pulid.ID) predicate.User {
+	return user.HasBlockedByWith(userblock.UserID(userID))
+}
+
+// Blocking matches the users who blocked the user.
+func Blocking(userID pulid.ID) predicate.User {
+	return user.HasBlocksWith(userblock.BlockedUserID(userID))
+}
+
+func (s *service) BlockUser(
+	ctx context.Context,
+	userID pulid.ID,
+) (*ent.User, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if userID == currentUserID {
+		return nil, ErrCannotBlockSelf
+	}
+
+	blockedUser, err := s.entClient.User.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.entClient.UserBlock.
+		Create().
+		SetUserID(currentUserID).
+		SetBlockedUserID(userID).
+		OnConflictColumns(
+			userblock.FieldUserID,
+			userblock.FieldBlockedUserID,
+		).
+		Ignore().
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pending requests of the blocked user are declined.
+	err = s.entClient.ContactRequest.
+		Update().
+		Where(
+			contactrequest.SenderID(userID),
+			contactrequest.ReceiverID(currentUserID),
+			contactrequest.StatusEQ(contactrequest.StatusPending),
+		).
+		SetStatus(contactrequest.StatusDeclined).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return blockedUser, nil
+}
+
+func (s *service) UnblockUser(
+	ctx context.Context,
+	userID pulid.ID,
+) (*ent.User, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockedUser, err := s.entClient.User.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.entClient.UserBlock.
+		Delete().
+		Where(
+			userblock.UserID(currentUserID),
+			userblock.BlockedUserID(userID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return blockedUser, nil
+}
@@ -0,0 +1,296 @@
+package contacts
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/ent/contactpin"
+	"journeyhub/ent/predicate"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"journeyhub/graph/model"
+	"journeyhub/internal/platform/db"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+var (
+	ErrContactPinNotFound  = errors.New("contact pin not found")
+	ErrContactPinExpired   = errors.New("contact pin expired")
+	ErrContactPinExhausted = errors.New("contact pin has no uses left")
+	ErrTooManyContactPins  = errors.New("too many active contact pins")
+	ErrInvalidPinPayload   = errors.New("invalid contact pin payload")
+)
+
+const (
+	// pinCodeLength is the number of random bytes of a pin code.
+	pinCodeLength = 10
+	// pinPayloadVersion prefixes the signed payloads of the pins.
+	pinPayloadVersion = "jh1"
+)
+
+// pinPayload is the content of the QR codes and deep links of a pin.
+type pinPayload struct {
+	UserID    pulid.ID `json:"u"`
+	Code      string   `json:"c"`
+	ExpiresAt int64    `json:"e,omitempty"`
+}
+
+func newPinCode() (string, error) {
+	b := make([]byte, pinCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b), nil
+}
+
+// signPinPayload encodes the payload as its version, content and signature
+// separated by dots.
+func signPinPayload(secret []byte, p pinPayload) (string, error) {
+	content, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+
+	signed := pinPayloadVersion + "." + base64.RawURLEncoding.EncodeToString(content)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(pinPayloadSignature(secret, signed)), nil
+}
+
+func parsePinPayload(secret []byte, payload string, now time.Time) (pinPayload, error) {
+	var p pinPayload
+
+	i := strings.LastIndexByte(payload, '.')
+	if i < 0 {
+		return p, ErrInvalidPinPayload
+	}
+	signed := payload[:i]
+
+	signature, err := base64.RawURLEncoding.DecodeString(payload[i+1:])
+	if err != nil || !hmac.Equal(signature, pinPayloadSignature(secret, signed)) {
+		return p, ErrInvalidPinPayload
+	}
+
+	version, content, _ := strings.Cut(signed, ".")
+	if version != pinPayloadVersion {
+		return p, ErrInvalidPinPayload
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(content)
+	if err != nil {
+		return p, ErrInvalidPinPayload
+	}
+	if err := json.Unmarshal(b, &p); err != nil || p.Code == "" {
+		return p, ErrInvalidPinPayload
+	}
+
+	if p.ExpiresAt != 0 && now.Unix() >= p.ExpiresAt {
+		return p, ErrContactPinExpired
+	}
+
+	return p, nil
+}
+
+func pinPayloadSignature(secret []byte, signed string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signed))
+	return mac.Sum(nil)
+}
+
+// isActivePin matches the pins which may still be used.
+func isActivePin(now time.Time) predicate.ContactPin {
+	return contactpin.And(
+		contactpin.RevokedAtIsNil(),
+		contactpin.Or(
+			contactpin.ExpiresAtIsNil(),
+			contactpin.ExpiresAtGT(now),
+		),
+		pinHasUsesLeft(),
+	)
+}
+
+// pinHasUsesLeft matches the pins used less often than they may be.
+func pinHasUsesLeft() predicate.ContactPin {
+	return contactpin.Or(
+		contactpin.MaxUsesIsNil(),
+		func(s *sql.Selector) {
+			s.Where(sql.ColumnsLT(s.C(contactpin.FieldUses), s.C(contactpin.FieldMaxUses)))
+		},
+	)
+}
+
+func validateContactPin(pin *ent.ContactPin, now time.Time) error {
+	switch {
+	case pin.RevokedAt != nil:
+		return ErrContactPinNotFound
+	case pin.ExpiresAt != nil && !pin.ExpiresAt.After(now):
+		return ErrContactPinExpired
+	case pin.MaxUses != nil && pin.Uses >= *pin.MaxUses:
+		return ErrContactPinExhausted
+	default:
+		return nil
+	}
+}
+
+// CreateContactPin creates a pin of the current user, which expires after
+// the configured validity unless given an expiry.
+func (s *service) CreateContactPin(
+	ctx context.Context,
+	input model.CreateContactPinInput,
+) (*model.CreateContactPinPayload, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiresAt := input.ExpiresAt
+	if expiresAt == nil && s.config.TTL > 0 {
+		at := now.Add(s.config.TTL)
+		expiresAt = &at
+	}
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, ErrContactPinExpired
+	}
+
+	if s.config.MaxActive > 0 {
+		active, err := s.entClient.ContactPin.
+			Query().
+			Where(
+				contactpin.UserID(currentUserID),
+				isActivePin(now),
+			).
+			Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if active >= s.config.MaxActive {
+			return nil, ErrTooManyContactPins
+		}
+	}
+
+	code, err := newPinCode()
+	if err != nil {
+		return nil, err
+	}
+
+	pin, err := s.entClient.ContactPin.
+		Create().
+		SetCode(code).
+		SetUserID(currentUserID).
+		SetNillableExpiresAt(expiresAt).
+		SetNillableMaxUses(input.MaxUses).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p := pinPayload{
+		UserID: currentUserID,
+		Code:   code,
+	}
+	if expiresAt != nil {
+		p.ExpiresAt = expiresAt.Unix()
+	}
+	payload, err := signPinPayload([]byte(s.config.Secret), p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.CreateContactPinPayload{
+		Pin:     pin,
+		Code:    code,
+		Payload: payload,
+	}, nil
+}
+
+func (s *service) RevokeContactPin(
+	ctx context.Context,
+	ID pulid.ID,
+) (*ent.ContactPin, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pin, err := s.entClient.ContactPin.
+		Query().
+		Where(
+			contactpin.ID(ID),
+			contactpin.UserID(currentUserID),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrContactPinNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if pin.RevokedAt != nil {
+		return pin, nil
+	}
+
+	return pin.
+		Update().
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+}
+
+// usePin counts a use of the pin by the user. Pins of users who blocked the
+// user can't be found.
+func (s *service) usePin(
+	ctx context.Context,
+	userID pulid.ID,
+	code string,
+) (*ent.ContactPin, error) {
+	repository := db.Client(ctx, s.entClient)
+
+	pin, err := repository.ContactPin.
+		Query().
+		Where(
+			contactpin.Code(code),
+			contactpin.HasUserWith(
+				user.Not(Blocking(userID)),
+			),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrContactPinNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateContactPin(pin, time.Now()); err != nil {
+		return nil, err
+	}
+	if pin.UserID == userID {
+		return nil, ErrCannotAddSelf
+	}
+
+	n, err := repository.ContactPin.
+		Update().
+		Where(
+			contactpin.ID(pin.ID),
+			pinHasUsesLeft(),
+		).
+		AddUses(1).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrContactPinExhausted
+	}
+
+	return pin, nil
+}
@@ -0,0 +1,68 @@
+package contacts
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"journeyhub/ent"
+)
+
+func TestPinPayload(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Now()
+	p := pinPayload{UserID: "US1", Code: "CODE", ExpiresAt: now.Add(time.Hour).Unix()}
+
+	payload, err := signPinPayload(secret, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := parsePinPayload(secret, payload, now)
+	if err != nil {
+		t.Fatalf("parsePinPayload() error = %v", err)
+	}
+	if got != p {
+		t.Errorf("parsePinPayload() = %+v, want %+v", got, p)
+	}
+
+	if _, err := parsePinPayload([]byte("other"), payload, now); !errors.Is(err, ErrInvalidPinPayload) {
+		t.Errorf("parsePinPayload() with another secret error = %v, want %v", err, ErrInvalidPinPayload)
+	}
+
+	tampered := strings.Replace(payload, ".", ".x", 1)
+	if _, err := parsePinPayload(secret, tampered, now); !errors.Is(err, ErrInvalidPinPayload) {
+		t.Errorf("parsePinPayload() tampered error = %v, want %v", err, ErrInvalidPinPayload)
+	}
+
+	if _, err := parsePinPayload(secret, payload, now.Add(2*time.Hour)); !errors.Is(err, ErrContactPinExpired) {
+		t.Errorf("parsePinPayload() expired error = %v, want %v", err, ErrContactPinExpired)
+	}
+}
+
+func TestValidateContactPin(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+	one := 1
+
+	tests := []struct {
+		name string
+		pin  *ent.ContactPin
+		want error
+	}{
+		{"unlimited", &ent.ContactPin{}, nil},
+		{"revoked", &ent.ContactPin{RevokedAt: &past}, ErrContactPinNotFound},
+		{"expired", &ent.ContactPin{ExpiresAt: &past}, ErrContactPinExpired},
+		{"not expired", &ent.ContactPin{ExpiresAt: &future}, nil},
+		{"single use unused", &ent.ContactPin{MaxUses: &one}, nil},
+		{"single use used", &ent.ContactPin{MaxUses: &one, Uses: 1}, ErrContactPinExhausted},
+	}
+
+	for _, tt := range tests {
+		if err := validateContactPin(tt.pin, now); err != tt.want {
+			t.Errorf("%s: validateContactPin() = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
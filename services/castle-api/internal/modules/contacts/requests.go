@@ -0,0 +1,285 @@
+package contacts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/ent/contactrequest"
+	"journeyhub/ent/notification"
+	"journeyhub/ent/schema/mixin"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"journeyhub/ent/usercontact"
+	"journeyhub/internal/modules/notifications"
+	"journeyhub/internal/platform/db"
+)
+
+// declinedRequestCooldown keeps a declined request closed, so the sender
+// can't keep pushing the receiver with it.
+const declinedRequestCooldown = 30 * 24 * time.Hour
+
+var (
+	ErrContactRequestNotFound = errors.New("contact request not found")
+	ErrCannotAddSelf          = errors.New("users cannot add themselves to contacts")
+	ErrAlreadyContact         = errors.New("user is already a contact")
+)
+
+func (s *service) AcceptContactRequest(
+	ctx context.Context,
+	ID pulid.ID,
+) (*ent.ContactRequest, error) {
+	currentUser, err := s.authService.AuthUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var contactRequest *ent.ContactRequest
+	err = db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+		pending, err := s.pendingContactRequest(ctx, ID, currentUser.ID)
+		if err != nil {
+			return err
+		}
+
+		contactRequest, err = s.accept(ctx, pending, currentUser)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return contactRequest, nil
+}
+
+// DeclineContactRequest closes the request without telling its sender.
+func (s *service) DeclineContactRequest(
+	ctx context.Context,
+	ID pulid.ID,
+) (*ent.ContactRequest, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := s.pendingContactRequest(ctx, ID, currentUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return pending.Update().
+		SetStatus(contactrequest.StatusDeclined).
+		Save(ctx)
+}
+
+// requestContact sends a contact request from the sender to the receiver.
+// A request the receiver already sent to the sender is accepted instead, a
+// request still pending is not sent again, and a declined one only after
+// the cooldown.
+func (s *service) requestContact(
+	ctx context.Context,
+	sender *ent.User,
+	receiverID pulid.ID,
+) (*ent.ContactRequest, error) {
+	repository := db.Client(ctx, s.entClient)
+
+	isContact, err := repository.UserContact.
+		Query().
+		Where(
+			usercontact.UserID(sender.ID),
+			usercontact.ContactID(receiverID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if isContact {
+		return nil, ErrAlreadyContact
+	}
+
+	received, err := repository.ContactRequest.
+		Query().
+		Where(
+			contactrequest.SenderID(receiverID),
+			contactrequest.ReceiverID(sender.ID),
+			contactrequest.StatusEQ(contactrequest.StatusPending),
+		).
+		Only(ctx)
+	if err == nil {
+		return s.accept(ctx, received, sender)
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	contactRequest, err := repository.ContactRequest.
+		Query().
+		Where(
+			contactrequest.SenderID(sender.ID),
+			contactrequest.ReceiverID(receiverID),
+		).
+		Only(ctx)
+	switch {
+	case err == nil && contactRequest.Status == contactrequest.StatusPending:
+		return contactRequest, nil
+	case err == nil && !canRequestAgain(contactRequest, time.Now()):
+		return contactRequest, nil
+	case err == nil:
+		contactRequest, err = contactRequest.Update().
+			SetStatus(contactrequest.StatusPending).
+			Save(ctx)
+	case ent.IsNotFound(err):
+		contactRequest, err = repository.ContactRequest.
+			Create().
+			SetSenderID(sender.ID).
+			SetReceiverID(receiverID).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.notificationsService.Send(ctx, notifications.SendInput{
+		Type:     notification.TypeContactRequest,
+		UserIDs:  []pulid.ID{receiverID},
+		Template: notifications.TemplateContactRequest,
+		Params: notifications.TemplateParams{
+			SenderName: fullName(sender),
+		},
+		Data: map[string]string{
+			"userID":           string(sender.ID),
+			"contactRequestID": string(contactRequest.ID),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	contactRequestID := contactRequest.ID
+	db.AfterCommit(ctx, func() {
+		s.subscriptions.PublishContactRequestReceivedEvent(ctx, receiverID, contactRequestID)
+	})
+
+	return contactRequest, nil
+}
+
+// canRequestAgain reports whether a closed request may be sent again.
+func canRequestAgain(contactRequest *ent.ContactRequest, now time.Time) bool {
+	return contactRequest.Status != contactrequest.StatusDeclined ||
+		now.Sub(contactRequest.UpdatedAt) >= declinedRequestCooldown
+}
+
+// accept makes the users of the request contacts of each other, sharing
+// their personal room. The receiver is the current user.
+func (s *service) accept(
+	ctx context.Context,
+	contactRequest *ent.ContactRequest,
+	receiver *ent.User,
+) (*ent.ContactRequest, error) {
+	contactRequest, err := db.Client(ctx, s.entClient).ContactRequest.
+		UpdateOne(contactRequest).
+		SetStatus(contactrequest.StatusAccepted).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := s.roomsService.FindOrCreatePersonalRoom(ctx, contactRequest.SenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.addContact(ctx, receiver.ID, contactRequest.SenderID, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.addContact(ctx, contactRequest.SenderID, receiver.ID, r.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.notificationsService.Send(ctx, notifications.SendInput{
+		Type:     notification.TypeContactAdded,
+		UserIDs:  []pulid.ID{contactRequest.SenderID},
+		Template: notifications.TemplateContactAccepted,
+		Params: notifications.TemplateParams{
+			SenderName: fullName(receiver),
+		},
+		Data: map[string]string{
+			"userID": string(receiver.ID),
+			"roomID": string(r.ID),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return contactRequest, nil
+}
+
+// addContact adds the contact to the user, restoring a deleted one.
+func (s *service) addContact(
+	ctx context.Context,
+	userID pulid.ID,
+	contactID pulid.ID,
+	roomID pulid.ID,
+) error {
+	repository := db.Client(ctx, s.entClient)
+
+	userContact, err := repository.UserContact.
+		Query().
+		Where(
+			usercontact.UserID(userID),
+			usercontact.ContactID(contactID),
+		).
+		First(mixin.SkipSoftDelete(ctx))
+	if ent.IsNotFound(err) {
+		return repository.UserContact.
+			Create().
+			SetUserID(userID).
+			SetContactID(contactID).
+			SetRoomID(roomID).
+			Exec(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	return userContact.Update().
+		ClearDeletedAt().
+		SetRoomID(roomID).
+		Exec(mixin.SkipSoftDelete(ctx))
+}
+
+// pendingContactRequest returns the pending request received by the user,
+// unless its sender blocked the user since.
+func (s *service) pendingContactRequest(
+	ctx context.Context,
+	ID pulid.ID,
+	receiverID pulid.ID,
+) (*ent.ContactRequest, error) {
+	contactRequest, err := db.Client(ctx, s.entClient).ContactRequest.
+		Query().
+		Where(
+			contactrequest.ID(ID),
+			contactrequest.ReceiverID(receiverID),
+			contactrequest.StatusEQ(contactrequest.StatusPending),
+			contactrequest.HasSenderWith(
+				user.Not(Blocking(receiverID)),
+			),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrContactRequestNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return contactRequest, nil
+}
+
+func fullName(u *ent.User) string {
+	return fmt.Sprintf("%s %s", u.FirstName, u.LastName)
+}
@@ -0,0 +1,42 @@
+package contacts
+
+import (
+	"testing"
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/ent/contactrequest"
+)
+
+func TestCanRequestAgain(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		request *ent.ContactRequest
+		want    bool
+	}{
+		{
+			"accepted",
+			&ent.ContactRequest{Status: contactrequest.StatusAccepted, UpdatedAt: now},
+			true,
+		},
+		{
+			"declined recently",
+			&ent.ContactRequest{Status: contactrequest.StatusDeclined, UpdatedAt: now.Add(-time.Hour)},
+			false,
+		},
+		{
+			"declined before the cooldown",
+			&ent.ContactRequest{Status: contactrequest.StatusDeclined, UpdatedAt: now.Add(-declinedRequestCooldown)},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := canRequestAgain(tt.request, now); got != tt.want {
+				t.Errorf("canRequestAgain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
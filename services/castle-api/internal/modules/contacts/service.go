@@ -2,95 +2,181 @@ package contacts
 
 import (
 	"context"
+	"time"
 
 	"journeyhub/ent"
 	"journeyhub/ent/schema/pulid"
-	"journeyhub/ent/user"
+	"journeyhub/graph/model"
 	"journeyhub/internal/modules/auth"
+	"journeyhub/internal/modules/notifications"
+	"journeyhub/internal/modules/rooms"
+	"journeyhub/internal/platform/config"
+	"journeyhub/internal/platform/db"
+	"journeyhub/internal/platform/ratelimit"
 )
 
 type Service interface {
-	GenerateUserPinCode(
+	CreateContactPin(
 		ctx context.Context,
-	) (*string, error)
+		input model.CreateContactPinInput,
+	) (*model.CreateContactPinPayload, error)
 
+	RevokeContactPin(
+		ctx context.Context,
+		ID pulid.ID,
+	) (*ent.ContactPin, error)
+
+	// AddUserContact sends a contact request to the owner of the pincode,
+	// or accepts the one they already sent to the current user.
 	AddUserContact(
 		ctx context.Context,
 		pincode string,
-	) (*ent.UserContact, error)
+	) (*ent.ContactRequest, error)
+
+	// AddUserContactByPayload is AddUserContact with the signed payload of
+	// a pin.
+	AddUserContactByPayload(
+		ctx context.Context,
+		payload string,
+	) (*ent.ContactRequest, error)
+
+	AcceptContactRequest(
+		ctx context.Context,
+		ID pulid.ID,
+	) (*ent.ContactRequest, error)
+
+	DeclineContactRequest(
+		ctx context.Context,
+		ID pulid.ID,
+	) (*ent.ContactRequest, error)
 
 	DeleteUserContact(
 		ctx context.Context,
 		ID pulid.ID,
 	) (*ent.UserContact, error)
+
+	BlockUser(
+		ctx context.Context,
+		userID pulid.ID,
+	) (*ent.User, error)
+
+	UnblockUser(
+		ctx context.Context,
+		userID pulid.ID,
+	) (*ent.User, error)
+
+	Subscriptions() Subscriptions
 }
 
 type service struct {
-	entClient   *ent.Client
-	authService auth.Service
+	config               config.ContactPinsConfig
+	entClient            *ent.Client
+	limiter              ratelimit.Limiter
+	subscriptions        Subscriptions
+	authService          auth.Service
+	notificationsService notifications.Service
+	roomsService         rooms.Service
 }
 
-func NewService(entClient *ent.Client, authService auth.Service) Service {
+func NewService(
+	config config.ContactPinsConfig,
+	entClient *ent.Client,
+	limiter ratelimit.Limiter,
+	subscriptions Subscriptions,
+	authService auth.Service,
+	notificationsService notifications.Service,
+	roomsService rooms.Service,
+) Service {
 	return &service{
-		entClient:   entClient,
-		authService: authService,
+		config:               config,
+		entClient:            entClient,
+		limiter:              limiter,
+		subscriptions:        subscriptions,
+		authService:          authService,
+		notificationsService: notificationsService,
+		roomsService:         roomsService,
 	}
 }
 
-func (s *service) GenerateUserPinCode(
+func (s *service) AddUserContact(
 	ctx context.Context,
-) (*string, error) {
-	currentUserID, err := s.authService.Auth(ctx)
+	pincode string,
+) (*ent.ContactRequest, error) {
+	currentUser, err := s.authService.AuthUser(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	pincode := string(pulid.MustNew("PIN"))
-
-	repository := s.entClient
-
-	err = repository.User.
-		UpdateOneID(currentUserID).
-		SetContactPin(pincode).
-		Exec(ctx)
-	if err != nil {
+	if err := s.limit(ctx, currentUser.ID); err != nil {
 		return nil, err
 	}
 
-	return &pincode, nil
+	return s.addUserContact(ctx, currentUser, pincode, "")
 }
 
-func (s *service) AddUserContact(
+func (s *service) AddUserContactByPayload(
 	ctx context.Context,
-	pincode string,
-) (*ent.UserContact, error) {
-	currentUserID, err := s.authService.Auth(ctx)
+	payload string,
+) (*ent.ContactRequest, error) {
+	currentUser, err := s.authService.AuthUser(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	repository := s.entClient
+	if err := s.limit(ctx, currentUser.ID); err != nil {
+		return nil, err
+	}
 
-	pincodeUser, err := repository.User.
-		Query().
-		Where(
-			user.ContactPin(pincode),
-		).
-		Only(ctx)
+	p, err := parsePinPayload([]byte(s.config.Secret), payload, time.Now())
 	if err != nil {
 		return nil, err
 	}
 
-	userContact, err := repository.UserContact.
-		Create().
-		SetUserID(currentUserID).
-		SetContactID(pincodeUser.ID).
-		Save(ctx)
+	return s.addUserContact(ctx, currentUser, p.Code, p.UserID)
+}
+
+// addUserContact uses the pin to request its owner, who is ownerID when
+// known, as a contact of the current user.
+func (s *service) addUserContact(
+	ctx context.Context,
+	currentUser *ent.User,
+	pincode string,
+	ownerID pulid.ID,
+) (*ent.ContactRequest, error) {
+	var contactRequest *ent.ContactRequest
+	err := db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+		pin, err := s.usePin(ctx, currentUser.ID, pincode)
+		if err != nil {
+			return err
+		}
+		if ownerID != "" && pin.UserID != ownerID {
+			return ErrContactPinNotFound
+		}
+
+		contactRequest, err = s.requestContact(ctx, currentUser, pin.UserID)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return userContact, nil
+	return contactRequest, nil
+}
+
+// limit counts an attempt of the user to add a contact, so that pins can't
+// be guessed.
+func (s *service) limit(
+	ctx context.Context,
+	userID pulid.ID,
+) error {
+	allowed, err := s.limiter.Allow(ctx, string(userID))
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return ratelimit.NewLimitExceededError()
+	}
+	return nil
 }
 
 func (s *service) DeleteUserContact(
@@ -118,3 +204,7 @@ func (s *service) DeleteUserContact(
 
 	return userContact, nil
 }
+
+func (s *service) Subscriptions() Subscriptions {
+	return s.subscriptions
+}
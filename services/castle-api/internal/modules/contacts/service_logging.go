@@ -6,6 +6,7 @@ import (
 
 	"journeyhub/ent"
 	"journeyhub/ent/schema/pulid"
+	"journeyhub/graph/model"
 
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
@@ -20,28 +21,44 @@ func NewServiceLogging(logger log.Logger, s Service) Service {
 	return &ServiceLogging{logger, s}
 }
 
-func (s *ServiceLogging) GenerateUserPinCode(
+func (s *ServiceLogging) CreateContactPin(
 	ctx context.Context,
-) (pincode *string, err error) {
+	input model.CreateContactPinInput,
+) (payload *model.CreateContactPinPayload, err error) {
 	defer func(begin time.Time) {
 		level.Debug(s.logger).Log(
-			"method", "GenerateUserPinCode",
-			"pincode", pincode,
+			"method", "CreateContactPin",
+			"expiresAt", input.ExpiresAt,
+			"maxUses", input.MaxUses,
 			"took", time.Since(begin),
 			"err", err,
 		)
 	}(time.Now())
-	return s.Service.GenerateUserPinCode(ctx)
+	return s.Service.CreateContactPin(ctx, input)
+}
+
+func (s *ServiceLogging) RevokeContactPin(
+	ctx context.Context,
+	ID pulid.ID,
+) (pin *ent.ContactPin, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "RevokeContactPin",
+			"ID", ID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.RevokeContactPin(ctx, ID)
 }
 
 func (s *ServiceLogging) AddUserContact(
 	ctx context.Context,
 	pincode string,
-) (usr *ent.UserContact, err error) {
+) (contactRequest *ent.ContactRequest, err error) {
 	defer func(begin time.Time) {
 		level.Debug(s.logger).Log(
 			"method", "AddUserContact",
-			"pincode", pincode,
 			"took", time.Since(begin),
 			"err", err,
 		)
@@ -49,6 +66,50 @@ func (s *ServiceLogging) AddUserContact(
 	return s.Service.AddUserContact(ctx, pincode)
 }
 
+func (s *ServiceLogging) AddUserContactByPayload(
+	ctx context.Context,
+	payload string,
+) (contactRequest *ent.ContactRequest, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "AddUserContactByPayload",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.AddUserContactByPayload(ctx, payload)
+}
+
+func (s *ServiceLogging) AcceptContactRequest(
+	ctx context.Context,
+	ID pulid.ID,
+) (contactRequest *ent.ContactRequest, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "AcceptContactRequest",
+			"ID", ID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.AcceptContactRequest(ctx, ID)
+}
+
+func (s *ServiceLogging) DeclineContactRequest(
+	ctx context.Context,
+	ID pulid.ID,
+) (contactRequest *ent.ContactRequest, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "DeclineContactRequest",
+			"ID", ID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.DeclineContactRequest(ctx, ID)
+}
+
 func (s *ServiceLogging) DeleteUserContact(
 	ctx context.Context,
 	ID pulid.ID,
@@ -63,3 +124,33 @@ func (s *ServiceLogging) DeleteUserContact(
 	}(time.Now())
 	return s.Service.DeleteUserContact(ctx, ID)
 }
+
+func (s *ServiceLogging) BlockUser(
+	ctx context.Context,
+	userID pulid.ID,
+) (usr *ent.User, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "BlockUser",
+			"userID", userID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.BlockUser(ctx, userID)
+}
+
+func (s *ServiceLogging) UnblockUser(
+	ctx context.Context,
+	userID pulid.ID,
+) (usr *ent.User, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "UnblockUser",
+			"userID", userID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.UnblockUser(ctx, userID)
+}
@@ -0,0 +1,89 @@
+package contacts
+
+import (
+	"context"
+	"fmt"
+
+	"journeyhub/ent"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/internal/modules/auth"
+	"journeyhub/internal/platform/nats"
+)
+
+type Subscriptions interface {
+	PublishContactRequestReceivedEvent(
+		ctx context.Context,
+		userID pulid.ID,
+		contactRequestID pulid.ID,
+	) (string, error)
+
+	SubscribeToContactRequestReceivedEvent(
+		ctx context.Context,
+	) (<-chan *ent.ContactRequestEdge, error)
+}
+
+type subscriptions struct {
+	entClient   *ent.Client
+	authService auth.Service
+	natsService nats.Service
+}
+
+func NewSubscriptions(
+	entClient *ent.Client,
+	authService auth.Service,
+	natsService nats.Service,
+) Subscriptions {
+	return &subscriptions{
+		entClient:   entClient,
+		authService: authService,
+		natsService: natsService,
+	}
+}
+
+func (s *subscriptions) PublishContactRequestReceivedEvent(
+	ctx context.Context,
+	userID pulid.ID,
+	contactRequestID pulid.ID,
+) (string, error) {
+	natsClient := s.natsService.Client()
+
+	subject := fmt.Sprintf("users.%s.contactrequests.received", userID)
+	if err := natsClient.Publish(subject, contactRequestID); err != nil {
+		return "", err
+	}
+
+	return subject, nil
+}
+
+func (s *subscriptions) SubscribeToContactRequestReceivedEvent(
+	ctx context.Context,
+) (<-chan *ent.ContactRequestEdge, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subject := fmt.Sprintf("users.%s.contactrequests.received", currentUserID)
+
+	natsClient := s.natsService.Client()
+
+	ch := make(chan *ent.ContactRequestEdge, 1)
+
+	sub, err := natsClient.Subscribe(subject, func(contactRequestID pulid.ID) {
+		cr, err := s.entClient.ContactRequest.Get(ctx, contactRequestID)
+		if err != nil {
+			return
+		}
+		ch <- cr.ToEdge(ent.DefaultContactRequestOrder)
+	})
+	if err != nil {
+		return ch, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		sub.Unsubscribe()
+	}()
+
+	return ch, nil
+}
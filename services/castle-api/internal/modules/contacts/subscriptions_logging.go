@@ -0,0 +1,50 @@
+package contacts
+
+import (
+	"context"
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/ent/schema/pulid"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+type subscriptionsLogging struct {
+	logger log.Logger
+	Subscriptions
+}
+
+func NewSubscriptionsLogging(logger log.Logger, s Subscriptions) Subscriptions {
+	return &subscriptionsLogging{logger, s}
+}
+
+func (s *subscriptionsLogging) PublishContactRequestReceivedEvent(
+	ctx context.Context,
+	userID pulid.ID,
+	contactRequestID pulid.ID,
+) (subject string, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "PublishContactRequestReceivedEvent",
+			"subject", subject,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Subscriptions.PublishContactRequestReceivedEvent(ctx, userID, contactRequestID)
+}
+
+func (s *subscriptionsLogging) SubscribeToContactRequestReceivedEvent(
+	ctx context.Context,
+) (ch <-chan *ent.ContactRequestEdge, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "SubscribeToContactRequestReceivedEvent",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Subscriptions.SubscribeToContactRequestReceivedEvent(ctx)
+}
@@ -0,0 +1,167 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"io"
+
+	// Decoders of the image formats accepted as avatars.
+	_ "image/gif"
+	_ "image/png"
+
+	"journeyhub/graph/model"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+var ErrInvalidImage = errors.New("invalid image")
+
+const avatarJPEGQuality = 90
+
+// UploadAvatar crops the image square and stores it at each configured
+// size, largest first. The first upload is the avatar, the others are its
+// thumbnails.
+func (s *service) UploadAvatar(
+	ctx context.Context,
+	owner UploadOwner,
+	prefix string,
+	file *graphql.Upload,
+	crop *model.AvatarCropInput,
+) ([]*UploadInfo, error) {
+	if err := s.checkQuota(ctx, owner, file.Size); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.inspect(ctx, FileKindAvatar, file); err != nil {
+		return nil, err
+	}
+
+	img, err := decodeImage(file.File, s.mediaConfig.Avatar.MaxDimension)
+	if err != nil {
+		return nil, err
+	}
+
+	square, err := cropSquare(img, crop)
+	if err != nil {
+		return nil, err
+	}
+
+	uploadInfo := make([]*UploadInfo, 0, len(s.mediaConfig.Avatar.Sizes))
+	for _, size := range s.mediaConfig.Avatar.Sizes {
+		var buf bytes.Buffer
+		err := jpeg.Encode(&buf, resize(square, size), &jpeg.Options{Quality: avatarJPEGQuality})
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := s.putObject(ctx, prefix, &graphql.Upload{
+			File:     bytes.NewReader(buf.Bytes()),
+			Filename: fmt.Sprintf("avatar_%d.jpg", size),
+			Size:     int64(buf.Len()),
+		}, "image/jpeg")
+		if err != nil {
+			return nil, err
+		}
+		info.Width, info.Height = size, size
+
+		uploadInfo = append(uploadInfo, info)
+	}
+
+	return uploadInfo, nil
+}
+
+// decodeImage decodes the image once its dimensions are known to be within
+// maxDimension, so oversized images are not decompressed.
+func decodeImage(r io.ReadSeeker, maxDimension int) (image.Image, error) {
+	cfg, _, err := image.DecodeConfig(r)
+	if err != nil {
+		return nil, newInvalidImageError(err.Error())
+	}
+	if maxDimension > 0 && (cfg.Width > maxDimension || cfg.Height > maxDimension) {
+		return nil, newInvalidImageError(fmt.Sprintf("image is larger than %dx%d pixels", maxDimension, maxDimension))
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	img, _, err := image.Decode(r)
+	if err != nil {
+		return nil, newInvalidImageError(err.Error())
+	}
+	return img, nil
+}
+
+// cropSquare returns the square of the image selected by crop, or the
+// largest centered one. Transparency is flattened on white.
+func cropSquare(img image.Image, crop *model.AvatarCropInput) (*image.RGBA, error) {
+	b := img.Bounds()
+
+	var rect image.Rectangle
+	if crop != nil {
+		rect = image.Rect(crop.X, crop.Y, crop.X+crop.Size, crop.Y+crop.Size).Add(b.Min)
+		if !rect.In(b) {
+			return nil, newInvalidImageError("crop is outside of the image")
+		}
+	} else {
+		size := min(b.Dx(), b.Dy())
+		x, y := b.Min.X+(b.Dx()-size)/2, b.Min.Y+(b.Dy()-size)/2
+		rect = image.Rect(x, y, x+size, y+size)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, rect.Dx(), rect.Dy()))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), img, rect.Min, draw.Over)
+	return dst, nil
+}
+
+// resize scales the square image to size x size, averaging the source
+// pixels covered by each destination pixel when downscaling.
+func resize(src *image.RGBA, size int) *image.RGBA {
+	n := src.Bounds().Dx()
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+
+	for y := 0; y < size; y++ {
+		y0, y1 := y*n/size, max((y+1)*n/size, y*n/size+1)
+		for x := 0; x < size; x++ {
+			x0, x1 := x*n/size, max((x+1)*n/size, x*n/size+1)
+
+			var r, g, b, a, count uint32
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					i := src.PixOffset(sx, sy)
+					r += uint32(src.Pix[i])
+					g += uint32(src.Pix[i+1])
+					b += uint32(src.Pix[i+2])
+					a += uint32(src.Pix[i+3])
+					count++
+				}
+			}
+
+			i := dst.PixOffset(x, y)
+			dst.Pix[i] = uint8(r / count)
+			dst.Pix[i+1] = uint8(g / count)
+			dst.Pix[i+2] = uint8(b / count)
+			dst.Pix[i+3] = uint8(a / count)
+		}
+	}
+
+	return dst
+}
+
+func newInvalidImageError(reason string) error {
+	return &gqlerror.Error{
+		Err:     ErrInvalidImage,
+		Message: fmt.Sprintf("%s: %s", ErrInvalidImage, reason),
+		Extensions: map[string]interface{}{
+			"code": "INVALID_IMAGE",
+		},
+	}
+}
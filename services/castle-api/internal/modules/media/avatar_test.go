@@ -0,0 +1,98 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"journeyhub/graph/model"
+)
+
+func TestCropSquare(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 40, 20))
+
+	tests := []struct {
+		name     string
+		crop     *model.AvatarCropInput
+		size     int
+		rejected bool
+	}{
+		{"centered", nil, 20, false},
+		{"crop", &model.AvatarCropInput{X: 10, Y: 5, Size: 10}, 10, false},
+		{"crop outside", &model.AvatarCropInput{X: 30, Y: 0, Size: 20}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			square, err := cropSquare(img, tt.crop)
+			if tt.rejected {
+				if !errors.Is(err, ErrInvalidImage) {
+					t.Fatalf("cropSquare() error = %v, want %v", err, ErrInvalidImage)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("cropSquare() error = %v", err)
+			}
+			if b := square.Bounds(); b.Dx() != tt.size || b.Dy() != tt.size {
+				t.Errorf("cropSquare() = %dx%d, want %dx%d", b.Dx(), b.Dy(), tt.size, tt.size)
+			}
+		})
+	}
+}
+
+func TestCropSquareFlattensTransparency(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+
+	square, err := cropSquare(img, nil)
+	if err != nil {
+		t.Fatalf("cropSquare() error = %v", err)
+	}
+	if c := square.RGBAAt(0, 0); c != (color.RGBA{255, 255, 255, 255}) {
+		t.Errorf("cropSquare() pixel = %v, want white", c)
+	}
+}
+
+func TestResize(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			if x < 2 {
+				src.SetRGBA(x, y, color.RGBA{0, 0, 0, 255})
+			} else {
+				src.SetRGBA(x, y, color.RGBA{255, 255, 255, 255})
+			}
+		}
+	}
+
+	dst := resize(src, 2)
+	if b := dst.Bounds(); b.Dx() != 2 || b.Dy() != 2 {
+		t.Fatalf("resize() = %dx%d, want 2x2", b.Dx(), b.Dy())
+	}
+	if c := dst.RGBAAt(0, 0); c.R != 0 {
+		t.Errorf("resize() left pixel = %v, want black", c)
+	}
+	if c := dst.RGBAAt(1, 0); c.R != 255 {
+		t.Errorf("resize() right pixel = %v, want white", c)
+	}
+}
+
+func TestDecodeImageMaxDimension(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 16, 8))); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := decodeImage(bytes.NewReader(buf.Bytes()), 16); err != nil {
+		t.Errorf("decodeImage() error = %v", err)
+	}
+	if _, err := decodeImage(bytes.NewReader(buf.Bytes()), 8); !errors.Is(err, ErrInvalidImage) {
+		t.Errorf("decodeImage() error = %v, want %v", err, ErrInvalidImage)
+	}
+	if _, err := decodeImage(bytes.NewReader([]byte("not an image")), 16); !errors.Is(err, ErrInvalidImage) {
+		t.Errorf("decodeImage() error = %v, want %v", err, ErrInvalidImage)
+	}
+}
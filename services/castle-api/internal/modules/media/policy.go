@@ -0,0 +1,135 @@
+package media
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"path"
+	"strings"
+
+	"journeyhub/ent/messageattachment"
+	"journeyhub/internal/platform/config"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+var (
+	ErrAttachmentRejected = errors.New("attachment rejected by content policy")
+	ErrAttachmentInfected = errors.New("attachment is infected")
+)
+
+type FileKind string
+
+const (
+	FileKindMedia  FileKind = "MEDIA"
+	FileKindFile   FileKind = "FILE"
+	FileKindVoice  FileKind = "VOICE"
+	FileKindAvatar FileKind = "AVATAR"
+)
+
+// FileKindFromAttachmentType maps a declared attachment type to a policy kind.
+func FileKindFromAttachmentType(t messageattachment.Type) FileKind {
+	if t == messageattachment.TypeMedia {
+		return FileKindMedia
+	}
+	return FileKindFile
+}
+
+// declaredContentTypes lists the MIME families a kind must sniff to,
+// regardless of the configured allow lists.
+var declaredContentTypes = map[FileKind][]string{
+	FileKindMedia:  {"image/*", "video/*"},
+	FileKindAvatar: {"image/jpeg", "image/png", "image/gif"},
+}
+
+// Policy decides whether an upload of a given kind may be stored.
+type Policy struct {
+	rules map[FileKind]config.MediaPolicyRuleConfig
+}
+
+func NewPolicy(policyConfig config.MediaPolicyConfig) *Policy {
+	return &Policy{
+		rules: map[FileKind]config.MediaPolicyRuleConfig{
+			FileKindMedia:  policyConfig.Media,
+			FileKindFile:   policyConfig.File,
+			FileKindVoice:  policyConfig.Voice,
+			FileKindAvatar: policyConfig.Avatar,
+		},
+	}
+}
+
+// Check validates the sniffed content type and the size of an upload.
+func (p *Policy) Check(kind FileKind, filename string, contentType string, size int64) error {
+	mediaType := normalizeContentType(contentType)
+	rule := p.rules[kind]
+
+	if rule.MaxSize > 0 && size > rule.MaxSize {
+		return newAttachmentRejectedError(
+			kind,
+			filename,
+			mediaType,
+			fmt.Sprintf("file is larger than %d bytes", rule.MaxSize),
+		)
+	}
+
+	if declared, ok := declaredContentTypes[kind]; ok && !matchContentType(declared, mediaType) {
+		return newAttachmentRejectedError(
+			kind,
+			filename,
+			mediaType,
+			"content does not match the declared attachment type",
+		)
+	}
+
+	if matchContentType(rule.Deny, mediaType) {
+		return newAttachmentRejectedError(kind, filename, mediaType, "content type is not allowed")
+	}
+
+	if len(rule.Allow) > 0 && !matchContentType(rule.Allow, mediaType) {
+		return newAttachmentRejectedError(kind, filename, mediaType, "content type is not allowed")
+	}
+
+	return nil
+}
+
+func normalizeContentType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(contentType))
+	}
+	return mediaType
+}
+
+func matchContentType(patterns []string, mediaType string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(strings.ToLower(pattern), mediaType); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func newAttachmentRejectedError(kind FileKind, filename, contentType, reason string) error {
+	return &gqlerror.Error{
+		Err:     ErrAttachmentRejected,
+		Message: fmt.Sprintf("%s: %s", ErrAttachmentRejected, reason),
+		Extensions: map[string]interface{}{
+			"code":        "ATTACHMENT_REJECTED",
+			"kind":        kind,
+			"filename":    filename,
+			"contentType": contentType,
+		},
+	}
+}
+
+func newAttachmentInfectedError(filename, signature string) error {
+	return &gqlerror.Error{
+		Err:     ErrAttachmentInfected,
+		Message: ErrAttachmentInfected.Error(),
+		Extensions: map[string]interface{}{
+			"code":      "ATTACHMENT_INFECTED",
+			"filename":  filename,
+			"signature": signature,
+		},
+	}
+}
@@ -0,0 +1,50 @@
+package media
+
+import (
+	"errors"
+	"testing"
+
+	"journeyhub/internal/platform/config"
+)
+
+func TestPolicyCheck(t *testing.T) {
+	policy := NewPolicy(config.MediaPolicyConfig{
+		Media: config.MediaPolicyRuleConfig{
+			MaxSize: 1024,
+		},
+		File: config.MediaPolicyRuleConfig{
+			Deny: []string{"application/x-msdownload"},
+		},
+		Voice: config.MediaPolicyRuleConfig{
+			Allow: []string{"audio/*", "video/mp4"},
+		},
+	})
+
+	tests := []struct {
+		name        string
+		kind        FileKind
+		contentType string
+		size        int64
+		rejected    bool
+	}{
+		{"media image", FileKindMedia, "image/png", 512, false},
+		{"media video", FileKindMedia, "video/mp4", 512, false},
+		{"media too large", FileKindMedia, "image/png", 2048, true},
+		{"media mislabeled document", FileKindMedia, "application/pdf", 512, true},
+		{"file document", FileKindFile, "application/pdf", 1 << 30, false},
+		{"file with parameters", FileKindFile, "text/plain; charset=utf-8", 10, false},
+		{"file denied", FileKindFile, "application/x-msdownload", 10, true},
+		{"voice audio", FileKindVoice, "audio/mp4", 10, false},
+		{"voice video container", FileKindVoice, "video/mp4", 10, false},
+		{"voice image", FileKindVoice, "image/png", 10, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Check(tt.kind, "upload", tt.contentType, tt.size)
+			if tt.rejected != errors.Is(err, ErrAttachmentRejected) {
+				t.Fatalf("expected rejected=%v, got %v", tt.rejected, err)
+			}
+		})
+	}
+}
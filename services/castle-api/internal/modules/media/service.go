@@ -2,6 +2,7 @@ package media
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"path/filepath"
@@ -9,18 +10,36 @@ import (
 	"journeyhub/ent"
 	"journeyhub/ent/file"
 	"journeyhub/ent/messageattachment"
+	"journeyhub/ent/room"
 	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
 	"journeyhub/graph/model"
+	"journeyhub/internal/platform/antivirus"
 	"journeyhub/internal/platform/config"
+	"journeyhub/internal/platform/db"
 
 	"github.com/gabriel-vasile/mimetype"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 	"golang.org/x/sync/errgroup"
 )
 
+var ErrQuotaExceeded = errors.New("storage quota exceeded")
+
+const (
+	QuotaScopeUser = "USER"
+	QuotaScopeRoom = "ROOM"
+)
+
+// UploadOwner identifies the user and the optional room an upload is charged to.
+type UploadOwner struct {
+	UserID pulid.ID
+	RoomID pulid.ID
+}
+
 type UploadInfo struct {
 	ID          pulid.ID
 	Type        messageattachment.Type
@@ -31,31 +50,73 @@ type UploadInfo struct {
 	Bucket      string
 	Location    string
 	Path        string
+	Width       int
+	Height      int
 }
 
 type Service interface {
 	UploadMessageFiles(
 		ctx context.Context,
+		owner UploadOwner,
 		prefix string,
 		files []*model.UploadMessageFileInput,
 	) ([]*UploadInfo, error)
 
 	UploadFile(
 		ctx context.Context,
+		owner UploadOwner,
+		kind FileKind,
 		prefix string,
 		file *graphql.Upload,
 	) (*UploadInfo, error)
 
+	UploadAvatar(
+		ctx context.Context,
+		owner UploadOwner,
+		prefix string,
+		file *graphql.Upload,
+		crop *model.AvatarCropInput,
+	) ([]*UploadInfo, error)
+
+	EnforceQuota(
+		ctx context.Context,
+		owner UploadOwner,
+	) error
+
+	RemoveUploads(
+		ctx context.Context,
+		uploadInfo []*UploadInfo,
+	) error
+
+	RemoveFiles(
+		ctx context.Context,
+		files []*ent.File,
+	) error
+
+	StorageUsage(
+		ctx context.Context,
+		userID pulid.ID,
+	) (*model.StorageUsage, error)
+
 	Config() config.S3Config
 }
 
 type service struct {
 	config         config.S3Config
+	mediaConfig    config.MediaConfig
+	entClient      *ent.Client
+	scanner        antivirus.Scanner
+	policy         *Policy
 	minioClient    *minio.Client
 	uploadIDPrefix string
 }
 
-func NewService(config config.S3Config) (Service, error) {
+func NewService(
+	config config.S3Config,
+	mediaConfig config.MediaConfig,
+	entClient *ent.Client,
+	scanner antivirus.Scanner,
+) (Service, error) {
 	minioClient, err := minio.New(
 		config.Host,
 		&minio.Options{
@@ -77,6 +138,10 @@ func NewService(config config.S3Config) (Service, error) {
 
 	return &service{
 		config:         config,
+		mediaConfig:    mediaConfig,
+		entClient:      entClient,
+		scanner:        scanner,
+		policy:         NewPolicy(mediaConfig.Policy),
 		minioClient:    minioClient,
 		uploadIDPrefix: uploadIDPrefix,
 	}, nil
@@ -84,35 +149,64 @@ func NewService(config config.S3Config) (Service, error) {
 
 func (s *service) UploadMessageFiles(
 	ctx context.Context,
+	owner UploadOwner,
 	prefix string,
 	files []*model.UploadMessageFileInput,
 ) ([]*UploadInfo, error) {
-	uploadInfoCh := make(chan *UploadInfo, len(files))
+	var size int64
+	for _, file := range files {
+		size += file.File.Size
+	}
+
+	if err := s.checkQuota(ctx, owner, size); err != nil {
+		return nil, err
+	}
+
+	contentTypes := make([]string, len(files))
 
 	eg, egCtx := errgroup.WithContext(ctx)
 	eg.SetLimit(20)
 
-	for _, file := range files {
+	for i, file := range files {
 		eg.Go(func() error {
-			uploadInfo, err := s.UploadFile(egCtx, prefix, &file.File)
+			contentType, err := s.inspect(egCtx, FileKindFromAttachmentType(file.Type), &file.File)
 			if err != nil {
 				return err
 			}
-			uploadInfo.Type = file.Type
-			uploadInfoCh <- uploadInfo
+			contentTypes[i] = contentType
 			return nil
 		})
 	}
 
-	err := eg.Wait()
-	if err != nil {
+	if err := eg.Wait(); err != nil {
 		return nil, err
 	}
-	close(uploadInfoCh)
 
-	uploadInfo := make([]*UploadInfo, 0, len(files))
-	for chValue := range uploadInfoCh {
-		uploadInfo = append(uploadInfo, chValue)
+	uploadInfo := make([]*UploadInfo, len(files))
+
+	eg, egCtx = errgroup.WithContext(ctx)
+	eg.SetLimit(20)
+
+	for i, file := range files {
+		eg.Go(func() error {
+			info, err := s.putObject(egCtx, prefix, &file.File, contentTypes[i])
+			if err != nil {
+				return err
+			}
+			info.Type = file.Type
+			uploadInfo[i] = info
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		stored := make([]*UploadInfo, 0, len(uploadInfo))
+		for _, info := range uploadInfo {
+			if info != nil {
+				stored = append(stored, info)
+			}
+		}
+		return nil, errors.Join(err, s.RemoveUploads(context.WithoutCancel(ctx), stored))
 	}
 
 	return uploadInfo, nil
@@ -120,28 +214,196 @@ func (s *service) UploadMessageFiles(
 
 func (s *service) UploadFile(
 	ctx context.Context,
+	owner UploadOwner,
+	kind FileKind,
 	prefix string,
 	file *graphql.Upload,
 ) (*UploadInfo, error) {
-	uploadID := pulid.MustNew(s.uploadIDPrefix)
-
-	objectName := fmt.Sprintf(
-		"%s/%s%s",
-		prefix,
-		uploadID,
-		filepath.Ext(file.Filename),
-	)
+	if err := s.checkQuota(ctx, owner, file.Size); err != nil {
+		return nil, err
+	}
 
-	mtype, err := mimetype.DetectReader(file.File)
+	contentType, err := s.inspect(ctx, kind, file)
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = file.File.Seek(0, io.SeekStart)
+	return s.putObject(ctx, prefix, file, contentType)
+}
+
+// EnforceQuota rejects the files just created in the transaction of ctx
+// when they take the owner over its quota. The storage counters were moved
+// by the creation, so the rows stay locked until the transaction ends and
+// concurrent uploads are checked one after the other.
+func (s *service) EnforceQuota(
+	ctx context.Context,
+	owner UploadOwner,
+) error {
+	return s.checkQuota(ctx, owner, 0)
+}
+
+// RemoveUploads deletes the stored objects of uploads that never made it
+// into a file.
+func (s *service) RemoveUploads(
+	ctx context.Context,
+	uploadInfo []*UploadInfo,
+) error {
+	var errs []error
+	for _, info := range uploadInfo {
+		errs = append(errs, s.removeObject(ctx, info.Bucket, info.Path))
+	}
+	return errors.Join(errs...)
+}
+
+// RemoveFiles deletes the stored objects of deleted files.
+func (s *service) RemoveFiles(
+	ctx context.Context,
+	files []*ent.File,
+) error {
+	var errs []error
+	for _, f := range files {
+		errs = append(errs, s.removeObject(ctx, f.Bucket, f.Path))
+	}
+	return errors.Join(errs...)
+}
+
+func (s *service) StorageUsage(
+	ctx context.Context,
+	userID pulid.ID,
+) (*model.StorageUsage, error) {
+	user, err := s.entClient.User.
+		Query().
+		Where(user.ID(userID)).
+		Select(user.FieldStorageUsed).
+		Only(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	usage := &model.StorageUsage{
+		Used: uint64(max(user.StorageUsed, 0)),
+	}
+	if s.mediaConfig.Quota.User > 0 {
+		quota := uint64(s.mediaConfig.Quota.User)
+		usage.Quota = &quota
+	}
+
+	return usage, nil
+}
+
+func (s *service) Config() config.S3Config {
+	return s.config
+}
+
+// checkQuota rejects pending bytes that would take the owner over its
+// quota.
+func (s *service) checkQuota(
+	ctx context.Context,
+	owner UploadOwner,
+	pending int64,
+) error {
+	repository := db.Client(ctx, s.entClient)
+	quota := s.mediaConfig.Quota
+
+	if owner.UserID != "" && quota.User > 0 {
+		u, err := repository.User.
+			Query().
+			Where(user.ID(owner.UserID)).
+			Select(user.FieldStorageUsed).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		if u.StorageUsed+pending > quota.User {
+			return newQuotaExceededError(QuotaScopeUser, u.StorageUsed, quota.User, pending)
+		}
+	}
+
+	if owner.RoomID != "" && quota.Room > 0 {
+		r, err := repository.Room.
+			Query().
+			Where(room.ID(owner.RoomID)).
+			Select(room.FieldStorageUsed).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		if r.StorageUsed+pending > quota.Room {
+			return newQuotaExceededError(QuotaScopeRoom, r.StorageUsed, quota.Room, pending)
+		}
+	}
+
+	return nil
+}
+
+func newQuotaExceededError(scope string, used, quota, size int64) error {
+	return &gqlerror.Error{
+		Err:     ErrQuotaExceeded,
+		Message: ErrQuotaExceeded.Error(),
+		Extensions: map[string]interface{}{
+			"code":      "QUOTA_EXCEEDED",
+			"scope":     scope,
+			"used":      used,
+			"quota":     quota,
+			"requested": size,
+		},
+	}
+}
+
+// inspect sniffs the content type of an upload, applies the content policy
+// and runs the antivirus scanner. The upload is rewound afterwards.
+func (s *service) inspect(
+	ctx context.Context,
+	kind FileKind,
+	file *graphql.Upload,
+) (string, error) {
+	if _, err := file.File.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	mtype, err := mimetype.DetectReader(file.File)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.policy.Check(kind, file.Filename, mtype.String(), file.Size); err != nil {
+		return "", err
+	}
+
+	if _, err := file.File.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	result, err := s.scanner.Scan(ctx, file.File)
+	if err != nil {
+		return "", err
+	}
+	if result.Infected {
+		return "", newAttachmentInfectedError(file.Filename, result.Signature)
+	}
+
+	if _, err := file.File.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return mtype.String(), nil
+}
+
+func (s *service) putObject(
+	ctx context.Context,
+	prefix string,
+	file *graphql.Upload,
+	contentType string,
+) (*UploadInfo, error) {
+	uploadID := pulid.MustNew(s.uploadIDPrefix)
+
+	objectName := fmt.Sprintf(
+		"%s/%s%s",
+		prefix,
+		uploadID,
+		filepath.Ext(file.Filename),
+	)
+
 	uploadInfo, err := s.minioClient.PutObject(
 		ctx,
 		s.config.Bucket,
@@ -149,7 +411,7 @@ func (s *service) UploadFile(
 		file.File,
 		file.Size,
 		minio.PutObjectOptions{
-			ContentType: mtype.String(),
+			ContentType: contentType,
 		},
 	)
 	if err != nil {
@@ -159,7 +421,7 @@ func (s *service) UploadFile(
 	return &UploadInfo{
 		ID:          uploadID,
 		Filename:    file.Filename,
-		ContentType: mtype.String(),
+		ContentType: contentType,
 		Size:        uploadInfo.Size,
 		Location:    uploadInfo.Location,
 		Bucket:      uploadInfo.Bucket,
@@ -167,6 +429,15 @@ func (s *service) UploadFile(
 	}, nil
 }
 
-func (s *service) Config() config.S3Config {
-	return s.config
+func (s *service) removeObject(
+	ctx context.Context,
+	bucket string,
+	path string,
+) error {
+	return s.minioClient.RemoveObject(
+		ctx,
+		bucket,
+		path,
+		minio.RemoveObjectOptions{},
+	)
 }
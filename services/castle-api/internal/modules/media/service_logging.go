@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"journeyhub/ent"
+	"journeyhub/ent/schema/pulid"
 	"journeyhub/graph/model"
 	"journeyhub/internal/platform/config"
 
@@ -23,35 +25,131 @@ func NewServiceLogging(logger log.Logger, s Service) Service {
 
 func (s *serviceLogging) UploadMessageFiles(
 	ctx context.Context,
+	owner UploadOwner,
 	prefix string,
 	files []*model.UploadMessageFileInput,
-) ([]*UploadInfo, error) {
+) (info []*UploadInfo, err error) {
 	defer func(begin time.Time) {
 		level.Debug(s.logger).Log(
 			"method", "UploadMessageFiles",
 			"host", s.Service.Config().Host,
 			"ssl", s.Service.Config().Ssl,
+			"userID", owner.UserID,
+			"roomID", owner.RoomID,
 			"count", len(files),
 			"took", time.Since(begin),
+			"err", err,
 		)
 	}(time.Now())
-	return s.Service.UploadMessageFiles(ctx, prefix, files)
+	return s.Service.UploadMessageFiles(ctx, owner, prefix, files)
 }
 
 func (s *serviceLogging) UploadFile(
 	ctx context.Context,
+	owner UploadOwner,
+	kind FileKind,
 	prefix string,
 	file *graphql.Upload,
-) (*UploadInfo, error) {
+) (info *UploadInfo, err error) {
 	defer func(begin time.Time) {
 		level.Debug(s.logger).Log(
 			"method", "UploadFile",
 			"host", s.Service.Config().Host,
 			"ssl", s.Service.Config().Ssl,
+			"userID", owner.UserID,
+			"roomID", owner.RoomID,
+			"kind", kind,
 			"took", time.Since(begin),
+			"err", err,
 		)
 	}(time.Now())
-	return s.Service.UploadFile(ctx, prefix, file)
+	return s.Service.UploadFile(ctx, owner, kind, prefix, file)
+}
+
+func (s *serviceLogging) UploadAvatar(
+	ctx context.Context,
+	owner UploadOwner,
+	prefix string,
+	file *graphql.Upload,
+	crop *model.AvatarCropInput,
+) (info []*UploadInfo, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "UploadAvatar",
+			"host", s.Service.Config().Host,
+			"ssl", s.Service.Config().Ssl,
+			"userID", owner.UserID,
+			"roomID", owner.RoomID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.UploadAvatar(ctx, owner, prefix, file, crop)
+}
+
+func (s *serviceLogging) EnforceQuota(
+	ctx context.Context,
+	owner UploadOwner,
+) (err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "EnforceQuota",
+			"userID", owner.UserID,
+			"roomID", owner.RoomID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.EnforceQuota(ctx, owner)
+}
+
+func (s *serviceLogging) RemoveUploads(
+	ctx context.Context,
+	uploadInfo []*UploadInfo,
+) (err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "RemoveUploads",
+			"host", s.Service.Config().Host,
+			"ssl", s.Service.Config().Ssl,
+			"count", len(uploadInfo),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.RemoveUploads(ctx, uploadInfo)
+}
+
+func (s *serviceLogging) RemoveFiles(
+	ctx context.Context,
+	files []*ent.File,
+) (err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "RemoveFiles",
+			"host", s.Service.Config().Host,
+			"ssl", s.Service.Config().Ssl,
+			"count", len(files),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.RemoveFiles(ctx, files)
+}
+
+func (s *serviceLogging) StorageUsage(
+	ctx context.Context,
+	userID pulid.ID,
+) (usage *model.StorageUsage, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "StorageUsage",
+			"userID", userID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.StorageUsage(ctx, userID)
 }
 
 func (s *serviceLogging) Config() (
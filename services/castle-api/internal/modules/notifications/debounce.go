@@ -0,0 +1,82 @@
+package notifications
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/ent/schema/pulid"
+)
+
+// pendingPush is a push waiting for delivery, standing for count
+// notifications of the same collapse group.
+type pendingPush struct {
+	recipient      *ent.User
+	input          SendInput
+	notificationID pulid.ID
+	count          int
+
+	// done is called with the delivery result of every push folded into
+	// this one.
+	done []func(err error)
+}
+
+func (p *pendingPush) finish(err error) {
+	for _, done := range p.done {
+		done(err)
+	}
+}
+
+// debouncer holds the first push of a collapse group for a window and
+// folds the following pushes of the group into it, so a burst of
+// notifications results in a single push.
+type debouncer struct {
+	window time.Duration
+	flush  func(pending *pendingPush)
+
+	mu      sync.Mutex
+	pending map[string]*pendingPush
+}
+
+func newDebouncer(window time.Duration, flush func(pending *pendingPush)) *debouncer {
+	return &debouncer{
+		window:  window,
+		flush:   flush,
+		pending: make(map[string]*pendingPush),
+	}
+}
+
+// add schedules the push and reports whether it was taken over by the
+// debouncer, which is never the case with a zero window.
+func (d *debouncer) add(push *pendingPush) bool {
+	if d.window <= 0 {
+		return false
+	}
+
+	key := fmt.Sprintf("%s:%s:%s", push.recipient.ID, push.input.CollapseKey, push.input.Template)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if pending, ok := d.pending[key]; ok {
+		pending.recipient = push.recipient
+		pending.input = push.input
+		pending.notificationID = push.notificationID
+		pending.count += push.count
+		pending.done = append(pending.done, push.done...)
+		return true
+	}
+
+	d.pending[key] = push
+	time.AfterFunc(d.window, func() {
+		d.mu.Lock()
+		pending := d.pending[key]
+		delete(d.pending, key)
+		d.mu.Unlock()
+
+		d.flush(pending)
+	})
+
+	return true
+}
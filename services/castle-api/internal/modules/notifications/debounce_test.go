@@ -0,0 +1,63 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	"journeyhub/ent"
+)
+
+func TestDebouncer(t *testing.T) {
+	flushed := make(chan *pendingPush, 2)
+	d := newDebouncer(20*time.Millisecond, func(pending *pendingPush) {
+		flushed <- pending
+	})
+
+	alice := &ent.User{ID: "alice"}
+	bob := &ent.User{ID: "bob"}
+	input := SendInput{Template: TemplateNewMessage, CollapseKey: "room"}
+
+	for i := 0; i < 5; i++ {
+		if !d.add(&pendingPush{recipient: alice, input: input, count: 1}) {
+			t.Fatal("expected the push to be debounced")
+		}
+	}
+	d.add(&pendingPush{recipient: bob, input: input, count: 1})
+
+	counts := map[string]int{}
+	for i := 0; i < 2; i++ {
+		select {
+		case pending := <-flushed:
+			counts[string(pending.recipient.ID)] = pending.count
+		case <-time.After(time.Second):
+			t.Fatal("debounced pushes were not flushed")
+		}
+	}
+
+	if counts["alice"] != 5 || counts["bob"] != 1 {
+		t.Fatalf("unexpected counts: %v", counts)
+	}
+
+	if newDebouncer(0, nil).add(&pendingPush{recipient: alice, input: input, count: 1}) {
+		t.Fatal("expected a zero window to disable debouncing")
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{0, time.Second},
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{4, 8 * time.Second},
+		{10, time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := retryBackoff(time.Second, time.Minute, tt.attempt); got != tt.want {
+			t.Errorf("retryBackoff(%d) = %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package notifications
+
+import (
+	"time"
+
+	"journeyhub/ent"
+)
+
+const quietHoursLayout = "15:04"
+
+// inQuietHours reports whether now falls within the quiet hours of the user,
+// in the user's timezone. Quiet hours may span midnight, e.g. 22:00-07:00.
+func inQuietHours(recipient *ent.User, now time.Time) bool {
+	if recipient.QuietHoursStart == nil || recipient.QuietHoursEnd == nil {
+		return false
+	}
+
+	start, err := time.Parse(quietHoursLayout, *recipient.QuietHoursStart)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse(quietHoursLayout, *recipient.QuietHoursEnd)
+	if err != nil {
+		return false
+	}
+
+	location, err := time.LoadLocation(recipient.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	local := now.In(location)
+	minute := local.Hour()*60 + local.Minute()
+	from := start.Hour()*60 + start.Minute()
+	to := end.Hour()*60 + end.Minute()
+
+	if from <= to {
+		return minute >= from && minute < to
+	}
+	return minute >= from || minute < to
+}
@@ -0,0 +1,42 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	"journeyhub/ent"
+)
+
+func TestInQuietHours(t *testing.T) {
+	start, end := "22:00", "07:00"
+	recipient := &ent.User{
+		Timezone:        "Europe/Moscow",
+		QuietHoursStart: &start,
+		QuietHoursEnd:   &end,
+	}
+
+	tests := []struct {
+		utc  string
+		want bool
+	}{
+		{"18:59", false},
+		{"19:00", true},
+		{"23:30", true},
+		{"03:59", true},
+		{"04:00", false},
+	}
+
+	for _, tt := range tests {
+		now, err := time.Parse("2006-01-02 15:04", "2026-10-19 "+tt.utc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := inQuietHours(recipient, now); got != tt.want {
+			t.Errorf("inQuietHours at %s UTC = %v, want %v", tt.utc, got, tt.want)
+		}
+	}
+
+	if inQuietHours(&ent.User{Timezone: "UTC"}, time.Now()) {
+		t.Error("user without quiet hours is in quiet hours")
+	}
+}
@@ -1,62 +1,408 @@
 package notifications
 
 import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/ent/device"
+	"journeyhub/ent/notification"
+	"journeyhub/ent/roommember"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"journeyhub/internal/modules/auth"
 	"journeyhub/internal/platform/config"
+	"journeyhub/internal/platform/db"
+	"journeyhub/internal/platform/nats"
+	"journeyhub/internal/platform/outbox"
+	"journeyhub/internal/platform/push"
+)
 
-	"github.com/appleboy/gorush/rpc/proto"
-	"github.com/nats-io/nats.go/jetstream"
+// SendInput describes a notification addressed to one or more users.
+type SendInput struct {
+	Type     notification.Type
+	UserIDs  []pulid.ID
+	Template Template
+	Params   TemplateParams
+	Data     map[string]string
 
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
-)
+	// CollapseKey groups notifications on the device so only the latest of
+	// a group stays on screen. Notifications of a group sent within the
+	// debounce window are coalesced into one push.
+	CollapseKey string
 
-type Service interface {
-	Connect() error
+	// ContentAvailable wakes the application up to handle the data in the
+	// background, e.g. to ring an incoming call.
+	ContentAvailable bool
+
+	// Silent delivers the pushes quietly, e.g. for messages sent without
+	// notifying. Pushes are also silent during the quiet hours of the
+	// recipient.
+	Silent bool
+
+	// VoIP delivers the notification through PushKit to iOS devices that
+	// registered a VoIP token.
+	VoIP bool
 
-	Client() proto.GorushClient
+	// Transient notifications only carry signaling data to the devices,
+	// they have no template and are neither stored in the inbox nor
+	// published to subscribers.
+	Transient bool
 
+	// InboxOnly notifications are stored and published to subscribers
+	// without a push, for recipients that were reached otherwise.
+	InboxOnly bool
+}
+
+type Service interface {
 	Config() config.NotificationsConfig
 
-	Close() error
+	Send(
+		ctx context.Context,
+		input SendInput,
+	) ([]*ent.Notification, error)
+
+	MarkNotificationsRead(
+		ctx context.Context,
+		notificationIDs []pulid.ID,
+	) (int, error)
+
+	UnreadNotificationsCount(
+		ctx context.Context,
+	) (int, error)
+
+	// ReportPushFeedback records a push failure reported by the provider
+	// after delivery, invalidating the device token if it is gone.
+	ReportPushFeedback(
+		ctx context.Context,
+		feedback *push.Feedback,
+	) error
+
+	// StartPushWorker starts delivering the queued pushes until ctx is
+	// done.
+	StartPushWorker(ctx context.Context) error
+
+	Subscriptions() Subscriptions
 }
 
 type service struct {
-	config    config.NotificationsConfig
-	conn      *grpc.ClientConn
-	client    proto.GorushClient
-	jetStream *jetstream.JetStream
+	config        config.NotificationsConfig
+	entClient     *ent.Client
+	subscriptions Subscriptions
+	authService   auth.Service
+	natsService   nats.Service
+	outboxService outbox.Service
+	pushSender    push.Sender
+	debouncer     *debouncer
 }
 
-func NewService(config config.NotificationsConfig) Service {
-	return &service{
-		config: config,
+func NewService(
+	config config.NotificationsConfig,
+	entClient *ent.Client,
+	subscriptions Subscriptions,
+	authService auth.Service,
+	natsService nats.Service,
+	outboxService outbox.Service,
+	pushSender push.Sender,
+) Service {
+	s := &service{
+		config:        config,
+		entClient:     entClient,
+		subscriptions: subscriptions,
+		authService:   authService,
+		natsService:   natsService,
+		outboxService: outboxService,
+		pushSender:    pushSender,
 	}
+	s.debouncer = newDebouncer(config.Debounce, func(pending *pendingPush) {
+		pending.finish(s.deliver(context.Background(), pending))
+	})
+	return s
+}
+
+func (s *service) Config() config.NotificationsConfig {
+	return s.config
 }
 
-func (s *service) Connect() error {
-	conn, err := grpc.NewClient(
-		s.config.Host,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+func (s *service) Send(
+	ctx context.Context,
+	input SendInput,
+) ([]*ent.Notification, error) {
+	if len(input.UserIDs) == 0 {
+		return nil, nil
+	}
+
+	repository := db.Client(ctx, s.entClient)
+
+	recipients, err := repository.User.
+		Query().
+		Where(user.IDIn(input.UserIDs...)).
+		WithDevice().
+		All(ctx)
 	if err != nil {
+		return nil, err
+	}
+
+	var notifications []*ent.Notification
+	if !input.Transient {
+		titles := make([]string, len(recipients))
+		bodies := make([]string, len(recipients))
+		for i, recipient := range recipients {
+			titles[i], bodies[i], err = render(recipient, input, 1)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		data := make(map[string]interface{}, len(input.Data))
+		for key, value := range input.Data {
+			data[key] = value
+		}
+
+		notifications, err = repository.Notification.MapCreateBulk(
+			recipients,
+			func(c *ent.NotificationCreate, i int) {
+				c.SetType(input.Type).
+					SetTitle(titles[i]).
+					SetBody(bodies[i]).
+					SetData(data).
+					SetUser(recipients[i])
+			},
+		).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, n := range notifications {
+			userID, notificationID := recipients[i].ID, n.ID
+			db.AfterCommit(ctx, func() {
+				s.subscriptions.PublishNotificationCreatedEvent(ctx, userID, notificationID)
+			})
+		}
+	}
+
+	if input.InboxOnly {
+		return notifications, nil
+	}
+
+	for i, recipient := range recipients {
+		if !pushable(recipient.Edges.Device) {
+			continue
+		}
+
+		job := pushJob{
+			RecipientID: recipient.ID,
+			Input:       input,
+		}
+		if notifications != nil {
+			job.NotificationID = notifications[i].ID
+		}
+
+		err = s.enqueuePushJob(ctx, job)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return notifications, nil
+}
+
+// deliver pushes a notification to the device of its recipient, rendering
+// the template for the number of coalesced notifications.
+func (s *service) deliver(
+	ctx context.Context,
+	pending *pendingPush,
+) error {
+	recipient, input := pending.recipient, pending.input
+	userDevice := recipient.Edges.Device
+
+	message := &push.Message{
+		Platform:         push.Platform(userDevice.Platform),
+		Token:            userDevice.FcmToken,
+		Data:             make(map[string]string, len(input.Data)+1),
+		CollapseKey:      input.CollapseKey,
+		ContentAvailable: input.ContentAvailable,
+	}
+	for key, value := range input.Data {
+		message.Data[key] = value
+	}
+
+	if !input.Transient {
+		title, body, err := render(recipient, input, pending.count)
+		if err != nil {
+			return err
+		}
+
+		badge, err := s.unreadMessagesCount(ctx, recipient.ID)
+		if err != nil {
+			return err
+		}
+
+		message.Title = title
+		message.Body = body
+		message.Badge = badge
+		message.Data["notificationID"] = string(pending.notificationID)
+	}
+
+	if input.VoIP && userDevice.Platform == device.PlatformIOS && userDevice.VoipToken != "" {
+		message.Token = userDevice.VoipToken
+		message.VoIP = true
+	}
+
+	if !message.VoIP {
+		message.Silent = input.Silent || inQuietHours(recipient, time.Now())
+	}
+
+	err := s.pushSender.Send(ctx, message)
+	if rErr := s.recordPushResult(ctx, userDevice, message, err); rErr != nil {
+		return errors.Join(err, rErr)
+	}
+
+	// Retrying a push to a dead token is pointless, the token has been
+	// invalidated instead.
+	if errors.Is(err, push.ErrInvalidToken) {
+		return nil
+	}
+
+	return err
+}
+
+// recordPushResult keeps the outcome of the last push on the device, and
+// drops the token the push was rejected for.
+func (s *service) recordPushResult(
+	ctx context.Context,
+	userDevice *ent.Device,
+	message *push.Message,
+	err error,
+) error {
+	update := s.entClient.Device.
+		UpdateOneID(userDevice.ID).
+		SetLastPushAt(time.Now())
+
+	switch {
+	case err == nil:
+		update.ClearLastPushError()
+	case errors.Is(err, push.ErrInvalidToken) && message.VoIP:
+		update.SetLastPushError(err.Error()).ClearVoipToken()
+	case errors.Is(err, push.ErrInvalidToken):
+		update.SetLastPushError(err.Error()).SetInvalidatedAt(time.Now())
+	default:
+		update.SetLastPushError(err.Error())
+	}
+
+	return update.Exec(ctx)
+}
+
+func (s *service) ReportPushFeedback(
+	ctx context.Context,
+	feedback *push.Feedback,
+) error {
+	now := time.Now()
+
+	update := s.entClient.Device.
+		Update().
+		Where(device.FcmToken(feedback.Token)).
+		SetLastPushAt(now).
+		SetLastPushError(feedback.Error)
+	if feedback.InvalidToken() {
+		update.SetInvalidatedAt(now)
+	}
+
+	_, err := update.Save(ctx)
+	if err != nil || !feedback.InvalidToken() {
 		return err
 	}
-	s.conn = conn
 
-	client := proto.NewGorushClient(conn)
-	s.client = client
+	_, err = s.entClient.Device.
+		Update().
+		Where(device.VoipToken(feedback.Token)).
+		SetLastPushAt(now).
+		SetLastPushError(feedback.Error).
+		ClearVoipToken().
+		Save(ctx)
+	return err
+}
 
-	return nil
+// pushable reports whether the device still has a valid token.
+func pushable(userDevice *ent.Device) bool {
+	return userDevice != nil && userDevice.FcmToken != "" && userDevice.InvalidatedAt == nil
 }
 
-func (s *service) Client() proto.GorushClient {
-	return s.client
+// unreadMessagesCount sums the unread messages of all rooms of the user.
+func (s *service) unreadMessagesCount(
+	ctx context.Context,
+	userID pulid.ID,
+) (int, error) {
+	var v []struct {
+		Sum sql.NullInt64 `json:"sum"`
+	}
+
+	err := s.entClient.RoomMember.
+		Query().
+		Where(roommember.UserID(userID)).
+		Aggregate(ent.Sum(roommember.FieldUnreadMessagesCount)).
+		Scan(ctx, &v)
+	if err != nil || len(v) == 0 {
+		return 0, err
+	}
+
+	return int(v[0].Sum.Int64), nil
 }
 
-func (s *service) Config() config.NotificationsConfig {
-	return s.config
+func render(recipient *ent.User, input SendInput, count int) (string, string, error) {
+	params := input.Params
+	params.Count = count
+	if !recipient.MessagePreviews {
+		params.Preview = ""
+	}
+	return Render(recipient.Locale, input.Template, params)
+}
+
+func (s *service) MarkNotificationsRead(
+	ctx context.Context,
+	notificationIDs []pulid.ID,
+) (int, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	repository := s.entClient
+
+	update := repository.Notification.
+		Update().
+		Where(
+			notification.HasUserWith(user.ID(currentUserID)),
+			notification.ReadAtIsNil(),
+		)
+	if notificationIDs != nil {
+		update.Where(notification.IDIn(notificationIDs...))
+	}
+
+	return update.
+		SetReadAt(time.Now()).
+		Save(ctx)
+}
+
+func (s *service) UnreadNotificationsCount(
+	ctx context.Context,
+) (int, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.entClient.Notification.
+		Query().
+		Where(
+			notification.HasUserWith(user.ID(currentUserID)),
+			notification.ReadAtIsNil(),
+		).
+		Count(ctx)
 }
 
-func (s *service) Close() error {
-	return s.conn.Close()
+func (s *service) Subscriptions() Subscriptions {
+	return s.subscriptions
 }
@@ -1,11 +1,14 @@
 package notifications
 
 import (
+	"context"
 	"time"
 
+	"journeyhub/ent"
+	"journeyhub/ent/schema/pulid"
 	"journeyhub/internal/platform/config"
+	"journeyhub/internal/platform/push"
 
-	"github.com/appleboy/gorush/rpc/proto"
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
 )
@@ -19,44 +22,87 @@ func NewServiceLogging(logger log.Logger, s Service) Service {
 	return &serviceLogging{logger, s}
 }
 
-func (s *serviceLogging) Connect() (err error) {
+func (s *serviceLogging) Config() (config config.NotificationsConfig) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "Config",
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	return s.Service.Config()
+}
+
+func (s *serviceLogging) Send(
+	ctx context.Context,
+	input SendInput,
+) (notifications []*ent.Notification, err error) {
 	defer func(begin time.Time) {
 		level.Debug(s.logger).Log(
-			"method", "Connect",
+			"method", "Send",
+			"type", input.Type,
+			"template", input.Template,
+			"recipients", len(input.UserIDs),
+			"transient", input.Transient,
 			"took", time.Since(begin),
 			"err", err,
 		)
 	}(time.Now())
-	return s.Service.Connect()
+	return s.Service.Send(ctx, input)
 }
 
-func (s *serviceLogging) Client() (client proto.GorushClient) {
+func (s *serviceLogging) ReportPushFeedback(
+	ctx context.Context,
+	feedback *push.Feedback,
+) (err error) {
 	defer func(begin time.Time) {
 		level.Debug(s.logger).Log(
-			"method", "Client",
+			"method", "ReportPushFeedback",
+			"platform", feedback.Platform,
+			"reason", feedback.Error,
 			"took", time.Since(begin),
+			"err", err,
 		)
 	}(time.Now())
-	return s.Service.Client()
+	return s.Service.ReportPushFeedback(ctx, feedback)
 }
 
-func (s *serviceLogging) Config() (config config.NotificationsConfig) {
+func (s *serviceLogging) StartPushWorker(
+	ctx context.Context,
+) (err error) {
 	defer func(begin time.Time) {
 		level.Debug(s.logger).Log(
-			"method", "Config",
+			"method", "StartPushWorker",
 			"took", time.Since(begin),
+			"err", err,
 		)
 	}(time.Now())
-	return s.Service.Config()
+	return s.Service.StartPushWorker(ctx)
+}
+
+func (s *serviceLogging) MarkNotificationsRead(
+	ctx context.Context,
+	notificationIDs []pulid.ID,
+) (count int, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "MarkNotificationsRead",
+			"count", count,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.MarkNotificationsRead(ctx, notificationIDs)
 }
 
-func (s *serviceLogging) Close() (err error) {
+func (s *serviceLogging) UnreadNotificationsCount(
+	ctx context.Context,
+) (count int, err error) {
 	defer func(begin time.Time) {
 		level.Debug(s.logger).Log(
-			"method", "Close",
+			"method", "UnreadNotificationsCount",
 			"took", time.Since(begin),
 			"err", err,
 		)
 	}(time.Now())
-	return s.Service.Close()
+	return s.Service.UnreadNotificationsCount(ctx)
 }
@@ -0,0 +1,158 @@
+package notifications
+
+import (
+	"context"
+	"testing"
+
+	"journeyhub/ent"
+	"journeyhub/ent/device"
+	"journeyhub/ent/enttest"
+	"journeyhub/ent/room"
+	"journeyhub/internal/platform/push"
+
+	_ "journeyhub/ent/runtime"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestDeliver(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   SendInput
+		sendErr error
+
+		wantToken       string
+		wantVoIP        bool
+		wantBadge       int
+		wantInvalidated bool
+		wantVoipToken   string
+	}{
+		{
+			name:          "badge of the unread messages",
+			input:         SendInput{Template: TemplateNewMessage, Params: TemplateParams{SenderName: "Alice"}},
+			wantToken:     "fcm-token",
+			wantBadge:     5,
+			wantVoipToken: "voip-token",
+		},
+		{
+			name:          "voip through pushkit",
+			input:         SendInput{Template: TemplateIncomingCall, Params: TemplateParams{SenderName: "Alice"}, VoIP: true},
+			wantToken:     "voip-token",
+			wantVoIP:      true,
+			wantBadge:     5,
+			wantVoipToken: "voip-token",
+		},
+		{
+			name:            "invalid token is invalidated",
+			input:           SendInput{Transient: true},
+			sendErr:         push.ErrInvalidToken,
+			wantInvalidated: true,
+			wantVoipToken:   "voip-token",
+		},
+		{
+			name:    "invalid voip token is dropped",
+			input:   SendInput{Transient: true, VoIP: true},
+			sendErr: push.ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+			defer client.Close()
+
+			sender := push.NewFakeSender()
+			if tt.sendErr != nil {
+				sender.FailWith(tt.sendErr)
+			}
+			s := &service{
+				entClient:  client,
+				pushSender: sender,
+			}
+
+			recipient := seedRecipient(t, client, 2, 3)
+
+			err := s.deliver(ctx, &pendingPush{
+				recipient:      recipient,
+				input:          tt.input,
+				notificationID: "NO01",
+				count:          1,
+			})
+			if err != nil {
+				t.Fatalf("deliver: %v", err)
+			}
+
+			if tt.sendErr == nil {
+				sent := sender.Sent()
+				if len(sent) != 1 {
+					t.Fatalf("expected one push, got %d", len(sent))
+				}
+				message := sent[0]
+				if message.Token != tt.wantToken || message.VoIP != tt.wantVoIP {
+					t.Errorf("pushed to %q (voip %v), want %q (voip %v)", message.Token, message.VoIP, tt.wantToken, tt.wantVoIP)
+				}
+				if message.Badge != tt.wantBadge {
+					t.Errorf("badge = %d, want %d", message.Badge, tt.wantBadge)
+				}
+				if message.Data["notificationID"] != "NO01" {
+					t.Errorf("notificationID = %q", message.Data["notificationID"])
+				}
+			}
+
+			d := client.Device.GetX(ctx, recipient.Edges.Device.ID)
+			if d.LastPushAt == nil {
+				t.Error("expected the push to be recorded")
+			}
+			if (d.LastPushError != nil) != (tt.sendErr != nil) {
+				t.Errorf("last push error = %v, want %v", d.LastPushError, tt.sendErr)
+			}
+			if (d.InvalidatedAt != nil) != tt.wantInvalidated {
+				t.Errorf("invalidated at = %v, want invalidated %v", d.InvalidatedAt, tt.wantInvalidated)
+			}
+			if d.VoipToken != tt.wantVoipToken {
+				t.Errorf("voip token = %q, want %q", d.VoipToken, tt.wantVoipToken)
+			}
+		})
+	}
+}
+
+// seedRecipient stores an iOS user with the given unread messages in each
+// of their rooms.
+func seedRecipient(t *testing.T, client *ent.Client, unread ...int) *ent.User {
+	t.Helper()
+	ctx := context.Background()
+
+	u := client.User.
+		Create().
+		SetFirstName("Bob").
+		SetLastName("Jones").
+		SetNickname("bobjones").
+		SetPassword("password").
+		SetLocale("en").
+		SaveX(ctx)
+
+	for _, count := range unread {
+		r := client.Room.
+			Create().
+			SetType(room.TypeGroup).
+			SaveX(ctx)
+		client.RoomMember.
+			Create().
+			SetRoomID(r.ID).
+			SetUserID(u.ID).
+			SetUnreadMessagesCount(count).
+			ExecX(ctx)
+	}
+
+	u.Edges.Device = client.Device.
+		Create().
+		SetDeviceID("device").
+		SetFcmToken("fcm-token").
+		SetVoipToken("voip-token").
+		SetPlatform(device.PlatformIOS).
+		SetUser(u).
+		SaveX(ctx)
+
+	return u
+}
@@ -0,0 +1,89 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+
+	"journeyhub/ent"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/internal/modules/auth"
+	"journeyhub/internal/platform/nats"
+)
+
+type Subscriptions interface {
+	PublishNotificationCreatedEvent(
+		ctx context.Context,
+		userID pulid.ID,
+		notificationID pulid.ID,
+	) (string, error)
+
+	SubscribeToNotificationCreatedEvent(
+		ctx context.Context,
+	) (<-chan *ent.NotificationEdge, error)
+}
+
+type subscriptions struct {
+	entClient   *ent.Client
+	authService auth.Service
+	natsService nats.Service
+}
+
+func NewSubscriptions(
+	entClient *ent.Client,
+	authService auth.Service,
+	natsService nats.Service,
+) Subscriptions {
+	return &subscriptions{
+		entClient:   entClient,
+		authService: authService,
+		natsService: natsService,
+	}
+}
+
+func (s *subscriptions) PublishNotificationCreatedEvent(
+	ctx context.Context,
+	userID pulid.ID,
+	notificationID pulid.ID,
+) (string, error) {
+	natsClient := s.natsService.Client()
+
+	subject := fmt.Sprintf("users.%s.notifications.created", userID)
+	if err := natsClient.Publish(subject, notificationID); err != nil {
+		return "", err
+	}
+
+	return subject, nil
+}
+
+func (s *subscriptions) SubscribeToNotificationCreatedEvent(
+	ctx context.Context,
+) (<-chan *ent.NotificationEdge, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subject := fmt.Sprintf("users.%s.notifications.created", currentUserID)
+
+	natsClient := s.natsService.Client()
+
+	ch := make(chan *ent.NotificationEdge, 1)
+
+	sub, err := natsClient.Subscribe(subject, func(notificationID pulid.ID) {
+		n, err := s.entClient.Notification.Get(ctx, notificationID)
+		if err != nil {
+			return
+		}
+		ch <- n.ToEdge(ent.DefaultNotificationOrder)
+	})
+	if err != nil {
+		return ch, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		sub.Unsubscribe()
+	}()
+
+	return ch, nil
+}
@@ -0,0 +1,50 @@
+package notifications
+
+import (
+	"context"
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/ent/schema/pulid"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+type subscriptionsLogging struct {
+	logger log.Logger
+	Subscriptions
+}
+
+func NewSubscriptionsLogging(logger log.Logger, s Subscriptions) Subscriptions {
+	return &subscriptionsLogging{logger, s}
+}
+
+func (s *subscriptionsLogging) PublishNotificationCreatedEvent(
+	ctx context.Context,
+	userID pulid.ID,
+	notificationID pulid.ID,
+) (subject string, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "PublishNotificationCreatedEvent",
+			"subject", subject,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Subscriptions.PublishNotificationCreatedEvent(ctx, userID, notificationID)
+}
+
+func (s *subscriptionsLogging) SubscribeToNotificationCreatedEvent(
+	ctx context.Context,
+) (ch <-chan *ent.NotificationEdge, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "SubscribeToNotificationCreatedEvent",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Subscriptions.SubscribeToNotificationCreatedEvent(ctx)
+}
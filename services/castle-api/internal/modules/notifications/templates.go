@@ -0,0 +1,207 @@
+package notifications
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+)
+
+type Template string
+
+const (
+	TemplateNewMessage      Template = "new_message"
+	TemplateMention         Template = "mention"
+	TemplateIncomingCall    Template = "incoming_call"
+	TemplateMissedCall      Template = "missed_call"
+	TemplateContactAdded    Template = "contact_added"
+	TemplateContactRequest  Template = "contact_request"
+	TemplateContactAccepted Template = "contact_accepted"
+)
+
+// DefaultLocale is used for users whose locale has no catalogue.
+const DefaultLocale = "en"
+
+const previewLength = 100
+
+// TemplateParams holds the values available to the notification templates.
+type TemplateParams struct {
+	SenderName string
+	RoomName   string
+	Preview    string
+	CallType   string
+	Count      int
+}
+
+type catalogueEntry struct {
+	title string
+	body  string
+}
+
+var catalogue = map[string]map[Template]catalogueEntry{
+	"en": {
+		TemplateNewMessage: {
+			title: `{{if .RoomName}}{{.RoomName}}{{else}}{{.SenderName}}{{end}}`,
+			body: `{{if and .Preview (eq .Count 1)}}{{if .RoomName}}{{.SenderName}}: {{end}}{{.Preview}}` +
+				`{{else}}{{.Count}} new {{plural .Count "message" "messages"}}{{end}}`,
+		},
+		TemplateMention: {
+			title: `{{if .RoomName}}{{.RoomName}}{{else}}{{.SenderName}}{{end}}`,
+			body:  `{{.SenderName}} mentioned you{{if .Preview}}: {{.Preview}}{{end}}`,
+		},
+		TemplateIncomingCall: {
+			title: `{{.SenderName}}`,
+			body:  `Incoming {{if eq .CallType "Video"}}video{{else}}audio{{end}} call`,
+		},
+		TemplateMissedCall: {
+			title: `{{.SenderName}}`,
+			body: `{{if eq .Count 1}}Missed {{if eq .CallType "Video"}}video{{else}}audio{{end}} call` +
+				`{{else}}{{.Count}} missed {{plural .Count "call" "calls"}}{{end}}`,
+		},
+		TemplateContactAdded: {
+			title: `{{.SenderName}}`,
+			body:  `{{.SenderName}} added you to contacts`,
+		},
+		TemplateContactRequest: {
+			title: `{{.SenderName}}`,
+			body:  `{{.SenderName}} wants to add you to contacts`,
+		},
+		TemplateContactAccepted: {
+			title: `{{.SenderName}}`,
+			body:  `{{.SenderName}} accepted your contact request`,
+		},
+	},
+	"ru": {
+		TemplateNewMessage: {
+			title: `{{if .RoomName}}{{.RoomName}}{{else}}{{.SenderName}}{{end}}`,
+			body: `{{if and .Preview (eq .Count 1)}}{{if .RoomName}}{{.SenderName}}: {{end}}{{.Preview}}` +
+				`{{else}}{{.Count}} {{plural .Count "новое сообщение" "новых сообщения" "новых сообщений"}}{{end}}`,
+		},
+		TemplateMention: {
+			title: `{{if .RoomName}}{{.RoomName}}{{else}}{{.SenderName}}{{end}}`,
+			body:  `{{.SenderName}} упомянул(а) вас{{if .Preview}}: {{.Preview}}{{end}}`,
+		},
+		TemplateIncomingCall: {
+			title: `{{.SenderName}}`,
+			body:  `Входящий {{if eq .CallType "Video"}}видеозвонок{{else}}аудиозвонок{{end}}`,
+		},
+		TemplateMissedCall: {
+			title: `{{.SenderName}}`,
+			body: `{{if eq .Count 1}}Пропущенный {{if eq .CallType "Video"}}видеозвонок{{else}}аудиозвонок{{end}}` +
+				`{{else}}{{.Count}} {{plural .Count "пропущенный звонок" "пропущенных звонка" "пропущенных звонков"}}{{end}}`,
+		},
+		TemplateContactAdded: {
+			title: `{{.SenderName}}`,
+			body:  `{{.SenderName}} добавил(а) вас в контакты`,
+		},
+		TemplateContactRequest: {
+			title: `{{.SenderName}}`,
+			body:  `{{.SenderName}} хочет добавить вас в контакты`,
+		},
+		TemplateContactAccepted: {
+			title: `{{.SenderName}}`,
+			body:  `{{.SenderName}} принял(а) ваш запрос в контакты`,
+		},
+	},
+}
+
+// pluralRules select the index of the plural form for n, following the
+// CLDR cardinal rules of each locale.
+var pluralRules = map[string]func(n int) int{
+	"en": func(n int) int {
+		if n == 1 {
+			return 0
+		}
+		return 1
+	},
+	"ru": func(n int) int {
+		switch {
+		case n%10 == 1 && n%100 != 11:
+			return 0
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+			return 1
+		default:
+			return 2
+		}
+	},
+}
+
+type localizedTemplate struct {
+	title *template.Template
+	body  *template.Template
+}
+
+var templates = parseCatalogue()
+
+func parseCatalogue() map[string]map[Template]localizedTemplate {
+	parsed := make(map[string]map[Template]localizedTemplate, len(catalogue))
+	for locale, entries := range catalogue {
+		funcs := template.FuncMap{
+			"plural": pluralFunc(pluralRules[locale]),
+		}
+
+		parsed[locale] = make(map[Template]localizedTemplate, len(entries))
+		for name, entry := range entries {
+			parsed[locale][name] = localizedTemplate{
+				title: template.Must(template.New(string(name)).Funcs(funcs).Parse(entry.title)),
+				body:  template.Must(template.New(string(name)).Funcs(funcs).Parse(entry.body)),
+			}
+		}
+	}
+	return parsed
+}
+
+func pluralFunc(rule func(n int) int) func(n int, forms ...string) string {
+	return func(n int, forms ...string) string {
+		if len(forms) == 0 {
+			return ""
+		}
+		i := rule(n)
+		if i >= len(forms) {
+			i = len(forms) - 1
+		}
+		return forms[i]
+	}
+}
+
+// Render renders the title and the body of a notification in the given
+// locale, falling back to DefaultLocale.
+func Render(locale string, name Template, params TemplateParams) (string, string, error) {
+	localized, ok := templates[matchLocale(locale)][name]
+	if !ok {
+		return "", "", fmt.Errorf("unknown notification template %q", name)
+	}
+
+	if params.Count == 0 {
+		params.Count = 1
+	}
+
+	var title, body strings.Builder
+	if err := localized.title.Execute(&title, params); err != nil {
+		return "", "", err
+	}
+	if err := localized.body.Execute(&body, params); err != nil {
+		return "", "", err
+	}
+
+	return title.String(), body.String(), nil
+}
+
+// matchLocale reduces a locale such as "ru-RU" to a catalogue language.
+func matchLocale(locale string) string {
+	language, _, _ := strings.Cut(strings.ToLower(locale), "-")
+	language, _, _ = strings.Cut(language, "_")
+	if _, ok := catalogue[language]; ok {
+		return language
+	}
+	return DefaultLocale
+}
+
+// MessagePreview shortens message content for a notification body.
+func MessagePreview(content string) string {
+	content = strings.Join(strings.Fields(content), " ")
+	if utf8.RuneCountInString(content) <= previewLength {
+		return content
+	}
+	return string([]rune(content)[:previewLength]) + "…"
+}
@@ -0,0 +1,91 @@
+package notifications
+
+import "testing"
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name   string
+		locale string
+		tpl    Template
+		params TemplateParams
+		title  string
+		body   string
+	}{
+		{
+			name:   "personal message preview",
+			locale: "en",
+			tpl:    TemplateNewMessage,
+			params: TemplateParams{SenderName: "Alice", Preview: "hi"},
+			title:  "Alice",
+			body:   "hi",
+		},
+		{
+			name:   "group message preview",
+			locale: "en-US",
+			tpl:    TemplateNewMessage,
+			params: TemplateParams{SenderName: "Alice", RoomName: "Team", Preview: "hi"},
+			title:  "Team",
+			body:   "Alice: hi",
+		},
+		{
+			name:   "previews disabled",
+			locale: "ru",
+			tpl:    TemplateNewMessage,
+			params: TemplateParams{SenderName: "Алиса"},
+			title:  "Алиса",
+			body:   "1 новое сообщение",
+		},
+		{
+			name:   "russian few",
+			locale: "ru_RU",
+			tpl:    TemplateNewMessage,
+			params: TemplateParams{SenderName: "Алиса", Preview: "привет", Count: 3},
+			body:   "3 новых сообщения",
+			title:  "Алиса",
+		},
+		{
+			name:   "russian many",
+			locale: "ru",
+			tpl:    TemplateMissedCall,
+			params: TemplateParams{SenderName: "Алиса", Count: 12},
+			title:  "Алиса",
+			body:   "12 пропущенных звонков",
+		},
+		{
+			name:   "russian one after eleven",
+			locale: "ru",
+			tpl:    TemplateMissedCall,
+			params: TemplateParams{SenderName: "Алиса", Count: 21},
+			title:  "Алиса",
+			body:   "21 пропущенный звонок",
+		},
+		{
+			name:   "contact request",
+			locale: "ru",
+			tpl:    TemplateContactRequest,
+			params: TemplateParams{SenderName: "Алиса"},
+			title:  "Алиса",
+			body:   "Алиса хочет добавить вас в контакты",
+		},
+		{
+			name:   "unknown locale falls back",
+			locale: "de",
+			tpl:    TemplateIncomingCall,
+			params: TemplateParams{SenderName: "Alice", CallType: "Video"},
+			title:  "Alice",
+			body:   "Incoming video call",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			title, body, err := Render(tt.locale, tt.tpl, tt.params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if title != tt.title || body != tt.body {
+				t.Fatalf("got %q / %q, want %q / %q", title, body, tt.title, tt.body)
+			}
+		})
+	}
+}
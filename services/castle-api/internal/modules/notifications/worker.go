@@ -0,0 +1,194 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	pushJobsStream     = "NOTIFICATIONS"
+	pushJobsSubject    = "notifications.push"
+	pushJobsConsumer   = "push-worker"
+	deadLetterStream   = "NOTIFICATIONS_DEAD"
+	deadLetterSubject  = "notifications.push.dead"
+	deadLetterMaxAge   = 14 * 24 * time.Hour
+	pushJobsAckWait    = 30 * time.Second
+	pushJobsDuplicates = 10 * time.Minute
+)
+
+// pushJob is the delivery of a notification to the device of one
+// recipient, queued through the outbox.
+type pushJob struct {
+	RecipientID    pulid.ID  `json:"recipientID"`
+	NotificationID pulid.ID  `json:"notificationID,omitempty"`
+	Input          SendInput `json:"input"`
+}
+
+func (s *service) enqueuePushJob(ctx context.Context, job pushJob) error {
+	payload, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return s.outboxService.Enqueue(ctx, pushJobsSubject, payload)
+}
+
+func (s *service) StartPushWorker(ctx context.Context) error {
+	js := *s.natsService.JetStream()
+
+	stream, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:       pushJobsStream,
+		Subjects:   []string{pushJobsSubject},
+		Retention:  jetstream.WorkQueuePolicy,
+		Duplicates: pushJobsDuplicates,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     deadLetterStream,
+		Subjects: []string{deadLetterSubject},
+		MaxAge:   deadLetterMaxAge,
+	})
+	if err != nil {
+		return err
+	}
+
+	consumer, err := stream.CreateOrUpdateConsumer(ctx, jetstream.ConsumerConfig{
+		Durable:   pushJobsConsumer,
+		AckPolicy: jetstream.AckExplicitPolicy,
+		AckWait:   pushJobsAckWait + s.config.Debounce,
+	})
+	if err != nil {
+		return err
+	}
+
+	consumeContext, err := consumer.Consume(func(msg jetstream.Msg) {
+		s.handlePushJob(ctx, msg)
+	})
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		consumeContext.Stop()
+	}()
+
+	return nil
+}
+
+func (s *service) handlePushJob(ctx context.Context, msg jetstream.Msg) {
+	var job pushJob
+	if err := json.Unmarshal(msg.Data(), &job); err != nil {
+		s.deadLetter(ctx, msg, err)
+		return
+	}
+
+	recipient, err := s.entClient.User.
+		Query().
+		Where(user.ID(job.RecipientID)).
+		WithDevice().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		msg.Ack()
+		return
+	}
+	if err != nil {
+		s.finishPushJob(ctx, msg, err)
+		return
+	}
+
+	// The device may have logged out since the job was queued.
+	if !pushable(recipient.Edges.Device) {
+		msg.Ack()
+		return
+	}
+
+	pending := &pendingPush{
+		recipient:      recipient,
+		input:          job.Input,
+		notificationID: job.NotificationID,
+		count:          1,
+		done: []func(err error){
+			func(err error) {
+				s.finishPushJob(ctx, msg, err)
+			},
+		},
+	}
+
+	if !job.Input.Transient && job.Input.CollapseKey != "" && s.debouncer.add(pending) {
+		return
+	}
+
+	pending.finish(s.deliver(ctx, pending))
+}
+
+// finishPushJob acknowledges a delivered job, or schedules its redelivery
+// with an exponential backoff until the attempts are exhausted.
+func (s *service) finishPushJob(ctx context.Context, msg jetstream.Msg, err error) {
+	if err == nil {
+		msg.Ack()
+		return
+	}
+
+	metadata, mErr := msg.Metadata()
+	if mErr != nil {
+		msg.Nak()
+		return
+	}
+
+	attempt := int(metadata.NumDelivered)
+	if attempt >= s.config.Retry.Attempts {
+		s.deadLetter(ctx, msg, err)
+		return
+	}
+
+	msg.NakWithDelay(retryBackoff(s.config.Retry.Backoff, s.config.Retry.MaxBackoff, attempt))
+}
+
+// deadLetter moves a job that cannot be delivered to the dead-letter
+// subject, keeping the last error in the headers.
+func (s *service) deadLetter(ctx context.Context, msg jetstream.Msg, err error) {
+	js := *s.natsService.JetStream()
+
+	deadMsg := nats.NewMsg(deadLetterSubject)
+	deadMsg.Data = msg.Data()
+	deadMsg.Header.Set("Castle-Error", err.Error())
+	if metadata, mErr := msg.Metadata(); mErr == nil {
+		deadMsg.Header.Set("Castle-Attempts", strconv.FormatUint(metadata.NumDelivered, 10))
+	}
+
+	if _, pErr := js.PublishMsg(ctx, deadMsg); pErr != nil {
+		msg.NakWithDelay(s.config.Retry.MaxBackoff)
+		return
+	}
+
+	msg.Term()
+}
+
+// retryBackoff doubles the delay after each attempt, up to max.
+func retryBackoff(initial, max time.Duration, attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	delay := initial
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= max {
+			return max
+		}
+	}
+
+	return delay
+}
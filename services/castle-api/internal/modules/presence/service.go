@@ -0,0 +1,458 @@
+package presence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/ent/predicate"
+	"journeyhub/ent/room"
+	"journeyhub/ent/roommember"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"journeyhub/ent/usercontact"
+	"journeyhub/graph/model"
+	"journeyhub/internal/modules/auth"
+	"journeyhub/internal/modules/contacts"
+	"journeyhub/internal/platform/config"
+	"journeyhub/internal/platform/nats"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+var ErrNotConnected = errors.New("connection is not tracked")
+
+const (
+	connectionIDPrefix = "CN"
+	// presenceChangesBuffer bounds the changes waiting for a slow
+	// subscriber, later ones are dropped.
+	presenceChangesBuffer = 16
+	// disconnectTimeout bounds the cleanup of a closed connection.
+	disconnectTimeout = 5 * time.Second
+	// blockingRefresh is how long a subscription trusts the peers it knows
+	// to block the current user.
+	blockingRefresh = 30 * time.Second
+)
+
+type Service interface {
+	// Start creates the presence bucket and keeps the view of the open
+	// connections up to date until ctx is done.
+	Start(ctx context.Context) error
+
+	// Connect tracks the connection of the current user and returns the
+	// context of the connection.
+	Connect(ctx context.Context) (context.Context, error)
+
+	// Disconnect stops tracking the connection of the context.
+	Disconnect(ctx context.Context) error
+
+	Presence(
+		ctx context.Context,
+		u *ent.User,
+	) (*model.Presence, error)
+
+	SubscribeToPresenceChanges(
+		ctx context.Context,
+	) (<-chan *model.PresenceChange, error)
+}
+
+type service struct {
+	config      config.PresenceConfig
+	entClient   *ent.Client
+	authService auth.Service
+	natsService nats.Service
+	kv          jetstream.KeyValue
+	tracker     *tracker
+	logger      log.Logger
+
+	mu sync.Mutex
+	// listeners holds the peers each subscriber is interested in.
+	listeners map[chan<- change]map[pulid.ID]bool
+}
+
+func NewService(
+	config config.PresenceConfig,
+	entClient *ent.Client,
+	authService auth.Service,
+	natsService nats.Service,
+	logger log.Logger,
+) Service {
+	return &service{
+		config:      config,
+		entClient:   entClient,
+		authService: authService,
+		natsService: natsService,
+		tracker:     newTracker(),
+		logger:      logger,
+		listeners:   make(map[chan<- change]map[pulid.ID]bool),
+	}
+}
+
+type connectionContextKey struct{}
+
+type connection struct {
+	userID pulid.ID
+	key    string
+	stop   context.CancelFunc
+}
+
+func (s *service) Start(ctx context.Context) error {
+	js := *s.natsService.JetStream()
+
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:  s.config.Bucket,
+		TTL:     s.config.TTL,
+		Storage: jetstream.MemoryStorage,
+	})
+	if err != nil {
+		return err
+	}
+	s.kv = kv
+
+	watcher, err := kv.WatchAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	go s.watch(ctx, watcher)
+	go s.expire(ctx)
+
+	return nil
+}
+
+func (s *service) Connect(ctx context.Context) (context.Context, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	key := fmt.Sprintf("%s.%s", currentUserID, pulid.MustNew(connectionIDPrefix))
+	if _, err := s.kv.Put(ctx, key, nil); err != nil {
+		return ctx, err
+	}
+
+	heartbeatCtx, stop := context.WithCancel(ctx)
+	go s.heartbeat(heartbeatCtx, key)
+
+	return context.WithValue(ctx, connectionContextKey{}, &connection{
+		userID: currentUserID,
+		key:    key,
+		stop:   stop,
+	}), nil
+}
+
+// Disconnect deletes the connection from the bucket and records when its
+// user was last seen. The connection context may already be done.
+func (s *service) Disconnect(ctx context.Context) error {
+	conn, ok := ctx.Value(connectionContextKey{}).(*connection)
+	if !ok {
+		return ErrNotConnected
+	}
+	conn.stop()
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), disconnectTimeout)
+	defer cancel()
+
+	if err := s.kv.Delete(ctx, conn.key); err != nil {
+		return err
+	}
+
+	return s.setLastSeenAt(ctx, conn.userID, time.Now())
+}
+
+// Presence returns the presence of the user to the user, their contacts and
+// room peers, unless the user blocked them.
+func (s *service) Presence(
+	ctx context.Context,
+	u *ent.User,
+) (*model.Presence, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.ID != currentUserID {
+		isPeer, err := s.entClient.User.
+			Query().
+			Where(
+				user.ID(u.ID),
+				visibleTo(currentUserID),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !isPeer {
+			return nil, nil
+		}
+	}
+
+	presence := &model.Presence{
+		LastSeenAt: u.LastSeenAt,
+	}
+	if at, online := s.tracker.lastHeartbeat(u.ID); online {
+		presence.Online = true
+		presence.LastSeenAt = &at
+	}
+	if u.HideLastSeen && u.ID != currentUserID {
+		presence.LastSeenAt = nil
+	}
+
+	return presence, nil
+}
+
+// SubscribeToPresenceChanges sends the changes of the contacts and room
+// peers the current user has when subscribing.
+func (s *service) SubscribeToPresenceChanges(
+	ctx context.Context,
+) (<-chan *model.PresenceChange, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	peers, err := s.entClient.User.
+		Query().
+		Where(
+			user.IDNEQ(currentUserID),
+			visibleTo(currentUserID),
+		).
+		Select(user.FieldID, user.FieldHideLastSeen).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	peerIDs := make([]pulid.ID, 0, len(peers))
+	hideLastSeen := make(map[pulid.ID]bool, len(peers))
+	for _, peer := range peers {
+		peerIDs = append(peerIDs, peer.ID)
+		hideLastSeen[peer.ID] = peer.HideLastSeen
+	}
+
+	changes := make(chan change, presenceChangesBuffer)
+	s.listen(changes, hideLastSeen)
+
+	ch := make(chan *model.PresenceChange, 1)
+	go func() {
+		defer s.unlisten(changes)
+
+		// The peers may block the current user after subscribing, the ones
+		// blocking are reloaded once they are older than blockingRefresh.
+		var (
+			blocking  map[pulid.ID]bool
+			checkedAt time.Time
+		)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case c := <-changes:
+				if time.Since(checkedAt) > blockingRefresh {
+					ids, err := s.entClient.User.
+						Query().
+						Where(
+							user.IDIn(peerIDs...),
+							contacts.Blocking(currentUserID),
+						).
+						IDs(ctx)
+					if err != nil {
+						level.Error(s.logger).Log(
+							"method", "SubscribeToPresenceChanges",
+							"userID", currentUserID,
+							"err", err,
+						)
+						continue
+					}
+
+					blocking = make(map[pulid.ID]bool, len(ids))
+					for _, id := range ids {
+						blocking[id] = true
+					}
+					checkedAt = time.Now()
+				}
+				if blocking[c.userID] {
+					continue
+				}
+
+				event := &model.PresenceChange{
+					UserID: c.userID,
+					Presence: &model.Presence{
+						Online: c.online,
+					},
+				}
+				if !hideLastSeen[c.userID] {
+					at := c.at
+					event.Presence.LastSeenAt = &at
+				}
+
+				select {
+				case ch <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// heartbeat refreshes the connection until it is closed, so it outlives the
+// ttl of the bucket.
+func (s *service) heartbeat(ctx context.Context, key string) {
+	ticker := time.NewTicker(s.config.Heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// A missed heartbeat is retried on the next tick, the connection
+			// only expires after the ttl of the bucket.
+			if _, err := s.kv.Put(ctx, key, nil); err != nil && ctx.Err() == nil {
+				level.Error(s.logger).Log("method", "heartbeat", "key", key, "err", err)
+			}
+		}
+	}
+}
+
+// watch applies the changes of the bucket to the view of the connections.
+func (s *service) watch(ctx context.Context, watcher jetstream.KeyWatcher) {
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case entry, ok := <-watcher.Updates():
+			if !ok {
+				return
+			}
+			// A nil entry marks the end of the initial values.
+			if entry == nil {
+				continue
+			}
+
+			userID, connectionID, found := strings.Cut(entry.Key(), ".")
+			if !found {
+				continue
+			}
+
+			switch entry.Operation() {
+			case jetstream.KeyValuePut:
+				if s.tracker.seen(pulid.ID(userID), connectionID, entry.Created()) {
+					s.notify(change{userID: pulid.ID(userID), online: true, at: entry.Created()})
+				}
+			case jetstream.KeyValueDelete, jetstream.KeyValuePurge:
+				if s.tracker.gone(pulid.ID(userID), connectionID) {
+					s.notify(change{userID: pulid.ID(userID), online: false, at: entry.Created()})
+				}
+			}
+		}
+	}
+}
+
+// expire drops the connections that missed their heartbeats, which were
+// left by replicas that stopped without closing them.
+func (s *service) expire(ctx context.Context) {
+	ticker := time.NewTicker(s.config.Heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, c := range s.tracker.expire(time.Now().Add(-s.config.TTL)) {
+				// Every replica expires the connection, the last seen time
+				// is the same for all of them.
+				s.setLastSeenAt(ctx, c.userID, c.at)
+				s.notify(c)
+			}
+		}
+	}
+}
+
+func (s *service) setLastSeenAt(
+	ctx context.Context,
+	userID pulid.ID,
+	at time.Time,
+) error {
+	return s.entClient.User.
+		Update().
+		Where(
+			user.ID(userID),
+			user.Or(
+				user.LastSeenAtIsNil(),
+				user.LastSeenAtLT(at),
+			),
+		).
+		SetLastSeenAt(at).
+		Exec(ctx)
+}
+
+func (s *service) listen(changes chan<- change, peers map[pulid.ID]bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listeners[changes] = peers
+}
+
+func (s *service) unlisten(changes chan<- change) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.listeners, changes)
+}
+
+// notify passes the change to the subscribers of its user without waiting
+// for them.
+func (s *service) notify(c change) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for changes, peers := range s.listeners {
+		if _, ok := peers[c.userID]; !ok {
+			continue
+		}
+		select {
+		case changes <- c:
+		default:
+		}
+	}
+}
+
+// visibleTo matches the peers of the user who did not block the user.
+func visibleTo(userID pulid.ID) predicate.User {
+	return user.And(
+		peerOf(userID),
+		user.Not(contacts.Blocking(userID)),
+	)
+}
+
+// peerOf matches the contacts of the user and the members of the rooms the
+// user belongs to.
+func peerOf(userID pulid.ID) predicate.User {
+	return user.Or(
+		user.HasUserContactsWith(
+			usercontact.ContactID(userID),
+			usercontact.DeletedAtIsNil(),
+		),
+		user.HasMembershipsWith(
+			roommember.DeletedAtIsNil(),
+			roommember.HasRoomWith(
+				room.HasRoomMembersWith(
+					roommember.UserID(userID),
+					roommember.DeletedAtIsNil(),
+				),
+			),
+		),
+	)
+}
@@ -0,0 +1,88 @@
+package presence
+
+import (
+	"context"
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/graph/model"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+type serviceLogging struct {
+	logger log.Logger
+	Service
+}
+
+func NewServiceLogging(logger log.Logger, s Service) Service {
+	return &serviceLogging{logger, s}
+}
+
+func (s *serviceLogging) Start(
+	ctx context.Context,
+) (err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "Start",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Start(ctx)
+}
+
+func (s *serviceLogging) Connect(
+	ctx context.Context,
+) (connCtx context.Context, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "Connect",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Connect(ctx)
+}
+
+func (s *serviceLogging) Disconnect(
+	ctx context.Context,
+) (err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "Disconnect",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Disconnect(ctx)
+}
+
+func (s *serviceLogging) Presence(
+	ctx context.Context,
+	u *ent.User,
+) (presence *model.Presence, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "Presence",
+			"userID", u.ID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Presence(ctx, u)
+}
+
+func (s *serviceLogging) SubscribeToPresenceChanges(
+	ctx context.Context,
+) (ch <-chan *model.PresenceChange, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "SubscribeToPresenceChanges",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.SubscribeToPresenceChanges(ctx)
+}
@@ -0,0 +1,33 @@
+package presence
+
+import (
+	"testing"
+	"time"
+
+	"journeyhub/ent/schema/pulid"
+)
+
+func TestNotify(t *testing.T) {
+	s := &service{
+		listeners: make(map[chan<- change]map[pulid.ID]bool),
+	}
+
+	changes := make(chan change, presenceChangesBuffer)
+	s.listen(changes, map[pulid.ID]bool{"URpeer": false})
+
+	s.notify(change{userID: "URstranger", online: true, at: time.Now()})
+	s.notify(change{userID: "URpeer", online: true, at: time.Now()})
+
+	if len(changes) != 1 {
+		t.Fatalf("expected one change, got %d", len(changes))
+	}
+	if c := <-changes; c.userID != "URpeer" {
+		t.Fatalf("unexpected change of %s", c.userID)
+	}
+
+	s.unlisten(changes)
+	s.notify(change{userID: "URpeer", online: false, at: time.Now()})
+	if len(changes) != 0 {
+		t.Fatal("expected no change after unsubscribing")
+	}
+}
@@ -0,0 +1,114 @@
+package presence
+
+import (
+	"sync"
+	"time"
+
+	"journeyhub/ent/schema/pulid"
+)
+
+// change is a user coming online or going offline at the given time.
+type change struct {
+	userID pulid.ID
+	online bool
+	at     time.Time
+}
+
+// tracker is the view of the open connections of every user, with the time
+// of their last heartbeat. Each replica builds it from the presence bucket.
+type tracker struct {
+	mu    sync.Mutex
+	users map[pulid.ID]map[string]time.Time
+}
+
+func newTracker() *tracker {
+	return &tracker{
+		users: make(map[pulid.ID]map[string]time.Time),
+	}
+}
+
+// seen records a heartbeat of the connection, and reports whether the user
+// came online.
+func (t *tracker) seen(userID pulid.ID, connectionID string, at time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	connections, ok := t.users[userID]
+	if !ok {
+		connections = make(map[string]time.Time)
+		t.users[userID] = connections
+	}
+	connections[connectionID] = at
+
+	return !ok
+}
+
+// gone removes the connection, and reports whether the user went offline.
+func (t *tracker) gone(userID pulid.ID, connectionID string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	connections, ok := t.users[userID]
+	if !ok {
+		return false
+	}
+	if _, ok := connections[connectionID]; !ok {
+		return false
+	}
+
+	delete(connections, connectionID)
+	if len(connections) > 0 {
+		return false
+	}
+
+	delete(t.users, userID)
+	return true
+}
+
+// expire removes the connections without a heartbeat since before, which
+// belonged to replicas that went away. It returns the changes of the users
+// left without connections, at the time of their last heartbeat.
+func (t *tracker) expire(before time.Time) []change {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var changes []change
+	for userID, connections := range t.users {
+		var last time.Time
+		for connectionID, at := range connections {
+			if at.Before(before) {
+				delete(connections, connectionID)
+			}
+			if at.After(last) {
+				last = at
+			}
+		}
+
+		if len(connections) == 0 {
+			delete(t.users, userID)
+			changes = append(changes, change{userID: userID, online: false, at: last})
+		}
+	}
+
+	return changes
+}
+
+// lastHeartbeat returns the latest heartbeat of the connections of the user,
+// and whether the user is online.
+func (t *tracker) lastHeartbeat(userID pulid.ID) (time.Time, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	connections, ok := t.users[userID]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	var last time.Time
+	for _, at := range connections {
+		if at.After(last) {
+			last = at
+		}
+	}
+	return last, true
+}
@@ -0,0 +1,60 @@
+package presence
+
+import (
+	"testing"
+	"time"
+
+	"journeyhub/ent/schema/pulid"
+)
+
+func TestTrackerConnections(t *testing.T) {
+	tr := newTracker()
+	userID := pulid.ID("US1")
+	at := time.Now()
+
+	if !tr.seen(userID, "a", at) {
+		t.Error("seen() first connection = false, want the user online")
+	}
+	if tr.seen(userID, "b", at) {
+		t.Error("seen() second connection = true, want no change")
+	}
+	if tr.seen(userID, "a", at.Add(time.Second)) {
+		t.Error("seen() heartbeat = true, want no change")
+	}
+	if last, online := tr.lastHeartbeat(userID); !online || !last.Equal(at.Add(time.Second)) {
+		t.Errorf("lastHeartbeat() = %v, %v, want %v, true", last, online, at.Add(time.Second))
+	}
+
+	if tr.gone(userID, "a") {
+		t.Error("gone() with a connection left = true, want no change")
+	}
+	if tr.gone(userID, "unknown") {
+		t.Error("gone() unknown connection = true, want no change")
+	}
+	if !tr.gone(userID, "b") {
+		t.Error("gone() last connection = false, want the user offline")
+	}
+	if _, online := tr.lastHeartbeat(userID); online {
+		t.Error("lastHeartbeat() online = true, want false")
+	}
+}
+
+func TestTrackerExpire(t *testing.T) {
+	tr := newTracker()
+	now := time.Now()
+
+	tr.seen("US1", "stale", now.Add(-time.Minute))
+	tr.seen("US2", "stale", now.Add(-time.Minute))
+	tr.seen("US2", "fresh", now)
+
+	changes := tr.expire(now.Add(-30 * time.Second))
+	if len(changes) != 1 {
+		t.Fatalf("expire() = %d changes, want 1", len(changes))
+	}
+	if c := changes[0]; c.userID != "US1" || c.online || !c.at.Equal(now.Add(-time.Minute)) {
+		t.Errorf("expire() = %+v, want US1 offline at its last heartbeat", c)
+	}
+	if _, online := tr.lastHeartbeat("US2"); !online {
+		t.Error("lastHeartbeat() US2 online = false, want true")
+	}
+}
@@ -0,0 +1,441 @@
+package reports
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/ent/device"
+	"journeyhub/ent/message"
+	"journeyhub/ent/messageattachment"
+	"journeyhub/ent/report"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"journeyhub/graph/model"
+	"journeyhub/internal/modules/auth"
+	"journeyhub/internal/modules/chat"
+	"journeyhub/internal/modules/roommembers"
+	"journeyhub/internal/modules/rooms"
+	"journeyhub/internal/platform/db"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+var (
+	ErrReportNotFound      = errors.New("report not found")
+	ErrReportClosed        = errors.New("report is closed")
+	ErrCannotReportSelf    = errors.New("users cannot report themselves")
+	ErrModeratorsOnly      = errors.New("available for moderators only")
+	ErrInvalidReportAction = errors.New("action does not apply to the report")
+	ErrAssigneeNotFound    = errors.New("assignee is not a moderator")
+)
+
+type Service interface {
+	ReportMessage(
+		ctx context.Context,
+		messageID pulid.ID,
+		input model.ReportInput,
+	) (*ent.Report, error)
+
+	ReportUser(
+		ctx context.Context,
+		userID pulid.ID,
+		input model.ReportInput,
+	) (*ent.Report, error)
+
+	ReportRoom(
+		ctx context.Context,
+		roomID pulid.ID,
+		input model.ReportInput,
+	) (*ent.Report, error)
+
+	// AuthModerator returns the current user when they are a moderator.
+	AuthModerator(
+		ctx context.Context,
+	) (*ent.User, error)
+
+	AssignReport(
+		ctx context.Context,
+		ID pulid.ID,
+		assigneeID *pulid.ID,
+	) (*ent.Report, error)
+
+	ResolveReport(
+		ctx context.Context,
+		ID pulid.ID,
+		input model.ResolveReportInput,
+	) (*ent.Report, error)
+
+	DismissReport(
+		ctx context.Context,
+		ID pulid.ID,
+		note *string,
+	) (*ent.Report, error)
+}
+
+type service struct {
+	entClient          *ent.Client
+	authService        auth.Service
+	roomsService       rooms.Service
+	roomMembersService roommembers.Service
+	chatService        chat.Service
+}
+
+func NewService(
+	entClient *ent.Client,
+	authService auth.Service,
+	roomsService rooms.Service,
+	roomMembersService roommembers.Service,
+	chatService chat.Service,
+) Service {
+	return &service{
+		entClient:          entClient,
+		authService:        authService,
+		roomsService:       roomsService,
+		roomMembersService: roomMembersService,
+		chatService:        chatService,
+	}
+}
+
+func (s *service) ReportMessage(
+	ctx context.Context,
+	messageID pulid.ID,
+	input model.ReportInput,
+) (*ent.Report, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := s.entClient.Message.
+		Query().
+		Where(message.ID(messageID)).
+		WithUser().
+		WithRoom().
+		WithAttachments(func(q *ent.MessageAttachmentQuery) {
+			q.WithFile().Order(ent.Asc(messageattachment.FieldOrder))
+		}).
+		WithVoice(func(q *ent.MessageVoiceQuery) {
+			q.WithFile()
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the members of the room see the message.
+	_, err = s.roomMembersService.Authorize(ctx, msg.Edges.Room.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	create := s.entClient.Report.
+		Create().
+		SetType(report.TypeMessage).
+		SetReason(input.Reason).
+		SetNillableText(input.Text).
+		SetSnapshot(messageSnapshot(msg)).
+		SetReporterID(currentUserID).
+		SetMessageID(msg.ID).
+		SetRoomID(msg.Edges.Room.ID)
+	if msg.Edges.User != nil {
+		if msg.Edges.User.ID == currentUserID {
+			return nil, ErrCannotReportSelf
+		}
+		create.SetUserID(msg.Edges.User.ID)
+	}
+
+	return create.Save(ctx)
+}
+
+func (s *service) ReportUser(
+	ctx context.Context,
+	userID pulid.ID,
+	input model.ReportInput,
+) (*ent.Report, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if userID == currentUserID {
+		return nil, ErrCannotReportSelf
+	}
+
+	u, err := s.entClient.User.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.entClient.Report.
+		Create().
+		SetType(report.TypeUser).
+		SetReason(input.Reason).
+		SetNillableText(input.Text).
+		SetSnapshot(userSnapshot(u)).
+		SetReporterID(currentUserID).
+		SetUserID(u.ID).
+		Save(ctx)
+}
+
+func (s *service) ReportRoom(
+	ctx context.Context,
+	roomID pulid.ID,
+	input model.ReportInput,
+) (*ent.Report, error) {
+	member, err := s.roomMembersService.Authorize(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.entClient.Report.
+		Create().
+		SetType(report.TypeRoom).
+		SetReason(input.Reason).
+		SetNillableText(input.Text).
+		SetSnapshot(roomSnapshot(member.Edges.Room)).
+		SetReporterID(member.UserID).
+		SetRoomID(roomID).
+		Save(ctx)
+}
+
+func (s *service) AuthModerator(
+	ctx context.Context,
+) (*ent.User, error) {
+	currentUser, err := s.authService.AuthUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if currentUser.Role != user.RoleModerator {
+		return nil, newModeratorsOnlyError()
+	}
+
+	return currentUser, nil
+}
+
+// AssignReport assigns the open report to the moderator, the current user
+// when assigneeID is nil.
+func (s *service) AssignReport(
+	ctx context.Context,
+	ID pulid.ID,
+	assigneeID *pulid.ID,
+) (*ent.Report, error) {
+	moderator, err := s.AuthModerator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	assignee := moderator.ID
+	if assigneeID != nil && *assigneeID != moderator.ID {
+		isModerator, err := s.entClient.User.
+			Query().
+			Where(
+				user.ID(*assigneeID),
+				user.RoleEQ(user.RoleModerator),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !isModerator {
+			return nil, ErrAssigneeNotFound
+		}
+		assignee = *assigneeID
+	}
+
+	r, err := s.openReport(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Update().
+		SetAssigneeID(assignee).
+		Save(ctx)
+}
+
+// ResolveReport takes the actions on the reported content and closes the
+// report, all or nothing.
+func (s *service) ResolveReport(
+	ctx context.Context,
+	ID pulid.ID,
+	input model.ResolveReportInput,
+) (*ent.Report, error) {
+	moderator, err := s.AuthModerator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *ent.Report
+	err = db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+		var err error
+		r, err = s.openReport(ctx, ID)
+		if err != nil {
+			return err
+		}
+
+		if err := validateActions(r, input.Actions); err != nil {
+			return err
+		}
+
+		for _, action := range input.Actions {
+			if err := s.takeAction(ctx, moderator, r, action); err != nil {
+				return err
+			}
+		}
+
+		actions := make([]string, len(input.Actions))
+		for i, action := range input.Actions {
+			actions[i] = action.String()
+		}
+
+		r, err = s.close(ctx, moderator, r, report.StatusResolved, input.Note).
+			SetActions(actions).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *service) DismissReport(
+	ctx context.Context,
+	ID pulid.ID,
+	note *string,
+) (*ent.Report, error) {
+	moderator, err := s.AuthModerator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := s.openReport(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.close(ctx, moderator, r, report.StatusDismissed, note).
+		Save(ctx)
+}
+
+func (s *service) openReport(
+	ctx context.Context,
+	ID pulid.ID,
+) (*ent.Report, error) {
+	r, err := db.Client(ctx, s.entClient).Report.Get(ctx, ID)
+	if ent.IsNotFound(err) {
+		return nil, ErrReportNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Status != report.StatusOpen {
+		return nil, ErrReportClosed
+	}
+
+	return r, nil
+}
+
+// close builds the update closing the report, which stays assigned to the
+// moderator who handled it.
+func (s *service) close(
+	ctx context.Context,
+	moderator *ent.User,
+	r *ent.Report,
+	status report.Status,
+	note *string,
+) *ent.ReportUpdateOne {
+	update := db.Client(ctx, s.entClient).Report.
+		UpdateOne(r).
+		SetStatus(status).
+		SetNillableNote(note).
+		SetClosedAt(time.Now())
+	if r.AssigneeID == nil {
+		update.SetAssigneeID(moderator.ID)
+	}
+	return update
+}
+
+func (s *service) takeAction(
+	ctx context.Context,
+	moderator *ent.User,
+	r *ent.Report,
+	action model.ReportAction,
+) error {
+	repository := db.Client(ctx, s.entClient)
+
+	switch action {
+	case model.ReportActionDeleteContent:
+		if r.Type == report.TypeMessage {
+			_, err := s.chatService.RemoveMessage(ctx, *r.MessageID)
+			return err
+		}
+		_, err := s.roomsService.RemoveRoom(ctx, *r.RoomID)
+		if errors.Is(err, rooms.ErrNotGroupRoom) {
+			return ErrInvalidReportAction
+		}
+		return err
+
+	case model.ReportActionSuspendAccount:
+		if *r.UserID == moderator.ID {
+			return ErrInvalidReportAction
+		}
+
+		err := repository.User.
+			UpdateOneID(*r.UserID).
+			SetSuspendedAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Suspended users no longer get pushes.
+		_, err = repository.Device.
+			Delete().
+			Where(device.HasUserWith(user.ID(*r.UserID))).
+			Exec(ctx)
+		return err
+
+	case model.ReportActionBanFromRoom:
+		_, err := s.roomMembersService.BanFromRoom(ctx, *r.RoomID, *r.UserID)
+		return err
+
+	default:
+		return ErrInvalidReportAction
+	}
+}
+
+// validateActions checks that the actions apply to what is left of the
+// reported content.
+func validateActions(r *ent.Report, actions []model.ReportAction) error {
+	for _, action := range actions {
+		var ok bool
+		switch action {
+		case model.ReportActionDeleteContent:
+			ok = (r.Type == report.TypeMessage && r.MessageID != nil) ||
+				(r.Type == report.TypeRoom && r.RoomID != nil)
+		case model.ReportActionSuspendAccount:
+			ok = r.UserID != nil
+		case model.ReportActionBanFromRoom:
+			ok = r.RoomID != nil && r.UserID != nil
+		}
+		if !ok {
+			return ErrInvalidReportAction
+		}
+	}
+	return nil
+}
+
+func newModeratorsOnlyError() error {
+	return &gqlerror.Error{
+		Err:     ErrModeratorsOnly,
+		Message: ErrModeratorsOnly.Error(),
+		Extensions: map[string]interface{}{
+			"code": "PERMISSION_DENIED",
+		},
+	}
+}
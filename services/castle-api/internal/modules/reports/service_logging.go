@@ -0,0 +1,136 @@
+package reports
+
+import (
+	"context"
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/graph/model"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+type serviceLogging struct {
+	logger log.Logger
+	Service
+}
+
+func NewServiceLogging(logger log.Logger, s Service) Service {
+	return &serviceLogging{logger, s}
+}
+
+func (s *serviceLogging) ReportMessage(
+	ctx context.Context,
+	messageID pulid.ID,
+	input model.ReportInput,
+) (report *ent.Report, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "ReportMessage",
+			"messageID", messageID,
+			"reason", input.Reason,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.ReportMessage(ctx, messageID, input)
+}
+
+func (s *serviceLogging) ReportUser(
+	ctx context.Context,
+	userID pulid.ID,
+	input model.ReportInput,
+) (report *ent.Report, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "ReportUser",
+			"userID", userID,
+			"reason", input.Reason,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.ReportUser(ctx, userID, input)
+}
+
+func (s *serviceLogging) ReportRoom(
+	ctx context.Context,
+	roomID pulid.ID,
+	input model.ReportInput,
+) (report *ent.Report, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "ReportRoom",
+			"roomID", roomID,
+			"reason", input.Reason,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.ReportRoom(ctx, roomID, input)
+}
+
+func (s *serviceLogging) AuthModerator(
+	ctx context.Context,
+) (user *ent.User, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "AuthModerator",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.AuthModerator(ctx)
+}
+
+func (s *serviceLogging) AssignReport(
+	ctx context.Context,
+	ID pulid.ID,
+	assigneeID *pulid.ID,
+) (report *ent.Report, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "AssignReport",
+			"ID", ID,
+			"assigneeID", assigneeID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.AssignReport(ctx, ID, assigneeID)
+}
+
+func (s *serviceLogging) ResolveReport(
+	ctx context.Context,
+	ID pulid.ID,
+	input model.ResolveReportInput,
+) (report *ent.Report, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "ResolveReport",
+			"ID", ID,
+			"actions", len(input.Actions),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.ResolveReport(ctx, ID, input)
+}
+
+func (s *serviceLogging) DismissReport(
+	ctx context.Context,
+	ID pulid.ID,
+	note *string,
+) (report *ent.Report, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "DismissReport",
+			"ID", ID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.DismissReport(ctx, ID, note)
+}
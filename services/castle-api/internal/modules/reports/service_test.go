@@ -0,0 +1,74 @@
+package reports
+
+import (
+	"errors"
+	"testing"
+
+	"journeyhub/ent"
+	"journeyhub/ent/report"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/graph/model"
+)
+
+func TestValidateActions(t *testing.T) {
+	userID := pulid.ID("US1")
+	roomID := pulid.ID("RO1")
+	messageID := pulid.ID("ME1")
+
+	messageReport := &ent.Report{Type: report.TypeMessage, MessageID: &messageID, RoomID: &roomID, UserID: &userID}
+	systemMessageReport := &ent.Report{Type: report.TypeMessage, MessageID: &messageID, RoomID: &roomID}
+	userReport := &ent.Report{Type: report.TypeUser, UserID: &userID}
+	roomReport := &ent.Report{Type: report.TypeRoom, RoomID: &roomID}
+
+	tests := []struct {
+		name    string
+		report  *ent.Report
+		actions []model.ReportAction
+		wantErr bool
+	}{
+		{"no actions", userReport, nil, false},
+		{"message all actions", messageReport, model.AllReportAction, false},
+		{"system message suspend", systemMessageReport, []model.ReportAction{model.ReportActionSuspendAccount}, true},
+		{"user suspend", userReport, []model.ReportAction{model.ReportActionSuspendAccount}, false},
+		{"user delete content", userReport, []model.ReportAction{model.ReportActionDeleteContent}, true},
+		{"user ban", userReport, []model.ReportAction{model.ReportActionBanFromRoom}, true},
+		{"room delete content", roomReport, []model.ReportAction{model.ReportActionDeleteContent}, false},
+		{"room ban", roomReport, []model.ReportAction{model.ReportActionBanFromRoom}, true},
+		{"unknown action", messageReport, []model.ReportAction{"UNKNOWN"}, true},
+	}
+
+	for _, tt := range tests {
+		err := validateActions(tt.report, tt.actions)
+		if gotErr := errors.Is(err, ErrInvalidReportAction); gotErr != tt.wantErr {
+			t.Errorf("%s: validateActions() = %v, want error %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestMessageSnapshot(t *testing.T) {
+	content := "hello"
+	msg := &ent.Message{ID: "ME1", Content: content}
+	msg.Edges.User = &ent.User{ID: "US1", FirstName: "Ann"}
+	msg.Edges.Room = &ent.Room{ID: "RO1"}
+	msg.Edges.Attachments = []*ent.MessageAttachment{{ID: "MA1"}}
+	msg.Edges.Attachments[0].Edges.File = &ent.File{ID: "FI1", Name: "photo.jpg"}
+
+	snapshot := messageSnapshot(msg)
+
+	if snapshot["roomID"] != pulid.ID("RO1") {
+		t.Errorf("roomID = %v, want RO1", snapshot["roomID"])
+	}
+	if u, _ := snapshot["user"].(map[string]interface{}); u["id"] != pulid.ID("US1") {
+		t.Errorf("user = %v, want the author", snapshot["user"])
+	}
+	attachments, _ := snapshot["attachments"].([]map[string]interface{})
+	if len(attachments) != 1 {
+		t.Fatalf("attachments = %v, want 1", snapshot["attachments"])
+	}
+	if f, _ := attachments[0]["file"].(map[string]interface{}); f["name"] != "photo.jpg" {
+		t.Errorf("attachment file = %v, want photo.jpg", attachments[0]["file"])
+	}
+	if _, ok := snapshot["voice"]; ok {
+		t.Error("voice is set, want none")
+	}
+}
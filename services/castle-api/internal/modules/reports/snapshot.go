@@ -0,0 +1,80 @@
+package reports
+
+import (
+	"journeyhub/ent"
+)
+
+// messageSnapshot keeps the message with its files, which stay referenced
+// by the report when the message is deleted.
+func messageSnapshot(msg *ent.Message) map[string]interface{} {
+	snapshot := map[string]interface{}{
+		"id":        msg.ID,
+		"type":      msg.Type,
+		"content":   msg.Content,
+		"createdAt": msg.CreatedAt,
+		"updatedAt": msg.UpdatedAt,
+	}
+	if msg.Edges.User != nil {
+		snapshot["user"] = userSnapshot(msg.Edges.User)
+	}
+	if msg.Edges.Room != nil {
+		snapshot["roomID"] = msg.Edges.Room.ID
+	}
+
+	attachments := make([]map[string]interface{}, 0, len(msg.Edges.Attachments))
+	for _, attachment := range msg.Edges.Attachments {
+		a := map[string]interface{}{
+			"id":   attachment.ID,
+			"type": attachment.Type,
+		}
+		if attachment.Edges.File != nil {
+			a["file"] = fileSnapshot(attachment.Edges.File)
+		}
+		attachments = append(attachments, a)
+	}
+	snapshot["attachments"] = attachments
+
+	if voice := msg.Edges.Voice; voice != nil && voice.Edges.File != nil {
+		snapshot["voice"] = fileSnapshot(voice.Edges.File)
+	}
+
+	return snapshot
+}
+
+func userSnapshot(u *ent.User) map[string]interface{} {
+	snapshot := map[string]interface{}{
+		"id":        u.ID,
+		"firstName": u.FirstName,
+		"lastName":  u.LastName,
+		"nickname":  u.Nickname,
+	}
+	if u.AvatarID != nil {
+		snapshot["avatarID"] = *u.AvatarID
+	}
+	return snapshot
+}
+
+func roomSnapshot(r *ent.Room) map[string]interface{} {
+	snapshot := map[string]interface{}{
+		"id":          r.ID,
+		"type":        r.Type,
+		"name":        r.Name,
+		"description": r.Description,
+	}
+	if r.AvatarID != nil {
+		snapshot["avatarID"] = *r.AvatarID
+	}
+	return snapshot
+}
+
+func fileSnapshot(f *ent.File) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          f.ID,
+		"name":        f.Name,
+		"contentType": f.ContentType,
+		"size":        f.Size,
+		"bucket":      f.Bucket,
+		"path":        f.Path,
+		"location":    f.Location,
+	}
+}
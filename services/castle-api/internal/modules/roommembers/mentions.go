@@ -0,0 +1,21 @@
+package roommembers
+
+import (
+	"regexp"
+	"strings"
+)
+
+var mentionRegexp = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_])@([\p{L}\p{N}_.]+)`)
+
+// mentionedNicknames returns the lower-cased nicknames mentioned in content
+// with the @nickname syntax.
+func mentionedNicknames(content string) map[string]struct{} {
+	nicknames := make(map[string]struct{})
+	for _, match := range mentionRegexp.FindAllStringSubmatch(content, -1) {
+		nickname := strings.TrimRight(match[1], ".")
+		if nickname != "" {
+			nicknames[strings.ToLower(nickname)] = struct{}{}
+		}
+	}
+	return nicknames
+}
@@ -0,0 +1,31 @@
+package roommembers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMentionedNicknames(t *testing.T) {
+	tests := []struct {
+		content string
+		want    []string
+	}{
+		{"hello", nil},
+		{"@alice hi", []string{"alice"}},
+		{"hi @Alice and @bob.", []string{"alice", "bob"}},
+		{"ping @john.doe, please", []string{"john.doe"}},
+		{"mail me at bob@example.com", nil},
+		{"привет @Иван", []string{"иван"}},
+	}
+
+	for _, tt := range tests {
+		got := mentionedNicknames(tt.content)
+		want := make(map[string]struct{}, len(tt.want))
+		for _, nickname := range tt.want {
+			want[nickname] = struct{}{}
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("mentionedNicknames(%q) = %v, want %v", tt.content, got, want)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package roommembers
+
+import (
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/ent/roommember"
+)
+
+// wantsNotification reports whether the member is notified of a new
+// message according to its notification level and mute.
+func wantsNotification(roomMember *ent.RoomMember, mentioned bool, now time.Time) bool {
+	if roomMember.MuteUntil != nil && roomMember.MuteUntil.After(now) {
+		return false
+	}
+
+	switch roomMember.NotificationLevel {
+	case roommember.NotificationLevelNone:
+		return false
+	case roommember.NotificationLevelMentions:
+		return mentioned
+	default:
+		return true
+	}
+}
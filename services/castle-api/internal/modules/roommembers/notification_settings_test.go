@@ -0,0 +1,34 @@
+package roommembers
+
+import (
+	"testing"
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/ent/roommember"
+)
+
+func TestWantsNotification(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		member    ent.RoomMember
+		mentioned bool
+		want      bool
+	}{
+		{"all", ent.RoomMember{NotificationLevel: roommember.NotificationLevelAll}, false, true},
+		{"mentions without mention", ent.RoomMember{NotificationLevel: roommember.NotificationLevelMentions}, false, false},
+		{"mentions with mention", ent.RoomMember{NotificationLevel: roommember.NotificationLevelMentions}, true, true},
+		{"none", ent.RoomMember{NotificationLevel: roommember.NotificationLevelNone}, true, false},
+		{"muted", ent.RoomMember{NotificationLevel: roommember.NotificationLevelAll, MuteUntil: &future}, true, false},
+		{"mute expired", ent.RoomMember{NotificationLevel: roommember.NotificationLevelAll, MuteUntil: &past}, false, true},
+	}
+
+	for _, tt := range tests {
+		if got := wantsNotification(&tt.member, tt.mentioned, now); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package roommembers
+
+import (
+	"errors"
+	"fmt"
+
+	"journeyhub/ent/room"
+	"journeyhub/ent/roommember"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+var (
+	ErrRoomMemberNotFound = errors.New("room member not found")
+	ErrPermissionDenied   = errors.New("permission denied")
+)
+
+type Permission string
+
+const (
+	PermissionSendMessages   Permission = "SEND_MESSAGES"
+	PermissionDeleteMessages Permission = "DELETE_MESSAGES"
+	PermissionPinMessages    Permission = "PIN_MESSAGES"
+	PermissionStartCalls     Permission = "START_CALLS"
+	PermissionRenameRoom     Permission = "RENAME_ROOM"
+	PermissionAddMembers     Permission = "ADD_MEMBERS"
+	PermissionRemoveMembers  Permission = "REMOVE_MEMBERS"
+	PermissionManageRoles    Permission = "MANAGE_ROLES"
+	PermissionDeleteRoom     Permission = "DELETE_ROOM"
+)
+
+// rolePermissions is the permission matrix of group rooms. Deleting
+// messages means deleting the messages of others, everyone may delete
+// their own.
+var rolePermissions = map[roommember.Role][]Permission{
+	roommember.RoleOwner: {
+		PermissionSendMessages, PermissionDeleteMessages, PermissionPinMessages,
+		PermissionStartCalls, PermissionRenameRoom, PermissionAddMembers,
+		PermissionRemoveMembers, PermissionManageRoles, PermissionDeleteRoom,
+	},
+	roommember.RoleAdmin: {
+		PermissionSendMessages, PermissionDeleteMessages, PermissionPinMessages,
+		PermissionStartCalls, PermissionRenameRoom, PermissionAddMembers,
+		PermissionRemoveMembers, PermissionManageRoles,
+	},
+	roommember.RoleMember: {
+		PermissionSendMessages, PermissionPinMessages, PermissionStartCalls,
+	},
+	roommember.RoleReadOnly: {},
+}
+
+// personalPermissions apply to both members of a personal room, which has
+// nobody to moderate.
+var personalPermissions = []Permission{
+	PermissionSendMessages, PermissionPinMessages, PermissionStartCalls,
+	PermissionDeleteRoom,
+}
+
+// roleRanks orders the roles, a member only moderates members of a lower
+// rank.
+var roleRanks = map[roommember.Role]int{
+	roommember.RoleReadOnly: 0,
+	roommember.RoleMember:   1,
+	roommember.RoleAdmin:    2,
+	roommember.RoleOwner:    3,
+}
+
+// Can reports whether a member with the role has the permission in a room
+// of the given type.
+func Can(roomType room.Type, role roommember.Role, permission Permission) bool {
+	permissions := rolePermissions[role]
+	if roomType == room.TypePersonal {
+		permissions = personalPermissions
+	}
+
+	for _, p := range permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Outranks reports whether a member with the role may moderate a member
+// with the target role.
+func Outranks(role, target roommember.Role) bool {
+	return roleRanks[role] > roleRanks[target]
+}
+
+// IsAdmin reports whether the role administrates the room.
+func IsAdmin(role roommember.Role) bool {
+	return role == roommember.RoleOwner || role == roommember.RoleAdmin
+}
+
+// promotedRole and demotedRole move a role one step up or down the ladder
+// of assignable roles. The owner is never assigned.
+func promotedRole(role roommember.Role) (roommember.Role, bool) {
+	switch role {
+	case roommember.RoleReadOnly:
+		return roommember.RoleMember, true
+	case roommember.RoleMember:
+		return roommember.RoleAdmin, true
+	default:
+		return role, false
+	}
+}
+
+func demotedRole(role roommember.Role) (roommember.Role, bool) {
+	switch role {
+	case roommember.RoleAdmin:
+		return roommember.RoleMember, true
+	case roommember.RoleMember:
+		return roommember.RoleReadOnly, true
+	default:
+		return role, false
+	}
+}
+
+func NewPermissionDeniedError(permission Permission) error {
+	return &gqlerror.Error{
+		Err:     ErrPermissionDenied,
+		Message: fmt.Sprintf("%s: %s", ErrPermissionDenied, permission),
+		Extensions: map[string]interface{}{
+			"code":       "PERMISSION_DENIED",
+			"permission": permission,
+		},
+	}
+}
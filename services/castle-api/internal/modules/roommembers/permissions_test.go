@@ -0,0 +1,51 @@
+package roommembers
+
+import (
+	"testing"
+
+	"journeyhub/ent/room"
+	"journeyhub/ent/roommember"
+)
+
+func TestCan(t *testing.T) {
+	tests := []struct {
+		roomType   room.Type
+		role       roommember.Role
+		permission Permission
+		want       bool
+	}{
+		{room.TypeGroup, roommember.RoleOwner, PermissionDeleteRoom, true},
+		{room.TypeGroup, roommember.RoleAdmin, PermissionDeleteRoom, false},
+		{room.TypeGroup, roommember.RoleAdmin, PermissionRemoveMembers, true},
+		{room.TypeGroup, roommember.RoleMember, PermissionRenameRoom, false},
+		{room.TypeGroup, roommember.RoleMember, PermissionStartCalls, true},
+		{room.TypeGroup, roommember.RoleReadOnly, PermissionSendMessages, false},
+		{room.TypePersonal, roommember.RoleMember, PermissionDeleteRoom, true},
+		{room.TypePersonal, roommember.RoleMember, PermissionAddMembers, false},
+	}
+
+	for _, tt := range tests {
+		if got := Can(tt.roomType, tt.role, tt.permission); got != tt.want {
+			t.Errorf("Can(%s, %s, %s) = %v, want %v", tt.roomType, tt.role, tt.permission, got, tt.want)
+		}
+	}
+}
+
+func TestRoleLadder(t *testing.T) {
+	if !Outranks(roommember.RoleAdmin, roommember.RoleMember) || Outranks(roommember.RoleAdmin, roommember.RoleAdmin) {
+		t.Fatal("admins only outrank lower roles")
+	}
+
+	if role, ok := promotedRole(roommember.RoleMember); !ok || role != roommember.RoleAdmin {
+		t.Fatalf("unexpected promotion of member: %s", role)
+	}
+	if _, ok := promotedRole(roommember.RoleAdmin); ok {
+		t.Fatal("admins are never promoted to owner")
+	}
+	if role, ok := demotedRole(roommember.RoleMember); !ok || role != roommember.RoleReadOnly {
+		t.Fatalf("unexpected demotion of member: %s", role)
+	}
+	if _, ok := demotedRole(roommember.RoleOwner); ok {
+		t.Fatal("owners are never demoted")
+	}
+}
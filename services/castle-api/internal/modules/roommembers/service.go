@@ -3,21 +3,30 @@ package roommembers
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"journeyhub/ent"
+	entmessage "journeyhub/ent/message"
+	"journeyhub/ent/notification"
+	"journeyhub/ent/room"
 	"journeyhub/ent/roommember"
 	"journeyhub/ent/schema/mixin"
 	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"journeyhub/ent/userblock"
+	"journeyhub/graph/model"
 	"journeyhub/internal/modules/auth"
 	"journeyhub/internal/modules/notifications"
-
-	"github.com/appleboy/gorush/rpc/proto"
+	"journeyhub/internal/platform/db"
 )
 
 type Service interface {
 	IncrementUnreadMessagesCount(
 		ctx context.Context,
 		roomID pulid.ID,
+		message *ent.Message,
+		silent bool,
 	) ([]*ent.RoomMember, error)
 
 	DeleteRoomMember(
@@ -35,6 +44,46 @@ type Service interface {
 		roomID pulid.ID,
 	) ([]*ent.RoomMember, error)
 
+	UpdateRoomMemberNotifications(
+		ctx context.Context,
+		ID pulid.ID,
+		input model.UpdateRoomMemberNotificationsInput,
+	) (*ent.RoomMember, error)
+
+	// Authorize returns the room member of the current user if its role
+	// grants all the permissions in the room.
+	Authorize(
+		ctx context.Context,
+		roomID pulid.ID,
+		permissions ...Permission,
+	) (*ent.RoomMember, error)
+
+	PromoteRoomMember(
+		ctx context.Context,
+		ID pulid.ID,
+	) (*ent.RoomMember, error)
+
+	DemoteRoomMember(
+		ctx context.Context,
+		ID pulid.ID,
+	) (*ent.RoomMember, error)
+
+	KickRoomMember(
+		ctx context.Context,
+		ID pulid.ID,
+	) (*ent.RoomMember, error)
+
+	BanRoomMember(
+		ctx context.Context,
+		ID pulid.ID,
+	) (*ent.RoomMember, error)
+
+	BanFromRoom(
+		ctx context.Context,
+		roomID pulid.ID,
+		userID pulid.ID,
+	) (*ent.RoomMember, error)
+
 	Subscriptions() Subscriptions
 }
 
@@ -62,22 +111,31 @@ func NewService(
 func (s *service) IncrementUnreadMessagesCount(
 	ctx context.Context,
 	roomID pulid.ID,
+	message *ent.Message,
+	silent bool,
 ) ([]*ent.RoomMember, error) {
-	currentUser, err := s.authService.AuthUser(ctx)
+	repository := db.Client(ctx, s.entClient)
+
+	// The author is the current user, or the user on whose behalf a system
+	// message is sent.
+	currentUser, err := repository.Message.QueryUser(message).Only(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	repository := s.entClient
+	// Members who blocked the author don't see the message, so it is
+	// neither counted nor notified to them.
+	recipients := roommember.And(
+		roommember.UserIDNEQ(currentUser.ID),
+		roommember.RoomID(roomID),
+		roommember.Not(roommember.HasUserWith(
+			user.HasBlocksWith(userblock.BlockedUserID(currentUser.ID)),
+		)),
+	)
 
 	err = repository.RoomMember.
 		Update().
-		Where(
-			roommember.And(
-				roommember.UserIDNEQ(currentUser.ID),
-				roommember.RoomID(roomID),
-			),
-		).
+		Where(recipients).
 		AddUnreadMessagesCount(1).
 		Exec(ctx)
 	if err != nil {
@@ -86,42 +144,80 @@ func (s *service) IncrementUnreadMessagesCount(
 
 	roomMembersToNotify, err := repository.RoomMember.
 		Query().
-		Where(
-			roommember.And(
-				roommember.UserIDNEQ(currentUser.ID),
-				roommember.RoomID(roomID),
-			),
-		).
+		Where(recipients).
 		WithRoom().
-		WithUser(func(q *ent.UserQuery) {
-			q.WithDevice()
-		}).
+		WithUser().
 		All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	fcmTokens := make([]string, 0, len(roomMembersToNotify))
+	mentioned := mentionedNicknames(message.Content)
+	now := time.Now()
+
+	var recipientIDs, mentionedIDs []pulid.ID
 	for _, roomMember := range roomMembersToNotify {
-		_, err = s.subscriptions.PublishRoomMemberUpdatedEvent(ctx, roomMember.UserID, roomMember.ID)
-		if err != nil {
-			return nil, err
+		userID, roomMemberID := roomMember.UserID, roomMember.ID
+		db.AfterCommit(ctx, func() {
+			s.subscriptions.PublishRoomMemberUpdatedEvent(ctx, userID, roomMemberID)
+		})
+
+		isMentioned := false
+		if roomMember.Edges.User != nil {
+			_, isMentioned = mentioned[strings.ToLower(roomMember.Edges.User.Nickname)]
 		}
-		if roomMember != nil &&
-			roomMember.Edges.User != nil &&
-			roomMember.Edges.User.Edges.Device != nil &&
-			roomMember.Edges.User.Edges.Device.FcmToken != "" {
-			fcmTokens = append(fcmTokens, roomMember.Edges.User.Edges.Device.FcmToken)
+
+		switch {
+		case !wantsNotification(roomMember, isMentioned, now):
+		case isMentioned:
+			mentionedIDs = append(mentionedIDs, roomMember.UserID)
+		default:
+			recipientIDs = append(recipientIDs, roomMember.UserID)
 		}
 	}
 
-	_, err = s.notificationsService.Client().Send(ctx, &proto.NotificationRequest{
-		Platform: 2,
-		Tokens:   fcmTokens,
-		Priority: proto.NotificationRequest_HIGH,
-		Title:    fmt.Sprintf("%s %s", currentUser.FirstName, currentUser.LastName),
-		Message:  "У вас новое сообщение",
-		Badge:    1,
+	// Only text messages are pushed, calls notify the callees on their own.
+	if message.Type != entmessage.TypeText {
+		return roomMembersToNotify, nil
+	}
+
+	params := notifications.TemplateParams{
+		SenderName: fmt.Sprintf("%s %s", currentUser.FirstName, currentUser.LastName),
+		Preview:    notifications.MessagePreview(message.Content),
+	}
+	if len(roomMembersToNotify) > 0 {
+		if r := roomMembersToNotify[0].Edges.Room; r != nil && r.Type == room.TypeGroup {
+			params.RoomName = r.Name
+		}
+	}
+
+	data := map[string]string{
+		"roomID":    string(roomID),
+		"messageID": string(message.ID),
+		"userID":    string(currentUser.ID),
+	}
+
+	_, err = s.notificationsService.Send(ctx, notifications.SendInput{
+		Type:        notification.TypeNewMessage,
+		UserIDs:     recipientIDs,
+		Template:    notifications.TemplateNewMessage,
+		Params:      params,
+		Data:        data,
+		CollapseKey: string(roomID),
+		Silent:      silent,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.notificationsService.Send(ctx, notifications.SendInput{
+		Type:        notification.TypeMention,
+		UserIDs:     mentionedIDs,
+		Template:    notifications.TemplateMention,
+		Params:      params,
+		Data:        data,
+		CollapseKey: string(roomID),
+		Silent:      silent,
 	})
 	if err != nil {
 		return nil, err
@@ -130,16 +226,26 @@ func (s *service) IncrementUnreadMessagesCount(
 	return roomMembersToNotify, nil
 }
 
+// DeleteRoomMember hides the room of the current user until the next
+// message. Deleting the member of someone else kicks them.
 func (s *service) DeleteRoomMember(
 	ctx context.Context,
 	ID pulid.ID,
 ) (*ent.RoomMember, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	repository := s.entClient
 
 	roomMember, err := repository.RoomMember.Get(ctx, ID)
 	if err != nil {
 		return nil, err
 	}
+	if roomMember.UserID != currentUserID {
+		return s.KickRoomMember(ctx, ID)
+	}
 
 	err = repository.RoomMember.
 		DeleteOneID(ID).
@@ -148,7 +254,7 @@ func (s *service) DeleteRoomMember(
 		return nil, err
 	}
 
-	_, err = s.subscriptions.PublishRoomMemberDeletedEvent(ctx, roomMember.ID)
+	_, err = s.subscriptions.PublishRoomMemberDeletedEvent(ctx, roomMember.UserID, roomMember.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -194,6 +300,7 @@ func (s *service) RestoreRoomMembersByRoom(
 		Where(
 			roommember.RoomID(roomID),
 			roommember.DeletedAtNotNil(),
+			roommember.BannedAtIsNil(),
 		).
 		All(mixin.SkipSoftDelete(ctx))
 	if err != nil {
@@ -205,6 +312,7 @@ func (s *service) RestoreRoomMembersByRoom(
 		Where(
 			roommember.RoomID(roomID),
 			roommember.DeletedAtNotNil(),
+			roommember.BannedAtIsNil(),
 		).
 		ClearDeletedAt().
 		Exec(ctx)
@@ -222,6 +330,261 @@ func (s *service) RestoreRoomMembersByRoom(
 	return roomMembersToNotify, nil
 }
 
+func (s *service) UpdateRoomMemberNotifications(
+	ctx context.Context,
+	ID pulid.ID,
+	input model.UpdateRoomMemberNotificationsInput,
+) (*ent.RoomMember, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	repository := s.entClient
+
+	roomMember, err := repository.RoomMember.
+		Query().
+		Where(
+			roommember.ID(ID),
+			roommember.UserID(currentUserID),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	update := roomMember.
+		Update().
+		SetNillableNotificationLevel(input.NotificationLevel).
+		SetNillableMuteUntil(input.MuteUntil)
+	if input.ClearMuteUntil != nil && *input.ClearMuteUntil {
+		update.ClearMuteUntil()
+	}
+
+	roomMember, err = update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.subscriptions.PublishRoomMemberUpdatedEvent(ctx, roomMember.UserID, roomMember.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return roomMember, nil
+}
+
+func (s *service) Authorize(
+	ctx context.Context,
+	roomID pulid.ID,
+	permissions ...Permission,
+) (*ent.RoomMember, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Members who hid the room keep their role, banned ones are gone.
+	roomMember, err := db.Client(ctx, s.entClient).RoomMember.
+		Query().
+		Where(
+			roommember.RoomID(roomID),
+			roommember.UserID(currentUserID),
+			roommember.BannedAtIsNil(),
+		).
+		WithRoom().
+		Only(mixin.SkipSoftDelete(ctx))
+	if ent.IsNotFound(err) {
+		return nil, ErrRoomMemberNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, permission := range permissions {
+		if !Can(roomMember.Edges.Room.Type, roomMember.Role, permission) {
+			return nil, NewPermissionDeniedError(permission)
+		}
+	}
+
+	return roomMember, nil
+}
+
+func (s *service) PromoteRoomMember(
+	ctx context.Context,
+	ID pulid.ID,
+) (*ent.RoomMember, error) {
+	actor, target, err := s.moderate(ctx, ID, PermissionManageRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	role, ok := promotedRole(target.Role)
+	if !ok || !Outranks(actor.Role, role) {
+		return nil, NewPermissionDeniedError(PermissionManageRoles)
+	}
+
+	return s.setRole(ctx, target, role)
+}
+
+func (s *service) DemoteRoomMember(
+	ctx context.Context,
+	ID pulid.ID,
+) (*ent.RoomMember, error) {
+	_, target, err := s.moderate(ctx, ID, PermissionManageRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	role, ok := demotedRole(target.Role)
+	if !ok {
+		return nil, NewPermissionDeniedError(PermissionManageRoles)
+	}
+
+	return s.setRole(ctx, target, role)
+}
+
+// KickRoomMember removes the member from the room for good, new messages
+// don't restore it. The user may be added again.
+func (s *service) KickRoomMember(
+	ctx context.Context,
+	ID pulid.ID,
+) (*ent.RoomMember, error) {
+	_, target, err := s.moderate(ctx, ID, PermissionRemoveMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.entClient.RoomMember.
+		DeleteOneID(target.ID).
+		Exec(mixin.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.subscriptions.PublishRoomMemberDeletedEvent(ctx, target.UserID, target.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
+
+// BanRoomMember removes the member from the room and keeps it from coming
+// back through new messages, until an admin adds the user again.
+func (s *service) BanRoomMember(
+	ctx context.Context,
+	ID pulid.ID,
+) (*ent.RoomMember, error) {
+	_, target, err := s.moderate(ctx, ID, PermissionRemoveMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ban(ctx, target)
+}
+
+// BanFromRoom bans the user from the room without authorizing the current
+// user, for moderators acting on reports.
+func (s *service) BanFromRoom(
+	ctx context.Context,
+	roomID pulid.ID,
+	userID pulid.ID,
+) (*ent.RoomMember, error) {
+	target, err := db.Client(ctx, s.entClient).RoomMember.
+		Query().
+		Where(
+			roommember.RoomID(roomID),
+			roommember.UserID(userID),
+		).
+		Only(mixin.SkipSoftDelete(ctx))
+	if ent.IsNotFound(err) {
+		return nil, ErrRoomMemberNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if target.BannedAt != nil {
+		return target, nil
+	}
+
+	return s.ban(ctx, target)
+}
+
+func (s *service) ban(
+	ctx context.Context,
+	target *ent.RoomMember,
+) (*ent.RoomMember, error) {
+	now := time.Now()
+	target, err := db.Client(ctx, s.entClient).RoomMember.
+		UpdateOne(target).
+		SetBannedAt(now).
+		SetDeletedAt(now).
+		Save(mixin.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	db.AfterCommit(ctx, func() {
+		s.subscriptions.PublishRoomMemberDeletedEvent(ctx, target.UserID, target.ID)
+	})
+
+	return target, nil
+}
+
+// moderate authorizes the current user to act on the member with the
+// permission. Members only moderate members of a lower rank.
+func (s *service) moderate(
+	ctx context.Context,
+	ID pulid.ID,
+	permission Permission,
+) (*ent.RoomMember, *ent.RoomMember, error) {
+	target, err := s.entClient.RoomMember.
+		Query().
+		Where(
+			roommember.ID(ID),
+			roommember.BannedAtIsNil(),
+		).
+		Only(mixin.SkipSoftDelete(ctx))
+	if ent.IsNotFound(err) {
+		return nil, nil, ErrRoomMemberNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actor, err := s.Authorize(ctx, target.RoomID, permission)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !Outranks(actor.Role, target.Role) {
+		return nil, nil, NewPermissionDeniedError(permission)
+	}
+
+	return actor, target, nil
+}
+
+func (s *service) setRole(
+	ctx context.Context,
+	roomMember *ent.RoomMember,
+	role roommember.Role,
+) (*ent.RoomMember, error) {
+	roomMember, err := roomMember.
+		Update().
+		SetRole(role).
+		Save(mixin.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.subscriptions.PublishRoomMemberUpdatedEvent(ctx, roomMember.UserID, roomMember.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return roomMember, nil
+}
+
 func (s *service) Subscriptions() Subscriptions {
 	return s.subscriptions
 }
@@ -2,10 +2,12 @@ package roommembers
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"journeyhub/ent"
 	"journeyhub/ent/schema/pulid"
+	"journeyhub/graph/model"
 
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
@@ -23,17 +25,20 @@ func NewServiceLogging(logger log.Logger, s Service) Service {
 func (s *serviceLogging) IncrementUnreadMessagesCount(
 	ctx context.Context,
 	ID pulid.ID,
+	message *ent.Message,
+	silent bool,
 ) (roomMembersToNotify []*ent.RoomMember, err error) {
 	defer func(begin time.Time) {
 		level.Debug(s.logger).Log(
 			"method", "IncrementUnreadMessagesCount",
 			"ID", ID,
+			"silent", silent,
 			"roomMembersToNotifyCount", len(roomMembersToNotify),
 			"took", time.Since(begin),
 			"err", err,
 		)
 	}(time.Now())
-	return s.Service.IncrementUnreadMessagesCount(ctx, ID)
+	return s.Service.IncrementUnreadMessagesCount(ctx, ID, message, silent)
 }
 
 func (s *serviceLogging) DeleteRoomMember(
@@ -80,3 +85,113 @@ func (s *serviceLogging) RestoreRoomMembersByRoom(
 	}(time.Now())
 	return s.Service.RestoreRoomMembersByRoom(ctx, roomID)
 }
+
+func (s *serviceLogging) UpdateRoomMemberNotifications(
+	ctx context.Context,
+	ID pulid.ID,
+	input model.UpdateRoomMemberNotificationsInput,
+) (roomMember *ent.RoomMember, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "UpdateRoomMemberNotifications",
+			"ID", ID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.UpdateRoomMemberNotifications(ctx, ID, input)
+}
+
+func (s *serviceLogging) Authorize(
+	ctx context.Context,
+	roomID pulid.ID,
+	permissions ...Permission,
+) (roomMember *ent.RoomMember, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "Authorize",
+			"roomID", roomID,
+			"permissions", fmt.Sprint(permissions),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Authorize(ctx, roomID, permissions...)
+}
+
+func (s *serviceLogging) PromoteRoomMember(
+	ctx context.Context,
+	ID pulid.ID,
+) (roomMember *ent.RoomMember, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "PromoteRoomMember",
+			"ID", ID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.PromoteRoomMember(ctx, ID)
+}
+
+func (s *serviceLogging) DemoteRoomMember(
+	ctx context.Context,
+	ID pulid.ID,
+) (roomMember *ent.RoomMember, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "DemoteRoomMember",
+			"ID", ID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.DemoteRoomMember(ctx, ID)
+}
+
+func (s *serviceLogging) KickRoomMember(
+	ctx context.Context,
+	ID pulid.ID,
+) (roomMember *ent.RoomMember, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "KickRoomMember",
+			"ID", ID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.KickRoomMember(ctx, ID)
+}
+
+func (s *serviceLogging) BanRoomMember(
+	ctx context.Context,
+	ID pulid.ID,
+) (roomMember *ent.RoomMember, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "BanRoomMember",
+			"ID", ID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.BanRoomMember(ctx, ID)
+}
+
+func (s *serviceLogging) BanFromRoom(
+	ctx context.Context,
+	roomID pulid.ID,
+	userID pulid.ID,
+) (roomMember *ent.RoomMember, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "BanFromRoom",
+			"roomID", roomID,
+			"userID", userID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.BanFromRoom(ctx, roomID, userID)
+}
@@ -33,6 +33,7 @@ type Subscriptions interface {
 
 	PublishRoomMemberDeletedEvent(
 		ctx context.Context,
+		userID pulid.ID,
 		roomMemberID pulid.ID,
 	) (string, error)
 
@@ -117,16 +118,12 @@ func (s *subscriptions) SubscribeToRoomMemberUpdatedEvent(
 
 func (s *subscriptions) PublishRoomMemberDeletedEvent(
 	ctx context.Context,
+	userID pulid.ID,
 	roomMemberID pulid.ID,
 ) (string, error) {
-	currentUserID, err := s.authService.Auth(ctx)
-	if err != nil {
-		return "", err
-	}
-
 	natsClient := s.natsService.Client()
 
-	subject := fmt.Sprintf("users.%s.roommembers.deleted", currentUserID)
+	subject := fmt.Sprintf("users.%s.roommembers.deleted", userID)
 	if err := natsClient.Publish(subject, roomMemberID); err != nil {
 		return "", err
 	}
@@ -80,6 +80,7 @@ func (s *subscriptionsLogging) SubscribeToRoomMemberUpdatedEvent(
 
 func (s *subscriptionsLogging) PublishRoomMemberDeletedEvent(
 	ctx context.Context,
+	userID pulid.ID,
 	roomMemberID pulid.ID,
 ) (subject string, err error) {
 	defer func(begin time.Time) {
@@ -90,7 +91,7 @@ func (s *subscriptionsLogging) PublishRoomMemberDeletedEvent(
 			"err", err,
 		)
 	}(time.Now())
-	return s.Subscriptions.PublishRoomMemberDeletedEvent(ctx, roomMemberID)
+	return s.Subscriptions.PublishRoomMemberDeletedEvent(ctx, userID, roomMemberID)
 }
 
 func (s *subscriptionsLogging) SubscribeToRoomMemberDeletedEvent(
@@ -0,0 +1,274 @@
+package rooms
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/ent/predicate"
+	"journeyhub/ent/room"
+	"journeyhub/ent/roominvite"
+	"journeyhub/ent/roommember"
+	"journeyhub/ent/schema/mixin"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/graph/model"
+	"journeyhub/internal/modules/roommembers"
+	"journeyhub/internal/platform/db"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+var (
+	ErrRoomInviteNotFound  = errors.New("room invite not found")
+	ErrRoomInviteExpired   = errors.New("room invite expired")
+	ErrRoomInviteExhausted = errors.New("room invite has no uses left")
+	ErrBannedFromRoom      = errors.New("user is banned from the room")
+)
+
+// inviteTokenLength is the number of random bytes of an invite token.
+const inviteTokenLength = 24
+
+func newInviteToken() (string, error) {
+	b := make([]byte, inviteTokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hasUsesLeft matches the invites used less often than they may be.
+func hasUsesLeft() predicate.RoomInvite {
+	return roominvite.Or(
+		roominvite.MaxUsesIsNil(),
+		func(s *sql.Selector) {
+			s.Where(sql.ColumnsLT(s.C(roominvite.FieldUses), s.C(roominvite.FieldMaxUses)))
+		},
+	)
+}
+
+func validateInvite(invite *ent.RoomInvite, now time.Time) error {
+	switch {
+	case invite.RevokedAt != nil:
+		return ErrRoomInviteNotFound
+	case invite.ExpiresAt != nil && !invite.ExpiresAt.After(now):
+		return ErrRoomInviteExpired
+	case invite.MaxUses != nil && invite.Uses >= *invite.MaxUses:
+		return ErrRoomInviteExhausted
+	default:
+		return nil
+	}
+}
+
+func (s *service) CreateRoomInvite(
+	ctx context.Context,
+	input model.CreateRoomInviteInput,
+) (*ent.RoomInvite, string, error) {
+	actor, err := s.roomMembersService.Authorize(ctx, input.RoomID, roommembers.PermissionAddMembers)
+	if err != nil {
+		return nil, "", err
+	}
+	if actor.Edges.Room.Type != room.TypeGroup {
+		return nil, "", ErrNotGroupRoom
+	}
+
+	token, err := newInviteToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	invite, err := s.entClient.RoomInvite.
+		Create().
+		SetToken(token).
+		SetRoomID(input.RoomID).
+		SetCreatorID(actor.UserID).
+		SetNillableExpiresAt(input.ExpiresAt).
+		SetNillableMaxUses(input.MaxUses).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return invite, token, nil
+}
+
+func (s *service) RevokeRoomInvite(
+	ctx context.Context,
+	ID pulid.ID,
+) (*ent.RoomInvite, error) {
+	invite, err := s.entClient.RoomInvite.Get(ctx, ID)
+	if ent.IsNotFound(err) {
+		return nil, ErrRoomInviteNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.roomMembersService.Authorize(ctx, invite.RoomID, roommembers.PermissionAddMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	if invite.RevokedAt != nil {
+		return invite, nil
+	}
+
+	return invite.
+		Update().
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+}
+
+// PreviewRoomInvite shows the room of a usable invite, without revealing
+// its members.
+func (s *service) PreviewRoomInvite(
+	ctx context.Context,
+	token string,
+) (*model.RoomInvitePreview, error) {
+	_, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invite, err := s.invite(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	r := invite.Edges.Room
+	membersCount, err := s.entClient.RoomMember.
+		Query().
+		Where(
+			roommember.RoomID(r.ID),
+			roommember.DeletedAtIsNil(),
+			roommember.BannedAtIsNil(),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &model.RoomInvitePreview{
+		RoomID:       r.ID,
+		Name:         r.Name,
+		MembersCount: membersCount,
+	}
+	if r.Description != "" {
+		preview.Description = &r.Description
+	}
+	if r.AvatarID != nil {
+		preview.Avatar, err = r.QueryAvatar().Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	return preview, nil
+}
+
+// JoinRoomByInvite adds the current user to the room of the invite. Members
+// are returned their membership without using up the invite.
+func (s *service) JoinRoomByInvite(
+	ctx context.Context,
+	token string,
+) (*ent.RoomMember, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var member *ent.RoomMember
+	err = db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+		repository := db.Client(ctx, s.entClient)
+
+		invite, err := s.invite(ctx, token)
+		if err != nil {
+			return err
+		}
+		r := invite.Edges.Room
+
+		existing, err := repository.RoomMember.
+			Query().
+			Where(
+				roommember.RoomID(r.ID),
+				roommember.UserID(currentUserID),
+			).
+			Only(mixin.SkipSoftDelete(ctx))
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		if existing != nil && existing.BannedAt != nil {
+			return ErrBannedFromRoom
+		}
+		if existing != nil && existing.DeletedAt.IsZero() {
+			member = existing
+			return nil
+		}
+
+		n, err := repository.RoomInvite.
+			Update().
+			Where(
+				roominvite.ID(invite.ID),
+				hasUsesLeft(),
+			).
+			AddUses(1).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrRoomInviteExhausted
+		}
+
+		addedIDs, err := s.addRoomMembers(ctx, r, []pulid.ID{currentUserID})
+		if err != nil {
+			return err
+		}
+
+		member, err = repository.RoomMember.Get(ctx, addedIDs[0])
+		if err != nil {
+			return err
+		}
+
+		_, err = r.Update().
+			AddVersion(1).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		return s.publishRoomMembersUpdated(ctx, r, addedIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// invite returns the usable invite of the token with its room.
+func (s *service) invite(
+	ctx context.Context,
+	token string,
+) (*ent.RoomInvite, error) {
+	invite, err := db.Client(ctx, s.entClient).RoomInvite.
+		Query().
+		Where(roominvite.Token(token)).
+		WithRoom().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrRoomInviteNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateInvite(invite, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return invite, nil
+}
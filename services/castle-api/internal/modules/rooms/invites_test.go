@@ -0,0 +1,48 @@
+package rooms
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"journeyhub/ent"
+)
+
+func TestValidateInvite(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Minute), now.Add(time.Minute)
+	one := 1
+
+	tests := []struct {
+		name   string
+		invite *ent.RoomInvite
+		want   error
+	}{
+		{"unlimited", &ent.RoomInvite{}, nil},
+		{"revoked", &ent.RoomInvite{RevokedAt: &past}, ErrRoomInviteNotFound},
+		{"expired", &ent.RoomInvite{ExpiresAt: &past}, ErrRoomInviteExpired},
+		{"not expired", &ent.RoomInvite{ExpiresAt: &future}, nil},
+		{"used up", &ent.RoomInvite{MaxUses: &one, Uses: 1}, ErrRoomInviteExhausted},
+		{"uses left", &ent.RoomInvite{MaxUses: &one}, nil},
+	}
+
+	for _, tt := range tests {
+		if err := validateInvite(tt.invite, now); !errors.Is(err, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, err)
+		}
+	}
+}
+
+func TestNewInviteToken(t *testing.T) {
+	a, err := newInviteToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := newInviteToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a == b || len(a) != 32 {
+		t.Fatalf("unexpected tokens %q and %q", a, b)
+	}
+}
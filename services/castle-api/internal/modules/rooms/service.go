@@ -2,18 +2,59 @@ package rooms
 
 import (
 	"context"
+	"errors"
 
 	"journeyhub/ent"
+	"journeyhub/ent/room"
+	"journeyhub/ent/roommember"
+	"journeyhub/ent/schema/mixin"
 	"journeyhub/ent/schema/pulid"
+	"journeyhub/graph/model"
 	"journeyhub/internal/modules/auth"
 	"journeyhub/internal/modules/roommembers"
+	"journeyhub/internal/platform/db"
 )
 
+var ErrNotGroupRoom = errors.New("room is not a group room")
+
 type Service interface {
-	// FindOrCreatePersonalRoom(
-	// 	ctx context.Context,
-	// 	targetUserID pulid.ID,
-	// ) (*ent.Room, error)
+	// FindOrCreatePersonalRoom returns the personal room of the current
+	// user and the target user, restoring it for members who hid it.
+	FindOrCreatePersonalRoom(
+		ctx context.Context,
+		targetUserID pulid.ID,
+	) (*ent.Room, error)
+
+	CreateRoom(
+		ctx context.Context,
+		input model.CreateRoomInput,
+	) (*ent.Room, error)
+
+	UpdateRoom(
+		ctx context.Context,
+		ID pulid.ID,
+		input model.UpdateRoomInput,
+	) (*ent.Room, error)
+
+	CreateRoomInvite(
+		ctx context.Context,
+		input model.CreateRoomInviteInput,
+	) (*ent.RoomInvite, string, error)
+
+	RevokeRoomInvite(
+		ctx context.Context,
+		ID pulid.ID,
+	) (*ent.RoomInvite, error)
+
+	PreviewRoomInvite(
+		ctx context.Context,
+		token string,
+	) (*model.RoomInvitePreview, error)
+
+	JoinRoomByInvite(
+		ctx context.Context,
+		token string,
+	) (*ent.RoomMember, error)
 
 	IncrementRoomVersion(
 		ctx context.Context,
@@ -21,10 +62,22 @@ type Service interface {
 		lastMessage *ent.Message,
 	) (*ent.Room, error)
 
+	PublishRoomUpdated(
+		ctx context.Context,
+		ID pulid.ID,
+	) error
+
 	DeleteRoom(
 		ctx context.Context,
 		ID pulid.ID,
 	) (*ent.Room, error)
+
+	// RemoveRoom deletes the group room without authorizing the current
+	// user, for moderators acting on reports.
+	RemoveRoom(
+		ctx context.Context,
+		ID pulid.ID,
+	) (*ent.Room, error)
 }
 
 type service struct {
@@ -45,57 +98,345 @@ func NewService(
 	}
 }
 
-// func (s *service) FindOrCreatePersonalRoom(
-// 	ctx context.Context,
-// 	targetUserID pulid.ID,
-// ) (*ent.Room, error) {
-// 	currentUserID, err := s.authService.Auth(ctx)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-//
-// 	room, err := s.roomsRepository.FindPersonal(ctx, currentUserID, targetUserID)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-//
-// 	if room != nil {
-// 		_, err = s.roomMembersService.RestoreRoomMemberByRoom(ctx, targetUserID, room.ID)
-// 		if !ent.IsNotFound(err) && err != nil {
-// 			return nil, err
-// 		}
-// 		return room, nil
-// 	}
-//
-// 	room, err = s.roomsRepository.CreatePersonal(ctx, currentUserID, targetUserID)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-//
-// 	s.roomMembersService.CreateRoomMembers(ctx, []roommembers.CreateRoomMemberInput{
-// 		{
-// 			UserID: currentUserID,
-// 			RoomID: room.ID,
-// 		},
-// 		{
-// 			UserID: targetUserID,
-// 			RoomID: room.ID,
-// 		},
-// 	})
-//
-// 	return room, nil
-// }
+func (s *service) FindOrCreatePersonalRoom(
+	ctx context.Context,
+	targetUserID pulid.ID,
+) (*ent.Room, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-func (s *service) IncrementRoomVersion(
+	var r *ent.Room
+	err = db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+		repository := db.Client(ctx, s.entClient)
+
+		// Hidden memberships still bind the room to its users.
+		var err error
+		r, err = repository.Room.
+			Query().
+			Where(
+				room.TypeEQ(room.TypePersonal),
+				room.HasRoomMembersWith(roommember.UserID(currentUserID)),
+				room.HasRoomMembersWith(roommember.UserID(targetUserID)),
+			).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			r, err = repository.Room.
+				Create().
+				SetType(room.TypePersonal).
+				Save(ctx)
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = s.addRoomMembers(ctx, r, []pulid.ID{currentUserID, targetUserID})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// CreateRoom creates a group room owned by the current user.
+func (s *service) CreateRoom(
+	ctx context.Context,
+	input model.CreateRoomInput,
+) (*ent.Room, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *ent.Room
+	err = db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+		var err error
+		r, err = db.Client(ctx, s.entClient).Room.
+			Create().
+			SetName(input.Name).
+			SetNillableDescription(input.Description).
+			SetType(room.TypeGroup).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		creator, err := db.Client(ctx, s.entClient).RoomMember.
+			Create().
+			SetRoomID(r.ID).
+			SetUserID(currentUserID).
+			SetName(r.Name).
+			SetRole(roommember.RoleOwner).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		s.publishRoomMemberCreated(ctx, creator)
+
+		_, err = s.addRoomMembers(ctx, r, input.UserIDs)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// UpdateRoom edits a group room of the current user and its members. Every
+// change bumps the version of the room and is signaled to its members.
+func (s *service) UpdateRoom(
 	ctx context.Context,
 	ID pulid.ID,
-	lastMessage *ent.Message,
+	input model.UpdateRoomInput,
 ) (*ent.Room, error) {
-	_, err := s.authService.Auth(ctx)
+	var permissions []roommembers.Permission
+	if input.Name != nil || input.Description != nil || input.ClearDescription != nil {
+		permissions = append(permissions, roommembers.PermissionRenameRoom)
+	}
+	if len(input.AddUserIDs) > 0 {
+		permissions = append(permissions, roommembers.PermissionAddMembers)
+	}
+	if len(input.RemoveUserIDs) > 0 || (input.ClearUsers != nil && *input.ClearUsers) {
+		permissions = append(permissions, roommembers.PermissionRemoveMembers)
+	}
+
+	var r *ent.Room
+	err := db.WithTx(ctx, s.entClient, func(ctx context.Context) error {
+		repository := db.Client(ctx, s.entClient)
+
+		actor, err := s.roomMembersService.Authorize(ctx, ID, permissions...)
+		if err != nil {
+			return err
+		}
+
+		r = actor.Edges.Room
+		if r.Type != room.TypeGroup {
+			return ErrNotGroupRoom
+		}
+
+		update := r.Update().
+			AddVersion(1).
+			SetNillableName(input.Name).
+			SetNillableDescription(input.Description)
+		if input.ClearDescription != nil && *input.ClearDescription {
+			update.ClearDescription()
+		}
+		r, err = update.Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		if input.Name != nil {
+			err = repository.RoomMember.
+				Update().
+				Where(roommember.RoomID(r.ID)).
+				SetName(r.Name).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		removeUserIDs := input.RemoveUserIDs
+		if input.ClearUsers != nil && *input.ClearUsers {
+			others, err := repository.RoomMember.
+				Query().
+				Where(
+					roommember.RoomID(r.ID),
+					roommember.UserIDNEQ(actor.UserID),
+				).
+				All(mixin.SkipSoftDelete(ctx))
+			if err != nil {
+				return err
+			}
+
+			removeUserIDs = make([]pulid.ID, len(others))
+			for i, member := range others {
+				removeUserIDs[i] = member.UserID
+			}
+		}
+
+		err = s.removeRoomMembers(ctx, r, actor, removeUserIDs)
+		if err != nil {
+			return err
+		}
+
+		addedIDs, err := s.addRoomMembers(ctx, r, input.AddUserIDs)
+		if err != nil {
+			return err
+		}
+
+		// Members added by this update already got a created event.
+		return s.publishRoomMembersUpdated(ctx, r, addedIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// addRoomMembers adds the users to the room. Members who hid the room have
+// it restored instead.
+func (s *service) addRoomMembers(
+	ctx context.Context,
+	r *ent.Room,
+	userIDs []pulid.ID,
+) ([]pulid.ID, error) {
+	repository := db.Client(ctx, s.entClient)
+
+	existing, err := repository.RoomMember.
+		Query().
+		Where(
+			roommember.RoomID(r.ID),
+			roommember.UserIDIn(userIDs...),
+		).
+		All(mixin.SkipSoftDelete(ctx))
 	if err != nil {
 		return nil, err
 	}
 
+	members := make(map[pulid.ID]*ent.RoomMember, len(existing))
+	for _, member := range existing {
+		members[member.UserID] = member
+	}
+
+	var addedIDs []pulid.ID
+	for _, userID := range userIDs {
+		member, ok := members[userID]
+		switch {
+		case ok && member.DeletedAt.IsZero():
+			continue
+		case ok:
+			member, err = member.Update().
+				ClearDeletedAt().
+				ClearBannedAt().
+				SetName(r.Name).
+				Save(mixin.SkipSoftDelete(ctx))
+		default:
+			member, err = repository.RoomMember.
+				Create().
+				SetRoomID(r.ID).
+				SetUserID(userID).
+				SetName(r.Name).
+				Save(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		members[userID] = member
+		addedIDs = append(addedIDs, member.ID)
+		s.publishRoomMemberCreated(ctx, member)
+	}
+
+	return addedIDs, nil
+}
+
+// removeRoomMembers removes the users from the room for good, so that new
+// messages don't restore their membership. The actor may leave, others are
+// only removed if the actor outranks them.
+func (s *service) removeRoomMembers(
+	ctx context.Context,
+	r *ent.Room,
+	actor *ent.RoomMember,
+	userIDs []pulid.ID,
+) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	repository := db.Client(ctx, s.entClient)
+
+	members, err := repository.RoomMember.
+		Query().
+		Where(
+			roommember.RoomID(r.ID),
+			roommember.UserIDIn(userIDs...),
+		).
+		All(mixin.SkipSoftDelete(ctx))
+	if err != nil {
+		return err
+	}
+
+	for _, member := range members {
+		if member.ID != actor.ID && !roommembers.Outranks(actor.Role, member.Role) {
+			return roommembers.NewPermissionDeniedError(roommembers.PermissionRemoveMembers)
+		}
+
+		err = repository.RoomMember.
+			DeleteOneID(member.ID).
+			Exec(mixin.SkipSoftDelete(ctx))
+		if err != nil {
+			return err
+		}
+
+		userID, memberID := member.UserID, member.ID
+		db.AfterCommit(ctx, func() {
+			s.roomMembersService.Subscriptions().PublishRoomMemberDeletedEvent(ctx, userID, memberID)
+		})
+	}
+
+	return nil
+}
+
+// publishRoomMembersUpdated signals a change of the room to its members,
+// but the excluded ones.
+func (s *service) publishRoomMembersUpdated(
+	ctx context.Context,
+	r *ent.Room,
+	excludedIDs []pulid.ID,
+) error {
+	members, err := db.Client(ctx, s.entClient).RoomMember.
+		Query().
+		Where(
+			roommember.RoomID(r.ID),
+			roommember.IDNotIn(excludedIDs...),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, member := range members {
+		userID, memberID := member.UserID, member.ID
+		db.AfterCommit(ctx, func() {
+			s.roomMembersService.Subscriptions().PublishRoomMemberUpdatedEvent(ctx, userID, memberID)
+		})
+	}
+
+	return nil
+}
+
+// PublishRoomUpdated notifies every member of the room that it changed.
+func (s *service) PublishRoomUpdated(
+	ctx context.Context,
+	ID pulid.ID,
+) error {
+	r, err := db.Client(ctx, s.entClient).Room.Get(ctx, ID)
+	if err != nil {
+		return err
+	}
+
+	return s.publishRoomMembersUpdated(ctx, r, nil)
+}
+
+func (s *service) publishRoomMemberCreated(ctx context.Context, member *ent.RoomMember) {
+	userID, memberID := member.UserID, member.ID
+	db.AfterCommit(ctx, func() {
+		s.roomMembersService.Subscriptions().PublishRoomMemberCreatedEvent(ctx, userID, memberID)
+	})
+}
+
+func (s *service) IncrementRoomVersion(
+	ctx context.Context,
+	ID pulid.ID,
+	lastMessage *ent.Message,
+) (*ent.Room, error) {
 	var lastMessageID *pulid.ID
 	if lastMessage == nil {
 		lastMessageID = nil
@@ -103,7 +444,7 @@ func (s *service) IncrementRoomVersion(
 		lastMessageID = &lastMessage.ID
 	}
 
-	repository := s.entClient
+	repository := db.Client(ctx, s.entClient)
 
 	room, err := repository.Room.
 		UpdateOneID(ID).
@@ -121,24 +462,64 @@ func (s *service) DeleteRoom(
 	ctx context.Context,
 	ID pulid.ID,
 ) (*ent.Room, error) {
-	_, err := s.authService.Auth(ctx)
+	_, err := s.roomMembersService.Authorize(ctx, ID, roommembers.PermissionDeleteRoom)
 	if err != nil {
 		return nil, err
 	}
 
-	repository := s.entClient
+	room, err := s.entClient.Room.Get(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deleteRoom(ctx, room)
+}
 
-	room, err := repository.Room.Get(ctx, ID)
+func (s *service) RemoveRoom(
+	ctx context.Context,
+	ID pulid.ID,
+) (*ent.Room, error) {
+	r, err := db.Client(ctx, s.entClient).Room.Get(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deleting a personal room would delete the history of both parties.
+	if r.Type != room.TypeGroup {
+		return nil, ErrNotGroupRoom
+	}
+
+	return s.deleteRoom(ctx, r)
+}
+
+// deleteRoom deletes the room, which is gone for all of its members.
+func (s *service) deleteRoom(
+	ctx context.Context,
+	r *ent.Room,
+) (*ent.Room, error) {
+	repository := db.Client(ctx, s.entClient)
+
+	members, err := repository.RoomMember.
+		Query().
+		Where(roommember.RoomID(r.ID)).
+		All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	err = repository.Room.
-		DeleteOneID(ID).
+		DeleteOneID(r.ID).
 		Exec(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return room, nil
+	for _, member := range members {
+		userID, memberID := member.UserID, member.ID
+		db.AfterCommit(ctx, func() {
+			s.roomMembersService.Subscriptions().PublishRoomMemberDeletedEvent(ctx, userID, memberID)
+		})
+	}
+
+	return r, nil
 }
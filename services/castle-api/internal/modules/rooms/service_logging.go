@@ -6,6 +6,7 @@ import (
 
 	"journeyhub/ent"
 	"journeyhub/ent/schema/pulid"
+	"journeyhub/graph/model"
 
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
@@ -20,20 +21,112 @@ func NewServiceLogging(logger log.Logger, s Service) Service {
 	return &serviceLogging{logger, s}
 }
 
-// func (s *loggingService) FindOrCreatePersonalRoom(
-// 	ctx context.Context,
-// 	targetUserID pulid.ID,
-// ) (room *ent.Room, err error) {
-// 	defer func(begin time.Time) {
-// 		level.Debug(s.logger).Log(
-// 			"method", "FindOrCreatePersonalRoom",
-// 			"targetUserID", targetUserID,
-// 			"took", time.Since(begin),
-// 			"err", err,
-// 		)
-// 	}(time.Now())
-// 	return s.Service.FindOrCreatePersonalRoom(ctx, targetUserID)
-// }
+func (s *serviceLogging) FindOrCreatePersonalRoom(
+	ctx context.Context,
+	targetUserID pulid.ID,
+) (room *ent.Room, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "FindOrCreatePersonalRoom",
+			"targetUserID", targetUserID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.FindOrCreatePersonalRoom(ctx, targetUserID)
+}
+
+func (s *serviceLogging) CreateRoom(
+	ctx context.Context,
+	input model.CreateRoomInput,
+) (room *ent.Room, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "CreateRoom",
+			"name", input.Name,
+			"usersCount", len(input.UserIDs),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.CreateRoom(ctx, input)
+}
+
+func (s *serviceLogging) UpdateRoom(
+	ctx context.Context,
+	ID pulid.ID,
+	input model.UpdateRoomInput,
+) (room *ent.Room, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "UpdateRoom",
+			"ID", ID,
+			"addUserIDs", len(input.AddUserIDs),
+			"removeUserIDs", len(input.RemoveUserIDs),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.UpdateRoom(ctx, ID, input)
+}
+
+func (s *serviceLogging) CreateRoomInvite(
+	ctx context.Context,
+	input model.CreateRoomInviteInput,
+) (invite *ent.RoomInvite, token string, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "CreateRoomInvite",
+			"roomID", input.RoomID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.CreateRoomInvite(ctx, input)
+}
+
+func (s *serviceLogging) RevokeRoomInvite(
+	ctx context.Context,
+	ID pulid.ID,
+) (invite *ent.RoomInvite, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "RevokeRoomInvite",
+			"ID", ID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.RevokeRoomInvite(ctx, ID)
+}
+
+func (s *serviceLogging) PreviewRoomInvite(
+	ctx context.Context,
+	token string,
+) (preview *model.RoomInvitePreview, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "PreviewRoomInvite",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.PreviewRoomInvite(ctx, token)
+}
+
+func (s *serviceLogging) JoinRoomByInvite(
+	ctx context.Context,
+	token string,
+) (roomMember *ent.RoomMember, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "JoinRoomByInvite",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.JoinRoomByInvite(ctx, token)
+}
 
 func (s *serviceLogging) IncrementRoomVersion(
 	ctx context.Context,
@@ -51,6 +144,21 @@ func (s *serviceLogging) IncrementRoomVersion(
 	return s.Service.IncrementRoomVersion(ctx, ID, lastMessage)
 }
 
+func (s *serviceLogging) PublishRoomUpdated(
+	ctx context.Context,
+	ID pulid.ID,
+) (err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "PublishRoomUpdated",
+			"ID", ID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.PublishRoomUpdated(ctx, ID)
+}
+
 func (s *serviceLogging) DeleteRoom(
 	ctx context.Context,
 	ID pulid.ID,
@@ -65,3 +173,18 @@ func (s *serviceLogging) DeleteRoom(
 	}(time.Now())
 	return s.Service.DeleteRoom(ctx, ID)
 }
+
+func (s *serviceLogging) RemoveRoom(
+	ctx context.Context,
+	ID pulid.ID,
+) (room *ent.Room, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "RemoveRoom",
+			"ID", ID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.RemoveRoom(ctx, ID)
+}
@@ -0,0 +1,201 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"journeyhub/ent/predicate"
+	"journeyhub/ent/roommember"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+	"journeyhub/ent/usercontact"
+	"journeyhub/graph/model"
+	"journeyhub/internal/modules/contacts"
+	"journeyhub/internal/platform/ratelimit"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	minSearchQueryLength = 2
+	maxSearchQueryLength = 64
+	defaultSearchLimit   = 20
+	maxSearchLimit       = 50
+)
+
+var ErrInvalidSearchQuery = errors.New("search query must be 2 to 64 characters long")
+
+// SearchUsers finds the users discoverable by the current user whose nickname
+// or name match every word of the query. Contacts come first, then the
+// users sharing a room, then the rest by similarity of the nickname. Only
+// the public profile of the users is returned.
+func (s *service) SearchUsers(
+	ctx context.Context,
+	query string,
+	first *int,
+) ([]*model.UserSearchResult, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query = strings.TrimSpace(query)
+	if n := utf8.RuneCountInString(query); n < minSearchQueryLength || n > maxSearchQueryLength {
+		return nil, ErrInvalidSearchQuery
+	}
+
+	allowed, err := s.searchLimiter.Allow(ctx, string(currentUserID))
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, ratelimit.NewLimitExceededError()
+	}
+
+	matches := make([]predicate.User, 0)
+	for _, word := range strings.Fields(query) {
+		matches = append(matches, user.Or(
+			user.NicknameContainsFold(word),
+			user.FirstNameContainsFold(word),
+			user.LastNameContainsFold(word),
+		))
+	}
+
+	users, err := s.entClient.User.
+		Query().
+		Where(
+			user.IDNEQ(currentUserID),
+			user.SuspendedAtIsNil(),
+			user.Not(contacts.Blocking(currentUserID)),
+			user.Not(contacts.BlockedBy(currentUserID)),
+			discoverableBy(currentUserID),
+			user.And(matches...),
+		).
+		Order(func(s *sql.Selector) {
+			s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+				b.WriteString("CASE WHEN ").
+					Join(contactOf(s, currentUserID)).
+					WriteString(" THEN 0 WHEN ").
+					Join(peerOf(s, currentUserID)).
+					WriteString(" THEN 1 ELSE 2 END, similarity(").
+					Ident(s.C(user.FieldNickname)).
+					WriteString(", ").
+					Arg(query).
+					WriteString(") DESC")
+			}))
+			s.OrderBy(s.C(user.FieldNickname))
+		}).
+		Limit(searchLimit(first)).
+		WithAvatar().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*model.UserSearchResult, len(users))
+	for i, u := range users {
+		results[i] = &model.UserSearchResult{
+			ID:        u.ID,
+			Nickname:  u.Nickname,
+			FirstName: u.FirstName,
+			LastName:  u.LastName,
+			Avatar:    u.Edges.Avatar,
+		}
+	}
+
+	return results, nil
+}
+
+// discoverableBy applies the discoverability setting of the users: contacts
+// of the user always find them, contacts of contacts when allowed.
+func discoverableBy(userID pulid.ID) predicate.User {
+	return func(s *sql.Selector) {
+		s.Where(sql.Or(
+			sql.EQ(s.C(user.FieldDiscoverability), user.DiscoverabilityEveryone.String()),
+			contactOf(s, userID),
+			sql.And(
+				sql.EQ(s.C(user.FieldDiscoverability), user.DiscoverabilityContactsOfContacts.String()),
+				sql.Or(
+					hasContact(s, userID),
+					contactOfContacts(s, userID),
+				),
+			),
+		))
+	}
+}
+
+// contactOf matches the users in the contacts of the user.
+func contactOf(s *sql.Selector, userID pulid.ID) *sql.Predicate {
+	t := sql.Table(usercontact.Table)
+	return sql.Exists(
+		sql.Select(t.C(usercontact.FieldID)).
+			From(t).
+			Where(sql.And(
+				sql.EQ(t.C(usercontact.FieldUserID), userID),
+				sql.ColumnsEQ(t.C(usercontact.FieldContactID), s.C(user.FieldID)),
+				sql.IsNull(t.C(usercontact.FieldDeletedAt)),
+			)),
+	)
+}
+
+// hasContact matches the users having the user in their contacts.
+func hasContact(s *sql.Selector, userID pulid.ID) *sql.Predicate {
+	t := sql.Table(usercontact.Table)
+	return sql.Exists(
+		sql.Select(t.C(usercontact.FieldID)).
+			From(t).
+			Where(sql.And(
+				sql.ColumnsEQ(t.C(usercontact.FieldUserID), s.C(user.FieldID)),
+				sql.EQ(t.C(usercontact.FieldContactID), userID),
+				sql.IsNull(t.C(usercontact.FieldDeletedAt)),
+			)),
+	)
+}
+
+// contactOfContacts matches the users whose contacts have the user in their
+// contacts.
+func contactOfContacts(s *sql.Selector, userID pulid.ID) *sql.Predicate {
+	t1 := sql.Table(usercontact.Table).As("uc1")
+	t2 := sql.Table(usercontact.Table).As("uc2")
+	return sql.Exists(
+		sql.Select(t1.C(usercontact.FieldID)).
+			From(t1).
+			Join(t2).
+			On(t2.C(usercontact.FieldUserID), t1.C(usercontact.FieldContactID)).
+			Where(sql.And(
+				sql.ColumnsEQ(t1.C(usercontact.FieldUserID), s.C(user.FieldID)),
+				sql.EQ(t2.C(usercontact.FieldContactID), userID),
+				sql.IsNull(t1.C(usercontact.FieldDeletedAt)),
+				sql.IsNull(t2.C(usercontact.FieldDeletedAt)),
+			)),
+	)
+}
+
+// peerOf matches the users sharing a room with the user.
+func peerOf(s *sql.Selector, userID pulid.ID) *sql.Predicate {
+	a := sql.Table(roommember.Table).As("rm1")
+	b := sql.Table(roommember.Table).As("rm2")
+	return sql.Exists(
+		sql.Select(a.C(roommember.FieldID)).
+			From(a).
+			Join(b).
+			On(b.C(roommember.FieldRoomID), a.C(roommember.FieldRoomID)).
+			Where(sql.And(
+				sql.EQ(a.C(roommember.FieldUserID), userID),
+				sql.ColumnsEQ(b.C(roommember.FieldUserID), s.C(user.FieldID)),
+				sql.IsNull(a.C(roommember.FieldDeletedAt)),
+				sql.IsNull(a.C(roommember.FieldBannedAt)),
+				sql.IsNull(b.C(roommember.FieldDeletedAt)),
+				sql.IsNull(b.C(roommember.FieldBannedAt)),
+			)),
+	)
+}
+
+func searchLimit(first *int) int {
+	if first == nil || *first <= 0 {
+		return defaultSearchLimit
+	}
+	return min(*first, maxSearchLimit)
+}
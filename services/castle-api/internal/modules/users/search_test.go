@@ -0,0 +1,62 @@
+package users
+
+import (
+	"strings"
+	"testing"
+
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/ent/user"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+func TestSearchLimit(t *testing.T) {
+	intPtr := func(i int) *int { return &i }
+
+	tests := []struct {
+		name  string
+		first *int
+		want  int
+	}{
+		{"default", nil, defaultSearchLimit},
+		{"not positive", intPtr(0), defaultSearchLimit},
+		{"within", intPtr(5), 5},
+		{"above max", intPtr(1000), maxSearchLimit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := searchLimit(tt.first); got != tt.want {
+				t.Errorf("searchLimit() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiscoverableBy(t *testing.T) {
+	s := sql.Dialect(dialect.Postgres).
+		Select("*").
+		From(sql.Table(user.Table))
+	userID := pulid.ID("UR1")
+	discoverableBy(userID)(s)
+
+	query, args := s.Query()
+	if n := strings.Count(query, "EXISTS"); n != 3 {
+		t.Errorf("discoverableBy() has %d subqueries, want 3: %s", n, query)
+	}
+	want := []any{
+		user.DiscoverabilityEveryone.String(),
+		userID,
+		user.DiscoverabilityContactsOfContacts.String(),
+		userID,
+		userID,
+	}
+	if len(args) != len(want) {
+		t.Fatalf("discoverableBy() args = %v, want %v", args, want)
+	}
+	for i := range want {
+		if args[i] != want[i] {
+			t.Errorf("discoverableBy() args[%d] = %v, want %v", i, args[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/graph/model"
+	"journeyhub/internal/modules/auth"
+	"journeyhub/internal/platform/ratelimit"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// quietHoursLayout is the format of the quiet hours, in the timezone of the
+// user.
+const quietHoursLayout = "15:04"
+
+var (
+	ErrInvalidTimezone   = errors.New("timezone is not a valid IANA time zone")
+	ErrInvalidQuietHours = errors.New("quiet hours need both a start and an end formatted as 15:04")
+)
+
+type Service interface {
+	UpdateUserSettings(
+		ctx context.Context,
+		input model.UpdateUserSettingsInput,
+	) (*ent.User, error)
+
+	SearchUsers(
+		ctx context.Context,
+		query string,
+		first *int,
+	) ([]*model.UserSearchResult, error)
+}
+
+type service struct {
+	entClient     *ent.Client
+	authService   auth.Service
+	searchLimiter ratelimit.Limiter
+}
+
+func NewService(
+	entClient *ent.Client,
+	authService auth.Service,
+	searchLimiter ratelimit.Limiter,
+) Service {
+	return &service{
+		entClient:     entClient,
+		authService:   authService,
+		searchLimiter: searchLimiter,
+	}
+}
+
+func (s *service) UpdateUserSettings(
+	ctx context.Context,
+	input model.UpdateUserSettingsInput,
+) (*ent.User, error) {
+	currentUserID, err := s.authService.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateSettings(input); err != nil {
+		return nil, newInvalidSettingsError(err)
+	}
+
+	repository := s.entClient
+
+	update := repository.User.
+		UpdateOneID(currentUserID).
+		SetNillableLocale(input.Locale).
+		SetNillableMessagePreviews(input.MessagePreviews).
+		SetNillableTimezone(input.Timezone).
+		SetNillableQuietHoursStart(input.QuietHoursStart).
+		SetNillableQuietHoursEnd(input.QuietHoursEnd).
+		SetNillableHideLastSeen(input.HideLastSeen).
+		SetNillableDiscoverability(input.Discoverability)
+	if input.ClearQuietHours != nil && *input.ClearQuietHours {
+		update.ClearQuietHoursStart().ClearQuietHoursEnd()
+	}
+
+	return update.Save(ctx)
+}
+
+// validateSettings checks the settings the notifications rely on, which
+// would otherwise be ignored when the pushes are sent.
+func validateSettings(input model.UpdateUserSettingsInput) error {
+	if input.Timezone != nil {
+		// An empty name or Local would load the timezone of the server.
+		if *input.Timezone == "" || *input.Timezone == "Local" {
+			return ErrInvalidTimezone
+		}
+		if _, err := time.LoadLocation(*input.Timezone); err != nil {
+			return ErrInvalidTimezone
+		}
+	}
+
+	if (input.QuietHoursStart == nil) != (input.QuietHoursEnd == nil) {
+		return ErrInvalidQuietHours
+	}
+	for _, value := range []*string{input.QuietHoursStart, input.QuietHoursEnd} {
+		if value == nil {
+			continue
+		}
+		if _, err := time.Parse(quietHoursLayout, *value); err != nil {
+			return ErrInvalidQuietHours
+		}
+	}
+
+	return nil
+}
+
+func newInvalidSettingsError(err error) error {
+	return &gqlerror.Error{
+		Err:     err,
+		Message: err.Error(),
+		Extensions: map[string]interface{}{
+			"code": "INVALID_SETTINGS",
+		},
+	}
+}
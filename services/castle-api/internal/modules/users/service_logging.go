@@ -0,0 +1,50 @@
+package users
+
+import (
+	"context"
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/graph/model"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+type serviceLogging struct {
+	logger log.Logger
+	Service
+}
+
+func NewServiceLogging(logger log.Logger, s Service) Service {
+	return &serviceLogging{logger, s}
+}
+
+func (s *serviceLogging) UpdateUserSettings(
+	ctx context.Context,
+	input model.UpdateUserSettingsInput,
+) (user *ent.User, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "UpdateUserSettings",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.UpdateUserSettings(ctx, input)
+}
+
+func (s *serviceLogging) SearchUsers(
+	ctx context.Context,
+	query string,
+	first *int,
+) (users []*model.UserSearchResult, err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "SearchUsers",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.SearchUsers(ctx, query, first)
+}
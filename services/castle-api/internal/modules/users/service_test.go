@@ -0,0 +1,41 @@
+package users
+
+import (
+	"errors"
+	"testing"
+
+	"journeyhub/graph/model"
+)
+
+func TestValidateSettings(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		name  string
+		input model.UpdateUserSettingsInput
+		want  error
+	}{
+		{"empty", model.UpdateUserSettingsInput{}, nil},
+		{"timezone", model.UpdateUserSettingsInput{Timezone: str("Europe/Moscow")}, nil},
+		{"unknown timezone", model.UpdateUserSettingsInput{Timezone: str("Mars/Olympus")}, ErrInvalidTimezone},
+		{"local timezone", model.UpdateUserSettingsInput{Timezone: str("Local")}, ErrInvalidTimezone},
+		{
+			"quiet hours",
+			model.UpdateUserSettingsInput{QuietHoursStart: str("22:00"), QuietHoursEnd: str("07:00")},
+			nil,
+		},
+		{"start without end", model.UpdateUserSettingsInput{QuietHoursStart: str("22:00")}, ErrInvalidQuietHours},
+		{
+			"invalid time",
+			model.UpdateUserSettingsInput{QuietHoursStart: str("25:99"), QuietHoursEnd: str("07:00")},
+			ErrInvalidQuietHours,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateSettings(tt.input); !errors.Is(err, tt.want) {
+				t.Errorf("validateSettings() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package antivirus
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"journeyhub/internal/platform/config"
+)
+
+const (
+	clamdChunkSize      = 64 << 10
+	clamdDefaultTimeout = 30 * time.Second
+)
+
+type clamdScanner struct {
+	config config.AntivirusConfig
+	dialer net.Dialer
+}
+
+// NewClamdScanner returns a scanner backed by the clamd INSTREAM command.
+func NewClamdScanner(config config.AntivirusConfig) Scanner {
+	return &clamdScanner{
+		config: config,
+	}
+}
+
+func (s *clamdScanner) Scan(ctx context.Context, r io.Reader) (*ScanResult, error) {
+	timeout := s.config.Timeout
+	if timeout <= 0 {
+		timeout = clamdDefaultTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	conn, err := s.dialer.DialContext(
+		ctx,
+		"tcp",
+		net.JoinHostPort(s.config.Host, fmt.Sprint(s.config.Port)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrScanFailed, err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrScanFailed, err)
+	}
+
+	chunk := make([]byte, clamdChunkSize)
+	size := make([]byte, 4)
+	for {
+		n, rErr := r.Read(chunk)
+		if n > 0 {
+			binary.BigEndian.PutUint32(size, uint32(n))
+			if _, err := conn.Write(size); err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrScanFailed, err)
+			}
+			if _, err := conn.Write(chunk[:n]); err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrScanFailed, err)
+			}
+		}
+		if rErr == io.EOF {
+			break
+		}
+		if rErr != nil {
+			return nil, rErr
+		}
+	}
+
+	binary.BigEndian.PutUint32(size, 0)
+	if _, err := conn.Write(size); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrScanFailed, err)
+	}
+
+	reply, err := bufio.NewReader(conn).ReadBytes(0)
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("%w: %v", ErrScanFailed, err)
+	}
+
+	return parseClamdReply(string(bytes.TrimRight(reply, "\x00\n")))
+}
+
+func (s *clamdScanner) Name() string {
+	return "clamd"
+}
+
+// parseClamdReply interprets replies such as "stream: OK" or
+// "stream: Eicar-Test-Signature FOUND".
+func parseClamdReply(reply string) (*ScanResult, error) {
+	_, status, ok := strings.Cut(reply, ": ")
+	if !ok {
+		return nil, fmt.Errorf("%w: unexpected reply %q", ErrScanFailed, reply)
+	}
+
+	switch {
+	case status == "OK":
+		return &ScanResult{}, nil
+	case strings.HasSuffix(status, " FOUND"):
+		return &ScanResult{
+			Infected:  true,
+			Signature: strings.TrimSuffix(status, " FOUND"),
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrScanFailed, status)
+	}
+}
@@ -0,0 +1,149 @@
+package antivirus_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"journeyhub/internal/platform/antivirus"
+	"journeyhub/internal/platform/config"
+)
+
+func TestClamdScanner(t *testing.T) {
+	tests := []struct {
+		name      string
+		payload   string
+		reply     string
+		infected  bool
+		signature string
+		err       error
+	}{
+		{
+			name:    "clean",
+			payload: "hello world",
+			reply:   "stream: OK\x00",
+		},
+		{
+			name:      "infected",
+			payload:   antivirus.EICARSignature,
+			reply:     "stream: Eicar-Test-Signature FOUND\x00",
+			infected:  true,
+			signature: "Eicar-Test-Signature",
+		},
+		{
+			name:    "error",
+			payload: "hello world",
+			reply:   "INSTREAM size limit exceeded. ERROR\x00",
+			err:     antivirus.ErrScanFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host, port, received := fakeClamd(t, tt.reply)
+
+			scanner := antivirus.NewClamdScanner(config.AntivirusConfig{
+				Host: host,
+				Port: port,
+			})
+
+			result, err := scanner.Scan(context.Background(), strings.NewReader(tt.payload))
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("expected %v, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result.Infected != tt.infected || result.Signature != tt.signature {
+				t.Fatalf("unexpected result: %+v", result)
+			}
+
+			if got := <-received; got != tt.payload {
+				t.Fatalf("clamd received %q, want %q", got, tt.payload)
+			}
+		})
+	}
+}
+
+func TestFakeScanner(t *testing.T) {
+	scanner := antivirus.NewFakeScanner()
+	scanner.AddSignature("Test-Marker", []byte("malicious"))
+
+	result, err := scanner.Scan(context.Background(), strings.NewReader("some malicious bytes"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Infected || result.Signature != "Test-Marker" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	result, err = scanner.Scan(context.Background(), strings.NewReader("clean"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Infected {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	if scanner.Scanned() != 2 {
+		t.Fatalf("expected 2 scans, got %d", scanner.Scanned())
+	}
+}
+
+// fakeClamd serves a single INSTREAM session and sends the stream it
+// received on the returned channel.
+func fakeClamd(t *testing.T, reply string) (string, int, <-chan string) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	received := make(chan string, 1)
+
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		command := make([]byte, len("zINSTREAM\x00"))
+		if _, err := io.ReadFull(conn, command); err != nil {
+			return
+		}
+
+		var stream bytes.Buffer
+		size := make([]byte, 4)
+		for {
+			if _, err := io.ReadFull(conn, size); err != nil {
+				return
+			}
+			n := binary.BigEndian.Uint32(size)
+			if n == 0 {
+				break
+			}
+			if _, err := io.CopyN(&stream, conn, int64(n)); err != nil {
+				return
+			}
+		}
+
+		received <- stream.String()
+		conn.Write([]byte(reply))
+	}()
+
+	addr := ln.Addr().(*net.TCPAddr)
+
+	return addr.IP.String(), addr.Port, received
+}
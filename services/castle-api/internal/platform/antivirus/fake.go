@@ -0,0 +1,77 @@
+package antivirus
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+)
+
+// EICARSignature is the industry standard antivirus test string.
+const EICARSignature = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+
+// FakeScanner reports a stream as infected when it contains one of the
+// registered patterns. It is intended for tests.
+type FakeScanner struct {
+	mu       sync.Mutex
+	patterns map[string][]byte
+	scanned  int
+	err      error
+}
+
+// NewFakeScanner returns a FakeScanner that detects the EICAR test string.
+func NewFakeScanner() *FakeScanner {
+	return &FakeScanner{
+		patterns: map[string][]byte{
+			"Eicar-Test-Signature": []byte(EICARSignature),
+		},
+	}
+}
+
+// AddSignature registers an additional pattern reported under name.
+func (s *FakeScanner) AddSignature(name string, pattern []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.patterns[name] = pattern
+}
+
+// FailWith makes every following scan return err.
+func (s *FakeScanner) FailWith(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+// Scanned returns the number of streams scanned so far.
+func (s *FakeScanner) Scanned() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.scanned
+}
+
+func (s *FakeScanner) Scan(ctx context.Context, r io.Reader) (*ScanResult, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.scanned++
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	for name, pattern := range s.patterns {
+		if bytes.Contains(data, pattern) {
+			return &ScanResult{Infected: true, Signature: name}, nil
+		}
+	}
+
+	return &ScanResult{}, nil
+}
+
+func (s *FakeScanner) Name() string {
+	return "fake"
+}
@@ -0,0 +1,36 @@
+package antivirus
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrScanFailed = errors.New("antivirus scan failed")
+
+type ScanResult struct {
+	Infected  bool
+	Signature string
+}
+
+type Scanner interface {
+	Scan(ctx context.Context, r io.Reader) (*ScanResult, error)
+
+	Name() string
+}
+
+type nopScanner struct{}
+
+// NewNopScanner returns a scanner that reports every stream as clean.
+// It is used when antivirus scanning is disabled.
+func NewNopScanner() Scanner {
+	return &nopScanner{}
+}
+
+func (s *nopScanner) Scan(ctx context.Context, r io.Reader) (*ScanResult, error) {
+	return &ScanResult{}, nil
+}
+
+func (s *nopScanner) Name() string {
+	return "nop"
+}
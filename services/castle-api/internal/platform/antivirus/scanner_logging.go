@@ -0,0 +1,42 @@
+package antivirus
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+type scannerLogging struct {
+	logger log.Logger
+	Scanner
+}
+
+func NewScannerLogging(logger log.Logger, s Scanner) Scanner {
+	return &scannerLogging{logger, s}
+}
+
+func (s *scannerLogging) Scan(
+	ctx context.Context,
+	r io.Reader,
+) (result *ScanResult, err error) {
+	defer func(begin time.Time) {
+		var infected bool
+		var signature string
+		if result != nil {
+			infected = result.Infected
+			signature = result.Signature
+		}
+		level.Debug(s.logger).Log(
+			"method", "Scan",
+			"scanner", s.Scanner.Name(),
+			"infected", infected,
+			"signature", signature,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Scanner.Scan(ctx, r)
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/knadh/koanf/parsers/yaml"
 	"github.com/knadh/koanf/providers/env"
@@ -11,12 +12,59 @@ import (
 )
 
 type ServerConfig struct {
-	Host string `koanf:"host"`
-	Port int    `koanf:"port"`
+	Host  string `koanf:"host"`
+	Port  int    `koanf:"port"`
+	Debug string `koanf:"debug"`
+}
+
+type PushProvidersConfig struct {
+	Android string `koanf:"android"`
+	IOS     string `koanf:"ios"`
+	Web     string `koanf:"web"`
+}
+
+type APNsConfig struct {
+	Host        string        `koanf:"host"`
+	KeyID       string        `koanf:"keyid"`
+	TeamID      string        `koanf:"teamid"`
+	KeyFile     string        `koanf:"keyfile"`
+	Topic       string        `koanf:"topic"`
+	Development bool          `koanf:"development"`
+	Timeout     time.Duration `koanf:"timeout"`
+}
+
+type WebhookConfig struct {
+	URL     string        `koanf:"url"`
+	Secret  string        `koanf:"secret"`
+	Timeout time.Duration `koanf:"timeout"`
+}
+
+type RetryConfig struct {
+	Attempts   int           `koanf:"attempts"`
+	Backoff    time.Duration `koanf:"backoff"`
+	MaxBackoff time.Duration `koanf:"maxbackoff"`
+}
+
+type PushFeedbackConfig struct {
+	Secret string `koanf:"secret"`
+}
+
+type InvalidTokensConfig struct {
+	Window    time.Duration `koanf:"window"`
+	Threshold float64       `koanf:"threshold"`
+	MinCount  int           `koanf:"mincount"`
 }
 
 type NotificationsConfig struct {
-	Host string `koanf:"host"`
+	Host      string              `koanf:"host"`
+	Debounce  time.Duration       `koanf:"debounce"`
+	Retry     RetryConfig         `koanf:"retry"`
+	Providers PushProvidersConfig `koanf:"providers"`
+	APNs      APNsConfig          `koanf:"apns"`
+	Webhook   WebhookConfig       `koanf:"webhook"`
+
+	Feedback      PushFeedbackConfig  `koanf:"feedback"`
+	InvalidTokens InvalidTokensConfig `koanf:"invalidtokens"`
 }
 
 type AuthConfig struct {
@@ -24,8 +72,41 @@ type AuthConfig struct {
 }
 
 type LivekitConfig struct {
-	Access string `koanf:"access"`
-	Secret string `koanf:"secret"`
+	Access   string        `koanf:"access"`
+	Secret   string        `koanf:"secret"`
+	TokenTTL time.Duration `koanf:"tokenttl"`
+}
+
+type CallsConfig struct {
+	RingTimeout time.Duration `koanf:"ringtimeout"`
+	Interval    time.Duration `koanf:"interval"`
+}
+
+type PresenceConfig struct {
+	Bucket    string        `koanf:"bucket"`
+	Heartbeat time.Duration `koanf:"heartbeat"`
+	TTL       time.Duration `koanf:"ttl"`
+}
+
+type RateLimitConfig struct {
+	Bucket   string        `koanf:"bucket"`
+	Attempts int           `koanf:"attempts"`
+	Window   time.Duration `koanf:"window"`
+}
+
+type ContactPinsConfig struct {
+	TTL       time.Duration `koanf:"ttl"`
+	MaxActive int           `koanf:"maxactive"`
+	Secret    string        `koanf:"secret"`
+}
+
+type ContactsConfig struct {
+	Pins      ContactPinsConfig `koanf:"pins"`
+	RateLimit RateLimitConfig   `koanf:"ratelimit"`
+}
+
+type UsersConfig struct {
+	Search RateLimitConfig `koanf:"search"`
 }
 
 type NatsConfig struct {
@@ -50,14 +131,62 @@ type S3Config struct {
 	Ssl    bool   `koanf:"ssl"`
 }
 
+type QuotaConfig struct {
+	User int64 `koanf:"user"`
+	Room int64 `koanf:"room"`
+}
+
+type MediaPolicyRuleConfig struct {
+	MaxSize int64    `koanf:"maxsize"`
+	Allow   []string `koanf:"allow"`
+	Deny    []string `koanf:"deny"`
+}
+
+type MediaPolicyConfig struct {
+	Media  MediaPolicyRuleConfig `koanf:"media"`
+	File   MediaPolicyRuleConfig `koanf:"file"`
+	Voice  MediaPolicyRuleConfig `koanf:"voice"`
+	Avatar MediaPolicyRuleConfig `koanf:"avatar"`
+}
+
+type AvatarConfig struct {
+	Sizes        []int `koanf:"sizes"`
+	MaxDimension int   `koanf:"maxdimension"`
+}
+
+type MediaConfig struct {
+	Quota  QuotaConfig       `koanf:"quota"`
+	Policy MediaPolicyConfig `koanf:"policy"`
+	Avatar AvatarConfig      `koanf:"avatar"`
+}
+
+type AntivirusConfig struct {
+	Enabled bool          `koanf:"enabled"`
+	Host    string        `koanf:"host"`
+	Port    int           `koanf:"port"`
+	Timeout time.Duration `koanf:"timeout"`
+}
+
+type OutboxConfig struct {
+	Interval  time.Duration `koanf:"interval"`
+	BatchSize int           `koanf:"batchsize"`
+}
+
 type Config struct {
 	Server        ServerConfig        `koanf:"server"`
 	Auth          AuthConfig          `koanf:"auth"`
 	Notifications NotificationsConfig `koanf:"notifications"`
 	Livekit       LivekitConfig       `koanf:"livekit"`
+	Calls         CallsConfig         `koanf:"calls"`
+	Presence      PresenceConfig      `koanf:"presence"`
+	Contacts      ContactsConfig      `koanf:"contacts"`
+	Users         UsersConfig         `koanf:"users"`
 	Nats          NatsConfig          `koanf:"nats"`
 	Database      DatabaseConfig      `koanf:"database"`
 	S3            S3Config            `koanf:"s3"`
+	Media         MediaConfig         `koanf:"media"`
+	Antivirus     AntivirusConfig     `koanf:"antivirus"`
+	Outbox        OutboxConfig        `koanf:"outbox"`
 }
 
 var (
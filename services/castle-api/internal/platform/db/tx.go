@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"journeyhub/ent"
+)
+
+// WithTx runs fn in a transaction carried by the context passed to it.
+// Calls nested in fn join the transaction already in progress.
+func WithTx(
+	ctx context.Context,
+	client *ent.Client,
+	fn func(ctx context.Context) error,
+) error {
+	if ent.TxFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	txCtx := ent.NewTxContext(ctx, tx)
+
+	if err := fn(txCtx); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			return errors.Join(err, rErr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Client returns the client of the transaction carried by ctx, or fallback
+// outside of a transaction.
+func Client(ctx context.Context, fallback *ent.Client) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	return fallback
+}
+
+// AfterCommit runs fn once the transaction carried by ctx is committed, or
+// right away outside of a transaction. It is meant for side effects such as
+// events that must not be observed before the data is visible.
+func AfterCommit(ctx context.Context, fn func()) {
+	tx := ent.TxFromContext(ctx)
+	if tx == nil {
+		fn()
+		return
+	}
+
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+			fn()
+			return nil
+		})
+	})
+}
+
+// Detach returns a context without the transaction and the cancellation of
+// ctx, for work carried on once the transaction is committed.
+func Detach(ctx context.Context) context.Context {
+	return ent.NewTxContext(context.WithoutCancel(ctx), nil)
+}
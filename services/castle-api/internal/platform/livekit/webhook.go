@@ -0,0 +1,93 @@
+package livekit
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+
+	"journeyhub/internal/platform/config"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	EventRoomStarted       = "room_started"
+	EventRoomFinished      = "room_finished"
+	EventParticipantJoined = "participant_joined"
+	EventParticipantLeft   = "participant_left"
+)
+
+var (
+	ErrNoAuthHeader    = errors.New("webhook authorization header is missing")
+	ErrUnknownAPIKey   = errors.New("webhook is signed with an unknown api key")
+	ErrInvalidChecksum = errors.New("webhook checksum does not match")
+)
+
+// ReceiveWebhookEvent reads the webhook event of the request and verifies
+// it is signed with the configured API key and secret.
+func ReceiveWebhookEvent(r *http.Request, config config.LivekitConfig) (*livekit.WebhookEvent, error) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	token := r.Header.Get("Authorization")
+	if token == "" {
+		return nil, ErrNoAuthHeader
+	}
+
+	verifier, err := auth.ParseAPIToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if verifier.APIKey() != config.Access {
+		return nil, ErrUnknownAPIKey
+	}
+
+	claims, err := verifier.Verify(config.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(data)
+	checksum := base64.StdEncoding.EncodeToString(sum[:])
+	if subtle.ConstantTimeCompare([]byte(claims.Sha256), []byte(checksum)) != 1 {
+		return nil, ErrInvalidChecksum
+	}
+
+	event := &livekit.WebhookEvent{}
+	err = protojson.UnmarshalOptions{DiscardUnknown: true, AllowPartial: true}.Unmarshal(data, event)
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// NewWebhookHandler returns the handler of the LiveKit webhooks, passing the
+// verified events to handle.
+func NewWebhookHandler(
+	config config.LivekitConfig,
+	handle func(ctx context.Context, event *livekit.WebhookEvent) error,
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event, err := ReceiveWebhookEvent(r, config)
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if err := handle(r.Context(), event); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
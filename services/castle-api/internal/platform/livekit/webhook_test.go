@@ -0,0 +1,106 @@
+package livekit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"journeyhub/internal/platform/config"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+)
+
+var testConfig = config.LivekitConfig{
+	Access: "devkey",
+	Secret: "secret",
+}
+
+func signedRequest(t *testing.T, fixture, key, secret string) *http.Request {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", fixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sum := sha256.Sum256(data)
+	token, err := auth.NewAccessToken(key, secret).
+		SetValidFor(time.Minute).
+		SetSha256(base64.StdEncoding.EncodeToString(sum[:])).
+		ToJWT()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/livekit/webhook", bytes.NewReader(data))
+	r.Header.Set("Authorization", token)
+	r.Header.Set("Content-Type", "application/webhook+json")
+	return r
+}
+
+func TestReceiveWebhookEvent(t *testing.T) {
+	event, err := ReceiveWebhookEvent(signedRequest(t, "participant_joined.json", "devkey", "secret"), testConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Event != EventParticipantJoined ||
+		event.Room.Name != "CA01J9ZC5QK2T3V8X7B6N4M1P0" ||
+		event.Participant.Identity != "UR01J9ZC5QK2T3V8X7B6N4M1P1" {
+		t.Fatalf("unexpected event: %v", event)
+	}
+
+	_, err = ReceiveWebhookEvent(signedRequest(t, "participant_joined.json", "devkey", "wrong"), testConfig)
+	if err == nil {
+		t.Fatal("expected error for a wrong secret")
+	}
+
+	_, err = ReceiveWebhookEvent(signedRequest(t, "participant_joined.json", "otherkey", "secret"), testConfig)
+	if !errors.Is(err, ErrUnknownAPIKey) {
+		t.Fatalf("expected %v, got %v", ErrUnknownAPIKey, err)
+	}
+
+	r := signedRequest(t, "participant_joined.json", "devkey", "secret")
+	r.Body = http.NoBody
+	if _, err = ReceiveWebhookEvent(r, testConfig); !errors.Is(err, ErrInvalidChecksum) {
+		t.Fatalf("expected %v, got %v", ErrInvalidChecksum, err)
+	}
+
+	r = signedRequest(t, "participant_joined.json", "devkey", "secret")
+	r.Header.Del("Authorization")
+	if _, err = ReceiveWebhookEvent(r, testConfig); !errors.Is(err, ErrNoAuthHeader) {
+		t.Fatalf("expected %v, got %v", ErrNoAuthHeader, err)
+	}
+}
+
+func TestWebhookHandler(t *testing.T) {
+	var events []string
+	handler := NewWebhookHandler(testConfig, func(ctx context.Context, event *livekit.WebhookEvent) error {
+		events = append(events, event.Event)
+		return nil
+	})
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, signedRequest(t, "room_finished.json", "devkey", "secret"))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected %d, got %d", http.StatusOK, w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, signedRequest(t, "room_finished.json", "devkey", "wrong"))
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+
+	if len(events) != 1 || events[0] != EventRoomFinished {
+		t.Fatalf("unexpected events: %v", events)
+	}
+}
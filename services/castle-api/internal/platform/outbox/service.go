@@ -0,0 +1,132 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"journeyhub/ent"
+	"journeyhub/ent/outboxmessage"
+	"journeyhub/ent/schema/pulid"
+	"journeyhub/internal/platform/config"
+	"journeyhub/internal/platform/db"
+	"journeyhub/internal/platform/nats"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+type Service interface {
+	// Enqueue stores a message in the transaction carried by ctx and
+	// publishes it to JetStream once the transaction is committed.
+	Enqueue(
+		ctx context.Context,
+		subject string,
+		payload []byte,
+	) error
+
+	// Relay publishes the messages left behind by failed publishes until
+	// ctx is done.
+	Relay(ctx context.Context) error
+}
+
+type service struct {
+	config      config.OutboxConfig
+	entClient   *ent.Client
+	natsService nats.Service
+	logger      log.Logger
+}
+
+func NewService(
+	config config.OutboxConfig,
+	entClient *ent.Client,
+	natsService nats.Service,
+	logger log.Logger,
+) Service {
+	return &service{
+		config:      config,
+		entClient:   entClient,
+		natsService: natsService,
+		logger:      logger,
+	}
+}
+
+func (s *service) Enqueue(
+	ctx context.Context,
+	subject string,
+	payload []byte,
+) error {
+	repository := db.Client(ctx, s.entClient)
+
+	message, err := repository.OutboxMessage.
+		Create().
+		SetSubject(subject).
+		SetPayload(payload).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	db.AfterCommit(ctx, func() {
+		// A message failing to publish here is picked up by the relay.
+		s.publish(context.WithoutCancel(ctx), message)
+	})
+
+	return nil
+}
+
+func (s *service) Relay(ctx context.Context) error {
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		messages, err := s.entClient.OutboxMessage.
+			Query().
+			Where(outboxmessage.CreatedAtLT(time.Now().Add(-s.config.Interval))).
+			Order(ent.Asc(outboxmessage.FieldCreatedAt)).
+			Limit(s.config.BatchSize).
+			All(ctx)
+		if err != nil {
+			level.Error(s.logger).Log("method", "Relay", "err", err)
+			continue
+		}
+
+		for _, message := range messages {
+			if err := s.publish(ctx, message); err != nil {
+				level.Error(s.logger).Log("method", "Relay", "messageID", message.ID, "err", err)
+				break
+			}
+		}
+	}
+}
+
+// publish sends the message to JetStream and deletes it. The message ID is
+// used for deduplication, so a message published both after the commit and
+// by the relay is only delivered once.
+func (s *service) publish(ctx context.Context, message *ent.OutboxMessage) error {
+	js := *s.natsService.JetStream()
+
+	_, err := js.Publish(
+		ctx,
+		message.Subject,
+		message.Payload,
+		jetstream.WithMsgID(string(message.ID)),
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.delete(ctx, message.ID)
+}
+
+func (s *service) delete(ctx context.Context, ID pulid.ID) error {
+	return s.entClient.OutboxMessage.
+		DeleteOneID(ID).
+		Exec(ctx)
+}
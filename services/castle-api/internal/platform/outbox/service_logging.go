@@ -0,0 +1,47 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+type serviceLogging struct {
+	logger log.Logger
+	Service
+}
+
+func NewServiceLogging(logger log.Logger, s Service) Service {
+	return &serviceLogging{logger, s}
+}
+
+func (s *serviceLogging) Enqueue(
+	ctx context.Context,
+	subject string,
+	payload []byte,
+) (err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "Enqueue",
+			"subject", subject,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Enqueue(ctx, subject, payload)
+}
+
+func (s *serviceLogging) Relay(
+	ctx context.Context,
+) (err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "Relay",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Relay(ctx)
+}
@@ -0,0 +1,229 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"journeyhub/internal/platform/config"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+const (
+	apnsProductionHost  = "https://api.push.apple.com"
+	apnsDevelopmentHost = "https://api.sandbox.push.apple.com"
+
+	// APNs rejects provider tokens older than one hour and throttles
+	// tokens refreshed more often than every 20 minutes.
+	apnsTokenLifetime = 50 * time.Minute
+)
+
+var ErrInvalidAPNsKey = errors.New("invalid APNs signing key")
+
+// APNsSender delivers messages to iOS devices directly through the Apple
+// Push Notification service, authenticating with a provider token.
+type APNsSender struct {
+	config     config.APNsConfig
+	host       string
+	key        *ecdsa.PrivateKey
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	token     string
+	tokenTime time.Time
+}
+
+func NewAPNsSender(apnsConfig config.APNsConfig) (*APNsSender, error) {
+	data, err := os.ReadFile(apnsConfig.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := parseAPNsKey(data)
+	if err != nil {
+		return nil, err
+	}
+
+	host := apnsConfig.Host
+	if host == "" {
+		host = apnsProductionHost
+		if apnsConfig.Development {
+			host = apnsDevelopmentHost
+		}
+	}
+
+	return &APNsSender{
+		config:     apnsConfig,
+		host:       host,
+		key:        key,
+		httpClient: &http.Client{Timeout: apnsConfig.Timeout},
+	}, nil
+}
+
+func (s *APNsSender) Send(ctx context.Context, message *Message) error {
+	if message.Platform != PlatformIOS {
+		return fmt.Errorf("%w: %s", ErrUnsupportedPlatform, message.Platform)
+	}
+
+	token, err := s.providerToken()
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(apnsPayload(message))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s/3/device/%s", s.host, message.Token),
+		bytes.NewReader(payload),
+	)
+	if err != nil {
+		return err
+	}
+
+	topic, pushType, priority := s.config.Topic, "alert", 10
+	switch {
+	case message.VoIP:
+		topic, pushType = topic+".voip", "voip"
+	case message.Title == "" && message.Body == "":
+		pushType, priority = "background", 5
+	case message.Silent:
+		priority = 5
+	}
+
+	req.Header.Set("authorization", "bearer "+token)
+	req.Header.Set("apns-topic", topic)
+	req.Header.Set("apns-push-type", pushType)
+	req.Header.Set("apns-priority", strconv.Itoa(priority))
+	req.Header.Set("content-type", "application/json")
+	if message.CollapseKey != "" {
+		req.Header.Set("apns-collapse-id", message.CollapseKey)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var reply struct {
+			Reason string `json:"reason"`
+		}
+		json.NewDecoder(resp.Body).Decode(&reply)
+		if resp.StatusCode == http.StatusGone || IsInvalidTokenReason(reply.Reason) {
+			return fmt.Errorf("%w: %w: apns %d %s", ErrDeliveryFailed, ErrInvalidToken, resp.StatusCode, reply.Reason)
+		}
+		return fmt.Errorf("%w: apns %d %s", ErrDeliveryFailed, resp.StatusCode, reply.Reason)
+	}
+
+	return nil
+}
+
+func (s *APNsSender) Name() string {
+	return "apns"
+}
+
+// providerToken returns the cached provider token, signing a new one once
+// the previous has expired.
+func (s *APNsSender) providerToken() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.token != "" && now.Sub(s.tokenTime) < apnsTokenLifetime {
+		return s.token, nil
+	}
+
+	t, err := jwt.NewBuilder().
+		Issuer(s.config.TeamID).
+		IssuedAt(now).
+		Build()
+	if err != nil {
+		return "", err
+	}
+
+	headers := jws.NewHeaders()
+	if err := headers.Set(jws.KeyIDKey, s.config.KeyID); err != nil {
+		return "", err
+	}
+
+	signed, err := jwt.Sign(t, jwt.WithKey(jwa.ES256, s.key, jws.WithProtectedHeaders(headers)))
+	if err != nil {
+		return "", err
+	}
+
+	s.token = string(signed)
+	s.tokenTime = now
+
+	return s.token, nil
+}
+
+func apnsPayload(message *Message) map[string]interface{} {
+	aps := map[string]interface{}{}
+	if message.Title != "" || message.Body != "" {
+		aps["alert"] = map[string]string{
+			"title": message.Title,
+			"body":  message.Body,
+		}
+		if message.Silent {
+			aps["interruption-level"] = "passive"
+		} else {
+			aps["sound"] = "default"
+		}
+	}
+	if message.Badge > 0 {
+		aps["badge"] = message.Badge
+	}
+	if message.ContentAvailable {
+		aps["content-available"] = 1
+	}
+	if message.CollapseKey != "" {
+		aps["thread-id"] = message.CollapseKey
+	}
+
+	payload := make(map[string]interface{}, len(message.Data)+1)
+	for key, value := range message.Data {
+		payload[key] = value
+	}
+	payload["aps"] = aps
+
+	return payload
+}
+
+// parseAPNsKey parses the PKCS #8 encoded .p8 key issued by Apple.
+func parseAPNsKey(data []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrInvalidAPNsKey
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidAPNsKey, err)
+	}
+
+	ecdsaKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, ErrInvalidAPNsKey
+	}
+
+	return ecdsaKey, nil
+}
@@ -0,0 +1,56 @@
+package push
+
+import (
+	"context"
+	"sync"
+)
+
+// FakeSender records the messages it is asked to send. It is intended for
+// tests.
+type FakeSender struct {
+	mu   sync.Mutex
+	sent []Message
+	err  error
+}
+
+func NewFakeSender() *FakeSender {
+	return &FakeSender{}
+}
+
+// FailWith makes every following send return err.
+func (s *FakeSender) FailWith(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+// Sent returns a copy of the messages sent so far.
+func (s *FakeSender) Sent() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.sent...)
+}
+
+// Reset forgets the messages sent so far.
+func (s *FakeSender) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = nil
+}
+
+func (s *FakeSender) Send(ctx context.Context, message *Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return s.err
+	}
+
+	s.sent = append(s.sent, *message)
+
+	return nil
+}
+
+func (s *FakeSender) Name() string {
+	return "fake"
+}
@@ -0,0 +1,72 @@
+package push
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+
+	"journeyhub/internal/platform/config"
+)
+
+const FeedbackSecretHeader = "X-Castle-Secret"
+
+// Feedback is a push reported by the gorush feedback hook.
+type Feedback struct {
+	ID       string `json:"notif_id"`
+	Type     string `json:"type"`
+	Platform string `json:"platform"`
+	Token    string `json:"token"`
+	Message  string `json:"message"`
+	Error    string `json:"error"`
+}
+
+// Failed reports whether the push could not be delivered.
+func (f *Feedback) Failed() bool {
+	return f.Type == "failed-push"
+}
+
+// InvalidToken reports whether the push failed because its token is no
+// longer valid.
+func (f *Feedback) InvalidToken() bool {
+	return f.Failed() && IsInvalidTokenReason(f.Error)
+}
+
+// NewFeedbackHandler returns the handler of the gorush feedback hook,
+// passing the failed pushes to handle. It rejects every call when no secret
+// is configured.
+func NewFeedbackHandler(
+	config config.PushFeedbackConfig,
+	monitor *Monitor,
+	handle func(ctx context.Context, feedback *Feedback) error,
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		secret := r.Header.Get(FeedbackSecretHeader)
+		if config.Secret == "" || subtle.ConstantTimeCompare([]byte(secret), []byte(config.Secret)) != 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var feedback Feedback
+		if err := json.NewDecoder(r.Body).Decode(&feedback); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if !feedback.Failed() || feedback.Token == "" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		if feedback.InvalidToken() {
+			monitor.ObserveInvalidToken()
+		}
+
+		if err := handle(r.Context(), &feedback); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,115 @@
+package push
+
+import (
+	"context"
+	"fmt"
+
+	"journeyhub/internal/platform/config"
+
+	"github.com/appleboy/gorush/rpc/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// gorush platform identifiers.
+var gorushPlatforms = map[Platform]int32{
+	PlatformIOS:     1,
+	PlatformAndroid: 2,
+}
+
+// GorushSender delivers messages through a gorush gRPC server, which
+// forwards them to FCM or APNs.
+type GorushSender struct {
+	config config.NotificationsConfig
+	conn   *grpc.ClientConn
+	client proto.GorushClient
+}
+
+func NewGorushSender(notificationsConfig config.NotificationsConfig) (*GorushSender, error) {
+	conn, err := grpc.NewClient(
+		notificationsConfig.Host,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GorushSender{
+		config: notificationsConfig,
+		conn:   conn,
+		client: proto.NewGorushClient(conn),
+	}, nil
+}
+
+func (s *GorushSender) Send(ctx context.Context, message *Message) error {
+	platform, ok := gorushPlatforms[message.Platform]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnsupportedPlatform, message.Platform)
+	}
+
+	fields := make(map[string]*structpb.Value, len(message.Data)+1)
+	for key, value := range message.Data {
+		fields[key] = structpb.NewStringValue(value)
+	}
+
+	// The gorush gRPC API has no collapse key, the application tags its
+	// notifications with the one passed in the data instead.
+	if message.CollapseKey != "" {
+		fields["collapseKey"] = structpb.NewStringValue(message.CollapseKey)
+	}
+
+	request := &proto.NotificationRequest{
+		Platform:         platform,
+		Tokens:           []string{message.Token},
+		Priority:         proto.NotificationRequest_HIGH,
+		ContentAvailable: message.ContentAvailable,
+		Title:            message.Title,
+		Message:          message.Body,
+		Badge:            int32(message.Badge),
+		Data:             &structpb.Struct{Fields: fields},
+	}
+
+	if message.Silent {
+		request.Priority = proto.NotificationRequest_NORMAL
+	} else if message.Title != "" || message.Body != "" {
+		request.Sound = "default"
+	}
+
+	if message.Platform == PlatformIOS {
+		request.Topic = s.config.APNs.Topic
+		request.Development = s.config.APNs.Development
+		request.ThreadID = message.CollapseKey
+		if message.VoIP {
+			request.Topic += ".voip"
+			request.PushType = "voip"
+		}
+	}
+
+	reply, err := s.client.Send(ctx, request)
+	if err != nil {
+		if reason := status.Convert(err).Message(); IsInvalidTokenReason(reason) {
+			return fmt.Errorf("%w: %w: gorush %s", ErrDeliveryFailed, ErrInvalidToken, reason)
+		}
+		return err
+	}
+	if !reply.GetSuccess() {
+		return ErrDeliveryFailed
+	}
+	// gorush counts the tokens it accepted, a single token it did not count
+	// was rejected.
+	if reply.GetCounts() < int32(len(request.Tokens)) {
+		return fmt.Errorf("%w: %w: gorush rejected the token", ErrDeliveryFailed, ErrInvalidToken)
+	}
+
+	return nil
+}
+
+func (s *GorushSender) Name() string {
+	return "gorush"
+}
+
+func (s *GorushSender) Close() error {
+	return s.conn.Close()
+}
@@ -0,0 +1,134 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"sync"
+	"time"
+
+	"journeyhub/internal/platform/config"
+)
+
+const monitorBuckets = 10
+
+var (
+	pushesSent         = expvar.NewInt("push_sent_total")
+	pushesFailed       = expvar.NewInt("push_failed_total")
+	invalidTokens      = expvar.NewInt("push_invalid_tokens_total")
+	invalidTokenRate   = expvar.NewFloat("push_invalid_token_rate")
+	invalidTokensAlert = expvar.NewInt("push_invalid_tokens_alert")
+)
+
+type monitorBucket struct {
+	start   time.Time
+	sent    int
+	invalid int
+}
+
+// Monitor tracks the share of pushes rejected for invalid tokens over a
+// sliding window and raises the push_invalid_tokens_alert variable when it
+// spikes above the configured threshold.
+type Monitor struct {
+	config  config.InvalidTokensConfig
+	now     func() time.Time
+	mu      sync.Mutex
+	buckets [monitorBuckets]monitorBucket
+	alert   bool
+}
+
+func NewMonitor(config config.InvalidTokensConfig) *Monitor {
+	return &Monitor{
+		config: config,
+		now:    time.Now,
+	}
+}
+
+// Observe records the outcome of a push.
+func (m *Monitor) Observe(err error) {
+	pushesSent.Add(1)
+	switch {
+	case errors.Is(err, ErrInvalidToken):
+		invalidTokens.Add(1)
+		m.record(1, 1)
+	case err != nil:
+		pushesFailed.Add(1)
+		m.record(1, 0)
+	default:
+		m.record(1, 0)
+	}
+}
+
+// ObserveInvalidToken records a token reported as invalid after the push
+// was accepted, e.g. by the gorush feedback hook.
+func (m *Monitor) ObserveInvalidToken() {
+	invalidTokens.Add(1)
+	m.record(0, 1)
+}
+
+// Alert reports whether invalid tokens currently exceed the threshold.
+func (m *Monitor) Alert() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.alert
+}
+
+func (m *Monitor) record(sent, invalid int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.config.Window <= 0 {
+		return
+	}
+
+	now := m.now()
+	size := m.config.Window / monitorBuckets
+	start := now.Truncate(size)
+
+	bucket := &m.buckets[start.UnixNano()/int64(size)%monitorBuckets]
+	if !bucket.start.Equal(start) {
+		*bucket = monitorBucket{start: start}
+	}
+	bucket.sent += sent
+	bucket.invalid += invalid
+
+	var totalSent, totalInvalid int
+	for _, b := range m.buckets {
+		if now.Sub(b.start) < m.config.Window {
+			totalSent += b.sent
+			totalInvalid += b.invalid
+		}
+	}
+
+	rate := 0.0
+	if totalSent > 0 {
+		rate = float64(totalInvalid) / float64(totalSent)
+	} else if totalInvalid > 0 {
+		rate = 1
+	}
+	invalidTokenRate.Set(rate)
+
+	m.alert = totalInvalid >= m.config.MinCount && rate >= m.config.Threshold
+	if m.alert {
+		invalidTokensAlert.Set(1)
+	} else {
+		invalidTokensAlert.Set(0)
+	}
+}
+
+type senderMonitoring struct {
+	monitor *Monitor
+	Sender
+}
+
+// NewSenderMonitoring returns a Sender reporting the outcome of every push
+// to the monitor.
+func NewSenderMonitoring(monitor *Monitor, s Sender) Sender {
+	return &senderMonitoring{monitor, s}
+}
+
+func (s *senderMonitoring) Send(ctx context.Context, message *Message) error {
+	err := s.Sender.Send(ctx, message)
+	s.monitor.Observe(err)
+	return err
+}
@@ -0,0 +1,118 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrUnsupportedPlatform = errors.New("push platform is not supported")
+	ErrDeliveryFailed      = errors.New("push delivery failed")
+	ErrInvalidToken        = errors.New("push token is no longer valid")
+)
+
+// invalidTokenReasons are the errors reported by FCM and APNs for tokens
+// that will never be deliverable again.
+var invalidTokenReasons = []string{
+	"NotRegistered",
+	"InvalidRegistration",
+	"UNREGISTERED",
+	"registration-token-not-registered",
+	"invalid-registration-token",
+	"Requested entity was not found",
+	"Unregistered",
+	"BadDeviceToken",
+	"DeviceTokenNotForTopic",
+}
+
+// IsInvalidTokenReason reports whether a provider error means that the
+// device token has to be dropped.
+func IsInvalidTokenReason(reason string) bool {
+	for _, r := range invalidTokenReasons {
+		if strings.Contains(reason, r) {
+			return true
+		}
+	}
+	return false
+}
+
+type Platform string
+
+const (
+	PlatformAndroid Platform = "Android"
+	PlatformIOS     Platform = "IOS"
+	PlatformWeb     Platform = "Web"
+)
+
+// Message is a push notification addressed to a single device token.
+type Message struct {
+	Platform Platform          `json:"platform"`
+	Token    string            `json:"token"`
+	Title    string            `json:"title,omitempty"`
+	Body     string            `json:"body,omitempty"`
+	Data     map[string]string `json:"data,omitempty"`
+	Badge    int               `json:"badge,omitempty"`
+
+	// CollapseKey groups messages so that a newer one replaces the
+	// previous of the group on the device.
+	CollapseKey string `json:"collapseKey,omitempty"`
+
+	// ContentAvailable wakes the application up to handle the data in the
+	// background.
+	ContentAvailable bool `json:"contentAvailable,omitempty"`
+
+	// Silent delivers the message quietly, without sound and at normal
+	// priority.
+	Silent bool `json:"silent,omitempty"`
+
+	// VoIP marks an incoming call push, delivered through PushKit on iOS.
+	// Token must then be the VoIP token of the device.
+	VoIP bool `json:"voip,omitempty"`
+}
+
+type Sender interface {
+	Send(ctx context.Context, message *Message) error
+
+	Name() string
+}
+
+type router struct {
+	senders map[Platform]Sender
+}
+
+// NewRouter returns a Sender delivering each message with the sender
+// registered for its platform.
+func NewRouter(senders map[Platform]Sender) Sender {
+	return &router{
+		senders: senders,
+	}
+}
+
+func (r *router) Send(ctx context.Context, message *Message) error {
+	sender, ok := r.senders[message.Platform]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnsupportedPlatform, message.Platform)
+	}
+	return sender.Send(ctx, message)
+}
+
+func (r *router) Name() string {
+	return "router"
+}
+
+type nopSender struct{}
+
+// NewNopSender returns a Sender that drops every message.
+func NewNopSender() Sender {
+	return nopSender{}
+}
+
+func (nopSender) Send(ctx context.Context, message *Message) error {
+	return nil
+}
+
+func (nopSender) Name() string {
+	return "nop"
+}
@@ -0,0 +1,35 @@
+package push
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+type senderLogging struct {
+	logger log.Logger
+	Sender
+}
+
+func NewSenderLogging(logger log.Logger, s Sender) Sender {
+	return &senderLogging{logger, s}
+}
+
+func (s *senderLogging) Send(
+	ctx context.Context,
+	message *Message,
+) (err error) {
+	defer func(begin time.Time) {
+		level.Debug(s.logger).Log(
+			"method", "Send",
+			"sender", s.Sender.Name(),
+			"platform", message.Platform,
+			"voip", message.VoIP,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Sender.Send(ctx, message)
+}
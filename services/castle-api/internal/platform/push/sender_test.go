@@ -0,0 +1,337 @@
+package push
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"journeyhub/internal/platform/config"
+
+	"github.com/appleboy/gorush/rpc/proto"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRouter(t *testing.T) {
+	android := NewFakeSender()
+	ios := NewFakeSender()
+
+	sender := NewRouter(map[Platform]Sender{
+		PlatformAndroid: android,
+		PlatformIOS:     ios,
+	})
+
+	ctx := context.Background()
+
+	if err := sender.Send(ctx, &Message{Platform: PlatformIOS, Token: "ios"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := sender.Send(ctx, &Message{Platform: PlatformAndroid, Token: "android"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := sender.Send(ctx, &Message{Platform: PlatformWeb, Token: "web"}); !errors.Is(err, ErrUnsupportedPlatform) {
+		t.Fatalf("expected %v, got %v", ErrUnsupportedPlatform, err)
+	}
+
+	if sent := ios.Sent(); len(sent) != 1 || sent[0].Token != "ios" {
+		t.Fatalf("unexpected iOS messages: %+v", sent)
+	}
+	if sent := android.Sent(); len(sent) != 1 || sent[0].Token != "android" {
+		t.Fatalf("unexpected Android messages: %+v", sent)
+	}
+}
+
+func TestAPNsSender(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyFile := filepath.Join(t.TempDir(), "AuthKey.p8")
+	err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type request struct {
+		path    string
+		header  http.Header
+		payload map[string]interface{}
+	}
+	requests := make(chan request, 1)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		requests <- request{r.URL.Path, r.Header, payload}
+
+		if strings.HasSuffix(r.URL.Path, "/bad-token") {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"reason":"BadDeviceToken"}`))
+		}
+	}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+
+	sender, err := NewAPNsSender(config.APNsConfig{
+		Host:    server.URL,
+		KeyID:   "KEY123",
+		TeamID:  "TEAM123",
+		KeyFile: keyFile,
+		Topic:   "com.example.castle",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sender.httpClient = server.Client()
+
+	ctx := context.Background()
+
+	err = sender.Send(ctx, &Message{
+		Platform: PlatformIOS,
+		Token:    "voip-token",
+		Title:    "Alice",
+		Body:     "Incoming call",
+		Data:     map[string]string{"callID": "1"},
+		VoIP:     true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := <-requests
+	if got.path != "/3/device/voip-token" {
+		t.Fatalf("unexpected path %q", got.path)
+	}
+	if got.header.Get("apns-push-type") != "voip" || got.header.Get("apns-topic") != "com.example.castle.voip" {
+		t.Fatalf("unexpected headers: %v", got.header)
+	}
+	if got.payload["callID"] != "1" || got.payload["aps"] == nil {
+		t.Fatalf("unexpected payload: %v", got.payload)
+	}
+
+	token := strings.TrimPrefix(got.header.Get("authorization"), "bearer ")
+	parsed, err := jwt.ParseString(token, jwt.WithKey(jwa.ES256, &key.PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Issuer() != "TEAM123" {
+		t.Fatalf("unexpected issuer %q", parsed.Issuer())
+	}
+	message, err := jws.ParseString(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kid := message.Signatures()[0].ProtectedHeaders().KeyID(); kid != "KEY123" {
+		t.Fatalf("unexpected key id %q", kid)
+	}
+
+	err = sender.Send(ctx, &Message{Platform: PlatformIOS, Token: "bad-token", Body: "hello"})
+	if !errors.Is(err, ErrInvalidToken) || !strings.Contains(err.Error(), "BadDeviceToken") {
+		t.Fatalf("expected invalid token, got %v", err)
+	}
+	if got := <-requests; got.header.Get("apns-push-type") != "alert" {
+		t.Fatalf("unexpected headers: %v", got.header)
+	}
+}
+
+func TestWebhookSender(t *testing.T) {
+	signatures := make(chan string, 1)
+	bodies := make(chan []byte, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies <- body
+		signatures <- r.Header.Get(WebhookSignatureHeader)
+	}))
+	defer server.Close()
+
+	sender := NewWebhookSender(config.WebhookConfig{
+		URL:    server.URL,
+		Secret: "secret",
+	})
+
+	err := sender.Send(context.Background(), &Message{Platform: PlatformWeb, Token: "web", Title: "hi"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := <-bodies
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(body)
+	if want := "sha256=" + hex.EncodeToString(mac.Sum(nil)); <-signatures != want {
+		t.Fatal("unexpected webhook signature")
+	}
+
+	var message Message
+	if err := json.Unmarshal(body, &message); err != nil {
+		t.Fatal(err)
+	}
+	if message.Token != "web" || message.Title != "hi" {
+		t.Fatalf("unexpected message: %+v", message)
+	}
+}
+
+func TestGorushSender(t *testing.T) {
+	tests := []struct {
+		name    string
+		reply   *proto.NotificationReply
+		err     error
+		wantErr error
+	}{
+		{
+			name:  "accepted",
+			reply: &proto.NotificationReply{Success: true, Counts: 1},
+		},
+		{
+			name:    "token rejected",
+			reply:   &proto.NotificationReply{Success: true},
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "token unregistered",
+			err:     status.Error(codes.InvalidArgument, "Requested entity was not found."),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "not delivered",
+			reply:   &proto.NotificationReply{},
+			wantErr: ErrDeliveryFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sender := &GorushSender{
+				client: fakeGorushClient{reply: tt.reply, err: tt.err},
+			}
+
+			err := sender.Send(context.Background(), &Message{Platform: PlatformAndroid, Token: "android"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+type fakeGorushClient struct {
+	reply *proto.NotificationReply
+	err   error
+}
+
+func (c fakeGorushClient) Send(
+	ctx context.Context,
+	in *proto.NotificationRequest,
+	opts ...grpc.CallOption,
+) (*proto.NotificationReply, error) {
+	return c.reply, c.err
+}
+
+func TestMonitor(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	monitor := NewMonitor(config.InvalidTokensConfig{
+		Window:    10 * time.Minute,
+		Threshold: 0.5,
+		MinCount:  2,
+	})
+	monitor.now = func() time.Time { return now }
+
+	sender := NewSenderMonitoring(monitor, NewFakeSender())
+	for i := 0; i < 2; i++ {
+		sender.Send(context.Background(), &Message{Platform: PlatformAndroid})
+	}
+	monitor.Observe(ErrInvalidToken)
+	if monitor.Alert() {
+		t.Fatal("unexpected alert below the minimum count")
+	}
+
+	monitor.ObserveInvalidToken()
+	if !monitor.Alert() {
+		t.Fatal("expected alert when half of the tokens are invalid")
+	}
+
+	now = now.Add(11 * time.Minute)
+	monitor.Observe(nil)
+	if monitor.Alert() {
+		t.Fatal("expected alert to clear once the window has passed")
+	}
+}
+
+func TestFeedbackHandler(t *testing.T) {
+	monitor := NewMonitor(config.InvalidTokensConfig{Window: time.Minute, MinCount: 1})
+
+	var reported []*Feedback
+	handler := NewFeedbackHandler(
+		config.PushFeedbackConfig{Secret: "secret"},
+		monitor,
+		func(ctx context.Context, feedback *Feedback) error {
+			reported = append(reported, feedback)
+			return nil
+		},
+	)
+
+	post := func(secret, body string) int {
+		r := httptest.NewRequest(http.MethodPost, "/push/feedback", strings.NewReader(body))
+		r.Header.Set(FeedbackSecretHeader, secret)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	failed := `{"type":"failed-push","platform":"android","token":"dead","error":"Requested entity was not found."}`
+	if code := post("wrong", failed); code != http.StatusUnauthorized {
+		t.Fatalf("expected %d, got %d", http.StatusUnauthorized, code)
+	}
+	if code := post("secret", `{"type":"succeeded-push","token":"alive"}`); code != http.StatusNoContent {
+		t.Fatalf("expected %d, got %d", http.StatusNoContent, code)
+	}
+	if code := post("secret", failed); code != http.StatusNoContent {
+		t.Fatalf("expected %d, got %d", http.StatusNoContent, code)
+	}
+
+	if len(reported) != 1 || reported[0].Token != "dead" || !reported[0].InvalidToken() {
+		t.Fatalf("unexpected feedback: %+v", reported)
+	}
+	if !monitor.Alert() {
+		t.Fatal("expected invalid token to be observed")
+	}
+
+	open := NewFeedbackHandler(
+		config.PushFeedbackConfig{},
+		monitor,
+		func(ctx context.Context, feedback *Feedback) error {
+			t.Fatal("expected feedback to be rejected without a secret")
+			return nil
+		},
+	)
+	r := httptest.NewRequest(http.MethodPost, "/push/feedback", strings.NewReader(failed))
+	w := httptest.NewRecorder()
+	open.ServeHTTP(w, r)
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+}
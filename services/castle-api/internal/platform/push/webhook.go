@@ -0,0 +1,70 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"journeyhub/internal/platform/config"
+)
+
+// WebhookSignatureHeader carries the hex encoded HMAC-SHA256 of the request
+// body, computed with the configured secret.
+const WebhookSignatureHeader = "X-Castle-Signature"
+
+// WebhookSender posts every message as JSON to a configured URL, leaving the
+// delivery to an external service.
+type WebhookSender struct {
+	config     config.WebhookConfig
+	httpClient *http.Client
+}
+
+func NewWebhookSender(webhookConfig config.WebhookConfig) *WebhookSender {
+	return &WebhookSender{
+		config:     webhookConfig,
+		httpClient: &http.Client{Timeout: webhookConfig.Timeout},
+	}
+}
+
+func (s *WebhookSender) Send(ctx context.Context, message *Message) error {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if s.config.Secret != "" {
+		mac := hmac.New(sha256.New, []byte(s.config.Secret))
+		mac.Write(body)
+		req.Header.Set(WebhookSignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusGone {
+		return fmt.Errorf("%w: %w: webhook %d", ErrDeliveryFailed, ErrInvalidToken, resp.StatusCode)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%w: webhook %d", ErrDeliveryFailed, resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (s *WebhookSender) Name() string {
+	return "webhook"
+}
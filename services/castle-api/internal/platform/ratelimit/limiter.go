@@ -0,0 +1,99 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"journeyhub/internal/platform/config"
+	"journeyhub/internal/platform/nats"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+var ErrLimitExceeded = errors.New("too many attempts, try again later")
+
+// maxConflicts bounds the retries of an attempt racing with others on the
+// same key.
+const maxConflicts = 5
+
+// Limiter counts the attempts of each key within fixed windows.
+type Limiter interface {
+	// Allow records an attempt of the key and reports whether it is within
+	// the limit of the current window.
+	Allow(ctx context.Context, key string) (bool, error)
+}
+
+type kvLimiter struct {
+	config config.RateLimitConfig
+	kv     jetstream.KeyValue
+}
+
+// NewKVLimiter keeps the counters in a NATS KV bucket, so that the limit
+// holds across replicas.
+func NewKVLimiter(
+	ctx context.Context,
+	natsService nats.Service,
+	config config.RateLimitConfig,
+) (Limiter, error) {
+	js := *natsService.JetStream()
+
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:  config.Bucket,
+		TTL:     config.Window,
+		Storage: jetstream.MemoryStorage,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &kvLimiter{
+		config: config,
+		kv:     kv,
+	}, nil
+}
+
+func (l *kvLimiter) Allow(ctx context.Context, key string) (bool, error) {
+	k := windowKey(key, l.config.Window, time.Now())
+
+	for i := 0; i < maxConflicts; i++ {
+		entry, err := l.kv.Get(ctx, k)
+		switch {
+		case errors.Is(err, jetstream.ErrKeyNotFound):
+			_, err = l.kv.Create(ctx, k, []byte("1"))
+		case err != nil:
+			return false, err
+		default:
+			attempts, _ := strconv.Atoi(string(entry.Value()))
+			if attempts >= l.config.Attempts {
+				return false, nil
+			}
+			_, err = l.kv.Update(ctx, k, []byte(strconv.Itoa(attempts+1)), entry.Revision())
+		}
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			continue
+		}
+		return err == nil, err
+	}
+
+	return false, nil
+}
+
+// windowKey is the counter of the key for the window holding now.
+func windowKey(key string, window time.Duration, now time.Time) string {
+	return fmt.Sprintf("%s.%d", key, now.UnixNano()/int64(window))
+}
+
+// NewLimitExceededError tells the client to slow down.
+func NewLimitExceededError() error {
+	return &gqlerror.Error{
+		Err:     ErrLimitExceeded,
+		Message: ErrLimitExceeded.Error(),
+		Extensions: map[string]interface{}{
+			"code": "RATE_LIMITED",
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+type limiterLogging struct {
+	logger log.Logger
+	Limiter
+}
+
+func NewLimiterLogging(logger log.Logger, l Limiter) Limiter {
+	return &limiterLogging{logger, l}
+}
+
+func (l *limiterLogging) Allow(
+	ctx context.Context,
+	key string,
+) (allowed bool, err error) {
+	defer func(begin time.Time) {
+		level.Debug(l.logger).Log(
+			"method", "Allow",
+			"key", key,
+			"allowed", allowed,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return l.Limiter.Allow(ctx, key)
+}
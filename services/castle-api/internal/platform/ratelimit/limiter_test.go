@@ -0,0 +1,21 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWindowKey(t *testing.T) {
+	window := 10 * time.Minute
+	start := time.Unix(0, 0).Add(6 * window)
+
+	if got, want := windowKey("US1", window, start), "US1.6"; got != want {
+		t.Errorf("windowKey() = %q, want %q", got, want)
+	}
+	if windowKey("US1", window, start) != windowKey("US1", window, start.Add(window-time.Nanosecond)) {
+		t.Error("windowKey() differs within the window")
+	}
+	if windowKey("US1", window, start) == windowKey("US1", window, start.Add(window)) {
+		t.Error("windowKey() is the same in the next window")
+	}
+}
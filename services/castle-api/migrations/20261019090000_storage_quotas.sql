@@ -0,0 +1,24 @@
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "room_id" character varying NULL, ADD COLUMN "user_id" character varying NULL, ADD
+ CONSTRAINT "files_rooms_files" FOREIGN KEY ("room_id") REFERENCES "rooms" ("id") ON UPDATE NO ACTION ON DELETE SET NULL, ADD
+ CONSTRAINT "files_users_files" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Modify "rooms" table
+ALTER TABLE "rooms" ADD COLUMN "storage_used" bigint NOT NULL DEFAULT 0;
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "storage_used" bigint NOT NULL DEFAULT 0;
+-- Backfill "files" owners from message attachments
+UPDATE "files" SET "user_id" = "messages"."user_messages", "room_id" = "message_attachments"."room_message_attachments"
+FROM "message_attachments" JOIN "messages" ON "messages"."id" = "message_attachments"."message_attachments"
+WHERE "message_attachments"."file_message_attachment" = "files"."id";
+-- Backfill "files" owners from message voices
+UPDATE "files" SET "user_id" = "messages"."user_messages", "room_id" = "message_voices"."room_message_voices"
+FROM "message_voices" JOIN "messages" ON "messages"."id" = "message_voices"."message_voice"
+WHERE "message_voices"."file_message_voice" = "files"."id";
+-- Backfill "users" storage usage
+UPDATE "users" SET "storage_used" = "usage"."size"
+FROM (SELECT "user_id", SUM("size") AS "size" FROM "files" WHERE "user_id" IS NOT NULL GROUP BY "user_id") AS "usage"
+WHERE "usage"."user_id" = "users"."id";
+-- Backfill "rooms" storage usage
+UPDATE "rooms" SET "storage_used" = "usage"."size"
+FROM (SELECT "room_id", SUM("size") AS "size" FROM "files" WHERE "room_id" IS NOT NULL GROUP BY "room_id") AS "usage"
+WHERE "usage"."room_id" = "rooms"."id";
@@ -0,0 +1,5 @@
+-- Modify "notifications" table
+ALTER TABLE "notifications" ADD COLUMN "type" character varying NOT NULL DEFAULT 'NewMessage', ADD COLUMN "read_at" timestamptz NULL;
+ALTER TABLE "notifications" ALTER COLUMN "type" DROP DEFAULT;
+-- Create index "notification_read_at_user_notifications" to table: "notifications"
+CREATE INDEX "notification_read_at_user_notifications" ON "notifications" ("read_at", "user_notifications");
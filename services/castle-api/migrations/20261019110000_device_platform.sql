@@ -0,0 +1,2 @@
+-- Modify "devices" table
+ALTER TABLE "devices" ADD COLUMN "platform" character varying NOT NULL DEFAULT 'Android', ADD COLUMN "voip_token" character varying NULL;
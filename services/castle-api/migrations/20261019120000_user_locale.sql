@@ -0,0 +1,2 @@
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "locale" character varying NOT NULL DEFAULT 'ru', ADD COLUMN "message_previews" boolean NOT NULL DEFAULT true;
@@ -0,0 +1,10 @@
+-- Create "outbox_messages" table
+CREATE TABLE "outbox_messages" (
+  "id" character varying NOT NULL,
+  "subject" character varying NOT NULL,
+  "payload" bytea NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "outboxmessage_created_at" to table: "outbox_messages"
+CREATE INDEX "outboxmessage_created_at" ON "outbox_messages" ("created_at");
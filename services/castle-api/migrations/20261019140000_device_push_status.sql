@@ -0,0 +1,2 @@
+-- Modify "devices" table
+ALTER TABLE "devices" ADD COLUMN "last_push_at" timestamptz NULL, ADD COLUMN "last_push_error" character varying NULL, ADD COLUMN "invalidated_at" timestamptz NULL;
@@ -0,0 +1,4 @@
+-- Modify "room_members" table
+ALTER TABLE "room_members" ADD COLUMN "notification_level" character varying NOT NULL DEFAULT 'All', ADD COLUMN "mute_until" timestamptz NULL;
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "timezone" character varying NOT NULL DEFAULT 'UTC', ADD COLUMN "quiet_hours_start" character varying NULL, ADD COLUMN "quiet_hours_end" character varying NULL;
@@ -0,0 +1,34 @@
+-- Create "calls" table
+CREATE TABLE "calls" (
+  "id" character varying NOT NULL,
+  "type" character varying NOT NULL,
+  "state" character varying NOT NULL DEFAULT 'Ringing',
+  "started_at" timestamptz NOT NULL,
+  "answered_at" timestamptz NULL,
+  "ended_at" timestamptz NULL,
+  "duration" bigint NOT NULL DEFAULT 0,
+  "room_id" character varying NOT NULL,
+  "caller_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "calls_rooms_room" FOREIGN KEY ("room_id") REFERENCES "rooms" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "calls_users_caller" FOREIGN KEY ("caller_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "call_room_id" to table: "calls"
+CREATE UNIQUE INDEX "call_room_id" ON "calls" ("room_id") WHERE ("state" IN ('Ringing', 'Active'));
+-- Create index "call_state_started_at" to table: "calls"
+CREATE INDEX "call_state_started_at" ON "calls" ("state", "started_at");
+-- Create "call_participants" table
+CREATE TABLE "call_participants" (
+  "id" character varying NOT NULL,
+  "state" character varying NOT NULL DEFAULT 'Invited',
+  "invited_at" timestamptz NOT NULL,
+  "joined_at" timestamptz NULL,
+  "left_at" timestamptz NULL,
+  "call_id" character varying NOT NULL,
+  "user_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "call_participants_calls_participants" FOREIGN KEY ("call_id") REFERENCES "calls" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "call_participants_users_user" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "callparticipant_call_id_user_id" to table: "call_participants"
+CREATE UNIQUE INDEX "callparticipant_call_id_user_id" ON "call_participants" ("call_id", "user_id");
@@ -0,0 +1,2 @@
+-- Modify "room_members" table
+ALTER TABLE "room_members" ADD COLUMN "role" character varying NOT NULL DEFAULT 'Member';
@@ -0,0 +1,2 @@
+-- Modify "messages" table
+ALTER TABLE "messages" ADD COLUMN "type" character varying NOT NULL DEFAULT 'Text', ADD COLUMN "call_id" character varying NULL, ADD CONSTRAINT "messages_calls_call" FOREIGN KEY ("call_id") REFERENCES "calls" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
@@ -0,0 +1,17 @@
+-- Create "room_invites" table
+CREATE TABLE "room_invites" (
+  "id" character varying NOT NULL,
+  "token" character varying NOT NULL,
+  "expires_at" timestamptz NULL,
+  "max_uses" bigint NULL,
+  "uses" bigint NOT NULL DEFAULT 0,
+  "revoked_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "room_id" character varying NOT NULL,
+  "creator_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "room_invites_rooms_room" FOREIGN KEY ("room_id") REFERENCES "rooms" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "room_invites_users_creator" FOREIGN KEY ("creator_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "room_invites_token_key" to table: "room_invites"
+CREATE UNIQUE INDEX "room_invites_token_key" ON "room_invites" ("token");
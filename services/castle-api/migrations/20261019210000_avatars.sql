@@ -0,0 +1,20 @@
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "width" bigint NULL, ADD COLUMN "height" bigint NULL, ADD COLUMN "file_thumbnails" character varying NULL, ADD CONSTRAINT "files_files_thumbnails" FOREIGN KEY ("file_thumbnails") REFERENCES "files" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Modify "rooms" table
+ALTER TABLE "rooms" ADD COLUMN "avatar_id" character varying NULL, ADD CONSTRAINT "rooms_files_avatar" FOREIGN KEY ("avatar_id") REFERENCES "files" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "avatar_id" character varying NULL, ADD CONSTRAINT "users_files_avatar" FOREIGN KEY ("avatar_id") REFERENCES "files" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create "avatars" table
+CREATE TABLE "avatars" (
+  "id" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "file_id" character varying NOT NULL,
+  "room_id" character varying NULL,
+  "user_id" character varying NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "avatars_files_file" FOREIGN KEY ("file_id") REFERENCES "files" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "avatars_rooms_avatars" FOREIGN KEY ("room_id") REFERENCES "rooms" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "avatars_users_avatars" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Modify "messages" table
+ALTER TABLE "messages" ADD COLUMN "avatar_id" character varying NULL, ADD CONSTRAINT "messages_avatars_avatar" FOREIGN KEY ("avatar_id") REFERENCES "avatars" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
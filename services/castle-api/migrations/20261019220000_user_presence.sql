@@ -0,0 +1,2 @@
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "hide_last_seen" boolean NOT NULL DEFAULT false, ADD COLUMN "last_seen_at" timestamptz NULL;
@@ -0,0 +1,14 @@
+-- Create "user_blocks" table
+CREATE TABLE "user_blocks" (
+  "id" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "user_id" character varying NOT NULL,
+  "blocked_user_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "user_blocks_users_blocked_user" FOREIGN KEY ("blocked_user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "user_blocks_users_user" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "userblock_blocked_user_id" to table: "user_blocks"
+CREATE INDEX "userblock_blocked_user_id" ON "user_blocks" ("blocked_user_id");
+-- Create index "userblock_user_id_blocked_user_id" to table: "user_blocks"
+CREATE UNIQUE INDEX "userblock_user_id_blocked_user_id" ON "user_blocks" ("user_id", "blocked_user_id");
@@ -0,0 +1,29 @@
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "role" character varying NOT NULL DEFAULT 'User', ADD COLUMN "suspended_at" timestamptz NULL;
+-- Create "reports" table
+CREATE TABLE "reports" (
+  "id" character varying NOT NULL,
+  "type" character varying NOT NULL,
+  "reason" character varying NOT NULL,
+  "text" text NULL,
+  "snapshot" jsonb NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'Open',
+  "actions" jsonb NULL,
+  "note" text NULL,
+  "closed_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "reporter_id" character varying NOT NULL,
+  "user_id" character varying NULL,
+  "message_id" character varying NULL,
+  "room_id" character varying NULL,
+  "assignee_id" character varying NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "reports_messages_message" FOREIGN KEY ("message_id") REFERENCES "messages" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "reports_rooms_room" FOREIGN KEY ("room_id") REFERENCES "rooms" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "reports_users_assignee" FOREIGN KEY ("assignee_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "reports_users_reporter" FOREIGN KEY ("reporter_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "reports_users_user" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+-- Create index "report_status_created_at" to table: "reports"
+CREATE INDEX "report_status_created_at" ON "reports" ("status", "created_at");
@@ -0,0 +1,16 @@
+-- Create "contact_requests" table
+CREATE TABLE "contact_requests" (
+  "id" character varying NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'Pending',
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "sender_id" character varying NOT NULL,
+  "receiver_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "contact_requests_users_receiver" FOREIGN KEY ("receiver_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "contact_requests_users_sender" FOREIGN KEY ("sender_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "contactrequest_receiver_id_status" to table: "contact_requests"
+CREATE INDEX "contactrequest_receiver_id_status" ON "contact_requests" ("receiver_id", "status");
+-- Create index "contactrequest_sender_id_receiver_id" to table: "contact_requests"
+CREATE UNIQUE INDEX "contactrequest_sender_id_receiver_id" ON "contact_requests" ("sender_id", "receiver_id");
@@ -0,0 +1,23 @@
+-- Create "contact_pins" table
+CREATE TABLE "contact_pins" (
+  "id" character varying NOT NULL,
+  "code" character varying NOT NULL,
+  "expires_at" timestamptz NULL,
+  "max_uses" bigint NULL,
+  "uses" bigint NOT NULL DEFAULT 0,
+  "revoked_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "user_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "contact_pins_users_user" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "contact_pins_code_key" to table: "contact_pins"
+CREATE UNIQUE INDEX "contact_pins_code_key" ON "contact_pins" ("code");
+-- Create index "contactpin_user_id_revoked_at" to table: "contact_pins"
+CREATE INDEX "contactpin_user_id_revoked_at" ON "contact_pins" ("user_id", "revoked_at");
+-- Backfill "contact_pins" from the pin of each user, keeping it without expiry
+INSERT INTO "contact_pins" ("id", "code", "created_at", "user_id")
+SELECT 'PN' || SUBSTRING("id" FROM 3), "contact_pin", NOW(), "id" FROM "users"
+WHERE "contact_pin" IS NOT NULL;
+-- Modify "users" table
+ALTER TABLE "users" DROP COLUMN "contact_pin";
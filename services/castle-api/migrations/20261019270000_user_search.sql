@@ -0,0 +1,10 @@
+-- Enable "pg_trgm" extension
+CREATE EXTENSION IF NOT EXISTS "pg_trgm";
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "discoverability" character varying NOT NULL DEFAULT 'ContactsOfContacts';
+-- Create index "user_first_name" to table: "users"
+CREATE INDEX "user_first_name" ON "users" USING GIN ("first_name" gin_trgm_ops);
+-- Create index "user_last_name" to table: "users"
+CREATE INDEX "user_last_name" ON "users" USING GIN ("last_name" gin_trgm_ops);
+-- Create index "user_nickname" to table: "users"
+CREATE INDEX "user_nickname" ON "users" USING GIN ("nickname" gin_trgm_ops);